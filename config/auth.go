@@ -9,11 +9,12 @@ import (
 
 // AuthConfiguration defines the authentication & authorization settings
 type AuthConfiguration struct {
-	Secret                string
-	JwtAccessLifespan     time.Duration
-	JwtRefreshLifespan    time.Duration
-	HmacTimestampAge      time.Duration
-	PasswordResetLifespan time.Duration
+	Secret                 string
+	JwtAccessLifespan      time.Duration
+	JwtRefreshLifespan     time.Duration
+	HmacTimestampAge       time.Duration
+	PasswordResetLifespan  time.Duration
+	TeamInvitationLifespan time.Duration
 }
 
 // AuthConfig sets the authentication & authorization configurations
@@ -22,13 +23,15 @@ func AuthConfig() (config *AuthConfiguration) {
 	viper.SetDefault("JWT_REFRESH_LIFESPAN", 10080) // 7 days
 	viper.SetDefault("HMAC_TIMESTAMP_AGE", 5)
 	viper.SetDefault("PASSWORD_RESET_LIFESPAN", 5)
+	viper.SetDefault("TEAM_INVITATION_LIFESPAN", 168) // 7 days
 
 	return &AuthConfiguration{
-		Secret:                viper.GetString("SECRET"),
-		JwtAccessLifespan:     time.Duration(viper.GetInt("JWT_ACCESS_LIFESPAN")) * time.Minute,
-		JwtRefreshLifespan:    time.Duration(viper.GetInt("JWT_REFRESH_LIFESPAN")) * time.Minute,
-		HmacTimestampAge:      time.Duration(viper.GetInt("HMAC_TIMESTAMP_AGE")) * time.Minute,
-		PasswordResetLifespan: time.Duration(viper.GetInt("PASSWORD_RESET_LIFESPAN")) * time.Minute,
+		Secret:                 viper.GetString("SECRET"),
+		JwtAccessLifespan:      time.Duration(viper.GetInt("JWT_ACCESS_LIFESPAN")) * time.Minute,
+		JwtRefreshLifespan:     time.Duration(viper.GetInt("JWT_REFRESH_LIFESPAN")) * time.Minute,
+		HmacTimestampAge:       time.Duration(viper.GetInt("HMAC_TIMESTAMP_AGE")) * time.Minute,
+		PasswordResetLifespan:  time.Duration(viper.GetInt("PASSWORD_RESET_LIFESPAN")) * time.Minute,
+		TeamInvitationLifespan: time.Duration(viper.GetInt("TEAM_INVITATION_LIFESPAN")) * time.Hour,
 	}
 }
 
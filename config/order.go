@@ -18,8 +18,45 @@ type OrderConfiguration struct {
 	EntryPointContractAddress        common.Address
 	BucketQueueRebuildInterval       int // in hours
 	RefundCancellationCount          int
+	OrderBatchSizeLimit              int
 	PercentDeviationFromExternalRate decimal.Decimal
 	PercentDeviationFromMarketRate   decimal.Decimal
+	StaleRateNotificationCooldown    time.Duration
+	ProviderHealthCheckTimeout       time.Duration
+	ProviderHealthFailureThreshold   int
+	ProviderHealthCheckRetention     time.Duration
+	ProviderHealthCheckConcurrency   int
+	ProviderSLABreachWindow          time.Duration
+	ProviderSLADemotionThreshold     int
+	ProviderSLADemotionDuration      time.Duration
+	ProviderSLASuspensionThreshold   int
+	ProviderSLASuspensionDuration    time.Duration
+	NodeMinProtocolVersion           int
+	DisputeWindow                    time.Duration
+	DisputeResponseWindow            time.Duration
+	DisputeResolutionWindow          time.Duration
+	PSPLookupTimeout                 time.Duration
+	PSPMockEnabled                   bool
+	MatchingStrategy                 string
+	OrderSplitMaxChunks              int
+	BucketProposalWindow             time.Duration
+	BucketProposalMinOrders          int
+	OrderBroadcastSize               int
+	DeadLetterRetryInterval          time.Duration
+	DeadLetterMaxRetryInterval       time.Duration
+	DeadLetterRefundDeadline         time.Duration
+	RateSources                      string
+	RateSourceTokens                 string
+	RateAggregation                  string
+	RateTrimPercent                  decimal.Decimal
+	RateSourceMaxDeviation           decimal.Decimal
+	RateSourceFailureThreshold       int
+	RateSourceCooldown               time.Duration
+	RateSourceFile                   string
+	RateFetchTimeout                 time.Duration
+	RateHistoryRawRetention          time.Duration
+	RateHistoryHourlyRetention       time.Duration
+	RateHistoryRetention             time.Duration
 	BundlerUrlEthereum               string
 	PaymasterUrlEthereum             string
 	BundlerUrlPolygon                string
@@ -40,9 +77,45 @@ func OrderConfig() *OrderConfiguration {
 	viper.SetDefault("ORDER_FULFILLMENT_VALIDITY", 10)
 	viper.SetDefault("BUCKET_QUEUE_REBUILD_INTERVAL", 1)
 	viper.SetDefault("REFUND_CANCELLATION_COUNT", 3)
+	viper.SetDefault("ORDER_BATCH_SIZE_LIMIT", 50)
 	viper.SetDefault("NETWORK_FEE", 0.05)
 	viper.SetDefault("PERCENT_DEVIATION_FROM_EXTERNAL_RATE", 0.01)
 	viper.SetDefault("PERCENT_DEVIATION_FROM_MARKET_RATE", 0.1)
+	viper.SetDefault("STALE_RATE_NOTIFICATION_COOLDOWN", 24)
+	viper.SetDefault("PROVIDER_HEALTH_CHECK_TIMEOUT", 5)
+	viper.SetDefault("PROVIDER_HEALTH_FAILURE_THRESHOLD", 3)
+	viper.SetDefault("PROVIDER_HEALTH_CHECK_RETENTION", 7)
+	viper.SetDefault("PROVIDER_HEALTH_CHECK_CONCURRENCY", 20)
+	viper.SetDefault("PROVIDER_SLA_BREACH_WINDOW", 24)
+	viper.SetDefault("PROVIDER_SLA_DEMOTION_THRESHOLD", 3)
+	viper.SetDefault("PROVIDER_SLA_DEMOTION_DURATION", 60)
+	viper.SetDefault("PROVIDER_SLA_SUSPENSION_THRESHOLD", 10)
+	viper.SetDefault("PROVIDER_SLA_SUSPENSION_DURATION", 1440)
+	viper.SetDefault("NODE_MIN_PROTOCOL_VERSION", 1)
+	viper.SetDefault("DISPUTE_WINDOW", 30)
+	viper.SetDefault("DISPUTE_RESPONSE_WINDOW", 48)
+	viper.SetDefault("DISPUTE_RESOLUTION_WINDOW", 120)
+	viper.SetDefault("PSP_LOOKUP_TIMEOUT", 10)
+	viper.SetDefault("PSP_MOCK_ENABLED", false)
+	viper.SetDefault("MATCHING_STRATEGY", "round_robin")
+	viper.SetDefault("ORDER_SPLIT_MAX_CHUNKS", 10)
+	viper.SetDefault("BUCKET_PROPOSAL_WINDOW", 30)
+	viper.SetDefault("BUCKET_PROPOSAL_MIN_ORDERS", 100)
+	viper.SetDefault("ORDER_BROADCAST_SIZE", 3)
+	viper.SetDefault("DEAD_LETTER_RETRY_INTERVAL", 60)
+	viper.SetDefault("DEAD_LETTER_MAX_RETRY_INTERVAL", 30)
+	viper.SetDefault("DEAD_LETTER_REFUND_DEADLINE", 120)
+	viper.SetDefault("RATE_SOURCES", "NGN=quidax;KES=binance_p2p;GHS=binance_p2p;TZS=binance_p2p;UGX=binance_p2p;XOF=binance_p2p")
+	viper.SetDefault("RATE_SOURCE_TOKENS", "USDT")
+	viper.SetDefault("RATE_AGGREGATION", "median")
+	viper.SetDefault("RATE_TRIM_PERCENT", 20)
+	viper.SetDefault("RATE_SOURCE_MAX_DEVIATION", 5)
+	viper.SetDefault("RATE_SOURCE_FAILURE_THRESHOLD", 3)
+	viper.SetDefault("RATE_SOURCE_COOLDOWN", 30)
+	viper.SetDefault("RATE_FETCH_TIMEOUT", 60)
+	viper.SetDefault("RATE_HISTORY_RAW_RETENTION", 7)
+	viper.SetDefault("RATE_HISTORY_HOURLY_RETENTION", 90)
+	viper.SetDefault("RATE_HISTORY_RETENTION", 730)
 	viper.SetDefault("ACTIVE_AA_SERVICE", "stackup")
 
 	return &OrderConfiguration{
@@ -64,8 +137,45 @@ func OrderConfig() *OrderConfiguration {
 		EntryPointContractAddress:        common.HexToAddress(viper.GetString("ENTRY_POINT_CONTRACT_ADDRESS")),
 		BucketQueueRebuildInterval:       viper.GetInt("BUCKET_QUEUE_REBUILD_INTERVAL"),
 		RefundCancellationCount:          viper.GetInt("REFUND_CANCELLATION_COUNT"),
+		OrderBatchSizeLimit:              viper.GetInt("ORDER_BATCH_SIZE_LIMIT"),
 		PercentDeviationFromExternalRate: decimal.NewFromFloat(viper.GetFloat64("PERCENT_DEVIATION_FROM_EXTERNAL_RATE")),
 		PercentDeviationFromMarketRate:   decimal.NewFromFloat(viper.GetFloat64("PERCENT_DEVIATION_FROM_MARKET_RATE")),
+		StaleRateNotificationCooldown:    time.Duration(viper.GetInt("STALE_RATE_NOTIFICATION_COOLDOWN")) * time.Hour,
+		ProviderHealthCheckTimeout:       time.Duration(viper.GetInt("PROVIDER_HEALTH_CHECK_TIMEOUT")) * time.Second,
+		ProviderHealthFailureThreshold:   viper.GetInt("PROVIDER_HEALTH_FAILURE_THRESHOLD"),
+		ProviderHealthCheckRetention:     time.Duration(viper.GetInt("PROVIDER_HEALTH_CHECK_RETENTION")) * 24 * time.Hour,
+		ProviderHealthCheckConcurrency:   viper.GetInt("PROVIDER_HEALTH_CHECK_CONCURRENCY"),
+		ProviderSLABreachWindow:          time.Duration(viper.GetInt("PROVIDER_SLA_BREACH_WINDOW")) * time.Hour,
+		ProviderSLADemotionThreshold:     viper.GetInt("PROVIDER_SLA_DEMOTION_THRESHOLD"),
+		ProviderSLADemotionDuration:      time.Duration(viper.GetInt("PROVIDER_SLA_DEMOTION_DURATION")) * time.Minute,
+		ProviderSLASuspensionThreshold:   viper.GetInt("PROVIDER_SLA_SUSPENSION_THRESHOLD"),
+		ProviderSLASuspensionDuration:    time.Duration(viper.GetInt("PROVIDER_SLA_SUSPENSION_DURATION")) * time.Minute,
+		NodeMinProtocolVersion:           viper.GetInt("NODE_MIN_PROTOCOL_VERSION"),
+		DisputeWindow:                    time.Duration(viper.GetInt("DISPUTE_WINDOW")) * 24 * time.Hour,
+		DisputeResponseWindow:            time.Duration(viper.GetInt("DISPUTE_RESPONSE_WINDOW")) * time.Hour,
+		DisputeResolutionWindow:          time.Duration(viper.GetInt("DISPUTE_RESOLUTION_WINDOW")) * time.Hour,
+		PSPLookupTimeout:                 time.Duration(viper.GetInt("PSP_LOOKUP_TIMEOUT")) * time.Second,
+		PSPMockEnabled:                   viper.GetBool("PSP_MOCK_ENABLED"),
+		MatchingStrategy:                 viper.GetString("MATCHING_STRATEGY"),
+		OrderSplitMaxChunks:              viper.GetInt("ORDER_SPLIT_MAX_CHUNKS"),
+		BucketProposalWindow:             time.Duration(viper.GetInt("BUCKET_PROPOSAL_WINDOW")) * 24 * time.Hour,
+		BucketProposalMinOrders:          viper.GetInt("BUCKET_PROPOSAL_MIN_ORDERS"),
+		OrderBroadcastSize:               viper.GetInt("ORDER_BROADCAST_SIZE"),
+		DeadLetterRetryInterval:          time.Duration(viper.GetInt("DEAD_LETTER_RETRY_INTERVAL")) * time.Second,
+		DeadLetterMaxRetryInterval:       time.Duration(viper.GetInt("DEAD_LETTER_MAX_RETRY_INTERVAL")) * time.Minute,
+		DeadLetterRefundDeadline:         time.Duration(viper.GetInt("DEAD_LETTER_REFUND_DEADLINE")) * time.Minute,
+		RateSources:                      viper.GetString("RATE_SOURCES"),
+		RateSourceTokens:                 viper.GetString("RATE_SOURCE_TOKENS"),
+		RateAggregation:                  viper.GetString("RATE_AGGREGATION"),
+		RateTrimPercent:                  decimal.NewFromFloat(viper.GetFloat64("RATE_TRIM_PERCENT")),
+		RateSourceMaxDeviation:           decimal.NewFromFloat(viper.GetFloat64("RATE_SOURCE_MAX_DEVIATION")),
+		RateSourceFailureThreshold:       viper.GetInt("RATE_SOURCE_FAILURE_THRESHOLD"),
+		RateSourceCooldown:               time.Duration(viper.GetInt("RATE_SOURCE_COOLDOWN")) * time.Minute,
+		RateSourceFile:                   viper.GetString("RATE_SOURCE_FILE"),
+		RateFetchTimeout:                 time.Duration(viper.GetInt("RATE_FETCH_TIMEOUT")) * time.Second,
+		RateHistoryRawRetention:          time.Duration(viper.GetInt("RATE_HISTORY_RAW_RETENTION")) * 24 * time.Hour,
+		RateHistoryHourlyRetention:       time.Duration(viper.GetInt("RATE_HISTORY_HOURLY_RETENTION")) * 24 * time.Hour,
+		RateHistoryRetention:             time.Duration(viper.GetInt("RATE_HISTORY_RETENTION")) * 24 * time.Hour,
 	}
 }
 
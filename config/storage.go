@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// BlobStorageConfiguration type defines the blob storage configurations
+type BlobStorageConfiguration struct {
+	Driver         string
+	LocalPath      string
+	MaxReceiptSize int64 // in bytes
+}
+
+// BlobStorageConfig retrieves the blob storage configuration
+func BlobStorageConfig() BlobStorageConfiguration {
+	viper.SetDefault("BLOB_STORAGE_DRIVER", "local")
+	viper.SetDefault("BLOB_STORAGE_LOCAL_PATH", "./data/blobs")
+	viper.SetDefault("RECEIPT_MAX_SIZE", 5)
+
+	return BlobStorageConfiguration{
+		Driver:         viper.GetString("BLOB_STORAGE_DRIVER"),
+		LocalPath:      viper.GetString("BLOB_STORAGE_LOCAL_PATH"),
+		MaxReceiptSize: viper.GetInt64("RECEIPT_MAX_SIZE") * 1024 * 1024,
+	}
+}
+
+func init() {
+	if err := SetupConfig(); err != nil {
+		panic(fmt.Sprintf("config SetupConfig() error: %s", err))
+	}
+}
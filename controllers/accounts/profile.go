@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
@@ -32,6 +34,7 @@ var orderConf = config.OrderConfig()
 type ProfileController struct {
 	apiKeyService        *svc.APIKeyService
 	priorityQueueService *svc.PriorityQueueService
+	marketRateService    *svc.MarketRateService
 }
 
 // NewProfileController creates a new instance of ProfileController
@@ -39,6 +42,7 @@ func NewProfileController() *ProfileController {
 	return &ProfileController{
 		apiKeyService:        svc.NewAPIKeyService(),
 		priorityQueueService: svc.NewPriorityQueueService(),
+		marketRateService:    svc.NewMarketRateService(),
 	}
 }
 
@@ -246,22 +250,84 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 		update.SetIsAvailable(false)
 	}
 
-	if payload.Currency != "" {
-		currency, err := storage.Client.FiatCurrency.
+	// Update supported currencies
+	currentCurrencies, err := provider.QueryCurrencies().All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
+		return
+	}
+
+	supportedCurrencies := map[string]*ent.FiatCurrency{}
+	if len(payload.Currencies) == 0 {
+		for _, currency := range currentCurrencies {
+			supportedCurrencies[currency.Code] = currency
+		}
+	}
+
+	currencyCodes := payload.Currencies
+	if payload.Currency != "" && !u.ContainsString(currencyCodes, payload.Currency) {
+		currencyCodes = append(currencyCodes, payload.Currency)
+	}
+
+	if len(currencyCodes) > 0 {
+		currencies, err := storage.Client.FiatCurrency.
 			Query().
 			Where(
 				fiatcurrency.IsEnabledEQ(true),
-				fiatcurrency.CodeEQ(payload.Currency),
+				fiatcurrency.CodeIn(currencyCodes...),
 			).
-			Only(ctx)
-		if err != nil {
+			All(ctx)
+		if err != nil || len(currencies) != len(currencyCodes) {
 			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
 				Field:   "FiatCurrency",
-				Message: "This field is required",
+				Message: "Currency is not supported",
 			})
 			return
 		}
-		update.SetCurrency(currency)
+
+		if len(payload.Currencies) > 0 {
+			// Replace the supported currencies, dropping the buckets and tokens of removed currencies
+			update.ClearCurrencies()
+			update.AddCurrencies(currencies...)
+
+			staleBuckets, err := storage.Client.ProvisionBucket.
+				Query().
+				Where(
+					provisionbucket.HasProviderProfilesWith(providerprofile.IDEQ(provider.ID)),
+					provisionbucket.Not(provisionbucket.HasCurrencyWith(fiatcurrency.CodeIn(payload.Currencies...))),
+				).
+				All(ctx)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
+				return
+			}
+			update.RemoveProvisionBuckets(staleBuckets...)
+
+			_, err = storage.Client.ProviderOrderToken.
+				Delete().
+				Where(
+					providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+					providerordertoken.Not(providerordertoken.HasCurrencyWith(fiatcurrency.CodeIn(payload.Currencies...))),
+				).
+				Exec(ctx)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
+				return
+			}
+		} else {
+			for _, currency := range currencies {
+				if _, ok := supportedCurrencies[currency.Code]; !ok {
+					update.AddCurrencies(currency)
+				}
+			}
+		}
+
+		for _, currency := range currencies {
+			supportedCurrencies[currency.Code] = currency
+		}
 	}
 
 	if payload.VisibilityMode != "" {
@@ -314,7 +380,107 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 		update.SetBusinessDocument(payload.BusinessDocument)
 	}
 
+	// Update operating schedule
+	if payload.OperatingTimezone != "" || payload.OperatingHours != nil || payload.OperatingHoursExceptions != nil {
+		timezone := provider.OperatingTimezone
+		if payload.OperatingTimezone != "" {
+			timezone = payload.OperatingTimezone
+		}
+
+		hours := payload.OperatingHours
+		if hours == nil {
+			hours = make([]types.OperatingHours, len(provider.OperatingHours))
+			for i, h := range provider.OperatingHours {
+				hours[i] = types.OperatingHours(h)
+			}
+		}
+
+		exceptions := payload.OperatingHoursExceptions
+		if exceptions == nil {
+			exceptions = make([]types.OperatingHoursException, len(provider.OperatingHoursExceptions))
+			for i, e := range provider.OperatingHoursExceptions {
+				exceptions[i] = types.OperatingHoursException(e)
+			}
+		}
+
+		if err := u.ValidateOperatingHours(timezone, hours, exceptions); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid operating hours", types.ErrorData{
+				Field:   "OperatingHours",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		operatingHours := make([]struct {
+			Weekday int    `json:"weekday"`
+			Open    string `json:"open"`
+			Close   string `json:"close"`
+		}, len(hours))
+		for i, h := range hours {
+			operatingHours[i] = struct {
+				Weekday int    `json:"weekday"`
+				Open    string `json:"open"`
+				Close   string `json:"close"`
+			}(h)
+		}
+
+		operatingExceptions := make([]struct {
+			Date   string `json:"date"`
+			Closed bool   `json:"closed"`
+			Open   string `json:"open"`
+			Close  string `json:"close"`
+		}, len(exceptions))
+		for i, e := range exceptions {
+			operatingExceptions[i] = struct {
+				Date   string `json:"date"`
+				Closed bool   `json:"closed"`
+				Open   string `json:"open"`
+				Close  string `json:"close"`
+			}(e)
+		}
+
+		update.SetOperatingTimezone(timezone).
+			SetOperatingHours(operatingHours).
+			SetOperatingHoursExceptions(operatingExceptions)
+	}
+
+	// Update institution allowlists
+	if payload.SupportedInstitutions != nil {
+		currencyCodes := make([]string, 0, len(supportedCurrencies))
+		for code := range supportedCurrencies {
+			currencyCodes = append(currencyCodes, code)
+		}
+
+		count, err := storage.Client.Institution.
+			Query().
+			Where(
+				institution.CodeIn(payload.SupportedInstitutions...),
+				institution.HasFiatCurrencyWith(fiatcurrency.CodeIn(currencyCodes...)),
+			).
+			Count(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
+			return
+		}
+
+		if count != len(payload.SupportedInstitutions) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+				Field:   "SupportedInstitutions",
+				Message: "Institution is not supported for your currencies",
+			})
+			return
+		}
+
+		update.SetSupportedInstitutions(payload.SupportedInstitutions)
+	}
+
+	if payload.SupportedInstitutionTypes != nil {
+		update.SetSupportedInstitutionTypes(payload.SupportedInstitutionTypes)
+	}
+
 	// Update tokens
+	currencyBuckets := map[uuid.UUID][]*ent.ProvisionBucket{}
 	for _, tokenPayload := range payload.Tokens {
 		if len(tokenPayload.Addresses) == 0 {
 			u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("No wallet address provided for %s settlements", tokenPayload.Symbol), nil)
@@ -348,25 +514,37 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 			}
 		}
 
-		// Ensure rate is within allowed deviation from the market rate
-		currency, err := storage.Client.FiatCurrency.
-			Query().
-			Where(
-				fiatcurrency.IsEnabledEQ(true),
-				fiatcurrency.CodeEQ(payload.Currency),
-			).
-			Only(ctx)
-		if err != nil {
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch currency", nil)
+		// Resolve the currency the token is configured for
+		currencyCode := tokenPayload.Currency
+		if currencyCode == "" {
+			currencyCode = payload.Currency
+		}
+		if currencyCode == "" && len(supportedCurrencies) == 1 {
+			for code := range supportedCurrencies {
+				currencyCode = code
+			}
+		}
+
+		currency, ok := supportedCurrencies[currencyCode]
+		if !ok {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", fmt.Sprintf("Currency not supported by provider for %s", tokenPayload.Symbol), nil)
 			return
 		}
 
+		// Ensure rate is within allowed deviation from the market rate
 		var rate decimal.Decimal
 
 		if tokenPayload.ConversionRateType == providerordertoken.ConversionRateTypeFloating {
-			rate = currency.MarketRate.Add(tokenPayload.FloatingConversionRate)
+			marketRate, err := ctrl.marketRateService.GetRate(ctx, currency, tokenPayload.Symbol)
+			if err != nil {
+				logger.Errorf("error: %v", err)
+				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch market rate", nil)
+				return
+			}
 
-			percentDeviation := u.AbsPercentageDeviation(currency.MarketRate, rate)
+			rate = marketRate.Add(tokenPayload.FloatingConversionRate)
+
+			percentDeviation := u.AbsPercentageDeviation(marketRate, rate)
 			if percentDeviation.GreaterThan(orderConf.PercentDeviationFromMarketRate) {
 				u.APIResponse(ctx, http.StatusBadRequest, "error", "Rate is too far from market rate", nil)
 				return
@@ -379,6 +557,7 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 			Where(
 				providerordertoken.SymbolEQ(tokenPayload.Symbol),
 				providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+				providerordertoken.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
 			).
 			Only(ctx)
 
@@ -395,6 +574,7 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 					SetMinOrderAmount(tokenPayload.MinOrderAmount).
 					SetAddresses(tokenPayload.Addresses).
 					SetProviderID(provider.ID).
+					SetCurrency(currency).
 					Save(ctx)
 				if err != nil {
 					u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to set token - "+tokenPayload.Symbol, nil)
@@ -420,16 +600,17 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 			}
 		}
 
-		rate, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, tokenPayload.Symbol)
+		rate, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, tokenPayload.Symbol, currency.Code)
 		if err != nil {
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to set token", nil)
 			return
 		}
 
-		// Add provider to buckets
+		// Add provider to the currency's buckets
 		buckets, err := storage.Client.ProvisionBucket.
 			Query().
 			Where(
+				provisionbucket.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
 				provisionbucket.Or(
 					provisionbucket.MinAmountLTE(tokenPayload.MinOrderAmount.Mul(rate)),
 					provisionbucket.MinAmountLTE(tokenPayload.MaxOrderAmount.Mul(rate)),
@@ -440,8 +621,31 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 		if err != nil {
 			logger.Errorf("Failed to assign provider %s to buckets", provider.ID)
 		} else {
-			update.ClearProvisionBuckets()
-			update.AddProvisionBuckets(buckets...)
+			currencyBuckets[currency.ID] = append(currencyBuckets[currency.ID], buckets...)
+		}
+	}
+
+	// Replace the provider's bucket memberships in the currencies of the updated tokens
+	for currencyID, buckets := range currencyBuckets {
+		existingBuckets, err := storage.Client.ProvisionBucket.
+			Query().
+			Where(
+				provisionbucket.HasProviderProfilesWith(providerprofile.IDEQ(provider.ID)),
+				provisionbucket.HasCurrencyWith(fiatcurrency.IDEQ(currencyID)),
+			).
+			All(ctx)
+		if err != nil {
+			logger.Errorf("Failed to assign provider %s to buckets", provider.ID)
+			continue
+		}
+		update.RemoveProvisionBuckets(existingBuckets...)
+
+		bucketIDs := map[int]bool{}
+		for _, bucket := range buckets {
+			if !bucketIDs[bucket.ID] {
+				bucketIDs[bucket.ID] = true
+				update.AddProvisionBucketIDs(bucket.ID)
+			}
 		}
 	}
 
@@ -468,7 +672,7 @@ func (ctrl *ProfileController) UpdateProviderProfile(ctx *gin.Context) {
 		update.SetIsActive(true)
 	}
 
-	_, err := update.Save(ctx)
+	_, err = update.Save(ctx)
 	if err != nil {
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update profile", nil)
 		return
@@ -545,7 +749,7 @@ func (ctrl *ProfileController) GetSenderProfile(ctx *gin.Context) {
 	linkedProvider, err := storage.Client.ProviderProfile.
 		Query().
 		Where(providerprofile.IDEQ(sender.ProviderID)).
-		WithCurrency().
+		WithCurrencies().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -559,7 +763,9 @@ func (ctrl *ProfileController) GetSenderProfile(ctx *gin.Context) {
 
 	if linkedProvider != nil {
 		response.ProviderID = sender.ProviderID
-		response.ProviderCurrency = linkedProvider.Edges.Currency.Code
+		for _, currency := range linkedProvider.Edges.Currencies {
+			response.ProviderCurrencies = append(response.ProviderCurrencies, currency.Code)
+		}
 	}
 
 	u.APIResponse(ctx, http.StatusOK, "success", "Profile retrieved successfully", response)
@@ -582,16 +788,21 @@ func (ctrl *ProfileController) GetProviderProfile(ctx *gin.Context) {
 		return
 	}
 
-	// Get currency
-	currency, err := provider.QueryCurrency().Only(ctx)
+	// Get currencies
+	currencies, err := provider.QueryCurrencies().All(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to retrieve profile", nil)
 		return
 	}
 
+	currencyCodes := make([]string, len(currencies))
+	for i, currency := range currencies {
+		currencyCodes[i] = currency.Code
+	}
+
 	// Get tokens
-	tokens, err := provider.QueryOrderTokens().All(ctx)
+	tokens, err := provider.QueryOrderTokens().WithCurrency().All(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to retrieve profile", nil)
@@ -602,6 +813,7 @@ func (ctrl *ProfileController) GetProviderProfile(ctx *gin.Context) {
 	for i, token := range tokens {
 		payload := types.ProviderOrderTokenPayload{
 			Symbol:                 token.Symbol,
+			Currency:               token.Edges.Currency.Code,
 			ConversionRateType:     token.ConversionRateType,
 			FixedConversionRate:    token.FixedConversionRate,
 			FloatingConversionRate: token.FloatingConversionRate,
@@ -634,26 +846,52 @@ func (ctrl *ProfileController) GetProviderProfile(ctx *gin.Context) {
 		return
 	}
 
+	operatingHours := make([]types.OperatingHours, len(provider.OperatingHours))
+	for i, h := range provider.OperatingHours {
+		operatingHours[i] = types.OperatingHours(h)
+	}
+
+	operatingExceptions := make([]types.OperatingHoursException, len(provider.OperatingHoursExceptions))
+	for i, e := range provider.OperatingHoursExceptions {
+		operatingExceptions[i] = types.OperatingHoursException(e)
+	}
+
+	// Get token rates excluded from the order queues for being stale
+	staleRates, err := ctrl.priorityQueueService.GetStaleRates(ctx, provider)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to retrieve profile", nil)
+		return
+	}
+
 	u.APIResponse(ctx, http.StatusOK, "success", "Profile retrieved successfully", &types.ProviderProfileResponse{
-		ID:                   provider.ID,
-		FirstName:            user.FirstName,
-		LastName:             user.LastName,
-		Email:                user.Email,
-		TradingName:          provider.TradingName,
-		Currency:             currency.Code,
-		HostIdentifier:       provider.HostIdentifier,
-		IsAvailable:          provider.IsAvailable,
-		Tokens:               tokensPayload,
-		APIKey:               *apiKey,
-		IsActive:             provider.IsActive,
-		Address:              provider.Address,
-		MobileNumber:         provider.MobileNumber,
-		DateOfBirth:          provider.DateOfBirth,
-		BusinessName:         provider.BusinessName,
-		VisibilityMode:       provider.VisibilityMode,
-		IdentityDocumentType: provider.IdentityDocumentType,
-		IdentityDocument:     provider.IdentityDocument,
-		BusinessDocument:     provider.BusinessDocument,
-		IsKybVerified:        provider.IsKybVerified,
+		ID:                        provider.ID,
+		FirstName:                 user.FirstName,
+		LastName:                  user.LastName,
+		Email:                     user.Email,
+		TradingName:               provider.TradingName,
+		Currencies:                currencyCodes,
+		HostIdentifier:            provider.HostIdentifier,
+		IsAvailable:               provider.IsAvailable,
+		Tokens:                    tokensPayload,
+		APIKey:                    *apiKey,
+		IsActive:                  provider.IsActive,
+		Address:                   provider.Address,
+		MobileNumber:              provider.MobileNumber,
+		DateOfBirth:               provider.DateOfBirth,
+		BusinessName:              provider.BusinessName,
+		VisibilityMode:            provider.VisibilityMode,
+		IdentityDocumentType:      provider.IdentityDocumentType,
+		IdentityDocument:          provider.IdentityDocument,
+		BusinessDocument:          provider.BusinessDocument,
+		IsKybVerified:             provider.IsKybVerified,
+		StaleRates:                staleRates,
+		OperatingTimezone:         provider.OperatingTimezone,
+		OperatingHours:            operatingHours,
+		OperatingHoursExceptions:  operatingExceptions,
+		SupportedInstitutions:     provider.SupportedInstitutions,
+		SupportedInstitutionTypes: provider.SupportedInstitutionTypes,
+		NodeProtocolVersion:       provider.NodeProtocolVersion,
+		NodeCapabilities:          provider.NodeCapabilities,
 	})
 }
@@ -18,7 +18,10 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	tokenDB "github.com/paycrest/aggregator/ent/token"
@@ -361,13 +364,14 @@ func TestProfile(t *testing.T) {
 			providerProfile, err := db.Client.ProviderProfile.
 				Query().
 				Where(providerprofile.HasUserWith(user.ID(testCtx.user.ID))).
-				WithCurrency().
+				WithCurrencies().
 				Only(context.Background())
 			assert.NoError(t, err)
 
 			assert.Contains(t, providerProfile.TradingName, payload.TradingName)
 			assert.Contains(t, providerProfile.HostIdentifier, payload.HostIdentifier)
-			assert.Contains(t, providerProfile.Edges.Currency.Code, payload.Currency)
+			assert.Equal(t, 1, len(providerProfile.Edges.Currencies))
+			assert.Contains(t, providerProfile.Edges.Currencies[0].Code, payload.Currency)
 			assert.Contains(t, providerProfile.BusinessDocument, payload.BusinessDocument)
 			assert.Contains(t, providerProfile.IdentityDocument, payload.IdentityDocument)
 			assert.True(t, providerProfile.IsActive)
@@ -576,6 +580,175 @@ func TestProfile(t *testing.T) {
 				assert.Equal(t, providerProfile.BusinessDocument, "https://example.com/business_doc.png")
 			})
 
+			t.Run("with institution allowlists", func(t *testing.T) {
+				payload := types.ProviderProfilePayload{
+					TradingName:               testCtx.providerProfile.TradingName,
+					HostIdentifier:            testCtx.providerProfile.HostIdentifier,
+					SupportedInstitutions:     []string{"ABNGNGLA"},
+					SupportedInstitutionTypes: []string{"mobile_money"},
+				}
+				res := profileUpdateRequest(payload)
+				assert.Equal(t, http.StatusOK, res.Code)
+
+				providerProfile, err := db.Client.ProviderProfile.
+					Query().
+					Where(providerprofile.HasUserWith(user.ID(testCtx.user.ID))).
+					Only(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"ABNGNGLA"}, providerProfile.SupportedInstitutions)
+				assert.Equal(t, []string{"mobile_money"}, providerProfile.SupportedInstitutionTypes)
+
+				// Institutions outside the provider's currencies are rejected
+				payload.SupportedInstitutions = []string{"UNKNOWNX"}
+				res = profileUpdateRequest(payload)
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+
+				payload.SupportedInstitutions = nil
+				payload.SupportedInstitutionTypes = []string{"crypto"}
+				res = profileUpdateRequest(payload)
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			})
+		})
+
+		t.Run("with multiple currencies", func(t *testing.T) {
+			ctx := context.Background()
+			accessToken, _ := token.GenerateAccessJWT(testCtx.user.ID.String(), "provider")
+			headers := map[string]string{
+				"Authorization": "Bearer " + accessToken,
+			}
+
+			kes, err := db.Client.FiatCurrency.
+				Query().
+				Where(fiatcurrency.CodeEQ("KES")).
+				Only(ctx)
+			assert.NoError(t, err)
+
+			ghs, err := db.Client.FiatCurrency.
+				Create().
+				SetCode("GHS").
+				SetShortName("Cedi").
+				SetDecimals(2).
+				SetSymbol("GH¢").
+				SetName("Ghana Cedi").
+				SetMarketRate(decimal.NewFromFloat(15)).
+				SetIsEnabled(true).
+				Save(ctx)
+			assert.NoError(t, err)
+
+			createBucket := func(currency *ent.FiatCurrency) {
+				_, err := db.Client.ProvisionBucket.
+					Create().
+					SetMinAmount(decimal.NewFromInt(1)).
+					SetMaxAmount(decimal.NewFromInt(100000)).
+					SetCurrency(currency).
+					Save(ctx)
+				assert.NoError(t, err)
+			}
+			createBucket(kes)
+			createBucket(ghs)
+
+			tokenPayload := func(currency string, rate int64) types.ProviderOrderTokenPayload {
+				payload := types.ProviderOrderTokenPayload{
+					Symbol:                 testCtx.token.Symbol,
+					Currency:               currency,
+					ConversionRateType:     providerordertoken.ConversionRateTypeFixed,
+					FixedConversionRate:    decimal.NewFromInt(rate),
+					FloatingConversionRate: decimal.Zero,
+					MaxOrderAmount:         decimal.NewFromInt(100),
+					MinOrderAmount:         decimal.NewFromInt(1),
+				}
+				payload.Addresses = append(payload.Addresses, struct {
+					Address string `json:"address"`
+					Network string `json:"network"`
+				}{
+					Address: "0xD4EB9067111F81b9bAabE06E2b8ebBaDADEd5DAf",
+					Network: testCtx.token.Edges.Network.Identifier,
+				})
+				return payload
+			}
+
+			updateProfile := func(payload types.ProviderProfilePayload) int {
+				payload.TradingName = testCtx.providerProfile.TradingName
+				payload.HostIdentifier = testCtx.providerProfile.HostIdentifier
+
+				res, err := test.PerformRequest(t, "PATCH", "/settings/provider", payload, headers, router)
+				assert.NoError(t, err)
+				return res.Code
+			}
+
+			// tokenRates returns the fixed rate of the provider's token in each currency
+			tokenRates := func() map[string]decimal.Decimal {
+				tokens, err := db.Client.ProviderOrderToken.
+					Query().
+					Where(providerordertoken.HasProviderWith(providerprofile.IDEQ(testCtx.providerProfile.ID))).
+					WithCurrency().
+					All(ctx)
+				assert.NoError(t, err)
+
+				rates := map[string]decimal.Decimal{}
+				for _, orderToken := range tokens {
+					rates[orderToken.Edges.Currency.Code] = orderToken.FixedConversionRate
+				}
+				return rates
+			}
+
+			// bucketCurrencies returns the currencies of the buckets the provider is a member of
+			bucketCurrencies := func() []string {
+				buckets, err := db.Client.ProvisionBucket.
+					Query().
+					Where(provisionbucket.HasProviderProfilesWith(providerprofile.IDEQ(testCtx.providerProfile.ID))).
+					WithCurrency().
+					Order(ent.Asc(provisionbucket.FieldID)).
+					All(ctx)
+				assert.NoError(t, err)
+
+				codes := []string{}
+				for _, bucket := range buckets {
+					codes = append(codes, bucket.Edges.Currency.Code)
+				}
+				return codes
+			}
+
+			// Each currency gets its own token config and buckets
+			code := updateProfile(types.ProviderProfilePayload{
+				Currencies: []string{"KES", "GHS"},
+				Tokens:     []types.ProviderOrderTokenPayload{tokenPayload("KES", 550), tokenPayload("GHS", 15)},
+			})
+			assert.Equal(t, http.StatusOK, code)
+
+			rates := tokenRates()
+			assert.Len(t, rates, 2)
+			assert.True(t, rates["KES"].Equal(decimal.NewFromInt(550)))
+			assert.True(t, rates["GHS"].Equal(decimal.NewFromInt(15)))
+			assert.Equal(t, []string{"KES", "GHS"}, bucketCurrencies())
+
+			// Updating the token of one currency leaves the other currency's token and buckets alone
+			code = updateProfile(types.ProviderProfilePayload{
+				Tokens: []types.ProviderOrderTokenPayload{tokenPayload("GHS", 16)},
+			})
+			assert.Equal(t, http.StatusOK, code)
+
+			rates = tokenRates()
+			assert.True(t, rates["KES"].Equal(decimal.NewFromInt(550)))
+			assert.True(t, rates["GHS"].Equal(decimal.NewFromInt(16)))
+			assert.Equal(t, []string{"KES", "GHS"}, bucketCurrencies())
+
+			// Tokens must be configured for one of the provider's currencies
+			code = updateProfile(types.ProviderProfilePayload{
+				Tokens: []types.ProviderOrderTokenPayload{tokenPayload("NGN", 950)},
+			})
+			assert.Equal(t, http.StatusBadRequest, code)
+
+			// Dropping a currency removes its token and bucket memberships
+			code = updateProfile(types.ProviderProfilePayload{
+				Currencies: []string{"GHS"},
+			})
+			assert.Equal(t, http.StatusOK, code)
+
+			rates = tokenRates()
+			assert.Len(t, rates, 1)
+			assert.True(t, rates["GHS"].Equal(decimal.NewFromInt(16)))
+			assert.Equal(t, []string{"GHS"}, bucketCurrencies())
 		})
 	})
 
@@ -0,0 +1,847 @@
+package accounts
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
+	userEnt "github.com/paycrest/aggregator/ent/user"
+	svc "github.com/paycrest/aggregator/services"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/crypto"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// TeamController is the controller type for the team management endpoints
+type TeamController struct {
+	emailService *svc.EmailService
+}
+
+// NewTeamController creates a new instance of TeamController with injected services
+func NewTeamController() *TeamController {
+	return &TeamController{
+		emailService: svc.NewEmailService(svc.SENDGRID_MAIL_PROVIDER),
+	}
+}
+
+// team identifies the sender or provider profile a team belongs to
+type team struct {
+	scope    string
+	role     string
+	name     string
+	owner    *ent.User
+	sender   *ent.SenderProfile
+	provider *ent.ProviderProfile
+}
+
+// memberPredicate returns the predicate matching the members of the team
+func (t *team) memberPredicate() predicate.TeamMember {
+	if t.sender != nil {
+		return teammember.HasSenderProfileWith(senderprofile.IDEQ(t.sender.ID))
+	}
+	return teammember.HasProviderProfileWith(providerprofile.IDEQ(t.provider.ID))
+}
+
+// invitationPredicate returns the predicate matching the invitations of the team
+func (t *team) invitationPredicate() predicate.TeamInvitation {
+	if t.sender != nil {
+		return teaminvitation.HasSenderProfileWith(senderprofile.IDEQ(t.sender.ID))
+	}
+	return teaminvitation.HasProviderProfileWith(providerprofile.IDEQ(t.provider.ID))
+}
+
+// auditLogPredicate returns the predicate matching the audit logs of the team
+func (t *team) auditLogPredicate() predicate.TeamAuditLog {
+	if t.sender != nil {
+		return teamauditlog.HasSenderProfileWith(senderprofile.IDEQ(t.sender.ID))
+	}
+	return teamauditlog.HasProviderProfileWith(providerprofile.IDEQ(t.provider.ID))
+}
+
+// getTeam resolves the team of the sender or provider profile in the request context
+func getTeam(ctx *gin.Context) (*team, error) {
+	t := &team{scope: "provider"}
+	if strings.Contains(ctx.FullPath(), "/settings/sender/") {
+		t.scope = "sender"
+	}
+	t.role = ctx.GetString(t.scope + "_role")
+
+	var err error
+	if t.scope == "sender" {
+		senderCtx, _ := ctx.Get("sender")
+		t.sender, _ = senderCtx.(*ent.SenderProfile)
+		if t.sender == nil {
+			return nil, fmt.Errorf("sender profile not found")
+		}
+		t.owner, err = db.Client.SenderProfile.QueryUser(t.sender).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t.name = fmt.Sprintf("%s %s's sender account", t.owner.FirstName, t.owner.LastName)
+	} else {
+		providerCtx, _ := ctx.Get("provider")
+		t.provider, _ = providerCtx.(*ent.ProviderProfile)
+		if t.provider == nil {
+			return nil, fmt.Errorf("provider profile not found")
+		}
+		t.owner, err = db.Client.ProviderProfile.QueryUser(t.provider).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t.name = t.provider.TradingName
+	}
+
+	return t, nil
+}
+
+// getActor fetches the authenticated user making the request
+func getActor(ctx *gin.Context) (*ent.User, error) {
+	userID, err := uuid.Parse(ctx.GetString("user_id"))
+	if err != nil {
+		return nil, err
+	}
+	return db.Client.User.Get(ctx, userID)
+}
+
+// logTeamAction records a membership change in the team audit log
+func logTeamAction(ctx *gin.Context, tx *ent.Tx, t *team, action teamauditlog.Action, actorEmail, targetEmail string, metadata map[string]interface{}) error {
+	auditLogCreate := tx.TeamAuditLog.
+		Create().
+		SetAction(action).
+		SetActorEmail(actorEmail).
+		SetTargetEmail(targetEmail).
+		SetMetadata(metadata)
+
+	if t.sender != nil {
+		auditLogCreate.SetSenderProfile(t.sender)
+	} else {
+		auditLogCreate.SetProviderProfile(t.provider)
+	}
+
+	return auditLogCreate.Exec(ctx)
+}
+
+// GetTeam controller fetches the owner, members and pending invitations of a team
+func (ctrl *TeamController) GetTeam(ctx *gin.Context) {
+	t, err := getTeam(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	members, err := db.Client.TeamMember.
+		Query().
+		Where(t.memberPredicate()).
+		WithUser().
+		Order(ent.Asc(teammember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch team", nil)
+		return
+	}
+
+	invitations, err := db.Client.TeamInvitation.
+		Query().
+		Where(
+			t.invitationPredicate(),
+			teaminvitation.StatusEQ(teaminvitation.StatusPending),
+			teaminvitation.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(teaminvitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch team", nil)
+		return
+	}
+
+	response := types.TeamResponse{
+		Owner: types.TeamMemberResponse{
+			ID:        t.owner.ID,
+			FirstName: t.owner.FirstName,
+			LastName:  t.owner.LastName,
+			Email:     t.owner.Email,
+			Role:      "owner",
+			CreatedAt: t.owner.CreatedAt,
+		},
+		Members:     []types.TeamMemberResponse{},
+		Invitations: []types.TeamInvitationResponse{},
+	}
+
+	for _, member := range members {
+		response.Members = append(response.Members, types.TeamMemberResponse{
+			ID:        member.ID,
+			FirstName: member.Edges.User.FirstName,
+			LastName:  member.Edges.User.LastName,
+			Email:     member.Edges.User.Email,
+			Role:      string(member.Role),
+			CreatedAt: member.CreatedAt,
+		})
+	}
+
+	for _, invitation := range invitations {
+		response.Invitations = append(response.Invitations, types.TeamInvitationResponse{
+			ID:        invitation.ID,
+			Email:     invitation.Email,
+			Role:      string(invitation.Role),
+			Status:    string(invitation.Status),
+			ExpiresAt: invitation.ExpiresAt,
+			CreatedAt: invitation.CreatedAt,
+		})
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Team retrieved successfully", response)
+}
+
+// InviteTeamMember controller invites a user to join a team by email.
+// Only the owner can invite admins.
+func (ctrl *TeamController) InviteTeamMember(ctx *gin.Context) {
+	var payload types.TeamInvitationPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+	email := strings.ToLower(payload.Email)
+
+	t, err := getTeam(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	if payload.Role == teammember.RoleAdmin.String() && t.role != "owner" {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Only the owner can invite admins", nil)
+		return
+	}
+
+	actor, err := getActor(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid credential", nil)
+		return
+	}
+
+	// Check that the email isn't already part of the team
+	isMember, err := db.Client.TeamMember.
+		Query().
+		Where(
+			t.memberPredicate(),
+			teammember.HasUserWith(userEnt.EmailEQ(email)),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to send invitation", nil)
+		return
+	}
+
+	if isMember || t.owner.Email == email {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "User is already a member of the team", nil)
+		return
+	}
+
+	isInvited, err := db.Client.TeamInvitation.
+		Query().
+		Where(
+			t.invitationPredicate(),
+			teaminvitation.EmailEQ(email),
+			teaminvitation.StatusEQ(teaminvitation.StatusPending),
+			teaminvitation.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to send invitation", nil)
+		return
+	}
+
+	if isInvited {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "User has a pending invitation to the team", nil)
+		return
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to send invitation", nil)
+		return
+	}
+
+	invitationCreate := tx.TeamInvitation.
+		Create().
+		SetEmail(email).
+		SetRole(teaminvitation.Role(payload.Role)).
+		SetExpiresAt(time.Now().Add(authConf.TeamInvitationLifespan)).
+		SetInvitedBy(actor)
+
+	if t.sender != nil {
+		invitationCreate.SetSenderProfile(t.sender)
+	} else {
+		invitationCreate.SetProviderProfile(t.provider)
+	}
+
+	invitation, err := invitationCreate.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to send invitation", nil)
+		return
+	}
+
+	err = logTeamAction(ctx, tx, t, teamauditlog.ActionMemberInvited, actor.Email, email, map[string]interface{}{
+		"role": payload.Role,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to send invitation", nil)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to send invitation", nil)
+		return
+	}
+
+	inviterName := fmt.Sprintf("%s %s", actor.FirstName, actor.LastName)
+	if _, err := ctrl.emailService.SendTeamInvitationEmail(ctx, invitation.Token, email, inviterName, t.name, payload.Role); err != nil {
+		logger.Errorf("error: %v", err)
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Invitation sent successfully", &types.TeamInvitationResponse{
+		ID:        invitation.ID,
+		Email:     invitation.Email,
+		Role:      string(invitation.Role),
+		Status:    string(invitation.Status),
+		ExpiresAt: invitation.ExpiresAt,
+		CreatedAt: invitation.CreatedAt,
+	})
+}
+
+// RevokeTeamInvitation controller revokes a pending team invitation.
+// Only the owner can revoke invitations for admins.
+func (ctrl *TeamController) RevokeTeamInvitation(ctx *gin.Context) {
+	invitationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid invitation ID", nil)
+		return
+	}
+
+	t, err := getTeam(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	actor, err := getActor(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid credential", nil)
+		return
+	}
+
+	invitation, err := db.Client.TeamInvitation.
+		Query().
+		Where(
+			teaminvitation.IDEQ(invitationID),
+			t.invitationPredicate(),
+			teaminvitation.StatusEQ(teaminvitation.StatusPending),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Invitation not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke invitation", nil)
+		}
+		return
+	}
+
+	if invitation.Role == teaminvitation.RoleAdmin && t.role != "owner" {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Only the owner can revoke invitations for admins", nil)
+		return
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke invitation", nil)
+		return
+	}
+
+	_, err = tx.TeamInvitation.
+		UpdateOne(invitation).
+		SetStatus(teaminvitation.StatusRevoked).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke invitation", nil)
+		return
+	}
+
+	err = logTeamAction(ctx, tx, t, teamauditlog.ActionInvitationRevoked, actor.Email, invitation.Email, map[string]interface{}{
+		"role": string(invitation.Role),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke invitation", nil)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to revoke invitation", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Invitation revoked successfully", nil)
+}
+
+// UpdateTeamMember controller changes the role of a team member.
+// Only the owner can change the role of an admin or promote a member to admin.
+func (ctrl *TeamController) UpdateTeamMember(ctx *gin.Context) {
+	var payload types.UpdateTeamMemberPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	memberID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid member ID", nil)
+		return
+	}
+
+	t, err := getTeam(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	actor, err := getActor(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid credential", nil)
+		return
+	}
+
+	member, err := db.Client.TeamMember.
+		Query().
+		Where(
+			teammember.IDEQ(memberID),
+			t.memberPredicate(),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Team member not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update team member", nil)
+		}
+		return
+	}
+
+	if member.Edges.User.ID == actor.ID {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "You cannot change your own role", nil)
+		return
+	}
+
+	if (member.Role == teammember.RoleAdmin || payload.Role == teammember.RoleAdmin.String()) && t.role != "owner" {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Only the owner can manage admins", nil)
+		return
+	}
+
+	if string(member.Role) == payload.Role {
+		u.APIResponse(ctx, http.StatusOK, "success", "Team member updated successfully", nil)
+		return
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update team member", nil)
+		return
+	}
+
+	_, err = tx.TeamMember.
+		UpdateOne(member).
+		SetRole(teammember.Role(payload.Role)).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update team member", nil)
+		return
+	}
+
+	err = logTeamAction(ctx, tx, t, teamauditlog.ActionRoleChanged, actor.Email, member.Edges.User.Email, map[string]interface{}{
+		"from": string(member.Role),
+		"to":   payload.Role,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update team member", nil)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update team member", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Team member updated successfully", nil)
+}
+
+// RemoveTeamMember controller removes a member from a team.
+// Members can remove themselves, and only the owner can remove admins.
+func (ctrl *TeamController) RemoveTeamMember(ctx *gin.Context) {
+	memberID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid member ID", nil)
+		return
+	}
+
+	t, err := getTeam(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	actor, err := getActor(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid credential", nil)
+		return
+	}
+
+	member, err := db.Client.TeamMember.
+		Query().
+		Where(
+			teammember.IDEQ(memberID),
+			t.memberPredicate(),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Team member not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		}
+		return
+	}
+
+	isSelf := member.Edges.User.ID == actor.ID
+	if !isSelf && t.role != "owner" && t.role != teammember.RoleAdmin.String() {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "You do not have permission to perform this action", nil)
+		return
+	}
+
+	if !isSelf && member.Role == teammember.RoleAdmin && t.role != "owner" {
+		u.APIResponse(ctx, http.StatusForbidden, "error", "Only the owner can manage admins", nil)
+		return
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		return
+	}
+
+	if err := tx.TeamMember.DeleteOne(member).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		return
+	}
+
+	// Drop the scope from the user's account since they no longer have access to a profile of this type
+	scopes := strings.Split(member.Edges.User.Scope, " ")
+	_, err = tx.User.
+		UpdateOne(member.Edges.User).
+		SetScope(strings.Join(u.Difference(scopes, []string{t.scope}), " ")).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		return
+	}
+
+	err = logTeamAction(ctx, tx, t, teamauditlog.ActionMemberRemoved, actor.Email, member.Edges.User.Email, map[string]interface{}{
+		"role": string(member.Role),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to remove team member", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Team member removed successfully", nil)
+}
+
+// GetTeamAuditLogs controller fetches the membership changes of a team
+func (ctrl *TeamController) GetTeamAuditLogs(ctx *gin.Context) {
+	page, offset, pageSize := u.Paginate(ctx)
+
+	t, err := getTeam(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+
+	auditLogQuery := db.Client.TeamAuditLog.
+		Query().
+		Where(t.auditLogPredicate())
+
+	count, err := auditLogQuery.Count(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch audit logs", nil)
+		return
+	}
+
+	auditLogs, err := auditLogQuery.
+		Limit(pageSize).
+		Offset(offset).
+		Order(ent.Desc(teamauditlog.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch audit logs", nil)
+		return
+	}
+
+	var logs []types.TeamAuditLogResponse
+	for _, auditLog := range auditLogs {
+		logs = append(logs, types.TeamAuditLogResponse{
+			ID:          auditLog.ID,
+			Action:      string(auditLog.Action),
+			ActorEmail:  auditLog.ActorEmail,
+			TargetEmail: auditLog.TargetEmail,
+			Metadata:    auditLog.Metadata,
+			CreatedAt:   auditLog.CreatedAt,
+		})
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Audit logs retrieved successfully", &types.TeamAuditLogList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Logs:         logs,
+	})
+}
+
+// AcceptInvitation controller accepts a team invitation.
+// It creates an account for the invited email, or adds the team's scope to an existing account after checking its password.
+func (ctrl *TeamController) AcceptInvitation(ctx *gin.Context) {
+	var payload types.AcceptTeamInvitationPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	invitation, err := db.Client.TeamInvitation.
+		Query().
+		Where(
+			teaminvitation.TokenEQ(payload.Token),
+			teaminvitation.StatusEQ(teaminvitation.StatusPending),
+			teaminvitation.ExpiresAtGT(time.Now()),
+		).
+		WithSenderProfile().
+		WithProviderProfile().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid or expired invitation", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		}
+		return
+	}
+
+	t := &team{
+		scope:    "provider",
+		sender:   invitation.Edges.SenderProfile,
+		provider: invitation.Edges.ProviderProfile,
+	}
+	if t.sender != nil {
+		t.scope = "sender"
+	}
+
+	user, err := db.Client.User.
+		Query().
+		Where(userEnt.EmailEQ(invitation.Email)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	if user != nil {
+		if !crypto.CheckPasswordHash(payload.Password, user.Password) {
+			u.APIResponse(ctx, http.StatusUnauthorized, "error", "Email and password do not match any user", nil)
+			return
+		}
+
+		// A user can only have access to one profile of each type
+		var hasProfile bool
+		if t.scope == "sender" {
+			hasProfile, err = db.Client.SenderProfile.
+				Query().
+				Where(senderprofile.Or(
+					senderprofile.HasUserWith(userEnt.IDEQ(user.ID)),
+					senderprofile.HasTeamMembersWith(teammember.HasUserWith(userEnt.IDEQ(user.ID))),
+				)).
+				Exist(ctx)
+		} else {
+			hasProfile, err = db.Client.ProviderProfile.
+				Query().
+				Where(providerprofile.Or(
+					providerprofile.HasUserWith(userEnt.IDEQ(user.ID)),
+					providerprofile.HasTeamMembersWith(teammember.HasUserWith(userEnt.IDEQ(user.ID))),
+				)).
+				Exist(ctx)
+		}
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+			return
+		}
+
+		if hasProfile {
+			u.APIResponse(ctx, http.StatusBadRequest, "error",
+				fmt.Sprintf("User already has access to a %s account", t.scope), nil)
+			return
+		}
+	} else if payload.FirstName == "" || payload.LastName == "" {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", []types.ErrorData{{
+			Field:   "FirstName",
+			Message: "First and last name are required for new accounts",
+		}})
+		return
+	}
+
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	if user == nil {
+		// The invitation token proves ownership of the email address
+		user, err = tx.User.
+			Create().
+			SetFirstName(payload.FirstName).
+			SetLastName(payload.LastName).
+			SetEmail(invitation.Email).
+			SetPassword(payload.Password).
+			SetScope(t.scope).
+			SetIsEmailVerified(true).
+			SetHasEarlyAccess(true).
+			Save(ctx)
+	} else if scopes := strings.Split(user.Scope, " "); !u.ContainsString(scopes, t.scope) {
+		user, err = tx.User.
+			UpdateOne(user).
+			SetScope(strings.TrimSpace(user.Scope + " " + t.scope)).
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	memberCreate := tx.TeamMember.
+		Create().
+		SetUser(user).
+		SetRole(teammember.Role(invitation.Role))
+
+	if t.sender != nil {
+		memberCreate.SetSenderProfile(t.sender)
+	} else {
+		memberCreate.SetProviderProfile(t.provider)
+	}
+
+	if err := memberCreate.Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	_, err = tx.TeamInvitation.
+		UpdateOne(invitation).
+		SetStatus(teaminvitation.StatusAccepted).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	err = logTeamAction(ctx, tx, t, teamauditlog.ActionInvitationAccepted, user.Email, user.Email, map[string]interface{}{
+		"role": string(invitation.Role),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept invitation", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Invitation accepted successfully", nil)
+}
@@ -0,0 +1,224 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jarcoal/httpmock"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
+	"github.com/paycrest/aggregator/ent/user"
+	"github.com/paycrest/aggregator/routers/middleware"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/paycrest/aggregator/utils/token"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeam(t *testing.T) {
+	// Set up test database client
+	client := enttest.Open(t, "sqlite3", "file:team?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	// Mock the mail provider
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterNoResponder(httpmock.ResponderFromResponse(&http.Response{
+		StatusCode: http.StatusAccepted,
+		Header:     http.Header{"X-Message-Id": []string{"test-message-id"}},
+		Body:       httpmock.NewRespBodyFromString(""),
+	}))
+
+	// Setup test data
+	owner, err := test.CreateTestUser(map[string]interface{}{
+		"scope": "sender",
+		"email": "teamowner@test.com",
+	})
+	assert.NoError(t, err)
+
+	_, err = db.Client.SenderProfile.
+		Create().
+		SetUser(owner).
+		Save(context.Background())
+	assert.NoError(t, err)
+
+	// Set up test routers
+	router := gin.New()
+	ctrl := NewTeamController()
+
+	router.POST("auth/accept-invitation", ctrl.AcceptInvitation)
+	router.PATCH(
+		"settings/sender",
+		middleware.JWTMiddleware,
+		middleware.OnlySenderMiddleware,
+		middleware.OnlyRoleMiddleware("sender", "owner", "admin", "developer"),
+		func(ctx *gin.Context) {
+			u.APIResponse(ctx, http.StatusOK, "success", "Profile updated successfully", nil)
+		},
+	)
+
+	team := router.Group("settings/sender/team")
+	team.Use(middleware.JWTMiddleware)
+	team.Use(middleware.OnlySenderMiddleware)
+	team.GET("", ctrl.GetTeam)
+	team.GET("audit-logs", middleware.OnlyRoleMiddleware("sender", "owner", "admin"), ctrl.GetTeamAuditLogs)
+	team.POST("invitations", middleware.OnlyRoleMiddleware("sender", "owner", "admin"), ctrl.InviteTeamMember)
+	team.PATCH("members/:id", middleware.OnlyRoleMiddleware("sender", "owner", "admin"), ctrl.UpdateTeamMember)
+	team.DELETE("members/:id", ctrl.RemoveTeamMember)
+
+	ownerToken, _ := token.GenerateAccessJWT(owner.ID.String(), "sender")
+	ownerHeaders := map[string]string{
+		"Authorization": "Bearer " + ownerToken,
+	}
+
+	var member *ent.TeamMember
+	var memberHeaders map[string]string
+
+	t.Run("InviteTeamMember", func(t *testing.T) {
+		payload := types.TeamInvitationPayload{
+			Email: "Developer@test.com",
+			Role:  "developer",
+		}
+
+		res, err := test.PerformRequest(t, "POST", "/settings/sender/team/invitations", payload, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, res.Code)
+
+		// Inviting the same email again is rejected while the invitation is pending
+		res, err = test.PerformRequest(t, "POST", "/settings/sender/team/invitations", payload, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("AcceptInvitation", func(t *testing.T) {
+		invitation, err := db.Client.TeamInvitation.
+			Query().
+			Where(teaminvitation.EmailEQ("developer@test.com")).
+			Only(context.Background())
+		assert.NoError(t, err)
+
+		payload := types.AcceptTeamInvitationPayload{
+			Token:     invitation.Token,
+			FirstName: "Jane",
+			LastName:  "Doe",
+			Password:  "password",
+		}
+
+		res, err := test.PerformRequest(t, "POST", "/auth/accept-invitation", payload, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		member, err = db.Client.TeamMember.
+			Query().
+			Where(teammember.HasUserWith(user.EmailEQ("developer@test.com"))).
+			WithUser().
+			Only(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, teammember.RoleDeveloper, member.Role)
+		assert.Equal(t, "sender", member.Edges.User.Scope)
+
+		memberToken, _ := token.GenerateAccessJWT(member.Edges.User.ID.String(), "sender")
+		memberHeaders = map[string]string{
+			"Authorization": "Bearer " + memberToken,
+		}
+
+		// The invitation can only be used once
+		res, err = test.PerformRequest(t, "POST", "/auth/accept-invitation", payload, nil, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("GetTeam", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "GET", "/settings/sender/team", nil, memberHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.TeamResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "owner", response.Data.Owner.Role)
+		assert.Equal(t, owner.Email, response.Data.Owner.Email)
+		assert.Len(t, response.Data.Members, 1)
+		assert.Equal(t, "developer", response.Data.Members[0].Role)
+		assert.Len(t, response.Data.Invitations, 0)
+	})
+
+	t.Run("role enforcement", func(t *testing.T) {
+		// Developers can update settings but cannot manage the team
+		res, err := test.PerformRequest(t, "PATCH", "/settings/sender", nil, memberHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		res, err = test.PerformRequest(t, "POST", "/settings/sender/team/invitations", types.TeamInvitationPayload{
+			Email: "finance@test.com",
+			Role:  "finance",
+		}, memberHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, res.Code)
+
+		// Finance members are read-only
+		res, err = test.PerformRequest(t, "PATCH", fmt.Sprintf("/settings/sender/team/members/%s", member.ID), types.UpdateTeamMemberPayload{
+			Role: "finance",
+		}, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		res, err = test.PerformRequest(t, "PATCH", "/settings/sender", nil, memberHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, res.Code)
+	})
+
+	t.Run("RemoveTeamMember", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "DELETE", fmt.Sprintf("/settings/sender/team/members/%s", member.ID), nil, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		// The removed member no longer has access to the sender profile
+		removed, err := db.Client.User.Get(context.Background(), member.Edges.User.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "", removed.Scope)
+
+		// Tokens issued before the removal no longer grant access to the sender profile
+		res, err = test.PerformRequest(t, "PATCH", "/settings/sender", nil, memberHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("GetTeamAuditLogs", func(t *testing.T) {
+		res, err := test.PerformRequest(t, "GET", "/settings/sender/team/audit-logs", nil, ownerHeaders, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.TeamAuditLogList `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, response.Data.TotalRecords)
+
+		actions := []string{}
+		for _, log := range response.Data.Logs {
+			actions = append(actions, log.Action)
+		}
+		assert.ElementsMatch(t, []string{
+			teamauditlog.ActionMemberInvited.String(),
+			teamauditlog.ActionInvitationAccepted.String(),
+			teamauditlog.ActionRoleChanged.String(),
+			teamauditlog.ActionMemberRemoved.String(),
+		}, actions)
+	})
+}
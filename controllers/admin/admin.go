@@ -0,0 +1,869 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	svc "github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	u "github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// AdminController is a controller type for admin endpoints
+type AdminController struct {
+	providerHealthService  *svc.ProviderHealthService
+	providerSLAService     *svc.ProviderSLAService
+	disputeService         *svc.DisputeService
+	priorityQueueService   *svc.PriorityQueueService
+	bucketProposalService  *svc.BucketProposalService
+	deadLetterService      *svc.DeadLetterService
+	orderAssignmentService *svc.OrderAssignmentService
+	circuitBreakerService  *svc.CircuitBreakerService
+}
+
+// NewAdminController creates a new instance of AdminController with injected services
+func NewAdminController() *AdminController {
+	return &AdminController{
+		providerHealthService:  svc.NewProviderHealthService(),
+		providerSLAService:     svc.NewProviderSLAService(),
+		disputeService:         svc.NewDisputeService(),
+		priorityQueueService:   svc.NewPriorityQueueService(),
+		bucketProposalService:  svc.NewBucketProposalService(),
+		deadLetterService:      svc.NewDeadLetterService(),
+		orderAssignmentService: svc.NewOrderAssignmentService(),
+		circuitBreakerService:  svc.NewCircuitBreakerService(),
+	}
+}
+
+// GetProviderHealthChecks controller fetches the health of a provider's node and its recent health checks
+func (ctrl *AdminController) GetProviderHealthChecks(ctx *gin.Context) {
+	provider, err := storage.Client.ProviderProfile.Get(ctx, ctx.Param("id"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Provider not found", nil)
+			return
+		}
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch node health", nil)
+		return
+	}
+
+	page, offset, pageSize := u.Paginate(ctx)
+
+	health, err := ctrl.providerHealthService.GetHealthHistory(ctx, provider, page, offset, pageSize)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch node health", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Node health fetched successfully", health)
+}
+
+// GetProviderSLAReport controller fetches a provider's SLA metrics and active penalties
+func (ctrl *AdminController) GetProviderSLAReport(ctx *gin.Context) {
+	provider, err := storage.Client.ProviderProfile.Get(ctx, ctx.Param("id"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Provider not found", nil)
+			return
+		}
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch SLA report", nil)
+		return
+	}
+
+	from, to, err := u.TimeRange(ctx, 7*24*time.Hour)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid date range", err.Error())
+		return
+	}
+
+	report, err := ctrl.providerSLAService.GetReport(ctx, provider, from, to)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch SLA report", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "SLA report fetched successfully", report)
+}
+
+// GetDisputes controller fetches disputes, optionally filtered by status and provider
+func (ctrl *AdminController) GetDisputes(ctx *gin.Context) {
+	page, offset, pageSize := u.Paginate(ctx)
+
+	predicates := []predicate.Dispute{}
+	if status := ctx.Query("status"); status != "" {
+		if err := dispute.StatusValidator(dispute.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute status", nil)
+			return
+		}
+		predicates = append(predicates, dispute.StatusEQ(dispute.Status(status)))
+	}
+	if providerID := ctx.Query("providerId"); providerID != "" {
+		predicates = append(predicates, dispute.HasProviderWith(providerprofile.IDEQ(providerID)))
+	}
+
+	disputes, err := ctrl.disputeService.GetDisputes(ctx, page, offset, pageSize, predicates...)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Disputes fetched successfully", disputes)
+}
+
+// GetDispute controller fetches a dispute with its evidence
+func (ctrl *AdminController) GetDispute(ctx *gin.Context) {
+	d, ok := ctrl.getDispute(ctx)
+	if !ok {
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dispute fetched successfully", svc.DisputeResponse(d))
+}
+
+// ResolveDispute controller resolves a dispute, adjusting the provider's trust score and recording any compensation owed
+func (ctrl *AdminController) ResolveDispute(ctx *gin.Context) {
+	var payload types.ResolveDisputePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if payload.CompensationAmount.IsNegative() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", types.ErrorData{
+			Field:   "CompensationAmount",
+			Message: "Compensation amount cannot be negative",
+		})
+		return
+	}
+
+	d, ok := ctrl.getDispute(ctx)
+	if !ok {
+		return
+	}
+
+	if !svc.CanTransitionDispute(d.Status, dispute.StatusResolved) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Dispute is closed", nil)
+		return
+	}
+
+	if err := ctrl.disputeService.ResolveDispute(ctx, d, payload); err != nil {
+		if errors.Is(err, svc.ErrDisputeNotActive) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Dispute is closed", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to resolve dispute", nil)
+		}
+		return
+	}
+
+	d, err := ctrl.disputeService.GetDispute(ctx, dispute.IDEQ(d.ID))
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to resolve dispute", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dispute resolved successfully", svc.DisputeResponse(d))
+}
+
+// MarkDisputeCompensationPaid controller records that the compensation of a resolved dispute was paid out
+func (ctrl *AdminController) MarkDisputeCompensationPaid(ctx *gin.Context) {
+	d, ok := ctrl.getDispute(ctx)
+	if !ok {
+		return
+	}
+
+	if d.CompensationStatus != dispute.CompensationStatusPending {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Dispute has no pending compensation", nil)
+		return
+	}
+
+	if err := ctrl.disputeService.MarkCompensationPaid(ctx, d); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update compensation", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Compensation marked as paid", nil)
+}
+
+// UpdateFulfillmentValidation controller sets whether fulfillments in a currency must be confirmed through the PSP before settlement
+func (ctrl *AdminController) UpdateFulfillmentValidation(ctx *gin.Context) {
+	var payload types.UpdateFulfillmentValidationPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code")))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Currency not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch currency", nil)
+		}
+		return
+	}
+
+	_, err = currency.Update().
+		SetFulfillmentValidation(payload.Policy).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update currency", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Fulfillment validation policy updated successfully", &types.UpdateFulfillmentValidationPayload{
+		Policy: payload.Policy,
+	})
+}
+
+// UpdateOrderRequestMode controller sets whether order requests in a currency are sent to one provider at a time
+// or broadcast to several providers, the first of which to accept gets the order
+func (ctrl *AdminController) UpdateOrderRequestMode(ctx *gin.Context) {
+	var payload types.UpdateOrderRequestModePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code")))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Currency not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch currency", nil)
+		}
+		return
+	}
+
+	_, err = currency.Update().
+		SetOrderRequestMode(payload.Mode).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update currency", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Order request mode updated successfully", &types.UpdateOrderRequestModePayload{
+		Mode: payload.Mode,
+	})
+}
+
+// UpdateCurrencyMatchingStrategy controller sets the matching strategy of a currency's buckets.
+// An empty strategy clears it so the configured default applies.
+func (ctrl *AdminController) UpdateCurrencyMatchingStrategy(ctx *gin.Context) {
+	var payload types.UpdateMatchingStrategyPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code")))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Currency not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch currency", nil)
+		}
+		return
+	}
+
+	update := currency.Update()
+	if payload.Strategy == "" {
+		update.ClearMatchingStrategy()
+	} else {
+		update.SetMatchingStrategy(fiatcurrency.MatchingStrategy(payload.Strategy))
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update currency", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Matching strategy updated successfully", &payload)
+}
+
+// UpdateBucketMatchingStrategy controller sets the matching strategy of a bucket.
+// An empty strategy clears it so the strategy of the bucket's currency applies.
+func (ctrl *AdminController) UpdateBucketMatchingStrategy(ctx *gin.Context) {
+	var payload types.UpdateMatchingStrategyPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	bucketID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid bucket ID", nil)
+		return
+	}
+
+	update := storage.Client.ProvisionBucket.UpdateOneID(bucketID)
+	if payload.Strategy == "" {
+		update.ClearMatchingStrategy()
+	} else {
+		update.SetMatchingStrategy(provisionbucket.MatchingStrategy(payload.Strategy))
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Bucket not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update bucket", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Matching strategy updated successfully", &payload)
+}
+
+// GetMatchingMetrics controller fetches the metrics of each matching strategy
+func (ctrl *AdminController) GetMatchingMetrics(ctx *gin.Context) {
+	metrics, err := ctrl.priorityQueueService.GetMatchingMetrics(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch matching metrics", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Matching metrics fetched successfully", metrics)
+}
+
+// GetBucketQueues controller lists the queues of all buckets with their decoded entries
+func (ctrl *AdminController) GetBucketQueues(ctx *gin.Context) {
+	queues, err := ctrl.priorityQueueService.GetBucketQueues(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch bucket queues", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket queues fetched successfully", queues)
+}
+
+// GetBucketQueue controller fetches the queue of a bucket with its decoded entries
+func (ctrl *AdminController) GetBucketQueue(ctx *gin.Context) {
+	bucketID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid bucket ID", nil)
+		return
+	}
+
+	queue, err := ctrl.priorityQueueService.GetBucketQueue(ctx, bucketID)
+	if err != nil {
+		ctrl.bucketQueueError(ctx, err, "Failed to fetch bucket queue")
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket queue fetched successfully", queue)
+}
+
+// RebuildBucketQueue controller rebuilds the queue of a bucket right away
+func (ctrl *AdminController) RebuildBucketQueue(ctx *gin.Context) {
+	bucketID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid bucket ID", nil)
+		return
+	}
+
+	queue, err := ctrl.priorityQueueService.RebuildBucketQueue(ctx, bucketID)
+	if err != nil {
+		ctrl.bucketQueueError(ctx, err, "Failed to rebuild bucket queue")
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket queue rebuilt successfully", queue)
+}
+
+// UpdateBucketQueueOverride controller temporarily pins or removes a provider in a bucket queue
+func (ctrl *AdminController) UpdateBucketQueueOverride(ctx *gin.Context) {
+	var payload types.BucketQueueOverridePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	bucketID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid bucket ID", nil)
+		return
+	}
+
+	queue, err := ctrl.priorityQueueService.SetBucketQueueOverride(
+		ctx, bucketID, ctx.Param("provider_id"), payload.Action, time.Duration(payload.DurationMinutes)*time.Minute,
+	)
+	if err != nil {
+		ctrl.bucketQueueError(ctx, err, "Failed to update bucket queue")
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket queue updated successfully", queue)
+}
+
+// DeleteBucketQueueOverride controller lifts a pin or removal of a provider in a bucket queue
+func (ctrl *AdminController) DeleteBucketQueueOverride(ctx *gin.Context) {
+	bucketID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid bucket ID", nil)
+		return
+	}
+
+	queue, err := ctrl.priorityQueueService.ClearBucketQueueOverride(ctx, bucketID, ctx.Param("provider_id"))
+	if err != nil {
+		ctrl.bucketQueueError(ctx, err, "Failed to update bucket queue")
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket queue updated successfully", queue)
+}
+
+// bucketQueueError responds with the status matching an error from the bucket queue service
+func (ctrl *AdminController) bucketQueueError(ctx *gin.Context, err error, message string) {
+	if ent.IsNotFound(err) {
+		u.APIResponse(ctx, http.StatusNotFound, "error", "Bucket not found", nil)
+	} else if errors.Is(err, svc.ErrProviderNotInBucket) {
+		u.APIResponse(ctx, http.StatusNotFound, "error", "Provider not found in bucket", nil)
+	} else {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", message, nil)
+	}
+}
+
+// GetBucketProposals controller lists bucket proposals, optionally filtered by currency and status
+func (ctrl *AdminController) GetBucketProposals(ctx *gin.Context) {
+	status := ctx.Query("status")
+	if status != "" && bucketproposal.StatusValidator(bucketproposal.Status(status)) != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+		return
+	}
+
+	proposals, err := ctrl.bucketProposalService.GetProposals(ctx, strings.ToUpper(ctx.Query("currency")), status)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch bucket proposals", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket proposals fetched successfully", proposals)
+}
+
+// CreateBucketProposal controller proposes new buckets for a currency from its recent order amounts
+func (ctrl *AdminController) CreateBucketProposal(ctx *gin.Context) {
+	var payload types.NewBucketProposalPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	proposal, err := ctrl.bucketProposalService.ProposeBucketsForCurrency(ctx, strings.ToUpper(payload.Currency))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Currency not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to propose buckets", nil)
+		}
+		return
+	}
+
+	if proposal == nil {
+		u.APIResponse(ctx, http.StatusOK, "success", "No changes to the buckets proposed", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Buckets proposed successfully", proposal)
+}
+
+// ApplyBucketProposal controller replaces a currency's buckets with the proposed ones
+func (ctrl *AdminController) ApplyBucketProposal(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid proposal ID", nil)
+		return
+	}
+
+	proposal, err := ctrl.bucketProposalService.ApplyProposal(ctx, id)
+	if err != nil {
+		ctrl.bucketProposalError(ctx, err, "Failed to apply bucket proposal")
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket proposal applied successfully", proposal)
+}
+
+// DismissBucketProposal controller dismisses a bucket proposal
+func (ctrl *AdminController) DismissBucketProposal(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid proposal ID", nil)
+		return
+	}
+
+	proposal, err := ctrl.bucketProposalService.DismissProposal(ctx, id)
+	if err != nil {
+		ctrl.bucketProposalError(ctx, err, "Failed to dismiss bucket proposal")
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Bucket proposal dismissed successfully", proposal)
+}
+
+// bucketProposalError responds with the status matching an error from the bucket proposal service
+func (ctrl *AdminController) bucketProposalError(ctx *gin.Context, err error, message string) {
+	if ent.IsNotFound(err) {
+		u.APIResponse(ctx, http.StatusNotFound, "error", "Bucket proposal not found", nil)
+	} else if errors.Is(err, svc.ErrBucketProposalNotPending) {
+		u.APIResponse(ctx, http.StatusConflict, "error", "Bucket proposal is no longer pending", nil)
+	} else {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", message, nil)
+	}
+}
+
+// GetDeadLetterOrders controller lists the orders that could not be matched to a provider, optionally filtered by status
+func (ctrl *AdminController) GetDeadLetterOrders(ctx *gin.Context) {
+	status := ctx.Query("status")
+	if status != "" && deadletterorder.StatusValidator(deadletterorder.Status(status)) != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid status", nil)
+		return
+	}
+
+	orders, err := ctrl.deadLetterService.GetDeadLetterOrders(ctx, status)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dead-letter orders", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dead-letter orders fetched successfully", orders)
+}
+
+// RetryDeadLetterOrder controller retries matching an order in the dead-letter queue right away
+func (ctrl *AdminController) RetryDeadLetterOrder(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dead-letter order ID", nil)
+		return
+	}
+
+	order, err := ctrl.deadLetterService.RetryNow(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dead-letter order not found", nil)
+		} else if errors.Is(err, svc.ErrDeadLetterOrderNotPending) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Order is no longer in the dead-letter queue", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to retry dead-letter order", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dead-letter order retried successfully", order)
+}
+
+// GetOrderAssignments controller fetches the history of order requests sent to providers for an order
+func (ctrl *AdminController) GetOrderAssignments(ctx *gin.Context) {
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	history, err := ctrl.orderAssignmentService.GetHistory(ctx, orderID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch order assignments", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Order assignments fetched successfully", history)
+}
+
+// GetRateCircuitBreaker controller fetches the market rate circuit breaker of a currency
+func (ctrl *AdminController) GetRateCircuitBreaker(ctx *gin.Context) {
+	breaker, err := ctrl.circuitBreakerService.Get(ctx, ctx.Param("code"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Circuit breaker not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch circuit breaker", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Circuit breaker fetched successfully", rateCircuitBreakerResponse(breaker))
+}
+
+// UpdateRateCircuitBreaker controller sets the rules of the market rate circuit breaker of a currency.
+// A rule with a zero limit is not checked.
+func (ctrl *AdminController) UpdateRateCircuitBreaker(ctx *gin.Context) {
+	var payload types.RateCircuitBreakerPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	if payload.MaxChangePercent.IsNegative() || payload.MaxDivergencePercent.IsNegative() {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Failed to validate payload", []types.ErrorData{{
+			Field:   "MaxChangePercent",
+			Message: "Limits must not be negative",
+		}})
+		return
+	}
+
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code")))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Currency not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch currency", nil)
+		}
+		return
+	}
+
+	breaker, err := ctrl.circuitBreakerService.Configure(ctx, currency, payload)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update circuit breaker", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Circuit breaker updated successfully", rateCircuitBreakerResponse(breaker))
+}
+
+// ResetRateCircuitBreaker controller resets the tripped market rate circuit breaker of a currency
+func (ctrl *AdminController) ResetRateCircuitBreaker(ctx *gin.Context) {
+	breaker, err := ctrl.circuitBreakerService.Reset(ctx, ctx.Param("code"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Circuit breaker not found", nil)
+		} else if errors.Is(err, svc.ErrCircuitBreakerNotTripped) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Circuit breaker is not tripped", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to reset circuit breaker", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Circuit breaker reset successfully", rateCircuitBreakerResponse(breaker))
+}
+
+// GetPublicHolidays controller fetches the public holidays of a currency, on which providers serving it are closed
+func (ctrl *AdminController) GetPublicHolidays(ctx *gin.Context) {
+	holidays, err := storage.Client.PublicHoliday.
+		Query().
+		Where(publicholiday.HasFiatCurrencyWith(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code"))))).
+		Order(ent.Asc(publicholiday.FieldDate)).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch public holidays", nil)
+		return
+	}
+
+	response := make([]types.PublicHolidayResponse, 0, len(holidays))
+	for _, holiday := range holidays {
+		response = append(response, publicHolidayResponse(holiday))
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Public holidays fetched successfully", response)
+}
+
+// CreatePublicHoliday controller adds a public holiday to a currency
+func (ctrl *AdminController) CreatePublicHoliday(ctx *gin.Context) {
+	var payload types.PublicHolidayPayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", payload.Date)
+
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code")))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Currency not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch currency", nil)
+		}
+		return
+	}
+
+	holiday, err := storage.Client.PublicHoliday.
+		Create().
+		SetDate(date).
+		SetName(payload.Name).
+		SetFiatCurrency(currency).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Currency already has a public holiday on this date", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to create public holiday", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Public holiday created successfully", publicHolidayResponse(holiday))
+}
+
+// DeletePublicHoliday controller removes a public holiday from a currency
+func (ctrl *AdminController) DeletePublicHoliday(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid public holiday ID", nil)
+		return
+	}
+
+	deleted, err := storage.Client.PublicHoliday.
+		Delete().
+		Where(
+			publicholiday.IDEQ(id),
+			publicholiday.HasFiatCurrencyWith(fiatcurrency.CodeEQ(strings.ToUpper(ctx.Param("code")))),
+		).
+		Exec(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to delete public holiday", nil)
+		return
+	}
+
+	if deleted == 0 {
+		u.APIResponse(ctx, http.StatusNotFound, "error", "Public holiday not found", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Public holiday deleted successfully", nil)
+}
+
+// getDispute fetches the dispute in the URL.
+// It writes the error response and returns false if the dispute can't be fetched.
+func (ctrl *AdminController) getDispute(ctx *gin.Context) (*ent.Dispute, bool) {
+	disputeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute ID", nil)
+		return nil, false
+	}
+
+	d, err := ctrl.disputeService.GetDispute(ctx, dispute.IDEQ(disputeID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dispute not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dispute", nil)
+		}
+		return nil, false
+	}
+
+	return d, true
+}
+
+// publicHolidayResponse builds the response for a public holiday
+func publicHolidayResponse(holiday *ent.PublicHoliday) types.PublicHolidayResponse {
+	return types.PublicHolidayResponse{
+		ID:   holiday.ID,
+		Date: holiday.Date.UTC().Format("2006-01-02"),
+		Name: holiday.Name,
+	}
+}
+
+// rateCircuitBreakerResponse builds the response for a market rate circuit breaker
+func rateCircuitBreakerResponse(breaker *ent.RateCircuitBreaker) *types.RateCircuitBreakerResponse {
+	response := &types.RateCircuitBreakerResponse{
+		MaxChangePercent:     breaker.MaxChangePercent,
+		ChangeWindow:         breaker.ChangeWindow,
+		MaxDivergencePercent: breaker.MaxDivergencePercent,
+		AutoResetAfter:       breaker.AutoResetAfter,
+		Tripped:              breaker.Tripped,
+		TripReason:           breaker.TripReason,
+		FrozenRate:           breaker.FrozenRate,
+	}
+
+	if breaker.Edges.Currency != nil {
+		response.Currency = breaker.Edges.Currency.Code
+	}
+
+	if breaker.Tripped {
+		response.TrippedAt = &breaker.TrippedAt
+	}
+
+	return response
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -21,6 +22,7 @@ import (
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
 	"github.com/paycrest/aggregator/ent/token"
 	svc "github.com/paycrest/aggregator/services"
 	orderSvc "github.com/paycrest/aggregator/services/order"
@@ -36,12 +38,16 @@ import (
 var cryptoConf = config.CryptoConfig()
 var serverConf = config.ServerConfig()
 var identityConf = config.IdentityConfig()
+var orderConf = config.OrderConfig()
 
 // Controller is the default controller for other endpoints
 type Controller struct {
 	orderService          types.OrderService
 	priorityQueueService  *svc.PriorityQueueService
 	receiveAddressService *svc.ReceiveAddressService
+	rateHistoryService    *svc.RateHistoryService
+	circuitBreakerService *svc.CircuitBreakerService
+	marketRateService     *svc.MarketRateService
 }
 
 // NewController creates a new instance of AuthController with injected services
@@ -50,6 +56,9 @@ func NewController() *Controller {
 		orderService:          orderSvc.NewOrderEVM(),
 		priorityQueueService:  svc.NewPriorityQueueService(),
 		receiveAddressService: svc.NewReceiveAddressService(),
+		rateHistoryService:    svc.NewRateHistoryService(),
+		circuitBreakerService: svc.NewCircuitBreakerService(),
+		marketRateService:     svc.NewMarketRateService(),
 	}
 }
 
@@ -152,7 +161,24 @@ func (ctrl *Controller) GetTokenRate(ctx *gin.Context) {
 		return
 	}
 
-	rateResponse := currency.MarketRate
+	// Rates are not quoted while the market rate circuit breaker of the currency is tripped
+	tripped, err := ctrl.circuitBreakerService.IsTripped(ctx, currency.Code)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch token rate", nil)
+		return
+	}
+	if tripped {
+		u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "Rates for the currency are paused", nil)
+		return
+	}
+
+	rateResponse, err := ctrl.marketRateService.GetRate(ctx, currency, token.Symbol)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch token rate", nil)
+		return
+	}
 
 	// get providerID from query params
 	providerID := ctx.Query("provider_id")
@@ -172,7 +198,7 @@ func (ctrl *Controller) GetTokenRate(ctx *gin.Context) {
 			}
 		}
 
-		rateResponse, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, token.Symbol)
+		rateResponse, err = ctrl.priorityQueueService.GetProviderRate(ctx, provider, token.Symbol, currency.Code)
 		if err != nil {
 			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch provider rate", nil)
 			return
@@ -223,6 +249,99 @@ func (ctrl *Controller) GetTokenRate(ctx *gin.Context) {
 	u.APIResponse(ctx, http.StatusOK, "success", "Rate fetched successfully", rateResponse)
 }
 
+// GetRateCandles controller fetches the OHLC candles of the rates of a fiat currency over a time range
+func (ctrl *Controller) GetRateCandles(ctx *gin.Context) {
+	filter, ok := rateHistoryFilter(ctx)
+	if !ok {
+		return
+	}
+
+	intervalParam := ctx.DefaultQuery("interval", "1h")
+	interval, ok := svc.RateCandleIntervals[intervalParam]
+	if !ok {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid interval", nil)
+		return
+	}
+
+	to := time.Now()
+	if toParam := ctx.Query("to"); toParam != "" {
+		parsed, err := time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid to time", nil)
+			return
+		}
+		to = parsed
+	}
+
+	from := to.Add(-100 * interval)
+	if fromParam := ctx.Query("from"); fromParam != "" {
+		parsed, err := time.Parse(time.RFC3339, fromParam)
+		if err != nil || !parsed.Before(to) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid from time", nil)
+			return
+		}
+		from = parsed
+	}
+
+	candles, err := ctrl.rateHistoryService.GetCandles(ctx, filter, interval, from, to)
+	if err != nil {
+		if errors.Is(err, svc.ErrTooManyCandles) {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Time range is too long for the interval", nil)
+			return
+		}
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch rate candles", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Rate candles fetched successfully", candles)
+}
+
+// GetHistoricalRate controller fetches the rate of a fiat currency at a point in time
+func (ctrl *Controller) GetHistoricalRate(ctx *gin.Context) {
+	filter, ok := rateHistoryFilter(ctx)
+	if !ok {
+		return
+	}
+
+	at, err := time.Parse(time.RFC3339, ctx.Query("at"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid at time", nil)
+		return
+	}
+
+	rate, err := ctrl.rateHistoryService.GetRateAt(ctx, filter, at)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "No rate recorded at the time", nil)
+			return
+		}
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch historical rate", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Rate fetched successfully", rate)
+}
+
+// rateHistoryFilter reads the rates requested from the rate history.
+// It writes the error response and returns false if the request is invalid.
+func rateHistoryFilter(ctx *gin.Context) (types.RateHistoryFilter, bool) {
+	filter := types.RateHistoryFilter{
+		Currency:   strings.ToUpper(ctx.Param("fiat")),
+		Kind:       ratesnapshot.Kind(ctx.DefaultQuery("kind", string(ratesnapshot.KindMarket))),
+		ProviderID: ctx.Query("provider_id"),
+		Token:      strings.ToUpper(ctx.Query("token")),
+	}
+
+	if ratesnapshot.KindValidator(filter.Kind) != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid kind", nil)
+		return filter, false
+	}
+
+	return filter, true
+}
+
 // GetAggregatorPublicKey controller expose Aggregator Public Key
 func (ctrl *Controller) GetAggregatorPublicKey(ctx *gin.Context) {
 	u.APIResponse(ctx, http.StatusOK, "success", "OK", cryptoConf.AggregatorPublicKey)
@@ -262,12 +381,15 @@ func (ctrl *Controller) VerifyAccount(ctx *gin.Context) {
 	providers, err := storage.Client.ProviderProfile.
 		Query().
 		Where(
-			providerprofile.HasCurrencyWith(
+			providerprofile.HasCurrenciesWith(
 				fiatcurrency.CodeEQ(institution.Edges.FiatCurrency.Code),
 			),
 			providerprofile.HostIdentifierNotNil(),
 			providerprofile.IsActiveEQ(true),
 			providerprofile.IsAvailableEQ(true),
+			providerprofile.IsOpenEQ(true),
+			providerprofile.IsHealthyEQ(true),
+			providerprofile.NodeProtocolVersionGTE(orderConf.NodeMinProtocolVersion),
 		).
 		All(ctx)
 	if err != nil {
@@ -277,6 +399,15 @@ func (ctrl *Controller) VerifyAccount(ctx *gin.Context) {
 		return
 	}
 
+	// Only ask providers that can pay out to the institution
+	providers = slices.DeleteFunc(providers, func(provider *ent.ProviderProfile) bool {
+		return !u.SupportsInstitution(provider, institution)
+	})
+	if len(providers) == 0 {
+		u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "Failed to verify account", "No provider supports this institution")
+		return
+	}
+
 	var res fastshot.Response
 	var data map[string]interface{}
 	for _, provider := range providers {
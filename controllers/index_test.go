@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	_ "github.com/mattn/go-sqlite3"
@@ -18,6 +19,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/ent/enttest"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	svc "github.com/paycrest/aggregator/services"
 	"github.com/paycrest/aggregator/utils/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -58,6 +61,8 @@ func TestIndex(t *testing.T) {
 	router.POST("kyc", ctrl.RequestIDVerification)
 	router.GET("kyc/:wallet_address", ctrl.GetIDVerificationStatus)
 	router.POST("kyc/webhook", ctrl.KYCWebhook)
+	router.GET("rate-history/:fiat", ctrl.GetHistoricalRate)
+	router.GET("rate-history/:fiat/candles", ctrl.GetRateCandles)
 
 	t.Run("GetInstitutions By Currency", func(t *testing.T) {
 
@@ -115,6 +120,51 @@ func TestIndex(t *testing.T) {
 		})
 	})
 
+	t.Run("Rate History", func(t *testing.T) {
+		recordedAt := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+		err := svc.NewRateHistoryService().Record(context.Background(), recordedAt, types.RateObservation{
+			CurrencyID: testCtx.currency.ID,
+			Kind:       ratesnapshot.KindMarket,
+			Rate:       decimal.NewFromInt(950),
+		})
+		assert.NoError(t, err)
+
+		t.Run("fetch rate candles", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/rate-history/ngn/candles?interval=1d&from=2025-01-01T00:00:00Z&to=2025-01-08T00:00:00Z", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data []types.RateCandleResponse
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Len(t, response.Data, 1)
+			assert.Equal(t, "950", response.Data[0].Close.String())
+
+			res, err = test.PerformRequest(t, "GET", "/rate-history/ngn/candles?interval=2d", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+
+		t.Run("fetch rate at a point in time", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "GET", "/rate-history/ngn?at=2025-01-06T12:00:00Z", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response struct {
+				Data types.HistoricalRateResponse
+			}
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "950", response.Data.Rate.String())
+
+			res, err = test.PerformRequest(t, "GET", "/rate-history/ngn?at=2025-01-06T09:00:00Z&kind=external", nil, nil, router)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		})
+	})
+
 	t.Run("Get Aggregator Public key", func(t *testing.T) {
 		t.Run("fetch Aggregator Public key", func(t *testing.T) {
 			res, err := test.PerformRequest(t, "GET", "/pubkey", nil, nil, router)
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -10,12 +12,16 @@ import (
 	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
+	svc "github.com/paycrest/aggregator/services"
 	orderService "github.com/paycrest/aggregator/services/order"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
@@ -27,13 +33,34 @@ import (
 )
 
 var orderConf = config.OrderConfig()
+var blobConf = config.BlobStorageConfig()
 
 // ProviderController is a controller type for provider endpoints
-type ProviderController struct{}
+type ProviderController struct {
+	priorityQueueService         *svc.PriorityQueueService
+	providerHealthService        *svc.ProviderHealthService
+	providerSLAService           *svc.ProviderSLAService
+	disputeService               *svc.DisputeService
+	fulfillmentProofService      *svc.FulfillmentProofService
+	fulfillmentValidationService *svc.FulfillmentValidationService
+	matchingEngine               *svc.MatchingEngine
+	orderAssignmentService       *svc.OrderAssignmentService
+	marketRateService            *svc.MarketRateService
+}
 
 // NewProviderController creates a new instance of ProviderController with injected services
 func NewProviderController() *ProviderController {
-	return &ProviderController{}
+	return &ProviderController{
+		priorityQueueService:         svc.NewPriorityQueueService(),
+		providerHealthService:        svc.NewProviderHealthService(),
+		providerSLAService:           svc.NewProviderSLAService(),
+		disputeService:               svc.NewDisputeService(),
+		fulfillmentProofService:      svc.NewFulfillmentProofService(),
+		fulfillmentValidationService: svc.NewFulfillmentValidationService(),
+		matchingEngine:               svc.NewMatchingEngine(),
+		orderAssignmentService:       svc.NewOrderAssignmentService(),
+		marketRateService:            svc.NewMarketRateService(),
+	}
 }
 
 // GetLockPaymentOrders controller fetches all assigned orders
@@ -135,6 +162,12 @@ func (ctrl *ProviderController) GetLockPaymentOrders(ctx *gin.Context) {
 	})
 }
 
+// orderActionError describes why an order action failed, shared by the single and batch order endpoints
+type orderActionError struct {
+	status  int
+	message string
+}
+
 // AcceptOrder controller accepts an order
 func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 	// Get provider profile from the context
@@ -153,32 +186,49 @@ func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 		return
 	}
 
+	response, actionErr := ctrl.acceptOrder(ctx, provider, orderID)
+	if actionErr != nil {
+		u.APIResponse(ctx, actionErr.status, "error", actionErr.message, nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Order request accepted successfully", response)
+}
+
+// acceptOrder accepts an order request sent to the provider and marks the order as processing
+func (ctrl *ProviderController) acceptOrder(ctx context.Context, provider *ent.ProviderProfile, orderID uuid.UUID) (*types.AcceptOrderResponse, *orderActionError) {
 	// Get Order request from Redis
 	result, err := storage.RedisClient.HGetAll(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
 	if err != nil {
 		logger.Errorf("error getting order request from Redis: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept order request", nil)
-		return
+		return nil, &orderActionError{http.StatusInternalServerError, "Failed to accept order request"}
+	}
+
+	recipient, err := ctrl.priorityQueueService.IsOrderRequestRecipient(ctx, orderID.String(), result, provider.ID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		return nil, &orderActionError{http.StatusInternalServerError, "Failed to accept order request"}
 	}
 
-	if result["providerId"] != provider.ID || len(result) == 0 {
+	if !recipient {
 		logger.Errorf("order request not found in Redis: %v", orderID)
-		u.APIResponse(ctx, http.StatusNotFound, "error", "Order request not found or is expired", nil)
-		return
+		return nil, &orderActionError{http.StatusNotFound, "Order request not found or is expired"}
 	}
 
-	// Delete order request from Redis
-	_, err = storage.RedisClient.Del(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
+	// Claim the order request, which only the first provider to accept a broadcast order request gets
+	claimed, err := ctrl.priorityQueueService.ClaimOrderRequest(ctx, orderID, provider.ID)
 	if err != nil {
-		logger.Errorf("error deleting order request from Redis: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to accept order request", nil)
-		return
+		logger.Errorf("error claiming order request: %v", err)
+		return nil, &orderActionError{http.StatusInternalServerError, "Failed to accept order request"}
+	}
+
+	if !claimed {
+		return nil, &orderActionError{http.StatusConflict, "Order request was accepted by another provider or is expired"}
 	}
 
 	tx, err := storage.Client.Tx(ctx)
 	if err != nil {
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-		return
+		return nil, &orderActionError{http.StatusInternalServerError, "Failed to update lock order status"}
 	}
 
 	// Log transaction status
@@ -194,8 +244,8 @@ func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 		Only(ctx)
 	if err != nil {
 		if !ent.IsNotFound(err) {
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			_ = tx.Rollback()
+			return nil, &orderActionError{http.StatusInternalServerError, "Failed to update lock order status"}
 		} else {
 			transactionLog, err = tx.TransactionLog.
 				Create().
@@ -206,8 +256,8 @@ func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 					}).
 				Save(ctx)
 			if err != nil {
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-				return
+				_ = tx.Rollback()
+				return nil, &orderActionError{http.StatusInternalServerError, "Failed to update lock order status"}
 			}
 		}
 	}
@@ -225,28 +275,30 @@ func (ctrl *ProviderController) AcceptOrder(ctx *gin.Context) {
 	order, err := orderBuilder.Save(ctx)
 	if err != nil {
 		logger.Errorf("%s - error.AcceptOrder: %v", orderID, err)
+		_ = tx.Rollback()
 		if ent.IsNotFound(err) {
-			u.APIResponse(ctx, http.StatusNotFound, "error", "Order not found", nil)
-		} else {
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
+			return nil, &orderActionError{http.StatusNotFound, "Order not found"}
 		}
-		return
+		return nil, &orderActionError{http.StatusInternalServerError, "Failed to update lock order status"}
 	}
 
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-		return
+		return nil, &orderActionError{http.StatusInternalServerError, "Failed to update lock order status"}
+	}
+
+	if err := ctrl.providerSLAService.RecordAcceptance(ctx, orderID, provider.ID); err != nil {
+		logger.Errorf("%s - error.AcceptOrder: %v", orderID, err)
 	}
 
-	u.APIResponse(ctx, http.StatusCreated, "success", "Order request accepted successfully", &types.AcceptOrderResponse{
+	return &types.AcceptOrderResponse{
 		ID:                orderID,
-		Amount:            order.Amount.Mul(order.Rate).RoundBank(0),
+		Amount:            u.OrderPayoutAmount(order.Amount, order.Rate),
 		Institution:       order.Institution,
 		AccountIdentifier: order.AccountIdentifier,
 		AccountName:       order.AccountName,
 		Memo:              order.Memo,
-	})
+	}, nil
 }
 
 // DeclineOrder controller declines an order
@@ -267,38 +319,53 @@ func (ctrl *ProviderController) DeclineOrder(ctx *gin.Context) {
 		return
 	}
 
+	if actionErr := ctrl.declineOrder(ctx, provider, orderID); actionErr != nil {
+		u.APIResponse(ctx, actionErr.status, "error", actionErr.message, nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Order request declined successfully", nil)
+}
+
+// declineOrder declines an order request sent to the provider and excludes the provider from the order
+func (ctrl *ProviderController) declineOrder(ctx context.Context, provider *ent.ProviderProfile, orderID uuid.UUID) *orderActionError {
 	// Get Order request from Redis
 	result, err := storage.RedisClient.HGetAll(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
 	if err != nil {
 		logger.Errorf("error getting order request from Redis: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to decline order request", nil)
-		return
+		return &orderActionError{http.StatusInternalServerError, "Failed to decline order request"}
 	}
 
-	if result["providerId"] != provider.ID || len(result) == 0 {
+	recipient, err := ctrl.priorityQueueService.IsOrderRequestRecipient(ctx, orderID.String(), result, provider.ID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		return &orderActionError{http.StatusInternalServerError, "Failed to decline order request"}
+	}
+
+	if !recipient {
 		logger.Errorf("order request not found in Redis: %v", orderID)
-		u.APIResponse(ctx, http.StatusNotFound, "error", "Order request not found or is expired", nil)
-		return
+		return &orderActionError{http.StatusNotFound, "Order request not found or is expired"}
 	}
 
-	// Delete order request from Redis
-	_, err = storage.RedisClient.Del(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
+	// Withdraw the order request from the provider
+	err = ctrl.priorityQueueService.DeclineOrderRequest(ctx, orderID, provider.ID)
 	if err != nil {
-		logger.Errorf("error deleting order request from Redis: %v", err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to decline order request", nil)
-		return
+		logger.Errorf("error withdrawing order request from Redis: %v", err)
+		return &orderActionError{http.StatusInternalServerError, "Failed to decline order request"}
 	}
 
-	// Push provider ID to order exclude list
-	orderKey := fmt.Sprintf("order_exclude_list_%s", orderID)
-	_, err = storage.RedisClient.RPush(ctx, orderKey, provider.ID).Result()
+	// Exclude the provider from the order
+	err = ctrl.orderAssignmentService.Exclude(ctx, orderID, provider.ID)
 	if err != nil {
-		logger.Errorf("error pushing provider %s to order %s exclude_list on Redis: %v", provider.ID, orderID, err)
-		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to decline order request", nil)
-		return
+		logger.Errorf("error excluding provider %s from order %s: %v", provider.ID, orderID, err)
+		return &orderActionError{http.StatusInternalServerError, "Failed to decline order request"}
 	}
 
-	u.APIResponse(ctx, http.StatusOK, "success", "Order request declined successfully", nil)
+	if err := ctrl.providerSLAService.RecordDecline(ctx, orderID, provider.ID); err != nil {
+		logger.Errorf("%s - error.DeclineOrder: %v", orderID, err)
+	}
+
+	return nil
 }
 
 // FulfillOrder controller fulfills an order
@@ -314,11 +381,12 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 	}
 
 	// Get provider profile from the context
-	_, ok := ctx.Get("provider")
+	providerCtx, ok := ctx.Get("provider")
 	if !ok {
 		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
 		return
 	}
+	provider := providerCtx.(*ent.ProviderProfile)
 
 	// Parse the Order ID string into a UUID
 	orderID, err := uuid.Parse(ctx.Param("id"))
@@ -328,10 +396,91 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 		return
 	}
 
-	updateLockOrder := storage.Client.LockPaymentOrder.
+	message, actionErr := ctrl.fulfillOrder(ctx, provider, orderID, payload)
+	if actionErr != nil {
+		u.APIResponse(ctx, actionErr.status, "error", actionErr.message, nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", message, nil)
+}
+
+// fulfillOrder records a fulfillment of an order assigned to the provider and updates the order according to
+// its validation status. It returns the success message for the resulting order state.
+func (ctrl *ProviderController) fulfillOrder(ctx context.Context, provider *ent.ProviderProfile, orderID uuid.UUID, payload types.FulfillLockOrderPayload) (string, *orderActionError) {
+	failed := &orderActionError{http.StatusInternalServerError, "Failed to update lock order status"}
+	notFound := &orderActionError{http.StatusNotFound, "Order not found"}
+
+	// Only the provider the order is assigned to can fulfill it
+	isAssigned, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.IDEQ(orderID),
+			lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		return "", failed
+	}
+	if !isAssigned {
+		return "", notFound
+	}
+
+	// Confirm the transfer through the PSP when the order's currency calls for it
+	pspConfirmed := false
+	if payload.ValidationStatus == lockorderfulfillment.ValidationStatusSuccess {
+		status, err := ctrl.fulfillmentValidationService.CheckTransfer(ctx, orderID, payload.PSP, payload.TxID)
+		if err != nil {
+			if errors.Is(err, svc.ErrPSPNotSupported) {
+				return "", &orderActionError{http.StatusBadRequest, fmt.Sprintf("PSP %s does not support independent validation", payload.PSP)}
+			}
+			if ent.IsNotFound(err) {
+				return "", notFound
+			}
+			logger.Errorf("error: %v", err)
+			return "", &orderActionError{http.StatusBadGateway, "Failed to confirm transfer with PSP"}
+		}
+
+		if status != nil {
+			switch status.State {
+			case types.PSPTransferSuccess:
+				pspConfirmed = true
+			case types.PSPTransferPending:
+				return "", &orderActionError{http.StatusConflict, "Transfer is not yet confirmed by the PSP"}
+			default:
+				payload.ValidationStatus = lockorderfulfillment.ValidationStatusFailed
+				payload.ValidationError = "Transfer not confirmed by the PSP"
+				if status.Message != "" {
+					payload.ValidationError = fmt.Sprintf("%s: %s", payload.ValidationError, status.Message)
+				}
+			}
+		}
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		return "", failed
+	}
+
+	receiptKey := ""
+	rollback := func(err error) (string, *orderActionError) {
+		logger.Errorf("error: %v", err)
+		_ = tx.Rollback()
+		if receiptKey != "" {
+			if err := ctrl.fulfillmentProofService.DeleteReceipt(ctx, receiptKey); err != nil {
+				logger.Errorf("error: %v", err)
+			}
+		}
+		return "", failed
+	}
+
+	updateLockOrder := tx.LockPaymentOrder.
 		Update().
 		Where(
 			lockpaymentorder.IDEQ(orderID),
+			lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
 			lockpaymentorder.Or(
 				lockpaymentorder.StatusEQ(lockpaymentorder.StatusProcessing),
 				lockpaymentorder.StatusEQ(lockpaymentorder.StatusFulfilled),
@@ -339,77 +488,119 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 		)
 
 	// Query or create lock order fulfillment
-	fulfillment, err := storage.Client.LockOrderFulfillment.
-		Query().
-		Where(lockorderfulfillment.TxIDEQ(payload.TxID)).
-		WithOrder(func(poq *ent.LockPaymentOrderQuery) {
-			poq.WithToken(func(tq *ent.TokenQuery) {
-				tq.WithNetwork()
-			})
-		}).
-		Only(ctx)
+	fulfillmentQuery := func() (*ent.LockOrderFulfillment, error) {
+		return tx.LockOrderFulfillment.
+			Query().
+			Where(
+				lockorderfulfillment.TxIDEQ(payload.TxID),
+				lockorderfulfillment.HasOrderWith(
+					lockpaymentorder.IDEQ(orderID),
+					lockpaymentorder.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+				),
+			).
+			WithOrder(func(poq *ent.LockPaymentOrderQuery) {
+				poq.WithToken(func(tq *ent.TokenQuery) {
+					tq.WithNetwork()
+				})
+			}).
+			Only(ctx)
+	}
+
+	fulfillment, err := fulfillmentQuery()
 	if err != nil {
-		if ent.IsNotFound(err) {
-			_, err = storage.Client.LockOrderFulfillment.
-				Create().
-				SetOrderID(orderID).
-				SetTxID(payload.TxID).
-				SetPsp(payload.PSP).
-				Save(ctx)
-			if err != nil {
-				logger.Errorf("error: %v", err)
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-				return
+		if !ent.IsNotFound(err) {
+			return rollback(err)
+		}
+
+		_, err = tx.LockOrderFulfillment.
+			Create().
+			SetOrderID(orderID).
+			SetTxID(payload.TxID).
+			SetPsp(payload.PSP).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				_ = tx.Rollback()
+				return "", &orderActionError{http.StatusConflict, "Transaction ID is already used by another order"}
 			}
+			return rollback(err)
+		}
 
-			fulfillment, err = storage.Client.LockOrderFulfillment.
-				Query().
-				Where(lockorderfulfillment.TxIDEQ(payload.TxID)).
-				WithOrder(func(poq *ent.LockPaymentOrderQuery) {
-					poq.WithToken(func(tq *ent.TokenQuery) {
-						tq.WithNetwork()
-					})
-				}).
-				Only(ctx)
-			if err != nil {
-				logger.Errorf("error: %v", err)
-				u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-				return
+		fulfillment, err = fulfillmentQuery()
+		if err != nil {
+			if ent.IsNotFound(err) {
+				_ = tx.Rollback()
+				return "", notFound
+			}
+			return rollback(err)
+		}
+	}
+
+	// Orders past fulfillment keep the proof they were validated with
+	switch orderStatus := fulfillment.Edges.Order.Status; {
+	case orderStatus == lockpaymentorder.StatusValidated || orderStatus == lockpaymentorder.StatusSettled:
+		_ = tx.Rollback()
+		return "Order already validated", nil
+	case orderStatus != lockpaymentorder.StatusProcessing && orderStatus != lockpaymentorder.StatusFulfilled:
+		_ = tx.Rollback()
+		return "", &orderActionError{http.StatusBadRequest, "Order is not being processed"}
+	case payload.ValidationStatus == lockorderfulfillment.ValidationStatusSuccess && orderStatus != lockpaymentorder.StatusFulfilled:
+		_ = tx.Rollback()
+		return "", &orderActionError{http.StatusBadRequest, "Order is not yet fulfilled"}
+	}
+
+	// Store the receipt once the fulfillment can take it, it is deleted again if the fulfillment isn't saved
+	receiptContentType := ""
+	if payload.Receipt != nil {
+		receiptKey, receiptContentType, err = ctrl.fulfillmentProofService.SaveReceipt(ctx, orderID, payload.Receipt)
+		if err != nil {
+			_ = tx.Rollback()
+			if errors.Is(err, svc.ErrReceiptTooLarge) {
+				return "", &orderActionError{http.StatusBadRequest, fmt.Sprintf("Receipt exceeds the maximum size of %d MB", blobConf.MaxReceiptSize/(1024*1024))}
+			}
+			if errors.Is(err, svc.ErrReceiptTypeNotSupported) {
+				return "", &orderActionError{http.StatusBadRequest, "Receipt must be a PDF, PNG or JPEG file"}
 			}
-		} else {
 			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return "", &orderActionError{http.StatusInternalServerError, "Failed to store receipt"}
 		}
 	}
 
+	// Attach the proof of the fulfillment
+	replacedReceiptKey := fulfillment.ReceiptKey
+	_, err = ctrl.fulfillmentProofService.
+		ApplyProof(tx.LockOrderFulfillment.UpdateOne(fulfillment), payload, receiptKey, receiptContentType).
+		Save(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+
+	settle := false
+
 	if payload.ValidationStatus == lockorderfulfillment.ValidationStatusSuccess {
-		if fulfillment.Edges.Order.Status != lockpaymentorder.StatusFulfilled {
-			u.APIResponse(ctx, http.StatusOK, "success", "Order already validated", nil)
-			return
+		updateFulfillment := tx.LockOrderFulfillment.
+			UpdateOne(fulfillment).
+			SetValidationStatus(lockorderfulfillment.ValidationStatusSuccess)
+		if pspConfirmed {
+			updateFulfillment.SetPspConfirmedAt(time.Now())
 		}
 
-		_, err := fulfillment.Update().
-			SetValidationStatus(lockorderfulfillment.ValidationStatusSuccess).
-			Save(ctx)
+		_, err := updateFulfillment.Save(ctx)
 		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return rollback(err)
 		}
 
-		transactionLog, err := storage.Client.TransactionLog.Create().
+		transactionLog, err := tx.TransactionLog.Create().
 			SetStatus(transactionlog.StatusOrderValidated).
 			SetNetwork(fulfillment.Edges.Order.Edges.Token.Edges.Network.Identifier).
 			SetMetadata(map[string]interface{}{
 				"TransactionID": payload.TxID,
 				"PSP":           payload.PSP,
+				"PSPConfirmed":  pspConfirmed,
 			}).
 			Save(ctx)
 		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return rollback(err)
 		}
 
 		_, err = updateLockOrder.
@@ -417,46 +608,30 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 			AddTransactions(transactionLog).
 			Save(ctx)
 		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return rollback(err)
 		}
 
-		// Settle order or fail silently
-		go func() {
-			var err error
-			if strings.HasPrefix(fulfillment.Edges.Order.Edges.Token.Edges.Network.Identifier, "tron") {
-				err = orderService.NewOrderTron().SettleOrder(ctx, nil, orderID)
-			} else {
-				err = orderService.NewOrderEVM().SettleOrder(ctx, nil, orderID)
-			}
-			if err != nil {
-				logger.Errorf("FulfillOrder.SettleOrder: %v", err)
-			}
-		}()
+		settle = true
 
 	} else if payload.ValidationStatus == lockorderfulfillment.ValidationStatusFailed {
-		_, err = fulfillment.Update().
+		_, err = tx.LockOrderFulfillment.
+			UpdateOne(fulfillment).
 			SetValidationStatus(lockorderfulfillment.ValidationStatusFailed).
 			SetValidationError(payload.ValidationError).
 			Save(ctx)
 		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return rollback(err)
 		}
 
 		_, err = updateLockOrder.
 			SetStatus(lockpaymentorder.StatusFulfilled).
 			Save(ctx)
 		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return rollback(err)
 		}
 
 	} else {
-		transactionLog, err := storage.Client.TransactionLog.Create().
+		transactionLog, err := tx.TransactionLog.Create().
 			SetStatus(transactionlog.StatusOrderFulfilled).
 			SetNetwork(fulfillment.Edges.Order.Edges.Token.Edges.Network.Identifier).
 			SetMetadata(map[string]interface{}{
@@ -465,9 +640,7 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 			}).
 			Save(ctx)
 		if err != nil {
-			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
+			return rollback(err)
 		}
 
 		_, err = updateLockOrder.
@@ -475,13 +648,218 @@ func (ctrl *ProviderController) FulfillOrder(ctx *gin.Context) {
 			AddTransactions(transactionLog).
 			Save(ctx)
 		if err != nil {
+			return rollback(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return rollback(err)
+	}
+
+	// The receipt replaced by the new one is no longer referenced
+	if receiptKey != "" && replacedReceiptKey != "" {
+		if err := ctrl.fulfillmentProofService.DeleteReceipt(ctx, replacedReceiptKey); err != nil {
 			logger.Errorf("error: %v", err)
-			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to update lock order status", nil)
-			return
 		}
 	}
 
-	u.APIResponse(ctx, http.StatusOK, "success", "Order fulfilled successfully", nil)
+	if settle {
+		if err := ctrl.providerSLAService.RecordValidation(ctx, orderID); err != nil {
+			logger.Errorf("error: %v", err)
+		}
+
+		// Settle order or fail silently
+		go func() {
+			var err error
+			if strings.HasPrefix(fulfillment.Edges.Order.Edges.Token.Edges.Network.Identifier, "tron") {
+				err = orderService.NewOrderTron().SettleOrder(ctx, nil, orderID)
+			} else {
+				err = orderService.NewOrderEVM().SettleOrder(ctx, nil, orderID)
+			}
+			if err != nil {
+				logger.Errorf("FulfillOrder.SettleOrder: %v", err)
+			}
+		}()
+	} else if payload.ValidationStatus != lockorderfulfillment.ValidationStatusFailed {
+		if err := ctrl.providerSLAService.RecordFulfillment(ctx, orderID); err != nil {
+			logger.Errorf("error: %v", err)
+		}
+	}
+
+	return "Order fulfilled successfully", nil
+}
+
+// BatchAcceptOrders controller accepts multiple order requests in one request
+func (ctrl *ProviderController) BatchAcceptOrders(ctx *gin.Context) {
+	var payload types.BatchOrderActionPayload
+
+	// Parse the batch payload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	if !svc.NodeSupports(provider, svc.NodeCapabilityBatchFulfillment) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Node does not support batch fulfillment", nil)
+		return
+	}
+
+	if len(payload.OrderIDs) > orderConf.OrderBatchSizeLimit {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			fmt.Sprintf("A batch can contain at most %d orders", orderConf.OrderBatchSizeLimit), nil)
+		return
+	}
+
+	response := processOrderBatch(payload.OrderIDs, func(_ int, orderID uuid.UUID) (string, interface{}, *orderActionError) {
+		order, actionErr := ctrl.acceptOrder(ctx, provider, orderID)
+		if actionErr != nil {
+			return "", nil, actionErr
+		}
+		return "Order request accepted successfully", order, nil
+	})
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Batch processed successfully", response)
+}
+
+// BatchDeclineOrders controller declines multiple order requests in one request
+func (ctrl *ProviderController) BatchDeclineOrders(ctx *gin.Context) {
+	var payload types.BatchOrderActionPayload
+
+	// Parse the batch payload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	if !svc.NodeSupports(provider, svc.NodeCapabilityBatchFulfillment) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Node does not support batch fulfillment", nil)
+		return
+	}
+
+	if len(payload.OrderIDs) > orderConf.OrderBatchSizeLimit {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			fmt.Sprintf("A batch can contain at most %d orders", orderConf.OrderBatchSizeLimit), nil)
+		return
+	}
+
+	response := processOrderBatch(payload.OrderIDs, func(_ int, orderID uuid.UUID) (string, interface{}, *orderActionError) {
+		if actionErr := ctrl.declineOrder(ctx, provider, orderID); actionErr != nil {
+			return "", nil, actionErr
+		}
+		return "Order request declined successfully", nil, nil
+	})
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Batch processed successfully", response)
+}
+
+// BatchFulfillOrders controller fulfills multiple orders in one request
+func (ctrl *ProviderController) BatchFulfillOrders(ctx *gin.Context) {
+	var payload types.BatchFulfillOrderPayload
+
+	// Parse the batch payload
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	if !svc.NodeSupports(provider, svc.NodeCapabilityBatchFulfillment) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Node does not support batch fulfillment", nil)
+		return
+	}
+
+	if len(payload.Orders) > orderConf.OrderBatchSizeLimit {
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			fmt.Sprintf("A batch can contain at most %d orders", orderConf.OrderBatchSizeLimit), nil)
+		return
+	}
+
+	orderIDs := make([]string, len(payload.Orders))
+	for i, item := range payload.Orders {
+		orderIDs[i] = item.OrderID
+	}
+
+	response := processOrderBatch(orderIDs, func(i int, orderID uuid.UUID) (string, interface{}, *orderActionError) {
+		message, actionErr := ctrl.fulfillOrder(ctx, provider, orderID, payload.Orders[i].FulfillLockOrderPayload)
+		if actionErr != nil {
+			return "", nil, actionErr
+		}
+		return message, nil, nil
+	})
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Batch processed successfully", response)
+}
+
+// processOrderBatch runs an order action for each order ID in a batch and collects the per-order results.
+// Each order is processed on its own, so a failed order doesn't affect the rest of the batch.
+func processOrderBatch(orderIDs []string, action func(index int, orderID uuid.UUID) (string, interface{}, *orderActionError)) *types.BatchOrderResponse {
+	response := &types.BatchOrderResponse{
+		Results: make([]types.BatchOrderResult, 0, len(orderIDs)),
+	}
+	seen := map[uuid.UUID]bool{}
+
+	for i, id := range orderIDs {
+		result := types.BatchOrderResult{
+			OrderID: id,
+			Status:  "error",
+		}
+
+		orderID, err := uuid.Parse(id)
+		if err != nil {
+			result.Message = "Invalid Order ID"
+		} else if seen[orderID] {
+			result.Message = "Duplicate Order ID in batch"
+		} else {
+			seen[orderID] = true
+
+			message, data, actionErr := action(i, orderID)
+			if actionErr != nil {
+				result.Message = actionErr.message
+			} else {
+				result.Status = "success"
+				result.Message = message
+				result.Data = data
+			}
+		}
+
+		if result.Status == "success" {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	return response
 }
 
 // CancelOrder controller cancels an order
@@ -578,6 +956,12 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 			}
 		}
 
+		// Remove the provider from the order book of the token until the next rebuild
+		book := svc.BookKey(order.Edges.ProvisionBucket.Edges.Currency.Code, order.Edges.Token.Symbol, order.Edges.ProvisionBucket.MinAmount, order.Edges.ProvisionBucket.MaxAmount)
+		if err := ctrl.matchingEngine.RemoveProvider(ctx, book, provider.ID); err != nil {
+			logger.Errorf("failed to remove provider from order book: %v", err)
+		}
+
 		// // Update provider availability to off
 		// _, err = storage.Client.ProviderProfile.
 		// 	UpdateOneID(provider.ID).
@@ -618,11 +1002,10 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 		}()
 	}
 
-	// Push provider ID to order exclude list
-	orderKey := fmt.Sprintf("order_exclude_list_%s", orderID)
-	_, err = storage.RedisClient.RPush(ctx, orderKey, provider.ID).Result()
+	// Exclude the provider from the order
+	err = ctrl.orderAssignmentService.Exclude(ctx, orderID, provider.ID)
 	if err != nil {
-		logger.Errorf("error pushing provider %s to order %s exclude_list on Redis: %v", provider.ID, orderID, err)
+		logger.Errorf("error excluding provider %s from order %s: %v", provider.ID, orderID, err)
 		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to decline order request", nil)
 		return
 	}
@@ -630,7 +1013,7 @@ func (ctrl *ProviderController) CancelOrder(ctx *gin.Context) {
 	u.APIResponse(ctx, http.StatusOK, "success", "Order cancelled successfully", nil)
 }
 
-// GetMarketRate controller fetches the median rate of the cryptocurrency token against the fiat currency
+// GetMarketRate controller fetches the market rate of the cryptocurrency token in the fiat currency
 func (ctrl *ProviderController) GetMarketRate(ctx *gin.Context) {
 	// Parse path parameters
 	tokenExists, err := storage.Client.Token.
@@ -650,7 +1033,6 @@ func (ctrl *ProviderController) GetMarketRate(ctx *gin.Context) {
 		u.APIResponse(ctx, http.StatusBadRequest, "error", "Token is not supported", nil)
 		return
 	}
-	// TODO: use token to get the token rate for that currency based on the USD/Token Ratio USD/USDC can be 1.005 and USD/USD can be 0.9995
 
 	currency, err := storage.Client.FiatCurrency.
 		Query().
@@ -665,12 +1047,19 @@ func (ctrl *ProviderController) GetMarketRate(ctx *gin.Context) {
 		return
 	}
 
-	deviation := currency.MarketRate.Mul(orderConf.PercentDeviationFromMarketRate.Div(decimal.NewFromInt(100)))
+	marketRate, err := ctrl.marketRateService.GetRate(ctx, currency, ctx.Param("token"))
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to get market rate", nil)
+		return
+	}
+
+	deviation := marketRate.Mul(orderConf.PercentDeviationFromMarketRate.Div(decimal.NewFromInt(100)))
 
 	u.APIResponse(ctx, http.StatusOK, "success", "Rate fetched successfully", &types.MarketRateResponse{
-		MarketRate:  currency.MarketRate,
-		MinimumRate: currency.MarketRate.Sub(deviation),
-		MaximumRate: currency.MarketRate.Add(deviation),
+		MarketRate:  marketRate,
+		MinimumRate: marketRate.Sub(deviation),
+		MaximumRate: marketRate.Add(deviation),
 	})
 }
 
@@ -714,7 +1103,7 @@ func (ctrl *ProviderController) Stats(ctx *gin.Context) {
 
 	var totalFiatVolume decimal.Decimal
 	for _, order := range settledOrders {
-		totalFiatVolume = totalFiatVolume.Add(order.Amount.Mul(order.Rate).RoundBank(0))
+		totalFiatVolume = totalFiatVolume.Add(u.OrderPayoutAmount(order.Amount, order.Rate))
 	}
 
 	count, err := storage.Client.LockPaymentOrder.
@@ -747,7 +1136,7 @@ func (ctrl *ProviderController) NodeInfo(ctx *gin.Context) {
 		Query().
 		Where(providerprofile.IDEQ(providerCtx.(*ent.ProviderProfile).ID)).
 		WithAPIKey().
-		WithCurrency().
+		WithCurrencies().
 		Only(ctx)
 	if err != nil {
 		logger.Errorf("error: %v", err)
@@ -772,16 +1161,126 @@ func (ctrl *ProviderController) NodeInfo(ctx *gin.Context) {
 		return
 	}
 
-	currency := data["data"].(map[string]interface{})["currency"].(string)
-	if currency != provider.Edges.Currency.Code {
-		logger.Errorf("error: %v", err)
+	// Ensure the node only serves currencies supported by the provider
+	nodeInfo := data["data"].(map[string]interface{})
+	nodeCurrencies := []string{}
+	if currencies, ok := nodeInfo["currencies"].([]interface{}); ok {
+		for _, currency := range currencies {
+			nodeCurrencies = append(nodeCurrencies, fmt.Sprintf("%v", currency))
+		}
+	} else if currency, ok := nodeInfo["currency"].(string); ok {
+		nodeCurrencies = append(nodeCurrencies, currency)
+	}
+
+	providerCurrencies := []string{}
+	for _, currency := range provider.Edges.Currencies {
+		providerCurrencies = append(providerCurrencies, currency.Code)
+	}
+
+	if len(nodeCurrencies) == 0 || len(u.Difference(nodeCurrencies, providerCurrencies)) > 0 {
+		logger.Errorf("error: node currencies %v do not match provider currencies %v", nodeCurrencies, providerCurrencies)
 		u.APIResponse(ctx, http.StatusServiceUnavailable, "error", "Failed to fetch node info", nil)
 		return
 	}
 
+	// Store the protocol version and capabilities declared by the node
+	protocolVersion, capabilities := svc.ParseNodeHandshake(data)
+	_, err = provider.Update().
+		SetNodeProtocolVersion(protocolVersion).
+		SetNodeCapabilities(capabilities).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch node info", nil)
+		return
+	}
+	nodeInfo["protocolCompatible"] = protocolVersion >= orderConf.NodeMinProtocolVersion
+
+	// Include the token rates excluded from the order queues for being stale
+	staleRates, err := ctrl.priorityQueueService.GetStaleRates(ctx, provider)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch node info", nil)
+		return
+	}
+	nodeInfo["staleRates"] = staleRates
+
 	u.APIResponse(ctx, http.StatusOK, "success", "Node info fetched successfully", data)
 }
 
+// GetOrderRequests controller fetches the pending order requests of a provider whose node pulls its order requests
+func (ctrl *ProviderController) GetOrderRequests(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	if !svc.NodeSupports(provider, svc.NodeCapabilityPullDelivery) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Node does not support pull delivery of order requests", nil)
+		return
+	}
+
+	orderRequests, err := ctrl.priorityQueueService.GetPendingOrderRequests(ctx, provider.ID)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch order requests", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Order requests fetched successfully", orderRequests)
+}
+
+// GetHealthChecks controller fetches the health of the provider's node and its recent health checks
+func (ctrl *ProviderController) GetHealthChecks(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	page, offset, pageSize := u.Paginate(ctx)
+
+	health, err := ctrl.providerHealthService.GetHealthHistory(ctx, provider, page, offset, pageSize)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch node health", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Node health fetched successfully", health)
+}
+
+// GetSLAReport controller fetches the provider's SLA metrics and active penalties
+func (ctrl *ProviderController) GetSLAReport(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	from, to, err := u.TimeRange(ctx, 7*24*time.Hour)
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid date range", err.Error())
+		return
+	}
+
+	report, err := ctrl.providerSLAService.GetReport(ctx, provider, from, to)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch SLA report", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "SLA report fetched successfully", report)
+}
+
 // GetLockPaymentOrderByID controller fetches a payment order by ID
 func (ctrl *ProviderController) GetLockPaymentOrderByID(ctx *gin.Context) {
 	// Get order ID from the URL
@@ -854,3 +1353,110 @@ func (ctrl *ProviderController) GetLockPaymentOrderByID(ctx *gin.Context) {
 		Transactions:      transactions,
 	})
 }
+
+// GetDisputes controller fetches the disputes raised on orders fulfilled by the provider
+func (ctrl *ProviderController) GetDisputes(ctx *gin.Context) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	page, offset, pageSize := u.Paginate(ctx)
+
+	predicates := []predicate.Dispute{
+		dispute.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+	}
+	if status := ctx.Query("status"); status != "" {
+		if err := dispute.StatusValidator(dispute.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute status", nil)
+			return
+		}
+		predicates = append(predicates, dispute.StatusEQ(dispute.Status(status)))
+	}
+
+	disputes, err := ctrl.disputeService.GetDisputes(ctx, page, offset, pageSize, predicates...)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Disputes fetched successfully", disputes)
+}
+
+// GetDispute controller fetches a dispute raised on an order fulfilled by the provider
+func (ctrl *ProviderController) GetDispute(ctx *gin.Context) {
+	d, ok := ctrl.getProviderDispute(ctx)
+	if !ok {
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dispute fetched successfully", svc.DisputeResponse(d))
+}
+
+// SubmitDisputeEvidence controller attaches the provider's evidence to a dispute and puts it under review
+func (ctrl *ProviderController) SubmitDisputeEvidence(ctx *gin.Context) {
+	var payload types.DisputeEvidencePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	d, ok := ctrl.getProviderDispute(ctx)
+	if !ok {
+		return
+	}
+
+	if !svc.IsDisputeActive(d) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Dispute is closed", nil)
+		return
+	}
+
+	if err := ctrl.disputeService.AddEvidence(ctx, d, disputeevidence.SubmittedByProvider, payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to submit evidence", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Evidence submitted successfully", nil)
+}
+
+// getProviderDispute fetches the dispute in the URL if it was raised against the provider in the context.
+// It writes the error response and returns false if the dispute can't be fetched.
+func (ctrl *ProviderController) getProviderDispute(ctx *gin.Context) (*ent.Dispute, bool) {
+	// Get provider profile from the context
+	providerCtx, ok := ctx.Get("provider")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	provider := providerCtx.(*ent.ProviderProfile)
+
+	disputeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute ID", nil)
+		return nil, false
+	}
+
+	d, err := ctrl.disputeService.GetDispute(ctx,
+		dispute.IDEQ(disputeID),
+		dispute.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dispute not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dispute", nil)
+		}
+		return nil, false
+	}
+
+	return d, true
+}
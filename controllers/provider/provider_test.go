@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
@@ -16,6 +19,7 @@ import (
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/routers/middleware"
 	"github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/services/psp"
 	db "github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
 	"github.com/redis/go-redis/v9"
@@ -23,6 +27,10 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/utils"
 	"github.com/paycrest/aggregator/utils/test"
 	"github.com/paycrest/aggregator/utils/token"
 	"github.com/stretchr/testify/assert"
@@ -140,11 +148,14 @@ func TestProvider(t *testing.T) {
 	router.GET("/orders", ctrl.GetLockPaymentOrders)
 	router.GET("/stats", ctrl.Stats)
 	router.GET("/node-info", ctrl.NodeInfo)
+	router.GET("/health", ctrl.GetHealthChecks)
+	router.GET("/sla", ctrl.GetSLAReport)
 	router.GET("/orders/:id", ctrl.GetLockPaymentOrderByID)
 	router.POST("/orders/:id/accept", ctrl.AcceptOrder)
 	router.POST("/orders/:id/decline", ctrl.DeclineOrder)
 	router.POST("/orders/:id/fulfill", ctrl.FulfillOrder)
 	router.POST("/orders/:id/cancel", ctrl.CancelOrder)
+	router.POST("/orders/batch/fulfill", ctrl.BatchFulfillOrders)
 	router.GET("/rates/:token/:fiat", ctrl.GetMarketRate)
 
 	t.Run("GetLockPaymentOrders", func(t *testing.T) {
@@ -539,6 +550,56 @@ func TestProvider(t *testing.T) {
 			assert.Equal(t, "Node info fetched successfully", response.Message)
 		})
 
+		t.Run("when node declares protocol version and capabilities", func(t *testing.T) {
+			// Activate httpmock
+			httpmock.Activate()
+			defer httpmock.Deactivate()
+
+			// Register mock response
+			httpmock.RegisterResponder("GET", "https://example.com/health",
+				func(r *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{
+						"status":  "success",
+						"message": "Node is live",
+						"data": map[string]interface{}{
+							"currency":        "NGN",
+							"protocolVersion": 2,
+							"capabilities":    []string{"pull_delivery", "batch_fulfillment"},
+						},
+					})
+				},
+			)
+
+			var payload = map[string]interface{}{
+				"timestamp": time.Now().Unix(),
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+			}
+
+			res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/node-info?timestamp=%v", payload["timestamp"]), nil, headers, router)
+			assert.NoError(t, err)
+
+			// Assert the response body
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			data, ok := response.Data.(map[string]interface{})
+			assert.True(t, ok, "response.Data is not of type map[string]interface{}")
+			assert.Equal(t, true, data["data"].(map[string]interface{})["protocolCompatible"])
+
+			// Assert the handshake was stored on the provider
+			provider, err := db.Client.ProviderProfile.Get(context.Background(), testCtx.provider.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, provider.NodeProtocolVersion)
+			assert.Equal(t, []string{"pull_delivery", "batch_fulfillment"}, provider.NodeCapabilities)
+		})
+
 		t.Run("when node is unhealthy", func(t *testing.T) {
 			// Activate httpmock
 			httpmock.Activate()
@@ -575,6 +636,162 @@ func TestProvider(t *testing.T) {
 		})
 	})
 
+	t.Run("GetHealthChecks", func(t *testing.T) {
+		// Activate httpmock
+		httpmock.Activate()
+		defer httpmock.Deactivate()
+
+		healthService := services.NewProviderHealthService()
+		statusCode := http.StatusServiceUnavailable
+
+		// Register mock response
+		httpmock.RegisterResponder("GET", "https://example.com/health",
+			func(r *http.Request) (*http.Response, error) {
+				return httpmock.NewJsonResponse(statusCode, nil)
+			},
+		)
+
+		// The provider is marked unhealthy only after consecutive failures reach the threshold
+		for i := 1; i <= 3; i++ {
+			provider, err := db.Client.ProviderProfile.Get(context.Background(), testCtx.provider.ID)
+			assert.NoError(t, err)
+
+			changed, err := healthService.CheckProvider(context.Background(), provider)
+			assert.NoError(t, err)
+			assert.Equal(t, i == 3, changed)
+		}
+
+		provider, err := db.Client.ProviderProfile.Get(context.Background(), testCtx.provider.ID)
+		assert.NoError(t, err)
+		assert.False(t, provider.IsHealthy)
+		assert.Equal(t, 3, provider.HealthFailureStreak)
+
+		// The provider is restored on the next successful check
+		statusCode = http.StatusOK
+		changed, err := healthService.CheckProvider(context.Background(), provider)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+
+		var payload = map[string]interface{}{
+			"timestamp": time.Now().Unix(),
+		}
+
+		signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+		headers := map[string]string{
+			"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+		}
+
+		res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/health?timestamp=%v", payload["timestamp"]), nil, headers, router)
+		assert.NoError(t, err)
+
+		// Assert the response body
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.ProviderHealthResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.True(t, response.Data.IsHealthy)
+		assert.Equal(t, 0, response.Data.FailureStreak)
+		assert.Equal(t, 4, response.Data.TotalRecords)
+		assert.True(t, response.Data.Checks[0].IsHealthy)
+		assert.Equal(t, http.StatusServiceUnavailable, response.Data.Checks[1].StatusCode)
+	})
+
+	t.Run("GetSLAReport", func(t *testing.T) {
+		slaService := services.NewProviderSLAService()
+		now := time.Now()
+
+		// Two orders accepted and fulfilled, and three order requests left to expire
+		for i := 0; i < 5; i++ {
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   testCtx.provider,
+			})
+			assert.NoError(t, err)
+
+			record := db.Client.ProviderSLARecord.
+				Create().
+				SetOrderID(order.ID).
+				SetProviderID(testCtx.provider.ID).
+				SetAssignedAt(now.Add(-time.Hour))
+
+			if i < 2 {
+				record.
+					SetAcceptedAt(now.Add(-time.Hour).Add(time.Duration(10*(i+1)) * time.Second)).
+					SetFulfilledAt(now.Add(-30 * time.Minute))
+			}
+
+			_, err = record.Save(context.Background())
+			assert.NoError(t, err)
+
+			if i >= 2 {
+				err = slaService.RecordAcceptTimeout(context.Background(), order.ID)
+				assert.NoError(t, err)
+			}
+		}
+
+		// Three breaches reach the demotion threshold
+		provider, err := db.Client.ProviderProfile.Get(context.Background(), testCtx.provider.ID)
+		assert.NoError(t, err)
+
+		penalized, err := slaService.ApplyPenalties(context.Background(), provider)
+		assert.NoError(t, err)
+		assert.True(t, penalized)
+
+		// The same breaches don't extend the penalty
+		provider, err = db.Client.ProviderProfile.Get(context.Background(), testCtx.provider.ID)
+		assert.NoError(t, err)
+		assert.True(t, provider.DemotedUntil.After(now))
+		assert.True(t, provider.SuspendedUntil.IsZero())
+
+		penalized, err = slaService.ApplyPenalties(context.Background(), provider)
+		assert.NoError(t, err)
+		assert.False(t, penalized)
+
+		var payload = map[string]interface{}{
+			"timestamp": time.Now().Unix(),
+		}
+
+		signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+		headers := map[string]string{
+			"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+		}
+
+		res, err := test.PerformRequest(t, "GET", fmt.Sprintf("/sla?timestamp=%v", payload["timestamp"]), nil, headers, router)
+		assert.NoError(t, err)
+
+		// Assert the response body
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.ProviderSLAReport `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, response.Data.TotalAssigned)
+		assert.Equal(t, 2, response.Data.TimeToAccept.Count)
+		assert.Equal(t, float64(10), response.Data.TimeToAccept.P50)
+		assert.Equal(t, float64(20), response.Data.TimeToAccept.P99)
+		assert.Equal(t, 2, response.Data.TimeToFulfill.Count)
+		assert.Equal(t, 0, response.Data.TimeToValidate.Count)
+		assert.Equal(t, 3, response.Data.AcceptTimeouts)
+		assert.NotNil(t, response.Data.DemotedUntil)
+		assert.Nil(t, response.Data.SuspendedUntil)
+
+		// An invalid date range is rejected
+		payload["from"] = "yesterday"
+		signature = token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+		headers["Authorization"] = "HMAC " + testCtx.apiKey.ID.String() + ":" + signature
+
+		res, err = test.PerformRequest(t, "GET", fmt.Sprintf("/sla?timestamp=%v&from=yesterday", payload["timestamp"]), nil, headers, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
 	t.Run("GetMarketRate", func(t *testing.T) {
 
 		t.Run("when token does not exist", func(t *testing.T) {
@@ -1543,12 +1760,12 @@ func TestProvider(t *testing.T) {
 				assert.NoError(t, err)
 
 				// Assert the response body
-				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, http.StatusNotFound, res.Code)
 
 				var response types.Response
 				err = json.Unmarshal(res.Body.Bytes(), &response)
 				assert.NoError(t, err)
-				assert.Equal(t, "Failed to update lock order status", response.Message)
+				assert.Equal(t, "Order not found", response.Message)
 			})
 		})
 
@@ -1594,6 +1811,401 @@ func TestProvider(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, "Order fulfilled successfully", response.Message)
 		})
+
+		fulfill := func(orderID uuid.UUID, validationStatus string) *httptest.ResponseRecorder {
+			var payload = map[string]interface{}{
+				"timestamp":        time.Now().Unix(),
+				"validationStatus": validationStatus,
+				"txId":             "0x321" + fmt.Sprint(rand.Intn(1000000)),
+				"psp":              "psp-name",
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+			}
+
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/orders/%s/fulfill", orderID), payload, headers, router)
+			assert.NoError(t, err)
+			return res
+		}
+
+		t.Run("when the order is assigned to another provider", func(t *testing.T) {
+			user, err := test.CreateTestUser(map[string]interface{}{
+				"email": "other_fulfillment_provider@test.com",
+				"scope": "provider",
+			})
+			assert.NoError(t, err)
+
+			otherProvider, err := test.CreateTestProviderProfile(map[string]interface{}{
+				"user_id":     user.ID,
+				"currency_id": testCtx.currency.ID,
+			})
+			assert.NoError(t, err)
+
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   otherProvider,
+				"status":     "processing",
+			})
+			assert.NoError(t, err)
+
+			res := fulfill(order.ID, "pending")
+			assert.Equal(t, http.StatusNotFound, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Order not found", response.Message)
+
+			// The order and its fulfillments are left untouched
+			order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusProcessing, order.Status)
+
+			fulfillments, err := order.QueryFulfillments().Count(context.Background())
+			assert.NoError(t, err)
+			assert.Zero(t, fulfillments)
+		})
+
+		t.Run("when the order is not being processed", func(t *testing.T) {
+			for _, status := range []string{"pending", "cancelled"} {
+				order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+					"gateway_id": uuid.New().String(),
+					"provider":   testCtx.provider,
+					"status":     status,
+				})
+				assert.NoError(t, err)
+
+				res := fulfill(order.ID, "pending")
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+
+				var response types.Response
+				err = json.Unmarshal(res.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "Order is not being processed", response.Message)
+
+				order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+				assert.NoError(t, err)
+				assert.Equal(t, lockpaymentorder.Status(status), order.Status)
+			}
+		})
+
+		t.Run("when the order is already validated", func(t *testing.T) {
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   testCtx.provider,
+				"status":     "validated",
+			})
+			assert.NoError(t, err)
+
+			res := fulfill(order.ID, "success")
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Order already validated", response.Message)
+		})
+	})
+
+	t.Run("BatchFulfillOrders", func(t *testing.T) {
+		order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"gateway_id": uuid.New().String(),
+			"provider":   testCtx.provider,
+			"status":     "processing",
+		})
+		assert.NoError(t, err)
+
+		// Orders of other providers and orders that aren't being processed are rejected
+		otherUser, err := test.CreateTestUser(map[string]interface{}{
+			"email": "other_batch_provider@test.com",
+			"scope": "provider",
+		})
+		assert.NoError(t, err)
+
+		otherProvider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     otherUser.ID,
+			"currency_id": testCtx.currency.ID,
+		})
+		assert.NoError(t, err)
+
+		otherOrder, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"gateway_id": uuid.New().String(),
+			"provider":   otherProvider,
+			"status":     "processing",
+		})
+		assert.NoError(t, err)
+
+		cancelledOrder, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"gateway_id": uuid.New().String(),
+			"provider":   testCtx.provider,
+			"status":     "cancelled",
+		})
+		assert.NoError(t, err)
+
+		txID := "0x456" + fmt.Sprint(rand.Intn(1000000))
+
+		var payload = map[string]interface{}{
+			"timestamp": time.Now().Unix(),
+			"orders": []map[string]interface{}{
+				{"orderId": "test", "txId": "0x1", "psp": "psp-name"},
+				{"orderId": order.ID.String(), "txId": txID, "psp": "psp-name"},
+				{"orderId": order.ID.String(), "txId": txID, "psp": "psp-name"},
+				{"orderId": otherOrder.ID.String(), "txId": "0x4" + fmt.Sprint(rand.Intn(1000000)), "psp": "psp-name"},
+				{"orderId": cancelledOrder.ID.String(), "txId": "0x5" + fmt.Sprint(rand.Intn(1000000)), "psp": "psp-name"},
+			},
+		}
+
+		signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+		headers := map[string]string{
+			"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+		}
+
+		// Nodes must declare the batch fulfillment capability to use the batch endpoints
+		_, err = db.Client.ProviderProfile.
+			UpdateOneID(testCtx.provider.ID).
+			ClearNodeCapabilities().
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		res, err := test.PerformRequest(t, "POST", "/orders/batch/fulfill", payload, headers, router)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		_, err = db.Client.ProviderProfile.
+			UpdateOneID(testCtx.provider.ID).
+			SetNodeCapabilities([]string{"batch_fulfillment"}).
+			Save(context.Background())
+		assert.NoError(t, err)
+
+		res, err = test.PerformRequest(t, "POST", "/orders/batch/fulfill", payload, headers, router)
+		assert.NoError(t, err)
+
+		// Assert the response body
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var response struct {
+			Data types.BatchOrderResponse `json:"data"`
+		}
+		err = json.Unmarshal(res.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.Data.Succeeded)
+		assert.Equal(t, 4, response.Data.Failed)
+		assert.Equal(t, "Invalid Order ID", response.Data.Results[0].Message)
+		assert.Equal(t, "success", response.Data.Results[1].Status)
+		assert.Equal(t, "Duplicate Order ID in batch", response.Data.Results[2].Message)
+		assert.Equal(t, "Order not found", response.Data.Results[3].Message)
+		assert.Equal(t, "Order is not being processed", response.Data.Results[4].Message)
+
+		order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, lockpaymentorder.StatusFulfilled, order.Status)
+	})
+
+	t.Run("FulfillOrderWithProof", func(t *testing.T) {
+		blobs, err := db.NewLocalBlobStore(t.TempDir())
+		assert.NoError(t, err)
+		db.Blobs = blobs
+
+		order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"gateway_id": uuid.New().String(),
+			"provider":   testCtx.provider,
+			"status":     "processing",
+		})
+		assert.NoError(t, err)
+
+		txID := "0x789" + fmt.Sprint(rand.Intn(1000000))
+		receipt := []byte("%PDF-1.4 test receipt")
+
+		var payload = map[string]interface{}{
+			"timestamp":     time.Now().Unix(),
+			"txId":          txID,
+			"psp":           "psp-name",
+			"pspSessionId":  "session-123",
+			"bankReference": "NIP-0001",
+			"transferredAt": time.Now().UTC().Format(time.RFC3339),
+			"receipt": map[string]interface{}{
+				"contentType": "application/pdf",
+				"data":        base64.StdEncoding.EncodeToString(receipt),
+			},
+			"recipientConfirmation": map[string]interface{}{
+				"accountName": "John Doe",
+			},
+		}
+
+		signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+		headers := map[string]string{
+			"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+		}
+
+		res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/orders/%s/fulfill", order.ID), payload, headers, router)
+		assert.NoError(t, err)
+
+		// Assert the response body
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		// Assert the proof was stored on the fulfillment
+		fulfillment, err := db.Client.LockOrderFulfillment.
+			Query().
+			Where(lockorderfulfillment.TxIDEQ(txID)).
+			Only(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "session-123", fulfillment.PspSessionID)
+		assert.Equal(t, "NIP-0001", fulfillment.BankReference)
+		assert.False(t, fulfillment.TransferredAt.IsZero())
+		assert.Equal(t, "application/pdf", fulfillment.ReceiptContentType)
+		assert.Equal(t, "John Doe", fulfillment.RecipientConfirmation["accountName"])
+
+		stored, err := db.Blobs.Get(context.Background(), fulfillment.ReceiptKey)
+		assert.NoError(t, err)
+		defer stored.Close()
+		storedReceipt, err := io.ReadAll(stored)
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, storedReceipt)
+	})
+
+	t.Run("FulfillOrderWithPSPValidation", func(t *testing.T) {
+		mock := psp.NewMockAdapter()
+		psp.Register(psp.MockPSPName, mock)
+		defer psp.Unregister(psp.MockPSPName)
+
+		_, err := testCtx.currency.Update().
+			SetFulfillmentValidation(fiatcurrency.FulfillmentValidationPspRequired).
+			Save(context.Background())
+		assert.NoError(t, err)
+		defer func() {
+			_, err := testCtx.currency.Update().
+				SetFulfillmentValidation(fiatcurrency.FulfillmentValidationProvider).
+				Save(context.Background())
+			assert.NoError(t, err)
+		}()
+
+		fulfill := func(orderID uuid.UUID, txID string, pspName string) *httptest.ResponseRecorder {
+			var payload = map[string]interface{}{
+				"timestamp":        time.Now().Unix(),
+				"txId":             txID,
+				"psp":              pspName,
+				"validationStatus": "success",
+			}
+
+			signature := token.GenerateHMACSignature(payload, testCtx.apiKeySecret)
+
+			headers := map[string]string{
+				"Authorization": "HMAC " + testCtx.apiKey.ID.String() + ":" + signature,
+			}
+
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/orders/%s/fulfill", orderID), payload, headers, router)
+			assert.NoError(t, err)
+			return res
+		}
+
+		createOrder := func() *ent.LockPaymentOrder {
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"gateway_id": uuid.New().String(),
+				"provider":   testCtx.provider,
+				"status":     "fulfilled",
+			})
+			assert.NoError(t, err)
+			return order
+		}
+
+		t.Run("when PSP has no adapter", func(t *testing.T) {
+			order := createOrder()
+
+			res := fulfill(order.ID, "0x"+fmt.Sprint(rand.Intn(1000000)), "psp-name")
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			order, err := db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusFulfilled, order.Status)
+		})
+
+		t.Run("when transfer is pending at the PSP", func(t *testing.T) {
+			order := createOrder()
+
+			res := fulfill(order.ID, "pending-"+fmt.Sprint(rand.Intn(1000000)), "mock")
+			assert.Equal(t, http.StatusConflict, res.Code)
+
+			order, err := db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusFulfilled, order.Status)
+		})
+
+		t.Run("when transfer failed at the PSP", func(t *testing.T) {
+			order := createOrder()
+			txID := "failed-" + fmt.Sprint(rand.Intn(1000000))
+
+			res := fulfill(order.ID, txID, "mock")
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			fulfillment, err := db.Client.LockOrderFulfillment.
+				Query().
+				Where(lockorderfulfillment.TxIDEQ(txID)).
+				Only(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, lockorderfulfillment.ValidationStatusFailed, fulfillment.ValidationStatus)
+			assert.Contains(t, fulfillment.ValidationError, "Transfer not confirmed by the PSP")
+
+			order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusFulfilled, order.Status)
+		})
+
+		t.Run("when transfer doesn't match the order", func(t *testing.T) {
+			order := createOrder()
+			txID := "0x" + fmt.Sprint(rand.Intn(1000000))
+			mock.SetTransfer(txID, types.PSPTransferStatus{
+				State:             types.PSPTransferSuccess,
+				Amount:            utils.OrderPayoutAmount(order.Amount, order.Rate),
+				Currency:          testCtx.currency.Code,
+				AccountIdentifier: "0987654321",
+			})
+
+			res := fulfill(order.ID, txID, "mock")
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			fulfillment, err := db.Client.LockOrderFulfillment.
+				Query().
+				Where(lockorderfulfillment.TxIDEQ(txID)).
+				Only(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, lockorderfulfillment.ValidationStatusFailed, fulfillment.ValidationStatus)
+			assert.Contains(t, fulfillment.ValidationError, "beneficiary account does not match")
+
+			order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusFulfilled, order.Status)
+		})
+
+		t.Run("when transfer is confirmed by the PSP", func(t *testing.T) {
+			order := createOrder()
+			txID := "0x" + fmt.Sprint(rand.Intn(1000000))
+			mock.SetTransfer(txID, types.PSPTransferStatus{
+				State:             types.PSPTransferSuccess,
+				Amount:            utils.OrderPayoutAmount(order.Amount, order.Rate),
+				Currency:          testCtx.currency.Code,
+				AccountIdentifier: order.AccountIdentifier,
+			})
+
+			res := fulfill(order.ID, txID, "Mock")
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			fulfillment, err := db.Client.LockOrderFulfillment.
+				Query().
+				Where(lockorderfulfillment.TxIDEQ(txID)).
+				Only(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, lockorderfulfillment.ValidationStatusSuccess, fulfillment.ValidationStatus)
+			assert.False(t, fulfillment.PspConfirmedAt.IsZero())
+
+			order, err = db.Client.LockPaymentOrder.Get(context.Background(), order.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, lockpaymentorder.StatusValidated, order.Status)
+		})
 	})
 
 }
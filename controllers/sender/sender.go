@@ -1,6 +1,8 @@
 package sender
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
@@ -11,9 +13,14 @@ import (
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/storage"
 
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
 	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
 	providerprofile "github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
@@ -31,14 +38,18 @@ import (
 
 // SenderController is a controller type for sender endpoints
 type SenderController struct {
-	receiveAddressService *svc.ReceiveAddressService
+	receiveAddressService   *svc.ReceiveAddressService
+	disputeService          *svc.DisputeService
+	fulfillmentProofService *svc.FulfillmentProofService
 }
 
 // NewSenderController creates a new instance of SenderController
 func NewSenderController() *SenderController {
 
 	return &SenderController{
-		receiveAddressService: svc.NewReceiveAddressService(),
+		receiveAddressService:   svc.NewReceiveAddressService(),
+		disputeService:          svc.NewDisputeService(),
+		fulfillmentProofService: svc.NewFulfillmentProofService(),
 	}
 }
 
@@ -502,6 +513,26 @@ func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
 		return
 	}
 
+	// Include the proof attached by the providers that fulfilled the order
+	var fulfillments []types.FulfillmentProofResponse
+	if paymentOrder.GatewayID != "" {
+		lockOrderFulfillments, err := storage.Client.LockOrderFulfillment.
+			Query().
+			Where(lockorderfulfillment.HasOrderWith(lockpaymentorder.GatewayIDEQ(paymentOrder.GatewayID))).
+			Order(ent.Asc(lockorderfulfillment.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch payment order", nil)
+			return
+		}
+
+		for _, fulfillment := range lockOrderFulfillments {
+			receiptURL := fmt.Sprintf("%s/v1/sender/orders/%s/fulfillments/%s/receipt", serverConf.HostDomain, paymentOrder.ID, fulfillment.ID)
+			fulfillments = append(fulfillments, ctrl.fulfillmentProofService.ProofResponse(fulfillment, receiptURL))
+		}
+	}
+
 	u.APIResponse(ctx, http.StatusOK, "success", "The order has been successfully retrieved", &types.PaymentOrderResponse{
 		ID:             paymentOrder.ID,
 		Amount:         paymentOrder.Amount,
@@ -531,9 +562,82 @@ func (ctrl *SenderController) GetPaymentOrderByID(ctx *gin.Context) {
 		UpdatedAt:      paymentOrder.UpdatedAt,
 		TxHash:         paymentOrder.TxHash,
 		Status:         paymentOrder.Status,
+		Fulfillments:   fulfillments,
 	})
 }
 
+// GetFulfillmentReceipt controller downloads the receipt a provider attached to the fulfillment of a payment order
+func (ctrl *SenderController) GetFulfillmentReceipt(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	fulfillmentID, err := uuid.Parse(ctx.Param("fulfillment_id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid fulfillment ID", nil)
+		return
+	}
+
+	paymentOrder, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+			paymentorder.GatewayIDNEQ(""),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch receipt", nil)
+		}
+		return
+	}
+
+	fulfillment, err := storage.Client.LockOrderFulfillment.
+		Query().
+		Where(
+			lockorderfulfillment.IDEQ(fulfillmentID),
+			lockorderfulfillment.HasOrderWith(lockpaymentorder.GatewayIDEQ(paymentOrder.GatewayID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Receipt not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch receipt", nil)
+		}
+		return
+	}
+
+	receipt, err := ctrl.fulfillmentProofService.OpenReceipt(ctx, fulfillment)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Receipt not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch receipt", nil)
+		}
+		return
+	}
+	defer receipt.Close()
+
+	ctx.DataFromReader(http.StatusOK, -1, fulfillment.ReceiptContentType, receipt, nil)
+}
+
 // GetPaymentOrders controller fetches all payment orders
 func (ctrl *SenderController) GetPaymentOrders(ctx *gin.Context) {
 	// Get sender profile from the context
@@ -760,3 +864,241 @@ func (ctrl *SenderController) Stats(ctx *gin.Context) {
 		TotalFeeEarnings: w[0].SumFieldSenderFee,
 	})
 }
+
+// OpenDispute controller opens a dispute on a payment order whose fulfillment was validated by the provider
+func (ctrl *SenderController) OpenDispute(ctx *gin.Context) {
+	var payload types.NewDisputePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	orderID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid order ID", nil)
+		return
+	}
+
+	paymentOrder, err := storage.Client.PaymentOrder.
+		Query().
+		Where(
+			paymentorder.IDEQ(orderID),
+			paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Payment order not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to open dispute", nil)
+		}
+		return
+	}
+
+	// Only one dispute can be active on a payment order at a time
+	hasActiveDispute, err := paymentOrder.QueryDisputes().
+		Where(dispute.StatusIn(dispute.StatusOpen, dispute.StatusUnderReview)).
+		Exist(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to open dispute", nil)
+		return
+	}
+	if hasActiveDispute {
+		u.APIResponse(ctx, http.StatusConflict, "error", "Payment order already has an active dispute", nil)
+		return
+	}
+
+	// Disputes can only be raised on lock orders the provider has validated
+	lockOrderQuery := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.GatewayIDEQ(paymentOrder.GatewayID),
+			lockpaymentorder.StatusIn(lockpaymentorder.StatusValidated, lockpaymentorder.StatusSettled),
+		)
+	if payload.LockOrderID != "" {
+		lockOrderQuery = lockOrderQuery.Where(lockpaymentorder.IDEQ(uuid.MustParse(payload.LockOrderID)))
+	}
+
+	lockOrders, err := lockOrderQuery.
+		WithProvider().
+		All(ctx)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to open dispute", nil)
+		return
+	}
+
+	if len(lockOrders) == 0 {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Payment order has no validated fulfillment to dispute", nil)
+		return
+	} else if len(lockOrders) > 1 {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Payment order was split across providers, specify the lockOrderId being disputed", nil)
+		return
+	}
+
+	if lockOrders[0].UpdatedAt.Before(time.Now().Add(-orderConf.DisputeWindow)) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "The dispute window for this payment order has closed", nil)
+		return
+	}
+
+	d, err := ctrl.disputeService.OpenDispute(ctx, paymentOrder, lockOrders[0], payload)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to open dispute", nil)
+		return
+	}
+
+	d, err = ctrl.disputeService.GetDispute(ctx, dispute.IDEQ(d.ID))
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to open dispute", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Dispute opened successfully", svc.DisputeResponse(d))
+}
+
+// GetDisputes controller fetches the disputes opened by the sender
+func (ctrl *SenderController) GetDisputes(ctx *gin.Context) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	page, offset, pageSize := u.Paginate(ctx)
+
+	predicates := []predicate.Dispute{
+		dispute.HasPaymentOrderWith(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))),
+	}
+	if status := ctx.Query("status"); status != "" {
+		if err := dispute.StatusValidator(dispute.Status(status)); err != nil {
+			u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute status", nil)
+			return
+		}
+		predicates = append(predicates, dispute.StatusEQ(dispute.Status(status)))
+	}
+
+	disputes, err := ctrl.disputeService.GetDisputes(ctx, page, offset, pageSize, predicates...)
+	if err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch disputes", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Disputes fetched successfully", disputes)
+}
+
+// GetDispute controller fetches a dispute opened by the sender
+func (ctrl *SenderController) GetDispute(ctx *gin.Context) {
+	d, ok := ctrl.getSenderDispute(ctx)
+	if !ok {
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dispute fetched successfully", svc.DisputeResponse(d))
+}
+
+// SubmitDisputeEvidence controller attaches the sender's evidence to a dispute
+func (ctrl *SenderController) SubmitDisputeEvidence(ctx *gin.Context) {
+	var payload types.DisputeEvidencePayload
+
+	if err := ctx.ShouldBindJSON(&payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusBadRequest, "error",
+			"Failed to validate payload", u.GetErrorData(err))
+		return
+	}
+
+	d, ok := ctrl.getSenderDispute(ctx)
+	if !ok {
+		return
+	}
+
+	if !svc.IsDisputeActive(d) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Dispute is closed", nil)
+		return
+	}
+
+	if err := ctrl.disputeService.AddEvidence(ctx, d, disputeevidence.SubmittedBySender, payload); err != nil {
+		logger.Errorf("error: %v", err)
+		u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to submit evidence", nil)
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusCreated, "success", "Evidence submitted successfully", nil)
+}
+
+// WithdrawDispute controller withdraws a dispute opened by the sender
+func (ctrl *SenderController) WithdrawDispute(ctx *gin.Context) {
+	d, ok := ctrl.getSenderDispute(ctx)
+	if !ok {
+		return
+	}
+
+	if !svc.CanTransitionDispute(d.Status, dispute.StatusWithdrawn) {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Dispute is closed", nil)
+		return
+	}
+
+	if err := ctrl.disputeService.WithdrawDispute(ctx, d); err != nil {
+		if errors.Is(err, svc.ErrDisputeNotActive) {
+			u.APIResponse(ctx, http.StatusConflict, "error", "Dispute is closed", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to withdraw dispute", nil)
+		}
+		return
+	}
+
+	u.APIResponse(ctx, http.StatusOK, "success", "Dispute withdrawn successfully", nil)
+}
+
+// getSenderDispute fetches the dispute in the URL if it was opened by the sender in the context.
+// It writes the error response and returns false if the dispute can't be fetched.
+func (ctrl *SenderController) getSenderDispute(ctx *gin.Context) (*ent.Dispute, bool) {
+	// Get sender profile from the context
+	senderCtx, ok := ctx.Get("sender")
+	if !ok {
+		u.APIResponse(ctx, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		return nil, false
+	}
+	sender := senderCtx.(*ent.SenderProfile)
+
+	disputeID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		u.APIResponse(ctx, http.StatusBadRequest, "error", "Invalid dispute ID", nil)
+		return nil, false
+	}
+
+	d, err := ctrl.disputeService.GetDispute(ctx,
+		dispute.IDEQ(disputeID),
+		dispute.HasPaymentOrderWith(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(sender.ID))),
+	)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			u.APIResponse(ctx, http.StatusNotFound, "error", "Dispute not found", nil)
+		} else {
+			logger.Errorf("error: %v", err)
+			u.APIResponse(ctx, http.StatusInternalServerError, "error", "Failed to fetch dispute", nil)
+		}
+		return nil, false
+	}
+
+	return d, true
+}
@@ -20,9 +20,11 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/gin-gonic/gin"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/enttest"
 	"github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/senderprofile"
 	"github.com/paycrest/aggregator/utils/test"
 	"github.com/paycrest/aggregator/utils/token"
 	"github.com/stretchr/testify/assert"
@@ -134,6 +136,8 @@ func TestSender(t *testing.T) {
 	router.GET("/sender/orders/:id", ctrl.GetPaymentOrderByID)
 	router.GET("/sender/orders", ctrl.GetPaymentOrders)
 	router.GET("/sender/stats", ctrl.Stats)
+	router.POST("/sender/orders/:id/disputes", ctrl.OpenDispute)
+	router.POST("/sender/disputes/:id/withdraw", ctrl.WithdrawDispute)
 
 	var paymentOrderUUID uuid.UUID
 
@@ -645,4 +649,112 @@ func TestSender(t *testing.T) {
 			assert.Equal(t, 0, totalFeeEarnings.Cmp(decimal.NewFromFloat(0.666667)))
 		})
 	})
+
+	t.Run("Disputes", func(t *testing.T) {
+		headers := map[string]string{
+			"API-Key": testCtx.apiKey.ID.String(),
+		}
+
+		// Set up a payment order fulfilled by a provider
+		paymentOrder, err := db.Client.PaymentOrder.
+			Query().
+			Where(paymentorder.HasSenderProfileWith(senderprofile.IDEQ(testCtx.user.ID))).
+			First(context.Background())
+		assert.NoError(t, err)
+
+		gatewayID := uuid.New().String()
+		_, err = paymentOrder.Update().SetGatewayID(gatewayID).Save(context.Background())
+		assert.NoError(t, err)
+
+		payload := map[string]interface{}{
+			"reason": "Recipient did not receive the funds",
+			"evidence": []map[string]interface{}{
+				{
+					"type":      "bank_statement",
+					"reference": "STMT-2024-001",
+					"note":      "No credit on the recipient's statement",
+				},
+			},
+		}
+
+		t.Run("when the order has no validated fulfillment", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/disputes", paymentOrder.ID), payload, headers, router)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Payment order has no validated fulfillment to dispute", response.Message)
+		})
+
+		providerUser, err := test.CreateTestUser(map[string]interface{}{
+			"email": "provider@test.com",
+			"scope": "provider",
+		})
+		assert.NoError(t, err)
+
+		currency, err := db.Client.FiatCurrency.Query().First(context.Background())
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     providerUser.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		_, err = test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"gateway_id": gatewayID,
+			"status":     "validated",
+			"provider":   provider,
+			"tokenID":    testCtx.token.ID,
+		})
+		assert.NoError(t, err)
+
+		var disputeID string
+
+		t.Run("when the order has a validated fulfillment", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/disputes", paymentOrder.ID), payload, headers, router)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusCreated, res.Code)
+
+			var response types.Response
+			err = json.Unmarshal(res.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, "Dispute opened successfully", response.Message)
+			data, ok := response.Data.(map[string]interface{})
+			assert.True(t, ok, "response.Data is of not type map[string]interface{}")
+			assert.Equal(t, "open", data["status"])
+			assert.Equal(t, provider.ID, data["providerId"])
+			assert.Len(t, data["evidence"], 1)
+
+			disputeID = data["id"].(string)
+		})
+
+		t.Run("when the order already has an active dispute", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/orders/%s/disputes", paymentOrder.ID), payload, headers, router)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusConflict, res.Code)
+		})
+
+		t.Run("when the dispute is withdrawn", func(t *testing.T) {
+			res, err := test.PerformRequest(t, "POST", fmt.Sprintf("/sender/disputes/%s/withdraw", disputeID), nil, headers, router)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusOK, res.Code)
+
+			d, err := db.Client.Dispute.Get(context.Background(), uuid.MustParse(disputeID))
+			assert.NoError(t, err)
+			assert.Equal(t, dispute.StatusWithdrawn, d.Status)
+
+			// A withdrawn dispute can't be withdrawn again
+			res, err = test.PerformRequest(t, "POST", fmt.Sprintf("/sender/disputes/%s/withdraw", disputeID), nil, headers, router)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusBadRequest, res.Code)
+		})
+	})
 }
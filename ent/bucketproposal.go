@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/shopspring/decimal"
+)
+
+// BucketProposal is the model entity for the BucketProposal schema.
+type BucketProposal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// WindowStart holds the value of the "window_start" field.
+	WindowStart time.Time `json:"window_start,omitempty"`
+	// WindowEnd holds the value of the "window_end" field.
+	WindowEnd time.Time `json:"window_end,omitempty"`
+	// OrderCount holds the value of the "order_count" field.
+	OrderCount int `json:"order_count,omitempty"`
+	// Buckets holds the value of the "buckets" field.
+	Buckets []struct {
+		MinAmount  decimal.Decimal "json:\"minAmount\""
+		MaxAmount  decimal.Decimal "json:\"maxAmount\""
+		OrderCount int             "json:\"orderCount\""
+	} `json:"buckets,omitempty"`
+	// CurrentBuckets holds the value of the "current_buckets" field.
+	CurrentBuckets []struct {
+		ID         int             "json:\"id\""
+		MinAmount  decimal.Decimal "json:\"minAmount\""
+		MaxAmount  decimal.Decimal "json:\"maxAmount\""
+		OrderCount int             "json:\"orderCount\""
+	} `json:"current_buckets,omitempty"`
+	// Status holds the value of the "status" field.
+	Status bucketproposal.Status `json:"status,omitempty"`
+	// AppliedAt holds the value of the "applied_at" field.
+	AppliedAt time.Time `json:"applied_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BucketProposalQuery when eager-loading is set.
+	Edges                          BucketProposalEdges `json:"edges"`
+	fiat_currency_bucket_proposals *uuid.UUID
+	selectValues                   sql.SelectValues
+}
+
+// BucketProposalEdges holds the relations/edges for other nodes in the graph.
+type BucketProposalEdges struct {
+	// Currency holds the value of the currency edge.
+	Currency *FiatCurrency `json:"currency,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CurrencyOrErr returns the Currency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BucketProposalEdges) CurrencyOrErr() (*FiatCurrency, error) {
+	if e.Currency != nil {
+		return e.Currency, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "currency"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BucketProposal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bucketproposal.FieldBuckets, bucketproposal.FieldCurrentBuckets:
+			values[i] = new([]byte)
+		case bucketproposal.FieldOrderCount:
+			values[i] = new(sql.NullInt64)
+		case bucketproposal.FieldStatus:
+			values[i] = new(sql.NullString)
+		case bucketproposal.FieldCreatedAt, bucketproposal.FieldUpdatedAt, bucketproposal.FieldWindowStart, bucketproposal.FieldWindowEnd, bucketproposal.FieldAppliedAt:
+			values[i] = new(sql.NullTime)
+		case bucketproposal.FieldID:
+			values[i] = new(uuid.UUID)
+		case bucketproposal.ForeignKeys[0]: // fiat_currency_bucket_proposals
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BucketProposal fields.
+func (bp *BucketProposal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bucketproposal.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				bp.ID = *value
+			}
+		case bucketproposal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bp.CreatedAt = value.Time
+			}
+		case bucketproposal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bp.UpdatedAt = value.Time
+			}
+		case bucketproposal.FieldWindowStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_start", values[i])
+			} else if value.Valid {
+				bp.WindowStart = value.Time
+			}
+		case bucketproposal.FieldWindowEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_end", values[i])
+			} else if value.Valid {
+				bp.WindowEnd = value.Time
+			}
+		case bucketproposal.FieldOrderCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_count", values[i])
+			} else if value.Valid {
+				bp.OrderCount = int(value.Int64)
+			}
+		case bucketproposal.FieldBuckets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field buckets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bp.Buckets); err != nil {
+					return fmt.Errorf("unmarshal field buckets: %w", err)
+				}
+			}
+		case bucketproposal.FieldCurrentBuckets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field current_buckets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bp.CurrentBuckets); err != nil {
+					return fmt.Errorf("unmarshal field current_buckets: %w", err)
+				}
+			}
+		case bucketproposal.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				bp.Status = bucketproposal.Status(value.String)
+			}
+		case bucketproposal.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				bp.AppliedAt = value.Time
+			}
+		case bucketproposal.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_bucket_proposals", values[i])
+			} else if value.Valid {
+				bp.fiat_currency_bucket_proposals = new(uuid.UUID)
+				*bp.fiat_currency_bucket_proposals = *value.S.(*uuid.UUID)
+			}
+		default:
+			bp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BucketProposal.
+// This includes values selected through modifiers, order, etc.
+func (bp *BucketProposal) Value(name string) (ent.Value, error) {
+	return bp.selectValues.Get(name)
+}
+
+// QueryCurrency queries the "currency" edge of the BucketProposal entity.
+func (bp *BucketProposal) QueryCurrency() *FiatCurrencyQuery {
+	return NewBucketProposalClient(bp.config).QueryCurrency(bp)
+}
+
+// Update returns a builder for updating this BucketProposal.
+// Note that you need to call BucketProposal.Unwrap() before calling this method if this BucketProposal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bp *BucketProposal) Update() *BucketProposalUpdateOne {
+	return NewBucketProposalClient(bp.config).UpdateOne(bp)
+}
+
+// Unwrap unwraps the BucketProposal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bp *BucketProposal) Unwrap() *BucketProposal {
+	_tx, ok := bp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BucketProposal is not a transactional entity")
+	}
+	bp.config.driver = _tx.drv
+	return bp
+}
+
+// String implements the fmt.Stringer.
+func (bp *BucketProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString("BucketProposal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(bp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("window_start=")
+	builder.WriteString(bp.WindowStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("window_end=")
+	builder.WriteString(bp.WindowEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("order_count=")
+	builder.WriteString(fmt.Sprintf("%v", bp.OrderCount))
+	builder.WriteString(", ")
+	builder.WriteString("buckets=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Buckets))
+	builder.WriteString(", ")
+	builder.WriteString("current_buckets=")
+	builder.WriteString(fmt.Sprintf("%v", bp.CurrentBuckets))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Status))
+	builder.WriteString(", ")
+	builder.WriteString("applied_at=")
+	builder.WriteString(bp.AppliedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BucketProposals is a parsable slice of BucketProposal.
+type BucketProposals []*BucketProposal
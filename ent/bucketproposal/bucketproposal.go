@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bucketproposal
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the bucketproposal type in the database.
+	Label = "bucket_proposal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldWindowStart holds the string denoting the window_start field in the database.
+	FieldWindowStart = "window_start"
+	// FieldWindowEnd holds the string denoting the window_end field in the database.
+	FieldWindowEnd = "window_end"
+	// FieldOrderCount holds the string denoting the order_count field in the database.
+	FieldOrderCount = "order_count"
+	// FieldBuckets holds the string denoting the buckets field in the database.
+	FieldBuckets = "buckets"
+	// FieldCurrentBuckets holds the string denoting the current_buckets field in the database.
+	FieldCurrentBuckets = "current_buckets"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// EdgeCurrency holds the string denoting the currency edge name in mutations.
+	EdgeCurrency = "currency"
+	// Table holds the table name of the bucketproposal in the database.
+	Table = "bucket_proposals"
+	// CurrencyTable is the table that holds the currency relation/edge.
+	CurrencyTable = "bucket_proposals"
+	// CurrencyInverseTable is the table name for the FiatCurrency entity.
+	// It exists in this package in order to avoid circular dependency with the "fiatcurrency" package.
+	CurrencyInverseTable = "fiat_currencies"
+	// CurrencyColumn is the table column denoting the currency relation/edge.
+	CurrencyColumn = "fiat_currency_bucket_proposals"
+)
+
+// Columns holds all SQL columns for bucketproposal fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldWindowStart,
+	FieldWindowEnd,
+	FieldOrderCount,
+	FieldBuckets,
+	FieldCurrentBuckets,
+	FieldStatus,
+	FieldAppliedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "bucket_proposals"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"fiat_currency_bucket_proposals",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending    Status = "pending"
+	StatusApplied    Status = "applied"
+	StatusDismissed  Status = "dismissed"
+	StatusSuperseded Status = "superseded"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApplied, StatusDismissed, StatusSuperseded:
+		return nil
+	default:
+		return fmt.Errorf("bucketproposal: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BucketProposal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByWindowStart orders the results by the window_start field.
+func ByWindowStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowStart, opts...).ToFunc()
+}
+
+// ByWindowEnd orders the results by the window_end field.
+func ByWindowEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowEnd, opts...).ToFunc()
+}
+
+// ByOrderCount orders the results by the order_count field.
+func ByOrderCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderCount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAppliedAt orders the results by the applied_at field.
+func ByAppliedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedAt, opts...).ToFunc()
+}
+
+// ByCurrencyField orders the results by currency field.
+func ByCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrencyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCurrencyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrencyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+	)
+}
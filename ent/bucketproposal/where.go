@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bucketproposal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// WindowStart applies equality check predicate on the "window_start" field. It's identical to WindowStartEQ.
+func WindowStart(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowEnd applies equality check predicate on the "window_end" field. It's identical to WindowEndEQ.
+func WindowEnd(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// OrderCount applies equality check predicate on the "order_count" field. It's identical to OrderCountEQ.
+func OrderCount(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldOrderCount, v))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// WindowStartEQ applies the EQ predicate on the "window_start" field.
+func WindowStartEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowStartNEQ applies the NEQ predicate on the "window_start" field.
+func WindowStartNEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldWindowStart, v))
+}
+
+// WindowStartIn applies the In predicate on the "window_start" field.
+func WindowStartIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldWindowStart, vs...))
+}
+
+// WindowStartNotIn applies the NotIn predicate on the "window_start" field.
+func WindowStartNotIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldWindowStart, vs...))
+}
+
+// WindowStartGT applies the GT predicate on the "window_start" field.
+func WindowStartGT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldWindowStart, v))
+}
+
+// WindowStartGTE applies the GTE predicate on the "window_start" field.
+func WindowStartGTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldWindowStart, v))
+}
+
+// WindowStartLT applies the LT predicate on the "window_start" field.
+func WindowStartLT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldWindowStart, v))
+}
+
+// WindowStartLTE applies the LTE predicate on the "window_start" field.
+func WindowStartLTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldWindowStart, v))
+}
+
+// WindowEndEQ applies the EQ predicate on the "window_end" field.
+func WindowEndEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// WindowEndNEQ applies the NEQ predicate on the "window_end" field.
+func WindowEndNEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldWindowEnd, v))
+}
+
+// WindowEndIn applies the In predicate on the "window_end" field.
+func WindowEndIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndNotIn applies the NotIn predicate on the "window_end" field.
+func WindowEndNotIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndGT applies the GT predicate on the "window_end" field.
+func WindowEndGT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldWindowEnd, v))
+}
+
+// WindowEndGTE applies the GTE predicate on the "window_end" field.
+func WindowEndGTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldWindowEnd, v))
+}
+
+// WindowEndLT applies the LT predicate on the "window_end" field.
+func WindowEndLT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldWindowEnd, v))
+}
+
+// WindowEndLTE applies the LTE predicate on the "window_end" field.
+func WindowEndLTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldWindowEnd, v))
+}
+
+// OrderCountEQ applies the EQ predicate on the "order_count" field.
+func OrderCountEQ(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldOrderCount, v))
+}
+
+// OrderCountNEQ applies the NEQ predicate on the "order_count" field.
+func OrderCountNEQ(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldOrderCount, v))
+}
+
+// OrderCountIn applies the In predicate on the "order_count" field.
+func OrderCountIn(vs ...int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldOrderCount, vs...))
+}
+
+// OrderCountNotIn applies the NotIn predicate on the "order_count" field.
+func OrderCountNotIn(vs ...int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldOrderCount, vs...))
+}
+
+// OrderCountGT applies the GT predicate on the "order_count" field.
+func OrderCountGT(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldOrderCount, v))
+}
+
+// OrderCountGTE applies the GTE predicate on the "order_count" field.
+func OrderCountGTE(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldOrderCount, v))
+}
+
+// OrderCountLT applies the LT predicate on the "order_count" field.
+func OrderCountLT(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldOrderCount, v))
+}
+
+// OrderCountLTE applies the LTE predicate on the "order_count" field.
+func OrderCountLTE(v int) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldOrderCount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// AppliedAtIsNil applies the IsNil predicate on the "applied_at" field.
+func AppliedAtIsNil() predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldIsNull(FieldAppliedAt))
+}
+
+// AppliedAtNotNil applies the NotNil predicate on the "applied_at" field.
+func AppliedAtNotNil() predicate.BucketProposal {
+	return predicate.BucketProposal(sql.FieldNotNull(FieldAppliedAt))
+}
+
+// HasCurrency applies the HasEdge predicate on the "currency" edge.
+func HasCurrency() predicate.BucketProposal {
+	return predicate.BucketProposal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
+func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.BucketProposal {
+	return predicate.BucketProposal(func(s *sql.Selector) {
+		step := newCurrencyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BucketProposal) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BucketProposal) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BucketProposal) predicate.BucketProposal {
+	return predicate.BucketProposal(sql.NotPredicates(p))
+}
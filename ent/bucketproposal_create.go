@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/shopspring/decimal"
+)
+
+// BucketProposalCreate is the builder for creating a BucketProposal entity.
+type BucketProposalCreate struct {
+	config
+	mutation *BucketProposalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bpc *BucketProposalCreate) SetCreatedAt(t time.Time) *BucketProposalCreate {
+	bpc.mutation.SetCreatedAt(t)
+	return bpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bpc *BucketProposalCreate) SetNillableCreatedAt(t *time.Time) *BucketProposalCreate {
+	if t != nil {
+		bpc.SetCreatedAt(*t)
+	}
+	return bpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpc *BucketProposalCreate) SetUpdatedAt(t time.Time) *BucketProposalCreate {
+	bpc.mutation.SetUpdatedAt(t)
+	return bpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (bpc *BucketProposalCreate) SetNillableUpdatedAt(t *time.Time) *BucketProposalCreate {
+	if t != nil {
+		bpc.SetUpdatedAt(*t)
+	}
+	return bpc
+}
+
+// SetWindowStart sets the "window_start" field.
+func (bpc *BucketProposalCreate) SetWindowStart(t time.Time) *BucketProposalCreate {
+	bpc.mutation.SetWindowStart(t)
+	return bpc
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (bpc *BucketProposalCreate) SetWindowEnd(t time.Time) *BucketProposalCreate {
+	bpc.mutation.SetWindowEnd(t)
+	return bpc
+}
+
+// SetOrderCount sets the "order_count" field.
+func (bpc *BucketProposalCreate) SetOrderCount(i int) *BucketProposalCreate {
+	bpc.mutation.SetOrderCount(i)
+	return bpc
+}
+
+// SetBuckets sets the "buckets" field.
+func (bpc *BucketProposalCreate) SetBuckets(saaaacc []struct {
+	MinAmount  decimal.Decimal "json:\"minAmount\""
+	MaxAmount  decimal.Decimal "json:\"maxAmount\""
+	OrderCount int             "json:\"orderCount\""
+}) *BucketProposalCreate {
+	bpc.mutation.SetBuckets(saaaacc)
+	return bpc
+}
+
+// SetCurrentBuckets sets the "current_buckets" field.
+func (bpc *BucketProposalCreate) SetCurrentBuckets(saaaacc []struct {
+	ID         int             "json:\"id\""
+	MinAmount  decimal.Decimal "json:\"minAmount\""
+	MaxAmount  decimal.Decimal "json:\"maxAmount\""
+	OrderCount int             "json:\"orderCount\""
+}) *BucketProposalCreate {
+	bpc.mutation.SetCurrentBuckets(saaaacc)
+	return bpc
+}
+
+// SetStatus sets the "status" field.
+func (bpc *BucketProposalCreate) SetStatus(b bucketproposal.Status) *BucketProposalCreate {
+	bpc.mutation.SetStatus(b)
+	return bpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpc *BucketProposalCreate) SetNillableStatus(b *bucketproposal.Status) *BucketProposalCreate {
+	if b != nil {
+		bpc.SetStatus(*b)
+	}
+	return bpc
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (bpc *BucketProposalCreate) SetAppliedAt(t time.Time) *BucketProposalCreate {
+	bpc.mutation.SetAppliedAt(t)
+	return bpc
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (bpc *BucketProposalCreate) SetNillableAppliedAt(t *time.Time) *BucketProposalCreate {
+	if t != nil {
+		bpc.SetAppliedAt(*t)
+	}
+	return bpc
+}
+
+// SetID sets the "id" field.
+func (bpc *BucketProposalCreate) SetID(u uuid.UUID) *BucketProposalCreate {
+	bpc.mutation.SetID(u)
+	return bpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bpc *BucketProposalCreate) SetNillableID(u *uuid.UUID) *BucketProposalCreate {
+	if u != nil {
+		bpc.SetID(*u)
+	}
+	return bpc
+}
+
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (bpc *BucketProposalCreate) SetCurrencyID(id uuid.UUID) *BucketProposalCreate {
+	bpc.mutation.SetCurrencyID(id)
+	return bpc
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (bpc *BucketProposalCreate) SetCurrency(f *FiatCurrency) *BucketProposalCreate {
+	return bpc.SetCurrencyID(f.ID)
+}
+
+// Mutation returns the BucketProposalMutation object of the builder.
+func (bpc *BucketProposalCreate) Mutation() *BucketProposalMutation {
+	return bpc.mutation
+}
+
+// Save creates the BucketProposal in the database.
+func (bpc *BucketProposalCreate) Save(ctx context.Context) (*BucketProposal, error) {
+	bpc.defaults()
+	return withHooks(ctx, bpc.sqlSave, bpc.mutation, bpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bpc *BucketProposalCreate) SaveX(ctx context.Context) *BucketProposal {
+	v, err := bpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpc *BucketProposalCreate) Exec(ctx context.Context) error {
+	_, err := bpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpc *BucketProposalCreate) ExecX(ctx context.Context) {
+	if err := bpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpc *BucketProposalCreate) defaults() {
+	if _, ok := bpc.mutation.CreatedAt(); !ok {
+		v := bucketproposal.DefaultCreatedAt()
+		bpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		v := bucketproposal.DefaultUpdatedAt()
+		bpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := bpc.mutation.Status(); !ok {
+		v := bucketproposal.DefaultStatus
+		bpc.mutation.SetStatus(v)
+	}
+	if _, ok := bpc.mutation.ID(); !ok {
+		v := bucketproposal.DefaultID()
+		bpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpc *BucketProposalCreate) check() error {
+	if _, ok := bpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BucketProposal.created_at"`)}
+	}
+	if _, ok := bpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BucketProposal.updated_at"`)}
+	}
+	if _, ok := bpc.mutation.WindowStart(); !ok {
+		return &ValidationError{Name: "window_start", err: errors.New(`ent: missing required field "BucketProposal.window_start"`)}
+	}
+	if _, ok := bpc.mutation.WindowEnd(); !ok {
+		return &ValidationError{Name: "window_end", err: errors.New(`ent: missing required field "BucketProposal.window_end"`)}
+	}
+	if _, ok := bpc.mutation.OrderCount(); !ok {
+		return &ValidationError{Name: "order_count", err: errors.New(`ent: missing required field "BucketProposal.order_count"`)}
+	}
+	if _, ok := bpc.mutation.Buckets(); !ok {
+		return &ValidationError{Name: "buckets", err: errors.New(`ent: missing required field "BucketProposal.buckets"`)}
+	}
+	if _, ok := bpc.mutation.CurrentBuckets(); !ok {
+		return &ValidationError{Name: "current_buckets", err: errors.New(`ent: missing required field "BucketProposal.current_buckets"`)}
+	}
+	if _, ok := bpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BucketProposal.status"`)}
+	}
+	if v, ok := bpc.mutation.Status(); ok {
+		if err := bucketproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BucketProposal.status": %w`, err)}
+		}
+	}
+	if len(bpc.mutation.CurrencyIDs()) == 0 {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "BucketProposal.currency"`)}
+	}
+	return nil
+}
+
+func (bpc *BucketProposalCreate) sqlSave(ctx context.Context) (*BucketProposal, error) {
+	if err := bpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	bpc.mutation.id = &_node.ID
+	bpc.mutation.done = true
+	return _node, nil
+}
+
+func (bpc *BucketProposalCreate) createSpec() (*BucketProposal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BucketProposal{config: bpc.config}
+		_spec = sqlgraph.NewCreateSpec(bucketproposal.Table, sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = bpc.conflict
+	if id, ok := bpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bpc.mutation.CreatedAt(); ok {
+		_spec.SetField(bucketproposal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(bucketproposal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := bpc.mutation.WindowStart(); ok {
+		_spec.SetField(bucketproposal.FieldWindowStart, field.TypeTime, value)
+		_node.WindowStart = value
+	}
+	if value, ok := bpc.mutation.WindowEnd(); ok {
+		_spec.SetField(bucketproposal.FieldWindowEnd, field.TypeTime, value)
+		_node.WindowEnd = value
+	}
+	if value, ok := bpc.mutation.OrderCount(); ok {
+		_spec.SetField(bucketproposal.FieldOrderCount, field.TypeInt, value)
+		_node.OrderCount = value
+	}
+	if value, ok := bpc.mutation.Buckets(); ok {
+		_spec.SetField(bucketproposal.FieldBuckets, field.TypeJSON, value)
+		_node.Buckets = value
+	}
+	if value, ok := bpc.mutation.CurrentBuckets(); ok {
+		_spec.SetField(bucketproposal.FieldCurrentBuckets, field.TypeJSON, value)
+		_node.CurrentBuckets = value
+	}
+	if value, ok := bpc.mutation.Status(); ok {
+		_spec.SetField(bucketproposal.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := bpc.mutation.AppliedAt(); ok {
+		_spec.SetField(bucketproposal.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = value
+	}
+	if nodes := bpc.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bucketproposal.CurrencyTable,
+			Columns: []string{bucketproposal.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_bucket_proposals = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BucketProposal.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BucketProposalUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bpc *BucketProposalCreate) OnConflict(opts ...sql.ConflictOption) *BucketProposalUpsertOne {
+	bpc.conflict = opts
+	return &BucketProposalUpsertOne{
+		create: bpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BucketProposal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bpc *BucketProposalCreate) OnConflictColumns(columns ...string) *BucketProposalUpsertOne {
+	bpc.conflict = append(bpc.conflict, sql.ConflictColumns(columns...))
+	return &BucketProposalUpsertOne{
+		create: bpc,
+	}
+}
+
+type (
+	// BucketProposalUpsertOne is the builder for "upsert"-ing
+	//  one BucketProposal node.
+	BucketProposalUpsertOne struct {
+		create *BucketProposalCreate
+	}
+
+	// BucketProposalUpsert is the "OnConflict" setter.
+	BucketProposalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BucketProposalUpsert) SetUpdatedAt(v time.Time) *BucketProposalUpsert {
+	u.Set(bucketproposal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BucketProposalUpsert) UpdateUpdatedAt() *BucketProposalUpsert {
+	u.SetExcluded(bucketproposal.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BucketProposalUpsert) SetStatus(v bucketproposal.Status) *BucketProposalUpsert {
+	u.Set(bucketproposal.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BucketProposalUpsert) UpdateStatus() *BucketProposalUpsert {
+	u.SetExcluded(bucketproposal.FieldStatus)
+	return u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *BucketProposalUpsert) SetAppliedAt(v time.Time) *BucketProposalUpsert {
+	u.Set(bucketproposal.FieldAppliedAt, v)
+	return u
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *BucketProposalUpsert) UpdateAppliedAt() *BucketProposalUpsert {
+	u.SetExcluded(bucketproposal.FieldAppliedAt)
+	return u
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *BucketProposalUpsert) ClearAppliedAt() *BucketProposalUpsert {
+	u.SetNull(bucketproposal.FieldAppliedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BucketProposal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bucketproposal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BucketProposalUpsertOne) UpdateNewValues() *BucketProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(bucketproposal.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(bucketproposal.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.WindowStart(); exists {
+			s.SetIgnore(bucketproposal.FieldWindowStart)
+		}
+		if _, exists := u.create.mutation.WindowEnd(); exists {
+			s.SetIgnore(bucketproposal.FieldWindowEnd)
+		}
+		if _, exists := u.create.mutation.OrderCount(); exists {
+			s.SetIgnore(bucketproposal.FieldOrderCount)
+		}
+		if _, exists := u.create.mutation.Buckets(); exists {
+			s.SetIgnore(bucketproposal.FieldBuckets)
+		}
+		if _, exists := u.create.mutation.CurrentBuckets(); exists {
+			s.SetIgnore(bucketproposal.FieldCurrentBuckets)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BucketProposal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BucketProposalUpsertOne) Ignore() *BucketProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BucketProposalUpsertOne) DoNothing() *BucketProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BucketProposalCreate.OnConflict
+// documentation for more info.
+func (u *BucketProposalUpsertOne) Update(set func(*BucketProposalUpsert)) *BucketProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BucketProposalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BucketProposalUpsertOne) SetUpdatedAt(v time.Time) *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BucketProposalUpsertOne) UpdateUpdatedAt() *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BucketProposalUpsertOne) SetStatus(v bucketproposal.Status) *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BucketProposalUpsertOne) UpdateStatus() *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *BucketProposalUpsertOne) SetAppliedAt(v time.Time) *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *BucketProposalUpsertOne) UpdateAppliedAt() *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *BucketProposalUpsertOne) ClearAppliedAt() *BucketProposalUpsertOne {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.ClearAppliedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BucketProposalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BucketProposalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BucketProposalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BucketProposalUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BucketProposalUpsertOne.ID is not supported by MySQL driver. Use BucketProposalUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BucketProposalUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BucketProposalCreateBulk is the builder for creating many BucketProposal entities in bulk.
+type BucketProposalCreateBulk struct {
+	config
+	err      error
+	builders []*BucketProposalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BucketProposal entities in the database.
+func (bpcb *BucketProposalCreateBulk) Save(ctx context.Context) ([]*BucketProposal, error) {
+	if bpcb.err != nil {
+		return nil, bpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bpcb.builders))
+	nodes := make([]*BucketProposal, len(bpcb.builders))
+	mutators := make([]Mutator, len(bpcb.builders))
+	for i := range bpcb.builders {
+		func(i int, root context.Context) {
+			builder := bpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BucketProposalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpcb *BucketProposalCreateBulk) SaveX(ctx context.Context) []*BucketProposal {
+	v, err := bpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpcb *BucketProposalCreateBulk) Exec(ctx context.Context) error {
+	_, err := bpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpcb *BucketProposalCreateBulk) ExecX(ctx context.Context) {
+	if err := bpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BucketProposal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BucketProposalUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (bpcb *BucketProposalCreateBulk) OnConflict(opts ...sql.ConflictOption) *BucketProposalUpsertBulk {
+	bpcb.conflict = opts
+	return &BucketProposalUpsertBulk{
+		create: bpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BucketProposal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bpcb *BucketProposalCreateBulk) OnConflictColumns(columns ...string) *BucketProposalUpsertBulk {
+	bpcb.conflict = append(bpcb.conflict, sql.ConflictColumns(columns...))
+	return &BucketProposalUpsertBulk{
+		create: bpcb,
+	}
+}
+
+// BucketProposalUpsertBulk is the builder for "upsert"-ing
+// a bulk of BucketProposal nodes.
+type BucketProposalUpsertBulk struct {
+	create *BucketProposalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BucketProposal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(bucketproposal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BucketProposalUpsertBulk) UpdateNewValues() *BucketProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(bucketproposal.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(bucketproposal.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.WindowStart(); exists {
+				s.SetIgnore(bucketproposal.FieldWindowStart)
+			}
+			if _, exists := b.mutation.WindowEnd(); exists {
+				s.SetIgnore(bucketproposal.FieldWindowEnd)
+			}
+			if _, exists := b.mutation.OrderCount(); exists {
+				s.SetIgnore(bucketproposal.FieldOrderCount)
+			}
+			if _, exists := b.mutation.Buckets(); exists {
+				s.SetIgnore(bucketproposal.FieldBuckets)
+			}
+			if _, exists := b.mutation.CurrentBuckets(); exists {
+				s.SetIgnore(bucketproposal.FieldCurrentBuckets)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BucketProposal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BucketProposalUpsertBulk) Ignore() *BucketProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BucketProposalUpsertBulk) DoNothing() *BucketProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BucketProposalCreateBulk.OnConflict
+// documentation for more info.
+func (u *BucketProposalUpsertBulk) Update(set func(*BucketProposalUpsert)) *BucketProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BucketProposalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BucketProposalUpsertBulk) SetUpdatedAt(v time.Time) *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BucketProposalUpsertBulk) UpdateUpdatedAt() *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BucketProposalUpsertBulk) SetStatus(v bucketproposal.Status) *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BucketProposalUpsertBulk) UpdateStatus() *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *BucketProposalUpsertBulk) SetAppliedAt(v time.Time) *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *BucketProposalUpsertBulk) UpdateAppliedAt() *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *BucketProposalUpsertBulk) ClearAppliedAt() *BucketProposalUpsertBulk {
+	return u.Update(func(s *BucketProposalUpsert) {
+		s.ClearAppliedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BucketProposalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BucketProposalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BucketProposalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BucketProposalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// BucketProposalDelete is the builder for deleting a BucketProposal entity.
+type BucketProposalDelete struct {
+	config
+	hooks    []Hook
+	mutation *BucketProposalMutation
+}
+
+// Where appends a list predicates to the BucketProposalDelete builder.
+func (bpd *BucketProposalDelete) Where(ps ...predicate.BucketProposal) *BucketProposalDelete {
+	bpd.mutation.Where(ps...)
+	return bpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bpd *BucketProposalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bpd.sqlExec, bpd.mutation, bpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpd *BucketProposalDelete) ExecX(ctx context.Context) int {
+	n, err := bpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bpd *BucketProposalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bucketproposal.Table, sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID))
+	if ps := bpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bpd.mutation.done = true
+	return affected, err
+}
+
+// BucketProposalDeleteOne is the builder for deleting a single BucketProposal entity.
+type BucketProposalDeleteOne struct {
+	bpd *BucketProposalDelete
+}
+
+// Where appends a list predicates to the BucketProposalDelete builder.
+func (bpdo *BucketProposalDeleteOne) Where(ps ...predicate.BucketProposal) *BucketProposalDeleteOne {
+	bpdo.bpd.mutation.Where(ps...)
+	return bpdo
+}
+
+// Exec executes the deletion query.
+func (bpdo *BucketProposalDeleteOne) Exec(ctx context.Context) error {
+	n, err := bpdo.bpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bucketproposal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpdo *BucketProposalDeleteOne) ExecX(ctx context.Context) {
+	if err := bpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
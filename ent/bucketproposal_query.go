@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// BucketProposalQuery is the builder for querying BucketProposal entities.
+type BucketProposalQuery struct {
+	config
+	ctx          *QueryContext
+	order        []bucketproposal.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.BucketProposal
+	withCurrency *FiatCurrencyQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BucketProposalQuery builder.
+func (bpq *BucketProposalQuery) Where(ps ...predicate.BucketProposal) *BucketProposalQuery {
+	bpq.predicates = append(bpq.predicates, ps...)
+	return bpq
+}
+
+// Limit the number of records to be returned by this query.
+func (bpq *BucketProposalQuery) Limit(limit int) *BucketProposalQuery {
+	bpq.ctx.Limit = &limit
+	return bpq
+}
+
+// Offset to start from.
+func (bpq *BucketProposalQuery) Offset(offset int) *BucketProposalQuery {
+	bpq.ctx.Offset = &offset
+	return bpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bpq *BucketProposalQuery) Unique(unique bool) *BucketProposalQuery {
+	bpq.ctx.Unique = &unique
+	return bpq
+}
+
+// Order specifies how the records should be ordered.
+func (bpq *BucketProposalQuery) Order(o ...bucketproposal.OrderOption) *BucketProposalQuery {
+	bpq.order = append(bpq.order, o...)
+	return bpq
+}
+
+// QueryCurrency chains the current query on the "currency" edge.
+func (bpq *BucketProposalQuery) QueryCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: bpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bucketproposal.Table, bucketproposal.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bucketproposal.CurrencyTable, bucketproposal.CurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BucketProposal entity from the query.
+// Returns a *NotFoundError when no BucketProposal was found.
+func (bpq *BucketProposalQuery) First(ctx context.Context) (*BucketProposal, error) {
+	nodes, err := bpq.Limit(1).All(setContextOp(ctx, bpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bucketproposal.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bpq *BucketProposalQuery) FirstX(ctx context.Context) *BucketProposal {
+	node, err := bpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BucketProposal ID from the query.
+// Returns a *NotFoundError when no BucketProposal ID was found.
+func (bpq *BucketProposalQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bpq.Limit(1).IDs(setContextOp(ctx, bpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bucketproposal.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bpq *BucketProposalQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := bpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BucketProposal entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BucketProposal entity is found.
+// Returns a *NotFoundError when no BucketProposal entities are found.
+func (bpq *BucketProposalQuery) Only(ctx context.Context) (*BucketProposal, error) {
+	nodes, err := bpq.Limit(2).All(setContextOp(ctx, bpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bucketproposal.Label}
+	default:
+		return nil, &NotSingularError{bucketproposal.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bpq *BucketProposalQuery) OnlyX(ctx context.Context) *BucketProposal {
+	node, err := bpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BucketProposal ID in the query.
+// Returns a *NotSingularError when more than one BucketProposal ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bpq *BucketProposalQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bpq.Limit(2).IDs(setContextOp(ctx, bpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bucketproposal.Label}
+	default:
+		err = &NotSingularError{bucketproposal.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bpq *BucketProposalQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := bpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BucketProposals.
+func (bpq *BucketProposalQuery) All(ctx context.Context) ([]*BucketProposal, error) {
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryAll)
+	if err := bpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BucketProposal, *BucketProposalQuery]()
+	return withInterceptors[[]*BucketProposal](ctx, bpq, qr, bpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bpq *BucketProposalQuery) AllX(ctx context.Context) []*BucketProposal {
+	nodes, err := bpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BucketProposal IDs.
+func (bpq *BucketProposalQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if bpq.ctx.Unique == nil && bpq.path != nil {
+		bpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryIDs)
+	if err = bpq.Select(bucketproposal.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bpq *BucketProposalQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := bpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bpq *BucketProposalQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryCount)
+	if err := bpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bpq, querierCount[*BucketProposalQuery](), bpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bpq *BucketProposalQuery) CountX(ctx context.Context) int {
+	count, err := bpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bpq *BucketProposalQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bpq.ctx, ent.OpQueryExist)
+	switch _, err := bpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bpq *BucketProposalQuery) ExistX(ctx context.Context) bool {
+	exist, err := bpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BucketProposalQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bpq *BucketProposalQuery) Clone() *BucketProposalQuery {
+	if bpq == nil {
+		return nil
+	}
+	return &BucketProposalQuery{
+		config:       bpq.config,
+		ctx:          bpq.ctx.Clone(),
+		order:        append([]bucketproposal.OrderOption{}, bpq.order...),
+		inters:       append([]Interceptor{}, bpq.inters...),
+		predicates:   append([]predicate.BucketProposal{}, bpq.predicates...),
+		withCurrency: bpq.withCurrency.Clone(),
+		// clone intermediate query.
+		sql:  bpq.sql.Clone(),
+		path: bpq.path,
+	}
+}
+
+// WithCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (bpq *BucketProposalQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *BucketProposalQuery {
+	query := (&FiatCurrencyClient{config: bpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bpq.withCurrency = query
+	return bpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BucketProposal.Query().
+//		GroupBy(bucketproposal.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bpq *BucketProposalQuery) GroupBy(field string, fields ...string) *BucketProposalGroupBy {
+	bpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BucketProposalGroupBy{build: bpq}
+	grbuild.flds = &bpq.ctx.Fields
+	grbuild.label = bucketproposal.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.BucketProposal.Query().
+//		Select(bucketproposal.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (bpq *BucketProposalQuery) Select(fields ...string) *BucketProposalSelect {
+	bpq.ctx.Fields = append(bpq.ctx.Fields, fields...)
+	sbuild := &BucketProposalSelect{BucketProposalQuery: bpq}
+	sbuild.label = bucketproposal.Label
+	sbuild.flds, sbuild.scan = &bpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BucketProposalSelect configured with the given aggregations.
+func (bpq *BucketProposalQuery) Aggregate(fns ...AggregateFunc) *BucketProposalSelect {
+	return bpq.Select().Aggregate(fns...)
+}
+
+func (bpq *BucketProposalQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bpq.ctx.Fields {
+		if !bucketproposal.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bpq.path != nil {
+		prev, err := bpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bpq.sql = prev
+	}
+	return nil
+}
+
+func (bpq *BucketProposalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BucketProposal, error) {
+	var (
+		nodes       = []*BucketProposal{}
+		withFKs     = bpq.withFKs
+		_spec       = bpq.querySpec()
+		loadedTypes = [1]bool{
+			bpq.withCurrency != nil,
+		}
+	)
+	if bpq.withCurrency != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, bucketproposal.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BucketProposal).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BucketProposal{config: bpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := bpq.withCurrency; query != nil {
+		if err := bpq.loadCurrency(ctx, query, nodes, nil,
+			func(n *BucketProposal, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (bpq *BucketProposalQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*BucketProposal, init func(*BucketProposal), assign func(*BucketProposal, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BucketProposal)
+	for i := range nodes {
+		if nodes[i].fiat_currency_bucket_proposals == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_bucket_proposals
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_bucket_proposals" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (bpq *BucketProposalQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bpq.querySpec()
+	_spec.Node.Columns = bpq.ctx.Fields
+	if len(bpq.ctx.Fields) > 0 {
+		_spec.Unique = bpq.ctx.Unique != nil && *bpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bpq.driver, _spec)
+}
+
+func (bpq *BucketProposalQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bucketproposal.Table, bucketproposal.Columns, sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID))
+	_spec.From = bpq.sql
+	if unique := bpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bucketproposal.FieldID)
+		for i := range fields {
+			if fields[i] != bucketproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bpq *BucketProposalQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bpq.driver.Dialect())
+	t1 := builder.Table(bucketproposal.Table)
+	columns := bpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = bucketproposal.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bpq.sql != nil {
+		selector = bpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bpq.ctx.Unique != nil && *bpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range bpq.predicates {
+		p(selector)
+	}
+	for _, p := range bpq.order {
+		p(selector)
+	}
+	if offset := bpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BucketProposalGroupBy is the group-by builder for BucketProposal entities.
+type BucketProposalGroupBy struct {
+	selector
+	build *BucketProposalQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bpgb *BucketProposalGroupBy) Aggregate(fns ...AggregateFunc) *BucketProposalGroupBy {
+	bpgb.fns = append(bpgb.fns, fns...)
+	return bpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bpgb *BucketProposalGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := bpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BucketProposalQuery, *BucketProposalGroupBy](ctx, bpgb.build, bpgb, bpgb.build.inters, v)
+}
+
+func (bpgb *BucketProposalGroupBy) sqlScan(ctx context.Context, root *BucketProposalQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bpgb.fns))
+	for _, fn := range bpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bpgb.flds)+len(bpgb.fns))
+		for _, f := range *bpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BucketProposalSelect is the builder for selecting fields of BucketProposal entities.
+type BucketProposalSelect struct {
+	*BucketProposalQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bps *BucketProposalSelect) Aggregate(fns ...AggregateFunc) *BucketProposalSelect {
+	bps.fns = append(bps.fns, fns...)
+	return bps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bps *BucketProposalSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bps.ctx, ent.OpQuerySelect)
+	if err := bps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BucketProposalQuery, *BucketProposalSelect](ctx, bps.BucketProposalQuery, bps, bps.inters, v)
+}
+
+func (bps *BucketProposalSelect) sqlScan(ctx context.Context, root *BucketProposalQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bps.fns))
+	for _, fn := range bps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
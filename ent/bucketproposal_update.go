@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// BucketProposalUpdate is the builder for updating BucketProposal entities.
+type BucketProposalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BucketProposalMutation
+}
+
+// Where appends a list predicates to the BucketProposalUpdate builder.
+func (bpu *BucketProposalUpdate) Where(ps ...predicate.BucketProposal) *BucketProposalUpdate {
+	bpu.mutation.Where(ps...)
+	return bpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpu *BucketProposalUpdate) SetUpdatedAt(t time.Time) *BucketProposalUpdate {
+	bpu.mutation.SetUpdatedAt(t)
+	return bpu
+}
+
+// SetStatus sets the "status" field.
+func (bpu *BucketProposalUpdate) SetStatus(b bucketproposal.Status) *BucketProposalUpdate {
+	bpu.mutation.SetStatus(b)
+	return bpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpu *BucketProposalUpdate) SetNillableStatus(b *bucketproposal.Status) *BucketProposalUpdate {
+	if b != nil {
+		bpu.SetStatus(*b)
+	}
+	return bpu
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (bpu *BucketProposalUpdate) SetAppliedAt(t time.Time) *BucketProposalUpdate {
+	bpu.mutation.SetAppliedAt(t)
+	return bpu
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (bpu *BucketProposalUpdate) SetNillableAppliedAt(t *time.Time) *BucketProposalUpdate {
+	if t != nil {
+		bpu.SetAppliedAt(*t)
+	}
+	return bpu
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (bpu *BucketProposalUpdate) ClearAppliedAt() *BucketProposalUpdate {
+	bpu.mutation.ClearAppliedAt()
+	return bpu
+}
+
+// Mutation returns the BucketProposalMutation object of the builder.
+func (bpu *BucketProposalUpdate) Mutation() *BucketProposalMutation {
+	return bpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bpu *BucketProposalUpdate) Save(ctx context.Context) (int, error) {
+	bpu.defaults()
+	return withHooks(ctx, bpu.sqlSave, bpu.mutation, bpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpu *BucketProposalUpdate) SaveX(ctx context.Context) int {
+	affected, err := bpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bpu *BucketProposalUpdate) Exec(ctx context.Context) error {
+	_, err := bpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpu *BucketProposalUpdate) ExecX(ctx context.Context) {
+	if err := bpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpu *BucketProposalUpdate) defaults() {
+	if _, ok := bpu.mutation.UpdatedAt(); !ok {
+		v := bucketproposal.UpdateDefaultUpdatedAt()
+		bpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpu *BucketProposalUpdate) check() error {
+	if v, ok := bpu.mutation.Status(); ok {
+		if err := bucketproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BucketProposal.status": %w`, err)}
+		}
+	}
+	if bpu.mutation.CurrencyCleared() && len(bpu.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BucketProposal.currency"`)
+	}
+	return nil
+}
+
+func (bpu *BucketProposalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bucketproposal.Table, bucketproposal.Columns, sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID))
+	if ps := bpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(bucketproposal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bpu.mutation.Status(); ok {
+		_spec.SetField(bucketproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bpu.mutation.AppliedAt(); ok {
+		_spec.SetField(bucketproposal.FieldAppliedAt, field.TypeTime, value)
+	}
+	if bpu.mutation.AppliedAtCleared() {
+		_spec.ClearField(bucketproposal.FieldAppliedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bucketproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bpu.mutation.done = true
+	return n, nil
+}
+
+// BucketProposalUpdateOne is the builder for updating a single BucketProposal entity.
+type BucketProposalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BucketProposalMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (bpuo *BucketProposalUpdateOne) SetUpdatedAt(t time.Time) *BucketProposalUpdateOne {
+	bpuo.mutation.SetUpdatedAt(t)
+	return bpuo
+}
+
+// SetStatus sets the "status" field.
+func (bpuo *BucketProposalUpdateOne) SetStatus(b bucketproposal.Status) *BucketProposalUpdateOne {
+	bpuo.mutation.SetStatus(b)
+	return bpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bpuo *BucketProposalUpdateOne) SetNillableStatus(b *bucketproposal.Status) *BucketProposalUpdateOne {
+	if b != nil {
+		bpuo.SetStatus(*b)
+	}
+	return bpuo
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (bpuo *BucketProposalUpdateOne) SetAppliedAt(t time.Time) *BucketProposalUpdateOne {
+	bpuo.mutation.SetAppliedAt(t)
+	return bpuo
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (bpuo *BucketProposalUpdateOne) SetNillableAppliedAt(t *time.Time) *BucketProposalUpdateOne {
+	if t != nil {
+		bpuo.SetAppliedAt(*t)
+	}
+	return bpuo
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (bpuo *BucketProposalUpdateOne) ClearAppliedAt() *BucketProposalUpdateOne {
+	bpuo.mutation.ClearAppliedAt()
+	return bpuo
+}
+
+// Mutation returns the BucketProposalMutation object of the builder.
+func (bpuo *BucketProposalUpdateOne) Mutation() *BucketProposalMutation {
+	return bpuo.mutation
+}
+
+// Where appends a list predicates to the BucketProposalUpdate builder.
+func (bpuo *BucketProposalUpdateOne) Where(ps ...predicate.BucketProposal) *BucketProposalUpdateOne {
+	bpuo.mutation.Where(ps...)
+	return bpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bpuo *BucketProposalUpdateOne) Select(field string, fields ...string) *BucketProposalUpdateOne {
+	bpuo.fields = append([]string{field}, fields...)
+	return bpuo
+}
+
+// Save executes the query and returns the updated BucketProposal entity.
+func (bpuo *BucketProposalUpdateOne) Save(ctx context.Context) (*BucketProposal, error) {
+	bpuo.defaults()
+	return withHooks(ctx, bpuo.sqlSave, bpuo.mutation, bpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpuo *BucketProposalUpdateOne) SaveX(ctx context.Context) *BucketProposal {
+	node, err := bpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bpuo *BucketProposalUpdateOne) Exec(ctx context.Context) error {
+	_, err := bpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpuo *BucketProposalUpdateOne) ExecX(ctx context.Context) {
+	if err := bpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpuo *BucketProposalUpdateOne) defaults() {
+	if _, ok := bpuo.mutation.UpdatedAt(); !ok {
+		v := bucketproposal.UpdateDefaultUpdatedAt()
+		bpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpuo *BucketProposalUpdateOne) check() error {
+	if v, ok := bpuo.mutation.Status(); ok {
+		if err := bucketproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BucketProposal.status": %w`, err)}
+		}
+	}
+	if bpuo.mutation.CurrencyCleared() && len(bpuo.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BucketProposal.currency"`)
+	}
+	return nil
+}
+
+func (bpuo *BucketProposalUpdateOne) sqlSave(ctx context.Context) (_node *BucketProposal, err error) {
+	if err := bpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bucketproposal.Table, bucketproposal.Columns, sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID))
+	id, ok := bpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BucketProposal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bucketproposal.FieldID)
+		for _, f := range fields {
+			if !bucketproposal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bucketproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(bucketproposal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := bpuo.mutation.Status(); ok {
+		_spec.SetField(bucketproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bpuo.mutation.AppliedAt(); ok {
+		_spec.SetField(bucketproposal.FieldAppliedAt, field.TypeTime, value)
+	}
+	if bpuo.mutation.AppliedAtCleared() {
+		_spec.ClearField(bucketproposal.FieldAppliedAt, field.TypeTime)
+	}
+	_node = &BucketProposal{config: bpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bucketproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bpuo.mutation.done = true
+	return _node, nil
+}
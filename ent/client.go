@@ -17,6 +17,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
 	"github.com/paycrest/aggregator/ent/institution"
@@ -24,16 +28,26 @@ import (
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/ent/verificationtoken"
@@ -47,6 +61,14 @@ type Client struct {
 	Schema *migrate.Schema
 	// APIKey is the client for interacting with the APIKey builders.
 	APIKey *APIKeyClient
+	// BucketProposal is the client for interacting with the BucketProposal builders.
+	BucketProposal *BucketProposalClient
+	// DeadLetterOrder is the client for interacting with the DeadLetterOrder builders.
+	DeadLetterOrder *DeadLetterOrderClient
+	// Dispute is the client for interacting with the Dispute builders.
+	Dispute *DisputeClient
+	// DisputeEvidence is the client for interacting with the DisputeEvidence builders.
+	DisputeEvidence *DisputeEvidenceClient
 	// FiatCurrency is the client for interacting with the FiatCurrency builders.
 	FiatCurrency *FiatCurrencyClient
 	// IdentityVerificationRequest is the client for interacting with the IdentityVerificationRequest builders.
@@ -61,26 +83,46 @@ type Client struct {
 	LockPaymentOrder *LockPaymentOrderClient
 	// Network is the client for interacting with the Network builders.
 	Network *NetworkClient
+	// OrderAssignment is the client for interacting with the OrderAssignment builders.
+	OrderAssignment *OrderAssignmentClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
 	// PaymentOrderRecipient is the client for interacting with the PaymentOrderRecipient builders.
 	PaymentOrderRecipient *PaymentOrderRecipientClient
+	// ProviderHealthCheck is the client for interacting with the ProviderHealthCheck builders.
+	ProviderHealthCheck *ProviderHealthCheckClient
 	// ProviderOrderToken is the client for interacting with the ProviderOrderToken builders.
 	ProviderOrderToken *ProviderOrderTokenClient
 	// ProviderProfile is the client for interacting with the ProviderProfile builders.
 	ProviderProfile *ProviderProfileClient
 	// ProviderRating is the client for interacting with the ProviderRating builders.
 	ProviderRating *ProviderRatingClient
+	// ProviderSLARecord is the client for interacting with the ProviderSLARecord builders.
+	ProviderSLARecord *ProviderSLARecordClient
 	// ProvisionBucket is the client for interacting with the ProvisionBucket builders.
 	ProvisionBucket *ProvisionBucketClient
+	// PublicHoliday is the client for interacting with the PublicHoliday builders.
+	PublicHoliday *PublicHolidayClient
+	// RateCircuitBreaker is the client for interacting with the RateCircuitBreaker builders.
+	RateCircuitBreaker *RateCircuitBreakerClient
+	// RateSnapshot is the client for interacting with the RateSnapshot builders.
+	RateSnapshot *RateSnapshotClient
 	// ReceiveAddress is the client for interacting with the ReceiveAddress builders.
 	ReceiveAddress *ReceiveAddressClient
 	// SenderOrderToken is the client for interacting with the SenderOrderToken builders.
 	SenderOrderToken *SenderOrderTokenClient
 	// SenderProfile is the client for interacting with the SenderProfile builders.
 	SenderProfile *SenderProfileClient
+	// TeamAuditLog is the client for interacting with the TeamAuditLog builders.
+	TeamAuditLog *TeamAuditLogClient
+	// TeamInvitation is the client for interacting with the TeamInvitation builders.
+	TeamInvitation *TeamInvitationClient
+	// TeamMember is the client for interacting with the TeamMember builders.
+	TeamMember *TeamMemberClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
+	// TokenMarketRate is the client for interacting with the TokenMarketRate builders.
+	TokenMarketRate *TokenMarketRateClient
 	// TransactionLog is the client for interacting with the TransactionLog builders.
 	TransactionLog *TransactionLogClient
 	// User is the client for interacting with the User builders.
@@ -101,6 +143,10 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.APIKey = NewAPIKeyClient(c.config)
+	c.BucketProposal = NewBucketProposalClient(c.config)
+	c.DeadLetterOrder = NewDeadLetterOrderClient(c.config)
+	c.Dispute = NewDisputeClient(c.config)
+	c.DisputeEvidence = NewDisputeEvidenceClient(c.config)
 	c.FiatCurrency = NewFiatCurrencyClient(c.config)
 	c.IdentityVerificationRequest = NewIdentityVerificationRequestClient(c.config)
 	c.Institution = NewInstitutionClient(c.config)
@@ -108,16 +154,26 @@ func (c *Client) init() {
 	c.LockOrderFulfillment = NewLockOrderFulfillmentClient(c.config)
 	c.LockPaymentOrder = NewLockPaymentOrderClient(c.config)
 	c.Network = NewNetworkClient(c.config)
+	c.OrderAssignment = NewOrderAssignmentClient(c.config)
 	c.PaymentOrder = NewPaymentOrderClient(c.config)
 	c.PaymentOrderRecipient = NewPaymentOrderRecipientClient(c.config)
+	c.ProviderHealthCheck = NewProviderHealthCheckClient(c.config)
 	c.ProviderOrderToken = NewProviderOrderTokenClient(c.config)
 	c.ProviderProfile = NewProviderProfileClient(c.config)
 	c.ProviderRating = NewProviderRatingClient(c.config)
+	c.ProviderSLARecord = NewProviderSLARecordClient(c.config)
 	c.ProvisionBucket = NewProvisionBucketClient(c.config)
+	c.PublicHoliday = NewPublicHolidayClient(c.config)
+	c.RateCircuitBreaker = NewRateCircuitBreakerClient(c.config)
+	c.RateSnapshot = NewRateSnapshotClient(c.config)
 	c.ReceiveAddress = NewReceiveAddressClient(c.config)
 	c.SenderOrderToken = NewSenderOrderTokenClient(c.config)
 	c.SenderProfile = NewSenderProfileClient(c.config)
+	c.TeamAuditLog = NewTeamAuditLogClient(c.config)
+	c.TeamInvitation = NewTeamInvitationClient(c.config)
+	c.TeamMember = NewTeamMemberClient(c.config)
 	c.Token = NewTokenClient(c.config)
+	c.TokenMarketRate = NewTokenMarketRateClient(c.config)
 	c.TransactionLog = NewTransactionLogClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.VerificationToken = NewVerificationTokenClient(c.config)
@@ -215,6 +271,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                         ctx,
 		config:                      cfg,
 		APIKey:                      NewAPIKeyClient(cfg),
+		BucketProposal:              NewBucketProposalClient(cfg),
+		DeadLetterOrder:             NewDeadLetterOrderClient(cfg),
+		Dispute:                     NewDisputeClient(cfg),
+		DisputeEvidence:             NewDisputeEvidenceClient(cfg),
 		FiatCurrency:                NewFiatCurrencyClient(cfg),
 		IdentityVerificationRequest: NewIdentityVerificationRequestClient(cfg),
 		Institution:                 NewInstitutionClient(cfg),
@@ -222,16 +282,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		LockOrderFulfillment:        NewLockOrderFulfillmentClient(cfg),
 		LockPaymentOrder:            NewLockPaymentOrderClient(cfg),
 		Network:                     NewNetworkClient(cfg),
+		OrderAssignment:             NewOrderAssignmentClient(cfg),
 		PaymentOrder:                NewPaymentOrderClient(cfg),
 		PaymentOrderRecipient:       NewPaymentOrderRecipientClient(cfg),
+		ProviderHealthCheck:         NewProviderHealthCheckClient(cfg),
 		ProviderOrderToken:          NewProviderOrderTokenClient(cfg),
 		ProviderProfile:             NewProviderProfileClient(cfg),
 		ProviderRating:              NewProviderRatingClient(cfg),
+		ProviderSLARecord:           NewProviderSLARecordClient(cfg),
 		ProvisionBucket:             NewProvisionBucketClient(cfg),
+		PublicHoliday:               NewPublicHolidayClient(cfg),
+		RateCircuitBreaker:          NewRateCircuitBreakerClient(cfg),
+		RateSnapshot:                NewRateSnapshotClient(cfg),
 		ReceiveAddress:              NewReceiveAddressClient(cfg),
 		SenderOrderToken:            NewSenderOrderTokenClient(cfg),
 		SenderProfile:               NewSenderProfileClient(cfg),
+		TeamAuditLog:                NewTeamAuditLogClient(cfg),
+		TeamInvitation:              NewTeamInvitationClient(cfg),
+		TeamMember:                  NewTeamMemberClient(cfg),
 		Token:                       NewTokenClient(cfg),
+		TokenMarketRate:             NewTokenMarketRateClient(cfg),
 		TransactionLog:              NewTransactionLogClient(cfg),
 		User:                        NewUserClient(cfg),
 		VerificationToken:           NewVerificationTokenClient(cfg),
@@ -256,6 +326,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                         ctx,
 		config:                      cfg,
 		APIKey:                      NewAPIKeyClient(cfg),
+		BucketProposal:              NewBucketProposalClient(cfg),
+		DeadLetterOrder:             NewDeadLetterOrderClient(cfg),
+		Dispute:                     NewDisputeClient(cfg),
+		DisputeEvidence:             NewDisputeEvidenceClient(cfg),
 		FiatCurrency:                NewFiatCurrencyClient(cfg),
 		IdentityVerificationRequest: NewIdentityVerificationRequestClient(cfg),
 		Institution:                 NewInstitutionClient(cfg),
@@ -263,16 +337,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		LockOrderFulfillment:        NewLockOrderFulfillmentClient(cfg),
 		LockPaymentOrder:            NewLockPaymentOrderClient(cfg),
 		Network:                     NewNetworkClient(cfg),
+		OrderAssignment:             NewOrderAssignmentClient(cfg),
 		PaymentOrder:                NewPaymentOrderClient(cfg),
 		PaymentOrderRecipient:       NewPaymentOrderRecipientClient(cfg),
+		ProviderHealthCheck:         NewProviderHealthCheckClient(cfg),
 		ProviderOrderToken:          NewProviderOrderTokenClient(cfg),
 		ProviderProfile:             NewProviderProfileClient(cfg),
 		ProviderRating:              NewProviderRatingClient(cfg),
+		ProviderSLARecord:           NewProviderSLARecordClient(cfg),
 		ProvisionBucket:             NewProvisionBucketClient(cfg),
+		PublicHoliday:               NewPublicHolidayClient(cfg),
+		RateCircuitBreaker:          NewRateCircuitBreakerClient(cfg),
+		RateSnapshot:                NewRateSnapshotClient(cfg),
 		ReceiveAddress:              NewReceiveAddressClient(cfg),
 		SenderOrderToken:            NewSenderOrderTokenClient(cfg),
 		SenderProfile:               NewSenderProfileClient(cfg),
+		TeamAuditLog:                NewTeamAuditLogClient(cfg),
+		TeamInvitation:              NewTeamInvitationClient(cfg),
+		TeamMember:                  NewTeamMemberClient(cfg),
 		Token:                       NewTokenClient(cfg),
+		TokenMarketRate:             NewTokenMarketRateClient(cfg),
 		TransactionLog:              NewTransactionLogClient(cfg),
 		User:                        NewUserClient(cfg),
 		VerificationToken:           NewVerificationTokenClient(cfg),
@@ -306,12 +390,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIKey, c.FiatCurrency, c.IdentityVerificationRequest, c.Institution,
-		c.LinkedAddress, c.LockOrderFulfillment, c.LockPaymentOrder, c.Network,
-		c.PaymentOrder, c.PaymentOrderRecipient, c.ProviderOrderToken,
-		c.ProviderProfile, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
-		c.SenderOrderToken, c.SenderProfile, c.Token, c.TransactionLog, c.User,
-		c.VerificationToken, c.WebhookRetryAttempt,
+		c.APIKey, c.BucketProposal, c.DeadLetterOrder, c.Dispute, c.DisputeEvidence,
+		c.FiatCurrency, c.IdentityVerificationRequest, c.Institution, c.LinkedAddress,
+		c.LockOrderFulfillment, c.LockPaymentOrder, c.Network, c.OrderAssignment,
+		c.PaymentOrder, c.PaymentOrderRecipient, c.ProviderHealthCheck,
+		c.ProviderOrderToken, c.ProviderProfile, c.ProviderRating, c.ProviderSLARecord,
+		c.ProvisionBucket, c.PublicHoliday, c.RateCircuitBreaker, c.RateSnapshot,
+		c.ReceiveAddress, c.SenderOrderToken, c.SenderProfile, c.TeamAuditLog,
+		c.TeamInvitation, c.TeamMember, c.Token, c.TokenMarketRate, c.TransactionLog,
+		c.User, c.VerificationToken, c.WebhookRetryAttempt,
 	} {
 		n.Use(hooks...)
 	}
@@ -321,12 +408,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIKey, c.FiatCurrency, c.IdentityVerificationRequest, c.Institution,
-		c.LinkedAddress, c.LockOrderFulfillment, c.LockPaymentOrder, c.Network,
-		c.PaymentOrder, c.PaymentOrderRecipient, c.ProviderOrderToken,
-		c.ProviderProfile, c.ProviderRating, c.ProvisionBucket, c.ReceiveAddress,
-		c.SenderOrderToken, c.SenderProfile, c.Token, c.TransactionLog, c.User,
-		c.VerificationToken, c.WebhookRetryAttempt,
+		c.APIKey, c.BucketProposal, c.DeadLetterOrder, c.Dispute, c.DisputeEvidence,
+		c.FiatCurrency, c.IdentityVerificationRequest, c.Institution, c.LinkedAddress,
+		c.LockOrderFulfillment, c.LockPaymentOrder, c.Network, c.OrderAssignment,
+		c.PaymentOrder, c.PaymentOrderRecipient, c.ProviderHealthCheck,
+		c.ProviderOrderToken, c.ProviderProfile, c.ProviderRating, c.ProviderSLARecord,
+		c.ProvisionBucket, c.PublicHoliday, c.RateCircuitBreaker, c.RateSnapshot,
+		c.ReceiveAddress, c.SenderOrderToken, c.SenderProfile, c.TeamAuditLog,
+		c.TeamInvitation, c.TeamMember, c.Token, c.TokenMarketRate, c.TransactionLog,
+		c.User, c.VerificationToken, c.WebhookRetryAttempt,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -337,6 +427,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *APIKeyMutation:
 		return c.APIKey.mutate(ctx, m)
+	case *BucketProposalMutation:
+		return c.BucketProposal.mutate(ctx, m)
+	case *DeadLetterOrderMutation:
+		return c.DeadLetterOrder.mutate(ctx, m)
+	case *DisputeMutation:
+		return c.Dispute.mutate(ctx, m)
+	case *DisputeEvidenceMutation:
+		return c.DisputeEvidence.mutate(ctx, m)
 	case *FiatCurrencyMutation:
 		return c.FiatCurrency.mutate(ctx, m)
 	case *IdentityVerificationRequestMutation:
@@ -351,26 +449,46 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.LockPaymentOrder.mutate(ctx, m)
 	case *NetworkMutation:
 		return c.Network.mutate(ctx, m)
+	case *OrderAssignmentMutation:
+		return c.OrderAssignment.mutate(ctx, m)
 	case *PaymentOrderMutation:
 		return c.PaymentOrder.mutate(ctx, m)
 	case *PaymentOrderRecipientMutation:
 		return c.PaymentOrderRecipient.mutate(ctx, m)
+	case *ProviderHealthCheckMutation:
+		return c.ProviderHealthCheck.mutate(ctx, m)
 	case *ProviderOrderTokenMutation:
 		return c.ProviderOrderToken.mutate(ctx, m)
 	case *ProviderProfileMutation:
 		return c.ProviderProfile.mutate(ctx, m)
 	case *ProviderRatingMutation:
 		return c.ProviderRating.mutate(ctx, m)
+	case *ProviderSLARecordMutation:
+		return c.ProviderSLARecord.mutate(ctx, m)
 	case *ProvisionBucketMutation:
 		return c.ProvisionBucket.mutate(ctx, m)
+	case *PublicHolidayMutation:
+		return c.PublicHoliday.mutate(ctx, m)
+	case *RateCircuitBreakerMutation:
+		return c.RateCircuitBreaker.mutate(ctx, m)
+	case *RateSnapshotMutation:
+		return c.RateSnapshot.mutate(ctx, m)
 	case *ReceiveAddressMutation:
 		return c.ReceiveAddress.mutate(ctx, m)
 	case *SenderOrderTokenMutation:
 		return c.SenderOrderToken.mutate(ctx, m)
 	case *SenderProfileMutation:
 		return c.SenderProfile.mutate(ctx, m)
+	case *TeamAuditLogMutation:
+		return c.TeamAuditLog.mutate(ctx, m)
+	case *TeamInvitationMutation:
+		return c.TeamInvitation.mutate(ctx, m)
+	case *TeamMemberMutation:
+		return c.TeamMember.mutate(ctx, m)
 	case *TokenMutation:
 		return c.Token.mutate(ctx, m)
+	case *TokenMarketRateMutation:
+		return c.TokenMarketRate.mutate(ctx, m)
 	case *TransactionLogMutation:
 		return c.TransactionLog.mutate(ctx, m)
 	case *UserMutation:
@@ -565,107 +683,107 @@ func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, er
 	}
 }
 
-// FiatCurrencyClient is a client for the FiatCurrency schema.
-type FiatCurrencyClient struct {
+// BucketProposalClient is a client for the BucketProposal schema.
+type BucketProposalClient struct {
 	config
 }
 
-// NewFiatCurrencyClient returns a client for the FiatCurrency from the given config.
-func NewFiatCurrencyClient(c config) *FiatCurrencyClient {
-	return &FiatCurrencyClient{config: c}
+// NewBucketProposalClient returns a client for the BucketProposal from the given config.
+func NewBucketProposalClient(c config) *BucketProposalClient {
+	return &BucketProposalClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `fiatcurrency.Hooks(f(g(h())))`.
-func (c *FiatCurrencyClient) Use(hooks ...Hook) {
-	c.hooks.FiatCurrency = append(c.hooks.FiatCurrency, hooks...)
+// A call to `Use(f, g, h)` equals to `bucketproposal.Hooks(f(g(h())))`.
+func (c *BucketProposalClient) Use(hooks ...Hook) {
+	c.hooks.BucketProposal = append(c.hooks.BucketProposal, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `fiatcurrency.Intercept(f(g(h())))`.
-func (c *FiatCurrencyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FiatCurrency = append(c.inters.FiatCurrency, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bucketproposal.Intercept(f(g(h())))`.
+func (c *BucketProposalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BucketProposal = append(c.inters.BucketProposal, interceptors...)
 }
 
-// Create returns a builder for creating a FiatCurrency entity.
-func (c *FiatCurrencyClient) Create() *FiatCurrencyCreate {
-	mutation := newFiatCurrencyMutation(c.config, OpCreate)
-	return &FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BucketProposal entity.
+func (c *BucketProposalClient) Create() *BucketProposalCreate {
+	mutation := newBucketProposalMutation(c.config, OpCreate)
+	return &BucketProposalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FiatCurrency entities.
-func (c *FiatCurrencyClient) CreateBulk(builders ...*FiatCurrencyCreate) *FiatCurrencyCreateBulk {
-	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BucketProposal entities.
+func (c *BucketProposalClient) CreateBulk(builders ...*BucketProposalCreate) *BucketProposalCreateBulk {
+	return &BucketProposalCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FiatCurrencyClient) MapCreateBulk(slice any, setFunc func(*FiatCurrencyCreate, int)) *FiatCurrencyCreateBulk {
+func (c *BucketProposalClient) MapCreateBulk(slice any, setFunc func(*BucketProposalCreate, int)) *BucketProposalCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FiatCurrencyCreateBulk{err: fmt.Errorf("calling to FiatCurrencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BucketProposalCreateBulk{err: fmt.Errorf("calling to BucketProposalClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FiatCurrencyCreate, rv.Len())
+	builders := make([]*BucketProposalCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
+	return &BucketProposalCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FiatCurrency.
-func (c *FiatCurrencyClient) Update() *FiatCurrencyUpdate {
-	mutation := newFiatCurrencyMutation(c.config, OpUpdate)
-	return &FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BucketProposal.
+func (c *BucketProposalClient) Update() *BucketProposalUpdate {
+	mutation := newBucketProposalMutation(c.config, OpUpdate)
+	return &BucketProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FiatCurrencyClient) UpdateOne(fc *FiatCurrency) *FiatCurrencyUpdateOne {
-	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrency(fc))
-	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BucketProposalClient) UpdateOne(bp *BucketProposal) *BucketProposalUpdateOne {
+	mutation := newBucketProposalMutation(c.config, OpUpdateOne, withBucketProposal(bp))
+	return &BucketProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FiatCurrencyClient) UpdateOneID(id uuid.UUID) *FiatCurrencyUpdateOne {
-	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrencyID(id))
-	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BucketProposalClient) UpdateOneID(id uuid.UUID) *BucketProposalUpdateOne {
+	mutation := newBucketProposalMutation(c.config, OpUpdateOne, withBucketProposalID(id))
+	return &BucketProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FiatCurrency.
-func (c *FiatCurrencyClient) Delete() *FiatCurrencyDelete {
-	mutation := newFiatCurrencyMutation(c.config, OpDelete)
-	return &FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BucketProposal.
+func (c *BucketProposalClient) Delete() *BucketProposalDelete {
+	mutation := newBucketProposalMutation(c.config, OpDelete)
+	return &BucketProposalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FiatCurrencyClient) DeleteOne(fc *FiatCurrency) *FiatCurrencyDeleteOne {
-	return c.DeleteOneID(fc.ID)
+func (c *BucketProposalClient) DeleteOne(bp *BucketProposal) *BucketProposalDeleteOne {
+	return c.DeleteOneID(bp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FiatCurrencyClient) DeleteOneID(id uuid.UUID) *FiatCurrencyDeleteOne {
-	builder := c.Delete().Where(fiatcurrency.ID(id))
+func (c *BucketProposalClient) DeleteOneID(id uuid.UUID) *BucketProposalDeleteOne {
+	builder := c.Delete().Where(bucketproposal.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FiatCurrencyDeleteOne{builder}
+	return &BucketProposalDeleteOne{builder}
 }
 
-// Query returns a query builder for FiatCurrency.
-func (c *FiatCurrencyClient) Query() *FiatCurrencyQuery {
-	return &FiatCurrencyQuery{
+// Query returns a query builder for BucketProposal.
+func (c *BucketProposalClient) Query() *BucketProposalQuery {
+	return &BucketProposalQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFiatCurrency},
+		ctx:    &QueryContext{Type: TypeBucketProposal},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FiatCurrency entity by its id.
-func (c *FiatCurrencyClient) Get(ctx context.Context, id uuid.UUID) (*FiatCurrency, error) {
-	return c.Query().Where(fiatcurrency.ID(id)).Only(ctx)
+// Get returns a BucketProposal entity by its id.
+func (c *BucketProposalClient) Get(ctx context.Context, id uuid.UUID) (*BucketProposal, error) {
+	return c.Query().Where(bucketproposal.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FiatCurrencyClient) GetX(ctx context.Context, id uuid.UUID) *FiatCurrency {
+func (c *BucketProposalClient) GetX(ctx context.Context, id uuid.UUID) *BucketProposal {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -673,180 +791,148 @@ func (c *FiatCurrencyClient) GetX(ctx context.Context, id uuid.UUID) *FiatCurren
 	return obj
 }
 
-// QueryProviders queries the providers edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryProviders(fc *FiatCurrency) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProvidersTable, fiatcurrency.ProvidersColumn),
-		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProvisionBuckets queries the provision_buckets edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryProvisionBuckets(fc *FiatCurrency) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProvisionBucketsTable, fiatcurrency.ProvisionBucketsColumn),
-		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInstitutions queries the institutions edge of a FiatCurrency.
-func (c *FiatCurrencyClient) QueryInstitutions(fc *FiatCurrency) *InstitutionQuery {
-	query := (&InstitutionClient{config: c.config}).Query()
+// QueryCurrency queries the currency edge of a BucketProposal.
+func (c *BucketProposalClient) QueryCurrency(bp *BucketProposal) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fc.ID
+		id := bp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
-			sqlgraph.To(institution.Table, institution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.InstitutionsTable, fiatcurrency.InstitutionsColumn),
+			sqlgraph.From(bucketproposal.Table, bucketproposal.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bucketproposal.CurrencyTable, bucketproposal.CurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(bp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FiatCurrencyClient) Hooks() []Hook {
-	return c.hooks.FiatCurrency
+func (c *BucketProposalClient) Hooks() []Hook {
+	return c.hooks.BucketProposal
 }
 
 // Interceptors returns the client interceptors.
-func (c *FiatCurrencyClient) Interceptors() []Interceptor {
-	return c.inters.FiatCurrency
+func (c *BucketProposalClient) Interceptors() []Interceptor {
+	return c.inters.BucketProposal
 }
 
-func (c *FiatCurrencyClient) mutate(ctx context.Context, m *FiatCurrencyMutation) (Value, error) {
+func (c *BucketProposalClient) mutate(ctx context.Context, m *BucketProposalMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BucketProposalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BucketProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BucketProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BucketProposalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FiatCurrency mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BucketProposal mutation op: %q", m.Op())
 	}
 }
 
-// IdentityVerificationRequestClient is a client for the IdentityVerificationRequest schema.
-type IdentityVerificationRequestClient struct {
+// DeadLetterOrderClient is a client for the DeadLetterOrder schema.
+type DeadLetterOrderClient struct {
 	config
 }
 
-// NewIdentityVerificationRequestClient returns a client for the IdentityVerificationRequest from the given config.
-func NewIdentityVerificationRequestClient(c config) *IdentityVerificationRequestClient {
-	return &IdentityVerificationRequestClient{config: c}
+// NewDeadLetterOrderClient returns a client for the DeadLetterOrder from the given config.
+func NewDeadLetterOrderClient(c config) *DeadLetterOrderClient {
+	return &DeadLetterOrderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `identityverificationrequest.Hooks(f(g(h())))`.
-func (c *IdentityVerificationRequestClient) Use(hooks ...Hook) {
-	c.hooks.IdentityVerificationRequest = append(c.hooks.IdentityVerificationRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `deadletterorder.Hooks(f(g(h())))`.
+func (c *DeadLetterOrderClient) Use(hooks ...Hook) {
+	c.hooks.DeadLetterOrder = append(c.hooks.DeadLetterOrder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `identityverificationrequest.Intercept(f(g(h())))`.
-func (c *IdentityVerificationRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.IdentityVerificationRequest = append(c.inters.IdentityVerificationRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `deadletterorder.Intercept(f(g(h())))`.
+func (c *DeadLetterOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeadLetterOrder = append(c.inters.DeadLetterOrder, interceptors...)
 }
 
-// Create returns a builder for creating a IdentityVerificationRequest entity.
-func (c *IdentityVerificationRequestClient) Create() *IdentityVerificationRequestCreate {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpCreate)
-	return &IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DeadLetterOrder entity.
+func (c *DeadLetterOrderClient) Create() *DeadLetterOrderCreate {
+	mutation := newDeadLetterOrderMutation(c.config, OpCreate)
+	return &DeadLetterOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of IdentityVerificationRequest entities.
-func (c *IdentityVerificationRequestClient) CreateBulk(builders ...*IdentityVerificationRequestCreate) *IdentityVerificationRequestCreateBulk {
-	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DeadLetterOrder entities.
+func (c *DeadLetterOrderClient) CreateBulk(builders ...*DeadLetterOrderCreate) *DeadLetterOrderCreateBulk {
+	return &DeadLetterOrderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IdentityVerificationRequestClient) MapCreateBulk(slice any, setFunc func(*IdentityVerificationRequestCreate, int)) *IdentityVerificationRequestCreateBulk {
+func (c *DeadLetterOrderClient) MapCreateBulk(slice any, setFunc func(*DeadLetterOrderCreate, int)) *DeadLetterOrderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IdentityVerificationRequestCreateBulk{err: fmt.Errorf("calling to IdentityVerificationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DeadLetterOrderCreateBulk{err: fmt.Errorf("calling to DeadLetterOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IdentityVerificationRequestCreate, rv.Len())
+	builders := make([]*DeadLetterOrderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
+	return &DeadLetterOrderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for IdentityVerificationRequest.
-func (c *IdentityVerificationRequestClient) Update() *IdentityVerificationRequestUpdate {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdate)
-	return &IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DeadLetterOrder.
+func (c *DeadLetterOrderClient) Update() *DeadLetterOrderUpdate {
+	mutation := newDeadLetterOrderMutation(c.config, OpUpdate)
+	return &DeadLetterOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IdentityVerificationRequestClient) UpdateOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestUpdateOne {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequest(ivr))
-	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeadLetterOrderClient) UpdateOne(dlo *DeadLetterOrder) *DeadLetterOrderUpdateOne {
+	mutation := newDeadLetterOrderMutation(c.config, OpUpdateOne, withDeadLetterOrder(dlo))
+	return &DeadLetterOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IdentityVerificationRequestClient) UpdateOneID(id uuid.UUID) *IdentityVerificationRequestUpdateOne {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequestID(id))
-	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DeadLetterOrderClient) UpdateOneID(id uuid.UUID) *DeadLetterOrderUpdateOne {
+	mutation := newDeadLetterOrderMutation(c.config, OpUpdateOne, withDeadLetterOrderID(id))
+	return &DeadLetterOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for IdentityVerificationRequest.
-func (c *IdentityVerificationRequestClient) Delete() *IdentityVerificationRequestDelete {
-	mutation := newIdentityVerificationRequestMutation(c.config, OpDelete)
-	return &IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DeadLetterOrder.
+func (c *DeadLetterOrderClient) Delete() *DeadLetterOrderDelete {
+	mutation := newDeadLetterOrderMutation(c.config, OpDelete)
+	return &DeadLetterOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IdentityVerificationRequestClient) DeleteOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestDeleteOne {
-	return c.DeleteOneID(ivr.ID)
+func (c *DeadLetterOrderClient) DeleteOne(dlo *DeadLetterOrder) *DeadLetterOrderDeleteOne {
+	return c.DeleteOneID(dlo.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IdentityVerificationRequestClient) DeleteOneID(id uuid.UUID) *IdentityVerificationRequestDeleteOne {
-	builder := c.Delete().Where(identityverificationrequest.ID(id))
+func (c *DeadLetterOrderClient) DeleteOneID(id uuid.UUID) *DeadLetterOrderDeleteOne {
+	builder := c.Delete().Where(deadletterorder.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IdentityVerificationRequestDeleteOne{builder}
+	return &DeadLetterOrderDeleteOne{builder}
 }
 
-// Query returns a query builder for IdentityVerificationRequest.
-func (c *IdentityVerificationRequestClient) Query() *IdentityVerificationRequestQuery {
-	return &IdentityVerificationRequestQuery{
+// Query returns a query builder for DeadLetterOrder.
+func (c *DeadLetterOrderClient) Query() *DeadLetterOrderQuery {
+	return &DeadLetterOrderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIdentityVerificationRequest},
+		ctx:    &QueryContext{Type: TypeDeadLetterOrder},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a IdentityVerificationRequest entity by its id.
-func (c *IdentityVerificationRequestClient) Get(ctx context.Context, id uuid.UUID) (*IdentityVerificationRequest, error) {
-	return c.Query().Where(identityverificationrequest.ID(id)).Only(ctx)
+// Get returns a DeadLetterOrder entity by its id.
+func (c *DeadLetterOrderClient) Get(ctx context.Context, id uuid.UUID) (*DeadLetterOrder, error) {
+	return c.Query().Where(deadletterorder.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IdentityVerificationRequestClient) GetX(ctx context.Context, id uuid.UUID) *IdentityVerificationRequest {
+func (c *DeadLetterOrderClient) GetX(ctx context.Context, id uuid.UUID) *DeadLetterOrder {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -854,132 +940,148 @@ func (c *IdentityVerificationRequestClient) GetX(ctx context.Context, id uuid.UU
 	return obj
 }
 
+// QueryLockPaymentOrder queries the lock_payment_order edge of a DeadLetterOrder.
+func (c *DeadLetterOrderClient) QueryLockPaymentOrder(dlo *DeadLetterOrder) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dlo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deadletterorder.Table, deadletterorder.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, deadletterorder.LockPaymentOrderTable, deadletterorder.LockPaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(dlo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *IdentityVerificationRequestClient) Hooks() []Hook {
-	return c.hooks.IdentityVerificationRequest
+func (c *DeadLetterOrderClient) Hooks() []Hook {
+	return c.hooks.DeadLetterOrder
 }
 
 // Interceptors returns the client interceptors.
-func (c *IdentityVerificationRequestClient) Interceptors() []Interceptor {
-	return c.inters.IdentityVerificationRequest
+func (c *DeadLetterOrderClient) Interceptors() []Interceptor {
+	return c.inters.DeadLetterOrder
 }
 
-func (c *IdentityVerificationRequestClient) mutate(ctx context.Context, m *IdentityVerificationRequestMutation) (Value, error) {
+func (c *DeadLetterOrderClient) mutate(ctx context.Context, m *DeadLetterOrderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DeadLetterOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DeadLetterOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IdentityVerificationRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DeadLetterOrder mutation op: %q", m.Op())
 	}
 }
 
-// InstitutionClient is a client for the Institution schema.
-type InstitutionClient struct {
+// DisputeClient is a client for the Dispute schema.
+type DisputeClient struct {
 	config
 }
 
-// NewInstitutionClient returns a client for the Institution from the given config.
-func NewInstitutionClient(c config) *InstitutionClient {
-	return &InstitutionClient{config: c}
+// NewDisputeClient returns a client for the Dispute from the given config.
+func NewDisputeClient(c config) *DisputeClient {
+	return &DisputeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `institution.Hooks(f(g(h())))`.
-func (c *InstitutionClient) Use(hooks ...Hook) {
-	c.hooks.Institution = append(c.hooks.Institution, hooks...)
+// A call to `Use(f, g, h)` equals to `dispute.Hooks(f(g(h())))`.
+func (c *DisputeClient) Use(hooks ...Hook) {
+	c.hooks.Dispute = append(c.hooks.Dispute, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `institution.Intercept(f(g(h())))`.
-func (c *InstitutionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Institution = append(c.inters.Institution, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dispute.Intercept(f(g(h())))`.
+func (c *DisputeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Dispute = append(c.inters.Dispute, interceptors...)
 }
 
-// Create returns a builder for creating a Institution entity.
-func (c *InstitutionClient) Create() *InstitutionCreate {
-	mutation := newInstitutionMutation(c.config, OpCreate)
-	return &InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Dispute entity.
+func (c *DisputeClient) Create() *DisputeCreate {
+	mutation := newDisputeMutation(c.config, OpCreate)
+	return &DisputeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Institution entities.
-func (c *InstitutionClient) CreateBulk(builders ...*InstitutionCreate) *InstitutionCreateBulk {
-	return &InstitutionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Dispute entities.
+func (c *DisputeClient) CreateBulk(builders ...*DisputeCreate) *DisputeCreateBulk {
+	return &DisputeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InstitutionClient) MapCreateBulk(slice any, setFunc func(*InstitutionCreate, int)) *InstitutionCreateBulk {
+func (c *DisputeClient) MapCreateBulk(slice any, setFunc func(*DisputeCreate, int)) *DisputeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InstitutionCreateBulk{err: fmt.Errorf("calling to InstitutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DisputeCreateBulk{err: fmt.Errorf("calling to DisputeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InstitutionCreate, rv.Len())
+	builders := make([]*DisputeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InstitutionCreateBulk{config: c.config, builders: builders}
+	return &DisputeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Institution.
-func (c *InstitutionClient) Update() *InstitutionUpdate {
-	mutation := newInstitutionMutation(c.config, OpUpdate)
-	return &InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Dispute.
+func (c *DisputeClient) Update() *DisputeUpdate {
+	mutation := newDisputeMutation(c.config, OpUpdate)
+	return &DisputeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InstitutionClient) UpdateOne(i *Institution) *InstitutionUpdateOne {
-	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitution(i))
-	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DisputeClient) UpdateOne(d *Dispute) *DisputeUpdateOne {
+	mutation := newDisputeMutation(c.config, OpUpdateOne, withDispute(d))
+	return &DisputeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InstitutionClient) UpdateOneID(id int) *InstitutionUpdateOne {
-	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitutionID(id))
-	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DisputeClient) UpdateOneID(id uuid.UUID) *DisputeUpdateOne {
+	mutation := newDisputeMutation(c.config, OpUpdateOne, withDisputeID(id))
+	return &DisputeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Institution.
-func (c *InstitutionClient) Delete() *InstitutionDelete {
-	mutation := newInstitutionMutation(c.config, OpDelete)
-	return &InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Dispute.
+func (c *DisputeClient) Delete() *DisputeDelete {
+	mutation := newDisputeMutation(c.config, OpDelete)
+	return &DisputeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InstitutionClient) DeleteOne(i *Institution) *InstitutionDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *DisputeClient) DeleteOne(d *Dispute) *DisputeDeleteOne {
+	return c.DeleteOneID(d.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InstitutionClient) DeleteOneID(id int) *InstitutionDeleteOne {
-	builder := c.Delete().Where(institution.ID(id))
+func (c *DisputeClient) DeleteOneID(id uuid.UUID) *DisputeDeleteOne {
+	builder := c.Delete().Where(dispute.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InstitutionDeleteOne{builder}
+	return &DisputeDeleteOne{builder}
 }
 
-// Query returns a query builder for Institution.
-func (c *InstitutionClient) Query() *InstitutionQuery {
-	return &InstitutionQuery{
+// Query returns a query builder for Dispute.
+func (c *DisputeClient) Query() *DisputeQuery {
+	return &DisputeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInstitution},
+		ctx:    &QueryContext{Type: TypeDispute},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Institution entity by its id.
-func (c *InstitutionClient) Get(ctx context.Context, id int) (*Institution, error) {
-	return c.Query().Where(institution.ID(id)).Only(ctx)
+// Get returns a Dispute entity by its id.
+func (c *DisputeClient) Get(ctx context.Context, id uuid.UUID) (*Dispute, error) {
+	return c.Query().Where(dispute.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InstitutionClient) GetX(ctx context.Context, id int) *Institution {
+func (c *DisputeClient) GetX(ctx context.Context, id uuid.UUID) *Dispute {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -987,148 +1089,196 @@ func (c *InstitutionClient) GetX(ctx context.Context, id int) *Institution {
 	return obj
 }
 
-// QueryFiatCurrency queries the fiat_currency edge of a Institution.
-func (c *InstitutionClient) QueryFiatCurrency(i *Institution) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
+// QueryPaymentOrder queries the payment_order edge of a Dispute.
+func (c *DisputeClient) QueryPaymentOrder(d *Dispute) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
+		id := d.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(institution.Table, institution.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, institution.FiatCurrencyTable, institution.FiatCurrencyColumn),
+			sqlgraph.From(dispute.Table, dispute.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dispute.PaymentOrderTable, dispute.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *InstitutionClient) Hooks() []Hook {
-	return c.hooks.Institution
+// QueryLockPaymentOrder queries the lock_payment_order edge of a Dispute.
+func (c *DisputeClient) QueryLockPaymentOrder(d *Dispute) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dispute.Table, dispute.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dispute.LockPaymentOrderTable, dispute.LockPaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *InstitutionClient) Interceptors() []Interceptor {
-	return c.inters.Institution
+// QueryProvider queries the provider edge of a Dispute.
+func (c *DisputeClient) QueryProvider(d *Dispute) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dispute.Table, dispute.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dispute.ProviderTable, dispute.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *InstitutionClient) mutate(ctx context.Context, m *InstitutionMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+// QueryEvidence queries the evidence edge of a Dispute.
+func (c *DisputeClient) QueryEvidence(d *Dispute) *DisputeEvidenceQuery {
+	query := (&DisputeEvidenceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dispute.Table, dispute.FieldID, id),
+			sqlgraph.To(disputeevidence.Table, disputeevidence.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dispute.EvidenceTable, dispute.EvidenceColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DisputeClient) Hooks() []Hook {
+	return c.hooks.Dispute
+}
+
+// Interceptors returns the client interceptors.
+func (c *DisputeClient) Interceptors() []Interceptor {
+	return c.inters.Dispute
+}
+
+func (c *DisputeClient) mutate(ctx context.Context, m *DisputeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DisputeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DisputeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DisputeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DisputeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Institution mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Dispute mutation op: %q", m.Op())
 	}
 }
 
-// LinkedAddressClient is a client for the LinkedAddress schema.
-type LinkedAddressClient struct {
+// DisputeEvidenceClient is a client for the DisputeEvidence schema.
+type DisputeEvidenceClient struct {
 	config
 }
 
-// NewLinkedAddressClient returns a client for the LinkedAddress from the given config.
-func NewLinkedAddressClient(c config) *LinkedAddressClient {
-	return &LinkedAddressClient{config: c}
+// NewDisputeEvidenceClient returns a client for the DisputeEvidence from the given config.
+func NewDisputeEvidenceClient(c config) *DisputeEvidenceClient {
+	return &DisputeEvidenceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `linkedaddress.Hooks(f(g(h())))`.
-func (c *LinkedAddressClient) Use(hooks ...Hook) {
-	c.hooks.LinkedAddress = append(c.hooks.LinkedAddress, hooks...)
+// A call to `Use(f, g, h)` equals to `disputeevidence.Hooks(f(g(h())))`.
+func (c *DisputeEvidenceClient) Use(hooks ...Hook) {
+	c.hooks.DisputeEvidence = append(c.hooks.DisputeEvidence, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `linkedaddress.Intercept(f(g(h())))`.
-func (c *LinkedAddressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LinkedAddress = append(c.inters.LinkedAddress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `disputeevidence.Intercept(f(g(h())))`.
+func (c *DisputeEvidenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DisputeEvidence = append(c.inters.DisputeEvidence, interceptors...)
 }
 
-// Create returns a builder for creating a LinkedAddress entity.
-func (c *LinkedAddressClient) Create() *LinkedAddressCreate {
-	mutation := newLinkedAddressMutation(c.config, OpCreate)
-	return &LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DisputeEvidence entity.
+func (c *DisputeEvidenceClient) Create() *DisputeEvidenceCreate {
+	mutation := newDisputeEvidenceMutation(c.config, OpCreate)
+	return &DisputeEvidenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LinkedAddress entities.
-func (c *LinkedAddressClient) CreateBulk(builders ...*LinkedAddressCreate) *LinkedAddressCreateBulk {
-	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DisputeEvidence entities.
+func (c *DisputeEvidenceClient) CreateBulk(builders ...*DisputeEvidenceCreate) *DisputeEvidenceCreateBulk {
+	return &DisputeEvidenceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LinkedAddressClient) MapCreateBulk(slice any, setFunc func(*LinkedAddressCreate, int)) *LinkedAddressCreateBulk {
+func (c *DisputeEvidenceClient) MapCreateBulk(slice any, setFunc func(*DisputeEvidenceCreate, int)) *DisputeEvidenceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LinkedAddressCreateBulk{err: fmt.Errorf("calling to LinkedAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DisputeEvidenceCreateBulk{err: fmt.Errorf("calling to DisputeEvidenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LinkedAddressCreate, rv.Len())
+	builders := make([]*DisputeEvidenceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+	return &DisputeEvidenceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LinkedAddress.
-func (c *LinkedAddressClient) Update() *LinkedAddressUpdate {
-	mutation := newLinkedAddressMutation(c.config, OpUpdate)
-	return &LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DisputeEvidence.
+func (c *DisputeEvidenceClient) Update() *DisputeEvidenceUpdate {
+	mutation := newDisputeEvidenceMutation(c.config, OpUpdate)
+	return &DisputeEvidenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LinkedAddressClient) UpdateOne(la *LinkedAddress) *LinkedAddressUpdateOne {
-	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddress(la))
-	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DisputeEvidenceClient) UpdateOne(de *DisputeEvidence) *DisputeEvidenceUpdateOne {
+	mutation := newDisputeEvidenceMutation(c.config, OpUpdateOne, withDisputeEvidence(de))
+	return &DisputeEvidenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LinkedAddressClient) UpdateOneID(id int) *LinkedAddressUpdateOne {
-	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddressID(id))
-	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DisputeEvidenceClient) UpdateOneID(id uuid.UUID) *DisputeEvidenceUpdateOne {
+	mutation := newDisputeEvidenceMutation(c.config, OpUpdateOne, withDisputeEvidenceID(id))
+	return &DisputeEvidenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LinkedAddress.
-func (c *LinkedAddressClient) Delete() *LinkedAddressDelete {
-	mutation := newLinkedAddressMutation(c.config, OpDelete)
-	return &LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DisputeEvidence.
+func (c *DisputeEvidenceClient) Delete() *DisputeEvidenceDelete {
+	mutation := newDisputeEvidenceMutation(c.config, OpDelete)
+	return &DisputeEvidenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LinkedAddressClient) DeleteOne(la *LinkedAddress) *LinkedAddressDeleteOne {
-	return c.DeleteOneID(la.ID)
+func (c *DisputeEvidenceClient) DeleteOne(de *DisputeEvidence) *DisputeEvidenceDeleteOne {
+	return c.DeleteOneID(de.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LinkedAddressClient) DeleteOneID(id int) *LinkedAddressDeleteOne {
-	builder := c.Delete().Where(linkedaddress.ID(id))
+func (c *DisputeEvidenceClient) DeleteOneID(id uuid.UUID) *DisputeEvidenceDeleteOne {
+	builder := c.Delete().Where(disputeevidence.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LinkedAddressDeleteOne{builder}
+	return &DisputeEvidenceDeleteOne{builder}
 }
 
-// Query returns a query builder for LinkedAddress.
-func (c *LinkedAddressClient) Query() *LinkedAddressQuery {
-	return &LinkedAddressQuery{
+// Query returns a query builder for DisputeEvidence.
+func (c *DisputeEvidenceClient) Query() *DisputeEvidenceQuery {
+	return &DisputeEvidenceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLinkedAddress},
+		ctx:    &QueryContext{Type: TypeDisputeEvidence},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LinkedAddress entity by its id.
-func (c *LinkedAddressClient) Get(ctx context.Context, id int) (*LinkedAddress, error) {
-	return c.Query().Where(linkedaddress.ID(id)).Only(ctx)
+// Get returns a DisputeEvidence entity by its id.
+func (c *DisputeEvidenceClient) Get(ctx context.Context, id uuid.UUID) (*DisputeEvidence, error) {
+	return c.Query().Where(disputeevidence.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LinkedAddressClient) GetX(ctx context.Context, id int) *LinkedAddress {
+func (c *DisputeEvidenceClient) GetX(ctx context.Context, id uuid.UUID) *DisputeEvidence {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1136,148 +1286,148 @@ func (c *LinkedAddressClient) GetX(ctx context.Context, id int) *LinkedAddress {
 	return obj
 }
 
-// QueryPaymentOrders queries the payment_orders edge of a LinkedAddress.
-func (c *LinkedAddressClient) QueryPaymentOrders(la *LinkedAddress) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
+// QueryDispute queries the dispute edge of a DisputeEvidence.
+func (c *DisputeEvidenceClient) QueryDispute(de *DisputeEvidence) *DisputeQuery {
+	query := (&DisputeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := la.ID
+		id := de.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(linkedaddress.Table, linkedaddress.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, linkedaddress.PaymentOrdersTable, linkedaddress.PaymentOrdersColumn),
+			sqlgraph.From(disputeevidence.Table, disputeevidence.FieldID, id),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, disputeevidence.DisputeTable, disputeevidence.DisputeColumn),
 		)
-		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(de.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LinkedAddressClient) Hooks() []Hook {
-	return c.hooks.LinkedAddress
+func (c *DisputeEvidenceClient) Hooks() []Hook {
+	return c.hooks.DisputeEvidence
 }
 
 // Interceptors returns the client interceptors.
-func (c *LinkedAddressClient) Interceptors() []Interceptor {
-	return c.inters.LinkedAddress
+func (c *DisputeEvidenceClient) Interceptors() []Interceptor {
+	return c.inters.DisputeEvidence
 }
 
-func (c *LinkedAddressClient) mutate(ctx context.Context, m *LinkedAddressMutation) (Value, error) {
+func (c *DisputeEvidenceClient) mutate(ctx context.Context, m *DisputeEvidenceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DisputeEvidenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DisputeEvidenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DisputeEvidenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DisputeEvidenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LinkedAddress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DisputeEvidence mutation op: %q", m.Op())
 	}
 }
 
-// LockOrderFulfillmentClient is a client for the LockOrderFulfillment schema.
-type LockOrderFulfillmentClient struct {
+// FiatCurrencyClient is a client for the FiatCurrency schema.
+type FiatCurrencyClient struct {
 	config
 }
 
-// NewLockOrderFulfillmentClient returns a client for the LockOrderFulfillment from the given config.
-func NewLockOrderFulfillmentClient(c config) *LockOrderFulfillmentClient {
-	return &LockOrderFulfillmentClient{config: c}
+// NewFiatCurrencyClient returns a client for the FiatCurrency from the given config.
+func NewFiatCurrencyClient(c config) *FiatCurrencyClient {
+	return &FiatCurrencyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lockorderfulfillment.Hooks(f(g(h())))`.
-func (c *LockOrderFulfillmentClient) Use(hooks ...Hook) {
-	c.hooks.LockOrderFulfillment = append(c.hooks.LockOrderFulfillment, hooks...)
+// A call to `Use(f, g, h)` equals to `fiatcurrency.Hooks(f(g(h())))`.
+func (c *FiatCurrencyClient) Use(hooks ...Hook) {
+	c.hooks.FiatCurrency = append(c.hooks.FiatCurrency, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lockorderfulfillment.Intercept(f(g(h())))`.
-func (c *LockOrderFulfillmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LockOrderFulfillment = append(c.inters.LockOrderFulfillment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `fiatcurrency.Intercept(f(g(h())))`.
+func (c *FiatCurrencyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FiatCurrency = append(c.inters.FiatCurrency, interceptors...)
 }
 
-// Create returns a builder for creating a LockOrderFulfillment entity.
-func (c *LockOrderFulfillmentClient) Create() *LockOrderFulfillmentCreate {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpCreate)
-	return &LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FiatCurrency entity.
+func (c *FiatCurrencyClient) Create() *FiatCurrencyCreate {
+	mutation := newFiatCurrencyMutation(c.config, OpCreate)
+	return &FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LockOrderFulfillment entities.
-func (c *LockOrderFulfillmentClient) CreateBulk(builders ...*LockOrderFulfillmentCreate) *LockOrderFulfillmentCreateBulk {
-	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FiatCurrency entities.
+func (c *FiatCurrencyClient) CreateBulk(builders ...*FiatCurrencyCreate) *FiatCurrencyCreateBulk {
+	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LockOrderFulfillmentClient) MapCreateBulk(slice any, setFunc func(*LockOrderFulfillmentCreate, int)) *LockOrderFulfillmentCreateBulk {
+func (c *FiatCurrencyClient) MapCreateBulk(slice any, setFunc func(*FiatCurrencyCreate, int)) *FiatCurrencyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LockOrderFulfillmentCreateBulk{err: fmt.Errorf("calling to LockOrderFulfillmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FiatCurrencyCreateBulk{err: fmt.Errorf("calling to FiatCurrencyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LockOrderFulfillmentCreate, rv.Len())
+	builders := make([]*FiatCurrencyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+	return &FiatCurrencyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) Update() *LockOrderFulfillmentUpdate {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdate)
-	return &LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FiatCurrency.
+func (c *FiatCurrencyClient) Update() *FiatCurrencyUpdate {
+	mutation := newFiatCurrencyMutation(c.config, OpUpdate)
+	return &FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LockOrderFulfillmentClient) UpdateOne(lof *LockOrderFulfillment) *LockOrderFulfillmentUpdateOne {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillment(lof))
-	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FiatCurrencyClient) UpdateOne(fc *FiatCurrency) *FiatCurrencyUpdateOne {
+	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrency(fc))
+	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LockOrderFulfillmentClient) UpdateOneID(id uuid.UUID) *LockOrderFulfillmentUpdateOne {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillmentID(id))
-	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FiatCurrencyClient) UpdateOneID(id uuid.UUID) *FiatCurrencyUpdateOne {
+	mutation := newFiatCurrencyMutation(c.config, OpUpdateOne, withFiatCurrencyID(id))
+	return &FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) Delete() *LockOrderFulfillmentDelete {
-	mutation := newLockOrderFulfillmentMutation(c.config, OpDelete)
-	return &LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FiatCurrency.
+func (c *FiatCurrencyClient) Delete() *FiatCurrencyDelete {
+	mutation := newFiatCurrencyMutation(c.config, OpDelete)
+	return &FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LockOrderFulfillmentClient) DeleteOne(lof *LockOrderFulfillment) *LockOrderFulfillmentDeleteOne {
-	return c.DeleteOneID(lof.ID)
+func (c *FiatCurrencyClient) DeleteOne(fc *FiatCurrency) *FiatCurrencyDeleteOne {
+	return c.DeleteOneID(fc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LockOrderFulfillmentClient) DeleteOneID(id uuid.UUID) *LockOrderFulfillmentDeleteOne {
-	builder := c.Delete().Where(lockorderfulfillment.ID(id))
+func (c *FiatCurrencyClient) DeleteOneID(id uuid.UUID) *FiatCurrencyDeleteOne {
+	builder := c.Delete().Where(fiatcurrency.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LockOrderFulfillmentDeleteOne{builder}
+	return &FiatCurrencyDeleteOne{builder}
 }
 
-// Query returns a query builder for LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) Query() *LockOrderFulfillmentQuery {
-	return &LockOrderFulfillmentQuery{
+// Query returns a query builder for FiatCurrency.
+func (c *FiatCurrencyClient) Query() *FiatCurrencyQuery {
+	return &FiatCurrencyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLockOrderFulfillment},
+		ctx:    &QueryContext{Type: TypeFiatCurrency},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LockOrderFulfillment entity by its id.
-func (c *LockOrderFulfillmentClient) Get(ctx context.Context, id uuid.UUID) (*LockOrderFulfillment, error) {
-	return c.Query().Where(lockorderfulfillment.ID(id)).Only(ctx)
+// Get returns a FiatCurrency entity by its id.
+func (c *FiatCurrencyClient) Get(ctx context.Context, id uuid.UUID) (*FiatCurrency, error) {
+	return c.Query().Where(fiatcurrency.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LockOrderFulfillmentClient) GetX(ctx context.Context, id uuid.UUID) *LockOrderFulfillment {
+func (c *FiatCurrencyClient) GetX(ctx context.Context, id uuid.UUID) *FiatCurrency {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1285,44 +1435,752 @@ func (c *LockOrderFulfillmentClient) GetX(ctx context.Context, id uuid.UUID) *Lo
 	return obj
 }
 
-// QueryOrder queries the order edge of a LockOrderFulfillment.
-func (c *LockOrderFulfillmentClient) QueryOrder(lof *LockOrderFulfillment) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryProviders queries the providers edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProviders(fc *FiatCurrency) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lof.ID
+		id := fc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lockorderfulfillment.Table, lockorderfulfillment.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockorderfulfillment.OrderTable, lockorderfulfillment.OrderColumn),
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, fiatcurrency.ProvidersTable, fiatcurrency.ProvidersPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(lof.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *LockOrderFulfillmentClient) Hooks() []Hook {
-	return c.hooks.LockOrderFulfillment
+// QueryProvisionBuckets queries the provision_buckets edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProvisionBuckets(fc *FiatCurrency) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProvisionBucketsTable, fiatcurrency.ProvisionBucketsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *LockOrderFulfillmentClient) Interceptors() []Interceptor {
-	return c.inters.LockOrderFulfillment
+// QueryInstitutions queries the institutions edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryInstitutions(fc *FiatCurrency) *InstitutionQuery {
+	query := (&InstitutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(institution.Table, institution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.InstitutionsTable, fiatcurrency.InstitutionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *LockOrderFulfillmentClient) mutate(ctx context.Context, m *LockOrderFulfillmentMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdateOne:
-		return (&LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpDelete, OpDeleteOne:
-		return (&LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
-	default:
-		return nil, fmt.Errorf("ent: unknown LockOrderFulfillment mutation op: %q", m.Op())
+// QueryProviderOrderTokens queries the provider_order_tokens edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryProviderOrderTokens(fc *FiatCurrency) *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderOrderTokensTable, fiatcurrency.ProviderOrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPublicHolidays queries the public_holidays edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryPublicHolidays(fc *FiatCurrency) *PublicHolidayQuery {
+	query := (&PublicHolidayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(publicholiday.Table, publicholiday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.PublicHolidaysTable, fiatcurrency.PublicHolidaysColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBucketProposals queries the bucket_proposals edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryBucketProposals(fc *FiatCurrency) *BucketProposalQuery {
+	query := (&BucketProposalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(bucketproposal.Table, bucketproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.BucketProposalsTable, fiatcurrency.BucketProposalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRateSnapshots queries the rate_snapshots edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryRateSnapshots(fc *FiatCurrency) *RateSnapshotQuery {
+	query := (&RateSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(ratesnapshot.Table, ratesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.RateSnapshotsTable, fiatcurrency.RateSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRateCircuitBreaker queries the rate_circuit_breaker edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryRateCircuitBreaker(fc *FiatCurrency) *RateCircuitBreakerQuery {
+	query := (&RateCircuitBreakerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(ratecircuitbreaker.Table, ratecircuitbreaker.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, fiatcurrency.RateCircuitBreakerTable, fiatcurrency.RateCircuitBreakerColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTokenMarketRates queries the token_market_rates edge of a FiatCurrency.
+func (c *FiatCurrencyClient) QueryTokenMarketRates(fc *FiatCurrency) *TokenMarketRateQuery {
+	query := (&TokenMarketRateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := fc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, id),
+			sqlgraph.To(tokenmarketrate.Table, tokenmarketrate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.TokenMarketRatesTable, fiatcurrency.TokenMarketRatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(fc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FiatCurrencyClient) Hooks() []Hook {
+	return c.hooks.FiatCurrency
+}
+
+// Interceptors returns the client interceptors.
+func (c *FiatCurrencyClient) Interceptors() []Interceptor {
+	return c.inters.FiatCurrency
+}
+
+func (c *FiatCurrencyClient) mutate(ctx context.Context, m *FiatCurrencyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FiatCurrencyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FiatCurrencyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FiatCurrencyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FiatCurrencyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FiatCurrency mutation op: %q", m.Op())
+	}
+}
+
+// IdentityVerificationRequestClient is a client for the IdentityVerificationRequest schema.
+type IdentityVerificationRequestClient struct {
+	config
+}
+
+// NewIdentityVerificationRequestClient returns a client for the IdentityVerificationRequest from the given config.
+func NewIdentityVerificationRequestClient(c config) *IdentityVerificationRequestClient {
+	return &IdentityVerificationRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identityverificationrequest.Hooks(f(g(h())))`.
+func (c *IdentityVerificationRequestClient) Use(hooks ...Hook) {
+	c.hooks.IdentityVerificationRequest = append(c.hooks.IdentityVerificationRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identityverificationrequest.Intercept(f(g(h())))`.
+func (c *IdentityVerificationRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdentityVerificationRequest = append(c.inters.IdentityVerificationRequest, interceptors...)
+}
+
+// Create returns a builder for creating a IdentityVerificationRequest entity.
+func (c *IdentityVerificationRequestClient) Create() *IdentityVerificationRequestCreate {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpCreate)
+	return &IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdentityVerificationRequest entities.
+func (c *IdentityVerificationRequestClient) CreateBulk(builders ...*IdentityVerificationRequestCreate) *IdentityVerificationRequestCreateBulk {
+	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityVerificationRequestClient) MapCreateBulk(slice any, setFunc func(*IdentityVerificationRequestCreate, int)) *IdentityVerificationRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityVerificationRequestCreateBulk{err: fmt.Errorf("calling to IdentityVerificationRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityVerificationRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityVerificationRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdentityVerificationRequest.
+func (c *IdentityVerificationRequestClient) Update() *IdentityVerificationRequestUpdate {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdate)
+	return &IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityVerificationRequestClient) UpdateOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestUpdateOne {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequest(ivr))
+	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityVerificationRequestClient) UpdateOneID(id uuid.UUID) *IdentityVerificationRequestUpdateOne {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpUpdateOne, withIdentityVerificationRequestID(id))
+	return &IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdentityVerificationRequest.
+func (c *IdentityVerificationRequestClient) Delete() *IdentityVerificationRequestDelete {
+	mutation := newIdentityVerificationRequestMutation(c.config, OpDelete)
+	return &IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityVerificationRequestClient) DeleteOne(ivr *IdentityVerificationRequest) *IdentityVerificationRequestDeleteOne {
+	return c.DeleteOneID(ivr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityVerificationRequestClient) DeleteOneID(id uuid.UUID) *IdentityVerificationRequestDeleteOne {
+	builder := c.Delete().Where(identityverificationrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityVerificationRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for IdentityVerificationRequest.
+func (c *IdentityVerificationRequestClient) Query() *IdentityVerificationRequestQuery {
+	return &IdentityVerificationRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentityVerificationRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdentityVerificationRequest entity by its id.
+func (c *IdentityVerificationRequestClient) Get(ctx context.Context, id uuid.UUID) (*IdentityVerificationRequest, error) {
+	return c.Query().Where(identityverificationrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityVerificationRequestClient) GetX(ctx context.Context, id uuid.UUID) *IdentityVerificationRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityVerificationRequestClient) Hooks() []Hook {
+	return c.hooks.IdentityVerificationRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityVerificationRequestClient) Interceptors() []Interceptor {
+	return c.inters.IdentityVerificationRequest
+}
+
+func (c *IdentityVerificationRequestClient) mutate(ctx context.Context, m *IdentityVerificationRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityVerificationRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityVerificationRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityVerificationRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityVerificationRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdentityVerificationRequest mutation op: %q", m.Op())
+	}
+}
+
+// InstitutionClient is a client for the Institution schema.
+type InstitutionClient struct {
+	config
+}
+
+// NewInstitutionClient returns a client for the Institution from the given config.
+func NewInstitutionClient(c config) *InstitutionClient {
+	return &InstitutionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `institution.Hooks(f(g(h())))`.
+func (c *InstitutionClient) Use(hooks ...Hook) {
+	c.hooks.Institution = append(c.hooks.Institution, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `institution.Intercept(f(g(h())))`.
+func (c *InstitutionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Institution = append(c.inters.Institution, interceptors...)
+}
+
+// Create returns a builder for creating a Institution entity.
+func (c *InstitutionClient) Create() *InstitutionCreate {
+	mutation := newInstitutionMutation(c.config, OpCreate)
+	return &InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Institution entities.
+func (c *InstitutionClient) CreateBulk(builders ...*InstitutionCreate) *InstitutionCreateBulk {
+	return &InstitutionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InstitutionClient) MapCreateBulk(slice any, setFunc func(*InstitutionCreate, int)) *InstitutionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InstitutionCreateBulk{err: fmt.Errorf("calling to InstitutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InstitutionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InstitutionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Institution.
+func (c *InstitutionClient) Update() *InstitutionUpdate {
+	mutation := newInstitutionMutation(c.config, OpUpdate)
+	return &InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InstitutionClient) UpdateOne(i *Institution) *InstitutionUpdateOne {
+	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitution(i))
+	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InstitutionClient) UpdateOneID(id int) *InstitutionUpdateOne {
+	mutation := newInstitutionMutation(c.config, OpUpdateOne, withInstitutionID(id))
+	return &InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Institution.
+func (c *InstitutionClient) Delete() *InstitutionDelete {
+	mutation := newInstitutionMutation(c.config, OpDelete)
+	return &InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InstitutionClient) DeleteOne(i *Institution) *InstitutionDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InstitutionClient) DeleteOneID(id int) *InstitutionDeleteOne {
+	builder := c.Delete().Where(institution.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InstitutionDeleteOne{builder}
+}
+
+// Query returns a query builder for Institution.
+func (c *InstitutionClient) Query() *InstitutionQuery {
+	return &InstitutionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInstitution},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Institution entity by its id.
+func (c *InstitutionClient) Get(ctx context.Context, id int) (*Institution, error) {
+	return c.Query().Where(institution.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InstitutionClient) GetX(ctx context.Context, id int) *Institution {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFiatCurrency queries the fiat_currency edge of a Institution.
+func (c *InstitutionClient) QueryFiatCurrency(i *Institution) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(institution.Table, institution.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, institution.FiatCurrencyTable, institution.FiatCurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InstitutionClient) Hooks() []Hook {
+	return c.hooks.Institution
+}
+
+// Interceptors returns the client interceptors.
+func (c *InstitutionClient) Interceptors() []Interceptor {
+	return c.inters.Institution
+}
+
+func (c *InstitutionClient) mutate(ctx context.Context, m *InstitutionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InstitutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InstitutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InstitutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InstitutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Institution mutation op: %q", m.Op())
+	}
+}
+
+// LinkedAddressClient is a client for the LinkedAddress schema.
+type LinkedAddressClient struct {
+	config
+}
+
+// NewLinkedAddressClient returns a client for the LinkedAddress from the given config.
+func NewLinkedAddressClient(c config) *LinkedAddressClient {
+	return &LinkedAddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `linkedaddress.Hooks(f(g(h())))`.
+func (c *LinkedAddressClient) Use(hooks ...Hook) {
+	c.hooks.LinkedAddress = append(c.hooks.LinkedAddress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `linkedaddress.Intercept(f(g(h())))`.
+func (c *LinkedAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LinkedAddress = append(c.inters.LinkedAddress, interceptors...)
+}
+
+// Create returns a builder for creating a LinkedAddress entity.
+func (c *LinkedAddressClient) Create() *LinkedAddressCreate {
+	mutation := newLinkedAddressMutation(c.config, OpCreate)
+	return &LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LinkedAddress entities.
+func (c *LinkedAddressClient) CreateBulk(builders ...*LinkedAddressCreate) *LinkedAddressCreateBulk {
+	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LinkedAddressClient) MapCreateBulk(slice any, setFunc func(*LinkedAddressCreate, int)) *LinkedAddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LinkedAddressCreateBulk{err: fmt.Errorf("calling to LinkedAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LinkedAddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LinkedAddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LinkedAddress.
+func (c *LinkedAddressClient) Update() *LinkedAddressUpdate {
+	mutation := newLinkedAddressMutation(c.config, OpUpdate)
+	return &LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LinkedAddressClient) UpdateOne(la *LinkedAddress) *LinkedAddressUpdateOne {
+	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddress(la))
+	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LinkedAddressClient) UpdateOneID(id int) *LinkedAddressUpdateOne {
+	mutation := newLinkedAddressMutation(c.config, OpUpdateOne, withLinkedAddressID(id))
+	return &LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LinkedAddress.
+func (c *LinkedAddressClient) Delete() *LinkedAddressDelete {
+	mutation := newLinkedAddressMutation(c.config, OpDelete)
+	return &LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LinkedAddressClient) DeleteOne(la *LinkedAddress) *LinkedAddressDeleteOne {
+	return c.DeleteOneID(la.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LinkedAddressClient) DeleteOneID(id int) *LinkedAddressDeleteOne {
+	builder := c.Delete().Where(linkedaddress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LinkedAddressDeleteOne{builder}
+}
+
+// Query returns a query builder for LinkedAddress.
+func (c *LinkedAddressClient) Query() *LinkedAddressQuery {
+	return &LinkedAddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLinkedAddress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LinkedAddress entity by its id.
+func (c *LinkedAddressClient) Get(ctx context.Context, id int) (*LinkedAddress, error) {
+	return c.Query().Where(linkedaddress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LinkedAddressClient) GetX(ctx context.Context, id int) *LinkedAddress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPaymentOrders queries the payment_orders edge of a LinkedAddress.
+func (c *LinkedAddressClient) QueryPaymentOrders(la *LinkedAddress) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := la.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(linkedaddress.Table, linkedaddress.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, linkedaddress.PaymentOrdersTable, linkedaddress.PaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(la.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LinkedAddressClient) Hooks() []Hook {
+	return c.hooks.LinkedAddress
+}
+
+// Interceptors returns the client interceptors.
+func (c *LinkedAddressClient) Interceptors() []Interceptor {
+	return c.inters.LinkedAddress
+}
+
+func (c *LinkedAddressClient) mutate(ctx context.Context, m *LinkedAddressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LinkedAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LinkedAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LinkedAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LinkedAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LinkedAddress mutation op: %q", m.Op())
+	}
+}
+
+// LockOrderFulfillmentClient is a client for the LockOrderFulfillment schema.
+type LockOrderFulfillmentClient struct {
+	config
+}
+
+// NewLockOrderFulfillmentClient returns a client for the LockOrderFulfillment from the given config.
+func NewLockOrderFulfillmentClient(c config) *LockOrderFulfillmentClient {
+	return &LockOrderFulfillmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lockorderfulfillment.Hooks(f(g(h())))`.
+func (c *LockOrderFulfillmentClient) Use(hooks ...Hook) {
+	c.hooks.LockOrderFulfillment = append(c.hooks.LockOrderFulfillment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lockorderfulfillment.Intercept(f(g(h())))`.
+func (c *LockOrderFulfillmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LockOrderFulfillment = append(c.inters.LockOrderFulfillment, interceptors...)
+}
+
+// Create returns a builder for creating a LockOrderFulfillment entity.
+func (c *LockOrderFulfillmentClient) Create() *LockOrderFulfillmentCreate {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpCreate)
+	return &LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LockOrderFulfillment entities.
+func (c *LockOrderFulfillmentClient) CreateBulk(builders ...*LockOrderFulfillmentCreate) *LockOrderFulfillmentCreateBulk {
+	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LockOrderFulfillmentClient) MapCreateBulk(slice any, setFunc func(*LockOrderFulfillmentCreate, int)) *LockOrderFulfillmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LockOrderFulfillmentCreateBulk{err: fmt.Errorf("calling to LockOrderFulfillmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LockOrderFulfillmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LockOrderFulfillmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) Update() *LockOrderFulfillmentUpdate {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdate)
+	return &LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LockOrderFulfillmentClient) UpdateOne(lof *LockOrderFulfillment) *LockOrderFulfillmentUpdateOne {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillment(lof))
+	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LockOrderFulfillmentClient) UpdateOneID(id uuid.UUID) *LockOrderFulfillmentUpdateOne {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpUpdateOne, withLockOrderFulfillmentID(id))
+	return &LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) Delete() *LockOrderFulfillmentDelete {
+	mutation := newLockOrderFulfillmentMutation(c.config, OpDelete)
+	return &LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LockOrderFulfillmentClient) DeleteOne(lof *LockOrderFulfillment) *LockOrderFulfillmentDeleteOne {
+	return c.DeleteOneID(lof.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LockOrderFulfillmentClient) DeleteOneID(id uuid.UUID) *LockOrderFulfillmentDeleteOne {
+	builder := c.Delete().Where(lockorderfulfillment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LockOrderFulfillmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) Query() *LockOrderFulfillmentQuery {
+	return &LockOrderFulfillmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLockOrderFulfillment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LockOrderFulfillment entity by its id.
+func (c *LockOrderFulfillmentClient) Get(ctx context.Context, id uuid.UUID) (*LockOrderFulfillment, error) {
+	return c.Query().Where(lockorderfulfillment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LockOrderFulfillmentClient) GetX(ctx context.Context, id uuid.UUID) *LockOrderFulfillment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a LockOrderFulfillment.
+func (c *LockOrderFulfillmentClient) QueryOrder(lof *LockOrderFulfillment) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lof.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockorderfulfillment.Table, lockorderfulfillment.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockorderfulfillment.OrderTable, lockorderfulfillment.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(lof.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LockOrderFulfillmentClient) Hooks() []Hook {
+	return c.hooks.LockOrderFulfillment
+}
+
+// Interceptors returns the client interceptors.
+func (c *LockOrderFulfillmentClient) Interceptors() []Interceptor {
+	return c.inters.LockOrderFulfillment
+}
+
+func (c *LockOrderFulfillmentClient) mutate(ctx context.Context, m *LockOrderFulfillmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LockOrderFulfillmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LockOrderFulfillmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LockOrderFulfillmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LockOrderFulfillmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LockOrderFulfillment mutation op: %q", m.Op())
 	}
 }
 
@@ -1331,102 +2189,1774 @@ type LockPaymentOrderClient struct {
 	config
 }
 
-// NewLockPaymentOrderClient returns a client for the LockPaymentOrder from the given config.
-func NewLockPaymentOrderClient(c config) *LockPaymentOrderClient {
-	return &LockPaymentOrderClient{config: c}
+// NewLockPaymentOrderClient returns a client for the LockPaymentOrder from the given config.
+func NewLockPaymentOrderClient(c config) *LockPaymentOrderClient {
+	return &LockPaymentOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lockpaymentorder.Hooks(f(g(h())))`.
+func (c *LockPaymentOrderClient) Use(hooks ...Hook) {
+	c.hooks.LockPaymentOrder = append(c.hooks.LockPaymentOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lockpaymentorder.Intercept(f(g(h())))`.
+func (c *LockPaymentOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LockPaymentOrder = append(c.inters.LockPaymentOrder, interceptors...)
+}
+
+// Create returns a builder for creating a LockPaymentOrder entity.
+func (c *LockPaymentOrderClient) Create() *LockPaymentOrderCreate {
+	mutation := newLockPaymentOrderMutation(c.config, OpCreate)
+	return &LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LockPaymentOrder entities.
+func (c *LockPaymentOrderClient) CreateBulk(builders ...*LockPaymentOrderCreate) *LockPaymentOrderCreateBulk {
+	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LockPaymentOrderClient) MapCreateBulk(slice any, setFunc func(*LockPaymentOrderCreate, int)) *LockPaymentOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LockPaymentOrderCreateBulk{err: fmt.Errorf("calling to LockPaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LockPaymentOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LockPaymentOrder.
+func (c *LockPaymentOrderClient) Update() *LockPaymentOrderUpdate {
+	mutation := newLockPaymentOrderMutation(c.config, OpUpdate)
+	return &LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LockPaymentOrderClient) UpdateOne(lpo *LockPaymentOrder) *LockPaymentOrderUpdateOne {
+	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrder(lpo))
+	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LockPaymentOrderClient) UpdateOneID(id uuid.UUID) *LockPaymentOrderUpdateOne {
+	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrderID(id))
+	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LockPaymentOrder.
+func (c *LockPaymentOrderClient) Delete() *LockPaymentOrderDelete {
+	mutation := newLockPaymentOrderMutation(c.config, OpDelete)
+	return &LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LockPaymentOrderClient) DeleteOne(lpo *LockPaymentOrder) *LockPaymentOrderDeleteOne {
+	return c.DeleteOneID(lpo.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LockPaymentOrderClient) DeleteOneID(id uuid.UUID) *LockPaymentOrderDeleteOne {
+	builder := c.Delete().Where(lockpaymentorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LockPaymentOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for LockPaymentOrder.
+func (c *LockPaymentOrderClient) Query() *LockPaymentOrderQuery {
+	return &LockPaymentOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLockPaymentOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LockPaymentOrder entity by its id.
+func (c *LockPaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*LockPaymentOrder, error) {
+	return c.Query().Where(lockpaymentorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LockPaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *LockPaymentOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryToken queries the token edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryToken(lpo *LockPaymentOrder) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.TokenTable, lockpaymentorder.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvisionBucket queries the provision_bucket edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryProvisionBucket(lpo *LockPaymentOrder) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProvisionBucketTable, lockpaymentorder.ProvisionBucketColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvider queries the provider edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryProvider(lpo *LockPaymentOrder) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProviderTable, lockpaymentorder.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFulfillments queries the fulfillments edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryFulfillments(lpo *LockPaymentOrder) *LockOrderFulfillmentQuery {
+	query := (&LockOrderFulfillmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(lockorderfulfillment.Table, lockorderfulfillment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.FulfillmentsTable, lockpaymentorder.FulfillmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransactions queries the transactions edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryTransactions(lpo *LockPaymentOrder) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.TransactionsTable, lockpaymentorder.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySLARecords queries the sla_records edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QuerySLARecords(lpo *LockPaymentOrder) *ProviderSLARecordQuery {
+	query := (&ProviderSLARecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(providerslarecord.Table, providerslarecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.SLARecordsTable, lockpaymentorder.SLARecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDisputes queries the disputes edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryDisputes(lpo *LockPaymentOrder) *DisputeQuery {
+	query := (&DisputeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.DisputesTable, lockpaymentorder.DisputesColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeadLetter queries the dead_letter edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryDeadLetter(lpo *LockPaymentOrder) *DeadLetterOrderQuery {
+	query := (&DeadLetterOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(deadletterorder.Table, deadletterorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, lockpaymentorder.DeadLetterTable, lockpaymentorder.DeadLetterColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a LockPaymentOrder.
+func (c *LockPaymentOrderClient) QueryAssignments(lpo *LockPaymentOrder) *OrderAssignmentQuery {
+	query := (&OrderAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lpo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
+			sqlgraph.To(orderassignment.Table, orderassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.AssignmentsTable, lockpaymentorder.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LockPaymentOrderClient) Hooks() []Hook {
+	return c.hooks.LockPaymentOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *LockPaymentOrderClient) Interceptors() []Interceptor {
+	return c.inters.LockPaymentOrder
+}
+
+func (c *LockPaymentOrderClient) mutate(ctx context.Context, m *LockPaymentOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LockPaymentOrder mutation op: %q", m.Op())
+	}
+}
+
+// NetworkClient is a client for the Network schema.
+type NetworkClient struct {
+	config
+}
+
+// NewNetworkClient returns a client for the Network from the given config.
+func NewNetworkClient(c config) *NetworkClient {
+	return &NetworkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `network.Hooks(f(g(h())))`.
+func (c *NetworkClient) Use(hooks ...Hook) {
+	c.hooks.Network = append(c.hooks.Network, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `network.Intercept(f(g(h())))`.
+func (c *NetworkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Network = append(c.inters.Network, interceptors...)
+}
+
+// Create returns a builder for creating a Network entity.
+func (c *NetworkClient) Create() *NetworkCreate {
+	mutation := newNetworkMutation(c.config, OpCreate)
+	return &NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Network entities.
+func (c *NetworkClient) CreateBulk(builders ...*NetworkCreate) *NetworkCreateBulk {
+	return &NetworkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NetworkClient) MapCreateBulk(slice any, setFunc func(*NetworkCreate, int)) *NetworkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NetworkCreateBulk{err: fmt.Errorf("calling to NetworkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NetworkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NetworkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Network.
+func (c *NetworkClient) Update() *NetworkUpdate {
+	mutation := newNetworkMutation(c.config, OpUpdate)
+	return &NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NetworkClient) UpdateOne(n *Network) *NetworkUpdateOne {
+	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetwork(n))
+	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NetworkClient) UpdateOneID(id int) *NetworkUpdateOne {
+	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetworkID(id))
+	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Network.
+func (c *NetworkClient) Delete() *NetworkDelete {
+	mutation := newNetworkMutation(c.config, OpDelete)
+	return &NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NetworkClient) DeleteOne(n *Network) *NetworkDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NetworkClient) DeleteOneID(id int) *NetworkDeleteOne {
+	builder := c.Delete().Where(network.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NetworkDeleteOne{builder}
+}
+
+// Query returns a query builder for Network.
+func (c *NetworkClient) Query() *NetworkQuery {
+	return &NetworkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNetwork},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Network entity by its id.
+func (c *NetworkClient) Get(ctx context.Context, id int) (*Network, error) {
+	return c.Query().Where(network.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NetworkClient) GetX(ctx context.Context, id int) *Network {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTokens queries the tokens edge of a Network.
+func (c *NetworkClient) QueryTokens(n *Network) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(network.Table, network.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, network.TokensTable, network.TokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NetworkClient) Hooks() []Hook {
+	return c.hooks.Network
+}
+
+// Interceptors returns the client interceptors.
+func (c *NetworkClient) Interceptors() []Interceptor {
+	return c.inters.Network
+}
+
+func (c *NetworkClient) mutate(ctx context.Context, m *NetworkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Network mutation op: %q", m.Op())
+	}
+}
+
+// OrderAssignmentClient is a client for the OrderAssignment schema.
+type OrderAssignmentClient struct {
+	config
+}
+
+// NewOrderAssignmentClient returns a client for the OrderAssignment from the given config.
+func NewOrderAssignmentClient(c config) *OrderAssignmentClient {
+	return &OrderAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderassignment.Hooks(f(g(h())))`.
+func (c *OrderAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.OrderAssignment = append(c.hooks.OrderAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderassignment.Intercept(f(g(h())))`.
+func (c *OrderAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderAssignment = append(c.inters.OrderAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a OrderAssignment entity.
+func (c *OrderAssignmentClient) Create() *OrderAssignmentCreate {
+	mutation := newOrderAssignmentMutation(c.config, OpCreate)
+	return &OrderAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderAssignment entities.
+func (c *OrderAssignmentClient) CreateBulk(builders ...*OrderAssignmentCreate) *OrderAssignmentCreateBulk {
+	return &OrderAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderAssignmentClient) MapCreateBulk(slice any, setFunc func(*OrderAssignmentCreate, int)) *OrderAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderAssignmentCreateBulk{err: fmt.Errorf("calling to OrderAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderAssignment.
+func (c *OrderAssignmentClient) Update() *OrderAssignmentUpdate {
+	mutation := newOrderAssignmentMutation(c.config, OpUpdate)
+	return &OrderAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderAssignmentClient) UpdateOne(oa *OrderAssignment) *OrderAssignmentUpdateOne {
+	mutation := newOrderAssignmentMutation(c.config, OpUpdateOne, withOrderAssignment(oa))
+	return &OrderAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderAssignmentClient) UpdateOneID(id uuid.UUID) *OrderAssignmentUpdateOne {
+	mutation := newOrderAssignmentMutation(c.config, OpUpdateOne, withOrderAssignmentID(id))
+	return &OrderAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderAssignment.
+func (c *OrderAssignmentClient) Delete() *OrderAssignmentDelete {
+	mutation := newOrderAssignmentMutation(c.config, OpDelete)
+	return &OrderAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderAssignmentClient) DeleteOne(oa *OrderAssignment) *OrderAssignmentDeleteOne {
+	return c.DeleteOneID(oa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderAssignmentClient) DeleteOneID(id uuid.UUID) *OrderAssignmentDeleteOne {
+	builder := c.Delete().Where(orderassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderAssignment.
+func (c *OrderAssignmentClient) Query() *OrderAssignmentQuery {
+	return &OrderAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderAssignment entity by its id.
+func (c *OrderAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*OrderAssignment, error) {
+	return c.Query().Where(orderassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *OrderAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLockPaymentOrder queries the lock_payment_order edge of a OrderAssignment.
+func (c *OrderAssignmentClient) QueryLockPaymentOrder(oa *OrderAssignment) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderassignment.Table, orderassignment.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderassignment.LockPaymentOrderTable, orderassignment.LockPaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(oa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvider queries the provider edge of a OrderAssignment.
+func (c *OrderAssignmentClient) QueryProvider(oa *OrderAssignment) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderassignment.Table, orderassignment.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderassignment.ProviderTable, orderassignment.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(oa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderAssignmentClient) Hooks() []Hook {
+	return c.hooks.OrderAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.OrderAssignment
+}
+
+func (c *OrderAssignmentClient) mutate(ctx context.Context, m *OrderAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderAssignment mutation op: %q", m.Op())
+	}
+}
+
+// PaymentOrderClient is a client for the PaymentOrder schema.
+type PaymentOrderClient struct {
+	config
+}
+
+// NewPaymentOrderClient returns a client for the PaymentOrder from the given config.
+func NewPaymentOrderClient(c config) *PaymentOrderClient {
+	return &PaymentOrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentorder.Hooks(f(g(h())))`.
+func (c *PaymentOrderClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrder = append(c.hooks.PaymentOrder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentorder.Intercept(f(g(h())))`.
+func (c *PaymentOrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrder = append(c.inters.PaymentOrder, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentOrder entity.
+func (c *PaymentOrderClient) Create() *PaymentOrderCreate {
+	mutation := newPaymentOrderMutation(c.config, OpCreate)
+	return &PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentOrder entities.
+func (c *PaymentOrderClient) CreateBulk(builders ...*PaymentOrderCreate) *PaymentOrderCreateBulk {
+	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentOrderClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderCreate, int)) *PaymentOrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentOrderCreateBulk{err: fmt.Errorf("calling to PaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentOrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentOrder.
+func (c *PaymentOrderClient) Update() *PaymentOrderUpdate {
+	mutation := newPaymentOrderMutation(c.config, OpUpdate)
+	return &PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentOrderClient) UpdateOne(po *PaymentOrder) *PaymentOrderUpdateOne {
+	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrder(po))
+	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentOrderClient) UpdateOneID(id uuid.UUID) *PaymentOrderUpdateOne {
+	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrderID(id))
+	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentOrder.
+func (c *PaymentOrderClient) Delete() *PaymentOrderDelete {
+	mutation := newPaymentOrderMutation(c.config, OpDelete)
+	return &PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentOrderClient) DeleteOne(po *PaymentOrder) *PaymentOrderDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentOrderClient) DeleteOneID(id uuid.UUID) *PaymentOrderDeleteOne {
+	builder := c.Delete().Where(paymentorder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentOrderDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentOrder.
+func (c *PaymentOrderClient) Query() *PaymentOrderQuery {
+	return &PaymentOrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentOrder entity by its id.
+func (c *PaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*PaymentOrder, error) {
+	return c.Query().Where(paymentorder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySenderProfile queries the sender_profile edge of a PaymentOrder.
+func (c *PaymentOrderClient) QuerySenderProfile(po *PaymentOrder) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.SenderProfileTable, paymentorder.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToken queries the token edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryToken(po *PaymentOrder) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.TokenTable, paymentorder.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinkedAddress queries the linked_address edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryLinkedAddress(po *PaymentOrder) *LinkedAddressQuery {
+	query := (&LinkedAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.LinkedAddressTable, paymentorder.LinkedAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceiveAddress queries the receive_address edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryReceiveAddress(po *PaymentOrder) *ReceiveAddressQuery {
+	query := (&ReceiveAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.ReceiveAddressTable, paymentorder.ReceiveAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecipient queries the recipient edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryRecipient(po *PaymentOrder) *PaymentOrderRecipientQuery {
+	query := (&PaymentOrderRecipientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(paymentorderrecipient.Table, paymentorderrecipient.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.RecipientTable, paymentorder.RecipientColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTransactions queries the transactions edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryTransactions(po *PaymentOrder) *TransactionLogQuery {
+	query := (&TransactionLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.TransactionsTable, paymentorder.TransactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDisputes queries the disputes edge of a PaymentOrder.
+func (c *PaymentOrderClient) QueryDisputes(po *PaymentOrder) *DisputeQuery {
+	query := (&DisputeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.DisputesTable, paymentorder.DisputesColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentOrderClient) Hooks() []Hook {
+	return c.hooks.PaymentOrder
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentOrderClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrder
+}
+
+func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentOrder mutation op: %q", m.Op())
+	}
+}
+
+// PaymentOrderRecipientClient is a client for the PaymentOrderRecipient schema.
+type PaymentOrderRecipientClient struct {
+	config
+}
+
+// NewPaymentOrderRecipientClient returns a client for the PaymentOrderRecipient from the given config.
+func NewPaymentOrderRecipientClient(c config) *PaymentOrderRecipientClient {
+	return &PaymentOrderRecipientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentorderrecipient.Hooks(f(g(h())))`.
+func (c *PaymentOrderRecipientClient) Use(hooks ...Hook) {
+	c.hooks.PaymentOrderRecipient = append(c.hooks.PaymentOrderRecipient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentorderrecipient.Intercept(f(g(h())))`.
+func (c *PaymentOrderRecipientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentOrderRecipient = append(c.inters.PaymentOrderRecipient, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentOrderRecipient entity.
+func (c *PaymentOrderRecipientClient) Create() *PaymentOrderRecipientCreate {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpCreate)
+	return &PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentOrderRecipient entities.
+func (c *PaymentOrderRecipientClient) CreateBulk(builders ...*PaymentOrderRecipientCreate) *PaymentOrderRecipientCreateBulk {
+	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentOrderRecipientClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderRecipientCreate, int)) *PaymentOrderRecipientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentOrderRecipientCreateBulk{err: fmt.Errorf("calling to PaymentOrderRecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentOrderRecipientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) Update() *PaymentOrderRecipientUpdate {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdate)
+	return &PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentOrderRecipientClient) UpdateOne(por *PaymentOrderRecipient) *PaymentOrderRecipientUpdateOne {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipient(por))
+	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentOrderRecipientClient) UpdateOneID(id int) *PaymentOrderRecipientUpdateOne {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipientID(id))
+	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) Delete() *PaymentOrderRecipientDelete {
+	mutation := newPaymentOrderRecipientMutation(c.config, OpDelete)
+	return &PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentOrderRecipientClient) DeleteOne(por *PaymentOrderRecipient) *PaymentOrderRecipientDeleteOne {
+	return c.DeleteOneID(por.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentOrderRecipientClient) DeleteOneID(id int) *PaymentOrderRecipientDeleteOne {
+	builder := c.Delete().Where(paymentorderrecipient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentOrderRecipientDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) Query() *PaymentOrderRecipientQuery {
+	return &PaymentOrderRecipientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentOrderRecipient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentOrderRecipient entity by its id.
+func (c *PaymentOrderRecipientClient) Get(ctx context.Context, id int) (*PaymentOrderRecipient, error) {
+	return c.Query().Where(paymentorderrecipient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentOrderRecipientClient) GetX(ctx context.Context, id int) *PaymentOrderRecipient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPaymentOrder queries the payment_order edge of a PaymentOrderRecipient.
+func (c *PaymentOrderRecipientClient) QueryPaymentOrder(por *PaymentOrderRecipient) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := por.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorderrecipient.Table, paymentorderrecipient.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, paymentorderrecipient.PaymentOrderTable, paymentorderrecipient.PaymentOrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(por.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentOrderRecipientClient) Hooks() []Hook {
+	return c.hooks.PaymentOrderRecipient
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentOrderRecipientClient) Interceptors() []Interceptor {
+	return c.inters.PaymentOrderRecipient
+}
+
+func (c *PaymentOrderRecipientClient) mutate(ctx context.Context, m *PaymentOrderRecipientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentOrderRecipient mutation op: %q", m.Op())
+	}
+}
+
+// ProviderHealthCheckClient is a client for the ProviderHealthCheck schema.
+type ProviderHealthCheckClient struct {
+	config
+}
+
+// NewProviderHealthCheckClient returns a client for the ProviderHealthCheck from the given config.
+func NewProviderHealthCheckClient(c config) *ProviderHealthCheckClient {
+	return &ProviderHealthCheckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerhealthcheck.Hooks(f(g(h())))`.
+func (c *ProviderHealthCheckClient) Use(hooks ...Hook) {
+	c.hooks.ProviderHealthCheck = append(c.hooks.ProviderHealthCheck, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerhealthcheck.Intercept(f(g(h())))`.
+func (c *ProviderHealthCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderHealthCheck = append(c.inters.ProviderHealthCheck, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderHealthCheck entity.
+func (c *ProviderHealthCheckClient) Create() *ProviderHealthCheckCreate {
+	mutation := newProviderHealthCheckMutation(c.config, OpCreate)
+	return &ProviderHealthCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderHealthCheck entities.
+func (c *ProviderHealthCheckClient) CreateBulk(builders ...*ProviderHealthCheckCreate) *ProviderHealthCheckCreateBulk {
+	return &ProviderHealthCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderHealthCheckClient) MapCreateBulk(slice any, setFunc func(*ProviderHealthCheckCreate, int)) *ProviderHealthCheckCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderHealthCheckCreateBulk{err: fmt.Errorf("calling to ProviderHealthCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderHealthCheckCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderHealthCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderHealthCheck.
+func (c *ProviderHealthCheckClient) Update() *ProviderHealthCheckUpdate {
+	mutation := newProviderHealthCheckMutation(c.config, OpUpdate)
+	return &ProviderHealthCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderHealthCheckClient) UpdateOne(phc *ProviderHealthCheck) *ProviderHealthCheckUpdateOne {
+	mutation := newProviderHealthCheckMutation(c.config, OpUpdateOne, withProviderHealthCheck(phc))
+	return &ProviderHealthCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderHealthCheckClient) UpdateOneID(id uuid.UUID) *ProviderHealthCheckUpdateOne {
+	mutation := newProviderHealthCheckMutation(c.config, OpUpdateOne, withProviderHealthCheckID(id))
+	return &ProviderHealthCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderHealthCheck.
+func (c *ProviderHealthCheckClient) Delete() *ProviderHealthCheckDelete {
+	mutation := newProviderHealthCheckMutation(c.config, OpDelete)
+	return &ProviderHealthCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderHealthCheckClient) DeleteOne(phc *ProviderHealthCheck) *ProviderHealthCheckDeleteOne {
+	return c.DeleteOneID(phc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderHealthCheckClient) DeleteOneID(id uuid.UUID) *ProviderHealthCheckDeleteOne {
+	builder := c.Delete().Where(providerhealthcheck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderHealthCheckDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderHealthCheck.
+func (c *ProviderHealthCheckClient) Query() *ProviderHealthCheckQuery {
+	return &ProviderHealthCheckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderHealthCheck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderHealthCheck entity by its id.
+func (c *ProviderHealthCheckClient) Get(ctx context.Context, id uuid.UUID) (*ProviderHealthCheck, error) {
+	return c.Query().Where(providerhealthcheck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderHealthCheckClient) GetX(ctx context.Context, id uuid.UUID) *ProviderHealthCheck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderHealthCheck.
+func (c *ProviderHealthCheckClient) QueryProvider(phc *ProviderHealthCheck) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := phc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerhealthcheck.Table, providerhealthcheck.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerhealthcheck.ProviderTable, providerhealthcheck.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(phc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderHealthCheckClient) Hooks() []Hook {
+	return c.hooks.ProviderHealthCheck
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderHealthCheckClient) Interceptors() []Interceptor {
+	return c.inters.ProviderHealthCheck
+}
+
+func (c *ProviderHealthCheckClient) mutate(ctx context.Context, m *ProviderHealthCheckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderHealthCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderHealthCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderHealthCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderHealthCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderHealthCheck mutation op: %q", m.Op())
+	}
+}
+
+// ProviderOrderTokenClient is a client for the ProviderOrderToken schema.
+type ProviderOrderTokenClient struct {
+	config
+}
+
+// NewProviderOrderTokenClient returns a client for the ProviderOrderToken from the given config.
+func NewProviderOrderTokenClient(c config) *ProviderOrderTokenClient {
+	return &ProviderOrderTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerordertoken.Hooks(f(g(h())))`.
+func (c *ProviderOrderTokenClient) Use(hooks ...Hook) {
+	c.hooks.ProviderOrderToken = append(c.hooks.ProviderOrderToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerordertoken.Intercept(f(g(h())))`.
+func (c *ProviderOrderTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderOrderToken = append(c.inters.ProviderOrderToken, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderOrderToken entity.
+func (c *ProviderOrderTokenClient) Create() *ProviderOrderTokenCreate {
+	mutation := newProviderOrderTokenMutation(c.config, OpCreate)
+	return &ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderOrderToken entities.
+func (c *ProviderOrderTokenClient) CreateBulk(builders ...*ProviderOrderTokenCreate) *ProviderOrderTokenCreateBulk {
+	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*ProviderOrderTokenCreate, int)) *ProviderOrderTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderOrderTokenCreateBulk{err: fmt.Errorf("calling to ProviderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderOrderTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Update() *ProviderOrderTokenUpdate {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdate)
+	return &ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderOrderTokenClient) UpdateOne(pot *ProviderOrderToken) *ProviderOrderTokenUpdateOne {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderToken(pot))
+	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderOrderTokenClient) UpdateOneID(id int) *ProviderOrderTokenUpdateOne {
+	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderTokenID(id))
+	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Delete() *ProviderOrderTokenDelete {
+	mutation := newProviderOrderTokenMutation(c.config, OpDelete)
+	return &ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderOrderTokenClient) DeleteOne(pot *ProviderOrderToken) *ProviderOrderTokenDeleteOne {
+	return c.DeleteOneID(pot.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderOrderTokenClient) DeleteOneID(id int) *ProviderOrderTokenDeleteOne {
+	builder := c.Delete().Where(providerordertoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderOrderTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderOrderToken.
+func (c *ProviderOrderTokenClient) Query() *ProviderOrderTokenQuery {
+	return &ProviderOrderTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderOrderToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderOrderToken entity by its id.
+func (c *ProviderOrderTokenClient) Get(ctx context.Context, id int) (*ProviderOrderToken, error) {
+	return c.Query().Where(providerordertoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOrderToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProvider queries the provider edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryProvider(pot *ProviderOrderToken) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.ProviderTable, providerordertoken.ProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrency queries the currency edge of a ProviderOrderToken.
+func (c *ProviderOrderTokenClient) QueryCurrency(pot *ProviderOrderToken) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.CurrencyTable, providerordertoken.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderOrderTokenClient) Hooks() []Hook {
+	return c.hooks.ProviderOrderToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderOrderTokenClient) Interceptors() []Interceptor {
+	return c.inters.ProviderOrderToken
+}
+
+func (c *ProviderOrderTokenClient) mutate(ctx context.Context, m *ProviderOrderTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderOrderToken mutation op: %q", m.Op())
+	}
+}
+
+// ProviderProfileClient is a client for the ProviderProfile schema.
+type ProviderProfileClient struct {
+	config
+}
+
+// NewProviderProfileClient returns a client for the ProviderProfile from the given config.
+func NewProviderProfileClient(c config) *ProviderProfileClient {
+	return &ProviderProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerprofile.Hooks(f(g(h())))`.
+func (c *ProviderProfileClient) Use(hooks ...Hook) {
+	c.hooks.ProviderProfile = append(c.hooks.ProviderProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerprofile.Intercept(f(g(h())))`.
+func (c *ProviderProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderProfile = append(c.inters.ProviderProfile, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderProfile entity.
+func (c *ProviderProfileClient) Create() *ProviderProfileCreate {
+	mutation := newProviderProfileMutation(c.config, OpCreate)
+	return &ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderProfile entities.
+func (c *ProviderProfileClient) CreateBulk(builders ...*ProviderProfileCreate) *ProviderProfileCreateBulk {
+	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderProfileClient) MapCreateBulk(slice any, setFunc func(*ProviderProfileCreate, int)) *ProviderProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderProfileCreateBulk{err: fmt.Errorf("calling to ProviderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderProfile.
+func (c *ProviderProfileClient) Update() *ProviderProfileUpdate {
+	mutation := newProviderProfileMutation(c.config, OpUpdate)
+	return &ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderProfileClient) UpdateOne(pp *ProviderProfile) *ProviderProfileUpdateOne {
+	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfile(pp))
+	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderProfileClient) UpdateOneID(id string) *ProviderProfileUpdateOne {
+	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfileID(id))
+	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderProfile.
+func (c *ProviderProfileClient) Delete() *ProviderProfileDelete {
+	mutation := newProviderProfileMutation(c.config, OpDelete)
+	return &ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderProfileClient) DeleteOne(pp *ProviderProfile) *ProviderProfileDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderProfileClient) DeleteOneID(id string) *ProviderProfileDeleteOne {
+	builder := c.Delete().Where(providerprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderProfile.
+func (c *ProviderProfileClient) Query() *ProviderProfileQuery {
+	return &ProviderProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderProfile entity by its id.
+func (c *ProviderProfileClient) Get(ctx context.Context, id string) (*ProviderProfile, error) {
+	return c.Query().Where(providerprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryUser(pp *ProviderProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerprofile.UserTable, providerprofile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKey queries the api_key edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryAPIKey(pp *ProviderProfile) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrencies queries the currencies edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryCurrencies(pp *ProviderProfile) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.CurrenciesTable, providerprofile.CurrenciesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvisionBuckets queries the provision_buckets edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProvisionBuckets(pp *ProviderProfile) *ProvisionBucketQuery {
+	query := (&ProvisionBucketClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.ProvisionBucketsTable, providerprofile.ProvisionBucketsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderTokens queries the order_tokens edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryOrderTokens(pp *ProviderProfile) *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderTokensTable, providerprofile.OrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderRating queries the provider_rating edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryProviderRating(pp *ProviderProfile) *ProviderRatingQuery {
+	query := (&ProviderRatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerrating.Table, providerrating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.ProviderRatingTable, providerprofile.ProviderRatingColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedOrders queries the assigned_orders edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryAssignedOrders(pp *ProviderProfile) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.AssignedOrdersTable, providerprofile.AssignedOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamMembers queries the team_members edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryTeamMembers(pp *ProviderProfile) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamMembersTable, providerprofile.TeamMembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamInvitations queries the team_invitations edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryTeamInvitations(pp *ProviderProfile) *TeamInvitationQuery {
+	query := (&TeamInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(teaminvitation.Table, teaminvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamInvitationsTable, providerprofile.TeamInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeamAuditLogs queries the team_audit_logs edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryTeamAuditLogs(pp *ProviderProfile) *TeamAuditLogQuery {
+	query := (&TeamAuditLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(teamauditlog.Table, teamauditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamAuditLogsTable, providerprofile.TeamAuditLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHealthChecks queries the health_checks edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryHealthChecks(pp *ProviderProfile) *ProviderHealthCheckQuery {
+	query := (&ProviderHealthCheckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerhealthcheck.Table, providerhealthcheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.HealthChecksTable, providerprofile.HealthChecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySLARecords queries the sla_records edge of a ProviderProfile.
+func (c *ProviderProfileClient) QuerySLARecords(pp *ProviderProfile) *ProviderSLARecordQuery {
+	query := (&ProviderSLARecordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(providerslarecord.Table, providerslarecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.SLARecordsTable, providerprofile.SLARecordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDisputes queries the disputes edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryDisputes(pp *ProviderProfile) *DisputeQuery {
+	query := (&DisputeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.DisputesTable, providerprofile.DisputesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderAssignments queries the order_assignments edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryOrderAssignments(pp *ProviderProfile) *OrderAssignmentQuery {
+	query := (&OrderAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(orderassignment.Table, orderassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderAssignmentsTable, providerprofile.OrderAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRateSnapshots queries the rate_snapshots edge of a ProviderProfile.
+func (c *ProviderProfileClient) QueryRateSnapshots(pp *ProviderProfile) *RateSnapshotQuery {
+	query := (&RateSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
+			sqlgraph.To(ratesnapshot.Table, ratesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.RateSnapshotsTable, providerprofile.RateSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderProfileClient) Hooks() []Hook {
+	return c.hooks.ProviderProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderProfileClient) Interceptors() []Interceptor {
+	return c.inters.ProviderProfile
+}
+
+func (c *ProviderProfileClient) mutate(ctx context.Context, m *ProviderProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderProfile mutation op: %q", m.Op())
+	}
+}
+
+// ProviderRatingClient is a client for the ProviderRating schema.
+type ProviderRatingClient struct {
+	config
+}
+
+// NewProviderRatingClient returns a client for the ProviderRating from the given config.
+func NewProviderRatingClient(c config) *ProviderRatingClient {
+	return &ProviderRatingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lockpaymentorder.Hooks(f(g(h())))`.
-func (c *LockPaymentOrderClient) Use(hooks ...Hook) {
-	c.hooks.LockPaymentOrder = append(c.hooks.LockPaymentOrder, hooks...)
+// A call to `Use(f, g, h)` equals to `providerrating.Hooks(f(g(h())))`.
+func (c *ProviderRatingClient) Use(hooks ...Hook) {
+	c.hooks.ProviderRating = append(c.hooks.ProviderRating, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lockpaymentorder.Intercept(f(g(h())))`.
-func (c *LockPaymentOrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LockPaymentOrder = append(c.inters.LockPaymentOrder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `providerrating.Intercept(f(g(h())))`.
+func (c *ProviderRatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderRating = append(c.inters.ProviderRating, interceptors...)
 }
 
-// Create returns a builder for creating a LockPaymentOrder entity.
-func (c *LockPaymentOrderClient) Create() *LockPaymentOrderCreate {
-	mutation := newLockPaymentOrderMutation(c.config, OpCreate)
-	return &LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProviderRating entity.
+func (c *ProviderRatingClient) Create() *ProviderRatingCreate {
+	mutation := newProviderRatingMutation(c.config, OpCreate)
+	return &ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LockPaymentOrder entities.
-func (c *LockPaymentOrderClient) CreateBulk(builders ...*LockPaymentOrderCreate) *LockPaymentOrderCreateBulk {
-	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProviderRating entities.
+func (c *ProviderRatingClient) CreateBulk(builders ...*ProviderRatingCreate) *ProviderRatingCreateBulk {
+	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LockPaymentOrderClient) MapCreateBulk(slice any, setFunc func(*LockPaymentOrderCreate, int)) *LockPaymentOrderCreateBulk {
+func (c *ProviderRatingClient) MapCreateBulk(slice any, setFunc func(*ProviderRatingCreate, int)) *ProviderRatingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LockPaymentOrderCreateBulk{err: fmt.Errorf("calling to LockPaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProviderRatingCreateBulk{err: fmt.Errorf("calling to ProviderRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LockPaymentOrderCreate, rv.Len())
+	builders := make([]*ProviderRatingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LockPaymentOrderCreateBulk{config: c.config, builders: builders}
+	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LockPaymentOrder.
-func (c *LockPaymentOrderClient) Update() *LockPaymentOrderUpdate {
-	mutation := newLockPaymentOrderMutation(c.config, OpUpdate)
-	return &LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProviderRating.
+func (c *ProviderRatingClient) Update() *ProviderRatingUpdate {
+	mutation := newProviderRatingMutation(c.config, OpUpdate)
+	return &ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LockPaymentOrderClient) UpdateOne(lpo *LockPaymentOrder) *LockPaymentOrderUpdateOne {
-	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrder(lpo))
-	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProviderRatingClient) UpdateOne(pr *ProviderRating) *ProviderRatingUpdateOne {
+	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRating(pr))
+	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LockPaymentOrderClient) UpdateOneID(id uuid.UUID) *LockPaymentOrderUpdateOne {
-	mutation := newLockPaymentOrderMutation(c.config, OpUpdateOne, withLockPaymentOrderID(id))
-	return &LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProviderRatingClient) UpdateOneID(id int) *ProviderRatingUpdateOne {
+	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRatingID(id))
+	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LockPaymentOrder.
-func (c *LockPaymentOrderClient) Delete() *LockPaymentOrderDelete {
-	mutation := newLockPaymentOrderMutation(c.config, OpDelete)
-	return &LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProviderRating.
+func (c *ProviderRatingClient) Delete() *ProviderRatingDelete {
+	mutation := newProviderRatingMutation(c.config, OpDelete)
+	return &ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LockPaymentOrderClient) DeleteOne(lpo *LockPaymentOrder) *LockPaymentOrderDeleteOne {
-	return c.DeleteOneID(lpo.ID)
+func (c *ProviderRatingClient) DeleteOne(pr *ProviderRating) *ProviderRatingDeleteOne {
+	return c.DeleteOneID(pr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LockPaymentOrderClient) DeleteOneID(id uuid.UUID) *LockPaymentOrderDeleteOne {
-	builder := c.Delete().Where(lockpaymentorder.ID(id))
+func (c *ProviderRatingClient) DeleteOneID(id int) *ProviderRatingDeleteOne {
+	builder := c.Delete().Where(providerrating.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LockPaymentOrderDeleteOne{builder}
+	return &ProviderRatingDeleteOne{builder}
 }
 
-// Query returns a query builder for LockPaymentOrder.
-func (c *LockPaymentOrderClient) Query() *LockPaymentOrderQuery {
-	return &LockPaymentOrderQuery{
+// Query returns a query builder for ProviderRating.
+func (c *ProviderRatingClient) Query() *ProviderRatingQuery {
+	return &ProviderRatingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLockPaymentOrder},
+		ctx:    &QueryContext{Type: TypeProviderRating},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LockPaymentOrder entity by its id.
-func (c *LockPaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*LockPaymentOrder, error) {
-	return c.Query().Where(lockpaymentorder.ID(id)).Only(ctx)
+// Get returns a ProviderRating entity by its id.
+func (c *ProviderRatingClient) Get(ctx context.Context, id int) (*ProviderRating, error) {
+	return c.Query().Where(providerrating.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LockPaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *LockPaymentOrder {
+func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1434,212 +3964,313 @@ func (c *LockPaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *LockPa
 	return obj
 }
 
-// QueryToken queries the token edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryToken(lpo *LockPaymentOrder) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
+// QueryProviderProfile queries the provider_profile edge of a ProviderRating.
+func (c *ProviderRatingClient) QueryProviderProfile(pr *ProviderRating) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
+		id := pr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.TokenTable, lockpaymentorder.TokenColumn),
+			sqlgraph.From(providerrating.Table, providerrating.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerrating.ProviderProfileTable, providerrating.ProviderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProvisionBucket queries the provision_bucket edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryProvisionBucket(lpo *LockPaymentOrder) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProvisionBucketTable, lockpaymentorder.ProvisionBucketColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ProviderRatingClient) Hooks() []Hook {
+	return c.hooks.ProviderRating
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderRatingClient) Interceptors() []Interceptor {
+	return c.inters.ProviderRating
+}
+
+func (c *ProviderRatingClient) mutate(ctx context.Context, m *ProviderRatingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderRating mutation op: %q", m.Op())
+	}
+}
+
+// ProviderSLARecordClient is a client for the ProviderSLARecord schema.
+type ProviderSLARecordClient struct {
+	config
+}
+
+// NewProviderSLARecordClient returns a client for the ProviderSLARecord from the given config.
+func NewProviderSLARecordClient(c config) *ProviderSLARecordClient {
+	return &ProviderSLARecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerslarecord.Hooks(f(g(h())))`.
+func (c *ProviderSLARecordClient) Use(hooks ...Hook) {
+	c.hooks.ProviderSLARecord = append(c.hooks.ProviderSLARecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerslarecord.Intercept(f(g(h())))`.
+func (c *ProviderSLARecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderSLARecord = append(c.inters.ProviderSLARecord, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderSLARecord entity.
+func (c *ProviderSLARecordClient) Create() *ProviderSLARecordCreate {
+	mutation := newProviderSLARecordMutation(c.config, OpCreate)
+	return &ProviderSLARecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderSLARecord entities.
+func (c *ProviderSLARecordClient) CreateBulk(builders ...*ProviderSLARecordCreate) *ProviderSLARecordCreateBulk {
+	return &ProviderSLARecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderSLARecordClient) MapCreateBulk(slice any, setFunc func(*ProviderSLARecordCreate, int)) *ProviderSLARecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderSLARecordCreateBulk{err: fmt.Errorf("calling to ProviderSLARecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderSLARecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderSLARecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderSLARecord.
+func (c *ProviderSLARecordClient) Update() *ProviderSLARecordUpdate {
+	mutation := newProviderSLARecordMutation(c.config, OpUpdate)
+	return &ProviderSLARecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderSLARecordClient) UpdateOne(psr *ProviderSLARecord) *ProviderSLARecordUpdateOne {
+	mutation := newProviderSLARecordMutation(c.config, OpUpdateOne, withProviderSLARecord(psr))
+	return &ProviderSLARecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderSLARecordClient) UpdateOneID(id uuid.UUID) *ProviderSLARecordUpdateOne {
+	mutation := newProviderSLARecordMutation(c.config, OpUpdateOne, withProviderSLARecordID(id))
+	return &ProviderSLARecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderSLARecord.
+func (c *ProviderSLARecordClient) Delete() *ProviderSLARecordDelete {
+	mutation := newProviderSLARecordMutation(c.config, OpDelete)
+	return &ProviderSLARecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderSLARecordClient) DeleteOne(psr *ProviderSLARecord) *ProviderSLARecordDeleteOne {
+	return c.DeleteOneID(psr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderSLARecordClient) DeleteOneID(id uuid.UUID) *ProviderSLARecordDeleteOne {
+	builder := c.Delete().Where(providerslarecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderSLARecordDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderSLARecord.
+func (c *ProviderSLARecordClient) Query() *ProviderSLARecordQuery {
+	return &ProviderSLARecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderSLARecord},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryProvider queries the provider edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryProvider(lpo *LockPaymentOrder) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lockpaymentorder.ProviderTable, lockpaymentorder.ProviderColumn),
-		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ProviderSLARecord entity by its id.
+func (c *ProviderSLARecordClient) Get(ctx context.Context, id uuid.UUID) (*ProviderSLARecord, error) {
+	return c.Query().Where(providerslarecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderSLARecordClient) GetX(ctx context.Context, id uuid.UUID) *ProviderSLARecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryFulfillments queries the fulfillments edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryFulfillments(lpo *LockPaymentOrder) *LockOrderFulfillmentQuery {
-	query := (&LockOrderFulfillmentClient{config: c.config}).Query()
+// QueryProvider queries the provider edge of a ProviderSLARecord.
+func (c *ProviderSLARecordClient) QueryProvider(psr *ProviderSLARecord) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
+		id := psr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(lockorderfulfillment.Table, lockorderfulfillment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.FulfillmentsTable, lockpaymentorder.FulfillmentsColumn),
+			sqlgraph.From(providerslarecord.Table, providerslarecord.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerslarecord.ProviderTable, providerslarecord.ProviderColumn),
 		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTransactions queries the transactions edge of a LockPaymentOrder.
-func (c *LockPaymentOrderClient) QueryTransactions(lpo *LockPaymentOrder) *TransactionLogQuery {
-	query := (&TransactionLogClient{config: c.config}).Query()
+// QueryOrder queries the order edge of a ProviderSLARecord.
+func (c *ProviderSLARecordClient) QueryOrder(psr *ProviderSLARecord) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lpo.ID
+		id := psr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, id),
-			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.TransactionsTable, lockpaymentorder.TransactionsColumn),
+			sqlgraph.From(providerslarecord.Table, providerslarecord.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerslarecord.OrderTable, providerslarecord.OrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(lpo.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(psr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *LockPaymentOrderClient) Hooks() []Hook {
-	return c.hooks.LockPaymentOrder
+func (c *ProviderSLARecordClient) Hooks() []Hook {
+	return c.hooks.ProviderSLARecord
 }
 
 // Interceptors returns the client interceptors.
-func (c *LockPaymentOrderClient) Interceptors() []Interceptor {
-	return c.inters.LockPaymentOrder
+func (c *ProviderSLARecordClient) Interceptors() []Interceptor {
+	return c.inters.ProviderSLARecord
 }
 
-func (c *LockPaymentOrderClient) mutate(ctx context.Context, m *LockPaymentOrderMutation) (Value, error) {
+func (c *ProviderSLARecordClient) mutate(ctx context.Context, m *ProviderSLARecordMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LockPaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProviderSLARecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LockPaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProviderSLARecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LockPaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProviderSLARecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LockPaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProviderSLARecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LockPaymentOrder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProviderSLARecord mutation op: %q", m.Op())
 	}
 }
 
-// NetworkClient is a client for the Network schema.
-type NetworkClient struct {
+// ProvisionBucketClient is a client for the ProvisionBucket schema.
+type ProvisionBucketClient struct {
 	config
 }
 
-// NewNetworkClient returns a client for the Network from the given config.
-func NewNetworkClient(c config) *NetworkClient {
-	return &NetworkClient{config: c}
+// NewProvisionBucketClient returns a client for the ProvisionBucket from the given config.
+func NewProvisionBucketClient(c config) *ProvisionBucketClient {
+	return &ProvisionBucketClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `network.Hooks(f(g(h())))`.
-func (c *NetworkClient) Use(hooks ...Hook) {
-	c.hooks.Network = append(c.hooks.Network, hooks...)
+// A call to `Use(f, g, h)` equals to `provisionbucket.Hooks(f(g(h())))`.
+func (c *ProvisionBucketClient) Use(hooks ...Hook) {
+	c.hooks.ProvisionBucket = append(c.hooks.ProvisionBucket, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `network.Intercept(f(g(h())))`.
-func (c *NetworkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Network = append(c.inters.Network, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `provisionbucket.Intercept(f(g(h())))`.
+func (c *ProvisionBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProvisionBucket = append(c.inters.ProvisionBucket, interceptors...)
 }
 
-// Create returns a builder for creating a Network entity.
-func (c *NetworkClient) Create() *NetworkCreate {
-	mutation := newNetworkMutation(c.config, OpCreate)
-	return &NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProvisionBucket entity.
+func (c *ProvisionBucketClient) Create() *ProvisionBucketCreate {
+	mutation := newProvisionBucketMutation(c.config, OpCreate)
+	return &ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Network entities.
-func (c *NetworkClient) CreateBulk(builders ...*NetworkCreate) *NetworkCreateBulk {
-	return &NetworkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProvisionBucket entities.
+func (c *ProvisionBucketClient) CreateBulk(builders ...*ProvisionBucketCreate) *ProvisionBucketCreateBulk {
+	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NetworkClient) MapCreateBulk(slice any, setFunc func(*NetworkCreate, int)) *NetworkCreateBulk {
+func (c *ProvisionBucketClient) MapCreateBulk(slice any, setFunc func(*ProvisionBucketCreate, int)) *ProvisionBucketCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NetworkCreateBulk{err: fmt.Errorf("calling to NetworkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProvisionBucketCreateBulk{err: fmt.Errorf("calling to ProvisionBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NetworkCreate, rv.Len())
+	builders := make([]*ProvisionBucketCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NetworkCreateBulk{config: c.config, builders: builders}
+	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Network.
-func (c *NetworkClient) Update() *NetworkUpdate {
-	mutation := newNetworkMutation(c.config, OpUpdate)
-	return &NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Update() *ProvisionBucketUpdate {
+	mutation := newProvisionBucketMutation(c.config, OpUpdate)
+	return &ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NetworkClient) UpdateOne(n *Network) *NetworkUpdateOne {
-	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetwork(n))
-	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProvisionBucketClient) UpdateOne(pb *ProvisionBucket) *ProvisionBucketUpdateOne {
+	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucket(pb))
+	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NetworkClient) UpdateOneID(id int) *NetworkUpdateOne {
-	mutation := newNetworkMutation(c.config, OpUpdateOne, withNetworkID(id))
-	return &NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProvisionBucketClient) UpdateOneID(id int) *ProvisionBucketUpdateOne {
+	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucketID(id))
+	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Network.
-func (c *NetworkClient) Delete() *NetworkDelete {
-	mutation := newNetworkMutation(c.config, OpDelete)
-	return &NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Delete() *ProvisionBucketDelete {
+	mutation := newProvisionBucketMutation(c.config, OpDelete)
+	return &ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NetworkClient) DeleteOne(n *Network) *NetworkDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *ProvisionBucketClient) DeleteOne(pb *ProvisionBucket) *ProvisionBucketDeleteOne {
+	return c.DeleteOneID(pb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NetworkClient) DeleteOneID(id int) *NetworkDeleteOne {
-	builder := c.Delete().Where(network.ID(id))
+func (c *ProvisionBucketClient) DeleteOneID(id int) *ProvisionBucketDeleteOne {
+	builder := c.Delete().Where(provisionbucket.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NetworkDeleteOne{builder}
+	return &ProvisionBucketDeleteOne{builder}
 }
 
-// Query returns a query builder for Network.
-func (c *NetworkClient) Query() *NetworkQuery {
-	return &NetworkQuery{
+// Query returns a query builder for ProvisionBucket.
+func (c *ProvisionBucketClient) Query() *ProvisionBucketQuery {
+	return &ProvisionBucketQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNetwork},
+		ctx:    &QueryContext{Type: TypeProvisionBucket},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Network entity by its id.
-func (c *NetworkClient) Get(ctx context.Context, id int) (*Network, error) {
-	return c.Query().Where(network.ID(id)).Only(ctx)
+// Get returns a ProvisionBucket entity by its id.
+func (c *ProvisionBucketClient) Get(ctx context.Context, id int) (*ProvisionBucket, error) {
+	return c.Query().Where(provisionbucket.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NetworkClient) GetX(ctx context.Context, id int) *Network {
+func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBucket {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1647,148 +4278,180 @@ func (c *NetworkClient) GetX(ctx context.Context, id int) *Network {
 	return obj
 }
 
-// QueryTokens queries the tokens edge of a Network.
-func (c *NetworkClient) QueryTokens(n *Network) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
+// QueryCurrency queries the currency edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryCurrency(pb *ProvisionBucket) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := pb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(network.Table, network.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, network.TokensTable, network.TokensColumn),
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, provisionbucket.CurrencyTable, provisionbucket.CurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLockPaymentOrders queries the lock_payment_orders edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryLockPaymentOrders(pb *ProvisionBucket) *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, provisionbucket.LockPaymentOrdersTable, provisionbucket.LockPaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderProfiles queries the provider_profiles edge of a ProvisionBucket.
+func (c *ProvisionBucketClient) QueryProviderProfiles(pb *ProvisionBucket) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pb.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, provisionbucket.ProviderProfilesTable, provisionbucket.ProviderProfilesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NetworkClient) Hooks() []Hook {
-	return c.hooks.Network
+func (c *ProvisionBucketClient) Hooks() []Hook {
+	return c.hooks.ProvisionBucket
 }
 
 // Interceptors returns the client interceptors.
-func (c *NetworkClient) Interceptors() []Interceptor {
-	return c.inters.Network
+func (c *ProvisionBucketClient) Interceptors() []Interceptor {
+	return c.inters.ProvisionBucket
 }
 
-func (c *NetworkClient) mutate(ctx context.Context, m *NetworkMutation) (Value, error) {
+func (c *ProvisionBucketClient) mutate(ctx context.Context, m *ProvisionBucketMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NetworkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NetworkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NetworkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NetworkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Network mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProvisionBucket mutation op: %q", m.Op())
 	}
 }
 
-// PaymentOrderClient is a client for the PaymentOrder schema.
-type PaymentOrderClient struct {
+// PublicHolidayClient is a client for the PublicHoliday schema.
+type PublicHolidayClient struct {
 	config
 }
 
-// NewPaymentOrderClient returns a client for the PaymentOrder from the given config.
-func NewPaymentOrderClient(c config) *PaymentOrderClient {
-	return &PaymentOrderClient{config: c}
+// NewPublicHolidayClient returns a client for the PublicHoliday from the given config.
+func NewPublicHolidayClient(c config) *PublicHolidayClient {
+	return &PublicHolidayClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentorder.Hooks(f(g(h())))`.
-func (c *PaymentOrderClient) Use(hooks ...Hook) {
-	c.hooks.PaymentOrder = append(c.hooks.PaymentOrder, hooks...)
+// A call to `Use(f, g, h)` equals to `publicholiday.Hooks(f(g(h())))`.
+func (c *PublicHolidayClient) Use(hooks ...Hook) {
+	c.hooks.PublicHoliday = append(c.hooks.PublicHoliday, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentorder.Intercept(f(g(h())))`.
-func (c *PaymentOrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentOrder = append(c.inters.PaymentOrder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `publicholiday.Intercept(f(g(h())))`.
+func (c *PublicHolidayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PublicHoliday = append(c.inters.PublicHoliday, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentOrder entity.
-func (c *PaymentOrderClient) Create() *PaymentOrderCreate {
-	mutation := newPaymentOrderMutation(c.config, OpCreate)
-	return &PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PublicHoliday entity.
+func (c *PublicHolidayClient) Create() *PublicHolidayCreate {
+	mutation := newPublicHolidayMutation(c.config, OpCreate)
+	return &PublicHolidayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentOrder entities.
-func (c *PaymentOrderClient) CreateBulk(builders ...*PaymentOrderCreate) *PaymentOrderCreateBulk {
-	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PublicHoliday entities.
+func (c *PublicHolidayClient) CreateBulk(builders ...*PublicHolidayCreate) *PublicHolidayCreateBulk {
+	return &PublicHolidayCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentOrderClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderCreate, int)) *PaymentOrderCreateBulk {
+func (c *PublicHolidayClient) MapCreateBulk(slice any, setFunc func(*PublicHolidayCreate, int)) *PublicHolidayCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentOrderCreateBulk{err: fmt.Errorf("calling to PaymentOrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PublicHolidayCreateBulk{err: fmt.Errorf("calling to PublicHolidayClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentOrderCreate, rv.Len())
+	builders := make([]*PublicHolidayCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentOrderCreateBulk{config: c.config, builders: builders}
+	return &PublicHolidayCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentOrder.
-func (c *PaymentOrderClient) Update() *PaymentOrderUpdate {
-	mutation := newPaymentOrderMutation(c.config, OpUpdate)
-	return &PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PublicHoliday.
+func (c *PublicHolidayClient) Update() *PublicHolidayUpdate {
+	mutation := newPublicHolidayMutation(c.config, OpUpdate)
+	return &PublicHolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentOrderClient) UpdateOne(po *PaymentOrder) *PaymentOrderUpdateOne {
-	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrder(po))
-	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PublicHolidayClient) UpdateOne(ph *PublicHoliday) *PublicHolidayUpdateOne {
+	mutation := newPublicHolidayMutation(c.config, OpUpdateOne, withPublicHoliday(ph))
+	return &PublicHolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
-
-// UpdateOneID returns an update builder for the given id.
-func (c *PaymentOrderClient) UpdateOneID(id uuid.UUID) *PaymentOrderUpdateOne {
-	mutation := newPaymentOrderMutation(c.config, OpUpdateOne, withPaymentOrderID(id))
-	return &PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PublicHolidayClient) UpdateOneID(id int) *PublicHolidayUpdateOne {
+	mutation := newPublicHolidayMutation(c.config, OpUpdateOne, withPublicHolidayID(id))
+	return &PublicHolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentOrder.
-func (c *PaymentOrderClient) Delete() *PaymentOrderDelete {
-	mutation := newPaymentOrderMutation(c.config, OpDelete)
-	return &PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PublicHoliday.
+func (c *PublicHolidayClient) Delete() *PublicHolidayDelete {
+	mutation := newPublicHolidayMutation(c.config, OpDelete)
+	return &PublicHolidayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentOrderClient) DeleteOne(po *PaymentOrder) *PaymentOrderDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *PublicHolidayClient) DeleteOne(ph *PublicHoliday) *PublicHolidayDeleteOne {
+	return c.DeleteOneID(ph.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentOrderClient) DeleteOneID(id uuid.UUID) *PaymentOrderDeleteOne {
-	builder := c.Delete().Where(paymentorder.ID(id))
+func (c *PublicHolidayClient) DeleteOneID(id int) *PublicHolidayDeleteOne {
+	builder := c.Delete().Where(publicholiday.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentOrderDeleteOne{builder}
+	return &PublicHolidayDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentOrder.
-func (c *PaymentOrderClient) Query() *PaymentOrderQuery {
-	return &PaymentOrderQuery{
+// Query returns a query builder for PublicHoliday.
+func (c *PublicHolidayClient) Query() *PublicHolidayQuery {
+	return &PublicHolidayQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentOrder},
+		ctx:    &QueryContext{Type: TypePublicHoliday},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentOrder entity by its id.
-func (c *PaymentOrderClient) Get(ctx context.Context, id uuid.UUID) (*PaymentOrder, error) {
-	return c.Query().Where(paymentorder.ID(id)).Only(ctx)
+// Get returns a PublicHoliday entity by its id.
+func (c *PublicHolidayClient) Get(ctx context.Context, id int) (*PublicHoliday, error) {
+	return c.Query().Where(publicholiday.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrder {
+func (c *PublicHolidayClient) GetX(ctx context.Context, id int) *PublicHoliday {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1796,228 +4459,148 @@ func (c *PaymentOrderClient) GetX(ctx context.Context, id uuid.UUID) *PaymentOrd
 	return obj
 }
 
-// QuerySenderProfile queries the sender_profile edge of a PaymentOrder.
-func (c *PaymentOrderClient) QuerySenderProfile(po *PaymentOrder) *SenderProfileQuery {
-	query := (&SenderProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.SenderProfileTable, paymentorder.SenderProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryToken queries the token edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryToken(po *PaymentOrder) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.TokenTable, paymentorder.TokenColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLinkedAddress queries the linked_address edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryLinkedAddress(po *PaymentOrder) *LinkedAddressQuery {
-	query := (&LinkedAddressClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, paymentorder.LinkedAddressTable, paymentorder.LinkedAddressColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReceiveAddress queries the receive_address edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryReceiveAddress(po *PaymentOrder) *ReceiveAddressQuery {
-	query := (&ReceiveAddressClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(receiveaddress.Table, receiveaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.ReceiveAddressTable, paymentorder.ReceiveAddressColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRecipient queries the recipient edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryRecipient(po *PaymentOrder) *PaymentOrderRecipientQuery {
-	query := (&PaymentOrderRecipientClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(paymentorderrecipient.Table, paymentorderrecipient.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, paymentorder.RecipientTable, paymentorder.RecipientColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTransactions queries the transactions edge of a PaymentOrder.
-func (c *PaymentOrderClient) QueryTransactions(po *PaymentOrder) *TransactionLogQuery {
-	query := (&TransactionLogClient{config: c.config}).Query()
+// QueryFiatCurrency queries the fiat_currency edge of a PublicHoliday.
+func (c *PublicHolidayClient) QueryFiatCurrency(ph *PublicHoliday) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
+		id := ph.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, id),
-			sqlgraph.To(transactionlog.Table, transactionlog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.TransactionsTable, paymentorder.TransactionsColumn),
+			sqlgraph.From(publicholiday.Table, publicholiday.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, publicholiday.FiatCurrencyTable, publicholiday.FiatCurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ph.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentOrderClient) Hooks() []Hook {
-	return c.hooks.PaymentOrder
+func (c *PublicHolidayClient) Hooks() []Hook {
+	return c.hooks.PublicHoliday
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentOrderClient) Interceptors() []Interceptor {
-	return c.inters.PaymentOrder
+func (c *PublicHolidayClient) Interceptors() []Interceptor {
+	return c.inters.PublicHoliday
 }
 
-func (c *PaymentOrderClient) mutate(ctx context.Context, m *PaymentOrderMutation) (Value, error) {
+func (c *PublicHolidayClient) mutate(ctx context.Context, m *PublicHolidayMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentOrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PublicHolidayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentOrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PublicHolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentOrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PublicHolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentOrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PublicHolidayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentOrder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PublicHoliday mutation op: %q", m.Op())
 	}
 }
 
-// PaymentOrderRecipientClient is a client for the PaymentOrderRecipient schema.
-type PaymentOrderRecipientClient struct {
+// RateCircuitBreakerClient is a client for the RateCircuitBreaker schema.
+type RateCircuitBreakerClient struct {
 	config
 }
 
-// NewPaymentOrderRecipientClient returns a client for the PaymentOrderRecipient from the given config.
-func NewPaymentOrderRecipientClient(c config) *PaymentOrderRecipientClient {
-	return &PaymentOrderRecipientClient{config: c}
+// NewRateCircuitBreakerClient returns a client for the RateCircuitBreaker from the given config.
+func NewRateCircuitBreakerClient(c config) *RateCircuitBreakerClient {
+	return &RateCircuitBreakerClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `paymentorderrecipient.Hooks(f(g(h())))`.
-func (c *PaymentOrderRecipientClient) Use(hooks ...Hook) {
-	c.hooks.PaymentOrderRecipient = append(c.hooks.PaymentOrderRecipient, hooks...)
+// A call to `Use(f, g, h)` equals to `ratecircuitbreaker.Hooks(f(g(h())))`.
+func (c *RateCircuitBreakerClient) Use(hooks ...Hook) {
+	c.hooks.RateCircuitBreaker = append(c.hooks.RateCircuitBreaker, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `paymentorderrecipient.Intercept(f(g(h())))`.
-func (c *PaymentOrderRecipientClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PaymentOrderRecipient = append(c.inters.PaymentOrderRecipient, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ratecircuitbreaker.Intercept(f(g(h())))`.
+func (c *RateCircuitBreakerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateCircuitBreaker = append(c.inters.RateCircuitBreaker, interceptors...)
 }
 
-// Create returns a builder for creating a PaymentOrderRecipient entity.
-func (c *PaymentOrderRecipientClient) Create() *PaymentOrderRecipientCreate {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpCreate)
-	return &PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RateCircuitBreaker entity.
+func (c *RateCircuitBreakerClient) Create() *RateCircuitBreakerCreate {
+	mutation := newRateCircuitBreakerMutation(c.config, OpCreate)
+	return &RateCircuitBreakerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PaymentOrderRecipient entities.
-func (c *PaymentOrderRecipientClient) CreateBulk(builders ...*PaymentOrderRecipientCreate) *PaymentOrderRecipientCreateBulk {
-	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RateCircuitBreaker entities.
+func (c *RateCircuitBreakerClient) CreateBulk(builders ...*RateCircuitBreakerCreate) *RateCircuitBreakerCreateBulk {
+	return &RateCircuitBreakerCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PaymentOrderRecipientClient) MapCreateBulk(slice any, setFunc func(*PaymentOrderRecipientCreate, int)) *PaymentOrderRecipientCreateBulk {
+func (c *RateCircuitBreakerClient) MapCreateBulk(slice any, setFunc func(*RateCircuitBreakerCreate, int)) *RateCircuitBreakerCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PaymentOrderRecipientCreateBulk{err: fmt.Errorf("calling to PaymentOrderRecipientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RateCircuitBreakerCreateBulk{err: fmt.Errorf("calling to RateCircuitBreakerClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PaymentOrderRecipientCreate, rv.Len())
+	builders := make([]*RateCircuitBreakerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PaymentOrderRecipientCreateBulk{config: c.config, builders: builders}
+	return &RateCircuitBreakerCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) Update() *PaymentOrderRecipientUpdate {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdate)
-	return &PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RateCircuitBreaker.
+func (c *RateCircuitBreakerClient) Update() *RateCircuitBreakerUpdate {
+	mutation := newRateCircuitBreakerMutation(c.config, OpUpdate)
+	return &RateCircuitBreakerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PaymentOrderRecipientClient) UpdateOne(por *PaymentOrderRecipient) *PaymentOrderRecipientUpdateOne {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipient(por))
-	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RateCircuitBreakerClient) UpdateOne(rcb *RateCircuitBreaker) *RateCircuitBreakerUpdateOne {
+	mutation := newRateCircuitBreakerMutation(c.config, OpUpdateOne, withRateCircuitBreaker(rcb))
+	return &RateCircuitBreakerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PaymentOrderRecipientClient) UpdateOneID(id int) *PaymentOrderRecipientUpdateOne {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpUpdateOne, withPaymentOrderRecipientID(id))
-	return &PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RateCircuitBreakerClient) UpdateOneID(id uuid.UUID) *RateCircuitBreakerUpdateOne {
+	mutation := newRateCircuitBreakerMutation(c.config, OpUpdateOne, withRateCircuitBreakerID(id))
+	return &RateCircuitBreakerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) Delete() *PaymentOrderRecipientDelete {
-	mutation := newPaymentOrderRecipientMutation(c.config, OpDelete)
-	return &PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RateCircuitBreaker.
+func (c *RateCircuitBreakerClient) Delete() *RateCircuitBreakerDelete {
+	mutation := newRateCircuitBreakerMutation(c.config, OpDelete)
+	return &RateCircuitBreakerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PaymentOrderRecipientClient) DeleteOne(por *PaymentOrderRecipient) *PaymentOrderRecipientDeleteOne {
-	return c.DeleteOneID(por.ID)
+func (c *RateCircuitBreakerClient) DeleteOne(rcb *RateCircuitBreaker) *RateCircuitBreakerDeleteOne {
+	return c.DeleteOneID(rcb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PaymentOrderRecipientClient) DeleteOneID(id int) *PaymentOrderRecipientDeleteOne {
-	builder := c.Delete().Where(paymentorderrecipient.ID(id))
+func (c *RateCircuitBreakerClient) DeleteOneID(id uuid.UUID) *RateCircuitBreakerDeleteOne {
+	builder := c.Delete().Where(ratecircuitbreaker.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PaymentOrderRecipientDeleteOne{builder}
+	return &RateCircuitBreakerDeleteOne{builder}
 }
 
-// Query returns a query builder for PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) Query() *PaymentOrderRecipientQuery {
-	return &PaymentOrderRecipientQuery{
+// Query returns a query builder for RateCircuitBreaker.
+func (c *RateCircuitBreakerClient) Query() *RateCircuitBreakerQuery {
+	return &RateCircuitBreakerQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePaymentOrderRecipient},
+		ctx:    &QueryContext{Type: TypeRateCircuitBreaker},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PaymentOrderRecipient entity by its id.
-func (c *PaymentOrderRecipientClient) Get(ctx context.Context, id int) (*PaymentOrderRecipient, error) {
-	return c.Query().Where(paymentorderrecipient.ID(id)).Only(ctx)
+// Get returns a RateCircuitBreaker entity by its id.
+func (c *RateCircuitBreakerClient) Get(ctx context.Context, id uuid.UUID) (*RateCircuitBreaker, error) {
+	return c.Query().Where(ratecircuitbreaker.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PaymentOrderRecipientClient) GetX(ctx context.Context, id int) *PaymentOrderRecipient {
+func (c *RateCircuitBreakerClient) GetX(ctx context.Context, id uuid.UUID) *RateCircuitBreaker {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2025,148 +4608,148 @@ func (c *PaymentOrderRecipientClient) GetX(ctx context.Context, id int) *Payment
 	return obj
 }
 
-// QueryPaymentOrder queries the payment_order edge of a PaymentOrderRecipient.
-func (c *PaymentOrderRecipientClient) QueryPaymentOrder(por *PaymentOrderRecipient) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
+// QueryCurrency queries the currency edge of a RateCircuitBreaker.
+func (c *RateCircuitBreakerClient) QueryCurrency(rcb *RateCircuitBreaker) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := por.ID
+		id := rcb.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(paymentorderrecipient.Table, paymentorderrecipient.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, paymentorderrecipient.PaymentOrderTable, paymentorderrecipient.PaymentOrderColumn),
+			sqlgraph.From(ratecircuitbreaker.Table, ratecircuitbreaker.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ratecircuitbreaker.CurrencyTable, ratecircuitbreaker.CurrencyColumn),
 		)
-		fromV = sqlgraph.Neighbors(por.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rcb.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PaymentOrderRecipientClient) Hooks() []Hook {
-	return c.hooks.PaymentOrderRecipient
+func (c *RateCircuitBreakerClient) Hooks() []Hook {
+	return c.hooks.RateCircuitBreaker
 }
 
 // Interceptors returns the client interceptors.
-func (c *PaymentOrderRecipientClient) Interceptors() []Interceptor {
-	return c.inters.PaymentOrderRecipient
+func (c *RateCircuitBreakerClient) Interceptors() []Interceptor {
+	return c.inters.RateCircuitBreaker
 }
 
-func (c *PaymentOrderRecipientClient) mutate(ctx context.Context, m *PaymentOrderRecipientMutation) (Value, error) {
+func (c *RateCircuitBreakerClient) mutate(ctx context.Context, m *RateCircuitBreakerMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PaymentOrderRecipientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RateCircuitBreakerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PaymentOrderRecipientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RateCircuitBreakerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PaymentOrderRecipientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RateCircuitBreakerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PaymentOrderRecipientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RateCircuitBreakerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PaymentOrderRecipient mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RateCircuitBreaker mutation op: %q", m.Op())
 	}
 }
 
-// ProviderOrderTokenClient is a client for the ProviderOrderToken schema.
-type ProviderOrderTokenClient struct {
+// RateSnapshotClient is a client for the RateSnapshot schema.
+type RateSnapshotClient struct {
 	config
 }
 
-// NewProviderOrderTokenClient returns a client for the ProviderOrderToken from the given config.
-func NewProviderOrderTokenClient(c config) *ProviderOrderTokenClient {
-	return &ProviderOrderTokenClient{config: c}
+// NewRateSnapshotClient returns a client for the RateSnapshot from the given config.
+func NewRateSnapshotClient(c config) *RateSnapshotClient {
+	return &RateSnapshotClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerordertoken.Hooks(f(g(h())))`.
-func (c *ProviderOrderTokenClient) Use(hooks ...Hook) {
-	c.hooks.ProviderOrderToken = append(c.hooks.ProviderOrderToken, hooks...)
+// A call to `Use(f, g, h)` equals to `ratesnapshot.Hooks(f(g(h())))`.
+func (c *RateSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.RateSnapshot = append(c.hooks.RateSnapshot, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerordertoken.Intercept(f(g(h())))`.
-func (c *ProviderOrderTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderOrderToken = append(c.inters.ProviderOrderToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `ratesnapshot.Intercept(f(g(h())))`.
+func (c *RateSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateSnapshot = append(c.inters.RateSnapshot, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderOrderToken entity.
-func (c *ProviderOrderTokenClient) Create() *ProviderOrderTokenCreate {
-	mutation := newProviderOrderTokenMutation(c.config, OpCreate)
-	return &ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RateSnapshot entity.
+func (c *RateSnapshotClient) Create() *RateSnapshotCreate {
+	mutation := newRateSnapshotMutation(c.config, OpCreate)
+	return &RateSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderOrderToken entities.
-func (c *ProviderOrderTokenClient) CreateBulk(builders ...*ProviderOrderTokenCreate) *ProviderOrderTokenCreateBulk {
-	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RateSnapshot entities.
+func (c *RateSnapshotClient) CreateBulk(builders ...*RateSnapshotCreate) *RateSnapshotCreateBulk {
+	return &RateSnapshotCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*ProviderOrderTokenCreate, int)) *ProviderOrderTokenCreateBulk {
+func (c *RateSnapshotClient) MapCreateBulk(slice any, setFunc func(*RateSnapshotCreate, int)) *RateSnapshotCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderOrderTokenCreateBulk{err: fmt.Errorf("calling to ProviderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RateSnapshotCreateBulk{err: fmt.Errorf("calling to RateSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderOrderTokenCreate, rv.Len())
+	builders := make([]*RateSnapshotCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderOrderTokenCreateBulk{config: c.config, builders: builders}
+	return &RateSnapshotCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Update() *ProviderOrderTokenUpdate {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdate)
-	return &ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RateSnapshot.
+func (c *RateSnapshotClient) Update() *RateSnapshotUpdate {
+	mutation := newRateSnapshotMutation(c.config, OpUpdate)
+	return &RateSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderOrderTokenClient) UpdateOne(pot *ProviderOrderToken) *ProviderOrderTokenUpdateOne {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderToken(pot))
-	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RateSnapshotClient) UpdateOne(rs *RateSnapshot) *RateSnapshotUpdateOne {
+	mutation := newRateSnapshotMutation(c.config, OpUpdateOne, withRateSnapshot(rs))
+	return &RateSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderOrderTokenClient) UpdateOneID(id int) *ProviderOrderTokenUpdateOne {
-	mutation := newProviderOrderTokenMutation(c.config, OpUpdateOne, withProviderOrderTokenID(id))
-	return &ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RateSnapshotClient) UpdateOneID(id uuid.UUID) *RateSnapshotUpdateOne {
+	mutation := newRateSnapshotMutation(c.config, OpUpdateOne, withRateSnapshotID(id))
+	return &RateSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Delete() *ProviderOrderTokenDelete {
-	mutation := newProviderOrderTokenMutation(c.config, OpDelete)
-	return &ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RateSnapshot.
+func (c *RateSnapshotClient) Delete() *RateSnapshotDelete {
+	mutation := newRateSnapshotMutation(c.config, OpDelete)
+	return &RateSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderOrderTokenClient) DeleteOne(pot *ProviderOrderToken) *ProviderOrderTokenDeleteOne {
-	return c.DeleteOneID(pot.ID)
+func (c *RateSnapshotClient) DeleteOne(rs *RateSnapshot) *RateSnapshotDeleteOne {
+	return c.DeleteOneID(rs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderOrderTokenClient) DeleteOneID(id int) *ProviderOrderTokenDeleteOne {
-	builder := c.Delete().Where(providerordertoken.ID(id))
+func (c *RateSnapshotClient) DeleteOneID(id uuid.UUID) *RateSnapshotDeleteOne {
+	builder := c.Delete().Where(ratesnapshot.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderOrderTokenDeleteOne{builder}
+	return &RateSnapshotDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderOrderToken.
-func (c *ProviderOrderTokenClient) Query() *ProviderOrderTokenQuery {
-	return &ProviderOrderTokenQuery{
+// Query returns a query builder for RateSnapshot.
+func (c *RateSnapshotClient) Query() *RateSnapshotQuery {
+	return &RateSnapshotQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderOrderToken},
+		ctx:    &QueryContext{Type: TypeRateSnapshot},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderOrderToken entity by its id.
-func (c *ProviderOrderTokenClient) Get(ctx context.Context, id int) (*ProviderOrderToken, error) {
-	return c.Query().Where(providerordertoken.ID(id)).Only(ctx)
+// Get returns a RateSnapshot entity by its id.
+func (c *RateSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*RateSnapshot, error) {
+	return c.Query().Where(ratesnapshot.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOrderToken {
+func (c *RateSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *RateSnapshot {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2174,148 +4757,164 @@ func (c *ProviderOrderTokenClient) GetX(ctx context.Context, id int) *ProviderOr
 	return obj
 }
 
-// QueryProvider queries the provider edge of a ProviderOrderToken.
-func (c *ProviderOrderTokenClient) QueryProvider(pot *ProviderOrderToken) *ProviderProfileQuery {
+// QueryCurrency queries the currency edge of a RateSnapshot.
+func (c *RateSnapshotClient) QueryCurrency(rs *RateSnapshot) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratesnapshot.Table, ratesnapshot.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratesnapshot.CurrencyTable, ratesnapshot.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProvider queries the provider edge of a RateSnapshot.
+func (c *RateSnapshotClient) QueryProvider(rs *RateSnapshot) *ProviderProfileQuery {
 	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pot.ID
+		id := rs.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, id),
+			sqlgraph.From(ratesnapshot.Table, ratesnapshot.FieldID, id),
 			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.ProviderTable, providerordertoken.ProviderColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratesnapshot.ProviderTable, ratesnapshot.ProviderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pot.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *ProviderOrderTokenClient) Hooks() []Hook {
-	return c.hooks.ProviderOrderToken
+// Hooks returns the client hooks.
+func (c *RateSnapshotClient) Hooks() []Hook {
+	return c.hooks.RateSnapshot
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderOrderTokenClient) Interceptors() []Interceptor {
-	return c.inters.ProviderOrderToken
+func (c *RateSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.RateSnapshot
 }
 
-func (c *ProviderOrderTokenClient) mutate(ctx context.Context, m *ProviderOrderTokenMutation) (Value, error) {
+func (c *RateSnapshotClient) mutate(ctx context.Context, m *RateSnapshotMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RateSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RateSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RateSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RateSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderOrderToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RateSnapshot mutation op: %q", m.Op())
 	}
 }
 
-// ProviderProfileClient is a client for the ProviderProfile schema.
-type ProviderProfileClient struct {
+// ReceiveAddressClient is a client for the ReceiveAddress schema.
+type ReceiveAddressClient struct {
 	config
 }
 
-// NewProviderProfileClient returns a client for the ProviderProfile from the given config.
-func NewProviderProfileClient(c config) *ProviderProfileClient {
-	return &ProviderProfileClient{config: c}
+// NewReceiveAddressClient returns a client for the ReceiveAddress from the given config.
+func NewReceiveAddressClient(c config) *ReceiveAddressClient {
+	return &ReceiveAddressClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerprofile.Hooks(f(g(h())))`.
-func (c *ProviderProfileClient) Use(hooks ...Hook) {
-	c.hooks.ProviderProfile = append(c.hooks.ProviderProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `receiveaddress.Hooks(f(g(h())))`.
+func (c *ReceiveAddressClient) Use(hooks ...Hook) {
+	c.hooks.ReceiveAddress = append(c.hooks.ReceiveAddress, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerprofile.Intercept(f(g(h())))`.
-func (c *ProviderProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderProfile = append(c.inters.ProviderProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `receiveaddress.Intercept(f(g(h())))`.
+func (c *ReceiveAddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReceiveAddress = append(c.inters.ReceiveAddress, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderProfile entity.
-func (c *ProviderProfileClient) Create() *ProviderProfileCreate {
-	mutation := newProviderProfileMutation(c.config, OpCreate)
-	return &ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ReceiveAddress entity.
+func (c *ReceiveAddressClient) Create() *ReceiveAddressCreate {
+	mutation := newReceiveAddressMutation(c.config, OpCreate)
+	return &ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderProfile entities.
-func (c *ProviderProfileClient) CreateBulk(builders ...*ProviderProfileCreate) *ProviderProfileCreateBulk {
-	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ReceiveAddress entities.
+func (c *ReceiveAddressClient) CreateBulk(builders ...*ReceiveAddressCreate) *ReceiveAddressCreateBulk {
+	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderProfileClient) MapCreateBulk(slice any, setFunc func(*ProviderProfileCreate, int)) *ProviderProfileCreateBulk {
+func (c *ReceiveAddressClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressCreate, int)) *ReceiveAddressCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderProfileCreateBulk{err: fmt.Errorf("calling to ProviderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ReceiveAddressCreateBulk{err: fmt.Errorf("calling to ReceiveAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderProfileCreate, rv.Len())
+	builders := make([]*ReceiveAddressCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderProfileCreateBulk{config: c.config, builders: builders}
+	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderProfile.
-func (c *ProviderProfileClient) Update() *ProviderProfileUpdate {
-	mutation := newProviderProfileMutation(c.config, OpUpdate)
-	return &ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Update() *ReceiveAddressUpdate {
+	mutation := newReceiveAddressMutation(c.config, OpUpdate)
+	return &ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderProfileClient) UpdateOne(pp *ProviderProfile) *ProviderProfileUpdateOne {
-	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfile(pp))
-	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiveAddressClient) UpdateOne(ra *ReceiveAddress) *ReceiveAddressUpdateOne {
+	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddress(ra))
+	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderProfileClient) UpdateOneID(id string) *ProviderProfileUpdateOne {
-	mutation := newProviderProfileMutation(c.config, OpUpdateOne, withProviderProfileID(id))
-	return &ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ReceiveAddressClient) UpdateOneID(id int) *ReceiveAddressUpdateOne {
+	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddressID(id))
+	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderProfile.
-func (c *ProviderProfileClient) Delete() *ProviderProfileDelete {
-	mutation := newProviderProfileMutation(c.config, OpDelete)
-	return &ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Delete() *ReceiveAddressDelete {
+	mutation := newReceiveAddressMutation(c.config, OpDelete)
+	return &ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderProfileClient) DeleteOne(pp *ProviderProfile) *ProviderProfileDeleteOne {
-	return c.DeleteOneID(pp.ID)
+func (c *ReceiveAddressClient) DeleteOne(ra *ReceiveAddress) *ReceiveAddressDeleteOne {
+	return c.DeleteOneID(ra.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderProfileClient) DeleteOneID(id string) *ProviderProfileDeleteOne {
-	builder := c.Delete().Where(providerprofile.ID(id))
+func (c *ReceiveAddressClient) DeleteOneID(id int) *ReceiveAddressDeleteOne {
+	builder := c.Delete().Where(receiveaddress.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderProfileDeleteOne{builder}
+	return &ReceiveAddressDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderProfile.
-func (c *ProviderProfileClient) Query() *ProviderProfileQuery {
-	return &ProviderProfileQuery{
+// Query returns a query builder for ReceiveAddress.
+func (c *ReceiveAddressClient) Query() *ReceiveAddressQuery {
+	return &ReceiveAddressQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderProfile},
+		ctx:    &QueryContext{Type: TypeReceiveAddress},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderProfile entity by its id.
-func (c *ProviderProfileClient) Get(ctx context.Context, id string) (*ProviderProfile, error) {
-	return c.Query().Where(providerprofile.ID(id)).Only(ctx)
+// Get returns a ReceiveAddress entity by its id.
+func (c *ReceiveAddressClient) Get(ctx context.Context, id int) (*ReceiveAddress, error) {
+	return c.Query().Where(receiveaddress.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderProfile {
+func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2323,244 +4922,148 @@ func (c *ProviderProfileClient) GetX(ctx context.Context, id string) *ProviderPr
 	return obj
 }
 
-// QueryUser queries the user edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryUser(pp *ProviderProfile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerprofile.UserTable, providerprofile.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAPIKey queries the api_key edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryAPIKey(pp *ProviderProfile) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.APIKeyTable, providerprofile.APIKeyColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCurrency queries the currency edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryCurrency(pp *ProviderProfile) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerprofile.CurrencyTable, providerprofile.CurrencyColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProvisionBuckets queries the provision_buckets edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProvisionBuckets(pp *ProviderProfile) *ProvisionBucketQuery {
-	query := (&ProvisionBucketClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(provisionbucket.Table, provisionbucket.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.ProvisionBucketsTable, providerprofile.ProvisionBucketsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOrderTokens queries the order_tokens edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryOrderTokens(pp *ProviderProfile) *ProviderOrderTokenQuery {
-	query := (&ProviderOrderTokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderTokensTable, providerprofile.OrderTokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProviderRating queries the provider_rating edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryProviderRating(pp *ProviderProfile) *ProviderRatingQuery {
-	query := (&ProviderRatingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(providerrating.Table, providerrating.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, providerprofile.ProviderRatingTable, providerprofile.ProviderRatingColumn),
-		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAssignedOrders queries the assigned_orders edge of a ProviderProfile.
-func (c *ProviderProfileClient) QueryAssignedOrders(pp *ProviderProfile) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryPaymentOrder queries the payment_order edge of a ReceiveAddress.
+func (c *ReceiveAddressClient) QueryPaymentOrder(ra *ReceiveAddress) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pp.ID
+		id := ra.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.AssignedOrdersTable, providerprofile.AssignedOrdersColumn),
+			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderTable, receiveaddress.PaymentOrderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderProfileClient) Hooks() []Hook {
-	return c.hooks.ProviderProfile
+func (c *ReceiveAddressClient) Hooks() []Hook {
+	return c.hooks.ReceiveAddress
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderProfileClient) Interceptors() []Interceptor {
-	return c.inters.ProviderProfile
+func (c *ReceiveAddressClient) Interceptors() []Interceptor {
+	return c.inters.ReceiveAddress
 }
 
-func (c *ProviderProfileClient) mutate(ctx context.Context, m *ProviderProfileMutation) (Value, error) {
+func (c *ReceiveAddressClient) mutate(ctx context.Context, m *ReceiveAddressMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ReceiveAddress mutation op: %q", m.Op())
 	}
 }
 
-// ProviderRatingClient is a client for the ProviderRating schema.
-type ProviderRatingClient struct {
+// SenderOrderTokenClient is a client for the SenderOrderToken schema.
+type SenderOrderTokenClient struct {
 	config
 }
 
-// NewProviderRatingClient returns a client for the ProviderRating from the given config.
-func NewProviderRatingClient(c config) *ProviderRatingClient {
-	return &ProviderRatingClient{config: c}
+// NewSenderOrderTokenClient returns a client for the SenderOrderToken from the given config.
+func NewSenderOrderTokenClient(c config) *SenderOrderTokenClient {
+	return &SenderOrderTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerrating.Hooks(f(g(h())))`.
-func (c *ProviderRatingClient) Use(hooks ...Hook) {
-	c.hooks.ProviderRating = append(c.hooks.ProviderRating, hooks...)
+// A call to `Use(f, g, h)` equals to `senderordertoken.Hooks(f(g(h())))`.
+func (c *SenderOrderTokenClient) Use(hooks ...Hook) {
+	c.hooks.SenderOrderToken = append(c.hooks.SenderOrderToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerrating.Intercept(f(g(h())))`.
-func (c *ProviderRatingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderRating = append(c.inters.ProviderRating, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `senderordertoken.Intercept(f(g(h())))`.
+func (c *SenderOrderTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SenderOrderToken = append(c.inters.SenderOrderToken, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderRating entity.
-func (c *ProviderRatingClient) Create() *ProviderRatingCreate {
-	mutation := newProviderRatingMutation(c.config, OpCreate)
-	return &ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SenderOrderToken entity.
+func (c *SenderOrderTokenClient) Create() *SenderOrderTokenCreate {
+	mutation := newSenderOrderTokenMutation(c.config, OpCreate)
+	return &SenderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderRating entities.
-func (c *ProviderRatingClient) CreateBulk(builders ...*ProviderRatingCreate) *ProviderRatingCreateBulk {
-	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SenderOrderToken entities.
+func (c *SenderOrderTokenClient) CreateBulk(builders ...*SenderOrderTokenCreate) *SenderOrderTokenCreateBulk {
+	return &SenderOrderTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderRatingClient) MapCreateBulk(slice any, setFunc func(*ProviderRatingCreate, int)) *ProviderRatingCreateBulk {
+func (c *SenderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*SenderOrderTokenCreate, int)) *SenderOrderTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderRatingCreateBulk{err: fmt.Errorf("calling to ProviderRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SenderOrderTokenCreateBulk{err: fmt.Errorf("calling to SenderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderRatingCreate, rv.Len())
+	builders := make([]*SenderOrderTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderRatingCreateBulk{config: c.config, builders: builders}
+	return &SenderOrderTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderRating.
-func (c *ProviderRatingClient) Update() *ProviderRatingUpdate {
-	mutation := newProviderRatingMutation(c.config, OpUpdate)
-	return &ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SenderOrderToken.
+func (c *SenderOrderTokenClient) Update() *SenderOrderTokenUpdate {
+	mutation := newSenderOrderTokenMutation(c.config, OpUpdate)
+	return &SenderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderRatingClient) UpdateOne(pr *ProviderRating) *ProviderRatingUpdateOne {
-	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRating(pr))
-	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SenderOrderTokenClient) UpdateOne(sot *SenderOrderToken) *SenderOrderTokenUpdateOne {
+	mutation := newSenderOrderTokenMutation(c.config, OpUpdateOne, withSenderOrderToken(sot))
+	return &SenderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderRatingClient) UpdateOneID(id int) *ProviderRatingUpdateOne {
-	mutation := newProviderRatingMutation(c.config, OpUpdateOne, withProviderRatingID(id))
-	return &ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SenderOrderTokenClient) UpdateOneID(id int) *SenderOrderTokenUpdateOne {
+	mutation := newSenderOrderTokenMutation(c.config, OpUpdateOne, withSenderOrderTokenID(id))
+	return &SenderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderRating.
-func (c *ProviderRatingClient) Delete() *ProviderRatingDelete {
-	mutation := newProviderRatingMutation(c.config, OpDelete)
-	return &ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SenderOrderToken.
+func (c *SenderOrderTokenClient) Delete() *SenderOrderTokenDelete {
+	mutation := newSenderOrderTokenMutation(c.config, OpDelete)
+	return &SenderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderRatingClient) DeleteOne(pr *ProviderRating) *ProviderRatingDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *SenderOrderTokenClient) DeleteOne(sot *SenderOrderToken) *SenderOrderTokenDeleteOne {
+	return c.DeleteOneID(sot.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderRatingClient) DeleteOneID(id int) *ProviderRatingDeleteOne {
-	builder := c.Delete().Where(providerrating.ID(id))
+func (c *SenderOrderTokenClient) DeleteOneID(id int) *SenderOrderTokenDeleteOne {
+	builder := c.Delete().Where(senderordertoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderRatingDeleteOne{builder}
+	return &SenderOrderTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderRating.
-func (c *ProviderRatingClient) Query() *ProviderRatingQuery {
-	return &ProviderRatingQuery{
+// Query returns a query builder for SenderOrderToken.
+func (c *SenderOrderTokenClient) Query() *SenderOrderTokenQuery {
+	return &SenderOrderTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderRating},
+		ctx:    &QueryContext{Type: TypeSenderOrderToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderRating entity by its id.
-func (c *ProviderRatingClient) Get(ctx context.Context, id int) (*ProviderRating, error) {
-	return c.Query().Where(providerrating.ID(id)).Only(ctx)
+// Get returns a SenderOrderToken entity by its id.
+func (c *SenderOrderTokenClient) Get(ctx context.Context, id int) (*SenderOrderToken, error) {
+	return c.Query().Where(senderordertoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating {
+func (c *SenderOrderTokenClient) GetX(ctx context.Context, id int) *SenderOrderToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2568,329 +5071,425 @@ func (c *ProviderRatingClient) GetX(ctx context.Context, id int) *ProviderRating
 	return obj
 }
 
-// QueryProviderProfile queries the provider_profile edge of a ProviderRating.
-func (c *ProviderRatingClient) QueryProviderProfile(pr *ProviderRating) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QuerySender queries the sender edge of a SenderOrderToken.
+func (c *SenderOrderTokenClient) QuerySender(sot *SenderOrderToken) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := sot.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerrating.Table, providerrating.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerrating.ProviderProfileTable, providerrating.ProviderProfileColumn),
+			sqlgraph.From(senderordertoken.Table, senderordertoken.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, senderordertoken.SenderTable, senderordertoken.SenderColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sot.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToken queries the token edge of a SenderOrderToken.
+func (c *SenderOrderTokenClient) QueryToken(sot *SenderOrderToken) *TokenQuery {
+	query := (&TokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sot.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderordertoken.Table, senderordertoken.FieldID, id),
+			sqlgraph.To(token.Table, token.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, senderordertoken.TokenTable, senderordertoken.TokenColumn),
+		)
+		fromV = sqlgraph.Neighbors(sot.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderRatingClient) Hooks() []Hook {
-	return c.hooks.ProviderRating
+func (c *SenderOrderTokenClient) Hooks() []Hook {
+	return c.hooks.SenderOrderToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderRatingClient) Interceptors() []Interceptor {
-	return c.inters.ProviderRating
+func (c *SenderOrderTokenClient) Interceptors() []Interceptor {
+	return c.inters.SenderOrderToken
 }
 
-func (c *ProviderRatingClient) mutate(ctx context.Context, m *ProviderRatingMutation) (Value, error) {
+func (c *SenderOrderTokenClient) mutate(ctx context.Context, m *SenderOrderTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SenderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderRating mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SenderOrderToken mutation op: %q", m.Op())
 	}
 }
 
-// ProvisionBucketClient is a client for the ProvisionBucket schema.
-type ProvisionBucketClient struct {
+// SenderProfileClient is a client for the SenderProfile schema.
+type SenderProfileClient struct {
 	config
 }
 
-// NewProvisionBucketClient returns a client for the ProvisionBucket from the given config.
-func NewProvisionBucketClient(c config) *ProvisionBucketClient {
-	return &ProvisionBucketClient{config: c}
+// NewSenderProfileClient returns a client for the SenderProfile from the given config.
+func NewSenderProfileClient(c config) *SenderProfileClient {
+	return &SenderProfileClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `provisionbucket.Hooks(f(g(h())))`.
-func (c *ProvisionBucketClient) Use(hooks ...Hook) {
-	c.hooks.ProvisionBucket = append(c.hooks.ProvisionBucket, hooks...)
+// A call to `Use(f, g, h)` equals to `senderprofile.Hooks(f(g(h())))`.
+func (c *SenderProfileClient) Use(hooks ...Hook) {
+	c.hooks.SenderProfile = append(c.hooks.SenderProfile, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `provisionbucket.Intercept(f(g(h())))`.
-func (c *ProvisionBucketClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProvisionBucket = append(c.inters.ProvisionBucket, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `senderprofile.Intercept(f(g(h())))`.
+func (c *SenderProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SenderProfile = append(c.inters.SenderProfile, interceptors...)
 }
 
-// Create returns a builder for creating a ProvisionBucket entity.
-func (c *ProvisionBucketClient) Create() *ProvisionBucketCreate {
-	mutation := newProvisionBucketMutation(c.config, OpCreate)
-	return &ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SenderProfile entity.
+func (c *SenderProfileClient) Create() *SenderProfileCreate {
+	mutation := newSenderProfileMutation(c.config, OpCreate)
+	return &SenderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProvisionBucket entities.
-func (c *ProvisionBucketClient) CreateBulk(builders ...*ProvisionBucketCreate) *ProvisionBucketCreateBulk {
-	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SenderProfile entities.
+func (c *SenderProfileClient) CreateBulk(builders ...*SenderProfileCreate) *SenderProfileCreateBulk {
+	return &SenderProfileCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProvisionBucketClient) MapCreateBulk(slice any, setFunc func(*ProvisionBucketCreate, int)) *ProvisionBucketCreateBulk {
+func (c *SenderProfileClient) MapCreateBulk(slice any, setFunc func(*SenderProfileCreate, int)) *SenderProfileCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProvisionBucketCreateBulk{err: fmt.Errorf("calling to ProvisionBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SenderProfileCreateBulk{err: fmt.Errorf("calling to SenderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProvisionBucketCreate, rv.Len())
+	builders := make([]*SenderProfileCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProvisionBucketCreateBulk{config: c.config, builders: builders}
+	return &SenderProfileCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Update() *ProvisionBucketUpdate {
-	mutation := newProvisionBucketMutation(c.config, OpUpdate)
-	return &ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SenderProfile.
+func (c *SenderProfileClient) Update() *SenderProfileUpdate {
+	mutation := newSenderProfileMutation(c.config, OpUpdate)
+	return &SenderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProvisionBucketClient) UpdateOne(pb *ProvisionBucket) *ProvisionBucketUpdateOne {
-	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucket(pb))
-	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SenderProfileClient) UpdateOne(sp *SenderProfile) *SenderProfileUpdateOne {
+	mutation := newSenderProfileMutation(c.config, OpUpdateOne, withSenderProfile(sp))
+	return &SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProvisionBucketClient) UpdateOneID(id int) *ProvisionBucketUpdateOne {
-	mutation := newProvisionBucketMutation(c.config, OpUpdateOne, withProvisionBucketID(id))
-	return &ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SenderProfileClient) UpdateOneID(id uuid.UUID) *SenderProfileUpdateOne {
+	mutation := newSenderProfileMutation(c.config, OpUpdateOne, withSenderProfileID(id))
+	return &SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Delete() *ProvisionBucketDelete {
-	mutation := newProvisionBucketMutation(c.config, OpDelete)
-	return &ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SenderProfile.
+func (c *SenderProfileClient) Delete() *SenderProfileDelete {
+	mutation := newSenderProfileMutation(c.config, OpDelete)
+	return &SenderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProvisionBucketClient) DeleteOne(pb *ProvisionBucket) *ProvisionBucketDeleteOne {
-	return c.DeleteOneID(pb.ID)
+func (c *SenderProfileClient) DeleteOne(sp *SenderProfile) *SenderProfileDeleteOne {
+	return c.DeleteOneID(sp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProvisionBucketClient) DeleteOneID(id int) *ProvisionBucketDeleteOne {
-	builder := c.Delete().Where(provisionbucket.ID(id))
+func (c *SenderProfileClient) DeleteOneID(id uuid.UUID) *SenderProfileDeleteOne {
+	builder := c.Delete().Where(senderprofile.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProvisionBucketDeleteOne{builder}
+	return &SenderProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for SenderProfile.
+func (c *SenderProfileClient) Query() *SenderProfileQuery {
+	return &SenderProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSenderProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SenderProfile entity by its id.
+func (c *SenderProfileClient) Get(ctx context.Context, id uuid.UUID) (*SenderProfile, error) {
+	return c.Query().Where(senderprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SenderProfileClient) GetX(ctx context.Context, id uuid.UUID) *SenderProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a SenderProfile.
+func (c *SenderProfileClient) QueryUser(sp *SenderProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, senderprofile.UserTable, senderprofile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKey queries the api_key edge of a SenderProfile.
+func (c *SenderProfileClient) QueryAPIKey(sp *SenderProfile) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Query returns a query builder for ProvisionBucket.
-func (c *ProvisionBucketClient) Query() *ProvisionBucketQuery {
-	return &ProvisionBucketQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeProvisionBucket},
-		inters: c.Interceptors(),
+// QueryPaymentOrders queries the payment_orders edge of a SenderProfile.
+func (c *SenderProfileClient) QueryPaymentOrders(sp *SenderProfile) *PaymentOrderQuery {
+	query := (&PaymentOrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.PaymentOrdersTable, senderprofile.PaymentOrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
 	}
+	return query
 }
 
-// Get returns a ProvisionBucket entity by its id.
-func (c *ProvisionBucketClient) Get(ctx context.Context, id int) (*ProvisionBucket, error) {
-	return c.Query().Where(provisionbucket.ID(id)).Only(ctx)
+// QueryOrderTokens queries the order_tokens edge of a SenderProfile.
+func (c *SenderProfileClient) QueryOrderTokens(sp *SenderProfile) *SenderOrderTokenQuery {
+	query := (&SenderOrderTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(senderordertoken.Table, senderordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.OrderTokensTable, senderprofile.OrderTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *ProvisionBucketClient) GetX(ctx context.Context, id int) *ProvisionBucket {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// QueryLinkedAddress queries the linked_address edge of a SenderProfile.
+func (c *SenderProfileClient) QueryLinkedAddress(sp *SenderProfile) *LinkedAddressQuery {
+	query := (&LinkedAddressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.LinkedAddressTable, senderprofile.LinkedAddressColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QueryCurrency queries the currency edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryCurrency(pb *ProvisionBucket) *FiatCurrencyQuery {
-	query := (&FiatCurrencyClient{config: c.config}).Query()
+// QueryTeamMembers queries the team_members edge of a SenderProfile.
+func (c *SenderProfileClient) QueryTeamMembers(sp *SenderProfile) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
+		id := sp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, provisionbucket.CurrencyTable, provisionbucket.CurrencyColumn),
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamMembersTable, senderprofile.TeamMembersColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLockPaymentOrders queries the lock_payment_orders edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryLockPaymentOrders(pb *ProvisionBucket) *LockPaymentOrderQuery {
-	query := (&LockPaymentOrderClient{config: c.config}).Query()
+// QueryTeamInvitations queries the team_invitations edge of a SenderProfile.
+func (c *SenderProfileClient) QueryTeamInvitations(sp *SenderProfile) *TeamInvitationQuery {
+	query := (&TeamInvitationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
+		id := sp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, provisionbucket.LockPaymentOrdersTable, provisionbucket.LockPaymentOrdersColumn),
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(teaminvitation.Table, teaminvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamInvitationsTable, senderprofile.TeamInvitationsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProviderProfiles queries the provider_profiles edge of a ProvisionBucket.
-func (c *ProvisionBucketClient) QueryProviderProfiles(pb *ProvisionBucket) *ProviderProfileQuery {
-	query := (&ProviderProfileClient{config: c.config}).Query()
+// QueryTeamAuditLogs queries the team_audit_logs edge of a SenderProfile.
+func (c *SenderProfileClient) QueryTeamAuditLogs(sp *SenderProfile) *TeamAuditLogQuery {
+	query := (&TeamAuditLogClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pb.ID
+		id := sp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(provisionbucket.Table, provisionbucket.FieldID, id),
-			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, provisionbucket.ProviderProfilesTable, provisionbucket.ProviderProfilesPrimaryKey...),
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.To(teamauditlog.Table, teamauditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamAuditLogsTable, senderprofile.TeamAuditLogsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pb.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProvisionBucketClient) Hooks() []Hook {
-	return c.hooks.ProvisionBucket
+func (c *SenderProfileClient) Hooks() []Hook {
+	return c.hooks.SenderProfile
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProvisionBucketClient) Interceptors() []Interceptor {
-	return c.inters.ProvisionBucket
+func (c *SenderProfileClient) Interceptors() []Interceptor {
+	return c.inters.SenderProfile
 }
 
-func (c *ProvisionBucketClient) mutate(ctx context.Context, m *ProvisionBucketMutation) (Value, error) {
+func (c *SenderProfileClient) mutate(ctx context.Context, m *SenderProfileMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProvisionBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProvisionBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProvisionBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProvisionBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SenderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProvisionBucket mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SenderProfile mutation op: %q", m.Op())
 	}
 }
 
-// ReceiveAddressClient is a client for the ReceiveAddress schema.
-type ReceiveAddressClient struct {
+// TeamAuditLogClient is a client for the TeamAuditLog schema.
+type TeamAuditLogClient struct {
 	config
 }
 
-// NewReceiveAddressClient returns a client for the ReceiveAddress from the given config.
-func NewReceiveAddressClient(c config) *ReceiveAddressClient {
-	return &ReceiveAddressClient{config: c}
+// NewTeamAuditLogClient returns a client for the TeamAuditLog from the given config.
+func NewTeamAuditLogClient(c config) *TeamAuditLogClient {
+	return &TeamAuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `receiveaddress.Hooks(f(g(h())))`.
-func (c *ReceiveAddressClient) Use(hooks ...Hook) {
-	c.hooks.ReceiveAddress = append(c.hooks.ReceiveAddress, hooks...)
+// A call to `Use(f, g, h)` equals to `teamauditlog.Hooks(f(g(h())))`.
+func (c *TeamAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.TeamAuditLog = append(c.hooks.TeamAuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `receiveaddress.Intercept(f(g(h())))`.
-func (c *ReceiveAddressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ReceiveAddress = append(c.inters.ReceiveAddress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `teamauditlog.Intercept(f(g(h())))`.
+func (c *TeamAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamAuditLog = append(c.inters.TeamAuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a ReceiveAddress entity.
-func (c *ReceiveAddressClient) Create() *ReceiveAddressCreate {
-	mutation := newReceiveAddressMutation(c.config, OpCreate)
-	return &ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TeamAuditLog entity.
+func (c *TeamAuditLogClient) Create() *TeamAuditLogCreate {
+	mutation := newTeamAuditLogMutation(c.config, OpCreate)
+	return &TeamAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ReceiveAddress entities.
-func (c *ReceiveAddressClient) CreateBulk(builders ...*ReceiveAddressCreate) *ReceiveAddressCreateBulk {
-	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TeamAuditLog entities.
+func (c *TeamAuditLogClient) CreateBulk(builders ...*TeamAuditLogCreate) *TeamAuditLogCreateBulk {
+	return &TeamAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReceiveAddressClient) MapCreateBulk(slice any, setFunc func(*ReceiveAddressCreate, int)) *ReceiveAddressCreateBulk {
+func (c *TeamAuditLogClient) MapCreateBulk(slice any, setFunc func(*TeamAuditLogCreate, int)) *TeamAuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReceiveAddressCreateBulk{err: fmt.Errorf("calling to ReceiveAddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TeamAuditLogCreateBulk{err: fmt.Errorf("calling to TeamAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReceiveAddressCreate, rv.Len())
+	builders := make([]*TeamAuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReceiveAddressCreateBulk{config: c.config, builders: builders}
+	return &TeamAuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Update() *ReceiveAddressUpdate {
-	mutation := newReceiveAddressMutation(c.config, OpUpdate)
-	return &ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TeamAuditLog.
+func (c *TeamAuditLogClient) Update() *TeamAuditLogUpdate {
+	mutation := newTeamAuditLogMutation(c.config, OpUpdate)
+	return &TeamAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReceiveAddressClient) UpdateOne(ra *ReceiveAddress) *ReceiveAddressUpdateOne {
-	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddress(ra))
-	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamAuditLogClient) UpdateOne(tal *TeamAuditLog) *TeamAuditLogUpdateOne {
+	mutation := newTeamAuditLogMutation(c.config, OpUpdateOne, withTeamAuditLog(tal))
+	return &TeamAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReceiveAddressClient) UpdateOneID(id int) *ReceiveAddressUpdateOne {
-	mutation := newReceiveAddressMutation(c.config, OpUpdateOne, withReceiveAddressID(id))
-	return &ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamAuditLogClient) UpdateOneID(id uuid.UUID) *TeamAuditLogUpdateOne {
+	mutation := newTeamAuditLogMutation(c.config, OpUpdateOne, withTeamAuditLogID(id))
+	return &TeamAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Delete() *ReceiveAddressDelete {
-	mutation := newReceiveAddressMutation(c.config, OpDelete)
-	return &ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TeamAuditLog.
+func (c *TeamAuditLogClient) Delete() *TeamAuditLogDelete {
+	mutation := newTeamAuditLogMutation(c.config, OpDelete)
+	return &TeamAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReceiveAddressClient) DeleteOne(ra *ReceiveAddress) *ReceiveAddressDeleteOne {
-	return c.DeleteOneID(ra.ID)
+func (c *TeamAuditLogClient) DeleteOne(tal *TeamAuditLog) *TeamAuditLogDeleteOne {
+	return c.DeleteOneID(tal.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReceiveAddressClient) DeleteOneID(id int) *ReceiveAddressDeleteOne {
-	builder := c.Delete().Where(receiveaddress.ID(id))
+func (c *TeamAuditLogClient) DeleteOneID(id uuid.UUID) *TeamAuditLogDeleteOne {
+	builder := c.Delete().Where(teamauditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReceiveAddressDeleteOne{builder}
+	return &TeamAuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for ReceiveAddress.
-func (c *ReceiveAddressClient) Query() *ReceiveAddressQuery {
-	return &ReceiveAddressQuery{
+// Query returns a query builder for TeamAuditLog.
+func (c *TeamAuditLogClient) Query() *TeamAuditLogQuery {
+	return &TeamAuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReceiveAddress},
+		ctx:    &QueryContext{Type: TypeTeamAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ReceiveAddress entity by its id.
-func (c *ReceiveAddressClient) Get(ctx context.Context, id int) (*ReceiveAddress, error) {
-	return c.Query().Where(receiveaddress.ID(id)).Only(ctx)
+// Get returns a TeamAuditLog entity by its id.
+func (c *TeamAuditLogClient) Get(ctx context.Context, id uuid.UUID) (*TeamAuditLog, error) {
+	return c.Query().Where(teamauditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress {
+func (c *TeamAuditLogClient) GetX(ctx context.Context, id uuid.UUID) *TeamAuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2898,313 +5497,345 @@ func (c *ReceiveAddressClient) GetX(ctx context.Context, id int) *ReceiveAddress
 	return obj
 }
 
-// QueryPaymentOrder queries the payment_order edge of a ReceiveAddress.
-func (c *ReceiveAddressClient) QueryPaymentOrder(ra *ReceiveAddress) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
+// QuerySenderProfile queries the sender_profile edge of a TeamAuditLog.
+func (c *TeamAuditLogClient) QuerySenderProfile(tal *TeamAuditLog) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ra.ID
+		id := tal.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(receiveaddress.Table, receiveaddress.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, receiveaddress.PaymentOrderTable, receiveaddress.PaymentOrderColumn),
+			sqlgraph.From(teamauditlog.Table, teamauditlog.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamauditlog.SenderProfileTable, teamauditlog.SenderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(ra.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tal.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProviderProfile queries the provider_profile edge of a TeamAuditLog.
+func (c *TeamAuditLogClient) QueryProviderProfile(tal *TeamAuditLog) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tal.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamauditlog.Table, teamauditlog.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamauditlog.ProviderProfileTable, teamauditlog.ProviderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(tal.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ReceiveAddressClient) Hooks() []Hook {
-	return c.hooks.ReceiveAddress
+func (c *TeamAuditLogClient) Hooks() []Hook {
+	return c.hooks.TeamAuditLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReceiveAddressClient) Interceptors() []Interceptor {
-	return c.inters.ReceiveAddress
+func (c *TeamAuditLogClient) Interceptors() []Interceptor {
+	return c.inters.TeamAuditLog
 }
 
-func (c *ReceiveAddressClient) mutate(ctx context.Context, m *ReceiveAddressMutation) (Value, error) {
+func (c *TeamAuditLogClient) mutate(ctx context.Context, m *TeamAuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReceiveAddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReceiveAddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReceiveAddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReceiveAddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TeamAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ReceiveAddress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TeamAuditLog mutation op: %q", m.Op())
 	}
 }
 
-// SenderOrderTokenClient is a client for the SenderOrderToken schema.
-type SenderOrderTokenClient struct {
+// TeamInvitationClient is a client for the TeamInvitation schema.
+type TeamInvitationClient struct {
 	config
 }
 
-// NewSenderOrderTokenClient returns a client for the SenderOrderToken from the given config.
-func NewSenderOrderTokenClient(c config) *SenderOrderTokenClient {
-	return &SenderOrderTokenClient{config: c}
+// NewTeamInvitationClient returns a client for the TeamInvitation from the given config.
+func NewTeamInvitationClient(c config) *TeamInvitationClient {
+	return &TeamInvitationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `senderordertoken.Hooks(f(g(h())))`.
-func (c *SenderOrderTokenClient) Use(hooks ...Hook) {
-	c.hooks.SenderOrderToken = append(c.hooks.SenderOrderToken, hooks...)
+// A call to `Use(f, g, h)` equals to `teaminvitation.Hooks(f(g(h())))`.
+func (c *TeamInvitationClient) Use(hooks ...Hook) {
+	c.hooks.TeamInvitation = append(c.hooks.TeamInvitation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `senderordertoken.Intercept(f(g(h())))`.
-func (c *SenderOrderTokenClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SenderOrderToken = append(c.inters.SenderOrderToken, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `teaminvitation.Intercept(f(g(h())))`.
+func (c *TeamInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamInvitation = append(c.inters.TeamInvitation, interceptors...)
 }
 
-// Create returns a builder for creating a SenderOrderToken entity.
-func (c *SenderOrderTokenClient) Create() *SenderOrderTokenCreate {
-	mutation := newSenderOrderTokenMutation(c.config, OpCreate)
-	return &SenderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TeamInvitation entity.
+func (c *TeamInvitationClient) Create() *TeamInvitationCreate {
+	mutation := newTeamInvitationMutation(c.config, OpCreate)
+	return &TeamInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SenderOrderToken entities.
-func (c *SenderOrderTokenClient) CreateBulk(builders ...*SenderOrderTokenCreate) *SenderOrderTokenCreateBulk {
-	return &SenderOrderTokenCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TeamInvitation entities.
+func (c *TeamInvitationClient) CreateBulk(builders ...*TeamInvitationCreate) *TeamInvitationCreateBulk {
+	return &TeamInvitationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SenderOrderTokenClient) MapCreateBulk(slice any, setFunc func(*SenderOrderTokenCreate, int)) *SenderOrderTokenCreateBulk {
+func (c *TeamInvitationClient) MapCreateBulk(slice any, setFunc func(*TeamInvitationCreate, int)) *TeamInvitationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SenderOrderTokenCreateBulk{err: fmt.Errorf("calling to SenderOrderTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TeamInvitationCreateBulk{err: fmt.Errorf("calling to TeamInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SenderOrderTokenCreate, rv.Len())
+	builders := make([]*TeamInvitationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SenderOrderTokenCreateBulk{config: c.config, builders: builders}
+	return &TeamInvitationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SenderOrderToken.
-func (c *SenderOrderTokenClient) Update() *SenderOrderTokenUpdate {
-	mutation := newSenderOrderTokenMutation(c.config, OpUpdate)
-	return &SenderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TeamInvitation.
+func (c *TeamInvitationClient) Update() *TeamInvitationUpdate {
+	mutation := newTeamInvitationMutation(c.config, OpUpdate)
+	return &TeamInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SenderOrderTokenClient) UpdateOne(sot *SenderOrderToken) *SenderOrderTokenUpdateOne {
-	mutation := newSenderOrderTokenMutation(c.config, OpUpdateOne, withSenderOrderToken(sot))
-	return &SenderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamInvitationClient) UpdateOne(ti *TeamInvitation) *TeamInvitationUpdateOne {
+	mutation := newTeamInvitationMutation(c.config, OpUpdateOne, withTeamInvitation(ti))
+	return &TeamInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SenderOrderTokenClient) UpdateOneID(id int) *SenderOrderTokenUpdateOne {
-	mutation := newSenderOrderTokenMutation(c.config, OpUpdateOne, withSenderOrderTokenID(id))
-	return &SenderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamInvitationClient) UpdateOneID(id uuid.UUID) *TeamInvitationUpdateOne {
+	mutation := newTeamInvitationMutation(c.config, OpUpdateOne, withTeamInvitationID(id))
+	return &TeamInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SenderOrderToken.
-func (c *SenderOrderTokenClient) Delete() *SenderOrderTokenDelete {
-	mutation := newSenderOrderTokenMutation(c.config, OpDelete)
-	return &SenderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TeamInvitation.
+func (c *TeamInvitationClient) Delete() *TeamInvitationDelete {
+	mutation := newTeamInvitationMutation(c.config, OpDelete)
+	return &TeamInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SenderOrderTokenClient) DeleteOne(sot *SenderOrderToken) *SenderOrderTokenDeleteOne {
-	return c.DeleteOneID(sot.ID)
+func (c *TeamInvitationClient) DeleteOne(ti *TeamInvitation) *TeamInvitationDeleteOne {
+	return c.DeleteOneID(ti.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SenderOrderTokenClient) DeleteOneID(id int) *SenderOrderTokenDeleteOne {
-	builder := c.Delete().Where(senderordertoken.ID(id))
+func (c *TeamInvitationClient) DeleteOneID(id uuid.UUID) *TeamInvitationDeleteOne {
+	builder := c.Delete().Where(teaminvitation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SenderOrderTokenDeleteOne{builder}
+	return &TeamInvitationDeleteOne{builder}
 }
 
-// Query returns a query builder for SenderOrderToken.
-func (c *SenderOrderTokenClient) Query() *SenderOrderTokenQuery {
-	return &SenderOrderTokenQuery{
+// Query returns a query builder for TeamInvitation.
+func (c *TeamInvitationClient) Query() *TeamInvitationQuery {
+	return &TeamInvitationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSenderOrderToken},
+		ctx:    &QueryContext{Type: TypeTeamInvitation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SenderOrderToken entity by its id.
-func (c *SenderOrderTokenClient) Get(ctx context.Context, id int) (*SenderOrderToken, error) {
-	return c.Query().Where(senderordertoken.ID(id)).Only(ctx)
+// Get returns a TeamInvitation entity by its id.
+func (c *TeamInvitationClient) Get(ctx context.Context, id uuid.UUID) (*TeamInvitation, error) {
+	return c.Query().Where(teaminvitation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SenderOrderTokenClient) GetX(ctx context.Context, id int) *SenderOrderToken {
+func (c *TeamInvitationClient) GetX(ctx context.Context, id uuid.UUID) *TeamInvitation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
 	}
-	return obj
+	return obj
+}
+
+// QuerySenderProfile queries the sender_profile edge of a TeamInvitation.
+func (c *TeamInvitationClient) QuerySenderProfile(ti *TeamInvitation) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ti.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teaminvitation.Table, teaminvitation.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teaminvitation.SenderProfileTable, teaminvitation.SenderProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// QuerySender queries the sender edge of a SenderOrderToken.
-func (c *SenderOrderTokenClient) QuerySender(sot *SenderOrderToken) *SenderProfileQuery {
-	query := (&SenderProfileClient{config: c.config}).Query()
+// QueryProviderProfile queries the provider_profile edge of a TeamInvitation.
+func (c *TeamInvitationClient) QueryProviderProfile(ti *TeamInvitation) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sot.ID
+		id := ti.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(senderordertoken.Table, senderordertoken.FieldID, id),
-			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, senderordertoken.SenderTable, senderordertoken.SenderColumn),
+			sqlgraph.From(teaminvitation.Table, teaminvitation.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teaminvitation.ProviderProfileTable, teaminvitation.ProviderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(sot.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryToken queries the token edge of a SenderOrderToken.
-func (c *SenderOrderTokenClient) QueryToken(sot *SenderOrderToken) *TokenQuery {
-	query := (&TokenClient{config: c.config}).Query()
+// QueryInvitedBy queries the invited_by edge of a TeamInvitation.
+func (c *TeamInvitationClient) QueryInvitedBy(ti *TeamInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sot.ID
+		id := ti.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(senderordertoken.Table, senderordertoken.FieldID, id),
-			sqlgraph.To(token.Table, token.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, senderordertoken.TokenTable, senderordertoken.TokenColumn),
+			sqlgraph.From(teaminvitation.Table, teaminvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teaminvitation.InvitedByTable, teaminvitation.InvitedByColumn),
 		)
-		fromV = sqlgraph.Neighbors(sot.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ti.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SenderOrderTokenClient) Hooks() []Hook {
-	return c.hooks.SenderOrderToken
+func (c *TeamInvitationClient) Hooks() []Hook {
+	return c.hooks.TeamInvitation
 }
 
 // Interceptors returns the client interceptors.
-func (c *SenderOrderTokenClient) Interceptors() []Interceptor {
-	return c.inters.SenderOrderToken
+func (c *TeamInvitationClient) Interceptors() []Interceptor {
+	return c.inters.TeamInvitation
 }
 
-func (c *SenderOrderTokenClient) mutate(ctx context.Context, m *SenderOrderTokenMutation) (Value, error) {
+func (c *TeamInvitationClient) mutate(ctx context.Context, m *TeamInvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SenderOrderTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SenderOrderTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SenderOrderTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SenderOrderTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TeamInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SenderOrderToken mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TeamInvitation mutation op: %q", m.Op())
 	}
 }
 
-// SenderProfileClient is a client for the SenderProfile schema.
-type SenderProfileClient struct {
+// TeamMemberClient is a client for the TeamMember schema.
+type TeamMemberClient struct {
 	config
 }
 
-// NewSenderProfileClient returns a client for the SenderProfile from the given config.
-func NewSenderProfileClient(c config) *SenderProfileClient {
-	return &SenderProfileClient{config: c}
+// NewTeamMemberClient returns a client for the TeamMember from the given config.
+func NewTeamMemberClient(c config) *TeamMemberClient {
+	return &TeamMemberClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `senderprofile.Hooks(f(g(h())))`.
-func (c *SenderProfileClient) Use(hooks ...Hook) {
-	c.hooks.SenderProfile = append(c.hooks.SenderProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `teammember.Hooks(f(g(h())))`.
+func (c *TeamMemberClient) Use(hooks ...Hook) {
+	c.hooks.TeamMember = append(c.hooks.TeamMember, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `senderprofile.Intercept(f(g(h())))`.
-func (c *SenderProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SenderProfile = append(c.inters.SenderProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `teammember.Intercept(f(g(h())))`.
+func (c *TeamMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TeamMember = append(c.inters.TeamMember, interceptors...)
 }
 
-// Create returns a builder for creating a SenderProfile entity.
-func (c *SenderProfileClient) Create() *SenderProfileCreate {
-	mutation := newSenderProfileMutation(c.config, OpCreate)
-	return &SenderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TeamMember entity.
+func (c *TeamMemberClient) Create() *TeamMemberCreate {
+	mutation := newTeamMemberMutation(c.config, OpCreate)
+	return &TeamMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SenderProfile entities.
-func (c *SenderProfileClient) CreateBulk(builders ...*SenderProfileCreate) *SenderProfileCreateBulk {
-	return &SenderProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of TeamMember entities.
+func (c *TeamMemberClient) CreateBulk(builders ...*TeamMemberCreate) *TeamMemberCreateBulk {
+	return &TeamMemberCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SenderProfileClient) MapCreateBulk(slice any, setFunc func(*SenderProfileCreate, int)) *SenderProfileCreateBulk {
+func (c *TeamMemberClient) MapCreateBulk(slice any, setFunc func(*TeamMemberCreate, int)) *TeamMemberCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SenderProfileCreateBulk{err: fmt.Errorf("calling to SenderProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TeamMemberCreateBulk{err: fmt.Errorf("calling to TeamMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SenderProfileCreate, rv.Len())
+	builders := make([]*TeamMemberCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SenderProfileCreateBulk{config: c.config, builders: builders}
+	return &TeamMemberCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SenderProfile.
-func (c *SenderProfileClient) Update() *SenderProfileUpdate {
-	mutation := newSenderProfileMutation(c.config, OpUpdate)
-	return &SenderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for TeamMember.
+func (c *TeamMemberClient) Update() *TeamMemberUpdate {
+	mutation := newTeamMemberMutation(c.config, OpUpdate)
+	return &TeamMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SenderProfileClient) UpdateOne(sp *SenderProfile) *SenderProfileUpdateOne {
-	mutation := newSenderProfileMutation(c.config, OpUpdateOne, withSenderProfile(sp))
-	return &SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamMemberClient) UpdateOne(tm *TeamMember) *TeamMemberUpdateOne {
+	mutation := newTeamMemberMutation(c.config, OpUpdateOne, withTeamMember(tm))
+	return &TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SenderProfileClient) UpdateOneID(id uuid.UUID) *SenderProfileUpdateOne {
-	mutation := newSenderProfileMutation(c.config, OpUpdateOne, withSenderProfileID(id))
-	return &SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TeamMemberClient) UpdateOneID(id uuid.UUID) *TeamMemberUpdateOne {
+	mutation := newTeamMemberMutation(c.config, OpUpdateOne, withTeamMemberID(id))
+	return &TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SenderProfile.
-func (c *SenderProfileClient) Delete() *SenderProfileDelete {
-	mutation := newSenderProfileMutation(c.config, OpDelete)
-	return &SenderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for TeamMember.
+func (c *TeamMemberClient) Delete() *TeamMemberDelete {
+	mutation := newTeamMemberMutation(c.config, OpDelete)
+	return &TeamMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SenderProfileClient) DeleteOne(sp *SenderProfile) *SenderProfileDeleteOne {
-	return c.DeleteOneID(sp.ID)
+func (c *TeamMemberClient) DeleteOne(tm *TeamMember) *TeamMemberDeleteOne {
+	return c.DeleteOneID(tm.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SenderProfileClient) DeleteOneID(id uuid.UUID) *SenderProfileDeleteOne {
-	builder := c.Delete().Where(senderprofile.ID(id))
+func (c *TeamMemberClient) DeleteOneID(id uuid.UUID) *TeamMemberDeleteOne {
+	builder := c.Delete().Where(teammember.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SenderProfileDeleteOne{builder}
+	return &TeamMemberDeleteOne{builder}
 }
 
-// Query returns a query builder for SenderProfile.
-func (c *SenderProfileClient) Query() *SenderProfileQuery {
-	return &SenderProfileQuery{
+// Query returns a query builder for TeamMember.
+func (c *TeamMemberClient) Query() *TeamMemberQuery {
+	return &TeamMemberQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSenderProfile},
+		ctx:    &QueryContext{Type: TypeTeamMember},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SenderProfile entity by its id.
-func (c *SenderProfileClient) Get(ctx context.Context, id uuid.UUID) (*SenderProfile, error) {
-	return c.Query().Where(senderprofile.ID(id)).Only(ctx)
+// Get returns a TeamMember entity by its id.
+func (c *TeamMemberClient) Get(ctx context.Context, id uuid.UUID) (*TeamMember, error) {
+	return c.Query().Where(teammember.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SenderProfileClient) GetX(ctx context.Context, id uuid.UUID) *SenderProfile {
+func (c *TeamMemberClient) GetX(ctx context.Context, id uuid.UUID) *TeamMember {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3212,108 +5843,76 @@ func (c *SenderProfileClient) GetX(ctx context.Context, id uuid.UUID) *SenderPro
 	return obj
 }
 
-// QueryUser queries the user edge of a SenderProfile.
-func (c *SenderProfileClient) QueryUser(sp *SenderProfile) *UserQuery {
+// QueryUser queries the user edge of a TeamMember.
+func (c *TeamMemberClient) QueryUser(tm *TeamMember) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
+		id := tm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, senderprofile.UserTable, senderprofile.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAPIKey queries the api_key edge of a SenderProfile.
-func (c *SenderProfileClient) QueryAPIKey(sp *SenderProfile) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, senderprofile.APIKeyTable, senderprofile.APIKeyColumn),
-		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPaymentOrders queries the payment_orders edge of a SenderProfile.
-func (c *SenderProfileClient) QueryPaymentOrders(sp *SenderProfile) *PaymentOrderQuery {
-	query := (&PaymentOrderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(paymentorder.Table, paymentorder.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.PaymentOrdersTable, senderprofile.PaymentOrdersColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.UserTable, teammember.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryOrderTokens queries the order_tokens edge of a SenderProfile.
-func (c *SenderProfileClient) QueryOrderTokens(sp *SenderProfile) *SenderOrderTokenQuery {
-	query := (&SenderOrderTokenClient{config: c.config}).Query()
+// QuerySenderProfile queries the sender_profile edge of a TeamMember.
+func (c *TeamMemberClient) QuerySenderProfile(tm *TeamMember) *SenderProfileQuery {
+	query := (&SenderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
+		id := tm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(senderordertoken.Table, senderordertoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.OrderTokensTable, senderprofile.OrderTokensColumn),
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.SenderProfileTable, teammember.SenderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLinkedAddress queries the linked_address edge of a SenderProfile.
-func (c *SenderProfileClient) QueryLinkedAddress(sp *SenderProfile) *LinkedAddressQuery {
-	query := (&LinkedAddressClient{config: c.config}).Query()
+// QueryProviderProfile queries the provider_profile edge of a TeamMember.
+func (c *TeamMemberClient) QueryProviderProfile(tm *TeamMember) *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sp.ID
+		id := tm.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, id),
-			sqlgraph.To(linkedaddress.Table, linkedaddress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.LinkedAddressTable, senderprofile.LinkedAddressColumn),
+			sqlgraph.From(teammember.Table, teammember.FieldID, id),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teammember.ProviderProfileTable, teammember.ProviderProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *SenderProfileClient) Hooks() []Hook {
-	return c.hooks.SenderProfile
+func (c *TeamMemberClient) Hooks() []Hook {
+	return c.hooks.TeamMember
 }
 
 // Interceptors returns the client interceptors.
-func (c *SenderProfileClient) Interceptors() []Interceptor {
-	return c.inters.SenderProfile
+func (c *TeamMemberClient) Interceptors() []Interceptor {
+	return c.inters.TeamMember
 }
 
-func (c *SenderProfileClient) mutate(ctx context.Context, m *SenderProfileMutation) (Value, error) {
+func (c *TeamMemberClient) mutate(ctx context.Context, m *TeamMemberMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SenderProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SenderProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SenderProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TeamMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SenderProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TeamMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SenderProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown TeamMember mutation op: %q", m.Op())
 	}
 }
 
@@ -3514,6 +6113,155 @@ func (c *TokenClient) mutate(ctx context.Context, m *TokenMutation) (Value, erro
 	}
 }
 
+// TokenMarketRateClient is a client for the TokenMarketRate schema.
+type TokenMarketRateClient struct {
+	config
+}
+
+// NewTokenMarketRateClient returns a client for the TokenMarketRate from the given config.
+func NewTokenMarketRateClient(c config) *TokenMarketRateClient {
+	return &TokenMarketRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tokenmarketrate.Hooks(f(g(h())))`.
+func (c *TokenMarketRateClient) Use(hooks ...Hook) {
+	c.hooks.TokenMarketRate = append(c.hooks.TokenMarketRate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tokenmarketrate.Intercept(f(g(h())))`.
+func (c *TokenMarketRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenMarketRate = append(c.inters.TokenMarketRate, interceptors...)
+}
+
+// Create returns a builder for creating a TokenMarketRate entity.
+func (c *TokenMarketRateClient) Create() *TokenMarketRateCreate {
+	mutation := newTokenMarketRateMutation(c.config, OpCreate)
+	return &TokenMarketRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TokenMarketRate entities.
+func (c *TokenMarketRateClient) CreateBulk(builders ...*TokenMarketRateCreate) *TokenMarketRateCreateBulk {
+	return &TokenMarketRateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenMarketRateClient) MapCreateBulk(slice any, setFunc func(*TokenMarketRateCreate, int)) *TokenMarketRateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenMarketRateCreateBulk{err: fmt.Errorf("calling to TokenMarketRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenMarketRateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenMarketRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenMarketRate.
+func (c *TokenMarketRateClient) Update() *TokenMarketRateUpdate {
+	mutation := newTokenMarketRateMutation(c.config, OpUpdate)
+	return &TokenMarketRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenMarketRateClient) UpdateOne(tmr *TokenMarketRate) *TokenMarketRateUpdateOne {
+	mutation := newTokenMarketRateMutation(c.config, OpUpdateOne, withTokenMarketRate(tmr))
+	return &TokenMarketRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenMarketRateClient) UpdateOneID(id uuid.UUID) *TokenMarketRateUpdateOne {
+	mutation := newTokenMarketRateMutation(c.config, OpUpdateOne, withTokenMarketRateID(id))
+	return &TokenMarketRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenMarketRate.
+func (c *TokenMarketRateClient) Delete() *TokenMarketRateDelete {
+	mutation := newTokenMarketRateMutation(c.config, OpDelete)
+	return &TokenMarketRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenMarketRateClient) DeleteOne(tmr *TokenMarketRate) *TokenMarketRateDeleteOne {
+	return c.DeleteOneID(tmr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenMarketRateClient) DeleteOneID(id uuid.UUID) *TokenMarketRateDeleteOne {
+	builder := c.Delete().Where(tokenmarketrate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenMarketRateDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenMarketRate.
+func (c *TokenMarketRateClient) Query() *TokenMarketRateQuery {
+	return &TokenMarketRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenMarketRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenMarketRate entity by its id.
+func (c *TokenMarketRateClient) Get(ctx context.Context, id uuid.UUID) (*TokenMarketRate, error) {
+	return c.Query().Where(tokenmarketrate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenMarketRateClient) GetX(ctx context.Context, id uuid.UUID) *TokenMarketRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCurrency queries the currency edge of a TokenMarketRate.
+func (c *TokenMarketRateClient) QueryCurrency(tmr *TokenMarketRate) *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tmr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokenmarketrate.Table, tokenmarketrate.FieldID, id),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tokenmarketrate.CurrencyTable, tokenmarketrate.CurrencyColumn),
+		)
+		fromV = sqlgraph.Neighbors(tmr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TokenMarketRateClient) Hooks() []Hook {
+	return c.hooks.TokenMarketRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenMarketRateClient) Interceptors() []Interceptor {
+	return c.inters.TokenMarketRate
+}
+
+func (c *TokenMarketRateClient) mutate(ctx context.Context, m *TokenMarketRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenMarketRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenMarketRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenMarketRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenMarketRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenMarketRate mutation op: %q", m.Op())
+	}
+}
+
 // TransactionLogClient is a client for the TransactionLog schema.
 type TransactionLogClient struct {
 	config
@@ -3803,6 +6551,38 @@ func (c *UserClient) QueryVerificationToken(u *User) *VerificationTokenQuery {
 	return query
 }
 
+// QueryTeamMemberships queries the team_memberships edge of a User.
+func (c *UserClient) QueryTeamMemberships(u *User) *TeamMemberQuery {
+	query := (&TeamMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TeamMembershipsTable, user.TeamMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentTeamInvitations queries the sent_team_invitations edge of a User.
+func (c *UserClient) QuerySentTeamInvitations(u *User) *TeamInvitationQuery {
+	query := (&TeamInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(teaminvitation.Table, teaminvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentTeamInvitationsTable, user.SentTeamInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	hooks := c.hooks.User
@@ -4115,17 +6895,25 @@ func (c *WebhookRetryAttemptClient) mutate(ctx context.Context, m *WebhookRetryA
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, FiatCurrency, IdentityVerificationRequest, Institution, LinkedAddress,
-		LockOrderFulfillment, LockPaymentOrder, Network, PaymentOrder,
-		PaymentOrderRecipient, ProviderOrderToken, ProviderProfile, ProviderRating,
-		ProvisionBucket, ReceiveAddress, SenderOrderToken, SenderProfile, Token,
-		TransactionLog, User, VerificationToken, WebhookRetryAttempt []ent.Hook
+		APIKey, BucketProposal, DeadLetterOrder, Dispute, DisputeEvidence, FiatCurrency,
+		IdentityVerificationRequest, Institution, LinkedAddress, LockOrderFulfillment,
+		LockPaymentOrder, Network, OrderAssignment, PaymentOrder,
+		PaymentOrderRecipient, ProviderHealthCheck, ProviderOrderToken,
+		ProviderProfile, ProviderRating, ProviderSLARecord, ProvisionBucket,
+		PublicHoliday, RateCircuitBreaker, RateSnapshot, ReceiveAddress,
+		SenderOrderToken, SenderProfile, TeamAuditLog, TeamInvitation, TeamMember,
+		Token, TokenMarketRate, TransactionLog, User, VerificationToken,
+		WebhookRetryAttempt []ent.Hook
 	}
 	inters struct {
-		APIKey, FiatCurrency, IdentityVerificationRequest, Institution, LinkedAddress,
-		LockOrderFulfillment, LockPaymentOrder, Network, PaymentOrder,
-		PaymentOrderRecipient, ProviderOrderToken, ProviderProfile, ProviderRating,
-		ProvisionBucket, ReceiveAddress, SenderOrderToken, SenderProfile, Token,
-		TransactionLog, User, VerificationToken, WebhookRetryAttempt []ent.Interceptor
+		APIKey, BucketProposal, DeadLetterOrder, Dispute, DisputeEvidence, FiatCurrency,
+		IdentityVerificationRequest, Institution, LinkedAddress, LockOrderFulfillment,
+		LockPaymentOrder, Network, OrderAssignment, PaymentOrder,
+		PaymentOrderRecipient, ProviderHealthCheck, ProviderOrderToken,
+		ProviderProfile, ProviderRating, ProviderSLARecord, ProvisionBucket,
+		PublicHoliday, RateCircuitBreaker, RateSnapshot, ReceiveAddress,
+		SenderOrderToken, SenderProfile, TeamAuditLog, TeamInvitation, TeamMember,
+		Token, TokenMarketRate, TransactionLog, User, VerificationToken,
+		WebhookRetryAttempt []ent.Interceptor
 	}
 )
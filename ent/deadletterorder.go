@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+)
+
+// DeadLetterOrder is the model entity for the DeadLetterOrder schema.
+type DeadLetterOrder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason deadletterorder.Reason `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status deadletterorder.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextRetryAt holds the value of the "next_retry_at" field.
+	NextRetryAt time.Time `json:"next_retry_at,omitempty"`
+	// RefundDeadline holds the value of the "refund_deadline" field.
+	RefundDeadline time.Time `json:"refund_deadline,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeadLetterOrderQuery when eager-loading is set.
+	Edges                          DeadLetterOrderEdges `json:"edges"`
+	lock_payment_order_dead_letter *uuid.UUID
+	selectValues                   sql.SelectValues
+}
+
+// DeadLetterOrderEdges holds the relations/edges for other nodes in the graph.
+type DeadLetterOrderEdges struct {
+	// LockPaymentOrder holds the value of the lock_payment_order edge.
+	LockPaymentOrder *LockPaymentOrder `json:"lock_payment_order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LockPaymentOrderOrErr returns the LockPaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeadLetterOrderEdges) LockPaymentOrderOrErr() (*LockPaymentOrder, error) {
+	if e.LockPaymentOrder != nil {
+		return e.LockPaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "lock_payment_order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeadLetterOrder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deadletterorder.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case deadletterorder.FieldReason, deadletterorder.FieldStatus:
+			values[i] = new(sql.NullString)
+		case deadletterorder.FieldCreatedAt, deadletterorder.FieldUpdatedAt, deadletterorder.FieldNextRetryAt, deadletterorder.FieldRefundDeadline, deadletterorder.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case deadletterorder.FieldID:
+			values[i] = new(uuid.UUID)
+		case deadletterorder.ForeignKeys[0]: // lock_payment_order_dead_letter
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeadLetterOrder fields.
+func (dlo *DeadLetterOrder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deadletterorder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dlo.ID = *value
+			}
+		case deadletterorder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dlo.CreatedAt = value.Time
+			}
+		case deadletterorder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dlo.UpdatedAt = value.Time
+			}
+		case deadletterorder.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				dlo.Reason = deadletterorder.Reason(value.String)
+			}
+		case deadletterorder.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dlo.Status = deadletterorder.Status(value.String)
+			}
+		case deadletterorder.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				dlo.Attempts = int(value.Int64)
+			}
+		case deadletterorder.FieldNextRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_retry_at", values[i])
+			} else if value.Valid {
+				dlo.NextRetryAt = value.Time
+			}
+		case deadletterorder.FieldRefundDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_deadline", values[i])
+			} else if value.Valid {
+				dlo.RefundDeadline = value.Time
+			}
+		case deadletterorder.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				dlo.ResolvedAt = value.Time
+			}
+		case deadletterorder.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_dead_letter", values[i])
+			} else if value.Valid {
+				dlo.lock_payment_order_dead_letter = new(uuid.UUID)
+				*dlo.lock_payment_order_dead_letter = *value.S.(*uuid.UUID)
+			}
+		default:
+			dlo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeadLetterOrder.
+// This includes values selected through modifiers, order, etc.
+func (dlo *DeadLetterOrder) Value(name string) (ent.Value, error) {
+	return dlo.selectValues.Get(name)
+}
+
+// QueryLockPaymentOrder queries the "lock_payment_order" edge of the DeadLetterOrder entity.
+func (dlo *DeadLetterOrder) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	return NewDeadLetterOrderClient(dlo.config).QueryLockPaymentOrder(dlo)
+}
+
+// Update returns a builder for updating this DeadLetterOrder.
+// Note that you need to call DeadLetterOrder.Unwrap() before calling this method if this DeadLetterOrder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dlo *DeadLetterOrder) Update() *DeadLetterOrderUpdateOne {
+	return NewDeadLetterOrderClient(dlo.config).UpdateOne(dlo)
+}
+
+// Unwrap unwraps the DeadLetterOrder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dlo *DeadLetterOrder) Unwrap() *DeadLetterOrder {
+	_tx, ok := dlo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeadLetterOrder is not a transactional entity")
+	}
+	dlo.config.driver = _tx.drv
+	return dlo
+}
+
+// String implements the fmt.Stringer.
+func (dlo *DeadLetterOrder) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeadLetterOrder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dlo.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(dlo.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dlo.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", dlo.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", dlo.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", dlo.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_retry_at=")
+	builder.WriteString(dlo.NextRetryAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("refund_deadline=")
+	builder.WriteString(dlo.RefundDeadline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(dlo.ResolvedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeadLetterOrders is a parsable slice of DeadLetterOrder.
+type DeadLetterOrders []*DeadLetterOrder
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletterorder
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the deadletterorder type in the database.
+	Label = "dead_letter_order"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextRetryAt holds the string denoting the next_retry_at field in the database.
+	FieldNextRetryAt = "next_retry_at"
+	// FieldRefundDeadline holds the string denoting the refund_deadline field in the database.
+	FieldRefundDeadline = "refund_deadline"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// EdgeLockPaymentOrder holds the string denoting the lock_payment_order edge name in mutations.
+	EdgeLockPaymentOrder = "lock_payment_order"
+	// Table holds the table name of the deadletterorder in the database.
+	Table = "dead_letter_orders"
+	// LockPaymentOrderTable is the table that holds the lock_payment_order relation/edge.
+	LockPaymentOrderTable = "dead_letter_orders"
+	// LockPaymentOrderInverseTable is the table name for the LockPaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "lockpaymentorder" package.
+	LockPaymentOrderInverseTable = "lock_payment_orders"
+	// LockPaymentOrderColumn is the table column denoting the lock_payment_order relation/edge.
+	LockPaymentOrderColumn = "lock_payment_order_dead_letter"
+)
+
+// Columns holds all SQL columns for deadletterorder fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldReason,
+	FieldStatus,
+	FieldAttempts,
+	FieldNextRetryAt,
+	FieldRefundDeadline,
+	FieldResolvedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "dead_letter_orders"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"lock_payment_order_dead_letter",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Reason defines the type for the "reason" enum field.
+type Reason string
+
+// Reason values.
+const (
+	ReasonNoProviderForToken Reason = "no_provider_for_token"
+	ReasonRateOutOfRange     Reason = "rate_out_of_range"
+	ReasonAllExcluded        Reason = "all_excluded"
+	ReasonNoEligibleProvider Reason = "no_eligible_provider"
+	ReasonMatchingPaused     Reason = "matching_paused"
+)
+
+func (r Reason) String() string {
+	return string(r)
+}
+
+// ReasonValidator is a validator for the "reason" field enum values. It is called by the builders before save.
+func ReasonValidator(r Reason) error {
+	switch r {
+	case ReasonNoProviderForToken, ReasonRateOutOfRange, ReasonAllExcluded, ReasonNoEligibleProvider, ReasonMatchingPaused:
+		return nil
+	default:
+		return fmt.Errorf("deadletterorder: invalid enum value for reason field: %q", r)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusResolved Status = "resolved"
+	StatusRefunded Status = "refunded"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusResolved, StatusRefunded:
+		return nil
+	default:
+		return fmt.Errorf("deadletterorder: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DeadLetterOrder queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByNextRetryAt orders the results by the next_retry_at field.
+func ByNextRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRetryAt, opts...).ToFunc()
+}
+
+// ByRefundDeadline orders the results by the refund_deadline field.
+func ByRefundDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundDeadline, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByLockPaymentOrderField orders the results by lock_payment_order field.
+func ByLockPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLockPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLockPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LockPaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+	)
+}
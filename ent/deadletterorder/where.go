@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletterorder
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextRetryAt applies equality check predicate on the "next_retry_at" field. It's identical to NextRetryAtEQ.
+func NextRetryAt(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldNextRetryAt, v))
+}
+
+// RefundDeadline applies equality check predicate on the "refund_deadline" field. It's identical to RefundDeadlineEQ.
+func RefundDeadline(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldRefundDeadline, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v Reason) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v Reason) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...Reason) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...Reason) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextRetryAtEQ applies the EQ predicate on the "next_retry_at" field.
+func NextRetryAtEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldNextRetryAt, v))
+}
+
+// NextRetryAtNEQ applies the NEQ predicate on the "next_retry_at" field.
+func NextRetryAtNEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldNextRetryAt, v))
+}
+
+// NextRetryAtIn applies the In predicate on the "next_retry_at" field.
+func NextRetryAtIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldNextRetryAt, vs...))
+}
+
+// NextRetryAtNotIn applies the NotIn predicate on the "next_retry_at" field.
+func NextRetryAtNotIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldNextRetryAt, vs...))
+}
+
+// NextRetryAtGT applies the GT predicate on the "next_retry_at" field.
+func NextRetryAtGT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldNextRetryAt, v))
+}
+
+// NextRetryAtGTE applies the GTE predicate on the "next_retry_at" field.
+func NextRetryAtGTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldNextRetryAt, v))
+}
+
+// NextRetryAtLT applies the LT predicate on the "next_retry_at" field.
+func NextRetryAtLT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldNextRetryAt, v))
+}
+
+// NextRetryAtLTE applies the LTE predicate on the "next_retry_at" field.
+func NextRetryAtLTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldNextRetryAt, v))
+}
+
+// RefundDeadlineEQ applies the EQ predicate on the "refund_deadline" field.
+func RefundDeadlineEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldRefundDeadline, v))
+}
+
+// RefundDeadlineNEQ applies the NEQ predicate on the "refund_deadline" field.
+func RefundDeadlineNEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldRefundDeadline, v))
+}
+
+// RefundDeadlineIn applies the In predicate on the "refund_deadline" field.
+func RefundDeadlineIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldRefundDeadline, vs...))
+}
+
+// RefundDeadlineNotIn applies the NotIn predicate on the "refund_deadline" field.
+func RefundDeadlineNotIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldRefundDeadline, vs...))
+}
+
+// RefundDeadlineGT applies the GT predicate on the "refund_deadline" field.
+func RefundDeadlineGT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldRefundDeadline, v))
+}
+
+// RefundDeadlineGTE applies the GTE predicate on the "refund_deadline" field.
+func RefundDeadlineGTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldRefundDeadline, v))
+}
+
+// RefundDeadlineLT applies the LT predicate on the "refund_deadline" field.
+func RefundDeadlineLT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldRefundDeadline, v))
+}
+
+// RefundDeadlineLTE applies the LTE predicate on the "refund_deadline" field.
+func RefundDeadlineLTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldRefundDeadline, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasLockPaymentOrder applies the HasEdge predicate on the "lock_payment_order" edge.
+func HasLockPaymentOrder() predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLockPaymentOrderWith applies the HasEdge predicate on the "lock_payment_order" edge with a given conditions (other predicates).
+func HasLockPaymentOrderWith(preds ...predicate.LockPaymentOrder) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(func(s *sql.Selector) {
+		step := newLockPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeadLetterOrder) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeadLetterOrder) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeadLetterOrder) predicate.DeadLetterOrder {
+	return predicate.DeadLetterOrder(sql.NotPredicates(p))
+}
@@ -0,0 +1,947 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+)
+
+// DeadLetterOrderCreate is the builder for creating a DeadLetterOrder entity.
+type DeadLetterOrderCreate struct {
+	config
+	mutation *DeadLetterOrderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dloc *DeadLetterOrderCreate) SetCreatedAt(t time.Time) *DeadLetterOrderCreate {
+	dloc.mutation.SetCreatedAt(t)
+	return dloc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dloc *DeadLetterOrderCreate) SetNillableCreatedAt(t *time.Time) *DeadLetterOrderCreate {
+	if t != nil {
+		dloc.SetCreatedAt(*t)
+	}
+	return dloc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dloc *DeadLetterOrderCreate) SetUpdatedAt(t time.Time) *DeadLetterOrderCreate {
+	dloc.mutation.SetUpdatedAt(t)
+	return dloc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dloc *DeadLetterOrderCreate) SetNillableUpdatedAt(t *time.Time) *DeadLetterOrderCreate {
+	if t != nil {
+		dloc.SetUpdatedAt(*t)
+	}
+	return dloc
+}
+
+// SetReason sets the "reason" field.
+func (dloc *DeadLetterOrderCreate) SetReason(d deadletterorder.Reason) *DeadLetterOrderCreate {
+	dloc.mutation.SetReason(d)
+	return dloc
+}
+
+// SetStatus sets the "status" field.
+func (dloc *DeadLetterOrderCreate) SetStatus(d deadletterorder.Status) *DeadLetterOrderCreate {
+	dloc.mutation.SetStatus(d)
+	return dloc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dloc *DeadLetterOrderCreate) SetNillableStatus(d *deadletterorder.Status) *DeadLetterOrderCreate {
+	if d != nil {
+		dloc.SetStatus(*d)
+	}
+	return dloc
+}
+
+// SetAttempts sets the "attempts" field.
+func (dloc *DeadLetterOrderCreate) SetAttempts(i int) *DeadLetterOrderCreate {
+	dloc.mutation.SetAttempts(i)
+	return dloc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dloc *DeadLetterOrderCreate) SetNillableAttempts(i *int) *DeadLetterOrderCreate {
+	if i != nil {
+		dloc.SetAttempts(*i)
+	}
+	return dloc
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (dloc *DeadLetterOrderCreate) SetNextRetryAt(t time.Time) *DeadLetterOrderCreate {
+	dloc.mutation.SetNextRetryAt(t)
+	return dloc
+}
+
+// SetRefundDeadline sets the "refund_deadline" field.
+func (dloc *DeadLetterOrderCreate) SetRefundDeadline(t time.Time) *DeadLetterOrderCreate {
+	dloc.mutation.SetRefundDeadline(t)
+	return dloc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dloc *DeadLetterOrderCreate) SetResolvedAt(t time.Time) *DeadLetterOrderCreate {
+	dloc.mutation.SetResolvedAt(t)
+	return dloc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dloc *DeadLetterOrderCreate) SetNillableResolvedAt(t *time.Time) *DeadLetterOrderCreate {
+	if t != nil {
+		dloc.SetResolvedAt(*t)
+	}
+	return dloc
+}
+
+// SetID sets the "id" field.
+func (dloc *DeadLetterOrderCreate) SetID(u uuid.UUID) *DeadLetterOrderCreate {
+	dloc.mutation.SetID(u)
+	return dloc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dloc *DeadLetterOrderCreate) SetNillableID(u *uuid.UUID) *DeadLetterOrderCreate {
+	if u != nil {
+		dloc.SetID(*u)
+	}
+	return dloc
+}
+
+// SetLockPaymentOrderID sets the "lock_payment_order" edge to the LockPaymentOrder entity by ID.
+func (dloc *DeadLetterOrderCreate) SetLockPaymentOrderID(id uuid.UUID) *DeadLetterOrderCreate {
+	dloc.mutation.SetLockPaymentOrderID(id)
+	return dloc
+}
+
+// SetLockPaymentOrder sets the "lock_payment_order" edge to the LockPaymentOrder entity.
+func (dloc *DeadLetterOrderCreate) SetLockPaymentOrder(l *LockPaymentOrder) *DeadLetterOrderCreate {
+	return dloc.SetLockPaymentOrderID(l.ID)
+}
+
+// Mutation returns the DeadLetterOrderMutation object of the builder.
+func (dloc *DeadLetterOrderCreate) Mutation() *DeadLetterOrderMutation {
+	return dloc.mutation
+}
+
+// Save creates the DeadLetterOrder in the database.
+func (dloc *DeadLetterOrderCreate) Save(ctx context.Context) (*DeadLetterOrder, error) {
+	dloc.defaults()
+	return withHooks(ctx, dloc.sqlSave, dloc.mutation, dloc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dloc *DeadLetterOrderCreate) SaveX(ctx context.Context) *DeadLetterOrder {
+	v, err := dloc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dloc *DeadLetterOrderCreate) Exec(ctx context.Context) error {
+	_, err := dloc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dloc *DeadLetterOrderCreate) ExecX(ctx context.Context) {
+	if err := dloc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dloc *DeadLetterOrderCreate) defaults() {
+	if _, ok := dloc.mutation.CreatedAt(); !ok {
+		v := deadletterorder.DefaultCreatedAt()
+		dloc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dloc.mutation.UpdatedAt(); !ok {
+		v := deadletterorder.DefaultUpdatedAt()
+		dloc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dloc.mutation.Status(); !ok {
+		v := deadletterorder.DefaultStatus
+		dloc.mutation.SetStatus(v)
+	}
+	if _, ok := dloc.mutation.Attempts(); !ok {
+		v := deadletterorder.DefaultAttempts
+		dloc.mutation.SetAttempts(v)
+	}
+	if _, ok := dloc.mutation.ID(); !ok {
+		v := deadletterorder.DefaultID()
+		dloc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dloc *DeadLetterOrderCreate) check() error {
+	if _, ok := dloc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeadLetterOrder.created_at"`)}
+	}
+	if _, ok := dloc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeadLetterOrder.updated_at"`)}
+	}
+	if _, ok := dloc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "DeadLetterOrder.reason"`)}
+	}
+	if v, ok := dloc.mutation.Reason(); ok {
+		if err := deadletterorder.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DeadLetterOrder.reason": %w`, err)}
+		}
+	}
+	if _, ok := dloc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeadLetterOrder.status"`)}
+	}
+	if v, ok := dloc.mutation.Status(); ok {
+		if err := deadletterorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetterOrder.status": %w`, err)}
+		}
+	}
+	if _, ok := dloc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "DeadLetterOrder.attempts"`)}
+	}
+	if _, ok := dloc.mutation.NextRetryAt(); !ok {
+		return &ValidationError{Name: "next_retry_at", err: errors.New(`ent: missing required field "DeadLetterOrder.next_retry_at"`)}
+	}
+	if _, ok := dloc.mutation.RefundDeadline(); !ok {
+		return &ValidationError{Name: "refund_deadline", err: errors.New(`ent: missing required field "DeadLetterOrder.refund_deadline"`)}
+	}
+	if len(dloc.mutation.LockPaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "lock_payment_order", err: errors.New(`ent: missing required edge "DeadLetterOrder.lock_payment_order"`)}
+	}
+	return nil
+}
+
+func (dloc *DeadLetterOrderCreate) sqlSave(ctx context.Context) (*DeadLetterOrder, error) {
+	if err := dloc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dloc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dloc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dloc.mutation.id = &_node.ID
+	dloc.mutation.done = true
+	return _node, nil
+}
+
+func (dloc *DeadLetterOrderCreate) createSpec() (*DeadLetterOrder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeadLetterOrder{config: dloc.config}
+		_spec = sqlgraph.NewCreateSpec(deadletterorder.Table, sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dloc.conflict
+	if id, ok := dloc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dloc.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletterorder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dloc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletterorder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dloc.mutation.Reason(); ok {
+		_spec.SetField(deadletterorder.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := dloc.mutation.Status(); ok {
+		_spec.SetField(deadletterorder.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dloc.mutation.Attempts(); ok {
+		_spec.SetField(deadletterorder.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := dloc.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletterorder.FieldNextRetryAt, field.TypeTime, value)
+		_node.NextRetryAt = value
+	}
+	if value, ok := dloc.mutation.RefundDeadline(); ok {
+		_spec.SetField(deadletterorder.FieldRefundDeadline, field.TypeTime, value)
+		_node.RefundDeadline = value
+	}
+	if value, ok := dloc.mutation.ResolvedAt(); ok {
+		_spec.SetField(deadletterorder.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	if nodes := dloc.mutation.LockPaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   deadletterorder.LockPaymentOrderTable,
+			Columns: []string{deadletterorder.LockPaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_dead_letter = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetterOrder.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterOrderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dloc *DeadLetterOrderCreate) OnConflict(opts ...sql.ConflictOption) *DeadLetterOrderUpsertOne {
+	dloc.conflict = opts
+	return &DeadLetterOrderUpsertOne{
+		create: dloc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetterOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dloc *DeadLetterOrderCreate) OnConflictColumns(columns ...string) *DeadLetterOrderUpsertOne {
+	dloc.conflict = append(dloc.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterOrderUpsertOne{
+		create: dloc,
+	}
+}
+
+type (
+	// DeadLetterOrderUpsertOne is the builder for "upsert"-ing
+	//  one DeadLetterOrder node.
+	DeadLetterOrderUpsertOne struct {
+		create *DeadLetterOrderCreate
+	}
+
+	// DeadLetterOrderUpsert is the "OnConflict" setter.
+	DeadLetterOrderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterOrderUpsert) SetUpdatedAt(v time.Time) *DeadLetterOrderUpsert {
+	u.Set(deadletterorder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsert) UpdateUpdatedAt() *DeadLetterOrderUpsert {
+	u.SetExcluded(deadletterorder.FieldUpdatedAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *DeadLetterOrderUpsert) SetReason(v deadletterorder.Reason) *DeadLetterOrderUpsert {
+	u.Set(deadletterorder.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsert) UpdateReason() *DeadLetterOrderUpsert {
+	u.SetExcluded(deadletterorder.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DeadLetterOrderUpsert) SetStatus(v deadletterorder.Status) *DeadLetterOrderUpsert {
+	u.Set(deadletterorder.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsert) UpdateStatus() *DeadLetterOrderUpsert {
+	u.SetExcluded(deadletterorder.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterOrderUpsert) SetAttempts(v int) *DeadLetterOrderUpsert {
+	u.Set(deadletterorder.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsert) UpdateAttempts() *DeadLetterOrderUpsert {
+	u.SetExcluded(deadletterorder.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterOrderUpsert) AddAttempts(v int) *DeadLetterOrderUpsert {
+	u.Add(deadletterorder.FieldAttempts, v)
+	return u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterOrderUpsert) SetNextRetryAt(v time.Time) *DeadLetterOrderUpsert {
+	u.Set(deadletterorder.FieldNextRetryAt, v)
+	return u
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsert) UpdateNextRetryAt() *DeadLetterOrderUpsert {
+	u.SetExcluded(deadletterorder.FieldNextRetryAt)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DeadLetterOrderUpsert) SetResolvedAt(v time.Time) *DeadLetterOrderUpsert {
+	u.Set(deadletterorder.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsert) UpdateResolvedAt() *DeadLetterOrderUpsert {
+	u.SetExcluded(deadletterorder.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DeadLetterOrderUpsert) ClearResolvedAt() *DeadLetterOrderUpsert {
+	u.SetNull(deadletterorder.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetterOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletterorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterOrderUpsertOne) UpdateNewValues() *DeadLetterOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deadletterorder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deadletterorder.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.RefundDeadline(); exists {
+			s.SetIgnore(deadletterorder.FieldRefundDeadline)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetterOrder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeadLetterOrderUpsertOne) Ignore() *DeadLetterOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterOrderUpsertOne) DoNothing() *DeadLetterOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterOrderCreate.OnConflict
+// documentation for more info.
+func (u *DeadLetterOrderUpsertOne) Update(set func(*DeadLetterOrderUpsert)) *DeadLetterOrderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterOrderUpsertOne) SetUpdatedAt(v time.Time) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertOne) UpdateUpdatedAt() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DeadLetterOrderUpsertOne) SetReason(v deadletterorder.Reason) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertOne) UpdateReason() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeadLetterOrderUpsertOne) SetStatus(v deadletterorder.Status) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertOne) UpdateStatus() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterOrderUpsertOne) SetAttempts(v int) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterOrderUpsertOne) AddAttempts(v int) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertOne) UpdateAttempts() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterOrderUpsertOne) SetNextRetryAt(v time.Time) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertOne) UpdateNextRetryAt() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DeadLetterOrderUpsertOne) SetResolvedAt(v time.Time) *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertOne) UpdateResolvedAt() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DeadLetterOrderUpsertOne) ClearResolvedAt() *DeadLetterOrderUpsertOne {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterOrderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterOrderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterOrderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeadLetterOrderUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeadLetterOrderUpsertOne.ID is not supported by MySQL driver. Use DeadLetterOrderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeadLetterOrderUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeadLetterOrderCreateBulk is the builder for creating many DeadLetterOrder entities in bulk.
+type DeadLetterOrderCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterOrderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeadLetterOrder entities in the database.
+func (dlocb *DeadLetterOrderCreateBulk) Save(ctx context.Context) ([]*DeadLetterOrder, error) {
+	if dlocb.err != nil {
+		return nil, dlocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dlocb.builders))
+	nodes := make([]*DeadLetterOrder, len(dlocb.builders))
+	mutators := make([]Mutator, len(dlocb.builders))
+	for i := range dlocb.builders {
+		func(i int, root context.Context) {
+			builder := dlocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterOrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dlocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dlocb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dlocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dlocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlocb *DeadLetterOrderCreateBulk) SaveX(ctx context.Context) []*DeadLetterOrder {
+	v, err := dlocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlocb *DeadLetterOrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := dlocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlocb *DeadLetterOrderCreateBulk) ExecX(ctx context.Context) {
+	if err := dlocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetterOrder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterOrderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dlocb *DeadLetterOrderCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeadLetterOrderUpsertBulk {
+	dlocb.conflict = opts
+	return &DeadLetterOrderUpsertBulk{
+		create: dlocb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetterOrder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dlocb *DeadLetterOrderCreateBulk) OnConflictColumns(columns ...string) *DeadLetterOrderUpsertBulk {
+	dlocb.conflict = append(dlocb.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterOrderUpsertBulk{
+		create: dlocb,
+	}
+}
+
+// DeadLetterOrderUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeadLetterOrder nodes.
+type DeadLetterOrderUpsertBulk struct {
+	create *DeadLetterOrderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeadLetterOrder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deadletterorder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterOrderUpsertBulk) UpdateNewValues() *DeadLetterOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deadletterorder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deadletterorder.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.RefundDeadline(); exists {
+				s.SetIgnore(deadletterorder.FieldRefundDeadline)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetterOrder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeadLetterOrderUpsertBulk) Ignore() *DeadLetterOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterOrderUpsertBulk) DoNothing() *DeadLetterOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterOrderCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeadLetterOrderUpsertBulk) Update(set func(*DeadLetterOrderUpsert)) *DeadLetterOrderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterOrderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterOrderUpsertBulk) SetUpdatedAt(v time.Time) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertBulk) UpdateUpdatedAt() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DeadLetterOrderUpsertBulk) SetReason(v deadletterorder.Reason) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertBulk) UpdateReason() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DeadLetterOrderUpsertBulk) SetStatus(v deadletterorder.Status) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertBulk) UpdateStatus() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterOrderUpsertBulk) SetAttempts(v int) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterOrderUpsertBulk) AddAttempts(v int) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertBulk) UpdateAttempts() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterOrderUpsertBulk) SetNextRetryAt(v time.Time) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertBulk) UpdateNextRetryAt() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DeadLetterOrderUpsertBulk) SetResolvedAt(v time.Time) *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DeadLetterOrderUpsertBulk) UpdateResolvedAt() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DeadLetterOrderUpsertBulk) ClearResolvedAt() *DeadLetterOrderUpsertBulk {
+	return u.Update(func(s *DeadLetterOrderUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterOrderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeadLetterOrderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterOrderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterOrderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DeadLetterOrderDelete is the builder for deleting a DeadLetterOrder entity.
+type DeadLetterOrderDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterOrderMutation
+}
+
+// Where appends a list predicates to the DeadLetterOrderDelete builder.
+func (dlod *DeadLetterOrderDelete) Where(ps ...predicate.DeadLetterOrder) *DeadLetterOrderDelete {
+	dlod.mutation.Where(ps...)
+	return dlod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dlod *DeadLetterOrderDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dlod.sqlExec, dlod.mutation, dlod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlod *DeadLetterOrderDelete) ExecX(ctx context.Context) int {
+	n, err := dlod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dlod *DeadLetterOrderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deadletterorder.Table, sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID))
+	if ps := dlod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dlod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dlod.mutation.done = true
+	return affected, err
+}
+
+// DeadLetterOrderDeleteOne is the builder for deleting a single DeadLetterOrder entity.
+type DeadLetterOrderDeleteOne struct {
+	dlod *DeadLetterOrderDelete
+}
+
+// Where appends a list predicates to the DeadLetterOrderDelete builder.
+func (dlodo *DeadLetterOrderDeleteOne) Where(ps ...predicate.DeadLetterOrder) *DeadLetterOrderDeleteOne {
+	dlodo.dlod.mutation.Where(ps...)
+	return dlodo
+}
+
+// Exec executes the deletion query.
+func (dlodo *DeadLetterOrderDeleteOne) Exec(ctx context.Context) error {
+	n, err := dlodo.dlod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deadletterorder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlodo *DeadLetterOrderDeleteOne) ExecX(ctx context.Context) {
+	if err := dlodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
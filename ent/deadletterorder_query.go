@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DeadLetterOrderQuery is the builder for querying DeadLetterOrder entities.
+type DeadLetterOrderQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []deadletterorder.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.DeadLetterOrder
+	withLockPaymentOrder *LockPaymentOrderQuery
+	withFKs              bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeadLetterOrderQuery builder.
+func (dloq *DeadLetterOrderQuery) Where(ps ...predicate.DeadLetterOrder) *DeadLetterOrderQuery {
+	dloq.predicates = append(dloq.predicates, ps...)
+	return dloq
+}
+
+// Limit the number of records to be returned by this query.
+func (dloq *DeadLetterOrderQuery) Limit(limit int) *DeadLetterOrderQuery {
+	dloq.ctx.Limit = &limit
+	return dloq
+}
+
+// Offset to start from.
+func (dloq *DeadLetterOrderQuery) Offset(offset int) *DeadLetterOrderQuery {
+	dloq.ctx.Offset = &offset
+	return dloq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dloq *DeadLetterOrderQuery) Unique(unique bool) *DeadLetterOrderQuery {
+	dloq.ctx.Unique = &unique
+	return dloq
+}
+
+// Order specifies how the records should be ordered.
+func (dloq *DeadLetterOrderQuery) Order(o ...deadletterorder.OrderOption) *DeadLetterOrderQuery {
+	dloq.order = append(dloq.order, o...)
+	return dloq
+}
+
+// QueryLockPaymentOrder chains the current query on the "lock_payment_order" edge.
+func (dloq *DeadLetterOrderQuery) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: dloq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dloq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dloq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deadletterorder.Table, deadletterorder.FieldID, selector),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, deadletterorder.LockPaymentOrderTable, deadletterorder.LockPaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dloq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DeadLetterOrder entity from the query.
+// Returns a *NotFoundError when no DeadLetterOrder was found.
+func (dloq *DeadLetterOrderQuery) First(ctx context.Context) (*DeadLetterOrder, error) {
+	nodes, err := dloq.Limit(1).All(setContextOp(ctx, dloq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deadletterorder.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) FirstX(ctx context.Context) *DeadLetterOrder {
+	node, err := dloq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeadLetterOrder ID from the query.
+// Returns a *NotFoundError when no DeadLetterOrder ID was found.
+func (dloq *DeadLetterOrderQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dloq.Limit(1).IDs(setContextOp(ctx, dloq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deadletterorder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dloq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeadLetterOrder entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeadLetterOrder entity is found.
+// Returns a *NotFoundError when no DeadLetterOrder entities are found.
+func (dloq *DeadLetterOrderQuery) Only(ctx context.Context) (*DeadLetterOrder, error) {
+	nodes, err := dloq.Limit(2).All(setContextOp(ctx, dloq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deadletterorder.Label}
+	default:
+		return nil, &NotSingularError{deadletterorder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) OnlyX(ctx context.Context) *DeadLetterOrder {
+	node, err := dloq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeadLetterOrder ID in the query.
+// Returns a *NotSingularError when more than one DeadLetterOrder ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dloq *DeadLetterOrderQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dloq.Limit(2).IDs(setContextOp(ctx, dloq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deadletterorder.Label}
+	default:
+		err = &NotSingularError{deadletterorder.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dloq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeadLetterOrders.
+func (dloq *DeadLetterOrderQuery) All(ctx context.Context) ([]*DeadLetterOrder, error) {
+	ctx = setContextOp(ctx, dloq.ctx, ent.OpQueryAll)
+	if err := dloq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeadLetterOrder, *DeadLetterOrderQuery]()
+	return withInterceptors[[]*DeadLetterOrder](ctx, dloq, qr, dloq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) AllX(ctx context.Context) []*DeadLetterOrder {
+	nodes, err := dloq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeadLetterOrder IDs.
+func (dloq *DeadLetterOrderQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dloq.ctx.Unique == nil && dloq.path != nil {
+		dloq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dloq.ctx, ent.OpQueryIDs)
+	if err = dloq.Select(deadletterorder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dloq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dloq *DeadLetterOrderQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dloq.ctx, ent.OpQueryCount)
+	if err := dloq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dloq, querierCount[*DeadLetterOrderQuery](), dloq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) CountX(ctx context.Context) int {
+	count, err := dloq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dloq *DeadLetterOrderQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dloq.ctx, ent.OpQueryExist)
+	switch _, err := dloq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dloq *DeadLetterOrderQuery) ExistX(ctx context.Context) bool {
+	exist, err := dloq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeadLetterOrderQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dloq *DeadLetterOrderQuery) Clone() *DeadLetterOrderQuery {
+	if dloq == nil {
+		return nil
+	}
+	return &DeadLetterOrderQuery{
+		config:               dloq.config,
+		ctx:                  dloq.ctx.Clone(),
+		order:                append([]deadletterorder.OrderOption{}, dloq.order...),
+		inters:               append([]Interceptor{}, dloq.inters...),
+		predicates:           append([]predicate.DeadLetterOrder{}, dloq.predicates...),
+		withLockPaymentOrder: dloq.withLockPaymentOrder.Clone(),
+		// clone intermediate query.
+		sql:  dloq.sql.Clone(),
+		path: dloq.path,
+	}
+}
+
+// WithLockPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "lock_payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (dloq *DeadLetterOrderQuery) WithLockPaymentOrder(opts ...func(*LockPaymentOrderQuery)) *DeadLetterOrderQuery {
+	query := (&LockPaymentOrderClient{config: dloq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dloq.withLockPaymentOrder = query
+	return dloq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeadLetterOrder.Query().
+//		GroupBy(deadletterorder.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dloq *DeadLetterOrderQuery) GroupBy(field string, fields ...string) *DeadLetterOrderGroupBy {
+	dloq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeadLetterOrderGroupBy{build: dloq}
+	grbuild.flds = &dloq.ctx.Fields
+	grbuild.label = deadletterorder.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DeadLetterOrder.Query().
+//		Select(deadletterorder.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dloq *DeadLetterOrderQuery) Select(fields ...string) *DeadLetterOrderSelect {
+	dloq.ctx.Fields = append(dloq.ctx.Fields, fields...)
+	sbuild := &DeadLetterOrderSelect{DeadLetterOrderQuery: dloq}
+	sbuild.label = deadletterorder.Label
+	sbuild.flds, sbuild.scan = &dloq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeadLetterOrderSelect configured with the given aggregations.
+func (dloq *DeadLetterOrderQuery) Aggregate(fns ...AggregateFunc) *DeadLetterOrderSelect {
+	return dloq.Select().Aggregate(fns...)
+}
+
+func (dloq *DeadLetterOrderQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dloq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dloq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dloq.ctx.Fields {
+		if !deadletterorder.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dloq.path != nil {
+		prev, err := dloq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dloq.sql = prev
+	}
+	return nil
+}
+
+func (dloq *DeadLetterOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeadLetterOrder, error) {
+	var (
+		nodes       = []*DeadLetterOrder{}
+		withFKs     = dloq.withFKs
+		_spec       = dloq.querySpec()
+		loadedTypes = [1]bool{
+			dloq.withLockPaymentOrder != nil,
+		}
+	)
+	if dloq.withLockPaymentOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletterorder.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeadLetterOrder).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeadLetterOrder{config: dloq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dloq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dloq.withLockPaymentOrder; query != nil {
+		if err := dloq.loadLockPaymentOrder(ctx, query, nodes, nil,
+			func(n *DeadLetterOrder, e *LockPaymentOrder) { n.Edges.LockPaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dloq *DeadLetterOrderQuery) loadLockPaymentOrder(ctx context.Context, query *LockPaymentOrderQuery, nodes []*DeadLetterOrder, init func(*DeadLetterOrder), assign func(*DeadLetterOrder, *LockPaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DeadLetterOrder)
+	for i := range nodes {
+		if nodes[i].lock_payment_order_dead_letter == nil {
+			continue
+		}
+		fk := *nodes[i].lock_payment_order_dead_letter
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(lockpaymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "lock_payment_order_dead_letter" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (dloq *DeadLetterOrderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dloq.querySpec()
+	_spec.Node.Columns = dloq.ctx.Fields
+	if len(dloq.ctx.Fields) > 0 {
+		_spec.Unique = dloq.ctx.Unique != nil && *dloq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dloq.driver, _spec)
+}
+
+func (dloq *DeadLetterOrderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deadletterorder.Table, deadletterorder.Columns, sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID))
+	_spec.From = dloq.sql
+	if unique := dloq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dloq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dloq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletterorder.FieldID)
+		for i := range fields {
+			if fields[i] != deadletterorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dloq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dloq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dloq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dloq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dloq *DeadLetterOrderQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dloq.driver.Dialect())
+	t1 := builder.Table(deadletterorder.Table)
+	columns := dloq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deadletterorder.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dloq.sql != nil {
+		selector = dloq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dloq.ctx.Unique != nil && *dloq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dloq.predicates {
+		p(selector)
+	}
+	for _, p := range dloq.order {
+		p(selector)
+	}
+	if offset := dloq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dloq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DeadLetterOrderGroupBy is the group-by builder for DeadLetterOrder entities.
+type DeadLetterOrderGroupBy struct {
+	selector
+	build *DeadLetterOrderQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dlogb *DeadLetterOrderGroupBy) Aggregate(fns ...AggregateFunc) *DeadLetterOrderGroupBy {
+	dlogb.fns = append(dlogb.fns, fns...)
+	return dlogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dlogb *DeadLetterOrderGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dlogb.build.ctx, ent.OpQueryGroupBy)
+	if err := dlogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeadLetterOrderQuery, *DeadLetterOrderGroupBy](ctx, dlogb.build, dlogb, dlogb.build.inters, v)
+}
+
+func (dlogb *DeadLetterOrderGroupBy) sqlScan(ctx context.Context, root *DeadLetterOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dlogb.fns))
+	for _, fn := range dlogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dlogb.flds)+len(dlogb.fns))
+		for _, f := range *dlogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dlogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dlogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeadLetterOrderSelect is the builder for selecting fields of DeadLetterOrder entities.
+type DeadLetterOrderSelect struct {
+	*DeadLetterOrderQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dlos *DeadLetterOrderSelect) Aggregate(fns ...AggregateFunc) *DeadLetterOrderSelect {
+	dlos.fns = append(dlos.fns, fns...)
+	return dlos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dlos *DeadLetterOrderSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dlos.ctx, ent.OpQuerySelect)
+	if err := dlos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeadLetterOrderQuery, *DeadLetterOrderSelect](ctx, dlos.DeadLetterOrderQuery, dlos, dlos.inters, v)
+}
+
+func (dlos *DeadLetterOrderSelect) sqlScan(ctx context.Context, root *DeadLetterOrderQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dlos.fns))
+	for _, fn := range dlos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dlos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dlos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
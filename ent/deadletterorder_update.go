@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DeadLetterOrderUpdate is the builder for updating DeadLetterOrder entities.
+type DeadLetterOrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterOrderMutation
+}
+
+// Where appends a list predicates to the DeadLetterOrderUpdate builder.
+func (dlou *DeadLetterOrderUpdate) Where(ps ...predicate.DeadLetterOrder) *DeadLetterOrderUpdate {
+	dlou.mutation.Where(ps...)
+	return dlou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlou *DeadLetterOrderUpdate) SetUpdatedAt(t time.Time) *DeadLetterOrderUpdate {
+	dlou.mutation.SetUpdatedAt(t)
+	return dlou
+}
+
+// SetReason sets the "reason" field.
+func (dlou *DeadLetterOrderUpdate) SetReason(d deadletterorder.Reason) *DeadLetterOrderUpdate {
+	dlou.mutation.SetReason(d)
+	return dlou
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (dlou *DeadLetterOrderUpdate) SetNillableReason(d *deadletterorder.Reason) *DeadLetterOrderUpdate {
+	if d != nil {
+		dlou.SetReason(*d)
+	}
+	return dlou
+}
+
+// SetStatus sets the "status" field.
+func (dlou *DeadLetterOrderUpdate) SetStatus(d deadletterorder.Status) *DeadLetterOrderUpdate {
+	dlou.mutation.SetStatus(d)
+	return dlou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dlou *DeadLetterOrderUpdate) SetNillableStatus(d *deadletterorder.Status) *DeadLetterOrderUpdate {
+	if d != nil {
+		dlou.SetStatus(*d)
+	}
+	return dlou
+}
+
+// SetAttempts sets the "attempts" field.
+func (dlou *DeadLetterOrderUpdate) SetAttempts(i int) *DeadLetterOrderUpdate {
+	dlou.mutation.ResetAttempts()
+	dlou.mutation.SetAttempts(i)
+	return dlou
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dlou *DeadLetterOrderUpdate) SetNillableAttempts(i *int) *DeadLetterOrderUpdate {
+	if i != nil {
+		dlou.SetAttempts(*i)
+	}
+	return dlou
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (dlou *DeadLetterOrderUpdate) AddAttempts(i int) *DeadLetterOrderUpdate {
+	dlou.mutation.AddAttempts(i)
+	return dlou
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (dlou *DeadLetterOrderUpdate) SetNextRetryAt(t time.Time) *DeadLetterOrderUpdate {
+	dlou.mutation.SetNextRetryAt(t)
+	return dlou
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (dlou *DeadLetterOrderUpdate) SetNillableNextRetryAt(t *time.Time) *DeadLetterOrderUpdate {
+	if t != nil {
+		dlou.SetNextRetryAt(*t)
+	}
+	return dlou
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dlou *DeadLetterOrderUpdate) SetResolvedAt(t time.Time) *DeadLetterOrderUpdate {
+	dlou.mutation.SetResolvedAt(t)
+	return dlou
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dlou *DeadLetterOrderUpdate) SetNillableResolvedAt(t *time.Time) *DeadLetterOrderUpdate {
+	if t != nil {
+		dlou.SetResolvedAt(*t)
+	}
+	return dlou
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dlou *DeadLetterOrderUpdate) ClearResolvedAt() *DeadLetterOrderUpdate {
+	dlou.mutation.ClearResolvedAt()
+	return dlou
+}
+
+// Mutation returns the DeadLetterOrderMutation object of the builder.
+func (dlou *DeadLetterOrderUpdate) Mutation() *DeadLetterOrderMutation {
+	return dlou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dlou *DeadLetterOrderUpdate) Save(ctx context.Context) (int, error) {
+	dlou.defaults()
+	return withHooks(ctx, dlou.sqlSave, dlou.mutation, dlou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlou *DeadLetterOrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := dlou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dlou *DeadLetterOrderUpdate) Exec(ctx context.Context) error {
+	_, err := dlou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlou *DeadLetterOrderUpdate) ExecX(ctx context.Context) {
+	if err := dlou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlou *DeadLetterOrderUpdate) defaults() {
+	if _, ok := dlou.mutation.UpdatedAt(); !ok {
+		v := deadletterorder.UpdateDefaultUpdatedAt()
+		dlou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlou *DeadLetterOrderUpdate) check() error {
+	if v, ok := dlou.mutation.Reason(); ok {
+		if err := deadletterorder.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DeadLetterOrder.reason": %w`, err)}
+		}
+	}
+	if v, ok := dlou.mutation.Status(); ok {
+		if err := deadletterorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetterOrder.status": %w`, err)}
+		}
+	}
+	if dlou.mutation.LockPaymentOrderCleared() && len(dlou.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeadLetterOrder.lock_payment_order"`)
+	}
+	return nil
+}
+
+func (dlou *DeadLetterOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dlou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletterorder.Table, deadletterorder.Columns, sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID))
+	if ps := dlou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dlou.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletterorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dlou.mutation.Reason(); ok {
+		_spec.SetField(deadletterorder.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := dlou.mutation.Status(); ok {
+		_spec.SetField(deadletterorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dlou.mutation.Attempts(); ok {
+		_spec.SetField(deadletterorder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dlou.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletterorder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dlou.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletterorder.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := dlou.mutation.ResolvedAt(); ok {
+		_spec.SetField(deadletterorder.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dlou.mutation.ResolvedAtCleared() {
+		_spec.ClearField(deadletterorder.FieldResolvedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dlou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletterorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dlou.mutation.done = true
+	return n, nil
+}
+
+// DeadLetterOrderUpdateOne is the builder for updating a single DeadLetterOrder entity.
+type DeadLetterOrderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeadLetterOrderMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlouo *DeadLetterOrderUpdateOne) SetUpdatedAt(t time.Time) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.SetUpdatedAt(t)
+	return dlouo
+}
+
+// SetReason sets the "reason" field.
+func (dlouo *DeadLetterOrderUpdateOne) SetReason(d deadletterorder.Reason) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.SetReason(d)
+	return dlouo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (dlouo *DeadLetterOrderUpdateOne) SetNillableReason(d *deadletterorder.Reason) *DeadLetterOrderUpdateOne {
+	if d != nil {
+		dlouo.SetReason(*d)
+	}
+	return dlouo
+}
+
+// SetStatus sets the "status" field.
+func (dlouo *DeadLetterOrderUpdateOne) SetStatus(d deadletterorder.Status) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.SetStatus(d)
+	return dlouo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dlouo *DeadLetterOrderUpdateOne) SetNillableStatus(d *deadletterorder.Status) *DeadLetterOrderUpdateOne {
+	if d != nil {
+		dlouo.SetStatus(*d)
+	}
+	return dlouo
+}
+
+// SetAttempts sets the "attempts" field.
+func (dlouo *DeadLetterOrderUpdateOne) SetAttempts(i int) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.ResetAttempts()
+	dlouo.mutation.SetAttempts(i)
+	return dlouo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (dlouo *DeadLetterOrderUpdateOne) SetNillableAttempts(i *int) *DeadLetterOrderUpdateOne {
+	if i != nil {
+		dlouo.SetAttempts(*i)
+	}
+	return dlouo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (dlouo *DeadLetterOrderUpdateOne) AddAttempts(i int) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.AddAttempts(i)
+	return dlouo
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (dlouo *DeadLetterOrderUpdateOne) SetNextRetryAt(t time.Time) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.SetNextRetryAt(t)
+	return dlouo
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (dlouo *DeadLetterOrderUpdateOne) SetNillableNextRetryAt(t *time.Time) *DeadLetterOrderUpdateOne {
+	if t != nil {
+		dlouo.SetNextRetryAt(*t)
+	}
+	return dlouo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dlouo *DeadLetterOrderUpdateOne) SetResolvedAt(t time.Time) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.SetResolvedAt(t)
+	return dlouo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dlouo *DeadLetterOrderUpdateOne) SetNillableResolvedAt(t *time.Time) *DeadLetterOrderUpdateOne {
+	if t != nil {
+		dlouo.SetResolvedAt(*t)
+	}
+	return dlouo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (dlouo *DeadLetterOrderUpdateOne) ClearResolvedAt() *DeadLetterOrderUpdateOne {
+	dlouo.mutation.ClearResolvedAt()
+	return dlouo
+}
+
+// Mutation returns the DeadLetterOrderMutation object of the builder.
+func (dlouo *DeadLetterOrderUpdateOne) Mutation() *DeadLetterOrderMutation {
+	return dlouo.mutation
+}
+
+// Where appends a list predicates to the DeadLetterOrderUpdate builder.
+func (dlouo *DeadLetterOrderUpdateOne) Where(ps ...predicate.DeadLetterOrder) *DeadLetterOrderUpdateOne {
+	dlouo.mutation.Where(ps...)
+	return dlouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dlouo *DeadLetterOrderUpdateOne) Select(field string, fields ...string) *DeadLetterOrderUpdateOne {
+	dlouo.fields = append([]string{field}, fields...)
+	return dlouo
+}
+
+// Save executes the query and returns the updated DeadLetterOrder entity.
+func (dlouo *DeadLetterOrderUpdateOne) Save(ctx context.Context) (*DeadLetterOrder, error) {
+	dlouo.defaults()
+	return withHooks(ctx, dlouo.sqlSave, dlouo.mutation, dlouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlouo *DeadLetterOrderUpdateOne) SaveX(ctx context.Context) *DeadLetterOrder {
+	node, err := dlouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dlouo *DeadLetterOrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := dlouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlouo *DeadLetterOrderUpdateOne) ExecX(ctx context.Context) {
+	if err := dlouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlouo *DeadLetterOrderUpdateOne) defaults() {
+	if _, ok := dlouo.mutation.UpdatedAt(); !ok {
+		v := deadletterorder.UpdateDefaultUpdatedAt()
+		dlouo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlouo *DeadLetterOrderUpdateOne) check() error {
+	if v, ok := dlouo.mutation.Reason(); ok {
+		if err := deadletterorder.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "DeadLetterOrder.reason": %w`, err)}
+		}
+	}
+	if v, ok := dlouo.mutation.Status(); ok {
+		if err := deadletterorder.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeadLetterOrder.status": %w`, err)}
+		}
+	}
+	if dlouo.mutation.LockPaymentOrderCleared() && len(dlouo.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeadLetterOrder.lock_payment_order"`)
+	}
+	return nil
+}
+
+func (dlouo *DeadLetterOrderUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetterOrder, err error) {
+	if err := dlouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deadletterorder.Table, deadletterorder.Columns, sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID))
+	id, ok := dlouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeadLetterOrder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dlouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletterorder.FieldID)
+		for _, f := range fields {
+			if !deadletterorder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deadletterorder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dlouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dlouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletterorder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dlouo.mutation.Reason(); ok {
+		_spec.SetField(deadletterorder.FieldReason, field.TypeEnum, value)
+	}
+	if value, ok := dlouo.mutation.Status(); ok {
+		_spec.SetField(deadletterorder.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dlouo.mutation.Attempts(); ok {
+		_spec.SetField(deadletterorder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dlouo.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletterorder.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := dlouo.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletterorder.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := dlouo.mutation.ResolvedAt(); ok {
+		_spec.SetField(deadletterorder.FieldResolvedAt, field.TypeTime, value)
+	}
+	if dlouo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(deadletterorder.FieldResolvedAt, field.TypeTime)
+	}
+	_node = &DeadLetterOrder{config: dlouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dlouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletterorder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dlouo.mutation.done = true
+	return _node, nil
+}
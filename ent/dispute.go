@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/shopspring/decimal"
+)
+
+// Dispute is the model entity for the Dispute schema.
+type Dispute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status dispute.Status `json:"status,omitempty"`
+	// ResponseDeadline holds the value of the "response_deadline" field.
+	ResponseDeadline time.Time `json:"response_deadline,omitempty"`
+	// ResolutionDeadline holds the value of the "resolution_deadline" field.
+	ResolutionDeadline time.Time `json:"resolution_deadline,omitempty"`
+	// Resolution holds the value of the "resolution" field.
+	Resolution dispute.Resolution `json:"resolution,omitempty"`
+	// ResolutionNote holds the value of the "resolution_note" field.
+	ResolutionNote string `json:"resolution_note,omitempty"`
+	// TrustScoreAdjustment holds the value of the "trust_score_adjustment" field.
+	TrustScoreAdjustment decimal.Decimal `json:"trust_score_adjustment,omitempty"`
+	// CompensationAmount holds the value of the "compensation_amount" field.
+	CompensationAmount decimal.Decimal `json:"compensation_amount,omitempty"`
+	// CompensationStatus holds the value of the "compensation_status" field.
+	CompensationStatus dispute.CompensationStatus `json:"compensation_status,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DisputeQuery when eager-loading is set.
+	Edges                       DisputeEdges `json:"edges"`
+	lock_payment_order_disputes *uuid.UUID
+	payment_order_disputes      *uuid.UUID
+	provider_profile_disputes   *string
+	selectValues                sql.SelectValues
+}
+
+// DisputeEdges holds the relations/edges for other nodes in the graph.
+type DisputeEdges struct {
+	// PaymentOrder holds the value of the payment_order edge.
+	PaymentOrder *PaymentOrder `json:"payment_order,omitempty"`
+	// LockPaymentOrder holds the value of the lock_payment_order edge.
+	LockPaymentOrder *LockPaymentOrder `json:"lock_payment_order,omitempty"`
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// Evidence holds the value of the evidence edge.
+	Evidence []*DisputeEvidence `json:"evidence,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PaymentOrderOrErr returns the PaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DisputeEdges) PaymentOrderOrErr() (*PaymentOrder, error) {
+	if e.PaymentOrder != nil {
+		return e.PaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment_order"}
+}
+
+// LockPaymentOrderOrErr returns the LockPaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DisputeEdges) LockPaymentOrderOrErr() (*LockPaymentOrder, error) {
+	if e.LockPaymentOrder != nil {
+		return e.LockPaymentOrder, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "lock_payment_order"}
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DisputeEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// EvidenceOrErr returns the Evidence value or an error if the edge
+// was not loaded in eager-loading.
+func (e DisputeEdges) EvidenceOrErr() ([]*DisputeEvidence, error) {
+	if e.loadedTypes[3] {
+		return e.Evidence, nil
+	}
+	return nil, &NotLoadedError{edge: "evidence"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Dispute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dispute.FieldTrustScoreAdjustment, dispute.FieldCompensationAmount:
+			values[i] = new(decimal.Decimal)
+		case dispute.FieldReason, dispute.FieldStatus, dispute.FieldResolution, dispute.FieldResolutionNote, dispute.FieldCompensationStatus:
+			values[i] = new(sql.NullString)
+		case dispute.FieldCreatedAt, dispute.FieldUpdatedAt, dispute.FieldResponseDeadline, dispute.FieldResolutionDeadline, dispute.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case dispute.FieldID:
+			values[i] = new(uuid.UUID)
+		case dispute.ForeignKeys[0]: // lock_payment_order_disputes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case dispute.ForeignKeys[1]: // payment_order_disputes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case dispute.ForeignKeys[2]: // provider_profile_disputes
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Dispute fields.
+func (d *Dispute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dispute.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				d.ID = *value
+			}
+		case dispute.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case dispute.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		case dispute.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				d.Reason = value.String
+			}
+		case dispute.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				d.Status = dispute.Status(value.String)
+			}
+		case dispute.FieldResponseDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field response_deadline", values[i])
+			} else if value.Valid {
+				d.ResponseDeadline = value.Time
+			}
+		case dispute.FieldResolutionDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_deadline", values[i])
+			} else if value.Valid {
+				d.ResolutionDeadline = value.Time
+			}
+		case dispute.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				d.Resolution = dispute.Resolution(value.String)
+			}
+		case dispute.FieldResolutionNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_note", values[i])
+			} else if value.Valid {
+				d.ResolutionNote = value.String
+			}
+		case dispute.FieldTrustScoreAdjustment:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field trust_score_adjustment", values[i])
+			} else if value != nil {
+				d.TrustScoreAdjustment = *value
+			}
+		case dispute.FieldCompensationAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field compensation_amount", values[i])
+			} else if value != nil {
+				d.CompensationAmount = *value
+			}
+		case dispute.FieldCompensationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field compensation_status", values[i])
+			} else if value.Valid {
+				d.CompensationStatus = dispute.CompensationStatus(value.String)
+			}
+		case dispute.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				d.ResolvedAt = value.Time
+			}
+		case dispute.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_disputes", values[i])
+			} else if value.Valid {
+				d.lock_payment_order_disputes = new(uuid.UUID)
+				*d.lock_payment_order_disputes = *value.S.(*uuid.UUID)
+			}
+		case dispute.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_order_disputes", values[i])
+			} else if value.Valid {
+				d.payment_order_disputes = new(uuid.UUID)
+				*d.payment_order_disputes = *value.S.(*uuid.UUID)
+			}
+		case dispute.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_disputes", values[i])
+			} else if value.Valid {
+				d.provider_profile_disputes = new(string)
+				*d.provider_profile_disputes = value.String
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Dispute.
+// This includes values selected through modifiers, order, etc.
+func (d *Dispute) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryPaymentOrder queries the "payment_order" edge of the Dispute entity.
+func (d *Dispute) QueryPaymentOrder() *PaymentOrderQuery {
+	return NewDisputeClient(d.config).QueryPaymentOrder(d)
+}
+
+// QueryLockPaymentOrder queries the "lock_payment_order" edge of the Dispute entity.
+func (d *Dispute) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	return NewDisputeClient(d.config).QueryLockPaymentOrder(d)
+}
+
+// QueryProvider queries the "provider" edge of the Dispute entity.
+func (d *Dispute) QueryProvider() *ProviderProfileQuery {
+	return NewDisputeClient(d.config).QueryProvider(d)
+}
+
+// QueryEvidence queries the "evidence" edge of the Dispute entity.
+func (d *Dispute) QueryEvidence() *DisputeEvidenceQuery {
+	return NewDisputeClient(d.config).QueryEvidence(d)
+}
+
+// Update returns a builder for updating this Dispute.
+// Note that you need to call Dispute.Unwrap() before calling this method if this Dispute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Dispute) Update() *DisputeUpdateOne {
+	return NewDisputeClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Dispute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Dispute) Unwrap() *Dispute {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Dispute is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Dispute) String() string {
+	var builder strings.Builder
+	builder.WriteString("Dispute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(d.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", d.Status))
+	builder.WriteString(", ")
+	builder.WriteString("response_deadline=")
+	builder.WriteString(d.ResponseDeadline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resolution_deadline=")
+	builder.WriteString(d.ResolutionDeadline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(fmt.Sprintf("%v", d.Resolution))
+	builder.WriteString(", ")
+	builder.WriteString("resolution_note=")
+	builder.WriteString(d.ResolutionNote)
+	builder.WriteString(", ")
+	builder.WriteString("trust_score_adjustment=")
+	builder.WriteString(fmt.Sprintf("%v", d.TrustScoreAdjustment))
+	builder.WriteString(", ")
+	builder.WriteString("compensation_amount=")
+	builder.WriteString(fmt.Sprintf("%v", d.CompensationAmount))
+	builder.WriteString(", ")
+	builder.WriteString("compensation_status=")
+	builder.WriteString(fmt.Sprintf("%v", d.CompensationStatus))
+	builder.WriteString(", ")
+	builder.WriteString("resolved_at=")
+	builder.WriteString(d.ResolvedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Disputes is a parsable slice of Dispute.
+type Disputes []*Dispute
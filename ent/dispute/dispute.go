@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dispute
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the dispute type in the database.
+	Label = "dispute"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResponseDeadline holds the string denoting the response_deadline field in the database.
+	FieldResponseDeadline = "response_deadline"
+	// FieldResolutionDeadline holds the string denoting the resolution_deadline field in the database.
+	FieldResolutionDeadline = "resolution_deadline"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldResolutionNote holds the string denoting the resolution_note field in the database.
+	FieldResolutionNote = "resolution_note"
+	// FieldTrustScoreAdjustment holds the string denoting the trust_score_adjustment field in the database.
+	FieldTrustScoreAdjustment = "trust_score_adjustment"
+	// FieldCompensationAmount holds the string denoting the compensation_amount field in the database.
+	FieldCompensationAmount = "compensation_amount"
+	// FieldCompensationStatus holds the string denoting the compensation_status field in the database.
+	FieldCompensationStatus = "compensation_status"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// EdgePaymentOrder holds the string denoting the payment_order edge name in mutations.
+	EdgePaymentOrder = "payment_order"
+	// EdgeLockPaymentOrder holds the string denoting the lock_payment_order edge name in mutations.
+	EdgeLockPaymentOrder = "lock_payment_order"
+	// EdgeProvider holds the string denoting the provider edge name in mutations.
+	EdgeProvider = "provider"
+	// EdgeEvidence holds the string denoting the evidence edge name in mutations.
+	EdgeEvidence = "evidence"
+	// Table holds the table name of the dispute in the database.
+	Table = "disputes"
+	// PaymentOrderTable is the table that holds the payment_order relation/edge.
+	PaymentOrderTable = "disputes"
+	// PaymentOrderInverseTable is the table name for the PaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "paymentorder" package.
+	PaymentOrderInverseTable = "payment_orders"
+	// PaymentOrderColumn is the table column denoting the payment_order relation/edge.
+	PaymentOrderColumn = "payment_order_disputes"
+	// LockPaymentOrderTable is the table that holds the lock_payment_order relation/edge.
+	LockPaymentOrderTable = "disputes"
+	// LockPaymentOrderInverseTable is the table name for the LockPaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "lockpaymentorder" package.
+	LockPaymentOrderInverseTable = "lock_payment_orders"
+	// LockPaymentOrderColumn is the table column denoting the lock_payment_order relation/edge.
+	LockPaymentOrderColumn = "lock_payment_order_disputes"
+	// ProviderTable is the table that holds the provider relation/edge.
+	ProviderTable = "disputes"
+	// ProviderInverseTable is the table name for the ProviderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "providerprofile" package.
+	ProviderInverseTable = "provider_profiles"
+	// ProviderColumn is the table column denoting the provider relation/edge.
+	ProviderColumn = "provider_profile_disputes"
+	// EvidenceTable is the table that holds the evidence relation/edge.
+	EvidenceTable = "dispute_evidences"
+	// EvidenceInverseTable is the table name for the DisputeEvidence entity.
+	// It exists in this package in order to avoid circular dependency with the "disputeevidence" package.
+	EvidenceInverseTable = "dispute_evidences"
+	// EvidenceColumn is the table column denoting the evidence relation/edge.
+	EvidenceColumn = "dispute_evidence"
+)
+
+// Columns holds all SQL columns for dispute fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldReason,
+	FieldStatus,
+	FieldResponseDeadline,
+	FieldResolutionDeadline,
+	FieldResolution,
+	FieldResolutionNote,
+	FieldTrustScoreAdjustment,
+	FieldCompensationAmount,
+	FieldCompensationStatus,
+	FieldResolvedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "disputes"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"lock_payment_order_disputes",
+	"payment_order_disputes",
+	"provider_profile_disputes",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusOpen is the default value of the Status enum.
+const DefaultStatus = StatusOpen
+
+// Status values.
+const (
+	StatusOpen        Status = "open"
+	StatusUnderReview Status = "under_review"
+	StatusResolved    Status = "resolved"
+	StatusWithdrawn   Status = "withdrawn"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusOpen, StatusUnderReview, StatusResolved, StatusWithdrawn:
+		return nil
+	default:
+		return fmt.Errorf("dispute: invalid enum value for status field: %q", s)
+	}
+}
+
+// Resolution defines the type for the "resolution" enum field.
+type Resolution string
+
+// Resolution values.
+const (
+	ResolutionSenderFavored   Resolution = "sender_favored"
+	ResolutionProviderFavored Resolution = "provider_favored"
+)
+
+func (r Resolution) String() string {
+	return string(r)
+}
+
+// ResolutionValidator is a validator for the "resolution" field enum values. It is called by the builders before save.
+func ResolutionValidator(r Resolution) error {
+	switch r {
+	case ResolutionSenderFavored, ResolutionProviderFavored:
+		return nil
+	default:
+		return fmt.Errorf("dispute: invalid enum value for resolution field: %q", r)
+	}
+}
+
+// CompensationStatus defines the type for the "compensation_status" enum field.
+type CompensationStatus string
+
+// CompensationStatusNone is the default value of the CompensationStatus enum.
+const DefaultCompensationStatus = CompensationStatusNone
+
+// CompensationStatus values.
+const (
+	CompensationStatusNone    CompensationStatus = "none"
+	CompensationStatusPending CompensationStatus = "pending"
+	CompensationStatusPaid    CompensationStatus = "paid"
+)
+
+func (cs CompensationStatus) String() string {
+	return string(cs)
+}
+
+// CompensationStatusValidator is a validator for the "compensation_status" field enum values. It is called by the builders before save.
+func CompensationStatusValidator(cs CompensationStatus) error {
+	switch cs {
+	case CompensationStatusNone, CompensationStatusPending, CompensationStatusPaid:
+		return nil
+	default:
+		return fmt.Errorf("dispute: invalid enum value for compensation_status field: %q", cs)
+	}
+}
+
+// OrderOption defines the ordering options for the Dispute queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResponseDeadline orders the results by the response_deadline field.
+func ByResponseDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseDeadline, opts...).ToFunc()
+}
+
+// ByResolutionDeadline orders the results by the resolution_deadline field.
+func ByResolutionDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionDeadline, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByResolutionNote orders the results by the resolution_note field.
+func ByResolutionNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionNote, opts...).ToFunc()
+}
+
+// ByTrustScoreAdjustment orders the results by the trust_score_adjustment field.
+func ByTrustScoreAdjustment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrustScoreAdjustment, opts...).ToFunc()
+}
+
+// ByCompensationAmount orders the results by the compensation_amount field.
+func ByCompensationAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompensationAmount, opts...).ToFunc()
+}
+
+// ByCompensationStatus orders the results by the compensation_status field.
+func ByCompensationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompensationStatus, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByPaymentOrderField orders the results by payment_order field.
+func ByPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLockPaymentOrderField orders the results by lock_payment_order field.
+func ByLockPaymentOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLockPaymentOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProviderField orders the results by provider field.
+func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEvidenceCount orders the results by evidence count.
+func ByEvidenceCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEvidenceStep(), opts...)
+	}
+}
+
+// ByEvidence orders the results by evidence terms.
+func ByEvidence(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEvidenceStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+	)
+}
+func newLockPaymentOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LockPaymentOrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+	)
+}
+func newProviderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+	)
+}
+func newEvidenceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EvidenceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EvidenceTable, EvidenceColumn),
+	)
+}
@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dispute
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldReason, v))
+}
+
+// ResponseDeadline applies equality check predicate on the "response_deadline" field. It's identical to ResponseDeadlineEQ.
+func ResponseDeadline(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResponseDeadline, v))
+}
+
+// ResolutionDeadline applies equality check predicate on the "resolution_deadline" field. It's identical to ResolutionDeadlineEQ.
+func ResolutionDeadline(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolutionDeadline, v))
+}
+
+// ResolutionNote applies equality check predicate on the "resolution_note" field. It's identical to ResolutionNoteEQ.
+func ResolutionNote(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolutionNote, v))
+}
+
+// TrustScoreAdjustment applies equality check predicate on the "trust_score_adjustment" field. It's identical to TrustScoreAdjustmentEQ.
+func TrustScoreAdjustment(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldTrustScoreAdjustment, v))
+}
+
+// CompensationAmount applies equality check predicate on the "compensation_amount" field. It's identical to CompensationAmountEQ.
+func CompensationAmount(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCompensationAmount, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResponseDeadlineEQ applies the EQ predicate on the "response_deadline" field.
+func ResponseDeadlineEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResponseDeadline, v))
+}
+
+// ResponseDeadlineNEQ applies the NEQ predicate on the "response_deadline" field.
+func ResponseDeadlineNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResponseDeadline, v))
+}
+
+// ResponseDeadlineIn applies the In predicate on the "response_deadline" field.
+func ResponseDeadlineIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResponseDeadline, vs...))
+}
+
+// ResponseDeadlineNotIn applies the NotIn predicate on the "response_deadline" field.
+func ResponseDeadlineNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResponseDeadline, vs...))
+}
+
+// ResponseDeadlineGT applies the GT predicate on the "response_deadline" field.
+func ResponseDeadlineGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResponseDeadline, v))
+}
+
+// ResponseDeadlineGTE applies the GTE predicate on the "response_deadline" field.
+func ResponseDeadlineGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResponseDeadline, v))
+}
+
+// ResponseDeadlineLT applies the LT predicate on the "response_deadline" field.
+func ResponseDeadlineLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResponseDeadline, v))
+}
+
+// ResponseDeadlineLTE applies the LTE predicate on the "response_deadline" field.
+func ResponseDeadlineLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResponseDeadline, v))
+}
+
+// ResolutionDeadlineEQ applies the EQ predicate on the "resolution_deadline" field.
+func ResolutionDeadlineEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolutionDeadline, v))
+}
+
+// ResolutionDeadlineNEQ applies the NEQ predicate on the "resolution_deadline" field.
+func ResolutionDeadlineNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolutionDeadline, v))
+}
+
+// ResolutionDeadlineIn applies the In predicate on the "resolution_deadline" field.
+func ResolutionDeadlineIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolutionDeadline, vs...))
+}
+
+// ResolutionDeadlineNotIn applies the NotIn predicate on the "resolution_deadline" field.
+func ResolutionDeadlineNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolutionDeadline, vs...))
+}
+
+// ResolutionDeadlineGT applies the GT predicate on the "resolution_deadline" field.
+func ResolutionDeadlineGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResolutionDeadline, v))
+}
+
+// ResolutionDeadlineGTE applies the GTE predicate on the "resolution_deadline" field.
+func ResolutionDeadlineGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResolutionDeadline, v))
+}
+
+// ResolutionDeadlineLT applies the LT predicate on the "resolution_deadline" field.
+func ResolutionDeadlineLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResolutionDeadline, v))
+}
+
+// ResolutionDeadlineLTE applies the LTE predicate on the "resolution_deadline" field.
+func ResolutionDeadlineLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResolutionDeadline, v))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v Resolution) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v Resolution) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...Resolution) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...Resolution) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// ResolutionIsNil applies the IsNil predicate on the "resolution" field.
+func ResolutionIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldResolution))
+}
+
+// ResolutionNotNil applies the NotNil predicate on the "resolution" field.
+func ResolutionNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldResolution))
+}
+
+// ResolutionNoteEQ applies the EQ predicate on the "resolution_note" field.
+func ResolutionNoteEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolutionNote, v))
+}
+
+// ResolutionNoteNEQ applies the NEQ predicate on the "resolution_note" field.
+func ResolutionNoteNEQ(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolutionNote, v))
+}
+
+// ResolutionNoteIn applies the In predicate on the "resolution_note" field.
+func ResolutionNoteIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolutionNote, vs...))
+}
+
+// ResolutionNoteNotIn applies the NotIn predicate on the "resolution_note" field.
+func ResolutionNoteNotIn(vs ...string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolutionNote, vs...))
+}
+
+// ResolutionNoteGT applies the GT predicate on the "resolution_note" field.
+func ResolutionNoteGT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResolutionNote, v))
+}
+
+// ResolutionNoteGTE applies the GTE predicate on the "resolution_note" field.
+func ResolutionNoteGTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResolutionNote, v))
+}
+
+// ResolutionNoteLT applies the LT predicate on the "resolution_note" field.
+func ResolutionNoteLT(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResolutionNote, v))
+}
+
+// ResolutionNoteLTE applies the LTE predicate on the "resolution_note" field.
+func ResolutionNoteLTE(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResolutionNote, v))
+}
+
+// ResolutionNoteContains applies the Contains predicate on the "resolution_note" field.
+func ResolutionNoteContains(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContains(FieldResolutionNote, v))
+}
+
+// ResolutionNoteHasPrefix applies the HasPrefix predicate on the "resolution_note" field.
+func ResolutionNoteHasPrefix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasPrefix(FieldResolutionNote, v))
+}
+
+// ResolutionNoteHasSuffix applies the HasSuffix predicate on the "resolution_note" field.
+func ResolutionNoteHasSuffix(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldHasSuffix(FieldResolutionNote, v))
+}
+
+// ResolutionNoteIsNil applies the IsNil predicate on the "resolution_note" field.
+func ResolutionNoteIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldResolutionNote))
+}
+
+// ResolutionNoteNotNil applies the NotNil predicate on the "resolution_note" field.
+func ResolutionNoteNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldResolutionNote))
+}
+
+// ResolutionNoteEqualFold applies the EqualFold predicate on the "resolution_note" field.
+func ResolutionNoteEqualFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEqualFold(FieldResolutionNote, v))
+}
+
+// ResolutionNoteContainsFold applies the ContainsFold predicate on the "resolution_note" field.
+func ResolutionNoteContainsFold(v string) predicate.Dispute {
+	return predicate.Dispute(sql.FieldContainsFold(FieldResolutionNote, v))
+}
+
+// TrustScoreAdjustmentEQ applies the EQ predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentEQ(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldTrustScoreAdjustment, v))
+}
+
+// TrustScoreAdjustmentNEQ applies the NEQ predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentNEQ(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldTrustScoreAdjustment, v))
+}
+
+// TrustScoreAdjustmentIn applies the In predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentIn(vs ...decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldTrustScoreAdjustment, vs...))
+}
+
+// TrustScoreAdjustmentNotIn applies the NotIn predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentNotIn(vs ...decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldTrustScoreAdjustment, vs...))
+}
+
+// TrustScoreAdjustmentGT applies the GT predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentGT(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldTrustScoreAdjustment, v))
+}
+
+// TrustScoreAdjustmentGTE applies the GTE predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentGTE(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldTrustScoreAdjustment, v))
+}
+
+// TrustScoreAdjustmentLT applies the LT predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentLT(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldTrustScoreAdjustment, v))
+}
+
+// TrustScoreAdjustmentLTE applies the LTE predicate on the "trust_score_adjustment" field.
+func TrustScoreAdjustmentLTE(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldTrustScoreAdjustment, v))
+}
+
+// CompensationAmountEQ applies the EQ predicate on the "compensation_amount" field.
+func CompensationAmountEQ(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCompensationAmount, v))
+}
+
+// CompensationAmountNEQ applies the NEQ predicate on the "compensation_amount" field.
+func CompensationAmountNEQ(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldCompensationAmount, v))
+}
+
+// CompensationAmountIn applies the In predicate on the "compensation_amount" field.
+func CompensationAmountIn(vs ...decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldCompensationAmount, vs...))
+}
+
+// CompensationAmountNotIn applies the NotIn predicate on the "compensation_amount" field.
+func CompensationAmountNotIn(vs ...decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldCompensationAmount, vs...))
+}
+
+// CompensationAmountGT applies the GT predicate on the "compensation_amount" field.
+func CompensationAmountGT(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldCompensationAmount, v))
+}
+
+// CompensationAmountGTE applies the GTE predicate on the "compensation_amount" field.
+func CompensationAmountGTE(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldCompensationAmount, v))
+}
+
+// CompensationAmountLT applies the LT predicate on the "compensation_amount" field.
+func CompensationAmountLT(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldCompensationAmount, v))
+}
+
+// CompensationAmountLTE applies the LTE predicate on the "compensation_amount" field.
+func CompensationAmountLTE(v decimal.Decimal) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldCompensationAmount, v))
+}
+
+// CompensationStatusEQ applies the EQ predicate on the "compensation_status" field.
+func CompensationStatusEQ(v CompensationStatus) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldCompensationStatus, v))
+}
+
+// CompensationStatusNEQ applies the NEQ predicate on the "compensation_status" field.
+func CompensationStatusNEQ(v CompensationStatus) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldCompensationStatus, v))
+}
+
+// CompensationStatusIn applies the In predicate on the "compensation_status" field.
+func CompensationStatusIn(vs ...CompensationStatus) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldCompensationStatus, vs...))
+}
+
+// CompensationStatusNotIn applies the NotIn predicate on the "compensation_status" field.
+func CompensationStatusNotIn(vs ...CompensationStatus) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldCompensationStatus, vs...))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.Dispute {
+	return predicate.Dispute(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.Dispute {
+	return predicate.Dispute(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasPaymentOrder applies the HasEdge predicate on the "payment_order" edge.
+func HasPaymentOrder() predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentOrderTable, PaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentOrderWith applies the HasEdge predicate on the "payment_order" edge with a given conditions (other predicates).
+func HasPaymentOrderWith(preds ...predicate.PaymentOrder) predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := newPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLockPaymentOrder applies the HasEdge predicate on the "lock_payment_order" edge.
+func HasLockPaymentOrder() predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LockPaymentOrderTable, LockPaymentOrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLockPaymentOrderWith applies the HasEdge predicate on the "lock_payment_order" edge with a given conditions (other predicates).
+func HasLockPaymentOrderWith(preds ...predicate.LockPaymentOrder) predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := newLockPaymentOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvidence applies the HasEdge predicate on the "evidence" edge.
+func HasEvidence() predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EvidenceTable, EvidenceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEvidenceWith applies the HasEdge predicate on the "evidence" edge with a given conditions (other predicates).
+func HasEvidenceWith(preds ...predicate.DisputeEvidence) predicate.Dispute {
+	return predicate.Dispute(func(s *sql.Selector) {
+		step := newEvidenceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Dispute) predicate.Dispute {
+	return predicate.Dispute(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Dispute) predicate.Dispute {
+	return predicate.Dispute(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Dispute) predicate.Dispute {
+	return predicate.Dispute(sql.NotPredicates(p))
+}
@@ -0,0 +1,1375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/shopspring/decimal"
+)
+
+// DisputeCreate is the builder for creating a Dispute entity.
+type DisputeCreate struct {
+	config
+	mutation *DisputeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DisputeCreate) SetCreatedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableCreatedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DisputeCreate) SetUpdatedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableUpdatedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetReason sets the "reason" field.
+func (dc *DisputeCreate) SetReason(s string) *DisputeCreate {
+	dc.mutation.SetReason(s)
+	return dc
+}
+
+// SetStatus sets the "status" field.
+func (dc *DisputeCreate) SetStatus(d dispute.Status) *DisputeCreate {
+	dc.mutation.SetStatus(d)
+	return dc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableStatus(d *dispute.Status) *DisputeCreate {
+	if d != nil {
+		dc.SetStatus(*d)
+	}
+	return dc
+}
+
+// SetResponseDeadline sets the "response_deadline" field.
+func (dc *DisputeCreate) SetResponseDeadline(t time.Time) *DisputeCreate {
+	dc.mutation.SetResponseDeadline(t)
+	return dc
+}
+
+// SetResolutionDeadline sets the "resolution_deadline" field.
+func (dc *DisputeCreate) SetResolutionDeadline(t time.Time) *DisputeCreate {
+	dc.mutation.SetResolutionDeadline(t)
+	return dc
+}
+
+// SetResolution sets the "resolution" field.
+func (dc *DisputeCreate) SetResolution(d dispute.Resolution) *DisputeCreate {
+	dc.mutation.SetResolution(d)
+	return dc
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableResolution(d *dispute.Resolution) *DisputeCreate {
+	if d != nil {
+		dc.SetResolution(*d)
+	}
+	return dc
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (dc *DisputeCreate) SetResolutionNote(s string) *DisputeCreate {
+	dc.mutation.SetResolutionNote(s)
+	return dc
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableResolutionNote(s *string) *DisputeCreate {
+	if s != nil {
+		dc.SetResolutionNote(*s)
+	}
+	return dc
+}
+
+// SetTrustScoreAdjustment sets the "trust_score_adjustment" field.
+func (dc *DisputeCreate) SetTrustScoreAdjustment(d decimal.Decimal) *DisputeCreate {
+	dc.mutation.SetTrustScoreAdjustment(d)
+	return dc
+}
+
+// SetCompensationAmount sets the "compensation_amount" field.
+func (dc *DisputeCreate) SetCompensationAmount(d decimal.Decimal) *DisputeCreate {
+	dc.mutation.SetCompensationAmount(d)
+	return dc
+}
+
+// SetCompensationStatus sets the "compensation_status" field.
+func (dc *DisputeCreate) SetCompensationStatus(ds dispute.CompensationStatus) *DisputeCreate {
+	dc.mutation.SetCompensationStatus(ds)
+	return dc
+}
+
+// SetNillableCompensationStatus sets the "compensation_status" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableCompensationStatus(ds *dispute.CompensationStatus) *DisputeCreate {
+	if ds != nil {
+		dc.SetCompensationStatus(*ds)
+	}
+	return dc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (dc *DisputeCreate) SetResolvedAt(t time.Time) *DisputeCreate {
+	dc.mutation.SetResolvedAt(t)
+	return dc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableResolvedAt(t *time.Time) *DisputeCreate {
+	if t != nil {
+		dc.SetResolvedAt(*t)
+	}
+	return dc
+}
+
+// SetID sets the "id" field.
+func (dc *DisputeCreate) SetID(u uuid.UUID) *DisputeCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dc *DisputeCreate) SetNillableID(u *uuid.UUID) *DisputeCreate {
+	if u != nil {
+		dc.SetID(*u)
+	}
+	return dc
+}
+
+// SetPaymentOrderID sets the "payment_order" edge to the PaymentOrder entity by ID.
+func (dc *DisputeCreate) SetPaymentOrderID(id uuid.UUID) *DisputeCreate {
+	dc.mutation.SetPaymentOrderID(id)
+	return dc
+}
+
+// SetPaymentOrder sets the "payment_order" edge to the PaymentOrder entity.
+func (dc *DisputeCreate) SetPaymentOrder(p *PaymentOrder) *DisputeCreate {
+	return dc.SetPaymentOrderID(p.ID)
+}
+
+// SetLockPaymentOrderID sets the "lock_payment_order" edge to the LockPaymentOrder entity by ID.
+func (dc *DisputeCreate) SetLockPaymentOrderID(id uuid.UUID) *DisputeCreate {
+	dc.mutation.SetLockPaymentOrderID(id)
+	return dc
+}
+
+// SetNillableLockPaymentOrderID sets the "lock_payment_order" edge to the LockPaymentOrder entity by ID if the given value is not nil.
+func (dc *DisputeCreate) SetNillableLockPaymentOrderID(id *uuid.UUID) *DisputeCreate {
+	if id != nil {
+		dc = dc.SetLockPaymentOrderID(*id)
+	}
+	return dc
+}
+
+// SetLockPaymentOrder sets the "lock_payment_order" edge to the LockPaymentOrder entity.
+func (dc *DisputeCreate) SetLockPaymentOrder(l *LockPaymentOrder) *DisputeCreate {
+	return dc.SetLockPaymentOrderID(l.ID)
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (dc *DisputeCreate) SetProviderID(id string) *DisputeCreate {
+	dc.mutation.SetProviderID(id)
+	return dc
+}
+
+// SetNillableProviderID sets the "provider" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (dc *DisputeCreate) SetNillableProviderID(id *string) *DisputeCreate {
+	if id != nil {
+		dc = dc.SetProviderID(*id)
+	}
+	return dc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (dc *DisputeCreate) SetProvider(p *ProviderProfile) *DisputeCreate {
+	return dc.SetProviderID(p.ID)
+}
+
+// AddEvidenceIDs adds the "evidence" edge to the DisputeEvidence entity by IDs.
+func (dc *DisputeCreate) AddEvidenceIDs(ids ...uuid.UUID) *DisputeCreate {
+	dc.mutation.AddEvidenceIDs(ids...)
+	return dc
+}
+
+// AddEvidence adds the "evidence" edges to the DisputeEvidence entity.
+func (dc *DisputeCreate) AddEvidence(d ...*DisputeEvidence) *DisputeCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddEvidenceIDs(ids...)
+}
+
+// Mutation returns the DisputeMutation object of the builder.
+func (dc *DisputeCreate) Mutation() *DisputeMutation {
+	return dc.mutation
+}
+
+// Save creates the Dispute in the database.
+func (dc *DisputeCreate) Save(ctx context.Context) (*Dispute, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DisputeCreate) SaveX(ctx context.Context) *Dispute {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DisputeCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DisputeCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DisputeCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := dispute.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := dispute.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		v := dispute.DefaultStatus
+		dc.mutation.SetStatus(v)
+	}
+	if _, ok := dc.mutation.CompensationStatus(); !ok {
+		v := dispute.DefaultCompensationStatus
+		dc.mutation.SetCompensationStatus(v)
+	}
+	if _, ok := dc.mutation.ID(); !ok {
+		v := dispute.DefaultID()
+		dc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DisputeCreate) check() error {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Dispute.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Dispute.updated_at"`)}
+	}
+	if _, ok := dc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Dispute.reason"`)}
+	}
+	if _, ok := dc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Dispute.status"`)}
+	}
+	if v, ok := dc.mutation.Status(); ok {
+		if err := dispute.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Dispute.status": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.ResponseDeadline(); !ok {
+		return &ValidationError{Name: "response_deadline", err: errors.New(`ent: missing required field "Dispute.response_deadline"`)}
+	}
+	if _, ok := dc.mutation.ResolutionDeadline(); !ok {
+		return &ValidationError{Name: "resolution_deadline", err: errors.New(`ent: missing required field "Dispute.resolution_deadline"`)}
+	}
+	if v, ok := dc.mutation.Resolution(); ok {
+		if err := dispute.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "Dispute.resolution": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.TrustScoreAdjustment(); !ok {
+		return &ValidationError{Name: "trust_score_adjustment", err: errors.New(`ent: missing required field "Dispute.trust_score_adjustment"`)}
+	}
+	if _, ok := dc.mutation.CompensationAmount(); !ok {
+		return &ValidationError{Name: "compensation_amount", err: errors.New(`ent: missing required field "Dispute.compensation_amount"`)}
+	}
+	if _, ok := dc.mutation.CompensationStatus(); !ok {
+		return &ValidationError{Name: "compensation_status", err: errors.New(`ent: missing required field "Dispute.compensation_status"`)}
+	}
+	if v, ok := dc.mutation.CompensationStatus(); ok {
+		if err := dispute.CompensationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "compensation_status", err: fmt.Errorf(`ent: validator failed for field "Dispute.compensation_status": %w`, err)}
+		}
+	}
+	if len(dc.mutation.PaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "payment_order", err: errors.New(`ent: missing required edge "Dispute.payment_order"`)}
+	}
+	return nil
+}
+
+func (dc *DisputeCreate) sqlSave(ctx context.Context) (*Dispute, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DisputeCreate) createSpec() (*Dispute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Dispute{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(dispute.Table, sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dc.conflict
+	if id, ok := dc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(dispute.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(dispute.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dc.mutation.Reason(); ok {
+		_spec.SetField(dispute.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := dc.mutation.Status(); ok {
+		_spec.SetField(dispute.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dc.mutation.ResponseDeadline(); ok {
+		_spec.SetField(dispute.FieldResponseDeadline, field.TypeTime, value)
+		_node.ResponseDeadline = value
+	}
+	if value, ok := dc.mutation.ResolutionDeadline(); ok {
+		_spec.SetField(dispute.FieldResolutionDeadline, field.TypeTime, value)
+		_node.ResolutionDeadline = value
+	}
+	if value, ok := dc.mutation.Resolution(); ok {
+		_spec.SetField(dispute.FieldResolution, field.TypeEnum, value)
+		_node.Resolution = value
+	}
+	if value, ok := dc.mutation.ResolutionNote(); ok {
+		_spec.SetField(dispute.FieldResolutionNote, field.TypeString, value)
+		_node.ResolutionNote = value
+	}
+	if value, ok := dc.mutation.TrustScoreAdjustment(); ok {
+		_spec.SetField(dispute.FieldTrustScoreAdjustment, field.TypeFloat64, value)
+		_node.TrustScoreAdjustment = value
+	}
+	if value, ok := dc.mutation.CompensationAmount(); ok {
+		_spec.SetField(dispute.FieldCompensationAmount, field.TypeFloat64, value)
+		_node.CompensationAmount = value
+	}
+	if value, ok := dc.mutation.CompensationStatus(); ok {
+		_spec.SetField(dispute.FieldCompensationStatus, field.TypeEnum, value)
+		_node.CompensationStatus = value
+	}
+	if value, ok := dc.mutation.ResolvedAt(); ok {
+		_spec.SetField(dispute.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = value
+	}
+	if nodes := dc.mutation.PaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dispute.PaymentOrderTable,
+			Columns: []string{dispute.PaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.payment_order_disputes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.LockPaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dispute.LockPaymentOrderTable,
+			Columns: []string{dispute.LockPaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_disputes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dispute.ProviderTable,
+			Columns: []string{dispute.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_disputes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.EvidenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Dispute.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DisputeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DisputeCreate) OnConflict(opts ...sql.ConflictOption) *DisputeUpsertOne {
+	dc.conflict = opts
+	return &DisputeUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DisputeCreate) OnConflictColumns(columns ...string) *DisputeUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DisputeUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DisputeUpsertOne is the builder for "upsert"-ing
+	//  one Dispute node.
+	DisputeUpsertOne struct {
+		create *DisputeCreate
+	}
+
+	// DisputeUpsert is the "OnConflict" setter.
+	DisputeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeUpsert) SetUpdatedAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateUpdatedAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldUpdatedAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *DisputeUpsert) SetReason(v string) *DisputeUpsert {
+	u.Set(dispute.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateReason() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldReason)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DisputeUpsert) SetStatus(v dispute.Status) *DisputeUpsert {
+	u.Set(dispute.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateStatus() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldStatus)
+	return u
+}
+
+// SetResponseDeadline sets the "response_deadline" field.
+func (u *DisputeUpsert) SetResponseDeadline(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldResponseDeadline, v)
+	return u
+}
+
+// UpdateResponseDeadline sets the "response_deadline" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResponseDeadline() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResponseDeadline)
+	return u
+}
+
+// SetResolutionDeadline sets the "resolution_deadline" field.
+func (u *DisputeUpsert) SetResolutionDeadline(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldResolutionDeadline, v)
+	return u
+}
+
+// UpdateResolutionDeadline sets the "resolution_deadline" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolutionDeadline() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolutionDeadline)
+	return u
+}
+
+// SetResolution sets the "resolution" field.
+func (u *DisputeUpsert) SetResolution(v dispute.Resolution) *DisputeUpsert {
+	u.Set(dispute.FieldResolution, v)
+	return u
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolution() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolution)
+	return u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *DisputeUpsert) ClearResolution() *DisputeUpsert {
+	u.SetNull(dispute.FieldResolution)
+	return u
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *DisputeUpsert) SetResolutionNote(v string) *DisputeUpsert {
+	u.Set(dispute.FieldResolutionNote, v)
+	return u
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolutionNote() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolutionNote)
+	return u
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (u *DisputeUpsert) ClearResolutionNote() *DisputeUpsert {
+	u.SetNull(dispute.FieldResolutionNote)
+	return u
+}
+
+// SetTrustScoreAdjustment sets the "trust_score_adjustment" field.
+func (u *DisputeUpsert) SetTrustScoreAdjustment(v decimal.Decimal) *DisputeUpsert {
+	u.Set(dispute.FieldTrustScoreAdjustment, v)
+	return u
+}
+
+// UpdateTrustScoreAdjustment sets the "trust_score_adjustment" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateTrustScoreAdjustment() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldTrustScoreAdjustment)
+	return u
+}
+
+// AddTrustScoreAdjustment adds v to the "trust_score_adjustment" field.
+func (u *DisputeUpsert) AddTrustScoreAdjustment(v decimal.Decimal) *DisputeUpsert {
+	u.Add(dispute.FieldTrustScoreAdjustment, v)
+	return u
+}
+
+// SetCompensationAmount sets the "compensation_amount" field.
+func (u *DisputeUpsert) SetCompensationAmount(v decimal.Decimal) *DisputeUpsert {
+	u.Set(dispute.FieldCompensationAmount, v)
+	return u
+}
+
+// UpdateCompensationAmount sets the "compensation_amount" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateCompensationAmount() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldCompensationAmount)
+	return u
+}
+
+// AddCompensationAmount adds v to the "compensation_amount" field.
+func (u *DisputeUpsert) AddCompensationAmount(v decimal.Decimal) *DisputeUpsert {
+	u.Add(dispute.FieldCompensationAmount, v)
+	return u
+}
+
+// SetCompensationStatus sets the "compensation_status" field.
+func (u *DisputeUpsert) SetCompensationStatus(v dispute.CompensationStatus) *DisputeUpsert {
+	u.Set(dispute.FieldCompensationStatus, v)
+	return u
+}
+
+// UpdateCompensationStatus sets the "compensation_status" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateCompensationStatus() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldCompensationStatus)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DisputeUpsert) SetResolvedAt(v time.Time) *DisputeUpsert {
+	u.Set(dispute.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DisputeUpsert) UpdateResolvedAt() *DisputeUpsert {
+	u.SetExcluded(dispute.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DisputeUpsert) ClearResolvedAt() *DisputeUpsert {
+	u.SetNull(dispute.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dispute.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DisputeUpsertOne) UpdateNewValues() *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dispute.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dispute.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DisputeUpsertOne) Ignore() *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DisputeUpsertOne) DoNothing() *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DisputeCreate.OnConflict
+// documentation for more info.
+func (u *DisputeUpsertOne) Update(set func(*DisputeUpsert)) *DisputeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DisputeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeUpsertOne) SetUpdatedAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateUpdatedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DisputeUpsertOne) SetReason(v string) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateReason() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DisputeUpsertOne) SetStatus(v dispute.Status) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateStatus() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResponseDeadline sets the "response_deadline" field.
+func (u *DisputeUpsertOne) SetResponseDeadline(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResponseDeadline(v)
+	})
+}
+
+// UpdateResponseDeadline sets the "response_deadline" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResponseDeadline() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResponseDeadline()
+	})
+}
+
+// SetResolutionDeadline sets the "resolution_deadline" field.
+func (u *DisputeUpsertOne) SetResolutionDeadline(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolutionDeadline(v)
+	})
+}
+
+// UpdateResolutionDeadline sets the "resolution_deadline" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolutionDeadline() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolutionDeadline()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *DisputeUpsertOne) SetResolution(v dispute.Resolution) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolution() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *DisputeUpsertOne) ClearResolution() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolution()
+	})
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *DisputeUpsertOne) SetResolutionNote(v string) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolutionNote(v)
+	})
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolutionNote() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolutionNote()
+	})
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (u *DisputeUpsertOne) ClearResolutionNote() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolutionNote()
+	})
+}
+
+// SetTrustScoreAdjustment sets the "trust_score_adjustment" field.
+func (u *DisputeUpsertOne) SetTrustScoreAdjustment(v decimal.Decimal) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetTrustScoreAdjustment(v)
+	})
+}
+
+// AddTrustScoreAdjustment adds v to the "trust_score_adjustment" field.
+func (u *DisputeUpsertOne) AddTrustScoreAdjustment(v decimal.Decimal) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.AddTrustScoreAdjustment(v)
+	})
+}
+
+// UpdateTrustScoreAdjustment sets the "trust_score_adjustment" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateTrustScoreAdjustment() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateTrustScoreAdjustment()
+	})
+}
+
+// SetCompensationAmount sets the "compensation_amount" field.
+func (u *DisputeUpsertOne) SetCompensationAmount(v decimal.Decimal) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetCompensationAmount(v)
+	})
+}
+
+// AddCompensationAmount adds v to the "compensation_amount" field.
+func (u *DisputeUpsertOne) AddCompensationAmount(v decimal.Decimal) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.AddCompensationAmount(v)
+	})
+}
+
+// UpdateCompensationAmount sets the "compensation_amount" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateCompensationAmount() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateCompensationAmount()
+	})
+}
+
+// SetCompensationStatus sets the "compensation_status" field.
+func (u *DisputeUpsertOne) SetCompensationStatus(v dispute.CompensationStatus) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetCompensationStatus(v)
+	})
+}
+
+// UpdateCompensationStatus sets the "compensation_status" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateCompensationStatus() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateCompensationStatus()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DisputeUpsertOne) SetResolvedAt(v time.Time) *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DisputeUpsertOne) UpdateResolvedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DisputeUpsertOne) ClearResolvedAt() *DisputeUpsertOne {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DisputeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DisputeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DisputeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DisputeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DisputeUpsertOne.ID is not supported by MySQL driver. Use DisputeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DisputeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DisputeCreateBulk is the builder for creating many Dispute entities in bulk.
+type DisputeCreateBulk struct {
+	config
+	err      error
+	builders []*DisputeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Dispute entities in the database.
+func (dcb *DisputeCreateBulk) Save(ctx context.Context) ([]*Dispute, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Dispute, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DisputeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DisputeCreateBulk) SaveX(ctx context.Context) []*Dispute {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DisputeCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DisputeCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Dispute.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DisputeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DisputeCreateBulk) OnConflict(opts ...sql.ConflictOption) *DisputeUpsertBulk {
+	dcb.conflict = opts
+	return &DisputeUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DisputeCreateBulk) OnConflictColumns(columns ...string) *DisputeUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DisputeUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DisputeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Dispute nodes.
+type DisputeUpsertBulk struct {
+	create *DisputeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dispute.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DisputeUpsertBulk) UpdateNewValues() *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dispute.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dispute.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Dispute.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DisputeUpsertBulk) Ignore() *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DisputeUpsertBulk) DoNothing() *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DisputeCreateBulk.OnConflict
+// documentation for more info.
+func (u *DisputeUpsertBulk) Update(set func(*DisputeUpsert)) *DisputeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DisputeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeUpsertBulk) SetUpdatedAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateUpdatedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *DisputeUpsertBulk) SetReason(v string) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateReason() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DisputeUpsertBulk) SetStatus(v dispute.Status) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateStatus() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetResponseDeadline sets the "response_deadline" field.
+func (u *DisputeUpsertBulk) SetResponseDeadline(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResponseDeadline(v)
+	})
+}
+
+// UpdateResponseDeadline sets the "response_deadline" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResponseDeadline() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResponseDeadline()
+	})
+}
+
+// SetResolutionDeadline sets the "resolution_deadline" field.
+func (u *DisputeUpsertBulk) SetResolutionDeadline(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolutionDeadline(v)
+	})
+}
+
+// UpdateResolutionDeadline sets the "resolution_deadline" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolutionDeadline() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolutionDeadline()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *DisputeUpsertBulk) SetResolution(v dispute.Resolution) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolution() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (u *DisputeUpsertBulk) ClearResolution() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolution()
+	})
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (u *DisputeUpsertBulk) SetResolutionNote(v string) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolutionNote(v)
+	})
+}
+
+// UpdateResolutionNote sets the "resolution_note" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolutionNote() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolutionNote()
+	})
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (u *DisputeUpsertBulk) ClearResolutionNote() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolutionNote()
+	})
+}
+
+// SetTrustScoreAdjustment sets the "trust_score_adjustment" field.
+func (u *DisputeUpsertBulk) SetTrustScoreAdjustment(v decimal.Decimal) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetTrustScoreAdjustment(v)
+	})
+}
+
+// AddTrustScoreAdjustment adds v to the "trust_score_adjustment" field.
+func (u *DisputeUpsertBulk) AddTrustScoreAdjustment(v decimal.Decimal) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.AddTrustScoreAdjustment(v)
+	})
+}
+
+// UpdateTrustScoreAdjustment sets the "trust_score_adjustment" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateTrustScoreAdjustment() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateTrustScoreAdjustment()
+	})
+}
+
+// SetCompensationAmount sets the "compensation_amount" field.
+func (u *DisputeUpsertBulk) SetCompensationAmount(v decimal.Decimal) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetCompensationAmount(v)
+	})
+}
+
+// AddCompensationAmount adds v to the "compensation_amount" field.
+func (u *DisputeUpsertBulk) AddCompensationAmount(v decimal.Decimal) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.AddCompensationAmount(v)
+	})
+}
+
+// UpdateCompensationAmount sets the "compensation_amount" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateCompensationAmount() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateCompensationAmount()
+	})
+}
+
+// SetCompensationStatus sets the "compensation_status" field.
+func (u *DisputeUpsertBulk) SetCompensationStatus(v dispute.CompensationStatus) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetCompensationStatus(v)
+	})
+}
+
+// UpdateCompensationStatus sets the "compensation_status" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateCompensationStatus() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateCompensationStatus()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DisputeUpsertBulk) SetResolvedAt(v time.Time) *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DisputeUpsertBulk) UpdateResolvedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DisputeUpsertBulk) ClearResolvedAt() *DisputeUpsertBulk {
+	return u.Update(func(s *DisputeUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DisputeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DisputeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DisputeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DisputeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
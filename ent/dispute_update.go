@@ -0,0 +1,863 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// DisputeUpdate is the builder for updating Dispute entities.
+type DisputeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DisputeMutation
+}
+
+// Where appends a list predicates to the DisputeUpdate builder.
+func (du *DisputeUpdate) Where(ps ...predicate.Dispute) *DisputeUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DisputeUpdate) SetUpdatedAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetReason sets the "reason" field.
+func (du *DisputeUpdate) SetReason(s string) *DisputeUpdate {
+	du.mutation.SetReason(s)
+	return du
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableReason(s *string) *DisputeUpdate {
+	if s != nil {
+		du.SetReason(*s)
+	}
+	return du
+}
+
+// SetStatus sets the "status" field.
+func (du *DisputeUpdate) SetStatus(d dispute.Status) *DisputeUpdate {
+	du.mutation.SetStatus(d)
+	return du
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableStatus(d *dispute.Status) *DisputeUpdate {
+	if d != nil {
+		du.SetStatus(*d)
+	}
+	return du
+}
+
+// SetResponseDeadline sets the "response_deadline" field.
+func (du *DisputeUpdate) SetResponseDeadline(t time.Time) *DisputeUpdate {
+	du.mutation.SetResponseDeadline(t)
+	return du
+}
+
+// SetNillableResponseDeadline sets the "response_deadline" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResponseDeadline(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetResponseDeadline(*t)
+	}
+	return du
+}
+
+// SetResolutionDeadline sets the "resolution_deadline" field.
+func (du *DisputeUpdate) SetResolutionDeadline(t time.Time) *DisputeUpdate {
+	du.mutation.SetResolutionDeadline(t)
+	return du
+}
+
+// SetNillableResolutionDeadline sets the "resolution_deadline" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolutionDeadline(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetResolutionDeadline(*t)
+	}
+	return du
+}
+
+// SetResolution sets the "resolution" field.
+func (du *DisputeUpdate) SetResolution(d dispute.Resolution) *DisputeUpdate {
+	du.mutation.SetResolution(d)
+	return du
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolution(d *dispute.Resolution) *DisputeUpdate {
+	if d != nil {
+		du.SetResolution(*d)
+	}
+	return du
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (du *DisputeUpdate) ClearResolution() *DisputeUpdate {
+	du.mutation.ClearResolution()
+	return du
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (du *DisputeUpdate) SetResolutionNote(s string) *DisputeUpdate {
+	du.mutation.SetResolutionNote(s)
+	return du
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolutionNote(s *string) *DisputeUpdate {
+	if s != nil {
+		du.SetResolutionNote(*s)
+	}
+	return du
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (du *DisputeUpdate) ClearResolutionNote() *DisputeUpdate {
+	du.mutation.ClearResolutionNote()
+	return du
+}
+
+// SetTrustScoreAdjustment sets the "trust_score_adjustment" field.
+func (du *DisputeUpdate) SetTrustScoreAdjustment(d decimal.Decimal) *DisputeUpdate {
+	du.mutation.ResetTrustScoreAdjustment()
+	du.mutation.SetTrustScoreAdjustment(d)
+	return du
+}
+
+// SetNillableTrustScoreAdjustment sets the "trust_score_adjustment" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableTrustScoreAdjustment(d *decimal.Decimal) *DisputeUpdate {
+	if d != nil {
+		du.SetTrustScoreAdjustment(*d)
+	}
+	return du
+}
+
+// AddTrustScoreAdjustment adds d to the "trust_score_adjustment" field.
+func (du *DisputeUpdate) AddTrustScoreAdjustment(d decimal.Decimal) *DisputeUpdate {
+	du.mutation.AddTrustScoreAdjustment(d)
+	return du
+}
+
+// SetCompensationAmount sets the "compensation_amount" field.
+func (du *DisputeUpdate) SetCompensationAmount(d decimal.Decimal) *DisputeUpdate {
+	du.mutation.ResetCompensationAmount()
+	du.mutation.SetCompensationAmount(d)
+	return du
+}
+
+// SetNillableCompensationAmount sets the "compensation_amount" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableCompensationAmount(d *decimal.Decimal) *DisputeUpdate {
+	if d != nil {
+		du.SetCompensationAmount(*d)
+	}
+	return du
+}
+
+// AddCompensationAmount adds d to the "compensation_amount" field.
+func (du *DisputeUpdate) AddCompensationAmount(d decimal.Decimal) *DisputeUpdate {
+	du.mutation.AddCompensationAmount(d)
+	return du
+}
+
+// SetCompensationStatus sets the "compensation_status" field.
+func (du *DisputeUpdate) SetCompensationStatus(ds dispute.CompensationStatus) *DisputeUpdate {
+	du.mutation.SetCompensationStatus(ds)
+	return du
+}
+
+// SetNillableCompensationStatus sets the "compensation_status" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableCompensationStatus(ds *dispute.CompensationStatus) *DisputeUpdate {
+	if ds != nil {
+		du.SetCompensationStatus(*ds)
+	}
+	return du
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (du *DisputeUpdate) SetResolvedAt(t time.Time) *DisputeUpdate {
+	du.mutation.SetResolvedAt(t)
+	return du
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (du *DisputeUpdate) SetNillableResolvedAt(t *time.Time) *DisputeUpdate {
+	if t != nil {
+		du.SetResolvedAt(*t)
+	}
+	return du
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (du *DisputeUpdate) ClearResolvedAt() *DisputeUpdate {
+	du.mutation.ClearResolvedAt()
+	return du
+}
+
+// AddEvidenceIDs adds the "evidence" edge to the DisputeEvidence entity by IDs.
+func (du *DisputeUpdate) AddEvidenceIDs(ids ...uuid.UUID) *DisputeUpdate {
+	du.mutation.AddEvidenceIDs(ids...)
+	return du
+}
+
+// AddEvidence adds the "evidence" edges to the DisputeEvidence entity.
+func (du *DisputeUpdate) AddEvidence(d ...*DisputeEvidence) *DisputeUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddEvidenceIDs(ids...)
+}
+
+// Mutation returns the DisputeMutation object of the builder.
+func (du *DisputeUpdate) Mutation() *DisputeMutation {
+	return du.mutation
+}
+
+// ClearEvidence clears all "evidence" edges to the DisputeEvidence entity.
+func (du *DisputeUpdate) ClearEvidence() *DisputeUpdate {
+	du.mutation.ClearEvidence()
+	return du
+}
+
+// RemoveEvidenceIDs removes the "evidence" edge to DisputeEvidence entities by IDs.
+func (du *DisputeUpdate) RemoveEvidenceIDs(ids ...uuid.UUID) *DisputeUpdate {
+	du.mutation.RemoveEvidenceIDs(ids...)
+	return du
+}
+
+// RemoveEvidence removes "evidence" edges to DisputeEvidence entities.
+func (du *DisputeUpdate) RemoveEvidence(d ...*DisputeEvidence) *DisputeUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveEvidenceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DisputeUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DisputeUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DisputeUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DisputeUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DisputeUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := dispute.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DisputeUpdate) check() error {
+	if v, ok := du.mutation.Status(); ok {
+		if err := dispute.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Dispute.status": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Resolution(); ok {
+		if err := dispute.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "Dispute.resolution": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.CompensationStatus(); ok {
+		if err := dispute.CompensationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "compensation_status", err: fmt.Errorf(`ent: validator failed for field "Dispute.compensation_status": %w`, err)}
+		}
+	}
+	if du.mutation.PaymentOrderCleared() && len(du.mutation.PaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Dispute.payment_order"`)
+	}
+	return nil
+}
+
+func (du *DisputeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dispute.Table, dispute.Columns, sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(dispute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.Reason(); ok {
+		_spec.SetField(dispute.FieldReason, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Status(); ok {
+		_spec.SetField(dispute.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.ResponseDeadline(); ok {
+		_spec.SetField(dispute.FieldResponseDeadline, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.ResolutionDeadline(); ok {
+		_spec.SetField(dispute.FieldResolutionDeadline, field.TypeTime, value)
+	}
+	if value, ok := du.mutation.Resolution(); ok {
+		_spec.SetField(dispute.FieldResolution, field.TypeEnum, value)
+	}
+	if du.mutation.ResolutionCleared() {
+		_spec.ClearField(dispute.FieldResolution, field.TypeEnum)
+	}
+	if value, ok := du.mutation.ResolutionNote(); ok {
+		_spec.SetField(dispute.FieldResolutionNote, field.TypeString, value)
+	}
+	if du.mutation.ResolutionNoteCleared() {
+		_spec.ClearField(dispute.FieldResolutionNote, field.TypeString)
+	}
+	if value, ok := du.mutation.TrustScoreAdjustment(); ok {
+		_spec.SetField(dispute.FieldTrustScoreAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := du.mutation.AddedTrustScoreAdjustment(); ok {
+		_spec.AddField(dispute.FieldTrustScoreAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := du.mutation.CompensationAmount(); ok {
+		_spec.SetField(dispute.FieldCompensationAmount, field.TypeFloat64, value)
+	}
+	if value, ok := du.mutation.AddedCompensationAmount(); ok {
+		_spec.AddField(dispute.FieldCompensationAmount, field.TypeFloat64, value)
+	}
+	if value, ok := du.mutation.CompensationStatus(); ok {
+		_spec.SetField(dispute.FieldCompensationStatus, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.ResolvedAt(); ok {
+		_spec.SetField(dispute.FieldResolvedAt, field.TypeTime, value)
+	}
+	if du.mutation.ResolvedAtCleared() {
+		_spec.ClearField(dispute.FieldResolvedAt, field.TypeTime)
+	}
+	if du.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedEvidenceIDs(); len(nodes) > 0 && !du.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.EvidenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dispute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DisputeUpdateOne is the builder for updating a single Dispute entity.
+type DisputeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DisputeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DisputeUpdateOne) SetUpdatedAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetReason sets the "reason" field.
+func (duo *DisputeUpdateOne) SetReason(s string) *DisputeUpdateOne {
+	duo.mutation.SetReason(s)
+	return duo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableReason(s *string) *DisputeUpdateOne {
+	if s != nil {
+		duo.SetReason(*s)
+	}
+	return duo
+}
+
+// SetStatus sets the "status" field.
+func (duo *DisputeUpdateOne) SetStatus(d dispute.Status) *DisputeUpdateOne {
+	duo.mutation.SetStatus(d)
+	return duo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableStatus(d *dispute.Status) *DisputeUpdateOne {
+	if d != nil {
+		duo.SetStatus(*d)
+	}
+	return duo
+}
+
+// SetResponseDeadline sets the "response_deadline" field.
+func (duo *DisputeUpdateOne) SetResponseDeadline(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetResponseDeadline(t)
+	return duo
+}
+
+// SetNillableResponseDeadline sets the "response_deadline" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResponseDeadline(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetResponseDeadline(*t)
+	}
+	return duo
+}
+
+// SetResolutionDeadline sets the "resolution_deadline" field.
+func (duo *DisputeUpdateOne) SetResolutionDeadline(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetResolutionDeadline(t)
+	return duo
+}
+
+// SetNillableResolutionDeadline sets the "resolution_deadline" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolutionDeadline(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetResolutionDeadline(*t)
+	}
+	return duo
+}
+
+// SetResolution sets the "resolution" field.
+func (duo *DisputeUpdateOne) SetResolution(d dispute.Resolution) *DisputeUpdateOne {
+	duo.mutation.SetResolution(d)
+	return duo
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolution(d *dispute.Resolution) *DisputeUpdateOne {
+	if d != nil {
+		duo.SetResolution(*d)
+	}
+	return duo
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (duo *DisputeUpdateOne) ClearResolution() *DisputeUpdateOne {
+	duo.mutation.ClearResolution()
+	return duo
+}
+
+// SetResolutionNote sets the "resolution_note" field.
+func (duo *DisputeUpdateOne) SetResolutionNote(s string) *DisputeUpdateOne {
+	duo.mutation.SetResolutionNote(s)
+	return duo
+}
+
+// SetNillableResolutionNote sets the "resolution_note" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolutionNote(s *string) *DisputeUpdateOne {
+	if s != nil {
+		duo.SetResolutionNote(*s)
+	}
+	return duo
+}
+
+// ClearResolutionNote clears the value of the "resolution_note" field.
+func (duo *DisputeUpdateOne) ClearResolutionNote() *DisputeUpdateOne {
+	duo.mutation.ClearResolutionNote()
+	return duo
+}
+
+// SetTrustScoreAdjustment sets the "trust_score_adjustment" field.
+func (duo *DisputeUpdateOne) SetTrustScoreAdjustment(d decimal.Decimal) *DisputeUpdateOne {
+	duo.mutation.ResetTrustScoreAdjustment()
+	duo.mutation.SetTrustScoreAdjustment(d)
+	return duo
+}
+
+// SetNillableTrustScoreAdjustment sets the "trust_score_adjustment" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableTrustScoreAdjustment(d *decimal.Decimal) *DisputeUpdateOne {
+	if d != nil {
+		duo.SetTrustScoreAdjustment(*d)
+	}
+	return duo
+}
+
+// AddTrustScoreAdjustment adds d to the "trust_score_adjustment" field.
+func (duo *DisputeUpdateOne) AddTrustScoreAdjustment(d decimal.Decimal) *DisputeUpdateOne {
+	duo.mutation.AddTrustScoreAdjustment(d)
+	return duo
+}
+
+// SetCompensationAmount sets the "compensation_amount" field.
+func (duo *DisputeUpdateOne) SetCompensationAmount(d decimal.Decimal) *DisputeUpdateOne {
+	duo.mutation.ResetCompensationAmount()
+	duo.mutation.SetCompensationAmount(d)
+	return duo
+}
+
+// SetNillableCompensationAmount sets the "compensation_amount" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableCompensationAmount(d *decimal.Decimal) *DisputeUpdateOne {
+	if d != nil {
+		duo.SetCompensationAmount(*d)
+	}
+	return duo
+}
+
+// AddCompensationAmount adds d to the "compensation_amount" field.
+func (duo *DisputeUpdateOne) AddCompensationAmount(d decimal.Decimal) *DisputeUpdateOne {
+	duo.mutation.AddCompensationAmount(d)
+	return duo
+}
+
+// SetCompensationStatus sets the "compensation_status" field.
+func (duo *DisputeUpdateOne) SetCompensationStatus(ds dispute.CompensationStatus) *DisputeUpdateOne {
+	duo.mutation.SetCompensationStatus(ds)
+	return duo
+}
+
+// SetNillableCompensationStatus sets the "compensation_status" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableCompensationStatus(ds *dispute.CompensationStatus) *DisputeUpdateOne {
+	if ds != nil {
+		duo.SetCompensationStatus(*ds)
+	}
+	return duo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (duo *DisputeUpdateOne) SetResolvedAt(t time.Time) *DisputeUpdateOne {
+	duo.mutation.SetResolvedAt(t)
+	return duo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (duo *DisputeUpdateOne) SetNillableResolvedAt(t *time.Time) *DisputeUpdateOne {
+	if t != nil {
+		duo.SetResolvedAt(*t)
+	}
+	return duo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (duo *DisputeUpdateOne) ClearResolvedAt() *DisputeUpdateOne {
+	duo.mutation.ClearResolvedAt()
+	return duo
+}
+
+// AddEvidenceIDs adds the "evidence" edge to the DisputeEvidence entity by IDs.
+func (duo *DisputeUpdateOne) AddEvidenceIDs(ids ...uuid.UUID) *DisputeUpdateOne {
+	duo.mutation.AddEvidenceIDs(ids...)
+	return duo
+}
+
+// AddEvidence adds the "evidence" edges to the DisputeEvidence entity.
+func (duo *DisputeUpdateOne) AddEvidence(d ...*DisputeEvidence) *DisputeUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddEvidenceIDs(ids...)
+}
+
+// Mutation returns the DisputeMutation object of the builder.
+func (duo *DisputeUpdateOne) Mutation() *DisputeMutation {
+	return duo.mutation
+}
+
+// ClearEvidence clears all "evidence" edges to the DisputeEvidence entity.
+func (duo *DisputeUpdateOne) ClearEvidence() *DisputeUpdateOne {
+	duo.mutation.ClearEvidence()
+	return duo
+}
+
+// RemoveEvidenceIDs removes the "evidence" edge to DisputeEvidence entities by IDs.
+func (duo *DisputeUpdateOne) RemoveEvidenceIDs(ids ...uuid.UUID) *DisputeUpdateOne {
+	duo.mutation.RemoveEvidenceIDs(ids...)
+	return duo
+}
+
+// RemoveEvidence removes "evidence" edges to DisputeEvidence entities.
+func (duo *DisputeUpdateOne) RemoveEvidence(d ...*DisputeEvidence) *DisputeUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveEvidenceIDs(ids...)
+}
+
+// Where appends a list predicates to the DisputeUpdate builder.
+func (duo *DisputeUpdateOne) Where(ps ...predicate.Dispute) *DisputeUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DisputeUpdateOne) Select(field string, fields ...string) *DisputeUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Dispute entity.
+func (duo *DisputeUpdateOne) Save(ctx context.Context) (*Dispute, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DisputeUpdateOne) SaveX(ctx context.Context) *Dispute {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DisputeUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DisputeUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DisputeUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := dispute.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DisputeUpdateOne) check() error {
+	if v, ok := duo.mutation.Status(); ok {
+		if err := dispute.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Dispute.status": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Resolution(); ok {
+		if err := dispute.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "Dispute.resolution": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.CompensationStatus(); ok {
+		if err := dispute.CompensationStatusValidator(v); err != nil {
+			return &ValidationError{Name: "compensation_status", err: fmt.Errorf(`ent: validator failed for field "Dispute.compensation_status": %w`, err)}
+		}
+	}
+	if duo.mutation.PaymentOrderCleared() && len(duo.mutation.PaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Dispute.payment_order"`)
+	}
+	return nil
+}
+
+func (duo *DisputeUpdateOne) sqlSave(ctx context.Context) (_node *Dispute, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dispute.Table, dispute.Columns, sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Dispute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dispute.FieldID)
+		for _, f := range fields {
+			if !dispute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dispute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dispute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.Reason(); ok {
+		_spec.SetField(dispute.FieldReason, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Status(); ok {
+		_spec.SetField(dispute.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.ResponseDeadline(); ok {
+		_spec.SetField(dispute.FieldResponseDeadline, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.ResolutionDeadline(); ok {
+		_spec.SetField(dispute.FieldResolutionDeadline, field.TypeTime, value)
+	}
+	if value, ok := duo.mutation.Resolution(); ok {
+		_spec.SetField(dispute.FieldResolution, field.TypeEnum, value)
+	}
+	if duo.mutation.ResolutionCleared() {
+		_spec.ClearField(dispute.FieldResolution, field.TypeEnum)
+	}
+	if value, ok := duo.mutation.ResolutionNote(); ok {
+		_spec.SetField(dispute.FieldResolutionNote, field.TypeString, value)
+	}
+	if duo.mutation.ResolutionNoteCleared() {
+		_spec.ClearField(dispute.FieldResolutionNote, field.TypeString)
+	}
+	if value, ok := duo.mutation.TrustScoreAdjustment(); ok {
+		_spec.SetField(dispute.FieldTrustScoreAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := duo.mutation.AddedTrustScoreAdjustment(); ok {
+		_spec.AddField(dispute.FieldTrustScoreAdjustment, field.TypeFloat64, value)
+	}
+	if value, ok := duo.mutation.CompensationAmount(); ok {
+		_spec.SetField(dispute.FieldCompensationAmount, field.TypeFloat64, value)
+	}
+	if value, ok := duo.mutation.AddedCompensationAmount(); ok {
+		_spec.AddField(dispute.FieldCompensationAmount, field.TypeFloat64, value)
+	}
+	if value, ok := duo.mutation.CompensationStatus(); ok {
+		_spec.SetField(dispute.FieldCompensationStatus, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.ResolvedAt(); ok {
+		_spec.SetField(dispute.FieldResolvedAt, field.TypeTime, value)
+	}
+	if duo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(dispute.FieldResolvedAt, field.TypeTime)
+	}
+	if duo.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedEvidenceIDs(); len(nodes) > 0 && !duo.mutation.EvidenceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.EvidenceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dispute.EvidenceTable,
+			Columns: []string{dispute.EvidenceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Dispute{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dispute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
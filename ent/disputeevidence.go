@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+)
+
+// DisputeEvidence is the model entity for the DisputeEvidence schema.
+type DisputeEvidence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SubmittedBy holds the value of the "submitted_by" field.
+	SubmittedBy disputeevidence.SubmittedBy `json:"submitted_by,omitempty"`
+	// Type holds the value of the "type" field.
+	Type disputeevidence.Type `json:"type,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DisputeEvidenceQuery when eager-loading is set.
+	Edges            DisputeEvidenceEdges `json:"edges"`
+	dispute_evidence *uuid.UUID
+	selectValues     sql.SelectValues
+}
+
+// DisputeEvidenceEdges holds the relations/edges for other nodes in the graph.
+type DisputeEvidenceEdges struct {
+	// Dispute holds the value of the dispute edge.
+	Dispute *Dispute `json:"dispute,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DisputeOrErr returns the Dispute value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DisputeEvidenceEdges) DisputeOrErr() (*Dispute, error) {
+	if e.Dispute != nil {
+		return e.Dispute, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: dispute.Label}
+	}
+	return nil, &NotLoadedError{edge: "dispute"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DisputeEvidence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case disputeevidence.FieldSubmittedBy, disputeevidence.FieldType, disputeevidence.FieldReference, disputeevidence.FieldURL, disputeevidence.FieldNote:
+			values[i] = new(sql.NullString)
+		case disputeevidence.FieldCreatedAt, disputeevidence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case disputeevidence.FieldID:
+			values[i] = new(uuid.UUID)
+		case disputeevidence.ForeignKeys[0]: // dispute_evidence
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DisputeEvidence fields.
+func (de *DisputeEvidence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case disputeevidence.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				de.ID = *value
+			}
+		case disputeevidence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				de.CreatedAt = value.Time
+			}
+		case disputeevidence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				de.UpdatedAt = value.Time
+			}
+		case disputeevidence.FieldSubmittedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_by", values[i])
+			} else if value.Valid {
+				de.SubmittedBy = disputeevidence.SubmittedBy(value.String)
+			}
+		case disputeevidence.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				de.Type = disputeevidence.Type(value.String)
+			}
+		case disputeevidence.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				de.Reference = value.String
+			}
+		case disputeevidence.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				de.URL = value.String
+			}
+		case disputeevidence.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				de.Note = value.String
+			}
+		case disputeevidence.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dispute_evidence", values[i])
+			} else if value.Valid {
+				de.dispute_evidence = new(uuid.UUID)
+				*de.dispute_evidence = *value.S.(*uuid.UUID)
+			}
+		default:
+			de.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DisputeEvidence.
+// This includes values selected through modifiers, order, etc.
+func (de *DisputeEvidence) Value(name string) (ent.Value, error) {
+	return de.selectValues.Get(name)
+}
+
+// QueryDispute queries the "dispute" edge of the DisputeEvidence entity.
+func (de *DisputeEvidence) QueryDispute() *DisputeQuery {
+	return NewDisputeEvidenceClient(de.config).QueryDispute(de)
+}
+
+// Update returns a builder for updating this DisputeEvidence.
+// Note that you need to call DisputeEvidence.Unwrap() before calling this method if this DisputeEvidence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (de *DisputeEvidence) Update() *DisputeEvidenceUpdateOne {
+	return NewDisputeEvidenceClient(de.config).UpdateOne(de)
+}
+
+// Unwrap unwraps the DisputeEvidence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (de *DisputeEvidence) Unwrap() *DisputeEvidence {
+	_tx, ok := de.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DisputeEvidence is not a transactional entity")
+	}
+	de.config.driver = _tx.drv
+	return de
+}
+
+// String implements the fmt.Stringer.
+func (de *DisputeEvidence) String() string {
+	var builder strings.Builder
+	builder.WriteString("DisputeEvidence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", de.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(de.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(de.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_by=")
+	builder.WriteString(fmt.Sprintf("%v", de.SubmittedBy))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", de.Type))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(de.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(de.URL)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(de.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DisputeEvidences is a parsable slice of DisputeEvidence.
+type DisputeEvidences []*DisputeEvidence
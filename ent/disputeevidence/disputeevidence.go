@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package disputeevidence
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the disputeevidence type in the database.
+	Label = "dispute_evidence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSubmittedBy holds the string denoting the submitted_by field in the database.
+	FieldSubmittedBy = "submitted_by"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// EdgeDispute holds the string denoting the dispute edge name in mutations.
+	EdgeDispute = "dispute"
+	// Table holds the table name of the disputeevidence in the database.
+	Table = "dispute_evidences"
+	// DisputeTable is the table that holds the dispute relation/edge.
+	DisputeTable = "dispute_evidences"
+	// DisputeInverseTable is the table name for the Dispute entity.
+	// It exists in this package in order to avoid circular dependency with the "dispute" package.
+	DisputeInverseTable = "disputes"
+	// DisputeColumn is the table column denoting the dispute relation/edge.
+	DisputeColumn = "dispute_evidence"
+)
+
+// Columns holds all SQL columns for disputeevidence fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSubmittedBy,
+	FieldType,
+	FieldReference,
+	FieldURL,
+	FieldNote,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "dispute_evidences"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"dispute_evidence",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// SubmittedBy defines the type for the "submitted_by" enum field.
+type SubmittedBy string
+
+// SubmittedBy values.
+const (
+	SubmittedBySender   SubmittedBy = "sender"
+	SubmittedByProvider SubmittedBy = "provider"
+	SubmittedByAdmin    SubmittedBy = "admin"
+)
+
+func (sb SubmittedBy) String() string {
+	return string(sb)
+}
+
+// SubmittedByValidator is a validator for the "submitted_by" field enum values. It is called by the builders before save.
+func SubmittedByValidator(sb SubmittedBy) error {
+	switch sb {
+	case SubmittedBySender, SubmittedByProvider, SubmittedByAdmin:
+		return nil
+	default:
+		return fmt.Errorf("disputeevidence: invalid enum value for submitted_by field: %q", sb)
+	}
+}
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypePspReference  Type = "psp_reference"
+	TypeReceipt       Type = "receipt"
+	TypeBankStatement Type = "bank_statement"
+	TypeOther         Type = "other"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypePspReference, TypeReceipt, TypeBankStatement, TypeOther:
+		return nil
+	default:
+		return fmt.Errorf("disputeevidence: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the DisputeEvidence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySubmittedBy orders the results by the submitted_by field.
+func BySubmittedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedBy, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByDisputeField orders the results by dispute field.
+func ByDisputeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisputeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDisputeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisputeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DisputeTable, DisputeColumn),
+	)
+}
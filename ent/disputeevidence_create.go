@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+)
+
+// DisputeEvidenceCreate is the builder for creating a DisputeEvidence entity.
+type DisputeEvidenceCreate struct {
+	config
+	mutation *DisputeEvidenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dec *DisputeEvidenceCreate) SetCreatedAt(t time.Time) *DisputeEvidenceCreate {
+	dec.mutation.SetCreatedAt(t)
+	return dec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dec *DisputeEvidenceCreate) SetNillableCreatedAt(t *time.Time) *DisputeEvidenceCreate {
+	if t != nil {
+		dec.SetCreatedAt(*t)
+	}
+	return dec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dec *DisputeEvidenceCreate) SetUpdatedAt(t time.Time) *DisputeEvidenceCreate {
+	dec.mutation.SetUpdatedAt(t)
+	return dec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dec *DisputeEvidenceCreate) SetNillableUpdatedAt(t *time.Time) *DisputeEvidenceCreate {
+	if t != nil {
+		dec.SetUpdatedAt(*t)
+	}
+	return dec
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (dec *DisputeEvidenceCreate) SetSubmittedBy(db disputeevidence.SubmittedBy) *DisputeEvidenceCreate {
+	dec.mutation.SetSubmittedBy(db)
+	return dec
+}
+
+// SetType sets the "type" field.
+func (dec *DisputeEvidenceCreate) SetType(d disputeevidence.Type) *DisputeEvidenceCreate {
+	dec.mutation.SetType(d)
+	return dec
+}
+
+// SetReference sets the "reference" field.
+func (dec *DisputeEvidenceCreate) SetReference(s string) *DisputeEvidenceCreate {
+	dec.mutation.SetReference(s)
+	return dec
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (dec *DisputeEvidenceCreate) SetNillableReference(s *string) *DisputeEvidenceCreate {
+	if s != nil {
+		dec.SetReference(*s)
+	}
+	return dec
+}
+
+// SetURL sets the "url" field.
+func (dec *DisputeEvidenceCreate) SetURL(s string) *DisputeEvidenceCreate {
+	dec.mutation.SetURL(s)
+	return dec
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (dec *DisputeEvidenceCreate) SetNillableURL(s *string) *DisputeEvidenceCreate {
+	if s != nil {
+		dec.SetURL(*s)
+	}
+	return dec
+}
+
+// SetNote sets the "note" field.
+func (dec *DisputeEvidenceCreate) SetNote(s string) *DisputeEvidenceCreate {
+	dec.mutation.SetNote(s)
+	return dec
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (dec *DisputeEvidenceCreate) SetNillableNote(s *string) *DisputeEvidenceCreate {
+	if s != nil {
+		dec.SetNote(*s)
+	}
+	return dec
+}
+
+// SetID sets the "id" field.
+func (dec *DisputeEvidenceCreate) SetID(u uuid.UUID) *DisputeEvidenceCreate {
+	dec.mutation.SetID(u)
+	return dec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dec *DisputeEvidenceCreate) SetNillableID(u *uuid.UUID) *DisputeEvidenceCreate {
+	if u != nil {
+		dec.SetID(*u)
+	}
+	return dec
+}
+
+// SetDisputeID sets the "dispute" edge to the Dispute entity by ID.
+func (dec *DisputeEvidenceCreate) SetDisputeID(id uuid.UUID) *DisputeEvidenceCreate {
+	dec.mutation.SetDisputeID(id)
+	return dec
+}
+
+// SetDispute sets the "dispute" edge to the Dispute entity.
+func (dec *DisputeEvidenceCreate) SetDispute(d *Dispute) *DisputeEvidenceCreate {
+	return dec.SetDisputeID(d.ID)
+}
+
+// Mutation returns the DisputeEvidenceMutation object of the builder.
+func (dec *DisputeEvidenceCreate) Mutation() *DisputeEvidenceMutation {
+	return dec.mutation
+}
+
+// Save creates the DisputeEvidence in the database.
+func (dec *DisputeEvidenceCreate) Save(ctx context.Context) (*DisputeEvidence, error) {
+	dec.defaults()
+	return withHooks(ctx, dec.sqlSave, dec.mutation, dec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dec *DisputeEvidenceCreate) SaveX(ctx context.Context) *DisputeEvidence {
+	v, err := dec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dec *DisputeEvidenceCreate) Exec(ctx context.Context) error {
+	_, err := dec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dec *DisputeEvidenceCreate) ExecX(ctx context.Context) {
+	if err := dec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dec *DisputeEvidenceCreate) defaults() {
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		v := disputeevidence.DefaultCreatedAt()
+		dec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dec.mutation.UpdatedAt(); !ok {
+		v := disputeevidence.DefaultUpdatedAt()
+		dec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dec.mutation.ID(); !ok {
+		v := disputeevidence.DefaultID()
+		dec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dec *DisputeEvidenceCreate) check() error {
+	if _, ok := dec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DisputeEvidence.created_at"`)}
+	}
+	if _, ok := dec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DisputeEvidence.updated_at"`)}
+	}
+	if _, ok := dec.mutation.SubmittedBy(); !ok {
+		return &ValidationError{Name: "submitted_by", err: errors.New(`ent: missing required field "DisputeEvidence.submitted_by"`)}
+	}
+	if v, ok := dec.mutation.SubmittedBy(); ok {
+		if err := disputeevidence.SubmittedByValidator(v); err != nil {
+			return &ValidationError{Name: "submitted_by", err: fmt.Errorf(`ent: validator failed for field "DisputeEvidence.submitted_by": %w`, err)}
+		}
+	}
+	if _, ok := dec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "DisputeEvidence.type"`)}
+	}
+	if v, ok := dec.mutation.GetType(); ok {
+		if err := disputeevidence.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "DisputeEvidence.type": %w`, err)}
+		}
+	}
+	if len(dec.mutation.DisputeIDs()) == 0 {
+		return &ValidationError{Name: "dispute", err: errors.New(`ent: missing required edge "DisputeEvidence.dispute"`)}
+	}
+	return nil
+}
+
+func (dec *DisputeEvidenceCreate) sqlSave(ctx context.Context) (*DisputeEvidence, error) {
+	if err := dec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dec.mutation.id = &_node.ID
+	dec.mutation.done = true
+	return _node, nil
+}
+
+func (dec *DisputeEvidenceCreate) createSpec() (*DisputeEvidence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DisputeEvidence{config: dec.config}
+		_spec = sqlgraph.NewCreateSpec(disputeevidence.Table, sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dec.conflict
+	if id, ok := dec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dec.mutation.CreatedAt(); ok {
+		_spec.SetField(disputeevidence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dec.mutation.UpdatedAt(); ok {
+		_spec.SetField(disputeevidence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dec.mutation.SubmittedBy(); ok {
+		_spec.SetField(disputeevidence.FieldSubmittedBy, field.TypeEnum, value)
+		_node.SubmittedBy = value
+	}
+	if value, ok := dec.mutation.GetType(); ok {
+		_spec.SetField(disputeevidence.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := dec.mutation.Reference(); ok {
+		_spec.SetField(disputeevidence.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := dec.mutation.URL(); ok {
+		_spec.SetField(disputeevidence.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := dec.mutation.Note(); ok {
+		_spec.SetField(disputeevidence.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if nodes := dec.mutation.DisputeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   disputeevidence.DisputeTable,
+			Columns: []string{disputeevidence.DisputeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.dispute_evidence = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DisputeEvidence.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DisputeEvidenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dec *DisputeEvidenceCreate) OnConflict(opts ...sql.ConflictOption) *DisputeEvidenceUpsertOne {
+	dec.conflict = opts
+	return &DisputeEvidenceUpsertOne{
+		create: dec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DisputeEvidence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dec *DisputeEvidenceCreate) OnConflictColumns(columns ...string) *DisputeEvidenceUpsertOne {
+	dec.conflict = append(dec.conflict, sql.ConflictColumns(columns...))
+	return &DisputeEvidenceUpsertOne{
+		create: dec,
+	}
+}
+
+type (
+	// DisputeEvidenceUpsertOne is the builder for "upsert"-ing
+	//  one DisputeEvidence node.
+	DisputeEvidenceUpsertOne struct {
+		create *DisputeEvidenceCreate
+	}
+
+	// DisputeEvidenceUpsert is the "OnConflict" setter.
+	DisputeEvidenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeEvidenceUpsert) SetUpdatedAt(v time.Time) *DisputeEvidenceUpsert {
+	u.Set(disputeevidence.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeEvidenceUpsert) UpdateUpdatedAt() *DisputeEvidenceUpsert {
+	u.SetExcluded(disputeevidence.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DisputeEvidence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(disputeevidence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DisputeEvidenceUpsertOne) UpdateNewValues() *DisputeEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(disputeevidence.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(disputeevidence.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.SubmittedBy(); exists {
+			s.SetIgnore(disputeevidence.FieldSubmittedBy)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(disputeevidence.FieldType)
+		}
+		if _, exists := u.create.mutation.Reference(); exists {
+			s.SetIgnore(disputeevidence.FieldReference)
+		}
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(disputeevidence.FieldURL)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(disputeevidence.FieldNote)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DisputeEvidence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DisputeEvidenceUpsertOne) Ignore() *DisputeEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DisputeEvidenceUpsertOne) DoNothing() *DisputeEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DisputeEvidenceCreate.OnConflict
+// documentation for more info.
+func (u *DisputeEvidenceUpsertOne) Update(set func(*DisputeEvidenceUpsert)) *DisputeEvidenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DisputeEvidenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeEvidenceUpsertOne) SetUpdatedAt(v time.Time) *DisputeEvidenceUpsertOne {
+	return u.Update(func(s *DisputeEvidenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeEvidenceUpsertOne) UpdateUpdatedAt() *DisputeEvidenceUpsertOne {
+	return u.Update(func(s *DisputeEvidenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DisputeEvidenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DisputeEvidenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DisputeEvidenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DisputeEvidenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DisputeEvidenceUpsertOne.ID is not supported by MySQL driver. Use DisputeEvidenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DisputeEvidenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DisputeEvidenceCreateBulk is the builder for creating many DisputeEvidence entities in bulk.
+type DisputeEvidenceCreateBulk struct {
+	config
+	err      error
+	builders []*DisputeEvidenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DisputeEvidence entities in the database.
+func (decb *DisputeEvidenceCreateBulk) Save(ctx context.Context) ([]*DisputeEvidence, error) {
+	if decb.err != nil {
+		return nil, decb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(decb.builders))
+	nodes := make([]*DisputeEvidence, len(decb.builders))
+	mutators := make([]Mutator, len(decb.builders))
+	for i := range decb.builders {
+		func(i int, root context.Context) {
+			builder := decb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DisputeEvidenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, decb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = decb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, decb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, decb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (decb *DisputeEvidenceCreateBulk) SaveX(ctx context.Context) []*DisputeEvidence {
+	v, err := decb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (decb *DisputeEvidenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := decb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (decb *DisputeEvidenceCreateBulk) ExecX(ctx context.Context) {
+	if err := decb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DisputeEvidence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DisputeEvidenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (decb *DisputeEvidenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *DisputeEvidenceUpsertBulk {
+	decb.conflict = opts
+	return &DisputeEvidenceUpsertBulk{
+		create: decb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DisputeEvidence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (decb *DisputeEvidenceCreateBulk) OnConflictColumns(columns ...string) *DisputeEvidenceUpsertBulk {
+	decb.conflict = append(decb.conflict, sql.ConflictColumns(columns...))
+	return &DisputeEvidenceUpsertBulk{
+		create: decb,
+	}
+}
+
+// DisputeEvidenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of DisputeEvidence nodes.
+type DisputeEvidenceUpsertBulk struct {
+	create *DisputeEvidenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DisputeEvidence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(disputeevidence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DisputeEvidenceUpsertBulk) UpdateNewValues() *DisputeEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(disputeevidence.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(disputeevidence.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.SubmittedBy(); exists {
+				s.SetIgnore(disputeevidence.FieldSubmittedBy)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(disputeevidence.FieldType)
+			}
+			if _, exists := b.mutation.Reference(); exists {
+				s.SetIgnore(disputeevidence.FieldReference)
+			}
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(disputeevidence.FieldURL)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(disputeevidence.FieldNote)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DisputeEvidence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DisputeEvidenceUpsertBulk) Ignore() *DisputeEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DisputeEvidenceUpsertBulk) DoNothing() *DisputeEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DisputeEvidenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *DisputeEvidenceUpsertBulk) Update(set func(*DisputeEvidenceUpsert)) *DisputeEvidenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DisputeEvidenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DisputeEvidenceUpsertBulk) SetUpdatedAt(v time.Time) *DisputeEvidenceUpsertBulk {
+	return u.Update(func(s *DisputeEvidenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DisputeEvidenceUpsertBulk) UpdateUpdatedAt() *DisputeEvidenceUpsertBulk {
+	return u.Update(func(s *DisputeEvidenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DisputeEvidenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DisputeEvidenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DisputeEvidenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DisputeEvidenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
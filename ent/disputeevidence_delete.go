@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DisputeEvidenceDelete is the builder for deleting a DisputeEvidence entity.
+type DisputeEvidenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *DisputeEvidenceMutation
+}
+
+// Where appends a list predicates to the DisputeEvidenceDelete builder.
+func (ded *DisputeEvidenceDelete) Where(ps ...predicate.DisputeEvidence) *DisputeEvidenceDelete {
+	ded.mutation.Where(ps...)
+	return ded
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ded *DisputeEvidenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ded.sqlExec, ded.mutation, ded.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ded *DisputeEvidenceDelete) ExecX(ctx context.Context) int {
+	n, err := ded.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ded *DisputeEvidenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(disputeevidence.Table, sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID))
+	if ps := ded.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ded.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ded.mutation.done = true
+	return affected, err
+}
+
+// DisputeEvidenceDeleteOne is the builder for deleting a single DisputeEvidence entity.
+type DisputeEvidenceDeleteOne struct {
+	ded *DisputeEvidenceDelete
+}
+
+// Where appends a list predicates to the DisputeEvidenceDelete builder.
+func (dedo *DisputeEvidenceDeleteOne) Where(ps ...predicate.DisputeEvidence) *DisputeEvidenceDeleteOne {
+	dedo.ded.mutation.Where(ps...)
+	return dedo
+}
+
+// Exec executes the deletion query.
+func (dedo *DisputeEvidenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := dedo.ded.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{disputeevidence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dedo *DisputeEvidenceDeleteOne) ExecX(ctx context.Context) {
+	if err := dedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
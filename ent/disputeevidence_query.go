@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DisputeEvidenceQuery is the builder for querying DisputeEvidence entities.
+type DisputeEvidenceQuery struct {
+	config
+	ctx         *QueryContext
+	order       []disputeevidence.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.DisputeEvidence
+	withDispute *DisputeQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DisputeEvidenceQuery builder.
+func (deq *DisputeEvidenceQuery) Where(ps ...predicate.DisputeEvidence) *DisputeEvidenceQuery {
+	deq.predicates = append(deq.predicates, ps...)
+	return deq
+}
+
+// Limit the number of records to be returned by this query.
+func (deq *DisputeEvidenceQuery) Limit(limit int) *DisputeEvidenceQuery {
+	deq.ctx.Limit = &limit
+	return deq
+}
+
+// Offset to start from.
+func (deq *DisputeEvidenceQuery) Offset(offset int) *DisputeEvidenceQuery {
+	deq.ctx.Offset = &offset
+	return deq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (deq *DisputeEvidenceQuery) Unique(unique bool) *DisputeEvidenceQuery {
+	deq.ctx.Unique = &unique
+	return deq
+}
+
+// Order specifies how the records should be ordered.
+func (deq *DisputeEvidenceQuery) Order(o ...disputeevidence.OrderOption) *DisputeEvidenceQuery {
+	deq.order = append(deq.order, o...)
+	return deq
+}
+
+// QueryDispute chains the current query on the "dispute" edge.
+func (deq *DisputeEvidenceQuery) QueryDispute() *DisputeQuery {
+	query := (&DisputeClient{config: deq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := deq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := deq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(disputeevidence.Table, disputeevidence.FieldID, selector),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, disputeevidence.DisputeTable, disputeevidence.DisputeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(deq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DisputeEvidence entity from the query.
+// Returns a *NotFoundError when no DisputeEvidence was found.
+func (deq *DisputeEvidenceQuery) First(ctx context.Context) (*DisputeEvidence, error) {
+	nodes, err := deq.Limit(1).All(setContextOp(ctx, deq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{disputeevidence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) FirstX(ctx context.Context) *DisputeEvidence {
+	node, err := deq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DisputeEvidence ID from the query.
+// Returns a *NotFoundError when no DisputeEvidence ID was found.
+func (deq *DisputeEvidenceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = deq.Limit(1).IDs(setContextOp(ctx, deq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{disputeevidence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := deq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DisputeEvidence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DisputeEvidence entity is found.
+// Returns a *NotFoundError when no DisputeEvidence entities are found.
+func (deq *DisputeEvidenceQuery) Only(ctx context.Context) (*DisputeEvidence, error) {
+	nodes, err := deq.Limit(2).All(setContextOp(ctx, deq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{disputeevidence.Label}
+	default:
+		return nil, &NotSingularError{disputeevidence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) OnlyX(ctx context.Context) *DisputeEvidence {
+	node, err := deq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DisputeEvidence ID in the query.
+// Returns a *NotSingularError when more than one DisputeEvidence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (deq *DisputeEvidenceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = deq.Limit(2).IDs(setContextOp(ctx, deq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{disputeevidence.Label}
+	default:
+		err = &NotSingularError{disputeevidence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := deq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DisputeEvidences.
+func (deq *DisputeEvidenceQuery) All(ctx context.Context) ([]*DisputeEvidence, error) {
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryAll)
+	if err := deq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DisputeEvidence, *DisputeEvidenceQuery]()
+	return withInterceptors[[]*DisputeEvidence](ctx, deq, qr, deq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) AllX(ctx context.Context) []*DisputeEvidence {
+	nodes, err := deq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DisputeEvidence IDs.
+func (deq *DisputeEvidenceQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if deq.ctx.Unique == nil && deq.path != nil {
+		deq.Unique(true)
+	}
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryIDs)
+	if err = deq.Select(disputeevidence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := deq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (deq *DisputeEvidenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryCount)
+	if err := deq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, deq, querierCount[*DisputeEvidenceQuery](), deq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) CountX(ctx context.Context) int {
+	count, err := deq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (deq *DisputeEvidenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, deq.ctx, ent.OpQueryExist)
+	switch _, err := deq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (deq *DisputeEvidenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := deq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DisputeEvidenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (deq *DisputeEvidenceQuery) Clone() *DisputeEvidenceQuery {
+	if deq == nil {
+		return nil
+	}
+	return &DisputeEvidenceQuery{
+		config:      deq.config,
+		ctx:         deq.ctx.Clone(),
+		order:       append([]disputeevidence.OrderOption{}, deq.order...),
+		inters:      append([]Interceptor{}, deq.inters...),
+		predicates:  append([]predicate.DisputeEvidence{}, deq.predicates...),
+		withDispute: deq.withDispute.Clone(),
+		// clone intermediate query.
+		sql:  deq.sql.Clone(),
+		path: deq.path,
+	}
+}
+
+// WithDispute tells the query-builder to eager-load the nodes that are connected to
+// the "dispute" edge. The optional arguments are used to configure the query builder of the edge.
+func (deq *DisputeEvidenceQuery) WithDispute(opts ...func(*DisputeQuery)) *DisputeEvidenceQuery {
+	query := (&DisputeClient{config: deq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	deq.withDispute = query
+	return deq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DisputeEvidence.Query().
+//		GroupBy(disputeevidence.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (deq *DisputeEvidenceQuery) GroupBy(field string, fields ...string) *DisputeEvidenceGroupBy {
+	deq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DisputeEvidenceGroupBy{build: deq}
+	grbuild.flds = &deq.ctx.Fields
+	grbuild.label = disputeevidence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DisputeEvidence.Query().
+//		Select(disputeevidence.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (deq *DisputeEvidenceQuery) Select(fields ...string) *DisputeEvidenceSelect {
+	deq.ctx.Fields = append(deq.ctx.Fields, fields...)
+	sbuild := &DisputeEvidenceSelect{DisputeEvidenceQuery: deq}
+	sbuild.label = disputeevidence.Label
+	sbuild.flds, sbuild.scan = &deq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DisputeEvidenceSelect configured with the given aggregations.
+func (deq *DisputeEvidenceQuery) Aggregate(fns ...AggregateFunc) *DisputeEvidenceSelect {
+	return deq.Select().Aggregate(fns...)
+}
+
+func (deq *DisputeEvidenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range deq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, deq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range deq.ctx.Fields {
+		if !disputeevidence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if deq.path != nil {
+		prev, err := deq.path(ctx)
+		if err != nil {
+			return err
+		}
+		deq.sql = prev
+	}
+	return nil
+}
+
+func (deq *DisputeEvidenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DisputeEvidence, error) {
+	var (
+		nodes       = []*DisputeEvidence{}
+		withFKs     = deq.withFKs
+		_spec       = deq.querySpec()
+		loadedTypes = [1]bool{
+			deq.withDispute != nil,
+		}
+	)
+	if deq.withDispute != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, disputeevidence.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DisputeEvidence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DisputeEvidence{config: deq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, deq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := deq.withDispute; query != nil {
+		if err := deq.loadDispute(ctx, query, nodes, nil,
+			func(n *DisputeEvidence, e *Dispute) { n.Edges.Dispute = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (deq *DisputeEvidenceQuery) loadDispute(ctx context.Context, query *DisputeQuery, nodes []*DisputeEvidence, init func(*DisputeEvidence), assign func(*DisputeEvidence, *Dispute)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DisputeEvidence)
+	for i := range nodes {
+		if nodes[i].dispute_evidence == nil {
+			continue
+		}
+		fk := *nodes[i].dispute_evidence
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(dispute.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "dispute_evidence" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (deq *DisputeEvidenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := deq.querySpec()
+	_spec.Node.Columns = deq.ctx.Fields
+	if len(deq.ctx.Fields) > 0 {
+		_spec.Unique = deq.ctx.Unique != nil && *deq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, deq.driver, _spec)
+}
+
+func (deq *DisputeEvidenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(disputeevidence.Table, disputeevidence.Columns, sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID))
+	_spec.From = deq.sql
+	if unique := deq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if deq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := deq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, disputeevidence.FieldID)
+		for i := range fields {
+			if fields[i] != disputeevidence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := deq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := deq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := deq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := deq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (deq *DisputeEvidenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(deq.driver.Dialect())
+	t1 := builder.Table(disputeevidence.Table)
+	columns := deq.ctx.Fields
+	if len(columns) == 0 {
+		columns = disputeevidence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if deq.sql != nil {
+		selector = deq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if deq.ctx.Unique != nil && *deq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range deq.predicates {
+		p(selector)
+	}
+	for _, p := range deq.order {
+		p(selector)
+	}
+	if offset := deq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := deq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DisputeEvidenceGroupBy is the group-by builder for DisputeEvidence entities.
+type DisputeEvidenceGroupBy struct {
+	selector
+	build *DisputeEvidenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (degb *DisputeEvidenceGroupBy) Aggregate(fns ...AggregateFunc) *DisputeEvidenceGroupBy {
+	degb.fns = append(degb.fns, fns...)
+	return degb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (degb *DisputeEvidenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, degb.build.ctx, ent.OpQueryGroupBy)
+	if err := degb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DisputeEvidenceQuery, *DisputeEvidenceGroupBy](ctx, degb.build, degb, degb.build.inters, v)
+}
+
+func (degb *DisputeEvidenceGroupBy) sqlScan(ctx context.Context, root *DisputeEvidenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(degb.fns))
+	for _, fn := range degb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*degb.flds)+len(degb.fns))
+		for _, f := range *degb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*degb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := degb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DisputeEvidenceSelect is the builder for selecting fields of DisputeEvidence entities.
+type DisputeEvidenceSelect struct {
+	*DisputeEvidenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (des *DisputeEvidenceSelect) Aggregate(fns ...AggregateFunc) *DisputeEvidenceSelect {
+	des.fns = append(des.fns, fns...)
+	return des
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (des *DisputeEvidenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, des.ctx, ent.OpQuerySelect)
+	if err := des.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DisputeEvidenceQuery, *DisputeEvidenceSelect](ctx, des.DisputeEvidenceQuery, des, des.inters, v)
+}
+
+func (des *DisputeEvidenceSelect) sqlScan(ctx context.Context, root *DisputeEvidenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(des.fns))
+	for _, fn := range des.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*des.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := des.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// DisputeEvidenceUpdate is the builder for updating DisputeEvidence entities.
+type DisputeEvidenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DisputeEvidenceMutation
+}
+
+// Where appends a list predicates to the DisputeEvidenceUpdate builder.
+func (deu *DisputeEvidenceUpdate) Where(ps ...predicate.DisputeEvidence) *DisputeEvidenceUpdate {
+	deu.mutation.Where(ps...)
+	return deu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (deu *DisputeEvidenceUpdate) SetUpdatedAt(t time.Time) *DisputeEvidenceUpdate {
+	deu.mutation.SetUpdatedAt(t)
+	return deu
+}
+
+// Mutation returns the DisputeEvidenceMutation object of the builder.
+func (deu *DisputeEvidenceUpdate) Mutation() *DisputeEvidenceMutation {
+	return deu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (deu *DisputeEvidenceUpdate) Save(ctx context.Context) (int, error) {
+	deu.defaults()
+	return withHooks(ctx, deu.sqlSave, deu.mutation, deu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deu *DisputeEvidenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := deu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (deu *DisputeEvidenceUpdate) Exec(ctx context.Context) error {
+	_, err := deu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deu *DisputeEvidenceUpdate) ExecX(ctx context.Context) {
+	if err := deu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (deu *DisputeEvidenceUpdate) defaults() {
+	if _, ok := deu.mutation.UpdatedAt(); !ok {
+		v := disputeevidence.UpdateDefaultUpdatedAt()
+		deu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deu *DisputeEvidenceUpdate) check() error {
+	if deu.mutation.DisputeCleared() && len(deu.mutation.DisputeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DisputeEvidence.dispute"`)
+	}
+	return nil
+}
+
+func (deu *DisputeEvidenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := deu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(disputeevidence.Table, disputeevidence.Columns, sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID))
+	if ps := deu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deu.mutation.UpdatedAt(); ok {
+		_spec.SetField(disputeevidence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if deu.mutation.ReferenceCleared() {
+		_spec.ClearField(disputeevidence.FieldReference, field.TypeString)
+	}
+	if deu.mutation.URLCleared() {
+		_spec.ClearField(disputeevidence.FieldURL, field.TypeString)
+	}
+	if deu.mutation.NoteCleared() {
+		_spec.ClearField(disputeevidence.FieldNote, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, deu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{disputeevidence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	deu.mutation.done = true
+	return n, nil
+}
+
+// DisputeEvidenceUpdateOne is the builder for updating a single DisputeEvidence entity.
+type DisputeEvidenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DisputeEvidenceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (deuo *DisputeEvidenceUpdateOne) SetUpdatedAt(t time.Time) *DisputeEvidenceUpdateOne {
+	deuo.mutation.SetUpdatedAt(t)
+	return deuo
+}
+
+// Mutation returns the DisputeEvidenceMutation object of the builder.
+func (deuo *DisputeEvidenceUpdateOne) Mutation() *DisputeEvidenceMutation {
+	return deuo.mutation
+}
+
+// Where appends a list predicates to the DisputeEvidenceUpdate builder.
+func (deuo *DisputeEvidenceUpdateOne) Where(ps ...predicate.DisputeEvidence) *DisputeEvidenceUpdateOne {
+	deuo.mutation.Where(ps...)
+	return deuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (deuo *DisputeEvidenceUpdateOne) Select(field string, fields ...string) *DisputeEvidenceUpdateOne {
+	deuo.fields = append([]string{field}, fields...)
+	return deuo
+}
+
+// Save executes the query and returns the updated DisputeEvidence entity.
+func (deuo *DisputeEvidenceUpdateOne) Save(ctx context.Context) (*DisputeEvidence, error) {
+	deuo.defaults()
+	return withHooks(ctx, deuo.sqlSave, deuo.mutation, deuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deuo *DisputeEvidenceUpdateOne) SaveX(ctx context.Context) *DisputeEvidence {
+	node, err := deuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (deuo *DisputeEvidenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := deuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deuo *DisputeEvidenceUpdateOne) ExecX(ctx context.Context) {
+	if err := deuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (deuo *DisputeEvidenceUpdateOne) defaults() {
+	if _, ok := deuo.mutation.UpdatedAt(); !ok {
+		v := disputeevidence.UpdateDefaultUpdatedAt()
+		deuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (deuo *DisputeEvidenceUpdateOne) check() error {
+	if deuo.mutation.DisputeCleared() && len(deuo.mutation.DisputeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DisputeEvidence.dispute"`)
+	}
+	return nil
+}
+
+func (deuo *DisputeEvidenceUpdateOne) sqlSave(ctx context.Context) (_node *DisputeEvidence, err error) {
+	if err := deuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(disputeevidence.Table, disputeevidence.Columns, sqlgraph.NewFieldSpec(disputeevidence.FieldID, field.TypeUUID))
+	id, ok := deuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DisputeEvidence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := deuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, disputeevidence.FieldID)
+		for _, f := range fields {
+			if !disputeevidence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != disputeevidence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := deuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(disputeevidence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if deuo.mutation.ReferenceCleared() {
+		_spec.ClearField(disputeevidence.FieldReference, field.TypeString)
+	}
+	if deuo.mutation.URLCleared() {
+		_spec.ClearField(disputeevidence.FieldURL, field.TypeString)
+	}
+	if deuo.mutation.NoteCleared() {
+		_spec.ClearField(disputeevidence.FieldNote, field.TypeString)
+	}
+	_node = &DisputeEvidence{config: deuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, deuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{disputeevidence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	deuo.mutation.done = true
+	return _node, nil
+}
@@ -13,6 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
 	"github.com/paycrest/aggregator/ent/institution"
@@ -20,16 +24,26 @@ import (
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/ent/verificationtoken"
@@ -95,6 +109,10 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			apikey.Table:                      apikey.ValidColumn,
+			bucketproposal.Table:              bucketproposal.ValidColumn,
+			deadletterorder.Table:             deadletterorder.ValidColumn,
+			dispute.Table:                     dispute.ValidColumn,
+			disputeevidence.Table:             disputeevidence.ValidColumn,
 			fiatcurrency.Table:                fiatcurrency.ValidColumn,
 			identityverificationrequest.Table: identityverificationrequest.ValidColumn,
 			institution.Table:                 institution.ValidColumn,
@@ -102,16 +120,26 @@ func checkColumn(table, column string) error {
 			lockorderfulfillment.Table:        lockorderfulfillment.ValidColumn,
 			lockpaymentorder.Table:            lockpaymentorder.ValidColumn,
 			network.Table:                     network.ValidColumn,
+			orderassignment.Table:             orderassignment.ValidColumn,
 			paymentorder.Table:                paymentorder.ValidColumn,
 			paymentorderrecipient.Table:       paymentorderrecipient.ValidColumn,
+			providerhealthcheck.Table:         providerhealthcheck.ValidColumn,
 			providerordertoken.Table:          providerordertoken.ValidColumn,
 			providerprofile.Table:             providerprofile.ValidColumn,
 			providerrating.Table:              providerrating.ValidColumn,
+			providerslarecord.Table:           providerslarecord.ValidColumn,
 			provisionbucket.Table:             provisionbucket.ValidColumn,
+			publicholiday.Table:               publicholiday.ValidColumn,
+			ratecircuitbreaker.Table:          ratecircuitbreaker.ValidColumn,
+			ratesnapshot.Table:                ratesnapshot.ValidColumn,
 			receiveaddress.Table:              receiveaddress.ValidColumn,
 			senderordertoken.Table:            senderordertoken.ValidColumn,
 			senderprofile.Table:               senderprofile.ValidColumn,
+			teamauditlog.Table:                teamauditlog.ValidColumn,
+			teaminvitation.Table:              teaminvitation.ValidColumn,
+			teammember.Table:                  teammember.ValidColumn,
 			token.Table:                       token.ValidColumn,
+			tokenmarketrate.Table:             tokenmarketrate.ValidColumn,
 			transactionlog.Table:              transactionlog.ValidColumn,
 			user.Table:                        user.ValidColumn,
 			verificationtoken.Table:           verificationtoken.ValidColumn,
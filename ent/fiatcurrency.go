@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
 	"github.com/shopspring/decimal"
 )
 
@@ -37,6 +38,12 @@ type FiatCurrency struct {
 	MarketRate decimal.Decimal `json:"market_rate,omitempty"`
 	// IsEnabled holds the value of the "is_enabled" field.
 	IsEnabled bool `json:"is_enabled,omitempty"`
+	// FulfillmentValidation holds the value of the "fulfillment_validation" field.
+	FulfillmentValidation fiatcurrency.FulfillmentValidation `json:"fulfillment_validation,omitempty"`
+	// MatchingStrategy holds the value of the "matching_strategy" field.
+	MatchingStrategy fiatcurrency.MatchingStrategy `json:"matching_strategy,omitempty"`
+	// OrderRequestMode holds the value of the "order_request_mode" field.
+	OrderRequestMode fiatcurrency.OrderRequestMode `json:"order_request_mode,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FiatCurrencyQuery when eager-loading is set.
 	Edges        FiatCurrencyEdges `json:"edges"`
@@ -51,9 +58,21 @@ type FiatCurrencyEdges struct {
 	ProvisionBuckets []*ProvisionBucket `json:"provision_buckets,omitempty"`
 	// Institutions holds the value of the institutions edge.
 	Institutions []*Institution `json:"institutions,omitempty"`
+	// ProviderOrderTokens holds the value of the provider_order_tokens edge.
+	ProviderOrderTokens []*ProviderOrderToken `json:"provider_order_tokens,omitempty"`
+	// PublicHolidays holds the value of the public_holidays edge.
+	PublicHolidays []*PublicHoliday `json:"public_holidays,omitempty"`
+	// BucketProposals holds the value of the bucket_proposals edge.
+	BucketProposals []*BucketProposal `json:"bucket_proposals,omitempty"`
+	// RateSnapshots holds the value of the rate_snapshots edge.
+	RateSnapshots []*RateSnapshot `json:"rate_snapshots,omitempty"`
+	// RateCircuitBreaker holds the value of the rate_circuit_breaker edge.
+	RateCircuitBreaker *RateCircuitBreaker `json:"rate_circuit_breaker,omitempty"`
+	// TokenMarketRates holds the value of the token_market_rates edge.
+	TokenMarketRates []*TokenMarketRate `json:"token_market_rates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [9]bool
 }
 
 // ProvidersOrErr returns the Providers value or an error if the edge
@@ -83,6 +102,62 @@ func (e FiatCurrencyEdges) InstitutionsOrErr() ([]*Institution, error) {
 	return nil, &NotLoadedError{edge: "institutions"}
 }
 
+// ProviderOrderTokensOrErr returns the ProviderOrderTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e FiatCurrencyEdges) ProviderOrderTokensOrErr() ([]*ProviderOrderToken, error) {
+	if e.loadedTypes[3] {
+		return e.ProviderOrderTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "provider_order_tokens"}
+}
+
+// PublicHolidaysOrErr returns the PublicHolidays value or an error if the edge
+// was not loaded in eager-loading.
+func (e FiatCurrencyEdges) PublicHolidaysOrErr() ([]*PublicHoliday, error) {
+	if e.loadedTypes[4] {
+		return e.PublicHolidays, nil
+	}
+	return nil, &NotLoadedError{edge: "public_holidays"}
+}
+
+// BucketProposalsOrErr returns the BucketProposals value or an error if the edge
+// was not loaded in eager-loading.
+func (e FiatCurrencyEdges) BucketProposalsOrErr() ([]*BucketProposal, error) {
+	if e.loadedTypes[5] {
+		return e.BucketProposals, nil
+	}
+	return nil, &NotLoadedError{edge: "bucket_proposals"}
+}
+
+// RateSnapshotsOrErr returns the RateSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e FiatCurrencyEdges) RateSnapshotsOrErr() ([]*RateSnapshot, error) {
+	if e.loadedTypes[6] {
+		return e.RateSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "rate_snapshots"}
+}
+
+// RateCircuitBreakerOrErr returns the RateCircuitBreaker value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FiatCurrencyEdges) RateCircuitBreakerOrErr() (*RateCircuitBreaker, error) {
+	if e.RateCircuitBreaker != nil {
+		return e.RateCircuitBreaker, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: ratecircuitbreaker.Label}
+	}
+	return nil, &NotLoadedError{edge: "rate_circuit_breaker"}
+}
+
+// TokenMarketRatesOrErr returns the TokenMarketRates value or an error if the edge
+// was not loaded in eager-loading.
+func (e FiatCurrencyEdges) TokenMarketRatesOrErr() ([]*TokenMarketRate, error) {
+	if e.loadedTypes[8] {
+		return e.TokenMarketRates, nil
+	}
+	return nil, &NotLoadedError{edge: "token_market_rates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*FiatCurrency) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -94,7 +169,7 @@ func (*FiatCurrency) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case fiatcurrency.FieldDecimals:
 			values[i] = new(sql.NullInt64)
-		case fiatcurrency.FieldCode, fiatcurrency.FieldShortName, fiatcurrency.FieldSymbol, fiatcurrency.FieldName:
+		case fiatcurrency.FieldCode, fiatcurrency.FieldShortName, fiatcurrency.FieldSymbol, fiatcurrency.FieldName, fiatcurrency.FieldFulfillmentValidation, fiatcurrency.FieldMatchingStrategy, fiatcurrency.FieldOrderRequestMode:
 			values[i] = new(sql.NullString)
 		case fiatcurrency.FieldCreatedAt, fiatcurrency.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -175,6 +250,24 @@ func (fc *FiatCurrency) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				fc.IsEnabled = value.Bool
 			}
+		case fiatcurrency.FieldFulfillmentValidation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfillment_validation", values[i])
+			} else if value.Valid {
+				fc.FulfillmentValidation = fiatcurrency.FulfillmentValidation(value.String)
+			}
+		case fiatcurrency.FieldMatchingStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field matching_strategy", values[i])
+			} else if value.Valid {
+				fc.MatchingStrategy = fiatcurrency.MatchingStrategy(value.String)
+			}
+		case fiatcurrency.FieldOrderRequestMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_request_mode", values[i])
+			} else if value.Valid {
+				fc.OrderRequestMode = fiatcurrency.OrderRequestMode(value.String)
+			}
 		default:
 			fc.selectValues.Set(columns[i], values[i])
 		}
@@ -203,6 +296,36 @@ func (fc *FiatCurrency) QueryInstitutions() *InstitutionQuery {
 	return NewFiatCurrencyClient(fc.config).QueryInstitutions(fc)
 }
 
+// QueryProviderOrderTokens queries the "provider_order_tokens" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryProviderOrderTokens() *ProviderOrderTokenQuery {
+	return NewFiatCurrencyClient(fc.config).QueryProviderOrderTokens(fc)
+}
+
+// QueryPublicHolidays queries the "public_holidays" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryPublicHolidays() *PublicHolidayQuery {
+	return NewFiatCurrencyClient(fc.config).QueryPublicHolidays(fc)
+}
+
+// QueryBucketProposals queries the "bucket_proposals" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryBucketProposals() *BucketProposalQuery {
+	return NewFiatCurrencyClient(fc.config).QueryBucketProposals(fc)
+}
+
+// QueryRateSnapshots queries the "rate_snapshots" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryRateSnapshots() *RateSnapshotQuery {
+	return NewFiatCurrencyClient(fc.config).QueryRateSnapshots(fc)
+}
+
+// QueryRateCircuitBreaker queries the "rate_circuit_breaker" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryRateCircuitBreaker() *RateCircuitBreakerQuery {
+	return NewFiatCurrencyClient(fc.config).QueryRateCircuitBreaker(fc)
+}
+
+// QueryTokenMarketRates queries the "token_market_rates" edge of the FiatCurrency entity.
+func (fc *FiatCurrency) QueryTokenMarketRates() *TokenMarketRateQuery {
+	return NewFiatCurrencyClient(fc.config).QueryTokenMarketRates(fc)
+}
+
 // Update returns a builder for updating this FiatCurrency.
 // Note that you need to call FiatCurrency.Unwrap() before calling this method if this FiatCurrency
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -252,6 +375,15 @@ func (fc *FiatCurrency) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_enabled=")
 	builder.WriteString(fmt.Sprintf("%v", fc.IsEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("fulfillment_validation=")
+	builder.WriteString(fmt.Sprintf("%v", fc.FulfillmentValidation))
+	builder.WriteString(", ")
+	builder.WriteString("matching_strategy=")
+	builder.WriteString(fmt.Sprintf("%v", fc.MatchingStrategy))
+	builder.WriteString(", ")
+	builder.WriteString("order_request_mode=")
+	builder.WriteString(fmt.Sprintf("%v", fc.OrderRequestMode))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package fiatcurrency
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -33,21 +34,37 @@ const (
 	FieldMarketRate = "market_rate"
 	// FieldIsEnabled holds the string denoting the is_enabled field in the database.
 	FieldIsEnabled = "is_enabled"
+	// FieldFulfillmentValidation holds the string denoting the fulfillment_validation field in the database.
+	FieldFulfillmentValidation = "fulfillment_validation"
+	// FieldMatchingStrategy holds the string denoting the matching_strategy field in the database.
+	FieldMatchingStrategy = "matching_strategy"
+	// FieldOrderRequestMode holds the string denoting the order_request_mode field in the database.
+	FieldOrderRequestMode = "order_request_mode"
 	// EdgeProviders holds the string denoting the providers edge name in mutations.
 	EdgeProviders = "providers"
 	// EdgeProvisionBuckets holds the string denoting the provision_buckets edge name in mutations.
 	EdgeProvisionBuckets = "provision_buckets"
 	// EdgeInstitutions holds the string denoting the institutions edge name in mutations.
 	EdgeInstitutions = "institutions"
+	// EdgeProviderOrderTokens holds the string denoting the provider_order_tokens edge name in mutations.
+	EdgeProviderOrderTokens = "provider_order_tokens"
+	// EdgePublicHolidays holds the string denoting the public_holidays edge name in mutations.
+	EdgePublicHolidays = "public_holidays"
+	// EdgeBucketProposals holds the string denoting the bucket_proposals edge name in mutations.
+	EdgeBucketProposals = "bucket_proposals"
+	// EdgeRateSnapshots holds the string denoting the rate_snapshots edge name in mutations.
+	EdgeRateSnapshots = "rate_snapshots"
+	// EdgeRateCircuitBreaker holds the string denoting the rate_circuit_breaker edge name in mutations.
+	EdgeRateCircuitBreaker = "rate_circuit_breaker"
+	// EdgeTokenMarketRates holds the string denoting the token_market_rates edge name in mutations.
+	EdgeTokenMarketRates = "token_market_rates"
 	// Table holds the table name of the fiatcurrency in the database.
 	Table = "fiat_currencies"
-	// ProvidersTable is the table that holds the providers relation/edge.
-	ProvidersTable = "provider_profiles"
+	// ProvidersTable is the table that holds the providers relation/edge. The primary key declared below.
+	ProvidersTable = "fiat_currency_providers"
 	// ProvidersInverseTable is the table name for the ProviderProfile entity.
 	// It exists in this package in order to avoid circular dependency with the "providerprofile" package.
 	ProvidersInverseTable = "provider_profiles"
-	// ProvidersColumn is the table column denoting the providers relation/edge.
-	ProvidersColumn = "fiat_currency_providers"
 	// ProvisionBucketsTable is the table that holds the provision_buckets relation/edge.
 	ProvisionBucketsTable = "provision_buckets"
 	// ProvisionBucketsInverseTable is the table name for the ProvisionBucket entity.
@@ -62,6 +79,48 @@ const (
 	InstitutionsInverseTable = "institutions"
 	// InstitutionsColumn is the table column denoting the institutions relation/edge.
 	InstitutionsColumn = "fiat_currency_institutions"
+	// ProviderOrderTokensTable is the table that holds the provider_order_tokens relation/edge.
+	ProviderOrderTokensTable = "provider_order_tokens"
+	// ProviderOrderTokensInverseTable is the table name for the ProviderOrderToken entity.
+	// It exists in this package in order to avoid circular dependency with the "providerordertoken" package.
+	ProviderOrderTokensInverseTable = "provider_order_tokens"
+	// ProviderOrderTokensColumn is the table column denoting the provider_order_tokens relation/edge.
+	ProviderOrderTokensColumn = "fiat_currency_provider_order_tokens"
+	// PublicHolidaysTable is the table that holds the public_holidays relation/edge.
+	PublicHolidaysTable = "public_holidays"
+	// PublicHolidaysInverseTable is the table name for the PublicHoliday entity.
+	// It exists in this package in order to avoid circular dependency with the "publicholiday" package.
+	PublicHolidaysInverseTable = "public_holidays"
+	// PublicHolidaysColumn is the table column denoting the public_holidays relation/edge.
+	PublicHolidaysColumn = "fiat_currency_public_holidays"
+	// BucketProposalsTable is the table that holds the bucket_proposals relation/edge.
+	BucketProposalsTable = "bucket_proposals"
+	// BucketProposalsInverseTable is the table name for the BucketProposal entity.
+	// It exists in this package in order to avoid circular dependency with the "bucketproposal" package.
+	BucketProposalsInverseTable = "bucket_proposals"
+	// BucketProposalsColumn is the table column denoting the bucket_proposals relation/edge.
+	BucketProposalsColumn = "fiat_currency_bucket_proposals"
+	// RateSnapshotsTable is the table that holds the rate_snapshots relation/edge.
+	RateSnapshotsTable = "rate_snapshots"
+	// RateSnapshotsInverseTable is the table name for the RateSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "ratesnapshot" package.
+	RateSnapshotsInverseTable = "rate_snapshots"
+	// RateSnapshotsColumn is the table column denoting the rate_snapshots relation/edge.
+	RateSnapshotsColumn = "fiat_currency_rate_snapshots"
+	// RateCircuitBreakerTable is the table that holds the rate_circuit_breaker relation/edge.
+	RateCircuitBreakerTable = "rate_circuit_breakers"
+	// RateCircuitBreakerInverseTable is the table name for the RateCircuitBreaker entity.
+	// It exists in this package in order to avoid circular dependency with the "ratecircuitbreaker" package.
+	RateCircuitBreakerInverseTable = "rate_circuit_breakers"
+	// RateCircuitBreakerColumn is the table column denoting the rate_circuit_breaker relation/edge.
+	RateCircuitBreakerColumn = "fiat_currency_rate_circuit_breaker"
+	// TokenMarketRatesTable is the table that holds the token_market_rates relation/edge.
+	TokenMarketRatesTable = "token_market_rates"
+	// TokenMarketRatesInverseTable is the table name for the TokenMarketRate entity.
+	// It exists in this package in order to avoid circular dependency with the "tokenmarketrate" package.
+	TokenMarketRatesInverseTable = "token_market_rates"
+	// TokenMarketRatesColumn is the table column denoting the token_market_rates relation/edge.
+	TokenMarketRatesColumn = "fiat_currency_token_market_rates"
 )
 
 // Columns holds all SQL columns for fiatcurrency fields.
@@ -76,8 +135,17 @@ var Columns = []string{
 	FieldName,
 	FieldMarketRate,
 	FieldIsEnabled,
+	FieldFulfillmentValidation,
+	FieldMatchingStrategy,
+	FieldOrderRequestMode,
 }
 
+var (
+	// ProvidersPrimaryKey and ProvidersColumn2 are the table columns denoting the
+	// primary key for the providers relation (M2M).
+	ProvidersPrimaryKey = []string{"fiat_currency_id", "provider_profile_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -103,6 +171,84 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// FulfillmentValidation defines the type for the "fulfillment_validation" enum field.
+type FulfillmentValidation string
+
+// FulfillmentValidationProvider is the default value of the FulfillmentValidation enum.
+const DefaultFulfillmentValidation = FulfillmentValidationProvider
+
+// FulfillmentValidation values.
+const (
+	FulfillmentValidationProvider    FulfillmentValidation = "provider"
+	FulfillmentValidationPspOptional FulfillmentValidation = "psp_optional"
+	FulfillmentValidationPspRequired FulfillmentValidation = "psp_required"
+)
+
+func (fv FulfillmentValidation) String() string {
+	return string(fv)
+}
+
+// FulfillmentValidationValidator is a validator for the "fulfillment_validation" field enum values. It is called by the builders before save.
+func FulfillmentValidationValidator(fv FulfillmentValidation) error {
+	switch fv {
+	case FulfillmentValidationProvider, FulfillmentValidationPspOptional, FulfillmentValidationPspRequired:
+		return nil
+	default:
+		return fmt.Errorf("fiatcurrency: invalid enum value for fulfillment_validation field: %q", fv)
+	}
+}
+
+// MatchingStrategy defines the type for the "matching_strategy" enum field.
+type MatchingStrategy string
+
+// MatchingStrategy values.
+const (
+	MatchingStrategyPriceTime     MatchingStrategy = "price_time"
+	MatchingStrategyRoundRobin    MatchingStrategy = "round_robin"
+	MatchingStrategyTrustWeighted MatchingStrategy = "trust_weighted"
+	MatchingStrategyLowestLatency MatchingStrategy = "lowest_latency"
+)
+
+func (ms MatchingStrategy) String() string {
+	return string(ms)
+}
+
+// MatchingStrategyValidator is a validator for the "matching_strategy" field enum values. It is called by the builders before save.
+func MatchingStrategyValidator(ms MatchingStrategy) error {
+	switch ms {
+	case MatchingStrategyPriceTime, MatchingStrategyRoundRobin, MatchingStrategyTrustWeighted, MatchingStrategyLowestLatency:
+		return nil
+	default:
+		return fmt.Errorf("fiatcurrency: invalid enum value for matching_strategy field: %q", ms)
+	}
+}
+
+// OrderRequestMode defines the type for the "order_request_mode" enum field.
+type OrderRequestMode string
+
+// OrderRequestModeSequential is the default value of the OrderRequestMode enum.
+const DefaultOrderRequestMode = OrderRequestModeSequential
+
+// OrderRequestMode values.
+const (
+	OrderRequestModeSequential OrderRequestMode = "sequential"
+	OrderRequestModeBroadcast  OrderRequestMode = "broadcast"
+)
+
+func (orm OrderRequestMode) String() string {
+	return string(orm)
+}
+
+// OrderRequestModeValidator is a validator for the "order_request_mode" field enum values. It is called by the builders before save.
+func OrderRequestModeValidator(orm OrderRequestMode) error {
+	switch orm {
+	case OrderRequestModeSequential, OrderRequestModeBroadcast:
+		return nil
+	default:
+		return fmt.Errorf("fiatcurrency: invalid enum value for order_request_mode field: %q", orm)
+	}
+}
+
 // OrderOption defines the ordering options for the FiatCurrency queries.
 type OrderOption func(*sql.Selector)
 
@@ -156,6 +302,21 @@ func ByIsEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsEnabled, opts...).ToFunc()
 }
 
+// ByFulfillmentValidation orders the results by the fulfillment_validation field.
+func ByFulfillmentValidation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfillmentValidation, opts...).ToFunc()
+}
+
+// ByMatchingStrategy orders the results by the matching_strategy field.
+func ByMatchingStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchingStrategy, opts...).ToFunc()
+}
+
+// ByOrderRequestMode orders the results by the order_request_mode field.
+func ByOrderRequestMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderRequestMode, opts...).ToFunc()
+}
+
 // ByProvidersCount orders the results by providers count.
 func ByProvidersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -197,11 +358,88 @@ func ByInstitutions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newInstitutionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByProviderOrderTokensCount orders the results by provider_order_tokens count.
+func ByProviderOrderTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProviderOrderTokensStep(), opts...)
+	}
+}
+
+// ByProviderOrderTokens orders the results by provider_order_tokens terms.
+func ByProviderOrderTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderOrderTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPublicHolidaysCount orders the results by public_holidays count.
+func ByPublicHolidaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPublicHolidaysStep(), opts...)
+	}
+}
+
+// ByPublicHolidays orders the results by public_holidays terms.
+func ByPublicHolidays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPublicHolidaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBucketProposalsCount orders the results by bucket_proposals count.
+func ByBucketProposalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBucketProposalsStep(), opts...)
+	}
+}
+
+// ByBucketProposals orders the results by bucket_proposals terms.
+func ByBucketProposals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBucketProposalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRateSnapshotsCount orders the results by rate_snapshots count.
+func ByRateSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRateSnapshotsStep(), opts...)
+	}
+}
+
+// ByRateSnapshots orders the results by rate_snapshots terms.
+func ByRateSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRateSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRateCircuitBreakerField orders the results by rate_circuit_breaker field.
+func ByRateCircuitBreakerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRateCircuitBreakerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTokenMarketRatesCount orders the results by token_market_rates count.
+func ByTokenMarketRatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTokenMarketRatesStep(), opts...)
+	}
+}
+
+// ByTokenMarketRates orders the results by token_market_rates terms.
+func ByTokenMarketRates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTokenMarketRatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProvidersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(ProvidersInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, ProvidersTable, ProvidersColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, ProvidersTable, ProvidersPrimaryKey...),
 	)
 }
 func newProvisionBucketsStep() *sqlgraph.Step {
@@ -218,3 +456,45 @@ func newInstitutionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, InstitutionsTable, InstitutionsColumn),
 	)
 }
+func newProviderOrderTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderOrderTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProviderOrderTokensTable, ProviderOrderTokensColumn),
+	)
+}
+func newPublicHolidaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PublicHolidaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PublicHolidaysTable, PublicHolidaysColumn),
+	)
+}
+func newBucketProposalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BucketProposalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BucketProposalsTable, BucketProposalsColumn),
+	)
+}
+func newRateSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RateSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RateSnapshotsTable, RateSnapshotsColumn),
+	)
+}
+func newRateCircuitBreakerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RateCircuitBreakerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RateCircuitBreakerTable, RateCircuitBreakerColumn),
+	)
+}
+func newTokenMarketRatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TokenMarketRatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TokenMarketRatesTable, TokenMarketRatesColumn),
+	)
+}
@@ -532,12 +532,82 @@ func IsEnabledNEQ(v bool) predicate.FiatCurrency {
 	return predicate.FiatCurrency(sql.FieldNEQ(FieldIsEnabled, v))
 }
 
+// FulfillmentValidationEQ applies the EQ predicate on the "fulfillment_validation" field.
+func FulfillmentValidationEQ(v FulfillmentValidation) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldEQ(FieldFulfillmentValidation, v))
+}
+
+// FulfillmentValidationNEQ applies the NEQ predicate on the "fulfillment_validation" field.
+func FulfillmentValidationNEQ(v FulfillmentValidation) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNEQ(FieldFulfillmentValidation, v))
+}
+
+// FulfillmentValidationIn applies the In predicate on the "fulfillment_validation" field.
+func FulfillmentValidationIn(vs ...FulfillmentValidation) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldIn(FieldFulfillmentValidation, vs...))
+}
+
+// FulfillmentValidationNotIn applies the NotIn predicate on the "fulfillment_validation" field.
+func FulfillmentValidationNotIn(vs ...FulfillmentValidation) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNotIn(FieldFulfillmentValidation, vs...))
+}
+
+// MatchingStrategyEQ applies the EQ predicate on the "matching_strategy" field.
+func MatchingStrategyEQ(v MatchingStrategy) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldEQ(FieldMatchingStrategy, v))
+}
+
+// MatchingStrategyNEQ applies the NEQ predicate on the "matching_strategy" field.
+func MatchingStrategyNEQ(v MatchingStrategy) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNEQ(FieldMatchingStrategy, v))
+}
+
+// MatchingStrategyIn applies the In predicate on the "matching_strategy" field.
+func MatchingStrategyIn(vs ...MatchingStrategy) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldIn(FieldMatchingStrategy, vs...))
+}
+
+// MatchingStrategyNotIn applies the NotIn predicate on the "matching_strategy" field.
+func MatchingStrategyNotIn(vs ...MatchingStrategy) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNotIn(FieldMatchingStrategy, vs...))
+}
+
+// MatchingStrategyIsNil applies the IsNil predicate on the "matching_strategy" field.
+func MatchingStrategyIsNil() predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldIsNull(FieldMatchingStrategy))
+}
+
+// MatchingStrategyNotNil applies the NotNil predicate on the "matching_strategy" field.
+func MatchingStrategyNotNil() predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNotNull(FieldMatchingStrategy))
+}
+
+// OrderRequestModeEQ applies the EQ predicate on the "order_request_mode" field.
+func OrderRequestModeEQ(v OrderRequestMode) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldEQ(FieldOrderRequestMode, v))
+}
+
+// OrderRequestModeNEQ applies the NEQ predicate on the "order_request_mode" field.
+func OrderRequestModeNEQ(v OrderRequestMode) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNEQ(FieldOrderRequestMode, v))
+}
+
+// OrderRequestModeIn applies the In predicate on the "order_request_mode" field.
+func OrderRequestModeIn(vs ...OrderRequestMode) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldIn(FieldOrderRequestMode, vs...))
+}
+
+// OrderRequestModeNotIn applies the NotIn predicate on the "order_request_mode" field.
+func OrderRequestModeNotIn(vs ...OrderRequestMode) predicate.FiatCurrency {
+	return predicate.FiatCurrency(sql.FieldNotIn(FieldOrderRequestMode, vs...))
+}
+
 // HasProviders applies the HasEdge predicate on the "providers" edge.
 func HasProviders() predicate.FiatCurrency {
 	return predicate.FiatCurrency(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ProvidersTable, ProvidersColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, ProvidersTable, ProvidersPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -601,6 +671,144 @@ func HasInstitutionsWith(preds ...predicate.Institution) predicate.FiatCurrency
 	})
 }
 
+// HasProviderOrderTokens applies the HasEdge predicate on the "provider_order_tokens" edge.
+func HasProviderOrderTokens() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProviderOrderTokensTable, ProviderOrderTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderOrderTokensWith applies the HasEdge predicate on the "provider_order_tokens" edge with a given conditions (other predicates).
+func HasProviderOrderTokensWith(preds ...predicate.ProviderOrderToken) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newProviderOrderTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPublicHolidays applies the HasEdge predicate on the "public_holidays" edge.
+func HasPublicHolidays() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PublicHolidaysTable, PublicHolidaysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPublicHolidaysWith applies the HasEdge predicate on the "public_holidays" edge with a given conditions (other predicates).
+func HasPublicHolidaysWith(preds ...predicate.PublicHoliday) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newPublicHolidaysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBucketProposals applies the HasEdge predicate on the "bucket_proposals" edge.
+func HasBucketProposals() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BucketProposalsTable, BucketProposalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBucketProposalsWith applies the HasEdge predicate on the "bucket_proposals" edge with a given conditions (other predicates).
+func HasBucketProposalsWith(preds ...predicate.BucketProposal) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newBucketProposalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRateSnapshots applies the HasEdge predicate on the "rate_snapshots" edge.
+func HasRateSnapshots() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RateSnapshotsTable, RateSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRateSnapshotsWith applies the HasEdge predicate on the "rate_snapshots" edge with a given conditions (other predicates).
+func HasRateSnapshotsWith(preds ...predicate.RateSnapshot) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newRateSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRateCircuitBreaker applies the HasEdge predicate on the "rate_circuit_breaker" edge.
+func HasRateCircuitBreaker() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, RateCircuitBreakerTable, RateCircuitBreakerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRateCircuitBreakerWith applies the HasEdge predicate on the "rate_circuit_breaker" edge with a given conditions (other predicates).
+func HasRateCircuitBreakerWith(preds ...predicate.RateCircuitBreaker) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newRateCircuitBreakerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTokenMarketRates applies the HasEdge predicate on the "token_market_rates" edge.
+func HasTokenMarketRates() predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TokenMarketRatesTable, TokenMarketRatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTokenMarketRatesWith applies the HasEdge predicate on the "token_market_rates" edge with a given conditions (other predicates).
+func HasTokenMarketRatesWith(preds ...predicate.TokenMarketRate) predicate.FiatCurrency {
+	return predicate.FiatCurrency(func(s *sql.Selector) {
+		step := newTokenMarketRatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FiatCurrency) predicate.FiatCurrency {
 	return predicate.FiatCurrency(sql.AndPredicates(predicates...))
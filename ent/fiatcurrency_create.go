@@ -13,10 +13,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
 	"github.com/shopspring/decimal"
 )
 
@@ -114,6 +120,48 @@ func (fcc *FiatCurrencyCreate) SetNillableIsEnabled(b *bool) *FiatCurrencyCreate
 	return fcc
 }
 
+// SetFulfillmentValidation sets the "fulfillment_validation" field.
+func (fcc *FiatCurrencyCreate) SetFulfillmentValidation(fv fiatcurrency.FulfillmentValidation) *FiatCurrencyCreate {
+	fcc.mutation.SetFulfillmentValidation(fv)
+	return fcc
+}
+
+// SetNillableFulfillmentValidation sets the "fulfillment_validation" field if the given value is not nil.
+func (fcc *FiatCurrencyCreate) SetNillableFulfillmentValidation(fv *fiatcurrency.FulfillmentValidation) *FiatCurrencyCreate {
+	if fv != nil {
+		fcc.SetFulfillmentValidation(*fv)
+	}
+	return fcc
+}
+
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (fcc *FiatCurrencyCreate) SetMatchingStrategy(fs fiatcurrency.MatchingStrategy) *FiatCurrencyCreate {
+	fcc.mutation.SetMatchingStrategy(fs)
+	return fcc
+}
+
+// SetNillableMatchingStrategy sets the "matching_strategy" field if the given value is not nil.
+func (fcc *FiatCurrencyCreate) SetNillableMatchingStrategy(fs *fiatcurrency.MatchingStrategy) *FiatCurrencyCreate {
+	if fs != nil {
+		fcc.SetMatchingStrategy(*fs)
+	}
+	return fcc
+}
+
+// SetOrderRequestMode sets the "order_request_mode" field.
+func (fcc *FiatCurrencyCreate) SetOrderRequestMode(frm fiatcurrency.OrderRequestMode) *FiatCurrencyCreate {
+	fcc.mutation.SetOrderRequestMode(frm)
+	return fcc
+}
+
+// SetNillableOrderRequestMode sets the "order_request_mode" field if the given value is not nil.
+func (fcc *FiatCurrencyCreate) SetNillableOrderRequestMode(frm *fiatcurrency.OrderRequestMode) *FiatCurrencyCreate {
+	if frm != nil {
+		fcc.SetOrderRequestMode(*frm)
+	}
+	return fcc
+}
+
 // SetID sets the "id" field.
 func (fcc *FiatCurrencyCreate) SetID(u uuid.UUID) *FiatCurrencyCreate {
 	fcc.mutation.SetID(u)
@@ -173,6 +221,100 @@ func (fcc *FiatCurrencyCreate) AddInstitutions(i ...*Institution) *FiatCurrencyC
 	return fcc.AddInstitutionIDs(ids...)
 }
 
+// AddProviderOrderTokenIDs adds the "provider_order_tokens" edge to the ProviderOrderToken entity by IDs.
+func (fcc *FiatCurrencyCreate) AddProviderOrderTokenIDs(ids ...int) *FiatCurrencyCreate {
+	fcc.mutation.AddProviderOrderTokenIDs(ids...)
+	return fcc
+}
+
+// AddProviderOrderTokens adds the "provider_order_tokens" edges to the ProviderOrderToken entity.
+func (fcc *FiatCurrencyCreate) AddProviderOrderTokens(p ...*ProviderOrderToken) *FiatCurrencyCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcc.AddProviderOrderTokenIDs(ids...)
+}
+
+// AddPublicHolidayIDs adds the "public_holidays" edge to the PublicHoliday entity by IDs.
+func (fcc *FiatCurrencyCreate) AddPublicHolidayIDs(ids ...int) *FiatCurrencyCreate {
+	fcc.mutation.AddPublicHolidayIDs(ids...)
+	return fcc
+}
+
+// AddPublicHolidays adds the "public_holidays" edges to the PublicHoliday entity.
+func (fcc *FiatCurrencyCreate) AddPublicHolidays(p ...*PublicHoliday) *FiatCurrencyCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcc.AddPublicHolidayIDs(ids...)
+}
+
+// AddBucketProposalIDs adds the "bucket_proposals" edge to the BucketProposal entity by IDs.
+func (fcc *FiatCurrencyCreate) AddBucketProposalIDs(ids ...uuid.UUID) *FiatCurrencyCreate {
+	fcc.mutation.AddBucketProposalIDs(ids...)
+	return fcc
+}
+
+// AddBucketProposals adds the "bucket_proposals" edges to the BucketProposal entity.
+func (fcc *FiatCurrencyCreate) AddBucketProposals(b ...*BucketProposal) *FiatCurrencyCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return fcc.AddBucketProposalIDs(ids...)
+}
+
+// AddRateSnapshotIDs adds the "rate_snapshots" edge to the RateSnapshot entity by IDs.
+func (fcc *FiatCurrencyCreate) AddRateSnapshotIDs(ids ...uuid.UUID) *FiatCurrencyCreate {
+	fcc.mutation.AddRateSnapshotIDs(ids...)
+	return fcc
+}
+
+// AddRateSnapshots adds the "rate_snapshots" edges to the RateSnapshot entity.
+func (fcc *FiatCurrencyCreate) AddRateSnapshots(r ...*RateSnapshot) *FiatCurrencyCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return fcc.AddRateSnapshotIDs(ids...)
+}
+
+// SetRateCircuitBreakerID sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity by ID.
+func (fcc *FiatCurrencyCreate) SetRateCircuitBreakerID(id uuid.UUID) *FiatCurrencyCreate {
+	fcc.mutation.SetRateCircuitBreakerID(id)
+	return fcc
+}
+
+// SetNillableRateCircuitBreakerID sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity by ID if the given value is not nil.
+func (fcc *FiatCurrencyCreate) SetNillableRateCircuitBreakerID(id *uuid.UUID) *FiatCurrencyCreate {
+	if id != nil {
+		fcc = fcc.SetRateCircuitBreakerID(*id)
+	}
+	return fcc
+}
+
+// SetRateCircuitBreaker sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity.
+func (fcc *FiatCurrencyCreate) SetRateCircuitBreaker(r *RateCircuitBreaker) *FiatCurrencyCreate {
+	return fcc.SetRateCircuitBreakerID(r.ID)
+}
+
+// AddTokenMarketRateIDs adds the "token_market_rates" edge to the TokenMarketRate entity by IDs.
+func (fcc *FiatCurrencyCreate) AddTokenMarketRateIDs(ids ...uuid.UUID) *FiatCurrencyCreate {
+	fcc.mutation.AddTokenMarketRateIDs(ids...)
+	return fcc
+}
+
+// AddTokenMarketRates adds the "token_market_rates" edges to the TokenMarketRate entity.
+func (fcc *FiatCurrencyCreate) AddTokenMarketRates(t ...*TokenMarketRate) *FiatCurrencyCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fcc.AddTokenMarketRateIDs(ids...)
+}
+
 // Mutation returns the FiatCurrencyMutation object of the builder.
 func (fcc *FiatCurrencyCreate) Mutation() *FiatCurrencyMutation {
 	return fcc.mutation
@@ -224,6 +366,14 @@ func (fcc *FiatCurrencyCreate) defaults() {
 		v := fiatcurrency.DefaultIsEnabled
 		fcc.mutation.SetIsEnabled(v)
 	}
+	if _, ok := fcc.mutation.FulfillmentValidation(); !ok {
+		v := fiatcurrency.DefaultFulfillmentValidation
+		fcc.mutation.SetFulfillmentValidation(v)
+	}
+	if _, ok := fcc.mutation.OrderRequestMode(); !ok {
+		v := fiatcurrency.DefaultOrderRequestMode
+		fcc.mutation.SetOrderRequestMode(v)
+	}
 	if _, ok := fcc.mutation.ID(); !ok {
 		v := fiatcurrency.DefaultID()
 		fcc.mutation.SetID(v)
@@ -259,6 +409,27 @@ func (fcc *FiatCurrencyCreate) check() error {
 	if _, ok := fcc.mutation.IsEnabled(); !ok {
 		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "FiatCurrency.is_enabled"`)}
 	}
+	if _, ok := fcc.mutation.FulfillmentValidation(); !ok {
+		return &ValidationError{Name: "fulfillment_validation", err: errors.New(`ent: missing required field "FiatCurrency.fulfillment_validation"`)}
+	}
+	if v, ok := fcc.mutation.FulfillmentValidation(); ok {
+		if err := fiatcurrency.FulfillmentValidationValidator(v); err != nil {
+			return &ValidationError{Name: "fulfillment_validation", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.fulfillment_validation": %w`, err)}
+		}
+	}
+	if v, ok := fcc.mutation.MatchingStrategy(); ok {
+		if err := fiatcurrency.MatchingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "matching_strategy", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.matching_strategy": %w`, err)}
+		}
+	}
+	if _, ok := fcc.mutation.OrderRequestMode(); !ok {
+		return &ValidationError{Name: "order_request_mode", err: errors.New(`ent: missing required field "FiatCurrency.order_request_mode"`)}
+	}
+	if v, ok := fcc.mutation.OrderRequestMode(); ok {
+		if err := fiatcurrency.OrderRequestModeValidator(v); err != nil {
+			return &ValidationError{Name: "order_request_mode", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.order_request_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -331,12 +502,24 @@ func (fcc *FiatCurrencyCreate) createSpec() (*FiatCurrency, *sqlgraph.CreateSpec
 		_spec.SetField(fiatcurrency.FieldIsEnabled, field.TypeBool, value)
 		_node.IsEnabled = value
 	}
+	if value, ok := fcc.mutation.FulfillmentValidation(); ok {
+		_spec.SetField(fiatcurrency.FieldFulfillmentValidation, field.TypeEnum, value)
+		_node.FulfillmentValidation = value
+	}
+	if value, ok := fcc.mutation.MatchingStrategy(); ok {
+		_spec.SetField(fiatcurrency.FieldMatchingStrategy, field.TypeEnum, value)
+		_node.MatchingStrategy = value
+	}
+	if value, ok := fcc.mutation.OrderRequestMode(); ok {
+		_spec.SetField(fiatcurrency.FieldOrderRequestMode, field.TypeEnum, value)
+		_node.OrderRequestMode = value
+	}
 	if nodes := fcc.mutation.ProvidersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -379,6 +562,102 @@ func (fcc *FiatCurrencyCreate) createSpec() (*FiatCurrency, *sqlgraph.CreateSpec
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := fcc.mutation.ProviderOrderTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fcc.mutation.PublicHolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fcc.mutation.BucketProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fcc.mutation.RateSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fcc.mutation.RateCircuitBreakerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   fiatcurrency.RateCircuitBreakerTable,
+			Columns: []string{fiatcurrency.RateCircuitBreakerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fcc.mutation.TokenMarketRatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -539,6 +818,48 @@ func (u *FiatCurrencyUpsert) UpdateIsEnabled() *FiatCurrencyUpsert {
 	return u
 }
 
+// SetFulfillmentValidation sets the "fulfillment_validation" field.
+func (u *FiatCurrencyUpsert) SetFulfillmentValidation(v fiatcurrency.FulfillmentValidation) *FiatCurrencyUpsert {
+	u.Set(fiatcurrency.FieldFulfillmentValidation, v)
+	return u
+}
+
+// UpdateFulfillmentValidation sets the "fulfillment_validation" field to the value that was provided on create.
+func (u *FiatCurrencyUpsert) UpdateFulfillmentValidation() *FiatCurrencyUpsert {
+	u.SetExcluded(fiatcurrency.FieldFulfillmentValidation)
+	return u
+}
+
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (u *FiatCurrencyUpsert) SetMatchingStrategy(v fiatcurrency.MatchingStrategy) *FiatCurrencyUpsert {
+	u.Set(fiatcurrency.FieldMatchingStrategy, v)
+	return u
+}
+
+// UpdateMatchingStrategy sets the "matching_strategy" field to the value that was provided on create.
+func (u *FiatCurrencyUpsert) UpdateMatchingStrategy() *FiatCurrencyUpsert {
+	u.SetExcluded(fiatcurrency.FieldMatchingStrategy)
+	return u
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (u *FiatCurrencyUpsert) ClearMatchingStrategy() *FiatCurrencyUpsert {
+	u.SetNull(fiatcurrency.FieldMatchingStrategy)
+	return u
+}
+
+// SetOrderRequestMode sets the "order_request_mode" field.
+func (u *FiatCurrencyUpsert) SetOrderRequestMode(v fiatcurrency.OrderRequestMode) *FiatCurrencyUpsert {
+	u.Set(fiatcurrency.FieldOrderRequestMode, v)
+	return u
+}
+
+// UpdateOrderRequestMode sets the "order_request_mode" field to the value that was provided on create.
+func (u *FiatCurrencyUpsert) UpdateOrderRequestMode() *FiatCurrencyUpsert {
+	u.SetExcluded(fiatcurrency.FieldOrderRequestMode)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -716,6 +1037,55 @@ func (u *FiatCurrencyUpsertOne) UpdateIsEnabled() *FiatCurrencyUpsertOne {
 	})
 }
 
+// SetFulfillmentValidation sets the "fulfillment_validation" field.
+func (u *FiatCurrencyUpsertOne) SetFulfillmentValidation(v fiatcurrency.FulfillmentValidation) *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.SetFulfillmentValidation(v)
+	})
+}
+
+// UpdateFulfillmentValidation sets the "fulfillment_validation" field to the value that was provided on create.
+func (u *FiatCurrencyUpsertOne) UpdateFulfillmentValidation() *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.UpdateFulfillmentValidation()
+	})
+}
+
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (u *FiatCurrencyUpsertOne) SetMatchingStrategy(v fiatcurrency.MatchingStrategy) *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.SetMatchingStrategy(v)
+	})
+}
+
+// UpdateMatchingStrategy sets the "matching_strategy" field to the value that was provided on create.
+func (u *FiatCurrencyUpsertOne) UpdateMatchingStrategy() *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.UpdateMatchingStrategy()
+	})
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (u *FiatCurrencyUpsertOne) ClearMatchingStrategy() *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.ClearMatchingStrategy()
+	})
+}
+
+// SetOrderRequestMode sets the "order_request_mode" field.
+func (u *FiatCurrencyUpsertOne) SetOrderRequestMode(v fiatcurrency.OrderRequestMode) *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.SetOrderRequestMode(v)
+	})
+}
+
+// UpdateOrderRequestMode sets the "order_request_mode" field to the value that was provided on create.
+func (u *FiatCurrencyUpsertOne) UpdateOrderRequestMode() *FiatCurrencyUpsertOne {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.UpdateOrderRequestMode()
+	})
+}
+
 // Exec executes the query.
 func (u *FiatCurrencyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1060,6 +1430,55 @@ func (u *FiatCurrencyUpsertBulk) UpdateIsEnabled() *FiatCurrencyUpsertBulk {
 	})
 }
 
+// SetFulfillmentValidation sets the "fulfillment_validation" field.
+func (u *FiatCurrencyUpsertBulk) SetFulfillmentValidation(v fiatcurrency.FulfillmentValidation) *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.SetFulfillmentValidation(v)
+	})
+}
+
+// UpdateFulfillmentValidation sets the "fulfillment_validation" field to the value that was provided on create.
+func (u *FiatCurrencyUpsertBulk) UpdateFulfillmentValidation() *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.UpdateFulfillmentValidation()
+	})
+}
+
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (u *FiatCurrencyUpsertBulk) SetMatchingStrategy(v fiatcurrency.MatchingStrategy) *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.SetMatchingStrategy(v)
+	})
+}
+
+// UpdateMatchingStrategy sets the "matching_strategy" field to the value that was provided on create.
+func (u *FiatCurrencyUpsertBulk) UpdateMatchingStrategy() *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.UpdateMatchingStrategy()
+	})
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (u *FiatCurrencyUpsertBulk) ClearMatchingStrategy() *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.ClearMatchingStrategy()
+	})
+}
+
+// SetOrderRequestMode sets the "order_request_mode" field.
+func (u *FiatCurrencyUpsertBulk) SetOrderRequestMode(v fiatcurrency.OrderRequestMode) *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.SetOrderRequestMode(v)
+	})
+}
+
+// UpdateOrderRequestMode sets the "order_request_mode" field to the value that was provided on create.
+func (u *FiatCurrencyUpsertBulk) UpdateOrderRequestMode() *FiatCurrencyUpsertBulk {
+	return u.Update(func(s *FiatCurrencyUpsert) {
+		s.UpdateOrderRequestMode()
+	})
+}
+
 // Exec executes the query.
 func (u *FiatCurrencyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,23 +13,35 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
 )
 
 // FiatCurrencyQuery is the builder for querying FiatCurrency entities.
 type FiatCurrencyQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []fiatcurrency.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.FiatCurrency
-	withProviders        *ProviderProfileQuery
-	withProvisionBuckets *ProvisionBucketQuery
-	withInstitutions     *InstitutionQuery
+	ctx                     *QueryContext
+	order                   []fiatcurrency.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.FiatCurrency
+	withProviders           *ProviderProfileQuery
+	withProvisionBuckets    *ProvisionBucketQuery
+	withInstitutions        *InstitutionQuery
+	withProviderOrderTokens *ProviderOrderTokenQuery
+	withPublicHolidays      *PublicHolidayQuery
+	withBucketProposals     *BucketProposalQuery
+	withRateSnapshots       *RateSnapshotQuery
+	withRateCircuitBreaker  *RateCircuitBreakerQuery
+	withTokenMarketRates    *TokenMarketRateQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -80,7 +92,7 @@ func (fcq *FiatCurrencyQuery) QueryProviders() *ProviderProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
 			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProvidersTable, fiatcurrency.ProvidersColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, fiatcurrency.ProvidersTable, fiatcurrency.ProvidersPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
 		return fromU, nil
@@ -132,6 +144,138 @@ func (fcq *FiatCurrencyQuery) QueryInstitutions() *InstitutionQuery {
 	return query
 }
 
+// QueryProviderOrderTokens chains the current query on the "provider_order_tokens" edge.
+func (fcq *FiatCurrencyQuery) QueryProviderOrderTokens() *ProviderOrderTokenQuery {
+	query := (&ProviderOrderTokenClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(providerordertoken.Table, providerordertoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.ProviderOrderTokensTable, fiatcurrency.ProviderOrderTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPublicHolidays chains the current query on the "public_holidays" edge.
+func (fcq *FiatCurrencyQuery) QueryPublicHolidays() *PublicHolidayQuery {
+	query := (&PublicHolidayClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(publicholiday.Table, publicholiday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.PublicHolidaysTable, fiatcurrency.PublicHolidaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBucketProposals chains the current query on the "bucket_proposals" edge.
+func (fcq *FiatCurrencyQuery) QueryBucketProposals() *BucketProposalQuery {
+	query := (&BucketProposalClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(bucketproposal.Table, bucketproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.BucketProposalsTable, fiatcurrency.BucketProposalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRateSnapshots chains the current query on the "rate_snapshots" edge.
+func (fcq *FiatCurrencyQuery) QueryRateSnapshots() *RateSnapshotQuery {
+	query := (&RateSnapshotClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(ratesnapshot.Table, ratesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.RateSnapshotsTable, fiatcurrency.RateSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRateCircuitBreaker chains the current query on the "rate_circuit_breaker" edge.
+func (fcq *FiatCurrencyQuery) QueryRateCircuitBreaker() *RateCircuitBreakerQuery {
+	query := (&RateCircuitBreakerClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(ratecircuitbreaker.Table, ratecircuitbreaker.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, fiatcurrency.RateCircuitBreakerTable, fiatcurrency.RateCircuitBreakerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTokenMarketRates chains the current query on the "token_market_rates" edge.
+func (fcq *FiatCurrencyQuery) QueryTokenMarketRates() *TokenMarketRateQuery {
+	query := (&TokenMarketRateClient{config: fcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := fcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fiatcurrency.Table, fiatcurrency.FieldID, selector),
+			sqlgraph.To(tokenmarketrate.Table, tokenmarketrate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, fiatcurrency.TokenMarketRatesTable, fiatcurrency.TokenMarketRatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first FiatCurrency entity from the query.
 // Returns a *NotFoundError when no FiatCurrency was found.
 func (fcq *FiatCurrencyQuery) First(ctx context.Context) (*FiatCurrency, error) {
@@ -319,14 +463,20 @@ func (fcq *FiatCurrencyQuery) Clone() *FiatCurrencyQuery {
 		return nil
 	}
 	return &FiatCurrencyQuery{
-		config:               fcq.config,
-		ctx:                  fcq.ctx.Clone(),
-		order:                append([]fiatcurrency.OrderOption{}, fcq.order...),
-		inters:               append([]Interceptor{}, fcq.inters...),
-		predicates:           append([]predicate.FiatCurrency{}, fcq.predicates...),
-		withProviders:        fcq.withProviders.Clone(),
-		withProvisionBuckets: fcq.withProvisionBuckets.Clone(),
-		withInstitutions:     fcq.withInstitutions.Clone(),
+		config:                  fcq.config,
+		ctx:                     fcq.ctx.Clone(),
+		order:                   append([]fiatcurrency.OrderOption{}, fcq.order...),
+		inters:                  append([]Interceptor{}, fcq.inters...),
+		predicates:              append([]predicate.FiatCurrency{}, fcq.predicates...),
+		withProviders:           fcq.withProviders.Clone(),
+		withProvisionBuckets:    fcq.withProvisionBuckets.Clone(),
+		withInstitutions:        fcq.withInstitutions.Clone(),
+		withProviderOrderTokens: fcq.withProviderOrderTokens.Clone(),
+		withPublicHolidays:      fcq.withPublicHolidays.Clone(),
+		withBucketProposals:     fcq.withBucketProposals.Clone(),
+		withRateSnapshots:       fcq.withRateSnapshots.Clone(),
+		withRateCircuitBreaker:  fcq.withRateCircuitBreaker.Clone(),
+		withTokenMarketRates:    fcq.withTokenMarketRates.Clone(),
 		// clone intermediate query.
 		sql:  fcq.sql.Clone(),
 		path: fcq.path,
@@ -366,6 +516,72 @@ func (fcq *FiatCurrencyQuery) WithInstitutions(opts ...func(*InstitutionQuery))
 	return fcq
 }
 
+// WithProviderOrderTokens tells the query-builder to eager-load the nodes that are connected to
+// the "provider_order_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithProviderOrderTokens(opts ...func(*ProviderOrderTokenQuery)) *FiatCurrencyQuery {
+	query := (&ProviderOrderTokenClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withProviderOrderTokens = query
+	return fcq
+}
+
+// WithPublicHolidays tells the query-builder to eager-load the nodes that are connected to
+// the "public_holidays" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithPublicHolidays(opts ...func(*PublicHolidayQuery)) *FiatCurrencyQuery {
+	query := (&PublicHolidayClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withPublicHolidays = query
+	return fcq
+}
+
+// WithBucketProposals tells the query-builder to eager-load the nodes that are connected to
+// the "bucket_proposals" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithBucketProposals(opts ...func(*BucketProposalQuery)) *FiatCurrencyQuery {
+	query := (&BucketProposalClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withBucketProposals = query
+	return fcq
+}
+
+// WithRateSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "rate_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithRateSnapshots(opts ...func(*RateSnapshotQuery)) *FiatCurrencyQuery {
+	query := (&RateSnapshotClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withRateSnapshots = query
+	return fcq
+}
+
+// WithRateCircuitBreaker tells the query-builder to eager-load the nodes that are connected to
+// the "rate_circuit_breaker" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithRateCircuitBreaker(opts ...func(*RateCircuitBreakerQuery)) *FiatCurrencyQuery {
+	query := (&RateCircuitBreakerClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withRateCircuitBreaker = query
+	return fcq
+}
+
+// WithTokenMarketRates tells the query-builder to eager-load the nodes that are connected to
+// the "token_market_rates" edge. The optional arguments are used to configure the query builder of the edge.
+func (fcq *FiatCurrencyQuery) WithTokenMarketRates(opts ...func(*TokenMarketRateQuery)) *FiatCurrencyQuery {
+	query := (&TokenMarketRateClient{config: fcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	fcq.withTokenMarketRates = query
+	return fcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +660,16 @@ func (fcq *FiatCurrencyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*FiatCurrency{}
 		_spec       = fcq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [9]bool{
 			fcq.withProviders != nil,
 			fcq.withProvisionBuckets != nil,
 			fcq.withInstitutions != nil,
+			fcq.withProviderOrderTokens != nil,
+			fcq.withPublicHolidays != nil,
+			fcq.withBucketProposals != nil,
+			fcq.withRateSnapshots != nil,
+			fcq.withRateCircuitBreaker != nil,
+			fcq.withTokenMarketRates != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -491,10 +713,116 @@ func (fcq *FiatCurrencyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := fcq.withProviderOrderTokens; query != nil {
+		if err := fcq.loadProviderOrderTokens(ctx, query, nodes,
+			func(n *FiatCurrency) { n.Edges.ProviderOrderTokens = []*ProviderOrderToken{} },
+			func(n *FiatCurrency, e *ProviderOrderToken) {
+				n.Edges.ProviderOrderTokens = append(n.Edges.ProviderOrderTokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := fcq.withPublicHolidays; query != nil {
+		if err := fcq.loadPublicHolidays(ctx, query, nodes,
+			func(n *FiatCurrency) { n.Edges.PublicHolidays = []*PublicHoliday{} },
+			func(n *FiatCurrency, e *PublicHoliday) { n.Edges.PublicHolidays = append(n.Edges.PublicHolidays, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fcq.withBucketProposals; query != nil {
+		if err := fcq.loadBucketProposals(ctx, query, nodes,
+			func(n *FiatCurrency) { n.Edges.BucketProposals = []*BucketProposal{} },
+			func(n *FiatCurrency, e *BucketProposal) { n.Edges.BucketProposals = append(n.Edges.BucketProposals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fcq.withRateSnapshots; query != nil {
+		if err := fcq.loadRateSnapshots(ctx, query, nodes,
+			func(n *FiatCurrency) { n.Edges.RateSnapshots = []*RateSnapshot{} },
+			func(n *FiatCurrency, e *RateSnapshot) { n.Edges.RateSnapshots = append(n.Edges.RateSnapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fcq.withRateCircuitBreaker; query != nil {
+		if err := fcq.loadRateCircuitBreaker(ctx, query, nodes, nil,
+			func(n *FiatCurrency, e *RateCircuitBreaker) { n.Edges.RateCircuitBreaker = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := fcq.withTokenMarketRates; query != nil {
+		if err := fcq.loadTokenMarketRates(ctx, query, nodes,
+			func(n *FiatCurrency) { n.Edges.TokenMarketRates = []*TokenMarketRate{} },
+			func(n *FiatCurrency, e *TokenMarketRate) {
+				n.Edges.TokenMarketRates = append(n.Edges.TokenMarketRates, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
 func (fcq *FiatCurrencyQuery) loadProviders(ctx context.Context, query *ProviderProfileQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *ProviderProfile)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*FiatCurrency)
+	nids := make(map[string]map[*FiatCurrency]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(fiatcurrency.ProvidersTable)
+		s.Join(joinT).On(s.C(providerprofile.FieldID), joinT.C(fiatcurrency.ProvidersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(fiatcurrency.ProvidersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(fiatcurrency.ProvidersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*FiatCurrency]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ProviderProfile](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "providers" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (fcq *FiatCurrencyQuery) loadProvisionBuckets(ctx context.Context, query *ProvisionBucketQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *ProvisionBucket)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*FiatCurrency)
 	for i := range nodes {
@@ -505,27 +833,27 @@ func (fcq *FiatCurrencyQuery) loadProviders(ctx context.Context, query *Provider
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.ProviderProfile(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(fiatcurrency.ProvidersColumn), fks...))
+	query.Where(predicate.ProvisionBucket(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.ProvisionBucketsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.fiat_currency_providers
+		fk := n.fiat_currency_provision_buckets
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "fiat_currency_providers" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "fiat_currency_provision_buckets" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_providers" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_provision_buckets" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (fcq *FiatCurrencyQuery) loadProvisionBuckets(ctx context.Context, query *ProvisionBucketQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *ProvisionBucket)) error {
+func (fcq *FiatCurrencyQuery) loadInstitutions(ctx context.Context, query *InstitutionQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *Institution)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*FiatCurrency)
 	for i := range nodes {
@@ -536,27 +864,27 @@ func (fcq *FiatCurrencyQuery) loadProvisionBuckets(ctx context.Context, query *P
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.ProvisionBucket(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(fiatcurrency.ProvisionBucketsColumn), fks...))
+	query.Where(predicate.Institution(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.InstitutionsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.fiat_currency_provision_buckets
+		fk := n.fiat_currency_institutions
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "fiat_currency_provision_buckets" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "fiat_currency_institutions" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_provision_buckets" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_institutions" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (fcq *FiatCurrencyQuery) loadInstitutions(ctx context.Context, query *InstitutionQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *Institution)) error {
+func (fcq *FiatCurrencyQuery) loadProviderOrderTokens(ctx context.Context, query *ProviderOrderTokenQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *ProviderOrderToken)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*FiatCurrency)
 	for i := range nodes {
@@ -567,21 +895,173 @@ func (fcq *FiatCurrencyQuery) loadInstitutions(ctx context.Context, query *Insti
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.Institution(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(fiatcurrency.InstitutionsColumn), fks...))
+	query.Where(predicate.ProviderOrderToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.ProviderOrderTokensColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.fiat_currency_institutions
+		fk := n.fiat_currency_provider_order_tokens
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "fiat_currency_institutions" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "fiat_currency_provider_order_tokens" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_institutions" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_provider_order_tokens" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (fcq *FiatCurrencyQuery) loadPublicHolidays(ctx context.Context, query *PublicHolidayQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *PublicHoliday)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FiatCurrency)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PublicHoliday(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.PublicHolidaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.fiat_currency_public_holidays
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fiat_currency_public_holidays" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_public_holidays" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (fcq *FiatCurrencyQuery) loadBucketProposals(ctx context.Context, query *BucketProposalQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *BucketProposal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FiatCurrency)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BucketProposal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.BucketProposalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.fiat_currency_bucket_proposals
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fiat_currency_bucket_proposals" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_bucket_proposals" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (fcq *FiatCurrencyQuery) loadRateSnapshots(ctx context.Context, query *RateSnapshotQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *RateSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FiatCurrency)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RateSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.RateSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.fiat_currency_rate_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fiat_currency_rate_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_rate_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (fcq *FiatCurrencyQuery) loadRateCircuitBreaker(ctx context.Context, query *RateCircuitBreakerQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *RateCircuitBreaker)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FiatCurrency)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.RateCircuitBreaker(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.RateCircuitBreakerColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.fiat_currency_rate_circuit_breaker
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fiat_currency_rate_circuit_breaker" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_rate_circuit_breaker" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (fcq *FiatCurrencyQuery) loadTokenMarketRates(ctx context.Context, query *TokenMarketRateQuery, nodes []*FiatCurrency, init func(*FiatCurrency), assign func(*FiatCurrency, *TokenMarketRate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FiatCurrency)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TokenMarketRate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(fiatcurrency.TokenMarketRatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.fiat_currency_token_market_rates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "fiat_currency_token_market_rates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "fiat_currency_token_market_rates" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
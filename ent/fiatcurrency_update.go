@@ -11,11 +11,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
 	"github.com/shopspring/decimal"
 )
 
@@ -150,6 +157,54 @@ func (fcu *FiatCurrencyUpdate) SetNillableIsEnabled(b *bool) *FiatCurrencyUpdate
 	return fcu
 }
 
+// SetFulfillmentValidation sets the "fulfillment_validation" field.
+func (fcu *FiatCurrencyUpdate) SetFulfillmentValidation(fv fiatcurrency.FulfillmentValidation) *FiatCurrencyUpdate {
+	fcu.mutation.SetFulfillmentValidation(fv)
+	return fcu
+}
+
+// SetNillableFulfillmentValidation sets the "fulfillment_validation" field if the given value is not nil.
+func (fcu *FiatCurrencyUpdate) SetNillableFulfillmentValidation(fv *fiatcurrency.FulfillmentValidation) *FiatCurrencyUpdate {
+	if fv != nil {
+		fcu.SetFulfillmentValidation(*fv)
+	}
+	return fcu
+}
+
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (fcu *FiatCurrencyUpdate) SetMatchingStrategy(fs fiatcurrency.MatchingStrategy) *FiatCurrencyUpdate {
+	fcu.mutation.SetMatchingStrategy(fs)
+	return fcu
+}
+
+// SetNillableMatchingStrategy sets the "matching_strategy" field if the given value is not nil.
+func (fcu *FiatCurrencyUpdate) SetNillableMatchingStrategy(fs *fiatcurrency.MatchingStrategy) *FiatCurrencyUpdate {
+	if fs != nil {
+		fcu.SetMatchingStrategy(*fs)
+	}
+	return fcu
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (fcu *FiatCurrencyUpdate) ClearMatchingStrategy() *FiatCurrencyUpdate {
+	fcu.mutation.ClearMatchingStrategy()
+	return fcu
+}
+
+// SetOrderRequestMode sets the "order_request_mode" field.
+func (fcu *FiatCurrencyUpdate) SetOrderRequestMode(frm fiatcurrency.OrderRequestMode) *FiatCurrencyUpdate {
+	fcu.mutation.SetOrderRequestMode(frm)
+	return fcu
+}
+
+// SetNillableOrderRequestMode sets the "order_request_mode" field if the given value is not nil.
+func (fcu *FiatCurrencyUpdate) SetNillableOrderRequestMode(frm *fiatcurrency.OrderRequestMode) *FiatCurrencyUpdate {
+	if frm != nil {
+		fcu.SetOrderRequestMode(*frm)
+	}
+	return fcu
+}
+
 // AddProviderIDs adds the "providers" edge to the ProviderProfile entity by IDs.
 func (fcu *FiatCurrencyUpdate) AddProviderIDs(ids ...string) *FiatCurrencyUpdate {
 	fcu.mutation.AddProviderIDs(ids...)
@@ -195,6 +250,100 @@ func (fcu *FiatCurrencyUpdate) AddInstitutions(i ...*Institution) *FiatCurrencyU
 	return fcu.AddInstitutionIDs(ids...)
 }
 
+// AddProviderOrderTokenIDs adds the "provider_order_tokens" edge to the ProviderOrderToken entity by IDs.
+func (fcu *FiatCurrencyUpdate) AddProviderOrderTokenIDs(ids ...int) *FiatCurrencyUpdate {
+	fcu.mutation.AddProviderOrderTokenIDs(ids...)
+	return fcu
+}
+
+// AddProviderOrderTokens adds the "provider_order_tokens" edges to the ProviderOrderToken entity.
+func (fcu *FiatCurrencyUpdate) AddProviderOrderTokens(p ...*ProviderOrderToken) *FiatCurrencyUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcu.AddProviderOrderTokenIDs(ids...)
+}
+
+// AddPublicHolidayIDs adds the "public_holidays" edge to the PublicHoliday entity by IDs.
+func (fcu *FiatCurrencyUpdate) AddPublicHolidayIDs(ids ...int) *FiatCurrencyUpdate {
+	fcu.mutation.AddPublicHolidayIDs(ids...)
+	return fcu
+}
+
+// AddPublicHolidays adds the "public_holidays" edges to the PublicHoliday entity.
+func (fcu *FiatCurrencyUpdate) AddPublicHolidays(p ...*PublicHoliday) *FiatCurrencyUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcu.AddPublicHolidayIDs(ids...)
+}
+
+// AddBucketProposalIDs adds the "bucket_proposals" edge to the BucketProposal entity by IDs.
+func (fcu *FiatCurrencyUpdate) AddBucketProposalIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.AddBucketProposalIDs(ids...)
+	return fcu
+}
+
+// AddBucketProposals adds the "bucket_proposals" edges to the BucketProposal entity.
+func (fcu *FiatCurrencyUpdate) AddBucketProposals(b ...*BucketProposal) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return fcu.AddBucketProposalIDs(ids...)
+}
+
+// AddRateSnapshotIDs adds the "rate_snapshots" edge to the RateSnapshot entity by IDs.
+func (fcu *FiatCurrencyUpdate) AddRateSnapshotIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.AddRateSnapshotIDs(ids...)
+	return fcu
+}
+
+// AddRateSnapshots adds the "rate_snapshots" edges to the RateSnapshot entity.
+func (fcu *FiatCurrencyUpdate) AddRateSnapshots(r ...*RateSnapshot) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return fcu.AddRateSnapshotIDs(ids...)
+}
+
+// SetRateCircuitBreakerID sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity by ID.
+func (fcu *FiatCurrencyUpdate) SetRateCircuitBreakerID(id uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.SetRateCircuitBreakerID(id)
+	return fcu
+}
+
+// SetNillableRateCircuitBreakerID sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity by ID if the given value is not nil.
+func (fcu *FiatCurrencyUpdate) SetNillableRateCircuitBreakerID(id *uuid.UUID) *FiatCurrencyUpdate {
+	if id != nil {
+		fcu = fcu.SetRateCircuitBreakerID(*id)
+	}
+	return fcu
+}
+
+// SetRateCircuitBreaker sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity.
+func (fcu *FiatCurrencyUpdate) SetRateCircuitBreaker(r *RateCircuitBreaker) *FiatCurrencyUpdate {
+	return fcu.SetRateCircuitBreakerID(r.ID)
+}
+
+// AddTokenMarketRateIDs adds the "token_market_rates" edge to the TokenMarketRate entity by IDs.
+func (fcu *FiatCurrencyUpdate) AddTokenMarketRateIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.AddTokenMarketRateIDs(ids...)
+	return fcu
+}
+
+// AddTokenMarketRates adds the "token_market_rates" edges to the TokenMarketRate entity.
+func (fcu *FiatCurrencyUpdate) AddTokenMarketRates(t ...*TokenMarketRate) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fcu.AddTokenMarketRateIDs(ids...)
+}
+
 // Mutation returns the FiatCurrencyMutation object of the builder.
 func (fcu *FiatCurrencyUpdate) Mutation() *FiatCurrencyMutation {
 	return fcu.mutation
@@ -263,6 +412,117 @@ func (fcu *FiatCurrencyUpdate) RemoveInstitutions(i ...*Institution) *FiatCurren
 	return fcu.RemoveInstitutionIDs(ids...)
 }
 
+// ClearProviderOrderTokens clears all "provider_order_tokens" edges to the ProviderOrderToken entity.
+func (fcu *FiatCurrencyUpdate) ClearProviderOrderTokens() *FiatCurrencyUpdate {
+	fcu.mutation.ClearProviderOrderTokens()
+	return fcu
+}
+
+// RemoveProviderOrderTokenIDs removes the "provider_order_tokens" edge to ProviderOrderToken entities by IDs.
+func (fcu *FiatCurrencyUpdate) RemoveProviderOrderTokenIDs(ids ...int) *FiatCurrencyUpdate {
+	fcu.mutation.RemoveProviderOrderTokenIDs(ids...)
+	return fcu
+}
+
+// RemoveProviderOrderTokens removes "provider_order_tokens" edges to ProviderOrderToken entities.
+func (fcu *FiatCurrencyUpdate) RemoveProviderOrderTokens(p ...*ProviderOrderToken) *FiatCurrencyUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcu.RemoveProviderOrderTokenIDs(ids...)
+}
+
+// ClearPublicHolidays clears all "public_holidays" edges to the PublicHoliday entity.
+func (fcu *FiatCurrencyUpdate) ClearPublicHolidays() *FiatCurrencyUpdate {
+	fcu.mutation.ClearPublicHolidays()
+	return fcu
+}
+
+// RemovePublicHolidayIDs removes the "public_holidays" edge to PublicHoliday entities by IDs.
+func (fcu *FiatCurrencyUpdate) RemovePublicHolidayIDs(ids ...int) *FiatCurrencyUpdate {
+	fcu.mutation.RemovePublicHolidayIDs(ids...)
+	return fcu
+}
+
+// RemovePublicHolidays removes "public_holidays" edges to PublicHoliday entities.
+func (fcu *FiatCurrencyUpdate) RemovePublicHolidays(p ...*PublicHoliday) *FiatCurrencyUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcu.RemovePublicHolidayIDs(ids...)
+}
+
+// ClearBucketProposals clears all "bucket_proposals" edges to the BucketProposal entity.
+func (fcu *FiatCurrencyUpdate) ClearBucketProposals() *FiatCurrencyUpdate {
+	fcu.mutation.ClearBucketProposals()
+	return fcu
+}
+
+// RemoveBucketProposalIDs removes the "bucket_proposals" edge to BucketProposal entities by IDs.
+func (fcu *FiatCurrencyUpdate) RemoveBucketProposalIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.RemoveBucketProposalIDs(ids...)
+	return fcu
+}
+
+// RemoveBucketProposals removes "bucket_proposals" edges to BucketProposal entities.
+func (fcu *FiatCurrencyUpdate) RemoveBucketProposals(b ...*BucketProposal) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return fcu.RemoveBucketProposalIDs(ids...)
+}
+
+// ClearRateSnapshots clears all "rate_snapshots" edges to the RateSnapshot entity.
+func (fcu *FiatCurrencyUpdate) ClearRateSnapshots() *FiatCurrencyUpdate {
+	fcu.mutation.ClearRateSnapshots()
+	return fcu
+}
+
+// RemoveRateSnapshotIDs removes the "rate_snapshots" edge to RateSnapshot entities by IDs.
+func (fcu *FiatCurrencyUpdate) RemoveRateSnapshotIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.RemoveRateSnapshotIDs(ids...)
+	return fcu
+}
+
+// RemoveRateSnapshots removes "rate_snapshots" edges to RateSnapshot entities.
+func (fcu *FiatCurrencyUpdate) RemoveRateSnapshots(r ...*RateSnapshot) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return fcu.RemoveRateSnapshotIDs(ids...)
+}
+
+// ClearRateCircuitBreaker clears the "rate_circuit_breaker" edge to the RateCircuitBreaker entity.
+func (fcu *FiatCurrencyUpdate) ClearRateCircuitBreaker() *FiatCurrencyUpdate {
+	fcu.mutation.ClearRateCircuitBreaker()
+	return fcu
+}
+
+// ClearTokenMarketRates clears all "token_market_rates" edges to the TokenMarketRate entity.
+func (fcu *FiatCurrencyUpdate) ClearTokenMarketRates() *FiatCurrencyUpdate {
+	fcu.mutation.ClearTokenMarketRates()
+	return fcu
+}
+
+// RemoveTokenMarketRateIDs removes the "token_market_rates" edge to TokenMarketRate entities by IDs.
+func (fcu *FiatCurrencyUpdate) RemoveTokenMarketRateIDs(ids ...uuid.UUID) *FiatCurrencyUpdate {
+	fcu.mutation.RemoveTokenMarketRateIDs(ids...)
+	return fcu
+}
+
+// RemoveTokenMarketRates removes "token_market_rates" edges to TokenMarketRate entities.
+func (fcu *FiatCurrencyUpdate) RemoveTokenMarketRates(t ...*TokenMarketRate) *FiatCurrencyUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fcu.RemoveTokenMarketRateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (fcu *FiatCurrencyUpdate) Save(ctx context.Context) (int, error) {
 	fcu.defaults()
@@ -299,7 +559,30 @@ func (fcu *FiatCurrencyUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fcu *FiatCurrencyUpdate) check() error {
+	if v, ok := fcu.mutation.FulfillmentValidation(); ok {
+		if err := fiatcurrency.FulfillmentValidationValidator(v); err != nil {
+			return &ValidationError{Name: "fulfillment_validation", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.fulfillment_validation": %w`, err)}
+		}
+	}
+	if v, ok := fcu.mutation.MatchingStrategy(); ok {
+		if err := fiatcurrency.MatchingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "matching_strategy", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.matching_strategy": %w`, err)}
+		}
+	}
+	if v, ok := fcu.mutation.OrderRequestMode(); ok {
+		if err := fiatcurrency.OrderRequestModeValidator(v); err != nil {
+			return &ValidationError{Name: "order_request_mode", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.order_request_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (fcu *FiatCurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fcu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(fiatcurrency.Table, fiatcurrency.Columns, sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID))
 	if ps := fcu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -338,12 +621,24 @@ func (fcu *FiatCurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := fcu.mutation.IsEnabled(); ok {
 		_spec.SetField(fiatcurrency.FieldIsEnabled, field.TypeBool, value)
 	}
+	if value, ok := fcu.mutation.FulfillmentValidation(); ok {
+		_spec.SetField(fiatcurrency.FieldFulfillmentValidation, field.TypeEnum, value)
+	}
+	if value, ok := fcu.mutation.MatchingStrategy(); ok {
+		_spec.SetField(fiatcurrency.FieldMatchingStrategy, field.TypeEnum, value)
+	}
+	if fcu.mutation.MatchingStrategyCleared() {
+		_spec.ClearField(fiatcurrency.FieldMatchingStrategy, field.TypeEnum)
+	}
+	if value, ok := fcu.mutation.OrderRequestMode(); ok {
+		_spec.SetField(fiatcurrency.FieldOrderRequestMode, field.TypeEnum, value)
+	}
 	if fcu.mutation.ProvidersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -353,10 +648,10 @@ func (fcu *FiatCurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := fcu.mutation.RemovedProvidersIDs(); len(nodes) > 0 && !fcu.mutation.ProvidersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -369,10 +664,10 @@ func (fcu *FiatCurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := fcu.mutation.ProvidersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -473,91 +768,345 @@ func (fcu *FiatCurrencyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, fcu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{fiatcurrency.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if fcu.mutation.ProviderOrderTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	fcu.mutation.done = true
-	return n, nil
-}
-
-// FiatCurrencyUpdateOne is the builder for updating a single FiatCurrency entity.
-type FiatCurrencyUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *FiatCurrencyMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (fcuo *FiatCurrencyUpdateOne) SetUpdatedAt(t time.Time) *FiatCurrencyUpdateOne {
-	fcuo.mutation.SetUpdatedAt(t)
-	return fcuo
-}
-
-// SetCode sets the "code" field.
-func (fcuo *FiatCurrencyUpdateOne) SetCode(s string) *FiatCurrencyUpdateOne {
-	fcuo.mutation.SetCode(s)
-	return fcuo
-}
-
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (fcuo *FiatCurrencyUpdateOne) SetNillableCode(s *string) *FiatCurrencyUpdateOne {
-	if s != nil {
-		fcuo.SetCode(*s)
+	if nodes := fcu.mutation.RemovedProviderOrderTokensIDs(); len(nodes) > 0 && !fcu.mutation.ProviderOrderTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return fcuo
-}
-
-// SetShortName sets the "short_name" field.
-func (fcuo *FiatCurrencyUpdateOne) SetShortName(s string) *FiatCurrencyUpdateOne {
-	fcuo.mutation.SetShortName(s)
-	return fcuo
-}
-
-// SetNillableShortName sets the "short_name" field if the given value is not nil.
-func (fcuo *FiatCurrencyUpdateOne) SetNillableShortName(s *string) *FiatCurrencyUpdateOne {
-	if s != nil {
-		fcuo.SetShortName(*s)
+	if nodes := fcu.mutation.ProviderOrderTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return fcuo
-}
-
-// SetDecimals sets the "decimals" field.
-func (fcuo *FiatCurrencyUpdateOne) SetDecimals(i int) *FiatCurrencyUpdateOne {
-	fcuo.mutation.ResetDecimals()
-	fcuo.mutation.SetDecimals(i)
-	return fcuo
-}
-
-// SetNillableDecimals sets the "decimals" field if the given value is not nil.
-func (fcuo *FiatCurrencyUpdateOne) SetNillableDecimals(i *int) *FiatCurrencyUpdateOne {
-	if i != nil {
-		fcuo.SetDecimals(*i)
+	if fcu.mutation.PublicHolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return fcuo
-}
-
-// AddDecimals adds i to the "decimals" field.
-func (fcuo *FiatCurrencyUpdateOne) AddDecimals(i int) *FiatCurrencyUpdateOne {
-	fcuo.mutation.AddDecimals(i)
-	return fcuo
-}
-
-// SetSymbol sets the "symbol" field.
-func (fcuo *FiatCurrencyUpdateOne) SetSymbol(s string) *FiatCurrencyUpdateOne {
-	fcuo.mutation.SetSymbol(s)
-	return fcuo
-}
-
-// SetNillableSymbol sets the "symbol" field if the given value is not nil.
-func (fcuo *FiatCurrencyUpdateOne) SetNillableSymbol(s *string) *FiatCurrencyUpdateOne {
-	if s != nil {
-		fcuo.SetSymbol(*s)
+	if nodes := fcu.mutation.RemovedPublicHolidaysIDs(); len(nodes) > 0 && !fcu.mutation.PublicHolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.PublicHolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcu.mutation.BucketProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.RemovedBucketProposalsIDs(); len(nodes) > 0 && !fcu.mutation.BucketProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.BucketProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcu.mutation.RateSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.RemovedRateSnapshotsIDs(); len(nodes) > 0 && !fcu.mutation.RateSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.RateSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcu.mutation.RateCircuitBreakerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   fiatcurrency.RateCircuitBreakerTable,
+			Columns: []string{fiatcurrency.RateCircuitBreakerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.RateCircuitBreakerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   fiatcurrency.RateCircuitBreakerTable,
+			Columns: []string{fiatcurrency.RateCircuitBreakerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcu.mutation.TokenMarketRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.RemovedTokenMarketRatesIDs(); len(nodes) > 0 && !fcu.mutation.TokenMarketRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcu.mutation.TokenMarketRatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fiatcurrency.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fcu.mutation.done = true
+	return n, nil
+}
+
+// FiatCurrencyUpdateOne is the builder for updating a single FiatCurrency entity.
+type FiatCurrencyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FiatCurrencyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fcuo *FiatCurrencyUpdateOne) SetUpdatedAt(t time.Time) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetUpdatedAt(t)
+	return fcuo
+}
+
+// SetCode sets the "code" field.
+func (fcuo *FiatCurrencyUpdateOne) SetCode(s string) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetCode(s)
+	return fcuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableCode(s *string) *FiatCurrencyUpdateOne {
+	if s != nil {
+		fcuo.SetCode(*s)
+	}
+	return fcuo
+}
+
+// SetShortName sets the "short_name" field.
+func (fcuo *FiatCurrencyUpdateOne) SetShortName(s string) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetShortName(s)
+	return fcuo
+}
+
+// SetNillableShortName sets the "short_name" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableShortName(s *string) *FiatCurrencyUpdateOne {
+	if s != nil {
+		fcuo.SetShortName(*s)
+	}
+	return fcuo
+}
+
+// SetDecimals sets the "decimals" field.
+func (fcuo *FiatCurrencyUpdateOne) SetDecimals(i int) *FiatCurrencyUpdateOne {
+	fcuo.mutation.ResetDecimals()
+	fcuo.mutation.SetDecimals(i)
+	return fcuo
+}
+
+// SetNillableDecimals sets the "decimals" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableDecimals(i *int) *FiatCurrencyUpdateOne {
+	if i != nil {
+		fcuo.SetDecimals(*i)
+	}
+	return fcuo
+}
+
+// AddDecimals adds i to the "decimals" field.
+func (fcuo *FiatCurrencyUpdateOne) AddDecimals(i int) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddDecimals(i)
+	return fcuo
+}
+
+// SetSymbol sets the "symbol" field.
+func (fcuo *FiatCurrencyUpdateOne) SetSymbol(s string) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetSymbol(s)
+	return fcuo
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableSymbol(s *string) *FiatCurrencyUpdateOne {
+	if s != nil {
+		fcuo.SetSymbol(*s)
 	}
 	return fcuo
 }
@@ -611,6 +1160,54 @@ func (fcuo *FiatCurrencyUpdateOne) SetNillableIsEnabled(b *bool) *FiatCurrencyUp
 	return fcuo
 }
 
+// SetFulfillmentValidation sets the "fulfillment_validation" field.
+func (fcuo *FiatCurrencyUpdateOne) SetFulfillmentValidation(fv fiatcurrency.FulfillmentValidation) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetFulfillmentValidation(fv)
+	return fcuo
+}
+
+// SetNillableFulfillmentValidation sets the "fulfillment_validation" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableFulfillmentValidation(fv *fiatcurrency.FulfillmentValidation) *FiatCurrencyUpdateOne {
+	if fv != nil {
+		fcuo.SetFulfillmentValidation(*fv)
+	}
+	return fcuo
+}
+
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (fcuo *FiatCurrencyUpdateOne) SetMatchingStrategy(fs fiatcurrency.MatchingStrategy) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetMatchingStrategy(fs)
+	return fcuo
+}
+
+// SetNillableMatchingStrategy sets the "matching_strategy" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableMatchingStrategy(fs *fiatcurrency.MatchingStrategy) *FiatCurrencyUpdateOne {
+	if fs != nil {
+		fcuo.SetMatchingStrategy(*fs)
+	}
+	return fcuo
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (fcuo *FiatCurrencyUpdateOne) ClearMatchingStrategy() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearMatchingStrategy()
+	return fcuo
+}
+
+// SetOrderRequestMode sets the "order_request_mode" field.
+func (fcuo *FiatCurrencyUpdateOne) SetOrderRequestMode(frm fiatcurrency.OrderRequestMode) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetOrderRequestMode(frm)
+	return fcuo
+}
+
+// SetNillableOrderRequestMode sets the "order_request_mode" field if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableOrderRequestMode(frm *fiatcurrency.OrderRequestMode) *FiatCurrencyUpdateOne {
+	if frm != nil {
+		fcuo.SetOrderRequestMode(*frm)
+	}
+	return fcuo
+}
+
 // AddProviderIDs adds the "providers" edge to the ProviderProfile entity by IDs.
 func (fcuo *FiatCurrencyUpdateOne) AddProviderIDs(ids ...string) *FiatCurrencyUpdateOne {
 	fcuo.mutation.AddProviderIDs(ids...)
@@ -653,7 +1250,101 @@ func (fcuo *FiatCurrencyUpdateOne) AddInstitutions(i ...*Institution) *FiatCurre
 	for j := range i {
 		ids[j] = i[j].ID
 	}
-	return fcuo.AddInstitutionIDs(ids...)
+	return fcuo.AddInstitutionIDs(ids...)
+}
+
+// AddProviderOrderTokenIDs adds the "provider_order_tokens" edge to the ProviderOrderToken entity by IDs.
+func (fcuo *FiatCurrencyUpdateOne) AddProviderOrderTokenIDs(ids ...int) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddProviderOrderTokenIDs(ids...)
+	return fcuo
+}
+
+// AddProviderOrderTokens adds the "provider_order_tokens" edges to the ProviderOrderToken entity.
+func (fcuo *FiatCurrencyUpdateOne) AddProviderOrderTokens(p ...*ProviderOrderToken) *FiatCurrencyUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcuo.AddProviderOrderTokenIDs(ids...)
+}
+
+// AddPublicHolidayIDs adds the "public_holidays" edge to the PublicHoliday entity by IDs.
+func (fcuo *FiatCurrencyUpdateOne) AddPublicHolidayIDs(ids ...int) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddPublicHolidayIDs(ids...)
+	return fcuo
+}
+
+// AddPublicHolidays adds the "public_holidays" edges to the PublicHoliday entity.
+func (fcuo *FiatCurrencyUpdateOne) AddPublicHolidays(p ...*PublicHoliday) *FiatCurrencyUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcuo.AddPublicHolidayIDs(ids...)
+}
+
+// AddBucketProposalIDs adds the "bucket_proposals" edge to the BucketProposal entity by IDs.
+func (fcuo *FiatCurrencyUpdateOne) AddBucketProposalIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddBucketProposalIDs(ids...)
+	return fcuo
+}
+
+// AddBucketProposals adds the "bucket_proposals" edges to the BucketProposal entity.
+func (fcuo *FiatCurrencyUpdateOne) AddBucketProposals(b ...*BucketProposal) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return fcuo.AddBucketProposalIDs(ids...)
+}
+
+// AddRateSnapshotIDs adds the "rate_snapshots" edge to the RateSnapshot entity by IDs.
+func (fcuo *FiatCurrencyUpdateOne) AddRateSnapshotIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddRateSnapshotIDs(ids...)
+	return fcuo
+}
+
+// AddRateSnapshots adds the "rate_snapshots" edges to the RateSnapshot entity.
+func (fcuo *FiatCurrencyUpdateOne) AddRateSnapshots(r ...*RateSnapshot) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return fcuo.AddRateSnapshotIDs(ids...)
+}
+
+// SetRateCircuitBreakerID sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity by ID.
+func (fcuo *FiatCurrencyUpdateOne) SetRateCircuitBreakerID(id uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.SetRateCircuitBreakerID(id)
+	return fcuo
+}
+
+// SetNillableRateCircuitBreakerID sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity by ID if the given value is not nil.
+func (fcuo *FiatCurrencyUpdateOne) SetNillableRateCircuitBreakerID(id *uuid.UUID) *FiatCurrencyUpdateOne {
+	if id != nil {
+		fcuo = fcuo.SetRateCircuitBreakerID(*id)
+	}
+	return fcuo
+}
+
+// SetRateCircuitBreaker sets the "rate_circuit_breaker" edge to the RateCircuitBreaker entity.
+func (fcuo *FiatCurrencyUpdateOne) SetRateCircuitBreaker(r *RateCircuitBreaker) *FiatCurrencyUpdateOne {
+	return fcuo.SetRateCircuitBreakerID(r.ID)
+}
+
+// AddTokenMarketRateIDs adds the "token_market_rates" edge to the TokenMarketRate entity by IDs.
+func (fcuo *FiatCurrencyUpdateOne) AddTokenMarketRateIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.AddTokenMarketRateIDs(ids...)
+	return fcuo
+}
+
+// AddTokenMarketRates adds the "token_market_rates" edges to the TokenMarketRate entity.
+func (fcuo *FiatCurrencyUpdateOne) AddTokenMarketRates(t ...*TokenMarketRate) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fcuo.AddTokenMarketRateIDs(ids...)
 }
 
 // Mutation returns the FiatCurrencyMutation object of the builder.
@@ -724,6 +1415,117 @@ func (fcuo *FiatCurrencyUpdateOne) RemoveInstitutions(i ...*Institution) *FiatCu
 	return fcuo.RemoveInstitutionIDs(ids...)
 }
 
+// ClearProviderOrderTokens clears all "provider_order_tokens" edges to the ProviderOrderToken entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearProviderOrderTokens() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearProviderOrderTokens()
+	return fcuo
+}
+
+// RemoveProviderOrderTokenIDs removes the "provider_order_tokens" edge to ProviderOrderToken entities by IDs.
+func (fcuo *FiatCurrencyUpdateOne) RemoveProviderOrderTokenIDs(ids ...int) *FiatCurrencyUpdateOne {
+	fcuo.mutation.RemoveProviderOrderTokenIDs(ids...)
+	return fcuo
+}
+
+// RemoveProviderOrderTokens removes "provider_order_tokens" edges to ProviderOrderToken entities.
+func (fcuo *FiatCurrencyUpdateOne) RemoveProviderOrderTokens(p ...*ProviderOrderToken) *FiatCurrencyUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcuo.RemoveProviderOrderTokenIDs(ids...)
+}
+
+// ClearPublicHolidays clears all "public_holidays" edges to the PublicHoliday entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearPublicHolidays() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearPublicHolidays()
+	return fcuo
+}
+
+// RemovePublicHolidayIDs removes the "public_holidays" edge to PublicHoliday entities by IDs.
+func (fcuo *FiatCurrencyUpdateOne) RemovePublicHolidayIDs(ids ...int) *FiatCurrencyUpdateOne {
+	fcuo.mutation.RemovePublicHolidayIDs(ids...)
+	return fcuo
+}
+
+// RemovePublicHolidays removes "public_holidays" edges to PublicHoliday entities.
+func (fcuo *FiatCurrencyUpdateOne) RemovePublicHolidays(p ...*PublicHoliday) *FiatCurrencyUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return fcuo.RemovePublicHolidayIDs(ids...)
+}
+
+// ClearBucketProposals clears all "bucket_proposals" edges to the BucketProposal entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearBucketProposals() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearBucketProposals()
+	return fcuo
+}
+
+// RemoveBucketProposalIDs removes the "bucket_proposals" edge to BucketProposal entities by IDs.
+func (fcuo *FiatCurrencyUpdateOne) RemoveBucketProposalIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.RemoveBucketProposalIDs(ids...)
+	return fcuo
+}
+
+// RemoveBucketProposals removes "bucket_proposals" edges to BucketProposal entities.
+func (fcuo *FiatCurrencyUpdateOne) RemoveBucketProposals(b ...*BucketProposal) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return fcuo.RemoveBucketProposalIDs(ids...)
+}
+
+// ClearRateSnapshots clears all "rate_snapshots" edges to the RateSnapshot entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearRateSnapshots() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearRateSnapshots()
+	return fcuo
+}
+
+// RemoveRateSnapshotIDs removes the "rate_snapshots" edge to RateSnapshot entities by IDs.
+func (fcuo *FiatCurrencyUpdateOne) RemoveRateSnapshotIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.RemoveRateSnapshotIDs(ids...)
+	return fcuo
+}
+
+// RemoveRateSnapshots removes "rate_snapshots" edges to RateSnapshot entities.
+func (fcuo *FiatCurrencyUpdateOne) RemoveRateSnapshots(r ...*RateSnapshot) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return fcuo.RemoveRateSnapshotIDs(ids...)
+}
+
+// ClearRateCircuitBreaker clears the "rate_circuit_breaker" edge to the RateCircuitBreaker entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearRateCircuitBreaker() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearRateCircuitBreaker()
+	return fcuo
+}
+
+// ClearTokenMarketRates clears all "token_market_rates" edges to the TokenMarketRate entity.
+func (fcuo *FiatCurrencyUpdateOne) ClearTokenMarketRates() *FiatCurrencyUpdateOne {
+	fcuo.mutation.ClearTokenMarketRates()
+	return fcuo
+}
+
+// RemoveTokenMarketRateIDs removes the "token_market_rates" edge to TokenMarketRate entities by IDs.
+func (fcuo *FiatCurrencyUpdateOne) RemoveTokenMarketRateIDs(ids ...uuid.UUID) *FiatCurrencyUpdateOne {
+	fcuo.mutation.RemoveTokenMarketRateIDs(ids...)
+	return fcuo
+}
+
+// RemoveTokenMarketRates removes "token_market_rates" edges to TokenMarketRate entities.
+func (fcuo *FiatCurrencyUpdateOne) RemoveTokenMarketRates(t ...*TokenMarketRate) *FiatCurrencyUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fcuo.RemoveTokenMarketRateIDs(ids...)
+}
+
 // Where appends a list predicates to the FiatCurrencyUpdate builder.
 func (fcuo *FiatCurrencyUpdateOne) Where(ps ...predicate.FiatCurrency) *FiatCurrencyUpdateOne {
 	fcuo.mutation.Where(ps...)
@@ -773,7 +1575,30 @@ func (fcuo *FiatCurrencyUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fcuo *FiatCurrencyUpdateOne) check() error {
+	if v, ok := fcuo.mutation.FulfillmentValidation(); ok {
+		if err := fiatcurrency.FulfillmentValidationValidator(v); err != nil {
+			return &ValidationError{Name: "fulfillment_validation", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.fulfillment_validation": %w`, err)}
+		}
+	}
+	if v, ok := fcuo.mutation.MatchingStrategy(); ok {
+		if err := fiatcurrency.MatchingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "matching_strategy", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.matching_strategy": %w`, err)}
+		}
+	}
+	if v, ok := fcuo.mutation.OrderRequestMode(); ok {
+		if err := fiatcurrency.OrderRequestModeValidator(v); err != nil {
+			return &ValidationError{Name: "order_request_mode", err: fmt.Errorf(`ent: validator failed for field "FiatCurrency.order_request_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (fcuo *FiatCurrencyUpdateOne) sqlSave(ctx context.Context) (_node *FiatCurrency, err error) {
+	if err := fcuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(fiatcurrency.Table, fiatcurrency.Columns, sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID))
 	id, ok := fcuo.mutation.ID()
 	if !ok {
@@ -829,12 +1654,24 @@ func (fcuo *FiatCurrencyUpdateOne) sqlSave(ctx context.Context) (_node *FiatCurr
 	if value, ok := fcuo.mutation.IsEnabled(); ok {
 		_spec.SetField(fiatcurrency.FieldIsEnabled, field.TypeBool, value)
 	}
+	if value, ok := fcuo.mutation.FulfillmentValidation(); ok {
+		_spec.SetField(fiatcurrency.FieldFulfillmentValidation, field.TypeEnum, value)
+	}
+	if value, ok := fcuo.mutation.MatchingStrategy(); ok {
+		_spec.SetField(fiatcurrency.FieldMatchingStrategy, field.TypeEnum, value)
+	}
+	if fcuo.mutation.MatchingStrategyCleared() {
+		_spec.ClearField(fiatcurrency.FieldMatchingStrategy, field.TypeEnum)
+	}
+	if value, ok := fcuo.mutation.OrderRequestMode(); ok {
+		_spec.SetField(fiatcurrency.FieldOrderRequestMode, field.TypeEnum, value)
+	}
 	if fcuo.mutation.ProvidersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -844,10 +1681,10 @@ func (fcuo *FiatCurrencyUpdateOne) sqlSave(ctx context.Context) (_node *FiatCurr
 	}
 	if nodes := fcuo.mutation.RemovedProvidersIDs(); len(nodes) > 0 && !fcuo.mutation.ProvidersCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -860,10 +1697,10 @@ func (fcuo *FiatCurrencyUpdateOne) sqlSave(ctx context.Context) (_node *FiatCurr
 	}
 	if nodes := fcuo.mutation.ProvidersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   fiatcurrency.ProvidersTable,
-			Columns: []string{fiatcurrency.ProvidersColumn},
+			Columns: fiatcurrency.ProvidersPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
@@ -964,6 +1801,260 @@ func (fcuo *FiatCurrencyUpdateOne) sqlSave(ctx context.Context) (_node *FiatCurr
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fcuo.mutation.ProviderOrderTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RemovedProviderOrderTokensIDs(); len(nodes) > 0 && !fcuo.mutation.ProviderOrderTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.ProviderOrderTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.ProviderOrderTokensTable,
+			Columns: []string{fiatcurrency.ProviderOrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcuo.mutation.PublicHolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RemovedPublicHolidaysIDs(); len(nodes) > 0 && !fcuo.mutation.PublicHolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.PublicHolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.PublicHolidaysTable,
+			Columns: []string{fiatcurrency.PublicHolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcuo.mutation.BucketProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RemovedBucketProposalsIDs(); len(nodes) > 0 && !fcuo.mutation.BucketProposalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.BucketProposalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.BucketProposalsTable,
+			Columns: []string{fiatcurrency.BucketProposalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bucketproposal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcuo.mutation.RateSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RemovedRateSnapshotsIDs(); len(nodes) > 0 && !fcuo.mutation.RateSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RateSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.RateSnapshotsTable,
+			Columns: []string{fiatcurrency.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcuo.mutation.RateCircuitBreakerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   fiatcurrency.RateCircuitBreakerTable,
+			Columns: []string{fiatcurrency.RateCircuitBreakerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RateCircuitBreakerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   fiatcurrency.RateCircuitBreakerTable,
+			Columns: []string{fiatcurrency.RateCircuitBreakerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fcuo.mutation.TokenMarketRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.RemovedTokenMarketRatesIDs(); len(nodes) > 0 && !fcuo.mutation.TokenMarketRatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fcuo.mutation.TokenMarketRatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   fiatcurrency.TokenMarketRatesTable,
+			Columns: []string{fiatcurrency.TokenMarketRatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &FiatCurrency{config: fcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -21,6 +21,54 @@ func (f APIKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.APIKeyMutation", m)
 }
 
+// The BucketProposalFunc type is an adapter to allow the use of ordinary
+// function as BucketProposal mutator.
+type BucketProposalFunc func(context.Context, *ent.BucketProposalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BucketProposalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BucketProposalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BucketProposalMutation", m)
+}
+
+// The DeadLetterOrderFunc type is an adapter to allow the use of ordinary
+// function as DeadLetterOrder mutator.
+type DeadLetterOrderFunc func(context.Context, *ent.DeadLetterOrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeadLetterOrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeadLetterOrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeadLetterOrderMutation", m)
+}
+
+// The DisputeFunc type is an adapter to allow the use of ordinary
+// function as Dispute mutator.
+type DisputeFunc func(context.Context, *ent.DisputeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DisputeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DisputeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DisputeMutation", m)
+}
+
+// The DisputeEvidenceFunc type is an adapter to allow the use of ordinary
+// function as DisputeEvidence mutator.
+type DisputeEvidenceFunc func(context.Context, *ent.DisputeEvidenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DisputeEvidenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DisputeEvidenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DisputeEvidenceMutation", m)
+}
+
 // The FiatCurrencyFunc type is an adapter to allow the use of ordinary
 // function as FiatCurrency mutator.
 type FiatCurrencyFunc func(context.Context, *ent.FiatCurrencyMutation) (ent.Value, error)
@@ -105,6 +153,18 @@ func (f NetworkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NetworkMutation", m)
 }
 
+// The OrderAssignmentFunc type is an adapter to allow the use of ordinary
+// function as OrderAssignment mutator.
+type OrderAssignmentFunc func(context.Context, *ent.OrderAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderAssignmentMutation", m)
+}
+
 // The PaymentOrderFunc type is an adapter to allow the use of ordinary
 // function as PaymentOrder mutator.
 type PaymentOrderFunc func(context.Context, *ent.PaymentOrderMutation) (ent.Value, error)
@@ -129,6 +189,18 @@ func (f PaymentOrderRecipientFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentOrderRecipientMutation", m)
 }
 
+// The ProviderHealthCheckFunc type is an adapter to allow the use of ordinary
+// function as ProviderHealthCheck mutator.
+type ProviderHealthCheckFunc func(context.Context, *ent.ProviderHealthCheckMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProviderHealthCheckFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProviderHealthCheckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderHealthCheckMutation", m)
+}
+
 // The ProviderOrderTokenFunc type is an adapter to allow the use of ordinary
 // function as ProviderOrderToken mutator.
 type ProviderOrderTokenFunc func(context.Context, *ent.ProviderOrderTokenMutation) (ent.Value, error)
@@ -165,6 +237,18 @@ func (f ProviderRatingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderRatingMutation", m)
 }
 
+// The ProviderSLARecordFunc type is an adapter to allow the use of ordinary
+// function as ProviderSLARecord mutator.
+type ProviderSLARecordFunc func(context.Context, *ent.ProviderSLARecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProviderSLARecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProviderSLARecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProviderSLARecordMutation", m)
+}
+
 // The ProvisionBucketFunc type is an adapter to allow the use of ordinary
 // function as ProvisionBucket mutator.
 type ProvisionBucketFunc func(context.Context, *ent.ProvisionBucketMutation) (ent.Value, error)
@@ -177,6 +261,42 @@ func (f ProvisionBucketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProvisionBucketMutation", m)
 }
 
+// The PublicHolidayFunc type is an adapter to allow the use of ordinary
+// function as PublicHoliday mutator.
+type PublicHolidayFunc func(context.Context, *ent.PublicHolidayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PublicHolidayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PublicHolidayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PublicHolidayMutation", m)
+}
+
+// The RateCircuitBreakerFunc type is an adapter to allow the use of ordinary
+// function as RateCircuitBreaker mutator.
+type RateCircuitBreakerFunc func(context.Context, *ent.RateCircuitBreakerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RateCircuitBreakerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RateCircuitBreakerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RateCircuitBreakerMutation", m)
+}
+
+// The RateSnapshotFunc type is an adapter to allow the use of ordinary
+// function as RateSnapshot mutator.
+type RateSnapshotFunc func(context.Context, *ent.RateSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RateSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RateSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RateSnapshotMutation", m)
+}
+
 // The ReceiveAddressFunc type is an adapter to allow the use of ordinary
 // function as ReceiveAddress mutator.
 type ReceiveAddressFunc func(context.Context, *ent.ReceiveAddressMutation) (ent.Value, error)
@@ -213,6 +333,42 @@ func (f SenderProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SenderProfileMutation", m)
 }
 
+// The TeamAuditLogFunc type is an adapter to allow the use of ordinary
+// function as TeamAuditLog mutator.
+type TeamAuditLogFunc func(context.Context, *ent.TeamAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamAuditLogMutation", m)
+}
+
+// The TeamInvitationFunc type is an adapter to allow the use of ordinary
+// function as TeamInvitation mutator.
+type TeamInvitationFunc func(context.Context, *ent.TeamInvitationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamInvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamInvitationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamInvitationMutation", m)
+}
+
+// The TeamMemberFunc type is an adapter to allow the use of ordinary
+// function as TeamMember mutator.
+type TeamMemberFunc func(context.Context, *ent.TeamMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamMemberMutation", m)
+}
+
 // The TokenFunc type is an adapter to allow the use of ordinary
 // function as Token mutator.
 type TokenFunc func(context.Context, *ent.TokenMutation) (ent.Value, error)
@@ -225,6 +381,18 @@ func (f TokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenMutation", m)
 }
 
+// The TokenMarketRateFunc type is an adapter to allow the use of ordinary
+// function as TokenMarketRate mutator.
+type TokenMarketRateFunc func(context.Context, *ent.TokenMarketRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenMarketRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenMarketRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenMarketRateMutation", m)
+}
+
 // The TransactionLogFunc type is an adapter to allow the use of ordinary
 // function as TransactionLog mutator.
 type TransactionLogFunc func(context.Context, *ent.TransactionLogMutation) (ent.Value, error)
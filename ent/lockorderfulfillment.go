@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -31,6 +32,20 @@ type LockOrderFulfillment struct {
 	ValidationStatus lockorderfulfillment.ValidationStatus `json:"validation_status,omitempty"`
 	// ValidationError holds the value of the "validation_error" field.
 	ValidationError string `json:"validation_error,omitempty"`
+	// PspSessionID holds the value of the "psp_session_id" field.
+	PspSessionID string `json:"psp_session_id,omitempty"`
+	// BankReference holds the value of the "bank_reference" field.
+	BankReference string `json:"bank_reference,omitempty"`
+	// TransferredAt holds the value of the "transferred_at" field.
+	TransferredAt time.Time `json:"transferred_at,omitempty"`
+	// ReceiptKey holds the value of the "receipt_key" field.
+	ReceiptKey string `json:"receipt_key,omitempty"`
+	// ReceiptContentType holds the value of the "receipt_content_type" field.
+	ReceiptContentType string `json:"receipt_content_type,omitempty"`
+	// RecipientConfirmation holds the value of the "recipient_confirmation" field.
+	RecipientConfirmation map[string]interface{} `json:"recipient_confirmation,omitempty"`
+	// PspConfirmedAt holds the value of the "psp_confirmed_at" field.
+	PspConfirmedAt time.Time `json:"psp_confirmed_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LockOrderFulfillmentQuery when eager-loading is set.
 	Edges                           LockOrderFulfillmentEdges `json:"edges"`
@@ -63,9 +78,11 @@ func (*LockOrderFulfillment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case lockorderfulfillment.FieldTxID, lockorderfulfillment.FieldPsp, lockorderfulfillment.FieldValidationStatus, lockorderfulfillment.FieldValidationError:
+		case lockorderfulfillment.FieldRecipientConfirmation:
+			values[i] = new([]byte)
+		case lockorderfulfillment.FieldTxID, lockorderfulfillment.FieldPsp, lockorderfulfillment.FieldValidationStatus, lockorderfulfillment.FieldValidationError, lockorderfulfillment.FieldPspSessionID, lockorderfulfillment.FieldBankReference, lockorderfulfillment.FieldReceiptKey, lockorderfulfillment.FieldReceiptContentType:
 			values[i] = new(sql.NullString)
-		case lockorderfulfillment.FieldCreatedAt, lockorderfulfillment.FieldUpdatedAt:
+		case lockorderfulfillment.FieldCreatedAt, lockorderfulfillment.FieldUpdatedAt, lockorderfulfillment.FieldTransferredAt, lockorderfulfillment.FieldPspConfirmedAt:
 			values[i] = new(sql.NullTime)
 		case lockorderfulfillment.FieldID:
 			values[i] = new(uuid.UUID)
@@ -128,6 +145,50 @@ func (lof *LockOrderFulfillment) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				lof.ValidationError = value.String
 			}
+		case lockorderfulfillment.FieldPspSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field psp_session_id", values[i])
+			} else if value.Valid {
+				lof.PspSessionID = value.String
+			}
+		case lockorderfulfillment.FieldBankReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bank_reference", values[i])
+			} else if value.Valid {
+				lof.BankReference = value.String
+			}
+		case lockorderfulfillment.FieldTransferredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field transferred_at", values[i])
+			} else if value.Valid {
+				lof.TransferredAt = value.Time
+			}
+		case lockorderfulfillment.FieldReceiptKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_key", values[i])
+			} else if value.Valid {
+				lof.ReceiptKey = value.String
+			}
+		case lockorderfulfillment.FieldReceiptContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_content_type", values[i])
+			} else if value.Valid {
+				lof.ReceiptContentType = value.String
+			}
+		case lockorderfulfillment.FieldRecipientConfirmation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_confirmation", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &lof.RecipientConfirmation); err != nil {
+					return fmt.Errorf("unmarshal field recipient_confirmation: %w", err)
+				}
+			}
+		case lockorderfulfillment.FieldPspConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field psp_confirmed_at", values[i])
+			} else if value.Valid {
+				lof.PspConfirmedAt = value.Time
+			}
 		case lockorderfulfillment.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field lock_payment_order_fulfillments", values[i])
@@ -193,6 +254,27 @@ func (lof *LockOrderFulfillment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("validation_error=")
 	builder.WriteString(lof.ValidationError)
+	builder.WriteString(", ")
+	builder.WriteString("psp_session_id=")
+	builder.WriteString(lof.PspSessionID)
+	builder.WriteString(", ")
+	builder.WriteString("bank_reference=")
+	builder.WriteString(lof.BankReference)
+	builder.WriteString(", ")
+	builder.WriteString("transferred_at=")
+	builder.WriteString(lof.TransferredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("receipt_key=")
+	builder.WriteString(lof.ReceiptKey)
+	builder.WriteString(", ")
+	builder.WriteString("receipt_content_type=")
+	builder.WriteString(lof.ReceiptContentType)
+	builder.WriteString(", ")
+	builder.WriteString("recipient_confirmation=")
+	builder.WriteString(fmt.Sprintf("%v", lof.RecipientConfirmation))
+	builder.WriteString(", ")
+	builder.WriteString("psp_confirmed_at=")
+	builder.WriteString(lof.PspConfirmedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,6 +28,20 @@ const (
 	FieldValidationStatus = "validation_status"
 	// FieldValidationError holds the string denoting the validation_error field in the database.
 	FieldValidationError = "validation_error"
+	// FieldPspSessionID holds the string denoting the psp_session_id field in the database.
+	FieldPspSessionID = "psp_session_id"
+	// FieldBankReference holds the string denoting the bank_reference field in the database.
+	FieldBankReference = "bank_reference"
+	// FieldTransferredAt holds the string denoting the transferred_at field in the database.
+	FieldTransferredAt = "transferred_at"
+	// FieldReceiptKey holds the string denoting the receipt_key field in the database.
+	FieldReceiptKey = "receipt_key"
+	// FieldReceiptContentType holds the string denoting the receipt_content_type field in the database.
+	FieldReceiptContentType = "receipt_content_type"
+	// FieldRecipientConfirmation holds the string denoting the recipient_confirmation field in the database.
+	FieldRecipientConfirmation = "recipient_confirmation"
+	// FieldPspConfirmedAt holds the string denoting the psp_confirmed_at field in the database.
+	FieldPspConfirmedAt = "psp_confirmed_at"
 	// EdgeOrder holds the string denoting the order edge name in mutations.
 	EdgeOrder = "order"
 	// Table holds the table name of the lockorderfulfillment in the database.
@@ -50,6 +64,13 @@ var Columns = []string{
 	FieldPsp,
 	FieldValidationStatus,
 	FieldValidationError,
+	FieldPspSessionID,
+	FieldBankReference,
+	FieldTransferredAt,
+	FieldReceiptKey,
+	FieldReceiptContentType,
+	FieldRecipientConfirmation,
+	FieldPspConfirmedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "lock_order_fulfillments"
@@ -149,6 +170,36 @@ func ByValidationError(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldValidationError, opts...).ToFunc()
 }
 
+// ByPspSessionID orders the results by the psp_session_id field.
+func ByPspSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPspSessionID, opts...).ToFunc()
+}
+
+// ByBankReference orders the results by the bank_reference field.
+func ByBankReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBankReference, opts...).ToFunc()
+}
+
+// ByTransferredAt orders the results by the transferred_at field.
+func ByTransferredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferredAt, opts...).ToFunc()
+}
+
+// ByReceiptKey orders the results by the receipt_key field.
+func ByReceiptKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptKey, opts...).ToFunc()
+}
+
+// ByReceiptContentType orders the results by the receipt_content_type field.
+func ByReceiptContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptContentType, opts...).ToFunc()
+}
+
+// ByPspConfirmedAt orders the results by the psp_confirmed_at field.
+func ByPspConfirmedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPspConfirmedAt, opts...).ToFunc()
+}
+
 // ByOrderField orders the results by order field.
 func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -81,6 +81,36 @@ func ValidationError(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldValidationError, v))
 }
 
+// PspSessionID applies equality check predicate on the "psp_session_id" field. It's identical to PspSessionIDEQ.
+func PspSessionID(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldPspSessionID, v))
+}
+
+// BankReference applies equality check predicate on the "bank_reference" field. It's identical to BankReferenceEQ.
+func BankReference(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldBankReference, v))
+}
+
+// TransferredAt applies equality check predicate on the "transferred_at" field. It's identical to TransferredAtEQ.
+func TransferredAt(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldTransferredAt, v))
+}
+
+// ReceiptKey applies equality check predicate on the "receipt_key" field. It's identical to ReceiptKeyEQ.
+func ReceiptKey(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldReceiptKey, v))
+}
+
+// ReceiptContentType applies equality check predicate on the "receipt_content_type" field. It's identical to ReceiptContentTypeEQ.
+func ReceiptContentType(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldReceiptContentType, v))
+}
+
+// PspConfirmedAt applies equality check predicate on the "psp_confirmed_at" field. It's identical to PspConfirmedAtEQ.
+func PspConfirmedAt(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldPspConfirmedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldCreatedAt, v))
@@ -396,6 +426,416 @@ func ValidationErrorContainsFold(v string) predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(sql.FieldContainsFold(FieldValidationError, v))
 }
 
+// PspSessionIDEQ applies the EQ predicate on the "psp_session_id" field.
+func PspSessionIDEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldPspSessionID, v))
+}
+
+// PspSessionIDNEQ applies the NEQ predicate on the "psp_session_id" field.
+func PspSessionIDNEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldPspSessionID, v))
+}
+
+// PspSessionIDIn applies the In predicate on the "psp_session_id" field.
+func PspSessionIDIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldPspSessionID, vs...))
+}
+
+// PspSessionIDNotIn applies the NotIn predicate on the "psp_session_id" field.
+func PspSessionIDNotIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldPspSessionID, vs...))
+}
+
+// PspSessionIDGT applies the GT predicate on the "psp_session_id" field.
+func PspSessionIDGT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldPspSessionID, v))
+}
+
+// PspSessionIDGTE applies the GTE predicate on the "psp_session_id" field.
+func PspSessionIDGTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldPspSessionID, v))
+}
+
+// PspSessionIDLT applies the LT predicate on the "psp_session_id" field.
+func PspSessionIDLT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldPspSessionID, v))
+}
+
+// PspSessionIDLTE applies the LTE predicate on the "psp_session_id" field.
+func PspSessionIDLTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldPspSessionID, v))
+}
+
+// PspSessionIDContains applies the Contains predicate on the "psp_session_id" field.
+func PspSessionIDContains(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContains(FieldPspSessionID, v))
+}
+
+// PspSessionIDHasPrefix applies the HasPrefix predicate on the "psp_session_id" field.
+func PspSessionIDHasPrefix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasPrefix(FieldPspSessionID, v))
+}
+
+// PspSessionIDHasSuffix applies the HasSuffix predicate on the "psp_session_id" field.
+func PspSessionIDHasSuffix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasSuffix(FieldPspSessionID, v))
+}
+
+// PspSessionIDIsNil applies the IsNil predicate on the "psp_session_id" field.
+func PspSessionIDIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldPspSessionID))
+}
+
+// PspSessionIDNotNil applies the NotNil predicate on the "psp_session_id" field.
+func PspSessionIDNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldPspSessionID))
+}
+
+// PspSessionIDEqualFold applies the EqualFold predicate on the "psp_session_id" field.
+func PspSessionIDEqualFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEqualFold(FieldPspSessionID, v))
+}
+
+// PspSessionIDContainsFold applies the ContainsFold predicate on the "psp_session_id" field.
+func PspSessionIDContainsFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContainsFold(FieldPspSessionID, v))
+}
+
+// BankReferenceEQ applies the EQ predicate on the "bank_reference" field.
+func BankReferenceEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldBankReference, v))
+}
+
+// BankReferenceNEQ applies the NEQ predicate on the "bank_reference" field.
+func BankReferenceNEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldBankReference, v))
+}
+
+// BankReferenceIn applies the In predicate on the "bank_reference" field.
+func BankReferenceIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldBankReference, vs...))
+}
+
+// BankReferenceNotIn applies the NotIn predicate on the "bank_reference" field.
+func BankReferenceNotIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldBankReference, vs...))
+}
+
+// BankReferenceGT applies the GT predicate on the "bank_reference" field.
+func BankReferenceGT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldBankReference, v))
+}
+
+// BankReferenceGTE applies the GTE predicate on the "bank_reference" field.
+func BankReferenceGTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldBankReference, v))
+}
+
+// BankReferenceLT applies the LT predicate on the "bank_reference" field.
+func BankReferenceLT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldBankReference, v))
+}
+
+// BankReferenceLTE applies the LTE predicate on the "bank_reference" field.
+func BankReferenceLTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldBankReference, v))
+}
+
+// BankReferenceContains applies the Contains predicate on the "bank_reference" field.
+func BankReferenceContains(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContains(FieldBankReference, v))
+}
+
+// BankReferenceHasPrefix applies the HasPrefix predicate on the "bank_reference" field.
+func BankReferenceHasPrefix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasPrefix(FieldBankReference, v))
+}
+
+// BankReferenceHasSuffix applies the HasSuffix predicate on the "bank_reference" field.
+func BankReferenceHasSuffix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasSuffix(FieldBankReference, v))
+}
+
+// BankReferenceIsNil applies the IsNil predicate on the "bank_reference" field.
+func BankReferenceIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldBankReference))
+}
+
+// BankReferenceNotNil applies the NotNil predicate on the "bank_reference" field.
+func BankReferenceNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldBankReference))
+}
+
+// BankReferenceEqualFold applies the EqualFold predicate on the "bank_reference" field.
+func BankReferenceEqualFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEqualFold(FieldBankReference, v))
+}
+
+// BankReferenceContainsFold applies the ContainsFold predicate on the "bank_reference" field.
+func BankReferenceContainsFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContainsFold(FieldBankReference, v))
+}
+
+// TransferredAtEQ applies the EQ predicate on the "transferred_at" field.
+func TransferredAtEQ(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldTransferredAt, v))
+}
+
+// TransferredAtNEQ applies the NEQ predicate on the "transferred_at" field.
+func TransferredAtNEQ(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldTransferredAt, v))
+}
+
+// TransferredAtIn applies the In predicate on the "transferred_at" field.
+func TransferredAtIn(vs ...time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldTransferredAt, vs...))
+}
+
+// TransferredAtNotIn applies the NotIn predicate on the "transferred_at" field.
+func TransferredAtNotIn(vs ...time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldTransferredAt, vs...))
+}
+
+// TransferredAtGT applies the GT predicate on the "transferred_at" field.
+func TransferredAtGT(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldTransferredAt, v))
+}
+
+// TransferredAtGTE applies the GTE predicate on the "transferred_at" field.
+func TransferredAtGTE(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldTransferredAt, v))
+}
+
+// TransferredAtLT applies the LT predicate on the "transferred_at" field.
+func TransferredAtLT(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldTransferredAt, v))
+}
+
+// TransferredAtLTE applies the LTE predicate on the "transferred_at" field.
+func TransferredAtLTE(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldTransferredAt, v))
+}
+
+// TransferredAtIsNil applies the IsNil predicate on the "transferred_at" field.
+func TransferredAtIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldTransferredAt))
+}
+
+// TransferredAtNotNil applies the NotNil predicate on the "transferred_at" field.
+func TransferredAtNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldTransferredAt))
+}
+
+// ReceiptKeyEQ applies the EQ predicate on the "receipt_key" field.
+func ReceiptKeyEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldReceiptKey, v))
+}
+
+// ReceiptKeyNEQ applies the NEQ predicate on the "receipt_key" field.
+func ReceiptKeyNEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldReceiptKey, v))
+}
+
+// ReceiptKeyIn applies the In predicate on the "receipt_key" field.
+func ReceiptKeyIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldReceiptKey, vs...))
+}
+
+// ReceiptKeyNotIn applies the NotIn predicate on the "receipt_key" field.
+func ReceiptKeyNotIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldReceiptKey, vs...))
+}
+
+// ReceiptKeyGT applies the GT predicate on the "receipt_key" field.
+func ReceiptKeyGT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldReceiptKey, v))
+}
+
+// ReceiptKeyGTE applies the GTE predicate on the "receipt_key" field.
+func ReceiptKeyGTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldReceiptKey, v))
+}
+
+// ReceiptKeyLT applies the LT predicate on the "receipt_key" field.
+func ReceiptKeyLT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldReceiptKey, v))
+}
+
+// ReceiptKeyLTE applies the LTE predicate on the "receipt_key" field.
+func ReceiptKeyLTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldReceiptKey, v))
+}
+
+// ReceiptKeyContains applies the Contains predicate on the "receipt_key" field.
+func ReceiptKeyContains(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContains(FieldReceiptKey, v))
+}
+
+// ReceiptKeyHasPrefix applies the HasPrefix predicate on the "receipt_key" field.
+func ReceiptKeyHasPrefix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasPrefix(FieldReceiptKey, v))
+}
+
+// ReceiptKeyHasSuffix applies the HasSuffix predicate on the "receipt_key" field.
+func ReceiptKeyHasSuffix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasSuffix(FieldReceiptKey, v))
+}
+
+// ReceiptKeyIsNil applies the IsNil predicate on the "receipt_key" field.
+func ReceiptKeyIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldReceiptKey))
+}
+
+// ReceiptKeyNotNil applies the NotNil predicate on the "receipt_key" field.
+func ReceiptKeyNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldReceiptKey))
+}
+
+// ReceiptKeyEqualFold applies the EqualFold predicate on the "receipt_key" field.
+func ReceiptKeyEqualFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEqualFold(FieldReceiptKey, v))
+}
+
+// ReceiptKeyContainsFold applies the ContainsFold predicate on the "receipt_key" field.
+func ReceiptKeyContainsFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContainsFold(FieldReceiptKey, v))
+}
+
+// ReceiptContentTypeEQ applies the EQ predicate on the "receipt_content_type" field.
+func ReceiptContentTypeEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeNEQ applies the NEQ predicate on the "receipt_content_type" field.
+func ReceiptContentTypeNEQ(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeIn applies the In predicate on the "receipt_content_type" field.
+func ReceiptContentTypeIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldReceiptContentType, vs...))
+}
+
+// ReceiptContentTypeNotIn applies the NotIn predicate on the "receipt_content_type" field.
+func ReceiptContentTypeNotIn(vs ...string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldReceiptContentType, vs...))
+}
+
+// ReceiptContentTypeGT applies the GT predicate on the "receipt_content_type" field.
+func ReceiptContentTypeGT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeGTE applies the GTE predicate on the "receipt_content_type" field.
+func ReceiptContentTypeGTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeLT applies the LT predicate on the "receipt_content_type" field.
+func ReceiptContentTypeLT(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeLTE applies the LTE predicate on the "receipt_content_type" field.
+func ReceiptContentTypeLTE(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeContains applies the Contains predicate on the "receipt_content_type" field.
+func ReceiptContentTypeContains(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContains(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeHasPrefix applies the HasPrefix predicate on the "receipt_content_type" field.
+func ReceiptContentTypeHasPrefix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasPrefix(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeHasSuffix applies the HasSuffix predicate on the "receipt_content_type" field.
+func ReceiptContentTypeHasSuffix(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldHasSuffix(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeIsNil applies the IsNil predicate on the "receipt_content_type" field.
+func ReceiptContentTypeIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldReceiptContentType))
+}
+
+// ReceiptContentTypeNotNil applies the NotNil predicate on the "receipt_content_type" field.
+func ReceiptContentTypeNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldReceiptContentType))
+}
+
+// ReceiptContentTypeEqualFold applies the EqualFold predicate on the "receipt_content_type" field.
+func ReceiptContentTypeEqualFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEqualFold(FieldReceiptContentType, v))
+}
+
+// ReceiptContentTypeContainsFold applies the ContainsFold predicate on the "receipt_content_type" field.
+func ReceiptContentTypeContainsFold(v string) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldContainsFold(FieldReceiptContentType, v))
+}
+
+// RecipientConfirmationIsNil applies the IsNil predicate on the "recipient_confirmation" field.
+func RecipientConfirmationIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldRecipientConfirmation))
+}
+
+// RecipientConfirmationNotNil applies the NotNil predicate on the "recipient_confirmation" field.
+func RecipientConfirmationNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldRecipientConfirmation))
+}
+
+// PspConfirmedAtEQ applies the EQ predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtEQ(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldEQ(FieldPspConfirmedAt, v))
+}
+
+// PspConfirmedAtNEQ applies the NEQ predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtNEQ(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNEQ(FieldPspConfirmedAt, v))
+}
+
+// PspConfirmedAtIn applies the In predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtIn(vs ...time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIn(FieldPspConfirmedAt, vs...))
+}
+
+// PspConfirmedAtNotIn applies the NotIn predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtNotIn(vs ...time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotIn(FieldPspConfirmedAt, vs...))
+}
+
+// PspConfirmedAtGT applies the GT predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtGT(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGT(FieldPspConfirmedAt, v))
+}
+
+// PspConfirmedAtGTE applies the GTE predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtGTE(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldGTE(FieldPspConfirmedAt, v))
+}
+
+// PspConfirmedAtLT applies the LT predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtLT(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLT(FieldPspConfirmedAt, v))
+}
+
+// PspConfirmedAtLTE applies the LTE predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtLTE(v time.Time) predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldLTE(FieldPspConfirmedAt, v))
+}
+
+// PspConfirmedAtIsNil applies the IsNil predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtIsNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldIsNull(FieldPspConfirmedAt))
+}
+
+// PspConfirmedAtNotNil applies the NotNil predicate on the "psp_confirmed_at" field.
+func PspConfirmedAtNotNil() predicate.LockOrderFulfillment {
+	return predicate.LockOrderFulfillment(sql.FieldNotNull(FieldPspConfirmedAt))
+}
+
 // HasOrder applies the HasEdge predicate on the "order" edge.
 func HasOrder() predicate.LockOrderFulfillment {
 	return predicate.LockOrderFulfillment(func(s *sql.Selector) {
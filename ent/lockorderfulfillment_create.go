@@ -101,6 +101,96 @@ func (lofc *LockOrderFulfillmentCreate) SetNillableValidationError(s *string) *L
 	return lofc
 }
 
+// SetPspSessionID sets the "psp_session_id" field.
+func (lofc *LockOrderFulfillmentCreate) SetPspSessionID(s string) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetPspSessionID(s)
+	return lofc
+}
+
+// SetNillablePspSessionID sets the "psp_session_id" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillablePspSessionID(s *string) *LockOrderFulfillmentCreate {
+	if s != nil {
+		lofc.SetPspSessionID(*s)
+	}
+	return lofc
+}
+
+// SetBankReference sets the "bank_reference" field.
+func (lofc *LockOrderFulfillmentCreate) SetBankReference(s string) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetBankReference(s)
+	return lofc
+}
+
+// SetNillableBankReference sets the "bank_reference" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillableBankReference(s *string) *LockOrderFulfillmentCreate {
+	if s != nil {
+		lofc.SetBankReference(*s)
+	}
+	return lofc
+}
+
+// SetTransferredAt sets the "transferred_at" field.
+func (lofc *LockOrderFulfillmentCreate) SetTransferredAt(t time.Time) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetTransferredAt(t)
+	return lofc
+}
+
+// SetNillableTransferredAt sets the "transferred_at" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillableTransferredAt(t *time.Time) *LockOrderFulfillmentCreate {
+	if t != nil {
+		lofc.SetTransferredAt(*t)
+	}
+	return lofc
+}
+
+// SetReceiptKey sets the "receipt_key" field.
+func (lofc *LockOrderFulfillmentCreate) SetReceiptKey(s string) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetReceiptKey(s)
+	return lofc
+}
+
+// SetNillableReceiptKey sets the "receipt_key" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillableReceiptKey(s *string) *LockOrderFulfillmentCreate {
+	if s != nil {
+		lofc.SetReceiptKey(*s)
+	}
+	return lofc
+}
+
+// SetReceiptContentType sets the "receipt_content_type" field.
+func (lofc *LockOrderFulfillmentCreate) SetReceiptContentType(s string) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetReceiptContentType(s)
+	return lofc
+}
+
+// SetNillableReceiptContentType sets the "receipt_content_type" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillableReceiptContentType(s *string) *LockOrderFulfillmentCreate {
+	if s != nil {
+		lofc.SetReceiptContentType(*s)
+	}
+	return lofc
+}
+
+// SetRecipientConfirmation sets the "recipient_confirmation" field.
+func (lofc *LockOrderFulfillmentCreate) SetRecipientConfirmation(m map[string]interface{}) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetRecipientConfirmation(m)
+	return lofc
+}
+
+// SetPspConfirmedAt sets the "psp_confirmed_at" field.
+func (lofc *LockOrderFulfillmentCreate) SetPspConfirmedAt(t time.Time) *LockOrderFulfillmentCreate {
+	lofc.mutation.SetPspConfirmedAt(t)
+	return lofc
+}
+
+// SetNillablePspConfirmedAt sets the "psp_confirmed_at" field if the given value is not nil.
+func (lofc *LockOrderFulfillmentCreate) SetNillablePspConfirmedAt(t *time.Time) *LockOrderFulfillmentCreate {
+	if t != nil {
+		lofc.SetPspConfirmedAt(*t)
+	}
+	return lofc
+}
+
 // SetID sets the "id" field.
 func (lofc *LockOrderFulfillmentCreate) SetID(u uuid.UUID) *LockOrderFulfillmentCreate {
 	lofc.mutation.SetID(u)
@@ -261,6 +351,34 @@ func (lofc *LockOrderFulfillmentCreate) createSpec() (*LockOrderFulfillment, *sq
 		_spec.SetField(lockorderfulfillment.FieldValidationError, field.TypeString, value)
 		_node.ValidationError = value
 	}
+	if value, ok := lofc.mutation.PspSessionID(); ok {
+		_spec.SetField(lockorderfulfillment.FieldPspSessionID, field.TypeString, value)
+		_node.PspSessionID = value
+	}
+	if value, ok := lofc.mutation.BankReference(); ok {
+		_spec.SetField(lockorderfulfillment.FieldBankReference, field.TypeString, value)
+		_node.BankReference = value
+	}
+	if value, ok := lofc.mutation.TransferredAt(); ok {
+		_spec.SetField(lockorderfulfillment.FieldTransferredAt, field.TypeTime, value)
+		_node.TransferredAt = value
+	}
+	if value, ok := lofc.mutation.ReceiptKey(); ok {
+		_spec.SetField(lockorderfulfillment.FieldReceiptKey, field.TypeString, value)
+		_node.ReceiptKey = value
+	}
+	if value, ok := lofc.mutation.ReceiptContentType(); ok {
+		_spec.SetField(lockorderfulfillment.FieldReceiptContentType, field.TypeString, value)
+		_node.ReceiptContentType = value
+	}
+	if value, ok := lofc.mutation.RecipientConfirmation(); ok {
+		_spec.SetField(lockorderfulfillment.FieldRecipientConfirmation, field.TypeJSON, value)
+		_node.RecipientConfirmation = value
+	}
+	if value, ok := lofc.mutation.PspConfirmedAt(); ok {
+		_spec.SetField(lockorderfulfillment.FieldPspConfirmedAt, field.TypeTime, value)
+		_node.PspConfirmedAt = value
+	}
 	if nodes := lofc.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -402,6 +520,132 @@ func (u *LockOrderFulfillmentUpsert) ClearValidationError() *LockOrderFulfillmen
 	return u
 }
 
+// SetPspSessionID sets the "psp_session_id" field.
+func (u *LockOrderFulfillmentUpsert) SetPspSessionID(v string) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldPspSessionID, v)
+	return u
+}
+
+// UpdatePspSessionID sets the "psp_session_id" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdatePspSessionID() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldPspSessionID)
+	return u
+}
+
+// ClearPspSessionID clears the value of the "psp_session_id" field.
+func (u *LockOrderFulfillmentUpsert) ClearPspSessionID() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldPspSessionID)
+	return u
+}
+
+// SetBankReference sets the "bank_reference" field.
+func (u *LockOrderFulfillmentUpsert) SetBankReference(v string) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldBankReference, v)
+	return u
+}
+
+// UpdateBankReference sets the "bank_reference" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdateBankReference() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldBankReference)
+	return u
+}
+
+// ClearBankReference clears the value of the "bank_reference" field.
+func (u *LockOrderFulfillmentUpsert) ClearBankReference() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldBankReference)
+	return u
+}
+
+// SetTransferredAt sets the "transferred_at" field.
+func (u *LockOrderFulfillmentUpsert) SetTransferredAt(v time.Time) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldTransferredAt, v)
+	return u
+}
+
+// UpdateTransferredAt sets the "transferred_at" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdateTransferredAt() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldTransferredAt)
+	return u
+}
+
+// ClearTransferredAt clears the value of the "transferred_at" field.
+func (u *LockOrderFulfillmentUpsert) ClearTransferredAt() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldTransferredAt)
+	return u
+}
+
+// SetReceiptKey sets the "receipt_key" field.
+func (u *LockOrderFulfillmentUpsert) SetReceiptKey(v string) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldReceiptKey, v)
+	return u
+}
+
+// UpdateReceiptKey sets the "receipt_key" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdateReceiptKey() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldReceiptKey)
+	return u
+}
+
+// ClearReceiptKey clears the value of the "receipt_key" field.
+func (u *LockOrderFulfillmentUpsert) ClearReceiptKey() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldReceiptKey)
+	return u
+}
+
+// SetReceiptContentType sets the "receipt_content_type" field.
+func (u *LockOrderFulfillmentUpsert) SetReceiptContentType(v string) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldReceiptContentType, v)
+	return u
+}
+
+// UpdateReceiptContentType sets the "receipt_content_type" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdateReceiptContentType() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldReceiptContentType)
+	return u
+}
+
+// ClearReceiptContentType clears the value of the "receipt_content_type" field.
+func (u *LockOrderFulfillmentUpsert) ClearReceiptContentType() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldReceiptContentType)
+	return u
+}
+
+// SetRecipientConfirmation sets the "recipient_confirmation" field.
+func (u *LockOrderFulfillmentUpsert) SetRecipientConfirmation(v map[string]interface{}) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldRecipientConfirmation, v)
+	return u
+}
+
+// UpdateRecipientConfirmation sets the "recipient_confirmation" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdateRecipientConfirmation() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldRecipientConfirmation)
+	return u
+}
+
+// ClearRecipientConfirmation clears the value of the "recipient_confirmation" field.
+func (u *LockOrderFulfillmentUpsert) ClearRecipientConfirmation() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldRecipientConfirmation)
+	return u
+}
+
+// SetPspConfirmedAt sets the "psp_confirmed_at" field.
+func (u *LockOrderFulfillmentUpsert) SetPspConfirmedAt(v time.Time) *LockOrderFulfillmentUpsert {
+	u.Set(lockorderfulfillment.FieldPspConfirmedAt, v)
+	return u
+}
+
+// UpdatePspConfirmedAt sets the "psp_confirmed_at" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsert) UpdatePspConfirmedAt() *LockOrderFulfillmentUpsert {
+	u.SetExcluded(lockorderfulfillment.FieldPspConfirmedAt)
+	return u
+}
+
+// ClearPspConfirmedAt clears the value of the "psp_confirmed_at" field.
+func (u *LockOrderFulfillmentUpsert) ClearPspConfirmedAt() *LockOrderFulfillmentUpsert {
+	u.SetNull(lockorderfulfillment.FieldPspConfirmedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -537,6 +781,153 @@ func (u *LockOrderFulfillmentUpsertOne) ClearValidationError() *LockOrderFulfill
 	})
 }
 
+// SetPspSessionID sets the "psp_session_id" field.
+func (u *LockOrderFulfillmentUpsertOne) SetPspSessionID(v string) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetPspSessionID(v)
+	})
+}
+
+// UpdatePspSessionID sets the "psp_session_id" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdatePspSessionID() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdatePspSessionID()
+	})
+}
+
+// ClearPspSessionID clears the value of the "psp_session_id" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearPspSessionID() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearPspSessionID()
+	})
+}
+
+// SetBankReference sets the "bank_reference" field.
+func (u *LockOrderFulfillmentUpsertOne) SetBankReference(v string) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetBankReference(v)
+	})
+}
+
+// UpdateBankReference sets the "bank_reference" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdateBankReference() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateBankReference()
+	})
+}
+
+// ClearBankReference clears the value of the "bank_reference" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearBankReference() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearBankReference()
+	})
+}
+
+// SetTransferredAt sets the "transferred_at" field.
+func (u *LockOrderFulfillmentUpsertOne) SetTransferredAt(v time.Time) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetTransferredAt(v)
+	})
+}
+
+// UpdateTransferredAt sets the "transferred_at" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdateTransferredAt() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateTransferredAt()
+	})
+}
+
+// ClearTransferredAt clears the value of the "transferred_at" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearTransferredAt() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearTransferredAt()
+	})
+}
+
+// SetReceiptKey sets the "receipt_key" field.
+func (u *LockOrderFulfillmentUpsertOne) SetReceiptKey(v string) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetReceiptKey(v)
+	})
+}
+
+// UpdateReceiptKey sets the "receipt_key" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdateReceiptKey() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateReceiptKey()
+	})
+}
+
+// ClearReceiptKey clears the value of the "receipt_key" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearReceiptKey() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearReceiptKey()
+	})
+}
+
+// SetReceiptContentType sets the "receipt_content_type" field.
+func (u *LockOrderFulfillmentUpsertOne) SetReceiptContentType(v string) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetReceiptContentType(v)
+	})
+}
+
+// UpdateReceiptContentType sets the "receipt_content_type" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdateReceiptContentType() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateReceiptContentType()
+	})
+}
+
+// ClearReceiptContentType clears the value of the "receipt_content_type" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearReceiptContentType() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearReceiptContentType()
+	})
+}
+
+// SetRecipientConfirmation sets the "recipient_confirmation" field.
+func (u *LockOrderFulfillmentUpsertOne) SetRecipientConfirmation(v map[string]interface{}) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetRecipientConfirmation(v)
+	})
+}
+
+// UpdateRecipientConfirmation sets the "recipient_confirmation" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdateRecipientConfirmation() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateRecipientConfirmation()
+	})
+}
+
+// ClearRecipientConfirmation clears the value of the "recipient_confirmation" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearRecipientConfirmation() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearRecipientConfirmation()
+	})
+}
+
+// SetPspConfirmedAt sets the "psp_confirmed_at" field.
+func (u *LockOrderFulfillmentUpsertOne) SetPspConfirmedAt(v time.Time) *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetPspConfirmedAt(v)
+	})
+}
+
+// UpdatePspConfirmedAt sets the "psp_confirmed_at" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertOne) UpdatePspConfirmedAt() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdatePspConfirmedAt()
+	})
+}
+
+// ClearPspConfirmedAt clears the value of the "psp_confirmed_at" field.
+func (u *LockOrderFulfillmentUpsertOne) ClearPspConfirmedAt() *LockOrderFulfillmentUpsertOne {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearPspConfirmedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *LockOrderFulfillmentUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -839,6 +1230,153 @@ func (u *LockOrderFulfillmentUpsertBulk) ClearValidationError() *LockOrderFulfil
 	})
 }
 
+// SetPspSessionID sets the "psp_session_id" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetPspSessionID(v string) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetPspSessionID(v)
+	})
+}
+
+// UpdatePspSessionID sets the "psp_session_id" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdatePspSessionID() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdatePspSessionID()
+	})
+}
+
+// ClearPspSessionID clears the value of the "psp_session_id" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearPspSessionID() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearPspSessionID()
+	})
+}
+
+// SetBankReference sets the "bank_reference" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetBankReference(v string) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetBankReference(v)
+	})
+}
+
+// UpdateBankReference sets the "bank_reference" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdateBankReference() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateBankReference()
+	})
+}
+
+// ClearBankReference clears the value of the "bank_reference" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearBankReference() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearBankReference()
+	})
+}
+
+// SetTransferredAt sets the "transferred_at" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetTransferredAt(v time.Time) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetTransferredAt(v)
+	})
+}
+
+// UpdateTransferredAt sets the "transferred_at" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdateTransferredAt() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateTransferredAt()
+	})
+}
+
+// ClearTransferredAt clears the value of the "transferred_at" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearTransferredAt() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearTransferredAt()
+	})
+}
+
+// SetReceiptKey sets the "receipt_key" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetReceiptKey(v string) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetReceiptKey(v)
+	})
+}
+
+// UpdateReceiptKey sets the "receipt_key" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdateReceiptKey() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateReceiptKey()
+	})
+}
+
+// ClearReceiptKey clears the value of the "receipt_key" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearReceiptKey() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearReceiptKey()
+	})
+}
+
+// SetReceiptContentType sets the "receipt_content_type" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetReceiptContentType(v string) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetReceiptContentType(v)
+	})
+}
+
+// UpdateReceiptContentType sets the "receipt_content_type" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdateReceiptContentType() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateReceiptContentType()
+	})
+}
+
+// ClearReceiptContentType clears the value of the "receipt_content_type" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearReceiptContentType() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearReceiptContentType()
+	})
+}
+
+// SetRecipientConfirmation sets the "recipient_confirmation" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetRecipientConfirmation(v map[string]interface{}) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetRecipientConfirmation(v)
+	})
+}
+
+// UpdateRecipientConfirmation sets the "recipient_confirmation" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdateRecipientConfirmation() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdateRecipientConfirmation()
+	})
+}
+
+// ClearRecipientConfirmation clears the value of the "recipient_confirmation" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearRecipientConfirmation() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearRecipientConfirmation()
+	})
+}
+
+// SetPspConfirmedAt sets the "psp_confirmed_at" field.
+func (u *LockOrderFulfillmentUpsertBulk) SetPspConfirmedAt(v time.Time) *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.SetPspConfirmedAt(v)
+	})
+}
+
+// UpdatePspConfirmedAt sets the "psp_confirmed_at" field to the value that was provided on create.
+func (u *LockOrderFulfillmentUpsertBulk) UpdatePspConfirmedAt() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.UpdatePspConfirmedAt()
+	})
+}
+
+// ClearPspConfirmedAt clears the value of the "psp_confirmed_at" field.
+func (u *LockOrderFulfillmentUpsertBulk) ClearPspConfirmedAt() *LockOrderFulfillmentUpsertBulk {
+	return u.Update(func(s *LockOrderFulfillmentUpsert) {
+		s.ClearPspConfirmedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *LockOrderFulfillmentUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -104,6 +104,138 @@ func (lofu *LockOrderFulfillmentUpdate) ClearValidationError() *LockOrderFulfill
 	return lofu
 }
 
+// SetPspSessionID sets the "psp_session_id" field.
+func (lofu *LockOrderFulfillmentUpdate) SetPspSessionID(s string) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetPspSessionID(s)
+	return lofu
+}
+
+// SetNillablePspSessionID sets the "psp_session_id" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillablePspSessionID(s *string) *LockOrderFulfillmentUpdate {
+	if s != nil {
+		lofu.SetPspSessionID(*s)
+	}
+	return lofu
+}
+
+// ClearPspSessionID clears the value of the "psp_session_id" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearPspSessionID() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearPspSessionID()
+	return lofu
+}
+
+// SetBankReference sets the "bank_reference" field.
+func (lofu *LockOrderFulfillmentUpdate) SetBankReference(s string) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetBankReference(s)
+	return lofu
+}
+
+// SetNillableBankReference sets the "bank_reference" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillableBankReference(s *string) *LockOrderFulfillmentUpdate {
+	if s != nil {
+		lofu.SetBankReference(*s)
+	}
+	return lofu
+}
+
+// ClearBankReference clears the value of the "bank_reference" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearBankReference() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearBankReference()
+	return lofu
+}
+
+// SetTransferredAt sets the "transferred_at" field.
+func (lofu *LockOrderFulfillmentUpdate) SetTransferredAt(t time.Time) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetTransferredAt(t)
+	return lofu
+}
+
+// SetNillableTransferredAt sets the "transferred_at" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillableTransferredAt(t *time.Time) *LockOrderFulfillmentUpdate {
+	if t != nil {
+		lofu.SetTransferredAt(*t)
+	}
+	return lofu
+}
+
+// ClearTransferredAt clears the value of the "transferred_at" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearTransferredAt() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearTransferredAt()
+	return lofu
+}
+
+// SetReceiptKey sets the "receipt_key" field.
+func (lofu *LockOrderFulfillmentUpdate) SetReceiptKey(s string) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetReceiptKey(s)
+	return lofu
+}
+
+// SetNillableReceiptKey sets the "receipt_key" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillableReceiptKey(s *string) *LockOrderFulfillmentUpdate {
+	if s != nil {
+		lofu.SetReceiptKey(*s)
+	}
+	return lofu
+}
+
+// ClearReceiptKey clears the value of the "receipt_key" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearReceiptKey() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearReceiptKey()
+	return lofu
+}
+
+// SetReceiptContentType sets the "receipt_content_type" field.
+func (lofu *LockOrderFulfillmentUpdate) SetReceiptContentType(s string) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetReceiptContentType(s)
+	return lofu
+}
+
+// SetNillableReceiptContentType sets the "receipt_content_type" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillableReceiptContentType(s *string) *LockOrderFulfillmentUpdate {
+	if s != nil {
+		lofu.SetReceiptContentType(*s)
+	}
+	return lofu
+}
+
+// ClearReceiptContentType clears the value of the "receipt_content_type" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearReceiptContentType() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearReceiptContentType()
+	return lofu
+}
+
+// SetRecipientConfirmation sets the "recipient_confirmation" field.
+func (lofu *LockOrderFulfillmentUpdate) SetRecipientConfirmation(m map[string]interface{}) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetRecipientConfirmation(m)
+	return lofu
+}
+
+// ClearRecipientConfirmation clears the value of the "recipient_confirmation" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearRecipientConfirmation() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearRecipientConfirmation()
+	return lofu
+}
+
+// SetPspConfirmedAt sets the "psp_confirmed_at" field.
+func (lofu *LockOrderFulfillmentUpdate) SetPspConfirmedAt(t time.Time) *LockOrderFulfillmentUpdate {
+	lofu.mutation.SetPspConfirmedAt(t)
+	return lofu
+}
+
+// SetNillablePspConfirmedAt sets the "psp_confirmed_at" field if the given value is not nil.
+func (lofu *LockOrderFulfillmentUpdate) SetNillablePspConfirmedAt(t *time.Time) *LockOrderFulfillmentUpdate {
+	if t != nil {
+		lofu.SetPspConfirmedAt(*t)
+	}
+	return lofu
+}
+
+// ClearPspConfirmedAt clears the value of the "psp_confirmed_at" field.
+func (lofu *LockOrderFulfillmentUpdate) ClearPspConfirmedAt() *LockOrderFulfillmentUpdate {
+	lofu.mutation.ClearPspConfirmedAt()
+	return lofu
+}
+
 // SetOrderID sets the "order" edge to the LockPaymentOrder entity by ID.
 func (lofu *LockOrderFulfillmentUpdate) SetOrderID(id uuid.UUID) *LockOrderFulfillmentUpdate {
 	lofu.mutation.SetOrderID(id)
@@ -208,6 +340,48 @@ func (lofu *LockOrderFulfillmentUpdate) sqlSave(ctx context.Context) (n int, err
 	if lofu.mutation.ValidationErrorCleared() {
 		_spec.ClearField(lockorderfulfillment.FieldValidationError, field.TypeString)
 	}
+	if value, ok := lofu.mutation.PspSessionID(); ok {
+		_spec.SetField(lockorderfulfillment.FieldPspSessionID, field.TypeString, value)
+	}
+	if lofu.mutation.PspSessionIDCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldPspSessionID, field.TypeString)
+	}
+	if value, ok := lofu.mutation.BankReference(); ok {
+		_spec.SetField(lockorderfulfillment.FieldBankReference, field.TypeString, value)
+	}
+	if lofu.mutation.BankReferenceCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldBankReference, field.TypeString)
+	}
+	if value, ok := lofu.mutation.TransferredAt(); ok {
+		_spec.SetField(lockorderfulfillment.FieldTransferredAt, field.TypeTime, value)
+	}
+	if lofu.mutation.TransferredAtCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldTransferredAt, field.TypeTime)
+	}
+	if value, ok := lofu.mutation.ReceiptKey(); ok {
+		_spec.SetField(lockorderfulfillment.FieldReceiptKey, field.TypeString, value)
+	}
+	if lofu.mutation.ReceiptKeyCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldReceiptKey, field.TypeString)
+	}
+	if value, ok := lofu.mutation.ReceiptContentType(); ok {
+		_spec.SetField(lockorderfulfillment.FieldReceiptContentType, field.TypeString, value)
+	}
+	if lofu.mutation.ReceiptContentTypeCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldReceiptContentType, field.TypeString)
+	}
+	if value, ok := lofu.mutation.RecipientConfirmation(); ok {
+		_spec.SetField(lockorderfulfillment.FieldRecipientConfirmation, field.TypeJSON, value)
+	}
+	if lofu.mutation.RecipientConfirmationCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldRecipientConfirmation, field.TypeJSON)
+	}
+	if value, ok := lofu.mutation.PspConfirmedAt(); ok {
+		_spec.SetField(lockorderfulfillment.FieldPspConfirmedAt, field.TypeTime, value)
+	}
+	if lofu.mutation.PspConfirmedAtCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldPspConfirmedAt, field.TypeTime)
+	}
 	if lofu.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -331,6 +505,138 @@ func (lofuo *LockOrderFulfillmentUpdateOne) ClearValidationError() *LockOrderFul
 	return lofuo
 }
 
+// SetPspSessionID sets the "psp_session_id" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetPspSessionID(s string) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetPspSessionID(s)
+	return lofuo
+}
+
+// SetNillablePspSessionID sets the "psp_session_id" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillablePspSessionID(s *string) *LockOrderFulfillmentUpdateOne {
+	if s != nil {
+		lofuo.SetPspSessionID(*s)
+	}
+	return lofuo
+}
+
+// ClearPspSessionID clears the value of the "psp_session_id" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearPspSessionID() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearPspSessionID()
+	return lofuo
+}
+
+// SetBankReference sets the "bank_reference" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetBankReference(s string) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetBankReference(s)
+	return lofuo
+}
+
+// SetNillableBankReference sets the "bank_reference" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillableBankReference(s *string) *LockOrderFulfillmentUpdateOne {
+	if s != nil {
+		lofuo.SetBankReference(*s)
+	}
+	return lofuo
+}
+
+// ClearBankReference clears the value of the "bank_reference" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearBankReference() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearBankReference()
+	return lofuo
+}
+
+// SetTransferredAt sets the "transferred_at" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetTransferredAt(t time.Time) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetTransferredAt(t)
+	return lofuo
+}
+
+// SetNillableTransferredAt sets the "transferred_at" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillableTransferredAt(t *time.Time) *LockOrderFulfillmentUpdateOne {
+	if t != nil {
+		lofuo.SetTransferredAt(*t)
+	}
+	return lofuo
+}
+
+// ClearTransferredAt clears the value of the "transferred_at" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearTransferredAt() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearTransferredAt()
+	return lofuo
+}
+
+// SetReceiptKey sets the "receipt_key" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetReceiptKey(s string) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetReceiptKey(s)
+	return lofuo
+}
+
+// SetNillableReceiptKey sets the "receipt_key" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillableReceiptKey(s *string) *LockOrderFulfillmentUpdateOne {
+	if s != nil {
+		lofuo.SetReceiptKey(*s)
+	}
+	return lofuo
+}
+
+// ClearReceiptKey clears the value of the "receipt_key" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearReceiptKey() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearReceiptKey()
+	return lofuo
+}
+
+// SetReceiptContentType sets the "receipt_content_type" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetReceiptContentType(s string) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetReceiptContentType(s)
+	return lofuo
+}
+
+// SetNillableReceiptContentType sets the "receipt_content_type" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillableReceiptContentType(s *string) *LockOrderFulfillmentUpdateOne {
+	if s != nil {
+		lofuo.SetReceiptContentType(*s)
+	}
+	return lofuo
+}
+
+// ClearReceiptContentType clears the value of the "receipt_content_type" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearReceiptContentType() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearReceiptContentType()
+	return lofuo
+}
+
+// SetRecipientConfirmation sets the "recipient_confirmation" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetRecipientConfirmation(m map[string]interface{}) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetRecipientConfirmation(m)
+	return lofuo
+}
+
+// ClearRecipientConfirmation clears the value of the "recipient_confirmation" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearRecipientConfirmation() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearRecipientConfirmation()
+	return lofuo
+}
+
+// SetPspConfirmedAt sets the "psp_confirmed_at" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetPspConfirmedAt(t time.Time) *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.SetPspConfirmedAt(t)
+	return lofuo
+}
+
+// SetNillablePspConfirmedAt sets the "psp_confirmed_at" field if the given value is not nil.
+func (lofuo *LockOrderFulfillmentUpdateOne) SetNillablePspConfirmedAt(t *time.Time) *LockOrderFulfillmentUpdateOne {
+	if t != nil {
+		lofuo.SetPspConfirmedAt(*t)
+	}
+	return lofuo
+}
+
+// ClearPspConfirmedAt clears the value of the "psp_confirmed_at" field.
+func (lofuo *LockOrderFulfillmentUpdateOne) ClearPspConfirmedAt() *LockOrderFulfillmentUpdateOne {
+	lofuo.mutation.ClearPspConfirmedAt()
+	return lofuo
+}
+
 // SetOrderID sets the "order" edge to the LockPaymentOrder entity by ID.
 func (lofuo *LockOrderFulfillmentUpdateOne) SetOrderID(id uuid.UUID) *LockOrderFulfillmentUpdateOne {
 	lofuo.mutation.SetOrderID(id)
@@ -465,6 +771,48 @@ func (lofuo *LockOrderFulfillmentUpdateOne) sqlSave(ctx context.Context) (_node
 	if lofuo.mutation.ValidationErrorCleared() {
 		_spec.ClearField(lockorderfulfillment.FieldValidationError, field.TypeString)
 	}
+	if value, ok := lofuo.mutation.PspSessionID(); ok {
+		_spec.SetField(lockorderfulfillment.FieldPspSessionID, field.TypeString, value)
+	}
+	if lofuo.mutation.PspSessionIDCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldPspSessionID, field.TypeString)
+	}
+	if value, ok := lofuo.mutation.BankReference(); ok {
+		_spec.SetField(lockorderfulfillment.FieldBankReference, field.TypeString, value)
+	}
+	if lofuo.mutation.BankReferenceCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldBankReference, field.TypeString)
+	}
+	if value, ok := lofuo.mutation.TransferredAt(); ok {
+		_spec.SetField(lockorderfulfillment.FieldTransferredAt, field.TypeTime, value)
+	}
+	if lofuo.mutation.TransferredAtCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldTransferredAt, field.TypeTime)
+	}
+	if value, ok := lofuo.mutation.ReceiptKey(); ok {
+		_spec.SetField(lockorderfulfillment.FieldReceiptKey, field.TypeString, value)
+	}
+	if lofuo.mutation.ReceiptKeyCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldReceiptKey, field.TypeString)
+	}
+	if value, ok := lofuo.mutation.ReceiptContentType(); ok {
+		_spec.SetField(lockorderfulfillment.FieldReceiptContentType, field.TypeString, value)
+	}
+	if lofuo.mutation.ReceiptContentTypeCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldReceiptContentType, field.TypeString)
+	}
+	if value, ok := lofuo.mutation.RecipientConfirmation(); ok {
+		_spec.SetField(lockorderfulfillment.FieldRecipientConfirmation, field.TypeJSON, value)
+	}
+	if lofuo.mutation.RecipientConfirmationCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldRecipientConfirmation, field.TypeJSON)
+	}
+	if value, ok := lofuo.mutation.PspConfirmedAt(); ok {
+		_spec.SetField(lockorderfulfillment.FieldPspConfirmedAt, field.TypeTime, value)
+	}
+	if lofuo.mutation.PspConfirmedAtCleared() {
+		_spec.ClearField(lockorderfulfillment.FieldPspConfirmedAt, field.TypeTime)
+	}
 	if lofuo.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
@@ -53,6 +54,8 @@ type LockPaymentOrder struct {
 	CancellationCount int `json:"cancellation_count,omitempty"`
 	// CancellationReasons holds the value of the "cancellation_reasons" field.
 	CancellationReasons []string `json:"cancellation_reasons,omitempty"`
+	// SplitPlan holds the value of the "split_plan" field.
+	SplitPlan map[string]interface{} `json:"split_plan,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LockPaymentOrderQuery when eager-loading is set.
 	Edges                                LockPaymentOrderEdges `json:"edges"`
@@ -74,9 +77,17 @@ type LockPaymentOrderEdges struct {
 	Fulfillments []*LockOrderFulfillment `json:"fulfillments,omitempty"`
 	// Transactions holds the value of the transactions edge.
 	Transactions []*TransactionLog `json:"transactions,omitempty"`
+	// SLARecords holds the value of the sla_records edge.
+	SLARecords []*ProviderSLARecord `json:"sla_records,omitempty"`
+	// Disputes holds the value of the disputes edge.
+	Disputes []*Dispute `json:"disputes,omitempty"`
+	// DeadLetter holds the value of the dead_letter edge.
+	DeadLetter *DeadLetterOrder `json:"dead_letter,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*OrderAssignment `json:"assignments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [9]bool
 }
 
 // TokenOrErr returns the Token value or an error if the edge
@@ -130,12 +141,50 @@ func (e LockPaymentOrderEdges) TransactionsOrErr() ([]*TransactionLog, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// SLARecordsOrErr returns the SLARecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e LockPaymentOrderEdges) SLARecordsOrErr() ([]*ProviderSLARecord, error) {
+	if e.loadedTypes[5] {
+		return e.SLARecords, nil
+	}
+	return nil, &NotLoadedError{edge: "sla_records"}
+}
+
+// DisputesOrErr returns the Disputes value or an error if the edge
+// was not loaded in eager-loading.
+func (e LockPaymentOrderEdges) DisputesOrErr() ([]*Dispute, error) {
+	if e.loadedTypes[6] {
+		return e.Disputes, nil
+	}
+	return nil, &NotLoadedError{edge: "disputes"}
+}
+
+// DeadLetterOrErr returns the DeadLetter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LockPaymentOrderEdges) DeadLetterOrErr() (*DeadLetterOrder, error) {
+	if e.DeadLetter != nil {
+		return e.DeadLetter, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: deadletterorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "dead_letter"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LockPaymentOrderEdges) AssignmentsOrErr() ([]*OrderAssignment, error) {
+	if e.loadedTypes[8] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*LockPaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case lockpaymentorder.FieldCancellationReasons:
+		case lockpaymentorder.FieldCancellationReasons, lockpaymentorder.FieldSplitPlan:
 			values[i] = new([]byte)
 		case lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate, lockpaymentorder.FieldOrderPercent:
 			values[i] = new(decimal.Decimal)
@@ -266,6 +315,14 @@ func (lpo *LockPaymentOrder) assignValues(columns []string, values []any) error
 					return fmt.Errorf("unmarshal field cancellation_reasons: %w", err)
 				}
 			}
+		case lockpaymentorder.FieldSplitPlan:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field split_plan", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &lpo.SplitPlan); err != nil {
+					return fmt.Errorf("unmarshal field split_plan: %w", err)
+				}
+			}
 		case lockpaymentorder.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_profile_assigned_orders", values[i])
@@ -325,6 +382,26 @@ func (lpo *LockPaymentOrder) QueryTransactions() *TransactionLogQuery {
 	return NewLockPaymentOrderClient(lpo.config).QueryTransactions(lpo)
 }
 
+// QuerySLARecords queries the "sla_records" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QuerySLARecords() *ProviderSLARecordQuery {
+	return NewLockPaymentOrderClient(lpo.config).QuerySLARecords(lpo)
+}
+
+// QueryDisputes queries the "disputes" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryDisputes() *DisputeQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryDisputes(lpo)
+}
+
+// QueryDeadLetter queries the "dead_letter" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryDeadLetter() *DeadLetterOrderQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryDeadLetter(lpo)
+}
+
+// QueryAssignments queries the "assignments" edge of the LockPaymentOrder entity.
+func (lpo *LockPaymentOrder) QueryAssignments() *OrderAssignmentQuery {
+	return NewLockPaymentOrderClient(lpo.config).QueryAssignments(lpo)
+}
+
 // Update returns a builder for updating this LockPaymentOrder.
 // Note that you need to call LockPaymentOrder.Unwrap() before calling this method if this LockPaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -392,6 +469,9 @@ func (lpo *LockPaymentOrder) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("cancellation_reasons=")
 	builder.WriteString(fmt.Sprintf("%v", lpo.CancellationReasons))
+	builder.WriteString(", ")
+	builder.WriteString("split_plan=")
+	builder.WriteString(fmt.Sprintf("%v", lpo.SplitPlan))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -46,6 +46,8 @@ const (
 	FieldCancellationCount = "cancellation_count"
 	// FieldCancellationReasons holds the string denoting the cancellation_reasons field in the database.
 	FieldCancellationReasons = "cancellation_reasons"
+	// FieldSplitPlan holds the string denoting the split_plan field in the database.
+	FieldSplitPlan = "split_plan"
 	// EdgeToken holds the string denoting the token edge name in mutations.
 	EdgeToken = "token"
 	// EdgeProvisionBucket holds the string denoting the provision_bucket edge name in mutations.
@@ -56,6 +58,14 @@ const (
 	EdgeFulfillments = "fulfillments"
 	// EdgeTransactions holds the string denoting the transactions edge name in mutations.
 	EdgeTransactions = "transactions"
+	// EdgeSLARecords holds the string denoting the sla_records edge name in mutations.
+	EdgeSLARecords = "sla_records"
+	// EdgeDisputes holds the string denoting the disputes edge name in mutations.
+	EdgeDisputes = "disputes"
+	// EdgeDeadLetter holds the string denoting the dead_letter edge name in mutations.
+	EdgeDeadLetter = "dead_letter"
+	// EdgeAssignments holds the string denoting the assignments edge name in mutations.
+	EdgeAssignments = "assignments"
 	// Table holds the table name of the lockpaymentorder in the database.
 	Table = "lock_payment_orders"
 	// TokenTable is the table that holds the token relation/edge.
@@ -93,6 +103,34 @@ const (
 	TransactionsInverseTable = "transaction_logs"
 	// TransactionsColumn is the table column denoting the transactions relation/edge.
 	TransactionsColumn = "lock_payment_order_transactions"
+	// SLARecordsTable is the table that holds the sla_records relation/edge.
+	SLARecordsTable = "provider_sla_records"
+	// SLARecordsInverseTable is the table name for the ProviderSLARecord entity.
+	// It exists in this package in order to avoid circular dependency with the "providerslarecord" package.
+	SLARecordsInverseTable = "provider_sla_records"
+	// SLARecordsColumn is the table column denoting the sla_records relation/edge.
+	SLARecordsColumn = "lock_payment_order_sla_records"
+	// DisputesTable is the table that holds the disputes relation/edge.
+	DisputesTable = "disputes"
+	// DisputesInverseTable is the table name for the Dispute entity.
+	// It exists in this package in order to avoid circular dependency with the "dispute" package.
+	DisputesInverseTable = "disputes"
+	// DisputesColumn is the table column denoting the disputes relation/edge.
+	DisputesColumn = "lock_payment_order_disputes"
+	// DeadLetterTable is the table that holds the dead_letter relation/edge.
+	DeadLetterTable = "dead_letter_orders"
+	// DeadLetterInverseTable is the table name for the DeadLetterOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "deadletterorder" package.
+	DeadLetterInverseTable = "dead_letter_orders"
+	// DeadLetterColumn is the table column denoting the dead_letter relation/edge.
+	DeadLetterColumn = "lock_payment_order_dead_letter"
+	// AssignmentsTable is the table that holds the assignments relation/edge.
+	AssignmentsTable = "order_assignments"
+	// AssignmentsInverseTable is the table name for the OrderAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "orderassignment" package.
+	AssignmentsInverseTable = "order_assignments"
+	// AssignmentsColumn is the table column denoting the assignments relation/edge.
+	AssignmentsColumn = "lock_payment_order_assignments"
 )
 
 // Columns holds all SQL columns for lockpaymentorder fields.
@@ -113,6 +151,7 @@ var Columns = []string{
 	FieldMemo,
 	FieldCancellationCount,
 	FieldCancellationReasons,
+	FieldSplitPlan,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "lock_payment_orders"
@@ -312,6 +351,55 @@ func ByTransactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTransactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySLARecordsCount orders the results by sla_records count.
+func BySLARecordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSLARecordsStep(), opts...)
+	}
+}
+
+// BySLARecords orders the results by sla_records terms.
+func BySLARecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSLARecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDisputesCount orders the results by disputes count.
+func ByDisputesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDisputesStep(), opts...)
+	}
+}
+
+// ByDisputes orders the results by disputes terms.
+func ByDisputes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisputesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDeadLetterField orders the results by dead_letter field.
+func ByDeadLetterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeadLetterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAssignmentsCount orders the results by assignments count.
+func ByAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignmentsStep(), opts...)
+	}
+}
+
+// ByAssignments orders the results by assignments terms.
+func ByAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTokenStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -347,3 +435,31 @@ func newTransactionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
 	)
 }
+func newSLARecordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SLARecordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SLARecordsTable, SLARecordsColumn),
+	)
+}
+func newDisputesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisputesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+	)
+}
+func newDeadLetterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeadLetterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, DeadLetterTable, DeadLetterColumn),
+	)
+}
+func newAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+	)
+}
@@ -13,9 +13,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
@@ -168,6 +172,12 @@ func (lpoc *LockPaymentOrderCreate) SetCancellationReasons(s []string) *LockPaym
 	return lpoc
 }
 
+// SetSplitPlan sets the "split_plan" field.
+func (lpoc *LockPaymentOrderCreate) SetSplitPlan(m map[string]interface{}) *LockPaymentOrderCreate {
+	lpoc.mutation.SetSplitPlan(m)
+	return lpoc
+}
+
 // SetID sets the "id" field.
 func (lpoc *LockPaymentOrderCreate) SetID(u uuid.UUID) *LockPaymentOrderCreate {
 	lpoc.mutation.SetID(u)
@@ -261,6 +271,70 @@ func (lpoc *LockPaymentOrderCreate) AddTransactions(t ...*TransactionLog) *LockP
 	return lpoc.AddTransactionIDs(ids...)
 }
 
+// AddSLARecordIDs adds the "sla_records" edge to the ProviderSLARecord entity by IDs.
+func (lpoc *LockPaymentOrderCreate) AddSLARecordIDs(ids ...uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.AddSLARecordIDs(ids...)
+	return lpoc
+}
+
+// AddSLARecords adds the "sla_records" edges to the ProviderSLARecord entity.
+func (lpoc *LockPaymentOrderCreate) AddSLARecords(p ...*ProviderSLARecord) *LockPaymentOrderCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lpoc.AddSLARecordIDs(ids...)
+}
+
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (lpoc *LockPaymentOrderCreate) AddDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.AddDisputeIDs(ids...)
+	return lpoc
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (lpoc *LockPaymentOrderCreate) AddDisputes(d ...*Dispute) *LockPaymentOrderCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpoc.AddDisputeIDs(ids...)
+}
+
+// SetDeadLetterID sets the "dead_letter" edge to the DeadLetterOrder entity by ID.
+func (lpoc *LockPaymentOrderCreate) SetDeadLetterID(id uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.SetDeadLetterID(id)
+	return lpoc
+}
+
+// SetNillableDeadLetterID sets the "dead_letter" edge to the DeadLetterOrder entity by ID if the given value is not nil.
+func (lpoc *LockPaymentOrderCreate) SetNillableDeadLetterID(id *uuid.UUID) *LockPaymentOrderCreate {
+	if id != nil {
+		lpoc = lpoc.SetDeadLetterID(*id)
+	}
+	return lpoc
+}
+
+// SetDeadLetter sets the "dead_letter" edge to the DeadLetterOrder entity.
+func (lpoc *LockPaymentOrderCreate) SetDeadLetter(d *DeadLetterOrder) *LockPaymentOrderCreate {
+	return lpoc.SetDeadLetterID(d.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the OrderAssignment entity by IDs.
+func (lpoc *LockPaymentOrderCreate) AddAssignmentIDs(ids ...uuid.UUID) *LockPaymentOrderCreate {
+	lpoc.mutation.AddAssignmentIDs(ids...)
+	return lpoc
+}
+
+// AddAssignments adds the "assignments" edges to the OrderAssignment entity.
+func (lpoc *LockPaymentOrderCreate) AddAssignments(o ...*OrderAssignment) *LockPaymentOrderCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lpoc.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpoc *LockPaymentOrderCreate) Mutation() *LockPaymentOrderMutation {
 	return lpoc.mutation
@@ -472,6 +546,10 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		_spec.SetField(lockpaymentorder.FieldCancellationReasons, field.TypeJSON, value)
 		_node.CancellationReasons = value
 	}
+	if value, ok := lpoc.mutation.SplitPlan(); ok {
+		_spec.SetField(lockpaymentorder.FieldSplitPlan, field.TypeJSON, value)
+		_node.SplitPlan = value
+	}
 	if nodes := lpoc.mutation.TokenIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -555,6 +633,70 @@ func (lpoc *LockPaymentOrderCreate) createSpec() (*LockPaymentOrder, *sqlgraph.C
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := lpoc.mutation.SLARecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lpoc.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lpoc.mutation.DeadLetterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   lockpaymentorder.DeadLetterTable,
+			Columns: []string{lockpaymentorder.DeadLetterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lpoc.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -817,6 +959,24 @@ func (u *LockPaymentOrderUpsert) UpdateCancellationReasons() *LockPaymentOrderUp
 	return u
 }
 
+// SetSplitPlan sets the "split_plan" field.
+func (u *LockPaymentOrderUpsert) SetSplitPlan(v map[string]interface{}) *LockPaymentOrderUpsert {
+	u.Set(lockpaymentorder.FieldSplitPlan, v)
+	return u
+}
+
+// UpdateSplitPlan sets the "split_plan" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsert) UpdateSplitPlan() *LockPaymentOrderUpsert {
+	u.SetExcluded(lockpaymentorder.FieldSplitPlan)
+	return u
+}
+
+// ClearSplitPlan clears the value of the "split_plan" field.
+func (u *LockPaymentOrderUpsert) ClearSplitPlan() *LockPaymentOrderUpsert {
+	u.SetNull(lockpaymentorder.FieldSplitPlan)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1113,6 +1273,27 @@ func (u *LockPaymentOrderUpsertOne) UpdateCancellationReasons() *LockPaymentOrde
 	})
 }
 
+// SetSplitPlan sets the "split_plan" field.
+func (u *LockPaymentOrderUpsertOne) SetSplitPlan(v map[string]interface{}) *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSplitPlan(v)
+	})
+}
+
+// UpdateSplitPlan sets the "split_plan" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertOne) UpdateSplitPlan() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSplitPlan()
+	})
+}
+
+// ClearSplitPlan clears the value of the "split_plan" field.
+func (u *LockPaymentOrderUpsertOne) ClearSplitPlan() *LockPaymentOrderUpsertOne {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearSplitPlan()
+	})
+}
+
 // Exec executes the query.
 func (u *LockPaymentOrderUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1576,6 +1757,27 @@ func (u *LockPaymentOrderUpsertBulk) UpdateCancellationReasons() *LockPaymentOrd
 	})
 }
 
+// SetSplitPlan sets the "split_plan" field.
+func (u *LockPaymentOrderUpsertBulk) SetSplitPlan(v map[string]interface{}) *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.SetSplitPlan(v)
+	})
+}
+
+// UpdateSplitPlan sets the "split_plan" field to the value that was provided on create.
+func (u *LockPaymentOrderUpsertBulk) UpdateSplitPlan() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.UpdateSplitPlan()
+	})
+}
+
+// ClearSplitPlan clears the value of the "split_plan" field.
+func (u *LockPaymentOrderUpsertBulk) ClearSplitPlan() *LockPaymentOrderUpsertBulk {
+	return u.Update(func(s *LockPaymentOrderUpsert) {
+		s.ClearSplitPlan()
+	})
+}
+
 // Exec executes the query.
 func (u *LockPaymentOrderUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -13,10 +13,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
@@ -34,6 +38,10 @@ type LockPaymentOrderQuery struct {
 	withProvider        *ProviderProfileQuery
 	withFulfillments    *LockOrderFulfillmentQuery
 	withTransactions    *TransactionLogQuery
+	withSLARecords      *ProviderSLARecordQuery
+	withDisputes        *DisputeQuery
+	withDeadLetter      *DeadLetterOrderQuery
+	withAssignments     *OrderAssignmentQuery
 	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -181,6 +189,94 @@ func (lpoq *LockPaymentOrderQuery) QueryTransactions() *TransactionLogQuery {
 	return query
 }
 
+// QuerySLARecords chains the current query on the "sla_records" edge.
+func (lpoq *LockPaymentOrderQuery) QuerySLARecords() *ProviderSLARecordQuery {
+	query := (&ProviderSLARecordClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(providerslarecord.Table, providerslarecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.SLARecordsTable, lockpaymentorder.SLARecordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDisputes chains the current query on the "disputes" edge.
+func (lpoq *LockPaymentOrderQuery) QueryDisputes() *DisputeQuery {
+	query := (&DisputeClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.DisputesTable, lockpaymentorder.DisputesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeadLetter chains the current query on the "dead_letter" edge.
+func (lpoq *LockPaymentOrderQuery) QueryDeadLetter() *DeadLetterOrderQuery {
+	query := (&DeadLetterOrderClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(deadletterorder.Table, deadletterorder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, lockpaymentorder.DeadLetterTable, lockpaymentorder.DeadLetterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignments chains the current query on the "assignments" edge.
+func (lpoq *LockPaymentOrderQuery) QueryAssignments() *OrderAssignmentQuery {
+	query := (&OrderAssignmentClient{config: lpoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lpoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lpoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lockpaymentorder.Table, lockpaymentorder.FieldID, selector),
+			sqlgraph.To(orderassignment.Table, orderassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, lockpaymentorder.AssignmentsTable, lockpaymentorder.AssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lpoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first LockPaymentOrder entity from the query.
 // Returns a *NotFoundError when no LockPaymentOrder was found.
 func (lpoq *LockPaymentOrderQuery) First(ctx context.Context) (*LockPaymentOrder, error) {
@@ -378,6 +474,10 @@ func (lpoq *LockPaymentOrderQuery) Clone() *LockPaymentOrderQuery {
 		withProvider:        lpoq.withProvider.Clone(),
 		withFulfillments:    lpoq.withFulfillments.Clone(),
 		withTransactions:    lpoq.withTransactions.Clone(),
+		withSLARecords:      lpoq.withSLARecords.Clone(),
+		withDisputes:        lpoq.withDisputes.Clone(),
+		withDeadLetter:      lpoq.withDeadLetter.Clone(),
+		withAssignments:     lpoq.withAssignments.Clone(),
 		// clone intermediate query.
 		sql:  lpoq.sql.Clone(),
 		path: lpoq.path,
@@ -439,6 +539,50 @@ func (lpoq *LockPaymentOrderQuery) WithTransactions(opts ...func(*TransactionLog
 	return lpoq
 }
 
+// WithSLARecords tells the query-builder to eager-load the nodes that are connected to
+// the "sla_records" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithSLARecords(opts ...func(*ProviderSLARecordQuery)) *LockPaymentOrderQuery {
+	query := (&ProviderSLARecordClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withSLARecords = query
+	return lpoq
+}
+
+// WithDisputes tells the query-builder to eager-load the nodes that are connected to
+// the "disputes" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithDisputes(opts ...func(*DisputeQuery)) *LockPaymentOrderQuery {
+	query := (&DisputeClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withDisputes = query
+	return lpoq
+}
+
+// WithDeadLetter tells the query-builder to eager-load the nodes that are connected to
+// the "dead_letter" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithDeadLetter(opts ...func(*DeadLetterOrderQuery)) *LockPaymentOrderQuery {
+	query := (&DeadLetterOrderClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withDeadLetter = query
+	return lpoq
+}
+
+// WithAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (lpoq *LockPaymentOrderQuery) WithAssignments(opts ...func(*OrderAssignmentQuery)) *LockPaymentOrderQuery {
+	query := (&OrderAssignmentClient{config: lpoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lpoq.withAssignments = query
+	return lpoq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +662,16 @@ func (lpoq *LockPaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 		nodes       = []*LockPaymentOrder{}
 		withFKs     = lpoq.withFKs
 		_spec       = lpoq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [9]bool{
 			lpoq.withToken != nil,
 			lpoq.withProvisionBucket != nil,
 			lpoq.withProvider != nil,
 			lpoq.withFulfillments != nil,
 			lpoq.withTransactions != nil,
+			lpoq.withSLARecords != nil,
+			lpoq.withDisputes != nil,
+			lpoq.withDeadLetter != nil,
+			lpoq.withAssignments != nil,
 		}
 	)
 	if lpoq.withToken != nil || lpoq.withProvisionBucket != nil || lpoq.withProvider != nil {
@@ -584,6 +732,33 @@ func (lpoq *LockPaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHoo
 			return nil, err
 		}
 	}
+	if query := lpoq.withSLARecords; query != nil {
+		if err := lpoq.loadSLARecords(ctx, query, nodes,
+			func(n *LockPaymentOrder) { n.Edges.SLARecords = []*ProviderSLARecord{} },
+			func(n *LockPaymentOrder, e *ProviderSLARecord) { n.Edges.SLARecords = append(n.Edges.SLARecords, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lpoq.withDisputes; query != nil {
+		if err := lpoq.loadDisputes(ctx, query, nodes,
+			func(n *LockPaymentOrder) { n.Edges.Disputes = []*Dispute{} },
+			func(n *LockPaymentOrder, e *Dispute) { n.Edges.Disputes = append(n.Edges.Disputes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lpoq.withDeadLetter; query != nil {
+		if err := lpoq.loadDeadLetter(ctx, query, nodes, nil,
+			func(n *LockPaymentOrder, e *DeadLetterOrder) { n.Edges.DeadLetter = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lpoq.withAssignments; query != nil {
+		if err := lpoq.loadAssignments(ctx, query, nodes,
+			func(n *LockPaymentOrder) { n.Edges.Assignments = []*OrderAssignment{} },
+			func(n *LockPaymentOrder, e *OrderAssignment) { n.Edges.Assignments = append(n.Edges.Assignments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -745,6 +920,127 @@ func (lpoq *LockPaymentOrderQuery) loadTransactions(ctx context.Context, query *
 	}
 	return nil
 }
+func (lpoq *LockPaymentOrderQuery) loadSLARecords(ctx context.Context, query *ProviderSLARecordQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *ProviderSLARecord)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderSLARecord(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.SLARecordsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_sla_records
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_sla_records" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_sla_records" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lpoq *LockPaymentOrderQuery) loadDisputes(ctx context.Context, query *DisputeQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *Dispute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Dispute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.DisputesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_disputes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_disputes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_disputes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lpoq *LockPaymentOrderQuery) loadDeadLetter(ctx context.Context, query *DeadLetterOrderQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *DeadLetterOrder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.DeadLetterOrder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.DeadLetterColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_dead_letter
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_dead_letter" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_dead_letter" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lpoq *LockPaymentOrderQuery) loadAssignments(ctx context.Context, query *OrderAssignmentQuery, nodes []*LockPaymentOrder, init func(*LockPaymentOrder), assign func(*LockPaymentOrder, *OrderAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*LockPaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OrderAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(lockpaymentorder.AssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.lock_payment_order_assignments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lock_payment_order_assignments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lock_payment_order_assignments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (lpoq *LockPaymentOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := lpoq.querySpec()
@@ -13,10 +13,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/token"
 	"github.com/paycrest/aggregator/ent/transactionlog"
@@ -269,6 +273,18 @@ func (lpou *LockPaymentOrderUpdate) AppendCancellationReasons(s []string) *LockP
 	return lpou
 }
 
+// SetSplitPlan sets the "split_plan" field.
+func (lpou *LockPaymentOrderUpdate) SetSplitPlan(m map[string]interface{}) *LockPaymentOrderUpdate {
+	lpou.mutation.SetSplitPlan(m)
+	return lpou
+}
+
+// ClearSplitPlan clears the value of the "split_plan" field.
+func (lpou *LockPaymentOrderUpdate) ClearSplitPlan() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearSplitPlan()
+	return lpou
+}
+
 // SetTokenID sets the "token" edge to the Token entity by ID.
 func (lpou *LockPaymentOrderUpdate) SetTokenID(id int) *LockPaymentOrderUpdate {
 	lpou.mutation.SetTokenID(id)
@@ -348,6 +364,70 @@ func (lpou *LockPaymentOrderUpdate) AddTransactions(t ...*TransactionLog) *LockP
 	return lpou.AddTransactionIDs(ids...)
 }
 
+// AddSLARecordIDs adds the "sla_records" edge to the ProviderSLARecord entity by IDs.
+func (lpou *LockPaymentOrderUpdate) AddSLARecordIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.AddSLARecordIDs(ids...)
+	return lpou
+}
+
+// AddSLARecords adds the "sla_records" edges to the ProviderSLARecord entity.
+func (lpou *LockPaymentOrderUpdate) AddSLARecords(p ...*ProviderSLARecord) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lpou.AddSLARecordIDs(ids...)
+}
+
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (lpou *LockPaymentOrderUpdate) AddDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.AddDisputeIDs(ids...)
+	return lpou
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (lpou *LockPaymentOrderUpdate) AddDisputes(d ...*Dispute) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpou.AddDisputeIDs(ids...)
+}
+
+// SetDeadLetterID sets the "dead_letter" edge to the DeadLetterOrder entity by ID.
+func (lpou *LockPaymentOrderUpdate) SetDeadLetterID(id uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.SetDeadLetterID(id)
+	return lpou
+}
+
+// SetNillableDeadLetterID sets the "dead_letter" edge to the DeadLetterOrder entity by ID if the given value is not nil.
+func (lpou *LockPaymentOrderUpdate) SetNillableDeadLetterID(id *uuid.UUID) *LockPaymentOrderUpdate {
+	if id != nil {
+		lpou = lpou.SetDeadLetterID(*id)
+	}
+	return lpou
+}
+
+// SetDeadLetter sets the "dead_letter" edge to the DeadLetterOrder entity.
+func (lpou *LockPaymentOrderUpdate) SetDeadLetter(d *DeadLetterOrder) *LockPaymentOrderUpdate {
+	return lpou.SetDeadLetterID(d.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the OrderAssignment entity by IDs.
+func (lpou *LockPaymentOrderUpdate) AddAssignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.AddAssignmentIDs(ids...)
+	return lpou
+}
+
+// AddAssignments adds the "assignments" edges to the OrderAssignment entity.
+func (lpou *LockPaymentOrderUpdate) AddAssignments(o ...*OrderAssignment) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lpou.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpou *LockPaymentOrderUpdate) Mutation() *LockPaymentOrderMutation {
 	return lpou.mutation
@@ -413,6 +493,75 @@ func (lpou *LockPaymentOrderUpdate) RemoveTransactions(t ...*TransactionLog) *Lo
 	return lpou.RemoveTransactionIDs(ids...)
 }
 
+// ClearSLARecords clears all "sla_records" edges to the ProviderSLARecord entity.
+func (lpou *LockPaymentOrderUpdate) ClearSLARecords() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearSLARecords()
+	return lpou
+}
+
+// RemoveSLARecordIDs removes the "sla_records" edge to ProviderSLARecord entities by IDs.
+func (lpou *LockPaymentOrderUpdate) RemoveSLARecordIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.RemoveSLARecordIDs(ids...)
+	return lpou
+}
+
+// RemoveSLARecords removes "sla_records" edges to ProviderSLARecord entities.
+func (lpou *LockPaymentOrderUpdate) RemoveSLARecords(p ...*ProviderSLARecord) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lpou.RemoveSLARecordIDs(ids...)
+}
+
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (lpou *LockPaymentOrderUpdate) ClearDisputes() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearDisputes()
+	return lpou
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (lpou *LockPaymentOrderUpdate) RemoveDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.RemoveDisputeIDs(ids...)
+	return lpou
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (lpou *LockPaymentOrderUpdate) RemoveDisputes(d ...*Dispute) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpou.RemoveDisputeIDs(ids...)
+}
+
+// ClearDeadLetter clears the "dead_letter" edge to the DeadLetterOrder entity.
+func (lpou *LockPaymentOrderUpdate) ClearDeadLetter() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearDeadLetter()
+	return lpou
+}
+
+// ClearAssignments clears all "assignments" edges to the OrderAssignment entity.
+func (lpou *LockPaymentOrderUpdate) ClearAssignments() *LockPaymentOrderUpdate {
+	lpou.mutation.ClearAssignments()
+	return lpou
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to OrderAssignment entities by IDs.
+func (lpou *LockPaymentOrderUpdate) RemoveAssignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdate {
+	lpou.mutation.RemoveAssignmentIDs(ids...)
+	return lpou
+}
+
+// RemoveAssignments removes "assignments" edges to OrderAssignment entities.
+func (lpou *LockPaymentOrderUpdate) RemoveAssignments(o ...*OrderAssignment) *LockPaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lpou.RemoveAssignmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (lpou *LockPaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	lpou.defaults()
@@ -547,6 +696,12 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 			sqljson.Append(u, lockpaymentorder.FieldCancellationReasons, value)
 		})
 	}
+	if value, ok := lpou.mutation.SplitPlan(); ok {
+		_spec.SetField(lockpaymentorder.FieldSplitPlan, field.TypeJSON, value)
+	}
+	if lpou.mutation.SplitPlanCleared() {
+		_spec.ClearField(lockpaymentorder.FieldSplitPlan, field.TypeJSON)
+	}
 	if lpou.mutation.TokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -724,6 +879,170 @@ func (lpou *LockPaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lpou.mutation.SLARecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.RemovedSLARecordsIDs(); len(nodes) > 0 && !lpou.mutation.SLARecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.SLARecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !lpou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpou.mutation.DeadLetterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   lockpaymentorder.DeadLetterTable,
+			Columns: []string{lockpaymentorder.DeadLetterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.DeadLetterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   lockpaymentorder.DeadLetterTable,
+			Columns: []string{lockpaymentorder.DeadLetterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpou.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !lpou.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpou.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, lpou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{lockpaymentorder.Label}
@@ -977,6 +1296,18 @@ func (lpouo *LockPaymentOrderUpdateOne) AppendCancellationReasons(s []string) *L
 	return lpouo
 }
 
+// SetSplitPlan sets the "split_plan" field.
+func (lpouo *LockPaymentOrderUpdateOne) SetSplitPlan(m map[string]interface{}) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetSplitPlan(m)
+	return lpouo
+}
+
+// ClearSplitPlan clears the value of the "split_plan" field.
+func (lpouo *LockPaymentOrderUpdateOne) ClearSplitPlan() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearSplitPlan()
+	return lpouo
+}
+
 // SetTokenID sets the "token" edge to the Token entity by ID.
 func (lpouo *LockPaymentOrderUpdateOne) SetTokenID(id int) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.SetTokenID(id)
@@ -1056,6 +1387,70 @@ func (lpouo *LockPaymentOrderUpdateOne) AddTransactions(t ...*TransactionLog) *L
 	return lpouo.AddTransactionIDs(ids...)
 }
 
+// AddSLARecordIDs adds the "sla_records" edge to the ProviderSLARecord entity by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) AddSLARecordIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddSLARecordIDs(ids...)
+	return lpouo
+}
+
+// AddSLARecords adds the "sla_records" edges to the ProviderSLARecord entity.
+func (lpouo *LockPaymentOrderUpdateOne) AddSLARecords(p ...*ProviderSLARecord) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lpouo.AddSLARecordIDs(ids...)
+}
+
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) AddDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddDisputeIDs(ids...)
+	return lpouo
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (lpouo *LockPaymentOrderUpdateOne) AddDisputes(d ...*Dispute) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpouo.AddDisputeIDs(ids...)
+}
+
+// SetDeadLetterID sets the "dead_letter" edge to the DeadLetterOrder entity by ID.
+func (lpouo *LockPaymentOrderUpdateOne) SetDeadLetterID(id uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.SetDeadLetterID(id)
+	return lpouo
+}
+
+// SetNillableDeadLetterID sets the "dead_letter" edge to the DeadLetterOrder entity by ID if the given value is not nil.
+func (lpouo *LockPaymentOrderUpdateOne) SetNillableDeadLetterID(id *uuid.UUID) *LockPaymentOrderUpdateOne {
+	if id != nil {
+		lpouo = lpouo.SetDeadLetterID(*id)
+	}
+	return lpouo
+}
+
+// SetDeadLetter sets the "dead_letter" edge to the DeadLetterOrder entity.
+func (lpouo *LockPaymentOrderUpdateOne) SetDeadLetter(d *DeadLetterOrder) *LockPaymentOrderUpdateOne {
+	return lpouo.SetDeadLetterID(d.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the OrderAssignment entity by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) AddAssignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.AddAssignmentIDs(ids...)
+	return lpouo
+}
+
+// AddAssignments adds the "assignments" edges to the OrderAssignment entity.
+func (lpouo *LockPaymentOrderUpdateOne) AddAssignments(o ...*OrderAssignment) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lpouo.AddAssignmentIDs(ids...)
+}
+
 // Mutation returns the LockPaymentOrderMutation object of the builder.
 func (lpouo *LockPaymentOrderUpdateOne) Mutation() *LockPaymentOrderMutation {
 	return lpouo.mutation
@@ -1121,6 +1516,75 @@ func (lpouo *LockPaymentOrderUpdateOne) RemoveTransactions(t ...*TransactionLog)
 	return lpouo.RemoveTransactionIDs(ids...)
 }
 
+// ClearSLARecords clears all "sla_records" edges to the ProviderSLARecord entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearSLARecords() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearSLARecords()
+	return lpouo
+}
+
+// RemoveSLARecordIDs removes the "sla_records" edge to ProviderSLARecord entities by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveSLARecordIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.RemoveSLARecordIDs(ids...)
+	return lpouo
+}
+
+// RemoveSLARecords removes "sla_records" edges to ProviderSLARecord entities.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveSLARecords(p ...*ProviderSLARecord) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return lpouo.RemoveSLARecordIDs(ids...)
+}
+
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearDisputes() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearDisputes()
+	return lpouo
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveDisputeIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.RemoveDisputeIDs(ids...)
+	return lpouo
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveDisputes(d ...*Dispute) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return lpouo.RemoveDisputeIDs(ids...)
+}
+
+// ClearDeadLetter clears the "dead_letter" edge to the DeadLetterOrder entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearDeadLetter() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearDeadLetter()
+	return lpouo
+}
+
+// ClearAssignments clears all "assignments" edges to the OrderAssignment entity.
+func (lpouo *LockPaymentOrderUpdateOne) ClearAssignments() *LockPaymentOrderUpdateOne {
+	lpouo.mutation.ClearAssignments()
+	return lpouo
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to OrderAssignment entities by IDs.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveAssignmentIDs(ids ...uuid.UUID) *LockPaymentOrderUpdateOne {
+	lpouo.mutation.RemoveAssignmentIDs(ids...)
+	return lpouo
+}
+
+// RemoveAssignments removes "assignments" edges to OrderAssignment entities.
+func (lpouo *LockPaymentOrderUpdateOne) RemoveAssignments(o ...*OrderAssignment) *LockPaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lpouo.RemoveAssignmentIDs(ids...)
+}
+
 // Where appends a list predicates to the LockPaymentOrderUpdate builder.
 func (lpouo *LockPaymentOrderUpdateOne) Where(ps ...predicate.LockPaymentOrder) *LockPaymentOrderUpdateOne {
 	lpouo.mutation.Where(ps...)
@@ -1285,6 +1749,12 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 			sqljson.Append(u, lockpaymentorder.FieldCancellationReasons, value)
 		})
 	}
+	if value, ok := lpouo.mutation.SplitPlan(); ok {
+		_spec.SetField(lockpaymentorder.FieldSplitPlan, field.TypeJSON, value)
+	}
+	if lpouo.mutation.SplitPlanCleared() {
+		_spec.ClearField(lockpaymentorder.FieldSplitPlan, field.TypeJSON)
+	}
 	if lpouo.mutation.TokenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1462,6 +1932,170 @@ func (lpouo *LockPaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *Loc
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lpouo.mutation.SLARecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.RemovedSLARecordsIDs(); len(nodes) > 0 && !lpouo.mutation.SLARecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.SLARecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.SLARecordsTable,
+			Columns: []string{lockpaymentorder.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !lpouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.DisputesTable,
+			Columns: []string{lockpaymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpouo.mutation.DeadLetterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   lockpaymentorder.DeadLetterTable,
+			Columns: []string{lockpaymentorder.DeadLetterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.DeadLetterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   lockpaymentorder.DeadLetterTable,
+			Columns: []string{lockpaymentorder.DeadLetterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deadletterorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lpouo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !lpouo.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lpouo.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   lockpaymentorder.AssignmentsTable,
+			Columns: []string{lockpaymentorder.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &LockPaymentOrder{config: lpouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,8 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "operating_timezone" character varying NULL, ADD COLUMN "operating_hours" jsonb NULL, ADD COLUMN "operating_hours_exceptions" jsonb NULL;
+-- Create "public_holidays" table
+CREATE TABLE "public_holidays" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 94489280512), "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "date" timestamptz NOT NULL, "name" character varying NOT NULL, "fiat_currency_public_holidays" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "public_holidays_fiat_currencies_public_holidays" FOREIGN KEY ("fiat_currency_public_holidays") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "publicholiday_date_fiat_currency_public_holidays" to table: "public_holidays"
+CREATE UNIQUE INDEX "publicholiday_date_fiat_currency_public_holidays" ON "public_holidays" ("date", "fiat_currency_public_holidays");
+-- Add pk ranges for ('public_holidays') tables
+INSERT INTO "ent_types" ("type") VALUES ('public_holidays');
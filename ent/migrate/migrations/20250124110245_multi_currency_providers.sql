@@ -0,0 +1,17 @@
+-- Create "fiat_currency_providers" table
+CREATE TABLE "fiat_currency_providers" ("fiat_currency_id" uuid NOT NULL, "provider_profile_id" character varying NOT NULL, PRIMARY KEY ("fiat_currency_id", "provider_profile_id"), CONSTRAINT "fiat_currency_providers_fiat_currency_id" FOREIGN KEY ("fiat_currency_id") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "fiat_currency_providers_provider_profile_id" FOREIGN KEY ("provider_profile_id") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Move existing provider currencies to the "fiat_currency_providers" table
+INSERT INTO "fiat_currency_providers" ("fiat_currency_id", "provider_profile_id") SELECT "fiat_currency_providers", "id" FROM "provider_profiles";
+-- Modify "provider_order_tokens" table
+ALTER TABLE "provider_order_tokens" ADD COLUMN "fiat_currency_provider_order_tokens" uuid NULL;
+-- Scope existing provider order tokens to the provider's currency
+UPDATE "provider_order_tokens" SET "fiat_currency_provider_order_tokens" = "provider_profiles"."fiat_currency_providers" FROM "provider_profiles" WHERE "provider_order_tokens"."provider_profile_order_tokens" = "provider_profiles"."id";
+-- Every provider had a currency ("fiat_currency_providers" is NOT NULL), so tokens left without one are only
+-- those not attached to any provider; they can't be matched or settled, and are dropped before the NOT NULL constraint
+DELETE FROM "provider_order_tokens" WHERE "fiat_currency_provider_order_tokens" IS NULL;
+-- Modify "provider_order_tokens" table
+ALTER TABLE "provider_order_tokens" ALTER COLUMN "fiat_currency_provider_order_tokens" SET NOT NULL, ADD CONSTRAINT "provider_order_tokens_fiat_currencies_provider_order_tokens" FOREIGN KEY ("fiat_currency_provider_order_tokens") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create index "providerordertoken_symbol_prov_1bcdf3a7640c7f80d0bd031b2ae5af5e" to table: "provider_order_tokens"
+CREATE UNIQUE INDEX "providerordertoken_symbol_prov_1bcdf3a7640c7f80d0bd031b2ae5af5e" ON "provider_order_tokens" ("symbol", "provider_profile_order_tokens", "fiat_currency_provider_order_tokens");
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" DROP CONSTRAINT "provider_profiles_fiat_currencies_providers", DROP COLUMN "fiat_currency_providers";
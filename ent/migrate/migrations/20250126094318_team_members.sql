@@ -0,0 +1,10 @@
+-- Create "team_audit_logs" table
+CREATE TABLE "team_audit_logs" ("id" uuid NOT NULL, "action" character varying NOT NULL, "actor_email" character varying NOT NULL, "target_email" character varying NOT NULL, "metadata" jsonb NULL, "created_at" timestamptz NOT NULL, "provider_profile_team_audit_logs" character varying NULL, "sender_profile_team_audit_logs" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "team_audit_logs_provider_profiles_team_audit_logs" FOREIGN KEY ("provider_profile_team_audit_logs") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_audit_logs_sender_profiles_team_audit_logs" FOREIGN KEY ("sender_profile_team_audit_logs") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create "team_invitations" table
+CREATE TABLE "team_invitations" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "email" character varying NOT NULL, "role" character varying NOT NULL, "token" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "expires_at" timestamptz NOT NULL, "provider_profile_team_invitations" character varying NULL, "sender_profile_team_invitations" uuid NULL, "user_sent_team_invitations" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "team_invitations_provider_profiles_team_invitations" FOREIGN KEY ("provider_profile_team_invitations") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_invitations_sender_profiles_team_invitations" FOREIGN KEY ("sender_profile_team_invitations") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_invitations_users_sent_team_invitations" FOREIGN KEY ("user_sent_team_invitations") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "team_invitations_token_key" to table: "team_invitations"
+CREATE UNIQUE INDEX "team_invitations_token_key" ON "team_invitations" ("token");
+-- Create "team_members" table
+CREATE TABLE "team_members" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "role" character varying NOT NULL, "provider_profile_team_members" character varying NULL, "sender_profile_team_members" uuid NULL, "user_team_memberships" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "team_members_provider_profiles_team_members" FOREIGN KEY ("provider_profile_team_members") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_members_sender_profiles_team_members" FOREIGN KEY ("sender_profile_team_members") REFERENCES "sender_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "team_members_users_team_memberships" FOREIGN KEY ("user_team_memberships") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Add pk ranges for ('team_audit_logs'),('team_invitations'),('team_members') tables
+INSERT INTO "ent_types" ("type") VALUES ('team_audit_logs'), ('team_invitations'), ('team_members');
@@ -0,0 +1,2 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "supported_institutions" jsonb NULL, ADD COLUMN "supported_institution_types" jsonb NULL;
@@ -0,0 +1,8 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "is_healthy" boolean NOT NULL DEFAULT true, ADD COLUMN "health_failure_streak" bigint NOT NULL DEFAULT 0, ADD COLUMN "last_health_check_at" timestamptz NULL;
+-- Create "provider_health_checks" table
+CREATE TABLE "provider_health_checks" ("id" uuid NOT NULL, "is_healthy" boolean NOT NULL, "latency_ms" bigint NOT NULL, "status_code" bigint NULL, "error" character varying NULL, "created_at" timestamptz NOT NULL, "provider_profile_health_checks" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "provider_health_checks_provider_profiles_health_checks" FOREIGN KEY ("provider_profile_health_checks") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "providerhealthcheck_created_at_provider_profile_health_checks" to table: "provider_health_checks"
+CREATE INDEX "providerhealthcheck_created_at_provider_profile_health_checks" ON "provider_health_checks" ("created_at", "provider_profile_health_checks");
+-- Add pk ranges for ('provider_health_checks') tables
+INSERT INTO "ent_types" ("type") VALUES ('provider_health_checks');
@@ -0,0 +1,8 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "demoted_until" timestamptz NULL, ADD COLUMN "suspended_until" timestamptz NULL;
+-- Create "provider_sla_records" table
+CREATE TABLE "provider_sla_records" ("id" uuid NOT NULL, "assigned_at" timestamptz NOT NULL, "accepted_at" timestamptz NULL, "declined_at" timestamptz NULL, "fulfilled_at" timestamptz NULL, "validated_at" timestamptz NULL, "breach" character varying NULL, "breached_at" timestamptz NULL, "lock_payment_order_sla_records" uuid NOT NULL, "provider_profile_sla_records" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "provider_sla_records_lock_payment_orders_sla_records" FOREIGN KEY ("lock_payment_order_sla_records") REFERENCES "lock_payment_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "provider_sla_records_provider_profiles_sla_records" FOREIGN KEY ("provider_profile_sla_records") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "providerslarecord_assigned_at_provider_profile_sla_records" to table: "provider_sla_records"
+CREATE INDEX "providerslarecord_assigned_at_provider_profile_sla_records" ON "provider_sla_records" ("assigned_at", "provider_profile_sla_records");
+-- Add pk ranges for ('provider_sla_records') tables
+INSERT INTO "ent_types" ("type") VALUES ('provider_sla_records');
@@ -0,0 +1,2 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "node_protocol_version" bigint NOT NULL DEFAULT 1, ADD COLUMN "node_capabilities" jsonb NULL;
@@ -0,0 +1,8 @@
+-- Create "disputes" table
+CREATE TABLE "disputes" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "reason" text NOT NULL, "status" character varying NOT NULL DEFAULT 'open', "response_deadline" timestamptz NOT NULL, "resolution_deadline" timestamptz NOT NULL, "resolution" character varying NULL, "resolution_note" text NULL, "trust_score_adjustment" double precision NOT NULL, "compensation_amount" double precision NOT NULL, "compensation_status" character varying NOT NULL DEFAULT 'none', "resolved_at" timestamptz NULL, "lock_payment_order_disputes" uuid NULL, "payment_order_disputes" uuid NOT NULL, "provider_profile_disputes" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "disputes_lock_payment_orders_disputes" FOREIGN KEY ("lock_payment_order_disputes") REFERENCES "lock_payment_orders" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "disputes_payment_orders_disputes" FOREIGN KEY ("payment_order_disputes") REFERENCES "payment_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "disputes_provider_profiles_disputes" FOREIGN KEY ("provider_profile_disputes") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "dispute_status_response_deadline" to table: "disputes"
+CREATE INDEX "dispute_status_response_deadline" ON "disputes" ("status", "response_deadline");
+-- Create "dispute_evidences" table
+CREATE TABLE "dispute_evidences" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "submitted_by" character varying NOT NULL, "type" character varying NOT NULL, "reference" character varying NULL, "url" character varying NULL, "note" text NULL, "dispute_evidence" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "dispute_evidences_disputes_evidence" FOREIGN KEY ("dispute_evidence") REFERENCES "disputes" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Add pk ranges for ('disputes'),('dispute_evidences') tables
+INSERT INTO "ent_types" ("type") VALUES ('disputes'), ('dispute_evidences');
@@ -0,0 +1,2 @@
+-- Modify "lock_order_fulfillments" table
+ALTER TABLE "lock_order_fulfillments" ADD COLUMN "psp_session_id" character varying NULL, ADD COLUMN "bank_reference" character varying NULL, ADD COLUMN "transferred_at" timestamptz NULL, ADD COLUMN "receipt_key" character varying NULL, ADD COLUMN "receipt_content_type" character varying NULL, ADD COLUMN "recipient_confirmation" jsonb NULL;
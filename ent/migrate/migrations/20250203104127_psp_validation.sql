@@ -0,0 +1,4 @@
+-- Modify "fiat_currencies" table
+ALTER TABLE "fiat_currencies" ADD COLUMN "fulfillment_validation" character varying NOT NULL DEFAULT 'provider';
+-- Modify "lock_order_fulfillments" table
+ALTER TABLE "lock_order_fulfillments" ADD COLUMN "psp_confirmed_at" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- Modify "fiat_currencies" table
+ALTER TABLE "fiat_currencies" ADD COLUMN "matching_strategy" character varying NULL;
+-- Modify "provision_buckets" table
+ALTER TABLE "provision_buckets" ADD COLUMN "matching_strategy" character varying NULL;
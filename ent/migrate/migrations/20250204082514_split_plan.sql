@@ -0,0 +1,2 @@
+-- Modify "lock_payment_orders" table
+ALTER TABLE "lock_payment_orders" ADD COLUMN "split_plan" jsonb NULL;
@@ -0,0 +1,6 @@
+-- Create "bucket_proposals" table
+CREATE TABLE "bucket_proposals" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "window_start" timestamptz NOT NULL, "window_end" timestamptz NOT NULL, "order_count" bigint NOT NULL, "buckets" jsonb NOT NULL, "current_buckets" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "applied_at" timestamptz NULL, "fiat_currency_bucket_proposals" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "bucket_proposals_fiat_currencies_bucket_proposals" FOREIGN KEY ("fiat_currency_bucket_proposals") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "bucketproposal_status_fiat_currency_bucket_proposals" to table: "bucket_proposals"
+CREATE INDEX "bucketproposal_status_fiat_currency_bucket_proposals" ON "bucket_proposals" ("status", "fiat_currency_bucket_proposals");
+-- Add pk ranges for ('bucket_proposals') tables
+INSERT INTO "ent_types" ("type") VALUES ('bucket_proposals');
@@ -0,0 +1,2 @@
+-- Modify "fiat_currencies" table
+ALTER TABLE "fiat_currencies" ADD COLUMN "order_request_mode" character varying NOT NULL DEFAULT 'sequential';
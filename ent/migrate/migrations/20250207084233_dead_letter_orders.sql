@@ -0,0 +1,8 @@
+-- Create "dead_letter_orders" table
+CREATE TABLE "dead_letter_orders" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "reason" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 1, "next_retry_at" timestamptz NOT NULL, "refund_deadline" timestamptz NOT NULL, "resolved_at" timestamptz NULL, "lock_payment_order_dead_letter" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "dead_letter_orders_lock_payment_orders_dead_letter" FOREIGN KEY ("lock_payment_order_dead_letter") REFERENCES "lock_payment_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "dead_letter_orders_lock_payment_order_dead_letter_key" to table: "dead_letter_orders"
+CREATE UNIQUE INDEX "dead_letter_orders_lock_payment_order_dead_letter_key" ON "dead_letter_orders" ("lock_payment_order_dead_letter");
+-- Create index "deadletterorder_status_next_retry_at" to table: "dead_letter_orders"
+CREATE INDEX "deadletterorder_status_next_retry_at" ON "dead_letter_orders" ("status", "next_retry_at");
+-- Add pk ranges for ('dead_letter_orders') tables
+INSERT INTO "ent_types" ("type") VALUES ('dead_letter_orders');
@@ -0,0 +1,6 @@
+-- Create "order_assignments" table
+CREATE TABLE "order_assignments" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "event" character varying NOT NULL, "expires_at" timestamptz NULL, "lock_payment_order_assignments" uuid NOT NULL, "provider_profile_order_assignments" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "order_assignments_lock_payment_orders_assignments" FOREIGN KEY ("lock_payment_order_assignments") REFERENCES "lock_payment_orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "order_assignments_provider_profiles_order_assignments" FOREIGN KEY ("provider_profile_order_assignments") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "orderassignment_event_expires_at" to table: "order_assignments"
+CREATE INDEX "orderassignment_event_expires_at" ON "order_assignments" ("event", "expires_at");
+-- Add pk ranges for ('order_assignments') tables
+INSERT INTO "ent_types" ("type") VALUES ('order_assignments');
@@ -0,0 +1,8 @@
+-- Create "rate_snapshots" table
+CREATE TABLE "rate_snapshots" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "kind" character varying NOT NULL, "resolution" character varying NOT NULL DEFAULT 'raw', "token" character varying NULL, "open" double precision NOT NULL, "high" double precision NOT NULL, "low" double precision NOT NULL, "close" double precision NOT NULL, "sample_count" bigint NOT NULL DEFAULT 1, "recorded_at" timestamptz NOT NULL, "fiat_currency_rate_snapshots" uuid NOT NULL, "provider_profile_rate_snapshots" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "rate_snapshots_fiat_currencies_rate_snapshots" FOREIGN KEY ("fiat_currency_rate_snapshots") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "rate_snapshots_provider_profiles_rate_snapshots" FOREIGN KEY ("provider_profile_rate_snapshots") REFERENCES "provider_profiles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "ratesnapshot_kind_recorded_at_fiat_currency_rate_snapshots" to table: "rate_snapshots"
+CREATE INDEX "ratesnapshot_kind_recorded_at_fiat_currency_rate_snapshots" ON "rate_snapshots" ("kind", "recorded_at", "fiat_currency_rate_snapshots");
+-- Create index "ratesnapshot_resolution_recorded_at" to table: "rate_snapshots"
+CREATE INDEX "ratesnapshot_resolution_recorded_at" ON "rate_snapshots" ("resolution", "recorded_at");
+-- Add pk ranges for ('rate_snapshots') tables
+INSERT INTO "ent_types" ("type") VALUES ('rate_snapshots');
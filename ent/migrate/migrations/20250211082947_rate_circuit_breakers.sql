@@ -0,0 +1,6 @@
+-- Create "rate_circuit_breakers" table
+CREATE TABLE "rate_circuit_breakers" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "max_change_percent" double precision NOT NULL, "change_window" bigint NOT NULL DEFAULT 30, "max_divergence_percent" double precision NOT NULL, "auto_reset_after" bigint NOT NULL DEFAULT 0, "tripped" boolean NOT NULL DEFAULT false, "trip_reason" character varying NULL, "tripped_at" timestamptz NULL, "frozen_rate" double precision NULL, "fiat_currency_rate_circuit_breaker" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "rate_circuit_breakers_fiat_currencies_rate_circuit_breaker" FOREIGN KEY ("fiat_currency_rate_circuit_breaker") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "rate_circuit_breakers_fiat_currency_rate_circuit_breaker_key" to table: "rate_circuit_breakers"
+CREATE UNIQUE INDEX "rate_circuit_breakers_fiat_currency_rate_circuit_breaker_key" ON "rate_circuit_breakers" ("fiat_currency_rate_circuit_breaker");
+-- Add pk ranges for ('rate_circuit_breakers') tables
+INSERT INTO "ent_types" ("type") VALUES ('rate_circuit_breakers');
@@ -0,0 +1,6 @@
+-- Create "token_market_rates" table
+CREATE TABLE "token_market_rates" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "token" character varying NOT NULL, "market_rate" double precision NOT NULL, "fiat_currency_token_market_rates" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "token_market_rates_fiat_currencies_token_market_rates" FOREIGN KEY ("fiat_currency_token_market_rates") REFERENCES "fiat_currencies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "tokenmarketrate_token_fiat_currency_token_market_rates" to table: "token_market_rates"
+CREATE UNIQUE INDEX "tokenmarketrate_token_fiat_currency_token_market_rates" ON "token_market_rates" ("token", "fiat_currency_token_market_rates");
+-- Add pk ranges for ('token_market_rates') tables
+INSERT INTO "ent_types" ("type") VALUES ('token_market_rates');
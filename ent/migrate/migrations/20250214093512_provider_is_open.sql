@@ -0,0 +1,2 @@
+-- Modify "provider_profiles" table
+ALTER TABLE "provider_profiles" ADD COLUMN "is_open" boolean NOT NULL DEFAULT true;
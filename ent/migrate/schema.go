@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -35,6 +36,153 @@ var (
 			},
 		},
 	}
+	// BucketProposalsColumns holds the columns for the "bucket_proposals" table.
+	BucketProposalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "window_start", Type: field.TypeTime},
+		{Name: "window_end", Type: field.TypeTime},
+		{Name: "order_count", Type: field.TypeInt},
+		{Name: "buckets", Type: field.TypeJSON},
+		{Name: "current_buckets", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "applied", "dismissed", "superseded"}, Default: "pending"},
+		{Name: "applied_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fiat_currency_bucket_proposals", Type: field.TypeUUID},
+	}
+	// BucketProposalsTable holds the schema information for the "bucket_proposals" table.
+	BucketProposalsTable = &schema.Table{
+		Name:       "bucket_proposals",
+		Columns:    BucketProposalsColumns,
+		PrimaryKey: []*schema.Column{BucketProposalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bucket_proposals_fiat_currencies_bucket_proposals",
+				Columns:    []*schema.Column{BucketProposalsColumns[10]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bucketproposal_status_fiat_currency_bucket_proposals",
+				Unique:  false,
+				Columns: []*schema.Column{BucketProposalsColumns[8], BucketProposalsColumns[10]},
+			},
+		},
+	}
+	// DeadLetterOrdersColumns holds the columns for the "dead_letter_orders" table.
+	DeadLetterOrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"no_provider_for_token", "rate_out_of_range", "all_excluded", "no_eligible_provider", "matching_paused"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "resolved", "refunded"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 1},
+		{Name: "next_retry_at", Type: field.TypeTime},
+		{Name: "refund_deadline", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lock_payment_order_dead_letter", Type: field.TypeUUID, Unique: true},
+	}
+	// DeadLetterOrdersTable holds the schema information for the "dead_letter_orders" table.
+	DeadLetterOrdersTable = &schema.Table{
+		Name:       "dead_letter_orders",
+		Columns:    DeadLetterOrdersColumns,
+		PrimaryKey: []*schema.Column{DeadLetterOrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "dead_letter_orders_lock_payment_orders_dead_letter",
+				Columns:    []*schema.Column{DeadLetterOrdersColumns[9]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletterorder_status_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLetterOrdersColumns[4], DeadLetterOrdersColumns[6]},
+			},
+		},
+	}
+	// DisputesColumns holds the columns for the "disputes" table.
+	DisputesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "under_review", "resolved", "withdrawn"}, Default: "open"},
+		{Name: "response_deadline", Type: field.TypeTime},
+		{Name: "resolution_deadline", Type: field.TypeTime},
+		{Name: "resolution", Type: field.TypeEnum, Nullable: true, Enums: []string{"sender_favored", "provider_favored"}},
+		{Name: "resolution_note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "trust_score_adjustment", Type: field.TypeFloat64},
+		{Name: "compensation_amount", Type: field.TypeFloat64},
+		{Name: "compensation_status", Type: field.TypeEnum, Enums: []string{"none", "pending", "paid"}, Default: "none"},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lock_payment_order_disputes", Type: field.TypeUUID, Nullable: true},
+		{Name: "payment_order_disputes", Type: field.TypeUUID},
+		{Name: "provider_profile_disputes", Type: field.TypeString, Nullable: true},
+	}
+	// DisputesTable holds the schema information for the "disputes" table.
+	DisputesTable = &schema.Table{
+		Name:       "disputes",
+		Columns:    DisputesColumns,
+		PrimaryKey: []*schema.Column{DisputesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "disputes_lock_payment_orders_disputes",
+				Columns:    []*schema.Column{DisputesColumns[13]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "disputes_payment_orders_disputes",
+				Columns:    []*schema.Column{DisputesColumns[14]},
+				RefColumns: []*schema.Column{PaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "disputes_provider_profiles_disputes",
+				Columns:    []*schema.Column{DisputesColumns[15]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dispute_status_response_deadline",
+				Unique:  false,
+				Columns: []*schema.Column{DisputesColumns[4], DisputesColumns[5]},
+			},
+		},
+	}
+	// DisputeEvidencesColumns holds the columns for the "dispute_evidences" table.
+	DisputeEvidencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "submitted_by", Type: field.TypeEnum, Enums: []string{"sender", "provider", "admin"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"psp_reference", "receipt", "bank_statement", "other"}},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "dispute_evidence", Type: field.TypeUUID},
+	}
+	// DisputeEvidencesTable holds the schema information for the "dispute_evidences" table.
+	DisputeEvidencesTable = &schema.Table{
+		Name:       "dispute_evidences",
+		Columns:    DisputeEvidencesColumns,
+		PrimaryKey: []*schema.Column{DisputeEvidencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "dispute_evidences_disputes_evidence",
+				Columns:    []*schema.Column{DisputeEvidencesColumns[8]},
+				RefColumns: []*schema.Column{DisputesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// FiatCurrenciesColumns holds the columns for the "fiat_currencies" table.
 	FiatCurrenciesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -47,6 +195,9 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "market_rate", Type: field.TypeFloat64},
 		{Name: "is_enabled", Type: field.TypeBool, Default: false},
+		{Name: "fulfillment_validation", Type: field.TypeEnum, Enums: []string{"provider", "psp_optional", "psp_required"}, Default: "provider"},
+		{Name: "matching_strategy", Type: field.TypeEnum, Nullable: true, Enums: []string{"price_time", "round_robin", "trust_weighted", "lowest_latency"}},
+		{Name: "order_request_mode", Type: field.TypeEnum, Enums: []string{"sequential", "broadcast"}, Default: "sequential"},
 	}
 	// FiatCurrenciesTable holds the schema information for the "fiat_currencies" table.
 	FiatCurrenciesTable = &schema.Table{
@@ -135,6 +286,13 @@ var (
 		{Name: "psp", Type: field.TypeString, Nullable: true},
 		{Name: "validation_status", Type: field.TypeEnum, Enums: []string{"pending", "success", "failed"}, Default: "pending"},
 		{Name: "validation_error", Type: field.TypeString, Nullable: true},
+		{Name: "psp_session_id", Type: field.TypeString, Nullable: true},
+		{Name: "bank_reference", Type: field.TypeString, Nullable: true},
+		{Name: "transferred_at", Type: field.TypeTime, Nullable: true},
+		{Name: "receipt_key", Type: field.TypeString, Nullable: true},
+		{Name: "receipt_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "recipient_confirmation", Type: field.TypeJSON, Nullable: true},
+		{Name: "psp_confirmed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "lock_payment_order_fulfillments", Type: field.TypeUUID},
 	}
 	// LockOrderFulfillmentsTable holds the schema information for the "lock_order_fulfillments" table.
@@ -145,7 +303,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lock_order_fulfillments_lock_payment_orders_fulfillments",
-				Columns:    []*schema.Column{LockOrderFulfillmentsColumns[7]},
+				Columns:    []*schema.Column{LockOrderFulfillmentsColumns[14]},
 				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -169,6 +327,7 @@ var (
 		{Name: "memo", Type: field.TypeString, Nullable: true},
 		{Name: "cancellation_count", Type: field.TypeInt, Default: 0},
 		{Name: "cancellation_reasons", Type: field.TypeJSON},
+		{Name: "split_plan", Type: field.TypeJSON, Nullable: true},
 		{Name: "provider_profile_assigned_orders", Type: field.TypeString, Nullable: true},
 		{Name: "provision_bucket_lock_payment_orders", Type: field.TypeInt, Nullable: true},
 		{Name: "token_lock_payment_orders", Type: field.TypeInt},
@@ -181,19 +340,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "lock_payment_orders_provider_profiles_assigned_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[16]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[17]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "lock_payment_orders_provision_buckets_lock_payment_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[17]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[18]},
 				RefColumns: []*schema.Column{ProvisionBucketsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "lock_payment_orders_tokens_lock_payment_orders",
-				Columns:    []*schema.Column{LockPaymentOrdersColumns[18]},
+				Columns:    []*schema.Column{LockPaymentOrdersColumns[19]},
 				RefColumns: []*schema.Column{TokensColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -202,7 +361,7 @@ var (
 			{
 				Name:    "lockpaymentorder_gateway_id_rate_tx_hash_block_number_institution_account_identifier_account_name_memo_token_lock_payment_orders",
 				Unique:  true,
-				Columns: []*schema.Column{LockPaymentOrdersColumns[3], LockPaymentOrdersColumns[5], LockPaymentOrdersColumns[7], LockPaymentOrdersColumns[9], LockPaymentOrdersColumns[10], LockPaymentOrdersColumns[11], LockPaymentOrdersColumns[12], LockPaymentOrdersColumns[13], LockPaymentOrdersColumns[18]},
+				Columns: []*schema.Column{LockPaymentOrdersColumns[3], LockPaymentOrdersColumns[5], LockPaymentOrdersColumns[7], LockPaymentOrdersColumns[9], LockPaymentOrdersColumns[10], LockPaymentOrdersColumns[11], LockPaymentOrdersColumns[12], LockPaymentOrdersColumns[13], LockPaymentOrdersColumns[19]},
 			},
 		},
 	}
@@ -225,6 +384,43 @@ var (
 		Columns:    NetworksColumns,
 		PrimaryKey: []*schema.Column{NetworksColumns[0]},
 	}
+	// OrderAssignmentsColumns holds the columns for the "order_assignments" table.
+	OrderAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"offered", "accepted", "declined", "timed_out", "excluded", "cancelled"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lock_payment_order_assignments", Type: field.TypeUUID},
+		{Name: "provider_profile_order_assignments", Type: field.TypeString},
+	}
+	// OrderAssignmentsTable holds the schema information for the "order_assignments" table.
+	OrderAssignmentsTable = &schema.Table{
+		Name:       "order_assignments",
+		Columns:    OrderAssignmentsColumns,
+		PrimaryKey: []*schema.Column{OrderAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_assignments_lock_payment_orders_assignments",
+				Columns:    []*schema.Column{OrderAssignmentsColumns[5]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "order_assignments_provider_profiles_order_assignments",
+				Columns:    []*schema.Column{OrderAssignmentsColumns[6]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderassignment_event_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrderAssignmentsColumns[3], OrderAssignmentsColumns[4]},
+			},
+		},
+	}
 	// PaymentOrdersColumns holds the columns for the "payment_orders" table.
 	PaymentOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -309,6 +505,37 @@ var (
 			},
 		},
 	}
+	// ProviderHealthChecksColumns holds the columns for the "provider_health_checks" table.
+	ProviderHealthChecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "is_healthy", Type: field.TypeBool},
+		{Name: "latency_ms", Type: field.TypeInt64},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "provider_profile_health_checks", Type: field.TypeString},
+	}
+	// ProviderHealthChecksTable holds the schema information for the "provider_health_checks" table.
+	ProviderHealthChecksTable = &schema.Table{
+		Name:       "provider_health_checks",
+		Columns:    ProviderHealthChecksColumns,
+		PrimaryKey: []*schema.Column{ProviderHealthChecksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_health_checks_provider_profiles_health_checks",
+				Columns:    []*schema.Column{ProviderHealthChecksColumns[6]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "providerhealthcheck_created_at_provider_profile_health_checks",
+				Unique:  false,
+				Columns: []*schema.Column{ProviderHealthChecksColumns[5], ProviderHealthChecksColumns[6]},
+			},
+		},
+	}
 	// ProviderOrderTokensColumns holds the columns for the "provider_order_tokens" table.
 	ProviderOrderTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -321,6 +548,9 @@ var (
 		{Name: "max_order_amount", Type: field.TypeFloat64},
 		{Name: "min_order_amount", Type: field.TypeFloat64},
 		{Name: "addresses", Type: field.TypeJSON},
+		{Name: "rate_stale_since", Type: field.TypeTime, Nullable: true},
+		{Name: "rate_stale_notified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fiat_currency_provider_order_tokens", Type: field.TypeUUID},
 		{Name: "provider_profile_order_tokens", Type: field.TypeString, Nullable: true},
 	}
 	// ProviderOrderTokensTable holds the schema information for the "provider_order_tokens" table.
@@ -329,13 +559,26 @@ var (
 		Columns:    ProviderOrderTokensColumns,
 		PrimaryKey: []*schema.Column{ProviderOrderTokensColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_order_tokens_fiat_currencies_provider_order_tokens",
+				Columns:    []*schema.Column{ProviderOrderTokensColumns[12]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "provider_order_tokens_provider_profiles_order_tokens",
-				Columns:    []*schema.Column{ProviderOrderTokensColumns[10]},
+				Columns:    []*schema.Column{ProviderOrderTokensColumns[13]},
 				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "providerordertoken_symbol_provider_profile_order_tokens_fiat_currency_provider_order_tokens",
+				Unique:  true,
+				Columns: []*schema.Column{ProviderOrderTokensColumns[3], ProviderOrderTokensColumns[13], ProviderOrderTokensColumns[12]},
+			},
+		},
 	}
 	// ProviderProfilesColumns holds the columns for the "provider_profiles" table.
 	ProviderProfilesColumns = []*schema.Column{
@@ -355,7 +598,19 @@ var (
 		{Name: "identity_document", Type: field.TypeString, Nullable: true},
 		{Name: "business_document", Type: field.TypeString, Nullable: true},
 		{Name: "is_kyb_verified", Type: field.TypeBool, Default: false},
-		{Name: "fiat_currency_providers", Type: field.TypeUUID},
+		{Name: "operating_timezone", Type: field.TypeString, Nullable: true},
+		{Name: "operating_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "operating_hours_exceptions", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_open", Type: field.TypeBool, Default: true},
+		{Name: "supported_institutions", Type: field.TypeJSON, Nullable: true},
+		{Name: "supported_institution_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_healthy", Type: field.TypeBool, Default: true},
+		{Name: "health_failure_streak", Type: field.TypeInt, Default: 0},
+		{Name: "last_health_check_at", Type: field.TypeTime, Nullable: true},
+		{Name: "node_protocol_version", Type: field.TypeInt, Default: 1},
+		{Name: "node_capabilities", Type: field.TypeJSON, Nullable: true},
+		{Name: "demoted_until", Type: field.TypeTime, Nullable: true},
+		{Name: "suspended_until", Type: field.TypeTime, Nullable: true},
 		{Name: "user_provider_profile", Type: field.TypeUUID, Unique: true},
 	}
 	// ProviderProfilesTable holds the schema information for the "provider_profiles" table.
@@ -364,15 +619,9 @@ var (
 		Columns:    ProviderProfilesColumns,
 		PrimaryKey: []*schema.Column{ProviderProfilesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
-			{
-				Symbol:     "provider_profiles_fiat_currencies_providers",
-				Columns:    []*schema.Column{ProviderProfilesColumns[16]},
-				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
-				OnDelete:   schema.Cascade,
-			},
 			{
 				Symbol:     "provider_profiles_users_provider_profile",
-				Columns:    []*schema.Column{ProviderProfilesColumns[17]},
+				Columns:    []*schema.Column{ProviderProfilesColumns[29]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -400,12 +649,53 @@ var (
 			},
 		},
 	}
+	// ProviderSLARecordsColumns holds the columns for the "provider_sla_records" table.
+	ProviderSLARecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "assigned_at", Type: field.TypeTime},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "declined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fulfilled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "validated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "breach", Type: field.TypeEnum, Nullable: true, Enums: []string{"accept_timeout", "fulfillment_timeout"}},
+		{Name: "breached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lock_payment_order_sla_records", Type: field.TypeUUID},
+		{Name: "provider_profile_sla_records", Type: field.TypeString},
+	}
+	// ProviderSLARecordsTable holds the schema information for the "provider_sla_records" table.
+	ProviderSLARecordsTable = &schema.Table{
+		Name:       "provider_sla_records",
+		Columns:    ProviderSLARecordsColumns,
+		PrimaryKey: []*schema.Column{ProviderSLARecordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "provider_sla_records_lock_payment_orders_sla_records",
+				Columns:    []*schema.Column{ProviderSLARecordsColumns[8]},
+				RefColumns: []*schema.Column{LockPaymentOrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "provider_sla_records_provider_profiles_sla_records",
+				Columns:    []*schema.Column{ProviderSLARecordsColumns[9]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "providerslarecord_assigned_at_provider_profile_sla_records",
+				Unique:  false,
+				Columns: []*schema.Column{ProviderSLARecordsColumns[1], ProviderSLARecordsColumns[9]},
+			},
+		},
+	}
 	// ProvisionBucketsColumns holds the columns for the "provision_buckets" table.
 	ProvisionBucketsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "min_amount", Type: field.TypeFloat64},
 		{Name: "max_amount", Type: field.TypeFloat64},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "matching_strategy", Type: field.TypeEnum, Nullable: true, Enums: []string{"price_time", "round_robin", "trust_weighted", "lowest_latency"}},
 		{Name: "fiat_currency_provision_buckets", Type: field.TypeUUID},
 	}
 	// ProvisionBucketsTable holds the schema information for the "provision_buckets" table.
@@ -416,10 +706,118 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provision_buckets_fiat_currencies_provision_buckets",
-				Columns:    []*schema.Column{ProvisionBucketsColumns[4]},
+				Columns:    []*schema.Column{ProvisionBucketsColumns[5]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PublicHolidaysColumns holds the columns for the "public_holidays" table.
+	PublicHolidaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "fiat_currency_public_holidays", Type: field.TypeUUID},
+	}
+	// PublicHolidaysTable holds the schema information for the "public_holidays" table.
+	PublicHolidaysTable = &schema.Table{
+		Name:       "public_holidays",
+		Columns:    PublicHolidaysColumns,
+		PrimaryKey: []*schema.Column{PublicHolidaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "public_holidays_fiat_currencies_public_holidays",
+				Columns:    []*schema.Column{PublicHolidaysColumns[5]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "publicholiday_date_fiat_currency_public_holidays",
+				Unique:  true,
+				Columns: []*schema.Column{PublicHolidaysColumns[3], PublicHolidaysColumns[5]},
+			},
+		},
+	}
+	// RateCircuitBreakersColumns holds the columns for the "rate_circuit_breakers" table.
+	RateCircuitBreakersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "max_change_percent", Type: field.TypeFloat64},
+		{Name: "change_window", Type: field.TypeInt, Default: 30},
+		{Name: "max_divergence_percent", Type: field.TypeFloat64},
+		{Name: "auto_reset_after", Type: field.TypeInt, Default: 0},
+		{Name: "tripped", Type: field.TypeBool, Default: false},
+		{Name: "trip_reason", Type: field.TypeString, Nullable: true},
+		{Name: "tripped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "frozen_rate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "fiat_currency_rate_circuit_breaker", Type: field.TypeUUID, Unique: true},
+	}
+	// RateCircuitBreakersTable holds the schema information for the "rate_circuit_breakers" table.
+	RateCircuitBreakersTable = &schema.Table{
+		Name:       "rate_circuit_breakers",
+		Columns:    RateCircuitBreakersColumns,
+		PrimaryKey: []*schema.Column{RateCircuitBreakersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rate_circuit_breakers_fiat_currencies_rate_circuit_breaker",
+				Columns:    []*schema.Column{RateCircuitBreakersColumns[11]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RateSnapshotsColumns holds the columns for the "rate_snapshots" table.
+	RateSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"market", "external", "provider"}},
+		{Name: "resolution", Type: field.TypeEnum, Enums: []string{"raw", "hour", "day"}, Default: "raw"},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "open", Type: field.TypeFloat64},
+		{Name: "high", Type: field.TypeFloat64},
+		{Name: "low", Type: field.TypeFloat64},
+		{Name: "close", Type: field.TypeFloat64},
+		{Name: "sample_count", Type: field.TypeInt, Default: 1},
+		{Name: "recorded_at", Type: field.TypeTime},
+		{Name: "fiat_currency_rate_snapshots", Type: field.TypeUUID},
+		{Name: "provider_profile_rate_snapshots", Type: field.TypeString, Nullable: true},
+	}
+	// RateSnapshotsTable holds the schema information for the "rate_snapshots" table.
+	RateSnapshotsTable = &schema.Table{
+		Name:       "rate_snapshots",
+		Columns:    RateSnapshotsColumns,
+		PrimaryKey: []*schema.Column{RateSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rate_snapshots_fiat_currencies_rate_snapshots",
+				Columns:    []*schema.Column{RateSnapshotsColumns[12]},
 				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "rate_snapshots_provider_profiles_rate_snapshots",
+				Columns:    []*schema.Column{RateSnapshotsColumns[13]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratesnapshot_kind_recorded_at_fiat_currency_rate_snapshots",
+				Unique:  false,
+				Columns: []*schema.Column{RateSnapshotsColumns[3], RateSnapshotsColumns[11], RateSnapshotsColumns[12]},
+			},
+			{
+				Name:    "ratesnapshot_resolution_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateSnapshotsColumns[4], RateSnapshotsColumns[11]},
+			},
 		},
 	}
 	// ReceiveAddressesColumns holds the columns for the "receive_addresses" table.
@@ -513,6 +911,113 @@ var (
 			},
 		},
 	}
+	// TeamAuditLogsColumns holds the columns for the "team_audit_logs" table.
+	TeamAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"member_invited", "invitation_accepted", "invitation_revoked", "role_changed", "member_removed"}},
+		{Name: "actor_email", Type: field.TypeString},
+		{Name: "target_email", Type: field.TypeString},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "provider_profile_team_audit_logs", Type: field.TypeString, Nullable: true},
+		{Name: "sender_profile_team_audit_logs", Type: field.TypeUUID, Nullable: true},
+	}
+	// TeamAuditLogsTable holds the schema information for the "team_audit_logs" table.
+	TeamAuditLogsTable = &schema.Table{
+		Name:       "team_audit_logs",
+		Columns:    TeamAuditLogsColumns,
+		PrimaryKey: []*schema.Column{TeamAuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_audit_logs_provider_profiles_team_audit_logs",
+				Columns:    []*schema.Column{TeamAuditLogsColumns[6]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_audit_logs_sender_profiles_team_audit_logs",
+				Columns:    []*schema.Column{TeamAuditLogsColumns[7]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TeamInvitationsColumns holds the columns for the "team_invitations" table.
+	TeamInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "developer", "finance"}},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "revoked"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "provider_profile_team_invitations", Type: field.TypeString, Nullable: true},
+		{Name: "sender_profile_team_invitations", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_sent_team_invitations", Type: field.TypeUUID, Nullable: true},
+	}
+	// TeamInvitationsTable holds the schema information for the "team_invitations" table.
+	TeamInvitationsTable = &schema.Table{
+		Name:       "team_invitations",
+		Columns:    TeamInvitationsColumns,
+		PrimaryKey: []*schema.Column{TeamInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_invitations_provider_profiles_team_invitations",
+				Columns:    []*schema.Column{TeamInvitationsColumns[8]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_invitations_sender_profiles_team_invitations",
+				Columns:    []*schema.Column{TeamInvitationsColumns[9]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_invitations_users_sent_team_invitations",
+				Columns:    []*schema.Column{TeamInvitationsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TeamMembersColumns holds the columns for the "team_members" table.
+	TeamMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "developer", "finance"}},
+		{Name: "provider_profile_team_members", Type: field.TypeString, Nullable: true},
+		{Name: "sender_profile_team_members", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_team_memberships", Type: field.TypeUUID},
+	}
+	// TeamMembersTable holds the schema information for the "team_members" table.
+	TeamMembersTable = &schema.Table{
+		Name:       "team_members",
+		Columns:    TeamMembersColumns,
+		PrimaryKey: []*schema.Column{TeamMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "team_members_provider_profiles_team_members",
+				Columns:    []*schema.Column{TeamMembersColumns[4]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_members_sender_profiles_team_members",
+				Columns:    []*schema.Column{TeamMembersColumns[5]},
+				RefColumns: []*schema.Column{SenderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "team_members_users_team_memberships",
+				Columns:    []*schema.Column{TeamMembersColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TokensColumns holds the columns for the "tokens" table.
 	TokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -538,6 +1043,36 @@ var (
 			},
 		},
 	}
+	// TokenMarketRatesColumns holds the columns for the "token_market_rates" table.
+	TokenMarketRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token", Type: field.TypeString},
+		{Name: "market_rate", Type: field.TypeFloat64},
+		{Name: "fiat_currency_token_market_rates", Type: field.TypeUUID},
+	}
+	// TokenMarketRatesTable holds the schema information for the "token_market_rates" table.
+	TokenMarketRatesTable = &schema.Table{
+		Name:       "token_market_rates",
+		Columns:    TokenMarketRatesColumns,
+		PrimaryKey: []*schema.Column{TokenMarketRatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "token_market_rates_fiat_currencies_token_market_rates",
+				Columns:    []*schema.Column{TokenMarketRatesColumns[5]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenmarketrate_token_fiat_currency_token_market_rates",
+				Unique:  true,
+				Columns: []*schema.Column{TokenMarketRatesColumns[3], TokenMarketRatesColumns[5]},
+			},
+		},
+	}
 	// TransactionLogsColumns holds the columns for the "transaction_logs" table.
 	TransactionLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -638,6 +1173,31 @@ var (
 		Columns:    WebhookRetryAttemptsColumns,
 		PrimaryKey: []*schema.Column{WebhookRetryAttemptsColumns[0]},
 	}
+	// FiatCurrencyProvidersColumns holds the columns for the "fiat_currency_providers" table.
+	FiatCurrencyProvidersColumns = []*schema.Column{
+		{Name: "fiat_currency_id", Type: field.TypeUUID},
+		{Name: "provider_profile_id", Type: field.TypeString},
+	}
+	// FiatCurrencyProvidersTable holds the schema information for the "fiat_currency_providers" table.
+	FiatCurrencyProvidersTable = &schema.Table{
+		Name:       "fiat_currency_providers",
+		Columns:    FiatCurrencyProvidersColumns,
+		PrimaryKey: []*schema.Column{FiatCurrencyProvidersColumns[0], FiatCurrencyProvidersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "fiat_currency_providers_fiat_currency_id",
+				Columns:    []*schema.Column{FiatCurrencyProvidersColumns[0]},
+				RefColumns: []*schema.Column{FiatCurrenciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "fiat_currency_providers_provider_profile_id",
+				Columns:    []*schema.Column{FiatCurrencyProvidersColumns[1]},
+				RefColumns: []*schema.Column{ProviderProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// ProvisionBucketProviderProfilesColumns holds the columns for the "provision_bucket_provider_profiles" table.
 	ProvisionBucketProviderProfilesColumns = []*schema.Column{
 		{Name: "provision_bucket_id", Type: field.TypeInt},
@@ -666,6 +1226,10 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APIKeysTable,
+		BucketProposalsTable,
+		DeadLetterOrdersTable,
+		DisputesTable,
+		DisputeEvidencesTable,
 		FiatCurrenciesTable,
 		IdentityVerificationRequestsTable,
 		InstitutionsTable,
@@ -673,20 +1237,31 @@ var (
 		LockOrderFulfillmentsTable,
 		LockPaymentOrdersTable,
 		NetworksTable,
+		OrderAssignmentsTable,
 		PaymentOrdersTable,
 		PaymentOrderRecipientsTable,
+		ProviderHealthChecksTable,
 		ProviderOrderTokensTable,
 		ProviderProfilesTable,
 		ProviderRatingsTable,
+		ProviderSLARecordsTable,
 		ProvisionBucketsTable,
+		PublicHolidaysTable,
+		RateCircuitBreakersTable,
+		RateSnapshotsTable,
 		ReceiveAddressesTable,
 		SenderOrderTokensTable,
 		SenderProfilesTable,
+		TeamAuditLogsTable,
+		TeamInvitationsTable,
+		TeamMembersTable,
 		TokensTable,
+		TokenMarketRatesTable,
 		TransactionLogsTable,
 		UsersTable,
 		VerificationTokensTable,
 		WebhookRetryAttemptsTable,
+		FiatCurrencyProvidersTable,
 		ProvisionBucketProviderProfilesTable,
 	}
 )
@@ -694,30 +1269,57 @@ var (
 func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	APIKeysTable.ForeignKeys[1].RefTable = SenderProfilesTable
+	BucketProposalsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	DeadLetterOrdersTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	DisputesTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	DisputesTable.ForeignKeys[1].RefTable = PaymentOrdersTable
+	DisputesTable.ForeignKeys[2].RefTable = ProviderProfilesTable
+	DisputeEvidencesTable.ForeignKeys[0].RefTable = DisputesTable
 	InstitutionsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	LinkedAddressesTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	LockOrderFulfillmentsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
 	LockPaymentOrdersTable.ForeignKeys[0].RefTable = ProviderProfilesTable
 	LockPaymentOrdersTable.ForeignKeys[1].RefTable = ProvisionBucketsTable
 	LockPaymentOrdersTable.ForeignKeys[2].RefTable = TokensTable
+	OrderAssignmentsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	OrderAssignmentsTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 	PaymentOrdersTable.ForeignKeys[0].RefTable = APIKeysTable
 	PaymentOrdersTable.ForeignKeys[1].RefTable = LinkedAddressesTable
 	PaymentOrdersTable.ForeignKeys[2].RefTable = SenderProfilesTable
 	PaymentOrdersTable.ForeignKeys[3].RefTable = TokensTable
 	PaymentOrderRecipientsTable.ForeignKeys[0].RefTable = PaymentOrdersTable
-	ProviderOrderTokensTable.ForeignKeys[0].RefTable = ProviderProfilesTable
-	ProviderProfilesTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
-	ProviderProfilesTable.ForeignKeys[1].RefTable = UsersTable
+	ProviderHealthChecksTable.ForeignKeys[0].RefTable = ProviderProfilesTable
+	ProviderOrderTokensTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	ProviderOrderTokensTable.ForeignKeys[1].RefTable = ProviderProfilesTable
+	ProviderProfilesTable.ForeignKeys[0].RefTable = UsersTable
 	ProviderRatingsTable.ForeignKeys[0].RefTable = ProviderProfilesTable
+	ProviderSLARecordsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
+	ProviderSLARecordsTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 	ProvisionBucketsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	PublicHolidaysTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	RateCircuitBreakersTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	RateSnapshotsTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	RateSnapshotsTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 	ReceiveAddressesTable.ForeignKeys[0].RefTable = PaymentOrdersTable
 	SenderOrderTokensTable.ForeignKeys[0].RefTable = SenderProfilesTable
 	SenderOrderTokensTable.ForeignKeys[1].RefTable = TokensTable
 	SenderProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	TeamAuditLogsTable.ForeignKeys[0].RefTable = ProviderProfilesTable
+	TeamAuditLogsTable.ForeignKeys[1].RefTable = SenderProfilesTable
+	TeamInvitationsTable.ForeignKeys[0].RefTable = ProviderProfilesTable
+	TeamInvitationsTable.ForeignKeys[1].RefTable = SenderProfilesTable
+	TeamInvitationsTable.ForeignKeys[2].RefTable = UsersTable
+	TeamMembersTable.ForeignKeys[0].RefTable = ProviderProfilesTable
+	TeamMembersTable.ForeignKeys[1].RefTable = SenderProfilesTable
+	TeamMembersTable.ForeignKeys[2].RefTable = UsersTable
 	TokensTable.ForeignKeys[0].RefTable = NetworksTable
+	TokenMarketRatesTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
 	TransactionLogsTable.ForeignKeys[0].RefTable = LockPaymentOrdersTable
 	TransactionLogsTable.ForeignKeys[1].RefTable = PaymentOrdersTable
 	VerificationTokensTable.ForeignKeys[0].RefTable = UsersTable
+	FiatCurrencyProvidersTable.ForeignKeys[0].RefTable = FiatCurrenciesTable
+	FiatCurrencyProvidersTable.ForeignKeys[1].RefTable = ProviderProfilesTable
+	FiatCurrencyProvidersTable.Annotation = &entsql.Annotation{}
 	ProvisionBucketProviderProfilesTable.ForeignKeys[0].RefTable = ProvisionBucketsTable
 	ProvisionBucketProviderProfilesTable.ForeignKeys[1].RefTable = ProviderProfilesTable
 }
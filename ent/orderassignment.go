@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// OrderAssignment is the model entity for the OrderAssignment schema.
+type OrderAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Event holds the value of the "event" field.
+	Event orderassignment.Event `json:"event,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderAssignmentQuery when eager-loading is set.
+	Edges                              OrderAssignmentEdges `json:"edges"`
+	lock_payment_order_assignments     *uuid.UUID
+	provider_profile_order_assignments *string
+	selectValues                       sql.SelectValues
+}
+
+// OrderAssignmentEdges holds the relations/edges for other nodes in the graph.
+type OrderAssignmentEdges struct {
+	// LockPaymentOrder holds the value of the lock_payment_order edge.
+	LockPaymentOrder *LockPaymentOrder `json:"lock_payment_order,omitempty"`
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LockPaymentOrderOrErr returns the LockPaymentOrder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderAssignmentEdges) LockPaymentOrderOrErr() (*LockPaymentOrder, error) {
+	if e.LockPaymentOrder != nil {
+		return e.LockPaymentOrder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "lock_payment_order"}
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderAssignmentEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderassignment.FieldEvent:
+			values[i] = new(sql.NullString)
+		case orderassignment.FieldCreatedAt, orderassignment.FieldUpdatedAt, orderassignment.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case orderassignment.FieldID:
+			values[i] = new(uuid.UUID)
+		case orderassignment.ForeignKeys[0]: // lock_payment_order_assignments
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case orderassignment.ForeignKeys[1]: // provider_profile_order_assignments
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderAssignment fields.
+func (oa *OrderAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				oa.ID = *value
+			}
+		case orderassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oa.CreatedAt = value.Time
+			}
+		case orderassignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				oa.UpdatedAt = value.Time
+			}
+		case orderassignment.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				oa.Event = orderassignment.Event(value.String)
+			}
+		case orderassignment.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				oa.ExpiresAt = value.Time
+			}
+		case orderassignment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_assignments", values[i])
+			} else if value.Valid {
+				oa.lock_payment_order_assignments = new(uuid.UUID)
+				*oa.lock_payment_order_assignments = *value.S.(*uuid.UUID)
+			}
+		case orderassignment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_order_assignments", values[i])
+			} else if value.Valid {
+				oa.provider_profile_order_assignments = new(string)
+				*oa.provider_profile_order_assignments = value.String
+			}
+		default:
+			oa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderAssignment.
+// This includes values selected through modifiers, order, etc.
+func (oa *OrderAssignment) Value(name string) (ent.Value, error) {
+	return oa.selectValues.Get(name)
+}
+
+// QueryLockPaymentOrder queries the "lock_payment_order" edge of the OrderAssignment entity.
+func (oa *OrderAssignment) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	return NewOrderAssignmentClient(oa.config).QueryLockPaymentOrder(oa)
+}
+
+// QueryProvider queries the "provider" edge of the OrderAssignment entity.
+func (oa *OrderAssignment) QueryProvider() *ProviderProfileQuery {
+	return NewOrderAssignmentClient(oa.config).QueryProvider(oa)
+}
+
+// Update returns a builder for updating this OrderAssignment.
+// Note that you need to call OrderAssignment.Unwrap() before calling this method if this OrderAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oa *OrderAssignment) Update() *OrderAssignmentUpdateOne {
+	return NewOrderAssignmentClient(oa.config).UpdateOne(oa)
+}
+
+// Unwrap unwraps the OrderAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oa *OrderAssignment) Unwrap() *OrderAssignment {
+	_tx, ok := oa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderAssignment is not a transactional entity")
+	}
+	oa.config.driver = _tx.drv
+	return oa
+}
+
+// String implements the fmt.Stringer.
+func (oa *OrderAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(oa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(oa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(fmt.Sprintf("%v", oa.Event))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(oa.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderAssignments is a parsable slice of OrderAssignment.
+type OrderAssignments []*OrderAssignment
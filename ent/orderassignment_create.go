@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// OrderAssignmentCreate is the builder for creating a OrderAssignment entity.
+type OrderAssignmentCreate struct {
+	config
+	mutation *OrderAssignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oac *OrderAssignmentCreate) SetCreatedAt(t time.Time) *OrderAssignmentCreate {
+	oac.mutation.SetCreatedAt(t)
+	return oac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oac *OrderAssignmentCreate) SetNillableCreatedAt(t *time.Time) *OrderAssignmentCreate {
+	if t != nil {
+		oac.SetCreatedAt(*t)
+	}
+	return oac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oac *OrderAssignmentCreate) SetUpdatedAt(t time.Time) *OrderAssignmentCreate {
+	oac.mutation.SetUpdatedAt(t)
+	return oac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oac *OrderAssignmentCreate) SetNillableUpdatedAt(t *time.Time) *OrderAssignmentCreate {
+	if t != nil {
+		oac.SetUpdatedAt(*t)
+	}
+	return oac
+}
+
+// SetEvent sets the "event" field.
+func (oac *OrderAssignmentCreate) SetEvent(o orderassignment.Event) *OrderAssignmentCreate {
+	oac.mutation.SetEvent(o)
+	return oac
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (oac *OrderAssignmentCreate) SetExpiresAt(t time.Time) *OrderAssignmentCreate {
+	oac.mutation.SetExpiresAt(t)
+	return oac
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (oac *OrderAssignmentCreate) SetNillableExpiresAt(t *time.Time) *OrderAssignmentCreate {
+	if t != nil {
+		oac.SetExpiresAt(*t)
+	}
+	return oac
+}
+
+// SetID sets the "id" field.
+func (oac *OrderAssignmentCreate) SetID(u uuid.UUID) *OrderAssignmentCreate {
+	oac.mutation.SetID(u)
+	return oac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oac *OrderAssignmentCreate) SetNillableID(u *uuid.UUID) *OrderAssignmentCreate {
+	if u != nil {
+		oac.SetID(*u)
+	}
+	return oac
+}
+
+// SetLockPaymentOrderID sets the "lock_payment_order" edge to the LockPaymentOrder entity by ID.
+func (oac *OrderAssignmentCreate) SetLockPaymentOrderID(id uuid.UUID) *OrderAssignmentCreate {
+	oac.mutation.SetLockPaymentOrderID(id)
+	return oac
+}
+
+// SetLockPaymentOrder sets the "lock_payment_order" edge to the LockPaymentOrder entity.
+func (oac *OrderAssignmentCreate) SetLockPaymentOrder(l *LockPaymentOrder) *OrderAssignmentCreate {
+	return oac.SetLockPaymentOrderID(l.ID)
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (oac *OrderAssignmentCreate) SetProviderID(id string) *OrderAssignmentCreate {
+	oac.mutation.SetProviderID(id)
+	return oac
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (oac *OrderAssignmentCreate) SetProvider(p *ProviderProfile) *OrderAssignmentCreate {
+	return oac.SetProviderID(p.ID)
+}
+
+// Mutation returns the OrderAssignmentMutation object of the builder.
+func (oac *OrderAssignmentCreate) Mutation() *OrderAssignmentMutation {
+	return oac.mutation
+}
+
+// Save creates the OrderAssignment in the database.
+func (oac *OrderAssignmentCreate) Save(ctx context.Context) (*OrderAssignment, error) {
+	oac.defaults()
+	return withHooks(ctx, oac.sqlSave, oac.mutation, oac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oac *OrderAssignmentCreate) SaveX(ctx context.Context) *OrderAssignment {
+	v, err := oac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oac *OrderAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := oac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oac *OrderAssignmentCreate) ExecX(ctx context.Context) {
+	if err := oac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oac *OrderAssignmentCreate) defaults() {
+	if _, ok := oac.mutation.CreatedAt(); !ok {
+		v := orderassignment.DefaultCreatedAt()
+		oac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oac.mutation.UpdatedAt(); !ok {
+		v := orderassignment.DefaultUpdatedAt()
+		oac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oac.mutation.ID(); !ok {
+		v := orderassignment.DefaultID()
+		oac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oac *OrderAssignmentCreate) check() error {
+	if _, ok := oac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrderAssignment.created_at"`)}
+	}
+	if _, ok := oac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OrderAssignment.updated_at"`)}
+	}
+	if _, ok := oac.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "OrderAssignment.event"`)}
+	}
+	if v, ok := oac.mutation.Event(); ok {
+		if err := orderassignment.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "OrderAssignment.event": %w`, err)}
+		}
+	}
+	if len(oac.mutation.LockPaymentOrderIDs()) == 0 {
+		return &ValidationError{Name: "lock_payment_order", err: errors.New(`ent: missing required edge "OrderAssignment.lock_payment_order"`)}
+	}
+	if len(oac.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "OrderAssignment.provider"`)}
+	}
+	return nil
+}
+
+func (oac *OrderAssignmentCreate) sqlSave(ctx context.Context) (*OrderAssignment, error) {
+	if err := oac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	oac.mutation.id = &_node.ID
+	oac.mutation.done = true
+	return _node, nil
+}
+
+func (oac *OrderAssignmentCreate) createSpec() (*OrderAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderAssignment{config: oac.config}
+		_spec = sqlgraph.NewCreateSpec(orderassignment.Table, sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = oac.conflict
+	if id, ok := oac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oac.mutation.CreatedAt(); ok {
+		_spec.SetField(orderassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oac.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderassignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := oac.mutation.Event(); ok {
+		_spec.SetField(orderassignment.FieldEvent, field.TypeEnum, value)
+		_node.Event = value
+	}
+	if value, ok := oac.mutation.ExpiresAt(); ok {
+		_spec.SetField(orderassignment.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := oac.mutation.LockPaymentOrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderassignment.LockPaymentOrderTable,
+			Columns: []string{orderassignment.LockPaymentOrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_assignments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oac.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderassignment.ProviderTable,
+			Columns: []string{orderassignment.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_order_assignments = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrderAssignment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrderAssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (oac *OrderAssignmentCreate) OnConflict(opts ...sql.ConflictOption) *OrderAssignmentUpsertOne {
+	oac.conflict = opts
+	return &OrderAssignmentUpsertOne{
+		create: oac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrderAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oac *OrderAssignmentCreate) OnConflictColumns(columns ...string) *OrderAssignmentUpsertOne {
+	oac.conflict = append(oac.conflict, sql.ConflictColumns(columns...))
+	return &OrderAssignmentUpsertOne{
+		create: oac,
+	}
+}
+
+type (
+	// OrderAssignmentUpsertOne is the builder for "upsert"-ing
+	//  one OrderAssignment node.
+	OrderAssignmentUpsertOne struct {
+		create *OrderAssignmentCreate
+	}
+
+	// OrderAssignmentUpsert is the "OnConflict" setter.
+	OrderAssignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrderAssignmentUpsert) SetUpdatedAt(v time.Time) *OrderAssignmentUpsert {
+	u.Set(orderassignment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrderAssignmentUpsert) UpdateUpdatedAt() *OrderAssignmentUpsert {
+	u.SetExcluded(orderassignment.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.OrderAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orderassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrderAssignmentUpsertOne) UpdateNewValues() *OrderAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(orderassignment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(orderassignment.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Event(); exists {
+			s.SetIgnore(orderassignment.FieldEvent)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(orderassignment.FieldExpiresAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrderAssignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrderAssignmentUpsertOne) Ignore() *OrderAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrderAssignmentUpsertOne) DoNothing() *OrderAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrderAssignmentCreate.OnConflict
+// documentation for more info.
+func (u *OrderAssignmentUpsertOne) Update(set func(*OrderAssignmentUpsert)) *OrderAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrderAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrderAssignmentUpsertOne) SetUpdatedAt(v time.Time) *OrderAssignmentUpsertOne {
+	return u.Update(func(s *OrderAssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrderAssignmentUpsertOne) UpdateUpdatedAt() *OrderAssignmentUpsertOne {
+	return u.Update(func(s *OrderAssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OrderAssignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrderAssignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrderAssignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrderAssignmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OrderAssignmentUpsertOne.ID is not supported by MySQL driver. Use OrderAssignmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrderAssignmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrderAssignmentCreateBulk is the builder for creating many OrderAssignment entities in bulk.
+type OrderAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*OrderAssignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OrderAssignment entities in the database.
+func (oacb *OrderAssignmentCreateBulk) Save(ctx context.Context) ([]*OrderAssignment, error) {
+	if oacb.err != nil {
+		return nil, oacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oacb.builders))
+	nodes := make([]*OrderAssignment, len(oacb.builders))
+	mutators := make([]Mutator, len(oacb.builders))
+	for i := range oacb.builders {
+		func(i int, root context.Context) {
+			builder := oacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = oacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oacb *OrderAssignmentCreateBulk) SaveX(ctx context.Context) []*OrderAssignment {
+	v, err := oacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oacb *OrderAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := oacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oacb *OrderAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := oacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrderAssignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrderAssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (oacb *OrderAssignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrderAssignmentUpsertBulk {
+	oacb.conflict = opts
+	return &OrderAssignmentUpsertBulk{
+		create: oacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrderAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (oacb *OrderAssignmentCreateBulk) OnConflictColumns(columns ...string) *OrderAssignmentUpsertBulk {
+	oacb.conflict = append(oacb.conflict, sql.ConflictColumns(columns...))
+	return &OrderAssignmentUpsertBulk{
+		create: oacb,
+	}
+}
+
+// OrderAssignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of OrderAssignment nodes.
+type OrderAssignmentUpsertBulk struct {
+	create *OrderAssignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OrderAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(orderassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OrderAssignmentUpsertBulk) UpdateNewValues() *OrderAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(orderassignment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(orderassignment.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Event(); exists {
+				s.SetIgnore(orderassignment.FieldEvent)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(orderassignment.FieldExpiresAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrderAssignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrderAssignmentUpsertBulk) Ignore() *OrderAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrderAssignmentUpsertBulk) DoNothing() *OrderAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrderAssignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrderAssignmentUpsertBulk) Update(set func(*OrderAssignmentUpsert)) *OrderAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrderAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *OrderAssignmentUpsertBulk) SetUpdatedAt(v time.Time) *OrderAssignmentUpsertBulk {
+	return u.Update(func(s *OrderAssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *OrderAssignmentUpsertBulk) UpdateUpdatedAt() *OrderAssignmentUpsertBulk {
+	return u.Update(func(s *OrderAssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OrderAssignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrderAssignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrderAssignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrderAssignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
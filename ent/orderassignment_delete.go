@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// OrderAssignmentDelete is the builder for deleting a OrderAssignment entity.
+type OrderAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrderAssignmentMutation
+}
+
+// Where appends a list predicates to the OrderAssignmentDelete builder.
+func (oad *OrderAssignmentDelete) Where(ps ...predicate.OrderAssignment) *OrderAssignmentDelete {
+	oad.mutation.Where(ps...)
+	return oad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (oad *OrderAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, oad.sqlExec, oad.mutation, oad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oad *OrderAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := oad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (oad *OrderAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(orderassignment.Table, sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID))
+	if ps := oad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, oad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	oad.mutation.done = true
+	return affected, err
+}
+
+// OrderAssignmentDeleteOne is the builder for deleting a single OrderAssignment entity.
+type OrderAssignmentDeleteOne struct {
+	oad *OrderAssignmentDelete
+}
+
+// Where appends a list predicates to the OrderAssignmentDelete builder.
+func (oado *OrderAssignmentDeleteOne) Where(ps ...predicate.OrderAssignment) *OrderAssignmentDeleteOne {
+	oado.oad.mutation.Where(ps...)
+	return oado
+}
+
+// Exec executes the deletion query.
+func (oado *OrderAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := oado.oad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{orderassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oado *OrderAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := oado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// OrderAssignmentQuery is the builder for querying OrderAssignment entities.
+type OrderAssignmentQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []orderassignment.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.OrderAssignment
+	withLockPaymentOrder *LockPaymentOrderQuery
+	withProvider         *ProviderProfileQuery
+	withFKs              bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OrderAssignmentQuery builder.
+func (oaq *OrderAssignmentQuery) Where(ps ...predicate.OrderAssignment) *OrderAssignmentQuery {
+	oaq.predicates = append(oaq.predicates, ps...)
+	return oaq
+}
+
+// Limit the number of records to be returned by this query.
+func (oaq *OrderAssignmentQuery) Limit(limit int) *OrderAssignmentQuery {
+	oaq.ctx.Limit = &limit
+	return oaq
+}
+
+// Offset to start from.
+func (oaq *OrderAssignmentQuery) Offset(offset int) *OrderAssignmentQuery {
+	oaq.ctx.Offset = &offset
+	return oaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oaq *OrderAssignmentQuery) Unique(unique bool) *OrderAssignmentQuery {
+	oaq.ctx.Unique = &unique
+	return oaq
+}
+
+// Order specifies how the records should be ordered.
+func (oaq *OrderAssignmentQuery) Order(o ...orderassignment.OrderOption) *OrderAssignmentQuery {
+	oaq.order = append(oaq.order, o...)
+	return oaq
+}
+
+// QueryLockPaymentOrder chains the current query on the "lock_payment_order" edge.
+func (oaq *OrderAssignmentQuery) QueryLockPaymentOrder() *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: oaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderassignment.Table, orderassignment.FieldID, selector),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderassignment.LockPaymentOrderTable, orderassignment.LockPaymentOrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (oaq *OrderAssignmentQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: oaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderassignment.Table, orderassignment.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderassignment.ProviderTable, orderassignment.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OrderAssignment entity from the query.
+// Returns a *NotFoundError when no OrderAssignment was found.
+func (oaq *OrderAssignmentQuery) First(ctx context.Context) (*OrderAssignment, error) {
+	nodes, err := oaq.Limit(1).All(setContextOp(ctx, oaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{orderassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) FirstX(ctx context.Context) *OrderAssignment {
+	node, err := oaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OrderAssignment ID from the query.
+// Returns a *NotFoundError when no OrderAssignment ID was found.
+func (oaq *OrderAssignmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = oaq.Limit(1).IDs(setContextOp(ctx, oaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{orderassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := oaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OrderAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OrderAssignment entity is found.
+// Returns a *NotFoundError when no OrderAssignment entities are found.
+func (oaq *OrderAssignmentQuery) Only(ctx context.Context) (*OrderAssignment, error) {
+	nodes, err := oaq.Limit(2).All(setContextOp(ctx, oaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{orderassignment.Label}
+	default:
+		return nil, &NotSingularError{orderassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) OnlyX(ctx context.Context) *OrderAssignment {
+	node, err := oaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OrderAssignment ID in the query.
+// Returns a *NotSingularError when more than one OrderAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oaq *OrderAssignmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = oaq.Limit(2).IDs(setContextOp(ctx, oaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{orderassignment.Label}
+	default:
+		err = &NotSingularError{orderassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := oaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OrderAssignments.
+func (oaq *OrderAssignmentQuery) All(ctx context.Context) ([]*OrderAssignment, error) {
+	ctx = setContextOp(ctx, oaq.ctx, ent.OpQueryAll)
+	if err := oaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OrderAssignment, *OrderAssignmentQuery]()
+	return withInterceptors[[]*OrderAssignment](ctx, oaq, qr, oaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) AllX(ctx context.Context) []*OrderAssignment {
+	nodes, err := oaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OrderAssignment IDs.
+func (oaq *OrderAssignmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if oaq.ctx.Unique == nil && oaq.path != nil {
+		oaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oaq.ctx, ent.OpQueryIDs)
+	if err = oaq.Select(orderassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := oaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oaq *OrderAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oaq.ctx, ent.OpQueryCount)
+	if err := oaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oaq, querierCount[*OrderAssignmentQuery](), oaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := oaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oaq *OrderAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oaq.ctx, ent.OpQueryExist)
+	switch _, err := oaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oaq *OrderAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := oaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OrderAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oaq *OrderAssignmentQuery) Clone() *OrderAssignmentQuery {
+	if oaq == nil {
+		return nil
+	}
+	return &OrderAssignmentQuery{
+		config:               oaq.config,
+		ctx:                  oaq.ctx.Clone(),
+		order:                append([]orderassignment.OrderOption{}, oaq.order...),
+		inters:               append([]Interceptor{}, oaq.inters...),
+		predicates:           append([]predicate.OrderAssignment{}, oaq.predicates...),
+		withLockPaymentOrder: oaq.withLockPaymentOrder.Clone(),
+		withProvider:         oaq.withProvider.Clone(),
+		// clone intermediate query.
+		sql:  oaq.sql.Clone(),
+		path: oaq.path,
+	}
+}
+
+// WithLockPaymentOrder tells the query-builder to eager-load the nodes that are connected to
+// the "lock_payment_order" edge. The optional arguments are used to configure the query builder of the edge.
+func (oaq *OrderAssignmentQuery) WithLockPaymentOrder(opts ...func(*LockPaymentOrderQuery)) *OrderAssignmentQuery {
+	query := (&LockPaymentOrderClient{config: oaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oaq.withLockPaymentOrder = query
+	return oaq
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (oaq *OrderAssignmentQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *OrderAssignmentQuery {
+	query := (&ProviderProfileClient{config: oaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oaq.withProvider = query
+	return oaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OrderAssignment.Query().
+//		GroupBy(orderassignment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oaq *OrderAssignmentQuery) GroupBy(field string, fields ...string) *OrderAssignmentGroupBy {
+	oaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OrderAssignmentGroupBy{build: oaq}
+	grbuild.flds = &oaq.ctx.Fields
+	grbuild.label = orderassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.OrderAssignment.Query().
+//		Select(orderassignment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (oaq *OrderAssignmentQuery) Select(fields ...string) *OrderAssignmentSelect {
+	oaq.ctx.Fields = append(oaq.ctx.Fields, fields...)
+	sbuild := &OrderAssignmentSelect{OrderAssignmentQuery: oaq}
+	sbuild.label = orderassignment.Label
+	sbuild.flds, sbuild.scan = &oaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OrderAssignmentSelect configured with the given aggregations.
+func (oaq *OrderAssignmentQuery) Aggregate(fns ...AggregateFunc) *OrderAssignmentSelect {
+	return oaq.Select().Aggregate(fns...)
+}
+
+func (oaq *OrderAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oaq.ctx.Fields {
+		if !orderassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oaq.path != nil {
+		prev, err := oaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oaq.sql = prev
+	}
+	return nil
+}
+
+func (oaq *OrderAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrderAssignment, error) {
+	var (
+		nodes       = []*OrderAssignment{}
+		withFKs     = oaq.withFKs
+		_spec       = oaq.querySpec()
+		loadedTypes = [2]bool{
+			oaq.withLockPaymentOrder != nil,
+			oaq.withProvider != nil,
+		}
+	)
+	if oaq.withLockPaymentOrder != nil || oaq.withProvider != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, orderassignment.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OrderAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OrderAssignment{config: oaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oaq.withLockPaymentOrder; query != nil {
+		if err := oaq.loadLockPaymentOrder(ctx, query, nodes, nil,
+			func(n *OrderAssignment, e *LockPaymentOrder) { n.Edges.LockPaymentOrder = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oaq.withProvider; query != nil {
+		if err := oaq.loadProvider(ctx, query, nodes, nil,
+			func(n *OrderAssignment, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oaq *OrderAssignmentQuery) loadLockPaymentOrder(ctx context.Context, query *LockPaymentOrderQuery, nodes []*OrderAssignment, init func(*OrderAssignment), assign func(*OrderAssignment, *LockPaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrderAssignment)
+	for i := range nodes {
+		if nodes[i].lock_payment_order_assignments == nil {
+			continue
+		}
+		fk := *nodes[i].lock_payment_order_assignments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(lockpaymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "lock_payment_order_assignments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (oaq *OrderAssignmentQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*OrderAssignment, init func(*OrderAssignment), assign func(*OrderAssignment, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*OrderAssignment)
+	for i := range nodes {
+		if nodes[i].provider_profile_order_assignments == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_order_assignments
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_order_assignments" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oaq *OrderAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oaq.querySpec()
+	_spec.Node.Columns = oaq.ctx.Fields
+	if len(oaq.ctx.Fields) > 0 {
+		_spec.Unique = oaq.ctx.Unique != nil && *oaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oaq.driver, _spec)
+}
+
+func (oaq *OrderAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(orderassignment.Table, orderassignment.Columns, sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID))
+	_spec.From = oaq.sql
+	if unique := oaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderassignment.FieldID)
+		for i := range fields {
+			if fields[i] != orderassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := oaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oaq *OrderAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oaq.driver.Dialect())
+	t1 := builder.Table(orderassignment.Table)
+	columns := oaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = orderassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oaq.sql != nil {
+		selector = oaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oaq.ctx.Unique != nil && *oaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range oaq.predicates {
+		p(selector)
+	}
+	for _, p := range oaq.order {
+		p(selector)
+	}
+	if offset := oaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OrderAssignmentGroupBy is the group-by builder for OrderAssignment entities.
+type OrderAssignmentGroupBy struct {
+	selector
+	build *OrderAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (oagb *OrderAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *OrderAssignmentGroupBy {
+	oagb.fns = append(oagb.fns, fns...)
+	return oagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oagb *OrderAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oagb.build.ctx, ent.OpQueryGroupBy)
+	if err := oagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderAssignmentQuery, *OrderAssignmentGroupBy](ctx, oagb.build, oagb, oagb.build.inters, v)
+}
+
+func (oagb *OrderAssignmentGroupBy) sqlScan(ctx context.Context, root *OrderAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(oagb.fns))
+	for _, fn := range oagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*oagb.flds)+len(oagb.fns))
+		for _, f := range *oagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*oagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrderAssignmentSelect is the builder for selecting fields of OrderAssignment entities.
+type OrderAssignmentSelect struct {
+	*OrderAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oas *OrderAssignmentSelect) Aggregate(fns ...AggregateFunc) *OrderAssignmentSelect {
+	oas.fns = append(oas.fns, fns...)
+	return oas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oas *OrderAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oas.ctx, ent.OpQuerySelect)
+	if err := oas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderAssignmentQuery, *OrderAssignmentSelect](ctx, oas.OrderAssignmentQuery, oas, oas.inters, v)
+}
+
+func (oas *OrderAssignmentSelect) sqlScan(ctx context.Context, root *OrderAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oas.fns))
+	for _, fn := range oas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
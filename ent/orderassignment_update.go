@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// OrderAssignmentUpdate is the builder for updating OrderAssignment entities.
+type OrderAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderAssignmentMutation
+}
+
+// Where appends a list predicates to the OrderAssignmentUpdate builder.
+func (oau *OrderAssignmentUpdate) Where(ps ...predicate.OrderAssignment) *OrderAssignmentUpdate {
+	oau.mutation.Where(ps...)
+	return oau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oau *OrderAssignmentUpdate) SetUpdatedAt(t time.Time) *OrderAssignmentUpdate {
+	oau.mutation.SetUpdatedAt(t)
+	return oau
+}
+
+// Mutation returns the OrderAssignmentMutation object of the builder.
+func (oau *OrderAssignmentUpdate) Mutation() *OrderAssignmentMutation {
+	return oau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oau *OrderAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	oau.defaults()
+	return withHooks(ctx, oau.sqlSave, oau.mutation, oau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oau *OrderAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := oau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oau *OrderAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := oau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oau *OrderAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := oau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oau *OrderAssignmentUpdate) defaults() {
+	if _, ok := oau.mutation.UpdatedAt(); !ok {
+		v := orderassignment.UpdateDefaultUpdatedAt()
+		oau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oau *OrderAssignmentUpdate) check() error {
+	if oau.mutation.LockPaymentOrderCleared() && len(oau.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderAssignment.lock_payment_order"`)
+	}
+	if oau.mutation.ProviderCleared() && len(oau.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderAssignment.provider"`)
+	}
+	return nil
+}
+
+func (oau *OrderAssignmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderassignment.Table, orderassignment.Columns, sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID))
+	if ps := oau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oau.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oau.mutation.ExpiresAtCleared() {
+		_spec.ClearField(orderassignment.FieldExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oau.mutation.done = true
+	return n, nil
+}
+
+// OrderAssignmentUpdateOne is the builder for updating a single OrderAssignment entity.
+type OrderAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderAssignmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oauo *OrderAssignmentUpdateOne) SetUpdatedAt(t time.Time) *OrderAssignmentUpdateOne {
+	oauo.mutation.SetUpdatedAt(t)
+	return oauo
+}
+
+// Mutation returns the OrderAssignmentMutation object of the builder.
+func (oauo *OrderAssignmentUpdateOne) Mutation() *OrderAssignmentMutation {
+	return oauo.mutation
+}
+
+// Where appends a list predicates to the OrderAssignmentUpdate builder.
+func (oauo *OrderAssignmentUpdateOne) Where(ps ...predicate.OrderAssignment) *OrderAssignmentUpdateOne {
+	oauo.mutation.Where(ps...)
+	return oauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oauo *OrderAssignmentUpdateOne) Select(field string, fields ...string) *OrderAssignmentUpdateOne {
+	oauo.fields = append([]string{field}, fields...)
+	return oauo
+}
+
+// Save executes the query and returns the updated OrderAssignment entity.
+func (oauo *OrderAssignmentUpdateOne) Save(ctx context.Context) (*OrderAssignment, error) {
+	oauo.defaults()
+	return withHooks(ctx, oauo.sqlSave, oauo.mutation, oauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oauo *OrderAssignmentUpdateOne) SaveX(ctx context.Context) *OrderAssignment {
+	node, err := oauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oauo *OrderAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := oauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oauo *OrderAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := oauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oauo *OrderAssignmentUpdateOne) defaults() {
+	if _, ok := oauo.mutation.UpdatedAt(); !ok {
+		v := orderassignment.UpdateDefaultUpdatedAt()
+		oauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oauo *OrderAssignmentUpdateOne) check() error {
+	if oauo.mutation.LockPaymentOrderCleared() && len(oauo.mutation.LockPaymentOrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderAssignment.lock_payment_order"`)
+	}
+	if oauo.mutation.ProviderCleared() && len(oauo.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderAssignment.provider"`)
+	}
+	return nil
+}
+
+func (oauo *OrderAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *OrderAssignment, err error) {
+	if err := oauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderassignment.Table, orderassignment.Columns, sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID))
+	id, ok := oauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderassignment.FieldID)
+		for _, f := range fields {
+			if !orderassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(orderassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oauo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(orderassignment.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &OrderAssignment{config: oauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oauo.mutation.done = true
+	return _node, nil
+}
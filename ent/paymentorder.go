@@ -88,9 +88,11 @@ type PaymentOrderEdges struct {
 	Recipient *PaymentOrderRecipient `json:"recipient,omitempty"`
 	// Transactions holds the value of the transactions edge.
 	Transactions []*TransactionLog `json:"transactions,omitempty"`
+	// Disputes holds the value of the disputes edge.
+	Disputes []*Dispute `json:"disputes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [7]bool
 }
 
 // SenderProfileOrErr returns the SenderProfile value or an error if the edge
@@ -157,6 +159,15 @@ func (e PaymentOrderEdges) TransactionsOrErr() ([]*TransactionLog, error) {
 	return nil, &NotLoadedError{edge: "transactions"}
 }
 
+// DisputesOrErr returns the Disputes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentOrderEdges) DisputesOrErr() ([]*Dispute, error) {
+	if e.loadedTypes[6] {
+		return e.Disputes, nil
+	}
+	return nil, &NotLoadedError{edge: "disputes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PaymentOrder) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -392,6 +403,11 @@ func (po *PaymentOrder) QueryTransactions() *TransactionLogQuery {
 	return NewPaymentOrderClient(po.config).QueryTransactions(po)
 }
 
+// QueryDisputes queries the "disputes" edge of the PaymentOrder entity.
+func (po *PaymentOrder) QueryDisputes() *DisputeQuery {
+	return NewPaymentOrderClient(po.config).QueryDisputes(po)
+}
+
 // Update returns a builder for updating this PaymentOrder.
 // Note that you need to call PaymentOrder.Unwrap() before calling this method if this PaymentOrder
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
@@ -347,6 +348,21 @@ func (poc *PaymentOrderCreate) AddTransactions(t ...*TransactionLog) *PaymentOrd
 	return poc.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (poc *PaymentOrderCreate) AddDisputeIDs(ids ...uuid.UUID) *PaymentOrderCreate {
+	poc.mutation.AddDisputeIDs(ids...)
+	return poc
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (poc *PaymentOrderCreate) AddDisputes(d ...*Dispute) *PaymentOrderCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return poc.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (poc *PaymentOrderCreate) Mutation() *PaymentOrderMutation {
 	return poc.mutation
@@ -706,6 +722,22 @@ func (poc *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := poc.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
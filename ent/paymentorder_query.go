@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
@@ -36,6 +37,7 @@ type PaymentOrderQuery struct {
 	withReceiveAddress *ReceiveAddressQuery
 	withRecipient      *PaymentOrderRecipientQuery
 	withTransactions   *TransactionLogQuery
+	withDisputes       *DisputeQuery
 	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -205,6 +207,28 @@ func (poq *PaymentOrderQuery) QueryTransactions() *TransactionLogQuery {
 	return query
 }
 
+// QueryDisputes chains the current query on the "disputes" edge.
+func (poq *PaymentOrderQuery) QueryDisputes() *DisputeQuery {
+	query := (&DisputeClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paymentorder.Table, paymentorder.FieldID, selector),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paymentorder.DisputesTable, paymentorder.DisputesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PaymentOrder entity from the query.
 // Returns a *NotFoundError when no PaymentOrder was found.
 func (poq *PaymentOrderQuery) First(ctx context.Context) (*PaymentOrder, error) {
@@ -403,6 +427,7 @@ func (poq *PaymentOrderQuery) Clone() *PaymentOrderQuery {
 		withReceiveAddress: poq.withReceiveAddress.Clone(),
 		withRecipient:      poq.withRecipient.Clone(),
 		withTransactions:   poq.withTransactions.Clone(),
+		withDisputes:       poq.withDisputes.Clone(),
 		// clone intermediate query.
 		sql:  poq.sql.Clone(),
 		path: poq.path,
@@ -475,6 +500,17 @@ func (poq *PaymentOrderQuery) WithTransactions(opts ...func(*TransactionLogQuery
 	return poq
 }
 
+// WithDisputes tells the query-builder to eager-load the nodes that are connected to
+// the "disputes" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *PaymentOrderQuery) WithDisputes(opts ...func(*DisputeQuery)) *PaymentOrderQuery {
+	query := (&DisputeClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withDisputes = query
+	return poq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -554,13 +590,14 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*PaymentOrder{}
 		withFKs     = poq.withFKs
 		_spec       = poq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [7]bool{
 			poq.withSenderProfile != nil,
 			poq.withToken != nil,
 			poq.withLinkedAddress != nil,
 			poq.withReceiveAddress != nil,
 			poq.withRecipient != nil,
 			poq.withTransactions != nil,
+			poq.withDisputes != nil,
 		}
 	)
 	if poq.withSenderProfile != nil || poq.withToken != nil || poq.withLinkedAddress != nil {
@@ -624,6 +661,13 @@ func (poq *PaymentOrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := poq.withDisputes; query != nil {
+		if err := poq.loadDisputes(ctx, query, nodes,
+			func(n *PaymentOrder) { n.Edges.Disputes = []*Dispute{} },
+			func(n *PaymentOrder, e *Dispute) { n.Edges.Disputes = append(n.Edges.Disputes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -810,6 +854,37 @@ func (poq *PaymentOrderQuery) loadTransactions(ctx context.Context, query *Trans
 	}
 	return nil
 }
+func (poq *PaymentOrderQuery) loadDisputes(ctx context.Context, query *DisputeQuery, nodes []*PaymentOrder, init func(*PaymentOrder), assign func(*PaymentOrder, *Dispute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PaymentOrder)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Dispute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(paymentorder.DisputesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.payment_order_disputes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "payment_order_disputes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_order_disputes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (poq *PaymentOrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := poq.querySpec()
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/linkedaddress"
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
@@ -502,6 +503,21 @@ func (pou *PaymentOrderUpdate) AddTransactions(t ...*TransactionLog) *PaymentOrd
 	return pou.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (pou *PaymentOrderUpdate) AddDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.AddDisputeIDs(ids...)
+	return pou
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (pou *PaymentOrderUpdate) AddDisputes(d ...*Dispute) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pou.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (pou *PaymentOrderUpdate) Mutation() *PaymentOrderMutation {
 	return pou.mutation
@@ -558,6 +574,27 @@ func (pou *PaymentOrderUpdate) RemoveTransactions(t ...*TransactionLog) *Payment
 	return pou.RemoveTransactionIDs(ids...)
 }
 
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (pou *PaymentOrderUpdate) ClearDisputes() *PaymentOrderUpdate {
+	pou.mutation.ClearDisputes()
+	return pou
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (pou *PaymentOrderUpdate) RemoveDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdate {
+	pou.mutation.RemoveDisputeIDs(ids...)
+	return pou
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (pou *PaymentOrderUpdate) RemoveDisputes(d ...*Dispute) *PaymentOrderUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pou.RemoveDisputeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pou *PaymentOrderUpdate) Save(ctx context.Context) (int, error) {
 	pou.defaults()
@@ -949,6 +986,51 @@ func (pou *PaymentOrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !pou.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{paymentorder.Label}
@@ -1435,6 +1517,21 @@ func (pouo *PaymentOrderUpdateOne) AddTransactions(t ...*TransactionLog) *Paymen
 	return pouo.AddTransactionIDs(ids...)
 }
 
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (pouo *PaymentOrderUpdateOne) AddDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.AddDisputeIDs(ids...)
+	return pouo
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (pouo *PaymentOrderUpdateOne) AddDisputes(d ...*Dispute) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pouo.AddDisputeIDs(ids...)
+}
+
 // Mutation returns the PaymentOrderMutation object of the builder.
 func (pouo *PaymentOrderUpdateOne) Mutation() *PaymentOrderMutation {
 	return pouo.mutation
@@ -1491,6 +1588,27 @@ func (pouo *PaymentOrderUpdateOne) RemoveTransactions(t ...*TransactionLog) *Pay
 	return pouo.RemoveTransactionIDs(ids...)
 }
 
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (pouo *PaymentOrderUpdateOne) ClearDisputes() *PaymentOrderUpdateOne {
+	pouo.mutation.ClearDisputes()
+	return pouo
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (pouo *PaymentOrderUpdateOne) RemoveDisputeIDs(ids ...uuid.UUID) *PaymentOrderUpdateOne {
+	pouo.mutation.RemoveDisputeIDs(ids...)
+	return pouo
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (pouo *PaymentOrderUpdateOne) RemoveDisputes(d ...*Dispute) *PaymentOrderUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pouo.RemoveDisputeIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentOrderUpdate builder.
 func (pouo *PaymentOrderUpdateOne) Where(ps ...predicate.PaymentOrder) *PaymentOrderUpdateOne {
 	pouo.mutation.Where(ps...)
@@ -1912,6 +2030,51 @@ func (pouo *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentO
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !pouo.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paymentorder.DisputesTable,
+			Columns: []string{paymentorder.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PaymentOrder{config: pouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
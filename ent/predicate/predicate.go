@@ -9,6 +9,18 @@ import (
 // APIKey is the predicate function for apikey builders.
 type APIKey func(*sql.Selector)
 
+// BucketProposal is the predicate function for bucketproposal builders.
+type BucketProposal func(*sql.Selector)
+
+// DeadLetterOrder is the predicate function for deadletterorder builders.
+type DeadLetterOrder func(*sql.Selector)
+
+// Dispute is the predicate function for dispute builders.
+type Dispute func(*sql.Selector)
+
+// DisputeEvidence is the predicate function for disputeevidence builders.
+type DisputeEvidence func(*sql.Selector)
+
 // FiatCurrency is the predicate function for fiatcurrency builders.
 type FiatCurrency func(*sql.Selector)
 
@@ -30,12 +42,18 @@ type LockPaymentOrder func(*sql.Selector)
 // Network is the predicate function for network builders.
 type Network func(*sql.Selector)
 
+// OrderAssignment is the predicate function for orderassignment builders.
+type OrderAssignment func(*sql.Selector)
+
 // PaymentOrder is the predicate function for paymentorder builders.
 type PaymentOrder func(*sql.Selector)
 
 // PaymentOrderRecipient is the predicate function for paymentorderrecipient builders.
 type PaymentOrderRecipient func(*sql.Selector)
 
+// ProviderHealthCheck is the predicate function for providerhealthcheck builders.
+type ProviderHealthCheck func(*sql.Selector)
+
 // ProviderOrderToken is the predicate function for providerordertoken builders.
 type ProviderOrderToken func(*sql.Selector)
 
@@ -45,9 +63,21 @@ type ProviderProfile func(*sql.Selector)
 // ProviderRating is the predicate function for providerrating builders.
 type ProviderRating func(*sql.Selector)
 
+// ProviderSLARecord is the predicate function for providerslarecord builders.
+type ProviderSLARecord func(*sql.Selector)
+
 // ProvisionBucket is the predicate function for provisionbucket builders.
 type ProvisionBucket func(*sql.Selector)
 
+// PublicHoliday is the predicate function for publicholiday builders.
+type PublicHoliday func(*sql.Selector)
+
+// RateCircuitBreaker is the predicate function for ratecircuitbreaker builders.
+type RateCircuitBreaker func(*sql.Selector)
+
+// RateSnapshot is the predicate function for ratesnapshot builders.
+type RateSnapshot func(*sql.Selector)
+
 // ReceiveAddress is the predicate function for receiveaddress builders.
 type ReceiveAddress func(*sql.Selector)
 
@@ -57,9 +87,21 @@ type SenderOrderToken func(*sql.Selector)
 // SenderProfile is the predicate function for senderprofile builders.
 type SenderProfile func(*sql.Selector)
 
+// TeamAuditLog is the predicate function for teamauditlog builders.
+type TeamAuditLog func(*sql.Selector)
+
+// TeamInvitation is the predicate function for teaminvitation builders.
+type TeamInvitation func(*sql.Selector)
+
+// TeamMember is the predicate function for teammember builders.
+type TeamMember func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
+// TokenMarketRate is the predicate function for tokenmarketrate builders.
+type TokenMarketRate func(*sql.Selector)
+
 // TransactionLog is the predicate function for transactionlog builders.
 type TransactionLog func(*sql.Selector)
 
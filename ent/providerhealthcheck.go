@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// ProviderHealthCheck is the model entity for the ProviderHealthCheck schema.
+type ProviderHealthCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// IsHealthy holds the value of the "is_healthy" field.
+	IsHealthy bool `json:"is_healthy,omitempty"`
+	// LatencyMs holds the value of the "latency_ms" field.
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode int `json:"status_code,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProviderHealthCheckQuery when eager-loading is set.
+	Edges                          ProviderHealthCheckEdges `json:"edges"`
+	provider_profile_health_checks *string
+	selectValues                   sql.SelectValues
+}
+
+// ProviderHealthCheckEdges holds the relations/edges for other nodes in the graph.
+type ProviderHealthCheckEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderHealthCheckEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderHealthCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providerhealthcheck.FieldIsHealthy:
+			values[i] = new(sql.NullBool)
+		case providerhealthcheck.FieldLatencyMs, providerhealthcheck.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case providerhealthcheck.FieldError:
+			values[i] = new(sql.NullString)
+		case providerhealthcheck.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case providerhealthcheck.FieldID:
+			values[i] = new(uuid.UUID)
+		case providerhealthcheck.ForeignKeys[0]: // provider_profile_health_checks
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderHealthCheck fields.
+func (phc *ProviderHealthCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providerhealthcheck.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				phc.ID = *value
+			}
+		case providerhealthcheck.FieldIsHealthy:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_healthy", values[i])
+			} else if value.Valid {
+				phc.IsHealthy = value.Bool
+			}
+		case providerhealthcheck.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				phc.LatencyMs = value.Int64
+			}
+		case providerhealthcheck.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				phc.StatusCode = int(value.Int64)
+			}
+		case providerhealthcheck.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				phc.Error = value.String
+			}
+		case providerhealthcheck.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				phc.CreatedAt = value.Time
+			}
+		case providerhealthcheck.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_health_checks", values[i])
+			} else if value.Valid {
+				phc.provider_profile_health_checks = new(string)
+				*phc.provider_profile_health_checks = value.String
+			}
+		default:
+			phc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderHealthCheck.
+// This includes values selected through modifiers, order, etc.
+func (phc *ProviderHealthCheck) Value(name string) (ent.Value, error) {
+	return phc.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the ProviderHealthCheck entity.
+func (phc *ProviderHealthCheck) QueryProvider() *ProviderProfileQuery {
+	return NewProviderHealthCheckClient(phc.config).QueryProvider(phc)
+}
+
+// Update returns a builder for updating this ProviderHealthCheck.
+// Note that you need to call ProviderHealthCheck.Unwrap() before calling this method if this ProviderHealthCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (phc *ProviderHealthCheck) Update() *ProviderHealthCheckUpdateOne {
+	return NewProviderHealthCheckClient(phc.config).UpdateOne(phc)
+}
+
+// Unwrap unwraps the ProviderHealthCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (phc *ProviderHealthCheck) Unwrap() *ProviderHealthCheck {
+	_tx, ok := phc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderHealthCheck is not a transactional entity")
+	}
+	phc.config.driver = _tx.drv
+	return phc
+}
+
+// String implements the fmt.Stringer.
+func (phc *ProviderHealthCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderHealthCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", phc.ID))
+	builder.WriteString("is_healthy=")
+	builder.WriteString(fmt.Sprintf("%v", phc.IsHealthy))
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", phc.LatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("status_code=")
+	builder.WriteString(fmt.Sprintf("%v", phc.StatusCode))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(phc.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(phc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderHealthChecks is a parsable slice of ProviderHealthCheck.
+type ProviderHealthChecks []*ProviderHealthCheck
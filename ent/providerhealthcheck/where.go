@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerhealthcheck
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLTE(FieldID, id))
+}
+
+// IsHealthy applies equality check predicate on the "is_healthy" field. It's identical to IsHealthyEQ.
+func IsHealthy(v bool) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldIsHealthy, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// IsHealthyEQ applies the EQ predicate on the "is_healthy" field.
+func IsHealthyEQ(v bool) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldIsHealthy, v))
+}
+
+// IsHealthyNEQ applies the NEQ predicate on the "is_healthy" field.
+func IsHealthyNEQ(v bool) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNEQ(FieldIsHealthy, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int64) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotNull(FieldStatusCode))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProviderHealthCheck) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProviderHealthCheck) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProviderHealthCheck) predicate.ProviderHealthCheck {
+	return predicate.ProviderHealthCheck(sql.NotPredicates(p))
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// ProviderHealthCheckCreate is the builder for creating a ProviderHealthCheck entity.
+type ProviderHealthCheckCreate struct {
+	config
+	mutation *ProviderHealthCheckMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetIsHealthy sets the "is_healthy" field.
+func (phcc *ProviderHealthCheckCreate) SetIsHealthy(b bool) *ProviderHealthCheckCreate {
+	phcc.mutation.SetIsHealthy(b)
+	return phcc
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (phcc *ProviderHealthCheckCreate) SetLatencyMs(i int64) *ProviderHealthCheckCreate {
+	phcc.mutation.SetLatencyMs(i)
+	return phcc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (phcc *ProviderHealthCheckCreate) SetStatusCode(i int) *ProviderHealthCheckCreate {
+	phcc.mutation.SetStatusCode(i)
+	return phcc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (phcc *ProviderHealthCheckCreate) SetNillableStatusCode(i *int) *ProviderHealthCheckCreate {
+	if i != nil {
+		phcc.SetStatusCode(*i)
+	}
+	return phcc
+}
+
+// SetError sets the "error" field.
+func (phcc *ProviderHealthCheckCreate) SetError(s string) *ProviderHealthCheckCreate {
+	phcc.mutation.SetError(s)
+	return phcc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (phcc *ProviderHealthCheckCreate) SetNillableError(s *string) *ProviderHealthCheckCreate {
+	if s != nil {
+		phcc.SetError(*s)
+	}
+	return phcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (phcc *ProviderHealthCheckCreate) SetCreatedAt(t time.Time) *ProviderHealthCheckCreate {
+	phcc.mutation.SetCreatedAt(t)
+	return phcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (phcc *ProviderHealthCheckCreate) SetNillableCreatedAt(t *time.Time) *ProviderHealthCheckCreate {
+	if t != nil {
+		phcc.SetCreatedAt(*t)
+	}
+	return phcc
+}
+
+// SetID sets the "id" field.
+func (phcc *ProviderHealthCheckCreate) SetID(u uuid.UUID) *ProviderHealthCheckCreate {
+	phcc.mutation.SetID(u)
+	return phcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (phcc *ProviderHealthCheckCreate) SetNillableID(u *uuid.UUID) *ProviderHealthCheckCreate {
+	if u != nil {
+		phcc.SetID(*u)
+	}
+	return phcc
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (phcc *ProviderHealthCheckCreate) SetProviderID(id string) *ProviderHealthCheckCreate {
+	phcc.mutation.SetProviderID(id)
+	return phcc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (phcc *ProviderHealthCheckCreate) SetProvider(p *ProviderProfile) *ProviderHealthCheckCreate {
+	return phcc.SetProviderID(p.ID)
+}
+
+// Mutation returns the ProviderHealthCheckMutation object of the builder.
+func (phcc *ProviderHealthCheckCreate) Mutation() *ProviderHealthCheckMutation {
+	return phcc.mutation
+}
+
+// Save creates the ProviderHealthCheck in the database.
+func (phcc *ProviderHealthCheckCreate) Save(ctx context.Context) (*ProviderHealthCheck, error) {
+	phcc.defaults()
+	return withHooks(ctx, phcc.sqlSave, phcc.mutation, phcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (phcc *ProviderHealthCheckCreate) SaveX(ctx context.Context) *ProviderHealthCheck {
+	v, err := phcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phcc *ProviderHealthCheckCreate) Exec(ctx context.Context) error {
+	_, err := phcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcc *ProviderHealthCheckCreate) ExecX(ctx context.Context) {
+	if err := phcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phcc *ProviderHealthCheckCreate) defaults() {
+	if _, ok := phcc.mutation.CreatedAt(); !ok {
+		v := providerhealthcheck.DefaultCreatedAt()
+		phcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := phcc.mutation.ID(); !ok {
+		v := providerhealthcheck.DefaultID()
+		phcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phcc *ProviderHealthCheckCreate) check() error {
+	if _, ok := phcc.mutation.IsHealthy(); !ok {
+		return &ValidationError{Name: "is_healthy", err: errors.New(`ent: missing required field "ProviderHealthCheck.is_healthy"`)}
+	}
+	if _, ok := phcc.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "ProviderHealthCheck.latency_ms"`)}
+	}
+	if _, ok := phcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProviderHealthCheck.created_at"`)}
+	}
+	if len(phcc.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "ProviderHealthCheck.provider"`)}
+	}
+	return nil
+}
+
+func (phcc *ProviderHealthCheckCreate) sqlSave(ctx context.Context) (*ProviderHealthCheck, error) {
+	if err := phcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := phcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, phcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	phcc.mutation.id = &_node.ID
+	phcc.mutation.done = true
+	return _node, nil
+}
+
+func (phcc *ProviderHealthCheckCreate) createSpec() (*ProviderHealthCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderHealthCheck{config: phcc.config}
+		_spec = sqlgraph.NewCreateSpec(providerhealthcheck.Table, sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = phcc.conflict
+	if id, ok := phcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := phcc.mutation.IsHealthy(); ok {
+		_spec.SetField(providerhealthcheck.FieldIsHealthy, field.TypeBool, value)
+		_node.IsHealthy = value
+	}
+	if value, ok := phcc.mutation.LatencyMs(); ok {
+		_spec.SetField(providerhealthcheck.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := phcc.mutation.StatusCode(); ok {
+		_spec.SetField(providerhealthcheck.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := phcc.mutation.Error(); ok {
+		_spec.SetField(providerhealthcheck.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := phcc.mutation.CreatedAt(); ok {
+		_spec.SetField(providerhealthcheck.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := phcc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerhealthcheck.ProviderTable,
+			Columns: []string{providerhealthcheck.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_health_checks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderHealthCheck.Create().
+//		SetIsHealthy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderHealthCheckUpsert) {
+//			SetIsHealthy(v+v).
+//		}).
+//		Exec(ctx)
+func (phcc *ProviderHealthCheckCreate) OnConflict(opts ...sql.ConflictOption) *ProviderHealthCheckUpsertOne {
+	phcc.conflict = opts
+	return &ProviderHealthCheckUpsertOne{
+		create: phcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderHealthCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (phcc *ProviderHealthCheckCreate) OnConflictColumns(columns ...string) *ProviderHealthCheckUpsertOne {
+	phcc.conflict = append(phcc.conflict, sql.ConflictColumns(columns...))
+	return &ProviderHealthCheckUpsertOne{
+		create: phcc,
+	}
+}
+
+type (
+	// ProviderHealthCheckUpsertOne is the builder for "upsert"-ing
+	//  one ProviderHealthCheck node.
+	ProviderHealthCheckUpsertOne struct {
+		create *ProviderHealthCheckCreate
+	}
+
+	// ProviderHealthCheckUpsert is the "OnConflict" setter.
+	ProviderHealthCheckUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProviderHealthCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerhealthcheck.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderHealthCheckUpsertOne) UpdateNewValues() *ProviderHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(providerhealthcheck.FieldID)
+		}
+		if _, exists := u.create.mutation.IsHealthy(); exists {
+			s.SetIgnore(providerhealthcheck.FieldIsHealthy)
+		}
+		if _, exists := u.create.mutation.LatencyMs(); exists {
+			s.SetIgnore(providerhealthcheck.FieldLatencyMs)
+		}
+		if _, exists := u.create.mutation.StatusCode(); exists {
+			s.SetIgnore(providerhealthcheck.FieldStatusCode)
+		}
+		if _, exists := u.create.mutation.Error(); exists {
+			s.SetIgnore(providerhealthcheck.FieldError)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(providerhealthcheck.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderHealthCheck.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProviderHealthCheckUpsertOne) Ignore() *ProviderHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderHealthCheckUpsertOne) DoNothing() *ProviderHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderHealthCheckCreate.OnConflict
+// documentation for more info.
+func (u *ProviderHealthCheckUpsertOne) Update(set func(*ProviderHealthCheckUpsert)) *ProviderHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderHealthCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ProviderHealthCheckUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderHealthCheckCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderHealthCheckUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProviderHealthCheckUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProviderHealthCheckUpsertOne.ID is not supported by MySQL driver. Use ProviderHealthCheckUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProviderHealthCheckUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProviderHealthCheckCreateBulk is the builder for creating many ProviderHealthCheck entities in bulk.
+type ProviderHealthCheckCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderHealthCheckCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProviderHealthCheck entities in the database.
+func (phccb *ProviderHealthCheckCreateBulk) Save(ctx context.Context) ([]*ProviderHealthCheck, error) {
+	if phccb.err != nil {
+		return nil, phccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(phccb.builders))
+	nodes := make([]*ProviderHealthCheck, len(phccb.builders))
+	mutators := make([]Mutator, len(phccb.builders))
+	for i := range phccb.builders {
+		func(i int, root context.Context) {
+			builder := phccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderHealthCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, phccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = phccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, phccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, phccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phccb *ProviderHealthCheckCreateBulk) SaveX(ctx context.Context) []*ProviderHealthCheck {
+	v, err := phccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phccb *ProviderHealthCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := phccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phccb *ProviderHealthCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := phccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderHealthCheck.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderHealthCheckUpsert) {
+//			SetIsHealthy(v+v).
+//		}).
+//		Exec(ctx)
+func (phccb *ProviderHealthCheckCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProviderHealthCheckUpsertBulk {
+	phccb.conflict = opts
+	return &ProviderHealthCheckUpsertBulk{
+		create: phccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderHealthCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (phccb *ProviderHealthCheckCreateBulk) OnConflictColumns(columns ...string) *ProviderHealthCheckUpsertBulk {
+	phccb.conflict = append(phccb.conflict, sql.ConflictColumns(columns...))
+	return &ProviderHealthCheckUpsertBulk{
+		create: phccb,
+	}
+}
+
+// ProviderHealthCheckUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProviderHealthCheck nodes.
+type ProviderHealthCheckUpsertBulk struct {
+	create *ProviderHealthCheckCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProviderHealthCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerhealthcheck.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderHealthCheckUpsertBulk) UpdateNewValues() *ProviderHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(providerhealthcheck.FieldID)
+			}
+			if _, exists := b.mutation.IsHealthy(); exists {
+				s.SetIgnore(providerhealthcheck.FieldIsHealthy)
+			}
+			if _, exists := b.mutation.LatencyMs(); exists {
+				s.SetIgnore(providerhealthcheck.FieldLatencyMs)
+			}
+			if _, exists := b.mutation.StatusCode(); exists {
+				s.SetIgnore(providerhealthcheck.FieldStatusCode)
+			}
+			if _, exists := b.mutation.Error(); exists {
+				s.SetIgnore(providerhealthcheck.FieldError)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(providerhealthcheck.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderHealthCheck.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProviderHealthCheckUpsertBulk) Ignore() *ProviderHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderHealthCheckUpsertBulk) DoNothing() *ProviderHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderHealthCheckCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProviderHealthCheckUpsertBulk) Update(set func(*ProviderHealthCheckUpsert)) *ProviderHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderHealthCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ProviderHealthCheckUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProviderHealthCheckCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderHealthCheckCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderHealthCheckUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+)
+
+// ProviderHealthCheckDelete is the builder for deleting a ProviderHealthCheck entity.
+type ProviderHealthCheckDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderHealthCheckMutation
+}
+
+// Where appends a list predicates to the ProviderHealthCheckDelete builder.
+func (phcd *ProviderHealthCheckDelete) Where(ps ...predicate.ProviderHealthCheck) *ProviderHealthCheckDelete {
+	phcd.mutation.Where(ps...)
+	return phcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (phcd *ProviderHealthCheckDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, phcd.sqlExec, phcd.mutation, phcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcd *ProviderHealthCheckDelete) ExecX(ctx context.Context) int {
+	n, err := phcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (phcd *ProviderHealthCheckDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providerhealthcheck.Table, sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID))
+	if ps := phcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, phcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	phcd.mutation.done = true
+	return affected, err
+}
+
+// ProviderHealthCheckDeleteOne is the builder for deleting a single ProviderHealthCheck entity.
+type ProviderHealthCheckDeleteOne struct {
+	phcd *ProviderHealthCheckDelete
+}
+
+// Where appends a list predicates to the ProviderHealthCheckDelete builder.
+func (phcdo *ProviderHealthCheckDeleteOne) Where(ps ...predicate.ProviderHealthCheck) *ProviderHealthCheckDeleteOne {
+	phcdo.phcd.mutation.Where(ps...)
+	return phcdo
+}
+
+// Exec executes the deletion query.
+func (phcdo *ProviderHealthCheckDeleteOne) Exec(ctx context.Context) error {
+	n, err := phcdo.phcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providerhealthcheck.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcdo *ProviderHealthCheckDeleteOne) ExecX(ctx context.Context) {
+	if err := phcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
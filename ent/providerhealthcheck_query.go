@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+)
+
+// ProviderHealthCheckQuery is the builder for querying ProviderHealthCheck entities.
+type ProviderHealthCheckQuery struct {
+	config
+	ctx          *QueryContext
+	order        []providerhealthcheck.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ProviderHealthCheck
+	withProvider *ProviderProfileQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProviderHealthCheckQuery builder.
+func (phcq *ProviderHealthCheckQuery) Where(ps ...predicate.ProviderHealthCheck) *ProviderHealthCheckQuery {
+	phcq.predicates = append(phcq.predicates, ps...)
+	return phcq
+}
+
+// Limit the number of records to be returned by this query.
+func (phcq *ProviderHealthCheckQuery) Limit(limit int) *ProviderHealthCheckQuery {
+	phcq.ctx.Limit = &limit
+	return phcq
+}
+
+// Offset to start from.
+func (phcq *ProviderHealthCheckQuery) Offset(offset int) *ProviderHealthCheckQuery {
+	phcq.ctx.Offset = &offset
+	return phcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (phcq *ProviderHealthCheckQuery) Unique(unique bool) *ProviderHealthCheckQuery {
+	phcq.ctx.Unique = &unique
+	return phcq
+}
+
+// Order specifies how the records should be ordered.
+func (phcq *ProviderHealthCheckQuery) Order(o ...providerhealthcheck.OrderOption) *ProviderHealthCheckQuery {
+	phcq.order = append(phcq.order, o...)
+	return phcq
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (phcq *ProviderHealthCheckQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: phcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := phcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := phcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerhealthcheck.Table, providerhealthcheck.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerhealthcheck.ProviderTable, providerhealthcheck.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(phcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProviderHealthCheck entity from the query.
+// Returns a *NotFoundError when no ProviderHealthCheck was found.
+func (phcq *ProviderHealthCheckQuery) First(ctx context.Context) (*ProviderHealthCheck, error) {
+	nodes, err := phcq.Limit(1).All(setContextOp(ctx, phcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{providerhealthcheck.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) FirstX(ctx context.Context) *ProviderHealthCheck {
+	node, err := phcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProviderHealthCheck ID from the query.
+// Returns a *NotFoundError when no ProviderHealthCheck ID was found.
+func (phcq *ProviderHealthCheckQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = phcq.Limit(1).IDs(setContextOp(ctx, phcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{providerhealthcheck.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := phcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProviderHealthCheck entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProviderHealthCheck entity is found.
+// Returns a *NotFoundError when no ProviderHealthCheck entities are found.
+func (phcq *ProviderHealthCheckQuery) Only(ctx context.Context) (*ProviderHealthCheck, error) {
+	nodes, err := phcq.Limit(2).All(setContextOp(ctx, phcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{providerhealthcheck.Label}
+	default:
+		return nil, &NotSingularError{providerhealthcheck.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) OnlyX(ctx context.Context) *ProviderHealthCheck {
+	node, err := phcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProviderHealthCheck ID in the query.
+// Returns a *NotSingularError when more than one ProviderHealthCheck ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (phcq *ProviderHealthCheckQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = phcq.Limit(2).IDs(setContextOp(ctx, phcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{providerhealthcheck.Label}
+	default:
+		err = &NotSingularError{providerhealthcheck.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := phcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProviderHealthChecks.
+func (phcq *ProviderHealthCheckQuery) All(ctx context.Context) ([]*ProviderHealthCheck, error) {
+	ctx = setContextOp(ctx, phcq.ctx, ent.OpQueryAll)
+	if err := phcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProviderHealthCheck, *ProviderHealthCheckQuery]()
+	return withInterceptors[[]*ProviderHealthCheck](ctx, phcq, qr, phcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) AllX(ctx context.Context) []*ProviderHealthCheck {
+	nodes, err := phcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProviderHealthCheck IDs.
+func (phcq *ProviderHealthCheckQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if phcq.ctx.Unique == nil && phcq.path != nil {
+		phcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, phcq.ctx, ent.OpQueryIDs)
+	if err = phcq.Select(providerhealthcheck.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := phcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (phcq *ProviderHealthCheckQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, phcq.ctx, ent.OpQueryCount)
+	if err := phcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, phcq, querierCount[*ProviderHealthCheckQuery](), phcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) CountX(ctx context.Context) int {
+	count, err := phcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (phcq *ProviderHealthCheckQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, phcq.ctx, ent.OpQueryExist)
+	switch _, err := phcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (phcq *ProviderHealthCheckQuery) ExistX(ctx context.Context) bool {
+	exist, err := phcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProviderHealthCheckQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (phcq *ProviderHealthCheckQuery) Clone() *ProviderHealthCheckQuery {
+	if phcq == nil {
+		return nil
+	}
+	return &ProviderHealthCheckQuery{
+		config:       phcq.config,
+		ctx:          phcq.ctx.Clone(),
+		order:        append([]providerhealthcheck.OrderOption{}, phcq.order...),
+		inters:       append([]Interceptor{}, phcq.inters...),
+		predicates:   append([]predicate.ProviderHealthCheck{}, phcq.predicates...),
+		withProvider: phcq.withProvider.Clone(),
+		// clone intermediate query.
+		sql:  phcq.sql.Clone(),
+		path: phcq.path,
+	}
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (phcq *ProviderHealthCheckQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *ProviderHealthCheckQuery {
+	query := (&ProviderProfileClient{config: phcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	phcq.withProvider = query
+	return phcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		IsHealthy bool `json:"is_healthy,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProviderHealthCheck.Query().
+//		GroupBy(providerhealthcheck.FieldIsHealthy).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (phcq *ProviderHealthCheckQuery) GroupBy(field string, fields ...string) *ProviderHealthCheckGroupBy {
+	phcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProviderHealthCheckGroupBy{build: phcq}
+	grbuild.flds = &phcq.ctx.Fields
+	grbuild.label = providerhealthcheck.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		IsHealthy bool `json:"is_healthy,omitempty"`
+//	}
+//
+//	client.ProviderHealthCheck.Query().
+//		Select(providerhealthcheck.FieldIsHealthy).
+//		Scan(ctx, &v)
+func (phcq *ProviderHealthCheckQuery) Select(fields ...string) *ProviderHealthCheckSelect {
+	phcq.ctx.Fields = append(phcq.ctx.Fields, fields...)
+	sbuild := &ProviderHealthCheckSelect{ProviderHealthCheckQuery: phcq}
+	sbuild.label = providerhealthcheck.Label
+	sbuild.flds, sbuild.scan = &phcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProviderHealthCheckSelect configured with the given aggregations.
+func (phcq *ProviderHealthCheckQuery) Aggregate(fns ...AggregateFunc) *ProviderHealthCheckSelect {
+	return phcq.Select().Aggregate(fns...)
+}
+
+func (phcq *ProviderHealthCheckQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range phcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, phcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range phcq.ctx.Fields {
+		if !providerhealthcheck.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if phcq.path != nil {
+		prev, err := phcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		phcq.sql = prev
+	}
+	return nil
+}
+
+func (phcq *ProviderHealthCheckQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProviderHealthCheck, error) {
+	var (
+		nodes       = []*ProviderHealthCheck{}
+		withFKs     = phcq.withFKs
+		_spec       = phcq.querySpec()
+		loadedTypes = [1]bool{
+			phcq.withProvider != nil,
+		}
+	)
+	if phcq.withProvider != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, providerhealthcheck.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProviderHealthCheck).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProviderHealthCheck{config: phcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, phcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := phcq.withProvider; query != nil {
+		if err := phcq.loadProvider(ctx, query, nodes, nil,
+			func(n *ProviderHealthCheck, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (phcq *ProviderHealthCheckQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*ProviderHealthCheck, init func(*ProviderHealthCheck), assign func(*ProviderHealthCheck, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProviderHealthCheck)
+	for i := range nodes {
+		if nodes[i].provider_profile_health_checks == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_health_checks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_health_checks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (phcq *ProviderHealthCheckQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := phcq.querySpec()
+	_spec.Node.Columns = phcq.ctx.Fields
+	if len(phcq.ctx.Fields) > 0 {
+		_spec.Unique = phcq.ctx.Unique != nil && *phcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, phcq.driver, _spec)
+}
+
+func (phcq *ProviderHealthCheckQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(providerhealthcheck.Table, providerhealthcheck.Columns, sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID))
+	_spec.From = phcq.sql
+	if unique := phcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if phcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := phcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerhealthcheck.FieldID)
+		for i := range fields {
+			if fields[i] != providerhealthcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := phcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := phcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := phcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := phcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (phcq *ProviderHealthCheckQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(phcq.driver.Dialect())
+	t1 := builder.Table(providerhealthcheck.Table)
+	columns := phcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = providerhealthcheck.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if phcq.sql != nil {
+		selector = phcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if phcq.ctx.Unique != nil && *phcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range phcq.predicates {
+		p(selector)
+	}
+	for _, p := range phcq.order {
+		p(selector)
+	}
+	if offset := phcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := phcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProviderHealthCheckGroupBy is the group-by builder for ProviderHealthCheck entities.
+type ProviderHealthCheckGroupBy struct {
+	selector
+	build *ProviderHealthCheckQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (phcgb *ProviderHealthCheckGroupBy) Aggregate(fns ...AggregateFunc) *ProviderHealthCheckGroupBy {
+	phcgb.fns = append(phcgb.fns, fns...)
+	return phcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (phcgb *ProviderHealthCheckGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, phcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := phcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderHealthCheckQuery, *ProviderHealthCheckGroupBy](ctx, phcgb.build, phcgb, phcgb.build.inters, v)
+}
+
+func (phcgb *ProviderHealthCheckGroupBy) sqlScan(ctx context.Context, root *ProviderHealthCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(phcgb.fns))
+	for _, fn := range phcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*phcgb.flds)+len(phcgb.fns))
+		for _, f := range *phcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*phcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := phcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProviderHealthCheckSelect is the builder for selecting fields of ProviderHealthCheck entities.
+type ProviderHealthCheckSelect struct {
+	*ProviderHealthCheckQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (phcs *ProviderHealthCheckSelect) Aggregate(fns ...AggregateFunc) *ProviderHealthCheckSelect {
+	phcs.fns = append(phcs.fns, fns...)
+	return phcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (phcs *ProviderHealthCheckSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, phcs.ctx, ent.OpQuerySelect)
+	if err := phcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderHealthCheckQuery, *ProviderHealthCheckSelect](ctx, phcs.ProviderHealthCheckQuery, phcs, phcs.inters, v)
+}
+
+func (phcs *ProviderHealthCheckSelect) sqlScan(ctx context.Context, root *ProviderHealthCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(phcs.fns))
+	for _, fn := range phcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*phcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := phcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
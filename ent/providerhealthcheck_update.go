@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+)
+
+// ProviderHealthCheckUpdate is the builder for updating ProviderHealthCheck entities.
+type ProviderHealthCheckUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderHealthCheckMutation
+}
+
+// Where appends a list predicates to the ProviderHealthCheckUpdate builder.
+func (phcu *ProviderHealthCheckUpdate) Where(ps ...predicate.ProviderHealthCheck) *ProviderHealthCheckUpdate {
+	phcu.mutation.Where(ps...)
+	return phcu
+}
+
+// Mutation returns the ProviderHealthCheckMutation object of the builder.
+func (phcu *ProviderHealthCheckUpdate) Mutation() *ProviderHealthCheckMutation {
+	return phcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (phcu *ProviderHealthCheckUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, phcu.sqlSave, phcu.mutation, phcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phcu *ProviderHealthCheckUpdate) SaveX(ctx context.Context) int {
+	affected, err := phcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (phcu *ProviderHealthCheckUpdate) Exec(ctx context.Context) error {
+	_, err := phcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcu *ProviderHealthCheckUpdate) ExecX(ctx context.Context) {
+	if err := phcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phcu *ProviderHealthCheckUpdate) check() error {
+	if phcu.mutation.ProviderCleared() && len(phcu.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderHealthCheck.provider"`)
+	}
+	return nil
+}
+
+func (phcu *ProviderHealthCheckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := phcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerhealthcheck.Table, providerhealthcheck.Columns, sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID))
+	if ps := phcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if phcu.mutation.StatusCodeCleared() {
+		_spec.ClearField(providerhealthcheck.FieldStatusCode, field.TypeInt)
+	}
+	if phcu.mutation.ErrorCleared() {
+		_spec.ClearField(providerhealthcheck.FieldError, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, phcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerhealthcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	phcu.mutation.done = true
+	return n, nil
+}
+
+// ProviderHealthCheckUpdateOne is the builder for updating a single ProviderHealthCheck entity.
+type ProviderHealthCheckUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderHealthCheckMutation
+}
+
+// Mutation returns the ProviderHealthCheckMutation object of the builder.
+func (phcuo *ProviderHealthCheckUpdateOne) Mutation() *ProviderHealthCheckMutation {
+	return phcuo.mutation
+}
+
+// Where appends a list predicates to the ProviderHealthCheckUpdate builder.
+func (phcuo *ProviderHealthCheckUpdateOne) Where(ps ...predicate.ProviderHealthCheck) *ProviderHealthCheckUpdateOne {
+	phcuo.mutation.Where(ps...)
+	return phcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (phcuo *ProviderHealthCheckUpdateOne) Select(field string, fields ...string) *ProviderHealthCheckUpdateOne {
+	phcuo.fields = append([]string{field}, fields...)
+	return phcuo
+}
+
+// Save executes the query and returns the updated ProviderHealthCheck entity.
+func (phcuo *ProviderHealthCheckUpdateOne) Save(ctx context.Context) (*ProviderHealthCheck, error) {
+	return withHooks(ctx, phcuo.sqlSave, phcuo.mutation, phcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phcuo *ProviderHealthCheckUpdateOne) SaveX(ctx context.Context) *ProviderHealthCheck {
+	node, err := phcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (phcuo *ProviderHealthCheckUpdateOne) Exec(ctx context.Context) error {
+	_, err := phcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcuo *ProviderHealthCheckUpdateOne) ExecX(ctx context.Context) {
+	if err := phcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phcuo *ProviderHealthCheckUpdateOne) check() error {
+	if phcuo.mutation.ProviderCleared() && len(phcuo.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderHealthCheck.provider"`)
+	}
+	return nil
+}
+
+func (phcuo *ProviderHealthCheckUpdateOne) sqlSave(ctx context.Context) (_node *ProviderHealthCheck, err error) {
+	if err := phcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerhealthcheck.Table, providerhealthcheck.Columns, sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID))
+	id, ok := phcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderHealthCheck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := phcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerhealthcheck.FieldID)
+		for _, f := range fields {
+			if !providerhealthcheck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providerhealthcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := phcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if phcuo.mutation.StatusCodeCleared() {
+		_spec.ClearField(providerhealthcheck.FieldStatusCode, field.TypeInt)
+	}
+	if phcuo.mutation.ErrorCleared() {
+		_spec.ClearField(providerhealthcheck.FieldError, field.TypeString)
+	}
+	_node = &ProviderHealthCheck{config: phcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, phcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerhealthcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	phcuo.mutation.done = true
+	return _node, nil
+}
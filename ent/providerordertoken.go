@@ -10,6 +10,8 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/shopspring/decimal"
@@ -41,20 +43,27 @@ type ProviderOrderToken struct {
 		Address string "json:\"address\""
 		Network string "json:\"network\""
 	} `json:"addresses,omitempty"`
+	// RateStaleSince holds the value of the "rate_stale_since" field.
+	RateStaleSince time.Time `json:"rate_stale_since,omitempty"`
+	// RateStaleNotifiedAt holds the value of the "rate_stale_notified_at" field.
+	RateStaleNotifiedAt time.Time `json:"rate_stale_notified_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProviderOrderTokenQuery when eager-loading is set.
-	Edges                         ProviderOrderTokenEdges `json:"edges"`
-	provider_profile_order_tokens *string
-	selectValues                  sql.SelectValues
+	Edges                               ProviderOrderTokenEdges `json:"edges"`
+	fiat_currency_provider_order_tokens *uuid.UUID
+	provider_profile_order_tokens       *string
+	selectValues                        sql.SelectValues
 }
 
 // ProviderOrderTokenEdges holds the relations/edges for other nodes in the graph.
 type ProviderOrderTokenEdges struct {
 	// Provider holds the value of the provider edge.
 	Provider *ProviderProfile `json:"provider,omitempty"`
+	// Currency holds the value of the currency edge.
+	Currency *FiatCurrency `json:"currency,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ProviderOrErr returns the Provider value or an error if the edge
@@ -68,6 +77,17 @@ func (e ProviderOrderTokenEdges) ProviderOrErr() (*ProviderProfile, error) {
 	return nil, &NotLoadedError{edge: "provider"}
 }
 
+// CurrencyOrErr returns the Currency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderOrderTokenEdges) CurrencyOrErr() (*FiatCurrency, error) {
+	if e.Currency != nil {
+		return e.Currency, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "currency"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProviderOrderToken) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -81,9 +101,11 @@ func (*ProviderOrderToken) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case providerordertoken.FieldSymbol, providerordertoken.FieldConversionRateType:
 			values[i] = new(sql.NullString)
-		case providerordertoken.FieldCreatedAt, providerordertoken.FieldUpdatedAt:
+		case providerordertoken.FieldCreatedAt, providerordertoken.FieldUpdatedAt, providerordertoken.FieldRateStaleSince, providerordertoken.FieldRateStaleNotifiedAt:
 			values[i] = new(sql.NullTime)
-		case providerordertoken.ForeignKeys[0]: // provider_profile_order_tokens
+		case providerordertoken.ForeignKeys[0]: // fiat_currency_provider_order_tokens
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case providerordertoken.ForeignKeys[1]: // provider_profile_order_tokens
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -162,7 +184,26 @@ func (pot *ProviderOrderToken) assignValues(columns []string, values []any) erro
 					return fmt.Errorf("unmarshal field addresses: %w", err)
 				}
 			}
+		case providerordertoken.FieldRateStaleSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_stale_since", values[i])
+			} else if value.Valid {
+				pot.RateStaleSince = value.Time
+			}
+		case providerordertoken.FieldRateStaleNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_stale_notified_at", values[i])
+			} else if value.Valid {
+				pot.RateStaleNotifiedAt = value.Time
+			}
 		case providerordertoken.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_provider_order_tokens", values[i])
+			} else if value.Valid {
+				pot.fiat_currency_provider_order_tokens = new(uuid.UUID)
+				*pot.fiat_currency_provider_order_tokens = *value.S.(*uuid.UUID)
+			}
+		case providerordertoken.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field provider_profile_order_tokens", values[i])
 			} else if value.Valid {
@@ -187,6 +228,11 @@ func (pot *ProviderOrderToken) QueryProvider() *ProviderProfileQuery {
 	return NewProviderOrderTokenClient(pot.config).QueryProvider(pot)
 }
 
+// QueryCurrency queries the "currency" edge of the ProviderOrderToken entity.
+func (pot *ProviderOrderToken) QueryCurrency() *FiatCurrencyQuery {
+	return NewProviderOrderTokenClient(pot.config).QueryCurrency(pot)
+}
+
 // Update returns a builder for updating this ProviderOrderToken.
 // Note that you need to call ProviderOrderToken.Unwrap() before calling this method if this ProviderOrderToken
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -236,6 +282,12 @@ func (pot *ProviderOrderToken) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("addresses=")
 	builder.WriteString(fmt.Sprintf("%v", pot.Addresses))
+	builder.WriteString(", ")
+	builder.WriteString("rate_stale_since=")
+	builder.WriteString(pot.RateStaleSince.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("rate_stale_notified_at=")
+	builder.WriteString(pot.RateStaleNotifiedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -33,8 +33,14 @@ const (
 	FieldMinOrderAmount = "min_order_amount"
 	// FieldAddresses holds the string denoting the addresses field in the database.
 	FieldAddresses = "addresses"
+	// FieldRateStaleSince holds the string denoting the rate_stale_since field in the database.
+	FieldRateStaleSince = "rate_stale_since"
+	// FieldRateStaleNotifiedAt holds the string denoting the rate_stale_notified_at field in the database.
+	FieldRateStaleNotifiedAt = "rate_stale_notified_at"
 	// EdgeProvider holds the string denoting the provider edge name in mutations.
 	EdgeProvider = "provider"
+	// EdgeCurrency holds the string denoting the currency edge name in mutations.
+	EdgeCurrency = "currency"
 	// Table holds the table name of the providerordertoken in the database.
 	Table = "provider_order_tokens"
 	// ProviderTable is the table that holds the provider relation/edge.
@@ -44,6 +50,13 @@ const (
 	ProviderInverseTable = "provider_profiles"
 	// ProviderColumn is the table column denoting the provider relation/edge.
 	ProviderColumn = "provider_profile_order_tokens"
+	// CurrencyTable is the table that holds the currency relation/edge.
+	CurrencyTable = "provider_order_tokens"
+	// CurrencyInverseTable is the table name for the FiatCurrency entity.
+	// It exists in this package in order to avoid circular dependency with the "fiatcurrency" package.
+	CurrencyInverseTable = "fiat_currencies"
+	// CurrencyColumn is the table column denoting the currency relation/edge.
+	CurrencyColumn = "fiat_currency_provider_order_tokens"
 )
 
 // Columns holds all SQL columns for providerordertoken fields.
@@ -58,11 +71,14 @@ var Columns = []string{
 	FieldMaxOrderAmount,
 	FieldMinOrderAmount,
 	FieldAddresses,
+	FieldRateStaleSince,
+	FieldRateStaleNotifiedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provider_order_tokens"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"fiat_currency_provider_order_tokens",
 	"provider_profile_order_tokens",
 }
 
@@ -161,12 +177,29 @@ func ByMinOrderAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMinOrderAmount, opts...).ToFunc()
 }
 
+// ByRateStaleSince orders the results by the rate_stale_since field.
+func ByRateStaleSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateStaleSince, opts...).ToFunc()
+}
+
+// ByRateStaleNotifiedAt orders the results by the rate_stale_notified_at field.
+func ByRateStaleNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateStaleNotifiedAt, opts...).ToFunc()
+}
+
 // ByProviderField orders the results by provider field.
 func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCurrencyField orders the results by currency field.
+func ByCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrencyStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newProviderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -174,3 +207,10 @@ func newProviderStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
 	)
 }
+func newCurrencyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrencyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+	)
+}
@@ -91,6 +91,16 @@ func MinOrderAmount(v decimal.Decimal) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldEQ(FieldMinOrderAmount, v))
 }
 
+// RateStaleSince applies equality check predicate on the "rate_stale_since" field. It's identical to RateStaleSinceEQ.
+func RateStaleSince(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateStaleSince, v))
+}
+
+// RateStaleNotifiedAt applies equality check predicate on the "rate_stale_notified_at" field. It's identical to RateStaleNotifiedAtEQ.
+func RateStaleNotifiedAt(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateStaleNotifiedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -416,6 +426,106 @@ func MinOrderAmountLTE(v decimal.Decimal) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.FieldLTE(FieldMinOrderAmount, v))
 }
 
+// RateStaleSinceEQ applies the EQ predicate on the "rate_stale_since" field.
+func RateStaleSinceEQ(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateStaleSince, v))
+}
+
+// RateStaleSinceNEQ applies the NEQ predicate on the "rate_stale_since" field.
+func RateStaleSinceNEQ(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNEQ(FieldRateStaleSince, v))
+}
+
+// RateStaleSinceIn applies the In predicate on the "rate_stale_since" field.
+func RateStaleSinceIn(vs ...time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIn(FieldRateStaleSince, vs...))
+}
+
+// RateStaleSinceNotIn applies the NotIn predicate on the "rate_stale_since" field.
+func RateStaleSinceNotIn(vs ...time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotIn(FieldRateStaleSince, vs...))
+}
+
+// RateStaleSinceGT applies the GT predicate on the "rate_stale_since" field.
+func RateStaleSinceGT(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGT(FieldRateStaleSince, v))
+}
+
+// RateStaleSinceGTE applies the GTE predicate on the "rate_stale_since" field.
+func RateStaleSinceGTE(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGTE(FieldRateStaleSince, v))
+}
+
+// RateStaleSinceLT applies the LT predicate on the "rate_stale_since" field.
+func RateStaleSinceLT(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLT(FieldRateStaleSince, v))
+}
+
+// RateStaleSinceLTE applies the LTE predicate on the "rate_stale_since" field.
+func RateStaleSinceLTE(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLTE(FieldRateStaleSince, v))
+}
+
+// RateStaleSinceIsNil applies the IsNil predicate on the "rate_stale_since" field.
+func RateStaleSinceIsNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIsNull(FieldRateStaleSince))
+}
+
+// RateStaleSinceNotNil applies the NotNil predicate on the "rate_stale_since" field.
+func RateStaleSinceNotNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotNull(FieldRateStaleSince))
+}
+
+// RateStaleNotifiedAtEQ applies the EQ predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtEQ(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldEQ(FieldRateStaleNotifiedAt, v))
+}
+
+// RateStaleNotifiedAtNEQ applies the NEQ predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtNEQ(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNEQ(FieldRateStaleNotifiedAt, v))
+}
+
+// RateStaleNotifiedAtIn applies the In predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtIn(vs ...time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIn(FieldRateStaleNotifiedAt, vs...))
+}
+
+// RateStaleNotifiedAtNotIn applies the NotIn predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtNotIn(vs ...time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotIn(FieldRateStaleNotifiedAt, vs...))
+}
+
+// RateStaleNotifiedAtGT applies the GT predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtGT(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGT(FieldRateStaleNotifiedAt, v))
+}
+
+// RateStaleNotifiedAtGTE applies the GTE predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtGTE(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldGTE(FieldRateStaleNotifiedAt, v))
+}
+
+// RateStaleNotifiedAtLT applies the LT predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtLT(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLT(FieldRateStaleNotifiedAt, v))
+}
+
+// RateStaleNotifiedAtLTE applies the LTE predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtLTE(v time.Time) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldLTE(FieldRateStaleNotifiedAt, v))
+}
+
+// RateStaleNotifiedAtIsNil applies the IsNil predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtIsNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldIsNull(FieldRateStaleNotifiedAt))
+}
+
+// RateStaleNotifiedAtNotNil applies the NotNil predicate on the "rate_stale_notified_at" field.
+func RateStaleNotifiedAtNotNil() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(sql.FieldNotNull(FieldRateStaleNotifiedAt))
+}
+
 // HasProvider applies the HasEdge predicate on the "provider" edge.
 func HasProvider() predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(func(s *sql.Selector) {
@@ -439,6 +549,29 @@ func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderOrder
 	})
 }
 
+// HasCurrency applies the HasEdge predicate on the "currency" edge.
+func HasCurrency() predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
+func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.ProviderOrderToken {
+	return predicate.ProviderOrderToken(func(s *sql.Selector) {
+		step := newCurrencyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProviderOrderToken) predicate.ProviderOrderToken {
 	return predicate.ProviderOrderToken(sql.AndPredicates(predicates...))
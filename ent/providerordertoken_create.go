@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/shopspring/decimal"
@@ -97,6 +99,34 @@ func (potc *ProviderOrderTokenCreate) SetAddresses(s []struct {
 	return potc
 }
 
+// SetRateStaleSince sets the "rate_stale_since" field.
+func (potc *ProviderOrderTokenCreate) SetRateStaleSince(t time.Time) *ProviderOrderTokenCreate {
+	potc.mutation.SetRateStaleSince(t)
+	return potc
+}
+
+// SetNillableRateStaleSince sets the "rate_stale_since" field if the given value is not nil.
+func (potc *ProviderOrderTokenCreate) SetNillableRateStaleSince(t *time.Time) *ProviderOrderTokenCreate {
+	if t != nil {
+		potc.SetRateStaleSince(*t)
+	}
+	return potc
+}
+
+// SetRateStaleNotifiedAt sets the "rate_stale_notified_at" field.
+func (potc *ProviderOrderTokenCreate) SetRateStaleNotifiedAt(t time.Time) *ProviderOrderTokenCreate {
+	potc.mutation.SetRateStaleNotifiedAt(t)
+	return potc
+}
+
+// SetNillableRateStaleNotifiedAt sets the "rate_stale_notified_at" field if the given value is not nil.
+func (potc *ProviderOrderTokenCreate) SetNillableRateStaleNotifiedAt(t *time.Time) *ProviderOrderTokenCreate {
+	if t != nil {
+		potc.SetRateStaleNotifiedAt(*t)
+	}
+	return potc
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potc *ProviderOrderTokenCreate) SetProviderID(id string) *ProviderOrderTokenCreate {
 	potc.mutation.SetProviderID(id)
@@ -116,6 +146,17 @@ func (potc *ProviderOrderTokenCreate) SetProvider(p *ProviderProfile) *ProviderO
 	return potc.SetProviderID(p.ID)
 }
 
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (potc *ProviderOrderTokenCreate) SetCurrencyID(id uuid.UUID) *ProviderOrderTokenCreate {
+	potc.mutation.SetCurrencyID(id)
+	return potc
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (potc *ProviderOrderTokenCreate) SetCurrency(f *FiatCurrency) *ProviderOrderTokenCreate {
+	return potc.SetCurrencyID(f.ID)
+}
+
 // Mutation returns the ProviderOrderTokenMutation object of the builder.
 func (potc *ProviderOrderTokenCreate) Mutation() *ProviderOrderTokenMutation {
 	return potc.mutation
@@ -195,6 +236,9 @@ func (potc *ProviderOrderTokenCreate) check() error {
 	if _, ok := potc.mutation.Addresses(); !ok {
 		return &ValidationError{Name: "addresses", err: errors.New(`ent: missing required field "ProviderOrderToken.addresses"`)}
 	}
+	if len(potc.mutation.CurrencyIDs()) == 0 {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "ProviderOrderToken.currency"`)}
+	}
 	return nil
 }
 
@@ -258,6 +302,14 @@ func (potc *ProviderOrderTokenCreate) createSpec() (*ProviderOrderToken, *sqlgra
 		_spec.SetField(providerordertoken.FieldAddresses, field.TypeJSON, value)
 		_node.Addresses = value
 	}
+	if value, ok := potc.mutation.RateStaleSince(); ok {
+		_spec.SetField(providerordertoken.FieldRateStaleSince, field.TypeTime, value)
+		_node.RateStaleSince = value
+	}
+	if value, ok := potc.mutation.RateStaleNotifiedAt(); ok {
+		_spec.SetField(providerordertoken.FieldRateStaleNotifiedAt, field.TypeTime, value)
+		_node.RateStaleNotifiedAt = value
+	}
 	if nodes := potc.mutation.ProviderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -275,6 +327,23 @@ func (potc *ProviderOrderTokenCreate) createSpec() (*ProviderOrderToken, *sqlgra
 		_node.provider_profile_order_tokens = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := potc.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerordertoken.CurrencyTable,
+			Columns: []string{providerordertoken.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_provider_order_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -450,6 +519,42 @@ func (u *ProviderOrderTokenUpsert) UpdateAddresses() *ProviderOrderTokenUpsert {
 	return u
 }
 
+// SetRateStaleSince sets the "rate_stale_since" field.
+func (u *ProviderOrderTokenUpsert) SetRateStaleSince(v time.Time) *ProviderOrderTokenUpsert {
+	u.Set(providerordertoken.FieldRateStaleSince, v)
+	return u
+}
+
+// UpdateRateStaleSince sets the "rate_stale_since" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsert) UpdateRateStaleSince() *ProviderOrderTokenUpsert {
+	u.SetExcluded(providerordertoken.FieldRateStaleSince)
+	return u
+}
+
+// ClearRateStaleSince clears the value of the "rate_stale_since" field.
+func (u *ProviderOrderTokenUpsert) ClearRateStaleSince() *ProviderOrderTokenUpsert {
+	u.SetNull(providerordertoken.FieldRateStaleSince)
+	return u
+}
+
+// SetRateStaleNotifiedAt sets the "rate_stale_notified_at" field.
+func (u *ProviderOrderTokenUpsert) SetRateStaleNotifiedAt(v time.Time) *ProviderOrderTokenUpsert {
+	u.Set(providerordertoken.FieldRateStaleNotifiedAt, v)
+	return u
+}
+
+// UpdateRateStaleNotifiedAt sets the "rate_stale_notified_at" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsert) UpdateRateStaleNotifiedAt() *ProviderOrderTokenUpsert {
+	u.SetExcluded(providerordertoken.FieldRateStaleNotifiedAt)
+	return u
+}
+
+// ClearRateStaleNotifiedAt clears the value of the "rate_stale_notified_at" field.
+func (u *ProviderOrderTokenUpsert) ClearRateStaleNotifiedAt() *ProviderOrderTokenUpsert {
+	u.SetNull(providerordertoken.FieldRateStaleNotifiedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -638,6 +743,48 @@ func (u *ProviderOrderTokenUpsertOne) UpdateAddresses() *ProviderOrderTokenUpser
 	})
 }
 
+// SetRateStaleSince sets the "rate_stale_since" field.
+func (u *ProviderOrderTokenUpsertOne) SetRateStaleSince(v time.Time) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateStaleSince(v)
+	})
+}
+
+// UpdateRateStaleSince sets the "rate_stale_since" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertOne) UpdateRateStaleSince() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateStaleSince()
+	})
+}
+
+// ClearRateStaleSince clears the value of the "rate_stale_since" field.
+func (u *ProviderOrderTokenUpsertOne) ClearRateStaleSince() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearRateStaleSince()
+	})
+}
+
+// SetRateStaleNotifiedAt sets the "rate_stale_notified_at" field.
+func (u *ProviderOrderTokenUpsertOne) SetRateStaleNotifiedAt(v time.Time) *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateStaleNotifiedAt(v)
+	})
+}
+
+// UpdateRateStaleNotifiedAt sets the "rate_stale_notified_at" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertOne) UpdateRateStaleNotifiedAt() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateStaleNotifiedAt()
+	})
+}
+
+// ClearRateStaleNotifiedAt clears the value of the "rate_stale_notified_at" field.
+func (u *ProviderOrderTokenUpsertOne) ClearRateStaleNotifiedAt() *ProviderOrderTokenUpsertOne {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearRateStaleNotifiedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderOrderTokenUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -992,6 +1139,48 @@ func (u *ProviderOrderTokenUpsertBulk) UpdateAddresses() *ProviderOrderTokenUpse
 	})
 }
 
+// SetRateStaleSince sets the "rate_stale_since" field.
+func (u *ProviderOrderTokenUpsertBulk) SetRateStaleSince(v time.Time) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateStaleSince(v)
+	})
+}
+
+// UpdateRateStaleSince sets the "rate_stale_since" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertBulk) UpdateRateStaleSince() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateStaleSince()
+	})
+}
+
+// ClearRateStaleSince clears the value of the "rate_stale_since" field.
+func (u *ProviderOrderTokenUpsertBulk) ClearRateStaleSince() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearRateStaleSince()
+	})
+}
+
+// SetRateStaleNotifiedAt sets the "rate_stale_notified_at" field.
+func (u *ProviderOrderTokenUpsertBulk) SetRateStaleNotifiedAt(v time.Time) *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.SetRateStaleNotifiedAt(v)
+	})
+}
+
+// UpdateRateStaleNotifiedAt sets the "rate_stale_notified_at" field to the value that was provided on create.
+func (u *ProviderOrderTokenUpsertBulk) UpdateRateStaleNotifiedAt() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.UpdateRateStaleNotifiedAt()
+	})
+}
+
+// ClearRateStaleNotifiedAt clears the value of the "rate_stale_notified_at" field.
+func (u *ProviderOrderTokenUpsertBulk) ClearRateStaleNotifiedAt() *ProviderOrderTokenUpsertBulk {
+	return u.Update(func(s *ProviderOrderTokenUpsert) {
+		s.ClearRateStaleNotifiedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderOrderTokenUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
@@ -24,6 +26,7 @@ type ProviderOrderTokenQuery struct {
 	inters       []Interceptor
 	predicates   []predicate.ProviderOrderToken
 	withProvider *ProviderProfileQuery
+	withCurrency *FiatCurrencyQuery
 	withFKs      bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -83,6 +86,28 @@ func (potq *ProviderOrderTokenQuery) QueryProvider() *ProviderProfileQuery {
 	return query
 }
 
+// QueryCurrency chains the current query on the "currency" edge.
+func (potq *ProviderOrderTokenQuery) QueryCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: potq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := potq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := potq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerordertoken.Table, providerordertoken.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerordertoken.CurrencyTable, providerordertoken.CurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(potq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProviderOrderToken entity from the query.
 // Returns a *NotFoundError when no ProviderOrderToken was found.
 func (potq *ProviderOrderTokenQuery) First(ctx context.Context) (*ProviderOrderToken, error) {
@@ -276,6 +301,7 @@ func (potq *ProviderOrderTokenQuery) Clone() *ProviderOrderTokenQuery {
 		inters:       append([]Interceptor{}, potq.inters...),
 		predicates:   append([]predicate.ProviderOrderToken{}, potq.predicates...),
 		withProvider: potq.withProvider.Clone(),
+		withCurrency: potq.withCurrency.Clone(),
 		// clone intermediate query.
 		sql:  potq.sql.Clone(),
 		path: potq.path,
@@ -293,6 +319,17 @@ func (potq *ProviderOrderTokenQuery) WithProvider(opts ...func(*ProviderProfileQ
 	return potq
 }
 
+// WithCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (potq *ProviderOrderTokenQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *ProviderOrderTokenQuery {
+	query := (&FiatCurrencyClient{config: potq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	potq.withCurrency = query
+	return potq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,11 +409,12 @@ func (potq *ProviderOrderTokenQuery) sqlAll(ctx context.Context, hooks ...queryH
 		nodes       = []*ProviderOrderToken{}
 		withFKs     = potq.withFKs
 		_spec       = potq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			potq.withProvider != nil,
+			potq.withCurrency != nil,
 		}
 	)
-	if potq.withProvider != nil {
+	if potq.withProvider != nil || potq.withCurrency != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -406,6 +444,12 @@ func (potq *ProviderOrderTokenQuery) sqlAll(ctx context.Context, hooks ...queryH
 			return nil, err
 		}
 	}
+	if query := potq.withCurrency; query != nil {
+		if err := potq.loadCurrency(ctx, query, nodes, nil,
+			func(n *ProviderOrderToken, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -441,6 +485,38 @@ func (potq *ProviderOrderTokenQuery) loadProvider(ctx context.Context, query *Pr
 	}
 	return nil
 }
+func (potq *ProviderOrderTokenQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*ProviderOrderToken, init func(*ProviderOrderToken), assign func(*ProviderOrderToken, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ProviderOrderToken)
+	for i := range nodes {
+		if nodes[i].fiat_currency_provider_order_tokens == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_provider_order_tokens
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_provider_order_tokens" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (potq *ProviderOrderTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := potq.querySpec()
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
@@ -167,6 +169,46 @@ func (potu *ProviderOrderTokenUpdate) AppendAddresses(s []struct {
 	return potu
 }
 
+// SetRateStaleSince sets the "rate_stale_since" field.
+func (potu *ProviderOrderTokenUpdate) SetRateStaleSince(t time.Time) *ProviderOrderTokenUpdate {
+	potu.mutation.SetRateStaleSince(t)
+	return potu
+}
+
+// SetNillableRateStaleSince sets the "rate_stale_since" field if the given value is not nil.
+func (potu *ProviderOrderTokenUpdate) SetNillableRateStaleSince(t *time.Time) *ProviderOrderTokenUpdate {
+	if t != nil {
+		potu.SetRateStaleSince(*t)
+	}
+	return potu
+}
+
+// ClearRateStaleSince clears the value of the "rate_stale_since" field.
+func (potu *ProviderOrderTokenUpdate) ClearRateStaleSince() *ProviderOrderTokenUpdate {
+	potu.mutation.ClearRateStaleSince()
+	return potu
+}
+
+// SetRateStaleNotifiedAt sets the "rate_stale_notified_at" field.
+func (potu *ProviderOrderTokenUpdate) SetRateStaleNotifiedAt(t time.Time) *ProviderOrderTokenUpdate {
+	potu.mutation.SetRateStaleNotifiedAt(t)
+	return potu
+}
+
+// SetNillableRateStaleNotifiedAt sets the "rate_stale_notified_at" field if the given value is not nil.
+func (potu *ProviderOrderTokenUpdate) SetNillableRateStaleNotifiedAt(t *time.Time) *ProviderOrderTokenUpdate {
+	if t != nil {
+		potu.SetRateStaleNotifiedAt(*t)
+	}
+	return potu
+}
+
+// ClearRateStaleNotifiedAt clears the value of the "rate_stale_notified_at" field.
+func (potu *ProviderOrderTokenUpdate) ClearRateStaleNotifiedAt() *ProviderOrderTokenUpdate {
+	potu.mutation.ClearRateStaleNotifiedAt()
+	return potu
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potu *ProviderOrderTokenUpdate) SetProviderID(id string) *ProviderOrderTokenUpdate {
 	potu.mutation.SetProviderID(id)
@@ -186,6 +228,17 @@ func (potu *ProviderOrderTokenUpdate) SetProvider(p *ProviderProfile) *ProviderO
 	return potu.SetProviderID(p.ID)
 }
 
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (potu *ProviderOrderTokenUpdate) SetCurrencyID(id uuid.UUID) *ProviderOrderTokenUpdate {
+	potu.mutation.SetCurrencyID(id)
+	return potu
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (potu *ProviderOrderTokenUpdate) SetCurrency(f *FiatCurrency) *ProviderOrderTokenUpdate {
+	return potu.SetCurrencyID(f.ID)
+}
+
 // Mutation returns the ProviderOrderTokenMutation object of the builder.
 func (potu *ProviderOrderTokenUpdate) Mutation() *ProviderOrderTokenMutation {
 	return potu.mutation
@@ -197,6 +250,12 @@ func (potu *ProviderOrderTokenUpdate) ClearProvider() *ProviderOrderTokenUpdate
 	return potu
 }
 
+// ClearCurrency clears the "currency" edge to the FiatCurrency entity.
+func (potu *ProviderOrderTokenUpdate) ClearCurrency() *ProviderOrderTokenUpdate {
+	potu.mutation.ClearCurrency()
+	return potu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (potu *ProviderOrderTokenUpdate) Save(ctx context.Context) (int, error) {
 	potu.defaults()
@@ -240,6 +299,9 @@ func (potu *ProviderOrderTokenUpdate) check() error {
 			return &ValidationError{Name: "conversion_rate_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.conversion_rate_type": %w`, err)}
 		}
 	}
+	if potu.mutation.CurrencyCleared() && len(potu.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderOrderToken.currency"`)
+	}
 	return nil
 }
 
@@ -296,6 +358,18 @@ func (potu *ProviderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err e
 			sqljson.Append(u, providerordertoken.FieldAddresses, value)
 		})
 	}
+	if value, ok := potu.mutation.RateStaleSince(); ok {
+		_spec.SetField(providerordertoken.FieldRateStaleSince, field.TypeTime, value)
+	}
+	if potu.mutation.RateStaleSinceCleared() {
+		_spec.ClearField(providerordertoken.FieldRateStaleSince, field.TypeTime)
+	}
+	if value, ok := potu.mutation.RateStaleNotifiedAt(); ok {
+		_spec.SetField(providerordertoken.FieldRateStaleNotifiedAt, field.TypeTime, value)
+	}
+	if potu.mutation.RateStaleNotifiedAtCleared() {
+		_spec.ClearField(providerordertoken.FieldRateStaleNotifiedAt, field.TypeTime)
+	}
 	if potu.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -325,6 +399,35 @@ func (potu *ProviderOrderTokenUpdate) sqlSave(ctx context.Context) (n int, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if potu.mutation.CurrencyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerordertoken.CurrencyTable,
+			Columns: []string{providerordertoken.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := potu.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerordertoken.CurrencyTable,
+			Columns: []string{providerordertoken.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, potu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{providerordertoken.Label}
@@ -481,6 +584,46 @@ func (potuo *ProviderOrderTokenUpdateOne) AppendAddresses(s []struct {
 	return potuo
 }
 
+// SetRateStaleSince sets the "rate_stale_since" field.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateStaleSince(t time.Time) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetRateStaleSince(t)
+	return potuo
+}
+
+// SetNillableRateStaleSince sets the "rate_stale_since" field if the given value is not nil.
+func (potuo *ProviderOrderTokenUpdateOne) SetNillableRateStaleSince(t *time.Time) *ProviderOrderTokenUpdateOne {
+	if t != nil {
+		potuo.SetRateStaleSince(*t)
+	}
+	return potuo
+}
+
+// ClearRateStaleSince clears the value of the "rate_stale_since" field.
+func (potuo *ProviderOrderTokenUpdateOne) ClearRateStaleSince() *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ClearRateStaleSince()
+	return potuo
+}
+
+// SetRateStaleNotifiedAt sets the "rate_stale_notified_at" field.
+func (potuo *ProviderOrderTokenUpdateOne) SetRateStaleNotifiedAt(t time.Time) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetRateStaleNotifiedAt(t)
+	return potuo
+}
+
+// SetNillableRateStaleNotifiedAt sets the "rate_stale_notified_at" field if the given value is not nil.
+func (potuo *ProviderOrderTokenUpdateOne) SetNillableRateStaleNotifiedAt(t *time.Time) *ProviderOrderTokenUpdateOne {
+	if t != nil {
+		potuo.SetRateStaleNotifiedAt(*t)
+	}
+	return potuo
+}
+
+// ClearRateStaleNotifiedAt clears the value of the "rate_stale_notified_at" field.
+func (potuo *ProviderOrderTokenUpdateOne) ClearRateStaleNotifiedAt() *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ClearRateStaleNotifiedAt()
+	return potuo
+}
+
 // SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
 func (potuo *ProviderOrderTokenUpdateOne) SetProviderID(id string) *ProviderOrderTokenUpdateOne {
 	potuo.mutation.SetProviderID(id)
@@ -500,6 +643,17 @@ func (potuo *ProviderOrderTokenUpdateOne) SetProvider(p *ProviderProfile) *Provi
 	return potuo.SetProviderID(p.ID)
 }
 
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (potuo *ProviderOrderTokenUpdateOne) SetCurrencyID(id uuid.UUID) *ProviderOrderTokenUpdateOne {
+	potuo.mutation.SetCurrencyID(id)
+	return potuo
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (potuo *ProviderOrderTokenUpdateOne) SetCurrency(f *FiatCurrency) *ProviderOrderTokenUpdateOne {
+	return potuo.SetCurrencyID(f.ID)
+}
+
 // Mutation returns the ProviderOrderTokenMutation object of the builder.
 func (potuo *ProviderOrderTokenUpdateOne) Mutation() *ProviderOrderTokenMutation {
 	return potuo.mutation
@@ -511,6 +665,12 @@ func (potuo *ProviderOrderTokenUpdateOne) ClearProvider() *ProviderOrderTokenUpd
 	return potuo
 }
 
+// ClearCurrency clears the "currency" edge to the FiatCurrency entity.
+func (potuo *ProviderOrderTokenUpdateOne) ClearCurrency() *ProviderOrderTokenUpdateOne {
+	potuo.mutation.ClearCurrency()
+	return potuo
+}
+
 // Where appends a list predicates to the ProviderOrderTokenUpdate builder.
 func (potuo *ProviderOrderTokenUpdateOne) Where(ps ...predicate.ProviderOrderToken) *ProviderOrderTokenUpdateOne {
 	potuo.mutation.Where(ps...)
@@ -567,6 +727,9 @@ func (potuo *ProviderOrderTokenUpdateOne) check() error {
 			return &ValidationError{Name: "conversion_rate_type", err: fmt.Errorf(`ent: validator failed for field "ProviderOrderToken.conversion_rate_type": %w`, err)}
 		}
 	}
+	if potuo.mutation.CurrencyCleared() && len(potuo.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderOrderToken.currency"`)
+	}
 	return nil
 }
 
@@ -640,6 +803,18 @@ func (potuo *ProviderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *P
 			sqljson.Append(u, providerordertoken.FieldAddresses, value)
 		})
 	}
+	if value, ok := potuo.mutation.RateStaleSince(); ok {
+		_spec.SetField(providerordertoken.FieldRateStaleSince, field.TypeTime, value)
+	}
+	if potuo.mutation.RateStaleSinceCleared() {
+		_spec.ClearField(providerordertoken.FieldRateStaleSince, field.TypeTime)
+	}
+	if value, ok := potuo.mutation.RateStaleNotifiedAt(); ok {
+		_spec.SetField(providerordertoken.FieldRateStaleNotifiedAt, field.TypeTime, value)
+	}
+	if potuo.mutation.RateStaleNotifiedAtCleared() {
+		_spec.ClearField(providerordertoken.FieldRateStaleNotifiedAt, field.TypeTime)
+	}
 	if potuo.mutation.ProviderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -669,6 +844,35 @@ func (potuo *ProviderOrderTokenUpdateOne) sqlSave(ctx context.Context) (_node *P
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if potuo.mutation.CurrencyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerordertoken.CurrencyTable,
+			Columns: []string{providerordertoken.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := potuo.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerordertoken.CurrencyTable,
+			Columns: []string{providerordertoken.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &ProviderOrderToken{config: potuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,7 +12,6 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
-	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
 	"github.com/paycrest/aggregator/ent/user"
@@ -52,12 +52,46 @@ type ProviderProfile struct {
 	BusinessDocument string `json:"business_document,omitempty"`
 	// IsKybVerified holds the value of the "is_kyb_verified" field.
 	IsKybVerified bool `json:"is_kyb_verified,omitempty"`
+	// OperatingTimezone holds the value of the "operating_timezone" field.
+	OperatingTimezone string `json:"operating_timezone,omitempty"`
+	// OperatingHours holds the value of the "operating_hours" field.
+	OperatingHours []struct {
+		Weekday int    "json:\"weekday\""
+		Open    string "json:\"open\""
+		Close   string "json:\"close\""
+	} `json:"operating_hours,omitempty"`
+	// OperatingHoursExceptions holds the value of the "operating_hours_exceptions" field.
+	OperatingHoursExceptions []struct {
+		Date   string "json:\"date\""
+		Closed bool   "json:\"closed\""
+		Open   string "json:\"open\""
+		Close  string "json:\"close\""
+	} `json:"operating_hours_exceptions,omitempty"`
+	// IsOpen holds the value of the "is_open" field.
+	IsOpen bool `json:"is_open,omitempty"`
+	// SupportedInstitutions holds the value of the "supported_institutions" field.
+	SupportedInstitutions []string `json:"supported_institutions,omitempty"`
+	// SupportedInstitutionTypes holds the value of the "supported_institution_types" field.
+	SupportedInstitutionTypes []string `json:"supported_institution_types,omitempty"`
+	// IsHealthy holds the value of the "is_healthy" field.
+	IsHealthy bool `json:"is_healthy,omitempty"`
+	// HealthFailureStreak holds the value of the "health_failure_streak" field.
+	HealthFailureStreak int `json:"health_failure_streak,omitempty"`
+	// LastHealthCheckAt holds the value of the "last_health_check_at" field.
+	LastHealthCheckAt time.Time `json:"last_health_check_at,omitempty"`
+	// NodeProtocolVersion holds the value of the "node_protocol_version" field.
+	NodeProtocolVersion int `json:"node_protocol_version,omitempty"`
+	// NodeCapabilities holds the value of the "node_capabilities" field.
+	NodeCapabilities []string `json:"node_capabilities,omitempty"`
+	// DemotedUntil holds the value of the "demoted_until" field.
+	DemotedUntil time.Time `json:"demoted_until,omitempty"`
+	// SuspendedUntil holds the value of the "suspended_until" field.
+	SuspendedUntil time.Time `json:"suspended_until,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProviderProfileQuery when eager-loading is set.
-	Edges                   ProviderProfileEdges `json:"edges"`
-	fiat_currency_providers *uuid.UUID
-	user_provider_profile   *uuid.UUID
-	selectValues            sql.SelectValues
+	Edges                 ProviderProfileEdges `json:"edges"`
+	user_provider_profile *uuid.UUID
+	selectValues          sql.SelectValues
 }
 
 // ProviderProfileEdges holds the relations/edges for other nodes in the graph.
@@ -66,8 +100,8 @@ type ProviderProfileEdges struct {
 	User *User `json:"user,omitempty"`
 	// APIKey holds the value of the api_key edge.
 	APIKey *APIKey `json:"api_key,omitempty"`
-	// Currency holds the value of the currency edge.
-	Currency *FiatCurrency `json:"currency,omitempty"`
+	// Currencies holds the value of the currencies edge.
+	Currencies []*FiatCurrency `json:"currencies,omitempty"`
 	// ProvisionBuckets holds the value of the provision_buckets edge.
 	ProvisionBuckets []*ProvisionBucket `json:"provision_buckets,omitempty"`
 	// OrderTokens holds the value of the order_tokens edge.
@@ -76,9 +110,25 @@ type ProviderProfileEdges struct {
 	ProviderRating *ProviderRating `json:"provider_rating,omitempty"`
 	// AssignedOrders holds the value of the assigned_orders edge.
 	AssignedOrders []*LockPaymentOrder `json:"assigned_orders,omitempty"`
+	// TeamMembers holds the value of the team_members edge.
+	TeamMembers []*TeamMember `json:"team_members,omitempty"`
+	// TeamInvitations holds the value of the team_invitations edge.
+	TeamInvitations []*TeamInvitation `json:"team_invitations,omitempty"`
+	// TeamAuditLogs holds the value of the team_audit_logs edge.
+	TeamAuditLogs []*TeamAuditLog `json:"team_audit_logs,omitempty"`
+	// HealthChecks holds the value of the health_checks edge.
+	HealthChecks []*ProviderHealthCheck `json:"health_checks,omitempty"`
+	// SLARecords holds the value of the sla_records edge.
+	SLARecords []*ProviderSLARecord `json:"sla_records,omitempty"`
+	// Disputes holds the value of the disputes edge.
+	Disputes []*Dispute `json:"disputes,omitempty"`
+	// OrderAssignments holds the value of the order_assignments edge.
+	OrderAssignments []*OrderAssignment `json:"order_assignments,omitempty"`
+	// RateSnapshots holds the value of the rate_snapshots edge.
+	RateSnapshots []*RateSnapshot `json:"rate_snapshots,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [15]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -103,15 +153,13 @@ func (e ProviderProfileEdges) APIKeyOrErr() (*APIKey, error) {
 	return nil, &NotLoadedError{edge: "api_key"}
 }
 
-// CurrencyOrErr returns the Currency value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e ProviderProfileEdges) CurrencyOrErr() (*FiatCurrency, error) {
-	if e.Currency != nil {
-		return e.Currency, nil
-	} else if e.loadedTypes[2] {
-		return nil, &NotFoundError{label: fiatcurrency.Label}
+// CurrenciesOrErr returns the Currencies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) CurrenciesOrErr() ([]*FiatCurrency, error) {
+	if e.loadedTypes[2] {
+		return e.Currencies, nil
 	}
-	return nil, &NotLoadedError{edge: "currency"}
+	return nil, &NotLoadedError{edge: "currencies"}
 }
 
 // ProvisionBucketsOrErr returns the ProvisionBuckets value or an error if the edge
@@ -152,20 +200,94 @@ func (e ProviderProfileEdges) AssignedOrdersOrErr() ([]*LockPaymentOrder, error)
 	return nil, &NotLoadedError{edge: "assigned_orders"}
 }
 
+// TeamMembersOrErr returns the TeamMembers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) TeamMembersOrErr() ([]*TeamMember, error) {
+	if e.loadedTypes[7] {
+		return e.TeamMembers, nil
+	}
+	return nil, &NotLoadedError{edge: "team_members"}
+}
+
+// TeamInvitationsOrErr returns the TeamInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) TeamInvitationsOrErr() ([]*TeamInvitation, error) {
+	if e.loadedTypes[8] {
+		return e.TeamInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "team_invitations"}
+}
+
+// TeamAuditLogsOrErr returns the TeamAuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) TeamAuditLogsOrErr() ([]*TeamAuditLog, error) {
+	if e.loadedTypes[9] {
+		return e.TeamAuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "team_audit_logs"}
+}
+
+// HealthChecksOrErr returns the HealthChecks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) HealthChecksOrErr() ([]*ProviderHealthCheck, error) {
+	if e.loadedTypes[10] {
+		return e.HealthChecks, nil
+	}
+	return nil, &NotLoadedError{edge: "health_checks"}
+}
+
+// SLARecordsOrErr returns the SLARecords value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) SLARecordsOrErr() ([]*ProviderSLARecord, error) {
+	if e.loadedTypes[11] {
+		return e.SLARecords, nil
+	}
+	return nil, &NotLoadedError{edge: "sla_records"}
+}
+
+// DisputesOrErr returns the Disputes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) DisputesOrErr() ([]*Dispute, error) {
+	if e.loadedTypes[12] {
+		return e.Disputes, nil
+	}
+	return nil, &NotLoadedError{edge: "disputes"}
+}
+
+// OrderAssignmentsOrErr returns the OrderAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) OrderAssignmentsOrErr() ([]*OrderAssignment, error) {
+	if e.loadedTypes[13] {
+		return e.OrderAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "order_assignments"}
+}
+
+// RateSnapshotsOrErr returns the RateSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProviderProfileEdges) RateSnapshotsOrErr() ([]*RateSnapshot, error) {
+	if e.loadedTypes[14] {
+		return e.RateSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "rate_snapshots"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ProviderProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case providerprofile.FieldIsActive, providerprofile.FieldIsAvailable, providerprofile.FieldIsKybVerified:
+		case providerprofile.FieldOperatingHours, providerprofile.FieldOperatingHoursExceptions, providerprofile.FieldSupportedInstitutions, providerprofile.FieldSupportedInstitutionTypes, providerprofile.FieldNodeCapabilities:
+			values[i] = new([]byte)
+		case providerprofile.FieldIsActive, providerprofile.FieldIsAvailable, providerprofile.FieldIsKybVerified, providerprofile.FieldIsOpen, providerprofile.FieldIsHealthy:
 			values[i] = new(sql.NullBool)
-		case providerprofile.FieldID, providerprofile.FieldTradingName, providerprofile.FieldHostIdentifier, providerprofile.FieldProvisionMode, providerprofile.FieldVisibilityMode, providerprofile.FieldAddress, providerprofile.FieldMobileNumber, providerprofile.FieldBusinessName, providerprofile.FieldIdentityDocumentType, providerprofile.FieldIdentityDocument, providerprofile.FieldBusinessDocument:
+		case providerprofile.FieldHealthFailureStreak, providerprofile.FieldNodeProtocolVersion:
+			values[i] = new(sql.NullInt64)
+		case providerprofile.FieldID, providerprofile.FieldTradingName, providerprofile.FieldHostIdentifier, providerprofile.FieldProvisionMode, providerprofile.FieldVisibilityMode, providerprofile.FieldAddress, providerprofile.FieldMobileNumber, providerprofile.FieldBusinessName, providerprofile.FieldIdentityDocumentType, providerprofile.FieldIdentityDocument, providerprofile.FieldBusinessDocument, providerprofile.FieldOperatingTimezone:
 			values[i] = new(sql.NullString)
-		case providerprofile.FieldUpdatedAt, providerprofile.FieldDateOfBirth:
+		case providerprofile.FieldUpdatedAt, providerprofile.FieldDateOfBirth, providerprofile.FieldLastHealthCheckAt, providerprofile.FieldDemotedUntil, providerprofile.FieldSuspendedUntil:
 			values[i] = new(sql.NullTime)
-		case providerprofile.ForeignKeys[0]: // fiat_currency_providers
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case providerprofile.ForeignKeys[1]: // user_provider_profile
+		case providerprofile.ForeignKeys[0]: // user_provider_profile
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -278,14 +400,95 @@ func (pp *ProviderProfile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pp.IsKybVerified = value.Bool
 			}
-		case providerprofile.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field fiat_currency_providers", values[i])
+		case providerprofile.FieldOperatingTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operating_timezone", values[i])
+			} else if value.Valid {
+				pp.OperatingTimezone = value.String
+			}
+		case providerprofile.FieldOperatingHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field operating_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.OperatingHours); err != nil {
+					return fmt.Errorf("unmarshal field operating_hours: %w", err)
+				}
+			}
+		case providerprofile.FieldOperatingHoursExceptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field operating_hours_exceptions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.OperatingHoursExceptions); err != nil {
+					return fmt.Errorf("unmarshal field operating_hours_exceptions: %w", err)
+				}
+			}
+		case providerprofile.FieldIsOpen:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_open", values[i])
+			} else if value.Valid {
+				pp.IsOpen = value.Bool
+			}
+		case providerprofile.FieldSupportedInstitutions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field supported_institutions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.SupportedInstitutions); err != nil {
+					return fmt.Errorf("unmarshal field supported_institutions: %w", err)
+				}
+			}
+		case providerprofile.FieldSupportedInstitutionTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field supported_institution_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.SupportedInstitutionTypes); err != nil {
+					return fmt.Errorf("unmarshal field supported_institution_types: %w", err)
+				}
+			}
+		case providerprofile.FieldIsHealthy:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_healthy", values[i])
+			} else if value.Valid {
+				pp.IsHealthy = value.Bool
+			}
+		case providerprofile.FieldHealthFailureStreak:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field health_failure_streak", values[i])
+			} else if value.Valid {
+				pp.HealthFailureStreak = int(value.Int64)
+			}
+		case providerprofile.FieldLastHealthCheckAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_health_check_at", values[i])
+			} else if value.Valid {
+				pp.LastHealthCheckAt = value.Time
+			}
+		case providerprofile.FieldNodeProtocolVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field node_protocol_version", values[i])
 			} else if value.Valid {
-				pp.fiat_currency_providers = new(uuid.UUID)
-				*pp.fiat_currency_providers = *value.S.(*uuid.UUID)
+				pp.NodeProtocolVersion = int(value.Int64)
 			}
-		case providerprofile.ForeignKeys[1]:
+		case providerprofile.FieldNodeCapabilities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field node_capabilities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pp.NodeCapabilities); err != nil {
+					return fmt.Errorf("unmarshal field node_capabilities: %w", err)
+				}
+			}
+		case providerprofile.FieldDemotedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field demoted_until", values[i])
+			} else if value.Valid {
+				pp.DemotedUntil = value.Time
+			}
+		case providerprofile.FieldSuspendedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_until", values[i])
+			} else if value.Valid {
+				pp.SuspendedUntil = value.Time
+			}
+		case providerprofile.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_provider_profile", values[i])
 			} else if value.Valid {
@@ -315,9 +518,9 @@ func (pp *ProviderProfile) QueryAPIKey() *APIKeyQuery {
 	return NewProviderProfileClient(pp.config).QueryAPIKey(pp)
 }
 
-// QueryCurrency queries the "currency" edge of the ProviderProfile entity.
-func (pp *ProviderProfile) QueryCurrency() *FiatCurrencyQuery {
-	return NewProviderProfileClient(pp.config).QueryCurrency(pp)
+// QueryCurrencies queries the "currencies" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryCurrencies() *FiatCurrencyQuery {
+	return NewProviderProfileClient(pp.config).QueryCurrencies(pp)
 }
 
 // QueryProvisionBuckets queries the "provision_buckets" edge of the ProviderProfile entity.
@@ -340,6 +543,46 @@ func (pp *ProviderProfile) QueryAssignedOrders() *LockPaymentOrderQuery {
 	return NewProviderProfileClient(pp.config).QueryAssignedOrders(pp)
 }
 
+// QueryTeamMembers queries the "team_members" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryTeamMembers() *TeamMemberQuery {
+	return NewProviderProfileClient(pp.config).QueryTeamMembers(pp)
+}
+
+// QueryTeamInvitations queries the "team_invitations" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryTeamInvitations() *TeamInvitationQuery {
+	return NewProviderProfileClient(pp.config).QueryTeamInvitations(pp)
+}
+
+// QueryTeamAuditLogs queries the "team_audit_logs" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryTeamAuditLogs() *TeamAuditLogQuery {
+	return NewProviderProfileClient(pp.config).QueryTeamAuditLogs(pp)
+}
+
+// QueryHealthChecks queries the "health_checks" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryHealthChecks() *ProviderHealthCheckQuery {
+	return NewProviderProfileClient(pp.config).QueryHealthChecks(pp)
+}
+
+// QuerySLARecords queries the "sla_records" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QuerySLARecords() *ProviderSLARecordQuery {
+	return NewProviderProfileClient(pp.config).QuerySLARecords(pp)
+}
+
+// QueryDisputes queries the "disputes" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryDisputes() *DisputeQuery {
+	return NewProviderProfileClient(pp.config).QueryDisputes(pp)
+}
+
+// QueryOrderAssignments queries the "order_assignments" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryOrderAssignments() *OrderAssignmentQuery {
+	return NewProviderProfileClient(pp.config).QueryOrderAssignments(pp)
+}
+
+// QueryRateSnapshots queries the "rate_snapshots" edge of the ProviderProfile entity.
+func (pp *ProviderProfile) QueryRateSnapshots() *RateSnapshotQuery {
+	return NewProviderProfileClient(pp.config).QueryRateSnapshots(pp)
+}
+
 // Update returns a builder for updating this ProviderProfile.
 // Note that you need to call ProviderProfile.Unwrap() before calling this method if this ProviderProfile
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -407,6 +650,45 @@ func (pp *ProviderProfile) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_kyb_verified=")
 	builder.WriteString(fmt.Sprintf("%v", pp.IsKybVerified))
+	builder.WriteString(", ")
+	builder.WriteString("operating_timezone=")
+	builder.WriteString(pp.OperatingTimezone)
+	builder.WriteString(", ")
+	builder.WriteString("operating_hours=")
+	builder.WriteString(fmt.Sprintf("%v", pp.OperatingHours))
+	builder.WriteString(", ")
+	builder.WriteString("operating_hours_exceptions=")
+	builder.WriteString(fmt.Sprintf("%v", pp.OperatingHoursExceptions))
+	builder.WriteString(", ")
+	builder.WriteString("is_open=")
+	builder.WriteString(fmt.Sprintf("%v", pp.IsOpen))
+	builder.WriteString(", ")
+	builder.WriteString("supported_institutions=")
+	builder.WriteString(fmt.Sprintf("%v", pp.SupportedInstitutions))
+	builder.WriteString(", ")
+	builder.WriteString("supported_institution_types=")
+	builder.WriteString(fmt.Sprintf("%v", pp.SupportedInstitutionTypes))
+	builder.WriteString(", ")
+	builder.WriteString("is_healthy=")
+	builder.WriteString(fmt.Sprintf("%v", pp.IsHealthy))
+	builder.WriteString(", ")
+	builder.WriteString("health_failure_streak=")
+	builder.WriteString(fmt.Sprintf("%v", pp.HealthFailureStreak))
+	builder.WriteString(", ")
+	builder.WriteString("last_health_check_at=")
+	builder.WriteString(pp.LastHealthCheckAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("node_protocol_version=")
+	builder.WriteString(fmt.Sprintf("%v", pp.NodeProtocolVersion))
+	builder.WriteString(", ")
+	builder.WriteString("node_capabilities=")
+	builder.WriteString(fmt.Sprintf("%v", pp.NodeCapabilities))
+	builder.WriteString(", ")
+	builder.WriteString("demoted_until=")
+	builder.WriteString(pp.DemotedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("suspended_until=")
+	builder.WriteString(pp.SuspendedUntil.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -45,12 +45,38 @@ const (
 	FieldBusinessDocument = "business_document"
 	// FieldIsKybVerified holds the string denoting the is_kyb_verified field in the database.
 	FieldIsKybVerified = "is_kyb_verified"
+	// FieldOperatingTimezone holds the string denoting the operating_timezone field in the database.
+	FieldOperatingTimezone = "operating_timezone"
+	// FieldOperatingHours holds the string denoting the operating_hours field in the database.
+	FieldOperatingHours = "operating_hours"
+	// FieldOperatingHoursExceptions holds the string denoting the operating_hours_exceptions field in the database.
+	FieldOperatingHoursExceptions = "operating_hours_exceptions"
+	// FieldIsOpen holds the string denoting the is_open field in the database.
+	FieldIsOpen = "is_open"
+	// FieldSupportedInstitutions holds the string denoting the supported_institutions field in the database.
+	FieldSupportedInstitutions = "supported_institutions"
+	// FieldSupportedInstitutionTypes holds the string denoting the supported_institution_types field in the database.
+	FieldSupportedInstitutionTypes = "supported_institution_types"
+	// FieldIsHealthy holds the string denoting the is_healthy field in the database.
+	FieldIsHealthy = "is_healthy"
+	// FieldHealthFailureStreak holds the string denoting the health_failure_streak field in the database.
+	FieldHealthFailureStreak = "health_failure_streak"
+	// FieldLastHealthCheckAt holds the string denoting the last_health_check_at field in the database.
+	FieldLastHealthCheckAt = "last_health_check_at"
+	// FieldNodeProtocolVersion holds the string denoting the node_protocol_version field in the database.
+	FieldNodeProtocolVersion = "node_protocol_version"
+	// FieldNodeCapabilities holds the string denoting the node_capabilities field in the database.
+	FieldNodeCapabilities = "node_capabilities"
+	// FieldDemotedUntil holds the string denoting the demoted_until field in the database.
+	FieldDemotedUntil = "demoted_until"
+	// FieldSuspendedUntil holds the string denoting the suspended_until field in the database.
+	FieldSuspendedUntil = "suspended_until"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeAPIKey holds the string denoting the api_key edge name in mutations.
 	EdgeAPIKey = "api_key"
-	// EdgeCurrency holds the string denoting the currency edge name in mutations.
-	EdgeCurrency = "currency"
+	// EdgeCurrencies holds the string denoting the currencies edge name in mutations.
+	EdgeCurrencies = "currencies"
 	// EdgeProvisionBuckets holds the string denoting the provision_buckets edge name in mutations.
 	EdgeProvisionBuckets = "provision_buckets"
 	// EdgeOrderTokens holds the string denoting the order_tokens edge name in mutations.
@@ -59,6 +85,22 @@ const (
 	EdgeProviderRating = "provider_rating"
 	// EdgeAssignedOrders holds the string denoting the assigned_orders edge name in mutations.
 	EdgeAssignedOrders = "assigned_orders"
+	// EdgeTeamMembers holds the string denoting the team_members edge name in mutations.
+	EdgeTeamMembers = "team_members"
+	// EdgeTeamInvitations holds the string denoting the team_invitations edge name in mutations.
+	EdgeTeamInvitations = "team_invitations"
+	// EdgeTeamAuditLogs holds the string denoting the team_audit_logs edge name in mutations.
+	EdgeTeamAuditLogs = "team_audit_logs"
+	// EdgeHealthChecks holds the string denoting the health_checks edge name in mutations.
+	EdgeHealthChecks = "health_checks"
+	// EdgeSLARecords holds the string denoting the sla_records edge name in mutations.
+	EdgeSLARecords = "sla_records"
+	// EdgeDisputes holds the string denoting the disputes edge name in mutations.
+	EdgeDisputes = "disputes"
+	// EdgeOrderAssignments holds the string denoting the order_assignments edge name in mutations.
+	EdgeOrderAssignments = "order_assignments"
+	// EdgeRateSnapshots holds the string denoting the rate_snapshots edge name in mutations.
+	EdgeRateSnapshots = "rate_snapshots"
 	// Table holds the table name of the providerprofile in the database.
 	Table = "provider_profiles"
 	// UserTable is the table that holds the user relation/edge.
@@ -75,13 +117,11 @@ const (
 	APIKeyInverseTable = "api_keys"
 	// APIKeyColumn is the table column denoting the api_key relation/edge.
 	APIKeyColumn = "provider_profile_api_key"
-	// CurrencyTable is the table that holds the currency relation/edge.
-	CurrencyTable = "provider_profiles"
-	// CurrencyInverseTable is the table name for the FiatCurrency entity.
+	// CurrenciesTable is the table that holds the currencies relation/edge. The primary key declared below.
+	CurrenciesTable = "fiat_currency_providers"
+	// CurrenciesInverseTable is the table name for the FiatCurrency entity.
 	// It exists in this package in order to avoid circular dependency with the "fiatcurrency" package.
-	CurrencyInverseTable = "fiat_currencies"
-	// CurrencyColumn is the table column denoting the currency relation/edge.
-	CurrencyColumn = "fiat_currency_providers"
+	CurrenciesInverseTable = "fiat_currencies"
 	// ProvisionBucketsTable is the table that holds the provision_buckets relation/edge. The primary key declared below.
 	ProvisionBucketsTable = "provision_bucket_provider_profiles"
 	// ProvisionBucketsInverseTable is the table name for the ProvisionBucket entity.
@@ -108,6 +148,62 @@ const (
 	AssignedOrdersInverseTable = "lock_payment_orders"
 	// AssignedOrdersColumn is the table column denoting the assigned_orders relation/edge.
 	AssignedOrdersColumn = "provider_profile_assigned_orders"
+	// TeamMembersTable is the table that holds the team_members relation/edge.
+	TeamMembersTable = "team_members"
+	// TeamMembersInverseTable is the table name for the TeamMember entity.
+	// It exists in this package in order to avoid circular dependency with the "teammember" package.
+	TeamMembersInverseTable = "team_members"
+	// TeamMembersColumn is the table column denoting the team_members relation/edge.
+	TeamMembersColumn = "provider_profile_team_members"
+	// TeamInvitationsTable is the table that holds the team_invitations relation/edge.
+	TeamInvitationsTable = "team_invitations"
+	// TeamInvitationsInverseTable is the table name for the TeamInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "teaminvitation" package.
+	TeamInvitationsInverseTable = "team_invitations"
+	// TeamInvitationsColumn is the table column denoting the team_invitations relation/edge.
+	TeamInvitationsColumn = "provider_profile_team_invitations"
+	// TeamAuditLogsTable is the table that holds the team_audit_logs relation/edge.
+	TeamAuditLogsTable = "team_audit_logs"
+	// TeamAuditLogsInverseTable is the table name for the TeamAuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "teamauditlog" package.
+	TeamAuditLogsInverseTable = "team_audit_logs"
+	// TeamAuditLogsColumn is the table column denoting the team_audit_logs relation/edge.
+	TeamAuditLogsColumn = "provider_profile_team_audit_logs"
+	// HealthChecksTable is the table that holds the health_checks relation/edge.
+	HealthChecksTable = "provider_health_checks"
+	// HealthChecksInverseTable is the table name for the ProviderHealthCheck entity.
+	// It exists in this package in order to avoid circular dependency with the "providerhealthcheck" package.
+	HealthChecksInverseTable = "provider_health_checks"
+	// HealthChecksColumn is the table column denoting the health_checks relation/edge.
+	HealthChecksColumn = "provider_profile_health_checks"
+	// SLARecordsTable is the table that holds the sla_records relation/edge.
+	SLARecordsTable = "provider_sla_records"
+	// SLARecordsInverseTable is the table name for the ProviderSLARecord entity.
+	// It exists in this package in order to avoid circular dependency with the "providerslarecord" package.
+	SLARecordsInverseTable = "provider_sla_records"
+	// SLARecordsColumn is the table column denoting the sla_records relation/edge.
+	SLARecordsColumn = "provider_profile_sla_records"
+	// DisputesTable is the table that holds the disputes relation/edge.
+	DisputesTable = "disputes"
+	// DisputesInverseTable is the table name for the Dispute entity.
+	// It exists in this package in order to avoid circular dependency with the "dispute" package.
+	DisputesInverseTable = "disputes"
+	// DisputesColumn is the table column denoting the disputes relation/edge.
+	DisputesColumn = "provider_profile_disputes"
+	// OrderAssignmentsTable is the table that holds the order_assignments relation/edge.
+	OrderAssignmentsTable = "order_assignments"
+	// OrderAssignmentsInverseTable is the table name for the OrderAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "orderassignment" package.
+	OrderAssignmentsInverseTable = "order_assignments"
+	// OrderAssignmentsColumn is the table column denoting the order_assignments relation/edge.
+	OrderAssignmentsColumn = "provider_profile_order_assignments"
+	// RateSnapshotsTable is the table that holds the rate_snapshots relation/edge.
+	RateSnapshotsTable = "rate_snapshots"
+	// RateSnapshotsInverseTable is the table name for the RateSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "ratesnapshot" package.
+	RateSnapshotsInverseTable = "rate_snapshots"
+	// RateSnapshotsColumn is the table column denoting the rate_snapshots relation/edge.
+	RateSnapshotsColumn = "provider_profile_rate_snapshots"
 )
 
 // Columns holds all SQL columns for providerprofile fields.
@@ -128,16 +224,31 @@ var Columns = []string{
 	FieldIdentityDocument,
 	FieldBusinessDocument,
 	FieldIsKybVerified,
+	FieldOperatingTimezone,
+	FieldOperatingHours,
+	FieldOperatingHoursExceptions,
+	FieldIsOpen,
+	FieldSupportedInstitutions,
+	FieldSupportedInstitutionTypes,
+	FieldIsHealthy,
+	FieldHealthFailureStreak,
+	FieldLastHealthCheckAt,
+	FieldNodeProtocolVersion,
+	FieldNodeCapabilities,
+	FieldDemotedUntil,
+	FieldSuspendedUntil,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provider_profiles"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
-	"fiat_currency_providers",
 	"user_provider_profile",
 }
 
 var (
+	// CurrenciesPrimaryKey and CurrenciesColumn2 are the table columns denoting the
+	// primary key for the currencies relation (M2M).
+	CurrenciesPrimaryKey = []string{"fiat_currency_id", "provider_profile_id"}
 	// ProvisionBucketsPrimaryKey and ProvisionBucketsColumn2 are the table columns denoting the
 	// primary key for the provision_buckets relation (M2M).
 	ProvisionBucketsPrimaryKey = []string{"provision_bucket_id", "provider_profile_id"}
@@ -171,6 +282,14 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultIsKybVerified holds the default value on creation for the "is_kyb_verified" field.
 	DefaultIsKybVerified bool
+	// DefaultIsOpen holds the default value on creation for the "is_open" field.
+	DefaultIsOpen bool
+	// DefaultIsHealthy holds the default value on creation for the "is_healthy" field.
+	DefaultIsHealthy bool
+	// DefaultHealthFailureStreak holds the default value on creation for the "health_failure_streak" field.
+	DefaultHealthFailureStreak int
+	// DefaultNodeProtocolVersion holds the default value on creation for the "node_protocol_version" field.
+	DefaultNodeProtocolVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -334,6 +453,46 @@ func ByIsKybVerified(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsKybVerified, opts...).ToFunc()
 }
 
+// ByOperatingTimezone orders the results by the operating_timezone field.
+func ByOperatingTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatingTimezone, opts...).ToFunc()
+}
+
+// ByIsOpen orders the results by the is_open field.
+func ByIsOpen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsOpen, opts...).ToFunc()
+}
+
+// ByIsHealthy orders the results by the is_healthy field.
+func ByIsHealthy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsHealthy, opts...).ToFunc()
+}
+
+// ByHealthFailureStreak orders the results by the health_failure_streak field.
+func ByHealthFailureStreak(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealthFailureStreak, opts...).ToFunc()
+}
+
+// ByLastHealthCheckAt orders the results by the last_health_check_at field.
+func ByLastHealthCheckAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHealthCheckAt, opts...).ToFunc()
+}
+
+// ByNodeProtocolVersion orders the results by the node_protocol_version field.
+func ByNodeProtocolVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNodeProtocolVersion, opts...).ToFunc()
+}
+
+// ByDemotedUntil orders the results by the demoted_until field.
+func ByDemotedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDemotedUntil, opts...).ToFunc()
+}
+
+// BySuspendedUntil orders the results by the suspended_until field.
+func BySuspendedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedUntil, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -348,10 +507,17 @@ func ByAPIKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
-// ByCurrencyField orders the results by currency field.
-func ByCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByCurrenciesCount orders the results by currencies count.
+func ByCurrenciesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newCurrencyStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborsCount(s, newCurrenciesStep(), opts...)
+	}
+}
+
+// ByCurrencies orders the results by currencies terms.
+func ByCurrencies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrenciesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -403,6 +569,118 @@ func ByAssignedOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAssignedOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTeamMembersCount orders the results by team_members count.
+func ByTeamMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamMembersStep(), opts...)
+	}
+}
+
+// ByTeamMembers orders the results by team_members terms.
+func ByTeamMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTeamInvitationsCount orders the results by team_invitations count.
+func ByTeamInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamInvitationsStep(), opts...)
+	}
+}
+
+// ByTeamInvitations orders the results by team_invitations terms.
+func ByTeamInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTeamAuditLogsCount orders the results by team_audit_logs count.
+func ByTeamAuditLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamAuditLogsStep(), opts...)
+	}
+}
+
+// ByTeamAuditLogs orders the results by team_audit_logs terms.
+func ByTeamAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHealthChecksCount orders the results by health_checks count.
+func ByHealthChecksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHealthChecksStep(), opts...)
+	}
+}
+
+// ByHealthChecks orders the results by health_checks terms.
+func ByHealthChecks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHealthChecksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySLARecordsCount orders the results by sla_records count.
+func BySLARecordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSLARecordsStep(), opts...)
+	}
+}
+
+// BySLARecords orders the results by sla_records terms.
+func BySLARecords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSLARecordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDisputesCount orders the results by disputes count.
+func ByDisputesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDisputesStep(), opts...)
+	}
+}
+
+// ByDisputes orders the results by disputes terms.
+func ByDisputes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDisputesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOrderAssignmentsCount orders the results by order_assignments count.
+func ByOrderAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrderAssignmentsStep(), opts...)
+	}
+}
+
+// ByOrderAssignments orders the results by order_assignments terms.
+func ByOrderAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRateSnapshotsCount orders the results by rate_snapshots count.
+func ByRateSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRateSnapshotsStep(), opts...)
+	}
+}
+
+// ByRateSnapshots orders the results by rate_snapshots terms.
+func ByRateSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRateSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -417,11 +695,11 @@ func newAPIKeyStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, APIKeyTable, APIKeyColumn),
 	)
 }
-func newCurrencyStep() *sqlgraph.Step {
+func newCurrenciesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(CurrencyInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+		sqlgraph.To(CurrenciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, CurrenciesTable, CurrenciesPrimaryKey...),
 	)
 }
 func newProvisionBucketsStep() *sqlgraph.Step {
@@ -452,3 +730,59 @@ func newAssignedOrdersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AssignedOrdersTable, AssignedOrdersColumn),
 	)
 }
+func newTeamMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamMembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
+	)
+}
+func newTeamInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamInvitationsTable, TeamInvitationsColumn),
+	)
+}
+func newTeamAuditLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamAuditLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamAuditLogsTable, TeamAuditLogsColumn),
+	)
+}
+func newHealthChecksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HealthChecksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HealthChecksTable, HealthChecksColumn),
+	)
+}
+func newSLARecordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SLARecordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SLARecordsTable, SLARecordsColumn),
+	)
+}
+func newDisputesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DisputesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+	)
+}
+func newOrderAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrderAssignmentsTable, OrderAssignmentsColumn),
+	)
+}
+func newRateSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RateSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RateSnapshotsTable, RateSnapshotsColumn),
+	)
+}
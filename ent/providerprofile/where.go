@@ -125,6 +125,46 @@ func IsKybVerified(v bool) predicate.ProviderProfile {
 	return predicate.ProviderProfile(sql.FieldEQ(FieldIsKybVerified, v))
 }
 
+// OperatingTimezone applies equality check predicate on the "operating_timezone" field. It's identical to OperatingTimezoneEQ.
+func OperatingTimezone(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldOperatingTimezone, v))
+}
+
+// IsOpen applies equality check predicate on the "is_open" field. It's identical to IsOpenEQ.
+func IsOpen(v bool) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldIsOpen, v))
+}
+
+// IsHealthy applies equality check predicate on the "is_healthy" field. It's identical to IsHealthyEQ.
+func IsHealthy(v bool) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldIsHealthy, v))
+}
+
+// HealthFailureStreak applies equality check predicate on the "health_failure_streak" field. It's identical to HealthFailureStreakEQ.
+func HealthFailureStreak(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldHealthFailureStreak, v))
+}
+
+// LastHealthCheckAt applies equality check predicate on the "last_health_check_at" field. It's identical to LastHealthCheckAtEQ.
+func LastHealthCheckAt(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldLastHealthCheckAt, v))
+}
+
+// NodeProtocolVersion applies equality check predicate on the "node_protocol_version" field. It's identical to NodeProtocolVersionEQ.
+func NodeProtocolVersion(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldNodeProtocolVersion, v))
+}
+
+// DemotedUntil applies equality check predicate on the "demoted_until" field. It's identical to DemotedUntilEQ.
+func DemotedUntil(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldDemotedUntil, v))
+}
+
+// SuspendedUntil applies equality check predicate on the "suspended_until" field. It's identical to SuspendedUntilEQ.
+func SuspendedUntil(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldSuspendedUntil, v))
+}
+
 // TradingNameEQ applies the EQ predicate on the "trading_name" field.
 func TradingNameEQ(v string) predicate.ProviderProfile {
 	return predicate.ProviderProfile(sql.FieldEQ(FieldTradingName, v))
@@ -840,6 +880,381 @@ func IsKybVerifiedNEQ(v bool) predicate.ProviderProfile {
 	return predicate.ProviderProfile(sql.FieldNEQ(FieldIsKybVerified, v))
 }
 
+// OperatingTimezoneEQ applies the EQ predicate on the "operating_timezone" field.
+func OperatingTimezoneEQ(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneNEQ applies the NEQ predicate on the "operating_timezone" field.
+func OperatingTimezoneNEQ(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneIn applies the In predicate on the "operating_timezone" field.
+func OperatingTimezoneIn(vs ...string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIn(FieldOperatingTimezone, vs...))
+}
+
+// OperatingTimezoneNotIn applies the NotIn predicate on the "operating_timezone" field.
+func OperatingTimezoneNotIn(vs ...string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotIn(FieldOperatingTimezone, vs...))
+}
+
+// OperatingTimezoneGT applies the GT predicate on the "operating_timezone" field.
+func OperatingTimezoneGT(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGT(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneGTE applies the GTE predicate on the "operating_timezone" field.
+func OperatingTimezoneGTE(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGTE(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneLT applies the LT predicate on the "operating_timezone" field.
+func OperatingTimezoneLT(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLT(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneLTE applies the LTE predicate on the "operating_timezone" field.
+func OperatingTimezoneLTE(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLTE(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneContains applies the Contains predicate on the "operating_timezone" field.
+func OperatingTimezoneContains(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldContains(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneHasPrefix applies the HasPrefix predicate on the "operating_timezone" field.
+func OperatingTimezoneHasPrefix(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldHasPrefix(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneHasSuffix applies the HasSuffix predicate on the "operating_timezone" field.
+func OperatingTimezoneHasSuffix(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldHasSuffix(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneIsNil applies the IsNil predicate on the "operating_timezone" field.
+func OperatingTimezoneIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldOperatingTimezone))
+}
+
+// OperatingTimezoneNotNil applies the NotNil predicate on the "operating_timezone" field.
+func OperatingTimezoneNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldOperatingTimezone))
+}
+
+// OperatingTimezoneEqualFold applies the EqualFold predicate on the "operating_timezone" field.
+func OperatingTimezoneEqualFold(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEqualFold(FieldOperatingTimezone, v))
+}
+
+// OperatingTimezoneContainsFold applies the ContainsFold predicate on the "operating_timezone" field.
+func OperatingTimezoneContainsFold(v string) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldContainsFold(FieldOperatingTimezone, v))
+}
+
+// OperatingHoursIsNil applies the IsNil predicate on the "operating_hours" field.
+func OperatingHoursIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldOperatingHours))
+}
+
+// OperatingHoursNotNil applies the NotNil predicate on the "operating_hours" field.
+func OperatingHoursNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldOperatingHours))
+}
+
+// OperatingHoursExceptionsIsNil applies the IsNil predicate on the "operating_hours_exceptions" field.
+func OperatingHoursExceptionsIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldOperatingHoursExceptions))
+}
+
+// OperatingHoursExceptionsNotNil applies the NotNil predicate on the "operating_hours_exceptions" field.
+func OperatingHoursExceptionsNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldOperatingHoursExceptions))
+}
+
+// IsOpenEQ applies the EQ predicate on the "is_open" field.
+func IsOpenEQ(v bool) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldIsOpen, v))
+}
+
+// IsOpenNEQ applies the NEQ predicate on the "is_open" field.
+func IsOpenNEQ(v bool) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldIsOpen, v))
+}
+
+// SupportedInstitutionsIsNil applies the IsNil predicate on the "supported_institutions" field.
+func SupportedInstitutionsIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldSupportedInstitutions))
+}
+
+// SupportedInstitutionsNotNil applies the NotNil predicate on the "supported_institutions" field.
+func SupportedInstitutionsNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldSupportedInstitutions))
+}
+
+// SupportedInstitutionTypesIsNil applies the IsNil predicate on the "supported_institution_types" field.
+func SupportedInstitutionTypesIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldSupportedInstitutionTypes))
+}
+
+// SupportedInstitutionTypesNotNil applies the NotNil predicate on the "supported_institution_types" field.
+func SupportedInstitutionTypesNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldSupportedInstitutionTypes))
+}
+
+// IsHealthyEQ applies the EQ predicate on the "is_healthy" field.
+func IsHealthyEQ(v bool) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldIsHealthy, v))
+}
+
+// IsHealthyNEQ applies the NEQ predicate on the "is_healthy" field.
+func IsHealthyNEQ(v bool) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldIsHealthy, v))
+}
+
+// HealthFailureStreakEQ applies the EQ predicate on the "health_failure_streak" field.
+func HealthFailureStreakEQ(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldHealthFailureStreak, v))
+}
+
+// HealthFailureStreakNEQ applies the NEQ predicate on the "health_failure_streak" field.
+func HealthFailureStreakNEQ(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldHealthFailureStreak, v))
+}
+
+// HealthFailureStreakIn applies the In predicate on the "health_failure_streak" field.
+func HealthFailureStreakIn(vs ...int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIn(FieldHealthFailureStreak, vs...))
+}
+
+// HealthFailureStreakNotIn applies the NotIn predicate on the "health_failure_streak" field.
+func HealthFailureStreakNotIn(vs ...int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotIn(FieldHealthFailureStreak, vs...))
+}
+
+// HealthFailureStreakGT applies the GT predicate on the "health_failure_streak" field.
+func HealthFailureStreakGT(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGT(FieldHealthFailureStreak, v))
+}
+
+// HealthFailureStreakGTE applies the GTE predicate on the "health_failure_streak" field.
+func HealthFailureStreakGTE(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGTE(FieldHealthFailureStreak, v))
+}
+
+// HealthFailureStreakLT applies the LT predicate on the "health_failure_streak" field.
+func HealthFailureStreakLT(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLT(FieldHealthFailureStreak, v))
+}
+
+// HealthFailureStreakLTE applies the LTE predicate on the "health_failure_streak" field.
+func HealthFailureStreakLTE(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLTE(FieldHealthFailureStreak, v))
+}
+
+// LastHealthCheckAtEQ applies the EQ predicate on the "last_health_check_at" field.
+func LastHealthCheckAtEQ(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtNEQ applies the NEQ predicate on the "last_health_check_at" field.
+func LastHealthCheckAtNEQ(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtIn applies the In predicate on the "last_health_check_at" field.
+func LastHealthCheckAtIn(vs ...time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIn(FieldLastHealthCheckAt, vs...))
+}
+
+// LastHealthCheckAtNotIn applies the NotIn predicate on the "last_health_check_at" field.
+func LastHealthCheckAtNotIn(vs ...time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotIn(FieldLastHealthCheckAt, vs...))
+}
+
+// LastHealthCheckAtGT applies the GT predicate on the "last_health_check_at" field.
+func LastHealthCheckAtGT(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGT(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtGTE applies the GTE predicate on the "last_health_check_at" field.
+func LastHealthCheckAtGTE(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGTE(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtLT applies the LT predicate on the "last_health_check_at" field.
+func LastHealthCheckAtLT(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLT(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtLTE applies the LTE predicate on the "last_health_check_at" field.
+func LastHealthCheckAtLTE(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLTE(FieldLastHealthCheckAt, v))
+}
+
+// LastHealthCheckAtIsNil applies the IsNil predicate on the "last_health_check_at" field.
+func LastHealthCheckAtIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldLastHealthCheckAt))
+}
+
+// LastHealthCheckAtNotNil applies the NotNil predicate on the "last_health_check_at" field.
+func LastHealthCheckAtNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldLastHealthCheckAt))
+}
+
+// NodeProtocolVersionEQ applies the EQ predicate on the "node_protocol_version" field.
+func NodeProtocolVersionEQ(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldNodeProtocolVersion, v))
+}
+
+// NodeProtocolVersionNEQ applies the NEQ predicate on the "node_protocol_version" field.
+func NodeProtocolVersionNEQ(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldNodeProtocolVersion, v))
+}
+
+// NodeProtocolVersionIn applies the In predicate on the "node_protocol_version" field.
+func NodeProtocolVersionIn(vs ...int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIn(FieldNodeProtocolVersion, vs...))
+}
+
+// NodeProtocolVersionNotIn applies the NotIn predicate on the "node_protocol_version" field.
+func NodeProtocolVersionNotIn(vs ...int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotIn(FieldNodeProtocolVersion, vs...))
+}
+
+// NodeProtocolVersionGT applies the GT predicate on the "node_protocol_version" field.
+func NodeProtocolVersionGT(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGT(FieldNodeProtocolVersion, v))
+}
+
+// NodeProtocolVersionGTE applies the GTE predicate on the "node_protocol_version" field.
+func NodeProtocolVersionGTE(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGTE(FieldNodeProtocolVersion, v))
+}
+
+// NodeProtocolVersionLT applies the LT predicate on the "node_protocol_version" field.
+func NodeProtocolVersionLT(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLT(FieldNodeProtocolVersion, v))
+}
+
+// NodeProtocolVersionLTE applies the LTE predicate on the "node_protocol_version" field.
+func NodeProtocolVersionLTE(v int) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLTE(FieldNodeProtocolVersion, v))
+}
+
+// NodeCapabilitiesIsNil applies the IsNil predicate on the "node_capabilities" field.
+func NodeCapabilitiesIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldNodeCapabilities))
+}
+
+// NodeCapabilitiesNotNil applies the NotNil predicate on the "node_capabilities" field.
+func NodeCapabilitiesNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldNodeCapabilities))
+}
+
+// DemotedUntilEQ applies the EQ predicate on the "demoted_until" field.
+func DemotedUntilEQ(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldDemotedUntil, v))
+}
+
+// DemotedUntilNEQ applies the NEQ predicate on the "demoted_until" field.
+func DemotedUntilNEQ(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldDemotedUntil, v))
+}
+
+// DemotedUntilIn applies the In predicate on the "demoted_until" field.
+func DemotedUntilIn(vs ...time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIn(FieldDemotedUntil, vs...))
+}
+
+// DemotedUntilNotIn applies the NotIn predicate on the "demoted_until" field.
+func DemotedUntilNotIn(vs ...time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotIn(FieldDemotedUntil, vs...))
+}
+
+// DemotedUntilGT applies the GT predicate on the "demoted_until" field.
+func DemotedUntilGT(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGT(FieldDemotedUntil, v))
+}
+
+// DemotedUntilGTE applies the GTE predicate on the "demoted_until" field.
+func DemotedUntilGTE(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGTE(FieldDemotedUntil, v))
+}
+
+// DemotedUntilLT applies the LT predicate on the "demoted_until" field.
+func DemotedUntilLT(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLT(FieldDemotedUntil, v))
+}
+
+// DemotedUntilLTE applies the LTE predicate on the "demoted_until" field.
+func DemotedUntilLTE(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLTE(FieldDemotedUntil, v))
+}
+
+// DemotedUntilIsNil applies the IsNil predicate on the "demoted_until" field.
+func DemotedUntilIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldDemotedUntil))
+}
+
+// DemotedUntilNotNil applies the NotNil predicate on the "demoted_until" field.
+func DemotedUntilNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldDemotedUntil))
+}
+
+// SuspendedUntilEQ applies the EQ predicate on the "suspended_until" field.
+func SuspendedUntilEQ(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilNEQ applies the NEQ predicate on the "suspended_until" field.
+func SuspendedUntilNEQ(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNEQ(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilIn applies the In predicate on the "suspended_until" field.
+func SuspendedUntilIn(vs ...time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIn(FieldSuspendedUntil, vs...))
+}
+
+// SuspendedUntilNotIn applies the NotIn predicate on the "suspended_until" field.
+func SuspendedUntilNotIn(vs ...time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotIn(FieldSuspendedUntil, vs...))
+}
+
+// SuspendedUntilGT applies the GT predicate on the "suspended_until" field.
+func SuspendedUntilGT(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGT(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilGTE applies the GTE predicate on the "suspended_until" field.
+func SuspendedUntilGTE(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldGTE(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilLT applies the LT predicate on the "suspended_until" field.
+func SuspendedUntilLT(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLT(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilLTE applies the LTE predicate on the "suspended_until" field.
+func SuspendedUntilLTE(v time.Time) predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldLTE(FieldSuspendedUntil, v))
+}
+
+// SuspendedUntilIsNil applies the IsNil predicate on the "suspended_until" field.
+func SuspendedUntilIsNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldIsNull(FieldSuspendedUntil))
+}
+
+// SuspendedUntilNotNil applies the NotNil predicate on the "suspended_until" field.
+func SuspendedUntilNotNil() predicate.ProviderProfile {
+	return predicate.ProviderProfile(sql.FieldNotNull(FieldSuspendedUntil))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.ProviderProfile {
 	return predicate.ProviderProfile(func(s *sql.Selector) {
@@ -886,21 +1301,21 @@ func HasAPIKeyWith(preds ...predicate.APIKey) predicate.ProviderProfile {
 	})
 }
 
-// HasCurrency applies the HasEdge predicate on the "currency" edge.
-func HasCurrency() predicate.ProviderProfile {
+// HasCurrencies applies the HasEdge predicate on the "currencies" edge.
+func HasCurrencies() predicate.ProviderProfile {
 	return predicate.ProviderProfile(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, CurrenciesTable, CurrenciesPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
-func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.ProviderProfile {
+// HasCurrenciesWith applies the HasEdge predicate on the "currencies" edge with a given conditions (other predicates).
+func HasCurrenciesWith(preds ...predicate.FiatCurrency) predicate.ProviderProfile {
 	return predicate.ProviderProfile(func(s *sql.Selector) {
-		step := newCurrencyStep()
+		step := newCurrenciesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -1001,6 +1416,190 @@ func HasAssignedOrdersWith(preds ...predicate.LockPaymentOrder) predicate.Provid
 	})
 }
 
+// HasTeamMembers applies the HasEdge predicate on the "team_members" edge.
+func HasTeamMembers() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamMembersWith applies the HasEdge predicate on the "team_members" edge with a given conditions (other predicates).
+func HasTeamMembersWith(preds ...predicate.TeamMember) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newTeamMembersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeamInvitations applies the HasEdge predicate on the "team_invitations" edge.
+func HasTeamInvitations() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamInvitationsTable, TeamInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamInvitationsWith applies the HasEdge predicate on the "team_invitations" edge with a given conditions (other predicates).
+func HasTeamInvitationsWith(preds ...predicate.TeamInvitation) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newTeamInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeamAuditLogs applies the HasEdge predicate on the "team_audit_logs" edge.
+func HasTeamAuditLogs() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamAuditLogsTable, TeamAuditLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamAuditLogsWith applies the HasEdge predicate on the "team_audit_logs" edge with a given conditions (other predicates).
+func HasTeamAuditLogsWith(preds ...predicate.TeamAuditLog) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newTeamAuditLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHealthChecks applies the HasEdge predicate on the "health_checks" edge.
+func HasHealthChecks() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HealthChecksTable, HealthChecksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHealthChecksWith applies the HasEdge predicate on the "health_checks" edge with a given conditions (other predicates).
+func HasHealthChecksWith(preds ...predicate.ProviderHealthCheck) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newHealthChecksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSLARecords applies the HasEdge predicate on the "sla_records" edge.
+func HasSLARecords() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SLARecordsTable, SLARecordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSLARecordsWith applies the HasEdge predicate on the "sla_records" edge with a given conditions (other predicates).
+func HasSLARecordsWith(preds ...predicate.ProviderSLARecord) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newSLARecordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDisputes applies the HasEdge predicate on the "disputes" edge.
+func HasDisputes() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DisputesTable, DisputesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDisputesWith applies the HasEdge predicate on the "disputes" edge with a given conditions (other predicates).
+func HasDisputesWith(preds ...predicate.Dispute) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newDisputesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderAssignments applies the HasEdge predicate on the "order_assignments" edge.
+func HasOrderAssignments() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderAssignmentsTable, OrderAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderAssignmentsWith applies the HasEdge predicate on the "order_assignments" edge with a given conditions (other predicates).
+func HasOrderAssignmentsWith(preds ...predicate.OrderAssignment) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newOrderAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRateSnapshots applies the HasEdge predicate on the "rate_snapshots" edge.
+func HasRateSnapshots() predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RateSnapshotsTable, RateSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRateSnapshotsWith applies the HasEdge predicate on the "rate_snapshots" edge with a given conditions (other predicates).
+func HasRateSnapshotsWith(preds ...predicate.RateSnapshot) predicate.ProviderProfile {
+	return predicate.ProviderProfile(func(s *sql.Selector) {
+		step := newRateSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProviderProfile) predicate.ProviderProfile {
 	return predicate.ProviderProfile(sql.AndPredicates(predicates...))
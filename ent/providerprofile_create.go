@@ -14,12 +14,20 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
@@ -241,6 +249,157 @@ func (ppc *ProviderProfileCreate) SetNillableIsKybVerified(b *bool) *ProviderPro
 	return ppc
 }
 
+// SetOperatingTimezone sets the "operating_timezone" field.
+func (ppc *ProviderProfileCreate) SetOperatingTimezone(s string) *ProviderProfileCreate {
+	ppc.mutation.SetOperatingTimezone(s)
+	return ppc
+}
+
+// SetNillableOperatingTimezone sets the "operating_timezone" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableOperatingTimezone(s *string) *ProviderProfileCreate {
+	if s != nil {
+		ppc.SetOperatingTimezone(*s)
+	}
+	return ppc
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (ppc *ProviderProfileCreate) SetOperatingHours(s []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileCreate {
+	ppc.mutation.SetOperatingHours(s)
+	return ppc
+}
+
+// SetOperatingHoursExceptions sets the "operating_hours_exceptions" field.
+func (ppc *ProviderProfileCreate) SetOperatingHoursExceptions(s []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileCreate {
+	ppc.mutation.SetOperatingHoursExceptions(s)
+	return ppc
+}
+
+// SetIsOpen sets the "is_open" field.
+func (ppc *ProviderProfileCreate) SetIsOpen(b bool) *ProviderProfileCreate {
+	ppc.mutation.SetIsOpen(b)
+	return ppc
+}
+
+// SetNillableIsOpen sets the "is_open" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableIsOpen(b *bool) *ProviderProfileCreate {
+	if b != nil {
+		ppc.SetIsOpen(*b)
+	}
+	return ppc
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (ppc *ProviderProfileCreate) SetSupportedInstitutions(s []string) *ProviderProfileCreate {
+	ppc.mutation.SetSupportedInstitutions(s)
+	return ppc
+}
+
+// SetSupportedInstitutionTypes sets the "supported_institution_types" field.
+func (ppc *ProviderProfileCreate) SetSupportedInstitutionTypes(s []string) *ProviderProfileCreate {
+	ppc.mutation.SetSupportedInstitutionTypes(s)
+	return ppc
+}
+
+// SetIsHealthy sets the "is_healthy" field.
+func (ppc *ProviderProfileCreate) SetIsHealthy(b bool) *ProviderProfileCreate {
+	ppc.mutation.SetIsHealthy(b)
+	return ppc
+}
+
+// SetNillableIsHealthy sets the "is_healthy" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableIsHealthy(b *bool) *ProviderProfileCreate {
+	if b != nil {
+		ppc.SetIsHealthy(*b)
+	}
+	return ppc
+}
+
+// SetHealthFailureStreak sets the "health_failure_streak" field.
+func (ppc *ProviderProfileCreate) SetHealthFailureStreak(i int) *ProviderProfileCreate {
+	ppc.mutation.SetHealthFailureStreak(i)
+	return ppc
+}
+
+// SetNillableHealthFailureStreak sets the "health_failure_streak" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableHealthFailureStreak(i *int) *ProviderProfileCreate {
+	if i != nil {
+		ppc.SetHealthFailureStreak(*i)
+	}
+	return ppc
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (ppc *ProviderProfileCreate) SetLastHealthCheckAt(t time.Time) *ProviderProfileCreate {
+	ppc.mutation.SetLastHealthCheckAt(t)
+	return ppc
+}
+
+// SetNillableLastHealthCheckAt sets the "last_health_check_at" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableLastHealthCheckAt(t *time.Time) *ProviderProfileCreate {
+	if t != nil {
+		ppc.SetLastHealthCheckAt(*t)
+	}
+	return ppc
+}
+
+// SetNodeProtocolVersion sets the "node_protocol_version" field.
+func (ppc *ProviderProfileCreate) SetNodeProtocolVersion(i int) *ProviderProfileCreate {
+	ppc.mutation.SetNodeProtocolVersion(i)
+	return ppc
+}
+
+// SetNillableNodeProtocolVersion sets the "node_protocol_version" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableNodeProtocolVersion(i *int) *ProviderProfileCreate {
+	if i != nil {
+		ppc.SetNodeProtocolVersion(*i)
+	}
+	return ppc
+}
+
+// SetNodeCapabilities sets the "node_capabilities" field.
+func (ppc *ProviderProfileCreate) SetNodeCapabilities(s []string) *ProviderProfileCreate {
+	ppc.mutation.SetNodeCapabilities(s)
+	return ppc
+}
+
+// SetDemotedUntil sets the "demoted_until" field.
+func (ppc *ProviderProfileCreate) SetDemotedUntil(t time.Time) *ProviderProfileCreate {
+	ppc.mutation.SetDemotedUntil(t)
+	return ppc
+}
+
+// SetNillableDemotedUntil sets the "demoted_until" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableDemotedUntil(t *time.Time) *ProviderProfileCreate {
+	if t != nil {
+		ppc.SetDemotedUntil(*t)
+	}
+	return ppc
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (ppc *ProviderProfileCreate) SetSuspendedUntil(t time.Time) *ProviderProfileCreate {
+	ppc.mutation.SetSuspendedUntil(t)
+	return ppc
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (ppc *ProviderProfileCreate) SetNillableSuspendedUntil(t *time.Time) *ProviderProfileCreate {
+	if t != nil {
+		ppc.SetSuspendedUntil(*t)
+	}
+	return ppc
+}
+
 // SetID sets the "id" field.
 func (ppc *ProviderProfileCreate) SetID(s string) *ProviderProfileCreate {
 	ppc.mutation.SetID(s)
@@ -285,15 +444,19 @@ func (ppc *ProviderProfileCreate) SetAPIKey(a *APIKey) *ProviderProfileCreate {
 	return ppc.SetAPIKeyID(a.ID)
 }
 
-// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
-func (ppc *ProviderProfileCreate) SetCurrencyID(id uuid.UUID) *ProviderProfileCreate {
-	ppc.mutation.SetCurrencyID(id)
+// AddCurrencyIDs adds the "currencies" edge to the FiatCurrency entity by IDs.
+func (ppc *ProviderProfileCreate) AddCurrencyIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddCurrencyIDs(ids...)
 	return ppc
 }
 
-// SetCurrency sets the "currency" edge to the FiatCurrency entity.
-func (ppc *ProviderProfileCreate) SetCurrency(f *FiatCurrency) *ProviderProfileCreate {
-	return ppc.SetCurrencyID(f.ID)
+// AddCurrencies adds the "currencies" edges to the FiatCurrency entity.
+func (ppc *ProviderProfileCreate) AddCurrencies(f ...*FiatCurrency) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ppc.AddCurrencyIDs(ids...)
 }
 
 // AddProvisionBucketIDs adds the "provision_buckets" edge to the ProvisionBucket entity by IDs.
@@ -360,6 +523,126 @@ func (ppc *ProviderProfileCreate) AddAssignedOrders(l ...*LockPaymentOrder) *Pro
 	return ppc.AddAssignedOrderIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (ppc *ProviderProfileCreate) AddTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddTeamMemberIDs(ids...)
+	return ppc
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (ppc *ProviderProfileCreate) AddTeamMembers(t ...*TeamMember) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppc.AddTeamMemberIDs(ids...)
+}
+
+// AddTeamInvitationIDs adds the "team_invitations" edge to the TeamInvitation entity by IDs.
+func (ppc *ProviderProfileCreate) AddTeamInvitationIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddTeamInvitationIDs(ids...)
+	return ppc
+}
+
+// AddTeamInvitations adds the "team_invitations" edges to the TeamInvitation entity.
+func (ppc *ProviderProfileCreate) AddTeamInvitations(t ...*TeamInvitation) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppc.AddTeamInvitationIDs(ids...)
+}
+
+// AddTeamAuditLogIDs adds the "team_audit_logs" edge to the TeamAuditLog entity by IDs.
+func (ppc *ProviderProfileCreate) AddTeamAuditLogIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddTeamAuditLogIDs(ids...)
+	return ppc
+}
+
+// AddTeamAuditLogs adds the "team_audit_logs" edges to the TeamAuditLog entity.
+func (ppc *ProviderProfileCreate) AddTeamAuditLogs(t ...*TeamAuditLog) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppc.AddTeamAuditLogIDs(ids...)
+}
+
+// AddHealthCheckIDs adds the "health_checks" edge to the ProviderHealthCheck entity by IDs.
+func (ppc *ProviderProfileCreate) AddHealthCheckIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddHealthCheckIDs(ids...)
+	return ppc
+}
+
+// AddHealthChecks adds the "health_checks" edges to the ProviderHealthCheck entity.
+func (ppc *ProviderProfileCreate) AddHealthChecks(p ...*ProviderHealthCheck) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppc.AddHealthCheckIDs(ids...)
+}
+
+// AddSLARecordIDs adds the "sla_records" edge to the ProviderSLARecord entity by IDs.
+func (ppc *ProviderProfileCreate) AddSLARecordIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddSLARecordIDs(ids...)
+	return ppc
+}
+
+// AddSLARecords adds the "sla_records" edges to the ProviderSLARecord entity.
+func (ppc *ProviderProfileCreate) AddSLARecords(p ...*ProviderSLARecord) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppc.AddSLARecordIDs(ids...)
+}
+
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (ppc *ProviderProfileCreate) AddDisputeIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddDisputeIDs(ids...)
+	return ppc
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (ppc *ProviderProfileCreate) AddDisputes(d ...*Dispute) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ppc.AddDisputeIDs(ids...)
+}
+
+// AddOrderAssignmentIDs adds the "order_assignments" edge to the OrderAssignment entity by IDs.
+func (ppc *ProviderProfileCreate) AddOrderAssignmentIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddOrderAssignmentIDs(ids...)
+	return ppc
+}
+
+// AddOrderAssignments adds the "order_assignments" edges to the OrderAssignment entity.
+func (ppc *ProviderProfileCreate) AddOrderAssignments(o ...*OrderAssignment) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ppc.AddOrderAssignmentIDs(ids...)
+}
+
+// AddRateSnapshotIDs adds the "rate_snapshots" edge to the RateSnapshot entity by IDs.
+func (ppc *ProviderProfileCreate) AddRateSnapshotIDs(ids ...uuid.UUID) *ProviderProfileCreate {
+	ppc.mutation.AddRateSnapshotIDs(ids...)
+	return ppc
+}
+
+// AddRateSnapshots adds the "rate_snapshots" edges to the RateSnapshot entity.
+func (ppc *ProviderProfileCreate) AddRateSnapshots(r ...*RateSnapshot) *ProviderProfileCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ppc.AddRateSnapshotIDs(ids...)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppc *ProviderProfileCreate) Mutation() *ProviderProfileMutation {
 	return ppc.mutation
@@ -419,6 +702,22 @@ func (ppc *ProviderProfileCreate) defaults() {
 		v := providerprofile.DefaultIsKybVerified
 		ppc.mutation.SetIsKybVerified(v)
 	}
+	if _, ok := ppc.mutation.IsOpen(); !ok {
+		v := providerprofile.DefaultIsOpen
+		ppc.mutation.SetIsOpen(v)
+	}
+	if _, ok := ppc.mutation.IsHealthy(); !ok {
+		v := providerprofile.DefaultIsHealthy
+		ppc.mutation.SetIsHealthy(v)
+	}
+	if _, ok := ppc.mutation.HealthFailureStreak(); !ok {
+		v := providerprofile.DefaultHealthFailureStreak
+		ppc.mutation.SetHealthFailureStreak(v)
+	}
+	if _, ok := ppc.mutation.NodeProtocolVersion(); !ok {
+		v := providerprofile.DefaultNodeProtocolVersion
+		ppc.mutation.SetNodeProtocolVersion(v)
+	}
 	if _, ok := ppc.mutation.ID(); !ok {
 		v := providerprofile.DefaultID()
 		ppc.mutation.SetID(v)
@@ -465,12 +764,21 @@ func (ppc *ProviderProfileCreate) check() error {
 	if _, ok := ppc.mutation.IsKybVerified(); !ok {
 		return &ValidationError{Name: "is_kyb_verified", err: errors.New(`ent: missing required field "ProviderProfile.is_kyb_verified"`)}
 	}
+	if _, ok := ppc.mutation.IsOpen(); !ok {
+		return &ValidationError{Name: "is_open", err: errors.New(`ent: missing required field "ProviderProfile.is_open"`)}
+	}
+	if _, ok := ppc.mutation.IsHealthy(); !ok {
+		return &ValidationError{Name: "is_healthy", err: errors.New(`ent: missing required field "ProviderProfile.is_healthy"`)}
+	}
+	if _, ok := ppc.mutation.HealthFailureStreak(); !ok {
+		return &ValidationError{Name: "health_failure_streak", err: errors.New(`ent: missing required field "ProviderProfile.health_failure_streak"`)}
+	}
+	if _, ok := ppc.mutation.NodeProtocolVersion(); !ok {
+		return &ValidationError{Name: "node_protocol_version", err: errors.New(`ent: missing required field "ProviderProfile.node_protocol_version"`)}
+	}
 	if len(ppc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ProviderProfile.user"`)}
 	}
-	if len(ppc.mutation.CurrencyIDs()) == 0 {
-		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "ProviderProfile.currency"`)}
-	}
 	return nil
 }
 
@@ -567,6 +875,58 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		_spec.SetField(providerprofile.FieldIsKybVerified, field.TypeBool, value)
 		_node.IsKybVerified = value
 	}
+	if value, ok := ppc.mutation.OperatingTimezone(); ok {
+		_spec.SetField(providerprofile.FieldOperatingTimezone, field.TypeString, value)
+		_node.OperatingTimezone = value
+	}
+	if value, ok := ppc.mutation.OperatingHours(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHours, field.TypeJSON, value)
+		_node.OperatingHours = value
+	}
+	if value, ok := ppc.mutation.OperatingHoursExceptions(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHoursExceptions, field.TypeJSON, value)
+		_node.OperatingHoursExceptions = value
+	}
+	if value, ok := ppc.mutation.IsOpen(); ok {
+		_spec.SetField(providerprofile.FieldIsOpen, field.TypeBool, value)
+		_node.IsOpen = value
+	}
+	if value, ok := ppc.mutation.SupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutions, field.TypeJSON, value)
+		_node.SupportedInstitutions = value
+	}
+	if value, ok := ppc.mutation.SupportedInstitutionTypes(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutionTypes, field.TypeJSON, value)
+		_node.SupportedInstitutionTypes = value
+	}
+	if value, ok := ppc.mutation.IsHealthy(); ok {
+		_spec.SetField(providerprofile.FieldIsHealthy, field.TypeBool, value)
+		_node.IsHealthy = value
+	}
+	if value, ok := ppc.mutation.HealthFailureStreak(); ok {
+		_spec.SetField(providerprofile.FieldHealthFailureStreak, field.TypeInt, value)
+		_node.HealthFailureStreak = value
+	}
+	if value, ok := ppc.mutation.LastHealthCheckAt(); ok {
+		_spec.SetField(providerprofile.FieldLastHealthCheckAt, field.TypeTime, value)
+		_node.LastHealthCheckAt = value
+	}
+	if value, ok := ppc.mutation.NodeProtocolVersion(); ok {
+		_spec.SetField(providerprofile.FieldNodeProtocolVersion, field.TypeInt, value)
+		_node.NodeProtocolVersion = value
+	}
+	if value, ok := ppc.mutation.NodeCapabilities(); ok {
+		_spec.SetField(providerprofile.FieldNodeCapabilities, field.TypeJSON, value)
+		_node.NodeCapabilities = value
+	}
+	if value, ok := ppc.mutation.DemotedUntil(); ok {
+		_spec.SetField(providerprofile.FieldDemotedUntil, field.TypeTime, value)
+		_node.DemotedUntil = value
+	}
+	if value, ok := ppc.mutation.SuspendedUntil(); ok {
+		_spec.SetField(providerprofile.FieldSuspendedUntil, field.TypeTime, value)
+		_node.SuspendedUntil = value
+	}
 	if nodes := ppc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -600,12 +960,12 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := ppc.mutation.CurrencyIDs(); len(nodes) > 0 {
+	if nodes := ppc.mutation.CurrenciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   providerprofile.CurrencyTable,
-			Columns: []string{providerprofile.CurrencyColumn},
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
@@ -614,7 +974,6 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.fiat_currency_providers = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := ppc.mutation.ProvisionBucketsIDs(); len(nodes) > 0 {
@@ -681,6 +1040,134 @@ func (ppc *ProviderProfileCreate) createSpec() (*ProviderProfile, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ppc.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.TeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.TeamAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.HealthChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.SLARecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.OrderAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ppc.mutation.RateSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -967,13 +1454,244 @@ func (u *ProviderProfileUpsert) UpdateIsKybVerified() *ProviderProfileUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.ProviderProfile.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
+// SetOperatingTimezone sets the "operating_timezone" field.
+func (u *ProviderProfileUpsert) SetOperatingTimezone(v string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldOperatingTimezone, v)
+	return u
+}
+
+// UpdateOperatingTimezone sets the "operating_timezone" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateOperatingTimezone() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldOperatingTimezone)
+	return u
+}
+
+// ClearOperatingTimezone clears the value of the "operating_timezone" field.
+func (u *ProviderProfileUpsert) ClearOperatingTimezone() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldOperatingTimezone)
+	return u
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (u *ProviderProfileUpsert) SetOperatingHours(v []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldOperatingHours, v)
+	return u
+}
+
+// UpdateOperatingHours sets the "operating_hours" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateOperatingHours() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldOperatingHours)
+	return u
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (u *ProviderProfileUpsert) ClearOperatingHours() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldOperatingHours)
+	return u
+}
+
+// SetOperatingHoursExceptions sets the "operating_hours_exceptions" field.
+func (u *ProviderProfileUpsert) SetOperatingHoursExceptions(v []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldOperatingHoursExceptions, v)
+	return u
+}
+
+// UpdateOperatingHoursExceptions sets the "operating_hours_exceptions" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateOperatingHoursExceptions() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldOperatingHoursExceptions)
+	return u
+}
+
+// ClearOperatingHoursExceptions clears the value of the "operating_hours_exceptions" field.
+func (u *ProviderProfileUpsert) ClearOperatingHoursExceptions() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldOperatingHoursExceptions)
+	return u
+}
+
+// SetIsOpen sets the "is_open" field.
+func (u *ProviderProfileUpsert) SetIsOpen(v bool) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldIsOpen, v)
+	return u
+}
+
+// UpdateIsOpen sets the "is_open" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateIsOpen() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldIsOpen)
+	return u
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (u *ProviderProfileUpsert) SetSupportedInstitutions(v []string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldSupportedInstitutions, v)
+	return u
+}
+
+// UpdateSupportedInstitutions sets the "supported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateSupportedInstitutions() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldSupportedInstitutions)
+	return u
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (u *ProviderProfileUpsert) ClearSupportedInstitutions() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldSupportedInstitutions)
+	return u
+}
+
+// SetSupportedInstitutionTypes sets the "supported_institution_types" field.
+func (u *ProviderProfileUpsert) SetSupportedInstitutionTypes(v []string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldSupportedInstitutionTypes, v)
+	return u
+}
+
+// UpdateSupportedInstitutionTypes sets the "supported_institution_types" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateSupportedInstitutionTypes() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldSupportedInstitutionTypes)
+	return u
+}
+
+// ClearSupportedInstitutionTypes clears the value of the "supported_institution_types" field.
+func (u *ProviderProfileUpsert) ClearSupportedInstitutionTypes() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldSupportedInstitutionTypes)
+	return u
+}
+
+// SetIsHealthy sets the "is_healthy" field.
+func (u *ProviderProfileUpsert) SetIsHealthy(v bool) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldIsHealthy, v)
+	return u
+}
+
+// UpdateIsHealthy sets the "is_healthy" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateIsHealthy() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldIsHealthy)
+	return u
+}
+
+// SetHealthFailureStreak sets the "health_failure_streak" field.
+func (u *ProviderProfileUpsert) SetHealthFailureStreak(v int) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldHealthFailureStreak, v)
+	return u
+}
+
+// UpdateHealthFailureStreak sets the "health_failure_streak" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateHealthFailureStreak() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldHealthFailureStreak)
+	return u
+}
+
+// AddHealthFailureStreak adds v to the "health_failure_streak" field.
+func (u *ProviderProfileUpsert) AddHealthFailureStreak(v int) *ProviderProfileUpsert {
+	u.Add(providerprofile.FieldHealthFailureStreak, v)
+	return u
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (u *ProviderProfileUpsert) SetLastHealthCheckAt(v time.Time) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldLastHealthCheckAt, v)
+	return u
+}
+
+// UpdateLastHealthCheckAt sets the "last_health_check_at" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateLastHealthCheckAt() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldLastHealthCheckAt)
+	return u
+}
+
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (u *ProviderProfileUpsert) ClearLastHealthCheckAt() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldLastHealthCheckAt)
+	return u
+}
+
+// SetNodeProtocolVersion sets the "node_protocol_version" field.
+func (u *ProviderProfileUpsert) SetNodeProtocolVersion(v int) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldNodeProtocolVersion, v)
+	return u
+}
+
+// UpdateNodeProtocolVersion sets the "node_protocol_version" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateNodeProtocolVersion() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldNodeProtocolVersion)
+	return u
+}
+
+// AddNodeProtocolVersion adds v to the "node_protocol_version" field.
+func (u *ProviderProfileUpsert) AddNodeProtocolVersion(v int) *ProviderProfileUpsert {
+	u.Add(providerprofile.FieldNodeProtocolVersion, v)
+	return u
+}
+
+// SetNodeCapabilities sets the "node_capabilities" field.
+func (u *ProviderProfileUpsert) SetNodeCapabilities(v []string) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldNodeCapabilities, v)
+	return u
+}
+
+// UpdateNodeCapabilities sets the "node_capabilities" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateNodeCapabilities() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldNodeCapabilities)
+	return u
+}
+
+// ClearNodeCapabilities clears the value of the "node_capabilities" field.
+func (u *ProviderProfileUpsert) ClearNodeCapabilities() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldNodeCapabilities)
+	return u
+}
+
+// SetDemotedUntil sets the "demoted_until" field.
+func (u *ProviderProfileUpsert) SetDemotedUntil(v time.Time) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldDemotedUntil, v)
+	return u
+}
+
+// UpdateDemotedUntil sets the "demoted_until" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateDemotedUntil() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldDemotedUntil)
+	return u
+}
+
+// ClearDemotedUntil clears the value of the "demoted_until" field.
+func (u *ProviderProfileUpsert) ClearDemotedUntil() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldDemotedUntil)
+	return u
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (u *ProviderProfileUpsert) SetSuspendedUntil(v time.Time) *ProviderProfileUpsert {
+	u.Set(providerprofile.FieldSuspendedUntil, v)
+	return u
+}
+
+// UpdateSuspendedUntil sets the "suspended_until" field to the value that was provided on create.
+func (u *ProviderProfileUpsert) UpdateSuspendedUntil() *ProviderProfileUpsert {
+	u.SetExcluded(providerprofile.FieldSuspendedUntil)
+	return u
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (u *ProviderProfileUpsert) ClearSuspendedUntil() *ProviderProfileUpsert {
+	u.SetNull(providerprofile.FieldSuspendedUntil)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProviderProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
 //				u.SetIgnore(providerprofile.FieldID)
 //			}),
 //		).
@@ -1288,6 +2006,274 @@ func (u *ProviderProfileUpsertOne) UpdateIsKybVerified() *ProviderProfileUpsertO
 	})
 }
 
+// SetOperatingTimezone sets the "operating_timezone" field.
+func (u *ProviderProfileUpsertOne) SetOperatingTimezone(v string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingTimezone(v)
+	})
+}
+
+// UpdateOperatingTimezone sets the "operating_timezone" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateOperatingTimezone() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingTimezone()
+	})
+}
+
+// ClearOperatingTimezone clears the value of the "operating_timezone" field.
+func (u *ProviderProfileUpsertOne) ClearOperatingTimezone() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingTimezone()
+	})
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (u *ProviderProfileUpsertOne) SetOperatingHours(v []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingHours(v)
+	})
+}
+
+// UpdateOperatingHours sets the "operating_hours" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateOperatingHours() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingHours()
+	})
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (u *ProviderProfileUpsertOne) ClearOperatingHours() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingHours()
+	})
+}
+
+// SetOperatingHoursExceptions sets the "operating_hours_exceptions" field.
+func (u *ProviderProfileUpsertOne) SetOperatingHoursExceptions(v []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingHoursExceptions(v)
+	})
+}
+
+// UpdateOperatingHoursExceptions sets the "operating_hours_exceptions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateOperatingHoursExceptions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingHoursExceptions()
+	})
+}
+
+// ClearOperatingHoursExceptions clears the value of the "operating_hours_exceptions" field.
+func (u *ProviderProfileUpsertOne) ClearOperatingHoursExceptions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingHoursExceptions()
+	})
+}
+
+// SetIsOpen sets the "is_open" field.
+func (u *ProviderProfileUpsertOne) SetIsOpen(v bool) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetIsOpen(v)
+	})
+}
+
+// UpdateIsOpen sets the "is_open" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateIsOpen() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateIsOpen()
+	})
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (u *ProviderProfileUpsertOne) SetSupportedInstitutions(v []string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSupportedInstitutions(v)
+	})
+}
+
+// UpdateSupportedInstitutions sets the "supported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateSupportedInstitutions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSupportedInstitutions()
+	})
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (u *ProviderProfileUpsertOne) ClearSupportedInstitutions() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSupportedInstitutions()
+	})
+}
+
+// SetSupportedInstitutionTypes sets the "supported_institution_types" field.
+func (u *ProviderProfileUpsertOne) SetSupportedInstitutionTypes(v []string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSupportedInstitutionTypes(v)
+	})
+}
+
+// UpdateSupportedInstitutionTypes sets the "supported_institution_types" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateSupportedInstitutionTypes() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSupportedInstitutionTypes()
+	})
+}
+
+// ClearSupportedInstitutionTypes clears the value of the "supported_institution_types" field.
+func (u *ProviderProfileUpsertOne) ClearSupportedInstitutionTypes() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSupportedInstitutionTypes()
+	})
+}
+
+// SetIsHealthy sets the "is_healthy" field.
+func (u *ProviderProfileUpsertOne) SetIsHealthy(v bool) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetIsHealthy(v)
+	})
+}
+
+// UpdateIsHealthy sets the "is_healthy" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateIsHealthy() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateIsHealthy()
+	})
+}
+
+// SetHealthFailureStreak sets the "health_failure_streak" field.
+func (u *ProviderProfileUpsertOne) SetHealthFailureStreak(v int) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetHealthFailureStreak(v)
+	})
+}
+
+// AddHealthFailureStreak adds v to the "health_failure_streak" field.
+func (u *ProviderProfileUpsertOne) AddHealthFailureStreak(v int) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.AddHealthFailureStreak(v)
+	})
+}
+
+// UpdateHealthFailureStreak sets the "health_failure_streak" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateHealthFailureStreak() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateHealthFailureStreak()
+	})
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (u *ProviderProfileUpsertOne) SetLastHealthCheckAt(v time.Time) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetLastHealthCheckAt(v)
+	})
+}
+
+// UpdateLastHealthCheckAt sets the "last_health_check_at" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateLastHealthCheckAt() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateLastHealthCheckAt()
+	})
+}
+
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (u *ProviderProfileUpsertOne) ClearLastHealthCheckAt() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearLastHealthCheckAt()
+	})
+}
+
+// SetNodeProtocolVersion sets the "node_protocol_version" field.
+func (u *ProviderProfileUpsertOne) SetNodeProtocolVersion(v int) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetNodeProtocolVersion(v)
+	})
+}
+
+// AddNodeProtocolVersion adds v to the "node_protocol_version" field.
+func (u *ProviderProfileUpsertOne) AddNodeProtocolVersion(v int) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.AddNodeProtocolVersion(v)
+	})
+}
+
+// UpdateNodeProtocolVersion sets the "node_protocol_version" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateNodeProtocolVersion() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateNodeProtocolVersion()
+	})
+}
+
+// SetNodeCapabilities sets the "node_capabilities" field.
+func (u *ProviderProfileUpsertOne) SetNodeCapabilities(v []string) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetNodeCapabilities(v)
+	})
+}
+
+// UpdateNodeCapabilities sets the "node_capabilities" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateNodeCapabilities() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateNodeCapabilities()
+	})
+}
+
+// ClearNodeCapabilities clears the value of the "node_capabilities" field.
+func (u *ProviderProfileUpsertOne) ClearNodeCapabilities() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearNodeCapabilities()
+	})
+}
+
+// SetDemotedUntil sets the "demoted_until" field.
+func (u *ProviderProfileUpsertOne) SetDemotedUntil(v time.Time) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetDemotedUntil(v)
+	})
+}
+
+// UpdateDemotedUntil sets the "demoted_until" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateDemotedUntil() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateDemotedUntil()
+	})
+}
+
+// ClearDemotedUntil clears the value of the "demoted_until" field.
+func (u *ProviderProfileUpsertOne) ClearDemotedUntil() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearDemotedUntil()
+	})
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (u *ProviderProfileUpsertOne) SetSuspendedUntil(v time.Time) *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSuspendedUntil(v)
+	})
+}
+
+// UpdateSuspendedUntil sets the "suspended_until" field to the value that was provided on create.
+func (u *ProviderProfileUpsertOne) UpdateSuspendedUntil() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSuspendedUntil()
+	})
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (u *ProviderProfileUpsertOne) ClearSuspendedUntil() *ProviderProfileUpsertOne {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSuspendedUntil()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderProfileUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1776,6 +2762,274 @@ func (u *ProviderProfileUpsertBulk) UpdateIsKybVerified() *ProviderProfileUpsert
 	})
 }
 
+// SetOperatingTimezone sets the "operating_timezone" field.
+func (u *ProviderProfileUpsertBulk) SetOperatingTimezone(v string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingTimezone(v)
+	})
+}
+
+// UpdateOperatingTimezone sets the "operating_timezone" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateOperatingTimezone() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingTimezone()
+	})
+}
+
+// ClearOperatingTimezone clears the value of the "operating_timezone" field.
+func (u *ProviderProfileUpsertBulk) ClearOperatingTimezone() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingTimezone()
+	})
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (u *ProviderProfileUpsertBulk) SetOperatingHours(v []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingHours(v)
+	})
+}
+
+// UpdateOperatingHours sets the "operating_hours" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateOperatingHours() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingHours()
+	})
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (u *ProviderProfileUpsertBulk) ClearOperatingHours() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingHours()
+	})
+}
+
+// SetOperatingHoursExceptions sets the "operating_hours_exceptions" field.
+func (u *ProviderProfileUpsertBulk) SetOperatingHoursExceptions(v []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetOperatingHoursExceptions(v)
+	})
+}
+
+// UpdateOperatingHoursExceptions sets the "operating_hours_exceptions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateOperatingHoursExceptions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateOperatingHoursExceptions()
+	})
+}
+
+// ClearOperatingHoursExceptions clears the value of the "operating_hours_exceptions" field.
+func (u *ProviderProfileUpsertBulk) ClearOperatingHoursExceptions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearOperatingHoursExceptions()
+	})
+}
+
+// SetIsOpen sets the "is_open" field.
+func (u *ProviderProfileUpsertBulk) SetIsOpen(v bool) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetIsOpen(v)
+	})
+}
+
+// UpdateIsOpen sets the "is_open" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateIsOpen() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateIsOpen()
+	})
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (u *ProviderProfileUpsertBulk) SetSupportedInstitutions(v []string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSupportedInstitutions(v)
+	})
+}
+
+// UpdateSupportedInstitutions sets the "supported_institutions" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateSupportedInstitutions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSupportedInstitutions()
+	})
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (u *ProviderProfileUpsertBulk) ClearSupportedInstitutions() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSupportedInstitutions()
+	})
+}
+
+// SetSupportedInstitutionTypes sets the "supported_institution_types" field.
+func (u *ProviderProfileUpsertBulk) SetSupportedInstitutionTypes(v []string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSupportedInstitutionTypes(v)
+	})
+}
+
+// UpdateSupportedInstitutionTypes sets the "supported_institution_types" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateSupportedInstitutionTypes() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSupportedInstitutionTypes()
+	})
+}
+
+// ClearSupportedInstitutionTypes clears the value of the "supported_institution_types" field.
+func (u *ProviderProfileUpsertBulk) ClearSupportedInstitutionTypes() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSupportedInstitutionTypes()
+	})
+}
+
+// SetIsHealthy sets the "is_healthy" field.
+func (u *ProviderProfileUpsertBulk) SetIsHealthy(v bool) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetIsHealthy(v)
+	})
+}
+
+// UpdateIsHealthy sets the "is_healthy" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateIsHealthy() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateIsHealthy()
+	})
+}
+
+// SetHealthFailureStreak sets the "health_failure_streak" field.
+func (u *ProviderProfileUpsertBulk) SetHealthFailureStreak(v int) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetHealthFailureStreak(v)
+	})
+}
+
+// AddHealthFailureStreak adds v to the "health_failure_streak" field.
+func (u *ProviderProfileUpsertBulk) AddHealthFailureStreak(v int) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.AddHealthFailureStreak(v)
+	})
+}
+
+// UpdateHealthFailureStreak sets the "health_failure_streak" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateHealthFailureStreak() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateHealthFailureStreak()
+	})
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (u *ProviderProfileUpsertBulk) SetLastHealthCheckAt(v time.Time) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetLastHealthCheckAt(v)
+	})
+}
+
+// UpdateLastHealthCheckAt sets the "last_health_check_at" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateLastHealthCheckAt() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateLastHealthCheckAt()
+	})
+}
+
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (u *ProviderProfileUpsertBulk) ClearLastHealthCheckAt() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearLastHealthCheckAt()
+	})
+}
+
+// SetNodeProtocolVersion sets the "node_protocol_version" field.
+func (u *ProviderProfileUpsertBulk) SetNodeProtocolVersion(v int) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetNodeProtocolVersion(v)
+	})
+}
+
+// AddNodeProtocolVersion adds v to the "node_protocol_version" field.
+func (u *ProviderProfileUpsertBulk) AddNodeProtocolVersion(v int) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.AddNodeProtocolVersion(v)
+	})
+}
+
+// UpdateNodeProtocolVersion sets the "node_protocol_version" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateNodeProtocolVersion() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateNodeProtocolVersion()
+	})
+}
+
+// SetNodeCapabilities sets the "node_capabilities" field.
+func (u *ProviderProfileUpsertBulk) SetNodeCapabilities(v []string) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetNodeCapabilities(v)
+	})
+}
+
+// UpdateNodeCapabilities sets the "node_capabilities" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateNodeCapabilities() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateNodeCapabilities()
+	})
+}
+
+// ClearNodeCapabilities clears the value of the "node_capabilities" field.
+func (u *ProviderProfileUpsertBulk) ClearNodeCapabilities() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearNodeCapabilities()
+	})
+}
+
+// SetDemotedUntil sets the "demoted_until" field.
+func (u *ProviderProfileUpsertBulk) SetDemotedUntil(v time.Time) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetDemotedUntil(v)
+	})
+}
+
+// UpdateDemotedUntil sets the "demoted_until" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateDemotedUntil() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateDemotedUntil()
+	})
+}
+
+// ClearDemotedUntil clears the value of the "demoted_until" field.
+func (u *ProviderProfileUpsertBulk) ClearDemotedUntil() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearDemotedUntil()
+	})
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (u *ProviderProfileUpsertBulk) SetSuspendedUntil(v time.Time) *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.SetSuspendedUntil(v)
+	})
+}
+
+// UpdateSuspendedUntil sets the "suspended_until" field to the value that was provided on create.
+func (u *ProviderProfileUpsertBulk) UpdateSuspendedUntil() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.UpdateSuspendedUntil()
+	})
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (u *ProviderProfileUpsertBulk) ClearSuspendedUntil() *ProviderProfileUpsertBulk {
+	return u.Update(func(s *ProviderProfileUpsert) {
+		s.ClearSuspendedUntil()
+	})
+}
+
 // Exec executes the query.
 func (u *ProviderProfileUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -14,13 +14,21 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
@@ -33,11 +41,19 @@ type ProviderProfileQuery struct {
 	predicates           []predicate.ProviderProfile
 	withUser             *UserQuery
 	withAPIKey           *APIKeyQuery
-	withCurrency         *FiatCurrencyQuery
+	withCurrencies       *FiatCurrencyQuery
 	withProvisionBuckets *ProvisionBucketQuery
 	withOrderTokens      *ProviderOrderTokenQuery
 	withProviderRating   *ProviderRatingQuery
 	withAssignedOrders   *LockPaymentOrderQuery
+	withTeamMembers      *TeamMemberQuery
+	withTeamInvitations  *TeamInvitationQuery
+	withTeamAuditLogs    *TeamAuditLogQuery
+	withHealthChecks     *ProviderHealthCheckQuery
+	withSLARecords       *ProviderSLARecordQuery
+	withDisputes         *DisputeQuery
+	withOrderAssignments *OrderAssignmentQuery
+	withRateSnapshots    *RateSnapshotQuery
 	withFKs              bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -119,8 +135,8 @@ func (ppq *ProviderProfileQuery) QueryAPIKey() *APIKeyQuery {
 	return query
 }
 
-// QueryCurrency chains the current query on the "currency" edge.
-func (ppq *ProviderProfileQuery) QueryCurrency() *FiatCurrencyQuery {
+// QueryCurrencies chains the current query on the "currencies" edge.
+func (ppq *ProviderProfileQuery) QueryCurrencies() *FiatCurrencyQuery {
 	query := (&FiatCurrencyClient{config: ppq.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := ppq.prepareQuery(ctx); err != nil {
@@ -133,7 +149,7 @@ func (ppq *ProviderProfileQuery) QueryCurrency() *FiatCurrencyQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
 			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, providerprofile.CurrencyTable, providerprofile.CurrencyColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, providerprofile.CurrenciesTable, providerprofile.CurrenciesPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
 		return fromU, nil
@@ -229,6 +245,182 @@ func (ppq *ProviderProfileQuery) QueryAssignedOrders() *LockPaymentOrderQuery {
 	return query
 }
 
+// QueryTeamMembers chains the current query on the "team_members" edge.
+func (ppq *ProviderProfileQuery) QueryTeamMembers() *TeamMemberQuery {
+	query := (&TeamMemberClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamMembersTable, providerprofile.TeamMembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeamInvitations chains the current query on the "team_invitations" edge.
+func (ppq *ProviderProfileQuery) QueryTeamInvitations() *TeamInvitationQuery {
+	query := (&TeamInvitationClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(teaminvitation.Table, teaminvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamInvitationsTable, providerprofile.TeamInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeamAuditLogs chains the current query on the "team_audit_logs" edge.
+func (ppq *ProviderProfileQuery) QueryTeamAuditLogs() *TeamAuditLogQuery {
+	query := (&TeamAuditLogClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(teamauditlog.Table, teamauditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.TeamAuditLogsTable, providerprofile.TeamAuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHealthChecks chains the current query on the "health_checks" edge.
+func (ppq *ProviderProfileQuery) QueryHealthChecks() *ProviderHealthCheckQuery {
+	query := (&ProviderHealthCheckClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(providerhealthcheck.Table, providerhealthcheck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.HealthChecksTable, providerprofile.HealthChecksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySLARecords chains the current query on the "sla_records" edge.
+func (ppq *ProviderProfileQuery) QuerySLARecords() *ProviderSLARecordQuery {
+	query := (&ProviderSLARecordClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(providerslarecord.Table, providerslarecord.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.SLARecordsTable, providerprofile.SLARecordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDisputes chains the current query on the "disputes" edge.
+func (ppq *ProviderProfileQuery) QueryDisputes() *DisputeQuery {
+	query := (&DisputeClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(dispute.Table, dispute.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.DisputesTable, providerprofile.DisputesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrderAssignments chains the current query on the "order_assignments" edge.
+func (ppq *ProviderProfileQuery) QueryOrderAssignments() *OrderAssignmentQuery {
+	query := (&OrderAssignmentClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(orderassignment.Table, orderassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.OrderAssignmentsTable, providerprofile.OrderAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRateSnapshots chains the current query on the "rate_snapshots" edge.
+func (ppq *ProviderProfileQuery) QueryRateSnapshots() *RateSnapshotQuery {
+	query := (&RateSnapshotClient{config: ppq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ppq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ppq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerprofile.Table, providerprofile.FieldID, selector),
+			sqlgraph.To(ratesnapshot.Table, ratesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, providerprofile.RateSnapshotsTable, providerprofile.RateSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ppq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ProviderProfile entity from the query.
 // Returns a *NotFoundError when no ProviderProfile was found.
 func (ppq *ProviderProfileQuery) First(ctx context.Context) (*ProviderProfile, error) {
@@ -423,11 +615,19 @@ func (ppq *ProviderProfileQuery) Clone() *ProviderProfileQuery {
 		predicates:           append([]predicate.ProviderProfile{}, ppq.predicates...),
 		withUser:             ppq.withUser.Clone(),
 		withAPIKey:           ppq.withAPIKey.Clone(),
-		withCurrency:         ppq.withCurrency.Clone(),
+		withCurrencies:       ppq.withCurrencies.Clone(),
 		withProvisionBuckets: ppq.withProvisionBuckets.Clone(),
 		withOrderTokens:      ppq.withOrderTokens.Clone(),
 		withProviderRating:   ppq.withProviderRating.Clone(),
 		withAssignedOrders:   ppq.withAssignedOrders.Clone(),
+		withTeamMembers:      ppq.withTeamMembers.Clone(),
+		withTeamInvitations:  ppq.withTeamInvitations.Clone(),
+		withTeamAuditLogs:    ppq.withTeamAuditLogs.Clone(),
+		withHealthChecks:     ppq.withHealthChecks.Clone(),
+		withSLARecords:       ppq.withSLARecords.Clone(),
+		withDisputes:         ppq.withDisputes.Clone(),
+		withOrderAssignments: ppq.withOrderAssignments.Clone(),
+		withRateSnapshots:    ppq.withRateSnapshots.Clone(),
 		// clone intermediate query.
 		sql:  ppq.sql.Clone(),
 		path: ppq.path,
@@ -456,14 +656,14 @@ func (ppq *ProviderProfileQuery) WithAPIKey(opts ...func(*APIKeyQuery)) *Provide
 	return ppq
 }
 
-// WithCurrency tells the query-builder to eager-load the nodes that are connected to
-// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
-func (ppq *ProviderProfileQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *ProviderProfileQuery {
+// WithCurrencies tells the query-builder to eager-load the nodes that are connected to
+// the "currencies" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithCurrencies(opts ...func(*FiatCurrencyQuery)) *ProviderProfileQuery {
 	query := (&FiatCurrencyClient{config: ppq.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	ppq.withCurrency = query
+	ppq.withCurrencies = query
 	return ppq
 }
 
@@ -511,6 +711,94 @@ func (ppq *ProviderProfileQuery) WithAssignedOrders(opts ...func(*LockPaymentOrd
 	return ppq
 }
 
+// WithTeamMembers tells the query-builder to eager-load the nodes that are connected to
+// the "team_members" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithTeamMembers(opts ...func(*TeamMemberQuery)) *ProviderProfileQuery {
+	query := (&TeamMemberClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withTeamMembers = query
+	return ppq
+}
+
+// WithTeamInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "team_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithTeamInvitations(opts ...func(*TeamInvitationQuery)) *ProviderProfileQuery {
+	query := (&TeamInvitationClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withTeamInvitations = query
+	return ppq
+}
+
+// WithTeamAuditLogs tells the query-builder to eager-load the nodes that are connected to
+// the "team_audit_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithTeamAuditLogs(opts ...func(*TeamAuditLogQuery)) *ProviderProfileQuery {
+	query := (&TeamAuditLogClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withTeamAuditLogs = query
+	return ppq
+}
+
+// WithHealthChecks tells the query-builder to eager-load the nodes that are connected to
+// the "health_checks" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithHealthChecks(opts ...func(*ProviderHealthCheckQuery)) *ProviderProfileQuery {
+	query := (&ProviderHealthCheckClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withHealthChecks = query
+	return ppq
+}
+
+// WithSLARecords tells the query-builder to eager-load the nodes that are connected to
+// the "sla_records" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithSLARecords(opts ...func(*ProviderSLARecordQuery)) *ProviderProfileQuery {
+	query := (&ProviderSLARecordClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withSLARecords = query
+	return ppq
+}
+
+// WithDisputes tells the query-builder to eager-load the nodes that are connected to
+// the "disputes" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithDisputes(opts ...func(*DisputeQuery)) *ProviderProfileQuery {
+	query := (&DisputeClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withDisputes = query
+	return ppq
+}
+
+// WithOrderAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "order_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithOrderAssignments(opts ...func(*OrderAssignmentQuery)) *ProviderProfileQuery {
+	query := (&OrderAssignmentClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withOrderAssignments = query
+	return ppq
+}
+
+// WithRateSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "rate_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (ppq *ProviderProfileQuery) WithRateSnapshots(opts ...func(*RateSnapshotQuery)) *ProviderProfileQuery {
+	query := (&RateSnapshotClient{config: ppq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ppq.withRateSnapshots = query
+	return ppq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -590,17 +878,25 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*ProviderProfile{}
 		withFKs     = ppq.withFKs
 		_spec       = ppq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [15]bool{
 			ppq.withUser != nil,
 			ppq.withAPIKey != nil,
-			ppq.withCurrency != nil,
+			ppq.withCurrencies != nil,
 			ppq.withProvisionBuckets != nil,
 			ppq.withOrderTokens != nil,
 			ppq.withProviderRating != nil,
 			ppq.withAssignedOrders != nil,
+			ppq.withTeamMembers != nil,
+			ppq.withTeamInvitations != nil,
+			ppq.withTeamAuditLogs != nil,
+			ppq.withHealthChecks != nil,
+			ppq.withSLARecords != nil,
+			ppq.withDisputes != nil,
+			ppq.withOrderAssignments != nil,
+			ppq.withRateSnapshots != nil,
 		}
 	)
-	if ppq.withUser != nil || ppq.withCurrency != nil {
+	if ppq.withUser != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -636,9 +932,10 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
-	if query := ppq.withCurrency; query != nil {
-		if err := ppq.loadCurrency(ctx, query, nodes, nil,
-			func(n *ProviderProfile, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+	if query := ppq.withCurrencies; query != nil {
+		if err := ppq.loadCurrencies(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.Currencies = []*FiatCurrency{} },
+			func(n *ProviderProfile, e *FiatCurrency) { n.Edges.Currencies = append(n.Edges.Currencies, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -673,6 +970,68 @@ func (ppq *ProviderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := ppq.withTeamMembers; query != nil {
+		if err := ppq.loadTeamMembers(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.TeamMembers = []*TeamMember{} },
+			func(n *ProviderProfile, e *TeamMember) { n.Edges.TeamMembers = append(n.Edges.TeamMembers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withTeamInvitations; query != nil {
+		if err := ppq.loadTeamInvitations(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.TeamInvitations = []*TeamInvitation{} },
+			func(n *ProviderProfile, e *TeamInvitation) {
+				n.Edges.TeamInvitations = append(n.Edges.TeamInvitations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withTeamAuditLogs; query != nil {
+		if err := ppq.loadTeamAuditLogs(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.TeamAuditLogs = []*TeamAuditLog{} },
+			func(n *ProviderProfile, e *TeamAuditLog) { n.Edges.TeamAuditLogs = append(n.Edges.TeamAuditLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withHealthChecks; query != nil {
+		if err := ppq.loadHealthChecks(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.HealthChecks = []*ProviderHealthCheck{} },
+			func(n *ProviderProfile, e *ProviderHealthCheck) {
+				n.Edges.HealthChecks = append(n.Edges.HealthChecks, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withSLARecords; query != nil {
+		if err := ppq.loadSLARecords(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.SLARecords = []*ProviderSLARecord{} },
+			func(n *ProviderProfile, e *ProviderSLARecord) { n.Edges.SLARecords = append(n.Edges.SLARecords, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withDisputes; query != nil {
+		if err := ppq.loadDisputes(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.Disputes = []*Dispute{} },
+			func(n *ProviderProfile, e *Dispute) { n.Edges.Disputes = append(n.Edges.Disputes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withOrderAssignments; query != nil {
+		if err := ppq.loadOrderAssignments(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.OrderAssignments = []*OrderAssignment{} },
+			func(n *ProviderProfile, e *OrderAssignment) {
+				n.Edges.OrderAssignments = append(n.Edges.OrderAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := ppq.withRateSnapshots; query != nil {
+		if err := ppq.loadRateSnapshots(ctx, query, nodes,
+			func(n *ProviderProfile) { n.Edges.RateSnapshots = []*RateSnapshot{} },
+			func(n *ProviderProfile, e *RateSnapshot) { n.Edges.RateSnapshots = append(n.Edges.RateSnapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -736,34 +1095,63 @@ func (ppq *ProviderProfileQuery) loadAPIKey(ctx context.Context, query *APIKeyQu
 	}
 	return nil
 }
-func (ppq *ProviderProfileQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *FiatCurrency)) error {
-	ids := make([]uuid.UUID, 0, len(nodes))
-	nodeids := make(map[uuid.UUID][]*ProviderProfile)
-	for i := range nodes {
-		if nodes[i].fiat_currency_providers == nil {
-			continue
-		}
-		fk := *nodes[i].fiat_currency_providers
-		if _, ok := nodeids[fk]; !ok {
-			ids = append(ids, fk)
+func (ppq *ProviderProfileQuery) loadCurrencies(ctx context.Context, query *FiatCurrencyQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *FiatCurrency)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*ProviderProfile)
+	nids := make(map[uuid.UUID]map[*ProviderProfile]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
 		}
-		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(providerprofile.CurrenciesTable)
+		s.Join(joinT).On(s.C(fiatcurrency.FieldID), joinT.C(providerprofile.CurrenciesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(providerprofile.CurrenciesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(providerprofile.CurrenciesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
 	}
-	query.Where(fiatcurrency.IDIn(ids...))
-	neighbors, err := query.All(ctx)
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ProviderProfile]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*FiatCurrency](ctx, query, qr, query.inters)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
+		nodes, ok := nids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "fiat_currency_providers" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected "currencies" node returned %v`, n.ID)
 		}
-		for i := range nodes {
-			assign(nodes[i], n)
+		for kn := range nodes {
+			assign(kn, n)
 		}
 	}
 	return nil
@@ -919,6 +1307,254 @@ func (ppq *ProviderProfileQuery) loadAssignedOrders(ctx context.Context, query *
 	}
 	return nil
 }
+func (ppq *ProviderProfileQuery) loadTeamMembers(ctx context.Context, query *TeamMemberQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *TeamMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.TeamMembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_team_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_team_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_team_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadTeamInvitations(ctx context.Context, query *TeamInvitationQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *TeamInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.TeamInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_team_invitations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_team_invitations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_team_invitations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadTeamAuditLogs(ctx context.Context, query *TeamAuditLogQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *TeamAuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamAuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.TeamAuditLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_team_audit_logs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_team_audit_logs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_team_audit_logs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadHealthChecks(ctx context.Context, query *ProviderHealthCheckQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *ProviderHealthCheck)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderHealthCheck(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.HealthChecksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_health_checks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_health_checks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_health_checks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadSLARecords(ctx context.Context, query *ProviderSLARecordQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *ProviderSLARecord)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ProviderSLARecord(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.SLARecordsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_sla_records
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_sla_records" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_sla_records" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadDisputes(ctx context.Context, query *DisputeQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *Dispute)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Dispute(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.DisputesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_disputes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_disputes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_disputes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadOrderAssignments(ctx context.Context, query *OrderAssignmentQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *OrderAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OrderAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.OrderAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_order_assignments
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_order_assignments" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_order_assignments" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (ppq *ProviderProfileQuery) loadRateSnapshots(ctx context.Context, query *RateSnapshotQuery, nodes []*ProviderProfile, init func(*ProviderProfile), assign func(*ProviderProfile, *RateSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProviderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RateSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(providerprofile.RateSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.provider_profile_rate_snapshots
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "provider_profile_rate_snapshots" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "provider_profile_rate_snapshots" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (ppq *ProviderProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ppq.querySpec()
@@ -10,16 +10,25 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 )
 
 // ProviderProfileUpdate is the builder for updating ProviderProfile entities.
@@ -291,6 +300,264 @@ func (ppu *ProviderProfileUpdate) SetNillableIsKybVerified(b *bool) *ProviderPro
 	return ppu
 }
 
+// SetOperatingTimezone sets the "operating_timezone" field.
+func (ppu *ProviderProfileUpdate) SetOperatingTimezone(s string) *ProviderProfileUpdate {
+	ppu.mutation.SetOperatingTimezone(s)
+	return ppu
+}
+
+// SetNillableOperatingTimezone sets the "operating_timezone" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableOperatingTimezone(s *string) *ProviderProfileUpdate {
+	if s != nil {
+		ppu.SetOperatingTimezone(*s)
+	}
+	return ppu
+}
+
+// ClearOperatingTimezone clears the value of the "operating_timezone" field.
+func (ppu *ProviderProfileUpdate) ClearOperatingTimezone() *ProviderProfileUpdate {
+	ppu.mutation.ClearOperatingTimezone()
+	return ppu
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (ppu *ProviderProfileUpdate) SetOperatingHours(s []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpdate {
+	ppu.mutation.SetOperatingHours(s)
+	return ppu
+}
+
+// AppendOperatingHours appends s to the "operating_hours" field.
+func (ppu *ProviderProfileUpdate) AppendOperatingHours(s []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpdate {
+	ppu.mutation.AppendOperatingHours(s)
+	return ppu
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (ppu *ProviderProfileUpdate) ClearOperatingHours() *ProviderProfileUpdate {
+	ppu.mutation.ClearOperatingHours()
+	return ppu
+}
+
+// SetOperatingHoursExceptions sets the "operating_hours_exceptions" field.
+func (ppu *ProviderProfileUpdate) SetOperatingHoursExceptions(s []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpdate {
+	ppu.mutation.SetOperatingHoursExceptions(s)
+	return ppu
+}
+
+// AppendOperatingHoursExceptions appends s to the "operating_hours_exceptions" field.
+func (ppu *ProviderProfileUpdate) AppendOperatingHoursExceptions(s []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpdate {
+	ppu.mutation.AppendOperatingHoursExceptions(s)
+	return ppu
+}
+
+// ClearOperatingHoursExceptions clears the value of the "operating_hours_exceptions" field.
+func (ppu *ProviderProfileUpdate) ClearOperatingHoursExceptions() *ProviderProfileUpdate {
+	ppu.mutation.ClearOperatingHoursExceptions()
+	return ppu
+}
+
+// SetIsOpen sets the "is_open" field.
+func (ppu *ProviderProfileUpdate) SetIsOpen(b bool) *ProviderProfileUpdate {
+	ppu.mutation.SetIsOpen(b)
+	return ppu
+}
+
+// SetNillableIsOpen sets the "is_open" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableIsOpen(b *bool) *ProviderProfileUpdate {
+	if b != nil {
+		ppu.SetIsOpen(*b)
+	}
+	return ppu
+}
+
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (ppu *ProviderProfileUpdate) SetSupportedInstitutions(s []string) *ProviderProfileUpdate {
+	ppu.mutation.SetSupportedInstitutions(s)
+	return ppu
+}
+
+// AppendSupportedInstitutions appends s to the "supported_institutions" field.
+func (ppu *ProviderProfileUpdate) AppendSupportedInstitutions(s []string) *ProviderProfileUpdate {
+	ppu.mutation.AppendSupportedInstitutions(s)
+	return ppu
+}
+
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (ppu *ProviderProfileUpdate) ClearSupportedInstitutions() *ProviderProfileUpdate {
+	ppu.mutation.ClearSupportedInstitutions()
+	return ppu
+}
+
+// SetSupportedInstitutionTypes sets the "supported_institution_types" field.
+func (ppu *ProviderProfileUpdate) SetSupportedInstitutionTypes(s []string) *ProviderProfileUpdate {
+	ppu.mutation.SetSupportedInstitutionTypes(s)
+	return ppu
+}
+
+// AppendSupportedInstitutionTypes appends s to the "supported_institution_types" field.
+func (ppu *ProviderProfileUpdate) AppendSupportedInstitutionTypes(s []string) *ProviderProfileUpdate {
+	ppu.mutation.AppendSupportedInstitutionTypes(s)
+	return ppu
+}
+
+// ClearSupportedInstitutionTypes clears the value of the "supported_institution_types" field.
+func (ppu *ProviderProfileUpdate) ClearSupportedInstitutionTypes() *ProviderProfileUpdate {
+	ppu.mutation.ClearSupportedInstitutionTypes()
+	return ppu
+}
+
+// SetIsHealthy sets the "is_healthy" field.
+func (ppu *ProviderProfileUpdate) SetIsHealthy(b bool) *ProviderProfileUpdate {
+	ppu.mutation.SetIsHealthy(b)
+	return ppu
+}
+
+// SetNillableIsHealthy sets the "is_healthy" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableIsHealthy(b *bool) *ProviderProfileUpdate {
+	if b != nil {
+		ppu.SetIsHealthy(*b)
+	}
+	return ppu
+}
+
+// SetHealthFailureStreak sets the "health_failure_streak" field.
+func (ppu *ProviderProfileUpdate) SetHealthFailureStreak(i int) *ProviderProfileUpdate {
+	ppu.mutation.ResetHealthFailureStreak()
+	ppu.mutation.SetHealthFailureStreak(i)
+	return ppu
+}
+
+// SetNillableHealthFailureStreak sets the "health_failure_streak" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableHealthFailureStreak(i *int) *ProviderProfileUpdate {
+	if i != nil {
+		ppu.SetHealthFailureStreak(*i)
+	}
+	return ppu
+}
+
+// AddHealthFailureStreak adds i to the "health_failure_streak" field.
+func (ppu *ProviderProfileUpdate) AddHealthFailureStreak(i int) *ProviderProfileUpdate {
+	ppu.mutation.AddHealthFailureStreak(i)
+	return ppu
+}
+
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (ppu *ProviderProfileUpdate) SetLastHealthCheckAt(t time.Time) *ProviderProfileUpdate {
+	ppu.mutation.SetLastHealthCheckAt(t)
+	return ppu
+}
+
+// SetNillableLastHealthCheckAt sets the "last_health_check_at" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableLastHealthCheckAt(t *time.Time) *ProviderProfileUpdate {
+	if t != nil {
+		ppu.SetLastHealthCheckAt(*t)
+	}
+	return ppu
+}
+
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (ppu *ProviderProfileUpdate) ClearLastHealthCheckAt() *ProviderProfileUpdate {
+	ppu.mutation.ClearLastHealthCheckAt()
+	return ppu
+}
+
+// SetNodeProtocolVersion sets the "node_protocol_version" field.
+func (ppu *ProviderProfileUpdate) SetNodeProtocolVersion(i int) *ProviderProfileUpdate {
+	ppu.mutation.ResetNodeProtocolVersion()
+	ppu.mutation.SetNodeProtocolVersion(i)
+	return ppu
+}
+
+// SetNillableNodeProtocolVersion sets the "node_protocol_version" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableNodeProtocolVersion(i *int) *ProviderProfileUpdate {
+	if i != nil {
+		ppu.SetNodeProtocolVersion(*i)
+	}
+	return ppu
+}
+
+// AddNodeProtocolVersion adds i to the "node_protocol_version" field.
+func (ppu *ProviderProfileUpdate) AddNodeProtocolVersion(i int) *ProviderProfileUpdate {
+	ppu.mutation.AddNodeProtocolVersion(i)
+	return ppu
+}
+
+// SetNodeCapabilities sets the "node_capabilities" field.
+func (ppu *ProviderProfileUpdate) SetNodeCapabilities(s []string) *ProviderProfileUpdate {
+	ppu.mutation.SetNodeCapabilities(s)
+	return ppu
+}
+
+// AppendNodeCapabilities appends s to the "node_capabilities" field.
+func (ppu *ProviderProfileUpdate) AppendNodeCapabilities(s []string) *ProviderProfileUpdate {
+	ppu.mutation.AppendNodeCapabilities(s)
+	return ppu
+}
+
+// ClearNodeCapabilities clears the value of the "node_capabilities" field.
+func (ppu *ProviderProfileUpdate) ClearNodeCapabilities() *ProviderProfileUpdate {
+	ppu.mutation.ClearNodeCapabilities()
+	return ppu
+}
+
+// SetDemotedUntil sets the "demoted_until" field.
+func (ppu *ProviderProfileUpdate) SetDemotedUntil(t time.Time) *ProviderProfileUpdate {
+	ppu.mutation.SetDemotedUntil(t)
+	return ppu
+}
+
+// SetNillableDemotedUntil sets the "demoted_until" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableDemotedUntil(t *time.Time) *ProviderProfileUpdate {
+	if t != nil {
+		ppu.SetDemotedUntil(*t)
+	}
+	return ppu
+}
+
+// ClearDemotedUntil clears the value of the "demoted_until" field.
+func (ppu *ProviderProfileUpdate) ClearDemotedUntil() *ProviderProfileUpdate {
+	ppu.mutation.ClearDemotedUntil()
+	return ppu
+}
+
+// SetSuspendedUntil sets the "suspended_until" field.
+func (ppu *ProviderProfileUpdate) SetSuspendedUntil(t time.Time) *ProviderProfileUpdate {
+	ppu.mutation.SetSuspendedUntil(t)
+	return ppu
+}
+
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (ppu *ProviderProfileUpdate) SetNillableSuspendedUntil(t *time.Time) *ProviderProfileUpdate {
+	if t != nil {
+		ppu.SetSuspendedUntil(*t)
+	}
+	return ppu
+}
+
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (ppu *ProviderProfileUpdate) ClearSuspendedUntil() *ProviderProfileUpdate {
+	ppu.mutation.ClearSuspendedUntil()
+	return ppu
+}
+
 // SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
 func (ppu *ProviderProfileUpdate) SetAPIKeyID(id uuid.UUID) *ProviderProfileUpdate {
 	ppu.mutation.SetAPIKeyID(id)
@@ -310,15 +577,19 @@ func (ppu *ProviderProfileUpdate) SetAPIKey(a *APIKey) *ProviderProfileUpdate {
 	return ppu.SetAPIKeyID(a.ID)
 }
 
-// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
-func (ppu *ProviderProfileUpdate) SetCurrencyID(id uuid.UUID) *ProviderProfileUpdate {
-	ppu.mutation.SetCurrencyID(id)
+// AddCurrencyIDs adds the "currencies" edge to the FiatCurrency entity by IDs.
+func (ppu *ProviderProfileUpdate) AddCurrencyIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddCurrencyIDs(ids...)
 	return ppu
 }
 
-// SetCurrency sets the "currency" edge to the FiatCurrency entity.
-func (ppu *ProviderProfileUpdate) SetCurrency(f *FiatCurrency) *ProviderProfileUpdate {
-	return ppu.SetCurrencyID(f.ID)
+// AddCurrencies adds the "currencies" edges to the FiatCurrency entity.
+func (ppu *ProviderProfileUpdate) AddCurrencies(f ...*FiatCurrency) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ppu.AddCurrencyIDs(ids...)
 }
 
 // AddProvisionBucketIDs adds the "provision_buckets" edge to the ProvisionBucket entity by IDs.
@@ -385,6 +656,126 @@ func (ppu *ProviderProfileUpdate) AddAssignedOrders(l ...*LockPaymentOrder) *Pro
 	return ppu.AddAssignedOrderIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (ppu *ProviderProfileUpdate) AddTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddTeamMemberIDs(ids...)
+	return ppu
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (ppu *ProviderProfileUpdate) AddTeamMembers(t ...*TeamMember) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppu.AddTeamMemberIDs(ids...)
+}
+
+// AddTeamInvitationIDs adds the "team_invitations" edge to the TeamInvitation entity by IDs.
+func (ppu *ProviderProfileUpdate) AddTeamInvitationIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddTeamInvitationIDs(ids...)
+	return ppu
+}
+
+// AddTeamInvitations adds the "team_invitations" edges to the TeamInvitation entity.
+func (ppu *ProviderProfileUpdate) AddTeamInvitations(t ...*TeamInvitation) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppu.AddTeamInvitationIDs(ids...)
+}
+
+// AddTeamAuditLogIDs adds the "team_audit_logs" edge to the TeamAuditLog entity by IDs.
+func (ppu *ProviderProfileUpdate) AddTeamAuditLogIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddTeamAuditLogIDs(ids...)
+	return ppu
+}
+
+// AddTeamAuditLogs adds the "team_audit_logs" edges to the TeamAuditLog entity.
+func (ppu *ProviderProfileUpdate) AddTeamAuditLogs(t ...*TeamAuditLog) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppu.AddTeamAuditLogIDs(ids...)
+}
+
+// AddHealthCheckIDs adds the "health_checks" edge to the ProviderHealthCheck entity by IDs.
+func (ppu *ProviderProfileUpdate) AddHealthCheckIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddHealthCheckIDs(ids...)
+	return ppu
+}
+
+// AddHealthChecks adds the "health_checks" edges to the ProviderHealthCheck entity.
+func (ppu *ProviderProfileUpdate) AddHealthChecks(p ...*ProviderHealthCheck) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.AddHealthCheckIDs(ids...)
+}
+
+// AddSLARecordIDs adds the "sla_records" edge to the ProviderSLARecord entity by IDs.
+func (ppu *ProviderProfileUpdate) AddSLARecordIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddSLARecordIDs(ids...)
+	return ppu
+}
+
+// AddSLARecords adds the "sla_records" edges to the ProviderSLARecord entity.
+func (ppu *ProviderProfileUpdate) AddSLARecords(p ...*ProviderSLARecord) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.AddSLARecordIDs(ids...)
+}
+
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (ppu *ProviderProfileUpdate) AddDisputeIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddDisputeIDs(ids...)
+	return ppu
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (ppu *ProviderProfileUpdate) AddDisputes(d ...*Dispute) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ppu.AddDisputeIDs(ids...)
+}
+
+// AddOrderAssignmentIDs adds the "order_assignments" edge to the OrderAssignment entity by IDs.
+func (ppu *ProviderProfileUpdate) AddOrderAssignmentIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddOrderAssignmentIDs(ids...)
+	return ppu
+}
+
+// AddOrderAssignments adds the "order_assignments" edges to the OrderAssignment entity.
+func (ppu *ProviderProfileUpdate) AddOrderAssignments(o ...*OrderAssignment) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ppu.AddOrderAssignmentIDs(ids...)
+}
+
+// AddRateSnapshotIDs adds the "rate_snapshots" edge to the RateSnapshot entity by IDs.
+func (ppu *ProviderProfileUpdate) AddRateSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.AddRateSnapshotIDs(ids...)
+	return ppu
+}
+
+// AddRateSnapshots adds the "rate_snapshots" edges to the RateSnapshot entity.
+func (ppu *ProviderProfileUpdate) AddRateSnapshots(r ...*RateSnapshot) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ppu.AddRateSnapshotIDs(ids...)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppu *ProviderProfileUpdate) Mutation() *ProviderProfileMutation {
 	return ppu.mutation
@@ -396,12 +787,27 @@ func (ppu *ProviderProfileUpdate) ClearAPIKey() *ProviderProfileUpdate {
 	return ppu
 }
 
-// ClearCurrency clears the "currency" edge to the FiatCurrency entity.
-func (ppu *ProviderProfileUpdate) ClearCurrency() *ProviderProfileUpdate {
-	ppu.mutation.ClearCurrency()
+// ClearCurrencies clears all "currencies" edges to the FiatCurrency entity.
+func (ppu *ProviderProfileUpdate) ClearCurrencies() *ProviderProfileUpdate {
+	ppu.mutation.ClearCurrencies()
+	return ppu
+}
+
+// RemoveCurrencyIDs removes the "currencies" edge to FiatCurrency entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveCurrencyIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveCurrencyIDs(ids...)
 	return ppu
 }
 
+// RemoveCurrencies removes "currencies" edges to FiatCurrency entities.
+func (ppu *ProviderProfileUpdate) RemoveCurrencies(f ...*FiatCurrency) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ppu.RemoveCurrencyIDs(ids...)
+}
+
 // ClearProvisionBuckets clears all "provision_buckets" edges to the ProvisionBucket entity.
 func (ppu *ProviderProfileUpdate) ClearProvisionBuckets() *ProviderProfileUpdate {
 	ppu.mutation.ClearProvisionBuckets()
@@ -471,74 +877,239 @@ func (ppu *ProviderProfileUpdate) RemoveAssignedOrders(l ...*LockPaymentOrder) *
 	return ppu.RemoveAssignedOrderIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (ppu *ProviderProfileUpdate) Save(ctx context.Context) (int, error) {
-	ppu.defaults()
-	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (ppu *ProviderProfileUpdate) ClearTeamMembers() *ProviderProfileUpdate {
+	ppu.mutation.ClearTeamMembers()
+	return ppu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (ppu *ProviderProfileUpdate) SaveX(ctx context.Context) int {
-	affected, err := ppu.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveTeamMemberIDs(ids...)
+	return ppu
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (ppu *ProviderProfileUpdate) RemoveTeamMembers(t ...*TeamMember) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return affected
+	return ppu.RemoveTeamMemberIDs(ids...)
 }
 
-// Exec executes the query.
-func (ppu *ProviderProfileUpdate) Exec(ctx context.Context) error {
-	_, err := ppu.Save(ctx)
-	return err
+// ClearTeamInvitations clears all "team_invitations" edges to the TeamInvitation entity.
+func (ppu *ProviderProfileUpdate) ClearTeamInvitations() *ProviderProfileUpdate {
+	ppu.mutation.ClearTeamInvitations()
+	return ppu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (ppu *ProviderProfileUpdate) ExecX(ctx context.Context) {
-	if err := ppu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveTeamInvitationIDs removes the "team_invitations" edge to TeamInvitation entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveTeamInvitationIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveTeamInvitationIDs(ids...)
+	return ppu
 }
 
-// defaults sets the default values of the builder before save.
-func (ppu *ProviderProfileUpdate) defaults() {
-	if _, ok := ppu.mutation.UpdatedAt(); !ok {
-		v := providerprofile.UpdateDefaultUpdatedAt()
-		ppu.mutation.SetUpdatedAt(v)
+// RemoveTeamInvitations removes "team_invitations" edges to TeamInvitation entities.
+func (ppu *ProviderProfileUpdate) RemoveTeamInvitations(t ...*TeamInvitation) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
+	return ppu.RemoveTeamInvitationIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (ppu *ProviderProfileUpdate) check() error {
-	if v, ok := ppu.mutation.TradingName(); ok {
-		if err := providerprofile.TradingNameValidator(v); err != nil {
-			return &ValidationError{Name: "trading_name", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.trading_name": %w`, err)}
-		}
-	}
-	if v, ok := ppu.mutation.ProvisionMode(); ok {
-		if err := providerprofile.ProvisionModeValidator(v); err != nil {
-			return &ValidationError{Name: "provision_mode", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.provision_mode": %w`, err)}
-		}
-	}
-	if v, ok := ppu.mutation.VisibilityMode(); ok {
-		if err := providerprofile.VisibilityModeValidator(v); err != nil {
-			return &ValidationError{Name: "visibility_mode", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.visibility_mode": %w`, err)}
-		}
-	}
-	if v, ok := ppu.mutation.IdentityDocumentType(); ok {
-		if err := providerprofile.IdentityDocumentTypeValidator(v); err != nil {
-			return &ValidationError{Name: "identity_document_type", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.identity_document_type": %w`, err)}
-		}
-	}
-	if ppu.mutation.UserCleared() && len(ppu.mutation.UserIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "ProviderProfile.user"`)
+// ClearTeamAuditLogs clears all "team_audit_logs" edges to the TeamAuditLog entity.
+func (ppu *ProviderProfileUpdate) ClearTeamAuditLogs() *ProviderProfileUpdate {
+	ppu.mutation.ClearTeamAuditLogs()
+	return ppu
+}
+
+// RemoveTeamAuditLogIDs removes the "team_audit_logs" edge to TeamAuditLog entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveTeamAuditLogIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveTeamAuditLogIDs(ids...)
+	return ppu
+}
+
+// RemoveTeamAuditLogs removes "team_audit_logs" edges to TeamAuditLog entities.
+func (ppu *ProviderProfileUpdate) RemoveTeamAuditLogs(t ...*TeamAuditLog) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	if ppu.mutation.CurrencyCleared() && len(ppu.mutation.CurrencyIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "ProviderProfile.currency"`)
+	return ppu.RemoveTeamAuditLogIDs(ids...)
+}
+
+// ClearHealthChecks clears all "health_checks" edges to the ProviderHealthCheck entity.
+func (ppu *ProviderProfileUpdate) ClearHealthChecks() *ProviderProfileUpdate {
+	ppu.mutation.ClearHealthChecks()
+	return ppu
+}
+
+// RemoveHealthCheckIDs removes the "health_checks" edge to ProviderHealthCheck entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveHealthCheckIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveHealthCheckIDs(ids...)
+	return ppu
+}
+
+// RemoveHealthChecks removes "health_checks" edges to ProviderHealthCheck entities.
+func (ppu *ProviderProfileUpdate) RemoveHealthChecks(p ...*ProviderHealthCheck) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
 	}
-	return nil
+	return ppu.RemoveHealthCheckIDs(ids...)
 }
 
-func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+// ClearSLARecords clears all "sla_records" edges to the ProviderSLARecord entity.
+func (ppu *ProviderProfileUpdate) ClearSLARecords() *ProviderProfileUpdate {
+	ppu.mutation.ClearSLARecords()
+	return ppu
+}
+
+// RemoveSLARecordIDs removes the "sla_records" edge to ProviderSLARecord entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveSLARecordIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveSLARecordIDs(ids...)
+	return ppu
+}
+
+// RemoveSLARecords removes "sla_records" edges to ProviderSLARecord entities.
+func (ppu *ProviderProfileUpdate) RemoveSLARecords(p ...*ProviderSLARecord) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppu.RemoveSLARecordIDs(ids...)
+}
+
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (ppu *ProviderProfileUpdate) ClearDisputes() *ProviderProfileUpdate {
+	ppu.mutation.ClearDisputes()
+	return ppu
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveDisputeIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveDisputeIDs(ids...)
+	return ppu
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (ppu *ProviderProfileUpdate) RemoveDisputes(d ...*Dispute) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ppu.RemoveDisputeIDs(ids...)
+}
+
+// ClearOrderAssignments clears all "order_assignments" edges to the OrderAssignment entity.
+func (ppu *ProviderProfileUpdate) ClearOrderAssignments() *ProviderProfileUpdate {
+	ppu.mutation.ClearOrderAssignments()
+	return ppu
+}
+
+// RemoveOrderAssignmentIDs removes the "order_assignments" edge to OrderAssignment entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveOrderAssignmentIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveOrderAssignmentIDs(ids...)
+	return ppu
+}
+
+// RemoveOrderAssignments removes "order_assignments" edges to OrderAssignment entities.
+func (ppu *ProviderProfileUpdate) RemoveOrderAssignments(o ...*OrderAssignment) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ppu.RemoveOrderAssignmentIDs(ids...)
+}
+
+// ClearRateSnapshots clears all "rate_snapshots" edges to the RateSnapshot entity.
+func (ppu *ProviderProfileUpdate) ClearRateSnapshots() *ProviderProfileUpdate {
+	ppu.mutation.ClearRateSnapshots()
+	return ppu
+}
+
+// RemoveRateSnapshotIDs removes the "rate_snapshots" edge to RateSnapshot entities by IDs.
+func (ppu *ProviderProfileUpdate) RemoveRateSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdate {
+	ppu.mutation.RemoveRateSnapshotIDs(ids...)
+	return ppu
+}
+
+// RemoveRateSnapshots removes "rate_snapshots" edges to RateSnapshot entities.
+func (ppu *ProviderProfileUpdate) RemoveRateSnapshots(r ...*RateSnapshot) *ProviderProfileUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ppu.RemoveRateSnapshotIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ppu *ProviderProfileUpdate) Save(ctx context.Context) (int, error) {
+	ppu.defaults()
+	return withHooks(ctx, ppu.sqlSave, ppu.mutation, ppu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ppu *ProviderProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := ppu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ppu *ProviderProfileUpdate) Exec(ctx context.Context) error {
+	_, err := ppu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppu *ProviderProfileUpdate) ExecX(ctx context.Context) {
+	if err := ppu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ppu *ProviderProfileUpdate) defaults() {
+	if _, ok := ppu.mutation.UpdatedAt(); !ok {
+		v := providerprofile.UpdateDefaultUpdatedAt()
+		ppu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ppu *ProviderProfileUpdate) check() error {
+	if v, ok := ppu.mutation.TradingName(); ok {
+		if err := providerprofile.TradingNameValidator(v); err != nil {
+			return &ValidationError{Name: "trading_name", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.trading_name": %w`, err)}
+		}
+	}
+	if v, ok := ppu.mutation.ProvisionMode(); ok {
+		if err := providerprofile.ProvisionModeValidator(v); err != nil {
+			return &ValidationError{Name: "provision_mode", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.provision_mode": %w`, err)}
+		}
+	}
+	if v, ok := ppu.mutation.VisibilityMode(); ok {
+		if err := providerprofile.VisibilityModeValidator(v); err != nil {
+			return &ValidationError{Name: "visibility_mode", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.visibility_mode": %w`, err)}
+		}
+	}
+	if v, ok := ppu.mutation.IdentityDocumentType(); ok {
+		if err := providerprofile.IdentityDocumentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "identity_document_type", err: fmt.Errorf(`ent: validator failed for field "ProviderProfile.identity_document_type": %w`, err)}
+		}
+	}
+	if ppu.mutation.UserCleared() && len(ppu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderProfile.user"`)
+	}
+	return nil
+}
+
+func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := ppu.check(); err != nil {
 		return n, err
 	}
@@ -622,6 +1193,103 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := ppu.mutation.IsKybVerified(); ok {
 		_spec.SetField(providerprofile.FieldIsKybVerified, field.TypeBool, value)
 	}
+	if value, ok := ppu.mutation.OperatingTimezone(); ok {
+		_spec.SetField(providerprofile.FieldOperatingTimezone, field.TypeString, value)
+	}
+	if ppu.mutation.OperatingTimezoneCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingTimezone, field.TypeString)
+	}
+	if value, ok := ppu.mutation.OperatingHours(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHours, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedOperatingHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldOperatingHours, value)
+		})
+	}
+	if ppu.mutation.OperatingHoursCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingHours, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.OperatingHoursExceptions(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHoursExceptions, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedOperatingHoursExceptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldOperatingHoursExceptions, value)
+		})
+	}
+	if ppu.mutation.OperatingHoursExceptionsCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingHoursExceptions, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.IsOpen(); ok {
+		_spec.SetField(providerprofile.FieldIsOpen, field.TypeBool, value)
+	}
+	if value, ok := ppu.mutation.SupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutions, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedSupportedInstitutions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldSupportedInstitutions, value)
+		})
+	}
+	if ppu.mutation.SupportedInstitutionsCleared() {
+		_spec.ClearField(providerprofile.FieldSupportedInstitutions, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.SupportedInstitutionTypes(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutionTypes, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedSupportedInstitutionTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldSupportedInstitutionTypes, value)
+		})
+	}
+	if ppu.mutation.SupportedInstitutionTypesCleared() {
+		_spec.ClearField(providerprofile.FieldSupportedInstitutionTypes, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.IsHealthy(); ok {
+		_spec.SetField(providerprofile.FieldIsHealthy, field.TypeBool, value)
+	}
+	if value, ok := ppu.mutation.HealthFailureStreak(); ok {
+		_spec.SetField(providerprofile.FieldHealthFailureStreak, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedHealthFailureStreak(); ok {
+		_spec.AddField(providerprofile.FieldHealthFailureStreak, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.LastHealthCheckAt(); ok {
+		_spec.SetField(providerprofile.FieldLastHealthCheckAt, field.TypeTime, value)
+	}
+	if ppu.mutation.LastHealthCheckAtCleared() {
+		_spec.ClearField(providerprofile.FieldLastHealthCheckAt, field.TypeTime)
+	}
+	if value, ok := ppu.mutation.NodeProtocolVersion(); ok {
+		_spec.SetField(providerprofile.FieldNodeProtocolVersion, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedNodeProtocolVersion(); ok {
+		_spec.AddField(providerprofile.FieldNodeProtocolVersion, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.NodeCapabilities(); ok {
+		_spec.SetField(providerprofile.FieldNodeCapabilities, field.TypeJSON, value)
+	}
+	if value, ok := ppu.mutation.AppendedNodeCapabilities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldNodeCapabilities, value)
+		})
+	}
+	if ppu.mutation.NodeCapabilitiesCleared() {
+		_spec.ClearField(providerprofile.FieldNodeCapabilities, field.TypeJSON)
+	}
+	if value, ok := ppu.mutation.DemotedUntil(); ok {
+		_spec.SetField(providerprofile.FieldDemotedUntil, field.TypeTime, value)
+	}
+	if ppu.mutation.DemotedUntilCleared() {
+		_spec.ClearField(providerprofile.FieldDemotedUntil, field.TypeTime)
+	}
+	if value, ok := ppu.mutation.SuspendedUntil(); ok {
+		_spec.SetField(providerprofile.FieldSuspendedUntil, field.TypeTime, value)
+	}
+	if ppu.mutation.SuspendedUntilCleared() {
+		_spec.ClearField(providerprofile.FieldSuspendedUntil, field.TypeTime)
+	}
 	if ppu.mutation.APIKeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -651,25 +1319,41 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppu.mutation.CurrencyCleared() {
+	if ppu.mutation.CurrenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedCurrenciesIDs(); len(nodes) > 0 && !ppu.mutation.CurrenciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   providerprofile.CurrencyTable,
-			Columns: []string{providerprofile.CurrencyColumn},
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppu.mutation.CurrencyIDs(); len(nodes) > 0 {
+	if nodes := ppu.mutation.CurrenciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
+			Rel:     sqlgraph.M2M,
 			Inverse: true,
-			Table:   providerprofile.CurrencyTable,
-			Columns: []string{providerprofile.CurrencyColumn},
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
@@ -844,83 +1528,443 @@ func (ppu *ProviderProfileUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{providerprofile.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if ppu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	ppu.mutation.done = true
-	return n, nil
-}
-
-// ProviderProfileUpdateOne is the builder for updating a single ProviderProfile entity.
-type ProviderProfileUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProviderProfileMutation
-}
-
-// SetTradingName sets the "trading_name" field.
-func (ppuo *ProviderProfileUpdateOne) SetTradingName(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetTradingName(s)
-	return ppuo
-}
-
-// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableTradingName(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetTradingName(*s)
+	if nodes := ppu.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !ppu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return ppuo
-}
-
-// ClearTradingName clears the value of the "trading_name" field.
-func (ppuo *ProviderProfileUpdateOne) ClearTradingName() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearTradingName()
-	return ppuo
-}
-
-// SetHostIdentifier sets the "host_identifier" field.
-func (ppuo *ProviderProfileUpdateOne) SetHostIdentifier(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetHostIdentifier(s)
-	return ppuo
-}
-
-// SetNillableHostIdentifier sets the "host_identifier" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableHostIdentifier(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetHostIdentifier(*s)
+	if nodes := ppu.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return ppuo
-}
-
-// ClearHostIdentifier clears the value of the "host_identifier" field.
-func (ppuo *ProviderProfileUpdateOne) ClearHostIdentifier() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearHostIdentifier()
-	return ppuo
-}
-
-// SetProvisionMode sets the "provision_mode" field.
-func (ppuo *ProviderProfileUpdateOne) SetProvisionMode(pm providerprofile.ProvisionMode) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetProvisionMode(pm)
-	return ppuo
-}
-
-// SetNillableProvisionMode sets the "provision_mode" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableProvisionMode(pm *providerprofile.ProvisionMode) *ProviderProfileUpdateOne {
-	if pm != nil {
-		ppuo.SetProvisionMode(*pm)
+	if ppu.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return ppuo
-}
-
-// SetIsActive sets the "is_active" field.
-func (ppuo *ProviderProfileUpdateOne) SetIsActive(b bool) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetIsActive(b)
+	if nodes := ppu.mutation.RemovedTeamInvitationsIDs(); len(nodes) > 0 && !ppu.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.TeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedTeamAuditLogsIDs(); len(nodes) > 0 && !ppu.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.TeamAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.HealthChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedHealthChecksIDs(); len(nodes) > 0 && !ppu.mutation.HealthChecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.HealthChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.SLARecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedSLARecordsIDs(); len(nodes) > 0 && !ppu.mutation.SLARecordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.SLARecordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !ppu.mutation.DisputesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.DisputesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.OrderAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedOrderAssignmentsIDs(); len(nodes) > 0 && !ppu.mutation.OrderAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.OrderAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppu.mutation.RateSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RemovedRateSnapshotsIDs(); len(nodes) > 0 && !ppu.mutation.RateSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppu.mutation.RateSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ppu.mutation.done = true
+	return n, nil
+}
+
+// ProviderProfileUpdateOne is the builder for updating a single ProviderProfile entity.
+type ProviderProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderProfileMutation
+}
+
+// SetTradingName sets the "trading_name" field.
+func (ppuo *ProviderProfileUpdateOne) SetTradingName(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetTradingName(s)
+	return ppuo
+}
+
+// SetNillableTradingName sets the "trading_name" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableTradingName(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetTradingName(*s)
+	}
+	return ppuo
+}
+
+// ClearTradingName clears the value of the "trading_name" field.
+func (ppuo *ProviderProfileUpdateOne) ClearTradingName() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearTradingName()
+	return ppuo
+}
+
+// SetHostIdentifier sets the "host_identifier" field.
+func (ppuo *ProviderProfileUpdateOne) SetHostIdentifier(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetHostIdentifier(s)
+	return ppuo
+}
+
+// SetNillableHostIdentifier sets the "host_identifier" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableHostIdentifier(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetHostIdentifier(*s)
+	}
+	return ppuo
+}
+
+// ClearHostIdentifier clears the value of the "host_identifier" field.
+func (ppuo *ProviderProfileUpdateOne) ClearHostIdentifier() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearHostIdentifier()
+	return ppuo
+}
+
+// SetProvisionMode sets the "provision_mode" field.
+func (ppuo *ProviderProfileUpdateOne) SetProvisionMode(pm providerprofile.ProvisionMode) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetProvisionMode(pm)
+	return ppuo
+}
+
+// SetNillableProvisionMode sets the "provision_mode" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableProvisionMode(pm *providerprofile.ProvisionMode) *ProviderProfileUpdateOne {
+	if pm != nil {
+		ppuo.SetProvisionMode(*pm)
+	}
+	return ppuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (ppuo *ProviderProfileUpdateOne) SetIsActive(b bool) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIsActive(b)
 	return ppuo
 }
 
@@ -932,194 +1976,452 @@ func (ppuo *ProviderProfileUpdateOne) SetNillableIsActive(b *bool) *ProviderProf
 	return ppuo
 }
 
-// SetIsAvailable sets the "is_available" field.
-func (ppuo *ProviderProfileUpdateOne) SetIsAvailable(b bool) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetIsAvailable(b)
+// SetIsAvailable sets the "is_available" field.
+func (ppuo *ProviderProfileUpdateOne) SetIsAvailable(b bool) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIsAvailable(b)
+	return ppuo
+}
+
+// SetNillableIsAvailable sets the "is_available" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableIsAvailable(b *bool) *ProviderProfileUpdateOne {
+	if b != nil {
+		ppuo.SetIsAvailable(*b)
+	}
+	return ppuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ppuo *ProviderProfileUpdateOne) SetUpdatedAt(t time.Time) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetUpdatedAt(t)
+	return ppuo
+}
+
+// SetVisibilityMode sets the "visibility_mode" field.
+func (ppuo *ProviderProfileUpdateOne) SetVisibilityMode(pm providerprofile.VisibilityMode) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetVisibilityMode(pm)
+	return ppuo
+}
+
+// SetNillableVisibilityMode sets the "visibility_mode" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableVisibilityMode(pm *providerprofile.VisibilityMode) *ProviderProfileUpdateOne {
+	if pm != nil {
+		ppuo.SetVisibilityMode(*pm)
+	}
+	return ppuo
+}
+
+// SetAddress sets the "address" field.
+func (ppuo *ProviderProfileUpdateOne) SetAddress(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetAddress(s)
+	return ppuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableAddress(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetAddress(*s)
+	}
+	return ppuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (ppuo *ProviderProfileUpdateOne) ClearAddress() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearAddress()
+	return ppuo
+}
+
+// SetMobileNumber sets the "mobile_number" field.
+func (ppuo *ProviderProfileUpdateOne) SetMobileNumber(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetMobileNumber(s)
+	return ppuo
+}
+
+// SetNillableMobileNumber sets the "mobile_number" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableMobileNumber(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetMobileNumber(*s)
+	}
+	return ppuo
+}
+
+// ClearMobileNumber clears the value of the "mobile_number" field.
+func (ppuo *ProviderProfileUpdateOne) ClearMobileNumber() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearMobileNumber()
+	return ppuo
+}
+
+// SetDateOfBirth sets the "date_of_birth" field.
+func (ppuo *ProviderProfileUpdateOne) SetDateOfBirth(t time.Time) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetDateOfBirth(t)
+	return ppuo
+}
+
+// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableDateOfBirth(t *time.Time) *ProviderProfileUpdateOne {
+	if t != nil {
+		ppuo.SetDateOfBirth(*t)
+	}
+	return ppuo
+}
+
+// ClearDateOfBirth clears the value of the "date_of_birth" field.
+func (ppuo *ProviderProfileUpdateOne) ClearDateOfBirth() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearDateOfBirth()
+	return ppuo
+}
+
+// SetBusinessName sets the "business_name" field.
+func (ppuo *ProviderProfileUpdateOne) SetBusinessName(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetBusinessName(s)
+	return ppuo
+}
+
+// SetNillableBusinessName sets the "business_name" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableBusinessName(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetBusinessName(*s)
+	}
+	return ppuo
+}
+
+// ClearBusinessName clears the value of the "business_name" field.
+func (ppuo *ProviderProfileUpdateOne) ClearBusinessName() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearBusinessName()
+	return ppuo
+}
+
+// SetIdentityDocumentType sets the "identity_document_type" field.
+func (ppuo *ProviderProfileUpdateOne) SetIdentityDocumentType(pdt providerprofile.IdentityDocumentType) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIdentityDocumentType(pdt)
+	return ppuo
+}
+
+// SetNillableIdentityDocumentType sets the "identity_document_type" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableIdentityDocumentType(pdt *providerprofile.IdentityDocumentType) *ProviderProfileUpdateOne {
+	if pdt != nil {
+		ppuo.SetIdentityDocumentType(*pdt)
+	}
+	return ppuo
+}
+
+// ClearIdentityDocumentType clears the value of the "identity_document_type" field.
+func (ppuo *ProviderProfileUpdateOne) ClearIdentityDocumentType() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearIdentityDocumentType()
+	return ppuo
+}
+
+// SetIdentityDocument sets the "identity_document" field.
+func (ppuo *ProviderProfileUpdateOne) SetIdentityDocument(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIdentityDocument(s)
+	return ppuo
+}
+
+// SetNillableIdentityDocument sets the "identity_document" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableIdentityDocument(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetIdentityDocument(*s)
+	}
+	return ppuo
+}
+
+// ClearIdentityDocument clears the value of the "identity_document" field.
+func (ppuo *ProviderProfileUpdateOne) ClearIdentityDocument() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearIdentityDocument()
+	return ppuo
+}
+
+// SetBusinessDocument sets the "business_document" field.
+func (ppuo *ProviderProfileUpdateOne) SetBusinessDocument(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetBusinessDocument(s)
+	return ppuo
+}
+
+// SetNillableBusinessDocument sets the "business_document" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableBusinessDocument(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetBusinessDocument(*s)
+	}
+	return ppuo
+}
+
+// ClearBusinessDocument clears the value of the "business_document" field.
+func (ppuo *ProviderProfileUpdateOne) ClearBusinessDocument() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearBusinessDocument()
+	return ppuo
+}
+
+// SetIsKybVerified sets the "is_kyb_verified" field.
+func (ppuo *ProviderProfileUpdateOne) SetIsKybVerified(b bool) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIsKybVerified(b)
+	return ppuo
+}
+
+// SetNillableIsKybVerified sets the "is_kyb_verified" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableIsKybVerified(b *bool) *ProviderProfileUpdateOne {
+	if b != nil {
+		ppuo.SetIsKybVerified(*b)
+	}
+	return ppuo
+}
+
+// SetOperatingTimezone sets the "operating_timezone" field.
+func (ppuo *ProviderProfileUpdateOne) SetOperatingTimezone(s string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetOperatingTimezone(s)
+	return ppuo
+}
+
+// SetNillableOperatingTimezone sets the "operating_timezone" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableOperatingTimezone(s *string) *ProviderProfileUpdateOne {
+	if s != nil {
+		ppuo.SetOperatingTimezone(*s)
+	}
+	return ppuo
+}
+
+// ClearOperatingTimezone clears the value of the "operating_timezone" field.
+func (ppuo *ProviderProfileUpdateOne) ClearOperatingTimezone() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearOperatingTimezone()
+	return ppuo
+}
+
+// SetOperatingHours sets the "operating_hours" field.
+func (ppuo *ProviderProfileUpdateOne) SetOperatingHours(s []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetOperatingHours(s)
+	return ppuo
+}
+
+// AppendOperatingHours appends s to the "operating_hours" field.
+func (ppuo *ProviderProfileUpdateOne) AppendOperatingHours(s []struct {
+	Weekday int    "json:\"weekday\""
+	Open    string "json:\"open\""
+	Close   string "json:\"close\""
+}) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendOperatingHours(s)
+	return ppuo
+}
+
+// ClearOperatingHours clears the value of the "operating_hours" field.
+func (ppuo *ProviderProfileUpdateOne) ClearOperatingHours() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearOperatingHours()
+	return ppuo
+}
+
+// SetOperatingHoursExceptions sets the "operating_hours_exceptions" field.
+func (ppuo *ProviderProfileUpdateOne) SetOperatingHoursExceptions(s []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetOperatingHoursExceptions(s)
+	return ppuo
+}
+
+// AppendOperatingHoursExceptions appends s to the "operating_hours_exceptions" field.
+func (ppuo *ProviderProfileUpdateOne) AppendOperatingHoursExceptions(s []struct {
+	Date   string "json:\"date\""
+	Closed bool   "json:\"closed\""
+	Open   string "json:\"open\""
+	Close  string "json:\"close\""
+}) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendOperatingHoursExceptions(s)
+	return ppuo
+}
+
+// ClearOperatingHoursExceptions clears the value of the "operating_hours_exceptions" field.
+func (ppuo *ProviderProfileUpdateOne) ClearOperatingHoursExceptions() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearOperatingHoursExceptions()
+	return ppuo
+}
+
+// SetIsOpen sets the "is_open" field.
+func (ppuo *ProviderProfileUpdateOne) SetIsOpen(b bool) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIsOpen(b)
 	return ppuo
 }
 
-// SetNillableIsAvailable sets the "is_available" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableIsAvailable(b *bool) *ProviderProfileUpdateOne {
+// SetNillableIsOpen sets the "is_open" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableIsOpen(b *bool) *ProviderProfileUpdateOne {
 	if b != nil {
-		ppuo.SetIsAvailable(*b)
+		ppuo.SetIsOpen(*b)
 	}
 	return ppuo
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (ppuo *ProviderProfileUpdateOne) SetUpdatedAt(t time.Time) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetUpdatedAt(t)
+// SetSupportedInstitutions sets the "supported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) SetSupportedInstitutions(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetSupportedInstitutions(s)
 	return ppuo
 }
 
-// SetVisibilityMode sets the "visibility_mode" field.
-func (ppuo *ProviderProfileUpdateOne) SetVisibilityMode(pm providerprofile.VisibilityMode) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetVisibilityMode(pm)
+// AppendSupportedInstitutions appends s to the "supported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) AppendSupportedInstitutions(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendSupportedInstitutions(s)
 	return ppuo
 }
 
-// SetNillableVisibilityMode sets the "visibility_mode" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableVisibilityMode(pm *providerprofile.VisibilityMode) *ProviderProfileUpdateOne {
-	if pm != nil {
-		ppuo.SetVisibilityMode(*pm)
-	}
+// ClearSupportedInstitutions clears the value of the "supported_institutions" field.
+func (ppuo *ProviderProfileUpdateOne) ClearSupportedInstitutions() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearSupportedInstitutions()
 	return ppuo
 }
 
-// SetAddress sets the "address" field.
-func (ppuo *ProviderProfileUpdateOne) SetAddress(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetAddress(s)
+// SetSupportedInstitutionTypes sets the "supported_institution_types" field.
+func (ppuo *ProviderProfileUpdateOne) SetSupportedInstitutionTypes(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetSupportedInstitutionTypes(s)
 	return ppuo
 }
 
-// SetNillableAddress sets the "address" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableAddress(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetAddress(*s)
-	}
+// AppendSupportedInstitutionTypes appends s to the "supported_institution_types" field.
+func (ppuo *ProviderProfileUpdateOne) AppendSupportedInstitutionTypes(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendSupportedInstitutionTypes(s)
 	return ppuo
 }
 
-// ClearAddress clears the value of the "address" field.
-func (ppuo *ProviderProfileUpdateOne) ClearAddress() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearAddress()
+// ClearSupportedInstitutionTypes clears the value of the "supported_institution_types" field.
+func (ppuo *ProviderProfileUpdateOne) ClearSupportedInstitutionTypes() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearSupportedInstitutionTypes()
 	return ppuo
 }
 
-// SetMobileNumber sets the "mobile_number" field.
-func (ppuo *ProviderProfileUpdateOne) SetMobileNumber(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetMobileNumber(s)
+// SetIsHealthy sets the "is_healthy" field.
+func (ppuo *ProviderProfileUpdateOne) SetIsHealthy(b bool) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetIsHealthy(b)
 	return ppuo
 }
 
-// SetNillableMobileNumber sets the "mobile_number" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableMobileNumber(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetMobileNumber(*s)
+// SetNillableIsHealthy sets the "is_healthy" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableIsHealthy(b *bool) *ProviderProfileUpdateOne {
+	if b != nil {
+		ppuo.SetIsHealthy(*b)
 	}
 	return ppuo
 }
 
-// ClearMobileNumber clears the value of the "mobile_number" field.
-func (ppuo *ProviderProfileUpdateOne) ClearMobileNumber() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearMobileNumber()
-	return ppuo
-}
-
-// SetDateOfBirth sets the "date_of_birth" field.
-func (ppuo *ProviderProfileUpdateOne) SetDateOfBirth(t time.Time) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetDateOfBirth(t)
+// SetHealthFailureStreak sets the "health_failure_streak" field.
+func (ppuo *ProviderProfileUpdateOne) SetHealthFailureStreak(i int) *ProviderProfileUpdateOne {
+	ppuo.mutation.ResetHealthFailureStreak()
+	ppuo.mutation.SetHealthFailureStreak(i)
 	return ppuo
 }
 
-// SetNillableDateOfBirth sets the "date_of_birth" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableDateOfBirth(t *time.Time) *ProviderProfileUpdateOne {
-	if t != nil {
-		ppuo.SetDateOfBirth(*t)
+// SetNillableHealthFailureStreak sets the "health_failure_streak" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableHealthFailureStreak(i *int) *ProviderProfileUpdateOne {
+	if i != nil {
+		ppuo.SetHealthFailureStreak(*i)
 	}
 	return ppuo
 }
 
-// ClearDateOfBirth clears the value of the "date_of_birth" field.
-func (ppuo *ProviderProfileUpdateOne) ClearDateOfBirth() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearDateOfBirth()
+// AddHealthFailureStreak adds i to the "health_failure_streak" field.
+func (ppuo *ProviderProfileUpdateOne) AddHealthFailureStreak(i int) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddHealthFailureStreak(i)
 	return ppuo
 }
 
-// SetBusinessName sets the "business_name" field.
-func (ppuo *ProviderProfileUpdateOne) SetBusinessName(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetBusinessName(s)
+// SetLastHealthCheckAt sets the "last_health_check_at" field.
+func (ppuo *ProviderProfileUpdateOne) SetLastHealthCheckAt(t time.Time) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetLastHealthCheckAt(t)
 	return ppuo
 }
 
-// SetNillableBusinessName sets the "business_name" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableBusinessName(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetBusinessName(*s)
+// SetNillableLastHealthCheckAt sets the "last_health_check_at" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableLastHealthCheckAt(t *time.Time) *ProviderProfileUpdateOne {
+	if t != nil {
+		ppuo.SetLastHealthCheckAt(*t)
 	}
 	return ppuo
 }
 
-// ClearBusinessName clears the value of the "business_name" field.
-func (ppuo *ProviderProfileUpdateOne) ClearBusinessName() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearBusinessName()
+// ClearLastHealthCheckAt clears the value of the "last_health_check_at" field.
+func (ppuo *ProviderProfileUpdateOne) ClearLastHealthCheckAt() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearLastHealthCheckAt()
 	return ppuo
 }
 
-// SetIdentityDocumentType sets the "identity_document_type" field.
-func (ppuo *ProviderProfileUpdateOne) SetIdentityDocumentType(pdt providerprofile.IdentityDocumentType) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetIdentityDocumentType(pdt)
+// SetNodeProtocolVersion sets the "node_protocol_version" field.
+func (ppuo *ProviderProfileUpdateOne) SetNodeProtocolVersion(i int) *ProviderProfileUpdateOne {
+	ppuo.mutation.ResetNodeProtocolVersion()
+	ppuo.mutation.SetNodeProtocolVersion(i)
 	return ppuo
 }
 
-// SetNillableIdentityDocumentType sets the "identity_document_type" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableIdentityDocumentType(pdt *providerprofile.IdentityDocumentType) *ProviderProfileUpdateOne {
-	if pdt != nil {
-		ppuo.SetIdentityDocumentType(*pdt)
+// SetNillableNodeProtocolVersion sets the "node_protocol_version" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableNodeProtocolVersion(i *int) *ProviderProfileUpdateOne {
+	if i != nil {
+		ppuo.SetNodeProtocolVersion(*i)
 	}
 	return ppuo
 }
 
-// ClearIdentityDocumentType clears the value of the "identity_document_type" field.
-func (ppuo *ProviderProfileUpdateOne) ClearIdentityDocumentType() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearIdentityDocumentType()
+// AddNodeProtocolVersion adds i to the "node_protocol_version" field.
+func (ppuo *ProviderProfileUpdateOne) AddNodeProtocolVersion(i int) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddNodeProtocolVersion(i)
 	return ppuo
 }
 
-// SetIdentityDocument sets the "identity_document" field.
-func (ppuo *ProviderProfileUpdateOne) SetIdentityDocument(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetIdentityDocument(s)
+// SetNodeCapabilities sets the "node_capabilities" field.
+func (ppuo *ProviderProfileUpdateOne) SetNodeCapabilities(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetNodeCapabilities(s)
 	return ppuo
 }
 
-// SetNillableIdentityDocument sets the "identity_document" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableIdentityDocument(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetIdentityDocument(*s)
-	}
+// AppendNodeCapabilities appends s to the "node_capabilities" field.
+func (ppuo *ProviderProfileUpdateOne) AppendNodeCapabilities(s []string) *ProviderProfileUpdateOne {
+	ppuo.mutation.AppendNodeCapabilities(s)
 	return ppuo
 }
 
-// ClearIdentityDocument clears the value of the "identity_document" field.
-func (ppuo *ProviderProfileUpdateOne) ClearIdentityDocument() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearIdentityDocument()
+// ClearNodeCapabilities clears the value of the "node_capabilities" field.
+func (ppuo *ProviderProfileUpdateOne) ClearNodeCapabilities() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearNodeCapabilities()
 	return ppuo
 }
 
-// SetBusinessDocument sets the "business_document" field.
-func (ppuo *ProviderProfileUpdateOne) SetBusinessDocument(s string) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetBusinessDocument(s)
+// SetDemotedUntil sets the "demoted_until" field.
+func (ppuo *ProviderProfileUpdateOne) SetDemotedUntil(t time.Time) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetDemotedUntil(t)
 	return ppuo
 }
 
-// SetNillableBusinessDocument sets the "business_document" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableBusinessDocument(s *string) *ProviderProfileUpdateOne {
-	if s != nil {
-		ppuo.SetBusinessDocument(*s)
+// SetNillableDemotedUntil sets the "demoted_until" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableDemotedUntil(t *time.Time) *ProviderProfileUpdateOne {
+	if t != nil {
+		ppuo.SetDemotedUntil(*t)
 	}
 	return ppuo
 }
 
-// ClearBusinessDocument clears the value of the "business_document" field.
-func (ppuo *ProviderProfileUpdateOne) ClearBusinessDocument() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearBusinessDocument()
+// ClearDemotedUntil clears the value of the "demoted_until" field.
+func (ppuo *ProviderProfileUpdateOne) ClearDemotedUntil() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearDemotedUntil()
 	return ppuo
 }
 
-// SetIsKybVerified sets the "is_kyb_verified" field.
-func (ppuo *ProviderProfileUpdateOne) SetIsKybVerified(b bool) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetIsKybVerified(b)
+// SetSuspendedUntil sets the "suspended_until" field.
+func (ppuo *ProviderProfileUpdateOne) SetSuspendedUntil(t time.Time) *ProviderProfileUpdateOne {
+	ppuo.mutation.SetSuspendedUntil(t)
 	return ppuo
 }
 
-// SetNillableIsKybVerified sets the "is_kyb_verified" field if the given value is not nil.
-func (ppuo *ProviderProfileUpdateOne) SetNillableIsKybVerified(b *bool) *ProviderProfileUpdateOne {
-	if b != nil {
-		ppuo.SetIsKybVerified(*b)
+// SetNillableSuspendedUntil sets the "suspended_until" field if the given value is not nil.
+func (ppuo *ProviderProfileUpdateOne) SetNillableSuspendedUntil(t *time.Time) *ProviderProfileUpdateOne {
+	if t != nil {
+		ppuo.SetSuspendedUntil(*t)
 	}
 	return ppuo
 }
 
+// ClearSuspendedUntil clears the value of the "suspended_until" field.
+func (ppuo *ProviderProfileUpdateOne) ClearSuspendedUntil() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearSuspendedUntil()
+	return ppuo
+}
+
 // SetAPIKeyID sets the "api_key" edge to the APIKey entity by ID.
 func (ppuo *ProviderProfileUpdateOne) SetAPIKeyID(id uuid.UUID) *ProviderProfileUpdateOne {
 	ppuo.mutation.SetAPIKeyID(id)
@@ -1139,15 +2441,19 @@ func (ppuo *ProviderProfileUpdateOne) SetAPIKey(a *APIKey) *ProviderProfileUpdat
 	return ppuo.SetAPIKeyID(a.ID)
 }
 
-// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
-func (ppuo *ProviderProfileUpdateOne) SetCurrencyID(id uuid.UUID) *ProviderProfileUpdateOne {
-	ppuo.mutation.SetCurrencyID(id)
+// AddCurrencyIDs adds the "currencies" edge to the FiatCurrency entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddCurrencyIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddCurrencyIDs(ids...)
 	return ppuo
 }
 
-// SetCurrency sets the "currency" edge to the FiatCurrency entity.
-func (ppuo *ProviderProfileUpdateOne) SetCurrency(f *FiatCurrency) *ProviderProfileUpdateOne {
-	return ppuo.SetCurrencyID(f.ID)
+// AddCurrencies adds the "currencies" edges to the FiatCurrency entity.
+func (ppuo *ProviderProfileUpdateOne) AddCurrencies(f ...*FiatCurrency) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ppuo.AddCurrencyIDs(ids...)
 }
 
 // AddProvisionBucketIDs adds the "provision_buckets" edge to the ProvisionBucket entity by IDs.
@@ -1214,6 +2520,126 @@ func (ppuo *ProviderProfileUpdateOne) AddAssignedOrders(l ...*LockPaymentOrder)
 	return ppuo.AddAssignedOrderIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddTeamMemberIDs(ids...)
+	return ppuo
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (ppuo *ProviderProfileUpdateOne) AddTeamMembers(t ...*TeamMember) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.AddTeamMemberIDs(ids...)
+}
+
+// AddTeamInvitationIDs adds the "team_invitations" edge to the TeamInvitation entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddTeamInvitationIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddTeamInvitationIDs(ids...)
+	return ppuo
+}
+
+// AddTeamInvitations adds the "team_invitations" edges to the TeamInvitation entity.
+func (ppuo *ProviderProfileUpdateOne) AddTeamInvitations(t ...*TeamInvitation) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.AddTeamInvitationIDs(ids...)
+}
+
+// AddTeamAuditLogIDs adds the "team_audit_logs" edge to the TeamAuditLog entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddTeamAuditLogIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddTeamAuditLogIDs(ids...)
+	return ppuo
+}
+
+// AddTeamAuditLogs adds the "team_audit_logs" edges to the TeamAuditLog entity.
+func (ppuo *ProviderProfileUpdateOne) AddTeamAuditLogs(t ...*TeamAuditLog) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.AddTeamAuditLogIDs(ids...)
+}
+
+// AddHealthCheckIDs adds the "health_checks" edge to the ProviderHealthCheck entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddHealthCheckIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddHealthCheckIDs(ids...)
+	return ppuo
+}
+
+// AddHealthChecks adds the "health_checks" edges to the ProviderHealthCheck entity.
+func (ppuo *ProviderProfileUpdateOne) AddHealthChecks(p ...*ProviderHealthCheck) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.AddHealthCheckIDs(ids...)
+}
+
+// AddSLARecordIDs adds the "sla_records" edge to the ProviderSLARecord entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddSLARecordIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddSLARecordIDs(ids...)
+	return ppuo
+}
+
+// AddSLARecords adds the "sla_records" edges to the ProviderSLARecord entity.
+func (ppuo *ProviderProfileUpdateOne) AddSLARecords(p ...*ProviderSLARecord) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.AddSLARecordIDs(ids...)
+}
+
+// AddDisputeIDs adds the "disputes" edge to the Dispute entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddDisputeIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddDisputeIDs(ids...)
+	return ppuo
+}
+
+// AddDisputes adds the "disputes" edges to the Dispute entity.
+func (ppuo *ProviderProfileUpdateOne) AddDisputes(d ...*Dispute) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return ppuo.AddDisputeIDs(ids...)
+}
+
+// AddOrderAssignmentIDs adds the "order_assignments" edge to the OrderAssignment entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddOrderAssignmentIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddOrderAssignmentIDs(ids...)
+	return ppuo
+}
+
+// AddOrderAssignments adds the "order_assignments" edges to the OrderAssignment entity.
+func (ppuo *ProviderProfileUpdateOne) AddOrderAssignments(o ...*OrderAssignment) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ppuo.AddOrderAssignmentIDs(ids...)
+}
+
+// AddRateSnapshotIDs adds the "rate_snapshots" edge to the RateSnapshot entity by IDs.
+func (ppuo *ProviderProfileUpdateOne) AddRateSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.AddRateSnapshotIDs(ids...)
+	return ppuo
+}
+
+// AddRateSnapshots adds the "rate_snapshots" edges to the RateSnapshot entity.
+func (ppuo *ProviderProfileUpdateOne) AddRateSnapshots(r ...*RateSnapshot) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ppuo.AddRateSnapshotIDs(ids...)
+}
+
 // Mutation returns the ProviderProfileMutation object of the builder.
 func (ppuo *ProviderProfileUpdateOne) Mutation() *ProviderProfileMutation {
 	return ppuo.mutation
@@ -1225,12 +2651,27 @@ func (ppuo *ProviderProfileUpdateOne) ClearAPIKey() *ProviderProfileUpdateOne {
 	return ppuo
 }
 
-// ClearCurrency clears the "currency" edge to the FiatCurrency entity.
-func (ppuo *ProviderProfileUpdateOne) ClearCurrency() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearCurrency()
+// ClearCurrencies clears all "currencies" edges to the FiatCurrency entity.
+func (ppuo *ProviderProfileUpdateOne) ClearCurrencies() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearCurrencies()
+	return ppuo
+}
+
+// RemoveCurrencyIDs removes the "currencies" edge to FiatCurrency entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveCurrencyIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveCurrencyIDs(ids...)
 	return ppuo
 }
 
+// RemoveCurrencies removes "currencies" edges to FiatCurrency entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveCurrencies(f ...*FiatCurrency) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return ppuo.RemoveCurrencyIDs(ids...)
+}
+
 // ClearProvisionBuckets clears all "provision_buckets" edges to the ProvisionBucket entity.
 func (ppuo *ProviderProfileUpdateOne) ClearProvisionBuckets() *ProviderProfileUpdateOne {
 	ppuo.mutation.ClearProvisionBuckets()
@@ -1264,40 +2705,208 @@ func (ppuo *ProviderProfileUpdateOne) RemoveOrderTokenIDs(ids ...int) *ProviderP
 	return ppuo
 }
 
-// RemoveOrderTokens removes "order_tokens" edges to ProviderOrderToken entities.
-func (ppuo *ProviderProfileUpdateOne) RemoveOrderTokens(p ...*ProviderOrderToken) *ProviderProfileUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+// RemoveOrderTokens removes "order_tokens" edges to ProviderOrderToken entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveOrderTokens(p ...*ProviderOrderToken) *ProviderProfileUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.RemoveOrderTokenIDs(ids...)
+}
+
+// ClearProviderRating clears the "provider_rating" edge to the ProviderRating entity.
+func (ppuo *ProviderProfileUpdateOne) ClearProviderRating() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearProviderRating()
+	return ppuo
+}
+
+// ClearAssignedOrders clears all "assigned_orders" edges to the LockPaymentOrder entity.
+func (ppuo *ProviderProfileUpdateOne) ClearAssignedOrders() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearAssignedOrders()
+	return ppuo
+}
+
+// RemoveAssignedOrderIDs removes the "assigned_orders" edge to LockPaymentOrder entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveAssignedOrderIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveAssignedOrderIDs(ids...)
+	return ppuo
+}
+
+// RemoveAssignedOrders removes "assigned_orders" edges to LockPaymentOrder entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveAssignedOrders(l ...*LockPaymentOrder) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ppuo.RemoveAssignedOrderIDs(ids...)
+}
+
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (ppuo *ProviderProfileUpdateOne) ClearTeamMembers() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearTeamMembers()
+	return ppuo
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamMemberIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveTeamMemberIDs(ids...)
+	return ppuo
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamMembers(t ...*TeamMember) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearTeamInvitations clears all "team_invitations" edges to the TeamInvitation entity.
+func (ppuo *ProviderProfileUpdateOne) ClearTeamInvitations() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearTeamInvitations()
+	return ppuo
+}
+
+// RemoveTeamInvitationIDs removes the "team_invitations" edge to TeamInvitation entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamInvitationIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveTeamInvitationIDs(ids...)
+	return ppuo
+}
+
+// RemoveTeamInvitations removes "team_invitations" edges to TeamInvitation entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamInvitations(t ...*TeamInvitation) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.RemoveTeamInvitationIDs(ids...)
+}
+
+// ClearTeamAuditLogs clears all "team_audit_logs" edges to the TeamAuditLog entity.
+func (ppuo *ProviderProfileUpdateOne) ClearTeamAuditLogs() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearTeamAuditLogs()
+	return ppuo
+}
+
+// RemoveTeamAuditLogIDs removes the "team_audit_logs" edge to TeamAuditLog entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamAuditLogIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveTeamAuditLogIDs(ids...)
+	return ppuo
+}
+
+// RemoveTeamAuditLogs removes "team_audit_logs" edges to TeamAuditLog entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveTeamAuditLogs(t ...*TeamAuditLog) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ppuo.RemoveTeamAuditLogIDs(ids...)
+}
+
+// ClearHealthChecks clears all "health_checks" edges to the ProviderHealthCheck entity.
+func (ppuo *ProviderProfileUpdateOne) ClearHealthChecks() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearHealthChecks()
+	return ppuo
+}
+
+// RemoveHealthCheckIDs removes the "health_checks" edge to ProviderHealthCheck entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveHealthCheckIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveHealthCheckIDs(ids...)
+	return ppuo
+}
+
+// RemoveHealthChecks removes "health_checks" edges to ProviderHealthCheck entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveHealthChecks(p ...*ProviderHealthCheck) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.RemoveHealthCheckIDs(ids...)
+}
+
+// ClearSLARecords clears all "sla_records" edges to the ProviderSLARecord entity.
+func (ppuo *ProviderProfileUpdateOne) ClearSLARecords() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearSLARecords()
+	return ppuo
+}
+
+// RemoveSLARecordIDs removes the "sla_records" edge to ProviderSLARecord entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveSLARecordIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveSLARecordIDs(ids...)
+	return ppuo
+}
+
+// RemoveSLARecords removes "sla_records" edges to ProviderSLARecord entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveSLARecords(p ...*ProviderSLARecord) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ppuo.RemoveSLARecordIDs(ids...)
+}
+
+// ClearDisputes clears all "disputes" edges to the Dispute entity.
+func (ppuo *ProviderProfileUpdateOne) ClearDisputes() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearDisputes()
+	return ppuo
+}
+
+// RemoveDisputeIDs removes the "disputes" edge to Dispute entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveDisputeIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveDisputeIDs(ids...)
+	return ppuo
+}
+
+// RemoveDisputes removes "disputes" edges to Dispute entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveDisputes(d ...*Dispute) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
 	}
-	return ppuo.RemoveOrderTokenIDs(ids...)
+	return ppuo.RemoveDisputeIDs(ids...)
 }
 
-// ClearProviderRating clears the "provider_rating" edge to the ProviderRating entity.
-func (ppuo *ProviderProfileUpdateOne) ClearProviderRating() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearProviderRating()
+// ClearOrderAssignments clears all "order_assignments" edges to the OrderAssignment entity.
+func (ppuo *ProviderProfileUpdateOne) ClearOrderAssignments() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearOrderAssignments()
 	return ppuo
 }
 
-// ClearAssignedOrders clears all "assigned_orders" edges to the LockPaymentOrder entity.
-func (ppuo *ProviderProfileUpdateOne) ClearAssignedOrders() *ProviderProfileUpdateOne {
-	ppuo.mutation.ClearAssignedOrders()
+// RemoveOrderAssignmentIDs removes the "order_assignments" edge to OrderAssignment entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveOrderAssignmentIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveOrderAssignmentIDs(ids...)
 	return ppuo
 }
 
-// RemoveAssignedOrderIDs removes the "assigned_orders" edge to LockPaymentOrder entities by IDs.
-func (ppuo *ProviderProfileUpdateOne) RemoveAssignedOrderIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
-	ppuo.mutation.RemoveAssignedOrderIDs(ids...)
+// RemoveOrderAssignments removes "order_assignments" edges to OrderAssignment entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveOrderAssignments(o ...*OrderAssignment) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ppuo.RemoveOrderAssignmentIDs(ids...)
+}
+
+// ClearRateSnapshots clears all "rate_snapshots" edges to the RateSnapshot entity.
+func (ppuo *ProviderProfileUpdateOne) ClearRateSnapshots() *ProviderProfileUpdateOne {
+	ppuo.mutation.ClearRateSnapshots()
 	return ppuo
 }
 
-// RemoveAssignedOrders removes "assigned_orders" edges to LockPaymentOrder entities.
-func (ppuo *ProviderProfileUpdateOne) RemoveAssignedOrders(l ...*LockPaymentOrder) *ProviderProfileUpdateOne {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+// RemoveRateSnapshotIDs removes the "rate_snapshots" edge to RateSnapshot entities by IDs.
+func (ppuo *ProviderProfileUpdateOne) RemoveRateSnapshotIDs(ids ...uuid.UUID) *ProviderProfileUpdateOne {
+	ppuo.mutation.RemoveRateSnapshotIDs(ids...)
+	return ppuo
+}
+
+// RemoveRateSnapshots removes "rate_snapshots" edges to RateSnapshot entities.
+func (ppuo *ProviderProfileUpdateOne) RemoveRateSnapshots(r ...*RateSnapshot) *ProviderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	return ppuo.RemoveAssignedOrderIDs(ids...)
+	return ppuo.RemoveRateSnapshotIDs(ids...)
 }
 
 // Where appends a list predicates to the ProviderProfileUpdate builder.
@@ -1374,9 +2983,6 @@ func (ppuo *ProviderProfileUpdateOne) check() error {
 	if ppuo.mutation.UserCleared() && len(ppuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ProviderProfile.user"`)
 	}
-	if ppuo.mutation.CurrencyCleared() && len(ppuo.mutation.CurrencyIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "ProviderProfile.currency"`)
-	}
 	return nil
 }
 
@@ -1448,61 +3054,502 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 	if ppuo.mutation.MobileNumberCleared() {
 		_spec.ClearField(providerprofile.FieldMobileNumber, field.TypeString)
 	}
-	if value, ok := ppuo.mutation.DateOfBirth(); ok {
-		_spec.SetField(providerprofile.FieldDateOfBirth, field.TypeTime, value)
+	if value, ok := ppuo.mutation.DateOfBirth(); ok {
+		_spec.SetField(providerprofile.FieldDateOfBirth, field.TypeTime, value)
+	}
+	if ppuo.mutation.DateOfBirthCleared() {
+		_spec.ClearField(providerprofile.FieldDateOfBirth, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.BusinessName(); ok {
+		_spec.SetField(providerprofile.FieldBusinessName, field.TypeString, value)
+	}
+	if ppuo.mutation.BusinessNameCleared() {
+		_spec.ClearField(providerprofile.FieldBusinessName, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.IdentityDocumentType(); ok {
+		_spec.SetField(providerprofile.FieldIdentityDocumentType, field.TypeEnum, value)
+	}
+	if ppuo.mutation.IdentityDocumentTypeCleared() {
+		_spec.ClearField(providerprofile.FieldIdentityDocumentType, field.TypeEnum)
+	}
+	if value, ok := ppuo.mutation.IdentityDocument(); ok {
+		_spec.SetField(providerprofile.FieldIdentityDocument, field.TypeString, value)
+	}
+	if ppuo.mutation.IdentityDocumentCleared() {
+		_spec.ClearField(providerprofile.FieldIdentityDocument, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.BusinessDocument(); ok {
+		_spec.SetField(providerprofile.FieldBusinessDocument, field.TypeString, value)
+	}
+	if ppuo.mutation.BusinessDocumentCleared() {
+		_spec.ClearField(providerprofile.FieldBusinessDocument, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.IsKybVerified(); ok {
+		_spec.SetField(providerprofile.FieldIsKybVerified, field.TypeBool, value)
+	}
+	if value, ok := ppuo.mutation.OperatingTimezone(); ok {
+		_spec.SetField(providerprofile.FieldOperatingTimezone, field.TypeString, value)
+	}
+	if ppuo.mutation.OperatingTimezoneCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingTimezone, field.TypeString)
+	}
+	if value, ok := ppuo.mutation.OperatingHours(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHours, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedOperatingHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldOperatingHours, value)
+		})
+	}
+	if ppuo.mutation.OperatingHoursCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingHours, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.OperatingHoursExceptions(); ok {
+		_spec.SetField(providerprofile.FieldOperatingHoursExceptions, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedOperatingHoursExceptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldOperatingHoursExceptions, value)
+		})
+	}
+	if ppuo.mutation.OperatingHoursExceptionsCleared() {
+		_spec.ClearField(providerprofile.FieldOperatingHoursExceptions, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.IsOpen(); ok {
+		_spec.SetField(providerprofile.FieldIsOpen, field.TypeBool, value)
+	}
+	if value, ok := ppuo.mutation.SupportedInstitutions(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutions, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedSupportedInstitutions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldSupportedInstitutions, value)
+		})
+	}
+	if ppuo.mutation.SupportedInstitutionsCleared() {
+		_spec.ClearField(providerprofile.FieldSupportedInstitutions, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.SupportedInstitutionTypes(); ok {
+		_spec.SetField(providerprofile.FieldSupportedInstitutionTypes, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedSupportedInstitutionTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldSupportedInstitutionTypes, value)
+		})
+	}
+	if ppuo.mutation.SupportedInstitutionTypesCleared() {
+		_spec.ClearField(providerprofile.FieldSupportedInstitutionTypes, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.IsHealthy(); ok {
+		_spec.SetField(providerprofile.FieldIsHealthy, field.TypeBool, value)
+	}
+	if value, ok := ppuo.mutation.HealthFailureStreak(); ok {
+		_spec.SetField(providerprofile.FieldHealthFailureStreak, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedHealthFailureStreak(); ok {
+		_spec.AddField(providerprofile.FieldHealthFailureStreak, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.LastHealthCheckAt(); ok {
+		_spec.SetField(providerprofile.FieldLastHealthCheckAt, field.TypeTime, value)
+	}
+	if ppuo.mutation.LastHealthCheckAtCleared() {
+		_spec.ClearField(providerprofile.FieldLastHealthCheckAt, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.NodeProtocolVersion(); ok {
+		_spec.SetField(providerprofile.FieldNodeProtocolVersion, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedNodeProtocolVersion(); ok {
+		_spec.AddField(providerprofile.FieldNodeProtocolVersion, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.NodeCapabilities(); ok {
+		_spec.SetField(providerprofile.FieldNodeCapabilities, field.TypeJSON, value)
+	}
+	if value, ok := ppuo.mutation.AppendedNodeCapabilities(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, providerprofile.FieldNodeCapabilities, value)
+		})
+	}
+	if ppuo.mutation.NodeCapabilitiesCleared() {
+		_spec.ClearField(providerprofile.FieldNodeCapabilities, field.TypeJSON)
+	}
+	if value, ok := ppuo.mutation.DemotedUntil(); ok {
+		_spec.SetField(providerprofile.FieldDemotedUntil, field.TypeTime, value)
+	}
+	if ppuo.mutation.DemotedUntilCleared() {
+		_spec.ClearField(providerprofile.FieldDemotedUntil, field.TypeTime)
+	}
+	if value, ok := ppuo.mutation.SuspendedUntil(); ok {
+		_spec.SetField(providerprofile.FieldSuspendedUntil, field.TypeTime, value)
+	}
+	if ppuo.mutation.SuspendedUntilCleared() {
+		_spec.ClearField(providerprofile.FieldSuspendedUntil, field.TypeTime)
+	}
+	if ppuo.mutation.APIKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.APIKeyTable,
+			Columns: []string{providerprofile.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.APIKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.APIKeyTable,
+			Columns: []string{providerprofile.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.CurrenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedCurrenciesIDs(); len(nodes) > 0 && !ppuo.mutation.CurrenciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.CurrenciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.CurrenciesTable,
+			Columns: providerprofile.CurrenciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.ProvisionBucketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.ProvisionBucketsTable,
+			Columns: providerprofile.ProvisionBucketsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(provisionbucket.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedProvisionBucketsIDs(); len(nodes) > 0 && !ppuo.mutation.ProvisionBucketsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.ProvisionBucketsTable,
+			Columns: providerprofile.ProvisionBucketsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(provisionbucket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.ProvisionBucketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   providerprofile.ProvisionBucketsTable,
+			Columns: providerprofile.ProvisionBucketsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(provisionbucket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.OrderTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderTokensTable,
+			Columns: []string{providerprofile.OrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.RemovedOrderTokensIDs(); len(nodes) > 0 && !ppuo.mutation.OrderTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderTokensTable,
+			Columns: []string{providerprofile.OrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.OrderTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderTokensTable,
+			Columns: []string{providerprofile.OrderTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ppuo.mutation.ProviderRatingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.ProviderRatingTable,
+			Columns: []string{providerprofile.ProviderRatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrating.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.ProviderRatingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   providerprofile.ProviderRatingTable,
+			Columns: []string{providerprofile.ProviderRatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerrating.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.DateOfBirthCleared() {
-		_spec.ClearField(providerprofile.FieldDateOfBirth, field.TypeTime)
+	if ppuo.mutation.AssignedOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.AssignedOrdersTable,
+			Columns: []string{providerprofile.AssignedOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := ppuo.mutation.BusinessName(); ok {
-		_spec.SetField(providerprofile.FieldBusinessName, field.TypeString, value)
+	if nodes := ppuo.mutation.RemovedAssignedOrdersIDs(); len(nodes) > 0 && !ppuo.mutation.AssignedOrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.AssignedOrdersTable,
+			Columns: []string{providerprofile.AssignedOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ppuo.mutation.BusinessNameCleared() {
-		_spec.ClearField(providerprofile.FieldBusinessName, field.TypeString)
+	if nodes := ppuo.mutation.AssignedOrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.AssignedOrdersTable,
+			Columns: []string{providerprofile.AssignedOrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := ppuo.mutation.IdentityDocumentType(); ok {
-		_spec.SetField(providerprofile.FieldIdentityDocumentType, field.TypeEnum, value)
+	if ppuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ppuo.mutation.IdentityDocumentTypeCleared() {
-		_spec.ClearField(providerprofile.FieldIdentityDocumentType, field.TypeEnum)
+	if nodes := ppuo.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !ppuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := ppuo.mutation.IdentityDocument(); ok {
-		_spec.SetField(providerprofile.FieldIdentityDocument, field.TypeString, value)
+	if nodes := ppuo.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamMembersTable,
+			Columns: []string{providerprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.IdentityDocumentCleared() {
-		_spec.ClearField(providerprofile.FieldIdentityDocument, field.TypeString)
+	if ppuo.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := ppuo.mutation.BusinessDocument(); ok {
-		_spec.SetField(providerprofile.FieldBusinessDocument, field.TypeString, value)
+	if nodes := ppuo.mutation.RemovedTeamInvitationsIDs(); len(nodes) > 0 && !ppuo.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ppuo.mutation.BusinessDocumentCleared() {
-		_spec.ClearField(providerprofile.FieldBusinessDocument, field.TypeString)
+	if nodes := ppuo.mutation.TeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamInvitationsTable,
+			Columns: []string{providerprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := ppuo.mutation.IsKybVerified(); ok {
-		_spec.SetField(providerprofile.FieldIsKybVerified, field.TypeBool, value)
+	if ppuo.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ppuo.mutation.APIKeyCleared() {
+	if nodes := ppuo.mutation.RemovedTeamAuditLogsIDs(); len(nodes) > 0 && !ppuo.mutation.TeamAuditLogsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.APIKeyIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.TeamAuditLogsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.APIKeyTable,
-			Columns: []string{providerprofile.APIKeyColumn},
+			Table:   providerprofile.TeamAuditLogsTable,
+			Columns: []string{providerprofile.TeamAuditLogsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1510,28 +3557,44 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.CurrencyCleared() {
+	if ppuo.mutation.HealthChecksCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   providerprofile.CurrencyTable,
-			Columns: []string{providerprofile.CurrencyColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.CurrencyIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.RemovedHealthChecksIDs(); len(nodes) > 0 && !ppuo.mutation.HealthChecksCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   providerprofile.CurrencyTable,
-			Columns: []string{providerprofile.CurrencyColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.HealthChecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.HealthChecksTable,
+			Columns: []string{providerprofile.HealthChecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerhealthcheck.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1539,28 +3602,28 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.ProvisionBucketsCleared() {
+	if ppuo.mutation.SLARecordsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   providerprofile.ProvisionBucketsTable,
-			Columns: providerprofile.ProvisionBucketsPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(provisionbucket.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.RemovedProvisionBucketsIDs(); len(nodes) > 0 && !ppuo.mutation.ProvisionBucketsCleared() {
+	if nodes := ppuo.mutation.RemovedSLARecordsIDs(); len(nodes) > 0 && !ppuo.mutation.SLARecordsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   providerprofile.ProvisionBucketsTable,
-			Columns: providerprofile.ProvisionBucketsPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(provisionbucket.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1568,15 +3631,15 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.ProvisionBucketsIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.SLARecordsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   providerprofile.ProvisionBucketsTable,
-			Columns: providerprofile.ProvisionBucketsPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.SLARecordsTable,
+			Columns: []string{providerprofile.SLARecordsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(provisionbucket.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1584,28 +3647,28 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.OrderTokensCleared() {
+	if ppuo.mutation.DisputesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.OrderTokensTable,
-			Columns: []string{providerprofile.OrderTokensColumn},
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.RemovedOrderTokensIDs(); len(nodes) > 0 && !ppuo.mutation.OrderTokensCleared() {
+	if nodes := ppuo.mutation.RemovedDisputesIDs(); len(nodes) > 0 && !ppuo.mutation.DisputesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.OrderTokensTable,
-			Columns: []string{providerprofile.OrderTokensColumn},
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1613,15 +3676,15 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.OrderTokensIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.DisputesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.OrderTokensTable,
-			Columns: []string{providerprofile.OrderTokensColumn},
+			Table:   providerprofile.DisputesTable,
+			Columns: []string{providerprofile.DisputesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(providerordertoken.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(dispute.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1629,28 +3692,44 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.ProviderRatingCleared() {
+	if ppuo.mutation.OrderAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.ProviderRatingTable,
-			Columns: []string{providerprofile.ProviderRatingColumn},
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(providerrating.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.ProviderRatingIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.RemovedOrderAssignmentsIDs(); len(nodes) > 0 && !ppuo.mutation.OrderAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.ProviderRatingTable,
-			Columns: []string{providerprofile.ProviderRatingColumn},
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(providerrating.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ppuo.mutation.OrderAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   providerprofile.OrderAssignmentsTable,
+			Columns: []string{providerprofile.OrderAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderassignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1658,28 +3737,28 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ppuo.mutation.AssignedOrdersCleared() {
+	if ppuo.mutation.RateSnapshotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.AssignedOrdersTable,
-			Columns: []string{providerprofile.AssignedOrdersColumn},
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.RemovedAssignedOrdersIDs(); len(nodes) > 0 && !ppuo.mutation.AssignedOrdersCleared() {
+	if nodes := ppuo.mutation.RemovedRateSnapshotsIDs(); len(nodes) > 0 && !ppuo.mutation.RateSnapshotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.AssignedOrdersTable,
-			Columns: []string{providerprofile.AssignedOrdersColumn},
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1687,15 +3766,15 @@ func (ppuo *ProviderProfileUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ppuo.mutation.AssignedOrdersIDs(); len(nodes) > 0 {
+	if nodes := ppuo.mutation.RateSnapshotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   providerprofile.AssignedOrdersTable,
-			Columns: []string{providerprofile.AssignedOrdersColumn},
+			Table:   providerprofile.RateSnapshotsTable,
+			Columns: []string{providerprofile.RateSnapshotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+)
+
+// ProviderSLARecord is the model entity for the ProviderSLARecord schema.
+type ProviderSLARecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AssignedAt holds the value of the "assigned_at" field.
+	AssignedAt time.Time `json:"assigned_at,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt time.Time `json:"accepted_at,omitempty"`
+	// DeclinedAt holds the value of the "declined_at" field.
+	DeclinedAt time.Time `json:"declined_at,omitempty"`
+	// FulfilledAt holds the value of the "fulfilled_at" field.
+	FulfilledAt time.Time `json:"fulfilled_at,omitempty"`
+	// ValidatedAt holds the value of the "validated_at" field.
+	ValidatedAt time.Time `json:"validated_at,omitempty"`
+	// Breach holds the value of the "breach" field.
+	Breach providerslarecord.Breach `json:"breach,omitempty"`
+	// BreachedAt holds the value of the "breached_at" field.
+	BreachedAt time.Time `json:"breached_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProviderSLARecordQuery when eager-loading is set.
+	Edges                          ProviderSLARecordEdges `json:"edges"`
+	lock_payment_order_sla_records *uuid.UUID
+	provider_profile_sla_records   *string
+	selectValues                   sql.SelectValues
+}
+
+// ProviderSLARecordEdges holds the relations/edges for other nodes in the graph.
+type ProviderSLARecordEdges struct {
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// Order holds the value of the order edge.
+	Order *LockPaymentOrder `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderSLARecordEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProviderSLARecordEdges) OrderOrErr() (*LockPaymentOrder, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: lockpaymentorder.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderSLARecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case providerslarecord.FieldBreach:
+			values[i] = new(sql.NullString)
+		case providerslarecord.FieldAssignedAt, providerslarecord.FieldAcceptedAt, providerslarecord.FieldDeclinedAt, providerslarecord.FieldFulfilledAt, providerslarecord.FieldValidatedAt, providerslarecord.FieldBreachedAt:
+			values[i] = new(sql.NullTime)
+		case providerslarecord.FieldID:
+			values[i] = new(uuid.UUID)
+		case providerslarecord.ForeignKeys[0]: // lock_payment_order_sla_records
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case providerslarecord.ForeignKeys[1]: // provider_profile_sla_records
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderSLARecord fields.
+func (psr *ProviderSLARecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case providerslarecord.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				psr.ID = *value
+			}
+		case providerslarecord.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				psr.AssignedAt = value.Time
+			}
+		case providerslarecord.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				psr.AcceptedAt = value.Time
+			}
+		case providerslarecord.FieldDeclinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field declined_at", values[i])
+			} else if value.Valid {
+				psr.DeclinedAt = value.Time
+			}
+		case providerslarecord.FieldFulfilledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fulfilled_at", values[i])
+			} else if value.Valid {
+				psr.FulfilledAt = value.Time
+			}
+		case providerslarecord.FieldValidatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field validated_at", values[i])
+			} else if value.Valid {
+				psr.ValidatedAt = value.Time
+			}
+		case providerslarecord.FieldBreach:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field breach", values[i])
+			} else if value.Valid {
+				psr.Breach = providerslarecord.Breach(value.String)
+			}
+		case providerslarecord.FieldBreachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field breached_at", values[i])
+			} else if value.Valid {
+				psr.BreachedAt = value.Time
+			}
+		case providerslarecord.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_payment_order_sla_records", values[i])
+			} else if value.Valid {
+				psr.lock_payment_order_sla_records = new(uuid.UUID)
+				*psr.lock_payment_order_sla_records = *value.S.(*uuid.UUID)
+			}
+		case providerslarecord.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_sla_records", values[i])
+			} else if value.Valid {
+				psr.provider_profile_sla_records = new(string)
+				*psr.provider_profile_sla_records = value.String
+			}
+		default:
+			psr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderSLARecord.
+// This includes values selected through modifiers, order, etc.
+func (psr *ProviderSLARecord) Value(name string) (ent.Value, error) {
+	return psr.selectValues.Get(name)
+}
+
+// QueryProvider queries the "provider" edge of the ProviderSLARecord entity.
+func (psr *ProviderSLARecord) QueryProvider() *ProviderProfileQuery {
+	return NewProviderSLARecordClient(psr.config).QueryProvider(psr)
+}
+
+// QueryOrder queries the "order" edge of the ProviderSLARecord entity.
+func (psr *ProviderSLARecord) QueryOrder() *LockPaymentOrderQuery {
+	return NewProviderSLARecordClient(psr.config).QueryOrder(psr)
+}
+
+// Update returns a builder for updating this ProviderSLARecord.
+// Note that you need to call ProviderSLARecord.Unwrap() before calling this method if this ProviderSLARecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (psr *ProviderSLARecord) Update() *ProviderSLARecordUpdateOne {
+	return NewProviderSLARecordClient(psr.config).UpdateOne(psr)
+}
+
+// Unwrap unwraps the ProviderSLARecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (psr *ProviderSLARecord) Unwrap() *ProviderSLARecord {
+	_tx, ok := psr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderSLARecord is not a transactional entity")
+	}
+	psr.config.driver = _tx.drv
+	return psr
+}
+
+// String implements the fmt.Stringer.
+func (psr *ProviderSLARecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderSLARecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", psr.ID))
+	builder.WriteString("assigned_at=")
+	builder.WriteString(psr.AssignedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("accepted_at=")
+	builder.WriteString(psr.AcceptedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("declined_at=")
+	builder.WriteString(psr.DeclinedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fulfilled_at=")
+	builder.WriteString(psr.FulfilledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("validated_at=")
+	builder.WriteString(psr.ValidatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("breach=")
+	builder.WriteString(fmt.Sprintf("%v", psr.Breach))
+	builder.WriteString(", ")
+	builder.WriteString("breached_at=")
+	builder.WriteString(psr.BreachedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderSLARecords is a parsable slice of ProviderSLARecord.
+type ProviderSLARecords []*ProviderSLARecord
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerslarecord
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the providerslarecord type in the database.
+	Label = "provider_sla_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAssignedAt holds the string denoting the assigned_at field in the database.
+	FieldAssignedAt = "assigned_at"
+	// FieldAcceptedAt holds the string denoting the accepted_at field in the database.
+	FieldAcceptedAt = "accepted_at"
+	// FieldDeclinedAt holds the string denoting the declined_at field in the database.
+	FieldDeclinedAt = "declined_at"
+	// FieldFulfilledAt holds the string denoting the fulfilled_at field in the database.
+	FieldFulfilledAt = "fulfilled_at"
+	// FieldValidatedAt holds the string denoting the validated_at field in the database.
+	FieldValidatedAt = "validated_at"
+	// FieldBreach holds the string denoting the breach field in the database.
+	FieldBreach = "breach"
+	// FieldBreachedAt holds the string denoting the breached_at field in the database.
+	FieldBreachedAt = "breached_at"
+	// EdgeProvider holds the string denoting the provider edge name in mutations.
+	EdgeProvider = "provider"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// Table holds the table name of the providerslarecord in the database.
+	Table = "provider_sla_records"
+	// ProviderTable is the table that holds the provider relation/edge.
+	ProviderTable = "provider_sla_records"
+	// ProviderInverseTable is the table name for the ProviderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "providerprofile" package.
+	ProviderInverseTable = "provider_profiles"
+	// ProviderColumn is the table column denoting the provider relation/edge.
+	ProviderColumn = "provider_profile_sla_records"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "provider_sla_records"
+	// OrderInverseTable is the table name for the LockPaymentOrder entity.
+	// It exists in this package in order to avoid circular dependency with the "lockpaymentorder" package.
+	OrderInverseTable = "lock_payment_orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "lock_payment_order_sla_records"
+)
+
+// Columns holds all SQL columns for providerslarecord fields.
+var Columns = []string{
+	FieldID,
+	FieldAssignedAt,
+	FieldAcceptedAt,
+	FieldDeclinedAt,
+	FieldFulfilledAt,
+	FieldValidatedAt,
+	FieldBreach,
+	FieldBreachedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "provider_sla_records"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"lock_payment_order_sla_records",
+	"provider_profile_sla_records",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAssignedAt holds the default value on creation for the "assigned_at" field.
+	DefaultAssignedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Breach defines the type for the "breach" enum field.
+type Breach string
+
+// Breach values.
+const (
+	BreachAcceptTimeout      Breach = "accept_timeout"
+	BreachFulfillmentTimeout Breach = "fulfillment_timeout"
+)
+
+func (b Breach) String() string {
+	return string(b)
+}
+
+// BreachValidator is a validator for the "breach" field enum values. It is called by the builders before save.
+func BreachValidator(b Breach) error {
+	switch b {
+	case BreachAcceptTimeout, BreachFulfillmentTimeout:
+		return nil
+	default:
+		return fmt.Errorf("providerslarecord: invalid enum value for breach field: %q", b)
+	}
+}
+
+// OrderOption defines the ordering options for the ProviderSLARecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAssignedAt orders the results by the assigned_at field.
+func ByAssignedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedAt, opts...).ToFunc()
+}
+
+// ByAcceptedAt orders the results by the accepted_at field.
+func ByAcceptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcceptedAt, opts...).ToFunc()
+}
+
+// ByDeclinedAt orders the results by the declined_at field.
+func ByDeclinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeclinedAt, opts...).ToFunc()
+}
+
+// ByFulfilledAt orders the results by the fulfilled_at field.
+func ByFulfilledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFulfilledAt, opts...).ToFunc()
+}
+
+// ByValidatedAt orders the results by the validated_at field.
+func ByValidatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidatedAt, opts...).ToFunc()
+}
+
+// ByBreach orders the results by the breach field.
+func ByBreach(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreach, opts...).ToFunc()
+}
+
+// ByBreachedAt orders the results by the breached_at field.
+func ByBreachedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreachedAt, opts...).ToFunc()
+}
+
+// ByProviderField orders the results by provider field.
+func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProviderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+	)
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package providerslarecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldID, id))
+}
+
+// AssignedAt applies equality check predicate on the "assigned_at" field. It's identical to AssignedAtEQ.
+func AssignedAt(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// DeclinedAt applies equality check predicate on the "declined_at" field. It's identical to DeclinedAtEQ.
+func DeclinedAt(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldDeclinedAt, v))
+}
+
+// FulfilledAt applies equality check predicate on the "fulfilled_at" field. It's identical to FulfilledAtEQ.
+func FulfilledAt(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldFulfilledAt, v))
+}
+
+// ValidatedAt applies equality check predicate on the "validated_at" field. It's identical to ValidatedAtEQ.
+func ValidatedAt(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldValidatedAt, v))
+}
+
+// BreachedAt applies equality check predicate on the "breached_at" field. It's identical to BreachedAtEQ.
+func BreachedAt(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldBreachedAt, v))
+}
+
+// AssignedAtEQ applies the EQ predicate on the "assigned_at" field.
+func AssignedAtEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtNEQ applies the NEQ predicate on the "assigned_at" field.
+func AssignedAtNEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldAssignedAt, v))
+}
+
+// AssignedAtIn applies the In predicate on the "assigned_at" field.
+func AssignedAtIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtNotIn applies the NotIn predicate on the "assigned_at" field.
+func AssignedAtNotIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldAssignedAt, vs...))
+}
+
+// AssignedAtGT applies the GT predicate on the "assigned_at" field.
+func AssignedAtGT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldAssignedAt, v))
+}
+
+// AssignedAtGTE applies the GTE predicate on the "assigned_at" field.
+func AssignedAtGTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldAssignedAt, v))
+}
+
+// AssignedAtLT applies the LT predicate on the "assigned_at" field.
+func AssignedAtLT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldAssignedAt, v))
+}
+
+// AssignedAtLTE applies the LTE predicate on the "assigned_at" field.
+func AssignedAtLTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldAssignedAt, v))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// DeclinedAtEQ applies the EQ predicate on the "declined_at" field.
+func DeclinedAtEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldDeclinedAt, v))
+}
+
+// DeclinedAtNEQ applies the NEQ predicate on the "declined_at" field.
+func DeclinedAtNEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldDeclinedAt, v))
+}
+
+// DeclinedAtIn applies the In predicate on the "declined_at" field.
+func DeclinedAtIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldDeclinedAt, vs...))
+}
+
+// DeclinedAtNotIn applies the NotIn predicate on the "declined_at" field.
+func DeclinedAtNotIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldDeclinedAt, vs...))
+}
+
+// DeclinedAtGT applies the GT predicate on the "declined_at" field.
+func DeclinedAtGT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldDeclinedAt, v))
+}
+
+// DeclinedAtGTE applies the GTE predicate on the "declined_at" field.
+func DeclinedAtGTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldDeclinedAt, v))
+}
+
+// DeclinedAtLT applies the LT predicate on the "declined_at" field.
+func DeclinedAtLT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldDeclinedAt, v))
+}
+
+// DeclinedAtLTE applies the LTE predicate on the "declined_at" field.
+func DeclinedAtLTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldDeclinedAt, v))
+}
+
+// DeclinedAtIsNil applies the IsNil predicate on the "declined_at" field.
+func DeclinedAtIsNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIsNull(FieldDeclinedAt))
+}
+
+// DeclinedAtNotNil applies the NotNil predicate on the "declined_at" field.
+func DeclinedAtNotNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotNull(FieldDeclinedAt))
+}
+
+// FulfilledAtEQ applies the EQ predicate on the "fulfilled_at" field.
+func FulfilledAtEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldFulfilledAt, v))
+}
+
+// FulfilledAtNEQ applies the NEQ predicate on the "fulfilled_at" field.
+func FulfilledAtNEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldFulfilledAt, v))
+}
+
+// FulfilledAtIn applies the In predicate on the "fulfilled_at" field.
+func FulfilledAtIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldFulfilledAt, vs...))
+}
+
+// FulfilledAtNotIn applies the NotIn predicate on the "fulfilled_at" field.
+func FulfilledAtNotIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldFulfilledAt, vs...))
+}
+
+// FulfilledAtGT applies the GT predicate on the "fulfilled_at" field.
+func FulfilledAtGT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldFulfilledAt, v))
+}
+
+// FulfilledAtGTE applies the GTE predicate on the "fulfilled_at" field.
+func FulfilledAtGTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldFulfilledAt, v))
+}
+
+// FulfilledAtLT applies the LT predicate on the "fulfilled_at" field.
+func FulfilledAtLT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldFulfilledAt, v))
+}
+
+// FulfilledAtLTE applies the LTE predicate on the "fulfilled_at" field.
+func FulfilledAtLTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldFulfilledAt, v))
+}
+
+// FulfilledAtIsNil applies the IsNil predicate on the "fulfilled_at" field.
+func FulfilledAtIsNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIsNull(FieldFulfilledAt))
+}
+
+// FulfilledAtNotNil applies the NotNil predicate on the "fulfilled_at" field.
+func FulfilledAtNotNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotNull(FieldFulfilledAt))
+}
+
+// ValidatedAtEQ applies the EQ predicate on the "validated_at" field.
+func ValidatedAtEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldValidatedAt, v))
+}
+
+// ValidatedAtNEQ applies the NEQ predicate on the "validated_at" field.
+func ValidatedAtNEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldValidatedAt, v))
+}
+
+// ValidatedAtIn applies the In predicate on the "validated_at" field.
+func ValidatedAtIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldValidatedAt, vs...))
+}
+
+// ValidatedAtNotIn applies the NotIn predicate on the "validated_at" field.
+func ValidatedAtNotIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldValidatedAt, vs...))
+}
+
+// ValidatedAtGT applies the GT predicate on the "validated_at" field.
+func ValidatedAtGT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldValidatedAt, v))
+}
+
+// ValidatedAtGTE applies the GTE predicate on the "validated_at" field.
+func ValidatedAtGTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldValidatedAt, v))
+}
+
+// ValidatedAtLT applies the LT predicate on the "validated_at" field.
+func ValidatedAtLT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldValidatedAt, v))
+}
+
+// ValidatedAtLTE applies the LTE predicate on the "validated_at" field.
+func ValidatedAtLTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldValidatedAt, v))
+}
+
+// ValidatedAtIsNil applies the IsNil predicate on the "validated_at" field.
+func ValidatedAtIsNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIsNull(FieldValidatedAt))
+}
+
+// ValidatedAtNotNil applies the NotNil predicate on the "validated_at" field.
+func ValidatedAtNotNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotNull(FieldValidatedAt))
+}
+
+// BreachEQ applies the EQ predicate on the "breach" field.
+func BreachEQ(v Breach) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldBreach, v))
+}
+
+// BreachNEQ applies the NEQ predicate on the "breach" field.
+func BreachNEQ(v Breach) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldBreach, v))
+}
+
+// BreachIn applies the In predicate on the "breach" field.
+func BreachIn(vs ...Breach) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldBreach, vs...))
+}
+
+// BreachNotIn applies the NotIn predicate on the "breach" field.
+func BreachNotIn(vs ...Breach) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldBreach, vs...))
+}
+
+// BreachIsNil applies the IsNil predicate on the "breach" field.
+func BreachIsNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIsNull(FieldBreach))
+}
+
+// BreachNotNil applies the NotNil predicate on the "breach" field.
+func BreachNotNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotNull(FieldBreach))
+}
+
+// BreachedAtEQ applies the EQ predicate on the "breached_at" field.
+func BreachedAtEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldEQ(FieldBreachedAt, v))
+}
+
+// BreachedAtNEQ applies the NEQ predicate on the "breached_at" field.
+func BreachedAtNEQ(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNEQ(FieldBreachedAt, v))
+}
+
+// BreachedAtIn applies the In predicate on the "breached_at" field.
+func BreachedAtIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIn(FieldBreachedAt, vs...))
+}
+
+// BreachedAtNotIn applies the NotIn predicate on the "breached_at" field.
+func BreachedAtNotIn(vs ...time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotIn(FieldBreachedAt, vs...))
+}
+
+// BreachedAtGT applies the GT predicate on the "breached_at" field.
+func BreachedAtGT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGT(FieldBreachedAt, v))
+}
+
+// BreachedAtGTE applies the GTE predicate on the "breached_at" field.
+func BreachedAtGTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldGTE(FieldBreachedAt, v))
+}
+
+// BreachedAtLT applies the LT predicate on the "breached_at" field.
+func BreachedAtLT(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLT(FieldBreachedAt, v))
+}
+
+// BreachedAtLTE applies the LTE predicate on the "breached_at" field.
+func BreachedAtLTE(v time.Time) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldLTE(FieldBreachedAt, v))
+}
+
+// BreachedAtIsNil applies the IsNil predicate on the "breached_at" field.
+func BreachedAtIsNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldIsNull(FieldBreachedAt))
+}
+
+// BreachedAtNotNil applies the NotNil predicate on the "breached_at" field.
+func BreachedAtNotNil() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.FieldNotNull(FieldBreachedAt))
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.LockPaymentOrder) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProviderSLARecord) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProviderSLARecord) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProviderSLARecord) predicate.ProviderSLARecord {
+	return predicate.ProviderSLARecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,1024 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+)
+
+// ProviderSLARecordCreate is the builder for creating a ProviderSLARecord entity.
+type ProviderSLARecordCreate struct {
+	config
+	mutation *ProviderSLARecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (psrc *ProviderSLARecordCreate) SetAssignedAt(t time.Time) *ProviderSLARecordCreate {
+	psrc.mutation.SetAssignedAt(t)
+	return psrc
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableAssignedAt(t *time.Time) *ProviderSLARecordCreate {
+	if t != nil {
+		psrc.SetAssignedAt(*t)
+	}
+	return psrc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (psrc *ProviderSLARecordCreate) SetAcceptedAt(t time.Time) *ProviderSLARecordCreate {
+	psrc.mutation.SetAcceptedAt(t)
+	return psrc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableAcceptedAt(t *time.Time) *ProviderSLARecordCreate {
+	if t != nil {
+		psrc.SetAcceptedAt(*t)
+	}
+	return psrc
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (psrc *ProviderSLARecordCreate) SetDeclinedAt(t time.Time) *ProviderSLARecordCreate {
+	psrc.mutation.SetDeclinedAt(t)
+	return psrc
+}
+
+// SetNillableDeclinedAt sets the "declined_at" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableDeclinedAt(t *time.Time) *ProviderSLARecordCreate {
+	if t != nil {
+		psrc.SetDeclinedAt(*t)
+	}
+	return psrc
+}
+
+// SetFulfilledAt sets the "fulfilled_at" field.
+func (psrc *ProviderSLARecordCreate) SetFulfilledAt(t time.Time) *ProviderSLARecordCreate {
+	psrc.mutation.SetFulfilledAt(t)
+	return psrc
+}
+
+// SetNillableFulfilledAt sets the "fulfilled_at" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableFulfilledAt(t *time.Time) *ProviderSLARecordCreate {
+	if t != nil {
+		psrc.SetFulfilledAt(*t)
+	}
+	return psrc
+}
+
+// SetValidatedAt sets the "validated_at" field.
+func (psrc *ProviderSLARecordCreate) SetValidatedAt(t time.Time) *ProviderSLARecordCreate {
+	psrc.mutation.SetValidatedAt(t)
+	return psrc
+}
+
+// SetNillableValidatedAt sets the "validated_at" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableValidatedAt(t *time.Time) *ProviderSLARecordCreate {
+	if t != nil {
+		psrc.SetValidatedAt(*t)
+	}
+	return psrc
+}
+
+// SetBreach sets the "breach" field.
+func (psrc *ProviderSLARecordCreate) SetBreach(pr providerslarecord.Breach) *ProviderSLARecordCreate {
+	psrc.mutation.SetBreach(pr)
+	return psrc
+}
+
+// SetNillableBreach sets the "breach" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableBreach(pr *providerslarecord.Breach) *ProviderSLARecordCreate {
+	if pr != nil {
+		psrc.SetBreach(*pr)
+	}
+	return psrc
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (psrc *ProviderSLARecordCreate) SetBreachedAt(t time.Time) *ProviderSLARecordCreate {
+	psrc.mutation.SetBreachedAt(t)
+	return psrc
+}
+
+// SetNillableBreachedAt sets the "breached_at" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableBreachedAt(t *time.Time) *ProviderSLARecordCreate {
+	if t != nil {
+		psrc.SetBreachedAt(*t)
+	}
+	return psrc
+}
+
+// SetID sets the "id" field.
+func (psrc *ProviderSLARecordCreate) SetID(u uuid.UUID) *ProviderSLARecordCreate {
+	psrc.mutation.SetID(u)
+	return psrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (psrc *ProviderSLARecordCreate) SetNillableID(u *uuid.UUID) *ProviderSLARecordCreate {
+	if u != nil {
+		psrc.SetID(*u)
+	}
+	return psrc
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (psrc *ProviderSLARecordCreate) SetProviderID(id string) *ProviderSLARecordCreate {
+	psrc.mutation.SetProviderID(id)
+	return psrc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (psrc *ProviderSLARecordCreate) SetProvider(p *ProviderProfile) *ProviderSLARecordCreate {
+	return psrc.SetProviderID(p.ID)
+}
+
+// SetOrderID sets the "order" edge to the LockPaymentOrder entity by ID.
+func (psrc *ProviderSLARecordCreate) SetOrderID(id uuid.UUID) *ProviderSLARecordCreate {
+	psrc.mutation.SetOrderID(id)
+	return psrc
+}
+
+// SetOrder sets the "order" edge to the LockPaymentOrder entity.
+func (psrc *ProviderSLARecordCreate) SetOrder(l *LockPaymentOrder) *ProviderSLARecordCreate {
+	return psrc.SetOrderID(l.ID)
+}
+
+// Mutation returns the ProviderSLARecordMutation object of the builder.
+func (psrc *ProviderSLARecordCreate) Mutation() *ProviderSLARecordMutation {
+	return psrc.mutation
+}
+
+// Save creates the ProviderSLARecord in the database.
+func (psrc *ProviderSLARecordCreate) Save(ctx context.Context) (*ProviderSLARecord, error) {
+	psrc.defaults()
+	return withHooks(ctx, psrc.sqlSave, psrc.mutation, psrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (psrc *ProviderSLARecordCreate) SaveX(ctx context.Context) *ProviderSLARecord {
+	v, err := psrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psrc *ProviderSLARecordCreate) Exec(ctx context.Context) error {
+	_, err := psrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psrc *ProviderSLARecordCreate) ExecX(ctx context.Context) {
+	if err := psrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (psrc *ProviderSLARecordCreate) defaults() {
+	if _, ok := psrc.mutation.AssignedAt(); !ok {
+		v := providerslarecord.DefaultAssignedAt()
+		psrc.mutation.SetAssignedAt(v)
+	}
+	if _, ok := psrc.mutation.ID(); !ok {
+		v := providerslarecord.DefaultID()
+		psrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psrc *ProviderSLARecordCreate) check() error {
+	if _, ok := psrc.mutation.AssignedAt(); !ok {
+		return &ValidationError{Name: "assigned_at", err: errors.New(`ent: missing required field "ProviderSLARecord.assigned_at"`)}
+	}
+	if v, ok := psrc.mutation.Breach(); ok {
+		if err := providerslarecord.BreachValidator(v); err != nil {
+			return &ValidationError{Name: "breach", err: fmt.Errorf(`ent: validator failed for field "ProviderSLARecord.breach": %w`, err)}
+		}
+	}
+	if len(psrc.mutation.ProviderIDs()) == 0 {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required edge "ProviderSLARecord.provider"`)}
+	}
+	if len(psrc.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "ProviderSLARecord.order"`)}
+	}
+	return nil
+}
+
+func (psrc *ProviderSLARecordCreate) sqlSave(ctx context.Context) (*ProviderSLARecord, error) {
+	if err := psrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := psrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, psrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	psrc.mutation.id = &_node.ID
+	psrc.mutation.done = true
+	return _node, nil
+}
+
+func (psrc *ProviderSLARecordCreate) createSpec() (*ProviderSLARecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderSLARecord{config: psrc.config}
+		_spec = sqlgraph.NewCreateSpec(providerslarecord.Table, sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = psrc.conflict
+	if id, ok := psrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := psrc.mutation.AssignedAt(); ok {
+		_spec.SetField(providerslarecord.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = value
+	}
+	if value, ok := psrc.mutation.AcceptedAt(); ok {
+		_spec.SetField(providerslarecord.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = value
+	}
+	if value, ok := psrc.mutation.DeclinedAt(); ok {
+		_spec.SetField(providerslarecord.FieldDeclinedAt, field.TypeTime, value)
+		_node.DeclinedAt = value
+	}
+	if value, ok := psrc.mutation.FulfilledAt(); ok {
+		_spec.SetField(providerslarecord.FieldFulfilledAt, field.TypeTime, value)
+		_node.FulfilledAt = value
+	}
+	if value, ok := psrc.mutation.ValidatedAt(); ok {
+		_spec.SetField(providerslarecord.FieldValidatedAt, field.TypeTime, value)
+		_node.ValidatedAt = value
+	}
+	if value, ok := psrc.mutation.Breach(); ok {
+		_spec.SetField(providerslarecord.FieldBreach, field.TypeEnum, value)
+		_node.Breach = value
+	}
+	if value, ok := psrc.mutation.BreachedAt(); ok {
+		_spec.SetField(providerslarecord.FieldBreachedAt, field.TypeTime, value)
+		_node.BreachedAt = value
+	}
+	if nodes := psrc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerslarecord.ProviderTable,
+			Columns: []string{providerslarecord.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_sla_records = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := psrc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   providerslarecord.OrderTable,
+			Columns: []string{providerslarecord.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockpaymentorder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.lock_payment_order_sla_records = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderSLARecord.Create().
+//		SetAssignedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderSLARecordUpsert) {
+//			SetAssignedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (psrc *ProviderSLARecordCreate) OnConflict(opts ...sql.ConflictOption) *ProviderSLARecordUpsertOne {
+	psrc.conflict = opts
+	return &ProviderSLARecordUpsertOne{
+		create: psrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderSLARecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (psrc *ProviderSLARecordCreate) OnConflictColumns(columns ...string) *ProviderSLARecordUpsertOne {
+	psrc.conflict = append(psrc.conflict, sql.ConflictColumns(columns...))
+	return &ProviderSLARecordUpsertOne{
+		create: psrc,
+	}
+}
+
+type (
+	// ProviderSLARecordUpsertOne is the builder for "upsert"-ing
+	//  one ProviderSLARecord node.
+	ProviderSLARecordUpsertOne struct {
+		create *ProviderSLARecordCreate
+	}
+
+	// ProviderSLARecordUpsert is the "OnConflict" setter.
+	ProviderSLARecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *ProviderSLARecordUpsert) SetAcceptedAt(v time.Time) *ProviderSLARecordUpsert {
+	u.Set(providerslarecord.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsert) UpdateAcceptedAt() *ProviderSLARecordUpsert {
+	u.SetExcluded(providerslarecord.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *ProviderSLARecordUpsert) ClearAcceptedAt() *ProviderSLARecordUpsert {
+	u.SetNull(providerslarecord.FieldAcceptedAt)
+	return u
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (u *ProviderSLARecordUpsert) SetDeclinedAt(v time.Time) *ProviderSLARecordUpsert {
+	u.Set(providerslarecord.FieldDeclinedAt, v)
+	return u
+}
+
+// UpdateDeclinedAt sets the "declined_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsert) UpdateDeclinedAt() *ProviderSLARecordUpsert {
+	u.SetExcluded(providerslarecord.FieldDeclinedAt)
+	return u
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (u *ProviderSLARecordUpsert) ClearDeclinedAt() *ProviderSLARecordUpsert {
+	u.SetNull(providerslarecord.FieldDeclinedAt)
+	return u
+}
+
+// SetFulfilledAt sets the "fulfilled_at" field.
+func (u *ProviderSLARecordUpsert) SetFulfilledAt(v time.Time) *ProviderSLARecordUpsert {
+	u.Set(providerslarecord.FieldFulfilledAt, v)
+	return u
+}
+
+// UpdateFulfilledAt sets the "fulfilled_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsert) UpdateFulfilledAt() *ProviderSLARecordUpsert {
+	u.SetExcluded(providerslarecord.FieldFulfilledAt)
+	return u
+}
+
+// ClearFulfilledAt clears the value of the "fulfilled_at" field.
+func (u *ProviderSLARecordUpsert) ClearFulfilledAt() *ProviderSLARecordUpsert {
+	u.SetNull(providerslarecord.FieldFulfilledAt)
+	return u
+}
+
+// SetValidatedAt sets the "validated_at" field.
+func (u *ProviderSLARecordUpsert) SetValidatedAt(v time.Time) *ProviderSLARecordUpsert {
+	u.Set(providerslarecord.FieldValidatedAt, v)
+	return u
+}
+
+// UpdateValidatedAt sets the "validated_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsert) UpdateValidatedAt() *ProviderSLARecordUpsert {
+	u.SetExcluded(providerslarecord.FieldValidatedAt)
+	return u
+}
+
+// ClearValidatedAt clears the value of the "validated_at" field.
+func (u *ProviderSLARecordUpsert) ClearValidatedAt() *ProviderSLARecordUpsert {
+	u.SetNull(providerslarecord.FieldValidatedAt)
+	return u
+}
+
+// SetBreach sets the "breach" field.
+func (u *ProviderSLARecordUpsert) SetBreach(v providerslarecord.Breach) *ProviderSLARecordUpsert {
+	u.Set(providerslarecord.FieldBreach, v)
+	return u
+}
+
+// UpdateBreach sets the "breach" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsert) UpdateBreach() *ProviderSLARecordUpsert {
+	u.SetExcluded(providerslarecord.FieldBreach)
+	return u
+}
+
+// ClearBreach clears the value of the "breach" field.
+func (u *ProviderSLARecordUpsert) ClearBreach() *ProviderSLARecordUpsert {
+	u.SetNull(providerslarecord.FieldBreach)
+	return u
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (u *ProviderSLARecordUpsert) SetBreachedAt(v time.Time) *ProviderSLARecordUpsert {
+	u.Set(providerslarecord.FieldBreachedAt, v)
+	return u
+}
+
+// UpdateBreachedAt sets the "breached_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsert) UpdateBreachedAt() *ProviderSLARecordUpsert {
+	u.SetExcluded(providerslarecord.FieldBreachedAt)
+	return u
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (u *ProviderSLARecordUpsert) ClearBreachedAt() *ProviderSLARecordUpsert {
+	u.SetNull(providerslarecord.FieldBreachedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProviderSLARecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerslarecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderSLARecordUpsertOne) UpdateNewValues() *ProviderSLARecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(providerslarecord.FieldID)
+		}
+		if _, exists := u.create.mutation.AssignedAt(); exists {
+			s.SetIgnore(providerslarecord.FieldAssignedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderSLARecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProviderSLARecordUpsertOne) Ignore() *ProviderSLARecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderSLARecordUpsertOne) DoNothing() *ProviderSLARecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderSLARecordCreate.OnConflict
+// documentation for more info.
+func (u *ProviderSLARecordUpsertOne) Update(set func(*ProviderSLARecordUpsert)) *ProviderSLARecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderSLARecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *ProviderSLARecordUpsertOne) SetAcceptedAt(v time.Time) *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertOne) UpdateAcceptedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *ProviderSLARecordUpsertOne) ClearAcceptedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (u *ProviderSLARecordUpsertOne) SetDeclinedAt(v time.Time) *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetDeclinedAt(v)
+	})
+}
+
+// UpdateDeclinedAt sets the "declined_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertOne) UpdateDeclinedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateDeclinedAt()
+	})
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (u *ProviderSLARecordUpsertOne) ClearDeclinedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearDeclinedAt()
+	})
+}
+
+// SetFulfilledAt sets the "fulfilled_at" field.
+func (u *ProviderSLARecordUpsertOne) SetFulfilledAt(v time.Time) *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetFulfilledAt(v)
+	})
+}
+
+// UpdateFulfilledAt sets the "fulfilled_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertOne) UpdateFulfilledAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateFulfilledAt()
+	})
+}
+
+// ClearFulfilledAt clears the value of the "fulfilled_at" field.
+func (u *ProviderSLARecordUpsertOne) ClearFulfilledAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearFulfilledAt()
+	})
+}
+
+// SetValidatedAt sets the "validated_at" field.
+func (u *ProviderSLARecordUpsertOne) SetValidatedAt(v time.Time) *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetValidatedAt(v)
+	})
+}
+
+// UpdateValidatedAt sets the "validated_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertOne) UpdateValidatedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateValidatedAt()
+	})
+}
+
+// ClearValidatedAt clears the value of the "validated_at" field.
+func (u *ProviderSLARecordUpsertOne) ClearValidatedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearValidatedAt()
+	})
+}
+
+// SetBreach sets the "breach" field.
+func (u *ProviderSLARecordUpsertOne) SetBreach(v providerslarecord.Breach) *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetBreach(v)
+	})
+}
+
+// UpdateBreach sets the "breach" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertOne) UpdateBreach() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateBreach()
+	})
+}
+
+// ClearBreach clears the value of the "breach" field.
+func (u *ProviderSLARecordUpsertOne) ClearBreach() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearBreach()
+	})
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (u *ProviderSLARecordUpsertOne) SetBreachedAt(v time.Time) *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetBreachedAt(v)
+	})
+}
+
+// UpdateBreachedAt sets the "breached_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertOne) UpdateBreachedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateBreachedAt()
+	})
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (u *ProviderSLARecordUpsertOne) ClearBreachedAt() *ProviderSLARecordUpsertOne {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearBreachedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderSLARecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderSLARecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderSLARecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProviderSLARecordUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProviderSLARecordUpsertOne.ID is not supported by MySQL driver. Use ProviderSLARecordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProviderSLARecordUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProviderSLARecordCreateBulk is the builder for creating many ProviderSLARecord entities in bulk.
+type ProviderSLARecordCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderSLARecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProviderSLARecord entities in the database.
+func (psrcb *ProviderSLARecordCreateBulk) Save(ctx context.Context) ([]*ProviderSLARecord, error) {
+	if psrcb.err != nil {
+		return nil, psrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(psrcb.builders))
+	nodes := make([]*ProviderSLARecord, len(psrcb.builders))
+	mutators := make([]Mutator, len(psrcb.builders))
+	for i := range psrcb.builders {
+		func(i int, root context.Context) {
+			builder := psrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderSLARecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, psrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = psrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, psrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, psrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psrcb *ProviderSLARecordCreateBulk) SaveX(ctx context.Context) []*ProviderSLARecord {
+	v, err := psrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (psrcb *ProviderSLARecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := psrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psrcb *ProviderSLARecordCreateBulk) ExecX(ctx context.Context) {
+	if err := psrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProviderSLARecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProviderSLARecordUpsert) {
+//			SetAssignedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (psrcb *ProviderSLARecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProviderSLARecordUpsertBulk {
+	psrcb.conflict = opts
+	return &ProviderSLARecordUpsertBulk{
+		create: psrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProviderSLARecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (psrcb *ProviderSLARecordCreateBulk) OnConflictColumns(columns ...string) *ProviderSLARecordUpsertBulk {
+	psrcb.conflict = append(psrcb.conflict, sql.ConflictColumns(columns...))
+	return &ProviderSLARecordUpsertBulk{
+		create: psrcb,
+	}
+}
+
+// ProviderSLARecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProviderSLARecord nodes.
+type ProviderSLARecordUpsertBulk struct {
+	create *ProviderSLARecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProviderSLARecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(providerslarecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProviderSLARecordUpsertBulk) UpdateNewValues() *ProviderSLARecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(providerslarecord.FieldID)
+			}
+			if _, exists := b.mutation.AssignedAt(); exists {
+				s.SetIgnore(providerslarecord.FieldAssignedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProviderSLARecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProviderSLARecordUpsertBulk) Ignore() *ProviderSLARecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProviderSLARecordUpsertBulk) DoNothing() *ProviderSLARecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProviderSLARecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProviderSLARecordUpsertBulk) Update(set func(*ProviderSLARecordUpsert)) *ProviderSLARecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProviderSLARecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *ProviderSLARecordUpsertBulk) SetAcceptedAt(v time.Time) *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertBulk) UpdateAcceptedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *ProviderSLARecordUpsertBulk) ClearAcceptedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (u *ProviderSLARecordUpsertBulk) SetDeclinedAt(v time.Time) *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetDeclinedAt(v)
+	})
+}
+
+// UpdateDeclinedAt sets the "declined_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertBulk) UpdateDeclinedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateDeclinedAt()
+	})
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (u *ProviderSLARecordUpsertBulk) ClearDeclinedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearDeclinedAt()
+	})
+}
+
+// SetFulfilledAt sets the "fulfilled_at" field.
+func (u *ProviderSLARecordUpsertBulk) SetFulfilledAt(v time.Time) *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetFulfilledAt(v)
+	})
+}
+
+// UpdateFulfilledAt sets the "fulfilled_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertBulk) UpdateFulfilledAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateFulfilledAt()
+	})
+}
+
+// ClearFulfilledAt clears the value of the "fulfilled_at" field.
+func (u *ProviderSLARecordUpsertBulk) ClearFulfilledAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearFulfilledAt()
+	})
+}
+
+// SetValidatedAt sets the "validated_at" field.
+func (u *ProviderSLARecordUpsertBulk) SetValidatedAt(v time.Time) *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetValidatedAt(v)
+	})
+}
+
+// UpdateValidatedAt sets the "validated_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertBulk) UpdateValidatedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateValidatedAt()
+	})
+}
+
+// ClearValidatedAt clears the value of the "validated_at" field.
+func (u *ProviderSLARecordUpsertBulk) ClearValidatedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearValidatedAt()
+	})
+}
+
+// SetBreach sets the "breach" field.
+func (u *ProviderSLARecordUpsertBulk) SetBreach(v providerslarecord.Breach) *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetBreach(v)
+	})
+}
+
+// UpdateBreach sets the "breach" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertBulk) UpdateBreach() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateBreach()
+	})
+}
+
+// ClearBreach clears the value of the "breach" field.
+func (u *ProviderSLARecordUpsertBulk) ClearBreach() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearBreach()
+	})
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (u *ProviderSLARecordUpsertBulk) SetBreachedAt(v time.Time) *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.SetBreachedAt(v)
+	})
+}
+
+// UpdateBreachedAt sets the "breached_at" field to the value that was provided on create.
+func (u *ProviderSLARecordUpsertBulk) UpdateBreachedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.UpdateBreachedAt()
+	})
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (u *ProviderSLARecordUpsertBulk) ClearBreachedAt() *ProviderSLARecordUpsertBulk {
+	return u.Update(func(s *ProviderSLARecordUpsert) {
+		s.ClearBreachedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ProviderSLARecordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProviderSLARecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProviderSLARecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProviderSLARecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+)
+
+// ProviderSLARecordDelete is the builder for deleting a ProviderSLARecord entity.
+type ProviderSLARecordDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProviderSLARecordMutation
+}
+
+// Where appends a list predicates to the ProviderSLARecordDelete builder.
+func (psrd *ProviderSLARecordDelete) Where(ps ...predicate.ProviderSLARecord) *ProviderSLARecordDelete {
+	psrd.mutation.Where(ps...)
+	return psrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (psrd *ProviderSLARecordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, psrd.sqlExec, psrd.mutation, psrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psrd *ProviderSLARecordDelete) ExecX(ctx context.Context) int {
+	n, err := psrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (psrd *ProviderSLARecordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(providerslarecord.Table, sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID))
+	if ps := psrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, psrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	psrd.mutation.done = true
+	return affected, err
+}
+
+// ProviderSLARecordDeleteOne is the builder for deleting a single ProviderSLARecord entity.
+type ProviderSLARecordDeleteOne struct {
+	psrd *ProviderSLARecordDelete
+}
+
+// Where appends a list predicates to the ProviderSLARecordDelete builder.
+func (psrdo *ProviderSLARecordDeleteOne) Where(ps ...predicate.ProviderSLARecord) *ProviderSLARecordDeleteOne {
+	psrdo.psrd.mutation.Where(ps...)
+	return psrdo
+}
+
+// Exec executes the deletion query.
+func (psrdo *ProviderSLARecordDeleteOne) Exec(ctx context.Context) error {
+	n, err := psrdo.psrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{providerslarecord.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psrdo *ProviderSLARecordDeleteOne) ExecX(ctx context.Context) {
+	if err := psrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+)
+
+// ProviderSLARecordQuery is the builder for querying ProviderSLARecord entities.
+type ProviderSLARecordQuery struct {
+	config
+	ctx          *QueryContext
+	order        []providerslarecord.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ProviderSLARecord
+	withProvider *ProviderProfileQuery
+	withOrder    *LockPaymentOrderQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProviderSLARecordQuery builder.
+func (psrq *ProviderSLARecordQuery) Where(ps ...predicate.ProviderSLARecord) *ProviderSLARecordQuery {
+	psrq.predicates = append(psrq.predicates, ps...)
+	return psrq
+}
+
+// Limit the number of records to be returned by this query.
+func (psrq *ProviderSLARecordQuery) Limit(limit int) *ProviderSLARecordQuery {
+	psrq.ctx.Limit = &limit
+	return psrq
+}
+
+// Offset to start from.
+func (psrq *ProviderSLARecordQuery) Offset(offset int) *ProviderSLARecordQuery {
+	psrq.ctx.Offset = &offset
+	return psrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (psrq *ProviderSLARecordQuery) Unique(unique bool) *ProviderSLARecordQuery {
+	psrq.ctx.Unique = &unique
+	return psrq
+}
+
+// Order specifies how the records should be ordered.
+func (psrq *ProviderSLARecordQuery) Order(o ...providerslarecord.OrderOption) *ProviderSLARecordQuery {
+	psrq.order = append(psrq.order, o...)
+	return psrq
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (psrq *ProviderSLARecordQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: psrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerslarecord.Table, providerslarecord.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerslarecord.ProviderTable, providerslarecord.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (psrq *ProviderSLARecordQuery) QueryOrder() *LockPaymentOrderQuery {
+	query := (&LockPaymentOrderClient{config: psrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := psrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := psrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerslarecord.Table, providerslarecord.FieldID, selector),
+			sqlgraph.To(lockpaymentorder.Table, lockpaymentorder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, providerslarecord.OrderTable, providerslarecord.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(psrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProviderSLARecord entity from the query.
+// Returns a *NotFoundError when no ProviderSLARecord was found.
+func (psrq *ProviderSLARecordQuery) First(ctx context.Context) (*ProviderSLARecord, error) {
+	nodes, err := psrq.Limit(1).All(setContextOp(ctx, psrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{providerslarecord.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) FirstX(ctx context.Context) *ProviderSLARecord {
+	node, err := psrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProviderSLARecord ID from the query.
+// Returns a *NotFoundError when no ProviderSLARecord ID was found.
+func (psrq *ProviderSLARecordQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = psrq.Limit(1).IDs(setContextOp(ctx, psrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{providerslarecord.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := psrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProviderSLARecord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProviderSLARecord entity is found.
+// Returns a *NotFoundError when no ProviderSLARecord entities are found.
+func (psrq *ProviderSLARecordQuery) Only(ctx context.Context) (*ProviderSLARecord, error) {
+	nodes, err := psrq.Limit(2).All(setContextOp(ctx, psrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{providerslarecord.Label}
+	default:
+		return nil, &NotSingularError{providerslarecord.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) OnlyX(ctx context.Context) *ProviderSLARecord {
+	node, err := psrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProviderSLARecord ID in the query.
+// Returns a *NotSingularError when more than one ProviderSLARecord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (psrq *ProviderSLARecordQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = psrq.Limit(2).IDs(setContextOp(ctx, psrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{providerslarecord.Label}
+	default:
+		err = &NotSingularError{providerslarecord.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := psrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProviderSLARecords.
+func (psrq *ProviderSLARecordQuery) All(ctx context.Context) ([]*ProviderSLARecord, error) {
+	ctx = setContextOp(ctx, psrq.ctx, ent.OpQueryAll)
+	if err := psrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProviderSLARecord, *ProviderSLARecordQuery]()
+	return withInterceptors[[]*ProviderSLARecord](ctx, psrq, qr, psrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) AllX(ctx context.Context) []*ProviderSLARecord {
+	nodes, err := psrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProviderSLARecord IDs.
+func (psrq *ProviderSLARecordQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if psrq.ctx.Unique == nil && psrq.path != nil {
+		psrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, psrq.ctx, ent.OpQueryIDs)
+	if err = psrq.Select(providerslarecord.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := psrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (psrq *ProviderSLARecordQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, psrq.ctx, ent.OpQueryCount)
+	if err := psrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, psrq, querierCount[*ProviderSLARecordQuery](), psrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) CountX(ctx context.Context) int {
+	count, err := psrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (psrq *ProviderSLARecordQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, psrq.ctx, ent.OpQueryExist)
+	switch _, err := psrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (psrq *ProviderSLARecordQuery) ExistX(ctx context.Context) bool {
+	exist, err := psrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProviderSLARecordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (psrq *ProviderSLARecordQuery) Clone() *ProviderSLARecordQuery {
+	if psrq == nil {
+		return nil
+	}
+	return &ProviderSLARecordQuery{
+		config:       psrq.config,
+		ctx:          psrq.ctx.Clone(),
+		order:        append([]providerslarecord.OrderOption{}, psrq.order...),
+		inters:       append([]Interceptor{}, psrq.inters...),
+		predicates:   append([]predicate.ProviderSLARecord{}, psrq.predicates...),
+		withProvider: psrq.withProvider.Clone(),
+		withOrder:    psrq.withOrder.Clone(),
+		// clone intermediate query.
+		sql:  psrq.sql.Clone(),
+		path: psrq.path,
+	}
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (psrq *ProviderSLARecordQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *ProviderSLARecordQuery {
+	query := (&ProviderProfileClient{config: psrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psrq.withProvider = query
+	return psrq
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (psrq *ProviderSLARecordQuery) WithOrder(opts ...func(*LockPaymentOrderQuery)) *ProviderSLARecordQuery {
+	query := (&LockPaymentOrderClient{config: psrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	psrq.withOrder = query
+	return psrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AssignedAt time.Time `json:"assigned_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProviderSLARecord.Query().
+//		GroupBy(providerslarecord.FieldAssignedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (psrq *ProviderSLARecordQuery) GroupBy(field string, fields ...string) *ProviderSLARecordGroupBy {
+	psrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProviderSLARecordGroupBy{build: psrq}
+	grbuild.flds = &psrq.ctx.Fields
+	grbuild.label = providerslarecord.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AssignedAt time.Time `json:"assigned_at,omitempty"`
+//	}
+//
+//	client.ProviderSLARecord.Query().
+//		Select(providerslarecord.FieldAssignedAt).
+//		Scan(ctx, &v)
+func (psrq *ProviderSLARecordQuery) Select(fields ...string) *ProviderSLARecordSelect {
+	psrq.ctx.Fields = append(psrq.ctx.Fields, fields...)
+	sbuild := &ProviderSLARecordSelect{ProviderSLARecordQuery: psrq}
+	sbuild.label = providerslarecord.Label
+	sbuild.flds, sbuild.scan = &psrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProviderSLARecordSelect configured with the given aggregations.
+func (psrq *ProviderSLARecordQuery) Aggregate(fns ...AggregateFunc) *ProviderSLARecordSelect {
+	return psrq.Select().Aggregate(fns...)
+}
+
+func (psrq *ProviderSLARecordQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range psrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, psrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range psrq.ctx.Fields {
+		if !providerslarecord.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if psrq.path != nil {
+		prev, err := psrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		psrq.sql = prev
+	}
+	return nil
+}
+
+func (psrq *ProviderSLARecordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProviderSLARecord, error) {
+	var (
+		nodes       = []*ProviderSLARecord{}
+		withFKs     = psrq.withFKs
+		_spec       = psrq.querySpec()
+		loadedTypes = [2]bool{
+			psrq.withProvider != nil,
+			psrq.withOrder != nil,
+		}
+	)
+	if psrq.withProvider != nil || psrq.withOrder != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, providerslarecord.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProviderSLARecord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProviderSLARecord{config: psrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, psrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := psrq.withProvider; query != nil {
+		if err := psrq.loadProvider(ctx, query, nodes, nil,
+			func(n *ProviderSLARecord, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := psrq.withOrder; query != nil {
+		if err := psrq.loadOrder(ctx, query, nodes, nil,
+			func(n *ProviderSLARecord, e *LockPaymentOrder) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (psrq *ProviderSLARecordQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*ProviderSLARecord, init func(*ProviderSLARecord), assign func(*ProviderSLARecord, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProviderSLARecord)
+	for i := range nodes {
+		if nodes[i].provider_profile_sla_records == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_sla_records
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_sla_records" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (psrq *ProviderSLARecordQuery) loadOrder(ctx context.Context, query *LockPaymentOrderQuery, nodes []*ProviderSLARecord, init func(*ProviderSLARecord), assign func(*ProviderSLARecord, *LockPaymentOrder)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ProviderSLARecord)
+	for i := range nodes {
+		if nodes[i].lock_payment_order_sla_records == nil {
+			continue
+		}
+		fk := *nodes[i].lock_payment_order_sla_records
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(lockpaymentorder.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "lock_payment_order_sla_records" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (psrq *ProviderSLARecordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := psrq.querySpec()
+	_spec.Node.Columns = psrq.ctx.Fields
+	if len(psrq.ctx.Fields) > 0 {
+		_spec.Unique = psrq.ctx.Unique != nil && *psrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, psrq.driver, _spec)
+}
+
+func (psrq *ProviderSLARecordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(providerslarecord.Table, providerslarecord.Columns, sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID))
+	_spec.From = psrq.sql
+	if unique := psrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if psrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := psrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerslarecord.FieldID)
+		for i := range fields {
+			if fields[i] != providerslarecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := psrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := psrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := psrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := psrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (psrq *ProviderSLARecordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(psrq.driver.Dialect())
+	t1 := builder.Table(providerslarecord.Table)
+	columns := psrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = providerslarecord.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if psrq.sql != nil {
+		selector = psrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if psrq.ctx.Unique != nil && *psrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range psrq.predicates {
+		p(selector)
+	}
+	for _, p := range psrq.order {
+		p(selector)
+	}
+	if offset := psrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := psrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProviderSLARecordGroupBy is the group-by builder for ProviderSLARecord entities.
+type ProviderSLARecordGroupBy struct {
+	selector
+	build *ProviderSLARecordQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (psrgb *ProviderSLARecordGroupBy) Aggregate(fns ...AggregateFunc) *ProviderSLARecordGroupBy {
+	psrgb.fns = append(psrgb.fns, fns...)
+	return psrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (psrgb *ProviderSLARecordGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, psrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := psrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderSLARecordQuery, *ProviderSLARecordGroupBy](ctx, psrgb.build, psrgb, psrgb.build.inters, v)
+}
+
+func (psrgb *ProviderSLARecordGroupBy) sqlScan(ctx context.Context, root *ProviderSLARecordQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(psrgb.fns))
+	for _, fn := range psrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*psrgb.flds)+len(psrgb.fns))
+		for _, f := range *psrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*psrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProviderSLARecordSelect is the builder for selecting fields of ProviderSLARecord entities.
+type ProviderSLARecordSelect struct {
+	*ProviderSLARecordQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (psrs *ProviderSLARecordSelect) Aggregate(fns ...AggregateFunc) *ProviderSLARecordSelect {
+	psrs.fns = append(psrs.fns, fns...)
+	return psrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (psrs *ProviderSLARecordSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, psrs.ctx, ent.OpQuerySelect)
+	if err := psrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProviderSLARecordQuery, *ProviderSLARecordSelect](ctx, psrs.ProviderSLARecordQuery, psrs, psrs.inters, v)
+}
+
+func (psrs *ProviderSLARecordSelect) sqlScan(ctx context.Context, root *ProviderSLARecordQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(psrs.fns))
+	for _, fn := range psrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*psrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := psrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+)
+
+// ProviderSLARecordUpdate is the builder for updating ProviderSLARecord entities.
+type ProviderSLARecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderSLARecordMutation
+}
+
+// Where appends a list predicates to the ProviderSLARecordUpdate builder.
+func (psru *ProviderSLARecordUpdate) Where(ps ...predicate.ProviderSLARecord) *ProviderSLARecordUpdate {
+	psru.mutation.Where(ps...)
+	return psru
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (psru *ProviderSLARecordUpdate) SetAcceptedAt(t time.Time) *ProviderSLARecordUpdate {
+	psru.mutation.SetAcceptedAt(t)
+	return psru
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (psru *ProviderSLARecordUpdate) SetNillableAcceptedAt(t *time.Time) *ProviderSLARecordUpdate {
+	if t != nil {
+		psru.SetAcceptedAt(*t)
+	}
+	return psru
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (psru *ProviderSLARecordUpdate) ClearAcceptedAt() *ProviderSLARecordUpdate {
+	psru.mutation.ClearAcceptedAt()
+	return psru
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (psru *ProviderSLARecordUpdate) SetDeclinedAt(t time.Time) *ProviderSLARecordUpdate {
+	psru.mutation.SetDeclinedAt(t)
+	return psru
+}
+
+// SetNillableDeclinedAt sets the "declined_at" field if the given value is not nil.
+func (psru *ProviderSLARecordUpdate) SetNillableDeclinedAt(t *time.Time) *ProviderSLARecordUpdate {
+	if t != nil {
+		psru.SetDeclinedAt(*t)
+	}
+	return psru
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (psru *ProviderSLARecordUpdate) ClearDeclinedAt() *ProviderSLARecordUpdate {
+	psru.mutation.ClearDeclinedAt()
+	return psru
+}
+
+// SetFulfilledAt sets the "fulfilled_at" field.
+func (psru *ProviderSLARecordUpdate) SetFulfilledAt(t time.Time) *ProviderSLARecordUpdate {
+	psru.mutation.SetFulfilledAt(t)
+	return psru
+}
+
+// SetNillableFulfilledAt sets the "fulfilled_at" field if the given value is not nil.
+func (psru *ProviderSLARecordUpdate) SetNillableFulfilledAt(t *time.Time) *ProviderSLARecordUpdate {
+	if t != nil {
+		psru.SetFulfilledAt(*t)
+	}
+	return psru
+}
+
+// ClearFulfilledAt clears the value of the "fulfilled_at" field.
+func (psru *ProviderSLARecordUpdate) ClearFulfilledAt() *ProviderSLARecordUpdate {
+	psru.mutation.ClearFulfilledAt()
+	return psru
+}
+
+// SetValidatedAt sets the "validated_at" field.
+func (psru *ProviderSLARecordUpdate) SetValidatedAt(t time.Time) *ProviderSLARecordUpdate {
+	psru.mutation.SetValidatedAt(t)
+	return psru
+}
+
+// SetNillableValidatedAt sets the "validated_at" field if the given value is not nil.
+func (psru *ProviderSLARecordUpdate) SetNillableValidatedAt(t *time.Time) *ProviderSLARecordUpdate {
+	if t != nil {
+		psru.SetValidatedAt(*t)
+	}
+	return psru
+}
+
+// ClearValidatedAt clears the value of the "validated_at" field.
+func (psru *ProviderSLARecordUpdate) ClearValidatedAt() *ProviderSLARecordUpdate {
+	psru.mutation.ClearValidatedAt()
+	return psru
+}
+
+// SetBreach sets the "breach" field.
+func (psru *ProviderSLARecordUpdate) SetBreach(pr providerslarecord.Breach) *ProviderSLARecordUpdate {
+	psru.mutation.SetBreach(pr)
+	return psru
+}
+
+// SetNillableBreach sets the "breach" field if the given value is not nil.
+func (psru *ProviderSLARecordUpdate) SetNillableBreach(pr *providerslarecord.Breach) *ProviderSLARecordUpdate {
+	if pr != nil {
+		psru.SetBreach(*pr)
+	}
+	return psru
+}
+
+// ClearBreach clears the value of the "breach" field.
+func (psru *ProviderSLARecordUpdate) ClearBreach() *ProviderSLARecordUpdate {
+	psru.mutation.ClearBreach()
+	return psru
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (psru *ProviderSLARecordUpdate) SetBreachedAt(t time.Time) *ProviderSLARecordUpdate {
+	psru.mutation.SetBreachedAt(t)
+	return psru
+}
+
+// SetNillableBreachedAt sets the "breached_at" field if the given value is not nil.
+func (psru *ProviderSLARecordUpdate) SetNillableBreachedAt(t *time.Time) *ProviderSLARecordUpdate {
+	if t != nil {
+		psru.SetBreachedAt(*t)
+	}
+	return psru
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (psru *ProviderSLARecordUpdate) ClearBreachedAt() *ProviderSLARecordUpdate {
+	psru.mutation.ClearBreachedAt()
+	return psru
+}
+
+// Mutation returns the ProviderSLARecordMutation object of the builder.
+func (psru *ProviderSLARecordUpdate) Mutation() *ProviderSLARecordMutation {
+	return psru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (psru *ProviderSLARecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, psru.sqlSave, psru.mutation, psru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psru *ProviderSLARecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := psru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (psru *ProviderSLARecordUpdate) Exec(ctx context.Context) error {
+	_, err := psru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psru *ProviderSLARecordUpdate) ExecX(ctx context.Context) {
+	if err := psru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psru *ProviderSLARecordUpdate) check() error {
+	if v, ok := psru.mutation.Breach(); ok {
+		if err := providerslarecord.BreachValidator(v); err != nil {
+			return &ValidationError{Name: "breach", err: fmt.Errorf(`ent: validator failed for field "ProviderSLARecord.breach": %w`, err)}
+		}
+	}
+	if psru.mutation.ProviderCleared() && len(psru.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderSLARecord.provider"`)
+	}
+	if psru.mutation.OrderCleared() && len(psru.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderSLARecord.order"`)
+	}
+	return nil
+}
+
+func (psru *ProviderSLARecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := psru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerslarecord.Table, providerslarecord.Columns, sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID))
+	if ps := psru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psru.mutation.AcceptedAt(); ok {
+		_spec.SetField(providerslarecord.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if psru.mutation.AcceptedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := psru.mutation.DeclinedAt(); ok {
+		_spec.SetField(providerslarecord.FieldDeclinedAt, field.TypeTime, value)
+	}
+	if psru.mutation.DeclinedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldDeclinedAt, field.TypeTime)
+	}
+	if value, ok := psru.mutation.FulfilledAt(); ok {
+		_spec.SetField(providerslarecord.FieldFulfilledAt, field.TypeTime, value)
+	}
+	if psru.mutation.FulfilledAtCleared() {
+		_spec.ClearField(providerslarecord.FieldFulfilledAt, field.TypeTime)
+	}
+	if value, ok := psru.mutation.ValidatedAt(); ok {
+		_spec.SetField(providerslarecord.FieldValidatedAt, field.TypeTime, value)
+	}
+	if psru.mutation.ValidatedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldValidatedAt, field.TypeTime)
+	}
+	if value, ok := psru.mutation.Breach(); ok {
+		_spec.SetField(providerslarecord.FieldBreach, field.TypeEnum, value)
+	}
+	if psru.mutation.BreachCleared() {
+		_spec.ClearField(providerslarecord.FieldBreach, field.TypeEnum)
+	}
+	if value, ok := psru.mutation.BreachedAt(); ok {
+		_spec.SetField(providerslarecord.FieldBreachedAt, field.TypeTime, value)
+	}
+	if psru.mutation.BreachedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldBreachedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, psru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerslarecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	psru.mutation.done = true
+	return n, nil
+}
+
+// ProviderSLARecordUpdateOne is the builder for updating a single ProviderSLARecord entity.
+type ProviderSLARecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderSLARecordMutation
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (psruo *ProviderSLARecordUpdateOne) SetAcceptedAt(t time.Time) *ProviderSLARecordUpdateOne {
+	psruo.mutation.SetAcceptedAt(t)
+	return psruo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (psruo *ProviderSLARecordUpdateOne) SetNillableAcceptedAt(t *time.Time) *ProviderSLARecordUpdateOne {
+	if t != nil {
+		psruo.SetAcceptedAt(*t)
+	}
+	return psruo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (psruo *ProviderSLARecordUpdateOne) ClearAcceptedAt() *ProviderSLARecordUpdateOne {
+	psruo.mutation.ClearAcceptedAt()
+	return psruo
+}
+
+// SetDeclinedAt sets the "declined_at" field.
+func (psruo *ProviderSLARecordUpdateOne) SetDeclinedAt(t time.Time) *ProviderSLARecordUpdateOne {
+	psruo.mutation.SetDeclinedAt(t)
+	return psruo
+}
+
+// SetNillableDeclinedAt sets the "declined_at" field if the given value is not nil.
+func (psruo *ProviderSLARecordUpdateOne) SetNillableDeclinedAt(t *time.Time) *ProviderSLARecordUpdateOne {
+	if t != nil {
+		psruo.SetDeclinedAt(*t)
+	}
+	return psruo
+}
+
+// ClearDeclinedAt clears the value of the "declined_at" field.
+func (psruo *ProviderSLARecordUpdateOne) ClearDeclinedAt() *ProviderSLARecordUpdateOne {
+	psruo.mutation.ClearDeclinedAt()
+	return psruo
+}
+
+// SetFulfilledAt sets the "fulfilled_at" field.
+func (psruo *ProviderSLARecordUpdateOne) SetFulfilledAt(t time.Time) *ProviderSLARecordUpdateOne {
+	psruo.mutation.SetFulfilledAt(t)
+	return psruo
+}
+
+// SetNillableFulfilledAt sets the "fulfilled_at" field if the given value is not nil.
+func (psruo *ProviderSLARecordUpdateOne) SetNillableFulfilledAt(t *time.Time) *ProviderSLARecordUpdateOne {
+	if t != nil {
+		psruo.SetFulfilledAt(*t)
+	}
+	return psruo
+}
+
+// ClearFulfilledAt clears the value of the "fulfilled_at" field.
+func (psruo *ProviderSLARecordUpdateOne) ClearFulfilledAt() *ProviderSLARecordUpdateOne {
+	psruo.mutation.ClearFulfilledAt()
+	return psruo
+}
+
+// SetValidatedAt sets the "validated_at" field.
+func (psruo *ProviderSLARecordUpdateOne) SetValidatedAt(t time.Time) *ProviderSLARecordUpdateOne {
+	psruo.mutation.SetValidatedAt(t)
+	return psruo
+}
+
+// SetNillableValidatedAt sets the "validated_at" field if the given value is not nil.
+func (psruo *ProviderSLARecordUpdateOne) SetNillableValidatedAt(t *time.Time) *ProviderSLARecordUpdateOne {
+	if t != nil {
+		psruo.SetValidatedAt(*t)
+	}
+	return psruo
+}
+
+// ClearValidatedAt clears the value of the "validated_at" field.
+func (psruo *ProviderSLARecordUpdateOne) ClearValidatedAt() *ProviderSLARecordUpdateOne {
+	psruo.mutation.ClearValidatedAt()
+	return psruo
+}
+
+// SetBreach sets the "breach" field.
+func (psruo *ProviderSLARecordUpdateOne) SetBreach(pr providerslarecord.Breach) *ProviderSLARecordUpdateOne {
+	psruo.mutation.SetBreach(pr)
+	return psruo
+}
+
+// SetNillableBreach sets the "breach" field if the given value is not nil.
+func (psruo *ProviderSLARecordUpdateOne) SetNillableBreach(pr *providerslarecord.Breach) *ProviderSLARecordUpdateOne {
+	if pr != nil {
+		psruo.SetBreach(*pr)
+	}
+	return psruo
+}
+
+// ClearBreach clears the value of the "breach" field.
+func (psruo *ProviderSLARecordUpdateOne) ClearBreach() *ProviderSLARecordUpdateOne {
+	psruo.mutation.ClearBreach()
+	return psruo
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (psruo *ProviderSLARecordUpdateOne) SetBreachedAt(t time.Time) *ProviderSLARecordUpdateOne {
+	psruo.mutation.SetBreachedAt(t)
+	return psruo
+}
+
+// SetNillableBreachedAt sets the "breached_at" field if the given value is not nil.
+func (psruo *ProviderSLARecordUpdateOne) SetNillableBreachedAt(t *time.Time) *ProviderSLARecordUpdateOne {
+	if t != nil {
+		psruo.SetBreachedAt(*t)
+	}
+	return psruo
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (psruo *ProviderSLARecordUpdateOne) ClearBreachedAt() *ProviderSLARecordUpdateOne {
+	psruo.mutation.ClearBreachedAt()
+	return psruo
+}
+
+// Mutation returns the ProviderSLARecordMutation object of the builder.
+func (psruo *ProviderSLARecordUpdateOne) Mutation() *ProviderSLARecordMutation {
+	return psruo.mutation
+}
+
+// Where appends a list predicates to the ProviderSLARecordUpdate builder.
+func (psruo *ProviderSLARecordUpdateOne) Where(ps ...predicate.ProviderSLARecord) *ProviderSLARecordUpdateOne {
+	psruo.mutation.Where(ps...)
+	return psruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (psruo *ProviderSLARecordUpdateOne) Select(field string, fields ...string) *ProviderSLARecordUpdateOne {
+	psruo.fields = append([]string{field}, fields...)
+	return psruo
+}
+
+// Save executes the query and returns the updated ProviderSLARecord entity.
+func (psruo *ProviderSLARecordUpdateOne) Save(ctx context.Context) (*ProviderSLARecord, error) {
+	return withHooks(ctx, psruo.sqlSave, psruo.mutation, psruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (psruo *ProviderSLARecordUpdateOne) SaveX(ctx context.Context) *ProviderSLARecord {
+	node, err := psruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (psruo *ProviderSLARecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := psruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (psruo *ProviderSLARecordUpdateOne) ExecX(ctx context.Context) {
+	if err := psruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (psruo *ProviderSLARecordUpdateOne) check() error {
+	if v, ok := psruo.mutation.Breach(); ok {
+		if err := providerslarecord.BreachValidator(v); err != nil {
+			return &ValidationError{Name: "breach", err: fmt.Errorf(`ent: validator failed for field "ProviderSLARecord.breach": %w`, err)}
+		}
+	}
+	if psruo.mutation.ProviderCleared() && len(psruo.mutation.ProviderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderSLARecord.provider"`)
+	}
+	if psruo.mutation.OrderCleared() && len(psruo.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProviderSLARecord.order"`)
+	}
+	return nil
+}
+
+func (psruo *ProviderSLARecordUpdateOne) sqlSave(ctx context.Context) (_node *ProviderSLARecord, err error) {
+	if err := psruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(providerslarecord.Table, providerslarecord.Columns, sqlgraph.NewFieldSpec(providerslarecord.FieldID, field.TypeUUID))
+	id, ok := psruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderSLARecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := psruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, providerslarecord.FieldID)
+		for _, f := range fields {
+			if !providerslarecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != providerslarecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := psruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := psruo.mutation.AcceptedAt(); ok {
+		_spec.SetField(providerslarecord.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if psruo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldAcceptedAt, field.TypeTime)
+	}
+	if value, ok := psruo.mutation.DeclinedAt(); ok {
+		_spec.SetField(providerslarecord.FieldDeclinedAt, field.TypeTime, value)
+	}
+	if psruo.mutation.DeclinedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldDeclinedAt, field.TypeTime)
+	}
+	if value, ok := psruo.mutation.FulfilledAt(); ok {
+		_spec.SetField(providerslarecord.FieldFulfilledAt, field.TypeTime, value)
+	}
+	if psruo.mutation.FulfilledAtCleared() {
+		_spec.ClearField(providerslarecord.FieldFulfilledAt, field.TypeTime)
+	}
+	if value, ok := psruo.mutation.ValidatedAt(); ok {
+		_spec.SetField(providerslarecord.FieldValidatedAt, field.TypeTime, value)
+	}
+	if psruo.mutation.ValidatedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldValidatedAt, field.TypeTime)
+	}
+	if value, ok := psruo.mutation.Breach(); ok {
+		_spec.SetField(providerslarecord.FieldBreach, field.TypeEnum, value)
+	}
+	if psruo.mutation.BreachCleared() {
+		_spec.ClearField(providerslarecord.FieldBreach, field.TypeEnum)
+	}
+	if value, ok := psruo.mutation.BreachedAt(); ok {
+		_spec.SetField(providerslarecord.FieldBreachedAt, field.TypeTime, value)
+	}
+	if psruo.mutation.BreachedAtCleared() {
+		_spec.ClearField(providerslarecord.FieldBreachedAt, field.TypeTime)
+	}
+	_node = &ProviderSLARecord{config: psruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, psruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{providerslarecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	psruo.mutation.done = true
+	return _node, nil
+}
@@ -26,6 +26,8 @@ type ProvisionBucket struct {
 	MaxAmount decimal.Decimal `json:"max_amount,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// MatchingStrategy holds the value of the "matching_strategy" field.
+	MatchingStrategy provisionbucket.MatchingStrategy `json:"matching_strategy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProvisionBucketQuery when eager-loading is set.
 	Edges                           ProvisionBucketEdges `json:"edges"`
@@ -84,6 +86,8 @@ func (*ProvisionBucket) scanValues(columns []string) ([]any, error) {
 			values[i] = new(decimal.Decimal)
 		case provisionbucket.FieldID:
 			values[i] = new(sql.NullInt64)
+		case provisionbucket.FieldMatchingStrategy:
+			values[i] = new(sql.NullString)
 		case provisionbucket.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case provisionbucket.ForeignKeys[0]: // fiat_currency_provision_buckets
@@ -127,6 +131,12 @@ func (pb *ProvisionBucket) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pb.CreatedAt = value.Time
 			}
+		case provisionbucket.FieldMatchingStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field matching_strategy", values[i])
+			} else if value.Valid {
+				pb.MatchingStrategy = provisionbucket.MatchingStrategy(value.String)
+			}
 		case provisionbucket.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field fiat_currency_provision_buckets", values[i])
@@ -193,6 +203,9 @@ func (pb *ProvisionBucket) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("matching_strategy=")
+	builder.WriteString(fmt.Sprintf("%v", pb.MatchingStrategy))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package provisionbucket
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -20,6 +21,8 @@ const (
 	FieldMaxAmount = "max_amount"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldMatchingStrategy holds the string denoting the matching_strategy field in the database.
+	FieldMatchingStrategy = "matching_strategy"
 	// EdgeCurrency holds the string denoting the currency edge name in mutations.
 	EdgeCurrency = "currency"
 	// EdgeLockPaymentOrders holds the string denoting the lock_payment_orders edge name in mutations.
@@ -55,6 +58,7 @@ var Columns = []string{
 	FieldMinAmount,
 	FieldMaxAmount,
 	FieldCreatedAt,
+	FieldMatchingStrategy,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provision_buckets"
@@ -89,6 +93,31 @@ var (
 	DefaultCreatedAt func() time.Time
 )
 
+// MatchingStrategy defines the type for the "matching_strategy" enum field.
+type MatchingStrategy string
+
+// MatchingStrategy values.
+const (
+	MatchingStrategyPriceTime     MatchingStrategy = "price_time"
+	MatchingStrategyRoundRobin    MatchingStrategy = "round_robin"
+	MatchingStrategyTrustWeighted MatchingStrategy = "trust_weighted"
+	MatchingStrategyLowestLatency MatchingStrategy = "lowest_latency"
+)
+
+func (ms MatchingStrategy) String() string {
+	return string(ms)
+}
+
+// MatchingStrategyValidator is a validator for the "matching_strategy" field enum values. It is called by the builders before save.
+func MatchingStrategyValidator(ms MatchingStrategy) error {
+	switch ms {
+	case MatchingStrategyPriceTime, MatchingStrategyRoundRobin, MatchingStrategyTrustWeighted, MatchingStrategyLowestLatency:
+		return nil
+	default:
+		return fmt.Errorf("provisionbucket: invalid enum value for matching_strategy field: %q", ms)
+	}
+}
+
 // OrderOption defines the ordering options for the ProvisionBucket queries.
 type OrderOption func(*sql.Selector)
 
@@ -112,6 +141,11 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByMatchingStrategy orders the results by the matching_strategy field.
+func ByMatchingStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchingStrategy, opts...).ToFunc()
+}
+
 // ByCurrencyField orders the results by currency field.
 func ByCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
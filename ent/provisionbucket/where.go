@@ -191,6 +191,36 @@ func CreatedAtLTE(v time.Time) predicate.ProvisionBucket {
 	return predicate.ProvisionBucket(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// MatchingStrategyEQ applies the EQ predicate on the "matching_strategy" field.
+func MatchingStrategyEQ(v MatchingStrategy) predicate.ProvisionBucket {
+	return predicate.ProvisionBucket(sql.FieldEQ(FieldMatchingStrategy, v))
+}
+
+// MatchingStrategyNEQ applies the NEQ predicate on the "matching_strategy" field.
+func MatchingStrategyNEQ(v MatchingStrategy) predicate.ProvisionBucket {
+	return predicate.ProvisionBucket(sql.FieldNEQ(FieldMatchingStrategy, v))
+}
+
+// MatchingStrategyIn applies the In predicate on the "matching_strategy" field.
+func MatchingStrategyIn(vs ...MatchingStrategy) predicate.ProvisionBucket {
+	return predicate.ProvisionBucket(sql.FieldIn(FieldMatchingStrategy, vs...))
+}
+
+// MatchingStrategyNotIn applies the NotIn predicate on the "matching_strategy" field.
+func MatchingStrategyNotIn(vs ...MatchingStrategy) predicate.ProvisionBucket {
+	return predicate.ProvisionBucket(sql.FieldNotIn(FieldMatchingStrategy, vs...))
+}
+
+// MatchingStrategyIsNil applies the IsNil predicate on the "matching_strategy" field.
+func MatchingStrategyIsNil() predicate.ProvisionBucket {
+	return predicate.ProvisionBucket(sql.FieldIsNull(FieldMatchingStrategy))
+}
+
+// MatchingStrategyNotNil applies the NotNil predicate on the "matching_strategy" field.
+func MatchingStrategyNotNil() predicate.ProvisionBucket {
+	return predicate.ProvisionBucket(sql.FieldNotNull(FieldMatchingStrategy))
+}
+
 // HasCurrency applies the HasEdge predicate on the "currency" edge.
 func HasCurrency() predicate.ProvisionBucket {
 	return predicate.ProvisionBucket(func(s *sql.Selector) {
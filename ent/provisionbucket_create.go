@@ -53,6 +53,20 @@ func (pbc *ProvisionBucketCreate) SetNillableCreatedAt(t *time.Time) *ProvisionB
 	return pbc
 }
 
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (pbc *ProvisionBucketCreate) SetMatchingStrategy(ps provisionbucket.MatchingStrategy) *ProvisionBucketCreate {
+	pbc.mutation.SetMatchingStrategy(ps)
+	return pbc
+}
+
+// SetNillableMatchingStrategy sets the "matching_strategy" field if the given value is not nil.
+func (pbc *ProvisionBucketCreate) SetNillableMatchingStrategy(ps *provisionbucket.MatchingStrategy) *ProvisionBucketCreate {
+	if ps != nil {
+		pbc.SetMatchingStrategy(*ps)
+	}
+	return pbc
+}
+
 // SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
 func (pbc *ProvisionBucketCreate) SetCurrencyID(id uuid.UUID) *ProvisionBucketCreate {
 	pbc.mutation.SetCurrencyID(id)
@@ -146,6 +160,11 @@ func (pbc *ProvisionBucketCreate) check() error {
 	if _, ok := pbc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProvisionBucket.created_at"`)}
 	}
+	if v, ok := pbc.mutation.MatchingStrategy(); ok {
+		if err := provisionbucket.MatchingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "matching_strategy", err: fmt.Errorf(`ent: validator failed for field "ProvisionBucket.matching_strategy": %w`, err)}
+		}
+	}
 	if len(pbc.mutation.CurrencyIDs()) == 0 {
 		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "ProvisionBucket.currency"`)}
 	}
@@ -188,6 +207,10 @@ func (pbc *ProvisionBucketCreate) createSpec() (*ProvisionBucket, *sqlgraph.Crea
 		_spec.SetField(provisionbucket.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := pbc.mutation.MatchingStrategy(); ok {
+		_spec.SetField(provisionbucket.FieldMatchingStrategy, field.TypeEnum, value)
+		_node.MatchingStrategy = value
+	}
 	if nodes := pbc.mutation.CurrencyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -325,6 +348,24 @@ func (u *ProvisionBucketUpsert) AddMaxAmount(v decimal.Decimal) *ProvisionBucket
 	return u
 }
 
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (u *ProvisionBucketUpsert) SetMatchingStrategy(v provisionbucket.MatchingStrategy) *ProvisionBucketUpsert {
+	u.Set(provisionbucket.FieldMatchingStrategy, v)
+	return u
+}
+
+// UpdateMatchingStrategy sets the "matching_strategy" field to the value that was provided on create.
+func (u *ProvisionBucketUpsert) UpdateMatchingStrategy() *ProvisionBucketUpsert {
+	u.SetExcluded(provisionbucket.FieldMatchingStrategy)
+	return u
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (u *ProvisionBucketUpsert) ClearMatchingStrategy() *ProvisionBucketUpsert {
+	u.SetNull(provisionbucket.FieldMatchingStrategy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -412,6 +453,27 @@ func (u *ProvisionBucketUpsertOne) UpdateMaxAmount() *ProvisionBucketUpsertOne {
 	})
 }
 
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (u *ProvisionBucketUpsertOne) SetMatchingStrategy(v provisionbucket.MatchingStrategy) *ProvisionBucketUpsertOne {
+	return u.Update(func(s *ProvisionBucketUpsert) {
+		s.SetMatchingStrategy(v)
+	})
+}
+
+// UpdateMatchingStrategy sets the "matching_strategy" field to the value that was provided on create.
+func (u *ProvisionBucketUpsertOne) UpdateMatchingStrategy() *ProvisionBucketUpsertOne {
+	return u.Update(func(s *ProvisionBucketUpsert) {
+		s.UpdateMatchingStrategy()
+	})
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (u *ProvisionBucketUpsertOne) ClearMatchingStrategy() *ProvisionBucketUpsertOne {
+	return u.Update(func(s *ProvisionBucketUpsert) {
+		s.ClearMatchingStrategy()
+	})
+}
+
 // Exec executes the query.
 func (u *ProvisionBucketUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -665,6 +727,27 @@ func (u *ProvisionBucketUpsertBulk) UpdateMaxAmount() *ProvisionBucketUpsertBulk
 	})
 }
 
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (u *ProvisionBucketUpsertBulk) SetMatchingStrategy(v provisionbucket.MatchingStrategy) *ProvisionBucketUpsertBulk {
+	return u.Update(func(s *ProvisionBucketUpsert) {
+		s.SetMatchingStrategy(v)
+	})
+}
+
+// UpdateMatchingStrategy sets the "matching_strategy" field to the value that was provided on create.
+func (u *ProvisionBucketUpsertBulk) UpdateMatchingStrategy() *ProvisionBucketUpsertBulk {
+	return u.Update(func(s *ProvisionBucketUpsert) {
+		s.UpdateMatchingStrategy()
+	})
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (u *ProvisionBucketUpsertBulk) ClearMatchingStrategy() *ProvisionBucketUpsertBulk {
+	return u.Update(func(s *ProvisionBucketUpsert) {
+		s.ClearMatchingStrategy()
+	})
+}
+
 // Exec executes the query.
 func (u *ProvisionBucketUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
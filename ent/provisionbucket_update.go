@@ -74,6 +74,26 @@ func (pbu *ProvisionBucketUpdate) AddMaxAmount(d decimal.Decimal) *ProvisionBuck
 	return pbu
 }
 
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (pbu *ProvisionBucketUpdate) SetMatchingStrategy(ps provisionbucket.MatchingStrategy) *ProvisionBucketUpdate {
+	pbu.mutation.SetMatchingStrategy(ps)
+	return pbu
+}
+
+// SetNillableMatchingStrategy sets the "matching_strategy" field if the given value is not nil.
+func (pbu *ProvisionBucketUpdate) SetNillableMatchingStrategy(ps *provisionbucket.MatchingStrategy) *ProvisionBucketUpdate {
+	if ps != nil {
+		pbu.SetMatchingStrategy(*ps)
+	}
+	return pbu
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (pbu *ProvisionBucketUpdate) ClearMatchingStrategy() *ProvisionBucketUpdate {
+	pbu.mutation.ClearMatchingStrategy()
+	return pbu
+}
+
 // SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
 func (pbu *ProvisionBucketUpdate) SetCurrencyID(id uuid.UUID) *ProvisionBucketUpdate {
 	pbu.mutation.SetCurrencyID(id)
@@ -197,6 +217,11 @@ func (pbu *ProvisionBucketUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (pbu *ProvisionBucketUpdate) check() error {
+	if v, ok := pbu.mutation.MatchingStrategy(); ok {
+		if err := provisionbucket.MatchingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "matching_strategy", err: fmt.Errorf(`ent: validator failed for field "ProvisionBucket.matching_strategy": %w`, err)}
+		}
+	}
 	if pbu.mutation.CurrencyCleared() && len(pbu.mutation.CurrencyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ProvisionBucket.currency"`)
 	}
@@ -227,6 +252,12 @@ func (pbu *ProvisionBucketUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := pbu.mutation.AddedMaxAmount(); ok {
 		_spec.AddField(provisionbucket.FieldMaxAmount, field.TypeFloat64, value)
 	}
+	if value, ok := pbu.mutation.MatchingStrategy(); ok {
+		_spec.SetField(provisionbucket.FieldMatchingStrategy, field.TypeEnum, value)
+	}
+	if pbu.mutation.MatchingStrategyCleared() {
+		_spec.ClearField(provisionbucket.FieldMatchingStrategy, field.TypeEnum)
+	}
 	if pbu.mutation.CurrencyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -408,6 +439,26 @@ func (pbuo *ProvisionBucketUpdateOne) AddMaxAmount(d decimal.Decimal) *Provision
 	return pbuo
 }
 
+// SetMatchingStrategy sets the "matching_strategy" field.
+func (pbuo *ProvisionBucketUpdateOne) SetMatchingStrategy(ps provisionbucket.MatchingStrategy) *ProvisionBucketUpdateOne {
+	pbuo.mutation.SetMatchingStrategy(ps)
+	return pbuo
+}
+
+// SetNillableMatchingStrategy sets the "matching_strategy" field if the given value is not nil.
+func (pbuo *ProvisionBucketUpdateOne) SetNillableMatchingStrategy(ps *provisionbucket.MatchingStrategy) *ProvisionBucketUpdateOne {
+	if ps != nil {
+		pbuo.SetMatchingStrategy(*ps)
+	}
+	return pbuo
+}
+
+// ClearMatchingStrategy clears the value of the "matching_strategy" field.
+func (pbuo *ProvisionBucketUpdateOne) ClearMatchingStrategy() *ProvisionBucketUpdateOne {
+	pbuo.mutation.ClearMatchingStrategy()
+	return pbuo
+}
+
 // SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
 func (pbuo *ProvisionBucketUpdateOne) SetCurrencyID(id uuid.UUID) *ProvisionBucketUpdateOne {
 	pbuo.mutation.SetCurrencyID(id)
@@ -544,6 +595,11 @@ func (pbuo *ProvisionBucketUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (pbuo *ProvisionBucketUpdateOne) check() error {
+	if v, ok := pbuo.mutation.MatchingStrategy(); ok {
+		if err := provisionbucket.MatchingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "matching_strategy", err: fmt.Errorf(`ent: validator failed for field "ProvisionBucket.matching_strategy": %w`, err)}
+		}
+	}
 	if pbuo.mutation.CurrencyCleared() && len(pbuo.mutation.CurrencyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ProvisionBucket.currency"`)
 	}
@@ -591,6 +647,12 @@ func (pbuo *ProvisionBucketUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 	if value, ok := pbuo.mutation.AddedMaxAmount(); ok {
 		_spec.AddField(provisionbucket.FieldMaxAmount, field.TypeFloat64, value)
 	}
+	if value, ok := pbuo.mutation.MatchingStrategy(); ok {
+		_spec.SetField(provisionbucket.FieldMatchingStrategy, field.TypeEnum, value)
+	}
+	if pbuo.mutation.MatchingStrategyCleared() {
+		_spec.ClearField(provisionbucket.FieldMatchingStrategy, field.TypeEnum)
+	}
 	if pbuo.mutation.CurrencyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
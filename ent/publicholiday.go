@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+)
+
+// PublicHoliday is the model entity for the PublicHoliday schema.
+type PublicHoliday struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Date holds the value of the "date" field.
+	Date time.Time `json:"date,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PublicHolidayQuery when eager-loading is set.
+	Edges                         PublicHolidayEdges `json:"edges"`
+	fiat_currency_public_holidays *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// PublicHolidayEdges holds the relations/edges for other nodes in the graph.
+type PublicHolidayEdges struct {
+	// FiatCurrency holds the value of the fiat_currency edge.
+	FiatCurrency *FiatCurrency `json:"fiat_currency,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FiatCurrencyOrErr returns the FiatCurrency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PublicHolidayEdges) FiatCurrencyOrErr() (*FiatCurrency, error) {
+	if e.FiatCurrency != nil {
+		return e.FiatCurrency, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "fiat_currency"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PublicHoliday) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case publicholiday.FieldID:
+			values[i] = new(sql.NullInt64)
+		case publicholiday.FieldName:
+			values[i] = new(sql.NullString)
+		case publicholiday.FieldCreatedAt, publicholiday.FieldUpdatedAt, publicholiday.FieldDate:
+			values[i] = new(sql.NullTime)
+		case publicholiday.ForeignKeys[0]: // fiat_currency_public_holidays
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PublicHoliday fields.
+func (ph *PublicHoliday) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case publicholiday.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ph.ID = int(value.Int64)
+		case publicholiday.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ph.CreatedAt = value.Time
+			}
+		case publicholiday.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ph.UpdatedAt = value.Time
+			}
+		case publicholiday.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				ph.Date = value.Time
+			}
+		case publicholiday.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ph.Name = value.String
+			}
+		case publicholiday.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_public_holidays", values[i])
+			} else if value.Valid {
+				ph.fiat_currency_public_holidays = new(uuid.UUID)
+				*ph.fiat_currency_public_holidays = *value.S.(*uuid.UUID)
+			}
+		default:
+			ph.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PublicHoliday.
+// This includes values selected through modifiers, order, etc.
+func (ph *PublicHoliday) Value(name string) (ent.Value, error) {
+	return ph.selectValues.Get(name)
+}
+
+// QueryFiatCurrency queries the "fiat_currency" edge of the PublicHoliday entity.
+func (ph *PublicHoliday) QueryFiatCurrency() *FiatCurrencyQuery {
+	return NewPublicHolidayClient(ph.config).QueryFiatCurrency(ph)
+}
+
+// Update returns a builder for updating this PublicHoliday.
+// Note that you need to call PublicHoliday.Unwrap() before calling this method if this PublicHoliday
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ph *PublicHoliday) Update() *PublicHolidayUpdateOne {
+	return NewPublicHolidayClient(ph.config).UpdateOne(ph)
+}
+
+// Unwrap unwraps the PublicHoliday entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ph *PublicHoliday) Unwrap() *PublicHoliday {
+	_tx, ok := ph.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PublicHoliday is not a transactional entity")
+	}
+	ph.config.driver = _tx.drv
+	return ph
+}
+
+// String implements the fmt.Stringer.
+func (ph *PublicHoliday) String() string {
+	var builder strings.Builder
+	builder.WriteString("PublicHoliday(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ph.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ph.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ph.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(ph.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ph.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PublicHolidays is a parsable slice of PublicHoliday.
+type PublicHolidays []*PublicHoliday
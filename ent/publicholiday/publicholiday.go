@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package publicholiday
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the publicholiday type in the database.
+	Label = "public_holiday"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// EdgeFiatCurrency holds the string denoting the fiat_currency edge name in mutations.
+	EdgeFiatCurrency = "fiat_currency"
+	// Table holds the table name of the publicholiday in the database.
+	Table = "public_holidays"
+	// FiatCurrencyTable is the table that holds the fiat_currency relation/edge.
+	FiatCurrencyTable = "public_holidays"
+	// FiatCurrencyInverseTable is the table name for the FiatCurrency entity.
+	// It exists in this package in order to avoid circular dependency with the "fiatcurrency" package.
+	FiatCurrencyInverseTable = "fiat_currencies"
+	// FiatCurrencyColumn is the table column denoting the fiat_currency relation/edge.
+	FiatCurrencyColumn = "fiat_currency_public_holidays"
+)
+
+// Columns holds all SQL columns for publicholiday fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDate,
+	FieldName,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "public_holidays"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"fiat_currency_public_holidays",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PublicHoliday queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByFiatCurrencyField orders the results by fiat_currency field.
+func ByFiatCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFiatCurrencyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFiatCurrencyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FiatCurrencyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FiatCurrencyTable, FiatCurrencyColumn),
+	)
+}
@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+)
+
+// PublicHolidayCreate is the builder for creating a PublicHoliday entity.
+type PublicHolidayCreate struct {
+	config
+	mutation *PublicHolidayMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (phc *PublicHolidayCreate) SetCreatedAt(t time.Time) *PublicHolidayCreate {
+	phc.mutation.SetCreatedAt(t)
+	return phc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (phc *PublicHolidayCreate) SetNillableCreatedAt(t *time.Time) *PublicHolidayCreate {
+	if t != nil {
+		phc.SetCreatedAt(*t)
+	}
+	return phc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (phc *PublicHolidayCreate) SetUpdatedAt(t time.Time) *PublicHolidayCreate {
+	phc.mutation.SetUpdatedAt(t)
+	return phc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (phc *PublicHolidayCreate) SetNillableUpdatedAt(t *time.Time) *PublicHolidayCreate {
+	if t != nil {
+		phc.SetUpdatedAt(*t)
+	}
+	return phc
+}
+
+// SetDate sets the "date" field.
+func (phc *PublicHolidayCreate) SetDate(t time.Time) *PublicHolidayCreate {
+	phc.mutation.SetDate(t)
+	return phc
+}
+
+// SetName sets the "name" field.
+func (phc *PublicHolidayCreate) SetName(s string) *PublicHolidayCreate {
+	phc.mutation.SetName(s)
+	return phc
+}
+
+// SetFiatCurrencyID sets the "fiat_currency" edge to the FiatCurrency entity by ID.
+func (phc *PublicHolidayCreate) SetFiatCurrencyID(id uuid.UUID) *PublicHolidayCreate {
+	phc.mutation.SetFiatCurrencyID(id)
+	return phc
+}
+
+// SetFiatCurrency sets the "fiat_currency" edge to the FiatCurrency entity.
+func (phc *PublicHolidayCreate) SetFiatCurrency(f *FiatCurrency) *PublicHolidayCreate {
+	return phc.SetFiatCurrencyID(f.ID)
+}
+
+// Mutation returns the PublicHolidayMutation object of the builder.
+func (phc *PublicHolidayCreate) Mutation() *PublicHolidayMutation {
+	return phc.mutation
+}
+
+// Save creates the PublicHoliday in the database.
+func (phc *PublicHolidayCreate) Save(ctx context.Context) (*PublicHoliday, error) {
+	phc.defaults()
+	return withHooks(ctx, phc.sqlSave, phc.mutation, phc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (phc *PublicHolidayCreate) SaveX(ctx context.Context) *PublicHoliday {
+	v, err := phc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phc *PublicHolidayCreate) Exec(ctx context.Context) error {
+	_, err := phc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phc *PublicHolidayCreate) ExecX(ctx context.Context) {
+	if err := phc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phc *PublicHolidayCreate) defaults() {
+	if _, ok := phc.mutation.CreatedAt(); !ok {
+		v := publicholiday.DefaultCreatedAt()
+		phc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := phc.mutation.UpdatedAt(); !ok {
+		v := publicholiday.DefaultUpdatedAt()
+		phc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phc *PublicHolidayCreate) check() error {
+	if _, ok := phc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PublicHoliday.created_at"`)}
+	}
+	if _, ok := phc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PublicHoliday.updated_at"`)}
+	}
+	if _, ok := phc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "PublicHoliday.date"`)}
+	}
+	if _, ok := phc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PublicHoliday.name"`)}
+	}
+	if len(phc.mutation.FiatCurrencyIDs()) == 0 {
+		return &ValidationError{Name: "fiat_currency", err: errors.New(`ent: missing required edge "PublicHoliday.fiat_currency"`)}
+	}
+	return nil
+}
+
+func (phc *PublicHolidayCreate) sqlSave(ctx context.Context) (*PublicHoliday, error) {
+	if err := phc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := phc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, phc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	phc.mutation.id = &_node.ID
+	phc.mutation.done = true
+	return _node, nil
+}
+
+func (phc *PublicHolidayCreate) createSpec() (*PublicHoliday, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PublicHoliday{config: phc.config}
+		_spec = sqlgraph.NewCreateSpec(publicholiday.Table, sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = phc.conflict
+	if value, ok := phc.mutation.CreatedAt(); ok {
+		_spec.SetField(publicholiday.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := phc.mutation.UpdatedAt(); ok {
+		_spec.SetField(publicholiday.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := phc.mutation.Date(); ok {
+		_spec.SetField(publicholiday.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := phc.mutation.Name(); ok {
+		_spec.SetField(publicholiday.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if nodes := phc.mutation.FiatCurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publicholiday.FiatCurrencyTable,
+			Columns: []string{publicholiday.FiatCurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_public_holidays = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PublicHoliday.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublicHolidayUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (phc *PublicHolidayCreate) OnConflict(opts ...sql.ConflictOption) *PublicHolidayUpsertOne {
+	phc.conflict = opts
+	return &PublicHolidayUpsertOne{
+		create: phc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PublicHoliday.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (phc *PublicHolidayCreate) OnConflictColumns(columns ...string) *PublicHolidayUpsertOne {
+	phc.conflict = append(phc.conflict, sql.ConflictColumns(columns...))
+	return &PublicHolidayUpsertOne{
+		create: phc,
+	}
+}
+
+type (
+	// PublicHolidayUpsertOne is the builder for "upsert"-ing
+	//  one PublicHoliday node.
+	PublicHolidayUpsertOne struct {
+		create *PublicHolidayCreate
+	}
+
+	// PublicHolidayUpsert is the "OnConflict" setter.
+	PublicHolidayUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublicHolidayUpsert) SetUpdatedAt(v time.Time) *PublicHolidayUpsert {
+	u.Set(publicholiday.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublicHolidayUpsert) UpdateUpdatedAt() *PublicHolidayUpsert {
+	u.SetExcluded(publicholiday.FieldUpdatedAt)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *PublicHolidayUpsert) SetDate(v time.Time) *PublicHolidayUpsert {
+	u.Set(publicholiday.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *PublicHolidayUpsert) UpdateDate() *PublicHolidayUpsert {
+	u.SetExcluded(publicholiday.FieldDate)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PublicHolidayUpsert) SetName(v string) *PublicHolidayUpsert {
+	u.Set(publicholiday.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PublicHolidayUpsert) UpdateName() *PublicHolidayUpsert {
+	u.SetExcluded(publicholiday.FieldName)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PublicHoliday.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PublicHolidayUpsertOne) UpdateNewValues() *PublicHolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(publicholiday.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PublicHoliday.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PublicHolidayUpsertOne) Ignore() *PublicHolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublicHolidayUpsertOne) DoNothing() *PublicHolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublicHolidayCreate.OnConflict
+// documentation for more info.
+func (u *PublicHolidayUpsertOne) Update(set func(*PublicHolidayUpsert)) *PublicHolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublicHolidayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublicHolidayUpsertOne) SetUpdatedAt(v time.Time) *PublicHolidayUpsertOne {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublicHolidayUpsertOne) UpdateUpdatedAt() *PublicHolidayUpsertOne {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *PublicHolidayUpsertOne) SetDate(v time.Time) *PublicHolidayUpsertOne {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *PublicHolidayUpsertOne) UpdateDate() *PublicHolidayUpsertOne {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PublicHolidayUpsertOne) SetName(v string) *PublicHolidayUpsertOne {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PublicHolidayUpsertOne) UpdateName() *PublicHolidayUpsertOne {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *PublicHolidayUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublicHolidayCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublicHolidayUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PublicHolidayUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PublicHolidayUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PublicHolidayCreateBulk is the builder for creating many PublicHoliday entities in bulk.
+type PublicHolidayCreateBulk struct {
+	config
+	err      error
+	builders []*PublicHolidayCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PublicHoliday entities in the database.
+func (phcb *PublicHolidayCreateBulk) Save(ctx context.Context) ([]*PublicHoliday, error) {
+	if phcb.err != nil {
+		return nil, phcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(phcb.builders))
+	nodes := make([]*PublicHoliday, len(phcb.builders))
+	mutators := make([]Mutator, len(phcb.builders))
+	for i := range phcb.builders {
+		func(i int, root context.Context) {
+			builder := phcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PublicHolidayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, phcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = phcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, phcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, phcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phcb *PublicHolidayCreateBulk) SaveX(ctx context.Context) []*PublicHoliday {
+	v, err := phcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (phcb *PublicHolidayCreateBulk) Exec(ctx context.Context) error {
+	_, err := phcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phcb *PublicHolidayCreateBulk) ExecX(ctx context.Context) {
+	if err := phcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PublicHoliday.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PublicHolidayUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (phcb *PublicHolidayCreateBulk) OnConflict(opts ...sql.ConflictOption) *PublicHolidayUpsertBulk {
+	phcb.conflict = opts
+	return &PublicHolidayUpsertBulk{
+		create: phcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PublicHoliday.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (phcb *PublicHolidayCreateBulk) OnConflictColumns(columns ...string) *PublicHolidayUpsertBulk {
+	phcb.conflict = append(phcb.conflict, sql.ConflictColumns(columns...))
+	return &PublicHolidayUpsertBulk{
+		create: phcb,
+	}
+}
+
+// PublicHolidayUpsertBulk is the builder for "upsert"-ing
+// a bulk of PublicHoliday nodes.
+type PublicHolidayUpsertBulk struct {
+	create *PublicHolidayCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PublicHoliday.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PublicHolidayUpsertBulk) UpdateNewValues() *PublicHolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(publicholiday.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PublicHoliday.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PublicHolidayUpsertBulk) Ignore() *PublicHolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PublicHolidayUpsertBulk) DoNothing() *PublicHolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PublicHolidayCreateBulk.OnConflict
+// documentation for more info.
+func (u *PublicHolidayUpsertBulk) Update(set func(*PublicHolidayUpsert)) *PublicHolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PublicHolidayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PublicHolidayUpsertBulk) SetUpdatedAt(v time.Time) *PublicHolidayUpsertBulk {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PublicHolidayUpsertBulk) UpdateUpdatedAt() *PublicHolidayUpsertBulk {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *PublicHolidayUpsertBulk) SetDate(v time.Time) *PublicHolidayUpsertBulk {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *PublicHolidayUpsertBulk) UpdateDate() *PublicHolidayUpsertBulk {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PublicHolidayUpsertBulk) SetName(v string) *PublicHolidayUpsertBulk {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PublicHolidayUpsertBulk) UpdateName() *PublicHolidayUpsertBulk {
+	return u.Update(func(s *PublicHolidayUpsert) {
+		s.UpdateName()
+	})
+}
+
+// Exec executes the query.
+func (u *PublicHolidayUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PublicHolidayCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PublicHolidayCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PublicHolidayUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
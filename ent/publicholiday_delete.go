@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+)
+
+// PublicHolidayDelete is the builder for deleting a PublicHoliday entity.
+type PublicHolidayDelete struct {
+	config
+	hooks    []Hook
+	mutation *PublicHolidayMutation
+}
+
+// Where appends a list predicates to the PublicHolidayDelete builder.
+func (phd *PublicHolidayDelete) Where(ps ...predicate.PublicHoliday) *PublicHolidayDelete {
+	phd.mutation.Where(ps...)
+	return phd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (phd *PublicHolidayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, phd.sqlExec, phd.mutation, phd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phd *PublicHolidayDelete) ExecX(ctx context.Context) int {
+	n, err := phd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (phd *PublicHolidayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(publicholiday.Table, sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt))
+	if ps := phd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, phd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	phd.mutation.done = true
+	return affected, err
+}
+
+// PublicHolidayDeleteOne is the builder for deleting a single PublicHoliday entity.
+type PublicHolidayDeleteOne struct {
+	phd *PublicHolidayDelete
+}
+
+// Where appends a list predicates to the PublicHolidayDelete builder.
+func (phdo *PublicHolidayDeleteOne) Where(ps ...predicate.PublicHoliday) *PublicHolidayDeleteOne {
+	phdo.phd.mutation.Where(ps...)
+	return phdo
+}
+
+// Exec executes the deletion query.
+func (phdo *PublicHolidayDeleteOne) Exec(ctx context.Context) error {
+	n, err := phdo.phd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{publicholiday.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phdo *PublicHolidayDeleteOne) ExecX(ctx context.Context) {
+	if err := phdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
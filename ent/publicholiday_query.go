@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+)
+
+// PublicHolidayQuery is the builder for querying PublicHoliday entities.
+type PublicHolidayQuery struct {
+	config
+	ctx              *QueryContext
+	order            []publicholiday.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.PublicHoliday
+	withFiatCurrency *FiatCurrencyQuery
+	withFKs          bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PublicHolidayQuery builder.
+func (phq *PublicHolidayQuery) Where(ps ...predicate.PublicHoliday) *PublicHolidayQuery {
+	phq.predicates = append(phq.predicates, ps...)
+	return phq
+}
+
+// Limit the number of records to be returned by this query.
+func (phq *PublicHolidayQuery) Limit(limit int) *PublicHolidayQuery {
+	phq.ctx.Limit = &limit
+	return phq
+}
+
+// Offset to start from.
+func (phq *PublicHolidayQuery) Offset(offset int) *PublicHolidayQuery {
+	phq.ctx.Offset = &offset
+	return phq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (phq *PublicHolidayQuery) Unique(unique bool) *PublicHolidayQuery {
+	phq.ctx.Unique = &unique
+	return phq
+}
+
+// Order specifies how the records should be ordered.
+func (phq *PublicHolidayQuery) Order(o ...publicholiday.OrderOption) *PublicHolidayQuery {
+	phq.order = append(phq.order, o...)
+	return phq
+}
+
+// QueryFiatCurrency chains the current query on the "fiat_currency" edge.
+func (phq *PublicHolidayQuery) QueryFiatCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: phq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := phq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := phq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(publicholiday.Table, publicholiday.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, publicholiday.FiatCurrencyTable, publicholiday.FiatCurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(phq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PublicHoliday entity from the query.
+// Returns a *NotFoundError when no PublicHoliday was found.
+func (phq *PublicHolidayQuery) First(ctx context.Context) (*PublicHoliday, error) {
+	nodes, err := phq.Limit(1).All(setContextOp(ctx, phq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{publicholiday.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (phq *PublicHolidayQuery) FirstX(ctx context.Context) *PublicHoliday {
+	node, err := phq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PublicHoliday ID from the query.
+// Returns a *NotFoundError when no PublicHoliday ID was found.
+func (phq *PublicHolidayQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = phq.Limit(1).IDs(setContextOp(ctx, phq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{publicholiday.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (phq *PublicHolidayQuery) FirstIDX(ctx context.Context) int {
+	id, err := phq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PublicHoliday entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PublicHoliday entity is found.
+// Returns a *NotFoundError when no PublicHoliday entities are found.
+func (phq *PublicHolidayQuery) Only(ctx context.Context) (*PublicHoliday, error) {
+	nodes, err := phq.Limit(2).All(setContextOp(ctx, phq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{publicholiday.Label}
+	default:
+		return nil, &NotSingularError{publicholiday.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (phq *PublicHolidayQuery) OnlyX(ctx context.Context) *PublicHoliday {
+	node, err := phq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PublicHoliday ID in the query.
+// Returns a *NotSingularError when more than one PublicHoliday ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (phq *PublicHolidayQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = phq.Limit(2).IDs(setContextOp(ctx, phq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{publicholiday.Label}
+	default:
+		err = &NotSingularError{publicholiday.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (phq *PublicHolidayQuery) OnlyIDX(ctx context.Context) int {
+	id, err := phq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PublicHolidays.
+func (phq *PublicHolidayQuery) All(ctx context.Context) ([]*PublicHoliday, error) {
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryAll)
+	if err := phq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PublicHoliday, *PublicHolidayQuery]()
+	return withInterceptors[[]*PublicHoliday](ctx, phq, qr, phq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (phq *PublicHolidayQuery) AllX(ctx context.Context) []*PublicHoliday {
+	nodes, err := phq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PublicHoliday IDs.
+func (phq *PublicHolidayQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if phq.ctx.Unique == nil && phq.path != nil {
+		phq.Unique(true)
+	}
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryIDs)
+	if err = phq.Select(publicholiday.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (phq *PublicHolidayQuery) IDsX(ctx context.Context) []int {
+	ids, err := phq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (phq *PublicHolidayQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryCount)
+	if err := phq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, phq, querierCount[*PublicHolidayQuery](), phq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (phq *PublicHolidayQuery) CountX(ctx context.Context) int {
+	count, err := phq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (phq *PublicHolidayQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryExist)
+	switch _, err := phq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (phq *PublicHolidayQuery) ExistX(ctx context.Context) bool {
+	exist, err := phq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PublicHolidayQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (phq *PublicHolidayQuery) Clone() *PublicHolidayQuery {
+	if phq == nil {
+		return nil
+	}
+	return &PublicHolidayQuery{
+		config:           phq.config,
+		ctx:              phq.ctx.Clone(),
+		order:            append([]publicholiday.OrderOption{}, phq.order...),
+		inters:           append([]Interceptor{}, phq.inters...),
+		predicates:       append([]predicate.PublicHoliday{}, phq.predicates...),
+		withFiatCurrency: phq.withFiatCurrency.Clone(),
+		// clone intermediate query.
+		sql:  phq.sql.Clone(),
+		path: phq.path,
+	}
+}
+
+// WithFiatCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "fiat_currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (phq *PublicHolidayQuery) WithFiatCurrency(opts ...func(*FiatCurrencyQuery)) *PublicHolidayQuery {
+	query := (&FiatCurrencyClient{config: phq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	phq.withFiatCurrency = query
+	return phq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PublicHoliday.Query().
+//		GroupBy(publicholiday.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (phq *PublicHolidayQuery) GroupBy(field string, fields ...string) *PublicHolidayGroupBy {
+	phq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PublicHolidayGroupBy{build: phq}
+	grbuild.flds = &phq.ctx.Fields
+	grbuild.label = publicholiday.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PublicHoliday.Query().
+//		Select(publicholiday.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (phq *PublicHolidayQuery) Select(fields ...string) *PublicHolidaySelect {
+	phq.ctx.Fields = append(phq.ctx.Fields, fields...)
+	sbuild := &PublicHolidaySelect{PublicHolidayQuery: phq}
+	sbuild.label = publicholiday.Label
+	sbuild.flds, sbuild.scan = &phq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PublicHolidaySelect configured with the given aggregations.
+func (phq *PublicHolidayQuery) Aggregate(fns ...AggregateFunc) *PublicHolidaySelect {
+	return phq.Select().Aggregate(fns...)
+}
+
+func (phq *PublicHolidayQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range phq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, phq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range phq.ctx.Fields {
+		if !publicholiday.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if phq.path != nil {
+		prev, err := phq.path(ctx)
+		if err != nil {
+			return err
+		}
+		phq.sql = prev
+	}
+	return nil
+}
+
+func (phq *PublicHolidayQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PublicHoliday, error) {
+	var (
+		nodes       = []*PublicHoliday{}
+		withFKs     = phq.withFKs
+		_spec       = phq.querySpec()
+		loadedTypes = [1]bool{
+			phq.withFiatCurrency != nil,
+		}
+	)
+	if phq.withFiatCurrency != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, publicholiday.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PublicHoliday).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PublicHoliday{config: phq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, phq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := phq.withFiatCurrency; query != nil {
+		if err := phq.loadFiatCurrency(ctx, query, nodes, nil,
+			func(n *PublicHoliday, e *FiatCurrency) { n.Edges.FiatCurrency = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (phq *PublicHolidayQuery) loadFiatCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*PublicHoliday, init func(*PublicHoliday), assign func(*PublicHoliday, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PublicHoliday)
+	for i := range nodes {
+		if nodes[i].fiat_currency_public_holidays == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_public_holidays
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_public_holidays" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (phq *PublicHolidayQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := phq.querySpec()
+	_spec.Node.Columns = phq.ctx.Fields
+	if len(phq.ctx.Fields) > 0 {
+		_spec.Unique = phq.ctx.Unique != nil && *phq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, phq.driver, _spec)
+}
+
+func (phq *PublicHolidayQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(publicholiday.Table, publicholiday.Columns, sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt))
+	_spec.From = phq.sql
+	if unique := phq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if phq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := phq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, publicholiday.FieldID)
+		for i := range fields {
+			if fields[i] != publicholiday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := phq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := phq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := phq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := phq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (phq *PublicHolidayQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(phq.driver.Dialect())
+	t1 := builder.Table(publicholiday.Table)
+	columns := phq.ctx.Fields
+	if len(columns) == 0 {
+		columns = publicholiday.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if phq.sql != nil {
+		selector = phq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if phq.ctx.Unique != nil && *phq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range phq.predicates {
+		p(selector)
+	}
+	for _, p := range phq.order {
+		p(selector)
+	}
+	if offset := phq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := phq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PublicHolidayGroupBy is the group-by builder for PublicHoliday entities.
+type PublicHolidayGroupBy struct {
+	selector
+	build *PublicHolidayQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (phgb *PublicHolidayGroupBy) Aggregate(fns ...AggregateFunc) *PublicHolidayGroupBy {
+	phgb.fns = append(phgb.fns, fns...)
+	return phgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (phgb *PublicHolidayGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, phgb.build.ctx, ent.OpQueryGroupBy)
+	if err := phgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PublicHolidayQuery, *PublicHolidayGroupBy](ctx, phgb.build, phgb, phgb.build.inters, v)
+}
+
+func (phgb *PublicHolidayGroupBy) sqlScan(ctx context.Context, root *PublicHolidayQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(phgb.fns))
+	for _, fn := range phgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*phgb.flds)+len(phgb.fns))
+		for _, f := range *phgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*phgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := phgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PublicHolidaySelect is the builder for selecting fields of PublicHoliday entities.
+type PublicHolidaySelect struct {
+	*PublicHolidayQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (phs *PublicHolidaySelect) Aggregate(fns ...AggregateFunc) *PublicHolidaySelect {
+	phs.fns = append(phs.fns, fns...)
+	return phs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (phs *PublicHolidaySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, phs.ctx, ent.OpQuerySelect)
+	if err := phs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PublicHolidayQuery, *PublicHolidaySelect](ctx, phs.PublicHolidayQuery, phs, phs.inters, v)
+}
+
+func (phs *PublicHolidaySelect) sqlScan(ctx context.Context, root *PublicHolidayQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(phs.fns))
+	for _, fn := range phs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*phs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := phs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+)
+
+// PublicHolidayUpdate is the builder for updating PublicHoliday entities.
+type PublicHolidayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PublicHolidayMutation
+}
+
+// Where appends a list predicates to the PublicHolidayUpdate builder.
+func (phu *PublicHolidayUpdate) Where(ps ...predicate.PublicHoliday) *PublicHolidayUpdate {
+	phu.mutation.Where(ps...)
+	return phu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (phu *PublicHolidayUpdate) SetUpdatedAt(t time.Time) *PublicHolidayUpdate {
+	phu.mutation.SetUpdatedAt(t)
+	return phu
+}
+
+// SetDate sets the "date" field.
+func (phu *PublicHolidayUpdate) SetDate(t time.Time) *PublicHolidayUpdate {
+	phu.mutation.SetDate(t)
+	return phu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (phu *PublicHolidayUpdate) SetNillableDate(t *time.Time) *PublicHolidayUpdate {
+	if t != nil {
+		phu.SetDate(*t)
+	}
+	return phu
+}
+
+// SetName sets the "name" field.
+func (phu *PublicHolidayUpdate) SetName(s string) *PublicHolidayUpdate {
+	phu.mutation.SetName(s)
+	return phu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (phu *PublicHolidayUpdate) SetNillableName(s *string) *PublicHolidayUpdate {
+	if s != nil {
+		phu.SetName(*s)
+	}
+	return phu
+}
+
+// SetFiatCurrencyID sets the "fiat_currency" edge to the FiatCurrency entity by ID.
+func (phu *PublicHolidayUpdate) SetFiatCurrencyID(id uuid.UUID) *PublicHolidayUpdate {
+	phu.mutation.SetFiatCurrencyID(id)
+	return phu
+}
+
+// SetFiatCurrency sets the "fiat_currency" edge to the FiatCurrency entity.
+func (phu *PublicHolidayUpdate) SetFiatCurrency(f *FiatCurrency) *PublicHolidayUpdate {
+	return phu.SetFiatCurrencyID(f.ID)
+}
+
+// Mutation returns the PublicHolidayMutation object of the builder.
+func (phu *PublicHolidayUpdate) Mutation() *PublicHolidayMutation {
+	return phu.mutation
+}
+
+// ClearFiatCurrency clears the "fiat_currency" edge to the FiatCurrency entity.
+func (phu *PublicHolidayUpdate) ClearFiatCurrency() *PublicHolidayUpdate {
+	phu.mutation.ClearFiatCurrency()
+	return phu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (phu *PublicHolidayUpdate) Save(ctx context.Context) (int, error) {
+	phu.defaults()
+	return withHooks(ctx, phu.sqlSave, phu.mutation, phu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phu *PublicHolidayUpdate) SaveX(ctx context.Context) int {
+	affected, err := phu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (phu *PublicHolidayUpdate) Exec(ctx context.Context) error {
+	_, err := phu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phu *PublicHolidayUpdate) ExecX(ctx context.Context) {
+	if err := phu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phu *PublicHolidayUpdate) defaults() {
+	if _, ok := phu.mutation.UpdatedAt(); !ok {
+		v := publicholiday.UpdateDefaultUpdatedAt()
+		phu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phu *PublicHolidayUpdate) check() error {
+	if phu.mutation.FiatCurrencyCleared() && len(phu.mutation.FiatCurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PublicHoliday.fiat_currency"`)
+	}
+	return nil
+}
+
+func (phu *PublicHolidayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := phu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publicholiday.Table, publicholiday.Columns, sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt))
+	if ps := phu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := phu.mutation.UpdatedAt(); ok {
+		_spec.SetField(publicholiday.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := phu.mutation.Date(); ok {
+		_spec.SetField(publicholiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := phu.mutation.Name(); ok {
+		_spec.SetField(publicholiday.FieldName, field.TypeString, value)
+	}
+	if phu.mutation.FiatCurrencyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publicholiday.FiatCurrencyTable,
+			Columns: []string{publicholiday.FiatCurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := phu.mutation.FiatCurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publicholiday.FiatCurrencyTable,
+			Columns: []string{publicholiday.FiatCurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, phu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publicholiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	phu.mutation.done = true
+	return n, nil
+}
+
+// PublicHolidayUpdateOne is the builder for updating a single PublicHoliday entity.
+type PublicHolidayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PublicHolidayMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (phuo *PublicHolidayUpdateOne) SetUpdatedAt(t time.Time) *PublicHolidayUpdateOne {
+	phuo.mutation.SetUpdatedAt(t)
+	return phuo
+}
+
+// SetDate sets the "date" field.
+func (phuo *PublicHolidayUpdateOne) SetDate(t time.Time) *PublicHolidayUpdateOne {
+	phuo.mutation.SetDate(t)
+	return phuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (phuo *PublicHolidayUpdateOne) SetNillableDate(t *time.Time) *PublicHolidayUpdateOne {
+	if t != nil {
+		phuo.SetDate(*t)
+	}
+	return phuo
+}
+
+// SetName sets the "name" field.
+func (phuo *PublicHolidayUpdateOne) SetName(s string) *PublicHolidayUpdateOne {
+	phuo.mutation.SetName(s)
+	return phuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (phuo *PublicHolidayUpdateOne) SetNillableName(s *string) *PublicHolidayUpdateOne {
+	if s != nil {
+		phuo.SetName(*s)
+	}
+	return phuo
+}
+
+// SetFiatCurrencyID sets the "fiat_currency" edge to the FiatCurrency entity by ID.
+func (phuo *PublicHolidayUpdateOne) SetFiatCurrencyID(id uuid.UUID) *PublicHolidayUpdateOne {
+	phuo.mutation.SetFiatCurrencyID(id)
+	return phuo
+}
+
+// SetFiatCurrency sets the "fiat_currency" edge to the FiatCurrency entity.
+func (phuo *PublicHolidayUpdateOne) SetFiatCurrency(f *FiatCurrency) *PublicHolidayUpdateOne {
+	return phuo.SetFiatCurrencyID(f.ID)
+}
+
+// Mutation returns the PublicHolidayMutation object of the builder.
+func (phuo *PublicHolidayUpdateOne) Mutation() *PublicHolidayMutation {
+	return phuo.mutation
+}
+
+// ClearFiatCurrency clears the "fiat_currency" edge to the FiatCurrency entity.
+func (phuo *PublicHolidayUpdateOne) ClearFiatCurrency() *PublicHolidayUpdateOne {
+	phuo.mutation.ClearFiatCurrency()
+	return phuo
+}
+
+// Where appends a list predicates to the PublicHolidayUpdate builder.
+func (phuo *PublicHolidayUpdateOne) Where(ps ...predicate.PublicHoliday) *PublicHolidayUpdateOne {
+	phuo.mutation.Where(ps...)
+	return phuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (phuo *PublicHolidayUpdateOne) Select(field string, fields ...string) *PublicHolidayUpdateOne {
+	phuo.fields = append([]string{field}, fields...)
+	return phuo
+}
+
+// Save executes the query and returns the updated PublicHoliday entity.
+func (phuo *PublicHolidayUpdateOne) Save(ctx context.Context) (*PublicHoliday, error) {
+	phuo.defaults()
+	return withHooks(ctx, phuo.sqlSave, phuo.mutation, phuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phuo *PublicHolidayUpdateOne) SaveX(ctx context.Context) *PublicHoliday {
+	node, err := phuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (phuo *PublicHolidayUpdateOne) Exec(ctx context.Context) error {
+	_, err := phuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phuo *PublicHolidayUpdateOne) ExecX(ctx context.Context) {
+	if err := phuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phuo *PublicHolidayUpdateOne) defaults() {
+	if _, ok := phuo.mutation.UpdatedAt(); !ok {
+		v := publicholiday.UpdateDefaultUpdatedAt()
+		phuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (phuo *PublicHolidayUpdateOne) check() error {
+	if phuo.mutation.FiatCurrencyCleared() && len(phuo.mutation.FiatCurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PublicHoliday.fiat_currency"`)
+	}
+	return nil
+}
+
+func (phuo *PublicHolidayUpdateOne) sqlSave(ctx context.Context) (_node *PublicHoliday, err error) {
+	if err := phuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(publicholiday.Table, publicholiday.Columns, sqlgraph.NewFieldSpec(publicholiday.FieldID, field.TypeInt))
+	id, ok := phuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PublicHoliday.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := phuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, publicholiday.FieldID)
+		for _, f := range fields {
+			if !publicholiday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != publicholiday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := phuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := phuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(publicholiday.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := phuo.mutation.Date(); ok {
+		_spec.SetField(publicholiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := phuo.mutation.Name(); ok {
+		_spec.SetField(publicholiday.FieldName, field.TypeString, value)
+	}
+	if phuo.mutation.FiatCurrencyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publicholiday.FiatCurrencyTable,
+			Columns: []string{publicholiday.FiatCurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := phuo.mutation.FiatCurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   publicholiday.FiatCurrencyTable,
+			Columns: []string{publicholiday.FiatCurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PublicHoliday{config: phuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, phuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{publicholiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	phuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/shopspring/decimal"
+)
+
+// RateCircuitBreaker is the model entity for the RateCircuitBreaker schema.
+type RateCircuitBreaker struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// MaxChangePercent holds the value of the "max_change_percent" field.
+	MaxChangePercent decimal.Decimal `json:"max_change_percent,omitempty"`
+	// ChangeWindow holds the value of the "change_window" field.
+	ChangeWindow int `json:"change_window,omitempty"`
+	// MaxDivergencePercent holds the value of the "max_divergence_percent" field.
+	MaxDivergencePercent decimal.Decimal `json:"max_divergence_percent,omitempty"`
+	// AutoResetAfter holds the value of the "auto_reset_after" field.
+	AutoResetAfter int `json:"auto_reset_after,omitempty"`
+	// Tripped holds the value of the "tripped" field.
+	Tripped bool `json:"tripped,omitempty"`
+	// TripReason holds the value of the "trip_reason" field.
+	TripReason string `json:"trip_reason,omitempty"`
+	// TrippedAt holds the value of the "tripped_at" field.
+	TrippedAt time.Time `json:"tripped_at,omitempty"`
+	// FrozenRate holds the value of the "frozen_rate" field.
+	FrozenRate *decimal.Decimal `json:"frozen_rate,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RateCircuitBreakerQuery when eager-loading is set.
+	Edges                              RateCircuitBreakerEdges `json:"edges"`
+	fiat_currency_rate_circuit_breaker *uuid.UUID
+	selectValues                       sql.SelectValues
+}
+
+// RateCircuitBreakerEdges holds the relations/edges for other nodes in the graph.
+type RateCircuitBreakerEdges struct {
+	// Currency holds the value of the currency edge.
+	Currency *FiatCurrency `json:"currency,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CurrencyOrErr returns the Currency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RateCircuitBreakerEdges) CurrencyOrErr() (*FiatCurrency, error) {
+	if e.Currency != nil {
+		return e.Currency, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "currency"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateCircuitBreaker) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratecircuitbreaker.FieldFrozenRate:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case ratecircuitbreaker.FieldMaxChangePercent, ratecircuitbreaker.FieldMaxDivergencePercent:
+			values[i] = new(decimal.Decimal)
+		case ratecircuitbreaker.FieldTripped:
+			values[i] = new(sql.NullBool)
+		case ratecircuitbreaker.FieldChangeWindow, ratecircuitbreaker.FieldAutoResetAfter:
+			values[i] = new(sql.NullInt64)
+		case ratecircuitbreaker.FieldTripReason:
+			values[i] = new(sql.NullString)
+		case ratecircuitbreaker.FieldCreatedAt, ratecircuitbreaker.FieldUpdatedAt, ratecircuitbreaker.FieldTrippedAt:
+			values[i] = new(sql.NullTime)
+		case ratecircuitbreaker.FieldID:
+			values[i] = new(uuid.UUID)
+		case ratecircuitbreaker.ForeignKeys[0]: // fiat_currency_rate_circuit_breaker
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateCircuitBreaker fields.
+func (rcb *RateCircuitBreaker) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratecircuitbreaker.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rcb.ID = *value
+			}
+		case ratecircuitbreaker.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rcb.CreatedAt = value.Time
+			}
+		case ratecircuitbreaker.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rcb.UpdatedAt = value.Time
+			}
+		case ratecircuitbreaker.FieldMaxChangePercent:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field max_change_percent", values[i])
+			} else if value != nil {
+				rcb.MaxChangePercent = *value
+			}
+		case ratecircuitbreaker.FieldChangeWindow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field change_window", values[i])
+			} else if value.Valid {
+				rcb.ChangeWindow = int(value.Int64)
+			}
+		case ratecircuitbreaker.FieldMaxDivergencePercent:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field max_divergence_percent", values[i])
+			} else if value != nil {
+				rcb.MaxDivergencePercent = *value
+			}
+		case ratecircuitbreaker.FieldAutoResetAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_reset_after", values[i])
+			} else if value.Valid {
+				rcb.AutoResetAfter = int(value.Int64)
+			}
+		case ratecircuitbreaker.FieldTripped:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tripped", values[i])
+			} else if value.Valid {
+				rcb.Tripped = value.Bool
+			}
+		case ratecircuitbreaker.FieldTripReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trip_reason", values[i])
+			} else if value.Valid {
+				rcb.TripReason = value.String
+			}
+		case ratecircuitbreaker.FieldTrippedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field tripped_at", values[i])
+			} else if value.Valid {
+				rcb.TrippedAt = value.Time
+			}
+		case ratecircuitbreaker.FieldFrozenRate:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field frozen_rate", values[i])
+			} else if value.Valid {
+				rcb.FrozenRate = new(decimal.Decimal)
+				*rcb.FrozenRate = *value.S.(*decimal.Decimal)
+			}
+		case ratecircuitbreaker.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_rate_circuit_breaker", values[i])
+			} else if value.Valid {
+				rcb.fiat_currency_rate_circuit_breaker = new(uuid.UUID)
+				*rcb.fiat_currency_rate_circuit_breaker = *value.S.(*uuid.UUID)
+			}
+		default:
+			rcb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RateCircuitBreaker.
+// This includes values selected through modifiers, order, etc.
+func (rcb *RateCircuitBreaker) Value(name string) (ent.Value, error) {
+	return rcb.selectValues.Get(name)
+}
+
+// QueryCurrency queries the "currency" edge of the RateCircuitBreaker entity.
+func (rcb *RateCircuitBreaker) QueryCurrency() *FiatCurrencyQuery {
+	return NewRateCircuitBreakerClient(rcb.config).QueryCurrency(rcb)
+}
+
+// Update returns a builder for updating this RateCircuitBreaker.
+// Note that you need to call RateCircuitBreaker.Unwrap() before calling this method if this RateCircuitBreaker
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rcb *RateCircuitBreaker) Update() *RateCircuitBreakerUpdateOne {
+	return NewRateCircuitBreakerClient(rcb.config).UpdateOne(rcb)
+}
+
+// Unwrap unwraps the RateCircuitBreaker entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rcb *RateCircuitBreaker) Unwrap() *RateCircuitBreaker {
+	_tx, ok := rcb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateCircuitBreaker is not a transactional entity")
+	}
+	rcb.config.driver = _tx.drv
+	return rcb
+}
+
+// String implements the fmt.Stringer.
+func (rcb *RateCircuitBreaker) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateCircuitBreaker(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rcb.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rcb.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rcb.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("max_change_percent=")
+	builder.WriteString(fmt.Sprintf("%v", rcb.MaxChangePercent))
+	builder.WriteString(", ")
+	builder.WriteString("change_window=")
+	builder.WriteString(fmt.Sprintf("%v", rcb.ChangeWindow))
+	builder.WriteString(", ")
+	builder.WriteString("max_divergence_percent=")
+	builder.WriteString(fmt.Sprintf("%v", rcb.MaxDivergencePercent))
+	builder.WriteString(", ")
+	builder.WriteString("auto_reset_after=")
+	builder.WriteString(fmt.Sprintf("%v", rcb.AutoResetAfter))
+	builder.WriteString(", ")
+	builder.WriteString("tripped=")
+	builder.WriteString(fmt.Sprintf("%v", rcb.Tripped))
+	builder.WriteString(", ")
+	builder.WriteString("trip_reason=")
+	builder.WriteString(rcb.TripReason)
+	builder.WriteString(", ")
+	builder.WriteString("tripped_at=")
+	builder.WriteString(rcb.TrippedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rcb.FrozenRate; v != nil {
+		builder.WriteString("frozen_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateCircuitBreakers is a parsable slice of RateCircuitBreaker.
+type RateCircuitBreakers []*RateCircuitBreaker
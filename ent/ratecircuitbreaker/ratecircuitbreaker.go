@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratecircuitbreaker
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ratecircuitbreaker type in the database.
+	Label = "rate_circuit_breaker"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldMaxChangePercent holds the string denoting the max_change_percent field in the database.
+	FieldMaxChangePercent = "max_change_percent"
+	// FieldChangeWindow holds the string denoting the change_window field in the database.
+	FieldChangeWindow = "change_window"
+	// FieldMaxDivergencePercent holds the string denoting the max_divergence_percent field in the database.
+	FieldMaxDivergencePercent = "max_divergence_percent"
+	// FieldAutoResetAfter holds the string denoting the auto_reset_after field in the database.
+	FieldAutoResetAfter = "auto_reset_after"
+	// FieldTripped holds the string denoting the tripped field in the database.
+	FieldTripped = "tripped"
+	// FieldTripReason holds the string denoting the trip_reason field in the database.
+	FieldTripReason = "trip_reason"
+	// FieldTrippedAt holds the string denoting the tripped_at field in the database.
+	FieldTrippedAt = "tripped_at"
+	// FieldFrozenRate holds the string denoting the frozen_rate field in the database.
+	FieldFrozenRate = "frozen_rate"
+	// EdgeCurrency holds the string denoting the currency edge name in mutations.
+	EdgeCurrency = "currency"
+	// Table holds the table name of the ratecircuitbreaker in the database.
+	Table = "rate_circuit_breakers"
+	// CurrencyTable is the table that holds the currency relation/edge.
+	CurrencyTable = "rate_circuit_breakers"
+	// CurrencyInverseTable is the table name for the FiatCurrency entity.
+	// It exists in this package in order to avoid circular dependency with the "fiatcurrency" package.
+	CurrencyInverseTable = "fiat_currencies"
+	// CurrencyColumn is the table column denoting the currency relation/edge.
+	CurrencyColumn = "fiat_currency_rate_circuit_breaker"
+)
+
+// Columns holds all SQL columns for ratecircuitbreaker fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldMaxChangePercent,
+	FieldChangeWindow,
+	FieldMaxDivergencePercent,
+	FieldAutoResetAfter,
+	FieldTripped,
+	FieldTripReason,
+	FieldTrippedAt,
+	FieldFrozenRate,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "rate_circuit_breakers"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"fiat_currency_rate_circuit_breaker",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultChangeWindow holds the default value on creation for the "change_window" field.
+	DefaultChangeWindow int
+	// DefaultAutoResetAfter holds the default value on creation for the "auto_reset_after" field.
+	DefaultAutoResetAfter int
+	// DefaultTripped holds the default value on creation for the "tripped" field.
+	DefaultTripped bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the RateCircuitBreaker queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByMaxChangePercent orders the results by the max_change_percent field.
+func ByMaxChangePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxChangePercent, opts...).ToFunc()
+}
+
+// ByChangeWindow orders the results by the change_window field.
+func ByChangeWindow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangeWindow, opts...).ToFunc()
+}
+
+// ByMaxDivergencePercent orders the results by the max_divergence_percent field.
+func ByMaxDivergencePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDivergencePercent, opts...).ToFunc()
+}
+
+// ByAutoResetAfter orders the results by the auto_reset_after field.
+func ByAutoResetAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoResetAfter, opts...).ToFunc()
+}
+
+// ByTripped orders the results by the tripped field.
+func ByTripped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTripped, opts...).ToFunc()
+}
+
+// ByTripReason orders the results by the trip_reason field.
+func ByTripReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTripReason, opts...).ToFunc()
+}
+
+// ByTrippedAt orders the results by the tripped_at field.
+func ByTrippedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrippedAt, opts...).ToFunc()
+}
+
+// ByFrozenRate orders the results by the frozen_rate field.
+func ByFrozenRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrozenRate, opts...).ToFunc()
+}
+
+// ByCurrencyField orders the results by currency field.
+func ByCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrencyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCurrencyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrencyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, CurrencyTable, CurrencyColumn),
+	)
+}
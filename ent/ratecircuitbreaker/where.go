@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratecircuitbreaker
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// MaxChangePercent applies equality check predicate on the "max_change_percent" field. It's identical to MaxChangePercentEQ.
+func MaxChangePercent(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldMaxChangePercent, v))
+}
+
+// ChangeWindow applies equality check predicate on the "change_window" field. It's identical to ChangeWindowEQ.
+func ChangeWindow(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldChangeWindow, v))
+}
+
+// MaxDivergencePercent applies equality check predicate on the "max_divergence_percent" field. It's identical to MaxDivergencePercentEQ.
+func MaxDivergencePercent(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldMaxDivergencePercent, v))
+}
+
+// AutoResetAfter applies equality check predicate on the "auto_reset_after" field. It's identical to AutoResetAfterEQ.
+func AutoResetAfter(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldAutoResetAfter, v))
+}
+
+// Tripped applies equality check predicate on the "tripped" field. It's identical to TrippedEQ.
+func Tripped(v bool) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldTripped, v))
+}
+
+// TripReason applies equality check predicate on the "trip_reason" field. It's identical to TripReasonEQ.
+func TripReason(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldTripReason, v))
+}
+
+// TrippedAt applies equality check predicate on the "tripped_at" field. It's identical to TrippedAtEQ.
+func TrippedAt(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldTrippedAt, v))
+}
+
+// FrozenRate applies equality check predicate on the "frozen_rate" field. It's identical to FrozenRateEQ.
+func FrozenRate(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldFrozenRate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// MaxChangePercentEQ applies the EQ predicate on the "max_change_percent" field.
+func MaxChangePercentEQ(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldMaxChangePercent, v))
+}
+
+// MaxChangePercentNEQ applies the NEQ predicate on the "max_change_percent" field.
+func MaxChangePercentNEQ(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldMaxChangePercent, v))
+}
+
+// MaxChangePercentIn applies the In predicate on the "max_change_percent" field.
+func MaxChangePercentIn(vs ...decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldMaxChangePercent, vs...))
+}
+
+// MaxChangePercentNotIn applies the NotIn predicate on the "max_change_percent" field.
+func MaxChangePercentNotIn(vs ...decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldMaxChangePercent, vs...))
+}
+
+// MaxChangePercentGT applies the GT predicate on the "max_change_percent" field.
+func MaxChangePercentGT(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldMaxChangePercent, v))
+}
+
+// MaxChangePercentGTE applies the GTE predicate on the "max_change_percent" field.
+func MaxChangePercentGTE(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldMaxChangePercent, v))
+}
+
+// MaxChangePercentLT applies the LT predicate on the "max_change_percent" field.
+func MaxChangePercentLT(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldMaxChangePercent, v))
+}
+
+// MaxChangePercentLTE applies the LTE predicate on the "max_change_percent" field.
+func MaxChangePercentLTE(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldMaxChangePercent, v))
+}
+
+// ChangeWindowEQ applies the EQ predicate on the "change_window" field.
+func ChangeWindowEQ(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldChangeWindow, v))
+}
+
+// ChangeWindowNEQ applies the NEQ predicate on the "change_window" field.
+func ChangeWindowNEQ(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldChangeWindow, v))
+}
+
+// ChangeWindowIn applies the In predicate on the "change_window" field.
+func ChangeWindowIn(vs ...int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldChangeWindow, vs...))
+}
+
+// ChangeWindowNotIn applies the NotIn predicate on the "change_window" field.
+func ChangeWindowNotIn(vs ...int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldChangeWindow, vs...))
+}
+
+// ChangeWindowGT applies the GT predicate on the "change_window" field.
+func ChangeWindowGT(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldChangeWindow, v))
+}
+
+// ChangeWindowGTE applies the GTE predicate on the "change_window" field.
+func ChangeWindowGTE(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldChangeWindow, v))
+}
+
+// ChangeWindowLT applies the LT predicate on the "change_window" field.
+func ChangeWindowLT(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldChangeWindow, v))
+}
+
+// ChangeWindowLTE applies the LTE predicate on the "change_window" field.
+func ChangeWindowLTE(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldChangeWindow, v))
+}
+
+// MaxDivergencePercentEQ applies the EQ predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentEQ(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldMaxDivergencePercent, v))
+}
+
+// MaxDivergencePercentNEQ applies the NEQ predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentNEQ(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldMaxDivergencePercent, v))
+}
+
+// MaxDivergencePercentIn applies the In predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentIn(vs ...decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldMaxDivergencePercent, vs...))
+}
+
+// MaxDivergencePercentNotIn applies the NotIn predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentNotIn(vs ...decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldMaxDivergencePercent, vs...))
+}
+
+// MaxDivergencePercentGT applies the GT predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentGT(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldMaxDivergencePercent, v))
+}
+
+// MaxDivergencePercentGTE applies the GTE predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentGTE(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldMaxDivergencePercent, v))
+}
+
+// MaxDivergencePercentLT applies the LT predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentLT(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldMaxDivergencePercent, v))
+}
+
+// MaxDivergencePercentLTE applies the LTE predicate on the "max_divergence_percent" field.
+func MaxDivergencePercentLTE(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldMaxDivergencePercent, v))
+}
+
+// AutoResetAfterEQ applies the EQ predicate on the "auto_reset_after" field.
+func AutoResetAfterEQ(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldAutoResetAfter, v))
+}
+
+// AutoResetAfterNEQ applies the NEQ predicate on the "auto_reset_after" field.
+func AutoResetAfterNEQ(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldAutoResetAfter, v))
+}
+
+// AutoResetAfterIn applies the In predicate on the "auto_reset_after" field.
+func AutoResetAfterIn(vs ...int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldAutoResetAfter, vs...))
+}
+
+// AutoResetAfterNotIn applies the NotIn predicate on the "auto_reset_after" field.
+func AutoResetAfterNotIn(vs ...int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldAutoResetAfter, vs...))
+}
+
+// AutoResetAfterGT applies the GT predicate on the "auto_reset_after" field.
+func AutoResetAfterGT(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldAutoResetAfter, v))
+}
+
+// AutoResetAfterGTE applies the GTE predicate on the "auto_reset_after" field.
+func AutoResetAfterGTE(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldAutoResetAfter, v))
+}
+
+// AutoResetAfterLT applies the LT predicate on the "auto_reset_after" field.
+func AutoResetAfterLT(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldAutoResetAfter, v))
+}
+
+// AutoResetAfterLTE applies the LTE predicate on the "auto_reset_after" field.
+func AutoResetAfterLTE(v int) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldAutoResetAfter, v))
+}
+
+// TrippedEQ applies the EQ predicate on the "tripped" field.
+func TrippedEQ(v bool) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldTripped, v))
+}
+
+// TrippedNEQ applies the NEQ predicate on the "tripped" field.
+func TrippedNEQ(v bool) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldTripped, v))
+}
+
+// TripReasonEQ applies the EQ predicate on the "trip_reason" field.
+func TripReasonEQ(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldTripReason, v))
+}
+
+// TripReasonNEQ applies the NEQ predicate on the "trip_reason" field.
+func TripReasonNEQ(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldTripReason, v))
+}
+
+// TripReasonIn applies the In predicate on the "trip_reason" field.
+func TripReasonIn(vs ...string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldTripReason, vs...))
+}
+
+// TripReasonNotIn applies the NotIn predicate on the "trip_reason" field.
+func TripReasonNotIn(vs ...string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldTripReason, vs...))
+}
+
+// TripReasonGT applies the GT predicate on the "trip_reason" field.
+func TripReasonGT(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldTripReason, v))
+}
+
+// TripReasonGTE applies the GTE predicate on the "trip_reason" field.
+func TripReasonGTE(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldTripReason, v))
+}
+
+// TripReasonLT applies the LT predicate on the "trip_reason" field.
+func TripReasonLT(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldTripReason, v))
+}
+
+// TripReasonLTE applies the LTE predicate on the "trip_reason" field.
+func TripReasonLTE(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldTripReason, v))
+}
+
+// TripReasonContains applies the Contains predicate on the "trip_reason" field.
+func TripReasonContains(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldContains(FieldTripReason, v))
+}
+
+// TripReasonHasPrefix applies the HasPrefix predicate on the "trip_reason" field.
+func TripReasonHasPrefix(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldHasPrefix(FieldTripReason, v))
+}
+
+// TripReasonHasSuffix applies the HasSuffix predicate on the "trip_reason" field.
+func TripReasonHasSuffix(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldHasSuffix(FieldTripReason, v))
+}
+
+// TripReasonIsNil applies the IsNil predicate on the "trip_reason" field.
+func TripReasonIsNil() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIsNull(FieldTripReason))
+}
+
+// TripReasonNotNil applies the NotNil predicate on the "trip_reason" field.
+func TripReasonNotNil() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotNull(FieldTripReason))
+}
+
+// TripReasonEqualFold applies the EqualFold predicate on the "trip_reason" field.
+func TripReasonEqualFold(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEqualFold(FieldTripReason, v))
+}
+
+// TripReasonContainsFold applies the ContainsFold predicate on the "trip_reason" field.
+func TripReasonContainsFold(v string) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldContainsFold(FieldTripReason, v))
+}
+
+// TrippedAtEQ applies the EQ predicate on the "tripped_at" field.
+func TrippedAtEQ(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldTrippedAt, v))
+}
+
+// TrippedAtNEQ applies the NEQ predicate on the "tripped_at" field.
+func TrippedAtNEQ(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldTrippedAt, v))
+}
+
+// TrippedAtIn applies the In predicate on the "tripped_at" field.
+func TrippedAtIn(vs ...time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldTrippedAt, vs...))
+}
+
+// TrippedAtNotIn applies the NotIn predicate on the "tripped_at" field.
+func TrippedAtNotIn(vs ...time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldTrippedAt, vs...))
+}
+
+// TrippedAtGT applies the GT predicate on the "tripped_at" field.
+func TrippedAtGT(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldTrippedAt, v))
+}
+
+// TrippedAtGTE applies the GTE predicate on the "tripped_at" field.
+func TrippedAtGTE(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldTrippedAt, v))
+}
+
+// TrippedAtLT applies the LT predicate on the "tripped_at" field.
+func TrippedAtLT(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldTrippedAt, v))
+}
+
+// TrippedAtLTE applies the LTE predicate on the "tripped_at" field.
+func TrippedAtLTE(v time.Time) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldTrippedAt, v))
+}
+
+// TrippedAtIsNil applies the IsNil predicate on the "tripped_at" field.
+func TrippedAtIsNil() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIsNull(FieldTrippedAt))
+}
+
+// TrippedAtNotNil applies the NotNil predicate on the "tripped_at" field.
+func TrippedAtNotNil() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotNull(FieldTrippedAt))
+}
+
+// FrozenRateEQ applies the EQ predicate on the "frozen_rate" field.
+func FrozenRateEQ(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldEQ(FieldFrozenRate, v))
+}
+
+// FrozenRateNEQ applies the NEQ predicate on the "frozen_rate" field.
+func FrozenRateNEQ(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNEQ(FieldFrozenRate, v))
+}
+
+// FrozenRateIn applies the In predicate on the "frozen_rate" field.
+func FrozenRateIn(vs ...decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIn(FieldFrozenRate, vs...))
+}
+
+// FrozenRateNotIn applies the NotIn predicate on the "frozen_rate" field.
+func FrozenRateNotIn(vs ...decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotIn(FieldFrozenRate, vs...))
+}
+
+// FrozenRateGT applies the GT predicate on the "frozen_rate" field.
+func FrozenRateGT(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGT(FieldFrozenRate, v))
+}
+
+// FrozenRateGTE applies the GTE predicate on the "frozen_rate" field.
+func FrozenRateGTE(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldGTE(FieldFrozenRate, v))
+}
+
+// FrozenRateLT applies the LT predicate on the "frozen_rate" field.
+func FrozenRateLT(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLT(FieldFrozenRate, v))
+}
+
+// FrozenRateLTE applies the LTE predicate on the "frozen_rate" field.
+func FrozenRateLTE(v decimal.Decimal) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldLTE(FieldFrozenRate, v))
+}
+
+// FrozenRateIsNil applies the IsNil predicate on the "frozen_rate" field.
+func FrozenRateIsNil() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldIsNull(FieldFrozenRate))
+}
+
+// FrozenRateNotNil applies the NotNil predicate on the "frozen_rate" field.
+func FrozenRateNotNil() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.FieldNotNull(FieldFrozenRate))
+}
+
+// HasCurrency applies the HasEdge predicate on the "currency" edge.
+func HasCurrency() predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, CurrencyTable, CurrencyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
+func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(func(s *sql.Selector) {
+		step := newCurrencyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RateCircuitBreaker) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RateCircuitBreaker) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RateCircuitBreaker) predicate.RateCircuitBreaker {
+	return predicate.RateCircuitBreaker(sql.NotPredicates(p))
+}
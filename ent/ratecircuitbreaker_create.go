@@ -0,0 +1,1220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/shopspring/decimal"
+)
+
+// RateCircuitBreakerCreate is the builder for creating a RateCircuitBreaker entity.
+type RateCircuitBreakerCreate struct {
+	config
+	mutation *RateCircuitBreakerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcbc *RateCircuitBreakerCreate) SetCreatedAt(t time.Time) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetCreatedAt(t)
+	return rcbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableCreatedAt(t *time.Time) *RateCircuitBreakerCreate {
+	if t != nil {
+		rcbc.SetCreatedAt(*t)
+	}
+	return rcbc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcbc *RateCircuitBreakerCreate) SetUpdatedAt(t time.Time) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetUpdatedAt(t)
+	return rcbc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableUpdatedAt(t *time.Time) *RateCircuitBreakerCreate {
+	if t != nil {
+		rcbc.SetUpdatedAt(*t)
+	}
+	return rcbc
+}
+
+// SetMaxChangePercent sets the "max_change_percent" field.
+func (rcbc *RateCircuitBreakerCreate) SetMaxChangePercent(d decimal.Decimal) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetMaxChangePercent(d)
+	return rcbc
+}
+
+// SetChangeWindow sets the "change_window" field.
+func (rcbc *RateCircuitBreakerCreate) SetChangeWindow(i int) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetChangeWindow(i)
+	return rcbc
+}
+
+// SetNillableChangeWindow sets the "change_window" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableChangeWindow(i *int) *RateCircuitBreakerCreate {
+	if i != nil {
+		rcbc.SetChangeWindow(*i)
+	}
+	return rcbc
+}
+
+// SetMaxDivergencePercent sets the "max_divergence_percent" field.
+func (rcbc *RateCircuitBreakerCreate) SetMaxDivergencePercent(d decimal.Decimal) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetMaxDivergencePercent(d)
+	return rcbc
+}
+
+// SetAutoResetAfter sets the "auto_reset_after" field.
+func (rcbc *RateCircuitBreakerCreate) SetAutoResetAfter(i int) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetAutoResetAfter(i)
+	return rcbc
+}
+
+// SetNillableAutoResetAfter sets the "auto_reset_after" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableAutoResetAfter(i *int) *RateCircuitBreakerCreate {
+	if i != nil {
+		rcbc.SetAutoResetAfter(*i)
+	}
+	return rcbc
+}
+
+// SetTripped sets the "tripped" field.
+func (rcbc *RateCircuitBreakerCreate) SetTripped(b bool) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetTripped(b)
+	return rcbc
+}
+
+// SetNillableTripped sets the "tripped" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableTripped(b *bool) *RateCircuitBreakerCreate {
+	if b != nil {
+		rcbc.SetTripped(*b)
+	}
+	return rcbc
+}
+
+// SetTripReason sets the "trip_reason" field.
+func (rcbc *RateCircuitBreakerCreate) SetTripReason(s string) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetTripReason(s)
+	return rcbc
+}
+
+// SetNillableTripReason sets the "trip_reason" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableTripReason(s *string) *RateCircuitBreakerCreate {
+	if s != nil {
+		rcbc.SetTripReason(*s)
+	}
+	return rcbc
+}
+
+// SetTrippedAt sets the "tripped_at" field.
+func (rcbc *RateCircuitBreakerCreate) SetTrippedAt(t time.Time) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetTrippedAt(t)
+	return rcbc
+}
+
+// SetNillableTrippedAt sets the "tripped_at" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableTrippedAt(t *time.Time) *RateCircuitBreakerCreate {
+	if t != nil {
+		rcbc.SetTrippedAt(*t)
+	}
+	return rcbc
+}
+
+// SetFrozenRate sets the "frozen_rate" field.
+func (rcbc *RateCircuitBreakerCreate) SetFrozenRate(d decimal.Decimal) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetFrozenRate(d)
+	return rcbc
+}
+
+// SetNillableFrozenRate sets the "frozen_rate" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableFrozenRate(d *decimal.Decimal) *RateCircuitBreakerCreate {
+	if d != nil {
+		rcbc.SetFrozenRate(*d)
+	}
+	return rcbc
+}
+
+// SetID sets the "id" field.
+func (rcbc *RateCircuitBreakerCreate) SetID(u uuid.UUID) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetID(u)
+	return rcbc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rcbc *RateCircuitBreakerCreate) SetNillableID(u *uuid.UUID) *RateCircuitBreakerCreate {
+	if u != nil {
+		rcbc.SetID(*u)
+	}
+	return rcbc
+}
+
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (rcbc *RateCircuitBreakerCreate) SetCurrencyID(id uuid.UUID) *RateCircuitBreakerCreate {
+	rcbc.mutation.SetCurrencyID(id)
+	return rcbc
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (rcbc *RateCircuitBreakerCreate) SetCurrency(f *FiatCurrency) *RateCircuitBreakerCreate {
+	return rcbc.SetCurrencyID(f.ID)
+}
+
+// Mutation returns the RateCircuitBreakerMutation object of the builder.
+func (rcbc *RateCircuitBreakerCreate) Mutation() *RateCircuitBreakerMutation {
+	return rcbc.mutation
+}
+
+// Save creates the RateCircuitBreaker in the database.
+func (rcbc *RateCircuitBreakerCreate) Save(ctx context.Context) (*RateCircuitBreaker, error) {
+	rcbc.defaults()
+	return withHooks(ctx, rcbc.sqlSave, rcbc.mutation, rcbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcbc *RateCircuitBreakerCreate) SaveX(ctx context.Context) *RateCircuitBreaker {
+	v, err := rcbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcbc *RateCircuitBreakerCreate) Exec(ctx context.Context) error {
+	_, err := rcbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcbc *RateCircuitBreakerCreate) ExecX(ctx context.Context) {
+	if err := rcbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcbc *RateCircuitBreakerCreate) defaults() {
+	if _, ok := rcbc.mutation.CreatedAt(); !ok {
+		v := ratecircuitbreaker.DefaultCreatedAt()
+		rcbc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rcbc.mutation.UpdatedAt(); !ok {
+		v := ratecircuitbreaker.DefaultUpdatedAt()
+		rcbc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rcbc.mutation.ChangeWindow(); !ok {
+		v := ratecircuitbreaker.DefaultChangeWindow
+		rcbc.mutation.SetChangeWindow(v)
+	}
+	if _, ok := rcbc.mutation.AutoResetAfter(); !ok {
+		v := ratecircuitbreaker.DefaultAutoResetAfter
+		rcbc.mutation.SetAutoResetAfter(v)
+	}
+	if _, ok := rcbc.mutation.Tripped(); !ok {
+		v := ratecircuitbreaker.DefaultTripped
+		rcbc.mutation.SetTripped(v)
+	}
+	if _, ok := rcbc.mutation.ID(); !ok {
+		v := ratecircuitbreaker.DefaultID()
+		rcbc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcbc *RateCircuitBreakerCreate) check() error {
+	if _, ok := rcbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RateCircuitBreaker.created_at"`)}
+	}
+	if _, ok := rcbc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RateCircuitBreaker.updated_at"`)}
+	}
+	if _, ok := rcbc.mutation.MaxChangePercent(); !ok {
+		return &ValidationError{Name: "max_change_percent", err: errors.New(`ent: missing required field "RateCircuitBreaker.max_change_percent"`)}
+	}
+	if _, ok := rcbc.mutation.ChangeWindow(); !ok {
+		return &ValidationError{Name: "change_window", err: errors.New(`ent: missing required field "RateCircuitBreaker.change_window"`)}
+	}
+	if _, ok := rcbc.mutation.MaxDivergencePercent(); !ok {
+		return &ValidationError{Name: "max_divergence_percent", err: errors.New(`ent: missing required field "RateCircuitBreaker.max_divergence_percent"`)}
+	}
+	if _, ok := rcbc.mutation.AutoResetAfter(); !ok {
+		return &ValidationError{Name: "auto_reset_after", err: errors.New(`ent: missing required field "RateCircuitBreaker.auto_reset_after"`)}
+	}
+	if _, ok := rcbc.mutation.Tripped(); !ok {
+		return &ValidationError{Name: "tripped", err: errors.New(`ent: missing required field "RateCircuitBreaker.tripped"`)}
+	}
+	if len(rcbc.mutation.CurrencyIDs()) == 0 {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "RateCircuitBreaker.currency"`)}
+	}
+	return nil
+}
+
+func (rcbc *RateCircuitBreakerCreate) sqlSave(ctx context.Context) (*RateCircuitBreaker, error) {
+	if err := rcbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rcbc.mutation.id = &_node.ID
+	rcbc.mutation.done = true
+	return _node, nil
+}
+
+func (rcbc *RateCircuitBreakerCreate) createSpec() (*RateCircuitBreaker, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateCircuitBreaker{config: rcbc.config}
+		_spec = sqlgraph.NewCreateSpec(ratecircuitbreaker.Table, sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rcbc.conflict
+	if id, ok := rcbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rcbc.mutation.CreatedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcbc.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rcbc.mutation.MaxChangePercent(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldMaxChangePercent, field.TypeFloat64, value)
+		_node.MaxChangePercent = value
+	}
+	if value, ok := rcbc.mutation.ChangeWindow(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldChangeWindow, field.TypeInt, value)
+		_node.ChangeWindow = value
+	}
+	if value, ok := rcbc.mutation.MaxDivergencePercent(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldMaxDivergencePercent, field.TypeFloat64, value)
+		_node.MaxDivergencePercent = value
+	}
+	if value, ok := rcbc.mutation.AutoResetAfter(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldAutoResetAfter, field.TypeInt, value)
+		_node.AutoResetAfter = value
+	}
+	if value, ok := rcbc.mutation.Tripped(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTripped, field.TypeBool, value)
+		_node.Tripped = value
+	}
+	if value, ok := rcbc.mutation.TripReason(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTripReason, field.TypeString, value)
+		_node.TripReason = value
+	}
+	if value, ok := rcbc.mutation.TrippedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTrippedAt, field.TypeTime, value)
+		_node.TrippedAt = value
+	}
+	if value, ok := rcbc.mutation.FrozenRate(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64, value)
+		_node.FrozenRate = &value
+	}
+	if nodes := rcbc.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ratecircuitbreaker.CurrencyTable,
+			Columns: []string{ratecircuitbreaker.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_rate_circuit_breaker = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RateCircuitBreaker.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RateCircuitBreakerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcbc *RateCircuitBreakerCreate) OnConflict(opts ...sql.ConflictOption) *RateCircuitBreakerUpsertOne {
+	rcbc.conflict = opts
+	return &RateCircuitBreakerUpsertOne{
+		create: rcbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RateCircuitBreaker.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcbc *RateCircuitBreakerCreate) OnConflictColumns(columns ...string) *RateCircuitBreakerUpsertOne {
+	rcbc.conflict = append(rcbc.conflict, sql.ConflictColumns(columns...))
+	return &RateCircuitBreakerUpsertOne{
+		create: rcbc,
+	}
+}
+
+type (
+	// RateCircuitBreakerUpsertOne is the builder for "upsert"-ing
+	//  one RateCircuitBreaker node.
+	RateCircuitBreakerUpsertOne struct {
+		create *RateCircuitBreakerCreate
+	}
+
+	// RateCircuitBreakerUpsert is the "OnConflict" setter.
+	RateCircuitBreakerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateCircuitBreakerUpsert) SetUpdatedAt(v time.Time) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateUpdatedAt() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldUpdatedAt)
+	return u
+}
+
+// SetMaxChangePercent sets the "max_change_percent" field.
+func (u *RateCircuitBreakerUpsert) SetMaxChangePercent(v decimal.Decimal) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldMaxChangePercent, v)
+	return u
+}
+
+// UpdateMaxChangePercent sets the "max_change_percent" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateMaxChangePercent() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldMaxChangePercent)
+	return u
+}
+
+// AddMaxChangePercent adds v to the "max_change_percent" field.
+func (u *RateCircuitBreakerUpsert) AddMaxChangePercent(v decimal.Decimal) *RateCircuitBreakerUpsert {
+	u.Add(ratecircuitbreaker.FieldMaxChangePercent, v)
+	return u
+}
+
+// SetChangeWindow sets the "change_window" field.
+func (u *RateCircuitBreakerUpsert) SetChangeWindow(v int) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldChangeWindow, v)
+	return u
+}
+
+// UpdateChangeWindow sets the "change_window" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateChangeWindow() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldChangeWindow)
+	return u
+}
+
+// AddChangeWindow adds v to the "change_window" field.
+func (u *RateCircuitBreakerUpsert) AddChangeWindow(v int) *RateCircuitBreakerUpsert {
+	u.Add(ratecircuitbreaker.FieldChangeWindow, v)
+	return u
+}
+
+// SetMaxDivergencePercent sets the "max_divergence_percent" field.
+func (u *RateCircuitBreakerUpsert) SetMaxDivergencePercent(v decimal.Decimal) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldMaxDivergencePercent, v)
+	return u
+}
+
+// UpdateMaxDivergencePercent sets the "max_divergence_percent" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateMaxDivergencePercent() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldMaxDivergencePercent)
+	return u
+}
+
+// AddMaxDivergencePercent adds v to the "max_divergence_percent" field.
+func (u *RateCircuitBreakerUpsert) AddMaxDivergencePercent(v decimal.Decimal) *RateCircuitBreakerUpsert {
+	u.Add(ratecircuitbreaker.FieldMaxDivergencePercent, v)
+	return u
+}
+
+// SetAutoResetAfter sets the "auto_reset_after" field.
+func (u *RateCircuitBreakerUpsert) SetAutoResetAfter(v int) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldAutoResetAfter, v)
+	return u
+}
+
+// UpdateAutoResetAfter sets the "auto_reset_after" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateAutoResetAfter() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldAutoResetAfter)
+	return u
+}
+
+// AddAutoResetAfter adds v to the "auto_reset_after" field.
+func (u *RateCircuitBreakerUpsert) AddAutoResetAfter(v int) *RateCircuitBreakerUpsert {
+	u.Add(ratecircuitbreaker.FieldAutoResetAfter, v)
+	return u
+}
+
+// SetTripped sets the "tripped" field.
+func (u *RateCircuitBreakerUpsert) SetTripped(v bool) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldTripped, v)
+	return u
+}
+
+// UpdateTripped sets the "tripped" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateTripped() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldTripped)
+	return u
+}
+
+// SetTripReason sets the "trip_reason" field.
+func (u *RateCircuitBreakerUpsert) SetTripReason(v string) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldTripReason, v)
+	return u
+}
+
+// UpdateTripReason sets the "trip_reason" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateTripReason() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldTripReason)
+	return u
+}
+
+// ClearTripReason clears the value of the "trip_reason" field.
+func (u *RateCircuitBreakerUpsert) ClearTripReason() *RateCircuitBreakerUpsert {
+	u.SetNull(ratecircuitbreaker.FieldTripReason)
+	return u
+}
+
+// SetTrippedAt sets the "tripped_at" field.
+func (u *RateCircuitBreakerUpsert) SetTrippedAt(v time.Time) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldTrippedAt, v)
+	return u
+}
+
+// UpdateTrippedAt sets the "tripped_at" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateTrippedAt() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldTrippedAt)
+	return u
+}
+
+// ClearTrippedAt clears the value of the "tripped_at" field.
+func (u *RateCircuitBreakerUpsert) ClearTrippedAt() *RateCircuitBreakerUpsert {
+	u.SetNull(ratecircuitbreaker.FieldTrippedAt)
+	return u
+}
+
+// SetFrozenRate sets the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsert) SetFrozenRate(v decimal.Decimal) *RateCircuitBreakerUpsert {
+	u.Set(ratecircuitbreaker.FieldFrozenRate, v)
+	return u
+}
+
+// UpdateFrozenRate sets the "frozen_rate" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsert) UpdateFrozenRate() *RateCircuitBreakerUpsert {
+	u.SetExcluded(ratecircuitbreaker.FieldFrozenRate)
+	return u
+}
+
+// AddFrozenRate adds v to the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsert) AddFrozenRate(v decimal.Decimal) *RateCircuitBreakerUpsert {
+	u.Add(ratecircuitbreaker.FieldFrozenRate, v)
+	return u
+}
+
+// ClearFrozenRate clears the value of the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsert) ClearFrozenRate() *RateCircuitBreakerUpsert {
+	u.SetNull(ratecircuitbreaker.FieldFrozenRate)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RateCircuitBreaker.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ratecircuitbreaker.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RateCircuitBreakerUpsertOne) UpdateNewValues() *RateCircuitBreakerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ratecircuitbreaker.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ratecircuitbreaker.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RateCircuitBreaker.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RateCircuitBreakerUpsertOne) Ignore() *RateCircuitBreakerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RateCircuitBreakerUpsertOne) DoNothing() *RateCircuitBreakerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RateCircuitBreakerCreate.OnConflict
+// documentation for more info.
+func (u *RateCircuitBreakerUpsertOne) Update(set func(*RateCircuitBreakerUpsert)) *RateCircuitBreakerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RateCircuitBreakerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateCircuitBreakerUpsertOne) SetUpdatedAt(v time.Time) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateUpdatedAt() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMaxChangePercent sets the "max_change_percent" field.
+func (u *RateCircuitBreakerUpsertOne) SetMaxChangePercent(v decimal.Decimal) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetMaxChangePercent(v)
+	})
+}
+
+// AddMaxChangePercent adds v to the "max_change_percent" field.
+func (u *RateCircuitBreakerUpsertOne) AddMaxChangePercent(v decimal.Decimal) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddMaxChangePercent(v)
+	})
+}
+
+// UpdateMaxChangePercent sets the "max_change_percent" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateMaxChangePercent() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateMaxChangePercent()
+	})
+}
+
+// SetChangeWindow sets the "change_window" field.
+func (u *RateCircuitBreakerUpsertOne) SetChangeWindow(v int) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetChangeWindow(v)
+	})
+}
+
+// AddChangeWindow adds v to the "change_window" field.
+func (u *RateCircuitBreakerUpsertOne) AddChangeWindow(v int) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddChangeWindow(v)
+	})
+}
+
+// UpdateChangeWindow sets the "change_window" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateChangeWindow() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateChangeWindow()
+	})
+}
+
+// SetMaxDivergencePercent sets the "max_divergence_percent" field.
+func (u *RateCircuitBreakerUpsertOne) SetMaxDivergencePercent(v decimal.Decimal) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetMaxDivergencePercent(v)
+	})
+}
+
+// AddMaxDivergencePercent adds v to the "max_divergence_percent" field.
+func (u *RateCircuitBreakerUpsertOne) AddMaxDivergencePercent(v decimal.Decimal) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddMaxDivergencePercent(v)
+	})
+}
+
+// UpdateMaxDivergencePercent sets the "max_divergence_percent" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateMaxDivergencePercent() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateMaxDivergencePercent()
+	})
+}
+
+// SetAutoResetAfter sets the "auto_reset_after" field.
+func (u *RateCircuitBreakerUpsertOne) SetAutoResetAfter(v int) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetAutoResetAfter(v)
+	})
+}
+
+// AddAutoResetAfter adds v to the "auto_reset_after" field.
+func (u *RateCircuitBreakerUpsertOne) AddAutoResetAfter(v int) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddAutoResetAfter(v)
+	})
+}
+
+// UpdateAutoResetAfter sets the "auto_reset_after" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateAutoResetAfter() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateAutoResetAfter()
+	})
+}
+
+// SetTripped sets the "tripped" field.
+func (u *RateCircuitBreakerUpsertOne) SetTripped(v bool) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetTripped(v)
+	})
+}
+
+// UpdateTripped sets the "tripped" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateTripped() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateTripped()
+	})
+}
+
+// SetTripReason sets the "trip_reason" field.
+func (u *RateCircuitBreakerUpsertOne) SetTripReason(v string) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetTripReason(v)
+	})
+}
+
+// UpdateTripReason sets the "trip_reason" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateTripReason() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateTripReason()
+	})
+}
+
+// ClearTripReason clears the value of the "trip_reason" field.
+func (u *RateCircuitBreakerUpsertOne) ClearTripReason() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.ClearTripReason()
+	})
+}
+
+// SetTrippedAt sets the "tripped_at" field.
+func (u *RateCircuitBreakerUpsertOne) SetTrippedAt(v time.Time) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetTrippedAt(v)
+	})
+}
+
+// UpdateTrippedAt sets the "tripped_at" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateTrippedAt() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateTrippedAt()
+	})
+}
+
+// ClearTrippedAt clears the value of the "tripped_at" field.
+func (u *RateCircuitBreakerUpsertOne) ClearTrippedAt() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.ClearTrippedAt()
+	})
+}
+
+// SetFrozenRate sets the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsertOne) SetFrozenRate(v decimal.Decimal) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetFrozenRate(v)
+	})
+}
+
+// AddFrozenRate adds v to the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsertOne) AddFrozenRate(v decimal.Decimal) *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddFrozenRate(v)
+	})
+}
+
+// UpdateFrozenRate sets the "frozen_rate" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertOne) UpdateFrozenRate() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateFrozenRate()
+	})
+}
+
+// ClearFrozenRate clears the value of the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsertOne) ClearFrozenRate() *RateCircuitBreakerUpsertOne {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.ClearFrozenRate()
+	})
+}
+
+// Exec executes the query.
+func (u *RateCircuitBreakerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RateCircuitBreakerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RateCircuitBreakerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RateCircuitBreakerUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RateCircuitBreakerUpsertOne.ID is not supported by MySQL driver. Use RateCircuitBreakerUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RateCircuitBreakerUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RateCircuitBreakerCreateBulk is the builder for creating many RateCircuitBreaker entities in bulk.
+type RateCircuitBreakerCreateBulk struct {
+	config
+	err      error
+	builders []*RateCircuitBreakerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RateCircuitBreaker entities in the database.
+func (rcbcb *RateCircuitBreakerCreateBulk) Save(ctx context.Context) ([]*RateCircuitBreaker, error) {
+	if rcbcb.err != nil {
+		return nil, rcbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcbcb.builders))
+	nodes := make([]*RateCircuitBreaker, len(rcbcb.builders))
+	mutators := make([]Mutator, len(rcbcb.builders))
+	for i := range rcbcb.builders {
+		func(i int, root context.Context) {
+			builder := rcbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateCircuitBreakerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcbcb *RateCircuitBreakerCreateBulk) SaveX(ctx context.Context) []*RateCircuitBreaker {
+	v, err := rcbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcbcb *RateCircuitBreakerCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcbcb *RateCircuitBreakerCreateBulk) ExecX(ctx context.Context) {
+	if err := rcbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RateCircuitBreaker.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RateCircuitBreakerUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rcbcb *RateCircuitBreakerCreateBulk) OnConflict(opts ...sql.ConflictOption) *RateCircuitBreakerUpsertBulk {
+	rcbcb.conflict = opts
+	return &RateCircuitBreakerUpsertBulk{
+		create: rcbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RateCircuitBreaker.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcbcb *RateCircuitBreakerCreateBulk) OnConflictColumns(columns ...string) *RateCircuitBreakerUpsertBulk {
+	rcbcb.conflict = append(rcbcb.conflict, sql.ConflictColumns(columns...))
+	return &RateCircuitBreakerUpsertBulk{
+		create: rcbcb,
+	}
+}
+
+// RateCircuitBreakerUpsertBulk is the builder for "upsert"-ing
+// a bulk of RateCircuitBreaker nodes.
+type RateCircuitBreakerUpsertBulk struct {
+	create *RateCircuitBreakerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RateCircuitBreaker.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ratecircuitbreaker.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RateCircuitBreakerUpsertBulk) UpdateNewValues() *RateCircuitBreakerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ratecircuitbreaker.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ratecircuitbreaker.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RateCircuitBreaker.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RateCircuitBreakerUpsertBulk) Ignore() *RateCircuitBreakerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RateCircuitBreakerUpsertBulk) DoNothing() *RateCircuitBreakerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RateCircuitBreakerCreateBulk.OnConflict
+// documentation for more info.
+func (u *RateCircuitBreakerUpsertBulk) Update(set func(*RateCircuitBreakerUpsert)) *RateCircuitBreakerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RateCircuitBreakerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateCircuitBreakerUpsertBulk) SetUpdatedAt(v time.Time) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateUpdatedAt() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMaxChangePercent sets the "max_change_percent" field.
+func (u *RateCircuitBreakerUpsertBulk) SetMaxChangePercent(v decimal.Decimal) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetMaxChangePercent(v)
+	})
+}
+
+// AddMaxChangePercent adds v to the "max_change_percent" field.
+func (u *RateCircuitBreakerUpsertBulk) AddMaxChangePercent(v decimal.Decimal) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddMaxChangePercent(v)
+	})
+}
+
+// UpdateMaxChangePercent sets the "max_change_percent" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateMaxChangePercent() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateMaxChangePercent()
+	})
+}
+
+// SetChangeWindow sets the "change_window" field.
+func (u *RateCircuitBreakerUpsertBulk) SetChangeWindow(v int) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetChangeWindow(v)
+	})
+}
+
+// AddChangeWindow adds v to the "change_window" field.
+func (u *RateCircuitBreakerUpsertBulk) AddChangeWindow(v int) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddChangeWindow(v)
+	})
+}
+
+// UpdateChangeWindow sets the "change_window" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateChangeWindow() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateChangeWindow()
+	})
+}
+
+// SetMaxDivergencePercent sets the "max_divergence_percent" field.
+func (u *RateCircuitBreakerUpsertBulk) SetMaxDivergencePercent(v decimal.Decimal) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetMaxDivergencePercent(v)
+	})
+}
+
+// AddMaxDivergencePercent adds v to the "max_divergence_percent" field.
+func (u *RateCircuitBreakerUpsertBulk) AddMaxDivergencePercent(v decimal.Decimal) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddMaxDivergencePercent(v)
+	})
+}
+
+// UpdateMaxDivergencePercent sets the "max_divergence_percent" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateMaxDivergencePercent() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateMaxDivergencePercent()
+	})
+}
+
+// SetAutoResetAfter sets the "auto_reset_after" field.
+func (u *RateCircuitBreakerUpsertBulk) SetAutoResetAfter(v int) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetAutoResetAfter(v)
+	})
+}
+
+// AddAutoResetAfter adds v to the "auto_reset_after" field.
+func (u *RateCircuitBreakerUpsertBulk) AddAutoResetAfter(v int) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddAutoResetAfter(v)
+	})
+}
+
+// UpdateAutoResetAfter sets the "auto_reset_after" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateAutoResetAfter() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateAutoResetAfter()
+	})
+}
+
+// SetTripped sets the "tripped" field.
+func (u *RateCircuitBreakerUpsertBulk) SetTripped(v bool) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetTripped(v)
+	})
+}
+
+// UpdateTripped sets the "tripped" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateTripped() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateTripped()
+	})
+}
+
+// SetTripReason sets the "trip_reason" field.
+func (u *RateCircuitBreakerUpsertBulk) SetTripReason(v string) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetTripReason(v)
+	})
+}
+
+// UpdateTripReason sets the "trip_reason" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateTripReason() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateTripReason()
+	})
+}
+
+// ClearTripReason clears the value of the "trip_reason" field.
+func (u *RateCircuitBreakerUpsertBulk) ClearTripReason() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.ClearTripReason()
+	})
+}
+
+// SetTrippedAt sets the "tripped_at" field.
+func (u *RateCircuitBreakerUpsertBulk) SetTrippedAt(v time.Time) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetTrippedAt(v)
+	})
+}
+
+// UpdateTrippedAt sets the "tripped_at" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateTrippedAt() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateTrippedAt()
+	})
+}
+
+// ClearTrippedAt clears the value of the "tripped_at" field.
+func (u *RateCircuitBreakerUpsertBulk) ClearTrippedAt() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.ClearTrippedAt()
+	})
+}
+
+// SetFrozenRate sets the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsertBulk) SetFrozenRate(v decimal.Decimal) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.SetFrozenRate(v)
+	})
+}
+
+// AddFrozenRate adds v to the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsertBulk) AddFrozenRate(v decimal.Decimal) *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.AddFrozenRate(v)
+	})
+}
+
+// UpdateFrozenRate sets the "frozen_rate" field to the value that was provided on create.
+func (u *RateCircuitBreakerUpsertBulk) UpdateFrozenRate() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.UpdateFrozenRate()
+	})
+}
+
+// ClearFrozenRate clears the value of the "frozen_rate" field.
+func (u *RateCircuitBreakerUpsertBulk) ClearFrozenRate() *RateCircuitBreakerUpsertBulk {
+	return u.Update(func(s *RateCircuitBreakerUpsert) {
+		s.ClearFrozenRate()
+	})
+}
+
+// Exec executes the query.
+func (u *RateCircuitBreakerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RateCircuitBreakerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RateCircuitBreakerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RateCircuitBreakerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+)
+
+// RateCircuitBreakerDelete is the builder for deleting a RateCircuitBreaker entity.
+type RateCircuitBreakerDelete struct {
+	config
+	hooks    []Hook
+	mutation *RateCircuitBreakerMutation
+}
+
+// Where appends a list predicates to the RateCircuitBreakerDelete builder.
+func (rcbd *RateCircuitBreakerDelete) Where(ps ...predicate.RateCircuitBreaker) *RateCircuitBreakerDelete {
+	rcbd.mutation.Where(ps...)
+	return rcbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rcbd *RateCircuitBreakerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rcbd.sqlExec, rcbd.mutation, rcbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcbd *RateCircuitBreakerDelete) ExecX(ctx context.Context) int {
+	n, err := rcbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rcbd *RateCircuitBreakerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ratecircuitbreaker.Table, sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID))
+	if ps := rcbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rcbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rcbd.mutation.done = true
+	return affected, err
+}
+
+// RateCircuitBreakerDeleteOne is the builder for deleting a single RateCircuitBreaker entity.
+type RateCircuitBreakerDeleteOne struct {
+	rcbd *RateCircuitBreakerDelete
+}
+
+// Where appends a list predicates to the RateCircuitBreakerDelete builder.
+func (rcbdo *RateCircuitBreakerDeleteOne) Where(ps ...predicate.RateCircuitBreaker) *RateCircuitBreakerDeleteOne {
+	rcbdo.rcbd.mutation.Where(ps...)
+	return rcbdo
+}
+
+// Exec executes the deletion query.
+func (rcbdo *RateCircuitBreakerDeleteOne) Exec(ctx context.Context) error {
+	n, err := rcbdo.rcbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratecircuitbreaker.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcbdo *RateCircuitBreakerDeleteOne) ExecX(ctx context.Context) {
+	if err := rcbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
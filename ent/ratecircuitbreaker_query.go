@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+)
+
+// RateCircuitBreakerQuery is the builder for querying RateCircuitBreaker entities.
+type RateCircuitBreakerQuery struct {
+	config
+	ctx          *QueryContext
+	order        []ratecircuitbreaker.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.RateCircuitBreaker
+	withCurrency *FiatCurrencyQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RateCircuitBreakerQuery builder.
+func (rcbq *RateCircuitBreakerQuery) Where(ps ...predicate.RateCircuitBreaker) *RateCircuitBreakerQuery {
+	rcbq.predicates = append(rcbq.predicates, ps...)
+	return rcbq
+}
+
+// Limit the number of records to be returned by this query.
+func (rcbq *RateCircuitBreakerQuery) Limit(limit int) *RateCircuitBreakerQuery {
+	rcbq.ctx.Limit = &limit
+	return rcbq
+}
+
+// Offset to start from.
+func (rcbq *RateCircuitBreakerQuery) Offset(offset int) *RateCircuitBreakerQuery {
+	rcbq.ctx.Offset = &offset
+	return rcbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rcbq *RateCircuitBreakerQuery) Unique(unique bool) *RateCircuitBreakerQuery {
+	rcbq.ctx.Unique = &unique
+	return rcbq
+}
+
+// Order specifies how the records should be ordered.
+func (rcbq *RateCircuitBreakerQuery) Order(o ...ratecircuitbreaker.OrderOption) *RateCircuitBreakerQuery {
+	rcbq.order = append(rcbq.order, o...)
+	return rcbq
+}
+
+// QueryCurrency chains the current query on the "currency" edge.
+func (rcbq *RateCircuitBreakerQuery) QueryCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: rcbq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rcbq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rcbq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratecircuitbreaker.Table, ratecircuitbreaker.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ratecircuitbreaker.CurrencyTable, ratecircuitbreaker.CurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rcbq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RateCircuitBreaker entity from the query.
+// Returns a *NotFoundError when no RateCircuitBreaker was found.
+func (rcbq *RateCircuitBreakerQuery) First(ctx context.Context) (*RateCircuitBreaker, error) {
+	nodes, err := rcbq.Limit(1).All(setContextOp(ctx, rcbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratecircuitbreaker.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) FirstX(ctx context.Context) *RateCircuitBreaker {
+	node, err := rcbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RateCircuitBreaker ID from the query.
+// Returns a *NotFoundError when no RateCircuitBreaker ID was found.
+func (rcbq *RateCircuitBreakerQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rcbq.Limit(1).IDs(setContextOp(ctx, rcbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratecircuitbreaker.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rcbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RateCircuitBreaker entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RateCircuitBreaker entity is found.
+// Returns a *NotFoundError when no RateCircuitBreaker entities are found.
+func (rcbq *RateCircuitBreakerQuery) Only(ctx context.Context) (*RateCircuitBreaker, error) {
+	nodes, err := rcbq.Limit(2).All(setContextOp(ctx, rcbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratecircuitbreaker.Label}
+	default:
+		return nil, &NotSingularError{ratecircuitbreaker.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) OnlyX(ctx context.Context) *RateCircuitBreaker {
+	node, err := rcbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RateCircuitBreaker ID in the query.
+// Returns a *NotSingularError when more than one RateCircuitBreaker ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rcbq *RateCircuitBreakerQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rcbq.Limit(2).IDs(setContextOp(ctx, rcbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratecircuitbreaker.Label}
+	default:
+		err = &NotSingularError{ratecircuitbreaker.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rcbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RateCircuitBreakers.
+func (rcbq *RateCircuitBreakerQuery) All(ctx context.Context) ([]*RateCircuitBreaker, error) {
+	ctx = setContextOp(ctx, rcbq.ctx, ent.OpQueryAll)
+	if err := rcbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RateCircuitBreaker, *RateCircuitBreakerQuery]()
+	return withInterceptors[[]*RateCircuitBreaker](ctx, rcbq, qr, rcbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) AllX(ctx context.Context) []*RateCircuitBreaker {
+	nodes, err := rcbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RateCircuitBreaker IDs.
+func (rcbq *RateCircuitBreakerQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rcbq.ctx.Unique == nil && rcbq.path != nil {
+		rcbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rcbq.ctx, ent.OpQueryIDs)
+	if err = rcbq.Select(ratecircuitbreaker.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rcbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rcbq *RateCircuitBreakerQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rcbq.ctx, ent.OpQueryCount)
+	if err := rcbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rcbq, querierCount[*RateCircuitBreakerQuery](), rcbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) CountX(ctx context.Context) int {
+	count, err := rcbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rcbq *RateCircuitBreakerQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rcbq.ctx, ent.OpQueryExist)
+	switch _, err := rcbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rcbq *RateCircuitBreakerQuery) ExistX(ctx context.Context) bool {
+	exist, err := rcbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RateCircuitBreakerQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rcbq *RateCircuitBreakerQuery) Clone() *RateCircuitBreakerQuery {
+	if rcbq == nil {
+		return nil
+	}
+	return &RateCircuitBreakerQuery{
+		config:       rcbq.config,
+		ctx:          rcbq.ctx.Clone(),
+		order:        append([]ratecircuitbreaker.OrderOption{}, rcbq.order...),
+		inters:       append([]Interceptor{}, rcbq.inters...),
+		predicates:   append([]predicate.RateCircuitBreaker{}, rcbq.predicates...),
+		withCurrency: rcbq.withCurrency.Clone(),
+		// clone intermediate query.
+		sql:  rcbq.sql.Clone(),
+		path: rcbq.path,
+	}
+}
+
+// WithCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (rcbq *RateCircuitBreakerQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *RateCircuitBreakerQuery {
+	query := (&FiatCurrencyClient{config: rcbq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rcbq.withCurrency = query
+	return rcbq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RateCircuitBreaker.Query().
+//		GroupBy(ratecircuitbreaker.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rcbq *RateCircuitBreakerQuery) GroupBy(field string, fields ...string) *RateCircuitBreakerGroupBy {
+	rcbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RateCircuitBreakerGroupBy{build: rcbq}
+	grbuild.flds = &rcbq.ctx.Fields
+	grbuild.label = ratecircuitbreaker.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RateCircuitBreaker.Query().
+//		Select(ratecircuitbreaker.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rcbq *RateCircuitBreakerQuery) Select(fields ...string) *RateCircuitBreakerSelect {
+	rcbq.ctx.Fields = append(rcbq.ctx.Fields, fields...)
+	sbuild := &RateCircuitBreakerSelect{RateCircuitBreakerQuery: rcbq}
+	sbuild.label = ratecircuitbreaker.Label
+	sbuild.flds, sbuild.scan = &rcbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RateCircuitBreakerSelect configured with the given aggregations.
+func (rcbq *RateCircuitBreakerQuery) Aggregate(fns ...AggregateFunc) *RateCircuitBreakerSelect {
+	return rcbq.Select().Aggregate(fns...)
+}
+
+func (rcbq *RateCircuitBreakerQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rcbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rcbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rcbq.ctx.Fields {
+		if !ratecircuitbreaker.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rcbq.path != nil {
+		prev, err := rcbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rcbq.sql = prev
+	}
+	return nil
+}
+
+func (rcbq *RateCircuitBreakerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RateCircuitBreaker, error) {
+	var (
+		nodes       = []*RateCircuitBreaker{}
+		withFKs     = rcbq.withFKs
+		_spec       = rcbq.querySpec()
+		loadedTypes = [1]bool{
+			rcbq.withCurrency != nil,
+		}
+	)
+	if rcbq.withCurrency != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ratecircuitbreaker.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RateCircuitBreaker).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RateCircuitBreaker{config: rcbq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rcbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rcbq.withCurrency; query != nil {
+		if err := rcbq.loadCurrency(ctx, query, nodes, nil,
+			func(n *RateCircuitBreaker, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rcbq *RateCircuitBreakerQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*RateCircuitBreaker, init func(*RateCircuitBreaker), assign func(*RateCircuitBreaker, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*RateCircuitBreaker)
+	for i := range nodes {
+		if nodes[i].fiat_currency_rate_circuit_breaker == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_rate_circuit_breaker
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_rate_circuit_breaker" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rcbq *RateCircuitBreakerQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rcbq.querySpec()
+	_spec.Node.Columns = rcbq.ctx.Fields
+	if len(rcbq.ctx.Fields) > 0 {
+		_spec.Unique = rcbq.ctx.Unique != nil && *rcbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rcbq.driver, _spec)
+}
+
+func (rcbq *RateCircuitBreakerQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ratecircuitbreaker.Table, ratecircuitbreaker.Columns, sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID))
+	_spec.From = rcbq.sql
+	if unique := rcbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rcbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rcbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratecircuitbreaker.FieldID)
+		for i := range fields {
+			if fields[i] != ratecircuitbreaker.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rcbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rcbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rcbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rcbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rcbq *RateCircuitBreakerQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rcbq.driver.Dialect())
+	t1 := builder.Table(ratecircuitbreaker.Table)
+	columns := rcbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ratecircuitbreaker.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rcbq.sql != nil {
+		selector = rcbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rcbq.ctx.Unique != nil && *rcbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rcbq.predicates {
+		p(selector)
+	}
+	for _, p := range rcbq.order {
+		p(selector)
+	}
+	if offset := rcbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rcbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RateCircuitBreakerGroupBy is the group-by builder for RateCircuitBreaker entities.
+type RateCircuitBreakerGroupBy struct {
+	selector
+	build *RateCircuitBreakerQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rcbgb *RateCircuitBreakerGroupBy) Aggregate(fns ...AggregateFunc) *RateCircuitBreakerGroupBy {
+	rcbgb.fns = append(rcbgb.fns, fns...)
+	return rcbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcbgb *RateCircuitBreakerGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rcbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rcbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RateCircuitBreakerQuery, *RateCircuitBreakerGroupBy](ctx, rcbgb.build, rcbgb, rcbgb.build.inters, v)
+}
+
+func (rcbgb *RateCircuitBreakerGroupBy) sqlScan(ctx context.Context, root *RateCircuitBreakerQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rcbgb.fns))
+	for _, fn := range rcbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rcbgb.flds)+len(rcbgb.fns))
+		for _, f := range *rcbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rcbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RateCircuitBreakerSelect is the builder for selecting fields of RateCircuitBreaker entities.
+type RateCircuitBreakerSelect struct {
+	*RateCircuitBreakerQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rcbs *RateCircuitBreakerSelect) Aggregate(fns ...AggregateFunc) *RateCircuitBreakerSelect {
+	rcbs.fns = append(rcbs.fns, fns...)
+	return rcbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcbs *RateCircuitBreakerSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rcbs.ctx, ent.OpQuerySelect)
+	if err := rcbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RateCircuitBreakerQuery, *RateCircuitBreakerSelect](ctx, rcbs.RateCircuitBreakerQuery, rcbs, rcbs.inters, v)
+}
+
+func (rcbs *RateCircuitBreakerSelect) sqlScan(ctx context.Context, root *RateCircuitBreakerQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rcbs.fns))
+	for _, fn := range rcbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rcbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/shopspring/decimal"
+)
+
+// RateCircuitBreakerUpdate is the builder for updating RateCircuitBreaker entities.
+type RateCircuitBreakerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateCircuitBreakerMutation
+}
+
+// Where appends a list predicates to the RateCircuitBreakerUpdate builder.
+func (rcbu *RateCircuitBreakerUpdate) Where(ps ...predicate.RateCircuitBreaker) *RateCircuitBreakerUpdate {
+	rcbu.mutation.Where(ps...)
+	return rcbu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcbu *RateCircuitBreakerUpdate) SetUpdatedAt(t time.Time) *RateCircuitBreakerUpdate {
+	rcbu.mutation.SetUpdatedAt(t)
+	return rcbu
+}
+
+// SetMaxChangePercent sets the "max_change_percent" field.
+func (rcbu *RateCircuitBreakerUpdate) SetMaxChangePercent(d decimal.Decimal) *RateCircuitBreakerUpdate {
+	rcbu.mutation.ResetMaxChangePercent()
+	rcbu.mutation.SetMaxChangePercent(d)
+	return rcbu
+}
+
+// SetNillableMaxChangePercent sets the "max_change_percent" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableMaxChangePercent(d *decimal.Decimal) *RateCircuitBreakerUpdate {
+	if d != nil {
+		rcbu.SetMaxChangePercent(*d)
+	}
+	return rcbu
+}
+
+// AddMaxChangePercent adds d to the "max_change_percent" field.
+func (rcbu *RateCircuitBreakerUpdate) AddMaxChangePercent(d decimal.Decimal) *RateCircuitBreakerUpdate {
+	rcbu.mutation.AddMaxChangePercent(d)
+	return rcbu
+}
+
+// SetChangeWindow sets the "change_window" field.
+func (rcbu *RateCircuitBreakerUpdate) SetChangeWindow(i int) *RateCircuitBreakerUpdate {
+	rcbu.mutation.ResetChangeWindow()
+	rcbu.mutation.SetChangeWindow(i)
+	return rcbu
+}
+
+// SetNillableChangeWindow sets the "change_window" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableChangeWindow(i *int) *RateCircuitBreakerUpdate {
+	if i != nil {
+		rcbu.SetChangeWindow(*i)
+	}
+	return rcbu
+}
+
+// AddChangeWindow adds i to the "change_window" field.
+func (rcbu *RateCircuitBreakerUpdate) AddChangeWindow(i int) *RateCircuitBreakerUpdate {
+	rcbu.mutation.AddChangeWindow(i)
+	return rcbu
+}
+
+// SetMaxDivergencePercent sets the "max_divergence_percent" field.
+func (rcbu *RateCircuitBreakerUpdate) SetMaxDivergencePercent(d decimal.Decimal) *RateCircuitBreakerUpdate {
+	rcbu.mutation.ResetMaxDivergencePercent()
+	rcbu.mutation.SetMaxDivergencePercent(d)
+	return rcbu
+}
+
+// SetNillableMaxDivergencePercent sets the "max_divergence_percent" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableMaxDivergencePercent(d *decimal.Decimal) *RateCircuitBreakerUpdate {
+	if d != nil {
+		rcbu.SetMaxDivergencePercent(*d)
+	}
+	return rcbu
+}
+
+// AddMaxDivergencePercent adds d to the "max_divergence_percent" field.
+func (rcbu *RateCircuitBreakerUpdate) AddMaxDivergencePercent(d decimal.Decimal) *RateCircuitBreakerUpdate {
+	rcbu.mutation.AddMaxDivergencePercent(d)
+	return rcbu
+}
+
+// SetAutoResetAfter sets the "auto_reset_after" field.
+func (rcbu *RateCircuitBreakerUpdate) SetAutoResetAfter(i int) *RateCircuitBreakerUpdate {
+	rcbu.mutation.ResetAutoResetAfter()
+	rcbu.mutation.SetAutoResetAfter(i)
+	return rcbu
+}
+
+// SetNillableAutoResetAfter sets the "auto_reset_after" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableAutoResetAfter(i *int) *RateCircuitBreakerUpdate {
+	if i != nil {
+		rcbu.SetAutoResetAfter(*i)
+	}
+	return rcbu
+}
+
+// AddAutoResetAfter adds i to the "auto_reset_after" field.
+func (rcbu *RateCircuitBreakerUpdate) AddAutoResetAfter(i int) *RateCircuitBreakerUpdate {
+	rcbu.mutation.AddAutoResetAfter(i)
+	return rcbu
+}
+
+// SetTripped sets the "tripped" field.
+func (rcbu *RateCircuitBreakerUpdate) SetTripped(b bool) *RateCircuitBreakerUpdate {
+	rcbu.mutation.SetTripped(b)
+	return rcbu
+}
+
+// SetNillableTripped sets the "tripped" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableTripped(b *bool) *RateCircuitBreakerUpdate {
+	if b != nil {
+		rcbu.SetTripped(*b)
+	}
+	return rcbu
+}
+
+// SetTripReason sets the "trip_reason" field.
+func (rcbu *RateCircuitBreakerUpdate) SetTripReason(s string) *RateCircuitBreakerUpdate {
+	rcbu.mutation.SetTripReason(s)
+	return rcbu
+}
+
+// SetNillableTripReason sets the "trip_reason" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableTripReason(s *string) *RateCircuitBreakerUpdate {
+	if s != nil {
+		rcbu.SetTripReason(*s)
+	}
+	return rcbu
+}
+
+// ClearTripReason clears the value of the "trip_reason" field.
+func (rcbu *RateCircuitBreakerUpdate) ClearTripReason() *RateCircuitBreakerUpdate {
+	rcbu.mutation.ClearTripReason()
+	return rcbu
+}
+
+// SetTrippedAt sets the "tripped_at" field.
+func (rcbu *RateCircuitBreakerUpdate) SetTrippedAt(t time.Time) *RateCircuitBreakerUpdate {
+	rcbu.mutation.SetTrippedAt(t)
+	return rcbu
+}
+
+// SetNillableTrippedAt sets the "tripped_at" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableTrippedAt(t *time.Time) *RateCircuitBreakerUpdate {
+	if t != nil {
+		rcbu.SetTrippedAt(*t)
+	}
+	return rcbu
+}
+
+// ClearTrippedAt clears the value of the "tripped_at" field.
+func (rcbu *RateCircuitBreakerUpdate) ClearTrippedAt() *RateCircuitBreakerUpdate {
+	rcbu.mutation.ClearTrippedAt()
+	return rcbu
+}
+
+// SetFrozenRate sets the "frozen_rate" field.
+func (rcbu *RateCircuitBreakerUpdate) SetFrozenRate(d decimal.Decimal) *RateCircuitBreakerUpdate {
+	rcbu.mutation.ResetFrozenRate()
+	rcbu.mutation.SetFrozenRate(d)
+	return rcbu
+}
+
+// SetNillableFrozenRate sets the "frozen_rate" field if the given value is not nil.
+func (rcbu *RateCircuitBreakerUpdate) SetNillableFrozenRate(d *decimal.Decimal) *RateCircuitBreakerUpdate {
+	if d != nil {
+		rcbu.SetFrozenRate(*d)
+	}
+	return rcbu
+}
+
+// AddFrozenRate adds d to the "frozen_rate" field.
+func (rcbu *RateCircuitBreakerUpdate) AddFrozenRate(d decimal.Decimal) *RateCircuitBreakerUpdate {
+	rcbu.mutation.AddFrozenRate(d)
+	return rcbu
+}
+
+// ClearFrozenRate clears the value of the "frozen_rate" field.
+func (rcbu *RateCircuitBreakerUpdate) ClearFrozenRate() *RateCircuitBreakerUpdate {
+	rcbu.mutation.ClearFrozenRate()
+	return rcbu
+}
+
+// Mutation returns the RateCircuitBreakerMutation object of the builder.
+func (rcbu *RateCircuitBreakerUpdate) Mutation() *RateCircuitBreakerMutation {
+	return rcbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcbu *RateCircuitBreakerUpdate) Save(ctx context.Context) (int, error) {
+	rcbu.defaults()
+	return withHooks(ctx, rcbu.sqlSave, rcbu.mutation, rcbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcbu *RateCircuitBreakerUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcbu *RateCircuitBreakerUpdate) Exec(ctx context.Context) error {
+	_, err := rcbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcbu *RateCircuitBreakerUpdate) ExecX(ctx context.Context) {
+	if err := rcbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcbu *RateCircuitBreakerUpdate) defaults() {
+	if _, ok := rcbu.mutation.UpdatedAt(); !ok {
+		v := ratecircuitbreaker.UpdateDefaultUpdatedAt()
+		rcbu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcbu *RateCircuitBreakerUpdate) check() error {
+	if rcbu.mutation.CurrencyCleared() && len(rcbu.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RateCircuitBreaker.currency"`)
+	}
+	return nil
+}
+
+func (rcbu *RateCircuitBreakerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rcbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratecircuitbreaker.Table, ratecircuitbreaker.Columns, sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID))
+	if ps := rcbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcbu.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcbu.mutation.MaxChangePercent(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldMaxChangePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbu.mutation.AddedMaxChangePercent(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldMaxChangePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbu.mutation.ChangeWindow(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldChangeWindow, field.TypeInt, value)
+	}
+	if value, ok := rcbu.mutation.AddedChangeWindow(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldChangeWindow, field.TypeInt, value)
+	}
+	if value, ok := rcbu.mutation.MaxDivergencePercent(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldMaxDivergencePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbu.mutation.AddedMaxDivergencePercent(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldMaxDivergencePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbu.mutation.AutoResetAfter(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldAutoResetAfter, field.TypeInt, value)
+	}
+	if value, ok := rcbu.mutation.AddedAutoResetAfter(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldAutoResetAfter, field.TypeInt, value)
+	}
+	if value, ok := rcbu.mutation.Tripped(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTripped, field.TypeBool, value)
+	}
+	if value, ok := rcbu.mutation.TripReason(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTripReason, field.TypeString, value)
+	}
+	if rcbu.mutation.TripReasonCleared() {
+		_spec.ClearField(ratecircuitbreaker.FieldTripReason, field.TypeString)
+	}
+	if value, ok := rcbu.mutation.TrippedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTrippedAt, field.TypeTime, value)
+	}
+	if rcbu.mutation.TrippedAtCleared() {
+		_spec.ClearField(ratecircuitbreaker.FieldTrippedAt, field.TypeTime)
+	}
+	if value, ok := rcbu.mutation.FrozenRate(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64, value)
+	}
+	if value, ok := rcbu.mutation.AddedFrozenRate(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64, value)
+	}
+	if rcbu.mutation.FrozenRateCleared() {
+		_spec.ClearField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rcbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratecircuitbreaker.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcbu.mutation.done = true
+	return n, nil
+}
+
+// RateCircuitBreakerUpdateOne is the builder for updating a single RateCircuitBreaker entity.
+type RateCircuitBreakerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateCircuitBreakerMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetUpdatedAt(t time.Time) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.SetUpdatedAt(t)
+	return rcbuo
+}
+
+// SetMaxChangePercent sets the "max_change_percent" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetMaxChangePercent(d decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ResetMaxChangePercent()
+	rcbuo.mutation.SetMaxChangePercent(d)
+	return rcbuo
+}
+
+// SetNillableMaxChangePercent sets the "max_change_percent" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableMaxChangePercent(d *decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	if d != nil {
+		rcbuo.SetMaxChangePercent(*d)
+	}
+	return rcbuo
+}
+
+// AddMaxChangePercent adds d to the "max_change_percent" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) AddMaxChangePercent(d decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.AddMaxChangePercent(d)
+	return rcbuo
+}
+
+// SetChangeWindow sets the "change_window" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetChangeWindow(i int) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ResetChangeWindow()
+	rcbuo.mutation.SetChangeWindow(i)
+	return rcbuo
+}
+
+// SetNillableChangeWindow sets the "change_window" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableChangeWindow(i *int) *RateCircuitBreakerUpdateOne {
+	if i != nil {
+		rcbuo.SetChangeWindow(*i)
+	}
+	return rcbuo
+}
+
+// AddChangeWindow adds i to the "change_window" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) AddChangeWindow(i int) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.AddChangeWindow(i)
+	return rcbuo
+}
+
+// SetMaxDivergencePercent sets the "max_divergence_percent" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetMaxDivergencePercent(d decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ResetMaxDivergencePercent()
+	rcbuo.mutation.SetMaxDivergencePercent(d)
+	return rcbuo
+}
+
+// SetNillableMaxDivergencePercent sets the "max_divergence_percent" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableMaxDivergencePercent(d *decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	if d != nil {
+		rcbuo.SetMaxDivergencePercent(*d)
+	}
+	return rcbuo
+}
+
+// AddMaxDivergencePercent adds d to the "max_divergence_percent" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) AddMaxDivergencePercent(d decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.AddMaxDivergencePercent(d)
+	return rcbuo
+}
+
+// SetAutoResetAfter sets the "auto_reset_after" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetAutoResetAfter(i int) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ResetAutoResetAfter()
+	rcbuo.mutation.SetAutoResetAfter(i)
+	return rcbuo
+}
+
+// SetNillableAutoResetAfter sets the "auto_reset_after" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableAutoResetAfter(i *int) *RateCircuitBreakerUpdateOne {
+	if i != nil {
+		rcbuo.SetAutoResetAfter(*i)
+	}
+	return rcbuo
+}
+
+// AddAutoResetAfter adds i to the "auto_reset_after" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) AddAutoResetAfter(i int) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.AddAutoResetAfter(i)
+	return rcbuo
+}
+
+// SetTripped sets the "tripped" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetTripped(b bool) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.SetTripped(b)
+	return rcbuo
+}
+
+// SetNillableTripped sets the "tripped" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableTripped(b *bool) *RateCircuitBreakerUpdateOne {
+	if b != nil {
+		rcbuo.SetTripped(*b)
+	}
+	return rcbuo
+}
+
+// SetTripReason sets the "trip_reason" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetTripReason(s string) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.SetTripReason(s)
+	return rcbuo
+}
+
+// SetNillableTripReason sets the "trip_reason" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableTripReason(s *string) *RateCircuitBreakerUpdateOne {
+	if s != nil {
+		rcbuo.SetTripReason(*s)
+	}
+	return rcbuo
+}
+
+// ClearTripReason clears the value of the "trip_reason" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) ClearTripReason() *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ClearTripReason()
+	return rcbuo
+}
+
+// SetTrippedAt sets the "tripped_at" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetTrippedAt(t time.Time) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.SetTrippedAt(t)
+	return rcbuo
+}
+
+// SetNillableTrippedAt sets the "tripped_at" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableTrippedAt(t *time.Time) *RateCircuitBreakerUpdateOne {
+	if t != nil {
+		rcbuo.SetTrippedAt(*t)
+	}
+	return rcbuo
+}
+
+// ClearTrippedAt clears the value of the "tripped_at" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) ClearTrippedAt() *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ClearTrippedAt()
+	return rcbuo
+}
+
+// SetFrozenRate sets the "frozen_rate" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetFrozenRate(d decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ResetFrozenRate()
+	rcbuo.mutation.SetFrozenRate(d)
+	return rcbuo
+}
+
+// SetNillableFrozenRate sets the "frozen_rate" field if the given value is not nil.
+func (rcbuo *RateCircuitBreakerUpdateOne) SetNillableFrozenRate(d *decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	if d != nil {
+		rcbuo.SetFrozenRate(*d)
+	}
+	return rcbuo
+}
+
+// AddFrozenRate adds d to the "frozen_rate" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) AddFrozenRate(d decimal.Decimal) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.AddFrozenRate(d)
+	return rcbuo
+}
+
+// ClearFrozenRate clears the value of the "frozen_rate" field.
+func (rcbuo *RateCircuitBreakerUpdateOne) ClearFrozenRate() *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.ClearFrozenRate()
+	return rcbuo
+}
+
+// Mutation returns the RateCircuitBreakerMutation object of the builder.
+func (rcbuo *RateCircuitBreakerUpdateOne) Mutation() *RateCircuitBreakerMutation {
+	return rcbuo.mutation
+}
+
+// Where appends a list predicates to the RateCircuitBreakerUpdate builder.
+func (rcbuo *RateCircuitBreakerUpdateOne) Where(ps ...predicate.RateCircuitBreaker) *RateCircuitBreakerUpdateOne {
+	rcbuo.mutation.Where(ps...)
+	return rcbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcbuo *RateCircuitBreakerUpdateOne) Select(field string, fields ...string) *RateCircuitBreakerUpdateOne {
+	rcbuo.fields = append([]string{field}, fields...)
+	return rcbuo
+}
+
+// Save executes the query and returns the updated RateCircuitBreaker entity.
+func (rcbuo *RateCircuitBreakerUpdateOne) Save(ctx context.Context) (*RateCircuitBreaker, error) {
+	rcbuo.defaults()
+	return withHooks(ctx, rcbuo.sqlSave, rcbuo.mutation, rcbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcbuo *RateCircuitBreakerUpdateOne) SaveX(ctx context.Context) *RateCircuitBreaker {
+	node, err := rcbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcbuo *RateCircuitBreakerUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcbuo *RateCircuitBreakerUpdateOne) ExecX(ctx context.Context) {
+	if err := rcbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcbuo *RateCircuitBreakerUpdateOne) defaults() {
+	if _, ok := rcbuo.mutation.UpdatedAt(); !ok {
+		v := ratecircuitbreaker.UpdateDefaultUpdatedAt()
+		rcbuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcbuo *RateCircuitBreakerUpdateOne) check() error {
+	if rcbuo.mutation.CurrencyCleared() && len(rcbuo.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RateCircuitBreaker.currency"`)
+	}
+	return nil
+}
+
+func (rcbuo *RateCircuitBreakerUpdateOne) sqlSave(ctx context.Context) (_node *RateCircuitBreaker, err error) {
+	if err := rcbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratecircuitbreaker.Table, ratecircuitbreaker.Columns, sqlgraph.NewFieldSpec(ratecircuitbreaker.FieldID, field.TypeUUID))
+	id, ok := rcbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateCircuitBreaker.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratecircuitbreaker.FieldID)
+		for _, f := range fields {
+			if !ratecircuitbreaker.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratecircuitbreaker.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcbuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcbuo.mutation.MaxChangePercent(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldMaxChangePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbuo.mutation.AddedMaxChangePercent(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldMaxChangePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbuo.mutation.ChangeWindow(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldChangeWindow, field.TypeInt, value)
+	}
+	if value, ok := rcbuo.mutation.AddedChangeWindow(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldChangeWindow, field.TypeInt, value)
+	}
+	if value, ok := rcbuo.mutation.MaxDivergencePercent(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldMaxDivergencePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbuo.mutation.AddedMaxDivergencePercent(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldMaxDivergencePercent, field.TypeFloat64, value)
+	}
+	if value, ok := rcbuo.mutation.AutoResetAfter(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldAutoResetAfter, field.TypeInt, value)
+	}
+	if value, ok := rcbuo.mutation.AddedAutoResetAfter(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldAutoResetAfter, field.TypeInt, value)
+	}
+	if value, ok := rcbuo.mutation.Tripped(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTripped, field.TypeBool, value)
+	}
+	if value, ok := rcbuo.mutation.TripReason(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTripReason, field.TypeString, value)
+	}
+	if rcbuo.mutation.TripReasonCleared() {
+		_spec.ClearField(ratecircuitbreaker.FieldTripReason, field.TypeString)
+	}
+	if value, ok := rcbuo.mutation.TrippedAt(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldTrippedAt, field.TypeTime, value)
+	}
+	if rcbuo.mutation.TrippedAtCleared() {
+		_spec.ClearField(ratecircuitbreaker.FieldTrippedAt, field.TypeTime)
+	}
+	if value, ok := rcbuo.mutation.FrozenRate(); ok {
+		_spec.SetField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64, value)
+	}
+	if value, ok := rcbuo.mutation.AddedFrozenRate(); ok {
+		_spec.AddField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64, value)
+	}
+	if rcbuo.mutation.FrozenRateCleared() {
+		_spec.ClearField(ratecircuitbreaker.FieldFrozenRate, field.TypeFloat64)
+	}
+	_node = &RateCircuitBreaker{config: rcbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratecircuitbreaker.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcbuo.mutation.done = true
+	return _node, nil
+}
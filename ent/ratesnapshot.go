@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/shopspring/decimal"
+)
+
+// RateSnapshot is the model entity for the RateSnapshot schema.
+type RateSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind ratesnapshot.Kind `json:"kind,omitempty"`
+	// Resolution holds the value of the "resolution" field.
+	Resolution ratesnapshot.Resolution `json:"resolution,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Open holds the value of the "open" field.
+	Open decimal.Decimal `json:"open,omitempty"`
+	// High holds the value of the "high" field.
+	High decimal.Decimal `json:"high,omitempty"`
+	// Low holds the value of the "low" field.
+	Low decimal.Decimal `json:"low,omitempty"`
+	// Close holds the value of the "close" field.
+	Close decimal.Decimal `json:"close,omitempty"`
+	// SampleCount holds the value of the "sample_count" field.
+	SampleCount int `json:"sample_count,omitempty"`
+	// RecordedAt holds the value of the "recorded_at" field.
+	RecordedAt time.Time `json:"recorded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RateSnapshotQuery when eager-loading is set.
+	Edges                           RateSnapshotEdges `json:"edges"`
+	fiat_currency_rate_snapshots    *uuid.UUID
+	provider_profile_rate_snapshots *string
+	selectValues                    sql.SelectValues
+}
+
+// RateSnapshotEdges holds the relations/edges for other nodes in the graph.
+type RateSnapshotEdges struct {
+	// Currency holds the value of the currency edge.
+	Currency *FiatCurrency `json:"currency,omitempty"`
+	// Provider holds the value of the provider edge.
+	Provider *ProviderProfile `json:"provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CurrencyOrErr returns the Currency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RateSnapshotEdges) CurrencyOrErr() (*FiatCurrency, error) {
+	if e.Currency != nil {
+		return e.Currency, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "currency"}
+}
+
+// ProviderOrErr returns the Provider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RateSnapshotEdges) ProviderOrErr() (*ProviderProfile, error) {
+	if e.Provider != nil {
+		return e.Provider, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratesnapshot.FieldOpen, ratesnapshot.FieldHigh, ratesnapshot.FieldLow, ratesnapshot.FieldClose:
+			values[i] = new(decimal.Decimal)
+		case ratesnapshot.FieldSampleCount:
+			values[i] = new(sql.NullInt64)
+		case ratesnapshot.FieldKind, ratesnapshot.FieldResolution, ratesnapshot.FieldToken:
+			values[i] = new(sql.NullString)
+		case ratesnapshot.FieldCreatedAt, ratesnapshot.FieldUpdatedAt, ratesnapshot.FieldRecordedAt:
+			values[i] = new(sql.NullTime)
+		case ratesnapshot.FieldID:
+			values[i] = new(uuid.UUID)
+		case ratesnapshot.ForeignKeys[0]: // fiat_currency_rate_snapshots
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case ratesnapshot.ForeignKeys[1]: // provider_profile_rate_snapshots
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateSnapshot fields.
+func (rs *RateSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratesnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rs.ID = *value
+			}
+		case ratesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rs.CreatedAt = value.Time
+			}
+		case ratesnapshot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rs.UpdatedAt = value.Time
+			}
+		case ratesnapshot.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				rs.Kind = ratesnapshot.Kind(value.String)
+			}
+		case ratesnapshot.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				rs.Resolution = ratesnapshot.Resolution(value.String)
+			}
+		case ratesnapshot.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				rs.Token = value.String
+			}
+		case ratesnapshot.FieldOpen:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field open", values[i])
+			} else if value != nil {
+				rs.Open = *value
+			}
+		case ratesnapshot.FieldHigh:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field high", values[i])
+			} else if value != nil {
+				rs.High = *value
+			}
+		case ratesnapshot.FieldLow:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field low", values[i])
+			} else if value != nil {
+				rs.Low = *value
+			}
+		case ratesnapshot.FieldClose:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field close", values[i])
+			} else if value != nil {
+				rs.Close = *value
+			}
+		case ratesnapshot.FieldSampleCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sample_count", values[i])
+			} else if value.Valid {
+				rs.SampleCount = int(value.Int64)
+			}
+		case ratesnapshot.FieldRecordedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field recorded_at", values[i])
+			} else if value.Valid {
+				rs.RecordedAt = value.Time
+			}
+		case ratesnapshot.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_rate_snapshots", values[i])
+			} else if value.Valid {
+				rs.fiat_currency_rate_snapshots = new(uuid.UUID)
+				*rs.fiat_currency_rate_snapshots = *value.S.(*uuid.UUID)
+			}
+		case ratesnapshot.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_rate_snapshots", values[i])
+			} else if value.Valid {
+				rs.provider_profile_rate_snapshots = new(string)
+				*rs.provider_profile_rate_snapshots = value.String
+			}
+		default:
+			rs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RateSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (rs *RateSnapshot) Value(name string) (ent.Value, error) {
+	return rs.selectValues.Get(name)
+}
+
+// QueryCurrency queries the "currency" edge of the RateSnapshot entity.
+func (rs *RateSnapshot) QueryCurrency() *FiatCurrencyQuery {
+	return NewRateSnapshotClient(rs.config).QueryCurrency(rs)
+}
+
+// QueryProvider queries the "provider" edge of the RateSnapshot entity.
+func (rs *RateSnapshot) QueryProvider() *ProviderProfileQuery {
+	return NewRateSnapshotClient(rs.config).QueryProvider(rs)
+}
+
+// Update returns a builder for updating this RateSnapshot.
+// Note that you need to call RateSnapshot.Unwrap() before calling this method if this RateSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *RateSnapshot) Update() *RateSnapshotUpdateOne {
+	return NewRateSnapshotClient(rs.config).UpdateOne(rs)
+}
+
+// Unwrap unwraps the RateSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rs *RateSnapshot) Unwrap() *RateSnapshot {
+	_tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateSnapshot is not a transactional entity")
+	}
+	rs.config.driver = _tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *RateSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Resolution))
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(rs.Token)
+	builder.WriteString(", ")
+	builder.WriteString("open=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Open))
+	builder.WriteString(", ")
+	builder.WriteString("high=")
+	builder.WriteString(fmt.Sprintf("%v", rs.High))
+	builder.WriteString(", ")
+	builder.WriteString("low=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Low))
+	builder.WriteString(", ")
+	builder.WriteString("close=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Close))
+	builder.WriteString(", ")
+	builder.WriteString("sample_count=")
+	builder.WriteString(fmt.Sprintf("%v", rs.SampleCount))
+	builder.WriteString(", ")
+	builder.WriteString("recorded_at=")
+	builder.WriteString(rs.RecordedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateSnapshots is a parsable slice of RateSnapshot.
+type RateSnapshots []*RateSnapshot
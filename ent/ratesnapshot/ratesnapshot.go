@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratesnapshot
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ratesnapshot type in the database.
+	Label = "rate_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldOpen holds the string denoting the open field in the database.
+	FieldOpen = "open"
+	// FieldHigh holds the string denoting the high field in the database.
+	FieldHigh = "high"
+	// FieldLow holds the string denoting the low field in the database.
+	FieldLow = "low"
+	// FieldClose holds the string denoting the close field in the database.
+	FieldClose = "close"
+	// FieldSampleCount holds the string denoting the sample_count field in the database.
+	FieldSampleCount = "sample_count"
+	// FieldRecordedAt holds the string denoting the recorded_at field in the database.
+	FieldRecordedAt = "recorded_at"
+	// EdgeCurrency holds the string denoting the currency edge name in mutations.
+	EdgeCurrency = "currency"
+	// EdgeProvider holds the string denoting the provider edge name in mutations.
+	EdgeProvider = "provider"
+	// Table holds the table name of the ratesnapshot in the database.
+	Table = "rate_snapshots"
+	// CurrencyTable is the table that holds the currency relation/edge.
+	CurrencyTable = "rate_snapshots"
+	// CurrencyInverseTable is the table name for the FiatCurrency entity.
+	// It exists in this package in order to avoid circular dependency with the "fiatcurrency" package.
+	CurrencyInverseTable = "fiat_currencies"
+	// CurrencyColumn is the table column denoting the currency relation/edge.
+	CurrencyColumn = "fiat_currency_rate_snapshots"
+	// ProviderTable is the table that holds the provider relation/edge.
+	ProviderTable = "rate_snapshots"
+	// ProviderInverseTable is the table name for the ProviderProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "providerprofile" package.
+	ProviderInverseTable = "provider_profiles"
+	// ProviderColumn is the table column denoting the provider relation/edge.
+	ProviderColumn = "provider_profile_rate_snapshots"
+)
+
+// Columns holds all SQL columns for ratesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldKind,
+	FieldResolution,
+	FieldToken,
+	FieldOpen,
+	FieldHigh,
+	FieldLow,
+	FieldClose,
+	FieldSampleCount,
+	FieldRecordedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "rate_snapshots"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"fiat_currency_rate_snapshots",
+	"provider_profile_rate_snapshots",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultSampleCount holds the default value on creation for the "sample_count" field.
+	DefaultSampleCount int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindMarket   Kind = "market"
+	KindExternal Kind = "external"
+	KindProvider Kind = "provider"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindMarket, KindExternal, KindProvider:
+		return nil
+	default:
+		return fmt.Errorf("ratesnapshot: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Resolution defines the type for the "resolution" enum field.
+type Resolution string
+
+// ResolutionRaw is the default value of the Resolution enum.
+const DefaultResolution = ResolutionRaw
+
+// Resolution values.
+const (
+	ResolutionRaw  Resolution = "raw"
+	ResolutionHour Resolution = "hour"
+	ResolutionDay  Resolution = "day"
+)
+
+func (r Resolution) String() string {
+	return string(r)
+}
+
+// ResolutionValidator is a validator for the "resolution" field enum values. It is called by the builders before save.
+func ResolutionValidator(r Resolution) error {
+	switch r {
+	case ResolutionRaw, ResolutionHour, ResolutionDay:
+		return nil
+	default:
+		return fmt.Errorf("ratesnapshot: invalid enum value for resolution field: %q", r)
+	}
+}
+
+// OrderOption defines the ordering options for the RateSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// ByOpen orders the results by the open field.
+func ByOpen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpen, opts...).ToFunc()
+}
+
+// ByHigh orders the results by the high field.
+func ByHigh(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHigh, opts...).ToFunc()
+}
+
+// ByLow orders the results by the low field.
+func ByLow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLow, opts...).ToFunc()
+}
+
+// ByClose orders the results by the close field.
+func ByClose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClose, opts...).ToFunc()
+}
+
+// BySampleCount orders the results by the sample_count field.
+func BySampleCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSampleCount, opts...).ToFunc()
+}
+
+// ByRecordedAt orders the results by the recorded_at field.
+func ByRecordedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecordedAt, opts...).ToFunc()
+}
+
+// ByCurrencyField orders the results by currency field.
+func ByCurrencyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrencyStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProviderField orders the results by provider field.
+func ByProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProviderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCurrencyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrencyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+	)
+}
+func newProviderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProviderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+	)
+}
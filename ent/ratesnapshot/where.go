@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldToken, v))
+}
+
+// Open applies equality check predicate on the "open" field. It's identical to OpenEQ.
+func Open(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldOpen, v))
+}
+
+// High applies equality check predicate on the "high" field. It's identical to HighEQ.
+func High(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldHigh, v))
+}
+
+// Low applies equality check predicate on the "low" field. It's identical to LowEQ.
+func Low(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldLow, v))
+}
+
+// Close applies equality check predicate on the "close" field. It's identical to CloseEQ.
+func Close(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldClose, v))
+}
+
+// SampleCount applies equality check predicate on the "sample_count" field. It's identical to SampleCountEQ.
+func SampleCount(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldSampleCount, v))
+}
+
+// RecordedAt applies equality check predicate on the "recorded_at" field. It's identical to RecordedAtEQ.
+func RecordedAt(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldRecordedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v Resolution) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v Resolution) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...Resolution) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...Resolution) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenIsNil applies the IsNil predicate on the "token" field.
+func TokenIsNil() predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIsNull(FieldToken))
+}
+
+// TokenNotNil applies the NotNil predicate on the "token" field.
+func TokenNotNil() predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotNull(FieldToken))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldContainsFold(FieldToken, v))
+}
+
+// OpenEQ applies the EQ predicate on the "open" field.
+func OpenEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldOpen, v))
+}
+
+// OpenNEQ applies the NEQ predicate on the "open" field.
+func OpenNEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldOpen, v))
+}
+
+// OpenIn applies the In predicate on the "open" field.
+func OpenIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldOpen, vs...))
+}
+
+// OpenNotIn applies the NotIn predicate on the "open" field.
+func OpenNotIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldOpen, vs...))
+}
+
+// OpenGT applies the GT predicate on the "open" field.
+func OpenGT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldOpen, v))
+}
+
+// OpenGTE applies the GTE predicate on the "open" field.
+func OpenGTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldOpen, v))
+}
+
+// OpenLT applies the LT predicate on the "open" field.
+func OpenLT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldOpen, v))
+}
+
+// OpenLTE applies the LTE predicate on the "open" field.
+func OpenLTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldOpen, v))
+}
+
+// HighEQ applies the EQ predicate on the "high" field.
+func HighEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldHigh, v))
+}
+
+// HighNEQ applies the NEQ predicate on the "high" field.
+func HighNEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldHigh, v))
+}
+
+// HighIn applies the In predicate on the "high" field.
+func HighIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldHigh, vs...))
+}
+
+// HighNotIn applies the NotIn predicate on the "high" field.
+func HighNotIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldHigh, vs...))
+}
+
+// HighGT applies the GT predicate on the "high" field.
+func HighGT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldHigh, v))
+}
+
+// HighGTE applies the GTE predicate on the "high" field.
+func HighGTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldHigh, v))
+}
+
+// HighLT applies the LT predicate on the "high" field.
+func HighLT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldHigh, v))
+}
+
+// HighLTE applies the LTE predicate on the "high" field.
+func HighLTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldHigh, v))
+}
+
+// LowEQ applies the EQ predicate on the "low" field.
+func LowEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldLow, v))
+}
+
+// LowNEQ applies the NEQ predicate on the "low" field.
+func LowNEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldLow, v))
+}
+
+// LowIn applies the In predicate on the "low" field.
+func LowIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldLow, vs...))
+}
+
+// LowNotIn applies the NotIn predicate on the "low" field.
+func LowNotIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldLow, vs...))
+}
+
+// LowGT applies the GT predicate on the "low" field.
+func LowGT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldLow, v))
+}
+
+// LowGTE applies the GTE predicate on the "low" field.
+func LowGTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldLow, v))
+}
+
+// LowLT applies the LT predicate on the "low" field.
+func LowLT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldLow, v))
+}
+
+// LowLTE applies the LTE predicate on the "low" field.
+func LowLTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldLow, v))
+}
+
+// CloseEQ applies the EQ predicate on the "close" field.
+func CloseEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldClose, v))
+}
+
+// CloseNEQ applies the NEQ predicate on the "close" field.
+func CloseNEQ(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldClose, v))
+}
+
+// CloseIn applies the In predicate on the "close" field.
+func CloseIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldClose, vs...))
+}
+
+// CloseNotIn applies the NotIn predicate on the "close" field.
+func CloseNotIn(vs ...decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldClose, vs...))
+}
+
+// CloseGT applies the GT predicate on the "close" field.
+func CloseGT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldClose, v))
+}
+
+// CloseGTE applies the GTE predicate on the "close" field.
+func CloseGTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldClose, v))
+}
+
+// CloseLT applies the LT predicate on the "close" field.
+func CloseLT(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldClose, v))
+}
+
+// CloseLTE applies the LTE predicate on the "close" field.
+func CloseLTE(v decimal.Decimal) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldClose, v))
+}
+
+// SampleCountEQ applies the EQ predicate on the "sample_count" field.
+func SampleCountEQ(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldSampleCount, v))
+}
+
+// SampleCountNEQ applies the NEQ predicate on the "sample_count" field.
+func SampleCountNEQ(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldSampleCount, v))
+}
+
+// SampleCountIn applies the In predicate on the "sample_count" field.
+func SampleCountIn(vs ...int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldSampleCount, vs...))
+}
+
+// SampleCountNotIn applies the NotIn predicate on the "sample_count" field.
+func SampleCountNotIn(vs ...int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldSampleCount, vs...))
+}
+
+// SampleCountGT applies the GT predicate on the "sample_count" field.
+func SampleCountGT(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldSampleCount, v))
+}
+
+// SampleCountGTE applies the GTE predicate on the "sample_count" field.
+func SampleCountGTE(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldSampleCount, v))
+}
+
+// SampleCountLT applies the LT predicate on the "sample_count" field.
+func SampleCountLT(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldSampleCount, v))
+}
+
+// SampleCountLTE applies the LTE predicate on the "sample_count" field.
+func SampleCountLTE(v int) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldSampleCount, v))
+}
+
+// RecordedAtEQ applies the EQ predicate on the "recorded_at" field.
+func RecordedAtEQ(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldEQ(FieldRecordedAt, v))
+}
+
+// RecordedAtNEQ applies the NEQ predicate on the "recorded_at" field.
+func RecordedAtNEQ(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNEQ(FieldRecordedAt, v))
+}
+
+// RecordedAtIn applies the In predicate on the "recorded_at" field.
+func RecordedAtIn(vs ...time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldIn(FieldRecordedAt, vs...))
+}
+
+// RecordedAtNotIn applies the NotIn predicate on the "recorded_at" field.
+func RecordedAtNotIn(vs ...time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldNotIn(FieldRecordedAt, vs...))
+}
+
+// RecordedAtGT applies the GT predicate on the "recorded_at" field.
+func RecordedAtGT(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGT(FieldRecordedAt, v))
+}
+
+// RecordedAtGTE applies the GTE predicate on the "recorded_at" field.
+func RecordedAtGTE(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldGTE(FieldRecordedAt, v))
+}
+
+// RecordedAtLT applies the LT predicate on the "recorded_at" field.
+func RecordedAtLT(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLT(FieldRecordedAt, v))
+}
+
+// RecordedAtLTE applies the LTE predicate on the "recorded_at" field.
+func RecordedAtLTE(v time.Time) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.FieldLTE(FieldRecordedAt, v))
+}
+
+// HasCurrency applies the HasEdge predicate on the "currency" edge.
+func HasCurrency() predicate.RateSnapshot {
+	return predicate.RateSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
+func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.RateSnapshot {
+	return predicate.RateSnapshot(func(s *sql.Selector) {
+		step := newCurrencyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProvider applies the HasEdge predicate on the "provider" edge.
+func HasProvider() predicate.RateSnapshot {
+	return predicate.RateSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderTable, ProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderWith applies the HasEdge predicate on the "provider" edge with a given conditions (other predicates).
+func HasProviderWith(preds ...predicate.ProviderProfile) predicate.RateSnapshot {
+	return predicate.RateSnapshot(func(s *sql.Selector) {
+		step := newProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RateSnapshot) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RateSnapshot) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RateSnapshot) predicate.RateSnapshot {
+	return predicate.RateSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/shopspring/decimal"
+)
+
+// RateSnapshotCreate is the builder for creating a RateSnapshot entity.
+type RateSnapshotCreate struct {
+	config
+	mutation *RateSnapshotMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rsc *RateSnapshotCreate) SetCreatedAt(t time.Time) *RateSnapshotCreate {
+	rsc.mutation.SetCreatedAt(t)
+	return rsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableCreatedAt(t *time.Time) *RateSnapshotCreate {
+	if t != nil {
+		rsc.SetCreatedAt(*t)
+	}
+	return rsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsc *RateSnapshotCreate) SetUpdatedAt(t time.Time) *RateSnapshotCreate {
+	rsc.mutation.SetUpdatedAt(t)
+	return rsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableUpdatedAt(t *time.Time) *RateSnapshotCreate {
+	if t != nil {
+		rsc.SetUpdatedAt(*t)
+	}
+	return rsc
+}
+
+// SetKind sets the "kind" field.
+func (rsc *RateSnapshotCreate) SetKind(r ratesnapshot.Kind) *RateSnapshotCreate {
+	rsc.mutation.SetKind(r)
+	return rsc
+}
+
+// SetResolution sets the "resolution" field.
+func (rsc *RateSnapshotCreate) SetResolution(r ratesnapshot.Resolution) *RateSnapshotCreate {
+	rsc.mutation.SetResolution(r)
+	return rsc
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableResolution(r *ratesnapshot.Resolution) *RateSnapshotCreate {
+	if r != nil {
+		rsc.SetResolution(*r)
+	}
+	return rsc
+}
+
+// SetToken sets the "token" field.
+func (rsc *RateSnapshotCreate) SetToken(s string) *RateSnapshotCreate {
+	rsc.mutation.SetToken(s)
+	return rsc
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableToken(s *string) *RateSnapshotCreate {
+	if s != nil {
+		rsc.SetToken(*s)
+	}
+	return rsc
+}
+
+// SetOpen sets the "open" field.
+func (rsc *RateSnapshotCreate) SetOpen(d decimal.Decimal) *RateSnapshotCreate {
+	rsc.mutation.SetOpen(d)
+	return rsc
+}
+
+// SetHigh sets the "high" field.
+func (rsc *RateSnapshotCreate) SetHigh(d decimal.Decimal) *RateSnapshotCreate {
+	rsc.mutation.SetHigh(d)
+	return rsc
+}
+
+// SetLow sets the "low" field.
+func (rsc *RateSnapshotCreate) SetLow(d decimal.Decimal) *RateSnapshotCreate {
+	rsc.mutation.SetLow(d)
+	return rsc
+}
+
+// SetClose sets the "close" field.
+func (rsc *RateSnapshotCreate) SetClose(d decimal.Decimal) *RateSnapshotCreate {
+	rsc.mutation.SetClose(d)
+	return rsc
+}
+
+// SetSampleCount sets the "sample_count" field.
+func (rsc *RateSnapshotCreate) SetSampleCount(i int) *RateSnapshotCreate {
+	rsc.mutation.SetSampleCount(i)
+	return rsc
+}
+
+// SetNillableSampleCount sets the "sample_count" field if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableSampleCount(i *int) *RateSnapshotCreate {
+	if i != nil {
+		rsc.SetSampleCount(*i)
+	}
+	return rsc
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (rsc *RateSnapshotCreate) SetRecordedAt(t time.Time) *RateSnapshotCreate {
+	rsc.mutation.SetRecordedAt(t)
+	return rsc
+}
+
+// SetID sets the "id" field.
+func (rsc *RateSnapshotCreate) SetID(u uuid.UUID) *RateSnapshotCreate {
+	rsc.mutation.SetID(u)
+	return rsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableID(u *uuid.UUID) *RateSnapshotCreate {
+	if u != nil {
+		rsc.SetID(*u)
+	}
+	return rsc
+}
+
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (rsc *RateSnapshotCreate) SetCurrencyID(id uuid.UUID) *RateSnapshotCreate {
+	rsc.mutation.SetCurrencyID(id)
+	return rsc
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (rsc *RateSnapshotCreate) SetCurrency(f *FiatCurrency) *RateSnapshotCreate {
+	return rsc.SetCurrencyID(f.ID)
+}
+
+// SetProviderID sets the "provider" edge to the ProviderProfile entity by ID.
+func (rsc *RateSnapshotCreate) SetProviderID(id string) *RateSnapshotCreate {
+	rsc.mutation.SetProviderID(id)
+	return rsc
+}
+
+// SetNillableProviderID sets the "provider" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (rsc *RateSnapshotCreate) SetNillableProviderID(id *string) *RateSnapshotCreate {
+	if id != nil {
+		rsc = rsc.SetProviderID(*id)
+	}
+	return rsc
+}
+
+// SetProvider sets the "provider" edge to the ProviderProfile entity.
+func (rsc *RateSnapshotCreate) SetProvider(p *ProviderProfile) *RateSnapshotCreate {
+	return rsc.SetProviderID(p.ID)
+}
+
+// Mutation returns the RateSnapshotMutation object of the builder.
+func (rsc *RateSnapshotCreate) Mutation() *RateSnapshotMutation {
+	return rsc.mutation
+}
+
+// Save creates the RateSnapshot in the database.
+func (rsc *RateSnapshotCreate) Save(ctx context.Context) (*RateSnapshot, error) {
+	rsc.defaults()
+	return withHooks(ctx, rsc.sqlSave, rsc.mutation, rsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *RateSnapshotCreate) SaveX(ctx context.Context) *RateSnapshot {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsc *RateSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := rsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsc *RateSnapshotCreate) ExecX(ctx context.Context) {
+	if err := rsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsc *RateSnapshotCreate) defaults() {
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		v := ratesnapshot.DefaultCreatedAt()
+		rsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		v := ratesnapshot.DefaultUpdatedAt()
+		rsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rsc.mutation.Resolution(); !ok {
+		v := ratesnapshot.DefaultResolution
+		rsc.mutation.SetResolution(v)
+	}
+	if _, ok := rsc.mutation.SampleCount(); !ok {
+		v := ratesnapshot.DefaultSampleCount
+		rsc.mutation.SetSampleCount(v)
+	}
+	if _, ok := rsc.mutation.ID(); !ok {
+		v := ratesnapshot.DefaultID()
+		rsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsc *RateSnapshotCreate) check() error {
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RateSnapshot.created_at"`)}
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RateSnapshot.updated_at"`)}
+	}
+	if _, ok := rsc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "RateSnapshot.kind"`)}
+	}
+	if v, ok := rsc.mutation.Kind(); ok {
+		if err := ratesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RateSnapshot.kind": %w`, err)}
+		}
+	}
+	if _, ok := rsc.mutation.Resolution(); !ok {
+		return &ValidationError{Name: "resolution", err: errors.New(`ent: missing required field "RateSnapshot.resolution"`)}
+	}
+	if v, ok := rsc.mutation.Resolution(); ok {
+		if err := ratesnapshot.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "RateSnapshot.resolution": %w`, err)}
+		}
+	}
+	if _, ok := rsc.mutation.Open(); !ok {
+		return &ValidationError{Name: "open", err: errors.New(`ent: missing required field "RateSnapshot.open"`)}
+	}
+	if _, ok := rsc.mutation.High(); !ok {
+		return &ValidationError{Name: "high", err: errors.New(`ent: missing required field "RateSnapshot.high"`)}
+	}
+	if _, ok := rsc.mutation.Low(); !ok {
+		return &ValidationError{Name: "low", err: errors.New(`ent: missing required field "RateSnapshot.low"`)}
+	}
+	if _, ok := rsc.mutation.Close(); !ok {
+		return &ValidationError{Name: "close", err: errors.New(`ent: missing required field "RateSnapshot.close"`)}
+	}
+	if _, ok := rsc.mutation.SampleCount(); !ok {
+		return &ValidationError{Name: "sample_count", err: errors.New(`ent: missing required field "RateSnapshot.sample_count"`)}
+	}
+	if _, ok := rsc.mutation.RecordedAt(); !ok {
+		return &ValidationError{Name: "recorded_at", err: errors.New(`ent: missing required field "RateSnapshot.recorded_at"`)}
+	}
+	if len(rsc.mutation.CurrencyIDs()) == 0 {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "RateSnapshot.currency"`)}
+	}
+	return nil
+}
+
+func (rsc *RateSnapshotCreate) sqlSave(ctx context.Context) (*RateSnapshot, error) {
+	if err := rsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rsc.mutation.id = &_node.ID
+	rsc.mutation.done = true
+	return _node, nil
+}
+
+func (rsc *RateSnapshotCreate) createSpec() (*RateSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateSnapshot{config: rsc.config}
+		_spec = sqlgraph.NewCreateSpec(ratesnapshot.Table, sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rsc.conflict
+	if id, ok := rsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rsc.mutation.CreatedAt(); ok {
+		_spec.SetField(ratesnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratesnapshot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rsc.mutation.Kind(); ok {
+		_spec.SetField(ratesnapshot.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := rsc.mutation.Resolution(); ok {
+		_spec.SetField(ratesnapshot.FieldResolution, field.TypeEnum, value)
+		_node.Resolution = value
+	}
+	if value, ok := rsc.mutation.Token(); ok {
+		_spec.SetField(ratesnapshot.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := rsc.mutation.Open(); ok {
+		_spec.SetField(ratesnapshot.FieldOpen, field.TypeFloat64, value)
+		_node.Open = value
+	}
+	if value, ok := rsc.mutation.High(); ok {
+		_spec.SetField(ratesnapshot.FieldHigh, field.TypeFloat64, value)
+		_node.High = value
+	}
+	if value, ok := rsc.mutation.Low(); ok {
+		_spec.SetField(ratesnapshot.FieldLow, field.TypeFloat64, value)
+		_node.Low = value
+	}
+	if value, ok := rsc.mutation.Close(); ok {
+		_spec.SetField(ratesnapshot.FieldClose, field.TypeFloat64, value)
+		_node.Close = value
+	}
+	if value, ok := rsc.mutation.SampleCount(); ok {
+		_spec.SetField(ratesnapshot.FieldSampleCount, field.TypeInt, value)
+		_node.SampleCount = value
+	}
+	if value, ok := rsc.mutation.RecordedAt(); ok {
+		_spec.SetField(ratesnapshot.FieldRecordedAt, field.TypeTime, value)
+		_node.RecordedAt = value
+	}
+	if nodes := rsc.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratesnapshot.CurrencyTable,
+			Columns: []string{ratesnapshot.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_rate_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsc.mutation.ProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratesnapshot.ProviderTable,
+			Columns: []string{ratesnapshot.ProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_rate_snapshots = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RateSnapshot.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RateSnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rsc *RateSnapshotCreate) OnConflict(opts ...sql.ConflictOption) *RateSnapshotUpsertOne {
+	rsc.conflict = opts
+	return &RateSnapshotUpsertOne{
+		create: rsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RateSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsc *RateSnapshotCreate) OnConflictColumns(columns ...string) *RateSnapshotUpsertOne {
+	rsc.conflict = append(rsc.conflict, sql.ConflictColumns(columns...))
+	return &RateSnapshotUpsertOne{
+		create: rsc,
+	}
+}
+
+type (
+	// RateSnapshotUpsertOne is the builder for "upsert"-ing
+	//  one RateSnapshot node.
+	RateSnapshotUpsertOne struct {
+		create *RateSnapshotCreate
+	}
+
+	// RateSnapshotUpsert is the "OnConflict" setter.
+	RateSnapshotUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateSnapshotUpsert) SetUpdatedAt(v time.Time) *RateSnapshotUpsert {
+	u.Set(ratesnapshot.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateSnapshotUpsert) UpdateUpdatedAt() *RateSnapshotUpsert {
+	u.SetExcluded(ratesnapshot.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RateSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ratesnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RateSnapshotUpsertOne) UpdateNewValues() *RateSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ratesnapshot.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(ratesnapshot.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(ratesnapshot.FieldKind)
+		}
+		if _, exists := u.create.mutation.Resolution(); exists {
+			s.SetIgnore(ratesnapshot.FieldResolution)
+		}
+		if _, exists := u.create.mutation.Token(); exists {
+			s.SetIgnore(ratesnapshot.FieldToken)
+		}
+		if _, exists := u.create.mutation.Open(); exists {
+			s.SetIgnore(ratesnapshot.FieldOpen)
+		}
+		if _, exists := u.create.mutation.High(); exists {
+			s.SetIgnore(ratesnapshot.FieldHigh)
+		}
+		if _, exists := u.create.mutation.Low(); exists {
+			s.SetIgnore(ratesnapshot.FieldLow)
+		}
+		if _, exists := u.create.mutation.Close(); exists {
+			s.SetIgnore(ratesnapshot.FieldClose)
+		}
+		if _, exists := u.create.mutation.SampleCount(); exists {
+			s.SetIgnore(ratesnapshot.FieldSampleCount)
+		}
+		if _, exists := u.create.mutation.RecordedAt(); exists {
+			s.SetIgnore(ratesnapshot.FieldRecordedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RateSnapshot.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RateSnapshotUpsertOne) Ignore() *RateSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RateSnapshotUpsertOne) DoNothing() *RateSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RateSnapshotCreate.OnConflict
+// documentation for more info.
+func (u *RateSnapshotUpsertOne) Update(set func(*RateSnapshotUpsert)) *RateSnapshotUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RateSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateSnapshotUpsertOne) SetUpdatedAt(v time.Time) *RateSnapshotUpsertOne {
+	return u.Update(func(s *RateSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateSnapshotUpsertOne) UpdateUpdatedAt() *RateSnapshotUpsertOne {
+	return u.Update(func(s *RateSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RateSnapshotUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RateSnapshotCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RateSnapshotUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RateSnapshotUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RateSnapshotUpsertOne.ID is not supported by MySQL driver. Use RateSnapshotUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RateSnapshotUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RateSnapshotCreateBulk is the builder for creating many RateSnapshot entities in bulk.
+type RateSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*RateSnapshotCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RateSnapshot entities in the database.
+func (rscb *RateSnapshotCreateBulk) Save(ctx context.Context) ([]*RateSnapshot, error) {
+	if rscb.err != nil {
+		return nil, rscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rscb.builders))
+	nodes := make([]*RateSnapshot, len(rscb.builders))
+	mutators := make([]Mutator, len(rscb.builders))
+	for i := range rscb.builders {
+		func(i int, root context.Context) {
+			builder := rscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rscb *RateSnapshotCreateBulk) SaveX(ctx context.Context) []*RateSnapshot {
+	v, err := rscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rscb *RateSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := rscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rscb *RateSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := rscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RateSnapshot.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RateSnapshotUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rscb *RateSnapshotCreateBulk) OnConflict(opts ...sql.ConflictOption) *RateSnapshotUpsertBulk {
+	rscb.conflict = opts
+	return &RateSnapshotUpsertBulk{
+		create: rscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RateSnapshot.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rscb *RateSnapshotCreateBulk) OnConflictColumns(columns ...string) *RateSnapshotUpsertBulk {
+	rscb.conflict = append(rscb.conflict, sql.ConflictColumns(columns...))
+	return &RateSnapshotUpsertBulk{
+		create: rscb,
+	}
+}
+
+// RateSnapshotUpsertBulk is the builder for "upsert"-ing
+// a bulk of RateSnapshot nodes.
+type RateSnapshotUpsertBulk struct {
+	create *RateSnapshotCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RateSnapshot.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ratesnapshot.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RateSnapshotUpsertBulk) UpdateNewValues() *RateSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ratesnapshot.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(ratesnapshot.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(ratesnapshot.FieldKind)
+			}
+			if _, exists := b.mutation.Resolution(); exists {
+				s.SetIgnore(ratesnapshot.FieldResolution)
+			}
+			if _, exists := b.mutation.Token(); exists {
+				s.SetIgnore(ratesnapshot.FieldToken)
+			}
+			if _, exists := b.mutation.Open(); exists {
+				s.SetIgnore(ratesnapshot.FieldOpen)
+			}
+			if _, exists := b.mutation.High(); exists {
+				s.SetIgnore(ratesnapshot.FieldHigh)
+			}
+			if _, exists := b.mutation.Low(); exists {
+				s.SetIgnore(ratesnapshot.FieldLow)
+			}
+			if _, exists := b.mutation.Close(); exists {
+				s.SetIgnore(ratesnapshot.FieldClose)
+			}
+			if _, exists := b.mutation.SampleCount(); exists {
+				s.SetIgnore(ratesnapshot.FieldSampleCount)
+			}
+			if _, exists := b.mutation.RecordedAt(); exists {
+				s.SetIgnore(ratesnapshot.FieldRecordedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RateSnapshot.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RateSnapshotUpsertBulk) Ignore() *RateSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RateSnapshotUpsertBulk) DoNothing() *RateSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RateSnapshotCreateBulk.OnConflict
+// documentation for more info.
+func (u *RateSnapshotUpsertBulk) Update(set func(*RateSnapshotUpsert)) *RateSnapshotUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RateSnapshotUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateSnapshotUpsertBulk) SetUpdatedAt(v time.Time) *RateSnapshotUpsertBulk {
+	return u.Update(func(s *RateSnapshotUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateSnapshotUpsertBulk) UpdateUpdatedAt() *RateSnapshotUpsertBulk {
+	return u.Update(func(s *RateSnapshotUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RateSnapshotUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RateSnapshotCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RateSnapshotCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RateSnapshotUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
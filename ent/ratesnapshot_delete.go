@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+)
+
+// RateSnapshotDelete is the builder for deleting a RateSnapshot entity.
+type RateSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *RateSnapshotMutation
+}
+
+// Where appends a list predicates to the RateSnapshotDelete builder.
+func (rsd *RateSnapshotDelete) Where(ps ...predicate.RateSnapshot) *RateSnapshotDelete {
+	rsd.mutation.Where(ps...)
+	return rsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsd *RateSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsd.sqlExec, rsd.mutation, rsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsd *RateSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := rsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsd *RateSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ratesnapshot.Table, sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID))
+	if ps := rsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsd.mutation.done = true
+	return affected, err
+}
+
+// RateSnapshotDeleteOne is the builder for deleting a single RateSnapshot entity.
+type RateSnapshotDeleteOne struct {
+	rsd *RateSnapshotDelete
+}
+
+// Where appends a list predicates to the RateSnapshotDelete builder.
+func (rsdo *RateSnapshotDeleteOne) Where(ps ...predicate.RateSnapshot) *RateSnapshotDeleteOne {
+	rsdo.rsd.mutation.Where(ps...)
+	return rsdo
+}
+
+// Exec executes the deletion query.
+func (rsdo *RateSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsdo.rsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratesnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsdo *RateSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := rsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
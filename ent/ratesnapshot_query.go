@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+)
+
+// RateSnapshotQuery is the builder for querying RateSnapshot entities.
+type RateSnapshotQuery struct {
+	config
+	ctx          *QueryContext
+	order        []ratesnapshot.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.RateSnapshot
+	withCurrency *FiatCurrencyQuery
+	withProvider *ProviderProfileQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RateSnapshotQuery builder.
+func (rsq *RateSnapshotQuery) Where(ps ...predicate.RateSnapshot) *RateSnapshotQuery {
+	rsq.predicates = append(rsq.predicates, ps...)
+	return rsq
+}
+
+// Limit the number of records to be returned by this query.
+func (rsq *RateSnapshotQuery) Limit(limit int) *RateSnapshotQuery {
+	rsq.ctx.Limit = &limit
+	return rsq
+}
+
+// Offset to start from.
+func (rsq *RateSnapshotQuery) Offset(offset int) *RateSnapshotQuery {
+	rsq.ctx.Offset = &offset
+	return rsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rsq *RateSnapshotQuery) Unique(unique bool) *RateSnapshotQuery {
+	rsq.ctx.Unique = &unique
+	return rsq
+}
+
+// Order specifies how the records should be ordered.
+func (rsq *RateSnapshotQuery) Order(o ...ratesnapshot.OrderOption) *RateSnapshotQuery {
+	rsq.order = append(rsq.order, o...)
+	return rsq
+}
+
+// QueryCurrency chains the current query on the "currency" edge.
+func (rsq *RateSnapshotQuery) QueryCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: rsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratesnapshot.Table, ratesnapshot.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratesnapshot.CurrencyTable, ratesnapshot.CurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProvider chains the current query on the "provider" edge.
+func (rsq *RateSnapshotQuery) QueryProvider() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: rsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratesnapshot.Table, ratesnapshot.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratesnapshot.ProviderTable, ratesnapshot.ProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RateSnapshot entity from the query.
+// Returns a *NotFoundError when no RateSnapshot was found.
+func (rsq *RateSnapshotQuery) First(ctx context.Context) (*RateSnapshot, error) {
+	nodes, err := rsq.Limit(1).All(setContextOp(ctx, rsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratesnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) FirstX(ctx context.Context) *RateSnapshot {
+	node, err := rsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RateSnapshot ID from the query.
+// Returns a *NotFoundError when no RateSnapshot ID was found.
+func (rsq *RateSnapshotQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rsq.Limit(1).IDs(setContextOp(ctx, rsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratesnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RateSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RateSnapshot entity is found.
+// Returns a *NotFoundError when no RateSnapshot entities are found.
+func (rsq *RateSnapshotQuery) Only(ctx context.Context) (*RateSnapshot, error) {
+	nodes, err := rsq.Limit(2).All(setContextOp(ctx, rsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratesnapshot.Label}
+	default:
+		return nil, &NotSingularError{ratesnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) OnlyX(ctx context.Context) *RateSnapshot {
+	node, err := rsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RateSnapshot ID in the query.
+// Returns a *NotSingularError when more than one RateSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rsq *RateSnapshotQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rsq.Limit(2).IDs(setContextOp(ctx, rsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratesnapshot.Label}
+	default:
+		err = &NotSingularError{ratesnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RateSnapshots.
+func (rsq *RateSnapshotQuery) All(ctx context.Context) ([]*RateSnapshot, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryAll)
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RateSnapshot, *RateSnapshotQuery]()
+	return withInterceptors[[]*RateSnapshot](ctx, rsq, qr, rsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) AllX(ctx context.Context) []*RateSnapshot {
+	nodes, err := rsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RateSnapshot IDs.
+func (rsq *RateSnapshotQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rsq.ctx.Unique == nil && rsq.path != nil {
+		rsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryIDs)
+	if err = rsq.Select(ratesnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rsq *RateSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryCount)
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rsq, querierCount[*RateSnapshotQuery](), rsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := rsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rsq *RateSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryExist)
+	switch _, err := rsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rsq *RateSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := rsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RateSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rsq *RateSnapshotQuery) Clone() *RateSnapshotQuery {
+	if rsq == nil {
+		return nil
+	}
+	return &RateSnapshotQuery{
+		config:       rsq.config,
+		ctx:          rsq.ctx.Clone(),
+		order:        append([]ratesnapshot.OrderOption{}, rsq.order...),
+		inters:       append([]Interceptor{}, rsq.inters...),
+		predicates:   append([]predicate.RateSnapshot{}, rsq.predicates...),
+		withCurrency: rsq.withCurrency.Clone(),
+		withProvider: rsq.withProvider.Clone(),
+		// clone intermediate query.
+		sql:  rsq.sql.Clone(),
+		path: rsq.path,
+	}
+}
+
+// WithCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsq *RateSnapshotQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *RateSnapshotQuery {
+	query := (&FiatCurrencyClient{config: rsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withCurrency = query
+	return rsq
+}
+
+// WithProvider tells the query-builder to eager-load the nodes that are connected to
+// the "provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsq *RateSnapshotQuery) WithProvider(opts ...func(*ProviderProfileQuery)) *RateSnapshotQuery {
+	query := (&ProviderProfileClient{config: rsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsq.withProvider = query
+	return rsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RateSnapshot.Query().
+//		GroupBy(ratesnapshot.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rsq *RateSnapshotQuery) GroupBy(field string, fields ...string) *RateSnapshotGroupBy {
+	rsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RateSnapshotGroupBy{build: rsq}
+	grbuild.flds = &rsq.ctx.Fields
+	grbuild.label = ratesnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RateSnapshot.Query().
+//		Select(ratesnapshot.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rsq *RateSnapshotQuery) Select(fields ...string) *RateSnapshotSelect {
+	rsq.ctx.Fields = append(rsq.ctx.Fields, fields...)
+	sbuild := &RateSnapshotSelect{RateSnapshotQuery: rsq}
+	sbuild.label = ratesnapshot.Label
+	sbuild.flds, sbuild.scan = &rsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RateSnapshotSelect configured with the given aggregations.
+func (rsq *RateSnapshotQuery) Aggregate(fns ...AggregateFunc) *RateSnapshotSelect {
+	return rsq.Select().Aggregate(fns...)
+}
+
+func (rsq *RateSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rsq.ctx.Fields {
+		if !ratesnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rsq.path != nil {
+		prev, err := rsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rsq.sql = prev
+	}
+	return nil
+}
+
+func (rsq *RateSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RateSnapshot, error) {
+	var (
+		nodes       = []*RateSnapshot{}
+		withFKs     = rsq.withFKs
+		_spec       = rsq.querySpec()
+		loadedTypes = [2]bool{
+			rsq.withCurrency != nil,
+			rsq.withProvider != nil,
+		}
+	)
+	if rsq.withCurrency != nil || rsq.withProvider != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ratesnapshot.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RateSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RateSnapshot{config: rsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rsq.withCurrency; query != nil {
+		if err := rsq.loadCurrency(ctx, query, nodes, nil,
+			func(n *RateSnapshot, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rsq.withProvider; query != nil {
+		if err := rsq.loadProvider(ctx, query, nodes, nil,
+			func(n *RateSnapshot, e *ProviderProfile) { n.Edges.Provider = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rsq *RateSnapshotQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*RateSnapshot, init func(*RateSnapshot), assign func(*RateSnapshot, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*RateSnapshot)
+	for i := range nodes {
+		if nodes[i].fiat_currency_rate_snapshots == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_rate_snapshots
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_rate_snapshots" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rsq *RateSnapshotQuery) loadProvider(ctx context.Context, query *ProviderProfileQuery, nodes []*RateSnapshot, init func(*RateSnapshot), assign func(*RateSnapshot, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*RateSnapshot)
+	for i := range nodes {
+		if nodes[i].provider_profile_rate_snapshots == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_rate_snapshots
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_rate_snapshots" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rsq *RateSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rsq.querySpec()
+	_spec.Node.Columns = rsq.ctx.Fields
+	if len(rsq.ctx.Fields) > 0 {
+		_spec.Unique = rsq.ctx.Unique != nil && *rsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rsq.driver, _spec)
+}
+
+func (rsq *RateSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ratesnapshot.Table, ratesnapshot.Columns, sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID))
+	_spec.From = rsq.sql
+	if unique := rsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratesnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != ratesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rsq *RateSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rsq.driver.Dialect())
+	t1 := builder.Table(ratesnapshot.Table)
+	columns := rsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ratesnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rsq.sql != nil {
+		selector = rsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rsq.ctx.Unique != nil && *rsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rsq.predicates {
+		p(selector)
+	}
+	for _, p := range rsq.order {
+		p(selector)
+	}
+	if offset := rsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RateSnapshotGroupBy is the group-by builder for RateSnapshot entities.
+type RateSnapshotGroupBy struct {
+	selector
+	build *RateSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rsgb *RateSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *RateSnapshotGroupBy {
+	rsgb.fns = append(rsgb.fns, fns...)
+	return rsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rsgb *RateSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RateSnapshotQuery, *RateSnapshotGroupBy](ctx, rsgb.build, rsgb, rsgb.build.inters, v)
+}
+
+func (rsgb *RateSnapshotGroupBy) sqlScan(ctx context.Context, root *RateSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rsgb.fns))
+	for _, fn := range rsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rsgb.flds)+len(rsgb.fns))
+		for _, f := range *rsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RateSnapshotSelect is the builder for selecting fields of RateSnapshot entities.
+type RateSnapshotSelect struct {
+	*RateSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rss *RateSnapshotSelect) Aggregate(fns ...AggregateFunc) *RateSnapshotSelect {
+	rss.fns = append(rss.fns, fns...)
+	return rss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rss *RateSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rss.ctx, ent.OpQuerySelect)
+	if err := rss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RateSnapshotQuery, *RateSnapshotSelect](ctx, rss.RateSnapshotQuery, rss, rss.inters, v)
+}
+
+func (rss *RateSnapshotSelect) sqlScan(ctx context.Context, root *RateSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rss.fns))
+	for _, fn := range rss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
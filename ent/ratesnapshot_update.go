@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+)
+
+// RateSnapshotUpdate is the builder for updating RateSnapshot entities.
+type RateSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateSnapshotMutation
+}
+
+// Where appends a list predicates to the RateSnapshotUpdate builder.
+func (rsu *RateSnapshotUpdate) Where(ps ...predicate.RateSnapshot) *RateSnapshotUpdate {
+	rsu.mutation.Where(ps...)
+	return rsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsu *RateSnapshotUpdate) SetUpdatedAt(t time.Time) *RateSnapshotUpdate {
+	rsu.mutation.SetUpdatedAt(t)
+	return rsu
+}
+
+// Mutation returns the RateSnapshotMutation object of the builder.
+func (rsu *RateSnapshotUpdate) Mutation() *RateSnapshotMutation {
+	return rsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsu *RateSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	rsu.defaults()
+	return withHooks(ctx, rsu.sqlSave, rsu.mutation, rsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *RateSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *RateSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *RateSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsu *RateSnapshotUpdate) defaults() {
+	if _, ok := rsu.mutation.UpdatedAt(); !ok {
+		v := ratesnapshot.UpdateDefaultUpdatedAt()
+		rsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsu *RateSnapshotUpdate) check() error {
+	if rsu.mutation.CurrencyCleared() && len(rsu.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RateSnapshot.currency"`)
+	}
+	return nil
+}
+
+func (rsu *RateSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratesnapshot.Table, ratesnapshot.Columns, sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID))
+	if ps := rsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratesnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rsu.mutation.TokenCleared() {
+		_spec.ClearField(ratesnapshot.FieldToken, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsu.mutation.done = true
+	return n, nil
+}
+
+// RateSnapshotUpdateOne is the builder for updating a single RateSnapshot entity.
+type RateSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateSnapshotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsuo *RateSnapshotUpdateOne) SetUpdatedAt(t time.Time) *RateSnapshotUpdateOne {
+	rsuo.mutation.SetUpdatedAt(t)
+	return rsuo
+}
+
+// Mutation returns the RateSnapshotMutation object of the builder.
+func (rsuo *RateSnapshotUpdateOne) Mutation() *RateSnapshotMutation {
+	return rsuo.mutation
+}
+
+// Where appends a list predicates to the RateSnapshotUpdate builder.
+func (rsuo *RateSnapshotUpdateOne) Where(ps ...predicate.RateSnapshot) *RateSnapshotUpdateOne {
+	rsuo.mutation.Where(ps...)
+	return rsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsuo *RateSnapshotUpdateOne) Select(field string, fields ...string) *RateSnapshotUpdateOne {
+	rsuo.fields = append([]string{field}, fields...)
+	return rsuo
+}
+
+// Save executes the query and returns the updated RateSnapshot entity.
+func (rsuo *RateSnapshotUpdateOne) Save(ctx context.Context) (*RateSnapshot, error) {
+	rsuo.defaults()
+	return withHooks(ctx, rsuo.sqlSave, rsuo.mutation, rsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *RateSnapshotUpdateOne) SaveX(ctx context.Context) *RateSnapshot {
+	node, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsuo *RateSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *RateSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsuo *RateSnapshotUpdateOne) defaults() {
+	if _, ok := rsuo.mutation.UpdatedAt(); !ok {
+		v := ratesnapshot.UpdateDefaultUpdatedAt()
+		rsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsuo *RateSnapshotUpdateOne) check() error {
+	if rsuo.mutation.CurrencyCleared() && len(rsuo.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RateSnapshot.currency"`)
+	}
+	return nil
+}
+
+func (rsuo *RateSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *RateSnapshot, err error) {
+	if err := rsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ratesnapshot.Table, ratesnapshot.Columns, sqlgraph.NewFieldSpec(ratesnapshot.FieldID, field.TypeUUID))
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratesnapshot.FieldID)
+		for _, f := range fields {
+			if !ratesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratesnapshot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rsuo.mutation.TokenCleared() {
+		_spec.ClearField(ratesnapshot.FieldToken, field.TypeString)
+	}
+	_node = &RateSnapshot{config: rsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsuo.mutation.done = true
+	return _node, nil
+}
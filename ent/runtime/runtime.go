@@ -7,6 +7,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/apikey"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/identityverificationrequest"
 	"github.com/paycrest/aggregator/ent/institution"
@@ -14,16 +18,26 @@ import (
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/network"
+	"github.com/paycrest/aggregator/ent/orderassignment"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/schema"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/token"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/ent/verificationtoken"
@@ -44,6 +58,86 @@ func init() {
 	apikeyDescID := apikeyFields[0].Descriptor()
 	// apikey.DefaultID holds the default value on creation for the id field.
 	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	bucketproposalMixin := schema.BucketProposal{}.Mixin()
+	bucketproposalMixinFields0 := bucketproposalMixin[0].Fields()
+	_ = bucketproposalMixinFields0
+	bucketproposalFields := schema.BucketProposal{}.Fields()
+	_ = bucketproposalFields
+	// bucketproposalDescCreatedAt is the schema descriptor for created_at field.
+	bucketproposalDescCreatedAt := bucketproposalMixinFields0[0].Descriptor()
+	// bucketproposal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bucketproposal.DefaultCreatedAt = bucketproposalDescCreatedAt.Default.(func() time.Time)
+	// bucketproposalDescUpdatedAt is the schema descriptor for updated_at field.
+	bucketproposalDescUpdatedAt := bucketproposalMixinFields0[1].Descriptor()
+	// bucketproposal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bucketproposal.DefaultUpdatedAt = bucketproposalDescUpdatedAt.Default.(func() time.Time)
+	// bucketproposal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bucketproposal.UpdateDefaultUpdatedAt = bucketproposalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bucketproposalDescID is the schema descriptor for id field.
+	bucketproposalDescID := bucketproposalFields[0].Descriptor()
+	// bucketproposal.DefaultID holds the default value on creation for the id field.
+	bucketproposal.DefaultID = bucketproposalDescID.Default.(func() uuid.UUID)
+	deadletterorderMixin := schema.DeadLetterOrder{}.Mixin()
+	deadletterorderMixinFields0 := deadletterorderMixin[0].Fields()
+	_ = deadletterorderMixinFields0
+	deadletterorderFields := schema.DeadLetterOrder{}.Fields()
+	_ = deadletterorderFields
+	// deadletterorderDescCreatedAt is the schema descriptor for created_at field.
+	deadletterorderDescCreatedAt := deadletterorderMixinFields0[0].Descriptor()
+	// deadletterorder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deadletterorder.DefaultCreatedAt = deadletterorderDescCreatedAt.Default.(func() time.Time)
+	// deadletterorderDescUpdatedAt is the schema descriptor for updated_at field.
+	deadletterorderDescUpdatedAt := deadletterorderMixinFields0[1].Descriptor()
+	// deadletterorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deadletterorder.DefaultUpdatedAt = deadletterorderDescUpdatedAt.Default.(func() time.Time)
+	// deadletterorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deadletterorder.UpdateDefaultUpdatedAt = deadletterorderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deadletterorderDescAttempts is the schema descriptor for attempts field.
+	deadletterorderDescAttempts := deadletterorderFields[3].Descriptor()
+	// deadletterorder.DefaultAttempts holds the default value on creation for the attempts field.
+	deadletterorder.DefaultAttempts = deadletterorderDescAttempts.Default.(int)
+	// deadletterorderDescID is the schema descriptor for id field.
+	deadletterorderDescID := deadletterorderFields[0].Descriptor()
+	// deadletterorder.DefaultID holds the default value on creation for the id field.
+	deadletterorder.DefaultID = deadletterorderDescID.Default.(func() uuid.UUID)
+	disputeMixin := schema.Dispute{}.Mixin()
+	disputeMixinFields0 := disputeMixin[0].Fields()
+	_ = disputeMixinFields0
+	disputeFields := schema.Dispute{}.Fields()
+	_ = disputeFields
+	// disputeDescCreatedAt is the schema descriptor for created_at field.
+	disputeDescCreatedAt := disputeMixinFields0[0].Descriptor()
+	// dispute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dispute.DefaultCreatedAt = disputeDescCreatedAt.Default.(func() time.Time)
+	// disputeDescUpdatedAt is the schema descriptor for updated_at field.
+	disputeDescUpdatedAt := disputeMixinFields0[1].Descriptor()
+	// dispute.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dispute.DefaultUpdatedAt = disputeDescUpdatedAt.Default.(func() time.Time)
+	// dispute.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dispute.UpdateDefaultUpdatedAt = disputeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// disputeDescID is the schema descriptor for id field.
+	disputeDescID := disputeFields[0].Descriptor()
+	// dispute.DefaultID holds the default value on creation for the id field.
+	dispute.DefaultID = disputeDescID.Default.(func() uuid.UUID)
+	disputeevidenceMixin := schema.DisputeEvidence{}.Mixin()
+	disputeevidenceMixinFields0 := disputeevidenceMixin[0].Fields()
+	_ = disputeevidenceMixinFields0
+	disputeevidenceFields := schema.DisputeEvidence{}.Fields()
+	_ = disputeevidenceFields
+	// disputeevidenceDescCreatedAt is the schema descriptor for created_at field.
+	disputeevidenceDescCreatedAt := disputeevidenceMixinFields0[0].Descriptor()
+	// disputeevidence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	disputeevidence.DefaultCreatedAt = disputeevidenceDescCreatedAt.Default.(func() time.Time)
+	// disputeevidenceDescUpdatedAt is the schema descriptor for updated_at field.
+	disputeevidenceDescUpdatedAt := disputeevidenceMixinFields0[1].Descriptor()
+	// disputeevidence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	disputeevidence.DefaultUpdatedAt = disputeevidenceDescUpdatedAt.Default.(func() time.Time)
+	// disputeevidence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	disputeevidence.UpdateDefaultUpdatedAt = disputeevidenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// disputeevidenceDescID is the schema descriptor for id field.
+	disputeevidenceDescID := disputeevidenceFields[0].Descriptor()
+	// disputeevidence.DefaultID holds the default value on creation for the id field.
+	disputeevidence.DefaultID = disputeevidenceDescID.Default.(func() uuid.UUID)
 	fiatcurrencyMixin := schema.FiatCurrency{}.Mixin()
 	fiatcurrencyMixinFields0 := fiatcurrencyMixin[0].Fields()
 	_ = fiatcurrencyMixinFields0
@@ -194,6 +288,25 @@ func init() {
 	networkDescGatewayContractAddress := networkFields[4].Descriptor()
 	// network.DefaultGatewayContractAddress holds the default value on creation for the gateway_contract_address field.
 	network.DefaultGatewayContractAddress = networkDescGatewayContractAddress.Default.(string)
+	orderassignmentMixin := schema.OrderAssignment{}.Mixin()
+	orderassignmentMixinFields0 := orderassignmentMixin[0].Fields()
+	_ = orderassignmentMixinFields0
+	orderassignmentFields := schema.OrderAssignment{}.Fields()
+	_ = orderassignmentFields
+	// orderassignmentDescCreatedAt is the schema descriptor for created_at field.
+	orderassignmentDescCreatedAt := orderassignmentMixinFields0[0].Descriptor()
+	// orderassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderassignment.DefaultCreatedAt = orderassignmentDescCreatedAt.Default.(func() time.Time)
+	// orderassignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	orderassignmentDescUpdatedAt := orderassignmentMixinFields0[1].Descriptor()
+	// orderassignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	orderassignment.DefaultUpdatedAt = orderassignmentDescUpdatedAt.Default.(func() time.Time)
+	// orderassignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	orderassignment.UpdateDefaultUpdatedAt = orderassignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderassignmentDescID is the schema descriptor for id field.
+	orderassignmentDescID := orderassignmentFields[0].Descriptor()
+	// orderassignment.DefaultID holds the default value on creation for the id field.
+	orderassignment.DefaultID = orderassignmentDescID.Default.(func() uuid.UUID)
 	paymentorderMixin := schema.PaymentOrder{}.Mixin()
 	paymentorderMixinFields0 := paymentorderMixin[0].Fields()
 	_ = paymentorderMixinFields0
@@ -245,6 +358,16 @@ func init() {
 	paymentorderDescID := paymentorderFields[0].Descriptor()
 	// paymentorder.DefaultID holds the default value on creation for the id field.
 	paymentorder.DefaultID = paymentorderDescID.Default.(func() uuid.UUID)
+	providerhealthcheckFields := schema.ProviderHealthCheck{}.Fields()
+	_ = providerhealthcheckFields
+	// providerhealthcheckDescCreatedAt is the schema descriptor for created_at field.
+	providerhealthcheckDescCreatedAt := providerhealthcheckFields[5].Descriptor()
+	// providerhealthcheck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	providerhealthcheck.DefaultCreatedAt = providerhealthcheckDescCreatedAt.Default.(func() time.Time)
+	// providerhealthcheckDescID is the schema descriptor for id field.
+	providerhealthcheckDescID := providerhealthcheckFields[0].Descriptor()
+	// providerhealthcheck.DefaultID holds the default value on creation for the id field.
+	providerhealthcheck.DefaultID = providerhealthcheckDescID.Default.(func() uuid.UUID)
 	providerordertokenMixin := schema.ProviderOrderToken{}.Mixin()
 	providerordertokenMixinFields0 := providerordertokenMixin[0].Fields()
 	_ = providerordertokenMixinFields0
@@ -284,6 +407,22 @@ func init() {
 	providerprofileDescIsKybVerified := providerprofileFields[15].Descriptor()
 	// providerprofile.DefaultIsKybVerified holds the default value on creation for the is_kyb_verified field.
 	providerprofile.DefaultIsKybVerified = providerprofileDescIsKybVerified.Default.(bool)
+	// providerprofileDescIsOpen is the schema descriptor for is_open field.
+	providerprofileDescIsOpen := providerprofileFields[19].Descriptor()
+	// providerprofile.DefaultIsOpen holds the default value on creation for the is_open field.
+	providerprofile.DefaultIsOpen = providerprofileDescIsOpen.Default.(bool)
+	// providerprofileDescIsHealthy is the schema descriptor for is_healthy field.
+	providerprofileDescIsHealthy := providerprofileFields[22].Descriptor()
+	// providerprofile.DefaultIsHealthy holds the default value on creation for the is_healthy field.
+	providerprofile.DefaultIsHealthy = providerprofileDescIsHealthy.Default.(bool)
+	// providerprofileDescHealthFailureStreak is the schema descriptor for health_failure_streak field.
+	providerprofileDescHealthFailureStreak := providerprofileFields[23].Descriptor()
+	// providerprofile.DefaultHealthFailureStreak holds the default value on creation for the health_failure_streak field.
+	providerprofile.DefaultHealthFailureStreak = providerprofileDescHealthFailureStreak.Default.(int)
+	// providerprofileDescNodeProtocolVersion is the schema descriptor for node_protocol_version field.
+	providerprofileDescNodeProtocolVersion := providerprofileFields[25].Descriptor()
+	// providerprofile.DefaultNodeProtocolVersion holds the default value on creation for the node_protocol_version field.
+	providerprofile.DefaultNodeProtocolVersion = providerprofileDescNodeProtocolVersion.Default.(int)
 	// providerprofileDescID is the schema descriptor for id field.
 	providerprofileDescID := providerprofileFields[0].Descriptor()
 	// providerprofile.DefaultID holds the default value on creation for the id field.
@@ -303,12 +442,91 @@ func init() {
 	providerrating.DefaultUpdatedAt = providerratingDescUpdatedAt.Default.(func() time.Time)
 	// providerrating.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	providerrating.UpdateDefaultUpdatedAt = providerratingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	providerslarecordFields := schema.ProviderSLARecord{}.Fields()
+	_ = providerslarecordFields
+	// providerslarecordDescAssignedAt is the schema descriptor for assigned_at field.
+	providerslarecordDescAssignedAt := providerslarecordFields[1].Descriptor()
+	// providerslarecord.DefaultAssignedAt holds the default value on creation for the assigned_at field.
+	providerslarecord.DefaultAssignedAt = providerslarecordDescAssignedAt.Default.(func() time.Time)
+	// providerslarecordDescID is the schema descriptor for id field.
+	providerslarecordDescID := providerslarecordFields[0].Descriptor()
+	// providerslarecord.DefaultID holds the default value on creation for the id field.
+	providerslarecord.DefaultID = providerslarecordDescID.Default.(func() uuid.UUID)
 	provisionbucketFields := schema.ProvisionBucket{}.Fields()
 	_ = provisionbucketFields
 	// provisionbucketDescCreatedAt is the schema descriptor for created_at field.
 	provisionbucketDescCreatedAt := provisionbucketFields[2].Descriptor()
 	// provisionbucket.DefaultCreatedAt holds the default value on creation for the created_at field.
 	provisionbucket.DefaultCreatedAt = provisionbucketDescCreatedAt.Default.(func() time.Time)
+	publicholidayMixin := schema.PublicHoliday{}.Mixin()
+	publicholidayMixinFields0 := publicholidayMixin[0].Fields()
+	_ = publicholidayMixinFields0
+	publicholidayFields := schema.PublicHoliday{}.Fields()
+	_ = publicholidayFields
+	// publicholidayDescCreatedAt is the schema descriptor for created_at field.
+	publicholidayDescCreatedAt := publicholidayMixinFields0[0].Descriptor()
+	// publicholiday.DefaultCreatedAt holds the default value on creation for the created_at field.
+	publicholiday.DefaultCreatedAt = publicholidayDescCreatedAt.Default.(func() time.Time)
+	// publicholidayDescUpdatedAt is the schema descriptor for updated_at field.
+	publicholidayDescUpdatedAt := publicholidayMixinFields0[1].Descriptor()
+	// publicholiday.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	publicholiday.DefaultUpdatedAt = publicholidayDescUpdatedAt.Default.(func() time.Time)
+	// publicholiday.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	publicholiday.UpdateDefaultUpdatedAt = publicholidayDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ratecircuitbreakerMixin := schema.RateCircuitBreaker{}.Mixin()
+	ratecircuitbreakerMixinFields0 := ratecircuitbreakerMixin[0].Fields()
+	_ = ratecircuitbreakerMixinFields0
+	ratecircuitbreakerFields := schema.RateCircuitBreaker{}.Fields()
+	_ = ratecircuitbreakerFields
+	// ratecircuitbreakerDescCreatedAt is the schema descriptor for created_at field.
+	ratecircuitbreakerDescCreatedAt := ratecircuitbreakerMixinFields0[0].Descriptor()
+	// ratecircuitbreaker.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ratecircuitbreaker.DefaultCreatedAt = ratecircuitbreakerDescCreatedAt.Default.(func() time.Time)
+	// ratecircuitbreakerDescUpdatedAt is the schema descriptor for updated_at field.
+	ratecircuitbreakerDescUpdatedAt := ratecircuitbreakerMixinFields0[1].Descriptor()
+	// ratecircuitbreaker.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ratecircuitbreaker.DefaultUpdatedAt = ratecircuitbreakerDescUpdatedAt.Default.(func() time.Time)
+	// ratecircuitbreaker.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ratecircuitbreaker.UpdateDefaultUpdatedAt = ratecircuitbreakerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ratecircuitbreakerDescChangeWindow is the schema descriptor for change_window field.
+	ratecircuitbreakerDescChangeWindow := ratecircuitbreakerFields[2].Descriptor()
+	// ratecircuitbreaker.DefaultChangeWindow holds the default value on creation for the change_window field.
+	ratecircuitbreaker.DefaultChangeWindow = ratecircuitbreakerDescChangeWindow.Default.(int)
+	// ratecircuitbreakerDescAutoResetAfter is the schema descriptor for auto_reset_after field.
+	ratecircuitbreakerDescAutoResetAfter := ratecircuitbreakerFields[4].Descriptor()
+	// ratecircuitbreaker.DefaultAutoResetAfter holds the default value on creation for the auto_reset_after field.
+	ratecircuitbreaker.DefaultAutoResetAfter = ratecircuitbreakerDescAutoResetAfter.Default.(int)
+	// ratecircuitbreakerDescTripped is the schema descriptor for tripped field.
+	ratecircuitbreakerDescTripped := ratecircuitbreakerFields[5].Descriptor()
+	// ratecircuitbreaker.DefaultTripped holds the default value on creation for the tripped field.
+	ratecircuitbreaker.DefaultTripped = ratecircuitbreakerDescTripped.Default.(bool)
+	// ratecircuitbreakerDescID is the schema descriptor for id field.
+	ratecircuitbreakerDescID := ratecircuitbreakerFields[0].Descriptor()
+	// ratecircuitbreaker.DefaultID holds the default value on creation for the id field.
+	ratecircuitbreaker.DefaultID = ratecircuitbreakerDescID.Default.(func() uuid.UUID)
+	ratesnapshotMixin := schema.RateSnapshot{}.Mixin()
+	ratesnapshotMixinFields0 := ratesnapshotMixin[0].Fields()
+	_ = ratesnapshotMixinFields0
+	ratesnapshotFields := schema.RateSnapshot{}.Fields()
+	_ = ratesnapshotFields
+	// ratesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	ratesnapshotDescCreatedAt := ratesnapshotMixinFields0[0].Descriptor()
+	// ratesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ratesnapshot.DefaultCreatedAt = ratesnapshotDescCreatedAt.Default.(func() time.Time)
+	// ratesnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	ratesnapshotDescUpdatedAt := ratesnapshotMixinFields0[1].Descriptor()
+	// ratesnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ratesnapshot.DefaultUpdatedAt = ratesnapshotDescUpdatedAt.Default.(func() time.Time)
+	// ratesnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ratesnapshot.UpdateDefaultUpdatedAt = ratesnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ratesnapshotDescSampleCount is the schema descriptor for sample_count field.
+	ratesnapshotDescSampleCount := ratesnapshotFields[8].Descriptor()
+	// ratesnapshot.DefaultSampleCount holds the default value on creation for the sample_count field.
+	ratesnapshot.DefaultSampleCount = ratesnapshotDescSampleCount.Default.(int)
+	// ratesnapshotDescID is the schema descriptor for id field.
+	ratesnapshotDescID := ratesnapshotFields[0].Descriptor()
+	// ratesnapshot.DefaultID holds the default value on creation for the id field.
+	ratesnapshot.DefaultID = ratesnapshotDescID.Default.(func() uuid.UUID)
 	receiveaddressMixin := schema.ReceiveAddress{}.Mixin()
 	receiveaddressMixinFields0 := receiveaddressMixin[0].Fields()
 	_ = receiveaddressMixinFields0
@@ -375,6 +593,58 @@ func init() {
 	senderprofileDescID := senderprofileFields[0].Descriptor()
 	// senderprofile.DefaultID holds the default value on creation for the id field.
 	senderprofile.DefaultID = senderprofileDescID.Default.(func() uuid.UUID)
+	teamauditlogFields := schema.TeamAuditLog{}.Fields()
+	_ = teamauditlogFields
+	// teamauditlogDescCreatedAt is the schema descriptor for created_at field.
+	teamauditlogDescCreatedAt := teamauditlogFields[5].Descriptor()
+	// teamauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teamauditlog.DefaultCreatedAt = teamauditlogDescCreatedAt.Default.(func() time.Time)
+	// teamauditlogDescID is the schema descriptor for id field.
+	teamauditlogDescID := teamauditlogFields[0].Descriptor()
+	// teamauditlog.DefaultID holds the default value on creation for the id field.
+	teamauditlog.DefaultID = teamauditlogDescID.Default.(func() uuid.UUID)
+	teaminvitationMixin := schema.TeamInvitation{}.Mixin()
+	teaminvitationMixinFields0 := teaminvitationMixin[0].Fields()
+	_ = teaminvitationMixinFields0
+	teaminvitationFields := schema.TeamInvitation{}.Fields()
+	_ = teaminvitationFields
+	// teaminvitationDescCreatedAt is the schema descriptor for created_at field.
+	teaminvitationDescCreatedAt := teaminvitationMixinFields0[0].Descriptor()
+	// teaminvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teaminvitation.DefaultCreatedAt = teaminvitationDescCreatedAt.Default.(func() time.Time)
+	// teaminvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	teaminvitationDescUpdatedAt := teaminvitationMixinFields0[1].Descriptor()
+	// teaminvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teaminvitation.DefaultUpdatedAt = teaminvitationDescUpdatedAt.Default.(func() time.Time)
+	// teaminvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teaminvitation.UpdateDefaultUpdatedAt = teaminvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teaminvitationDescToken is the schema descriptor for token field.
+	teaminvitationDescToken := teaminvitationFields[3].Descriptor()
+	// teaminvitation.DefaultToken holds the default value on creation for the token field.
+	teaminvitation.DefaultToken = teaminvitationDescToken.Default.(func() string)
+	// teaminvitationDescID is the schema descriptor for id field.
+	teaminvitationDescID := teaminvitationFields[0].Descriptor()
+	// teaminvitation.DefaultID holds the default value on creation for the id field.
+	teaminvitation.DefaultID = teaminvitationDescID.Default.(func() uuid.UUID)
+	teammemberMixin := schema.TeamMember{}.Mixin()
+	teammemberMixinFields0 := teammemberMixin[0].Fields()
+	_ = teammemberMixinFields0
+	teammemberFields := schema.TeamMember{}.Fields()
+	_ = teammemberFields
+	// teammemberDescCreatedAt is the schema descriptor for created_at field.
+	teammemberDescCreatedAt := teammemberMixinFields0[0].Descriptor()
+	// teammember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	teammember.DefaultCreatedAt = teammemberDescCreatedAt.Default.(func() time.Time)
+	// teammemberDescUpdatedAt is the schema descriptor for updated_at field.
+	teammemberDescUpdatedAt := teammemberMixinFields0[1].Descriptor()
+	// teammember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	teammember.DefaultUpdatedAt = teammemberDescUpdatedAt.Default.(func() time.Time)
+	// teammember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	teammember.UpdateDefaultUpdatedAt = teammemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// teammemberDescID is the schema descriptor for id field.
+	teammemberDescID := teammemberFields[0].Descriptor()
+	// teammember.DefaultID holds the default value on creation for the id field.
+	teammember.DefaultID = teammemberDescID.Default.(func() uuid.UUID)
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinFields0 := tokenMixin[0].Fields()
 	_ = tokenMixinFields0
@@ -402,6 +672,25 @@ func init() {
 	tokenDescIsEnabled := tokenFields[3].Descriptor()
 	// token.DefaultIsEnabled holds the default value on creation for the is_enabled field.
 	token.DefaultIsEnabled = tokenDescIsEnabled.Default.(bool)
+	tokenmarketrateMixin := schema.TokenMarketRate{}.Mixin()
+	tokenmarketrateMixinFields0 := tokenmarketrateMixin[0].Fields()
+	_ = tokenmarketrateMixinFields0
+	tokenmarketrateFields := schema.TokenMarketRate{}.Fields()
+	_ = tokenmarketrateFields
+	// tokenmarketrateDescCreatedAt is the schema descriptor for created_at field.
+	tokenmarketrateDescCreatedAt := tokenmarketrateMixinFields0[0].Descriptor()
+	// tokenmarketrate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tokenmarketrate.DefaultCreatedAt = tokenmarketrateDescCreatedAt.Default.(func() time.Time)
+	// tokenmarketrateDescUpdatedAt is the schema descriptor for updated_at field.
+	tokenmarketrateDescUpdatedAt := tokenmarketrateMixinFields0[1].Descriptor()
+	// tokenmarketrate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tokenmarketrate.DefaultUpdatedAt = tokenmarketrateDescUpdatedAt.Default.(func() time.Time)
+	// tokenmarketrate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tokenmarketrate.UpdateDefaultUpdatedAt = tokenmarketrateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tokenmarketrateDescID is the schema descriptor for id field.
+	tokenmarketrateDescID := tokenmarketrateFields[0].Descriptor()
+	// tokenmarketrate.DefaultID holds the default value on creation for the id field.
+	tokenmarketrate.DefaultID = tokenmarketrateDescID.Default.(func() uuid.UUID)
 	transactionlogFields := schema.TransactionLog{}.Fields()
 	_ = transactionlogFields
 	// transactionlogDescCreatedAt is the schema descriptor for created_at field.
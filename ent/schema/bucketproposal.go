@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BucketProposal holds the schema definition for the BucketProposal entity.
+// A proposal is a set of bucket boundaries for a currency derived from the distribution of its recent order amounts.
+type BucketProposal struct {
+	ent.Schema
+}
+
+// Mixin of the BucketProposal.
+func (BucketProposal) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the BucketProposal.
+func (BucketProposal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Time("window_start").
+			Immutable(),
+		field.Time("window_end").
+			Immutable(),
+		field.Int("order_count").
+			Immutable(),
+		field.JSON("buckets", []struct {
+			MinAmount  decimal.Decimal `json:"minAmount"`
+			MaxAmount  decimal.Decimal `json:"maxAmount"`
+			OrderCount int             `json:"orderCount"`
+		}{}).
+			Immutable(),
+		// Buckets of the currency when the proposal was made, with the orders that fell in each
+		field.JSON("current_buckets", []struct {
+			ID         int             `json:"id"`
+			MinAmount  decimal.Decimal `json:"minAmount"`
+			MaxAmount  decimal.Decimal `json:"maxAmount"`
+			OrderCount int             `json:"orderCount"`
+		}{}).
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "applied", "dismissed", "superseded").
+			Default("pending"),
+		field.Time("applied_at").
+			Optional(),
+	}
+}
+
+// Edges of the BucketProposal.
+func (BucketProposal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("currency", FiatCurrency.Type).
+			Ref("bucket_proposals").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the BucketProposal.
+func (BucketProposal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status").
+			Edges("currency"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DeadLetterOrder holds the schema definition for the DeadLetterOrder entity.
+type DeadLetterOrder struct {
+	ent.Schema
+}
+
+// Mixin of the DeadLetterOrder.
+func (DeadLetterOrder) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the DeadLetterOrder.
+func (DeadLetterOrder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// Why the last attempt to match the order failed
+		field.Enum("reason").
+			Values("no_provider_for_token", "rate_out_of_range", "all_excluded", "no_eligible_provider", "matching_paused"),
+		field.Enum("status").
+			Values("pending", "resolved", "refunded").
+			Default("pending"),
+		field.Int("attempts").
+			Default(1),
+		field.Time("next_retry_at"),
+		// The order is cancelled for a refund if it is still unmatched by then
+		field.Time("refund_deadline").
+			Immutable(),
+		field.Time("resolved_at").
+			Optional(),
+	}
+}
+
+// Edges of the DeadLetterOrder.
+func (DeadLetterOrder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lock_payment_order", LockPaymentOrder.Type).
+			Ref("dead_letter").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the DeadLetterOrder.
+func (DeadLetterOrder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_retry_at"),
+	}
+}
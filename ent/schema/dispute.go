@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Dispute holds the schema definition for the Dispute entity.
+type Dispute struct {
+	ent.Schema
+}
+
+// Mixin of the Dispute.
+func (Dispute) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Dispute.
+func (Dispute) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Text("reason"),
+		field.Enum("status").
+			Values("open", "under_review", "resolved", "withdrawn").
+			Default("open"),
+		field.Time("response_deadline"),
+		field.Time("resolution_deadline"),
+		field.Enum("resolution").
+			Values("sender_favored", "provider_favored").
+			Optional(),
+		field.Text("resolution_note").
+			Optional(),
+		field.Float("trust_score_adjustment").
+			GoType(decimal.Decimal{}),
+		field.Float("compensation_amount").
+			GoType(decimal.Decimal{}),
+		field.Enum("compensation_status").
+			Values("none", "pending", "paid").
+			Default("none"),
+		field.Time("resolved_at").
+			Optional(),
+	}
+}
+
+// Edges of the Dispute.
+func (Dispute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment_order", PaymentOrder.Type).
+			Ref("disputes").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("lock_payment_order", LockPaymentOrder.Type).
+			Ref("disputes").
+			Unique().
+			Immutable(),
+		edge.From("provider", ProviderProfile.Type).
+			Ref("disputes").
+			Unique().
+			Immutable(),
+		edge.To("evidence", DisputeEvidence.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Dispute.
+func (Dispute) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "response_deadline"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DisputeEvidence holds the schema definition for the DisputeEvidence entity.
+type DisputeEvidence struct {
+	ent.Schema
+}
+
+// Mixin of the DisputeEvidence.
+func (DisputeEvidence) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the DisputeEvidence.
+func (DisputeEvidence) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("submitted_by").
+			Values("sender", "provider", "admin").
+			Immutable(),
+		field.Enum("type").
+			Values("psp_reference", "receipt", "bank_statement", "other").
+			Immutable(),
+		field.String("reference").
+			Optional().
+			Immutable(),
+		field.String("url").
+			Optional().
+			Immutable(),
+		field.Text("note").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the DisputeEvidence.
+func (DisputeEvidence) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("dispute", Dispute.Type).
+			Ref("evidence").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
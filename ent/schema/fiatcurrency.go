@@ -31,9 +31,22 @@ func (FiatCurrency) Fields() []ent.Field {
 		field.Int("decimals").Default(2),
 		field.String("symbol"),
 		field.String("name"),
+		// Market rate of USDT in the currency, used for tokens without a market rate of their own
 		field.Float("market_rate").
 			GoType(decimal.Decimal{}),
 		field.Bool("is_enabled").Default(false),
+		// Whether fulfillments must be confirmed through the PSP before settlement
+		field.Enum("fulfillment_validation").
+			Values("provider", "psp_optional", "psp_required").
+			Default("provider"),
+		// Matching strategy of the currency's buckets, unless set on the bucket
+		field.Enum("matching_strategy").
+			Values("price_time", "round_robin", "trust_weighted", "lowest_latency").
+			Optional(),
+		// Whether order requests are sent to one provider at a time or to several at once
+		field.Enum("order_request_mode").
+			Values("sequential", "broadcast").
+			Default("sequential"),
 	}
 }
 
@@ -45,5 +58,18 @@ func (FiatCurrency) Edges() []ent.Edge {
 		edge.To("provision_buckets", ProvisionBucket.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("institutions", Institution.Type),
+		edge.To("provider_order_tokens", ProviderOrderToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("public_holidays", PublicHoliday.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("bucket_proposals", BucketProposal.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("rate_snapshots", RateSnapshot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("rate_circuit_breaker", RateCircuitBreaker.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("token_market_rates", TokenMarketRate.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
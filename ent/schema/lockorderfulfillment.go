@@ -33,6 +33,24 @@ func (LockOrderFulfillment) Fields() []ent.Field {
 			Default("pending"),
 		field.String("validation_error").
 			Optional(),
+
+		// Proof of the fulfillment attached by the provider
+		field.String("psp_session_id").
+			Optional(),
+		field.String("bank_reference").
+			Optional(),
+		field.Time("transferred_at").
+			Optional(),
+		field.String("receipt_key").
+			Optional(),
+		field.String("receipt_content_type").
+			Optional(),
+		field.JSON("recipient_confirmation", map[string]interface{}{}).
+			Optional(),
+
+		// Set when the aggregator confirmed the transfer through the PSP itself
+		field.Time("psp_confirmed_at").
+			Optional(),
 	}
 }
 
@@ -50,6 +50,9 @@ func (LockPaymentOrder) Fields() []ent.Field {
 			Default(0),
 		field.Strings("cancellation_reasons").
 			Default([]string{}),
+		// Plan the order was split by, recorded on each of its chunks
+		field.JSON("split_plan", map[string]interface{}{}).
+			Optional(),
 	}
 }
 
@@ -69,6 +72,15 @@ func (LockPaymentOrder) Edges() []ent.Edge {
 		edge.To("fulfillments", LockOrderFulfillment.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("transactions", TransactionLog.Type),
+		edge.To("sla_records", ProviderSLARecord.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("disputes", Dispute.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("dead_letter", DeadLetterOrder.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("assignments", OrderAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrderAssignment holds the schema definition for the OrderAssignment entity.
+// Each entry is an event in the assignment history of a lock payment order to a provider.
+type OrderAssignment struct {
+	ent.Schema
+}
+
+// Mixin of the OrderAssignment.
+func (OrderAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the OrderAssignment.
+func (OrderAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("event").
+			Values("offered", "accepted", "declined", "timed_out", "excluded", "cancelled").
+			Immutable(),
+		// When an offered order request lapses
+		field.Time("expires_at").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the OrderAssignment.
+func (OrderAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("lock_payment_order", LockPaymentOrder.Type).
+			Ref("assignments").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("provider", ProviderProfile.Type).
+			Ref("order_assignments").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the OrderAssignment.
+func (OrderAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event", "expires_at"),
+	}
+}
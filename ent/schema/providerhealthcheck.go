@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ProviderHealthCheck holds the schema definition for the ProviderHealthCheck entity.
+type ProviderHealthCheck struct {
+	ent.Schema
+}
+
+// Fields of the ProviderHealthCheck.
+func (ProviderHealthCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Bool("is_healthy").Immutable(),
+		field.Int64("latency_ms").Immutable(),
+		field.Int("status_code").Optional().Immutable(),
+		field.String("error").Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ProviderHealthCheck.
+func (ProviderHealthCheck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", ProviderProfile.Type).
+			Ref("health_checks").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ProviderHealthCheck.
+func (ProviderHealthCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").
+			Edges("provider"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/shopspring/decimal"
 )
 
@@ -37,6 +38,10 @@ func (ProviderOrderToken) Fields() []ent.Field {
 			Address string `json:"address"`
 			Network string `json:"network"`
 		}{}),
+		field.Time("rate_stale_since").
+			Optional(),
+		field.Time("rate_stale_notified_at").
+			Optional(),
 	}
 }
 
@@ -46,10 +51,18 @@ func (ProviderOrderToken) Edges() []ent.Edge {
 		edge.From("provider", ProviderProfile.Type).
 			Ref("order_tokens").
 			Unique(),
+		edge.From("currency", FiatCurrency.Type).
+			Ref("provider_order_tokens").
+			Unique().
+			Required(),
 	}
 }
 
 // Indexes of the ProviderOrderToken.
 func (ProviderOrderToken) Indexes() []ent.Index {
-	return nil
+	return []ent.Index{
+		index.Fields("symbol").
+			Edges("provider", "currency").
+			Unique(),
+	}
 }
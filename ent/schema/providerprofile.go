@@ -50,6 +50,47 @@ func (ProviderProfile) Fields() []ent.Field {
 		field.String("identity_document").Optional(),
 		field.String("business_document").Optional(),
 		field.Bool("is_kyb_verified").Default(false),
+
+		// Operating schedule fields
+		field.String("operating_timezone").Optional(), // IANA timezone e.g Africa/Lagos
+		field.JSON("operating_hours", []struct {
+			Weekday int    `json:"weekday"` // 0 is Sunday
+			Open    string `json:"open"`    // HH:MM
+			Close   string `json:"close"`   // HH:MM
+		}{}).
+			Optional(),
+		field.JSON("operating_hours_exceptions", []struct {
+			Date   string `json:"date"` // YYYY-MM-DD
+			Closed bool   `json:"closed"`
+			Open   string `json:"open"`  // HH:MM
+			Close  string `json:"close"` // HH:MM
+		}{}).
+			Optional(),
+		// Whether the provider is within its operating hours, kept by the availability sync.
+		// Providers are only matched when both open and available.
+		field.Bool("is_open").
+			Default(true),
+		// Institution codes and types (bank, mobile_money) the provider can pay out to.
+		// A provider without either list can pay out to every institution of its currencies.
+		field.Strings("supported_institutions").Optional(),
+		field.Strings("supported_institution_types").Optional(),
+		// Node health as seen by the health checker; unhealthy providers are left out of the bucket queues
+		field.Bool("is_healthy").
+			Default(true),
+		field.Int("health_failure_streak").
+			Default(0),
+		field.Time("last_health_check_at").
+			Optional(),
+		// Protocol version and capabilities declared by the provider's node in its health check response
+		field.Int("node_protocol_version").
+			Default(1),
+		field.Strings("node_capabilities").
+			Optional(),
+		// SLA penalties; demoted providers are moved to the back of the bucket queues and suspended providers are left out
+		field.Time("demoted_until").
+			Optional(),
+		field.Time("suspended_until").
+			Optional(),
 	}
 }
 
@@ -64,10 +105,8 @@ func (ProviderProfile) Edges() []ent.Edge {
 		edge.To("api_key", APIKey.Type).
 			Unique().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
-		edge.From("currency", FiatCurrency.Type).
-			Ref("providers").
-			Unique().
-			Required(),
+		edge.From("currencies", FiatCurrency.Type).
+			Ref("providers"),
 		edge.From("provision_buckets", ProvisionBucket.Type).
 			Ref("provider_profiles"),
 		edge.To("order_tokens", ProviderOrderToken.Type).
@@ -76,6 +115,22 @@ func (ProviderProfile) Edges() []ent.Edge {
 			Unique(),
 		edge.To("assigned_orders", LockPaymentOrder.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_members", TeamMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_invitations", TeamInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_audit_logs", TeamAuditLog.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("health_checks", ProviderHealthCheck.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sla_records", ProviderSLARecord.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("disputes", Dispute.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("order_assignments", OrderAssignment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("rate_snapshots", RateSnapshot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
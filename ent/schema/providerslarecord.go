@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ProviderSLARecord holds the schema definition for the ProviderSLARecord entity.
+// A record is created each time an order request is sent to a provider and tracks it through acceptance, fulfillment and validation.
+type ProviderSLARecord struct {
+	ent.Schema
+}
+
+// Fields of the ProviderSLARecord.
+func (ProviderSLARecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Time("assigned_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("accepted_at").Optional(),
+		field.Time("declined_at").Optional(),
+		field.Time("fulfilled_at").Optional(),
+		field.Time("validated_at").Optional(),
+		field.Enum("breach").
+			Values("accept_timeout", "fulfillment_timeout").
+			Optional(),
+		field.Time("breached_at").Optional(),
+	}
+}
+
+// Edges of the ProviderSLARecord.
+func (ProviderSLARecord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("provider", ProviderProfile.Type).
+			Ref("sla_records").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("order", LockPaymentOrder.Type).
+			Ref("sla_records").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ProviderSLARecord.
+func (ProviderSLARecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("assigned_at").
+			Edges("provider"),
+	}
+}
@@ -25,6 +25,9 @@ func (ProvisionBucket) Fields() []ent.Field {
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now),
+		field.Enum("matching_strategy").
+			Values("price_time", "round_robin", "trust_weighted", "lowest_latency").
+			Optional(),
 	}
 }
 
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PublicHoliday holds the schema definition for the PublicHoliday entity.
+type PublicHoliday struct {
+	ent.Schema
+}
+
+// Mixin of the PublicHoliday.
+func (PublicHoliday) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PublicHoliday.
+func (PublicHoliday) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("date"),
+		field.String("name"),
+	}
+}
+
+// Edges of the PublicHoliday.
+func (PublicHoliday) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("fiat_currency", FiatCurrency.Type).
+			Ref("public_holidays").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PublicHoliday.
+func (PublicHoliday) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date").
+			Edges("fiat_currency").
+			Unique(),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RateCircuitBreaker holds the schema definition for the RateCircuitBreaker entity.
+// It holds the rules a currency's market rate is checked against and whether one of them tripped.
+// While tripped, the market rate is frozen and orders of the currency are neither matched nor quoted.
+type RateCircuitBreaker struct {
+	ent.Schema
+}
+
+// Mixin of the RateCircuitBreaker.
+func (RateCircuitBreaker) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RateCircuitBreaker.
+func (RateCircuitBreaker) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// Largest change of the market rate over the change window in percent, zero to not check it
+		field.Float("max_change_percent").
+			GoType(decimal.Decimal{}),
+		// Window the change of the market rate is measured over in minutes
+		field.Int("change_window").
+			Default(30),
+		// Largest spread between the rates of the currency's sources in percent, zero to not check it
+		field.Float("max_divergence_percent").
+			GoType(decimal.Decimal{}),
+		// Minutes after which a tripped breaker resets itself, zero to only reset it manually
+		field.Int("auto_reset_after").
+			Default(0),
+		field.Bool("tripped").
+			Default(false),
+		field.String("trip_reason").
+			Optional(),
+		field.Time("tripped_at").
+			Optional(),
+		// Last good market rate, kept while the breaker is tripped
+		field.Float("frozen_rate").
+			GoType(decimal.Decimal{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the RateCircuitBreaker.
+func (RateCircuitBreaker) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("currency", FiatCurrency.Type).
+			Ref("rate_circuit_breaker").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
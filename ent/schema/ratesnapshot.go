@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RateSnapshot holds the schema definition for the RateSnapshot entity.
+// A raw snapshot is one rate, with the same open, high, low and close. Raw snapshots past their retention
+// are downsampled into hourly and then daily snapshots that keep the OHLC of the rates they replace.
+type RateSnapshot struct {
+	ent.Schema
+}
+
+// Mixin of the RateSnapshot.
+func (RateSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RateSnapshot.
+func (RateSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// Market rate computed for the currency, rate of its external sources or rate quoted by a provider
+		field.Enum("kind").
+			Values("market", "external", "provider").
+			Immutable(),
+		field.Enum("resolution").
+			Values("raw", "hour", "day").
+			Default("raw").
+			Immutable(),
+		// Symbol of the token the rate was quoted for, if any
+		field.String("token").
+			Optional().
+			Immutable(),
+		field.Float("open").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.Float("high").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.Float("low").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.Float("close").
+			GoType(decimal.Decimal{}).
+			Immutable(),
+		field.Int("sample_count").
+			Default(1).
+			Immutable(),
+		// Time of the rate, or start of the period of a downsampled snapshot
+		field.Time("recorded_at").
+			Immutable(),
+	}
+}
+
+// Edges of the RateSnapshot.
+func (RateSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("currency", FiatCurrency.Type).
+			Ref("rate_snapshots").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("provider", ProviderProfile.Type).
+			Ref("rate_snapshots").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the RateSnapshot.
+func (RateSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "recorded_at").
+			Edges("currency"),
+		index.Fields("resolution", "recorded_at"),
+	}
+}
@@ -50,5 +50,11 @@ func (SenderProfile) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("linked_address", LinkedAddress.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_members", TeamMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_invitations", TeamInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_audit_logs", TeamAuditLog.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TeamAuditLog holds the schema definition for the TeamAuditLog entity.
+type TeamAuditLog struct {
+	ent.Schema
+}
+
+// Fields of the TeamAuditLog.
+func (TeamAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Enum("action").
+			Values("member_invited", "invitation_accepted", "invitation_revoked", "role_changed", "member_removed").
+			Immutable(),
+		field.String("actor_email").Immutable(),
+		field.String("target_email").Immutable(),
+		field.JSON("metadata", map[string]interface{}{}).Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the TeamAuditLog.
+func (TeamAuditLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("team_audit_logs").
+			Unique().
+			Immutable(),
+		edge.From("provider_profile", ProviderProfile.Type).
+			Ref("team_audit_logs").
+			Unique().
+			Immutable(),
+	}
+}
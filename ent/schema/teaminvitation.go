@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TeamInvitation holds the schema definition for the TeamInvitation entity.
+type TeamInvitation struct {
+	ent.Schema
+}
+
+// Mixin of the TeamInvitation.
+func (TeamInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TeamInvitation.
+func (TeamInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("email"),
+		field.Enum("role").Values("admin", "developer", "finance"),
+		field.String("token").
+			Unique().
+			Immutable().
+			Sensitive().
+			DefaultFunc(generateInvitationToken),
+		field.Enum("status").
+			Values("pending", "accepted", "revoked").
+			Default("pending"),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the TeamInvitation.
+func (TeamInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("team_invitations").
+			Unique().
+			Immutable(),
+		edge.From("provider_profile", ProviderProfile.Type).
+			Ref("team_invitations").
+			Unique().
+			Immutable(),
+		edge.From("invited_by", User.Type).
+			Ref("sent_team_invitations").
+			Unique().
+			Immutable(),
+	}
+}
+
+// generateInvitationToken generates a random hex token for an invitation link.
+func generateInvitationToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
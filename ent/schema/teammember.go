@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TeamMember holds the schema definition for the TeamMember entity.
+// The owner of a profile is the user linked to it directly; team members are the other users granted access to it.
+type TeamMember struct {
+	ent.Schema
+}
+
+// Mixin of the TeamMember.
+func (TeamMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TeamMember.
+func (TeamMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("role").Values("admin", "developer", "finance"),
+	}
+}
+
+// Edges of the TeamMember.
+func (TeamMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("team_memberships").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("sender_profile", SenderProfile.Type).
+			Ref("team_members").
+			Unique().
+			Immutable(),
+		edge.From("provider_profile", ProviderProfile.Type).
+			Ref("team_members").
+			Unique().
+			Immutable(),
+	}
+}
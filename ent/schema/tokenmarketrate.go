@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TokenMarketRate holds the schema definition for the TokenMarketRate entity.
+// It is the market rate of a token in a fiat currency, computed from the rate sources of the pair.
+type TokenMarketRate struct {
+	ent.Schema
+}
+
+// Mixin of the TokenMarketRate.
+func (TokenMarketRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TokenMarketRate.
+func (TokenMarketRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// Symbol of the token, such as USDT
+		field.String("token").
+			Immutable(),
+		field.Float("market_rate").
+			GoType(decimal.Decimal{}),
+	}
+}
+
+// Edges of the TokenMarketRate.
+func (TokenMarketRate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("currency", FiatCurrency.Type).
+			Ref("token_market_rates").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the TokenMarketRate.
+func (TokenMarketRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token").
+			Edges("currency").
+			Unique(),
+	}
+}
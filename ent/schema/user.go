@@ -55,6 +55,10 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("verification_token", VerificationToken.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("team_memberships", TeamMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sent_team_invitations", TeamInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
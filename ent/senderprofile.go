@@ -52,9 +52,15 @@ type SenderProfileEdges struct {
 	OrderTokens []*SenderOrderToken `json:"order_tokens,omitempty"`
 	// LinkedAddress holds the value of the linked_address edge.
 	LinkedAddress []*LinkedAddress `json:"linked_address,omitempty"`
+	// TeamMembers holds the value of the team_members edge.
+	TeamMembers []*TeamMember `json:"team_members,omitempty"`
+	// TeamInvitations holds the value of the team_invitations edge.
+	TeamInvitations []*TeamInvitation `json:"team_invitations,omitempty"`
+	// TeamAuditLogs holds the value of the team_audit_logs edge.
+	TeamAuditLogs []*TeamAuditLog `json:"team_audit_logs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -106,6 +112,33 @@ func (e SenderProfileEdges) LinkedAddressOrErr() ([]*LinkedAddress, error) {
 	return nil, &NotLoadedError{edge: "linked_address"}
 }
 
+// TeamMembersOrErr returns the TeamMembers value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) TeamMembersOrErr() ([]*TeamMember, error) {
+	if e.loadedTypes[5] {
+		return e.TeamMembers, nil
+	}
+	return nil, &NotLoadedError{edge: "team_members"}
+}
+
+// TeamInvitationsOrErr returns the TeamInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) TeamInvitationsOrErr() ([]*TeamInvitation, error) {
+	if e.loadedTypes[6] {
+		return e.TeamInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "team_invitations"}
+}
+
+// TeamAuditLogsOrErr returns the TeamAuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e SenderProfileEdges) TeamAuditLogsOrErr() ([]*TeamAuditLog, error) {
+	if e.loadedTypes[7] {
+		return e.TeamAuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "team_audit_logs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SenderProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -227,6 +260,21 @@ func (sp *SenderProfile) QueryLinkedAddress() *LinkedAddressQuery {
 	return NewSenderProfileClient(sp.config).QueryLinkedAddress(sp)
 }
 
+// QueryTeamMembers queries the "team_members" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryTeamMembers() *TeamMemberQuery {
+	return NewSenderProfileClient(sp.config).QueryTeamMembers(sp)
+}
+
+// QueryTeamInvitations queries the "team_invitations" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryTeamInvitations() *TeamInvitationQuery {
+	return NewSenderProfileClient(sp.config).QueryTeamInvitations(sp)
+}
+
+// QueryTeamAuditLogs queries the "team_audit_logs" edge of the SenderProfile entity.
+func (sp *SenderProfile) QueryTeamAuditLogs() *TeamAuditLogQuery {
+	return NewSenderProfileClient(sp.config).QueryTeamAuditLogs(sp)
+}
+
 // Update returns a builder for updating this SenderProfile.
 // Note that you need to call SenderProfile.Unwrap() before calling this method if this SenderProfile
 // was returned from a transaction, and the transaction was committed or rolled back.
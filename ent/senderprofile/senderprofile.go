@@ -37,6 +37,12 @@ const (
 	EdgeOrderTokens = "order_tokens"
 	// EdgeLinkedAddress holds the string denoting the linked_address edge name in mutations.
 	EdgeLinkedAddress = "linked_address"
+	// EdgeTeamMembers holds the string denoting the team_members edge name in mutations.
+	EdgeTeamMembers = "team_members"
+	// EdgeTeamInvitations holds the string denoting the team_invitations edge name in mutations.
+	EdgeTeamInvitations = "team_invitations"
+	// EdgeTeamAuditLogs holds the string denoting the team_audit_logs edge name in mutations.
+	EdgeTeamAuditLogs = "team_audit_logs"
 	// Table holds the table name of the senderprofile in the database.
 	Table = "sender_profiles"
 	// UserTable is the table that holds the user relation/edge.
@@ -74,6 +80,27 @@ const (
 	LinkedAddressInverseTable = "linked_addresses"
 	// LinkedAddressColumn is the table column denoting the linked_address relation/edge.
 	LinkedAddressColumn = "sender_profile_linked_address"
+	// TeamMembersTable is the table that holds the team_members relation/edge.
+	TeamMembersTable = "team_members"
+	// TeamMembersInverseTable is the table name for the TeamMember entity.
+	// It exists in this package in order to avoid circular dependency with the "teammember" package.
+	TeamMembersInverseTable = "team_members"
+	// TeamMembersColumn is the table column denoting the team_members relation/edge.
+	TeamMembersColumn = "sender_profile_team_members"
+	// TeamInvitationsTable is the table that holds the team_invitations relation/edge.
+	TeamInvitationsTable = "team_invitations"
+	// TeamInvitationsInverseTable is the table name for the TeamInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "teaminvitation" package.
+	TeamInvitationsInverseTable = "team_invitations"
+	// TeamInvitationsColumn is the table column denoting the team_invitations relation/edge.
+	TeamInvitationsColumn = "sender_profile_team_invitations"
+	// TeamAuditLogsTable is the table that holds the team_audit_logs relation/edge.
+	TeamAuditLogsTable = "team_audit_logs"
+	// TeamAuditLogsInverseTable is the table name for the TeamAuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "teamauditlog" package.
+	TeamAuditLogsInverseTable = "team_audit_logs"
+	// TeamAuditLogsColumn is the table column denoting the team_audit_logs relation/edge.
+	TeamAuditLogsColumn = "sender_profile_team_audit_logs"
 )
 
 // Columns holds all SQL columns for senderprofile fields.
@@ -211,6 +238,48 @@ func ByLinkedAddress(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLinkedAddressStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTeamMembersCount orders the results by team_members count.
+func ByTeamMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamMembersStep(), opts...)
+	}
+}
+
+// ByTeamMembers orders the results by team_members terms.
+func ByTeamMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTeamInvitationsCount orders the results by team_invitations count.
+func ByTeamInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamInvitationsStep(), opts...)
+	}
+}
+
+// ByTeamInvitations orders the results by team_invitations terms.
+func ByTeamInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTeamAuditLogsCount orders the results by team_audit_logs count.
+func ByTeamAuditLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamAuditLogsStep(), opts...)
+	}
+}
+
+// ByTeamAuditLogs orders the results by team_audit_logs terms.
+func ByTeamAuditLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamAuditLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -246,3 +315,24 @@ func newLinkedAddressStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LinkedAddressTable, LinkedAddressColumn),
 	)
 }
+func newTeamMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamMembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamMembersTable, TeamMembersColumn),
+	)
+}
+func newTeamInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamInvitationsTable, TeamInvitationsColumn),
+	)
+}
+func newTeamAuditLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamAuditLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TeamAuditLogsTable, TeamAuditLogsColumn),
+	)
+}
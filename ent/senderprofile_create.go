@@ -18,6 +18,9 @@ import (
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
@@ -194,6 +197,51 @@ func (spc *SenderProfileCreate) AddLinkedAddress(l ...*LinkedAddress) *SenderPro
 	return spc.AddLinkedAddresIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (spc *SenderProfileCreate) AddTeamMemberIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddTeamMemberIDs(ids...)
+	return spc
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (spc *SenderProfileCreate) AddTeamMembers(t ...*TeamMember) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spc.AddTeamMemberIDs(ids...)
+}
+
+// AddTeamInvitationIDs adds the "team_invitations" edge to the TeamInvitation entity by IDs.
+func (spc *SenderProfileCreate) AddTeamInvitationIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddTeamInvitationIDs(ids...)
+	return spc
+}
+
+// AddTeamInvitations adds the "team_invitations" edges to the TeamInvitation entity.
+func (spc *SenderProfileCreate) AddTeamInvitations(t ...*TeamInvitation) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spc.AddTeamInvitationIDs(ids...)
+}
+
+// AddTeamAuditLogIDs adds the "team_audit_logs" edge to the TeamAuditLog entity by IDs.
+func (spc *SenderProfileCreate) AddTeamAuditLogIDs(ids ...uuid.UUID) *SenderProfileCreate {
+	spc.mutation.AddTeamAuditLogIDs(ids...)
+	return spc
+}
+
+// AddTeamAuditLogs adds the "team_audit_logs" edges to the TeamAuditLog entity.
+func (spc *SenderProfileCreate) AddTeamAuditLogs(t ...*TeamAuditLog) *SenderProfileCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spc.AddTeamAuditLogIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spc *SenderProfileCreate) Mutation() *SenderProfileMutation {
 	return spc.mutation
@@ -409,6 +457,54 @@ func (spc *SenderProfileCreate) createSpec() (*SenderProfile, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := spc.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.TeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := spc.mutation.TeamAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -19,22 +19,28 @@ import (
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 )
 
 // SenderProfileQuery is the builder for querying SenderProfile entities.
 type SenderProfileQuery struct {
 	config
-	ctx               *QueryContext
-	order             []senderprofile.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.SenderProfile
-	withUser          *UserQuery
-	withAPIKey        *APIKeyQuery
-	withPaymentOrders *PaymentOrderQuery
-	withOrderTokens   *SenderOrderTokenQuery
-	withLinkedAddress *LinkedAddressQuery
-	withFKs           bool
+	ctx                 *QueryContext
+	order               []senderprofile.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.SenderProfile
+	withUser            *UserQuery
+	withAPIKey          *APIKeyQuery
+	withPaymentOrders   *PaymentOrderQuery
+	withOrderTokens     *SenderOrderTokenQuery
+	withLinkedAddress   *LinkedAddressQuery
+	withTeamMembers     *TeamMemberQuery
+	withTeamInvitations *TeamInvitationQuery
+	withTeamAuditLogs   *TeamAuditLogQuery
+	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -181,6 +187,72 @@ func (spq *SenderProfileQuery) QueryLinkedAddress() *LinkedAddressQuery {
 	return query
 }
 
+// QueryTeamMembers chains the current query on the "team_members" edge.
+func (spq *SenderProfileQuery) QueryTeamMembers() *TeamMemberQuery {
+	query := (&TeamMemberClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(teammember.Table, teammember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamMembersTable, senderprofile.TeamMembersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeamInvitations chains the current query on the "team_invitations" edge.
+func (spq *SenderProfileQuery) QueryTeamInvitations() *TeamInvitationQuery {
+	query := (&TeamInvitationClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(teaminvitation.Table, teaminvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamInvitationsTable, senderprofile.TeamInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeamAuditLogs chains the current query on the "team_audit_logs" edge.
+func (spq *SenderProfileQuery) QueryTeamAuditLogs() *TeamAuditLogQuery {
+	query := (&TeamAuditLogClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(senderprofile.Table, senderprofile.FieldID, selector),
+			sqlgraph.To(teamauditlog.Table, teamauditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, senderprofile.TeamAuditLogsTable, senderprofile.TeamAuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SenderProfile entity from the query.
 // Returns a *NotFoundError when no SenderProfile was found.
 func (spq *SenderProfileQuery) First(ctx context.Context) (*SenderProfile, error) {
@@ -368,16 +440,19 @@ func (spq *SenderProfileQuery) Clone() *SenderProfileQuery {
 		return nil
 	}
 	return &SenderProfileQuery{
-		config:            spq.config,
-		ctx:               spq.ctx.Clone(),
-		order:             append([]senderprofile.OrderOption{}, spq.order...),
-		inters:            append([]Interceptor{}, spq.inters...),
-		predicates:        append([]predicate.SenderProfile{}, spq.predicates...),
-		withUser:          spq.withUser.Clone(),
-		withAPIKey:        spq.withAPIKey.Clone(),
-		withPaymentOrders: spq.withPaymentOrders.Clone(),
-		withOrderTokens:   spq.withOrderTokens.Clone(),
-		withLinkedAddress: spq.withLinkedAddress.Clone(),
+		config:              spq.config,
+		ctx:                 spq.ctx.Clone(),
+		order:               append([]senderprofile.OrderOption{}, spq.order...),
+		inters:              append([]Interceptor{}, spq.inters...),
+		predicates:          append([]predicate.SenderProfile{}, spq.predicates...),
+		withUser:            spq.withUser.Clone(),
+		withAPIKey:          spq.withAPIKey.Clone(),
+		withPaymentOrders:   spq.withPaymentOrders.Clone(),
+		withOrderTokens:     spq.withOrderTokens.Clone(),
+		withLinkedAddress:   spq.withLinkedAddress.Clone(),
+		withTeamMembers:     spq.withTeamMembers.Clone(),
+		withTeamInvitations: spq.withTeamInvitations.Clone(),
+		withTeamAuditLogs:   spq.withTeamAuditLogs.Clone(),
 		// clone intermediate query.
 		sql:  spq.sql.Clone(),
 		path: spq.path,
@@ -439,6 +514,39 @@ func (spq *SenderProfileQuery) WithLinkedAddress(opts ...func(*LinkedAddressQuer
 	return spq
 }
 
+// WithTeamMembers tells the query-builder to eager-load the nodes that are connected to
+// the "team_members" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithTeamMembers(opts ...func(*TeamMemberQuery)) *SenderProfileQuery {
+	query := (&TeamMemberClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withTeamMembers = query
+	return spq
+}
+
+// WithTeamInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "team_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithTeamInvitations(opts ...func(*TeamInvitationQuery)) *SenderProfileQuery {
+	query := (&TeamInvitationClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withTeamInvitations = query
+	return spq
+}
+
+// WithTeamAuditLogs tells the query-builder to eager-load the nodes that are connected to
+// the "team_audit_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *SenderProfileQuery) WithTeamAuditLogs(opts ...func(*TeamAuditLogQuery)) *SenderProfileQuery {
+	query := (&TeamAuditLogClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withTeamAuditLogs = query
+	return spq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +626,15 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		nodes       = []*SenderProfile{}
 		withFKs     = spq.withFKs
 		_spec       = spq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			spq.withUser != nil,
 			spq.withAPIKey != nil,
 			spq.withPaymentOrders != nil,
 			spq.withOrderTokens != nil,
 			spq.withLinkedAddress != nil,
+			spq.withTeamMembers != nil,
+			spq.withTeamInvitations != nil,
+			spq.withTeamAuditLogs != nil,
 		}
 	)
 	if spq.withUser != nil {
@@ -583,6 +694,29 @@ func (spq *SenderProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := spq.withTeamMembers; query != nil {
+		if err := spq.loadTeamMembers(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.TeamMembers = []*TeamMember{} },
+			func(n *SenderProfile, e *TeamMember) { n.Edges.TeamMembers = append(n.Edges.TeamMembers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withTeamInvitations; query != nil {
+		if err := spq.loadTeamInvitations(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.TeamInvitations = []*TeamInvitation{} },
+			func(n *SenderProfile, e *TeamInvitation) {
+				n.Edges.TeamInvitations = append(n.Edges.TeamInvitations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := spq.withTeamAuditLogs; query != nil {
+		if err := spq.loadTeamAuditLogs(ctx, query, nodes,
+			func(n *SenderProfile) { n.Edges.TeamAuditLogs = []*TeamAuditLog{} },
+			func(n *SenderProfile, e *TeamAuditLog) { n.Edges.TeamAuditLogs = append(n.Edges.TeamAuditLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -739,6 +873,99 @@ func (spq *SenderProfileQuery) loadLinkedAddress(ctx context.Context, query *Lin
 	}
 	return nil
 }
+func (spq *SenderProfileQuery) loadTeamMembers(ctx context.Context, query *TeamMemberQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *TeamMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.TeamMembersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_team_members
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_team_members" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_team_members" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadTeamInvitations(ctx context.Context, query *TeamInvitationQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *TeamInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.TeamInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_team_invitations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_team_invitations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_team_invitations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (spq *SenderProfileQuery) loadTeamAuditLogs(ctx context.Context, query *TeamAuditLogQuery, nodes []*SenderProfile, init func(*SenderProfile), assign func(*SenderProfile, *TeamAuditLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SenderProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TeamAuditLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(senderprofile.TeamAuditLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.sender_profile_team_audit_logs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "sender_profile_team_audit_logs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_profile_team_audit_logs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (spq *SenderProfileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := spq.querySpec()
@@ -19,6 +19,9 @@ import (
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/senderordertoken"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 )
 
 // SenderProfileUpdate is the builder for updating SenderProfile entities.
@@ -184,6 +187,51 @@ func (spu *SenderProfileUpdate) AddLinkedAddress(l ...*LinkedAddress) *SenderPro
 	return spu.AddLinkedAddresIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (spu *SenderProfileUpdate) AddTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddTeamMemberIDs(ids...)
+	return spu
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (spu *SenderProfileUpdate) AddTeamMembers(t ...*TeamMember) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.AddTeamMemberIDs(ids...)
+}
+
+// AddTeamInvitationIDs adds the "team_invitations" edge to the TeamInvitation entity by IDs.
+func (spu *SenderProfileUpdate) AddTeamInvitationIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddTeamInvitationIDs(ids...)
+	return spu
+}
+
+// AddTeamInvitations adds the "team_invitations" edges to the TeamInvitation entity.
+func (spu *SenderProfileUpdate) AddTeamInvitations(t ...*TeamInvitation) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.AddTeamInvitationIDs(ids...)
+}
+
+// AddTeamAuditLogIDs adds the "team_audit_logs" edge to the TeamAuditLog entity by IDs.
+func (spu *SenderProfileUpdate) AddTeamAuditLogIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.AddTeamAuditLogIDs(ids...)
+	return spu
+}
+
+// AddTeamAuditLogs adds the "team_audit_logs" edges to the TeamAuditLog entity.
+func (spu *SenderProfileUpdate) AddTeamAuditLogs(t ...*TeamAuditLog) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.AddTeamAuditLogIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spu *SenderProfileUpdate) Mutation() *SenderProfileMutation {
 	return spu.mutation
@@ -258,6 +306,69 @@ func (spu *SenderProfileUpdate) RemoveLinkedAddress(l ...*LinkedAddress) *Sender
 	return spu.RemoveLinkedAddresIDs(ids...)
 }
 
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (spu *SenderProfileUpdate) ClearTeamMembers() *SenderProfileUpdate {
+	spu.mutation.ClearTeamMembers()
+	return spu
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (spu *SenderProfileUpdate) RemoveTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveTeamMemberIDs(ids...)
+	return spu
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (spu *SenderProfileUpdate) RemoveTeamMembers(t ...*TeamMember) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearTeamInvitations clears all "team_invitations" edges to the TeamInvitation entity.
+func (spu *SenderProfileUpdate) ClearTeamInvitations() *SenderProfileUpdate {
+	spu.mutation.ClearTeamInvitations()
+	return spu
+}
+
+// RemoveTeamInvitationIDs removes the "team_invitations" edge to TeamInvitation entities by IDs.
+func (spu *SenderProfileUpdate) RemoveTeamInvitationIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveTeamInvitationIDs(ids...)
+	return spu
+}
+
+// RemoveTeamInvitations removes "team_invitations" edges to TeamInvitation entities.
+func (spu *SenderProfileUpdate) RemoveTeamInvitations(t ...*TeamInvitation) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.RemoveTeamInvitationIDs(ids...)
+}
+
+// ClearTeamAuditLogs clears all "team_audit_logs" edges to the TeamAuditLog entity.
+func (spu *SenderProfileUpdate) ClearTeamAuditLogs() *SenderProfileUpdate {
+	spu.mutation.ClearTeamAuditLogs()
+	return spu
+}
+
+// RemoveTeamAuditLogIDs removes the "team_audit_logs" edge to TeamAuditLog entities by IDs.
+func (spu *SenderProfileUpdate) RemoveTeamAuditLogIDs(ids ...uuid.UUID) *SenderProfileUpdate {
+	spu.mutation.RemoveTeamAuditLogIDs(ids...)
+	return spu
+}
+
+// RemoveTeamAuditLogs removes "team_audit_logs" edges to TeamAuditLog entities.
+func (spu *SenderProfileUpdate) RemoveTeamAuditLogs(t ...*TeamAuditLog) *SenderProfileUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spu.RemoveTeamAuditLogIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (spu *SenderProfileUpdate) Save(ctx context.Context) (int, error) {
 	spu.defaults()
@@ -507,6 +618,141 @@ func (spu *SenderProfileUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !spu.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedTeamInvitationsIDs(); len(nodes) > 0 && !spu.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.TeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spu.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedTeamAuditLogsIDs(); len(nodes) > 0 && !spu.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.TeamAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{senderprofile.Label}
@@ -677,6 +923,51 @@ func (spuo *SenderProfileUpdateOne) AddLinkedAddress(l ...*LinkedAddress) *Sende
 	return spuo.AddLinkedAddresIDs(ids...)
 }
 
+// AddTeamMemberIDs adds the "team_members" edge to the TeamMember entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddTeamMemberIDs(ids...)
+	return spuo
+}
+
+// AddTeamMembers adds the "team_members" edges to the TeamMember entity.
+func (spuo *SenderProfileUpdateOne) AddTeamMembers(t ...*TeamMember) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.AddTeamMemberIDs(ids...)
+}
+
+// AddTeamInvitationIDs adds the "team_invitations" edge to the TeamInvitation entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddTeamInvitationIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddTeamInvitationIDs(ids...)
+	return spuo
+}
+
+// AddTeamInvitations adds the "team_invitations" edges to the TeamInvitation entity.
+func (spuo *SenderProfileUpdateOne) AddTeamInvitations(t ...*TeamInvitation) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.AddTeamInvitationIDs(ids...)
+}
+
+// AddTeamAuditLogIDs adds the "team_audit_logs" edge to the TeamAuditLog entity by IDs.
+func (spuo *SenderProfileUpdateOne) AddTeamAuditLogIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.AddTeamAuditLogIDs(ids...)
+	return spuo
+}
+
+// AddTeamAuditLogs adds the "team_audit_logs" edges to the TeamAuditLog entity.
+func (spuo *SenderProfileUpdateOne) AddTeamAuditLogs(t ...*TeamAuditLog) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.AddTeamAuditLogIDs(ids...)
+}
+
 // Mutation returns the SenderProfileMutation object of the builder.
 func (spuo *SenderProfileUpdateOne) Mutation() *SenderProfileMutation {
 	return spuo.mutation
@@ -751,6 +1042,69 @@ func (spuo *SenderProfileUpdateOne) RemoveLinkedAddress(l ...*LinkedAddress) *Se
 	return spuo.RemoveLinkedAddresIDs(ids...)
 }
 
+// ClearTeamMembers clears all "team_members" edges to the TeamMember entity.
+func (spuo *SenderProfileUpdateOne) ClearTeamMembers() *SenderProfileUpdateOne {
+	spuo.mutation.ClearTeamMembers()
+	return spuo
+}
+
+// RemoveTeamMemberIDs removes the "team_members" edge to TeamMember entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveTeamMemberIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveTeamMemberIDs(ids...)
+	return spuo
+}
+
+// RemoveTeamMembers removes "team_members" edges to TeamMember entities.
+func (spuo *SenderProfileUpdateOne) RemoveTeamMembers(t ...*TeamMember) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.RemoveTeamMemberIDs(ids...)
+}
+
+// ClearTeamInvitations clears all "team_invitations" edges to the TeamInvitation entity.
+func (spuo *SenderProfileUpdateOne) ClearTeamInvitations() *SenderProfileUpdateOne {
+	spuo.mutation.ClearTeamInvitations()
+	return spuo
+}
+
+// RemoveTeamInvitationIDs removes the "team_invitations" edge to TeamInvitation entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveTeamInvitationIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveTeamInvitationIDs(ids...)
+	return spuo
+}
+
+// RemoveTeamInvitations removes "team_invitations" edges to TeamInvitation entities.
+func (spuo *SenderProfileUpdateOne) RemoveTeamInvitations(t ...*TeamInvitation) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.RemoveTeamInvitationIDs(ids...)
+}
+
+// ClearTeamAuditLogs clears all "team_audit_logs" edges to the TeamAuditLog entity.
+func (spuo *SenderProfileUpdateOne) ClearTeamAuditLogs() *SenderProfileUpdateOne {
+	spuo.mutation.ClearTeamAuditLogs()
+	return spuo
+}
+
+// RemoveTeamAuditLogIDs removes the "team_audit_logs" edge to TeamAuditLog entities by IDs.
+func (spuo *SenderProfileUpdateOne) RemoveTeamAuditLogIDs(ids ...uuid.UUID) *SenderProfileUpdateOne {
+	spuo.mutation.RemoveTeamAuditLogIDs(ids...)
+	return spuo
+}
+
+// RemoveTeamAuditLogs removes "team_audit_logs" edges to TeamAuditLog entities.
+func (spuo *SenderProfileUpdateOne) RemoveTeamAuditLogs(t ...*TeamAuditLog) *SenderProfileUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return spuo.RemoveTeamAuditLogIDs(ids...)
+}
+
 // Where appends a list predicates to the SenderProfileUpdate builder.
 func (spuo *SenderProfileUpdateOne) Where(ps ...predicate.SenderProfile) *SenderProfileUpdateOne {
 	spuo.mutation.Where(ps...)
@@ -1030,6 +1384,141 @@ func (spuo *SenderProfileUpdateOne) sqlSave(ctx context.Context) (_node *SenderP
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedTeamMembersIDs(); len(nodes) > 0 && !spuo.mutation.TeamMembersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.TeamMembersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamMembersTable,
+			Columns: []string{senderprofile.TeamMembersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedTeamInvitationsIDs(); len(nodes) > 0 && !spuo.mutation.TeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.TeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamInvitationsTable,
+			Columns: []string{senderprofile.TeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if spuo.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedTeamAuditLogsIDs(); len(nodes) > 0 && !spuo.mutation.TeamAuditLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.TeamAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   senderprofile.TeamAuditLogsTable,
+			Columns: []string{senderprofile.TeamAuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SenderProfile{config: spuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+)
+
+// TeamAuditLog is the model entity for the TeamAuditLog schema.
+type TeamAuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action teamauditlog.Action `json:"action,omitempty"`
+	// ActorEmail holds the value of the "actor_email" field.
+	ActorEmail string `json:"actor_email,omitempty"`
+	// TargetEmail holds the value of the "target_email" field.
+	TargetEmail string `json:"target_email,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamAuditLogQuery when eager-loading is set.
+	Edges                            TeamAuditLogEdges `json:"edges"`
+	provider_profile_team_audit_logs *string
+	sender_profile_team_audit_logs   *uuid.UUID
+	selectValues                     sql.SelectValues
+}
+
+// TeamAuditLogEdges holds the relations/edges for other nodes in the graph.
+type TeamAuditLogEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// ProviderProfile holds the value of the provider_profile edge.
+	ProviderProfile *ProviderProfile `json:"provider_profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamAuditLogEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// ProviderProfileOrErr returns the ProviderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamAuditLogEdges) ProviderProfileOrErr() (*ProviderProfile, error) {
+	if e.ProviderProfile != nil {
+		return e.ProviderProfile, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamAuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teamauditlog.FieldMetadata:
+			values[i] = new([]byte)
+		case teamauditlog.FieldAction, teamauditlog.FieldActorEmail, teamauditlog.FieldTargetEmail:
+			values[i] = new(sql.NullString)
+		case teamauditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case teamauditlog.FieldID:
+			values[i] = new(uuid.UUID)
+		case teamauditlog.ForeignKeys[0]: // provider_profile_team_audit_logs
+			values[i] = new(sql.NullString)
+		case teamauditlog.ForeignKeys[1]: // sender_profile_team_audit_logs
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamAuditLog fields.
+func (tal *TeamAuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teamauditlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tal.ID = *value
+			}
+		case teamauditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				tal.Action = teamauditlog.Action(value.String)
+			}
+		case teamauditlog.FieldActorEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_email", values[i])
+			} else if value.Valid {
+				tal.ActorEmail = value.String
+			}
+		case teamauditlog.FieldTargetEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_email", values[i])
+			} else if value.Valid {
+				tal.TargetEmail = value.String
+			}
+		case teamauditlog.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tal.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case teamauditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tal.CreatedAt = value.Time
+			}
+		case teamauditlog.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_team_audit_logs", values[i])
+			} else if value.Valid {
+				tal.provider_profile_team_audit_logs = new(string)
+				*tal.provider_profile_team_audit_logs = value.String
+			}
+		case teamauditlog.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_team_audit_logs", values[i])
+			} else if value.Valid {
+				tal.sender_profile_team_audit_logs = new(uuid.UUID)
+				*tal.sender_profile_team_audit_logs = *value.S.(*uuid.UUID)
+			}
+		default:
+			tal.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamAuditLog.
+// This includes values selected through modifiers, order, etc.
+func (tal *TeamAuditLog) Value(name string) (ent.Value, error) {
+	return tal.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the TeamAuditLog entity.
+func (tal *TeamAuditLog) QuerySenderProfile() *SenderProfileQuery {
+	return NewTeamAuditLogClient(tal.config).QuerySenderProfile(tal)
+}
+
+// QueryProviderProfile queries the "provider_profile" edge of the TeamAuditLog entity.
+func (tal *TeamAuditLog) QueryProviderProfile() *ProviderProfileQuery {
+	return NewTeamAuditLogClient(tal.config).QueryProviderProfile(tal)
+}
+
+// Update returns a builder for updating this TeamAuditLog.
+// Note that you need to call TeamAuditLog.Unwrap() before calling this method if this TeamAuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tal *TeamAuditLog) Update() *TeamAuditLogUpdateOne {
+	return NewTeamAuditLogClient(tal.config).UpdateOne(tal)
+}
+
+// Unwrap unwraps the TeamAuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tal *TeamAuditLog) Unwrap() *TeamAuditLog {
+	_tx, ok := tal.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamAuditLog is not a transactional entity")
+	}
+	tal.config.driver = _tx.drv
+	return tal
+}
+
+// String implements the fmt.Stringer.
+func (tal *TeamAuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamAuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tal.ID))
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", tal.Action))
+	builder.WriteString(", ")
+	builder.WriteString("actor_email=")
+	builder.WriteString(tal.ActorEmail)
+	builder.WriteString(", ")
+	builder.WriteString("target_email=")
+	builder.WriteString(tal.TargetEmail)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", tal.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tal.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamAuditLogs is a parsable slice of TeamAuditLog.
+type TeamAuditLogs []*TeamAuditLog
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package teamauditlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// ActorEmail applies equality check predicate on the "actor_email" field. It's identical to ActorEmailEQ.
+func ActorEmail(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldActorEmail, v))
+}
+
+// TargetEmail applies equality check predicate on the "target_email" field. It's identical to TargetEmailEQ.
+func TargetEmail(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldTargetEmail, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorEmailEQ applies the EQ predicate on the "actor_email" field.
+func ActorEmailEQ(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldActorEmail, v))
+}
+
+// ActorEmailNEQ applies the NEQ predicate on the "actor_email" field.
+func ActorEmailNEQ(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNEQ(FieldActorEmail, v))
+}
+
+// ActorEmailIn applies the In predicate on the "actor_email" field.
+func ActorEmailIn(vs ...string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldIn(FieldActorEmail, vs...))
+}
+
+// ActorEmailNotIn applies the NotIn predicate on the "actor_email" field.
+func ActorEmailNotIn(vs ...string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNotIn(FieldActorEmail, vs...))
+}
+
+// ActorEmailGT applies the GT predicate on the "actor_email" field.
+func ActorEmailGT(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGT(FieldActorEmail, v))
+}
+
+// ActorEmailGTE applies the GTE predicate on the "actor_email" field.
+func ActorEmailGTE(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGTE(FieldActorEmail, v))
+}
+
+// ActorEmailLT applies the LT predicate on the "actor_email" field.
+func ActorEmailLT(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLT(FieldActorEmail, v))
+}
+
+// ActorEmailLTE applies the LTE predicate on the "actor_email" field.
+func ActorEmailLTE(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLTE(FieldActorEmail, v))
+}
+
+// ActorEmailContains applies the Contains predicate on the "actor_email" field.
+func ActorEmailContains(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldContains(FieldActorEmail, v))
+}
+
+// ActorEmailHasPrefix applies the HasPrefix predicate on the "actor_email" field.
+func ActorEmailHasPrefix(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldHasPrefix(FieldActorEmail, v))
+}
+
+// ActorEmailHasSuffix applies the HasSuffix predicate on the "actor_email" field.
+func ActorEmailHasSuffix(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldHasSuffix(FieldActorEmail, v))
+}
+
+// ActorEmailEqualFold applies the EqualFold predicate on the "actor_email" field.
+func ActorEmailEqualFold(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEqualFold(FieldActorEmail, v))
+}
+
+// ActorEmailContainsFold applies the ContainsFold predicate on the "actor_email" field.
+func ActorEmailContainsFold(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldContainsFold(FieldActorEmail, v))
+}
+
+// TargetEmailEQ applies the EQ predicate on the "target_email" field.
+func TargetEmailEQ(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldTargetEmail, v))
+}
+
+// TargetEmailNEQ applies the NEQ predicate on the "target_email" field.
+func TargetEmailNEQ(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNEQ(FieldTargetEmail, v))
+}
+
+// TargetEmailIn applies the In predicate on the "target_email" field.
+func TargetEmailIn(vs ...string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldIn(FieldTargetEmail, vs...))
+}
+
+// TargetEmailNotIn applies the NotIn predicate on the "target_email" field.
+func TargetEmailNotIn(vs ...string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNotIn(FieldTargetEmail, vs...))
+}
+
+// TargetEmailGT applies the GT predicate on the "target_email" field.
+func TargetEmailGT(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGT(FieldTargetEmail, v))
+}
+
+// TargetEmailGTE applies the GTE predicate on the "target_email" field.
+func TargetEmailGTE(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGTE(FieldTargetEmail, v))
+}
+
+// TargetEmailLT applies the LT predicate on the "target_email" field.
+func TargetEmailLT(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLT(FieldTargetEmail, v))
+}
+
+// TargetEmailLTE applies the LTE predicate on the "target_email" field.
+func TargetEmailLTE(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLTE(FieldTargetEmail, v))
+}
+
+// TargetEmailContains applies the Contains predicate on the "target_email" field.
+func TargetEmailContains(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldContains(FieldTargetEmail, v))
+}
+
+// TargetEmailHasPrefix applies the HasPrefix predicate on the "target_email" field.
+func TargetEmailHasPrefix(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldHasPrefix(FieldTargetEmail, v))
+}
+
+// TargetEmailHasSuffix applies the HasSuffix predicate on the "target_email" field.
+func TargetEmailHasSuffix(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldHasSuffix(FieldTargetEmail, v))
+}
+
+// TargetEmailEqualFold applies the EqualFold predicate on the "target_email" field.
+func TargetEmailEqualFold(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEqualFold(FieldTargetEmail, v))
+}
+
+// TargetEmailContainsFold applies the ContainsFold predicate on the "target_email" field.
+func TargetEmailContainsFold(v string) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldContainsFold(FieldTargetEmail, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNotNull(FieldMetadata))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSenderProfile applies the HasEdge predicate on the "sender_profile" edge.
+func HasSenderProfile() predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderProfileTable, SenderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderProfileWith applies the HasEdge predicate on the "sender_profile" edge with a given conditions (other predicates).
+func HasSenderProfileWith(preds ...predicate.SenderProfile) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(func(s *sql.Selector) {
+		step := newSenderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProviderProfile applies the HasEdge predicate on the "provider_profile" edge.
+func HasProviderProfile() predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProviderProfileTable, ProviderProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProviderProfileWith applies the HasEdge predicate on the "provider_profile" edge with a given conditions (other predicates).
+func HasProviderProfileWith(preds ...predicate.ProviderProfile) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(func(s *sql.Selector) {
+		step := newProviderProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TeamAuditLog) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TeamAuditLog) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TeamAuditLog) predicate.TeamAuditLog {
+	return predicate.TeamAuditLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,693 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+)
+
+// TeamAuditLogCreate is the builder for creating a TeamAuditLog entity.
+type TeamAuditLogCreate struct {
+	config
+	mutation *TeamAuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAction sets the "action" field.
+func (talc *TeamAuditLogCreate) SetAction(t teamauditlog.Action) *TeamAuditLogCreate {
+	talc.mutation.SetAction(t)
+	return talc
+}
+
+// SetActorEmail sets the "actor_email" field.
+func (talc *TeamAuditLogCreate) SetActorEmail(s string) *TeamAuditLogCreate {
+	talc.mutation.SetActorEmail(s)
+	return talc
+}
+
+// SetTargetEmail sets the "target_email" field.
+func (talc *TeamAuditLogCreate) SetTargetEmail(s string) *TeamAuditLogCreate {
+	talc.mutation.SetTargetEmail(s)
+	return talc
+}
+
+// SetMetadata sets the "metadata" field.
+func (talc *TeamAuditLogCreate) SetMetadata(m map[string]interface{}) *TeamAuditLogCreate {
+	talc.mutation.SetMetadata(m)
+	return talc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (talc *TeamAuditLogCreate) SetCreatedAt(t time.Time) *TeamAuditLogCreate {
+	talc.mutation.SetCreatedAt(t)
+	return talc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (talc *TeamAuditLogCreate) SetNillableCreatedAt(t *time.Time) *TeamAuditLogCreate {
+	if t != nil {
+		talc.SetCreatedAt(*t)
+	}
+	return talc
+}
+
+// SetID sets the "id" field.
+func (talc *TeamAuditLogCreate) SetID(u uuid.UUID) *TeamAuditLogCreate {
+	talc.mutation.SetID(u)
+	return talc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (talc *TeamAuditLogCreate) SetNillableID(u *uuid.UUID) *TeamAuditLogCreate {
+	if u != nil {
+		talc.SetID(*u)
+	}
+	return talc
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (talc *TeamAuditLogCreate) SetSenderProfileID(id uuid.UUID) *TeamAuditLogCreate {
+	talc.mutation.SetSenderProfileID(id)
+	return talc
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (talc *TeamAuditLogCreate) SetNillableSenderProfileID(id *uuid.UUID) *TeamAuditLogCreate {
+	if id != nil {
+		talc = talc.SetSenderProfileID(*id)
+	}
+	return talc
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (talc *TeamAuditLogCreate) SetSenderProfile(s *SenderProfile) *TeamAuditLogCreate {
+	return talc.SetSenderProfileID(s.ID)
+}
+
+// SetProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID.
+func (talc *TeamAuditLogCreate) SetProviderProfileID(id string) *TeamAuditLogCreate {
+	talc.mutation.SetProviderProfileID(id)
+	return talc
+}
+
+// SetNillableProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (talc *TeamAuditLogCreate) SetNillableProviderProfileID(id *string) *TeamAuditLogCreate {
+	if id != nil {
+		talc = talc.SetProviderProfileID(*id)
+	}
+	return talc
+}
+
+// SetProviderProfile sets the "provider_profile" edge to the ProviderProfile entity.
+func (talc *TeamAuditLogCreate) SetProviderProfile(p *ProviderProfile) *TeamAuditLogCreate {
+	return talc.SetProviderProfileID(p.ID)
+}
+
+// Mutation returns the TeamAuditLogMutation object of the builder.
+func (talc *TeamAuditLogCreate) Mutation() *TeamAuditLogMutation {
+	return talc.mutation
+}
+
+// Save creates the TeamAuditLog in the database.
+func (talc *TeamAuditLogCreate) Save(ctx context.Context) (*TeamAuditLog, error) {
+	talc.defaults()
+	return withHooks(ctx, talc.sqlSave, talc.mutation, talc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (talc *TeamAuditLogCreate) SaveX(ctx context.Context) *TeamAuditLog {
+	v, err := talc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (talc *TeamAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := talc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (talc *TeamAuditLogCreate) ExecX(ctx context.Context) {
+	if err := talc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (talc *TeamAuditLogCreate) defaults() {
+	if _, ok := talc.mutation.CreatedAt(); !ok {
+		v := teamauditlog.DefaultCreatedAt()
+		talc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := talc.mutation.ID(); !ok {
+		v := teamauditlog.DefaultID()
+		talc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (talc *TeamAuditLogCreate) check() error {
+	if _, ok := talc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "TeamAuditLog.action"`)}
+	}
+	if v, ok := talc.mutation.Action(); ok {
+		if err := teamauditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "TeamAuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := talc.mutation.ActorEmail(); !ok {
+		return &ValidationError{Name: "actor_email", err: errors.New(`ent: missing required field "TeamAuditLog.actor_email"`)}
+	}
+	if _, ok := talc.mutation.TargetEmail(); !ok {
+		return &ValidationError{Name: "target_email", err: errors.New(`ent: missing required field "TeamAuditLog.target_email"`)}
+	}
+	if _, ok := talc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TeamAuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (talc *TeamAuditLogCreate) sqlSave(ctx context.Context) (*TeamAuditLog, error) {
+	if err := talc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := talc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, talc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	talc.mutation.id = &_node.ID
+	talc.mutation.done = true
+	return _node, nil
+}
+
+func (talc *TeamAuditLogCreate) createSpec() (*TeamAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamAuditLog{config: talc.config}
+		_spec = sqlgraph.NewCreateSpec(teamauditlog.Table, sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = talc.conflict
+	if id, ok := talc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := talc.mutation.Action(); ok {
+		_spec.SetField(teamauditlog.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := talc.mutation.ActorEmail(); ok {
+		_spec.SetField(teamauditlog.FieldActorEmail, field.TypeString, value)
+		_node.ActorEmail = value
+	}
+	if value, ok := talc.mutation.TargetEmail(); ok {
+		_spec.SetField(teamauditlog.FieldTargetEmail, field.TypeString, value)
+		_node.TargetEmail = value
+	}
+	if value, ok := talc.mutation.Metadata(); ok {
+		_spec.SetField(teamauditlog.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := talc.mutation.CreatedAt(); ok {
+		_spec.SetField(teamauditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := talc.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamauditlog.SenderProfileTable,
+			Columns: []string{teamauditlog.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_team_audit_logs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := talc.mutation.ProviderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teamauditlog.ProviderProfileTable,
+			Columns: []string{teamauditlog.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_team_audit_logs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamAuditLog.Create().
+//		SetAction(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamAuditLogUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (talc *TeamAuditLogCreate) OnConflict(opts ...sql.ConflictOption) *TeamAuditLogUpsertOne {
+	talc.conflict = opts
+	return &TeamAuditLogUpsertOne{
+		create: talc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (talc *TeamAuditLogCreate) OnConflictColumns(columns ...string) *TeamAuditLogUpsertOne {
+	talc.conflict = append(talc.conflict, sql.ConflictColumns(columns...))
+	return &TeamAuditLogUpsertOne{
+		create: talc,
+	}
+}
+
+type (
+	// TeamAuditLogUpsertOne is the builder for "upsert"-ing
+	//  one TeamAuditLog node.
+	TeamAuditLogUpsertOne struct {
+		create *TeamAuditLogCreate
+	}
+
+	// TeamAuditLogUpsert is the "OnConflict" setter.
+	TeamAuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMetadata sets the "metadata" field.
+func (u *TeamAuditLogUpsert) SetMetadata(v map[string]interface{}) *TeamAuditLogUpsert {
+	u.Set(teamauditlog.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TeamAuditLogUpsert) UpdateMetadata() *TeamAuditLogUpsert {
+	u.SetExcluded(teamauditlog.FieldMetadata)
+	return u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TeamAuditLogUpsert) ClearMetadata() *TeamAuditLogUpsert {
+	u.SetNull(teamauditlog.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamAuditLogUpsertOne) UpdateNewValues() *TeamAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teamauditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(teamauditlog.FieldAction)
+		}
+		if _, exists := u.create.mutation.ActorEmail(); exists {
+			s.SetIgnore(teamauditlog.FieldActorEmail)
+		}
+		if _, exists := u.create.mutation.TargetEmail(); exists {
+			s.SetIgnore(teamauditlog.FieldTargetEmail)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teamauditlog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamAuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamAuditLogUpsertOne) Ignore() *TeamAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamAuditLogUpsertOne) DoNothing() *TeamAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamAuditLogCreate.OnConflict
+// documentation for more info.
+func (u *TeamAuditLogUpsertOne) Update(set func(*TeamAuditLogUpsert)) *TeamAuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TeamAuditLogUpsertOne) SetMetadata(v map[string]interface{}) *TeamAuditLogUpsertOne {
+	return u.Update(func(s *TeamAuditLogUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TeamAuditLogUpsertOne) UpdateMetadata() *TeamAuditLogUpsertOne {
+	return u.Update(func(s *TeamAuditLogUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TeamAuditLogUpsertOne) ClearMetadata() *TeamAuditLogUpsertOne {
+	return u.Update(func(s *TeamAuditLogUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamAuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TeamAuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamAuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamAuditLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TeamAuditLogUpsertOne.ID is not supported by MySQL driver. Use TeamAuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamAuditLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamAuditLogCreateBulk is the builder for creating many TeamAuditLog entities in bulk.
+type TeamAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*TeamAuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamAuditLog entities in the database.
+func (talcb *TeamAuditLogCreateBulk) Save(ctx context.Context) ([]*TeamAuditLog, error) {
+	if talcb.err != nil {
+		return nil, talcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(talcb.builders))
+	nodes := make([]*TeamAuditLog, len(talcb.builders))
+	mutators := make([]Mutator, len(talcb.builders))
+	for i := range talcb.builders {
+		func(i int, root context.Context) {
+			builder := talcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, talcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = talcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, talcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, talcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (talcb *TeamAuditLogCreateBulk) SaveX(ctx context.Context) []*TeamAuditLog {
+	v, err := talcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (talcb *TeamAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := talcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (talcb *TeamAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := talcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamAuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamAuditLogUpsert) {
+//			SetAction(v+v).
+//		}).
+//		Exec(ctx)
+func (talcb *TeamAuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamAuditLogUpsertBulk {
+	talcb.conflict = opts
+	return &TeamAuditLogUpsertBulk{
+		create: talcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamAuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (talcb *TeamAuditLogCreateBulk) OnConflictColumns(columns ...string) *TeamAuditLogUpsertBulk {
+	talcb.conflict = append(talcb.conflict, sql.ConflictColumns(columns...))
+	return &TeamAuditLogUpsertBulk{
+		create: talcb,
+	}
+}
+
+// TeamAuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamAuditLog nodes.
+type TeamAuditLogUpsertBulk struct {
+	create *TeamAuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamAuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teamauditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamAuditLogUpsertBulk) UpdateNewValues() *TeamAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teamauditlog.FieldID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(teamauditlog.FieldAction)
+			}
+			if _, exists := b.mutation.ActorEmail(); exists {
+				s.SetIgnore(teamauditlog.FieldActorEmail)
+			}
+			if _, exists := b.mutation.TargetEmail(); exists {
+				s.SetIgnore(teamauditlog.FieldTargetEmail)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teamauditlog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamAuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamAuditLogUpsertBulk) Ignore() *TeamAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamAuditLogUpsertBulk) DoNothing() *TeamAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamAuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamAuditLogUpsertBulk) Update(set func(*TeamAuditLogUpsert)) *TeamAuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamAuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *TeamAuditLogUpsertBulk) SetMetadata(v map[string]interface{}) *TeamAuditLogUpsertBulk {
+	return u.Update(func(s *TeamAuditLogUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *TeamAuditLogUpsertBulk) UpdateMetadata() *TeamAuditLogUpsertBulk {
+	return u.Update(func(s *TeamAuditLogUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *TeamAuditLogUpsertBulk) ClearMetadata() *TeamAuditLogUpsertBulk {
+	return u.Update(func(s *TeamAuditLogUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamAuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TeamAuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TeamAuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamAuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
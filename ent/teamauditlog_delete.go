@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+)
+
+// TeamAuditLogDelete is the builder for deleting a TeamAuditLog entity.
+type TeamAuditLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamAuditLogMutation
+}
+
+// Where appends a list predicates to the TeamAuditLogDelete builder.
+func (tald *TeamAuditLogDelete) Where(ps ...predicate.TeamAuditLog) *TeamAuditLogDelete {
+	tald.mutation.Where(ps...)
+	return tald
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tald *TeamAuditLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tald.sqlExec, tald.mutation, tald.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tald *TeamAuditLogDelete) ExecX(ctx context.Context) int {
+	n, err := tald.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tald *TeamAuditLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teamauditlog.Table, sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID))
+	if ps := tald.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tald.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tald.mutation.done = true
+	return affected, err
+}
+
+// TeamAuditLogDeleteOne is the builder for deleting a single TeamAuditLog entity.
+type TeamAuditLogDeleteOne struct {
+	tald *TeamAuditLogDelete
+}
+
+// Where appends a list predicates to the TeamAuditLogDelete builder.
+func (taldo *TeamAuditLogDeleteOne) Where(ps ...predicate.TeamAuditLog) *TeamAuditLogDeleteOne {
+	taldo.tald.mutation.Where(ps...)
+	return taldo
+}
+
+// Exec executes the deletion query.
+func (taldo *TeamAuditLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := taldo.tald.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teamauditlog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taldo *TeamAuditLogDeleteOne) ExecX(ctx context.Context) {
+	if err := taldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
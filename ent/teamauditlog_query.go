@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+)
+
+// TeamAuditLogQuery is the builder for querying TeamAuditLog entities.
+type TeamAuditLogQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []teamauditlog.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.TeamAuditLog
+	withSenderProfile   *SenderProfileQuery
+	withProviderProfile *ProviderProfileQuery
+	withFKs             bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamAuditLogQuery builder.
+func (talq *TeamAuditLogQuery) Where(ps ...predicate.TeamAuditLog) *TeamAuditLogQuery {
+	talq.predicates = append(talq.predicates, ps...)
+	return talq
+}
+
+// Limit the number of records to be returned by this query.
+func (talq *TeamAuditLogQuery) Limit(limit int) *TeamAuditLogQuery {
+	talq.ctx.Limit = &limit
+	return talq
+}
+
+// Offset to start from.
+func (talq *TeamAuditLogQuery) Offset(offset int) *TeamAuditLogQuery {
+	talq.ctx.Offset = &offset
+	return talq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (talq *TeamAuditLogQuery) Unique(unique bool) *TeamAuditLogQuery {
+	talq.ctx.Unique = &unique
+	return talq
+}
+
+// Order specifies how the records should be ordered.
+func (talq *TeamAuditLogQuery) Order(o ...teamauditlog.OrderOption) *TeamAuditLogQuery {
+	talq.order = append(talq.order, o...)
+	return talq
+}
+
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (talq *TeamAuditLogQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: talq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := talq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := talq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamauditlog.Table, teamauditlog.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamauditlog.SenderProfileTable, teamauditlog.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(talq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProviderProfile chains the current query on the "provider_profile" edge.
+func (talq *TeamAuditLogQuery) QueryProviderProfile() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: talq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := talq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := talq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teamauditlog.Table, teamauditlog.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teamauditlog.ProviderProfileTable, teamauditlog.ProviderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(talq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TeamAuditLog entity from the query.
+// Returns a *NotFoundError when no TeamAuditLog was found.
+func (talq *TeamAuditLogQuery) First(ctx context.Context) (*TeamAuditLog, error) {
+	nodes, err := talq.Limit(1).All(setContextOp(ctx, talq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teamauditlog.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) FirstX(ctx context.Context) *TeamAuditLog {
+	node, err := talq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamAuditLog ID from the query.
+// Returns a *NotFoundError when no TeamAuditLog ID was found.
+func (talq *TeamAuditLogQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = talq.Limit(1).IDs(setContextOp(ctx, talq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teamauditlog.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := talq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamAuditLog entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamAuditLog entity is found.
+// Returns a *NotFoundError when no TeamAuditLog entities are found.
+func (talq *TeamAuditLogQuery) Only(ctx context.Context) (*TeamAuditLog, error) {
+	nodes, err := talq.Limit(2).All(setContextOp(ctx, talq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teamauditlog.Label}
+	default:
+		return nil, &NotSingularError{teamauditlog.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) OnlyX(ctx context.Context) *TeamAuditLog {
+	node, err := talq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamAuditLog ID in the query.
+// Returns a *NotSingularError when more than one TeamAuditLog ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (talq *TeamAuditLogQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = talq.Limit(2).IDs(setContextOp(ctx, talq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teamauditlog.Label}
+	default:
+		err = &NotSingularError{teamauditlog.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := talq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamAuditLogs.
+func (talq *TeamAuditLogQuery) All(ctx context.Context) ([]*TeamAuditLog, error) {
+	ctx = setContextOp(ctx, talq.ctx, ent.OpQueryAll)
+	if err := talq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamAuditLog, *TeamAuditLogQuery]()
+	return withInterceptors[[]*TeamAuditLog](ctx, talq, qr, talq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) AllX(ctx context.Context) []*TeamAuditLog {
+	nodes, err := talq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamAuditLog IDs.
+func (talq *TeamAuditLogQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if talq.ctx.Unique == nil && talq.path != nil {
+		talq.Unique(true)
+	}
+	ctx = setContextOp(ctx, talq.ctx, ent.OpQueryIDs)
+	if err = talq.Select(teamauditlog.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := talq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (talq *TeamAuditLogQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, talq.ctx, ent.OpQueryCount)
+	if err := talq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, talq, querierCount[*TeamAuditLogQuery](), talq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) CountX(ctx context.Context) int {
+	count, err := talq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (talq *TeamAuditLogQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, talq.ctx, ent.OpQueryExist)
+	switch _, err := talq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (talq *TeamAuditLogQuery) ExistX(ctx context.Context) bool {
+	exist, err := talq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamAuditLogQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (talq *TeamAuditLogQuery) Clone() *TeamAuditLogQuery {
+	if talq == nil {
+		return nil
+	}
+	return &TeamAuditLogQuery{
+		config:              talq.config,
+		ctx:                 talq.ctx.Clone(),
+		order:               append([]teamauditlog.OrderOption{}, talq.order...),
+		inters:              append([]Interceptor{}, talq.inters...),
+		predicates:          append([]predicate.TeamAuditLog{}, talq.predicates...),
+		withSenderProfile:   talq.withSenderProfile.Clone(),
+		withProviderProfile: talq.withProviderProfile.Clone(),
+		// clone intermediate query.
+		sql:  talq.sql.Clone(),
+		path: talq.path,
+	}
+}
+
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (talq *TeamAuditLogQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *TeamAuditLogQuery {
+	query := (&SenderProfileClient{config: talq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	talq.withSenderProfile = query
+	return talq
+}
+
+// WithProviderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "provider_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (talq *TeamAuditLogQuery) WithProviderProfile(opts ...func(*ProviderProfileQuery)) *TeamAuditLogQuery {
+	query := (&ProviderProfileClient{config: talq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	talq.withProviderProfile = query
+	return talq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Action teamauditlog.Action `json:"action,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamAuditLog.Query().
+//		GroupBy(teamauditlog.FieldAction).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (talq *TeamAuditLogQuery) GroupBy(field string, fields ...string) *TeamAuditLogGroupBy {
+	talq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamAuditLogGroupBy{build: talq}
+	grbuild.flds = &talq.ctx.Fields
+	grbuild.label = teamauditlog.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Action teamauditlog.Action `json:"action,omitempty"`
+//	}
+//
+//	client.TeamAuditLog.Query().
+//		Select(teamauditlog.FieldAction).
+//		Scan(ctx, &v)
+func (talq *TeamAuditLogQuery) Select(fields ...string) *TeamAuditLogSelect {
+	talq.ctx.Fields = append(talq.ctx.Fields, fields...)
+	sbuild := &TeamAuditLogSelect{TeamAuditLogQuery: talq}
+	sbuild.label = teamauditlog.Label
+	sbuild.flds, sbuild.scan = &talq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamAuditLogSelect configured with the given aggregations.
+func (talq *TeamAuditLogQuery) Aggregate(fns ...AggregateFunc) *TeamAuditLogSelect {
+	return talq.Select().Aggregate(fns...)
+}
+
+func (talq *TeamAuditLogQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range talq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, talq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range talq.ctx.Fields {
+		if !teamauditlog.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if talq.path != nil {
+		prev, err := talq.path(ctx)
+		if err != nil {
+			return err
+		}
+		talq.sql = prev
+	}
+	return nil
+}
+
+func (talq *TeamAuditLogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamAuditLog, error) {
+	var (
+		nodes       = []*TeamAuditLog{}
+		withFKs     = talq.withFKs
+		_spec       = talq.querySpec()
+		loadedTypes = [2]bool{
+			talq.withSenderProfile != nil,
+			talq.withProviderProfile != nil,
+		}
+	)
+	if talq.withSenderProfile != nil || talq.withProviderProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, teamauditlog.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamAuditLog).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamAuditLog{config: talq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, talq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := talq.withSenderProfile; query != nil {
+		if err := talq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *TeamAuditLog, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := talq.withProviderProfile; query != nil {
+		if err := talq.loadProviderProfile(ctx, query, nodes, nil,
+			func(n *TeamAuditLog, e *ProviderProfile) { n.Edges.ProviderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (talq *TeamAuditLogQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*TeamAuditLog, init func(*TeamAuditLog), assign func(*TeamAuditLog, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TeamAuditLog)
+	for i := range nodes {
+		if nodes[i].sender_profile_team_audit_logs == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_team_audit_logs
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_team_audit_logs" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (talq *TeamAuditLogQuery) loadProviderProfile(ctx context.Context, query *ProviderProfileQuery, nodes []*TeamAuditLog, init func(*TeamAuditLog), assign func(*TeamAuditLog, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*TeamAuditLog)
+	for i := range nodes {
+		if nodes[i].provider_profile_team_audit_logs == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_team_audit_logs
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_team_audit_logs" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (talq *TeamAuditLogQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := talq.querySpec()
+	_spec.Node.Columns = talq.ctx.Fields
+	if len(talq.ctx.Fields) > 0 {
+		_spec.Unique = talq.ctx.Unique != nil && *talq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, talq.driver, _spec)
+}
+
+func (talq *TeamAuditLogQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teamauditlog.Table, teamauditlog.Columns, sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID))
+	_spec.From = talq.sql
+	if unique := talq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if talq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := talq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamauditlog.FieldID)
+		for i := range fields {
+			if fields[i] != teamauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := talq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := talq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := talq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := talq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (talq *TeamAuditLogQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(talq.driver.Dialect())
+	t1 := builder.Table(teamauditlog.Table)
+	columns := talq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teamauditlog.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if talq.sql != nil {
+		selector = talq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if talq.ctx.Unique != nil && *talq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range talq.predicates {
+		p(selector)
+	}
+	for _, p := range talq.order {
+		p(selector)
+	}
+	if offset := talq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := talq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TeamAuditLogGroupBy is the group-by builder for TeamAuditLog entities.
+type TeamAuditLogGroupBy struct {
+	selector
+	build *TeamAuditLogQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (talgb *TeamAuditLogGroupBy) Aggregate(fns ...AggregateFunc) *TeamAuditLogGroupBy {
+	talgb.fns = append(talgb.fns, fns...)
+	return talgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (talgb *TeamAuditLogGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, talgb.build.ctx, ent.OpQueryGroupBy)
+	if err := talgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamAuditLogQuery, *TeamAuditLogGroupBy](ctx, talgb.build, talgb, talgb.build.inters, v)
+}
+
+func (talgb *TeamAuditLogGroupBy) sqlScan(ctx context.Context, root *TeamAuditLogQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(talgb.fns))
+	for _, fn := range talgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*talgb.flds)+len(talgb.fns))
+		for _, f := range *talgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*talgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := talgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamAuditLogSelect is the builder for selecting fields of TeamAuditLog entities.
+type TeamAuditLogSelect struct {
+	*TeamAuditLogQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tals *TeamAuditLogSelect) Aggregate(fns ...AggregateFunc) *TeamAuditLogSelect {
+	tals.fns = append(tals.fns, fns...)
+	return tals
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tals *TeamAuditLogSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tals.ctx, ent.OpQuerySelect)
+	if err := tals.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamAuditLogQuery, *TeamAuditLogSelect](ctx, tals.TeamAuditLogQuery, tals, tals.inters, v)
+}
+
+func (tals *TeamAuditLogSelect) sqlScan(ctx context.Context, root *TeamAuditLogQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tals.fns))
+	for _, fn := range tals.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tals.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tals.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
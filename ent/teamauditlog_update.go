@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/teamauditlog"
+)
+
+// TeamAuditLogUpdate is the builder for updating TeamAuditLog entities.
+type TeamAuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamAuditLogMutation
+}
+
+// Where appends a list predicates to the TeamAuditLogUpdate builder.
+func (talu *TeamAuditLogUpdate) Where(ps ...predicate.TeamAuditLog) *TeamAuditLogUpdate {
+	talu.mutation.Where(ps...)
+	return talu
+}
+
+// SetMetadata sets the "metadata" field.
+func (talu *TeamAuditLogUpdate) SetMetadata(m map[string]interface{}) *TeamAuditLogUpdate {
+	talu.mutation.SetMetadata(m)
+	return talu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (talu *TeamAuditLogUpdate) ClearMetadata() *TeamAuditLogUpdate {
+	talu.mutation.ClearMetadata()
+	return talu
+}
+
+// Mutation returns the TeamAuditLogMutation object of the builder.
+func (talu *TeamAuditLogUpdate) Mutation() *TeamAuditLogMutation {
+	return talu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (talu *TeamAuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, talu.sqlSave, talu.mutation, talu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (talu *TeamAuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := talu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (talu *TeamAuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := talu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (talu *TeamAuditLogUpdate) ExecX(ctx context.Context) {
+	if err := talu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (talu *TeamAuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamauditlog.Table, teamauditlog.Columns, sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID))
+	if ps := talu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := talu.mutation.Metadata(); ok {
+		_spec.SetField(teamauditlog.FieldMetadata, field.TypeJSON, value)
+	}
+	if talu.mutation.MetadataCleared() {
+		_spec.ClearField(teamauditlog.FieldMetadata, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, talu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	talu.mutation.done = true
+	return n, nil
+}
+
+// TeamAuditLogUpdateOne is the builder for updating a single TeamAuditLog entity.
+type TeamAuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamAuditLogMutation
+}
+
+// SetMetadata sets the "metadata" field.
+func (taluo *TeamAuditLogUpdateOne) SetMetadata(m map[string]interface{}) *TeamAuditLogUpdateOne {
+	taluo.mutation.SetMetadata(m)
+	return taluo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (taluo *TeamAuditLogUpdateOne) ClearMetadata() *TeamAuditLogUpdateOne {
+	taluo.mutation.ClearMetadata()
+	return taluo
+}
+
+// Mutation returns the TeamAuditLogMutation object of the builder.
+func (taluo *TeamAuditLogUpdateOne) Mutation() *TeamAuditLogMutation {
+	return taluo.mutation
+}
+
+// Where appends a list predicates to the TeamAuditLogUpdate builder.
+func (taluo *TeamAuditLogUpdateOne) Where(ps ...predicate.TeamAuditLog) *TeamAuditLogUpdateOne {
+	taluo.mutation.Where(ps...)
+	return taluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (taluo *TeamAuditLogUpdateOne) Select(field string, fields ...string) *TeamAuditLogUpdateOne {
+	taluo.fields = append([]string{field}, fields...)
+	return taluo
+}
+
+// Save executes the query and returns the updated TeamAuditLog entity.
+func (taluo *TeamAuditLogUpdateOne) Save(ctx context.Context) (*TeamAuditLog, error) {
+	return withHooks(ctx, taluo.sqlSave, taluo.mutation, taluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (taluo *TeamAuditLogUpdateOne) SaveX(ctx context.Context) *TeamAuditLog {
+	node, err := taluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (taluo *TeamAuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := taluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taluo *TeamAuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := taluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (taluo *TeamAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *TeamAuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(teamauditlog.Table, teamauditlog.Columns, sqlgraph.NewFieldSpec(teamauditlog.FieldID, field.TypeUUID))
+	id, ok := taluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamAuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := taluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teamauditlog.FieldID)
+		for _, f := range fields {
+			if !teamauditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teamauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := taluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := taluo.mutation.Metadata(); ok {
+		_spec.SetField(teamauditlog.FieldMetadata, field.TypeJSON, value)
+	}
+	if taluo.mutation.MetadataCleared() {
+		_spec.ClearField(teamauditlog.FieldMetadata, field.TypeJSON)
+	}
+	_node = &TeamAuditLog{config: taluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, taluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teamauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	taluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/user"
+)
+
+// TeamInvitation is the model entity for the TeamInvitation schema.
+type TeamInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Role holds the value of the "role" field.
+	Role teaminvitation.Role `json:"role,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// Status holds the value of the "status" field.
+	Status teaminvitation.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamInvitationQuery when eager-loading is set.
+	Edges                             TeamInvitationEdges `json:"edges"`
+	provider_profile_team_invitations *string
+	sender_profile_team_invitations   *uuid.UUID
+	user_sent_team_invitations        *uuid.UUID
+	selectValues                      sql.SelectValues
+}
+
+// TeamInvitationEdges holds the relations/edges for other nodes in the graph.
+type TeamInvitationEdges struct {
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// ProviderProfile holds the value of the provider_profile edge.
+	ProviderProfile *ProviderProfile `json:"provider_profile,omitempty"`
+	// InvitedBy holds the value of the invited_by edge.
+	InvitedBy *User `json:"invited_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamInvitationEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// ProviderProfileOrErr returns the ProviderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamInvitationEdges) ProviderProfileOrErr() (*ProviderProfile, error) {
+	if e.ProviderProfile != nil {
+		return e.ProviderProfile, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider_profile"}
+}
+
+// InvitedByOrErr returns the InvitedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamInvitationEdges) InvitedByOrErr() (*User, error) {
+	if e.InvitedBy != nil {
+		return e.InvitedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "invited_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teaminvitation.FieldEmail, teaminvitation.FieldRole, teaminvitation.FieldToken, teaminvitation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case teaminvitation.FieldCreatedAt, teaminvitation.FieldUpdatedAt, teaminvitation.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case teaminvitation.FieldID:
+			values[i] = new(uuid.UUID)
+		case teaminvitation.ForeignKeys[0]: // provider_profile_team_invitations
+			values[i] = new(sql.NullString)
+		case teaminvitation.ForeignKeys[1]: // sender_profile_team_invitations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case teaminvitation.ForeignKeys[2]: // user_sent_team_invitations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamInvitation fields.
+func (ti *TeamInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teaminvitation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ti.ID = *value
+			}
+		case teaminvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ti.CreatedAt = value.Time
+			}
+		case teaminvitation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ti.UpdatedAt = value.Time
+			}
+		case teaminvitation.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ti.Email = value.String
+			}
+		case teaminvitation.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				ti.Role = teaminvitation.Role(value.String)
+			}
+		case teaminvitation.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				ti.Token = value.String
+			}
+		case teaminvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ti.Status = teaminvitation.Status(value.String)
+			}
+		case teaminvitation.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ti.ExpiresAt = value.Time
+			}
+		case teaminvitation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_team_invitations", values[i])
+			} else if value.Valid {
+				ti.provider_profile_team_invitations = new(string)
+				*ti.provider_profile_team_invitations = value.String
+			}
+		case teaminvitation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_team_invitations", values[i])
+			} else if value.Valid {
+				ti.sender_profile_team_invitations = new(uuid.UUID)
+				*ti.sender_profile_team_invitations = *value.S.(*uuid.UUID)
+			}
+		case teaminvitation.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_sent_team_invitations", values[i])
+			} else if value.Valid {
+				ti.user_sent_team_invitations = new(uuid.UUID)
+				*ti.user_sent_team_invitations = *value.S.(*uuid.UUID)
+			}
+		default:
+			ti.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamInvitation.
+// This includes values selected through modifiers, order, etc.
+func (ti *TeamInvitation) Value(name string) (ent.Value, error) {
+	return ti.selectValues.Get(name)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the TeamInvitation entity.
+func (ti *TeamInvitation) QuerySenderProfile() *SenderProfileQuery {
+	return NewTeamInvitationClient(ti.config).QuerySenderProfile(ti)
+}
+
+// QueryProviderProfile queries the "provider_profile" edge of the TeamInvitation entity.
+func (ti *TeamInvitation) QueryProviderProfile() *ProviderProfileQuery {
+	return NewTeamInvitationClient(ti.config).QueryProviderProfile(ti)
+}
+
+// QueryInvitedBy queries the "invited_by" edge of the TeamInvitation entity.
+func (ti *TeamInvitation) QueryInvitedBy() *UserQuery {
+	return NewTeamInvitationClient(ti.config).QueryInvitedBy(ti)
+}
+
+// Update returns a builder for updating this TeamInvitation.
+// Note that you need to call TeamInvitation.Unwrap() before calling this method if this TeamInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ti *TeamInvitation) Update() *TeamInvitationUpdateOne {
+	return NewTeamInvitationClient(ti.config).UpdateOne(ti)
+}
+
+// Unwrap unwraps the TeamInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ti *TeamInvitation) Unwrap() *TeamInvitation {
+	_tx, ok := ti.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamInvitation is not a transactional entity")
+	}
+	ti.config.driver = _tx.drv
+	return ti
+}
+
+// String implements the fmt.Stringer.
+func (ti *TeamInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ti.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ti.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ti.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ti.Email)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", ti.Role))
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ti.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ti.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamInvitations is a parsable slice of TeamInvitation.
+type TeamInvitations []*TeamInvitation
@@ -0,0 +1,928 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/user"
+)
+
+// TeamInvitationCreate is the builder for creating a TeamInvitation entity.
+type TeamInvitationCreate struct {
+	config
+	mutation *TeamInvitationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tic *TeamInvitationCreate) SetCreatedAt(t time.Time) *TeamInvitationCreate {
+	tic.mutation.SetCreatedAt(t)
+	return tic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableCreatedAt(t *time.Time) *TeamInvitationCreate {
+	if t != nil {
+		tic.SetCreatedAt(*t)
+	}
+	return tic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tic *TeamInvitationCreate) SetUpdatedAt(t time.Time) *TeamInvitationCreate {
+	tic.mutation.SetUpdatedAt(t)
+	return tic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableUpdatedAt(t *time.Time) *TeamInvitationCreate {
+	if t != nil {
+		tic.SetUpdatedAt(*t)
+	}
+	return tic
+}
+
+// SetEmail sets the "email" field.
+func (tic *TeamInvitationCreate) SetEmail(s string) *TeamInvitationCreate {
+	tic.mutation.SetEmail(s)
+	return tic
+}
+
+// SetRole sets the "role" field.
+func (tic *TeamInvitationCreate) SetRole(t teaminvitation.Role) *TeamInvitationCreate {
+	tic.mutation.SetRole(t)
+	return tic
+}
+
+// SetToken sets the "token" field.
+func (tic *TeamInvitationCreate) SetToken(s string) *TeamInvitationCreate {
+	tic.mutation.SetToken(s)
+	return tic
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableToken(s *string) *TeamInvitationCreate {
+	if s != nil {
+		tic.SetToken(*s)
+	}
+	return tic
+}
+
+// SetStatus sets the "status" field.
+func (tic *TeamInvitationCreate) SetStatus(t teaminvitation.Status) *TeamInvitationCreate {
+	tic.mutation.SetStatus(t)
+	return tic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableStatus(t *teaminvitation.Status) *TeamInvitationCreate {
+	if t != nil {
+		tic.SetStatus(*t)
+	}
+	return tic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tic *TeamInvitationCreate) SetExpiresAt(t time.Time) *TeamInvitationCreate {
+	tic.mutation.SetExpiresAt(t)
+	return tic
+}
+
+// SetID sets the "id" field.
+func (tic *TeamInvitationCreate) SetID(u uuid.UUID) *TeamInvitationCreate {
+	tic.mutation.SetID(u)
+	return tic
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableID(u *uuid.UUID) *TeamInvitationCreate {
+	if u != nil {
+		tic.SetID(*u)
+	}
+	return tic
+}
+
+// SetSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID.
+func (tic *TeamInvitationCreate) SetSenderProfileID(id uuid.UUID) *TeamInvitationCreate {
+	tic.mutation.SetSenderProfileID(id)
+	return tic
+}
+
+// SetNillableSenderProfileID sets the "sender_profile" edge to the SenderProfile entity by ID if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableSenderProfileID(id *uuid.UUID) *TeamInvitationCreate {
+	if id != nil {
+		tic = tic.SetSenderProfileID(*id)
+	}
+	return tic
+}
+
+// SetSenderProfile sets the "sender_profile" edge to the SenderProfile entity.
+func (tic *TeamInvitationCreate) SetSenderProfile(s *SenderProfile) *TeamInvitationCreate {
+	return tic.SetSenderProfileID(s.ID)
+}
+
+// SetProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID.
+func (tic *TeamInvitationCreate) SetProviderProfileID(id string) *TeamInvitationCreate {
+	tic.mutation.SetProviderProfileID(id)
+	return tic
+}
+
+// SetNillableProviderProfileID sets the "provider_profile" edge to the ProviderProfile entity by ID if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableProviderProfileID(id *string) *TeamInvitationCreate {
+	if id != nil {
+		tic = tic.SetProviderProfileID(*id)
+	}
+	return tic
+}
+
+// SetProviderProfile sets the "provider_profile" edge to the ProviderProfile entity.
+func (tic *TeamInvitationCreate) SetProviderProfile(p *ProviderProfile) *TeamInvitationCreate {
+	return tic.SetProviderProfileID(p.ID)
+}
+
+// SetInvitedByID sets the "invited_by" edge to the User entity by ID.
+func (tic *TeamInvitationCreate) SetInvitedByID(id uuid.UUID) *TeamInvitationCreate {
+	tic.mutation.SetInvitedByID(id)
+	return tic
+}
+
+// SetNillableInvitedByID sets the "invited_by" edge to the User entity by ID if the given value is not nil.
+func (tic *TeamInvitationCreate) SetNillableInvitedByID(id *uuid.UUID) *TeamInvitationCreate {
+	if id != nil {
+		tic = tic.SetInvitedByID(*id)
+	}
+	return tic
+}
+
+// SetInvitedBy sets the "invited_by" edge to the User entity.
+func (tic *TeamInvitationCreate) SetInvitedBy(u *User) *TeamInvitationCreate {
+	return tic.SetInvitedByID(u.ID)
+}
+
+// Mutation returns the TeamInvitationMutation object of the builder.
+func (tic *TeamInvitationCreate) Mutation() *TeamInvitationMutation {
+	return tic.mutation
+}
+
+// Save creates the TeamInvitation in the database.
+func (tic *TeamInvitationCreate) Save(ctx context.Context) (*TeamInvitation, error) {
+	tic.defaults()
+	return withHooks(ctx, tic.sqlSave, tic.mutation, tic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tic *TeamInvitationCreate) SaveX(ctx context.Context) *TeamInvitation {
+	v, err := tic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tic *TeamInvitationCreate) Exec(ctx context.Context) error {
+	_, err := tic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tic *TeamInvitationCreate) ExecX(ctx context.Context) {
+	if err := tic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tic *TeamInvitationCreate) defaults() {
+	if _, ok := tic.mutation.CreatedAt(); !ok {
+		v := teaminvitation.DefaultCreatedAt()
+		tic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tic.mutation.UpdatedAt(); !ok {
+		v := teaminvitation.DefaultUpdatedAt()
+		tic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tic.mutation.Token(); !ok {
+		v := teaminvitation.DefaultToken()
+		tic.mutation.SetToken(v)
+	}
+	if _, ok := tic.mutation.Status(); !ok {
+		v := teaminvitation.DefaultStatus
+		tic.mutation.SetStatus(v)
+	}
+	if _, ok := tic.mutation.ID(); !ok {
+		v := teaminvitation.DefaultID()
+		tic.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tic *TeamInvitationCreate) check() error {
+	if _, ok := tic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TeamInvitation.created_at"`)}
+	}
+	if _, ok := tic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TeamInvitation.updated_at"`)}
+	}
+	if _, ok := tic.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "TeamInvitation.email"`)}
+	}
+	if _, ok := tic.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "TeamInvitation.role"`)}
+	}
+	if v, ok := tic.mutation.Role(); ok {
+		if err := teaminvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamInvitation.role": %w`, err)}
+		}
+	}
+	if _, ok := tic.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "TeamInvitation.token"`)}
+	}
+	if _, ok := tic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TeamInvitation.status"`)}
+	}
+	if v, ok := tic.mutation.Status(); ok {
+		if err := teaminvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TeamInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := tic.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "TeamInvitation.expires_at"`)}
+	}
+	return nil
+}
+
+func (tic *TeamInvitationCreate) sqlSave(ctx context.Context) (*TeamInvitation, error) {
+	if err := tic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tic.mutation.id = &_node.ID
+	tic.mutation.done = true
+	return _node, nil
+}
+
+func (tic *TeamInvitationCreate) createSpec() (*TeamInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TeamInvitation{config: tic.config}
+		_spec = sqlgraph.NewCreateSpec(teaminvitation.Table, sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tic.conflict
+	if id, ok := tic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tic.mutation.CreatedAt(); ok {
+		_spec.SetField(teaminvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tic.mutation.UpdatedAt(); ok {
+		_spec.SetField(teaminvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tic.mutation.Email(); ok {
+		_spec.SetField(teaminvitation.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := tic.mutation.Role(); ok {
+		_spec.SetField(teaminvitation.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := tic.mutation.Token(); ok {
+		_spec.SetField(teaminvitation.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := tic.mutation.Status(); ok {
+		_spec.SetField(teaminvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tic.mutation.ExpiresAt(); ok {
+		_spec.SetField(teaminvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := tic.mutation.SenderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teaminvitation.SenderProfileTable,
+			Columns: []string{teaminvitation.SenderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(senderprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.sender_profile_team_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.ProviderProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teaminvitation.ProviderProfileTable,
+			Columns: []string{teaminvitation.ProviderProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(providerprofile.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.provider_profile_team_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tic.mutation.InvitedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   teaminvitation.InvitedByTable,
+			Columns: []string{teaminvitation.InvitedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sent_team_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamInvitation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamInvitationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tic *TeamInvitationCreate) OnConflict(opts ...sql.ConflictOption) *TeamInvitationUpsertOne {
+	tic.conflict = opts
+	return &TeamInvitationUpsertOne{
+		create: tic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamInvitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tic *TeamInvitationCreate) OnConflictColumns(columns ...string) *TeamInvitationUpsertOne {
+	tic.conflict = append(tic.conflict, sql.ConflictColumns(columns...))
+	return &TeamInvitationUpsertOne{
+		create: tic,
+	}
+}
+
+type (
+	// TeamInvitationUpsertOne is the builder for "upsert"-ing
+	//  one TeamInvitation node.
+	TeamInvitationUpsertOne struct {
+		create *TeamInvitationCreate
+	}
+
+	// TeamInvitationUpsert is the "OnConflict" setter.
+	TeamInvitationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamInvitationUpsert) SetUpdatedAt(v time.Time) *TeamInvitationUpsert {
+	u.Set(teaminvitation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamInvitationUpsert) UpdateUpdatedAt() *TeamInvitationUpsert {
+	u.SetExcluded(teaminvitation.FieldUpdatedAt)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *TeamInvitationUpsert) SetEmail(v string) *TeamInvitationUpsert {
+	u.Set(teaminvitation.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *TeamInvitationUpsert) UpdateEmail() *TeamInvitationUpsert {
+	u.SetExcluded(teaminvitation.FieldEmail)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *TeamInvitationUpsert) SetRole(v teaminvitation.Role) *TeamInvitationUpsert {
+	u.Set(teaminvitation.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *TeamInvitationUpsert) UpdateRole() *TeamInvitationUpsert {
+	u.SetExcluded(teaminvitation.FieldRole)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TeamInvitationUpsert) SetStatus(v teaminvitation.Status) *TeamInvitationUpsert {
+	u.Set(teaminvitation.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TeamInvitationUpsert) UpdateStatus() *TeamInvitationUpsert {
+	u.SetExcluded(teaminvitation.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamInvitationUpsert) SetExpiresAt(v time.Time) *TeamInvitationUpsert {
+	u.Set(teaminvitation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamInvitationUpsert) UpdateExpiresAt() *TeamInvitationUpsert {
+	u.SetExcluded(teaminvitation.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TeamInvitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teaminvitation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamInvitationUpsertOne) UpdateNewValues() *TeamInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(teaminvitation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(teaminvitation.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Token(); exists {
+			s.SetIgnore(teaminvitation.FieldToken)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamInvitation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TeamInvitationUpsertOne) Ignore() *TeamInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamInvitationUpsertOne) DoNothing() *TeamInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamInvitationCreate.OnConflict
+// documentation for more info.
+func (u *TeamInvitationUpsertOne) Update(set func(*TeamInvitationUpsert)) *TeamInvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamInvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamInvitationUpsertOne) SetUpdatedAt(v time.Time) *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamInvitationUpsertOne) UpdateUpdatedAt() *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *TeamInvitationUpsertOne) SetEmail(v string) *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *TeamInvitationUpsertOne) UpdateEmail() *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *TeamInvitationUpsertOne) SetRole(v teaminvitation.Role) *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *TeamInvitationUpsertOne) UpdateRole() *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TeamInvitationUpsertOne) SetStatus(v teaminvitation.Status) *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TeamInvitationUpsertOne) UpdateStatus() *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamInvitationUpsertOne) SetExpiresAt(v time.Time) *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamInvitationUpsertOne) UpdateExpiresAt() *TeamInvitationUpsertOne {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamInvitationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TeamInvitationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamInvitationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TeamInvitationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TeamInvitationUpsertOne.ID is not supported by MySQL driver. Use TeamInvitationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TeamInvitationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TeamInvitationCreateBulk is the builder for creating many TeamInvitation entities in bulk.
+type TeamInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*TeamInvitationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TeamInvitation entities in the database.
+func (ticb *TeamInvitationCreateBulk) Save(ctx context.Context) ([]*TeamInvitation, error) {
+	if ticb.err != nil {
+		return nil, ticb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ticb.builders))
+	nodes := make([]*TeamInvitation, len(ticb.builders))
+	mutators := make([]Mutator, len(ticb.builders))
+	for i := range ticb.builders {
+		func(i int, root context.Context) {
+			builder := ticb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TeamInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ticb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ticb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ticb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ticb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ticb *TeamInvitationCreateBulk) SaveX(ctx context.Context) []*TeamInvitation {
+	v, err := ticb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ticb *TeamInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ticb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ticb *TeamInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := ticb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TeamInvitation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TeamInvitationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ticb *TeamInvitationCreateBulk) OnConflict(opts ...sql.ConflictOption) *TeamInvitationUpsertBulk {
+	ticb.conflict = opts
+	return &TeamInvitationUpsertBulk{
+		create: ticb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TeamInvitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ticb *TeamInvitationCreateBulk) OnConflictColumns(columns ...string) *TeamInvitationUpsertBulk {
+	ticb.conflict = append(ticb.conflict, sql.ConflictColumns(columns...))
+	return &TeamInvitationUpsertBulk{
+		create: ticb,
+	}
+}
+
+// TeamInvitationUpsertBulk is the builder for "upsert"-ing
+// a bulk of TeamInvitation nodes.
+type TeamInvitationUpsertBulk struct {
+	create *TeamInvitationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TeamInvitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(teaminvitation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TeamInvitationUpsertBulk) UpdateNewValues() *TeamInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(teaminvitation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(teaminvitation.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Token(); exists {
+				s.SetIgnore(teaminvitation.FieldToken)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TeamInvitation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TeamInvitationUpsertBulk) Ignore() *TeamInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TeamInvitationUpsertBulk) DoNothing() *TeamInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TeamInvitationCreateBulk.OnConflict
+// documentation for more info.
+func (u *TeamInvitationUpsertBulk) Update(set func(*TeamInvitationUpsert)) *TeamInvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TeamInvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TeamInvitationUpsertBulk) SetUpdatedAt(v time.Time) *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TeamInvitationUpsertBulk) UpdateUpdatedAt() *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *TeamInvitationUpsertBulk) SetEmail(v string) *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *TeamInvitationUpsertBulk) UpdateEmail() *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *TeamInvitationUpsertBulk) SetRole(v teaminvitation.Role) *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *TeamInvitationUpsertBulk) UpdateRole() *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TeamInvitationUpsertBulk) SetStatus(v teaminvitation.Status) *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TeamInvitationUpsertBulk) UpdateStatus() *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *TeamInvitationUpsertBulk) SetExpiresAt(v time.Time) *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *TeamInvitationUpsertBulk) UpdateExpiresAt() *TeamInvitationUpsertBulk {
+	return u.Update(func(s *TeamInvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TeamInvitationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TeamInvitationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TeamInvitationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TeamInvitationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
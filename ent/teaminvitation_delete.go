@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+)
+
+// TeamInvitationDelete is the builder for deleting a TeamInvitation entity.
+type TeamInvitationDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamInvitationMutation
+}
+
+// Where appends a list predicates to the TeamInvitationDelete builder.
+func (tid *TeamInvitationDelete) Where(ps ...predicate.TeamInvitation) *TeamInvitationDelete {
+	tid.mutation.Where(ps...)
+	return tid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tid *TeamInvitationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tid.sqlExec, tid.mutation, tid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tid *TeamInvitationDelete) ExecX(ctx context.Context) int {
+	n, err := tid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tid *TeamInvitationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teaminvitation.Table, sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID))
+	if ps := tid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tid.mutation.done = true
+	return affected, err
+}
+
+// TeamInvitationDeleteOne is the builder for deleting a single TeamInvitation entity.
+type TeamInvitationDeleteOne struct {
+	tid *TeamInvitationDelete
+}
+
+// Where appends a list predicates to the TeamInvitationDelete builder.
+func (tido *TeamInvitationDeleteOne) Where(ps ...predicate.TeamInvitation) *TeamInvitationDeleteOne {
+	tido.tid.mutation.Where(ps...)
+	return tido
+}
+
+// Exec executes the deletion query.
+func (tido *TeamInvitationDeleteOne) Exec(ctx context.Context) error {
+	n, err := tido.tid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teaminvitation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tido *TeamInvitationDeleteOne) ExecX(ctx context.Context) {
+	if err := tido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/user"
+)
+
+// TeamInvitationQuery is the builder for querying TeamInvitation entities.
+type TeamInvitationQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []teaminvitation.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.TeamInvitation
+	withSenderProfile   *SenderProfileQuery
+	withProviderProfile *ProviderProfileQuery
+	withInvitedBy       *UserQuery
+	withFKs             bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TeamInvitationQuery builder.
+func (tiq *TeamInvitationQuery) Where(ps ...predicate.TeamInvitation) *TeamInvitationQuery {
+	tiq.predicates = append(tiq.predicates, ps...)
+	return tiq
+}
+
+// Limit the number of records to be returned by this query.
+func (tiq *TeamInvitationQuery) Limit(limit int) *TeamInvitationQuery {
+	tiq.ctx.Limit = &limit
+	return tiq
+}
+
+// Offset to start from.
+func (tiq *TeamInvitationQuery) Offset(offset int) *TeamInvitationQuery {
+	tiq.ctx.Offset = &offset
+	return tiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tiq *TeamInvitationQuery) Unique(unique bool) *TeamInvitationQuery {
+	tiq.ctx.Unique = &unique
+	return tiq
+}
+
+// Order specifies how the records should be ordered.
+func (tiq *TeamInvitationQuery) Order(o ...teaminvitation.OrderOption) *TeamInvitationQuery {
+	tiq.order = append(tiq.order, o...)
+	return tiq
+}
+
+// QuerySenderProfile chains the current query on the "sender_profile" edge.
+func (tiq *TeamInvitationQuery) QuerySenderProfile() *SenderProfileQuery {
+	query := (&SenderProfileClient{config: tiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teaminvitation.Table, teaminvitation.FieldID, selector),
+			sqlgraph.To(senderprofile.Table, senderprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teaminvitation.SenderProfileTable, teaminvitation.SenderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProviderProfile chains the current query on the "provider_profile" edge.
+func (tiq *TeamInvitationQuery) QueryProviderProfile() *ProviderProfileQuery {
+	query := (&ProviderProfileClient{config: tiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teaminvitation.Table, teaminvitation.FieldID, selector),
+			sqlgraph.To(providerprofile.Table, providerprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teaminvitation.ProviderProfileTable, teaminvitation.ProviderProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitedBy chains the current query on the "invited_by" edge.
+func (tiq *TeamInvitationQuery) QueryInvitedBy() *UserQuery {
+	query := (&UserClient{config: tiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(teaminvitation.Table, teaminvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, teaminvitation.InvitedByTable, teaminvitation.InvitedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TeamInvitation entity from the query.
+// Returns a *NotFoundError when no TeamInvitation was found.
+func (tiq *TeamInvitationQuery) First(ctx context.Context) (*TeamInvitation, error) {
+	nodes, err := tiq.Limit(1).All(setContextOp(ctx, tiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{teaminvitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) FirstX(ctx context.Context) *TeamInvitation {
+	node, err := tiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TeamInvitation ID from the query.
+// Returns a *NotFoundError when no TeamInvitation ID was found.
+func (tiq *TeamInvitationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tiq.Limit(1).IDs(setContextOp(ctx, tiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{teaminvitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TeamInvitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TeamInvitation entity is found.
+// Returns a *NotFoundError when no TeamInvitation entities are found.
+func (tiq *TeamInvitationQuery) Only(ctx context.Context) (*TeamInvitation, error) {
+	nodes, err := tiq.Limit(2).All(setContextOp(ctx, tiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{teaminvitation.Label}
+	default:
+		return nil, &NotSingularError{teaminvitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) OnlyX(ctx context.Context) *TeamInvitation {
+	node, err := tiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TeamInvitation ID in the query.
+// Returns a *NotSingularError when more than one TeamInvitation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tiq *TeamInvitationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tiq.Limit(2).IDs(setContextOp(ctx, tiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{teaminvitation.Label}
+	default:
+		err = &NotSingularError{teaminvitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TeamInvitations.
+func (tiq *TeamInvitationQuery) All(ctx context.Context) ([]*TeamInvitation, error) {
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryAll)
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TeamInvitation, *TeamInvitationQuery]()
+	return withInterceptors[[]*TeamInvitation](ctx, tiq, qr, tiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) AllX(ctx context.Context) []*TeamInvitation {
+	nodes, err := tiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TeamInvitation IDs.
+func (tiq *TeamInvitationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tiq.ctx.Unique == nil && tiq.path != nil {
+		tiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryIDs)
+	if err = tiq.Select(teaminvitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tiq *TeamInvitationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryCount)
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tiq, querierCount[*TeamInvitationQuery](), tiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) CountX(ctx context.Context) int {
+	count, err := tiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tiq *TeamInvitationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tiq.ctx, ent.OpQueryExist)
+	switch _, err := tiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tiq *TeamInvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := tiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TeamInvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tiq *TeamInvitationQuery) Clone() *TeamInvitationQuery {
+	if tiq == nil {
+		return nil
+	}
+	return &TeamInvitationQuery{
+		config:              tiq.config,
+		ctx:                 tiq.ctx.Clone(),
+		order:               append([]teaminvitation.OrderOption{}, tiq.order...),
+		inters:              append([]Interceptor{}, tiq.inters...),
+		predicates:          append([]predicate.TeamInvitation{}, tiq.predicates...),
+		withSenderProfile:   tiq.withSenderProfile.Clone(),
+		withProviderProfile: tiq.withProviderProfile.Clone(),
+		withInvitedBy:       tiq.withInvitedBy.Clone(),
+		// clone intermediate query.
+		sql:  tiq.sql.Clone(),
+		path: tiq.path,
+	}
+}
+
+// WithSenderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "sender_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TeamInvitationQuery) WithSenderProfile(opts ...func(*SenderProfileQuery)) *TeamInvitationQuery {
+	query := (&SenderProfileClient{config: tiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withSenderProfile = query
+	return tiq
+}
+
+// WithProviderProfile tells the query-builder to eager-load the nodes that are connected to
+// the "provider_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TeamInvitationQuery) WithProviderProfile(opts ...func(*ProviderProfileQuery)) *TeamInvitationQuery {
+	query := (&ProviderProfileClient{config: tiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withProviderProfile = query
+	return tiq
+}
+
+// WithInvitedBy tells the query-builder to eager-load the nodes that are connected to
+// the "invited_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tiq *TeamInvitationQuery) WithInvitedBy(opts ...func(*UserQuery)) *TeamInvitationQuery {
+	query := (&UserClient{config: tiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tiq.withInvitedBy = query
+	return tiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TeamInvitation.Query().
+//		GroupBy(teaminvitation.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tiq *TeamInvitationQuery) GroupBy(field string, fields ...string) *TeamInvitationGroupBy {
+	tiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TeamInvitationGroupBy{build: tiq}
+	grbuild.flds = &tiq.ctx.Fields
+	grbuild.label = teaminvitation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TeamInvitation.Query().
+//		Select(teaminvitation.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tiq *TeamInvitationQuery) Select(fields ...string) *TeamInvitationSelect {
+	tiq.ctx.Fields = append(tiq.ctx.Fields, fields...)
+	sbuild := &TeamInvitationSelect{TeamInvitationQuery: tiq}
+	sbuild.label = teaminvitation.Label
+	sbuild.flds, sbuild.scan = &tiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TeamInvitationSelect configured with the given aggregations.
+func (tiq *TeamInvitationQuery) Aggregate(fns ...AggregateFunc) *TeamInvitationSelect {
+	return tiq.Select().Aggregate(fns...)
+}
+
+func (tiq *TeamInvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tiq.ctx.Fields {
+		if !teaminvitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tiq.path != nil {
+		prev, err := tiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tiq.sql = prev
+	}
+	return nil
+}
+
+func (tiq *TeamInvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TeamInvitation, error) {
+	var (
+		nodes       = []*TeamInvitation{}
+		withFKs     = tiq.withFKs
+		_spec       = tiq.querySpec()
+		loadedTypes = [3]bool{
+			tiq.withSenderProfile != nil,
+			tiq.withProviderProfile != nil,
+			tiq.withInvitedBy != nil,
+		}
+	)
+	if tiq.withSenderProfile != nil || tiq.withProviderProfile != nil || tiq.withInvitedBy != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, teaminvitation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TeamInvitation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TeamInvitation{config: tiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tiq.withSenderProfile; query != nil {
+		if err := tiq.loadSenderProfile(ctx, query, nodes, nil,
+			func(n *TeamInvitation, e *SenderProfile) { n.Edges.SenderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tiq.withProviderProfile; query != nil {
+		if err := tiq.loadProviderProfile(ctx, query, nodes, nil,
+			func(n *TeamInvitation, e *ProviderProfile) { n.Edges.ProviderProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tiq.withInvitedBy; query != nil {
+		if err := tiq.loadInvitedBy(ctx, query, nodes, nil,
+			func(n *TeamInvitation, e *User) { n.Edges.InvitedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tiq *TeamInvitationQuery) loadSenderProfile(ctx context.Context, query *SenderProfileQuery, nodes []*TeamInvitation, init func(*TeamInvitation), assign func(*TeamInvitation, *SenderProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TeamInvitation)
+	for i := range nodes {
+		if nodes[i].sender_profile_team_invitations == nil {
+			continue
+		}
+		fk := *nodes[i].sender_profile_team_invitations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(senderprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sender_profile_team_invitations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tiq *TeamInvitationQuery) loadProviderProfile(ctx context.Context, query *ProviderProfileQuery, nodes []*TeamInvitation, init func(*TeamInvitation), assign func(*TeamInvitation, *ProviderProfile)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*TeamInvitation)
+	for i := range nodes {
+		if nodes[i].provider_profile_team_invitations == nil {
+			continue
+		}
+		fk := *nodes[i].provider_profile_team_invitations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(providerprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "provider_profile_team_invitations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tiq *TeamInvitationQuery) loadInvitedBy(ctx context.Context, query *UserQuery, nodes []*TeamInvitation, init func(*TeamInvitation), assign func(*TeamInvitation, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TeamInvitation)
+	for i := range nodes {
+		if nodes[i].user_sent_team_invitations == nil {
+			continue
+		}
+		fk := *nodes[i].user_sent_team_invitations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_sent_team_invitations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tiq *TeamInvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tiq.querySpec()
+	_spec.Node.Columns = tiq.ctx.Fields
+	if len(tiq.ctx.Fields) > 0 {
+		_spec.Unique = tiq.ctx.Unique != nil && *tiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tiq.driver, _spec)
+}
+
+func (tiq *TeamInvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(teaminvitation.Table, teaminvitation.Columns, sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID))
+	_spec.From = tiq.sql
+	if unique := tiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teaminvitation.FieldID)
+		for i := range fields {
+			if fields[i] != teaminvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tiq *TeamInvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tiq.driver.Dialect())
+	t1 := builder.Table(teaminvitation.Table)
+	columns := tiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = teaminvitation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tiq.sql != nil {
+		selector = tiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tiq.ctx.Unique != nil && *tiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tiq.predicates {
+		p(selector)
+	}
+	for _, p := range tiq.order {
+		p(selector)
+	}
+	if offset := tiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TeamInvitationGroupBy is the group-by builder for TeamInvitation entities.
+type TeamInvitationGroupBy struct {
+	selector
+	build *TeamInvitationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tigb *TeamInvitationGroupBy) Aggregate(fns ...AggregateFunc) *TeamInvitationGroupBy {
+	tigb.fns = append(tigb.fns, fns...)
+	return tigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tigb *TeamInvitationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tigb.build.ctx, ent.OpQueryGroupBy)
+	if err := tigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamInvitationQuery, *TeamInvitationGroupBy](ctx, tigb.build, tigb, tigb.build.inters, v)
+}
+
+func (tigb *TeamInvitationGroupBy) sqlScan(ctx context.Context, root *TeamInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tigb.fns))
+	for _, fn := range tigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tigb.flds)+len(tigb.fns))
+		for _, f := range *tigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TeamInvitationSelect is the builder for selecting fields of TeamInvitation entities.
+type TeamInvitationSelect struct {
+	*TeamInvitationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tis *TeamInvitationSelect) Aggregate(fns ...AggregateFunc) *TeamInvitationSelect {
+	tis.fns = append(tis.fns, fns...)
+	return tis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tis *TeamInvitationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tis.ctx, ent.OpQuerySelect)
+	if err := tis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TeamInvitationQuery, *TeamInvitationSelect](ctx, tis.TeamInvitationQuery, tis, tis.inters, v)
+}
+
+func (tis *TeamInvitationSelect) sqlScan(ctx context.Context, root *TeamInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tis.fns))
+	for _, fn := range tis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+)
+
+// TeamInvitationUpdate is the builder for updating TeamInvitation entities.
+type TeamInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamInvitationMutation
+}
+
+// Where appends a list predicates to the TeamInvitationUpdate builder.
+func (tiu *TeamInvitationUpdate) Where(ps ...predicate.TeamInvitation) *TeamInvitationUpdate {
+	tiu.mutation.Where(ps...)
+	return tiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiu *TeamInvitationUpdate) SetUpdatedAt(t time.Time) *TeamInvitationUpdate {
+	tiu.mutation.SetUpdatedAt(t)
+	return tiu
+}
+
+// SetEmail sets the "email" field.
+func (tiu *TeamInvitationUpdate) SetEmail(s string) *TeamInvitationUpdate {
+	tiu.mutation.SetEmail(s)
+	return tiu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tiu *TeamInvitationUpdate) SetNillableEmail(s *string) *TeamInvitationUpdate {
+	if s != nil {
+		tiu.SetEmail(*s)
+	}
+	return tiu
+}
+
+// SetRole sets the "role" field.
+func (tiu *TeamInvitationUpdate) SetRole(t teaminvitation.Role) *TeamInvitationUpdate {
+	tiu.mutation.SetRole(t)
+	return tiu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tiu *TeamInvitationUpdate) SetNillableRole(t *teaminvitation.Role) *TeamInvitationUpdate {
+	if t != nil {
+		tiu.SetRole(*t)
+	}
+	return tiu
+}
+
+// SetStatus sets the "status" field.
+func (tiu *TeamInvitationUpdate) SetStatus(t teaminvitation.Status) *TeamInvitationUpdate {
+	tiu.mutation.SetStatus(t)
+	return tiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tiu *TeamInvitationUpdate) SetNillableStatus(t *teaminvitation.Status) *TeamInvitationUpdate {
+	if t != nil {
+		tiu.SetStatus(*t)
+	}
+	return tiu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tiu *TeamInvitationUpdate) SetExpiresAt(t time.Time) *TeamInvitationUpdate {
+	tiu.mutation.SetExpiresAt(t)
+	return tiu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tiu *TeamInvitationUpdate) SetNillableExpiresAt(t *time.Time) *TeamInvitationUpdate {
+	if t != nil {
+		tiu.SetExpiresAt(*t)
+	}
+	return tiu
+}
+
+// Mutation returns the TeamInvitationMutation object of the builder.
+func (tiu *TeamInvitationUpdate) Mutation() *TeamInvitationMutation {
+	return tiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tiu *TeamInvitationUpdate) Save(ctx context.Context) (int, error) {
+	tiu.defaults()
+	return withHooks(ctx, tiu.sqlSave, tiu.mutation, tiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiu *TeamInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := tiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tiu *TeamInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := tiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiu *TeamInvitationUpdate) ExecX(ctx context.Context) {
+	if err := tiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tiu *TeamInvitationUpdate) defaults() {
+	if _, ok := tiu.mutation.UpdatedAt(); !ok {
+		v := teaminvitation.UpdateDefaultUpdatedAt()
+		tiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tiu *TeamInvitationUpdate) check() error {
+	if v, ok := tiu.mutation.Role(); ok {
+		if err := teaminvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := tiu.mutation.Status(); ok {
+		if err := teaminvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TeamInvitation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tiu *TeamInvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teaminvitation.Table, teaminvitation.Columns, sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID))
+	if ps := tiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teaminvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tiu.mutation.Email(); ok {
+		_spec.SetField(teaminvitation.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := tiu.mutation.Role(); ok {
+		_spec.SetField(teaminvitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := tiu.mutation.Status(); ok {
+		_spec.SetField(teaminvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tiu.mutation.ExpiresAt(); ok {
+		_spec.SetField(teaminvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teaminvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tiu.mutation.done = true
+	return n, nil
+}
+
+// TeamInvitationUpdateOne is the builder for updating a single TeamInvitation entity.
+type TeamInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamInvitationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiuo *TeamInvitationUpdateOne) SetUpdatedAt(t time.Time) *TeamInvitationUpdateOne {
+	tiuo.mutation.SetUpdatedAt(t)
+	return tiuo
+}
+
+// SetEmail sets the "email" field.
+func (tiuo *TeamInvitationUpdateOne) SetEmail(s string) *TeamInvitationUpdateOne {
+	tiuo.mutation.SetEmail(s)
+	return tiuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (tiuo *TeamInvitationUpdateOne) SetNillableEmail(s *string) *TeamInvitationUpdateOne {
+	if s != nil {
+		tiuo.SetEmail(*s)
+	}
+	return tiuo
+}
+
+// SetRole sets the "role" field.
+func (tiuo *TeamInvitationUpdateOne) SetRole(t teaminvitation.Role) *TeamInvitationUpdateOne {
+	tiuo.mutation.SetRole(t)
+	return tiuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tiuo *TeamInvitationUpdateOne) SetNillableRole(t *teaminvitation.Role) *TeamInvitationUpdateOne {
+	if t != nil {
+		tiuo.SetRole(*t)
+	}
+	return tiuo
+}
+
+// SetStatus sets the "status" field.
+func (tiuo *TeamInvitationUpdateOne) SetStatus(t teaminvitation.Status) *TeamInvitationUpdateOne {
+	tiuo.mutation.SetStatus(t)
+	return tiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tiuo *TeamInvitationUpdateOne) SetNillableStatus(t *teaminvitation.Status) *TeamInvitationUpdateOne {
+	if t != nil {
+		tiuo.SetStatus(*t)
+	}
+	return tiuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (tiuo *TeamInvitationUpdateOne) SetExpiresAt(t time.Time) *TeamInvitationUpdateOne {
+	tiuo.mutation.SetExpiresAt(t)
+	return tiuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tiuo *TeamInvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *TeamInvitationUpdateOne {
+	if t != nil {
+		tiuo.SetExpiresAt(*t)
+	}
+	return tiuo
+}
+
+// Mutation returns the TeamInvitationMutation object of the builder.
+func (tiuo *TeamInvitationUpdateOne) Mutation() *TeamInvitationMutation {
+	return tiuo.mutation
+}
+
+// Where appends a list predicates to the TeamInvitationUpdate builder.
+func (tiuo *TeamInvitationUpdateOne) Where(ps ...predicate.TeamInvitation) *TeamInvitationUpdateOne {
+	tiuo.mutation.Where(ps...)
+	return tiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tiuo *TeamInvitationUpdateOne) Select(field string, fields ...string) *TeamInvitationUpdateOne {
+	tiuo.fields = append([]string{field}, fields...)
+	return tiuo
+}
+
+// Save executes the query and returns the updated TeamInvitation entity.
+func (tiuo *TeamInvitationUpdateOne) Save(ctx context.Context) (*TeamInvitation, error) {
+	tiuo.defaults()
+	return withHooks(ctx, tiuo.sqlSave, tiuo.mutation, tiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiuo *TeamInvitationUpdateOne) SaveX(ctx context.Context) *TeamInvitation {
+	node, err := tiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tiuo *TeamInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := tiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiuo *TeamInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := tiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tiuo *TeamInvitationUpdateOne) defaults() {
+	if _, ok := tiuo.mutation.UpdatedAt(); !ok {
+		v := teaminvitation.UpdateDefaultUpdatedAt()
+		tiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tiuo *TeamInvitationUpdateOne) check() error {
+	if v, ok := tiuo.mutation.Role(); ok {
+		if err := teaminvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := tiuo.mutation.Status(); ok {
+		if err := teaminvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TeamInvitation.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tiuo *TeamInvitationUpdateOne) sqlSave(ctx context.Context) (_node *TeamInvitation, err error) {
+	if err := tiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teaminvitation.Table, teaminvitation.Columns, sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID))
+	id, ok := tiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teaminvitation.FieldID)
+		for _, f := range fields {
+			if !teaminvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teaminvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teaminvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tiuo.mutation.Email(); ok {
+		_spec.SetField(teaminvitation.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := tiuo.mutation.Role(); ok {
+		_spec.SetField(teaminvitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := tiuo.mutation.Status(); ok {
+		_spec.SetField(teaminvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tiuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(teaminvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &TeamInvitation{config: tiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teaminvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tiuo.mutation.done = true
+	return _node, nil
+}
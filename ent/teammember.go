@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
+	"github.com/paycrest/aggregator/ent/user"
+)
+
+// TeamMember is the model entity for the TeamMember schema.
+type TeamMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Role holds the value of the "role" field.
+	Role teammember.Role `json:"role,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamMemberQuery when eager-loading is set.
+	Edges                         TeamMemberEdges `json:"edges"`
+	provider_profile_team_members *string
+	sender_profile_team_members   *uuid.UUID
+	user_team_memberships         *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// TeamMemberEdges holds the relations/edges for other nodes in the graph.
+type TeamMemberEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// SenderProfile holds the value of the sender_profile edge.
+	SenderProfile *SenderProfile `json:"sender_profile,omitempty"`
+	// ProviderProfile holds the value of the provider_profile edge.
+	ProviderProfile *ProviderProfile `json:"provider_profile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamMemberEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// SenderProfileOrErr returns the SenderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamMemberEdges) SenderProfileOrErr() (*SenderProfile, error) {
+	if e.SenderProfile != nil {
+		return e.SenderProfile, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: senderprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender_profile"}
+}
+
+// ProviderProfileOrErr returns the ProviderProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamMemberEdges) ProviderProfileOrErr() (*ProviderProfile, error) {
+	if e.ProviderProfile != nil {
+		return e.ProviderProfile, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: providerprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "provider_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TeamMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case teammember.FieldRole:
+			values[i] = new(sql.NullString)
+		case teammember.FieldCreatedAt, teammember.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case teammember.FieldID:
+			values[i] = new(uuid.UUID)
+		case teammember.ForeignKeys[0]: // provider_profile_team_members
+			values[i] = new(sql.NullString)
+		case teammember.ForeignKeys[1]: // sender_profile_team_members
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case teammember.ForeignKeys[2]: // user_team_memberships
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TeamMember fields.
+func (tm *TeamMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case teammember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tm.ID = *value
+			}
+		case teammember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tm.CreatedAt = value.Time
+			}
+		case teammember.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tm.UpdatedAt = value.Time
+			}
+		case teammember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				tm.Role = teammember.Role(value.String)
+			}
+		case teammember.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_profile_team_members", values[i])
+			} else if value.Valid {
+				tm.provider_profile_team_members = new(string)
+				*tm.provider_profile_team_members = value.String
+			}
+		case teammember.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_profile_team_members", values[i])
+			} else if value.Valid {
+				tm.sender_profile_team_members = new(uuid.UUID)
+				*tm.sender_profile_team_members = *value.S.(*uuid.UUID)
+			}
+		case teammember.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_team_memberships", values[i])
+			} else if value.Valid {
+				tm.user_team_memberships = new(uuid.UUID)
+				*tm.user_team_memberships = *value.S.(*uuid.UUID)
+			}
+		default:
+			tm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TeamMember.
+// This includes values selected through modifiers, order, etc.
+func (tm *TeamMember) Value(name string) (ent.Value, error) {
+	return tm.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TeamMember entity.
+func (tm *TeamMember) QueryUser() *UserQuery {
+	return NewTeamMemberClient(tm.config).QueryUser(tm)
+}
+
+// QuerySenderProfile queries the "sender_profile" edge of the TeamMember entity.
+func (tm *TeamMember) QuerySenderProfile() *SenderProfileQuery {
+	return NewTeamMemberClient(tm.config).QuerySenderProfile(tm)
+}
+
+// QueryProviderProfile queries the "provider_profile" edge of the TeamMember entity.
+func (tm *TeamMember) QueryProviderProfile() *ProviderProfileQuery {
+	return NewTeamMemberClient(tm.config).QueryProviderProfile(tm)
+}
+
+// Update returns a builder for updating this TeamMember.
+// Note that you need to call TeamMember.Unwrap() before calling this method if this TeamMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tm *TeamMember) Update() *TeamMemberUpdateOne {
+	return NewTeamMemberClient(tm.config).UpdateOne(tm)
+}
+
+// Unwrap unwraps the TeamMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tm *TeamMember) Unwrap() *TeamMember {
+	_tx, ok := tm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TeamMember is not a transactional entity")
+	}
+	tm.config.driver = _tx.drv
+	return tm
+}
+
+// String implements the fmt.Stringer.
+func (tm *TeamMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("TeamMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tm.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Role))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TeamMembers is a parsable slice of TeamMember.
+type TeamMembers []*TeamMember
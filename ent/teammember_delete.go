@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/teammember"
+)
+
+// TeamMemberDelete is the builder for deleting a TeamMember entity.
+type TeamMemberDelete struct {
+	config
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// Where appends a list predicates to the TeamMemberDelete builder.
+func (tmd *TeamMemberDelete) Where(ps ...predicate.TeamMember) *TeamMemberDelete {
+	tmd.mutation.Where(ps...)
+	return tmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tmd *TeamMemberDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tmd.sqlExec, tmd.mutation, tmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmd *TeamMemberDelete) ExecX(ctx context.Context) int {
+	n, err := tmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tmd *TeamMemberDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(teammember.Table, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID))
+	if ps := tmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tmd.mutation.done = true
+	return affected, err
+}
+
+// TeamMemberDeleteOne is the builder for deleting a single TeamMember entity.
+type TeamMemberDeleteOne struct {
+	tmd *TeamMemberDelete
+}
+
+// Where appends a list predicates to the TeamMemberDelete builder.
+func (tmdo *TeamMemberDeleteOne) Where(ps ...predicate.TeamMember) *TeamMemberDeleteOne {
+	tmdo.tmd.mutation.Where(ps...)
+	return tmdo
+}
+
+// Exec executes the deletion query.
+func (tmdo *TeamMemberDeleteOne) Exec(ctx context.Context) error {
+	n, err := tmdo.tmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{teammember.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmdo *TeamMemberDeleteOne) ExecX(ctx context.Context) {
+	if err := tmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/teammember"
+)
+
+// TeamMemberUpdate is the builder for updating TeamMember entities.
+type TeamMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// Where appends a list predicates to the TeamMemberUpdate builder.
+func (tmu *TeamMemberUpdate) Where(ps ...predicate.TeamMember) *TeamMemberUpdate {
+	tmu.mutation.Where(ps...)
+	return tmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmu *TeamMemberUpdate) SetUpdatedAt(t time.Time) *TeamMemberUpdate {
+	tmu.mutation.SetUpdatedAt(t)
+	return tmu
+}
+
+// SetRole sets the "role" field.
+func (tmu *TeamMemberUpdate) SetRole(t teammember.Role) *TeamMemberUpdate {
+	tmu.mutation.SetRole(t)
+	return tmu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmu *TeamMemberUpdate) SetNillableRole(t *teammember.Role) *TeamMemberUpdate {
+	if t != nil {
+		tmu.SetRole(*t)
+	}
+	return tmu
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (tmu *TeamMemberUpdate) Mutation() *TeamMemberMutation {
+	return tmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tmu *TeamMemberUpdate) Save(ctx context.Context) (int, error) {
+	tmu.defaults()
+	return withHooks(ctx, tmu.sqlSave, tmu.mutation, tmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmu *TeamMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := tmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tmu *TeamMemberUpdate) Exec(ctx context.Context) error {
+	_, err := tmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmu *TeamMemberUpdate) ExecX(ctx context.Context) {
+	if err := tmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmu *TeamMemberUpdate) defaults() {
+	if _, ok := tmu.mutation.UpdatedAt(); !ok {
+		v := teammember.UpdateDefaultUpdatedAt()
+		tmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmu *TeamMemberUpdate) check() error {
+	if v, ok := tmu.mutation.Role(); ok {
+		if err := teammember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMember.role": %w`, err)}
+		}
+	}
+	if tmu.mutation.UserCleared() && len(tmu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TeamMember.user"`)
+	}
+	return nil
+}
+
+func (tmu *TeamMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teammember.Table, teammember.Columns, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID))
+	if ps := tmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(teammember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tmu.mutation.Role(); ok {
+		_spec.SetField(teammember.FieldRole, field.TypeEnum, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tmu.mutation.done = true
+	return n, nil
+}
+
+// TeamMemberUpdateOne is the builder for updating a single TeamMember entity.
+type TeamMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TeamMemberMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmuo *TeamMemberUpdateOne) SetUpdatedAt(t time.Time) *TeamMemberUpdateOne {
+	tmuo.mutation.SetUpdatedAt(t)
+	return tmuo
+}
+
+// SetRole sets the "role" field.
+func (tmuo *TeamMemberUpdateOne) SetRole(t teammember.Role) *TeamMemberUpdateOne {
+	tmuo.mutation.SetRole(t)
+	return tmuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (tmuo *TeamMemberUpdateOne) SetNillableRole(t *teammember.Role) *TeamMemberUpdateOne {
+	if t != nil {
+		tmuo.SetRole(*t)
+	}
+	return tmuo
+}
+
+// Mutation returns the TeamMemberMutation object of the builder.
+func (tmuo *TeamMemberUpdateOne) Mutation() *TeamMemberMutation {
+	return tmuo.mutation
+}
+
+// Where appends a list predicates to the TeamMemberUpdate builder.
+func (tmuo *TeamMemberUpdateOne) Where(ps ...predicate.TeamMember) *TeamMemberUpdateOne {
+	tmuo.mutation.Where(ps...)
+	return tmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tmuo *TeamMemberUpdateOne) Select(field string, fields ...string) *TeamMemberUpdateOne {
+	tmuo.fields = append([]string{field}, fields...)
+	return tmuo
+}
+
+// Save executes the query and returns the updated TeamMember entity.
+func (tmuo *TeamMemberUpdateOne) Save(ctx context.Context) (*TeamMember, error) {
+	tmuo.defaults()
+	return withHooks(ctx, tmuo.sqlSave, tmuo.mutation, tmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmuo *TeamMemberUpdateOne) SaveX(ctx context.Context) *TeamMember {
+	node, err := tmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tmuo *TeamMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := tmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmuo *TeamMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := tmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmuo *TeamMemberUpdateOne) defaults() {
+	if _, ok := tmuo.mutation.UpdatedAt(); !ok {
+		v := teammember.UpdateDefaultUpdatedAt()
+		tmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmuo *TeamMemberUpdateOne) check() error {
+	if v, ok := tmuo.mutation.Role(); ok {
+		if err := teammember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "TeamMember.role": %w`, err)}
+		}
+	}
+	if tmuo.mutation.UserCleared() && len(tmuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TeamMember.user"`)
+	}
+	return nil
+}
+
+func (tmuo *TeamMemberUpdateOne) sqlSave(ctx context.Context) (_node *TeamMember, err error) {
+	if err := tmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(teammember.Table, teammember.Columns, sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID))
+	id, ok := tmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TeamMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, teammember.FieldID)
+		for _, f := range fields {
+			if !teammember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != teammember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(teammember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tmuo.mutation.Role(); ok {
+		_spec.SetField(teammember.FieldRole, field.TypeEnum, value)
+	}
+	_node = &TeamMember{config: tmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{teammember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tmuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
+	"github.com/shopspring/decimal"
+)
+
+// TokenMarketRate is the model entity for the TokenMarketRate schema.
+type TokenMarketRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// MarketRate holds the value of the "market_rate" field.
+	MarketRate decimal.Decimal `json:"market_rate,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TokenMarketRateQuery when eager-loading is set.
+	Edges                            TokenMarketRateEdges `json:"edges"`
+	fiat_currency_token_market_rates *uuid.UUID
+	selectValues                     sql.SelectValues
+}
+
+// TokenMarketRateEdges holds the relations/edges for other nodes in the graph.
+type TokenMarketRateEdges struct {
+	// Currency holds the value of the currency edge.
+	Currency *FiatCurrency `json:"currency,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CurrencyOrErr returns the Currency value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TokenMarketRateEdges) CurrencyOrErr() (*FiatCurrency, error) {
+	if e.Currency != nil {
+		return e.Currency, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: fiatcurrency.Label}
+	}
+	return nil, &NotLoadedError{edge: "currency"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenMarketRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenmarketrate.FieldMarketRate:
+			values[i] = new(decimal.Decimal)
+		case tokenmarketrate.FieldToken:
+			values[i] = new(sql.NullString)
+		case tokenmarketrate.FieldCreatedAt, tokenmarketrate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case tokenmarketrate.FieldID:
+			values[i] = new(uuid.UUID)
+		case tokenmarketrate.ForeignKeys[0]: // fiat_currency_token_market_rates
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenMarketRate fields.
+func (tmr *TokenMarketRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenmarketrate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tmr.ID = *value
+			}
+		case tokenmarketrate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tmr.CreatedAt = value.Time
+			}
+		case tokenmarketrate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tmr.UpdatedAt = value.Time
+			}
+		case tokenmarketrate.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				tmr.Token = value.String
+			}
+		case tokenmarketrate.FieldMarketRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field market_rate", values[i])
+			} else if value != nil {
+				tmr.MarketRate = *value
+			}
+		case tokenmarketrate.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fiat_currency_token_market_rates", values[i])
+			} else if value.Valid {
+				tmr.fiat_currency_token_market_rates = new(uuid.UUID)
+				*tmr.fiat_currency_token_market_rates = *value.S.(*uuid.UUID)
+			}
+		default:
+			tmr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenMarketRate.
+// This includes values selected through modifiers, order, etc.
+func (tmr *TokenMarketRate) Value(name string) (ent.Value, error) {
+	return tmr.selectValues.Get(name)
+}
+
+// QueryCurrency queries the "currency" edge of the TokenMarketRate entity.
+func (tmr *TokenMarketRate) QueryCurrency() *FiatCurrencyQuery {
+	return NewTokenMarketRateClient(tmr.config).QueryCurrency(tmr)
+}
+
+// Update returns a builder for updating this TokenMarketRate.
+// Note that you need to call TokenMarketRate.Unwrap() before calling this method if this TokenMarketRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tmr *TokenMarketRate) Update() *TokenMarketRateUpdateOne {
+	return NewTokenMarketRateClient(tmr.config).UpdateOne(tmr)
+}
+
+// Unwrap unwraps the TokenMarketRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tmr *TokenMarketRate) Unwrap() *TokenMarketRate {
+	_tx, ok := tmr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenMarketRate is not a transactional entity")
+	}
+	tmr.config.driver = _tx.drv
+	return tmr
+}
+
+// String implements the fmt.Stringer.
+func (tmr *TokenMarketRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenMarketRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tmr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tmr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tmr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(tmr.Token)
+	builder.WriteString(", ")
+	builder.WriteString("market_rate=")
+	builder.WriteString(fmt.Sprintf("%v", tmr.MarketRate))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenMarketRates is a parsable slice of TokenMarketRate.
+type TokenMarketRates []*TokenMarketRate
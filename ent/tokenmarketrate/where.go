@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenmarketrate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldToken, v))
+}
+
+// MarketRate applies equality check predicate on the "market_rate" field. It's identical to MarketRateEQ.
+func MarketRate(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldMarketRate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldContainsFold(FieldToken, v))
+}
+
+// MarketRateEQ applies the EQ predicate on the "market_rate" field.
+func MarketRateEQ(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldEQ(FieldMarketRate, v))
+}
+
+// MarketRateNEQ applies the NEQ predicate on the "market_rate" field.
+func MarketRateNEQ(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNEQ(FieldMarketRate, v))
+}
+
+// MarketRateIn applies the In predicate on the "market_rate" field.
+func MarketRateIn(vs ...decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldIn(FieldMarketRate, vs...))
+}
+
+// MarketRateNotIn applies the NotIn predicate on the "market_rate" field.
+func MarketRateNotIn(vs ...decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldNotIn(FieldMarketRate, vs...))
+}
+
+// MarketRateGT applies the GT predicate on the "market_rate" field.
+func MarketRateGT(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGT(FieldMarketRate, v))
+}
+
+// MarketRateGTE applies the GTE predicate on the "market_rate" field.
+func MarketRateGTE(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldGTE(FieldMarketRate, v))
+}
+
+// MarketRateLT applies the LT predicate on the "market_rate" field.
+func MarketRateLT(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLT(FieldMarketRate, v))
+}
+
+// MarketRateLTE applies the LTE predicate on the "market_rate" field.
+func MarketRateLTE(v decimal.Decimal) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.FieldLTE(FieldMarketRate, v))
+}
+
+// HasCurrency applies the HasEdge predicate on the "currency" edge.
+func HasCurrency() predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CurrencyTable, CurrencyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrencyWith applies the HasEdge predicate on the "currency" edge with a given conditions (other predicates).
+func HasCurrencyWith(preds ...predicate.FiatCurrency) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(func(s *sql.Selector) {
+		step := newCurrencyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenMarketRate) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenMarketRate) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenMarketRate) predicate.TokenMarketRate {
+	return predicate.TokenMarketRate(sql.NotPredicates(p))
+}
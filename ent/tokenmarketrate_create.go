@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
+	"github.com/shopspring/decimal"
+)
+
+// TokenMarketRateCreate is the builder for creating a TokenMarketRate entity.
+type TokenMarketRateCreate struct {
+	config
+	mutation *TokenMarketRateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tmrc *TokenMarketRateCreate) SetCreatedAt(t time.Time) *TokenMarketRateCreate {
+	tmrc.mutation.SetCreatedAt(t)
+	return tmrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tmrc *TokenMarketRateCreate) SetNillableCreatedAt(t *time.Time) *TokenMarketRateCreate {
+	if t != nil {
+		tmrc.SetCreatedAt(*t)
+	}
+	return tmrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmrc *TokenMarketRateCreate) SetUpdatedAt(t time.Time) *TokenMarketRateCreate {
+	tmrc.mutation.SetUpdatedAt(t)
+	return tmrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tmrc *TokenMarketRateCreate) SetNillableUpdatedAt(t *time.Time) *TokenMarketRateCreate {
+	if t != nil {
+		tmrc.SetUpdatedAt(*t)
+	}
+	return tmrc
+}
+
+// SetToken sets the "token" field.
+func (tmrc *TokenMarketRateCreate) SetToken(s string) *TokenMarketRateCreate {
+	tmrc.mutation.SetToken(s)
+	return tmrc
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (tmrc *TokenMarketRateCreate) SetMarketRate(d decimal.Decimal) *TokenMarketRateCreate {
+	tmrc.mutation.SetMarketRate(d)
+	return tmrc
+}
+
+// SetID sets the "id" field.
+func (tmrc *TokenMarketRateCreate) SetID(u uuid.UUID) *TokenMarketRateCreate {
+	tmrc.mutation.SetID(u)
+	return tmrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tmrc *TokenMarketRateCreate) SetNillableID(u *uuid.UUID) *TokenMarketRateCreate {
+	if u != nil {
+		tmrc.SetID(*u)
+	}
+	return tmrc
+}
+
+// SetCurrencyID sets the "currency" edge to the FiatCurrency entity by ID.
+func (tmrc *TokenMarketRateCreate) SetCurrencyID(id uuid.UUID) *TokenMarketRateCreate {
+	tmrc.mutation.SetCurrencyID(id)
+	return tmrc
+}
+
+// SetCurrency sets the "currency" edge to the FiatCurrency entity.
+func (tmrc *TokenMarketRateCreate) SetCurrency(f *FiatCurrency) *TokenMarketRateCreate {
+	return tmrc.SetCurrencyID(f.ID)
+}
+
+// Mutation returns the TokenMarketRateMutation object of the builder.
+func (tmrc *TokenMarketRateCreate) Mutation() *TokenMarketRateMutation {
+	return tmrc.mutation
+}
+
+// Save creates the TokenMarketRate in the database.
+func (tmrc *TokenMarketRateCreate) Save(ctx context.Context) (*TokenMarketRate, error) {
+	tmrc.defaults()
+	return withHooks(ctx, tmrc.sqlSave, tmrc.mutation, tmrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tmrc *TokenMarketRateCreate) SaveX(ctx context.Context) *TokenMarketRate {
+	v, err := tmrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmrc *TokenMarketRateCreate) Exec(ctx context.Context) error {
+	_, err := tmrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmrc *TokenMarketRateCreate) ExecX(ctx context.Context) {
+	if err := tmrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmrc *TokenMarketRateCreate) defaults() {
+	if _, ok := tmrc.mutation.CreatedAt(); !ok {
+		v := tokenmarketrate.DefaultCreatedAt()
+		tmrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tmrc.mutation.UpdatedAt(); !ok {
+		v := tokenmarketrate.DefaultUpdatedAt()
+		tmrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tmrc.mutation.ID(); !ok {
+		v := tokenmarketrate.DefaultID()
+		tmrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmrc *TokenMarketRateCreate) check() error {
+	if _, ok := tmrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TokenMarketRate.created_at"`)}
+	}
+	if _, ok := tmrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TokenMarketRate.updated_at"`)}
+	}
+	if _, ok := tmrc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "TokenMarketRate.token"`)}
+	}
+	if _, ok := tmrc.mutation.MarketRate(); !ok {
+		return &ValidationError{Name: "market_rate", err: errors.New(`ent: missing required field "TokenMarketRate.market_rate"`)}
+	}
+	if len(tmrc.mutation.CurrencyIDs()) == 0 {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required edge "TokenMarketRate.currency"`)}
+	}
+	return nil
+}
+
+func (tmrc *TokenMarketRateCreate) sqlSave(ctx context.Context) (*TokenMarketRate, error) {
+	if err := tmrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tmrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tmrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tmrc.mutation.id = &_node.ID
+	tmrc.mutation.done = true
+	return _node, nil
+}
+
+func (tmrc *TokenMarketRateCreate) createSpec() (*TokenMarketRate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenMarketRate{config: tmrc.config}
+		_spec = sqlgraph.NewCreateSpec(tokenmarketrate.Table, sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tmrc.conflict
+	if id, ok := tmrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tmrc.mutation.CreatedAt(); ok {
+		_spec.SetField(tokenmarketrate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tmrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenmarketrate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tmrc.mutation.Token(); ok {
+		_spec.SetField(tokenmarketrate.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := tmrc.mutation.MarketRate(); ok {
+		_spec.SetField(tokenmarketrate.FieldMarketRate, field.TypeFloat64, value)
+		_node.MarketRate = value
+	}
+	if nodes := tmrc.mutation.CurrencyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tokenmarketrate.CurrencyTable,
+			Columns: []string{tokenmarketrate.CurrencyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(fiatcurrency.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.fiat_currency_token_market_rates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenMarketRate.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenMarketRateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tmrc *TokenMarketRateCreate) OnConflict(opts ...sql.ConflictOption) *TokenMarketRateUpsertOne {
+	tmrc.conflict = opts
+	return &TokenMarketRateUpsertOne{
+		create: tmrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenMarketRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tmrc *TokenMarketRateCreate) OnConflictColumns(columns ...string) *TokenMarketRateUpsertOne {
+	tmrc.conflict = append(tmrc.conflict, sql.ConflictColumns(columns...))
+	return &TokenMarketRateUpsertOne{
+		create: tmrc,
+	}
+}
+
+type (
+	// TokenMarketRateUpsertOne is the builder for "upsert"-ing
+	//  one TokenMarketRate node.
+	TokenMarketRateUpsertOne struct {
+		create *TokenMarketRateCreate
+	}
+
+	// TokenMarketRateUpsert is the "OnConflict" setter.
+	TokenMarketRateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenMarketRateUpsert) SetUpdatedAt(v time.Time) *TokenMarketRateUpsert {
+	u.Set(tokenmarketrate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenMarketRateUpsert) UpdateUpdatedAt() *TokenMarketRateUpsert {
+	u.SetExcluded(tokenmarketrate.FieldUpdatedAt)
+	return u
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (u *TokenMarketRateUpsert) SetMarketRate(v decimal.Decimal) *TokenMarketRateUpsert {
+	u.Set(tokenmarketrate.FieldMarketRate, v)
+	return u
+}
+
+// UpdateMarketRate sets the "market_rate" field to the value that was provided on create.
+func (u *TokenMarketRateUpsert) UpdateMarketRate() *TokenMarketRateUpsert {
+	u.SetExcluded(tokenmarketrate.FieldMarketRate)
+	return u
+}
+
+// AddMarketRate adds v to the "market_rate" field.
+func (u *TokenMarketRateUpsert) AddMarketRate(v decimal.Decimal) *TokenMarketRateUpsert {
+	u.Add(tokenmarketrate.FieldMarketRate, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenMarketRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenmarketrate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenMarketRateUpsertOne) UpdateNewValues() *TokenMarketRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenmarketrate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tokenmarketrate.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Token(); exists {
+			s.SetIgnore(tokenmarketrate.FieldToken)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenMarketRate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenMarketRateUpsertOne) Ignore() *TokenMarketRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenMarketRateUpsertOne) DoNothing() *TokenMarketRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenMarketRateCreate.OnConflict
+// documentation for more info.
+func (u *TokenMarketRateUpsertOne) Update(set func(*TokenMarketRateUpsert)) *TokenMarketRateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenMarketRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenMarketRateUpsertOne) SetUpdatedAt(v time.Time) *TokenMarketRateUpsertOne {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenMarketRateUpsertOne) UpdateUpdatedAt() *TokenMarketRateUpsertOne {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (u *TokenMarketRateUpsertOne) SetMarketRate(v decimal.Decimal) *TokenMarketRateUpsertOne {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.SetMarketRate(v)
+	})
+}
+
+// AddMarketRate adds v to the "market_rate" field.
+func (u *TokenMarketRateUpsertOne) AddMarketRate(v decimal.Decimal) *TokenMarketRateUpsertOne {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.AddMarketRate(v)
+	})
+}
+
+// UpdateMarketRate sets the "market_rate" field to the value that was provided on create.
+func (u *TokenMarketRateUpsertOne) UpdateMarketRate() *TokenMarketRateUpsertOne {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.UpdateMarketRate()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenMarketRateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenMarketRateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenMarketRateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenMarketRateUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenMarketRateUpsertOne.ID is not supported by MySQL driver. Use TokenMarketRateUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenMarketRateUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenMarketRateCreateBulk is the builder for creating many TokenMarketRate entities in bulk.
+type TokenMarketRateCreateBulk struct {
+	config
+	err      error
+	builders []*TokenMarketRateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenMarketRate entities in the database.
+func (tmrcb *TokenMarketRateCreateBulk) Save(ctx context.Context) ([]*TokenMarketRate, error) {
+	if tmrcb.err != nil {
+		return nil, tmrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tmrcb.builders))
+	nodes := make([]*TokenMarketRate, len(tmrcb.builders))
+	mutators := make([]Mutator, len(tmrcb.builders))
+	for i := range tmrcb.builders {
+		func(i int, root context.Context) {
+			builder := tmrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenMarketRateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tmrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tmrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tmrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tmrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmrcb *TokenMarketRateCreateBulk) SaveX(ctx context.Context) []*TokenMarketRate {
+	v, err := tmrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmrcb *TokenMarketRateCreateBulk) Exec(ctx context.Context) error {
+	_, err := tmrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmrcb *TokenMarketRateCreateBulk) ExecX(ctx context.Context) {
+	if err := tmrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenMarketRate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenMarketRateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tmrcb *TokenMarketRateCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenMarketRateUpsertBulk {
+	tmrcb.conflict = opts
+	return &TokenMarketRateUpsertBulk{
+		create: tmrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenMarketRate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tmrcb *TokenMarketRateCreateBulk) OnConflictColumns(columns ...string) *TokenMarketRateUpsertBulk {
+	tmrcb.conflict = append(tmrcb.conflict, sql.ConflictColumns(columns...))
+	return &TokenMarketRateUpsertBulk{
+		create: tmrcb,
+	}
+}
+
+// TokenMarketRateUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenMarketRate nodes.
+type TokenMarketRateUpsertBulk struct {
+	create *TokenMarketRateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenMarketRate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenmarketrate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenMarketRateUpsertBulk) UpdateNewValues() *TokenMarketRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenmarketrate.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tokenmarketrate.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Token(); exists {
+				s.SetIgnore(tokenmarketrate.FieldToken)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenMarketRate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenMarketRateUpsertBulk) Ignore() *TokenMarketRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenMarketRateUpsertBulk) DoNothing() *TokenMarketRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenMarketRateCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenMarketRateUpsertBulk) Update(set func(*TokenMarketRateUpsert)) *TokenMarketRateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenMarketRateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenMarketRateUpsertBulk) SetUpdatedAt(v time.Time) *TokenMarketRateUpsertBulk {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenMarketRateUpsertBulk) UpdateUpdatedAt() *TokenMarketRateUpsertBulk {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (u *TokenMarketRateUpsertBulk) SetMarketRate(v decimal.Decimal) *TokenMarketRateUpsertBulk {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.SetMarketRate(v)
+	})
+}
+
+// AddMarketRate adds v to the "market_rate" field.
+func (u *TokenMarketRateUpsertBulk) AddMarketRate(v decimal.Decimal) *TokenMarketRateUpsertBulk {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.AddMarketRate(v)
+	})
+}
+
+// UpdateMarketRate sets the "market_rate" field to the value that was provided on create.
+func (u *TokenMarketRateUpsertBulk) UpdateMarketRate() *TokenMarketRateUpsertBulk {
+	return u.Update(func(s *TokenMarketRateUpsert) {
+		s.UpdateMarketRate()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenMarketRateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenMarketRateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenMarketRateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenMarketRateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
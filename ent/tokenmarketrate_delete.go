@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
+)
+
+// TokenMarketRateDelete is the builder for deleting a TokenMarketRate entity.
+type TokenMarketRateDelete struct {
+	config
+	hooks    []Hook
+	mutation *TokenMarketRateMutation
+}
+
+// Where appends a list predicates to the TokenMarketRateDelete builder.
+func (tmrd *TokenMarketRateDelete) Where(ps ...predicate.TokenMarketRate) *TokenMarketRateDelete {
+	tmrd.mutation.Where(ps...)
+	return tmrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tmrd *TokenMarketRateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tmrd.sqlExec, tmrd.mutation, tmrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmrd *TokenMarketRateDelete) ExecX(ctx context.Context) int {
+	n, err := tmrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tmrd *TokenMarketRateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tokenmarketrate.Table, sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID))
+	if ps := tmrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tmrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tmrd.mutation.done = true
+	return affected, err
+}
+
+// TokenMarketRateDeleteOne is the builder for deleting a single TokenMarketRate entity.
+type TokenMarketRateDeleteOne struct {
+	tmrd *TokenMarketRateDelete
+}
+
+// Where appends a list predicates to the TokenMarketRateDelete builder.
+func (tmrdo *TokenMarketRateDeleteOne) Where(ps ...predicate.TokenMarketRate) *TokenMarketRateDeleteOne {
+	tmrdo.tmrd.mutation.Where(ps...)
+	return tmrdo
+}
+
+// Exec executes the deletion query.
+func (tmrdo *TokenMarketRateDeleteOne) Exec(ctx context.Context) error {
+	n, err := tmrdo.tmrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tokenmarketrate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmrdo *TokenMarketRateDeleteOne) ExecX(ctx context.Context) {
+	if err := tmrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
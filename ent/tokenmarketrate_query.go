@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
+)
+
+// TokenMarketRateQuery is the builder for querying TokenMarketRate entities.
+type TokenMarketRateQuery struct {
+	config
+	ctx          *QueryContext
+	order        []tokenmarketrate.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.TokenMarketRate
+	withCurrency *FiatCurrencyQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TokenMarketRateQuery builder.
+func (tmrq *TokenMarketRateQuery) Where(ps ...predicate.TokenMarketRate) *TokenMarketRateQuery {
+	tmrq.predicates = append(tmrq.predicates, ps...)
+	return tmrq
+}
+
+// Limit the number of records to be returned by this query.
+func (tmrq *TokenMarketRateQuery) Limit(limit int) *TokenMarketRateQuery {
+	tmrq.ctx.Limit = &limit
+	return tmrq
+}
+
+// Offset to start from.
+func (tmrq *TokenMarketRateQuery) Offset(offset int) *TokenMarketRateQuery {
+	tmrq.ctx.Offset = &offset
+	return tmrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tmrq *TokenMarketRateQuery) Unique(unique bool) *TokenMarketRateQuery {
+	tmrq.ctx.Unique = &unique
+	return tmrq
+}
+
+// Order specifies how the records should be ordered.
+func (tmrq *TokenMarketRateQuery) Order(o ...tokenmarketrate.OrderOption) *TokenMarketRateQuery {
+	tmrq.order = append(tmrq.order, o...)
+	return tmrq
+}
+
+// QueryCurrency chains the current query on the "currency" edge.
+func (tmrq *TokenMarketRateQuery) QueryCurrency() *FiatCurrencyQuery {
+	query := (&FiatCurrencyClient{config: tmrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tmrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tmrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokenmarketrate.Table, tokenmarketrate.FieldID, selector),
+			sqlgraph.To(fiatcurrency.Table, fiatcurrency.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tokenmarketrate.CurrencyTable, tokenmarketrate.CurrencyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tmrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TokenMarketRate entity from the query.
+// Returns a *NotFoundError when no TokenMarketRate was found.
+func (tmrq *TokenMarketRateQuery) First(ctx context.Context) (*TokenMarketRate, error) {
+	nodes, err := tmrq.Limit(1).All(setContextOp(ctx, tmrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tokenmarketrate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) FirstX(ctx context.Context) *TokenMarketRate {
+	node, err := tmrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TokenMarketRate ID from the query.
+// Returns a *NotFoundError when no TokenMarketRate ID was found.
+func (tmrq *TokenMarketRateQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tmrq.Limit(1).IDs(setContextOp(ctx, tmrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tokenmarketrate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tmrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TokenMarketRate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TokenMarketRate entity is found.
+// Returns a *NotFoundError when no TokenMarketRate entities are found.
+func (tmrq *TokenMarketRateQuery) Only(ctx context.Context) (*TokenMarketRate, error) {
+	nodes, err := tmrq.Limit(2).All(setContextOp(ctx, tmrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tokenmarketrate.Label}
+	default:
+		return nil, &NotSingularError{tokenmarketrate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) OnlyX(ctx context.Context) *TokenMarketRate {
+	node, err := tmrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TokenMarketRate ID in the query.
+// Returns a *NotSingularError when more than one TokenMarketRate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tmrq *TokenMarketRateQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tmrq.Limit(2).IDs(setContextOp(ctx, tmrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tokenmarketrate.Label}
+	default:
+		err = &NotSingularError{tokenmarketrate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tmrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TokenMarketRates.
+func (tmrq *TokenMarketRateQuery) All(ctx context.Context) ([]*TokenMarketRate, error) {
+	ctx = setContextOp(ctx, tmrq.ctx, ent.OpQueryAll)
+	if err := tmrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TokenMarketRate, *TokenMarketRateQuery]()
+	return withInterceptors[[]*TokenMarketRate](ctx, tmrq, qr, tmrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) AllX(ctx context.Context) []*TokenMarketRate {
+	nodes, err := tmrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TokenMarketRate IDs.
+func (tmrq *TokenMarketRateQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tmrq.ctx.Unique == nil && tmrq.path != nil {
+		tmrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tmrq.ctx, ent.OpQueryIDs)
+	if err = tmrq.Select(tokenmarketrate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tmrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tmrq *TokenMarketRateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tmrq.ctx, ent.OpQueryCount)
+	if err := tmrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tmrq, querierCount[*TokenMarketRateQuery](), tmrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) CountX(ctx context.Context) int {
+	count, err := tmrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tmrq *TokenMarketRateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tmrq.ctx, ent.OpQueryExist)
+	switch _, err := tmrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tmrq *TokenMarketRateQuery) ExistX(ctx context.Context) bool {
+	exist, err := tmrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TokenMarketRateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tmrq *TokenMarketRateQuery) Clone() *TokenMarketRateQuery {
+	if tmrq == nil {
+		return nil
+	}
+	return &TokenMarketRateQuery{
+		config:       tmrq.config,
+		ctx:          tmrq.ctx.Clone(),
+		order:        append([]tokenmarketrate.OrderOption{}, tmrq.order...),
+		inters:       append([]Interceptor{}, tmrq.inters...),
+		predicates:   append([]predicate.TokenMarketRate{}, tmrq.predicates...),
+		withCurrency: tmrq.withCurrency.Clone(),
+		// clone intermediate query.
+		sql:  tmrq.sql.Clone(),
+		path: tmrq.path,
+	}
+}
+
+// WithCurrency tells the query-builder to eager-load the nodes that are connected to
+// the "currency" edge. The optional arguments are used to configure the query builder of the edge.
+func (tmrq *TokenMarketRateQuery) WithCurrency(opts ...func(*FiatCurrencyQuery)) *TokenMarketRateQuery {
+	query := (&FiatCurrencyClient{config: tmrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tmrq.withCurrency = query
+	return tmrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TokenMarketRate.Query().
+//		GroupBy(tokenmarketrate.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tmrq *TokenMarketRateQuery) GroupBy(field string, fields ...string) *TokenMarketRateGroupBy {
+	tmrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TokenMarketRateGroupBy{build: tmrq}
+	grbuild.flds = &tmrq.ctx.Fields
+	grbuild.label = tokenmarketrate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.TokenMarketRate.Query().
+//		Select(tokenmarketrate.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (tmrq *TokenMarketRateQuery) Select(fields ...string) *TokenMarketRateSelect {
+	tmrq.ctx.Fields = append(tmrq.ctx.Fields, fields...)
+	sbuild := &TokenMarketRateSelect{TokenMarketRateQuery: tmrq}
+	sbuild.label = tokenmarketrate.Label
+	sbuild.flds, sbuild.scan = &tmrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TokenMarketRateSelect configured with the given aggregations.
+func (tmrq *TokenMarketRateQuery) Aggregate(fns ...AggregateFunc) *TokenMarketRateSelect {
+	return tmrq.Select().Aggregate(fns...)
+}
+
+func (tmrq *TokenMarketRateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tmrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tmrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tmrq.ctx.Fields {
+		if !tokenmarketrate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tmrq.path != nil {
+		prev, err := tmrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tmrq.sql = prev
+	}
+	return nil
+}
+
+func (tmrq *TokenMarketRateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TokenMarketRate, error) {
+	var (
+		nodes       = []*TokenMarketRate{}
+		withFKs     = tmrq.withFKs
+		_spec       = tmrq.querySpec()
+		loadedTypes = [1]bool{
+			tmrq.withCurrency != nil,
+		}
+	)
+	if tmrq.withCurrency != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenmarketrate.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TokenMarketRate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TokenMarketRate{config: tmrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tmrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tmrq.withCurrency; query != nil {
+		if err := tmrq.loadCurrency(ctx, query, nodes, nil,
+			func(n *TokenMarketRate, e *FiatCurrency) { n.Edges.Currency = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tmrq *TokenMarketRateQuery) loadCurrency(ctx context.Context, query *FiatCurrencyQuery, nodes []*TokenMarketRate, init func(*TokenMarketRate), assign func(*TokenMarketRate, *FiatCurrency)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TokenMarketRate)
+	for i := range nodes {
+		if nodes[i].fiat_currency_token_market_rates == nil {
+			continue
+		}
+		fk := *nodes[i].fiat_currency_token_market_rates
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(fiatcurrency.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "fiat_currency_token_market_rates" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tmrq *TokenMarketRateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tmrq.querySpec()
+	_spec.Node.Columns = tmrq.ctx.Fields
+	if len(tmrq.ctx.Fields) > 0 {
+		_spec.Unique = tmrq.ctx.Unique != nil && *tmrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tmrq.driver, _spec)
+}
+
+func (tmrq *TokenMarketRateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tokenmarketrate.Table, tokenmarketrate.Columns, sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID))
+	_spec.From = tmrq.sql
+	if unique := tmrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tmrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tmrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenmarketrate.FieldID)
+		for i := range fields {
+			if fields[i] != tokenmarketrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tmrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tmrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tmrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tmrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tmrq *TokenMarketRateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tmrq.driver.Dialect())
+	t1 := builder.Table(tokenmarketrate.Table)
+	columns := tmrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tokenmarketrate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tmrq.sql != nil {
+		selector = tmrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tmrq.ctx.Unique != nil && *tmrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tmrq.predicates {
+		p(selector)
+	}
+	for _, p := range tmrq.order {
+		p(selector)
+	}
+	if offset := tmrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tmrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TokenMarketRateGroupBy is the group-by builder for TokenMarketRate entities.
+type TokenMarketRateGroupBy struct {
+	selector
+	build *TokenMarketRateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tmrgb *TokenMarketRateGroupBy) Aggregate(fns ...AggregateFunc) *TokenMarketRateGroupBy {
+	tmrgb.fns = append(tmrgb.fns, fns...)
+	return tmrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tmrgb *TokenMarketRateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tmrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tmrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenMarketRateQuery, *TokenMarketRateGroupBy](ctx, tmrgb.build, tmrgb, tmrgb.build.inters, v)
+}
+
+func (tmrgb *TokenMarketRateGroupBy) sqlScan(ctx context.Context, root *TokenMarketRateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tmrgb.fns))
+	for _, fn := range tmrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tmrgb.flds)+len(tmrgb.fns))
+		for _, f := range *tmrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tmrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tmrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TokenMarketRateSelect is the builder for selecting fields of TokenMarketRate entities.
+type TokenMarketRateSelect struct {
+	*TokenMarketRateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tmrs *TokenMarketRateSelect) Aggregate(fns ...AggregateFunc) *TokenMarketRateSelect {
+	tmrs.fns = append(tmrs.fns, fns...)
+	return tmrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tmrs *TokenMarketRateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tmrs.ctx, ent.OpQuerySelect)
+	if err := tmrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenMarketRateQuery, *TokenMarketRateSelect](ctx, tmrs.TokenMarketRateQuery, tmrs, tmrs.inters, v)
+}
+
+func (tmrs *TokenMarketRateSelect) sqlScan(ctx context.Context, root *TokenMarketRateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tmrs.fns))
+	for _, fn := range tmrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tmrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tmrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
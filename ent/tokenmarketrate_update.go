@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
+	"github.com/shopspring/decimal"
+)
+
+// TokenMarketRateUpdate is the builder for updating TokenMarketRate entities.
+type TokenMarketRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenMarketRateMutation
+}
+
+// Where appends a list predicates to the TokenMarketRateUpdate builder.
+func (tmru *TokenMarketRateUpdate) Where(ps ...predicate.TokenMarketRate) *TokenMarketRateUpdate {
+	tmru.mutation.Where(ps...)
+	return tmru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmru *TokenMarketRateUpdate) SetUpdatedAt(t time.Time) *TokenMarketRateUpdate {
+	tmru.mutation.SetUpdatedAt(t)
+	return tmru
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (tmru *TokenMarketRateUpdate) SetMarketRate(d decimal.Decimal) *TokenMarketRateUpdate {
+	tmru.mutation.ResetMarketRate()
+	tmru.mutation.SetMarketRate(d)
+	return tmru
+}
+
+// SetNillableMarketRate sets the "market_rate" field if the given value is not nil.
+func (tmru *TokenMarketRateUpdate) SetNillableMarketRate(d *decimal.Decimal) *TokenMarketRateUpdate {
+	if d != nil {
+		tmru.SetMarketRate(*d)
+	}
+	return tmru
+}
+
+// AddMarketRate adds d to the "market_rate" field.
+func (tmru *TokenMarketRateUpdate) AddMarketRate(d decimal.Decimal) *TokenMarketRateUpdate {
+	tmru.mutation.AddMarketRate(d)
+	return tmru
+}
+
+// Mutation returns the TokenMarketRateMutation object of the builder.
+func (tmru *TokenMarketRateUpdate) Mutation() *TokenMarketRateMutation {
+	return tmru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tmru *TokenMarketRateUpdate) Save(ctx context.Context) (int, error) {
+	tmru.defaults()
+	return withHooks(ctx, tmru.sqlSave, tmru.mutation, tmru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmru *TokenMarketRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := tmru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tmru *TokenMarketRateUpdate) Exec(ctx context.Context) error {
+	_, err := tmru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmru *TokenMarketRateUpdate) ExecX(ctx context.Context) {
+	if err := tmru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmru *TokenMarketRateUpdate) defaults() {
+	if _, ok := tmru.mutation.UpdatedAt(); !ok {
+		v := tokenmarketrate.UpdateDefaultUpdatedAt()
+		tmru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmru *TokenMarketRateUpdate) check() error {
+	if tmru.mutation.CurrencyCleared() && len(tmru.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TokenMarketRate.currency"`)
+	}
+	return nil
+}
+
+func (tmru *TokenMarketRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tmru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenmarketrate.Table, tokenmarketrate.Columns, sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID))
+	if ps := tmru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmru.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenmarketrate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tmru.mutation.MarketRate(); ok {
+		_spec.SetField(tokenmarketrate.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if value, ok := tmru.mutation.AddedMarketRate(); ok {
+		_spec.AddField(tokenmarketrate.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tmru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenmarketrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tmru.mutation.done = true
+	return n, nil
+}
+
+// TokenMarketRateUpdateOne is the builder for updating a single TokenMarketRate entity.
+type TokenMarketRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenMarketRateMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tmruo *TokenMarketRateUpdateOne) SetUpdatedAt(t time.Time) *TokenMarketRateUpdateOne {
+	tmruo.mutation.SetUpdatedAt(t)
+	return tmruo
+}
+
+// SetMarketRate sets the "market_rate" field.
+func (tmruo *TokenMarketRateUpdateOne) SetMarketRate(d decimal.Decimal) *TokenMarketRateUpdateOne {
+	tmruo.mutation.ResetMarketRate()
+	tmruo.mutation.SetMarketRate(d)
+	return tmruo
+}
+
+// SetNillableMarketRate sets the "market_rate" field if the given value is not nil.
+func (tmruo *TokenMarketRateUpdateOne) SetNillableMarketRate(d *decimal.Decimal) *TokenMarketRateUpdateOne {
+	if d != nil {
+		tmruo.SetMarketRate(*d)
+	}
+	return tmruo
+}
+
+// AddMarketRate adds d to the "market_rate" field.
+func (tmruo *TokenMarketRateUpdateOne) AddMarketRate(d decimal.Decimal) *TokenMarketRateUpdateOne {
+	tmruo.mutation.AddMarketRate(d)
+	return tmruo
+}
+
+// Mutation returns the TokenMarketRateMutation object of the builder.
+func (tmruo *TokenMarketRateUpdateOne) Mutation() *TokenMarketRateMutation {
+	return tmruo.mutation
+}
+
+// Where appends a list predicates to the TokenMarketRateUpdate builder.
+func (tmruo *TokenMarketRateUpdateOne) Where(ps ...predicate.TokenMarketRate) *TokenMarketRateUpdateOne {
+	tmruo.mutation.Where(ps...)
+	return tmruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tmruo *TokenMarketRateUpdateOne) Select(field string, fields ...string) *TokenMarketRateUpdateOne {
+	tmruo.fields = append([]string{field}, fields...)
+	return tmruo
+}
+
+// Save executes the query and returns the updated TokenMarketRate entity.
+func (tmruo *TokenMarketRateUpdateOne) Save(ctx context.Context) (*TokenMarketRate, error) {
+	tmruo.defaults()
+	return withHooks(ctx, tmruo.sqlSave, tmruo.mutation, tmruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmruo *TokenMarketRateUpdateOne) SaveX(ctx context.Context) *TokenMarketRate {
+	node, err := tmruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tmruo *TokenMarketRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := tmruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmruo *TokenMarketRateUpdateOne) ExecX(ctx context.Context) {
+	if err := tmruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmruo *TokenMarketRateUpdateOne) defaults() {
+	if _, ok := tmruo.mutation.UpdatedAt(); !ok {
+		v := tokenmarketrate.UpdateDefaultUpdatedAt()
+		tmruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmruo *TokenMarketRateUpdateOne) check() error {
+	if tmruo.mutation.CurrencyCleared() && len(tmruo.mutation.CurrencyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TokenMarketRate.currency"`)
+	}
+	return nil
+}
+
+func (tmruo *TokenMarketRateUpdateOne) sqlSave(ctx context.Context) (_node *TokenMarketRate, err error) {
+	if err := tmruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenmarketrate.Table, tokenmarketrate.Columns, sqlgraph.NewFieldSpec(tokenmarketrate.FieldID, field.TypeUUID))
+	id, ok := tmruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenMarketRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tmruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenmarketrate.FieldID)
+		for _, f := range fields {
+			if !tokenmarketrate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenmarketrate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tmruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenmarketrate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tmruo.mutation.MarketRate(); ok {
+		_spec.SetField(tokenmarketrate.FieldMarketRate, field.TypeFloat64, value)
+	}
+	if value, ok := tmruo.mutation.AddedMarketRate(); ok {
+		_spec.AddField(tokenmarketrate.FieldMarketRate, field.TypeFloat64, value)
+	}
+	_node = &TokenMarketRate{config: tmruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tmruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenmarketrate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tmruo.mutation.done = true
+	return _node, nil
+}
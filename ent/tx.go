@@ -14,6 +14,14 @@ type Tx struct {
 	config
 	// APIKey is the client for interacting with the APIKey builders.
 	APIKey *APIKeyClient
+	// BucketProposal is the client for interacting with the BucketProposal builders.
+	BucketProposal *BucketProposalClient
+	// DeadLetterOrder is the client for interacting with the DeadLetterOrder builders.
+	DeadLetterOrder *DeadLetterOrderClient
+	// Dispute is the client for interacting with the Dispute builders.
+	Dispute *DisputeClient
+	// DisputeEvidence is the client for interacting with the DisputeEvidence builders.
+	DisputeEvidence *DisputeEvidenceClient
 	// FiatCurrency is the client for interacting with the FiatCurrency builders.
 	FiatCurrency *FiatCurrencyClient
 	// IdentityVerificationRequest is the client for interacting with the IdentityVerificationRequest builders.
@@ -28,26 +36,46 @@ type Tx struct {
 	LockPaymentOrder *LockPaymentOrderClient
 	// Network is the client for interacting with the Network builders.
 	Network *NetworkClient
+	// OrderAssignment is the client for interacting with the OrderAssignment builders.
+	OrderAssignment *OrderAssignmentClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
 	// PaymentOrderRecipient is the client for interacting with the PaymentOrderRecipient builders.
 	PaymentOrderRecipient *PaymentOrderRecipientClient
+	// ProviderHealthCheck is the client for interacting with the ProviderHealthCheck builders.
+	ProviderHealthCheck *ProviderHealthCheckClient
 	// ProviderOrderToken is the client for interacting with the ProviderOrderToken builders.
 	ProviderOrderToken *ProviderOrderTokenClient
 	// ProviderProfile is the client for interacting with the ProviderProfile builders.
 	ProviderProfile *ProviderProfileClient
 	// ProviderRating is the client for interacting with the ProviderRating builders.
 	ProviderRating *ProviderRatingClient
+	// ProviderSLARecord is the client for interacting with the ProviderSLARecord builders.
+	ProviderSLARecord *ProviderSLARecordClient
 	// ProvisionBucket is the client for interacting with the ProvisionBucket builders.
 	ProvisionBucket *ProvisionBucketClient
+	// PublicHoliday is the client for interacting with the PublicHoliday builders.
+	PublicHoliday *PublicHolidayClient
+	// RateCircuitBreaker is the client for interacting with the RateCircuitBreaker builders.
+	RateCircuitBreaker *RateCircuitBreakerClient
+	// RateSnapshot is the client for interacting with the RateSnapshot builders.
+	RateSnapshot *RateSnapshotClient
 	// ReceiveAddress is the client for interacting with the ReceiveAddress builders.
 	ReceiveAddress *ReceiveAddressClient
 	// SenderOrderToken is the client for interacting with the SenderOrderToken builders.
 	SenderOrderToken *SenderOrderTokenClient
 	// SenderProfile is the client for interacting with the SenderProfile builders.
 	SenderProfile *SenderProfileClient
+	// TeamAuditLog is the client for interacting with the TeamAuditLog builders.
+	TeamAuditLog *TeamAuditLogClient
+	// TeamInvitation is the client for interacting with the TeamInvitation builders.
+	TeamInvitation *TeamInvitationClient
+	// TeamMember is the client for interacting with the TeamMember builders.
+	TeamMember *TeamMemberClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
+	// TokenMarketRate is the client for interacting with the TokenMarketRate builders.
+	TokenMarketRate *TokenMarketRateClient
 	// TransactionLog is the client for interacting with the TransactionLog builders.
 	TransactionLog *TransactionLogClient
 	// User is the client for interacting with the User builders.
@@ -188,6 +216,10 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.BucketProposal = NewBucketProposalClient(tx.config)
+	tx.DeadLetterOrder = NewDeadLetterOrderClient(tx.config)
+	tx.Dispute = NewDisputeClient(tx.config)
+	tx.DisputeEvidence = NewDisputeEvidenceClient(tx.config)
 	tx.FiatCurrency = NewFiatCurrencyClient(tx.config)
 	tx.IdentityVerificationRequest = NewIdentityVerificationRequestClient(tx.config)
 	tx.Institution = NewInstitutionClient(tx.config)
@@ -195,16 +227,26 @@ func (tx *Tx) init() {
 	tx.LockOrderFulfillment = NewLockOrderFulfillmentClient(tx.config)
 	tx.LockPaymentOrder = NewLockPaymentOrderClient(tx.config)
 	tx.Network = NewNetworkClient(tx.config)
+	tx.OrderAssignment = NewOrderAssignmentClient(tx.config)
 	tx.PaymentOrder = NewPaymentOrderClient(tx.config)
 	tx.PaymentOrderRecipient = NewPaymentOrderRecipientClient(tx.config)
+	tx.ProviderHealthCheck = NewProviderHealthCheckClient(tx.config)
 	tx.ProviderOrderToken = NewProviderOrderTokenClient(tx.config)
 	tx.ProviderProfile = NewProviderProfileClient(tx.config)
 	tx.ProviderRating = NewProviderRatingClient(tx.config)
+	tx.ProviderSLARecord = NewProviderSLARecordClient(tx.config)
 	tx.ProvisionBucket = NewProvisionBucketClient(tx.config)
+	tx.PublicHoliday = NewPublicHolidayClient(tx.config)
+	tx.RateCircuitBreaker = NewRateCircuitBreakerClient(tx.config)
+	tx.RateSnapshot = NewRateSnapshotClient(tx.config)
 	tx.ReceiveAddress = NewReceiveAddressClient(tx.config)
 	tx.SenderOrderToken = NewSenderOrderTokenClient(tx.config)
 	tx.SenderProfile = NewSenderProfileClient(tx.config)
+	tx.TeamAuditLog = NewTeamAuditLogClient(tx.config)
+	tx.TeamInvitation = NewTeamInvitationClient(tx.config)
+	tx.TeamMember = NewTeamMemberClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
+	tx.TokenMarketRate = NewTokenMarketRateClient(tx.config)
 	tx.TransactionLog = NewTransactionLogClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.VerificationToken = NewVerificationTokenClient(tx.config)
@@ -15,6 +15,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/ent/verificationtoken"
 )
@@ -180,6 +182,36 @@ func (uc *UserCreate) AddVerificationToken(v ...*VerificationToken) *UserCreate
 	return uc.AddVerificationTokenIDs(ids...)
 }
 
+// AddTeamMembershipIDs adds the "team_memberships" edge to the TeamMember entity by IDs.
+func (uc *UserCreate) AddTeamMembershipIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddTeamMembershipIDs(ids...)
+	return uc
+}
+
+// AddTeamMemberships adds the "team_memberships" edges to the TeamMember entity.
+func (uc *UserCreate) AddTeamMemberships(t ...*TeamMember) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddTeamMembershipIDs(ids...)
+}
+
+// AddSentTeamInvitationIDs adds the "sent_team_invitations" edge to the TeamInvitation entity by IDs.
+func (uc *UserCreate) AddSentTeamInvitationIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddSentTeamInvitationIDs(ids...)
+	return uc
+}
+
+// AddSentTeamInvitations adds the "sent_team_invitations" edges to the TeamInvitation entity.
+func (uc *UserCreate) AddSentTeamInvitations(t ...*TeamInvitation) *UserCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uc.AddSentTeamInvitationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -408,6 +440,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.TeamMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SentTeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,6 +15,8 @@ import (
 	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teaminvitation"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/ent/verificationtoken"
 )
@@ -189,6 +191,36 @@ func (uu *UserUpdate) AddVerificationToken(v ...*VerificationToken) *UserUpdate
 	return uu.AddVerificationTokenIDs(ids...)
 }
 
+// AddTeamMembershipIDs adds the "team_memberships" edge to the TeamMember entity by IDs.
+func (uu *UserUpdate) AddTeamMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddTeamMembershipIDs(ids...)
+	return uu
+}
+
+// AddTeamMemberships adds the "team_memberships" edges to the TeamMember entity.
+func (uu *UserUpdate) AddTeamMemberships(t ...*TeamMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddTeamMembershipIDs(ids...)
+}
+
+// AddSentTeamInvitationIDs adds the "sent_team_invitations" edge to the TeamInvitation entity by IDs.
+func (uu *UserUpdate) AddSentTeamInvitationIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddSentTeamInvitationIDs(ids...)
+	return uu
+}
+
+// AddSentTeamInvitations adds the "sent_team_invitations" edges to the TeamInvitation entity.
+func (uu *UserUpdate) AddSentTeamInvitations(t ...*TeamInvitation) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.AddSentTeamInvitationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -227,6 +259,48 @@ func (uu *UserUpdate) RemoveVerificationToken(v ...*VerificationToken) *UserUpda
 	return uu.RemoveVerificationTokenIDs(ids...)
 }
 
+// ClearTeamMemberships clears all "team_memberships" edges to the TeamMember entity.
+func (uu *UserUpdate) ClearTeamMemberships() *UserUpdate {
+	uu.mutation.ClearTeamMemberships()
+	return uu
+}
+
+// RemoveTeamMembershipIDs removes the "team_memberships" edge to TeamMember entities by IDs.
+func (uu *UserUpdate) RemoveTeamMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveTeamMembershipIDs(ids...)
+	return uu
+}
+
+// RemoveTeamMemberships removes "team_memberships" edges to TeamMember entities.
+func (uu *UserUpdate) RemoveTeamMemberships(t ...*TeamMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveTeamMembershipIDs(ids...)
+}
+
+// ClearSentTeamInvitations clears all "sent_team_invitations" edges to the TeamInvitation entity.
+func (uu *UserUpdate) ClearSentTeamInvitations() *UserUpdate {
+	uu.mutation.ClearSentTeamInvitations()
+	return uu
+}
+
+// RemoveSentTeamInvitationIDs removes the "sent_team_invitations" edge to TeamInvitation entities by IDs.
+func (uu *UserUpdate) RemoveSentTeamInvitationIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveSentTeamInvitationIDs(ids...)
+	return uu
+}
+
+// RemoveSentTeamInvitations removes "sent_team_invitations" edges to TeamInvitation entities.
+func (uu *UserUpdate) RemoveSentTeamInvitations(t ...*TeamInvitation) *UserUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uu.RemoveSentTeamInvitationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if err := uu.defaults(); err != nil {
@@ -423,6 +497,96 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uu.mutation.TeamMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedTeamMembershipsIDs(); len(nodes) > 0 && !uu.mutation.TeamMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.TeamMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SentTeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSentTeamInvitationsIDs(); len(nodes) > 0 && !uu.mutation.SentTeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SentTeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -600,6 +764,36 @@ func (uuo *UserUpdateOne) AddVerificationToken(v ...*VerificationToken) *UserUpd
 	return uuo.AddVerificationTokenIDs(ids...)
 }
 
+// AddTeamMembershipIDs adds the "team_memberships" edge to the TeamMember entity by IDs.
+func (uuo *UserUpdateOne) AddTeamMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddTeamMembershipIDs(ids...)
+	return uuo
+}
+
+// AddTeamMemberships adds the "team_memberships" edges to the TeamMember entity.
+func (uuo *UserUpdateOne) AddTeamMemberships(t ...*TeamMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTeamMembershipIDs(ids...)
+}
+
+// AddSentTeamInvitationIDs adds the "sent_team_invitations" edge to the TeamInvitation entity by IDs.
+func (uuo *UserUpdateOne) AddSentTeamInvitationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddSentTeamInvitationIDs(ids...)
+	return uuo
+}
+
+// AddSentTeamInvitations adds the "sent_team_invitations" edges to the TeamInvitation entity.
+func (uuo *UserUpdateOne) AddSentTeamInvitations(t ...*TeamInvitation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddSentTeamInvitationIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -638,6 +832,48 @@ func (uuo *UserUpdateOne) RemoveVerificationToken(v ...*VerificationToken) *User
 	return uuo.RemoveVerificationTokenIDs(ids...)
 }
 
+// ClearTeamMemberships clears all "team_memberships" edges to the TeamMember entity.
+func (uuo *UserUpdateOne) ClearTeamMemberships() *UserUpdateOne {
+	uuo.mutation.ClearTeamMemberships()
+	return uuo
+}
+
+// RemoveTeamMembershipIDs removes the "team_memberships" edge to TeamMember entities by IDs.
+func (uuo *UserUpdateOne) RemoveTeamMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveTeamMembershipIDs(ids...)
+	return uuo
+}
+
+// RemoveTeamMemberships removes "team_memberships" edges to TeamMember entities.
+func (uuo *UserUpdateOne) RemoveTeamMemberships(t ...*TeamMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTeamMembershipIDs(ids...)
+}
+
+// ClearSentTeamInvitations clears all "sent_team_invitations" edges to the TeamInvitation entity.
+func (uuo *UserUpdateOne) ClearSentTeamInvitations() *UserUpdateOne {
+	uuo.mutation.ClearSentTeamInvitations()
+	return uuo
+}
+
+// RemoveSentTeamInvitationIDs removes the "sent_team_invitations" edge to TeamInvitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveSentTeamInvitationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveSentTeamInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveSentTeamInvitations removes "sent_team_invitations" edges to TeamInvitation entities.
+func (uuo *UserUpdateOne) RemoveSentTeamInvitations(t ...*TeamInvitation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveSentTeamInvitationIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	uuo.mutation.Where(ps...)
@@ -864,6 +1100,96 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if uuo.mutation.TeamMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedTeamMembershipsIDs(); len(nodes) > 0 && !uuo.mutation.TeamMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.TeamMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TeamMembershipsTable,
+			Columns: []string{user.TeamMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teammember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SentTeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSentTeamInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.SentTeamInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SentTeamInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentTeamInvitationsTable,
+			Columns: []string{user.SentTeamInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(teaminvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -7,6 +7,8 @@ import (
 
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/routers"
+	"github.com/paycrest/aggregator/services/psp"
+	"github.com/paycrest/aggregator/services/rates"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/tasks"
 	"github.com/paycrest/aggregator/utils/logger"
@@ -38,9 +40,23 @@ func main() {
 		logger.Fatalf("Redis initialization: %v", err)
 	}
 
+	// Initialize blob storage
+	if err := storage.InitializeBlobStorage(); err != nil {
+		logger.Fatalf("Blob storage initialization: %v", err)
+	}
+
+	// Register PSP adapters used to validate fulfillments
+	psp.RegisterAdapters()
+
+	// Register rate sources
+	rates.RegisterSources()
+
 	// Subscribe to Redis keyspace events
 	tasks.SubscribeToRedisKeyspaceEvents()
 
+	// Repair orders whose order request expiry events were missed while nothing was subscribed
+	go tasks.RecoverOrderAssignments()
+
 	// Start cron jobs
 	tasks.StartCronJobs()
 
@@ -6,12 +6,20 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/paycrest/aggregator/controllers"
 	"github.com/paycrest/aggregator/controllers/accounts"
+	"github.com/paycrest/aggregator/controllers/admin"
 	"github.com/paycrest/aggregator/controllers/provider"
 	"github.com/paycrest/aggregator/controllers/sender"
 	"github.com/paycrest/aggregator/routers/middleware"
 	u "github.com/paycrest/aggregator/utils"
 )
 
+// Team roles allowed to make changes through the settings and dashboard endpoints.
+// The finance role has read-only access.
+var (
+	writeRoles   = []string{"owner", "admin", "developer"}
+	managerRoles = []string{"owner", "admin"}
+)
+
 // RegisterRoutes add all routing list here automatically get main router
 func RegisterRoutes(route *gin.Engine) {
 	route.NoRoute(func(ctx *gin.Context) {
@@ -23,6 +31,7 @@ func RegisterRoutes(route *gin.Engine) {
 	authRoutes(route)
 	senderRoutes(route)
 	providerRoutes(route)
+	adminRoutes(route)
 
 	ctrl := controllers.NewController()
 
@@ -37,6 +46,8 @@ func RegisterRoutes(route *gin.Engine) {
 		ctrl.GetInstitutionsByCurrency,
 	)
 	v1.GET("rates/:token/:amount/:fiat", ctrl.GetTokenRate)
+	v1.GET("rate-history/:fiat", ctrl.GetHistoricalRate)
+	v1.GET("rate-history/:fiat/candles", ctrl.GetRateCandles)
 	v1.GET("pubkey", ctrl.GetAggregatorPublicKey)
 	v1.POST("verify-account", ctrl.VerifyAccount)
 	v1.GET("orders/:id", ctrl.GetLockPaymentOrderStatus)
@@ -56,6 +67,7 @@ func RegisterRoutes(route *gin.Engine) {
 func authRoutes(route *gin.Engine) {
 	authCtrl := accounts.NewAuthController()
 	var profileCtrl accounts.ProfileController
+	teamCtrl := accounts.NewTeamController()
 
 	v1 := route.Group("/v1/")
 	v1.POST("auth/register", middleware.OnlyWebMiddleware, authCtrl.Register)
@@ -66,6 +78,7 @@ func authRoutes(route *gin.Engine) {
 	v1.POST("auth/reset-password-token", middleware.OnlyWebMiddleware, authCtrl.ResetPasswordToken)
 	v1.PATCH("auth/reset-password", middleware.OnlyWebMiddleware, authCtrl.ResetPassword)
 	v1.PATCH("auth/change-password", middleware.JWTMiddleware, authCtrl.ChangePassword)
+	v1.POST("auth/accept-invitation", middleware.OnlyWebMiddleware, teamCtrl.AcceptInvitation)
 
 	v1.GET(
 		"settings/provider",
@@ -79,6 +92,7 @@ func authRoutes(route *gin.Engine) {
 		middleware.OnlyWebMiddleware,
 		middleware.JWTMiddleware,
 		middleware.OnlyProviderMiddleware,
+		middleware.OnlyRoleMiddleware("provider", writeRoles...),
 		profileCtrl.UpdateProviderProfile,
 	)
 
@@ -94,8 +108,29 @@ func authRoutes(route *gin.Engine) {
 		middleware.OnlyWebMiddleware,
 		middleware.JWTMiddleware,
 		middleware.OnlySenderMiddleware,
+		middleware.OnlyRoleMiddleware("sender", writeRoles...),
 		profileCtrl.UpdateSenderProfile,
 	)
+
+	// Team routes
+	for _, scope := range []string{"sender", "provider"} {
+		onlyScopeMiddleware := middleware.OnlySenderMiddleware
+		if scope == "provider" {
+			onlyScopeMiddleware = middleware.OnlyProviderMiddleware
+		}
+
+		team := v1.Group("settings/" + scope + "/team")
+		team.Use(middleware.OnlyWebMiddleware)
+		team.Use(middleware.JWTMiddleware)
+		team.Use(onlyScopeMiddleware)
+
+		team.GET("", teamCtrl.GetTeam)
+		team.GET("audit-logs", middleware.OnlyRoleMiddleware(scope, managerRoles...), teamCtrl.GetTeamAuditLogs)
+		team.POST("invitations", middleware.OnlyRoleMiddleware(scope, managerRoles...), teamCtrl.InviteTeamMember)
+		team.DELETE("invitations/:id", middleware.OnlyRoleMiddleware(scope, managerRoles...), teamCtrl.RevokeTeamInvitation)
+		team.PATCH("members/:id", middleware.OnlyRoleMiddleware(scope, managerRoles...), teamCtrl.UpdateTeamMember)
+		team.DELETE("members/:id", teamCtrl.RemoveTeamMember)
+	}
 }
 
 func senderRoutes(route *gin.Engine) {
@@ -105,10 +140,16 @@ func senderRoutes(route *gin.Engine) {
 	v1.Use(middleware.DynamicAuthMiddleware)
 	v1.Use(middleware.OnlySenderMiddleware)
 
-	v1.POST("orders", senderCtrl.InitiatePaymentOrder)
+	v1.POST("orders", middleware.OnlyRoleMiddleware("sender", writeRoles...), senderCtrl.InitiatePaymentOrder)
 	v1.GET("orders/:id", senderCtrl.GetPaymentOrderByID)
+	v1.GET("orders/:id/fulfillments/:fulfillment_id/receipt", senderCtrl.GetFulfillmentReceipt)
 	v1.GET("orders", senderCtrl.GetPaymentOrders)
 	v1.GET("stats", senderCtrl.Stats)
+	v1.POST("orders/:id/disputes", middleware.OnlyRoleMiddleware("sender", writeRoles...), senderCtrl.OpenDispute)
+	v1.GET("disputes", senderCtrl.GetDisputes)
+	v1.GET("disputes/:id", senderCtrl.GetDispute)
+	v1.POST("disputes/:id/evidence", middleware.OnlyRoleMiddleware("sender", writeRoles...), senderCtrl.SubmitDisputeEvidence)
+	v1.POST("disputes/:id/withdraw", middleware.OnlyRoleMiddleware("sender", writeRoles...), senderCtrl.WithdrawDispute)
 }
 
 func providerRoutes(route *gin.Engine) {
@@ -119,11 +160,58 @@ func providerRoutes(route *gin.Engine) {
 	v1.Use(middleware.OnlyProviderMiddleware)
 
 	v1.GET("orders", providerCtrl.GetLockPaymentOrders)
-	v1.POST("orders/:id/accept", providerCtrl.AcceptOrder)
-	v1.POST("orders/:id/decline", providerCtrl.DeclineOrder)
-	v1.POST("orders/:id/fulfill", providerCtrl.FulfillOrder)
-	v1.POST("orders/:id/cancel", providerCtrl.CancelOrder)
+	v1.POST("orders/:id/accept", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.AcceptOrder)
+	v1.POST("orders/:id/decline", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.DeclineOrder)
+	v1.POST("orders/:id/fulfill", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.FulfillOrder)
+	v1.POST("orders/:id/cancel", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.CancelOrder)
+	v1.POST("orders/batch/accept", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.BatchAcceptOrders)
+	v1.POST("orders/batch/decline", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.BatchDeclineOrders)
+	v1.POST("orders/batch/fulfill", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.BatchFulfillOrders)
 	v1.GET("rates/:token/:fiat", providerCtrl.GetMarketRate)
 	v1.GET("stats", providerCtrl.Stats)
 	v1.GET("node-info", providerCtrl.NodeInfo)
+	v1.GET("order-requests", providerCtrl.GetOrderRequests)
+	v1.GET("health", providerCtrl.GetHealthChecks)
+	v1.GET("sla", providerCtrl.GetSLAReport)
+	v1.GET("disputes", providerCtrl.GetDisputes)
+	v1.GET("disputes/:id", providerCtrl.GetDispute)
+	v1.POST("disputes/:id/evidence", middleware.OnlyRoleMiddleware("provider", writeRoles...), providerCtrl.SubmitDisputeEvidence)
+}
+
+func adminRoutes(route *gin.Engine) {
+	adminCtrl := admin.NewAdminController()
+
+	v1 := route.Group("/v1/admin/")
+	v1.Use(middleware.JWTMiddleware)
+	v1.Use(middleware.OnlyAdminMiddleware)
+
+	v1.GET("providers/:id/health", adminCtrl.GetProviderHealthChecks)
+	v1.GET("providers/:id/sla", adminCtrl.GetProviderSLAReport)
+	v1.GET("disputes", adminCtrl.GetDisputes)
+	v1.GET("disputes/:id", adminCtrl.GetDispute)
+	v1.POST("disputes/:id/resolve", adminCtrl.ResolveDispute)
+	v1.POST("disputes/:id/compensation/paid", adminCtrl.MarkDisputeCompensationPaid)
+	v1.PUT("currencies/:code/fulfillment-validation", adminCtrl.UpdateFulfillmentValidation)
+	v1.PUT("currencies/:code/matching-strategy", adminCtrl.UpdateCurrencyMatchingStrategy)
+	v1.PUT("currencies/:code/order-request-mode", adminCtrl.UpdateOrderRequestMode)
+	v1.GET("currencies/:code/circuit-breaker", adminCtrl.GetRateCircuitBreaker)
+	v1.PUT("currencies/:code/circuit-breaker", adminCtrl.UpdateRateCircuitBreaker)
+	v1.POST("currencies/:code/circuit-breaker/reset", adminCtrl.ResetRateCircuitBreaker)
+	v1.GET("currencies/:code/holidays", adminCtrl.GetPublicHolidays)
+	v1.POST("currencies/:code/holidays", adminCtrl.CreatePublicHoliday)
+	v1.DELETE("currencies/:code/holidays/:id", adminCtrl.DeletePublicHoliday)
+	v1.PUT("buckets/:id/matching-strategy", adminCtrl.UpdateBucketMatchingStrategy)
+	v1.GET("matching/metrics", adminCtrl.GetMatchingMetrics)
+	v1.GET("bucket-queues", adminCtrl.GetBucketQueues)
+	v1.GET("bucket-queues/:id", adminCtrl.GetBucketQueue)
+	v1.POST("bucket-queues/:id/rebuild", adminCtrl.RebuildBucketQueue)
+	v1.PUT("bucket-queues/:id/overrides/:provider_id", adminCtrl.UpdateBucketQueueOverride)
+	v1.DELETE("bucket-queues/:id/overrides/:provider_id", adminCtrl.DeleteBucketQueueOverride)
+	v1.GET("bucket-proposals", adminCtrl.GetBucketProposals)
+	v1.POST("bucket-proposals", adminCtrl.CreateBucketProposal)
+	v1.POST("bucket-proposals/:id/apply", adminCtrl.ApplyBucketProposal)
+	v1.POST("bucket-proposals/:id/dismiss", adminCtrl.DismissBucketProposal)
+	v1.GET("dead-letter-orders", adminCtrl.GetDeadLetterOrders)
+	v1.POST("dead-letter-orders/:id/retry", adminCtrl.RetryDeadLetterOrder)
+	v1.GET("orders/:id/assignments", adminCtrl.GetOrderAssignments)
 }
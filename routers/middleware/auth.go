@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/paycrest/aggregator/ent/apikey"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/senderprofile"
+	"github.com/paycrest/aggregator/ent/teammember"
 	"github.com/paycrest/aggregator/ent/user"
 	"github.com/paycrest/aggregator/storage"
 	u "github.com/paycrest/aggregator/utils"
@@ -28,6 +30,13 @@ import (
 	"github.com/paycrest/aggregator/utils/token"
 )
 
+// Methods a request can be authenticated with, set in the request context as "auth_method"
+const (
+	authMethodJWT    = "jwt"
+	authMethodAPIKey = "api_key"
+	authMethodHMAC   = "hmac"
+)
+
 // JWTMiddleware is a middleware to handle JWT authentication
 func JWTMiddleware(c *gin.Context) {
 	authHeader := c.GetHeader("Authorization")
@@ -69,35 +78,102 @@ func JWTMiddleware(c *gin.Context) {
 
 	senderAndProvider := strings.Contains(scope, "sender") && strings.Contains(scope, "provider")
 
-	// Set user profiles based on scope
+	// Set user profiles and team roles based on scope.
+	// A profile the user no longer owns or is a team member of is left unset, so its routes are refused.
+	profileFound := false
 	if scope == "sender" || senderAndProvider {
-		senderProfile, err := storage.Client.SenderProfile.
-			Query().
-			Where(senderprofile.HasUserWith(user.IDEQ(userUUID))).
-			WithOrderTokens().
-			Only(c)
-		if err != nil {
-			c.Set("sender", nil)
+		senderProfile, role, err := getSenderProfile(c, userUUID)
+		if err == nil {
+			c.Set("sender", senderProfile)
+			c.Set("sender_role", role)
+			profileFound = true
+		} else if !ent.IsNotFound(err) {
+			logger.Errorf("error: %v", err)
 		}
-
-		c.Set("sender", senderProfile)
 	}
 
 	if scope == "provider" || senderAndProvider {
-		providerProfile, err := storage.Client.ProviderProfile.
-			Query().
-			Where(providerprofile.HasUserWith(user.IDEQ(userUUID))).
-			Only(c)
-		if err != nil && !senderAndProvider {
-			c.Set("provider", nil)
+		providerProfile, role, err := getProviderProfile(c, userUUID)
+		if err == nil {
+			c.Set("provider", providerProfile)
+			c.Set("provider_role", role)
+			profileFound = true
+		} else if !ent.IsNotFound(err) {
+			logger.Errorf("error: %v", err)
 		}
+	}
 
-		c.Set("provider", providerProfile)
+	if (scope == "sender" || scope == "provider" || senderAndProvider) && !profileFound {
+		u.APIResponse(c, http.StatusUnauthorized, "error", "Invalid API key or token", nil)
+		c.Abort()
+		return
 	}
 
+	c.Set("auth_method", authMethodJWT)
+
+	// Admins are users with the admin scope; they can be granted alongside a sender or provider scope
+	c.Set("is_admin", slices.Contains(strings.Fields(scope), "admin"))
+
 	c.Next()
 }
 
+// getSenderProfile fetches the sender profile a user owns or is a team member of, along with the user's role on it
+func getSenderProfile(ctx *gin.Context, userID uuid.UUID) (*ent.SenderProfile, string, error) {
+	senderProfile, err := storage.Client.SenderProfile.
+		Query().
+		Where(senderprofile.HasUserWith(user.IDEQ(userID))).
+		WithOrderTokens().
+		Only(ctx)
+	if err == nil {
+		return senderProfile, "owner", nil
+	} else if !ent.IsNotFound(err) {
+		return nil, "", err
+	}
+
+	member, err := storage.Client.TeamMember.
+		Query().
+		Where(
+			teammember.HasUserWith(user.IDEQ(userID)),
+			teammember.HasSenderProfile(),
+		).
+		WithSenderProfile(func(spq *ent.SenderProfileQuery) {
+			spq.WithOrderTokens()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return member.Edges.SenderProfile, string(member.Role), nil
+}
+
+// getProviderProfile fetches the provider profile a user owns or is a team member of, along with the user's role on it
+func getProviderProfile(ctx *gin.Context, userID uuid.UUID) (*ent.ProviderProfile, string, error) {
+	providerProfile, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.HasUserWith(user.IDEQ(userID))).
+		Only(ctx)
+	if err == nil {
+		return providerProfile, "owner", nil
+	} else if !ent.IsNotFound(err) {
+		return nil, "", err
+	}
+
+	member, err := storage.Client.TeamMember.
+		Query().
+		Where(
+			teammember.HasUserWith(user.IDEQ(userID)),
+			teammember.HasProviderProfile(),
+		).
+		WithProviderProfile().
+		Only(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return member.Edges.ProviderProfile, string(member.Role), nil
+}
+
 type PrivyClaims struct {
 	jwt.MapClaims
 	AppId          string `json:"aud,omitempty"`
@@ -360,6 +436,8 @@ func HMACVerificationMiddleware(c *gin.Context) {
 		return
 	}
 
+	c.Set("auth_method", authMethodHMAC)
+
 	// Remove the timestamp key from the payload
 	delete(payloadData, "timestamp")
 
@@ -432,6 +510,8 @@ func APIKeyMiddleware(c *gin.Context) {
 		return
 	}
 
+	c.Set("auth_method", authMethodAPIKey)
+
 	// Continue to the next middleware
 	c.Next()
 }
@@ -482,6 +562,37 @@ func OnlyProviderMiddleware(c *gin.Context) {
 	c.Next()
 }
 
+// OnlyAdminMiddleware is a middleware that checks if the user scope is admin.
+func OnlyAdminMiddleware(c *gin.Context) {
+	if !c.GetBool("is_admin") {
+		u.APIResponse(c, http.StatusForbidden, "error", "You do not have permission to perform this action", nil)
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
+
+// OnlyRoleMiddleware is a middleware that checks if the user's team role on the sender or provider profile is one of the allowed roles.
+// Requests authenticated with an API key or HMAC signature act as the profile itself and are not restricted.
+func OnlyRoleMiddleware(scope string, roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authMethod := c.GetString("auth_method")
+		if authMethod == authMethodAPIKey || authMethod == authMethodHMAC {
+			c.Next()
+			return
+		}
+
+		if !u.ContainsString(roles, c.GetString(scope+"_role")) {
+			u.APIResponse(c, http.StatusForbidden, "error", "You do not have permission to perform this action", nil)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
 // OnlyWebMiddleware is a middle that checks your Client-Type and allows for auth
 func OnlyWebMiddleware(c *gin.Context) {
 	// Check the request headers to determine the desired authentication method
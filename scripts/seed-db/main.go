@@ -219,7 +219,7 @@ func seedProvider(ctx context.Context, client *ent.Client, bucket *ent.Provision
 		SetUser(user).
 		SetIsActive(true).
 		SetIsAvailable(true).
-		SetCurrencyID(currency.ID).
+		AddCurrencyIDs(currency.ID).
 		SetAddress("123 Main St").
 		SetMobileNumber("+2348063000000").
 		SetDateOfBirth(time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC)).
@@ -264,6 +264,7 @@ func seedProvider(ctx context.Context, client *ent.Client, bucket *ent.Provision
 		SetMaxOrderAmount(bucket.MaxAmount).
 		SetAddresses(addresses).
 		SetProvider(provider).
+		SetCurrency(currency).
 		Save(ctx)
 	if err != nil {
 		return "", "", "", fmt.Errorf("failed to configure order tokens: %s", err)
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/paycrest/aggregator/config"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/services"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Replays historical lock payment orders through the matching engine in-process and reports how they
+// would be assigned. Providers, token configs, buckets and orders are read from the database, or from a
+// snapshot fixture loaded into an in-memory database with -fixture. No Redis or provider nodes are used.
+//
+//	go run scripts/simulate-matching/main.go -fixture snapshot.json -strategy round_robin
+func main() {
+	fixture := flag.String("fixture", "", "path to a JSON snapshot fixture to replay instead of the database")
+	strategy := flag.String("strategy", "", "matching strategy to replay with, overriding the configured ones")
+	currency := flag.String("currency", "", "only replay the buckets and orders of this fiat currency")
+	from := flag.String("from", "", "only replay orders created at or after this date (YYYY-MM-DD or RFC3339)")
+	to := flag.String("to", "", "only replay orders created before this date (YYYY-MM-DD or RFC3339)")
+	limit := flag.Int("limit", 0, "maximum number of orders to replay")
+	seed := flag.Int64("seed", 1, "seed for the random choices of the trust weighted strategy")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	flag.Parse()
+
+	ctx := context.Background()
+	simulator := services.NewMatchingSimulator()
+
+	if *fixture != "" {
+		snapshot, err := readSnapshot(*fixture)
+		if err != nil {
+			logger.Fatalf("failed to read snapshot fixture: %s", err)
+		}
+
+		client, err := ent.Open("sqlite3", "file:simulation?mode=memory&cache=shared&_fk=1")
+		if err != nil {
+			logger.Fatalf("failed to open in-memory database: %s", err)
+		}
+		defer client.Close()
+
+		if err := client.Schema.Create(ctx); err != nil {
+			logger.Fatalf("failed to create in-memory database schema: %s", err)
+		}
+		storage.Client = client
+
+		if err := simulator.LoadSnapshot(ctx, client, snapshot); err != nil {
+			logger.Fatalf("failed to load snapshot fixture: %s", err)
+		}
+	} else {
+		// Connect to the database
+		DSN := config.DBConfig()
+
+		if err := storage.DBConnection(DSN); err != nil {
+			logger.Fatalf("database DBConnection: %s", err)
+		}
+
+		client := storage.GetClient()
+		defer client.Close()
+	}
+
+	opts := services.MatchingSimulationOptions{
+		Strategy: *strategy,
+		Currency: *currency,
+		Limit:    *limit,
+		Seed:     *seed,
+	}
+
+	var err error
+	if opts.From, err = parseDate(*from); err != nil {
+		logger.Fatalf("invalid -from date: %s", err)
+	}
+	if opts.To, err = parseDate(*to); err != nil {
+		logger.Fatalf("invalid -to date: %s", err)
+	}
+
+	report, err := simulator.Run(ctx, opts)
+	if err != nil {
+		logger.Fatalf("failed to run matching simulation: %s", err)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			logger.Fatalf("failed to encode report: %s", err)
+		}
+		return
+	}
+
+	printReport(report)
+}
+
+// readSnapshot reads a matching snapshot fixture from a JSON file
+func readSnapshot(path string) (*types.MatchingSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot types.MatchingSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// parseDate parses a date flag, returning the zero time when it is empty
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// printReport prints a simulation report as text tables
+func printReport(report *types.MatchingSimulationReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	strategy := report.Strategy
+	if strategy == "" {
+		strategy = "configured"
+	}
+
+	fmt.Fprintf(w, "Strategy:\t%s\n", strategy)
+	fmt.Fprintf(w, "Orders:\t%d\n", report.Orders)
+	fmt.Fprintf(w, "Matched:\t%d (%s%%)\n", report.Matched, report.MatchRate)
+	fmt.Fprintf(w, "Unmatched:\t%d\n", report.Unmatched)
+	fmt.Fprintf(w, "Assigned to a different provider than before:\t%d\n", report.Reassigned)
+
+	strategies := make([]string, 0, len(report.Strategies))
+	for name := range report.Strategies {
+		strategies = append(strategies, name)
+	}
+	sort.Strings(strategies)
+	for _, name := range strategies {
+		fmt.Fprintf(w, "Matched by %s:\t%d\n", name, report.Strategies[name])
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Matched rate vs order rate")
+	fmt.Fprintf(w, "Above:\t%d\n", report.Rates.AboveOrderRate)
+	fmt.Fprintf(w, "At:\t%d\n", report.Rates.AtOrderRate)
+	fmt.Fprintf(w, "Below:\t%d\n", report.Rates.BelowOrderRate)
+	fmt.Fprintf(w, "Spread (avg / min / max):\t%s / %s / %s\n", report.Rates.AverageSpread, report.Rates.MinSpread, report.Rates.MaxSpread)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "PROVIDER\tORDERS\tSHARE %\tVOLUME\tFIAT VOLUME\tHISTORICAL ORDERS")
+	for _, provider := range report.Providers {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%d\n",
+			provider.ProviderID, provider.Orders, provider.Share, provider.Volume, provider.FiatVolume.Round(2), provider.HistoricalOrders)
+	}
+
+	if len(report.UnmatchedOrders) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "UNMATCHED ORDER\tTOKEN\tAMOUNT\tRATE\tINSTITUTION\tREASON")
+		for _, order := range report.UnmatchedOrders {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				order.OrderID, order.Token, order.Amount, order.Rate, order.Institution, order.Reason)
+		}
+	}
+
+	w.Flush()
+}
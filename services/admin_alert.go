@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/paycrest/aggregator/ent/user"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// AlertAdmins emails an alert to every admin.
+// An admin that can't be reached doesn't keep the others from being alerted.
+func AlertAdmins(ctx context.Context, subject, message string) error {
+	admins, err := storage.Client.User.
+		Query().
+		Where(user.ScopeContains("admin")).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("AlertAdmins: %w", err)
+	}
+
+	emailService := NewEmailService(SENDGRID_MAIL_PROVIDER)
+	for _, admin := range admins {
+		_, err := emailService.SendAdminAlertEmail(ctx, admin.Email, admin.FirstName, subject, message)
+		if err != nil {
+			logger.Errorf("failed to alert admin %s: %v", admin.Email, err)
+		}
+	}
+
+	return nil
+}
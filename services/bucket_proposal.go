@@ -0,0 +1,578 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// ErrBucketProposalNotPending is returned when applying or dismissing a proposal that was already acted on or superseded
+var ErrBucketProposalNotPending = errors.New("bucket proposal is not pending")
+
+// BucketProposalService proposes provision bucket boundaries from recent order amounts and applies them
+type BucketProposalService struct {
+	priorityQueue *PriorityQueueService
+}
+
+// NewBucketProposalService creates a new instance of BucketProposalService
+func NewBucketProposalService() *BucketProposalService {
+	return &BucketProposalService{
+		priorityQueue: NewPriorityQueueService(),
+	}
+}
+
+// ProposeBuckets proposes new buckets for each enabled currency and returns the number of proposals made
+func (s *BucketProposalService) ProposeBuckets(ctx context.Context) (int, error) {
+	currencies, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.IsEnabled(true)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("ProposeBuckets.currencies: %w", err)
+	}
+
+	proposed := 0
+	for _, currency := range currencies {
+		proposal, err := s.ProposeBucketsForCurrency(ctx, currency.Code)
+		if err != nil {
+			logger.Errorf("ProposeBuckets: %s: %v", currency.Code, err)
+			continue
+		}
+		if proposal != nil {
+			proposed++
+		}
+	}
+
+	return proposed, nil
+}
+
+// ProposeBucketsForCurrency analyses the fiat amounts of a currency's orders within the proposal window and
+// proposes bucket boundaries that spread them evenly across as many buckets as the currency has.
+// Pending proposals of the currency are superseded. No proposal is made, and nil is returned, when there are
+// too few orders to go by or the proposed buckets are the current ones.
+func (s *BucketProposalService) ProposeBucketsForCurrency(ctx context.Context, currencyCode string) (*types.BucketProposalResponse, error) {
+	currency, err := storage.Client.FiatCurrency.
+		Query().
+		Where(fiatcurrency.CodeEQ(currencyCode)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.currency: %w", err)
+	}
+
+	buckets, err := currency.QueryProvisionBuckets().
+		Order(ent.Asc(provisionbucket.FieldMinAmount)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.buckets: %w", err)
+	}
+
+	if len(buckets) == 0 {
+		return nil, nil
+	}
+
+	institutions, err := currency.QueryInstitutions().
+		Select(institution.FieldCode).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.institutions: %w", err)
+	}
+
+	windowEnd := time.Now()
+	windowStart := windowEnd.Add(-orderConf.BucketProposalWindow)
+
+	orders, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.CreatedAtGTE(windowStart),
+			lockpaymentorder.InstitutionIn(institutions...),
+		).
+		Select(lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.orders: %w", err)
+	}
+
+	if len(orders) < orderConf.BucketProposalMinOrders {
+		return nil, nil
+	}
+
+	amounts := make([]decimal.Decimal, 0, len(orders))
+	for _, order := range orders {
+		amounts = append(amounts, order.Amount.Mul(order.Rate))
+	}
+
+	current := make([]types.ProposedBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		current = append(current, types.ProposedBucket{
+			ID:        bucket.ID,
+			MinAmount: bucket.MinAmount,
+			MaxAmount: bucket.MaxAmount,
+		})
+	}
+	countBucketOrders(current, amounts)
+
+	proposed := proposeBucketRanges(amounts, len(buckets), buckets[0].MinAmount, buckets[len(buckets)-1].MaxAmount)
+	countBucketOrders(proposed, amounts)
+
+	if sameBucketRanges(current, proposed) {
+		return nil, nil
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.tx: %w", err)
+	}
+
+	_, err = tx.BucketProposal.
+		Update().
+		Where(
+			bucketproposal.StatusEQ(bucketproposal.StatusPending),
+			bucketproposal.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+		).
+		SetStatus(bucketproposal.StatusSuperseded).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.supersede: %w", err)
+	}
+
+	proposalBuckets := make([]struct {
+		MinAmount  decimal.Decimal `json:"minAmount"`
+		MaxAmount  decimal.Decimal `json:"maxAmount"`
+		OrderCount int             `json:"orderCount"`
+	}, len(proposed))
+	for i, bucket := range proposed {
+		proposalBuckets[i].MinAmount = bucket.MinAmount
+		proposalBuckets[i].MaxAmount = bucket.MaxAmount
+		proposalBuckets[i].OrderCount = bucket.OrderCount
+	}
+
+	currentBuckets := make([]struct {
+		ID         int             `json:"id"`
+		MinAmount  decimal.Decimal `json:"minAmount"`
+		MaxAmount  decimal.Decimal `json:"maxAmount"`
+		OrderCount int             `json:"orderCount"`
+	}, len(current))
+	for i, bucket := range current {
+		currentBuckets[i].ID = bucket.ID
+		currentBuckets[i].MinAmount = bucket.MinAmount
+		currentBuckets[i].MaxAmount = bucket.MaxAmount
+		currentBuckets[i].OrderCount = bucket.OrderCount
+	}
+
+	proposal, err := tx.BucketProposal.
+		Create().
+		SetCurrency(currency).
+		SetWindowStart(windowStart).
+		SetWindowEnd(windowEnd).
+		SetOrderCount(len(orders)).
+		SetBuckets(proposalBuckets).
+		SetCurrentBuckets(currentBuckets).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.create: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ProposeBucketsForCurrency.commit: %w", err)
+	}
+
+	proposal.Edges.Currency = currency
+	return bucketProposalResponse(proposal), nil
+}
+
+// GetProposals returns bucket proposals, newest first, optionally filtered by currency code and status
+func (s *BucketProposalService) GetProposals(ctx context.Context, currencyCode string, status string) ([]types.BucketProposalResponse, error) {
+	predicates := []predicate.BucketProposal{}
+	if currencyCode != "" {
+		predicates = append(predicates, bucketproposal.HasCurrencyWith(fiatcurrency.CodeEQ(currencyCode)))
+	}
+	if status != "" {
+		predicates = append(predicates, bucketproposal.StatusEQ(bucketproposal.Status(status)))
+	}
+
+	proposals, err := storage.Client.BucketProposal.
+		Query().
+		Where(predicates...).
+		WithCurrency().
+		Order(ent.Desc(bucketproposal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetProposals: %w", err)
+	}
+
+	responses := make([]types.BucketProposalResponse, 0, len(proposals))
+	for _, proposal := range proposals {
+		responses = append(responses, *bucketProposalResponse(proposal))
+	}
+
+	return responses, nil
+}
+
+// DismissProposal dismisses a pending bucket proposal
+func (s *BucketProposalService) DismissProposal(ctx context.Context, id uuid.UUID) (*types.BucketProposalResponse, error) {
+	proposal, err := s.pendingProposal(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("DismissProposal: %w", err)
+	}
+
+	proposal, err = proposal.Update().
+		SetStatus(bucketproposal.StatusDismissed).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("DismissProposal.update: %w", err)
+	}
+
+	return s.proposal(ctx, proposal.ID)
+}
+
+// ApplyProposal replaces the buckets of a currency with the proposed ones.
+//
+// Existing buckets are updated in place, lowest first, so their orders keep them; buckets beyond the proposed
+// ones are removed and missing ones created. Each new bucket takes the providers of the old buckets it overlaps,
+// so providers keep covering the amounts they did. Orders of the old buckets are moved to the bucket their amount
+// now falls in, and the bucket queues are rebuilt so matching picks up the new buckets right away.
+func (s *BucketProposalService) ApplyProposal(ctx context.Context, id uuid.UUID) (*types.BucketProposalResponse, error) {
+	proposal, err := s.pendingProposal(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("ApplyProposal: %w", err)
+	}
+
+	oldBuckets, err := proposal.Edges.Currency.QueryProvisionBuckets().
+		WithProviderProfiles().
+		Order(ent.Asc(provisionbucket.FieldMinAmount)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ApplyProposal.buckets: %w", err)
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ApplyProposal.tx: %w", err)
+	}
+
+	err = applyBucketProposal(ctx, tx, proposal, oldBuckets)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("ApplyProposal: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("ApplyProposal.commit: %w", err)
+	}
+
+	// Rebuild the queues of the new buckets before clearing the old ones, so matching always has a queue
+	buckets, err := s.priorityQueue.GetProvisionBuckets(ctx, provisionbucket.HasCurrencyWith(fiatcurrency.IDEQ(proposal.Edges.Currency.ID)))
+	if err != nil {
+		return nil, fmt.Errorf("ApplyProposal.GetProvisionBuckets: %w", err)
+	}
+
+	for _, bucket := range buckets {
+		s.priorityQueue.CreatePriorityQueueForBucket(ctx, bucket)
+	}
+
+	// Clear the queues and order books of the ranges that no longer exist
+	for _, bucket := range oldBuckets {
+		stillExists := false
+		for _, proposed := range proposal.Buckets {
+			if proposed.MinAmount.Equal(bucket.MinAmount) && proposed.MaxAmount.Equal(bucket.MaxAmount) {
+				stillExists = true
+				break
+			}
+		}
+		if stillExists {
+			continue
+		}
+
+		bucket.Edges.Currency = proposal.Edges.Currency
+		err := storage.RedisClient.Del(ctx, bucketQueueKey(bucket), bucketOverridesKey(bucket), bucketBuildKey(bucket)).Err()
+		if err != nil {
+			logger.Errorf("ApplyProposal: failed to clear queue of bucket %d: %v", bucket.ID, err)
+		}
+
+		err = s.priorityQueue.matchingEngine.RebuildBooks(ctx, proposal.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount, nil)
+		if err != nil {
+			logger.Errorf("ApplyProposal: failed to clear order books of bucket %d: %v", bucket.ID, err)
+		}
+	}
+
+	return s.proposal(ctx, proposal.ID)
+}
+
+// applyBucketProposal updates the buckets of a proposal's currency within a transaction
+func applyBucketProposal(ctx context.Context, tx *ent.Tx, proposal *ent.BucketProposal, oldBuckets []*ent.ProvisionBucket) error {
+	newBuckets := make([]*ent.ProvisionBucket, 0, len(proposal.Buckets))
+
+	for i, proposed := range proposal.Buckets {
+		// Providers of the old buckets overlapping the new one
+		providerIDs := []string{}
+		seen := map[string]bool{}
+		for _, old := range oldBuckets {
+			if old.MaxAmount.LessThan(proposed.MinAmount) || old.MinAmount.GreaterThan(proposed.MaxAmount) {
+				continue
+			}
+			for _, provider := range old.Edges.ProviderProfiles {
+				if !seen[provider.ID] {
+					seen[provider.ID] = true
+					providerIDs = append(providerIDs, provider.ID)
+				}
+			}
+		}
+
+		var bucket *ent.ProvisionBucket
+		var err error
+		if i < len(oldBuckets) {
+			bucket, err = tx.ProvisionBucket.
+				UpdateOne(oldBuckets[i]).
+				SetMinAmount(proposed.MinAmount).
+				SetMaxAmount(proposed.MaxAmount).
+				ClearProviderProfiles().
+				AddProviderProfileIDs(providerIDs...).
+				Save(ctx)
+		} else {
+			bucket, err = tx.ProvisionBucket.
+				Create().
+				SetMinAmount(proposed.MinAmount).
+				SetMaxAmount(proposed.MaxAmount).
+				SetCurrencyID(proposal.Edges.Currency.ID).
+				AddProviderProfileIDs(providerIDs...).
+				Save(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("bucket %s-%s: %w", proposed.MinAmount, proposed.MaxAmount, err)
+		}
+
+		newBuckets = append(newBuckets, bucket)
+	}
+
+	if len(oldBuckets) > 0 {
+		oldBucketIDs := make([]int, 0, len(oldBuckets))
+		for _, bucket := range oldBuckets {
+			oldBucketIDs = append(oldBucketIDs, bucket.ID)
+		}
+
+		// Move every order of the old buckets to the bucket its amount now falls in, so no order is left
+		// on a removed bucket or on a bucket whose range no longer covers it
+		orders, err := tx.LockPaymentOrder.
+			Query().
+			Where(lockpaymentorder.HasProvisionBucketWith(provisionbucket.IDIn(oldBucketIDs...))).
+			Select(lockpaymentorder.FieldAmount, lockpaymentorder.FieldRate).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("orders: %w", err)
+		}
+
+		orderIDs := map[int][]uuid.UUID{}
+		for _, order := range orders {
+			bucket := closestBucket(newBuckets, order.Amount.Mul(order.Rate))
+			orderIDs[bucket.ID] = append(orderIDs[bucket.ID], order.ID)
+		}
+
+		for bucketID, ids := range orderIDs {
+			_, err := tx.LockPaymentOrder.
+				Update().
+				Where(lockpaymentorder.IDIn(ids...)).
+				SetProvisionBucketID(bucketID).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("orders of bucket %d: %w", bucketID, err)
+			}
+		}
+	}
+
+	// Remove the old buckets beyond the proposed ones
+	for _, bucket := range oldBuckets[min(len(oldBuckets), len(newBuckets)):] {
+		if err := tx.ProvisionBucket.DeleteOne(bucket).Exec(ctx); err != nil {
+			return fmt.Errorf("delete bucket %d: %w", bucket.ID, err)
+		}
+	}
+
+	_, err := tx.BucketProposal.
+		UpdateOne(proposal).
+		SetStatus(bucketproposal.StatusApplied).
+		SetAppliedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("proposal: %w", err)
+	}
+
+	return nil
+}
+
+// pendingProposal returns a pending bucket proposal with its currency
+func (s *BucketProposalService) pendingProposal(ctx context.Context, id uuid.UUID) (*ent.BucketProposal, error) {
+	proposal, err := storage.Client.BucketProposal.
+		Query().
+		Where(bucketproposal.IDEQ(id)).
+		WithCurrency().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if proposal.Status != bucketproposal.StatusPending {
+		return nil, ErrBucketProposalNotPending
+	}
+
+	return proposal, nil
+}
+
+// proposal returns a bucket proposal as a response
+func (s *BucketProposalService) proposal(ctx context.Context, id uuid.UUID) (*types.BucketProposalResponse, error) {
+	proposal, err := storage.Client.BucketProposal.
+		Query().
+		Where(bucketproposal.IDEQ(id)).
+		WithCurrency().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucketProposalResponse(proposal), nil
+}
+
+// bucketProposalResponse converts a bucket proposal with its currency to a response
+func bucketProposalResponse(proposal *ent.BucketProposal) *types.BucketProposalResponse {
+	response := &types.BucketProposalResponse{
+		ID:             proposal.ID,
+		Status:         string(proposal.Status),
+		WindowStart:    proposal.WindowStart,
+		WindowEnd:      proposal.WindowEnd,
+		OrderCount:     proposal.OrderCount,
+		Buckets:        make([]types.ProposedBucket, 0, len(proposal.Buckets)),
+		CurrentBuckets: make([]types.ProposedBucket, 0, len(proposal.CurrentBuckets)),
+		CreatedAt:      proposal.CreatedAt,
+	}
+
+	if proposal.Edges.Currency != nil {
+		response.Currency = proposal.Edges.Currency.Code
+	}
+
+	if !proposal.AppliedAt.IsZero() {
+		response.AppliedAt = &proposal.AppliedAt
+	}
+
+	for _, bucket := range proposal.Buckets {
+		response.Buckets = append(response.Buckets, types.ProposedBucket{
+			MinAmount:  bucket.MinAmount,
+			MaxAmount:  bucket.MaxAmount,
+			OrderCount: bucket.OrderCount,
+		})
+	}
+
+	for _, bucket := range proposal.CurrentBuckets {
+		response.CurrentBuckets = append(response.CurrentBuckets, types.ProposedBucket{
+			ID:         bucket.ID,
+			MinAmount:  bucket.MinAmount,
+			MaxAmount:  bucket.MaxAmount,
+			OrderCount: bucket.OrderCount,
+		})
+	}
+
+	return response
+}
+
+// proposeBucketRanges splits [floor, ceiling] into up to count buckets holding about as many of the amounts each.
+// Boundaries are rounded down to two significant digits and, as with the seeded buckets, each bucket starts one
+// above the end of the previous one. The ceiling is raised to cover the largest amount.
+func proposeBucketRanges(amounts []decimal.Decimal, count int, floor, ceiling decimal.Decimal) []types.ProposedBucket {
+	sorted := append([]decimal.Decimal{}, amounts...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+
+	if len(sorted) > 0 {
+		if largest := roundBucketBoundary(sorted[len(sorted)-1], true); largest.GreaterThan(ceiling) {
+			ceiling = largest
+		}
+	}
+
+	buckets := []types.ProposedBucket{}
+	minAmount := floor
+
+	for i := 1; i < count && len(sorted) > 0; i++ {
+		boundary := roundBucketBoundary(sorted[i*len(sorted)/count], false)
+
+		// Skip boundaries that would leave a bucket empty of range
+		if boundary.LessThanOrEqual(minAmount) || boundary.GreaterThanOrEqual(ceiling) {
+			continue
+		}
+
+		buckets = append(buckets, types.ProposedBucket{MinAmount: minAmount, MaxAmount: boundary})
+		minAmount = boundary.Add(decimal.NewFromInt(1))
+	}
+
+	return append(buckets, types.ProposedBucket{MinAmount: minAmount, MaxAmount: ceiling})
+}
+
+// roundBucketBoundary rounds an amount down, or up, to two significant digits
+func roundBucketBoundary(amount decimal.Decimal, up bool) decimal.Decimal {
+	digits := len(amount.Truncate(0).Abs().String())
+	if digits <= 2 {
+		if up {
+			return amount.Ceil()
+		}
+		return amount.Floor()
+	}
+
+	step := decimal.New(1, int32(digits-2))
+	if up {
+		return amount.Div(step).Ceil().Mul(step)
+	}
+	return amount.Div(step).Floor().Mul(step)
+}
+
+// countBucketOrders counts the amounts falling in each of a sorted list of buckets. Amounts between the end of
+// a bucket and the start of the next are counted in the next.
+func countBucketOrders(buckets []types.ProposedBucket, amounts []decimal.Decimal) {
+	for _, amount := range amounts {
+		for i := range buckets {
+			if amount.LessThanOrEqual(buckets[i].MaxAmount) || i == len(buckets)-1 {
+				buckets[i].OrderCount++
+				break
+			}
+		}
+	}
+}
+
+// sameBucketRanges checks whether two sorted lists of buckets have the same ranges
+func sameBucketRanges(a, b []types.ProposedBucket) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].MinAmount.Equal(b[i].MinAmount) || !a[i].MaxAmount.Equal(b[i].MaxAmount) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// closestBucket returns the bucket a fiat amount falls in, or the closest one if it falls in none
+func closestBucket(buckets []*ent.ProvisionBucket, fiatAmount decimal.Decimal) *ent.ProvisionBucket {
+	for _, bucket := range buckets {
+		if fiatAmount.LessThanOrEqual(bucket.MaxAmount) {
+			return bucket
+		}
+	}
+
+	return buckets[len(buckets)-1]
+}
@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/bucketproposal"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketProposal(t *testing.T) {
+	amounts := func(values ...int64) []decimal.Decimal {
+		result := make([]decimal.Decimal, 0, len(values))
+		for _, value := range values {
+			result = append(result, decimal.NewFromInt(value))
+		}
+		return result
+	}
+
+	t.Run("proposes buckets that spread orders evenly", func(t *testing.T) {
+		orders := amounts(100, 150, 200, 250, 2300, 2500, 2700, 2900, 12345, 14000, 15000, 16000)
+
+		buckets := proposeBucketRanges(orders, 3, decimal.Zero, decimal.NewFromInt(50000))
+		countBucketOrders(buckets, orders)
+
+		assert.Len(t, buckets, 3)
+		assert.True(t, buckets[0].MinAmount.Equal(decimal.Zero))
+		assert.True(t, buckets[0].MaxAmount.Equal(decimal.NewFromInt(2300)))
+		assert.True(t, buckets[1].MinAmount.Equal(decimal.NewFromInt(2301)))
+		assert.True(t, buckets[1].MaxAmount.Equal(decimal.NewFromInt(12000)))
+		assert.True(t, buckets[2].MinAmount.Equal(decimal.NewFromInt(12001)))
+		assert.True(t, buckets[2].MaxAmount.Equal(decimal.NewFromInt(50000)))
+
+		assert.Equal(t, 5, buckets[0].OrderCount)
+		assert.Equal(t, 3, buckets[1].OrderCount)
+		assert.Equal(t, 4, buckets[2].OrderCount)
+	})
+
+	t.Run("merges buckets that would be empty and covers the largest order", func(t *testing.T) {
+		orders := amounts(500, 500, 500, 500, 500, 500, 73456)
+
+		buckets := proposeBucketRanges(orders, 3, decimal.Zero, decimal.NewFromInt(50000))
+		assert.Len(t, buckets, 2)
+		assert.True(t, buckets[0].MaxAmount.Equal(decimal.NewFromInt(500)))
+		assert.True(t, buckets[1].MaxAmount.Equal(decimal.NewFromInt(74000)))
+	})
+
+	t.Run("rounds boundaries to two significant digits", func(t *testing.T) {
+		assert.True(t, roundBucketBoundary(decimal.NewFromFloat(12345.67), false).Equal(decimal.NewFromInt(12000)))
+		assert.True(t, roundBucketBoundary(decimal.NewFromFloat(12345.67), true).Equal(decimal.NewFromInt(13000)))
+		assert.True(t, roundBucketBoundary(decimal.NewFromFloat(987), false).Equal(decimal.NewFromInt(980)))
+		assert.True(t, roundBucketBoundary(decimal.NewFromFloat(42.5), false).Equal(decimal.NewFromInt(42)))
+	})
+
+	t.Run("applies a proposal", func(t *testing.T) {
+		ctx := context.Background()
+		client := enttest.Open(t, "sqlite3", "file:bucketproposal?mode=memory&_fk=1")
+		defer client.Close()
+
+		currency, err := client.FiatCurrency.
+			Create().
+			SetCode("NGN").
+			SetShortName("Naira").
+			SetSymbol("₦").
+			SetName("Nigerian Naira").
+			SetMarketRate(decimal.NewFromInt(1500)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		createProvider := func(id string) *ent.ProviderProfile {
+			user, err := client.User.
+				Create().
+				SetFirstName("Test").
+				SetLastName("Provider").
+				SetEmail(id + "@example.com").
+				SetPassword("password").
+				SetScope("provider").
+				Save(ctx)
+			assert.NoError(t, err)
+
+			provider, err := client.ProviderProfile.
+				Create().
+				SetID(id).
+				SetUser(user).
+				Save(ctx)
+			assert.NoError(t, err)
+			return provider
+		}
+		small := createProvider("small")
+		large := createProvider("large")
+
+		oldBuckets := []*ent.ProvisionBucket{}
+		for _, b := range []struct {
+			min, max int64
+			provider *ent.ProviderProfile
+		}{{0, 1000, small}, {1001, 5000, small}, {5001, 50000, large}} {
+			bucket, err := client.ProvisionBucket.
+				Create().
+				SetMinAmount(decimal.NewFromInt(b.min)).
+				SetMaxAmount(decimal.NewFromInt(b.max)).
+				SetCurrency(currency).
+				AddProviderProfiles(b.provider).
+				Save(ctx)
+			assert.NoError(t, err)
+			oldBuckets = append(oldBuckets, bucket)
+		}
+
+		network, err := client.Network.
+			Create().
+			SetChainID(1).
+			SetIdentifier("test").
+			SetRPCEndpoint("").
+			SetIsTestnet(true).
+			SetFee(decimal.Zero).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		token, err := client.Token.
+			Create().
+			SetSymbol("USDT").
+			SetContractAddress("0x").
+			SetDecimals(6).
+			SetNetwork(network).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		createOrder := func(amount int64, status lockpaymentorder.Status, bucket *ent.ProvisionBucket) *ent.LockPaymentOrder {
+			order, err := client.LockPaymentOrder.
+				Create().
+				SetGatewayID("").
+				SetAmount(decimal.NewFromInt(amount)).
+				SetRate(decimal.NewFromInt(1)).
+				SetOrderPercent(decimal.NewFromInt(100)).
+				SetBlockNumber(0).
+				SetInstitution("GTBINGLA").
+				SetAccountIdentifier("").
+				SetAccountName("").
+				SetStatus(status).
+				SetToken(token).
+				SetProvisionBucket(bucket).
+				Save(ctx)
+			assert.NoError(t, err)
+			return order
+		}
+		inFlight := createOrder(3000, lockpaymentorder.StatusProcessing, oldBuckets[1])
+		settled := createOrder(1500, lockpaymentorder.StatusSettled, oldBuckets[1])
+		fulfilled := createOrder(20000, lockpaymentorder.StatusFulfilled, oldBuckets[2])
+
+		proposal, err := client.BucketProposal.
+			Create().
+			SetCurrency(currency).
+			SetWindowStart(time.Now().Add(-time.Hour)).
+			SetWindowEnd(time.Now()).
+			SetOrderCount(2).
+			SetBuckets([]struct {
+				MinAmount  decimal.Decimal `json:"minAmount"`
+				MaxAmount  decimal.Decimal `json:"maxAmount"`
+				OrderCount int             `json:"orderCount"`
+			}{
+				{MinAmount: decimal.Zero, MaxAmount: decimal.NewFromInt(2000)},
+				{MinAmount: decimal.NewFromInt(2001), MaxAmount: decimal.NewFromInt(50000)},
+			}).
+			SetCurrentBuckets([]struct {
+				ID         int             `json:"id"`
+				MinAmount  decimal.Decimal `json:"minAmount"`
+				MaxAmount  decimal.Decimal `json:"maxAmount"`
+				OrderCount int             `json:"orderCount"`
+			}{}).
+			Save(ctx)
+		assert.NoError(t, err)
+		proposal.Edges.Currency = currency
+
+		for i := range oldBuckets {
+			oldBuckets[i] = client.ProvisionBucket.Query().
+				Where(provisionbucket.IDEQ(oldBuckets[i].ID)).
+				WithProviderProfiles().
+				OnlyX(ctx)
+		}
+
+		tx, err := client.Tx(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, applyBucketProposal(ctx, tx, proposal, oldBuckets))
+		assert.NoError(t, tx.Commit())
+
+		buckets := client.ProvisionBucket.Query().
+			WithProviderProfiles().
+			Order(ent.Asc(provisionbucket.FieldMinAmount)).
+			AllX(ctx)
+		assert.Len(t, buckets, 2)
+		assert.Equal(t, oldBuckets[0].ID, buckets[0].ID)
+		assert.True(t, buckets[0].MaxAmount.Equal(decimal.NewFromInt(2000)))
+		assert.Len(t, buckets[0].Edges.ProviderProfiles, 1)
+		assert.Equal(t, "small", buckets[0].Edges.ProviderProfiles[0].ID)
+
+		// The second bucket overlaps buckets of both providers
+		assert.Equal(t, oldBuckets[1].ID, buckets[1].ID)
+		assert.Len(t, buckets[1].Edges.ProviderProfiles, 2)
+
+		// Orders follow their amount, including completed ones and those of removed buckets
+		assert.Equal(t, buckets[1].ID, inFlight.QueryProvisionBucket().OnlyIDX(ctx))
+		assert.Equal(t, buckets[0].ID, settled.QueryProvisionBucket().OnlyIDX(ctx))
+		assert.Equal(t, buckets[1].ID, fulfilled.QueryProvisionBucket().OnlyIDX(ctx))
+
+		proposal = client.BucketProposal.GetX(ctx, proposal.ID)
+		assert.Equal(t, bucketproposal.StatusApplied, proposal.Status)
+		assert.False(t, proposal.AppliedAt.IsZero())
+
+		response := bucketProposalResponse(proposal)
+		assert.NotNil(t, response.AppliedAt)
+		assert.Len(t, response.Buckets, 2)
+		assert.Equal(t, []types.ProposedBucket{}, response.CurrentBuckets)
+	})
+}
@@ -0,0 +1,347 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+)
+
+// Actions an admin can take on a provider in a bucket queue
+const (
+	BucketQueueOverridePin    = "pin"
+	BucketQueueOverrideRemove = "remove"
+)
+
+// ErrProviderNotInBucket is returned when overriding the place in a bucket queue of a provider not in the bucket
+var ErrProviderNotInBucket = errors.New("provider is not in the bucket")
+
+// bucketOverridesKey returns the Redis key of the hash of admin overrides of a bucket queue, keyed by provider ID
+func bucketOverridesKey(bucket *ent.ProvisionBucket) string {
+	return fmt.Sprintf("bucket_overrides_%s_%s_%s", bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount)
+}
+
+// bucketBuildKey returns the Redis key of the record of the last build of a bucket queue
+func bucketBuildKey(bucket *ent.ProvisionBucket) string {
+	return fmt.Sprintf("bucket_build_%s_%s_%s", bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount)
+}
+
+// GetBucketQueues returns the queues of all buckets with their decoded entries
+func (s *PriorityQueueService) GetBucketQueues(ctx context.Context) ([]types.BucketQueueResponse, error) {
+	buckets, err := storage.Client.ProvisionBucket.
+		Query().
+		WithCurrency().
+		Order(ent.Asc(provisionbucket.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetBucketQueues.buckets: %w", err)
+	}
+
+	queues := make([]types.BucketQueueResponse, 0, len(buckets))
+	for _, bucket := range buckets {
+		queue, err := s.bucketQueue(ctx, bucket)
+		if err != nil {
+			return nil, fmt.Errorf("GetBucketQueues: %w", err)
+		}
+		queues = append(queues, *queue)
+	}
+
+	return queues, nil
+}
+
+// GetBucketQueue returns the queue of a bucket with its decoded entries
+func (s *PriorityQueueService) GetBucketQueue(ctx context.Context, bucketID int) (*types.BucketQueueResponse, error) {
+	bucket, err := storage.Client.ProvisionBucket.
+		Query().
+		Where(provisionbucket.IDEQ(bucketID)).
+		WithCurrency().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetBucketQueue.bucket: %w", err)
+	}
+
+	return s.bucketQueue(ctx, bucket)
+}
+
+// RebuildBucketQueue rebuilds the queue and order books of a bucket right away and returns the new queue
+func (s *PriorityQueueService) RebuildBucketQueue(ctx context.Context, bucketID int) (*types.BucketQueueResponse, error) {
+	if _, err := storage.Client.ProvisionBucket.Get(ctx, bucketID); err != nil {
+		return nil, fmt.Errorf("RebuildBucketQueue.bucket: %w", err)
+	}
+
+	buckets, err := s.GetProvisionBuckets(ctx, provisionbucket.IDEQ(bucketID))
+	if err != nil {
+		return nil, fmt.Errorf("RebuildBucketQueue.GetProvisionBuckets: %w", err)
+	}
+
+	for _, bucket := range buckets {
+		s.CreatePriorityQueueForBucket(ctx, bucket)
+	}
+
+	return s.GetBucketQueue(ctx, bucketID)
+}
+
+// SetBucketQueueOverride pins or removes a provider in a bucket queue until the override expires,
+// and rebuilds the bucket queue so it takes effect right away
+func (s *PriorityQueueService) SetBucketQueueOverride(ctx context.Context, bucketID int, providerID string, action string, duration time.Duration) (*types.BucketQueueResponse, error) {
+	bucket, err := s.bucketWithProvider(ctx, bucketID, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("SetBucketQueueOverride: %w", err)
+	}
+
+	override, err := json.Marshal(types.BucketQueueOverride{
+		ProviderID: providerID,
+		Action:     action,
+		ExpiresAt:  time.Now().Add(duration),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("SetBucketQueueOverride.marshal: %w", err)
+	}
+
+	err = storage.RedisClient.HSet(ctx, bucketOverridesKey(bucket), providerID, override).Err()
+	if err != nil {
+		return nil, fmt.Errorf("SetBucketQueueOverride.save: %w", err)
+	}
+
+	return s.RebuildBucketQueue(ctx, bucketID)
+}
+
+// ClearBucketQueueOverride lifts a pin or removal of a provider in a bucket queue and rebuilds the bucket queue
+func (s *PriorityQueueService) ClearBucketQueueOverride(ctx context.Context, bucketID int, providerID string) (*types.BucketQueueResponse, error) {
+	bucket, err := s.bucketWithProvider(ctx, bucketID, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("ClearBucketQueueOverride: %w", err)
+	}
+
+	err = storage.RedisClient.HDel(ctx, bucketOverridesKey(bucket), providerID).Err()
+	if err != nil {
+		return nil, fmt.Errorf("ClearBucketQueueOverride.delete: %w", err)
+	}
+
+	return s.RebuildBucketQueue(ctx, bucketID)
+}
+
+// bucketWithProvider returns a bucket, checking that the provider is in it
+func (s *PriorityQueueService) bucketWithProvider(ctx context.Context, bucketID int, providerID string) (*ent.ProvisionBucket, error) {
+	bucket, err := storage.Client.ProvisionBucket.
+		Query().
+		Where(provisionbucket.IDEQ(bucketID)).
+		WithCurrency().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inBucket, err := bucket.QueryProviderProfiles().
+		Where(providerprofile.IDEQ(providerID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	} else if !inBucket {
+		return nil, ErrProviderNotInBucket
+	}
+
+	return bucket, nil
+}
+
+// bucketQueue returns the decoded queue of a bucket with its active overrides and last build
+func (s *PriorityQueueService) bucketQueue(ctx context.Context, bucket *ent.ProvisionBucket) (*types.BucketQueueResponse, error) {
+	data, err := storage.RedisClient.LRange(ctx, bucketQueueKey(bucket), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("bucketQueue.entries: %w", err)
+	}
+
+	overrides, err := s.bucketOverrides(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("bucketQueue.overrides: %w", err)
+	}
+
+	queue := &types.BucketQueueResponse{
+		BucketID:         bucket.ID,
+		Currency:         bucket.Edges.Currency.Code,
+		MinAmount:        bucket.MinAmount,
+		MaxAmount:        bucket.MaxAmount,
+		MatchingStrategy: string(bucket.MatchingStrategy),
+		Entries:          decodeQueueEntries(data),
+		Overrides:        []types.BucketQueueOverride{},
+	}
+
+	for i := range queue.Entries {
+		queue.Entries[i].Pinned = overrides[queue.Entries[i].ProviderID].Action == BucketQueueOverridePin
+	}
+
+	for _, override := range overrides {
+		queue.Overrides = append(queue.Overrides, override)
+	}
+	sort.Slice(queue.Overrides, func(i, j int) bool {
+		return queue.Overrides[i].ProviderID < queue.Overrides[j].ProviderID
+	})
+
+	build, err := storage.RedisClient.Get(ctx, bucketBuildKey(bucket)).Result()
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("bucketQueue.build: %w", err)
+	} else if err == nil {
+		queue.LastBuild = &types.BucketQueueBuild{}
+		if err := json.Unmarshal([]byte(build), queue.LastBuild); err != nil {
+			return nil, fmt.Errorf("bucketQueue.build: %w", err)
+		}
+	}
+
+	return queue, nil
+}
+
+// bucketOverrides returns the unexpired admin overrides of a bucket queue, keyed by provider ID.
+// Expired overrides are cleared.
+func (s *PriorityQueueService) bucketOverrides(ctx context.Context, bucket *ent.ProvisionBucket) (map[string]types.BucketQueueOverride, error) {
+	key := bucketOverridesKey(bucket)
+
+	values, err := storage.RedisClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	overrides := map[string]types.BucketQueueOverride{}
+	for providerID, value := range values {
+		var override types.BucketQueueOverride
+		if err := json.Unmarshal([]byte(value), &override); err != nil || !override.ExpiresAt.After(now) {
+			_ = storage.RedisClient.HDel(ctx, key, providerID).Err()
+			continue
+		}
+		overrides[providerID] = override
+	}
+
+	return overrides, nil
+}
+
+// providerExclusions returns why the providers of a bucket that aren't eligible for its queue were left out
+func (s *PriorityQueueService) providerExclusions(ctx context.Context, bucket *ent.ProvisionBucket) ([]types.BucketQueueExclusion, error) {
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.HasProvisionBucketsWith(provisionbucket.IDEQ(bucket.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eligible := map[string]bool{}
+	for _, provider := range bucket.Edges.ProviderProfiles {
+		eligible[provider.ID] = true
+	}
+
+	exclusions := []types.BucketQueueExclusion{}
+	for _, provider := range providers {
+		if eligible[provider.ID] {
+			continue
+		}
+
+		reasons := providerExclusionReasons(provider, time.Now())
+		if len(reasons) == 0 {
+			continue
+		}
+
+		exclusions = append(exclusions, types.BucketQueueExclusion{
+			ProviderID: provider.ID,
+			Reason:     strings.Join(reasons, "; "),
+		})
+	}
+
+	return exclusions, nil
+}
+
+// saveBucketBuild records when a bucket queue was built and who was left out of it
+func (s *PriorityQueueService) saveBucketBuild(ctx context.Context, bucket *ent.ProvisionBucket, exclusions []types.BucketQueueExclusion) error {
+	build, err := json.Marshal(types.BucketQueueBuild{
+		BuiltAt:    time.Now(),
+		Exclusions: exclusions,
+	})
+	if err != nil {
+		return err
+	}
+
+	return storage.RedisClient.Set(ctx, bucketBuildKey(bucket), build, 0).Err()
+}
+
+// providerExclusionReasons returns why a provider isn't eligible for bucket queues, matching the filters of GetProvisionBuckets
+func providerExclusionReasons(provider *ent.ProviderProfile, now time.Time) []string {
+	reasons := []string{}
+
+	if !provider.IsAvailable {
+		reasons = append(reasons, "unavailable")
+	}
+	if !provider.IsOpen {
+		reasons = append(reasons, "outside operating hours")
+	}
+	if !provider.IsActive {
+		reasons = append(reasons, "inactive")
+	}
+	if !provider.IsHealthy {
+		reasons = append(reasons, "node is unhealthy")
+	}
+	if provider.NodeProtocolVersion < orderConf.NodeMinProtocolVersion {
+		reasons = append(reasons, fmt.Sprintf(
+			"node protocol version %d is below the minimum of %d", provider.NodeProtocolVersion, orderConf.NodeMinProtocolVersion,
+		))
+	}
+	if provider.SuspendedUntil.After(now) {
+		reasons = append(reasons, fmt.Sprintf("suspended until %s", provider.SuspendedUntil.Format(time.RFC3339)))
+	}
+	if !provider.IsKybVerified {
+		reasons = append(reasons, "KYB is not verified")
+	}
+	if provider.VisibilityMode != providerprofile.VisibilityModePublic {
+		reasons = append(reasons, "visibility is private")
+	}
+
+	return reasons
+}
+
+// decodeQueueEntries decodes the entries of a bucket's circular queue (in the format
+// "providerID:token:rate:minAmount:maxAmount"), skipping malformed ones
+func decodeQueueEntries(data []string) []types.BucketQueueEntry {
+	entries := []types.BucketQueueEntry{}
+
+	for position, providerData := range data {
+		parts := strings.Split(providerData, ":")
+		if len(parts) != 5 {
+			continue
+		}
+
+		rate, err := decimal.NewFromString(parts[2])
+		if err != nil {
+			continue
+		}
+
+		minOrderAmount, err := decimal.NewFromString(parts[3])
+		if err != nil {
+			continue
+		}
+
+		maxOrderAmount, err := decimal.NewFromString(parts[4])
+		if err != nil {
+			continue
+		}
+
+		entries = append(entries, types.BucketQueueEntry{
+			Position:       position,
+			ProviderID:     parts[0],
+			Token:          parts[1],
+			Rate:           rate,
+			MinOrderAmount: minOrderAmount,
+			MaxOrderAmount: maxOrderAmount,
+		})
+	}
+
+	return entries
+}
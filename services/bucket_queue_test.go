@@ -0,0 +1,64 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketQueue(t *testing.T) {
+	t.Run("decodes queue entries", func(t *testing.T) {
+		entries := decodeQueueEntries([]string{
+			"provider-a:USDT:1500.5:1:500",
+			"malformed",
+			"provider-b:USDC:1499:0.5:1000",
+		})
+
+		assert.Len(t, entries, 2)
+		assert.Equal(t, 0, entries[0].Position)
+		assert.Equal(t, "provider-a", entries[0].ProviderID)
+		assert.Equal(t, "USDT", entries[0].Token)
+		assert.True(t, entries[0].Rate.Equal(decimal.NewFromFloat(1500.5)))
+		assert.True(t, entries[0].MaxOrderAmount.Equal(decimal.NewFromInt(500)))
+		assert.Equal(t, 2, entries[1].Position)
+		assert.True(t, entries[1].MinOrderAmount.Equal(decimal.NewFromFloat(0.5)))
+	})
+
+	t.Run("explains why providers are excluded", func(t *testing.T) {
+		now := time.Now()
+		eligible := &ent.ProviderProfile{
+			IsAvailable:         true,
+			IsOpen:              true,
+			IsActive:            true,
+			IsHealthy:           true,
+			IsKybVerified:       true,
+			NodeProtocolVersion: orderConf.NodeMinProtocolVersion,
+			VisibilityMode:      providerprofile.VisibilityModePublic,
+		}
+		assert.Empty(t, providerExclusionReasons(eligible, now))
+
+		excluded := *eligible
+		excluded.IsHealthy = false
+		excluded.SuspendedUntil = now.Add(time.Hour)
+		excluded.VisibilityMode = providerprofile.VisibilityModePrivate
+
+		reasons := providerExclusionReasons(&excluded, now)
+		assert.Len(t, reasons, 3)
+		assert.Equal(t, "node is unhealthy", reasons[0])
+		assert.Contains(t, reasons[1], "suspended until")
+		assert.Equal(t, "visibility is private", reasons[2])
+
+		excluded = *eligible
+		excluded.SuspendedUntil = now.Add(-time.Hour)
+		assert.Empty(t, providerExclusionReasons(&excluded, now))
+
+		// Providers outside their operating hours are excluded even when they are available
+		excluded = *eligible
+		excluded.IsOpen = false
+		assert.Equal(t, []string{"outside operating hours"}, providerExclusionReasons(&excluded, now))
+	})
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/ratecircuitbreaker"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// ErrCircuitBreakerNotTripped is returned when resetting a circuit breaker that isn't tripped
+var ErrCircuitBreakerNotTripped = errors.New("circuit breaker is not tripped")
+
+// CircuitBreakerService checks the market rates of currencies against their circuit breaker rules.
+// A tripped breaker freezes the market rate of its currency and pauses matching and quoting of its orders
+// until it is reset by an admin or after its auto reset delay.
+type CircuitBreakerService struct{}
+
+// NewCircuitBreakerService creates a new instance of CircuitBreakerService
+func NewCircuitBreakerService() *CircuitBreakerService {
+	return &CircuitBreakerService{}
+}
+
+// Get returns the circuit breaker of a currency
+func (s *CircuitBreakerService) Get(ctx context.Context, currencyCode string) (*ent.RateCircuitBreaker, error) {
+	breaker, err := storage.Client.RateCircuitBreaker.
+		Query().
+		Where(ratecircuitbreaker.HasCurrencyWith(fiatcurrency.CodeEQ(strings.ToUpper(currencyCode)))).
+		WithCurrency().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Get: %w", err)
+	}
+
+	return breaker, nil
+}
+
+// Configure sets the rules of the circuit breaker of a currency, creating it if it has none
+func (s *CircuitBreakerService) Configure(ctx context.Context, currency *ent.FiatCurrency, payload types.RateCircuitBreakerPayload) (*ent.RateCircuitBreaker, error) {
+	breaker, err := s.Get(ctx, currency.Code)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("Configure: %w", err)
+	}
+
+	if breaker == nil {
+		breaker, err = storage.Client.RateCircuitBreaker.
+			Create().
+			SetCurrency(currency).
+			SetMaxChangePercent(payload.MaxChangePercent).
+			SetChangeWindow(payload.ChangeWindow).
+			SetMaxDivergencePercent(payload.MaxDivergencePercent).
+			SetAutoResetAfter(payload.AutoResetAfter).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Configure.create: %w", err)
+		}
+
+		breaker.Edges.Currency = currency
+		return breaker, nil
+	}
+
+	breaker, err = breaker.Update().
+		SetMaxChangePercent(payload.MaxChangePercent).
+		SetChangeWindow(payload.ChangeWindow).
+		SetMaxDivergencePercent(payload.MaxDivergencePercent).
+		SetAutoResetAfter(payload.AutoResetAfter).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Configure.update: %w", err)
+	}
+
+	breaker.Edges.Currency = currency
+	return breaker, nil
+}
+
+// IsTripped checks whether the circuit breaker of a currency is tripped
+func (s *CircuitBreakerService) IsTripped(ctx context.Context, currencyCode string) (bool, error) {
+	tripped, err := storage.Client.RateCircuitBreaker.
+		Query().
+		Where(
+			ratecircuitbreaker.HasCurrencyWith(fiatcurrency.CodeEQ(strings.ToUpper(currencyCode))),
+			ratecircuitbreaker.TrippedEQ(true),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("IsTripped: %w", err)
+	}
+
+	return tripped, nil
+}
+
+// Check checks a new market rate of a currency, with the rates of the sources it was computed from,
+// against the rules of the currency's circuit breaker and trips it if one is broken.
+// It returns whether the breaker is tripped, in which case the new rate must not be used.
+func (s *CircuitBreakerService) Check(ctx context.Context, currency *ent.FiatCurrency, rate decimal.Decimal, sourceRates []decimal.Decimal) (bool, error) {
+	breaker, err := s.Get(ctx, currency.Code)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Check: %w", err)
+	}
+
+	if breaker.Tripped {
+		return true, nil
+	}
+
+	reason, err := s.brokenRule(ctx, breaker, currency, rate, sourceRates)
+	if err != nil {
+		return false, fmt.Errorf("Check: %w", err)
+	}
+
+	if reason == "" {
+		return false, nil
+	}
+
+	if err := s.trip(ctx, breaker, currency, rate, reason); err != nil {
+		return false, fmt.Errorf("Check: %w", err)
+	}
+
+	return true, nil
+}
+
+// Reset resets the tripped circuit breaker of a currency, so its market rate is computed and its orders are matched again
+func (s *CircuitBreakerService) Reset(ctx context.Context, currencyCode string) (*ent.RateCircuitBreaker, error) {
+	breaker, err := s.Get(ctx, currencyCode)
+	if err != nil {
+		return nil, fmt.Errorf("Reset: %w", err)
+	}
+
+	if !breaker.Tripped {
+		return nil, ErrCircuitBreakerNotTripped
+	}
+
+	currency := breaker.Edges.Currency
+	breaker, err = breaker.Update().
+		SetTripped(false).
+		ClearTripReason().
+		ClearTrippedAt().
+		ClearFrozenRate().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Reset: %w", err)
+	}
+	breaker.Edges.Currency = currency
+
+	// Orders held back while matching was paused are retried right away
+	if err := NewDeadLetterService().ExpeditePaused(ctx, currency.Code); err != nil {
+		logger.Errorf("failed to retry orders held back for %s: %v", currency.Code, err)
+	}
+
+	return breaker, nil
+}
+
+// ResetExpired resets the tripped circuit breakers whose auto reset delay has passed.
+// It returns the number of breakers reset.
+func (s *CircuitBreakerService) ResetExpired(ctx context.Context) (int, error) {
+	breakers, err := storage.Client.RateCircuitBreaker.
+		Query().
+		Where(
+			ratecircuitbreaker.TrippedEQ(true),
+			ratecircuitbreaker.AutoResetAfterGT(0),
+		).
+		WithCurrency().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("ResetExpired: %w", err)
+	}
+
+	reset := 0
+	for _, breaker := range breakers {
+		if time.Since(breaker.TrippedAt) < time.Duration(breaker.AutoResetAfter)*time.Minute {
+			continue
+		}
+
+		if _, err := s.Reset(ctx, breaker.Edges.Currency.Code); err != nil {
+			logger.Errorf("ResetExpired: %v", err)
+			continue
+		}
+		reset++
+	}
+
+	return reset, nil
+}
+
+// brokenRule returns why a new market rate breaks a rule of a circuit breaker, or an empty string if it breaks none
+func (s *CircuitBreakerService) brokenRule(ctx context.Context, breaker *ent.RateCircuitBreaker, currency *ent.FiatCurrency, rate decimal.Decimal, sourceRates []decimal.Decimal) (string, error) {
+	if breaker.MaxDivergencePercent.IsPositive() && len(sourceRates) > 1 {
+		low, high := sourceRates[0], sourceRates[0]
+		for _, sourceRate := range sourceRates[1:] {
+			low = decimal.Min(low, sourceRate)
+			high = decimal.Max(high, sourceRate)
+		}
+
+		divergence := utils.AbsPercentageDeviation(low, high)
+		if divergence.GreaterThan(breaker.MaxDivergencePercent) {
+			return fmt.Sprintf(
+				"the rates of the sources diverge by %s%%, more than %s%%",
+				divergence.StringFixed(2), breaker.MaxDivergencePercent,
+			), nil
+		}
+	}
+
+	if breaker.MaxChangePercent.IsPositive() {
+		// The rate is compared to the last rate, and to the rate at the start of the change window
+		references := []decimal.Decimal{currency.MarketRate}
+
+		windowStart := time.Now().Add(-time.Duration(breaker.ChangeWindow) * time.Minute)
+		previous, err := NewRateHistoryService().GetRateAt(ctx, types.RateHistoryFilter{
+			Currency: currency.Code,
+			Kind:     ratesnapshot.KindMarket,
+		}, windowStart)
+		if err != nil && !ent.IsNotFound(err) {
+			return "", err
+		}
+		if previous != nil {
+			references = append(references, previous.Rate)
+		}
+
+		for _, reference := range references {
+			if !reference.IsPositive() {
+				continue
+			}
+
+			change := utils.AbsPercentageDeviation(reference, rate)
+			if change.GreaterThan(breaker.MaxChangePercent) {
+				return fmt.Sprintf(
+					"the market rate moved from %s to %s, %s%% within %d minutes and more than %s%%",
+					reference, rate, change.StringFixed(2), breaker.ChangeWindow, breaker.MaxChangePercent,
+				), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// trip trips a circuit breaker, freezing the currency's last good market rate, and alerts admins
+func (s *CircuitBreakerService) trip(ctx context.Context, breaker *ent.RateCircuitBreaker, currency *ent.FiatCurrency, rate decimal.Decimal, reason string) error {
+	_, err := breaker.Update().
+		SetTripped(true).
+		SetTripReason(reason).
+		SetTrippedAt(time.Now()).
+		SetFrozenRate(currency.MarketRate).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("trip: %w", err)
+	}
+
+	reset := "It must be reset by an admin."
+	if breaker.AutoResetAfter > 0 {
+		reset = fmt.Sprintf("It resets itself in %d minutes unless an admin resets it sooner.", breaker.AutoResetAfter)
+	}
+
+	message := fmt.Sprintf(
+		"The market rate circuit breaker of %s tripped: %s. The new rate %s was rejected and the market rate is frozen at %s. "+
+			"Orders of %s are not matched or quoted until the breaker is reset. %s",
+		currency.Code, reason, rate, currency.MarketRate, currency.Code, reset,
+	)
+	if err := AlertAdmins(ctx, fmt.Sprintf("%s market rate circuit breaker tripped", currency.Code), message); err != nil {
+		logger.Errorf("failed to alert admins of tripped circuit breaker of %s: %v", currency.Code, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:circuitbreaker?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+	service := NewCircuitBreakerService()
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	// Currencies without a circuit breaker are never tripped
+	tripped, err := service.Check(ctx, currency, decimal.NewFromInt(2000), nil)
+	assert.NoError(t, err)
+	assert.False(t, tripped)
+
+	_, err = service.Configure(ctx, currency, types.RateCircuitBreakerPayload{
+		MaxChangePercent:     decimal.NewFromInt(5),
+		ChangeWindow:         30,
+		MaxDivergencePercent: decimal.NewFromInt(3),
+		AutoResetAfter:       10,
+	})
+	assert.NoError(t, err)
+
+	t.Run("passes rates within the rules", func(t *testing.T) {
+		tripped, err := service.Check(ctx, currency, decimal.NewFromInt(960), []decimal.Decimal{
+			decimal.NewFromInt(955), decimal.NewFromInt(965),
+		})
+		assert.NoError(t, err)
+		assert.False(t, tripped)
+
+		_, err = service.Reset(ctx, currency.Code)
+		assert.ErrorIs(t, err, ErrCircuitBreakerNotTripped)
+	})
+
+	t.Run("trips when the sources diverge", func(t *testing.T) {
+		tripped, err := service.Check(ctx, currency, decimal.NewFromInt(960), []decimal.Decimal{
+			decimal.NewFromInt(930), decimal.NewFromInt(990),
+		})
+		assert.NoError(t, err)
+		assert.True(t, tripped)
+
+		breaker, err := service.Get(ctx, currency.Code)
+		assert.NoError(t, err)
+		assert.True(t, breaker.Tripped)
+		assert.Contains(t, breaker.TripReason, "diverge")
+		if assert.NotNil(t, breaker.FrozenRate) {
+			assert.Equal(t, currency.MarketRate.String(), breaker.FrozenRate.String())
+		}
+
+		tripped, err = service.IsTripped(ctx, "ngn")
+		assert.NoError(t, err)
+		assert.True(t, tripped)
+
+		// Breakers aren't reset before their auto reset delay
+		reset, err := service.ResetExpired(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, reset)
+
+		breaker, err = service.Reset(ctx, currency.Code)
+		assert.NoError(t, err)
+		assert.False(t, breaker.Tripped)
+		assert.Empty(t, breaker.TripReason)
+		assert.Nil(t, breaker.FrozenRate)
+	})
+
+	t.Run("trips when the rate moves too far within the change window", func(t *testing.T) {
+		// The rate at the start of the window was recorded 40 minutes ago, the current rate is close to the new one
+		err := NewRateHistoryService().Record(ctx, time.Now().Add(-40*time.Minute), types.RateObservation{
+			CurrencyID: currency.ID,
+			Kind:       ratesnapshot.KindMarket,
+			Rate:       decimal.NewFromInt(900),
+		})
+		assert.NoError(t, err)
+
+		tripped, err := service.Check(ctx, currency, decimal.NewFromInt(980), nil)
+		assert.NoError(t, err)
+		assert.True(t, tripped)
+
+		breaker, err := service.Get(ctx, currency.Code)
+		assert.NoError(t, err)
+		assert.Contains(t, breaker.TripReason, "from 900 to 980")
+
+		// Breakers are reset once their auto reset delay passed
+		_, err = breaker.Update().SetTrippedAt(time.Now().Add(-11 * time.Minute)).Save(ctx)
+		assert.NoError(t, err)
+
+		reset, err := service.ResetExpired(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, reset)
+
+		tripped, err = service.IsTripped(ctx, currency.Code)
+		assert.NoError(t, err)
+		assert.False(t, tripped)
+	})
+}
@@ -0,0 +1,340 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// ErrDeadLetterOrderNotPending is returned when retrying an order that already left the dead-letter queue
+var ErrDeadLetterOrderNotPending = errors.New("dead-letter order is not pending")
+
+// deadLetterReasonDescriptions describes the reasons an order could not be matched, for admin alerts
+var deadLetterReasonDescriptions = map[deadletterorder.Reason]string{
+	deadletterorder.ReasonNoProviderForToken: "no provider quotes the order's token in its bucket",
+	deadletterorder.ReasonRateOutOfRange:     "no provider quotes a rate within tolerance of the order rate",
+	deadletterorder.ReasonAllExcluded:        "every provider quoting the order rate declined or missed the order",
+	deadletterorder.ReasonNoEligibleProvider: "no provider quoting the order rate takes its amount or institution",
+	deadletterorder.ReasonMatchingPaused:     "matching is paused by the market rate circuit breaker of the order's currency",
+}
+
+// DeadLetterService manages orders that could not be matched to a provider.
+// They are retried with exponential backoff until they are matched or reach their refund deadline.
+type DeadLetterService struct{}
+
+// NewDeadLetterService creates a new instance of DeadLetterService
+func NewDeadLetterService() *DeadLetterService {
+	return &DeadLetterService{}
+}
+
+// Record adds an order that could not be matched to the dead-letter queue, or schedules the next retry of an
+// order already in it. Admins are alerted when an order first enters the queue.
+func (s *DeadLetterService) Record(ctx context.Context, order types.LockPaymentOrderFields, reason deadletterorder.Reason) error {
+	now := time.Now()
+
+	entry, err := storage.Client.DeadLetterOrder.
+		Query().
+		Where(deadletterorder.HasLockPaymentOrderWith(lockpaymentorder.IDEQ(order.ID))).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("Record.fetch: %w", err)
+	}
+
+	if entry != nil {
+		if entry.Status == deadletterorder.StatusRefunded {
+			return nil
+		}
+
+		// The refund deadline still runs from when the order first entered the queue
+		_, err = entry.Update().
+			SetReason(reason).
+			SetStatus(deadletterorder.StatusPending).
+			ClearResolvedAt().
+			AddAttempts(1).
+			SetNextRetryAt(now.Add(deadLetterBackoff(entry.Attempts + 1))).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("Record.update: %w", err)
+		}
+
+		return nil
+	}
+
+	entry, err = storage.Client.DeadLetterOrder.
+		Create().
+		SetLockPaymentOrderID(order.ID).
+		SetReason(reason).
+		SetNextRetryAt(now.Add(deadLetterBackoff(1))).
+		SetRefundDeadline(now.Add(orderConf.DeadLetterRefundDeadline)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("Record.create: %w", err)
+	}
+
+	// Admins are alerted once when the circuit breaker trips rather than for every order it holds back
+	if reason == deadletterorder.ReasonMatchingPaused {
+		return nil
+	}
+
+	go func() {
+		message := fmt.Sprintf(
+			"Order %s of %s could not be matched to a provider: %s. "+
+				"It will be retried until %s, when it will be refunded if it is still unmatched.",
+			order.ID, order.Amount, deadLetterReasonDescriptions[reason], entry.RefundDeadline.Format(time.RFC1123),
+		)
+		if err := AlertAdmins(context.Background(), "Order could not be matched", message); err != nil {
+			logger.Errorf("failed to alert admins of unmatched order %s: %v", order.ID, err)
+		}
+	}()
+
+	return nil
+}
+
+// Resolve takes an order off the dead-letter queue once it was matched to a provider
+func (s *DeadLetterService) Resolve(ctx context.Context, orderID uuid.UUID) error {
+	_, err := storage.Client.DeadLetterOrder.
+		Update().
+		Where(
+			deadletterorder.HasLockPaymentOrderWith(lockpaymentorder.IDEQ(orderID)),
+			deadletterorder.StatusEQ(deadletterorder.StatusPending),
+		).
+		SetStatus(deadletterorder.StatusResolved).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("Resolve: %w", err)
+	}
+
+	return nil
+}
+
+// ExpeditePaused makes the orders of a currency held back while its matching was paused due for retry now
+func (s *DeadLetterService) ExpeditePaused(ctx context.Context, currencyCode string) error {
+	_, err := storage.Client.DeadLetterOrder.
+		Update().
+		Where(
+			deadletterorder.StatusEQ(deadletterorder.StatusPending),
+			deadletterorder.ReasonEQ(deadletterorder.ReasonMatchingPaused),
+			deadletterorder.HasLockPaymentOrderWith(
+				lockpaymentorder.HasProvisionBucketWith(
+					provisionbucket.HasCurrencyWith(fiatcurrency.CodeEQ(strings.ToUpper(currencyCode))),
+				),
+			),
+		).
+		SetNextRetryAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("ExpeditePaused: %w", err)
+	}
+
+	return nil
+}
+
+// DueForRetry returns the orders in the dead-letter queue whose next retry is due
+func (s *DeadLetterService) DueForRetry(ctx context.Context) ([]*ent.DeadLetterOrder, error) {
+	entries, err := s.query(
+		deadletterorder.StatusEQ(deadletterorder.StatusPending),
+		deadletterorder.NextRetryAtLTE(time.Now()),
+	).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("DueForRetry: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Retry tries to match an order in the dead-letter queue again.
+// Orders matched since they were last tried are resolved, and orders past their refund deadline are cancelled
+// so they get refunded.
+func (s *DeadLetterService) Retry(ctx context.Context, entry *ent.DeadLetterOrder) error {
+	order := entry.Edges.LockPaymentOrder
+
+	if order.Status != lockpaymentorder.StatusPending {
+		return s.Resolve(ctx, order.ID)
+	}
+
+	if time.Now().After(entry.RefundDeadline) {
+		return s.refund(ctx, entry)
+	}
+
+	// Put off the next retry in case the order is neither matched nor recorded as unmatched again
+	err := entry.Update().
+		SetNextRetryAt(time.Now().Add(deadLetterBackoff(entry.Attempts))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("Retry.schedule: %w", err)
+	}
+
+	orderFields := lockPaymentOrderFields(order)
+
+	// A failed match records the next retry of the order
+	if err := NewPriorityQueueService().AssignLockPaymentOrder(ctx, orderFields); err != nil {
+		return fmt.Errorf("Retry: %w", err)
+	}
+
+	return nil
+}
+
+// RetryNow retries an order in the dead-letter queue without waiting for its next retry
+func (s *DeadLetterService) RetryNow(ctx context.Context, id uuid.UUID) (*types.DeadLetterOrderResponse, error) {
+	entry, err := s.query(deadletterorder.IDEQ(id)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RetryNow.fetch: %w", err)
+	}
+
+	if entry.Status != deadletterorder.StatusPending {
+		return nil, ErrDeadLetterOrderNotPending
+	}
+
+	if err := s.Retry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("RetryNow: %w", err)
+	}
+
+	entry, err = s.query(deadletterorder.IDEQ(id)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("RetryNow.fetch: %w", err)
+	}
+
+	return deadLetterOrderResponse(entry), nil
+}
+
+// GetDeadLetterOrders returns the orders in the dead-letter queue, optionally filtered by status, most recent first
+func (s *DeadLetterService) GetDeadLetterOrders(ctx context.Context, status string) ([]*types.DeadLetterOrderResponse, error) {
+	predicates := []predicate.DeadLetterOrder{}
+	if status != "" {
+		predicates = append(predicates, deadletterorder.StatusEQ(deadletterorder.Status(status)))
+	}
+
+	entries, err := s.query(predicates...).
+		Order(ent.Desc(deadletterorder.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetDeadLetterOrders: %w", err)
+	}
+
+	response := make([]*types.DeadLetterOrderResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, deadLetterOrderResponse(entry))
+	}
+
+	return response, nil
+}
+
+// refund cancels an order that is still unmatched past its refund deadline.
+// Cancelled orders are refunded to the sender by the stale user operations task.
+func (s *DeadLetterService) refund(ctx context.Context, entry *ent.DeadLetterOrder) error {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("refund.tx: %w", err)
+	}
+
+	_, err = tx.LockPaymentOrder.
+		UpdateOneID(entry.Edges.LockPaymentOrder.ID).
+		Where(lockpaymentorder.StatusEQ(lockpaymentorder.StatusPending)).
+		SetStatus(lockpaymentorder.StatusCancelled).
+		SetCancellationCount(orderConf.RefundCancellationCount).
+		AppendCancellationReasons([]string{"No provider available to fulfill the order"}).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsNotFound(err) {
+			// The order left the pending status since it was fetched
+			return s.Resolve(ctx, entry.Edges.LockPaymentOrder.ID)
+		}
+		return fmt.Errorf("refund.order: %w", err)
+	}
+
+	_, err = tx.DeadLetterOrder.
+		UpdateOne(entry).
+		SetStatus(deadletterorder.StatusRefunded).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("refund.entry: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("refund.commit: %w", err)
+	}
+
+	message := fmt.Sprintf(
+		"Order %s could not be matched to a provider after %d attempts and was cancelled for a refund.",
+		entry.Edges.LockPaymentOrder.ID, entry.Attempts,
+	)
+	if err := AlertAdmins(ctx, "Unmatched order cancelled for a refund", message); err != nil {
+		logger.Errorf("failed to alert admins of refunded order %s: %v", entry.Edges.LockPaymentOrder.ID, err)
+	}
+
+	return nil
+}
+
+// query returns a query of dead-letter entries with their orders loaded for a retry
+func (s *DeadLetterService) query(predicates ...predicate.DeadLetterOrder) *ent.DeadLetterOrderQuery {
+	return storage.Client.DeadLetterOrder.
+		Query().
+		Where(predicates...).
+		WithLockPaymentOrder(func(lpoq *ent.LockPaymentOrderQuery) {
+			lpoq.WithToken()
+			lpoq.WithProvider()
+			lpoq.WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+				pbq.WithCurrency()
+			})
+		})
+}
+
+// deadLetterBackoff returns how long to wait before retrying an order after a number of failed attempts to match it.
+// The wait doubles with every attempt, up to the configured maximum.
+func deadLetterBackoff(attempts int) time.Duration {
+	backoff := orderConf.DeadLetterRetryInterval
+	for i := 1; i < attempts && backoff < orderConf.DeadLetterMaxRetryInterval; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, orderConf.DeadLetterMaxRetryInterval)
+}
+
+// deadLetterOrderResponse converts a dead-letter entry to its API response
+func deadLetterOrderResponse(entry *ent.DeadLetterOrder) *types.DeadLetterOrderResponse {
+	response := &types.DeadLetterOrderResponse{
+		ID:             entry.ID,
+		Reason:         string(entry.Reason),
+		Status:         string(entry.Status),
+		Attempts:       entry.Attempts,
+		NextRetryAt:    entry.NextRetryAt,
+		RefundDeadline: entry.RefundDeadline,
+		CreatedAt:      entry.CreatedAt,
+	}
+
+	if !entry.ResolvedAt.IsZero() {
+		response.ResolvedAt = &entry.ResolvedAt
+	}
+
+	if order := entry.Edges.LockPaymentOrder; order != nil {
+		response.OrderID = order.ID
+		response.Amount = order.Amount
+		response.Rate = order.Rate
+		response.Institution = order.Institution
+		if order.Edges.Token != nil {
+			response.Token = order.Edges.Token.Symbol
+		}
+		if order.Edges.ProvisionBucket != nil && order.Edges.ProvisionBucket.Edges.Currency != nil {
+			response.Currency = order.Edges.ProvisionBucket.Edges.Currency.Code
+		}
+	}
+
+	return response
+}
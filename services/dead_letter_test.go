@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLetter(t *testing.T) {
+	t.Run("backs off exponentially up to the maximum interval", func(t *testing.T) {
+		base := orderConf.DeadLetterRetryInterval
+
+		assert.Equal(t, base, deadLetterBackoff(1))
+		assert.Equal(t, 2*base, deadLetterBackoff(2))
+		assert.Equal(t, 4*base, deadLetterBackoff(3))
+		assert.Equal(t, orderConf.DeadLetterMaxRetryInterval, deadLetterBackoff(50))
+	})
+
+	t.Run("records, resolves and refunds unmatched orders", func(t *testing.T) {
+		ctx := context.Background()
+		client := enttest.Open(t, "sqlite3", "file:deadletter?mode=memory&_fk=1")
+		defer client.Close()
+
+		db.Client = client
+		service := NewDeadLetterService()
+
+		network, err := client.Network.
+			Create().
+			SetChainID(1).
+			SetIdentifier("test").
+			SetRPCEndpoint("").
+			SetIsTestnet(true).
+			SetFee(decimal.Zero).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		token, err := client.Token.
+			Create().
+			SetSymbol("USDT").
+			SetContractAddress("0x").
+			SetDecimals(6).
+			SetNetwork(network).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		createOrder := func(gatewayID string) *ent.LockPaymentOrder {
+			order, err := client.LockPaymentOrder.
+				Create().
+				SetGatewayID(gatewayID).
+				SetAmount(decimal.NewFromInt(100)).
+				SetRate(decimal.NewFromInt(1500)).
+				SetOrderPercent(decimal.NewFromInt(100)).
+				SetBlockNumber(0).
+				SetInstitution("GTBINGLA").
+				SetAccountIdentifier("").
+				SetAccountName("").
+				SetToken(token).
+				Save(ctx)
+			assert.NoError(t, err)
+			return order
+		}
+
+		order := createOrder("0x1")
+		orderFields := types.LockPaymentOrderFields{ID: order.ID, Amount: order.Amount}
+
+		// An order enters the queue the first time it can't be matched
+		assert.NoError(t, service.Record(ctx, orderFields, deadletterorder.ReasonRateOutOfRange))
+		entry := client.DeadLetterOrder.Query().OnlyX(ctx)
+		assert.Equal(t, deadletterorder.StatusPending, entry.Status)
+		assert.Equal(t, 1, entry.Attempts)
+		assert.WithinDuration(t, time.Now().Add(orderConf.DeadLetterRefundDeadline), entry.RefundDeadline, time.Minute)
+
+		// Failed retries back off and keep the refund deadline
+		assert.NoError(t, service.Record(ctx, orderFields, deadletterorder.ReasonAllExcluded))
+		retried := client.DeadLetterOrder.GetX(ctx, entry.ID)
+		assert.Equal(t, 2, retried.Attempts)
+		assert.Equal(t, deadletterorder.ReasonAllExcluded, retried.Reason)
+		assert.True(t, retried.NextRetryAt.After(entry.NextRetryAt))
+		assert.True(t, retried.RefundDeadline.Equal(entry.RefundDeadline))
+
+		// A matched order leaves the queue
+		assert.NoError(t, service.Resolve(ctx, order.ID))
+		resolved := client.DeadLetterOrder.GetX(ctx, entry.ID)
+		assert.Equal(t, deadletterorder.StatusResolved, resolved.Status)
+		assert.False(t, resolved.ResolvedAt.IsZero())
+
+		// An order still unmatched past its refund deadline is cancelled for a refund
+		expired := createOrder("0x2")
+		expiredEntry, err := client.DeadLetterOrder.
+			Create().
+			SetLockPaymentOrder(expired).
+			SetReason(deadletterorder.ReasonNoProviderForToken).
+			SetNextRetryAt(time.Now().Add(-time.Minute)).
+			SetRefundDeadline(time.Now().Add(-time.Second)).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		due, err := service.DueForRetry(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, due, 1)
+		assert.Equal(t, expiredEntry.ID, due[0].ID)
+
+		assert.NoError(t, service.Retry(ctx, due[0]))
+		assert.Equal(t, deadletterorder.StatusRefunded, client.DeadLetterOrder.GetX(ctx, expiredEntry.ID).Status)
+
+		expired = client.LockPaymentOrder.GetX(ctx, expired.ID)
+		assert.Equal(t, lockpaymentorder.StatusCancelled, expired.Status)
+		assert.Equal(t, orderConf.RefundCancellationCount, expired.CancellationCount)
+
+		// Refunded orders don't re-enter the queue
+		assert.NoError(t, service.Record(ctx, types.LockPaymentOrderFields{ID: expired.ID}, deadletterorder.ReasonAllExcluded))
+		assert.Equal(t, deadletterorder.StatusRefunded, client.DeadLetterOrder.GetX(ctx, expiredEntry.ID).Status)
+
+		orders, err := service.GetDeadLetterOrders(ctx, string(deadletterorder.StatusRefunded))
+		assert.NoError(t, err)
+		assert.Len(t, orders, 1)
+		assert.Equal(t, expired.ID, orders[0].OrderID)
+		assert.Equal(t, "USDT", orders[0].Token)
+		assert.NotNil(t, orders[0].ResolvedAt)
+	})
+}
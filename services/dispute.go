@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
+)
+
+// ErrDisputeNotActive is returned when resolving or withdrawing a dispute that is no longer awaiting a resolution
+var ErrDisputeNotActive = errors.New("dispute is not active")
+
+// disputeTransitions are the statuses a dispute can move to from each status
+var disputeTransitions = map[dispute.Status][]dispute.Status{
+	dispute.StatusOpen:        {dispute.StatusUnderReview, dispute.StatusResolved, dispute.StatusWithdrawn},
+	dispute.StatusUnderReview: {dispute.StatusResolved, dispute.StatusWithdrawn},
+}
+
+// CanTransitionDispute checks whether a dispute can move from one status to another
+func CanTransitionDispute(from, to dispute.Status) bool {
+	for _, status := range disputeTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDisputeActive checks whether a dispute is still awaiting a resolution
+func IsDisputeActive(d *ent.Dispute) bool {
+	return d.Status == dispute.StatusOpen || d.Status == dispute.StatusUnderReview
+}
+
+// DisputeService provides functionality related to disputes raised on fulfilled orders
+type DisputeService struct{}
+
+// NewDisputeService creates a new instance of DisputeService
+func NewDisputeService() *DisputeService {
+	return &DisputeService{}
+}
+
+// OpenDispute opens a dispute on a payment order along with the sender's initial evidence.
+// The provider that fulfilled the disputed lock order is given until the response deadline to submit its own evidence.
+func (s *DisputeService) OpenDispute(ctx context.Context, paymentOrder *ent.PaymentOrder, lockOrder *ent.LockPaymentOrder, payload types.NewDisputePayload) (*ent.Dispute, error) {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("OpenDispute.tx: %w", err)
+	}
+
+	now := time.Now()
+	disputeCreate := tx.Dispute.
+		Create().
+		SetPaymentOrder(paymentOrder).
+		SetReason(payload.Reason).
+		SetResponseDeadline(now.Add(orderConf.DisputeResponseWindow)).
+		SetResolutionDeadline(now.Add(orderConf.DisputeResolutionWindow)).
+		SetTrustScoreAdjustment(decimal.Zero).
+		SetCompensationAmount(decimal.Zero)
+
+	if lockOrder != nil {
+		disputeCreate.SetLockPaymentOrder(lockOrder)
+		if lockOrder.Edges.Provider != nil {
+			disputeCreate.SetProvider(lockOrder.Edges.Provider)
+		}
+	}
+
+	d, err := disputeCreate.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("OpenDispute.create: %w", err)
+	}
+
+	for _, evidence := range payload.Evidence {
+		if err := createDisputeEvidence(ctx, tx, d, disputeevidence.SubmittedBySender, evidence); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("OpenDispute.evidence: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("OpenDispute.commit: %w", err)
+	}
+
+	return d, nil
+}
+
+// AddEvidence attaches evidence to a dispute.
+// Evidence from the provider on an open dispute puts the dispute under review.
+func (s *DisputeService) AddEvidence(ctx context.Context, d *ent.Dispute, submittedBy disputeevidence.SubmittedBy, payload types.DisputeEvidencePayload) error {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("AddEvidence.tx: %w", err)
+	}
+
+	if err := createDisputeEvidence(ctx, tx, d, submittedBy, payload); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("AddEvidence.create: %w", err)
+	}
+
+	if submittedBy == disputeevidence.SubmittedByProvider && d.Status == dispute.StatusOpen {
+		_, err = tx.Dispute.
+			UpdateOneID(d.ID).
+			SetStatus(dispute.StatusUnderReview).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("AddEvidence.status: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("AddEvidence.commit: %w", err)
+	}
+
+	return nil
+}
+
+// WithdrawDispute closes a dispute at the sender's request.
+// It returns ErrDisputeNotActive if the dispute was resolved or withdrawn in the meantime.
+func (s *DisputeService) WithdrawDispute(ctx context.Context, d *ent.Dispute) error {
+	// Only an active dispute is withdrawn, so a concurrent resolution isn't overwritten
+	updated, err := storage.Client.Dispute.
+		Update().
+		Where(
+			dispute.IDEQ(d.ID),
+			dispute.StatusIn(dispute.StatusOpen, dispute.StatusUnderReview),
+		).
+		SetStatus(dispute.StatusWithdrawn).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("WithdrawDispute: %w", err)
+	}
+
+	if updated == 0 {
+		return ErrDisputeNotActive
+	}
+
+	return nil
+}
+
+// ResolveDispute records an admin's resolution of a dispute.
+// A non-zero trust score adjustment is applied to the provider's rating, and a compensation amount
+// marks the compensation as pending payout. It returns ErrDisputeNotActive if the dispute was resolved or
+// withdrawn in the meantime.
+func (s *DisputeService) ResolveDispute(ctx context.Context, d *ent.Dispute, payload types.ResolveDisputePayload) error {
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("ResolveDispute.tx: %w", err)
+	}
+
+	// Only an active dispute is resolved, so concurrent resolutions adjust the trust score once
+	disputeUpdate := tx.Dispute.
+		Update().
+		Where(
+			dispute.IDEQ(d.ID),
+			dispute.StatusIn(dispute.StatusOpen, dispute.StatusUnderReview),
+		).
+		SetStatus(dispute.StatusResolved).
+		SetResolution(payload.Resolution).
+		SetResolutionNote(payload.Note).
+		SetTrustScoreAdjustment(payload.TrustScoreAdjustment).
+		SetCompensationAmount(payload.CompensationAmount).
+		SetResolvedAt(time.Now())
+
+	if payload.CompensationAmount.GreaterThan(decimal.Zero) {
+		disputeUpdate.SetCompensationStatus(dispute.CompensationStatusPending)
+	}
+
+	updated, err := disputeUpdate.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("ResolveDispute.update: %w", err)
+	}
+
+	if updated == 0 {
+		_ = tx.Rollback()
+		return ErrDisputeNotActive
+	}
+
+	if !payload.TrustScoreAdjustment.IsZero() && d.Edges.Provider != nil {
+		if err := adjustTrustScore(ctx, tx, d.Edges.Provider.ID, payload.TrustScoreAdjustment); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("ResolveDispute.trustScore: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("ResolveDispute.commit: %w", err)
+	}
+
+	return nil
+}
+
+// MarkCompensationPaid records that the compensation of a resolved dispute was paid out
+func (s *DisputeService) MarkCompensationPaid(ctx context.Context, d *ent.Dispute) error {
+	_, err := d.Update().
+		SetCompensationStatus(dispute.CompensationStatusPaid).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("MarkCompensationPaid: %w", err)
+	}
+
+	return nil
+}
+
+// EscalateOverdueDisputes puts open disputes under review once the provider's response deadline passes.
+// It returns the number of disputes escalated.
+func (s *DisputeService) EscalateOverdueDisputes(ctx context.Context) (int, error) {
+	count, err := storage.Client.Dispute.
+		Update().
+		Where(
+			dispute.StatusEQ(dispute.StatusOpen),
+			dispute.ResponseDeadlineLTE(time.Now()),
+		).
+		SetStatus(dispute.StatusUnderReview).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("EscalateOverdueDisputes: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetDispute fetches a single dispute matching the predicates, with its evidence
+func (s *DisputeService) GetDispute(ctx context.Context, predicates ...predicate.Dispute) (*ent.Dispute, error) {
+	d, err := disputeQuery(predicates...).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// GetDisputes fetches a page of disputes matching the predicates
+func (s *DisputeService) GetDisputes(ctx context.Context, page, offset, pageSize int, predicates ...predicate.Dispute) (*types.DisputeList, error) {
+	query := disputeQuery(predicates...)
+
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetDisputes.count: %w", err)
+	}
+
+	disputes, err := query.
+		Order(ent.Desc(dispute.FieldCreatedAt)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetDisputes.fetch: %w", err)
+	}
+
+	response := &types.DisputeList{
+		TotalRecords: count,
+		Page:         page,
+		PageSize:     pageSize,
+		Disputes:     make([]types.DisputeResponse, 0, len(disputes)),
+	}
+	for _, d := range disputes {
+		response.Disputes = append(response.Disputes, DisputeResponse(d))
+	}
+
+	return response, nil
+}
+
+// DisputeResponse builds the API response of a dispute fetched with its edges
+func DisputeResponse(d *ent.Dispute) types.DisputeResponse {
+	response := types.DisputeResponse{
+		ID:                   d.ID,
+		Reason:               d.Reason,
+		Status:               d.Status,
+		ResponseDeadline:     d.ResponseDeadline,
+		ResolutionDeadline:   d.ResolutionDeadline,
+		Resolution:           d.Resolution,
+		ResolutionNote:       d.ResolutionNote,
+		TrustScoreAdjustment: d.TrustScoreAdjustment,
+		CompensationAmount:   d.CompensationAmount,
+		CompensationStatus:   d.CompensationStatus,
+		Evidence:             make([]types.DisputeEvidenceResponse, 0, len(d.Edges.Evidence)),
+		CreatedAt:            d.CreatedAt,
+		UpdatedAt:            d.UpdatedAt,
+	}
+
+	if d.Edges.PaymentOrder != nil {
+		response.PaymentOrderID = d.Edges.PaymentOrder.ID
+	}
+	if d.Edges.LockPaymentOrder != nil {
+		response.LockOrderID = &d.Edges.LockPaymentOrder.ID
+	}
+	if d.Edges.Provider != nil {
+		response.ProviderID = d.Edges.Provider.ID
+	}
+	if !d.ResolvedAt.IsZero() {
+		response.ResolvedAt = &d.ResolvedAt
+	}
+
+	for _, evidence := range d.Edges.Evidence {
+		response.Evidence = append(response.Evidence, types.DisputeEvidenceResponse{
+			ID:          evidence.ID,
+			SubmittedBy: evidence.SubmittedBy,
+			Type:        evidence.Type,
+			Reference:   evidence.Reference,
+			URL:         evidence.URL,
+			Note:        evidence.Note,
+			CreatedAt:   evidence.CreatedAt,
+		})
+	}
+
+	return response
+}
+
+// disputeQuery builds a dispute query with the edges needed for the API response
+func disputeQuery(predicates ...predicate.Dispute) *ent.DisputeQuery {
+	return storage.Client.Dispute.
+		Query().
+		Where(predicates...).
+		WithPaymentOrder(func(poq *ent.PaymentOrderQuery) {
+			poq.Select(paymentorder.FieldID)
+		}).
+		WithLockPaymentOrder(func(lpoq *ent.LockPaymentOrderQuery) {
+			lpoq.Select(lockpaymentorder.FieldID)
+		}).
+		WithProvider(func(ppq *ent.ProviderProfileQuery) {
+			ppq.Select(providerprofile.FieldID)
+		}).
+		WithEvidence(func(deq *ent.DisputeEvidenceQuery) {
+			deq.Order(ent.Asc(disputeevidence.FieldCreatedAt))
+		})
+}
+
+// createDisputeEvidence creates a piece of evidence on a dispute within a transaction
+func createDisputeEvidence(ctx context.Context, tx *ent.Tx, d *ent.Dispute, submittedBy disputeevidence.SubmittedBy, payload types.DisputeEvidencePayload) error {
+	return tx.DisputeEvidence.
+		Create().
+		SetDispute(d).
+		SetSubmittedBy(submittedBy).
+		SetType(payload.Type).
+		SetReference(payload.Reference).
+		SetURL(payload.URL).
+		SetNote(payload.Note).
+		Exec(ctx)
+}
+
+// adjustTrustScore adds an adjustment to a provider's trust score, creating the rating if the provider has none
+func adjustTrustScore(ctx context.Context, tx *ent.Tx, providerID string, adjustment decimal.Decimal) error {
+	rating, err := tx.ProviderRating.
+		Query().
+		Where(providerrating.HasProviderProfileWith(providerprofile.IDEQ(providerID))).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return err
+		}
+
+		return tx.ProviderRating.
+			Create().
+			SetProviderProfileID(providerID).
+			SetTrustScore(adjustment).
+			Exec(ctx)
+	}
+
+	_, err = rating.Update().
+		SetTrustScore(rating.TrustScore.Add(adjustment)).
+		Save(ctx)
+	return err
+}
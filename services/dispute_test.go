@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/enttest"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisputeService(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:disputeservice?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider"})
+	assert.NoError(t, err)
+
+	provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"currency_id": currency.ID,
+	})
+	assert.NoError(t, err)
+
+	lockOrder, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+		"gateway_id": uuid.New().String(),
+		"status":     "validated",
+		"provider":   provider,
+	})
+	assert.NoError(t, err)
+	lockOrder.Edges.Provider = provider
+
+	paymentOrder, err := client.PaymentOrder.
+		Create().
+		SetAmount(lockOrder.Amount).
+		SetAmountPaid(decimal.Zero).
+		SetAmountReturned(decimal.Zero).
+		SetPercentSettled(decimal.Zero).
+		SetSenderFee(decimal.Zero).
+		SetNetworkFee(decimal.Zero).
+		SetProtocolFee(decimal.Zero).
+		SetRate(lockOrder.Rate).
+		SetFeePercent(decimal.Zero).
+		SetReceiveAddressText("0x1234567890123456789012345678901234567890").
+		SetGatewayID(lockOrder.GatewayID).
+		SetTokenID(lockOrder.QueryToken().OnlyIDX(ctx)).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	service := NewDisputeService()
+	payload := types.NewDisputePayload{Reason: "Recipient did not receive the funds"}
+
+	t.Run("WithdrawDispute withdraws an active dispute", func(t *testing.T) {
+		d, err := service.OpenDispute(ctx, paymentOrder, lockOrder, payload)
+		assert.NoError(t, err)
+
+		assert.NoError(t, service.WithdrawDispute(ctx, d))
+
+		d, err = client.Dispute.Get(ctx, d.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, dispute.StatusWithdrawn, d.Status)
+
+		assert.ErrorIs(t, service.WithdrawDispute(ctx, d), ErrDisputeNotActive)
+	})
+
+	t.Run("WithdrawDispute doesn't overwrite a concurrent resolution", func(t *testing.T) {
+		d, err := service.OpenDispute(ctx, paymentOrder, lockOrder, payload)
+		assert.NoError(t, err)
+		d.Edges.Provider = provider
+
+		err = service.ResolveDispute(ctx, d, types.ResolveDisputePayload{
+			Resolution:           dispute.ResolutionSenderFavored,
+			Note:                 "Provider could not show the transfer",
+			TrustScoreAdjustment: decimal.NewFromInt(-5),
+			CompensationAmount:   decimal.NewFromInt(10),
+		})
+		assert.NoError(t, err)
+
+		// The stale copy of the dispute is still open
+		assert.ErrorIs(t, service.WithdrawDispute(ctx, d), ErrDisputeNotActive)
+
+		d, err = client.Dispute.Get(ctx, d.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, dispute.StatusResolved, d.Status)
+		assert.Equal(t, dispute.CompensationStatusPending, d.CompensationStatus)
+		assert.True(t, d.TrustScoreAdjustment.Equal(decimal.NewFromInt(-5)))
+	})
+}
@@ -3,12 +3,14 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	mailgunv3 "github.com/mailgun/mailgun-go/v3"
 	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/sendgrid/sendgrid-go"
 	"github.com/sendgrid/sendgrid-go/helpers/mail"
+	"github.com/shopspring/decimal"
 
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/types"
@@ -90,6 +92,64 @@ func (m *EmailService) SendPasswordResetEmail(ctx context.Context, token, email,
 	return SendTemplateEmail(payload, "d-8b689801cd9947748775ccd1c4cc932e")
 }
 
+// SendStaleRateEmail performs the actions for alerting a provider that their token rate has been excluded from the order queues.
+func (m *EmailService) SendStaleRateEmail(ctx context.Context, email, firstName, token string, rate, marketRate, deviation decimal.Decimal) (types.SendEmailResponse, error) {
+	body := fmt.Sprintf(
+		"Hi %s,\n\nYour %s rate of %s deviates %s%% from the market rate of %s, so you are no longer receiving %s orders. "+
+			"Update your rate to bring it within range of the market rate and start receiving orders again.\n\nPaycrest",
+		firstName, token, rate, deviation.RoundBank(2), marketRate, token,
+	)
+
+	payload := types.SendEmailPayload{
+		FromAddress: _DefaultFromAddress,
+		ToAddress:   email,
+		Subject:     fmt.Sprintf("Your %s rate is stale", token),
+		Body:        body,
+		HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+	}
+	return m.SendEmail(ctx, payload)
+}
+
+// SendTeamInvitationEmail performs the actions for sending a team invitation token to the invited email.
+func (m *EmailService) SendTeamInvitationEmail(ctx context.Context, token, email, inviterName, organisation, role string) (types.SendEmailResponse, error) {
+	body := fmt.Sprintf(
+		"Hi,\n\n%s has invited you to join %s on Paycrest as %s %s. "+
+			"Use the invitation code below to accept the invitation:\n\n%s\n\nPaycrest",
+		inviterName, organisation, article(role), role, token,
+	)
+
+	payload := types.SendEmailPayload{
+		FromAddress: _DefaultFromAddress,
+		ToAddress:   email,
+		Subject:     fmt.Sprintf("You have been invited to join %s", organisation),
+		Body:        body,
+		HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+	}
+	return m.SendEmail(ctx, payload)
+}
+
+// SendAdminAlertEmail performs the actions for alerting an admin of something that needs their attention.
+func (m *EmailService) SendAdminAlertEmail(ctx context.Context, email, firstName, subject, message string) (types.SendEmailResponse, error) {
+	body := fmt.Sprintf("Hi %s,\n\n%s\n\nPaycrest", firstName, message)
+
+	payload := types.SendEmailPayload{
+		FromAddress: _DefaultFromAddress,
+		ToAddress:   email,
+		Subject:     subject,
+		Body:        body,
+		HTMLBody:    strings.ReplaceAll(body, "\n", "<br>"),
+	}
+	return m.SendEmail(ctx, payload)
+}
+
+// article returns the indefinite article for a word
+func article(word string) string {
+	if word != "" && strings.ContainsRune("aeiou", rune(word[0])) {
+		return "an"
+	}
+	return "a"
+}
+
 // sendEmailViaMailgun performs the actions for sending an email.
 func sendEmailViaMailgun(ctx context.Context, content types.SendEmailPayload) (types.SendEmailResponse, error) {
 	// initialize
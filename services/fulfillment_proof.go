@@ -0,0 +1,139 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/config"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+)
+
+var blobConf = config.BlobStorageConfig()
+
+// ErrReceiptTooLarge is returned when a fulfillment receipt exceeds the configured maximum size
+var ErrReceiptTooLarge = errors.New("receipt exceeds the maximum size")
+
+// ErrReceiptTypeNotSupported is returned when the content of a fulfillment receipt isn't a PDF, PNG or JPEG file
+var ErrReceiptTypeNotSupported = errors.New("receipt type is not supported")
+
+// receiptExtensions maps the accepted receipt content types to file extensions
+var receiptExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/png":       ".png",
+	"image/jpeg":      ".jpg",
+}
+
+// FulfillmentProofService provides functionality related to the proof providers attach to order fulfillments
+type FulfillmentProofService struct{}
+
+// NewFulfillmentProofService creates a new instance of FulfillmentProofService
+func NewFulfillmentProofService() *FulfillmentProofService {
+	return &FulfillmentProofService{}
+}
+
+// SaveReceipt stores a fulfillment receipt in the blob store and returns its key and content type.
+// The content type is detected from the receipt's content rather than taken from the payload.
+func (s *FulfillmentProofService) SaveReceipt(ctx context.Context, orderID uuid.UUID, receipt *types.FulfillmentReceiptPayload) (string, string, error) {
+	data, err := base64.StdEncoding.DecodeString(receipt.Data)
+	if err != nil {
+		return "", "", fmt.Errorf("SaveReceipt.decode: %w", err)
+	}
+
+	if int64(len(data)) > blobConf.MaxReceiptSize {
+		return "", "", ErrReceiptTooLarge
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	extension, ok := receiptExtensions[contentType]
+	if !ok {
+		return "", "", ErrReceiptTypeNotSupported
+	}
+
+	key := fmt.Sprintf("receipts/%s/%s%s", orderID, uuid.New(), extension)
+	if err := storage.Blobs.Put(ctx, key, bytes.NewReader(data)); err != nil {
+		return "", "", fmt.Errorf("SaveReceipt.put: %w", err)
+	}
+
+	return key, contentType, nil
+}
+
+// DeleteReceipt removes a fulfillment receipt from the blob store
+func (s *FulfillmentProofService) DeleteReceipt(ctx context.Context, key string) error {
+	if err := storage.Blobs.Delete(ctx, key); err != nil {
+		return fmt.Errorf("DeleteReceipt: %w", err)
+	}
+
+	return nil
+}
+
+// OpenReceipt opens the receipt attached to a fulfillment
+func (s *FulfillmentProofService) OpenReceipt(ctx context.Context, fulfillment *ent.LockOrderFulfillment) (io.ReadCloser, error) {
+	if fulfillment.ReceiptKey == "" {
+		return nil, storage.ErrBlobNotFound
+	}
+
+	receipt, err := storage.Blobs.Get(ctx, fulfillment.ReceiptKey)
+	if err != nil {
+		return nil, fmt.Errorf("OpenReceipt: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// ApplyProof sets the proof in a fulfill order payload, and the key and content type of its stored receipt,
+// on a fulfillment update, leaving fields that weren't sent unchanged
+func (s *FulfillmentProofService) ApplyProof(update *ent.LockOrderFulfillmentUpdateOne, payload types.FulfillLockOrderPayload, receiptKey, receiptContentType string) *ent.LockOrderFulfillmentUpdateOne {
+	if payload.PSPSessionID != "" {
+		update.SetPspSessionID(payload.PSPSessionID)
+	}
+	if payload.BankReference != "" {
+		update.SetBankReference(payload.BankReference)
+	}
+	if payload.TransferredAt != nil {
+		update.SetTransferredAt(*payload.TransferredAt)
+	}
+	if receiptKey != "" {
+		update.SetReceiptKey(receiptKey).
+			SetReceiptContentType(receiptContentType)
+	}
+	if payload.RecipientConfirmation != nil {
+		update.SetRecipientConfirmation(payload.RecipientConfirmation)
+	}
+
+	return update
+}
+
+// ProofResponse builds the API response of a fulfillment's proof
+func (s *FulfillmentProofService) ProofResponse(fulfillment *ent.LockOrderFulfillment, receiptURL string) types.FulfillmentProofResponse {
+	response := types.FulfillmentProofResponse{
+		ID:                    fulfillment.ID,
+		TxID:                  fulfillment.TxID,
+		PSP:                   fulfillment.Psp,
+		PSPSessionID:          fulfillment.PspSessionID,
+		BankReference:         fulfillment.BankReference,
+		RecipientConfirmation: fulfillment.RecipientConfirmation,
+		ValidationStatus:      fulfillment.ValidationStatus,
+		CreatedAt:             fulfillment.CreatedAt,
+	}
+
+	if !fulfillment.TransferredAt.IsZero() {
+		response.TransferredAt = &fulfillment.TransferredAt
+	}
+	if !fulfillment.PspConfirmedAt.IsZero() {
+		response.PSPConfirmedAt = &fulfillment.PspConfirmedAt
+	}
+	if fulfillment.ReceiptKey != "" {
+		response.ReceiptURL = receiptURL
+	}
+
+	return response
+}
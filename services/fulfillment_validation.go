@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/services/psp"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// ErrPSPNotSupported is returned when a currency requires independent validation but the fulfillment's PSP has no adapter
+var ErrPSPNotSupported = errors.New("PSP does not support independent validation")
+
+// FulfillmentValidationService provides functionality related to independently validating order fulfillments
+type FulfillmentValidationService struct{}
+
+// NewFulfillmentValidationService creates a new instance of FulfillmentValidationService
+func NewFulfillmentValidationService() *FulfillmentValidationService {
+	return &FulfillmentValidationService{}
+}
+
+// CheckTransfer confirms a fulfillment's transfer through the PSP it was made with, according to the
+// fulfillment validation policy of the order's currency.
+// It returns a nil status when the provider's own validation is trusted. A successful transfer whose amount,
+// currency or beneficiary account doesn't match the order is reported as failed.
+func (s *FulfillmentValidationService) CheckTransfer(ctx context.Context, orderID uuid.UUID, pspName string, txID string) (*types.PSPTransferStatus, error) {
+	order, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(orderID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("CheckTransfer.fetchOrder: %w", err)
+	}
+
+	currency, err := order.QueryProvisionBucket().QueryCurrency().Only(ctx)
+	if ent.IsNotFound(err) {
+		// Private orders may not be assigned a provision bucket
+		currency, err = storage.Client.Institution.
+			Query().
+			Where(institution.CodeEQ(order.Institution)).
+			QueryFiatCurrency().
+			Only(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CheckTransfer.fetchCurrency: %w", err)
+	}
+
+	if currency.FulfillmentValidation == fiatcurrency.FulfillmentValidationProvider {
+		return nil, nil
+	}
+
+	adapter, ok := psp.Get(pspName)
+	if !ok {
+		if currency.FulfillmentValidation == fiatcurrency.FulfillmentValidationPspRequired {
+			return nil, ErrPSPNotSupported
+		}
+		return nil, nil
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, orderConf.PSPLookupTimeout)
+	defer cancel()
+
+	status, err := adapter.GetTransferStatus(lookupCtx, txID)
+	if err != nil {
+		if currency.FulfillmentValidation == fiatcurrency.FulfillmentValidationPspOptional {
+			// Fall back to the provider's validation when the PSP can't be reached
+			logger.Errorf("CheckTransfer.lookup: %v", err)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("CheckTransfer.lookup: %w", err)
+	}
+
+	if status.State == types.PSPTransferSuccess {
+		if mismatch := transferMismatch(status, order, currency); mismatch != "" {
+			return &types.PSPTransferStatus{
+				State:             types.PSPTransferFailed,
+				Message:           mismatch,
+				Amount:            status.Amount,
+				Currency:          status.Currency,
+				AccountIdentifier: status.AccountIdentifier,
+			}, nil
+		}
+	}
+
+	return status, nil
+}
+
+// transferMismatch describes how a transfer reported by a PSP differs from the order it fulfills,
+// or returns an empty string if it matches. The amount is compared to the payout amount the provider
+// was instructed to send, and fields the PSP doesn't report are not compared.
+func transferMismatch(status *types.PSPTransferStatus, order *ent.LockPaymentOrder, currency *ent.FiatCurrency) string {
+	expectedAmount := utils.OrderPayoutAmount(order.Amount, order.Rate)
+	if !status.Amount.IsZero() && !status.Amount.Round(int32(currency.Decimals)).Equal(expectedAmount) {
+		return fmt.Sprintf("transfer amount %s does not match order amount %s", status.Amount, expectedAmount)
+	}
+
+	if status.Currency != "" && !strings.EqualFold(status.Currency, currency.Code) {
+		return fmt.Sprintf("transfer currency %q does not match order currency %s", status.Currency, currency.Code)
+	}
+
+	account := strings.TrimSpace(status.AccountIdentifier)
+	if account != "" && account != strings.TrimSpace(order.AccountIdentifier) {
+		return "transfer beneficiary account does not match order account"
+	}
+
+	return ""
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/services/psp"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFulfillmentValidation(t *testing.T) {
+	order := &ent.LockPaymentOrder{
+		Amount:            decimal.NewFromFloat(100.5),
+		Rate:              decimal.NewFromFloat(1501.333),
+		AccountIdentifier: "1234567890",
+	}
+	currency := &ent.FiatCurrency{Code: "NGN", Decimals: 2}
+
+	transfer := func(amount string, currency string, account string) *types.PSPTransferStatus {
+		return &types.PSPTransferStatus{
+			State:             types.PSPTransferSuccess,
+			Amount:            decimal.RequireFromString(amount),
+			Currency:          currency,
+			AccountIdentifier: account,
+		}
+	}
+
+	t.Run("accepts transfers of the instructed payout amount", func(t *testing.T) {
+		assert.Empty(t, transferMismatch(transfer("150884", "ngn", "1234567890"), order, currency))
+		assert.Empty(t, transferMismatch(transfer("150884.00", "NGN", " 1234567890"), order, currency))
+	})
+
+	t.Run("skips fields the PSP doesn't report", func(t *testing.T) {
+		assert.Empty(t, transferMismatch(transfer("0", "", ""), order, currency))
+		assert.Empty(t, transferMismatch(transfer("150884", "", ""), order, currency))
+	})
+
+	t.Run("rejects transfers that don't match the order", func(t *testing.T) {
+		assert.Contains(t, transferMismatch(transfer("150000", "NGN", "1234567890"), order, currency), "amount")
+		assert.Contains(t, transferMismatch(transfer("150883.97", "NGN", "1234567890"), order, currency), "amount")
+		assert.Contains(t, transferMismatch(transfer("150884", "KES", "1234567890"), order, currency), "currency")
+		assert.Contains(t, transferMismatch(transfer("150884", "NGN", "0987654321"), order, currency), "account")
+	})
+
+	t.Run("CheckTransfer validates mock PSP transfers", func(t *testing.T) {
+		ctx := context.Background()
+		client := enttest.Open(t, "sqlite3", "file:fulfillmentvalidation?mode=memory&_fk=1")
+		defer client.Close()
+
+		db.Client = client
+
+		currency, err := test.CreateTestFiatCurrency(nil)
+		assert.NoError(t, err)
+		currency, err = currency.Update().
+			SetFulfillmentValidation(fiatcurrency.FulfillmentValidationPspRequired).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		bucket, err := client.ProvisionBucket.
+			Create().
+			SetMinAmount(decimal.NewFromInt(1)).
+			SetMaxAmount(decimal.NewFromInt(1000)).
+			SetCurrency(currency).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider"})
+		assert.NoError(t, err)
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+			"gateway_id": uuid.New().String(),
+			"rate":       1501.333,
+			"provider":   provider,
+		})
+		assert.NoError(t, err)
+		order, err = order.Update().SetProvisionBucket(bucket).Save(ctx)
+		assert.NoError(t, err)
+
+		mock := psp.NewMockAdapter()
+		psp.Register(psp.MockPSPName, mock)
+		defer psp.Unregister(psp.MockPSPName)
+
+		service := NewFulfillmentValidationService()
+
+		// Transfers without reported details are validated on state alone
+		status, err := service.CheckTransfer(ctx, order.ID, psp.MockPSPName, "0x123")
+		assert.NoError(t, err)
+		assert.Equal(t, types.PSPTransferSuccess, status.State)
+
+		status, err = service.CheckTransfer(ctx, order.ID, psp.MockPSPName, "failed-123")
+		assert.NoError(t, err)
+		assert.Equal(t, types.PSPTransferFailed, status.State)
+
+		mock.SetTransfer("0x456", types.PSPTransferStatus{
+			State:             types.PSPTransferSuccess,
+			Amount:            decimal.NewFromInt(150884),
+			Currency:          currency.Code,
+			AccountIdentifier: order.AccountIdentifier,
+		})
+		status, err = service.CheckTransfer(ctx, order.ID, psp.MockPSPName, "0x456")
+		assert.NoError(t, err)
+		assert.Equal(t, types.PSPTransferSuccess, status.State)
+
+		mock.SetTransfer("0x789", types.PSPTransferStatus{
+			State:             types.PSPTransferSuccess,
+			Amount:            decimal.RequireFromString("150883.97"),
+			Currency:          currency.Code,
+			AccountIdentifier: order.AccountIdentifier,
+		})
+		status, err = service.CheckTransfer(ctx, order.ID, psp.MockPSPName, "0x789")
+		assert.NoError(t, err)
+		assert.Equal(t, types.PSPTransferFailed, status.State)
+		assert.Contains(t, status.Message, "amount")
+
+		_, err = service.CheckTransfer(ctx, order.ID, "unsupported", "0x123")
+		assert.ErrorIs(t, err, ErrPSPNotSupported)
+	})
+}
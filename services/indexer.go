@@ -23,6 +23,7 @@ import (
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	networkent "github.com/paycrest/aggregator/ent/network"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
@@ -62,6 +63,7 @@ type Indexer interface {
 // IndexerService performs blockchain to database extract, transform, load (ETL) operations.
 type IndexerService struct {
 	priorityQueue *PriorityQueueService
+	splitPlanner  *SplitPlanner
 	order         types.OrderService
 }
 
@@ -71,6 +73,7 @@ func NewIndexerService(order types.OrderService) Indexer {
 
 	return &IndexerService{
 		priorityQueue: priorityQueue,
+		splitPlanner:  NewSplitPlanner(priorityQueue),
 		order:         order,
 	}
 }
@@ -216,8 +219,14 @@ func (s *IndexerService) IndexERC20Transfer(ctx context.Context, client types.RP
 				continue
 			}
 
+			marketRate, err := NewMarketRateService().GetRate(ctx, currency, token.Symbol)
+			if err != nil {
+				logger.Errorf("IndexERC20Transfer.GetMarketRate: %v", err)
+				continue
+			}
+
 			// Get rate from priority queue
-			rateResponse, err := utils.GetTokenRateFromQueue(token.Symbol, orderAmount, currency.Code, currency.MarketRate)
+			rateResponse, err := utils.GetTokenRateFromQueue(token.Symbol, orderAmount, currency.Code, marketRate)
 			if err != nil {
 				logger.Errorf("IndexERC20Transfer.GetTokenRateFromQueue: %v", err)
 				continue
@@ -953,12 +962,17 @@ func (s *IndexerService) CreateLockPaymentOrder(ctx context.Context, client type
 			Query().
 			Where(
 				providerprofile.IDEQ(recipient.ProviderID),
-				providerprofile.HasCurrencyWith(
+				providerprofile.HasCurrenciesWith(
 					fiatcurrency.Code(institution.Edges.FiatCurrency.Code),
 				),
 				providerprofile.IsAvailableEQ(true),
+				providerprofile.IsOpenEQ(true),
 			).
-			WithOrderTokens().
+			WithOrderTokens(func(otq *ent.ProviderOrderTokenQuery) {
+				otq.Where(providerordertoken.HasCurrencyWith(
+					fiatcurrency.Code(institution.Edges.FiatCurrency.Code),
+				))
+			}).
 			Only(ctx)
 		if err != nil {
 			err := s.handleCancellation(ctx, client, nil, &lockPaymentOrder, "Provider is not available")
@@ -1551,7 +1565,12 @@ func (s *IndexerService) UpdateReceiveAddressStatus(
 					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 				}
 
-				rate, err := s.priorityQueue.GetProviderRate(ctx, providerProfile, paymentOrder.Edges.Token.Symbol)
+				institution, err := s.getInstitutionByCode(ctx, orderRecipient.Institution)
+				if err != nil {
+					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
+				}
+
+				rate, err := s.priorityQueue.GetProviderRate(ctx, providerProfile, paymentOrder.Edges.Token.Symbol, institution.Edges.FiatCurrency.Code)
 				if err != nil {
 					return true, fmt.Errorf("UpdateReceiveAddressStatus.db: %v", err)
 				}
@@ -1691,154 +1710,103 @@ func (s *IndexerService) getInstitutionByCode(ctx context.Context, institutionCo
 	return institution, nil
 }
 
-// splitLockPaymentOrder splits a lock payment order into multiple orders
+// splitLockPaymentOrder splits a lock payment order into multiple orders according to a split plan
 func (s *IndexerService) splitLockPaymentOrder(ctx context.Context, client types.RPCClient, lockPaymentOrder types.LockPaymentOrderFields, currency *ent.FiatCurrency) error {
-	buckets, err := db.Client.ProvisionBucket.
-		Query().
-		Where(provisionbucket.HasCurrencyWith(
-			fiatcurrency.IDEQ(currency.ID),
-		)).
-		WithProviderProfiles().
-		Order(ent.Desc(provisionbucket.FieldMaxAmount)).
-		All(ctx)
+	plan, buckets, err := s.splitPlanner.Plan(ctx, lockPaymentOrder, currency)
 	if err != nil {
-		logger.Errorf("failed to fetch provision buckets: %v", err)
+		logger.Errorf("failed to plan split of lock payment order: %v", err)
 		return err
 	}
 
-	amountToSplit := lockPaymentOrder.Amount.Mul(lockPaymentOrder.Rate) // e.g 100,000
-
-	var isRefunded bool
-
-	for _, bucket := range buckets {
-		// Get the number of providers in the bucket
-		bucketSize := int64(len(bucket.Edges.ProviderProfiles))
-
-		// Get the number of allocations to make in the bucket
-		numberOfAllocations := amountToSplit.Div(bucket.MaxAmount).IntPart() // e.g 100000 / 10000 = 10
-
-		var trips int64
-
-		if bucketSize >= numberOfAllocations {
-			trips = numberOfAllocations // e.g 10
-		} else {
-			trips = bucketSize // e.g 2
-		}
-
-		// Create a slice to hold the LockPaymentOrder entities for this bucket
-		lockOrders := make([]*ent.LockPaymentOrderCreate, 0, trips)
-
-		tx, err := db.Client.Tx(ctx)
-		if err != nil {
-			return err
-		}
-
-		for i := int64(0); i < trips; i++ {
-			ratio := bucket.MaxAmount.Div(amountToSplit)
-			orderPercent := ratio.Mul(decimal.NewFromInt(100))
-			lockOrder := tx.LockPaymentOrder.
-				Create().
-				SetToken(lockPaymentOrder.Token).
-				SetGatewayID(lockPaymentOrder.GatewayID).
-				SetAmount(bucket.MaxAmount.Div(lockPaymentOrder.Rate)).
-				SetRate(lockPaymentOrder.Rate).
-				SetOrderPercent(orderPercent).
-				SetBlockNumber(lockPaymentOrder.BlockNumber).
-				SetTxHash(lockPaymentOrder.TxHash).
-				SetInstitution(lockPaymentOrder.Institution).
-				SetAccountIdentifier(lockPaymentOrder.AccountIdentifier).
-				SetAccountName(lockPaymentOrder.AccountName).
-				SetProviderID(lockPaymentOrder.ProviderID).
-				SetProvisionBucket(bucket)
-			lockOrders = append(lockOrders, lockOrder)
-		}
-
-		// Batch insert all LockPaymentOrder entities for this bucket in a single transaction
-		ordersCreated, err := tx.LockPaymentOrder.
-			CreateBulk(lockOrders...).
-			Save(ctx)
-		if err != nil {
-			logger.Errorf("failed to create lock payment orders in bulk: %v", err)
-			_ = tx.Rollback()
-			return err
-		}
-
-		// Commit the transaction if everything succeeded
-		if err := tx.Commit(); err != nil {
-			logger.Errorf("failed to split lock payment order: %v", err)
-			return err
-		}
-
-		// Check AML compliance
-		if serverConf.Environment == "production" && !strings.HasPrefix(lockPaymentOrder.Network.Identifier, "tron") {
-			ok, err := s.checkAMLCompliance(lockPaymentOrder.Network.RPCEndpoint, lockPaymentOrder.TxHash)
-			if err != nil {
-				logger.Errorf("splitLockPaymentOrder.checkAMLCompliance: %v", err)
-			}
-
-			if !ok && err == nil && len(ordersCreated) > 0 {
-				isRefunded = true
-				err := s.handleCancellation(ctx, client, ordersCreated[0], nil, "AML compliance check failed")
-				if err != nil {
-					logger.Errorf("splitLockPaymentOrder.checkAMLCompliance.RefundOrder: %v", err)
-				}
-				break
-			}
-		}
-
-		// Assign the lock payment orders to providers
-		for _, order := range ordersCreated {
-			lockPaymentOrder.ID = order.ID
-			_ = s.priorityQueue.AssignLockPaymentOrder(ctx, lockPaymentOrder)
-		}
-
-		amountToSplit = amountToSplit.Sub(bucket.MaxAmount)
+	if plan.Strategy != SplitStrategyCapacity {
+		logger.Errorf("%s - splitting lock payment order by %s: %s", lockPaymentOrder.GatewayID, plan.Strategy, plan.Reason)
 	}
 
-	largestBucket := buckets[0]
+	planMap, err := splitPlanMap(plan)
+	if err != nil {
+		return err
+	}
 
-	if amountToSplit.LessThan(largestBucket.MaxAmount) {
-		bucket, err := s.getProvisionBucket(ctx, amountToSplit, currency)
-		if err != nil {
-			return err
-		}
+	tx, err := db.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
 
-		orderCreatedUpdate := db.Client.LockPaymentOrder.
+	// Create a LockPaymentOrder for each chunk of the plan
+	lockOrders := make([]*ent.LockPaymentOrderCreate, 0, len(plan.Chunks))
+	for _, chunk := range plan.Chunks {
+		lockOrder := tx.LockPaymentOrder.
 			Create().
 			SetToken(lockPaymentOrder.Token).
 			SetGatewayID(lockPaymentOrder.GatewayID).
-			SetAmount(amountToSplit.Div(lockPaymentOrder.Rate)).
+			SetAmount(chunk.Amount).
 			SetRate(lockPaymentOrder.Rate).
+			SetOrderPercent(chunk.OrderPercent).
 			SetBlockNumber(lockPaymentOrder.BlockNumber).
 			SetTxHash(lockPaymentOrder.TxHash).
 			SetInstitution(lockPaymentOrder.Institution).
 			SetAccountIdentifier(lockPaymentOrder.AccountIdentifier).
 			SetAccountName(lockPaymentOrder.AccountName).
 			SetProviderID(lockPaymentOrder.ProviderID).
-			SetProvisionBucket(bucket)
+			SetProvisionBucket(buckets[chunk.BucketID]).
+			SetSplitPlan(planMap)
+		lockOrders = append(lockOrders, lockOrder)
+	}
 
-		if isRefunded {
-			orderCreatedUpdate = orderCreatedUpdate.SetStatus(lockpaymentorder.StatusRefunded)
-		}
+	// Batch insert all LockPaymentOrder entities in a single transaction
+	ordersCreated, err := tx.LockPaymentOrder.
+		CreateBulk(lockOrders...).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("failed to create lock payment orders in bulk: %v", err)
+		_ = tx.Rollback()
+		return err
+	}
 
-		orderCreated, err := orderCreatedUpdate.Save(ctx)
+	// Commit the transaction if everything succeeded
+	if err := tx.Commit(); err != nil {
+		logger.Errorf("failed to split lock payment order: %v", err)
+		return err
+	}
+
+	// Check AML compliance
+	if serverConf.Environment == "production" && !strings.HasPrefix(lockPaymentOrder.Network.Identifier, "tron") {
+		ok, err := s.checkAMLCompliance(lockPaymentOrder.Network.RPCEndpoint, lockPaymentOrder.TxHash)
 		if err != nil {
-			logger.Errorf("failed to create lock payment order: %v", err)
-			return err
+			logger.Errorf("splitLockPaymentOrder.checkAMLCompliance: %v", err)
 		}
 
-		if !isRefunded {
-			// Assign the lock payment order to a provider
-			lockPaymentOrder.ID = orderCreated.ID
-			_ = s.priorityQueue.AssignLockPaymentOrder(ctx, lockPaymentOrder)
+		if !ok && err == nil && len(ordersCreated) > 0 {
+			err := s.handleCancellation(ctx, client, ordersCreated[0], nil, "AML compliance check failed")
+			if err != nil {
+				logger.Errorf("splitLockPaymentOrder.checkAMLCompliance.RefundOrder: %v", err)
+			}
+
+			// The refund covers the whole order, so the other chunks are refunded with it
+			for _, order := range ordersCreated[1:] {
+				_, err := order.Update().
+					SetStatus(lockpaymentorder.StatusRefunded).
+					Save(ctx)
+				if err != nil {
+					logger.Errorf("splitLockPaymentOrder.checkAMLCompliance.RefundChunk: %v", err)
+				}
+			}
+
+			return nil
 		}
-	} else {
-		// TODO: figure out how to handle this case, currently it recursively splits the amount
-		lockPaymentOrder.Amount = amountToSplit.Div(lockPaymentOrder.Rate)
-		err := s.splitLockPaymentOrder(ctx, client, lockPaymentOrder, currency)
-		if err != nil {
-			return err
+	}
+
+	// Assign the lock payment orders to providers, trying the provider each chunk was planned for first
+	for i, order := range ordersCreated {
+		chunk := plan.Chunks[i]
+		chunkOrder := lockPaymentOrder
+		chunkOrder.ID = order.ID
+		chunkOrder.Amount = chunk.Amount
+		chunkOrder.ProvisionBucket = buckets[chunk.BucketID]
+		if chunkOrder.ProviderID == "" {
+			chunkOrder.ProviderID = chunk.ProviderID
 		}
+		_ = s.priorityQueue.AssignLockPaymentOrder(ctx, chunkOrder)
 	}
 
 	return nil
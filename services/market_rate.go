@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/tokenmarketrate"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/shopspring/decimal"
+)
+
+// CurrencyMarketRateToken is the token whose market rate is also kept as the market rate of the fiat currency
+const CurrencyMarketRateToken = "USDT"
+
+// MarketRateService keeps the market rates of tokens in fiat currencies
+type MarketRateService struct{}
+
+// NewMarketRateService creates a new instance of MarketRateService
+func NewMarketRateService() *MarketRateService {
+	return &MarketRateService{}
+}
+
+// GetRate returns the market rate of a token in a currency.
+// Tokens without a market rate of their own in the currency use the market rate of the currency.
+func (s *MarketRateService) GetRate(ctx context.Context, currency *ent.FiatCurrency, token string) (decimal.Decimal, error) {
+	marketRate, err := storage.Client.TokenMarketRate.
+		Query().
+		Where(
+			tokenmarketrate.TokenEQ(strings.ToUpper(token)),
+			tokenmarketrate.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return currency.MarketRate, nil
+		}
+		return decimal.Zero, fmt.Errorf("GetRate: %w", err)
+	}
+
+	return marketRate.MarketRate, nil
+}
+
+// GetRates returns the market rates of the tokens of a currency that have one, by token
+func (s *MarketRateService) GetRates(ctx context.Context, currency *ent.FiatCurrency) (map[string]decimal.Decimal, error) {
+	marketRates, err := storage.Client.TokenMarketRate.
+		Query().
+		Where(tokenmarketrate.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetRates: %w", err)
+	}
+
+	rates := make(map[string]decimal.Decimal, len(marketRates))
+	for _, marketRate := range marketRates {
+		rates[marketRate.Token] = marketRate.MarketRate
+	}
+
+	return rates, nil
+}
+
+// SetRate sets the market rate of a token in a currency
+func (s *MarketRateService) SetRate(ctx context.Context, currency *ent.FiatCurrency, token string, rate decimal.Decimal) error {
+	token = strings.ToUpper(token)
+
+	updated, err := storage.Client.TokenMarketRate.
+		Update().
+		Where(
+			tokenmarketrate.TokenEQ(token),
+			tokenmarketrate.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+		).
+		SetMarketRate(rate).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("SetRate.update: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = storage.Client.TokenMarketRate.
+		Create().
+		SetCurrency(currency).
+		SetToken(token).
+		SetMarketRate(rate).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("SetRate.create: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketRate(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:marketrate?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+	service := NewMarketRateService()
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	t.Run("sets and returns the market rates of tokens", func(t *testing.T) {
+		// Tokens without a market rate of their own use the market rate of the currency
+		rate, err := service.GetRate(ctx, currency, "USDC")
+		assert.NoError(t, err)
+		assert.Equal(t, "950", rate.String())
+
+		assert.NoError(t, service.SetRate(ctx, currency, "usdc", decimal.NewFromInt(948)))
+		assert.NoError(t, service.SetRate(ctx, currency, "USDC", decimal.NewFromInt(947)))
+		assert.NoError(t, service.SetRate(ctx, currency, "CUSD", decimal.NewFromInt(940)))
+
+		rate, err = service.GetRate(ctx, currency, "usdc")
+		assert.NoError(t, err)
+		assert.Equal(t, "947", rate.String())
+
+		rates, err := service.GetRates(ctx, currency)
+		assert.NoError(t, err)
+		assert.Len(t, rates, 2)
+		assert.Equal(t, "940", rates["CUSD"].String())
+	})
+
+	t.Run("quotes floating provider rates on the market rate of their token", func(t *testing.T) {
+		user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider"})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+
+		for _, symbol := range []string{"USDT", "USDC"} {
+			_, err := client.ProviderOrderToken.
+				Create().
+				SetSymbol(symbol).
+				SetProvider(provider).
+				SetCurrency(currency).
+				SetConversionRateType(providerordertoken.ConversionRateTypeFloating).
+				SetFixedConversionRate(decimal.Zero).
+				SetFloatingConversionRate(decimal.NewFromInt(2)).
+				SetMinOrderAmount(decimal.NewFromInt(1)).
+				SetMaxOrderAmount(decimal.NewFromInt(1000)).
+				SetAddresses([]struct {
+					Address string `json:"address"`
+					Network string `json:"network"`
+				}{}).
+				Save(ctx)
+			assert.NoError(t, err)
+		}
+
+		priorityQueueService := NewPriorityQueueService()
+
+		rate, err := priorityQueueService.GetProviderRate(ctx, provider, "USDT", currency.Code)
+		assert.NoError(t, err)
+		assert.Equal(t, "952", rate.String())
+
+		rate, err = priorityQueueService.GetProviderRate(ctx, provider, "USDC", currency.Code)
+		assert.NoError(t, err)
+		assert.Equal(t, "949", rate.String())
+	})
+}
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+)
+
+// providerLastAssignedKey is the Redis hash of when each provider was last assigned an order, in unix milliseconds
+const providerLastAssignedKey = "provider_last_assigned"
+
+// demotedPriorityOffset pushes providers demoted for SLA breaches behind the others quoting the same rate
+const demotedPriorityOffset = float64(100 * 365 * 24 * time.Hour / time.Millisecond)
+
+// BookEntry is a provider's quote in an order book
+type BookEntry struct {
+	ProviderID     string
+	Pinned         bool
+	Demoted        bool
+	Rate           decimal.Decimal
+	MinOrderAmount decimal.Decimal
+	MaxOrderAmount decimal.Decimal
+}
+
+// MatchingEngine matches orders to providers by price-time priority.
+//
+// Providers are kept in an order book per (currency, token, bucket). A book is a Redis sorted set of the
+// rates quoted in it, scored by rate, and a sorted set per rate of the providers quoting it, scored by
+// when they were last assigned an order. Matching picks the best rate within tolerance of the order rate
+// and, among providers quoting that rate, the one assigned longest ago.
+type MatchingEngine struct{}
+
+// NewMatchingEngine creates a new instance of MatchingEngine
+func NewMatchingEngine() *MatchingEngine {
+	return &MatchingEngine{}
+}
+
+// BookKey returns the Redis key of the order book of a token in a bucket
+func BookKey(currency, token string, minAmount, maxAmount decimal.Decimal) string {
+	return fmt.Sprintf("book_%s_%s_%s_%s", currency, token, minAmount, maxAmount)
+}
+
+// bucketQueueKey returns the Redis key of the circular queue of a bucket
+func bucketQueueKey(bucket *ent.ProvisionBucket) string {
+	return fmt.Sprintf("bucket_%s_%s_%s", bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount)
+}
+
+// bookTokensKey returns the Redis key of the set of tokens with an order book in a bucket
+func bookTokensKey(currency string, minAmount, maxAmount decimal.Decimal) string {
+	return fmt.Sprintf("book_tokens_%s_%s_%s", currency, minAmount, maxAmount)
+}
+
+// levelKey returns the Redis key of the providers quoting a rate in an order book
+func levelKey(book string, rate string) string {
+	return fmt.Sprintf("%s_%s", book, rate)
+}
+
+// RebuildBooks replaces the order books of a bucket with the given entries, keyed by token.
+// Providers keep their time priority across rebuilds, except pinned providers which are moved ahead of the rest
+// and demoted providers which are moved behind them.
+func (e *MatchingEngine) RebuildBooks(ctx context.Context, currency string, minAmount, maxAmount decimal.Decimal, entries map[string][]BookEntry) error {
+	tokensKey := bookTokensKey(currency, minAmount, maxAmount)
+
+	// Books of tokens no longer quoted in the bucket are cleared too
+	tokens, err := storage.RedisClient.SMembers(ctx, tokensKey).Result()
+	if err != nil {
+		return fmt.Errorf("RebuildBooks.tokens: %w", err)
+	}
+
+	staleKeys := []string{tokensKey}
+	for _, token := range tokens {
+		book := BookKey(currency, token, minAmount, maxAmount)
+		rates, err := storage.RedisClient.ZRange(ctx, book, 0, -1).Result()
+		if err != nil {
+			return fmt.Errorf("RebuildBooks.levels: %w", err)
+		}
+
+		staleKeys = append(staleKeys, book)
+		for _, rate := range rates {
+			staleKeys = append(staleKeys, levelKey(book, rate))
+		}
+	}
+
+	lastAssigned, err := e.lastAssigned(ctx, entries)
+	if err != nil {
+		return fmt.Errorf("RebuildBooks.lastAssigned: %w", err)
+	}
+
+	_, err = storage.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, staleKeys...)
+
+		for token, tokenEntries := range entries {
+			if len(tokenEntries) == 0 {
+				continue
+			}
+
+			book := BookKey(currency, token, minAmount, maxAmount)
+			pipe.SAdd(ctx, tokensKey, token)
+
+			for _, entry := range tokenEntries {
+				priority := bookEntryPriority(entry, lastAssigned[entry.ProviderID])
+
+				rate, _ := entry.Rate.Float64()
+				pipe.ZAdd(ctx, book, redis.Z{Score: rate, Member: entry.Rate.String()})
+				pipe.ZAdd(ctx, levelKey(book, entry.Rate.String()), redis.Z{
+					Score:  priority,
+					Member: encodeBookEntry(entry),
+				})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("RebuildBooks.save: %w", err)
+	}
+
+	return nil
+}
+
+// Match returns the entry with the best rate within tolerance of the order rate that is accepted by the
+// given filter, or nil if there is none. Rates are tried from highest to lowest, and providers quoting
+// the same rate from least to most recently assigned.
+func (e *MatchingEngine) Match(ctx context.Context, book string, rate, tolerance decimal.Decimal, accept func(BookEntry) bool) (*BookEntry, error) {
+	var match *BookEntry
+
+	err := e.scan(ctx, book, rate, tolerance, func(entry BookEntry) bool {
+		if accept(entry) {
+			match = &entry
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Match: %w", err)
+	}
+
+	return match, nil
+}
+
+// Candidates returns all entries within tolerance of the order rate that are accepted by the given filter,
+// in price-time priority
+func (e *MatchingEngine) Candidates(ctx context.Context, book string, rate, tolerance decimal.Decimal, accept func(BookEntry) bool) ([]BookEntry, error) {
+	candidates := []BookEntry{}
+
+	err := e.scan(ctx, book, rate, tolerance, func(entry BookEntry) bool {
+		if accept(entry) {
+			candidates = append(candidates, entry)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Candidates: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// scan visits the entries within tolerance of the order rate in price-time priority until visit returns true
+func (e *MatchingEngine) scan(ctx context.Context, book string, rate, tolerance decimal.Decimal, visit func(BookEntry) bool) error {
+	rates, err := storage.RedisClient.ZRevRangeByScore(ctx, book, &redis.ZRangeBy{
+		Min: rate.Sub(tolerance).String(),
+		Max: rate.Add(tolerance).String(),
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("levels: %w", err)
+	}
+
+	for _, levelRate := range rates {
+		members, err := storage.RedisClient.ZRangeWithScores(ctx, levelKey(book, levelRate), 0, -1).Result()
+		if err != nil {
+			return fmt.Errorf("level: %w", err)
+		}
+
+		for _, member := range members {
+			data, _ := member.Member.(string)
+			entry, err := decodeBookEntry(data, levelRate)
+			if err != nil {
+				continue
+			}
+
+			// Pinned and demoted providers are told apart by their time priority
+			entry.Pinned = member.Score < 0
+			entry.Demoted = member.Score >= demotedPriorityOffset
+
+			if visit(entry) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// BookDepth returns the number of rate levels quoted in an order book
+func (e *MatchingEngine) BookDepth(ctx context.Context, book string) (int64, error) {
+	depth, err := storage.RedisClient.ZCard(ctx, book).Result()
+	if err != nil {
+		return 0, fmt.Errorf("BookDepth: %w", err)
+	}
+
+	return depth, nil
+}
+
+// RecordAssignment moves a provider to the back of its rate level after it was assigned an order.
+// Pinned providers stay ahead of the rest and demoted providers behind them.
+func (e *MatchingEngine) RecordAssignment(ctx context.Context, book string, entry *BookEntry) error {
+	now := time.Now().UnixMilli()
+
+	_, err := storage.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAddXX(ctx, levelKey(book, entry.Rate.String()), redis.Z{
+			Score:  bookEntryPriority(*entry, now),
+			Member: encodeBookEntry(*entry),
+		})
+		pipe.HSet(ctx, providerLastAssignedKey, entry.ProviderID, now)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("RecordAssignment: %w", err)
+	}
+
+	return nil
+}
+
+// QueueEntry returns the entry at an index of a circular queue, and false past the end of the queue
+func (e *MatchingEngine) QueueEntry(ctx context.Context, queue string, index int) (string, bool, error) {
+	data, err := storage.RedisClient.LIndex(ctx, queue, int64(index)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("QueueEntry: %w", err)
+	}
+
+	return data, true, nil
+}
+
+// RotateQueue moves the entry at the head of a circular queue to its back if it is the given entry
+func (e *MatchingEngine) RotateQueue(ctx context.Context, queue string, data string) error {
+	head, err := storage.RedisClient.LIndex(ctx, queue, 0).Result()
+	if err != nil || head != data {
+		return nil
+	}
+
+	// Match found at the head of the queue, dequeue and enqueue it to the end of the queue
+	data, err = storage.RedisClient.LPop(ctx, queue).Result()
+	if err != nil {
+		return fmt.Errorf("RotateQueue.dequeue: %w", err)
+	}
+
+	err = storage.RedisClient.RPush(ctx, queue, data).Err()
+	if err != nil {
+		return fmt.Errorf("RotateQueue.enqueue: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveProvider removes a provider's quotes from an order book until the next rebuild
+func (e *MatchingEngine) RemoveProvider(ctx context.Context, book string, providerID string) error {
+	rates, err := storage.RedisClient.ZRange(ctx, book, 0, -1).Result()
+	if err != nil {
+		return fmt.Errorf("RemoveProvider.levels: %w", err)
+	}
+
+	for _, rate := range rates {
+		level := levelKey(book, rate)
+		members, err := storage.RedisClient.ZRange(ctx, level, 0, -1).Result()
+		if err != nil {
+			return fmt.Errorf("RemoveProvider.level: %w", err)
+		}
+
+		remaining := len(members)
+		for _, member := range members {
+			if !strings.HasPrefix(member, providerID+":") {
+				continue
+			}
+
+			if err := storage.RedisClient.ZRem(ctx, level, member).Err(); err != nil {
+				return fmt.Errorf("RemoveProvider.remove: %w", err)
+			}
+			remaining--
+		}
+
+		if remaining == 0 {
+			if err := storage.RedisClient.ZRem(ctx, book, rate).Err(); err != nil {
+				return fmt.Errorf("RemoveProvider.removeLevel: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lastAssigned returns when the providers in the given entries were last assigned an order
+func (e *MatchingEngine) lastAssigned(ctx context.Context, entries map[string][]BookEntry) (map[string]int64, error) {
+	providerIDs := []string{}
+	seen := map[string]bool{}
+	for _, tokenEntries := range entries {
+		for _, entry := range tokenEntries {
+			if !seen[entry.ProviderID] {
+				seen[entry.ProviderID] = true
+				providerIDs = append(providerIDs, entry.ProviderID)
+			}
+		}
+	}
+
+	lastAssigned := map[string]int64{}
+	if len(providerIDs) == 0 {
+		return lastAssigned, nil
+	}
+
+	values, err := storage.RedisClient.HMGet(ctx, providerLastAssignedKey, providerIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		if s, ok := value.(string); ok {
+			if millis, err := strconv.ParseInt(s, 10, 64); err == nil {
+				lastAssigned[providerIDs[i]] = millis
+			}
+		}
+	}
+
+	return lastAssigned, nil
+}
+
+// bookEntryPriority returns the time priority of an entry in its rate level, given when its provider was
+// last assigned an order in unix milliseconds
+func bookEntryPriority(entry BookEntry, lastAssigned int64) float64 {
+	if entry.Pinned {
+		return -1
+	}
+	if entry.Demoted {
+		return float64(lastAssigned) + demotedPriorityOffset
+	}
+	return float64(lastAssigned)
+}
+
+// encodeBookEntry serializes a book entry as "providerID:minAmount:maxAmount".
+// The rate is implied by the level the entry is in.
+func encodeBookEntry(entry BookEntry) string {
+	return fmt.Sprintf("%s:%s:%s", entry.ProviderID, entry.MinOrderAmount, entry.MaxOrderAmount)
+}
+
+// decodeBookEntry parses a book entry in a rate level
+func decodeBookEntry(member string, rate string) (BookEntry, error) {
+	parts := strings.Split(member, ":")
+	if len(parts) != 3 {
+		return BookEntry{}, fmt.Errorf("invalid book entry: %s", member)
+	}
+
+	parsedRate, err := decimal.NewFromString(rate)
+	if err != nil {
+		return BookEntry{}, err
+	}
+
+	minOrderAmount, err := decimal.NewFromString(parts[1])
+	if err != nil {
+		return BookEntry{}, err
+	}
+
+	maxOrderAmount, err := decimal.NewFromString(parts[2])
+	if err != nil {
+		return BookEntry{}, err
+	}
+
+	return BookEntry{
+		ProviderID:     parts[0],
+		Rate:           parsedRate,
+		MinOrderAmount: minOrderAmount,
+		MaxOrderAmount: maxOrderAmount,
+	}, nil
+}
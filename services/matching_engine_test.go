@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchingEngine(t *testing.T) {
+	ctx := context.Background()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: "localhost:6379",
+	})
+	defer redisClient.Close()
+
+	db.RedisClient = redisClient
+	{
+		err := redisClient.FlushAll(ctx).Err()
+		assert.NoError(t, err)
+	}
+
+	engine := NewMatchingEngine()
+	minAmount, maxAmount := decimal.NewFromInt(1), decimal.NewFromInt(1000)
+	book := BookKey("NGN", "USDT", minAmount, maxAmount)
+	acceptAll := func(BookEntry) bool { return true }
+
+	entry := func(providerID string, pinned, demoted bool) BookEntry {
+		return BookEntry{
+			ProviderID:     providerID,
+			Pinned:         pinned,
+			Demoted:        demoted,
+			Rate:           decimal.NewFromInt(1500),
+			MinOrderAmount: decimal.NewFromInt(1),
+			MaxOrderAmount: decimal.NewFromInt(100),
+		}
+	}
+
+	candidateIDs := func() []string {
+		candidates, err := engine.Candidates(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptAll)
+		assert.NoError(t, err)
+		return bookEntryProviderIDs(candidates)
+	}
+
+	t.Run("keeps pinned and demoted priorities after assignments", func(t *testing.T) {
+		err := engine.RebuildBooks(ctx, "NGN", minAmount, maxAmount, map[string][]BookEntry{
+			"USDT": {
+				entry("provider-a", false, false),
+				entry("provider-b", false, false),
+				entry("provider-demoted", false, true),
+				entry("provider-pinned", true, false),
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"provider-pinned", "provider-a", "provider-b", "provider-demoted"}, candidateIDs())
+
+		// Entries are matched with the priority they are kept at
+		match, err := engine.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, func(entry BookEntry) bool {
+			return entry.ProviderID == "provider-demoted"
+		})
+		assert.NoError(t, err)
+		if assert.NotNil(t, match) {
+			assert.True(t, match.Demoted)
+			assert.NoError(t, engine.RecordAssignment(ctx, book, match))
+		}
+
+		match, err = engine.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptAll)
+		assert.NoError(t, err)
+		if assert.NotNil(t, match) {
+			assert.True(t, match.Pinned)
+			assert.NoError(t, engine.RecordAssignment(ctx, book, match))
+		}
+
+		match, err = engine.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, func(entry BookEntry) bool {
+			return entry.ProviderID == "provider-a"
+		})
+		assert.NoError(t, err)
+		if assert.NotNil(t, match) {
+			assert.NoError(t, engine.RecordAssignment(ctx, book, match))
+		}
+
+		// The demoted provider stays behind its undemoted peers and the pinned provider keeps its pin
+		assert.Equal(t, []string{"provider-pinned", "provider-b", "provider-a", "provider-demoted"}, candidateIDs())
+	})
+}
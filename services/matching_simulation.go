@@ -0,0 +1,555 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/shopspring/decimal"
+)
+
+// Reasons an order is left unmatched in a matching simulation
+const (
+	unmatchedReasonUnknownInstitution = "unknown institution"
+	unmatchedReasonNoBucket           = "no bucket for the order's fiat amount"
+	unmatchedReasonNoProvider         = "no provider quoting within tolerance of the order rate"
+)
+
+// MatchingSimulationOptions configures a matching simulation
+type MatchingSimulationOptions struct {
+	// Strategy overrides the matching strategies configured for currencies and buckets when set
+	Strategy string
+
+	// Currency limits the simulation to the buckets and orders of a fiat currency when set
+	Currency string
+
+	// From and To limit the orders replayed to those created in [From, To) when set
+	From time.Time
+	To   time.Time
+
+	// Limit caps the number of orders replayed when positive
+	Limit int
+
+	// Seed seeds the random choices of the trust weighted strategy
+	Seed int64
+}
+
+// MatchingSimulator replays historical lock payment orders through the matching strategies in-process.
+//
+// The order books are built from the providers, token configs and buckets in the database, the same way
+// they are for Redis, but are kept in memory. Orders are matched in the order they were created without
+// being sent to providers, so nothing is written to the database, Redis or provider nodes.
+type MatchingSimulator struct {
+	priorityQueue *PriorityQueueService
+}
+
+// NewMatchingSimulator creates a new instance of MatchingSimulator
+func NewMatchingSimulator() *MatchingSimulator {
+	return &MatchingSimulator{
+		priorityQueue: NewPriorityQueueService(),
+	}
+}
+
+// Run replays orders through the matching strategies and reports how they were assigned
+func (s *MatchingSimulator) Run(ctx context.Context, opts MatchingSimulationOptions) (*types.MatchingSimulationReport, error) {
+	if opts.Strategy != "" && !utils.ContainsString(MatchingStrategies, opts.Strategy) {
+		return nil, fmt.Errorf("unknown matching strategy: %s", opts.Strategy)
+	}
+
+	bucketPredicates := []predicate.ProvisionBucket{}
+	if opts.Currency != "" {
+		bucketPredicates = append(bucketPredicates, provisionbucket.HasCurrencyWith(fiatcurrency.CodeEQ(opts.Currency)))
+	}
+
+	buckets, err := s.priorityQueue.GetProvisionBuckets(ctx, bucketPredicates...)
+	if err != nil {
+		return nil, fmt.Errorf("Run.buckets: %w", err)
+	}
+
+	store := newMemoryOrderBookStore()
+	for _, bucket := range buckets {
+		queue, entries, _ := s.priorityQueue.bucketEntries(ctx, bucket, false, nil)
+		store.load(bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount, bucketQueueKey(bucket), queue, entries)
+	}
+
+	orders, err := s.orders(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Run.orders: %w", err)
+	}
+
+	institutions, err := storage.Client.Institution.
+		Query().
+		WithFiatCurrency().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Run.institutions: %w", err)
+	}
+
+	institutionsByCode := map[string]*ent.Institution{}
+	for _, inst := range institutions {
+		institutionsByCode[inst.Code] = inst
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	strategies := map[string]MatchingStrategy{}
+
+	report := &types.MatchingSimulationReport{
+		Strategy:        opts.Strategy,
+		Strategies:      map[string]int{},
+		UnmatchedOrders: []types.MatchingSimulationUnmatchedOrder{},
+	}
+	loads := map[string]*types.MatchingSimulationProviderLoad{}
+	load := func(providerID string) *types.MatchingSimulationProviderLoad {
+		if _, ok := loads[providerID]; !ok {
+			loads[providerID] = &types.MatchingSimulationProviderLoad{ProviderID: providerID}
+		}
+		return loads[providerID]
+	}
+
+	// Providers checked against an institution, keyed by provider ID and institution code
+	supportedProviders := map[string]bool{}
+	totalSpread := decimal.Zero
+
+	for _, order := range orders {
+		unmatched := func(reason string) {
+			report.Unmatched++
+			report.UnmatchedOrders = append(report.UnmatchedOrders, types.MatchingSimulationUnmatchedOrder{
+				OrderID:     order.ID,
+				Token:       order.Edges.Token.Symbol,
+				Amount:      order.Amount,
+				Rate:        order.Rate,
+				Institution: order.Institution,
+				Reason:      reason,
+			})
+		}
+
+		orderInstitution := institutionsByCode[order.Institution]
+		if orderInstitution != nil && orderInstitution.Edges.FiatCurrency != nil &&
+			opts.Currency != "" && orderInstitution.Edges.FiatCurrency.Code != opts.Currency {
+			continue
+		}
+
+		report.Orders++
+		if order.Edges.Provider != nil {
+			load(order.Edges.Provider.ID).HistoricalOrders++
+		}
+
+		if orderInstitution == nil || orderInstitution.Edges.FiatCurrency == nil {
+			unmatched(unmatchedReasonUnknownInstitution)
+			continue
+		}
+
+		bucket := simulationBucket(buckets, orderInstitution.Edges.FiatCurrency.Code, order.Amount.Mul(order.Rate))
+		if bucket == nil {
+			unmatched(unmatchedReasonNoBucket)
+			continue
+		}
+
+		name := opts.Strategy
+		if name == "" {
+			name = matchingStrategyName(bucket)
+		}
+
+		strategy, ok := strategies[name]
+		if !ok {
+			strategy, err = newMatchingStrategy(name, store, rng)
+			if err != nil {
+				strategy, _ = newMatchingStrategy(MatchingStrategyPriceTime, store, rng)
+			}
+			strategies[name] = strategy
+		}
+
+		fields := types.LockPaymentOrderFields{
+			ID:              order.ID,
+			Token:           order.Edges.Token,
+			Amount:          order.Amount,
+			Rate:            order.Rate,
+			Institution:     order.Institution,
+			ProvisionBucket: bucket,
+			UpdatedAt:       order.UpdatedAt,
+			CreatedAt:       order.CreatedAt,
+		}
+
+		var matchErr error
+		entry, err := strategy.Match(ctx, fields, func(entry BookEntry) bool {
+			// Skip entry if order amount is not within provider's min and max order amount
+			if order.Amount.LessThan(entry.MinOrderAmount) || order.Amount.GreaterThan(entry.MaxOrderAmount) {
+				return false
+			}
+
+			// Skip entry if provider can't pay out to the order's institution
+			key := entry.ProviderID + ":" + orderInstitution.Code
+			supported, ok := supportedProviders[key]
+			if !ok {
+				var err error
+				supported, err = s.priorityQueue.providerSupportsInstitution(ctx, entry.ProviderID, orderInstitution)
+				if err != nil {
+					matchErr = err
+					return false
+				}
+				supportedProviders[key] = supported
+			}
+
+			return supported
+		})
+		if err == nil {
+			err = matchErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Run.match: %w", err)
+		}
+
+		if entry == nil {
+			unmatched(unmatchedReasonNoProvider)
+			continue
+		}
+
+		err = strategy.RecordAssignment(ctx, fields, entry)
+		if err != nil {
+			return nil, fmt.Errorf("Run.recordAssignment: %w", err)
+		}
+
+		report.Matched++
+		report.Strategies[strategy.Name()]++
+		if order.Edges.Provider != nil && order.Edges.Provider.ID != entry.ProviderID {
+			report.Reassigned++
+		}
+
+		providerLoad := load(entry.ProviderID)
+		providerLoad.Orders++
+		providerLoad.Volume = providerLoad.Volume.Add(order.Amount)
+		providerLoad.FiatVolume = providerLoad.FiatVolume.Add(order.Amount.Mul(entry.Rate))
+
+		spread := entry.Rate.Sub(order.Rate)
+		switch {
+		case spread.IsPositive():
+			report.Rates.AboveOrderRate++
+		case spread.IsNegative():
+			report.Rates.BelowOrderRate++
+		default:
+			report.Rates.AtOrderRate++
+		}
+
+		if report.Matched == 1 || spread.LessThan(report.Rates.MinSpread) {
+			report.Rates.MinSpread = spread
+		}
+		if report.Matched == 1 || spread.GreaterThan(report.Rates.MaxSpread) {
+			report.Rates.MaxSpread = spread
+		}
+		totalSpread = totalSpread.Add(spread)
+	}
+
+	if report.Orders > 0 {
+		report.MatchRate = decimal.NewFromInt(int64(report.Matched)).
+			Div(decimal.NewFromInt(int64(report.Orders))).
+			Mul(decimal.NewFromInt(100)).
+			Round(2)
+	}
+
+	if report.Matched > 0 {
+		report.Rates.AverageSpread = totalSpread.Div(decimal.NewFromInt(int64(report.Matched))).Round(4)
+	}
+
+	report.Providers = []types.MatchingSimulationProviderLoad{}
+	for _, providerLoad := range loads {
+		if report.Matched > 0 {
+			providerLoad.Share = decimal.NewFromInt(int64(providerLoad.Orders)).
+				Div(decimal.NewFromInt(int64(report.Matched))).
+				Mul(decimal.NewFromInt(100)).
+				Round(2)
+		}
+		report.Providers = append(report.Providers, *providerLoad)
+	}
+
+	sort.Slice(report.Providers, func(i, j int) bool {
+		if report.Providers[i].Orders != report.Providers[j].Orders {
+			return report.Providers[i].Orders > report.Providers[j].Orders
+		}
+		return report.Providers[i].ProviderID < report.Providers[j].ProviderID
+	})
+
+	return report, nil
+}
+
+// orders returns the lock payment orders to replay, oldest first
+func (s *MatchingSimulator) orders(ctx context.Context, opts MatchingSimulationOptions) ([]*ent.LockPaymentOrder, error) {
+	predicates := []predicate.LockPaymentOrder{}
+	if !opts.From.IsZero() {
+		predicates = append(predicates, lockpaymentorder.CreatedAtGTE(opts.From))
+	}
+	if !opts.To.IsZero() {
+		predicates = append(predicates, lockpaymentorder.CreatedAtLT(opts.To))
+	}
+
+	query := storage.Client.LockPaymentOrder.
+		Query().
+		Where(predicates...).
+		WithToken().
+		WithProvider(func(ppq *ent.ProviderProfileQuery) {
+			ppq.Select(providerprofile.FieldID)
+		}).
+		Order(ent.Asc(lockpaymentorder.FieldCreatedAt))
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+
+	return query.All(ctx)
+}
+
+// simulationBucket returns the bucket of a currency a fiat amount falls in, or nil if there is none
+func simulationBucket(buckets []*ent.ProvisionBucket, currency string, fiatAmount decimal.Decimal) *ent.ProvisionBucket {
+	for _, bucket := range buckets {
+		if bucket.Edges.Currency.Code == currency &&
+			fiatAmount.GreaterThanOrEqual(bucket.MinAmount) && fiatAmount.LessThanOrEqual(bucket.MaxAmount) {
+			return bucket
+		}
+	}
+
+	return nil
+}
+
+// LoadSnapshot writes a matching snapshot fixture to a database, typically an empty in-memory one,
+// so it can be replayed by Run
+func (s *MatchingSimulator) LoadSnapshot(ctx context.Context, client *ent.Client, snapshot *types.MatchingSnapshot) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("LoadSnapshot.tx: %w", err)
+	}
+
+	err = loadMatchingSnapshot(ctx, tx, snapshot)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("LoadSnapshot: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("LoadSnapshot.commit: %w", err)
+	}
+
+	return nil
+}
+
+// loadMatchingSnapshot creates the entities of a matching snapshot
+func loadMatchingSnapshot(ctx context.Context, tx *ent.Tx, snapshot *types.MatchingSnapshot) error {
+	network, err := tx.Network.
+		Create().
+		SetChainID(0).
+		SetIdentifier("simulation").
+		SetRPCEndpoint("").
+		SetIsTestnet(true).
+		SetFee(decimal.Zero).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("network: %w", err)
+	}
+
+	currencies := map[string]*ent.FiatCurrency{}
+	for _, c := range snapshot.Currencies {
+		create := tx.FiatCurrency.
+			Create().
+			SetCode(c.Code).
+			SetShortName(c.Code).
+			SetSymbol(c.Code).
+			SetName(c.Code).
+			SetMarketRate(c.MarketRate).
+			SetIsEnabled(true)
+		if c.MatchingStrategy != "" {
+			create.SetMatchingStrategy(fiatcurrency.MatchingStrategy(c.MatchingStrategy))
+		}
+
+		currency, err := create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("currency %s: %w", c.Code, err)
+		}
+		currencies[c.Code] = currency
+
+		for _, inst := range c.Institutions {
+			create := tx.Institution.
+				Create().
+				SetCode(inst.Code).
+				SetName(inst.Code).
+				SetFiatCurrency(currency)
+			if inst.Type != "" {
+				create.SetType(institution.Type(inst.Type))
+			}
+
+			if _, err := create.Save(ctx); err != nil {
+				return fmt.Errorf("institution %s: %w", inst.Code, err)
+			}
+		}
+	}
+
+	tokens := map[string]*ent.Token{}
+	token := func(symbol string) (*ent.Token, error) {
+		if t, ok := tokens[symbol]; ok {
+			return t, nil
+		}
+
+		t, err := tx.Token.
+			Create().
+			SetSymbol(symbol).
+			SetContractAddress("").
+			SetDecimals(6).
+			SetIsEnabled(true).
+			SetNetwork(network).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("token %s: %w", symbol, err)
+		}
+
+		tokens[symbol] = t
+		return t, nil
+	}
+
+	for _, p := range snapshot.Providers {
+		user, err := tx.User.
+			Create().
+			SetFirstName("Simulation").
+			SetLastName("Provider").
+			SetEmail(strings.ToLower(p.ID) + "@simulation.local").
+			SetPassword(p.ID).
+			SetScope("provider").
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("provider %s user: %w", p.ID, err)
+		}
+
+		create := tx.ProviderProfile.
+			Create().
+			SetID(p.ID).
+			SetUser(user).
+			SetIsActive(true).
+			SetIsAvailable(true).
+			SetIsKybVerified(true).
+			SetNodeProtocolVersion(orderConf.NodeMinProtocolVersion).
+			SetSupportedInstitutions(p.SupportedInstitutions).
+			SetSupportedInstitutionTypes(p.SupportedInstitutionTypes)
+		if p.Demoted {
+			create.SetDemotedUntil(time.Now().Add(24 * time.Hour))
+		}
+
+		provider, err := create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("provider %s: %w", p.ID, err)
+		}
+
+		_, err = tx.ProviderRating.
+			Create().
+			SetTrustScore(p.TrustScore).
+			SetProviderProfile(provider).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("provider %s rating: %w", p.ID, err)
+		}
+
+		if p.LatencyMs > 0 {
+			_, err = tx.ProviderHealthCheck.
+				Create().
+				SetIsHealthy(true).
+				SetLatencyMs(p.LatencyMs).
+				SetProvider(provider).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("provider %s health check: %w", p.ID, err)
+			}
+		}
+
+		for _, t := range p.Tokens {
+			currency, ok := currencies[t.Currency]
+			if !ok {
+				return fmt.Errorf("provider %s token: unknown currency %s", p.ID, t.Currency)
+			}
+
+			_, err = tx.ProviderOrderToken.
+				Create().
+				SetSymbol(t.Symbol).
+				SetConversionRateType(providerordertoken.ConversionRateType(t.ConversionRateType)).
+				SetFixedConversionRate(t.FixedConversionRate).
+				SetFloatingConversionRate(t.FloatingConversionRate).
+				SetMinOrderAmount(t.MinOrderAmount).
+				SetMaxOrderAmount(t.MaxOrderAmount).
+				SetAddresses([]struct {
+					Address string `json:"address"`
+					Network string `json:"network"`
+				}{}).
+				SetProvider(provider).
+				SetCurrency(currency).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("provider %s %s token: %w", p.ID, t.Symbol, err)
+			}
+		}
+	}
+
+	for _, b := range snapshot.Buckets {
+		currency, ok := currencies[b.Currency]
+		if !ok {
+			return fmt.Errorf("bucket: unknown currency %s", b.Currency)
+		}
+
+		create := tx.ProvisionBucket.
+			Create().
+			SetMinAmount(b.MinAmount).
+			SetMaxAmount(b.MaxAmount).
+			SetCurrency(currency).
+			AddProviderProfileIDs(b.Providers...)
+		if b.MatchingStrategy != "" {
+			create.SetMatchingStrategy(provisionbucket.MatchingStrategy(b.MatchingStrategy))
+		}
+
+		if _, err := create.Save(ctx); err != nil {
+			return fmt.Errorf("bucket %s-%s: %w", b.MinAmount, b.MaxAmount, err)
+		}
+	}
+
+	for _, o := range snapshot.Orders {
+		t, err := token(o.Token)
+		if err != nil {
+			return err
+		}
+
+		create := tx.LockPaymentOrder.
+			Create().
+			SetGatewayID("").
+			SetAmount(o.Amount).
+			SetRate(o.Rate).
+			SetOrderPercent(decimal.NewFromInt(100)).
+			SetBlockNumber(0).
+			SetInstitution(o.Institution).
+			SetAccountIdentifier("").
+			SetAccountName("").
+			SetStatus(lockpaymentorder.StatusSettled).
+			SetToken(t)
+		if o.ID != uuid.Nil {
+			create.SetID(o.ID)
+		}
+		if !o.CreatedAt.IsZero() {
+			create.SetCreatedAt(o.CreatedAt)
+		}
+		if o.ProviderID != "" {
+			create.SetProviderID(o.ProviderID)
+		}
+
+		if _, err := create.Save(ctx); err != nil {
+			return fmt.Errorf("order %s: %w", o.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent/enttest"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchingSimulator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("memory order book matches by price-time priority", func(t *testing.T) {
+		store := newMemoryOrderBookStore()
+		entries := map[string][]BookEntry{
+			"USDT": {
+				{ProviderID: "provider-a", Rate: decimal.NewFromInt(1500), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+				{ProviderID: "provider-b", Rate: decimal.NewFromInt(1500), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+				{ProviderID: "provider-c", Demoted: true, Rate: decimal.NewFromFloat(1500.4), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+				{ProviderID: "provider-d", Rate: decimal.NewFromInt(1502), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+			},
+		}
+		store.load("NGN", decimal.NewFromInt(1), decimal.NewFromInt(1000), "queue", nil, entries)
+
+		book := BookKey("NGN", "USDT", decimal.NewFromInt(1), decimal.NewFromInt(1000))
+		acceptAll := func(BookEntry) bool { return true }
+
+		// Best rate within tolerance first, regardless of demotion
+		entry, err := store.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptAll)
+		assert.NoError(t, err)
+		assert.Equal(t, "provider-c", entry.ProviderID)
+
+		// Providers quoting the same rate rotate as they are assigned
+		acceptSameRate := func(entry BookEntry) bool { return entry.ProviderID != "provider-c" }
+		entry, _ = store.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptSameRate)
+		assert.Equal(t, "provider-a", entry.ProviderID)
+
+		assert.NoError(t, store.RecordAssignment(ctx, book, entry))
+		entry, _ = store.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptSameRate)
+		assert.Equal(t, "provider-b", entry.ProviderID)
+
+		candidates, err := store.Candidates(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptAll)
+		assert.NoError(t, err)
+		assert.Len(t, candidates, 3)
+
+		// Demoted providers stay behind the others quoting their rate after they are assigned
+		store.load("NGN", decimal.NewFromInt(1), decimal.NewFromInt(1000), "queue", nil, map[string][]BookEntry{
+			"USDT": {
+				{ProviderID: "provider-a", Rate: decimal.NewFromInt(1500), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+				{ProviderID: "provider-b", Demoted: true, Rate: decimal.NewFromInt(1500), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+				{ProviderID: "provider-c", Pinned: true, Rate: decimal.NewFromInt(1500), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)},
+			},
+		})
+		for _, providerID := range []string{"provider-b", "provider-c"} {
+			entry, _ = store.Match(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, func(entry BookEntry) bool {
+				return entry.ProviderID == providerID
+			})
+			assert.NoError(t, store.RecordAssignment(ctx, book, entry))
+		}
+
+		candidates, _ = store.Candidates(ctx, book, decimal.NewFromInt(1500), matchingRateTolerance, acceptAll)
+		assert.Equal(t, []string{"provider-c", "provider-a", "provider-b"}, bookEntryProviderIDs(candidates))
+	})
+
+	t.Run("replays a snapshot fixture", func(t *testing.T) {
+		client := enttest.Open(t, "sqlite3", "file:simulation?mode=memory&_fk=1")
+		defer client.Close()
+
+		db.Client = client
+
+		snapshot := &types.MatchingSnapshot{
+			Currencies: []types.MatchingSnapshotCurrency{{
+				Code:       "NGN",
+				MarketRate: decimal.NewFromInt(1500),
+				Institutions: []types.MatchingSnapshotInstitution{
+					{Code: "GTBINGLA", Type: "bank"},
+					{Code: "OPAYNGPC", Type: "mobile_money"},
+				},
+			}},
+			Providers: []types.MatchingSnapshotProvider{
+				{
+					ID:         "provider-a",
+					TrustScore: decimal.NewFromInt(4),
+					Tokens: []types.MatchingSnapshotProviderToken{{
+						Symbol:              "USDT",
+						Currency:            "NGN",
+						ConversionRateType:  "fixed",
+						FixedConversionRate: decimal.NewFromInt(1500),
+						MinOrderAmount:      decimal.NewFromInt(1),
+						MaxOrderAmount:      decimal.NewFromInt(500),
+					}},
+				},
+				{
+					ID:                        "provider-b",
+					TrustScore:                decimal.NewFromInt(2),
+					SupportedInstitutionTypes: []string{"bank"},
+					Tokens: []types.MatchingSnapshotProviderToken{{
+						Symbol:                 "USDT",
+						Currency:               "NGN",
+						ConversionRateType:     "floating",
+						FloatingConversionRate: decimal.NewFromFloat(0.2),
+						MinOrderAmount:         decimal.NewFromInt(1),
+						MaxOrderAmount:         decimal.NewFromInt(1000),
+					}},
+				},
+			},
+			Buckets: []types.MatchingSnapshotBucket{{
+				Currency:  "NGN",
+				MinAmount: decimal.NewFromInt(1),
+				MaxAmount: decimal.NewFromInt(2000000),
+				Providers: []string{"provider-a", "provider-b"},
+			}},
+			Orders: []types.MatchingSnapshotOrder{
+				{Token: "USDT", Amount: decimal.NewFromInt(20), Rate: decimal.NewFromInt(1500), Institution: "GTBINGLA", ProviderID: "provider-a", CreatedAt: time.Now().Add(-4 * time.Hour)},
+				{Token: "USDT", Amount: decimal.NewFromInt(30), Rate: decimal.NewFromInt(1500), Institution: "OPAYNGPC", ProviderID: "provider-a", CreatedAt: time.Now().Add(-3 * time.Hour)},
+				{Token: "USDT", Amount: decimal.NewFromInt(800), Rate: decimal.NewFromInt(1500), Institution: "GTBINGLA", CreatedAt: time.Now().Add(-2 * time.Hour)},
+				{Token: "USDT", Amount: decimal.NewFromInt(2000), Rate: decimal.NewFromInt(1500), Institution: "GTBINGLA", CreatedAt: time.Now().Add(-1 * time.Hour)},
+			},
+		}
+
+		simulator := NewMatchingSimulator()
+		err := simulator.LoadSnapshot(ctx, client, snapshot)
+		assert.NoError(t, err)
+
+		report, err := simulator.Run(ctx, MatchingSimulationOptions{Strategy: MatchingStrategyPriceTime})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 4, report.Orders)
+		assert.Equal(t, 3, report.Matched)
+		assert.Equal(t, 1, report.Unmatched)
+		assert.Equal(t, 1, report.Reassigned)
+		assert.Equal(t, 3, report.Strategies[MatchingStrategyPriceTime])
+		assert.Equal(t, unmatchedReasonNoBucket, report.UnmatchedOrders[0].Reason)
+
+		assert.Equal(t, 2, report.Rates.AboveOrderRate)
+		assert.Equal(t, 1, report.Rates.AtOrderRate)
+		assert.True(t, report.Rates.MaxSpread.Equal(decimal.NewFromFloat(0.2)))
+
+		assert.Len(t, report.Providers, 2)
+		assert.Equal(t, "provider-b", report.Providers[0].ProviderID)
+		assert.Equal(t, 2, report.Providers[0].Orders)
+		assert.True(t, report.Providers[0].Volume.Equal(decimal.NewFromInt(820)))
+		assert.Equal(t, "provider-a", report.Providers[1].ProviderID)
+		assert.Equal(t, 1, report.Providers[1].Orders)
+		assert.Equal(t, 2, report.Providers[1].HistoricalOrders)
+
+		report, err = simulator.Run(ctx, MatchingSimulationOptions{Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Orders)
+
+		_, err = simulator.Run(ctx, MatchingSimulationOptions{Strategy: "unknown"})
+		assert.Error(t, err)
+	})
+}
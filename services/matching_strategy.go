@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerrating"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// Strategies for matching orders to the providers in a bucket
+const (
+	MatchingStrategyPriceTime     = "price_time"
+	MatchingStrategyRoundRobin    = "round_robin"
+	MatchingStrategyTrustWeighted = "trust_weighted"
+	MatchingStrategyLowestLatency = "lowest_latency"
+)
+
+// MatchingStrategies lists the available matching strategies
+var MatchingStrategies = []string{
+	MatchingStrategyPriceTime,
+	MatchingStrategyRoundRobin,
+	MatchingStrategyTrustWeighted,
+	MatchingStrategyLowestLatency,
+}
+
+// TODO: make the slippage of 0.5 configurable by provider
+var matchingRateTolerance = decimal.NewFromFloat(0.5)
+
+// OrderBookStore stores the order books and circular queues orders are matched against
+type OrderBookStore interface {
+	// Match returns the first entry within tolerance of the order rate in price-time priority accepted by the filter
+	Match(ctx context.Context, book string, rate, tolerance decimal.Decimal, accept func(BookEntry) bool) (*BookEntry, error)
+
+	// Candidates returns all entries within tolerance of the order rate accepted by the filter, in price-time priority
+	Candidates(ctx context.Context, book string, rate, tolerance decimal.Decimal, accept func(BookEntry) bool) ([]BookEntry, error)
+
+	// RecordAssignment moves a provider to the back of its rate level
+	RecordAssignment(ctx context.Context, book string, entry *BookEntry) error
+
+	// QueueEntry returns the entry at an index of a circular queue, and false past the end of the queue
+	QueueEntry(ctx context.Context, queue string, index int) (string, bool, error)
+
+	// RotateQueue moves the entry at the head of a circular queue to its back if it is the given entry
+	RotateQueue(ctx context.Context, queue string, data string) error
+}
+
+// MatchingStrategy selects the provider in a bucket an order is assigned to
+type MatchingStrategy interface {
+	// Name returns the name the strategy is configured with
+	Name() string
+
+	// Match returns the entry of the provider to assign the order to, or nil if no provider matches.
+	// Only entries accepted by the given filter may be returned.
+	Match(ctx context.Context, order types.LockPaymentOrderFields, accept func(BookEntry) bool) (*BookEntry, error)
+
+	// RecordAssignment records that the order was assigned to the matched entry
+	RecordAssignment(ctx context.Context, order types.LockPaymentOrderFields, entry *BookEntry) error
+}
+
+// NewMatchingStrategy creates the matching strategy with the given name, matching against the given store
+func NewMatchingStrategy(name string, store OrderBookStore) (MatchingStrategy, error) {
+	return newMatchingStrategy(name, store, rand.New(rand.NewSource(time.Now().UnixNano())))
+}
+
+// newMatchingStrategy creates the matching strategy with the given name, drawing random numbers from rng
+func newMatchingStrategy(name string, store OrderBookStore, rng *rand.Rand) (MatchingStrategy, error) {
+	switch name {
+	case MatchingStrategyPriceTime:
+		return &priceTimeStrategy{store: store}, nil
+	case MatchingStrategyRoundRobin:
+		return &roundRobinStrategy{store: store}, nil
+	case MatchingStrategyTrustWeighted:
+		return &trustWeightedStrategy{store: store, rng: rng}, nil
+	case MatchingStrategyLowestLatency:
+		return &lowestLatencyStrategy{store: store}, nil
+	default:
+		return nil, fmt.Errorf("unknown matching strategy: %s", name)
+	}
+}
+
+// orderBook returns the key of the order book an order is matched against
+func orderBook(order types.LockPaymentOrderFields) string {
+	return BookKey(order.ProvisionBucket.Edges.Currency.Code, order.Token.Symbol, order.ProvisionBucket.MinAmount, order.ProvisionBucket.MaxAmount)
+}
+
+// priceTimeStrategy assigns orders to the provider quoting the best rate within tolerance,
+// rotating among providers quoting the same rate
+type priceTimeStrategy struct {
+	store OrderBookStore
+}
+
+func (m *priceTimeStrategy) Name() string {
+	return MatchingStrategyPriceTime
+}
+
+func (m *priceTimeStrategy) Match(ctx context.Context, order types.LockPaymentOrderFields, accept func(BookEntry) bool) (*BookEntry, error) {
+	return m.store.Match(ctx, orderBook(order), order.Rate, matchingRateTolerance, accept)
+}
+
+func (m *priceTimeStrategy) RecordAssignment(ctx context.Context, order types.LockPaymentOrderFields, entry *BookEntry) error {
+	return m.store.RecordAssignment(ctx, orderBook(order), entry)
+}
+
+// roundRobinStrategy assigns orders to the first provider within tolerance in the bucket's circular queue,
+// moving the provider at the head of the queue to the back when it is assigned
+type roundRobinStrategy struct {
+	store OrderBookStore
+}
+
+func (m *roundRobinStrategy) Name() string {
+	return MatchingStrategyRoundRobin
+}
+
+func (m *roundRobinStrategy) Match(ctx context.Context, order types.LockPaymentOrderFields, accept func(BookEntry) bool) (*BookEntry, error) {
+	queue := bucketQueueKey(order.ProvisionBucket)
+
+	for index := 0; ; index++ {
+		providerData, ok, err := m.store.QueueEntry(ctx, queue, index)
+		if err != nil {
+			return nil, fmt.Errorf("Match: %w", err)
+		}
+
+		if !ok {
+			return nil, nil
+		}
+
+		// Extract the entry from the data (in the format "providerID:token:rate:minAmount:maxAmount")
+		parts := strings.Split(providerData, ":")
+		if len(parts) != 5 {
+			continue // Skip this entry due to invalid format
+		}
+
+		// Skip entry if token doesn't match
+		if parts[1] != order.Token.Symbol {
+			continue
+		}
+
+		entry, err := decodeBookEntry(fmt.Sprintf("%s:%s:%s", parts[0], parts[3], parts[4]), parts[2])
+		if err != nil {
+			continue
+		}
+
+		if entry.Rate.Sub(order.Rate).Abs().GreaterThan(matchingRateTolerance) {
+			continue
+		}
+
+		if accept(entry) {
+			return &entry, nil
+		}
+	}
+}
+
+func (m *roundRobinStrategy) RecordAssignment(ctx context.Context, order types.LockPaymentOrderFields, entry *BookEntry) error {
+	data := fmt.Sprintf("%s:%s:%s:%s:%s", entry.ProviderID, order.Token.Symbol, entry.Rate, entry.MinOrderAmount, entry.MaxOrderAmount)
+	return m.store.RotateQueue(ctx, bucketQueueKey(order.ProvisionBucket), data)
+}
+
+// trustWeightedStrategy assigns orders to a random provider within tolerance, weighted by trust score
+type trustWeightedStrategy struct {
+	store OrderBookStore
+	rng   *rand.Rand
+}
+
+func (m *trustWeightedStrategy) Name() string {
+	return MatchingStrategyTrustWeighted
+}
+
+func (m *trustWeightedStrategy) Match(ctx context.Context, order types.LockPaymentOrderFields, accept func(BookEntry) bool) (*BookEntry, error) {
+	candidates, err := m.store.Candidates(ctx, orderBook(order), order.Rate, matchingRateTolerance, accept)
+	if err != nil {
+		return nil, fmt.Errorf("Match: %w", err)
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	ratings, err := storage.Client.ProviderRating.
+		Query().
+		Where(providerrating.HasProviderProfileWith(providerprofile.IDIn(bookEntryProviderIDs(candidates)...))).
+		WithProviderProfile(func(ppq *ent.ProviderProfileQuery) {
+			ppq.Select(providerprofile.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Match.ratings: %w", err)
+	}
+
+	trustScores := map[string]decimal.Decimal{}
+	for _, rating := range ratings {
+		trustScores[rating.Edges.ProviderProfile.ID] = rating.TrustScore
+	}
+
+	// Every candidate gets a minimum weight so unrated providers can still be picked
+	minWeight := decimal.NewFromInt(1)
+	weights := make([]decimal.Decimal, len(candidates))
+	total := decimal.Zero
+	for i, candidate := range candidates {
+		weights[i] = decimal.Max(trustScores[candidate.ProviderID], decimal.Zero).Add(minWeight)
+		total = total.Add(weights[i])
+	}
+
+	pick := decimal.NewFromFloat(m.rng.Float64()).Mul(total)
+	for i, weight := range weights {
+		if pick.LessThan(weight) {
+			return &candidates[i], nil
+		}
+		pick = pick.Sub(weight)
+	}
+
+	return &candidates[len(candidates)-1], nil
+}
+
+func (m *trustWeightedStrategy) RecordAssignment(ctx context.Context, order types.LockPaymentOrderFields, entry *BookEntry) error {
+	return m.store.RecordAssignment(ctx, orderBook(order), entry)
+}
+
+// lowestLatencyStrategy assigns orders to the provider within tolerance whose node responded fastest to its
+// latest health check, falling back to price-time priority among equally fast providers
+type lowestLatencyStrategy struct {
+	store OrderBookStore
+}
+
+func (m *lowestLatencyStrategy) Name() string {
+	return MatchingStrategyLowestLatency
+}
+
+func (m *lowestLatencyStrategy) Match(ctx context.Context, order types.LockPaymentOrderFields, accept func(BookEntry) bool) (*BookEntry, error) {
+	candidates, err := m.store.Candidates(ctx, orderBook(order), order.Rate, matchingRateTolerance, accept)
+	if err != nil {
+		return nil, fmt.Errorf("Match: %w", err)
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// Only recent health checks reflect the current latency of a node
+	checks, err := storage.Client.ProviderHealthCheck.
+		Query().
+		Where(
+			providerhealthcheck.HasProviderWith(providerprofile.IDIn(bookEntryProviderIDs(candidates)...)),
+			providerhealthcheck.IsHealthy(true),
+			providerhealthcheck.CreatedAtGT(time.Now().Add(-time.Hour)),
+		).
+		WithProvider(func(ppq *ent.ProviderProfileQuery) {
+			ppq.Select(providerprofile.FieldID)
+		}).
+		Order(ent.Desc(providerhealthcheck.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Match.healthChecks: %w", err)
+	}
+
+	latencies := map[string]int64{}
+	for _, check := range checks {
+		if _, ok := latencies[check.Edges.Provider.ID]; !ok {
+			latencies[check.Edges.Provider.ID] = check.LatencyMs
+		}
+	}
+
+	var match *BookEntry
+	var matchLatency int64
+	for i, candidate := range candidates {
+		latency, ok := latencies[candidate.ProviderID]
+		if !ok {
+			continue
+		}
+
+		if match == nil || latency < matchLatency {
+			match = &candidates[i]
+			matchLatency = latency
+		}
+	}
+
+	// Providers without a recent health check are only picked when no other provider has one
+	if match == nil {
+		match = &candidates[0]
+	}
+
+	return match, nil
+}
+
+func (m *lowestLatencyStrategy) RecordAssignment(ctx context.Context, order types.LockPaymentOrderFields, entry *BookEntry) error {
+	return m.store.RecordAssignment(ctx, orderBook(order), entry)
+}
+
+// bookEntryProviderIDs returns the IDs of the providers of the given entries
+func bookEntryProviderIDs(entries []BookEntry) []string {
+	providerIDs := make([]string, len(entries))
+	for i, entry := range entries {
+		providerIDs[i] = entry.ProviderID
+	}
+	return providerIDs
+}
+
+// matchingMetricsKey returns the Redis key of the metrics of a matching strategy
+func matchingMetricsKey(strategy string) string {
+	return fmt.Sprintf("matching_metrics_%s", strategy)
+}
+
+// recordMatchingMetric increments a metric of a matching strategy
+func recordMatchingMetric(ctx context.Context, strategy string, metric string, value int64) {
+	err := storage.RedisClient.HIncrBy(ctx, matchingMetricsKey(strategy), metric, value).Err()
+	if err != nil {
+		logger.Errorf("failed to record %s metric of %s matching strategy: %v", metric, strategy, err)
+	}
+}
+
+// GetMatchingMetrics returns the metrics of each matching strategy
+func (s *PriorityQueueService) GetMatchingMetrics(ctx context.Context) ([]types.MatchingStrategyMetrics, error) {
+	metrics := make([]types.MatchingStrategyMetrics, 0, len(MatchingStrategies))
+
+	for _, strategy := range MatchingStrategies {
+		var counts struct {
+			Attempts     int64 `redis:"attempts"`
+			Matches      int64 `redis:"matches"`
+			Unmatched    int64 `redis:"unmatched"`
+			SendFailures int64 `redis:"send_failures"`
+			MatchTimeMs  int64 `redis:"match_time_ms"`
+		}
+
+		err := storage.RedisClient.HGetAll(ctx, matchingMetricsKey(strategy)).Scan(&counts)
+		if err != nil {
+			return nil, fmt.Errorf("GetMatchingMetrics: %w", err)
+		}
+
+		strategyMetrics := types.MatchingStrategyMetrics{
+			Strategy:     strategy,
+			Attempts:     counts.Attempts,
+			Matches:      counts.Matches,
+			Unmatched:    counts.Unmatched,
+			SendFailures: counts.SendFailures,
+			MatchRate:    decimal.Zero,
+		}
+
+		if counts.Attempts > 0 {
+			strategyMetrics.MatchRate = decimal.NewFromInt(counts.Matches).Div(decimal.NewFromInt(counts.Attempts)).Round(4)
+			strategyMetrics.AverageMatchTimeMs = decimal.NewFromInt(counts.MatchTimeMs).Div(decimal.NewFromInt(counts.Attempts)).Round(2)
+		}
+
+		metrics = append(metrics, strategyMetrics)
+	}
+
+	return metrics, nil
+}
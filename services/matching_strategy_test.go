@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/enttest"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchingStrategies(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:matchingstrategies?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	createProvider := func(email string) string {
+		user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider", "email": email})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+		return provider.ID
+	}
+	first := createProvider("first@test.com")
+	second := createProvider("second@test.com")
+	third := createProvider("third@test.com")
+
+	bucket := &ent.ProvisionBucket{
+		MinAmount: decimal.NewFromInt(1),
+		MaxAmount: decimal.NewFromInt(1000),
+		Edges:     ent.ProvisionBucketEdges{Currency: currency},
+	}
+	order := types.LockPaymentOrderFields{
+		Token:           &ent.Token{Symbol: "USDT"},
+		Rate:            decimal.NewFromInt(950),
+		ProvisionBucket: bucket,
+	}
+
+	newEntry := func(providerID string, rate float64) BookEntry {
+		return BookEntry{
+			ProviderID:     providerID,
+			Rate:           decimal.NewFromFloat(rate),
+			MinOrderAmount: decimal.NewFromInt(1),
+			MaxOrderAmount: decimal.NewFromInt(100),
+		}
+	}
+
+	// Every provider quotes within tolerance of the order rate except the third
+	newStore := func() *memoryOrderBookStore {
+		store := newMemoryOrderBookStore()
+		store.load(currency.Code, bucket.MinAmount, bucket.MaxAmount, bucketQueueKey(bucket), []string{
+			third + ":USDT:960:1:100",
+			first + ":CNGN:950:1:100",
+			"invalid",
+			first + ":USDT:950.2:1:100",
+			second + ":USDT:950:1:100",
+		}, map[string][]BookEntry{
+			"USDT": {newEntry(first, 950.2), newEntry(second, 950), newEntry(third, 960)},
+		})
+		return store
+	}
+
+	acceptAll := func(BookEntry) bool { return true }
+
+	t.Run("round_robin matches the first provider within tolerance in the queue", func(t *testing.T) {
+		store := newStore()
+		strategy, err := newMatchingStrategy(MatchingStrategyRoundRobin, store, nil)
+		assert.NoError(t, err)
+
+		// Entries of other tokens, malformed entries and rates out of tolerance are skipped
+		entry, err := strategy.Match(ctx, order, acceptAll)
+		assert.NoError(t, err)
+		assert.Equal(t, first, entry.ProviderID)
+		assert.True(t, entry.Rate.Equal(decimal.NewFromFloat(950.2)))
+
+		entry, err = strategy.Match(ctx, order, func(entry BookEntry) bool { return entry.ProviderID != first })
+		assert.NoError(t, err)
+		assert.Equal(t, second, entry.ProviderID)
+
+		entry, err = strategy.Match(ctx, order, func(BookEntry) bool { return false })
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+
+		// Assigning the provider at the head of the queue moves it to the back
+		store.queues[bucketQueueKey(bucket)] = []string{first + ":USDT:950.2:1:100", second + ":USDT:950:1:100"}
+		entry, _ = strategy.Match(ctx, order, acceptAll)
+		assert.NoError(t, strategy.RecordAssignment(ctx, order, entry))
+		assert.Equal(t, []string{second + ":USDT:950:1:100", first + ":USDT:950.2:1:100"}, store.queues[bucketQueueKey(bucket)])
+
+		entry, _ = strategy.Match(ctx, order, acceptAll)
+		assert.Equal(t, second, entry.ProviderID)
+	})
+
+	t.Run("trust_weighted favours the most trusted provider within tolerance", func(t *testing.T) {
+		for providerID, trustScore := range map[string]int64{first: 18, third: 100} {
+			_, err := client.ProviderRating.
+				Create().
+				SetProviderProfileID(providerID).
+				SetTrustScore(decimal.NewFromInt(trustScore)).
+				Save(ctx)
+			assert.NoError(t, err)
+		}
+
+		strategy, err := newMatchingStrategy(MatchingStrategyTrustWeighted, newStore(), rand.New(rand.NewSource(1)))
+		assert.NoError(t, err)
+
+		// The first provider weighs 19 against 1 for the unrated second one; the third is out of tolerance
+		matches := map[string]int{}
+		for i := 0; i < 200; i++ {
+			entry, err := strategy.Match(ctx, order, acceptAll)
+			assert.NoError(t, err)
+			matches[entry.ProviderID]++
+		}
+		assert.Zero(t, matches[third])
+		assert.Greater(t, matches[second], 0)
+		assert.Greater(t, matches[first], 8*matches[second])
+
+		entry, err := strategy.Match(ctx, order, func(entry BookEntry) bool { return entry.ProviderID == second })
+		assert.NoError(t, err)
+		assert.Equal(t, second, entry.ProviderID)
+
+		entry, err = strategy.Match(ctx, order, func(BookEntry) bool { return false })
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("lowest_latency matches the provider with the fastest recent healthy check", func(t *testing.T) {
+		strategy, err := newMatchingStrategy(MatchingStrategyLowestLatency, newStore(), nil)
+		assert.NoError(t, err)
+
+		// Without health checks, price-time priority decides
+		entry, err := strategy.Match(ctx, order, acceptAll)
+		assert.NoError(t, err)
+		assert.Equal(t, first, entry.ProviderID)
+
+		checks := []struct {
+			providerID string
+			healthy    bool
+			latencyMs  int64
+			age        time.Duration
+		}{
+			{first, true, 400, time.Minute},
+			{first, true, 20, 2 * time.Hour},
+			{second, true, 300, 10 * time.Minute},
+			{second, true, 150, time.Minute},
+			{second, false, 10, 30 * time.Second},
+			{third, true, 5, time.Minute},
+		}
+		for _, check := range checks {
+			_, err := client.ProviderHealthCheck.
+				Create().
+				SetProviderID(check.providerID).
+				SetIsHealthy(check.healthy).
+				SetLatencyMs(check.latencyMs).
+				SetCreatedAt(time.Now().Add(-check.age)).
+				Save(ctx)
+			assert.NoError(t, err)
+		}
+
+		// Stale and unhealthy checks are ignored, and the latest healthy check of each provider counts
+		entry, err = strategy.Match(ctx, order, acceptAll)
+		assert.NoError(t, err)
+		assert.Equal(t, second, entry.ProviderID)
+
+		entry, err = strategy.Match(ctx, order, func(entry BookEntry) bool { return entry.ProviderID != second })
+		assert.NoError(t, err)
+		assert.Equal(t, first, entry.ProviderID)
+	})
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// memoryBookEntry is a provider's quote in an in-memory order book, with its time priority
+type memoryBookEntry struct {
+	entry    BookEntry
+	priority float64
+}
+
+// memoryOrderBookStore keeps order books and circular queues in memory, ordered the same way as the
+// Redis ones of MatchingEngine. Assignment times are taken from a logical clock so replays are repeatable.
+type memoryOrderBookStore struct {
+	books  map[string][]*memoryBookEntry
+	queues map[string][]string
+	clock  int64
+}
+
+// newMemoryOrderBookStore creates an empty in-memory order book store
+func newMemoryOrderBookStore() *memoryOrderBookStore {
+	return &memoryOrderBookStore{
+		books:  map[string][]*memoryBookEntry{},
+		queues: map[string][]string{},
+	}
+}
+
+// load replaces the circular queue and order books of a bucket with the given entries, keyed by token.
+// Pinned providers are put ahead of the rest and demoted providers behind them, as when the Redis books are rebuilt.
+func (m *memoryOrderBookStore) load(currency string, minAmount, maxAmount decimal.Decimal, queue string, queueEntries []string, entries map[string][]BookEntry) {
+	m.queues[queue] = append([]string{}, queueEntries...)
+
+	for token, tokenEntries := range entries {
+		book := BookKey(currency, token, minAmount, maxAmount)
+		m.books[book] = nil
+
+		for _, entry := range tokenEntries {
+			m.books[book] = append(m.books[book], &memoryBookEntry{entry: entry, priority: bookEntryPriority(entry, 0)})
+		}
+	}
+}
+
+func (m *memoryOrderBookStore) Match(ctx context.Context, book string, rate, tolerance decimal.Decimal, accept func(BookEntry) bool) (*BookEntry, error) {
+	for _, entry := range m.scan(book, rate, tolerance) {
+		if accept(entry.entry) {
+			match := entry.entry
+			return &match, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (m *memoryOrderBookStore) Candidates(ctx context.Context, book string, rate, tolerance decimal.Decimal, accept func(BookEntry) bool) ([]BookEntry, error) {
+	candidates := []BookEntry{}
+	for _, entry := range m.scan(book, rate, tolerance) {
+		if accept(entry.entry) {
+			candidates = append(candidates, entry.entry)
+		}
+	}
+
+	return candidates, nil
+}
+
+// scan returns the entries within tolerance of the order rate in price-time priority.
+// Ties in time priority are broken by the encoded entry, as Redis does for equal scores.
+func (m *memoryOrderBookStore) scan(book string, rate, tolerance decimal.Decimal) []*memoryBookEntry {
+	entries := []*memoryBookEntry{}
+	for _, entry := range m.books[book] {
+		if entry.entry.Rate.Sub(rate).Abs().LessThanOrEqual(tolerance) {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].entry.Rate.Equal(entries[j].entry.Rate) {
+			return entries[i].entry.Rate.GreaterThan(entries[j].entry.Rate)
+		}
+		if entries[i].priority != entries[j].priority {
+			return entries[i].priority < entries[j].priority
+		}
+		return encodeBookEntry(entries[i].entry) < encodeBookEntry(entries[j].entry)
+	})
+
+	return entries
+}
+
+func (m *memoryOrderBookStore) RecordAssignment(ctx context.Context, book string, entry *BookEntry) error {
+	m.clock++
+
+	for _, bookEntry := range m.books[book] {
+		if bookEntry.entry.Rate.Equal(entry.Rate) && encodeBookEntry(bookEntry.entry) == encodeBookEntry(*entry) {
+			bookEntry.priority = bookEntryPriority(bookEntry.entry, m.clock)
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryOrderBookStore) QueueEntry(ctx context.Context, queue string, index int) (string, bool, error) {
+	if index >= len(m.queues[queue]) {
+		return "", false, nil
+	}
+
+	return m.queues[queue][index], true, nil
+}
+
+func (m *memoryOrderBookStore) RotateQueue(ctx context.Context, queue string, data string) error {
+	entries := m.queues[queue]
+	if len(entries) == 0 || entries[0] != data {
+		return nil
+	}
+
+	m.queues[queue] = append(entries[1:], entries[0])
+	return nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/utils"
+)
+
+// Provider node protocol versions
+const (
+	// NodeProtocolV1 nodes receive order requests with the order ID, amount and institution.
+	// Nodes that don't declare a protocol version are treated as v1.
+	NodeProtocolV1 = 1
+	// NodeProtocolV2 nodes also receive the token, rate, currency and expiry of order requests
+	NodeProtocolV2 = 2
+)
+
+// Provider node capabilities
+const (
+	// NodeCapabilityPullDelivery nodes fetch their order requests instead of having them pushed to /new_order
+	NodeCapabilityPullDelivery = "pull_delivery"
+	// NodeCapabilityBatchFulfillment nodes may use the batch accept, decline and fulfill endpoints
+	NodeCapabilityBatchFulfillment = "batch_fulfillment"
+)
+
+// ParseNodeHandshake extracts the protocol version and capabilities declared in a node's health check data
+func ParseNodeHandshake(data map[string]interface{}) (int, []string) {
+	version := NodeProtocolV1
+	capabilities := []string{}
+
+	nodeInfo, ok := data["data"].(map[string]interface{})
+	if !ok {
+		return version, capabilities
+	}
+
+	// JSON numbers are decoded as float64
+	if v, ok := nodeInfo["protocolVersion"].(float64); ok && v >= NodeProtocolV1 {
+		version = int(v)
+	}
+
+	if c, ok := nodeInfo["capabilities"].([]interface{}); ok {
+		for _, capability := range c {
+			name := fmt.Sprintf("%v", capability)
+			if !utils.ContainsString(capabilities, name) {
+				capabilities = append(capabilities, name)
+			}
+		}
+	}
+
+	return version, capabilities
+}
+
+// IsNodeCompatible checks whether a provider's node speaks a protocol version the aggregator still supports
+func IsNodeCompatible(provider *ent.ProviderProfile) bool {
+	return provider.NodeProtocolVersion >= orderConf.NodeMinProtocolVersion
+}
+
+// NodeSupports checks whether a provider's node declared a capability
+func NodeSupports(provider *ent.ProviderProfile, capability string) bool {
+	return utils.ContainsString(provider.NodeCapabilities, capability)
+}
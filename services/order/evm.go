@@ -16,10 +16,12 @@ import (
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/services"
 	"github.com/paycrest/aggregator/services/contracts"
 	db "github.com/paycrest/aggregator/storage"
 	"github.com/shopspring/decimal"
 
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
@@ -82,7 +84,12 @@ func (s *OrderEVM) CreateOrder(ctx context.Context, client types.RPCClient, orde
 			return fmt.Errorf("%s - CreateOrder.fetchInstitution: %w", orderIDPrefix, err)
 		}
 
-		rate, err := utils.GetTokenRateFromQueue(order.Edges.Token.Symbol, order.Amount, institution.Edges.FiatCurrency.Code, institution.Edges.FiatCurrency.MarketRate)
+		marketRate, err := services.NewMarketRateService().GetRate(ctx, institution.Edges.FiatCurrency, order.Edges.Token.Symbol)
+		if err != nil {
+			return fmt.Errorf("%s - CreateOrder.getMarketRate: %w", orderIDPrefix, err)
+		}
+
+		rate, err := utils.GetTokenRateFromQueue(order.Edges.Token.Symbol, order.Amount, institution.Edges.FiatCurrency.Code, marketRate)
 		if err != nil {
 			return fmt.Errorf("%s - CreateOrder.getRate: %w", orderIDPrefix, err)
 		}
@@ -736,6 +743,9 @@ func (s *OrderEVM) settleCallData(ctx context.Context, order *ent.LockPaymentOrd
 			providerordertoken.HasProviderWith(
 				providerprofile.IDEQ(order.Edges.Provider.ID),
 			),
+			providerordertoken.HasCurrencyWith(
+				fiatcurrency.HasInstitutionsWith(institution.CodeEQ(order.Institution)),
+			),
 		).
 		Only(ctx)
 	if err != nil {
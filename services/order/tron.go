@@ -28,6 +28,8 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorder"
@@ -556,6 +558,9 @@ func (s *OrderTron) settleCallData(ctx context.Context, order *ent.LockPaymentOr
 			providerordertoken.HasProviderWith(
 				providerprofile.IDEQ(order.Edges.Provider.ID),
 			),
+			providerordertoken.HasCurrencyWith(
+				fiatcurrency.HasInstitutionsWith(institution.CodeEQ(order.Institution)),
+			),
 		).
 		Only(ctx)
 	if err != nil {
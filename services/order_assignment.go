@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// orderAssignmentRecoveryWindow bounds how long ago an order request may have lapsed for the recovery sweep to repair it
+const orderAssignmentRecoveryWindow = 24 * time.Hour
+
+// OrderAssignmentService keeps the durable history of order requests sent to providers and their outcomes.
+// Order requests and exclude lists in Redis are a cache of this history.
+type OrderAssignmentService struct{}
+
+// NewOrderAssignmentService creates a new instance of OrderAssignmentService
+func NewOrderAssignmentService() *OrderAssignmentService {
+	return &OrderAssignmentService{}
+}
+
+// RecordOffer records that an order request was sent to a provider
+func (s *OrderAssignmentService) RecordOffer(ctx context.Context, orderID uuid.UUID, providerID string, expiresAt time.Time) error {
+	err := storage.Client.OrderAssignment.
+		Create().
+		SetLockPaymentOrderID(orderID).
+		SetProviderID(providerID).
+		SetEvent(orderassignment.EventOffered).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordOffer: %w", err)
+	}
+
+	return nil
+}
+
+// RecordEvent records the outcome of an order request for providers
+func (s *OrderAssignmentService) RecordEvent(ctx context.Context, orderID uuid.UUID, event orderassignment.Event, providerIDs ...string) error {
+	if len(providerIDs) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.OrderAssignmentCreate, 0, len(providerIDs))
+	for _, providerID := range providerIDs {
+		builders = append(builders, storage.Client.OrderAssignment.
+			Create().
+			SetLockPaymentOrderID(orderID).
+			SetProviderID(providerID).
+			SetEvent(event))
+	}
+
+	if err := storage.Client.OrderAssignment.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("RecordEvent: %w", err)
+	}
+
+	return nil
+}
+
+// Exclude keeps a provider from being sent an order again.
+// The exclusion is recorded in the database before it is cached in the order's exclude list in Redis.
+func (s *OrderAssignmentService) Exclude(ctx context.Context, orderID uuid.UUID, providerID string) error {
+	if err := s.RecordEvent(ctx, orderID, orderassignment.EventExcluded, providerID); err != nil {
+		return fmt.Errorf("Exclude: %w", err)
+	}
+
+	err := storage.RedisClient.RPush(ctx, fmt.Sprintf("order_exclude_list_%s", orderID), providerID).Err()
+	if err != nil {
+		return fmt.Errorf("Exclude.cache: %w", err)
+	}
+
+	return nil
+}
+
+// ExcludedProviders returns the providers excluded from an order.
+// They are read from Redis, and from the database when Redis has none, in which case the cache is filled again.
+func (s *OrderAssignmentService) ExcludedProviders(ctx context.Context, orderID uuid.UUID) ([]string, error) {
+	excludeKey := fmt.Sprintf("order_exclude_list_%s", orderID)
+
+	excludeList, err := storage.RedisClient.LRange(ctx, excludeKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("ExcludedProviders.cache: %w", err)
+	}
+
+	if len(excludeList) > 0 {
+		return excludeList, nil
+	}
+
+	excludeList, err = storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.HasOrderAssignmentsWith(
+			orderassignment.EventEQ(orderassignment.EventExcluded),
+			orderassignment.HasLockPaymentOrderWith(lockpaymentorder.IDEQ(orderID)),
+		)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ExcludedProviders: %w", err)
+	}
+
+	if len(excludeList) > 0 {
+		if err := storage.RedisClient.RPush(ctx, excludeKey, redisMembers(excludeList)...).Err(); err != nil {
+			logger.Errorf("failed to cache exclude list of order %s: %v", orderID, err)
+		}
+	}
+
+	return excludeList, nil
+}
+
+// ExpireOffers handles order requests of an order that lapsed without an answer.
+// The providers that let them lapse are recorded as timed out and the order is assigned again.
+func (s *OrderAssignmentService) ExpireOffers(ctx context.Context, orderID uuid.UUID) error {
+	providerIDs, err := s.openOffers(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("ExpireOffers: %w", err)
+	}
+
+	if err := s.RecordEvent(ctx, orderID, orderassignment.EventTimedOut, providerIDs...); err != nil {
+		return fmt.Errorf("ExpireOffers: %w", err)
+	}
+
+	// The providers let the order request expire without accepting or declining it
+	if err := NewProviderSLAService().RecordAcceptTimeout(ctx, orderID); err != nil {
+		logger.Errorf("ExpireOffers.RecordAcceptTimeout: %v", err)
+	}
+
+	order, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(orderID)).
+		WithToken().
+		WithProvisionBucket(func(pbq *ent.ProvisionBucketQuery) {
+			pbq.WithCurrency()
+		}).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("ExpireOffers.order: %w", err)
+	}
+
+	if order.Status != lockpaymentorder.StatusPending {
+		return nil
+	}
+
+	// Assign the order to a provider
+	if err := NewPriorityQueueService().AssignLockPaymentOrder(ctx, lockPaymentOrderFields(order)); err != nil {
+		return fmt.Errorf("ExpireOffers.AssignLockPaymentOrder: %w", err)
+	}
+
+	return nil
+}
+
+// RecoverMissedExpiries finds pending orders whose order requests lapsed without their expiry being handled,
+// as when Redis expiry events are dropped while nothing is subscribed to them, and expires them.
+// It returns the number of orders repaired.
+func (s *OrderAssignmentService) RecoverMissedExpiries(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	orderIDs, err := storage.Client.LockPaymentOrder.
+		Query().
+		Where(
+			lockpaymentorder.StatusEQ(lockpaymentorder.StatusPending),
+			lockpaymentorder.HasAssignmentsWith(
+				orderassignment.EventEQ(orderassignment.EventOffered),
+				orderassignment.ExpiresAtLT(now),
+				orderassignment.ExpiresAtGT(now.Add(-orderAssignmentRecoveryWindow)),
+			),
+		).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("RecoverMissedExpiries: %w", err)
+	}
+
+	recovered := 0
+	for _, orderID := range orderIDs {
+		providerIDs, err := s.openOffers(ctx, orderID)
+		if err != nil {
+			return recovered, fmt.Errorf("RecoverMissedExpiries: %w", err)
+		}
+
+		if len(providerIDs) == 0 {
+			continue
+		}
+
+		// The order was sent a new order request that is still live
+		exists, err := storage.RedisClient.Exists(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
+		if err != nil {
+			return recovered, fmt.Errorf("RecoverMissedExpiries.cache: %w", err)
+		}
+
+		if exists > 0 {
+			continue
+		}
+
+		if err := s.ExpireOffers(ctx, orderID); err != nil {
+			logger.Errorf("RecoverMissedExpiries: %v", err)
+			continue
+		}
+		recovered++
+	}
+
+	return recovered, nil
+}
+
+// GetHistory returns the assignment history of an order, oldest first
+func (s *OrderAssignmentService) GetHistory(ctx context.Context, orderID uuid.UUID) ([]*types.OrderAssignmentResponse, error) {
+	events, err := storage.Client.OrderAssignment.
+		Query().
+		Where(orderassignment.HasLockPaymentOrderWith(lockpaymentorder.IDEQ(orderID))).
+		WithProvider().
+		Order(ent.Asc(orderassignment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetHistory: %w", err)
+	}
+
+	history := make([]*types.OrderAssignmentResponse, 0, len(events))
+	for _, event := range events {
+		response := &types.OrderAssignmentResponse{
+			ProviderID: event.Edges.Provider.ID,
+			Event:      string(event.Event),
+			CreatedAt:  event.CreatedAt,
+		}
+		if !event.ExpiresAt.IsZero() {
+			response.ExpiresAt = &event.ExpiresAt
+		}
+		history = append(history, response)
+	}
+
+	return history, nil
+}
+
+// openOffers returns the providers whose last order request for an order is unanswered
+func (s *OrderAssignmentService) openOffers(ctx context.Context, orderID uuid.UUID) ([]string, error) {
+	events, err := storage.Client.OrderAssignment.
+		Query().
+		Where(orderassignment.HasLockPaymentOrderWith(lockpaymentorder.IDEQ(orderID))).
+		WithProvider(func(ppq *ent.ProviderProfileQuery) {
+			ppq.Select(providerprofile.FieldID)
+		}).
+		Order(ent.Asc(orderassignment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("openOffers: %w", err)
+	}
+
+	providerIDs := []string{}
+	lastEvents := map[string]orderassignment.Event{}
+	for _, event := range events {
+		providerID := event.Edges.Provider.ID
+		if _, ok := lastEvents[providerID]; !ok {
+			providerIDs = append(providerIDs, providerID)
+		}
+		lastEvents[providerID] = event.Event
+	}
+
+	open := []string{}
+	for _, providerID := range providerIDs {
+		if lastEvents[providerID] == orderassignment.EventOffered {
+			open = append(open, providerID)
+		}
+	}
+
+	return open, nil
+}
+
+// lockPaymentOrderFields returns the fields a lock payment order is assigned with.
+// The order's token and provision bucket, with its currency, must be loaded.
+func lockPaymentOrderFields(order *ent.LockPaymentOrder) types.LockPaymentOrderFields {
+	orderFields := types.LockPaymentOrderFields{
+		ID:                order.ID,
+		Token:             order.Edges.Token,
+		GatewayID:         order.GatewayID,
+		Amount:            order.Amount,
+		Rate:              order.Rate,
+		BlockNumber:       order.BlockNumber,
+		Institution:       order.Institution,
+		AccountIdentifier: order.AccountIdentifier,
+		AccountName:       order.AccountName,
+		Memo:              order.Memo,
+		ProvisionBucket:   order.Edges.ProvisionBucket,
+		UpdatedAt:         order.UpdatedAt,
+	}
+
+	if order.Edges.Provider != nil {
+		orderFields.ProviderID = order.Edges.Provider.ID
+	}
+
+	return orderFields
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderAssignment(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:orderassignment?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+	service := NewOrderAssignmentService()
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	providerIDs := []string{}
+	for _, email := range []string{"provider-a@test.com", "provider-b@test.com"} {
+		user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider", "email": email})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+		providerIDs = append(providerIDs, provider.ID)
+	}
+	providerA, providerB := providerIDs[0], providerIDs[1]
+
+	network, err := client.Network.
+		Create().
+		SetChainID(1).
+		SetIdentifier("test").
+		SetRPCEndpoint("").
+		SetIsTestnet(true).
+		SetFee(decimal.Zero).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	token, err := client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0x").
+		SetDecimals(6).
+		SetNetwork(network).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	order, err := client.LockPaymentOrder.
+		Create().
+		SetGatewayID("0x1").
+		SetAmount(decimal.NewFromInt(100)).
+		SetRate(decimal.NewFromInt(1500)).
+		SetOrderPercent(decimal.NewFromInt(100)).
+		SetBlockNumber(0).
+		SetInstitution("GTBINGLA").
+		SetAccountIdentifier("").
+		SetAccountName("").
+		SetToken(token).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	// Events are told apart by their creation time
+	step := func() { time.Sleep(5 * time.Millisecond) }
+
+	expiresAt := time.Now().Add(-time.Minute)
+	assert.NoError(t, service.RecordOffer(ctx, order.ID, providerA, expiresAt))
+	assert.NoError(t, service.RecordOffer(ctx, order.ID, providerB, expiresAt))
+	step()
+
+	t.Run("keeps offers without an answer open", func(t *testing.T) {
+		open, err := service.openOffers(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{providerA, providerB}, open)
+	})
+
+	t.Run("closes offers that were answered", func(t *testing.T) {
+		assert.NoError(t, service.RecordEvent(ctx, order.ID, orderassignment.EventDeclined, providerB))
+		step()
+
+		open, err := service.openOffers(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{providerA}, open)
+
+		assert.NoError(t, service.RecordEvent(ctx, order.ID, orderassignment.EventTimedOut, open...))
+		step()
+
+		open, err = service.openOffers(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, open)
+	})
+
+	t.Run("reopens a provider sent the order again", func(t *testing.T) {
+		assert.NoError(t, service.RecordOffer(ctx, order.ID, providerA, time.Now().Add(time.Minute)))
+
+		open, err := service.openOffers(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{providerA}, open)
+	})
+
+	t.Run("returns the history of an order oldest first", func(t *testing.T) {
+		history, err := service.GetHistory(ctx, order.ID)
+		assert.NoError(t, err)
+		assert.Len(t, history, 5)
+
+		assert.Equal(t, string(orderassignment.EventOffered), history[0].Event)
+		assert.NotNil(t, history[0].ExpiresAt)
+		assert.Equal(t, string(orderassignment.EventDeclined), history[2].Event)
+		assert.Equal(t, providerB, history[2].ProviderID)
+		assert.Nil(t, history[2].ExpiresAt)
+		assert.Equal(t, string(orderassignment.EventTimedOut), history[3].Event)
+		assert.Equal(t, string(orderassignment.EventOffered), history[4].Event)
+	})
+}
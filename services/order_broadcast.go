@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/orderassignment"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// orderBroadcastKey returns the key of the set of providers a broadcast order request was sent to
+func orderBroadcastKey(orderID string) string {
+	return fmt.Sprintf("order_broadcast_%s", orderID)
+}
+
+// redisMembers converts strings to the members of a Redis set command
+func redisMembers(values []string) []interface{} {
+	members := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		members = append(members, value)
+	}
+	return members
+}
+
+// orderRequestMode returns how order requests for a bucket's currency are sent to providers
+func orderRequestMode(bucket *ent.ProvisionBucket) fiatcurrency.OrderRequestMode {
+	if bucket == nil || bucket.Edges.Currency == nil {
+		return fiatcurrency.OrderRequestModeSequential
+	}
+
+	return bucket.Edges.Currency.OrderRequestMode
+}
+
+// broadcastLockPaymentOrder sends an order request to the top providers matched for the order at once
+func (s *PriorityQueueService) broadcastLockPaymentOrder(ctx context.Context, order types.LockPaymentOrderFields, strategy MatchingStrategy, accept func(BookEntry) bool) error {
+	matchStart := time.Now()
+	entries, err := matchTopEntries(ctx, order, strategy, accept, orderConf.OrderBroadcastSize)
+	if err != nil {
+		logger.Errorf("%s - failed to match order with %s strategy: %v", order.ID, strategy.Name(), err)
+		return err
+	}
+	recordMatchingMetric(ctx, strategy.Name(), "match_time_ms", time.Since(matchStart).Milliseconds())
+
+	if len(entries) == 0 {
+		recordMatchingMetric(ctx, strategy.Name(), "unmatched", 1)
+		s.deadLetterOrder(ctx, order)
+		return nil
+	}
+
+	recordMatchingMetric(ctx, strategy.Name(), "matches", 1)
+
+	providerIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		err := strategy.RecordAssignment(ctx, order, entry)
+		if err != nil {
+			logger.Errorf("%s - failed to record assignment to provider %s: %v", order.ID, entry.ProviderID, err)
+			return err
+		}
+		providerIDs = append(providerIDs, entry.ProviderID)
+	}
+
+	failed, err := s.broadcastOrderRequest(ctx, order, providerIDs)
+	if len(failed) > 0 {
+		recordMatchingMetric(ctx, strategy.Name(), "send_failures", int64(len(failed)))
+
+		// Exclude the providers that could not be reached from the order
+		for _, providerID := range failed {
+			if excludeErr := NewOrderAssignmentService().Exclude(ctx, order.ID, providerID); excludeErr != nil {
+				logger.Errorf("%s - error excluding provider %s from order: %v", order.ID, providerID, excludeErr)
+			}
+		}
+	}
+
+	if err != nil {
+		logger.Errorf("%s - failed to broadcast order request: %v", order.ID, err)
+
+		// Broadcast the order request to the next providers
+		return s.AssignLockPaymentOrder(ctx, order)
+	}
+
+	return nil
+}
+
+// matchTopEntries returns up to size entries of distinct providers for an order.
+// The strategy is matched repeatedly, skipping providers already selected, so the entries are in the order of the strategy.
+func matchTopEntries(ctx context.Context, order types.LockPaymentOrderFields, strategy MatchingStrategy, accept func(BookEntry) bool, size int) ([]*BookEntry, error) {
+	selected := map[string]bool{}
+	entries := []*BookEntry{}
+
+	for len(entries) < size {
+		entry, err := strategy.Match(ctx, order, func(entry BookEntry) bool {
+			return !selected[entry.ProviderID] && accept(entry)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if entry == nil {
+			break
+		}
+
+		selected[entry.ProviderID] = true
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// broadcastOrderRequest sends an order request to several providers at once.
+// It returns the providers the request could not be delivered to, and an error if none of them received it.
+func (s *PriorityQueueService) broadcastOrderRequest(ctx context.Context, order types.LockPaymentOrderFields, providerIDs []string) ([]string, error) {
+	orderKey := fmt.Sprintf("order_request_%s", order.ID)
+	broadcastKey := orderBroadcastKey(order.ID.String())
+	expiresAt := time.Now().Add(orderConf.OrderRequestValidity)
+
+	// The order request is stored without a provider and with all order details, its recipients are kept
+	// in a set of their own. Nodes that are pushed the order request still get it shaped to their protocol version.
+	if err := storage.RedisClient.HSet(ctx, orderKey, orderRequestPayload(order, NodeProtocolV2, expiresAt)).Err(); err != nil {
+		return nil, fmt.Errorf("broadcastOrderRequest.request: %w", err)
+	}
+
+	if err := storage.RedisClient.SAdd(ctx, broadcastKey, redisMembers(providerIDs)...).Err(); err != nil {
+		return nil, fmt.Errorf("broadcastOrderRequest.recipients: %w", err)
+	}
+
+	for _, key := range []string{orderKey, broadcastKey} {
+		if err := storage.RedisClient.ExpireAt(ctx, key, expiresAt).Err(); err != nil {
+			return nil, fmt.Errorf("broadcastOrderRequest.ttl: %w", err)
+		}
+	}
+
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDIn(providerIDs...)).
+		Select(providerprofile.FieldNodeProtocolVersion, providerprofile.FieldNodeCapabilities).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("broadcastOrderRequest.providers: %w", err)
+	}
+
+	failed := []string{}
+	for _, providerID := range providerIDs {
+		var provider *ent.ProviderProfile
+		for _, p := range providers {
+			if p.ID == providerID {
+				provider = p
+				break
+			}
+		}
+
+		if provider == nil {
+			failed = append(failed, providerID)
+			continue
+		}
+
+		orderRequestData := orderRequestPayload(order, provider.NodeProtocolVersion, expiresAt)
+		orderRequestData["providerId"] = provider.ID
+
+		if err := s.deliverOrderRequest(ctx, provider, order.ID, orderRequestData, expiresAt); err != nil {
+			failed = append(failed, providerID)
+			continue
+		}
+
+		// Start tracking the provider's SLA for this order
+		if err := NewProviderSLAService().RecordAssignment(ctx, order.ID, provider.ID); err != nil {
+			logger.Errorf("failed to record order assignment for provider %s: %v", provider.ID, err)
+		}
+
+		if err := NewOrderAssignmentService().RecordOffer(ctx, order.ID, provider.ID, expiresAt); err != nil {
+			logger.Errorf("failed to record order request to provider %s: %v", provider.ID, err)
+		}
+	}
+
+	if len(failed) < len(providerIDs) {
+		if err := NewDeadLetterService().Resolve(ctx, order.ID); err != nil {
+			logger.Errorf("failed to take order %s off the dead-letter queue: %v", order.ID, err)
+		}
+	}
+
+	if len(failed) == 0 {
+		return failed, nil
+	}
+
+	if err := storage.RedisClient.SRem(ctx, broadcastKey, redisMembers(failed)...).Err(); err != nil {
+		return failed, fmt.Errorf("broadcastOrderRequest.remove: %w", err)
+	}
+
+	if len(failed) == len(providerIDs) {
+		if err := storage.RedisClient.Del(ctx, orderKey, broadcastKey).Err(); err != nil {
+			logger.Errorf("failed to delete undelivered order request %s: %v", order.ID, err)
+		}
+		return failed, fmt.Errorf("broadcastOrderRequest: no provider received the order request")
+	}
+
+	return failed, nil
+}
+
+// IsOrderRequestRecipient checks whether a live order request was sent to a provider, on its own or as part of a broadcast
+func (s *PriorityQueueService) IsOrderRequestRecipient(ctx context.Context, orderID string, orderRequest map[string]string, providerID string) (bool, error) {
+	if len(orderRequest) == 0 {
+		return false, nil
+	}
+
+	if orderRequest["providerId"] != "" {
+		return orderRequest["providerId"] == providerID, nil
+	}
+
+	recipient, err := storage.RedisClient.SIsMember(ctx, orderBroadcastKey(orderID), providerID).Result()
+	if err != nil {
+		return false, fmt.Errorf("IsOrderRequestRecipient: %w", err)
+	}
+
+	return recipient, nil
+}
+
+// ClaimOrderRequest takes an order request for the provider accepting it.
+// Deleting the order request is the compare-and-set: of concurrent claims on a broadcast order request only
+// one deletes it, and the others get false, as does a claim on an expired order request.
+// The other recipients of a claimed broadcast order request are sent a cancellation.
+func (s *PriorityQueueService) ClaimOrderRequest(ctx context.Context, orderID uuid.UUID, providerID string) (bool, error) {
+	broadcastKey := orderBroadcastKey(orderID.String())
+
+	recipients, err := storage.RedisClient.SMembers(ctx, broadcastKey).Result()
+	if err != nil {
+		return false, fmt.Errorf("ClaimOrderRequest.recipients: %w", err)
+	}
+
+	deleted, err := storage.RedisClient.Del(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("ClaimOrderRequest.request: %w", err)
+	}
+
+	if deleted == 0 {
+		return false, nil
+	}
+
+	if err := NewOrderAssignmentService().RecordEvent(ctx, orderID, orderassignment.EventAccepted, providerID); err != nil {
+		logger.Errorf("failed to record acceptance of order request %s: %v", orderID, err)
+	}
+
+	if len(recipients) == 0 {
+		return true, nil
+	}
+
+	if err := storage.RedisClient.Del(ctx, broadcastKey).Err(); err != nil {
+		logger.Errorf("failed to delete recipients of order request %s: %v", orderID, err)
+	}
+
+	others := []string{}
+	for _, recipient := range recipients {
+		if recipient != providerID {
+			others = append(others, recipient)
+		}
+	}
+
+	if len(others) > 0 {
+		// The other providers didn't miss the order request, it was taken from them
+		if err := NewProviderSLAService().ReleaseAssignments(ctx, orderID, others); err != nil {
+			logger.Errorf("failed to release order assignments of %s: %v", orderID, err)
+		}
+
+		if err := NewOrderAssignmentService().RecordEvent(ctx, orderID, orderassignment.EventCancelled, others...); err != nil {
+			logger.Errorf("failed to record cancellation of order request %s: %v", orderID, err)
+		}
+
+		go s.cancelOrderRequests(context.Background(), orderID, others)
+	}
+
+	return true, nil
+}
+
+// DeclineOrderRequest withdraws an order request from a provider declining it.
+// A broadcast order request stays open to its other recipients until all of them decline it.
+func (s *PriorityQueueService) DeclineOrderRequest(ctx context.Context, orderID uuid.UUID, providerID string) error {
+	broadcastKey := orderBroadcastKey(orderID.String())
+
+	if err := NewOrderAssignmentService().RecordEvent(ctx, orderID, orderassignment.EventDeclined, providerID); err != nil {
+		return fmt.Errorf("DeclineOrderRequest: %w", err)
+	}
+
+	removed, err := storage.RedisClient.SRem(ctx, broadcastKey, providerID).Result()
+	if err != nil {
+		return fmt.Errorf("DeclineOrderRequest.recipients: %w", err)
+	}
+
+	if removed > 0 {
+		remaining, err := storage.RedisClient.SCard(ctx, broadcastKey).Result()
+		if err != nil {
+			return fmt.Errorf("DeclineOrderRequest.remaining: %w", err)
+		}
+
+		if remaining > 0 {
+			return nil
+		}
+	}
+
+	if err := storage.RedisClient.Del(ctx, fmt.Sprintf("order_request_%s", orderID)).Err(); err != nil {
+		return fmt.Errorf("DeclineOrderRequest.request: %w", err)
+	}
+
+	return nil
+}
+
+// cancelOrderRequests tells the nodes of providers that an order request broadcast to them was accepted by another provider
+func (s *PriorityQueueService) cancelOrderRequests(ctx context.Context, orderID uuid.UUID, providerIDs []string) {
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDIn(providerIDs...)).
+		WithAPIKey().
+		Select(providerprofile.FieldHostIdentifier, providerprofile.FieldNodeCapabilities).
+		All(ctx)
+	if err != nil {
+		logger.Errorf("failed to get providers to cancel order request %s: %v", orderID, err)
+		return
+	}
+
+	for _, provider := range providers {
+		// Nodes that pull their order requests no longer get it
+		if NodeSupports(provider, NodeCapabilityPullDelivery) {
+			_ = storage.RedisClient.ZRem(ctx, fmt.Sprintf("order_requests_%s", provider.ID), orderID.String()).Err()
+			continue
+		}
+
+		if provider.HostIdentifier == "" || provider.Edges.APIKey == nil {
+			continue
+		}
+
+		err := s.sendNodeRequest(provider, "/cancel_order", map[string]interface{}{
+			"orderId": orderID.String(),
+			"reason":  "accepted_by_another_provider",
+		})
+		if err != nil {
+			logger.Errorf("failed to cancel order request %s for provider %s: %v", orderID, provider.ID, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderBroadcast(t *testing.T) {
+	ctx := context.Background()
+
+	currency := &ent.FiatCurrency{Code: "NGN", OrderRequestMode: fiatcurrency.OrderRequestModeBroadcast}
+	bucket := &ent.ProvisionBucket{
+		MinAmount: decimal.NewFromInt(1),
+		MaxAmount: decimal.NewFromInt(1000),
+		Edges:     ent.ProvisionBucketEdges{Currency: currency},
+	}
+	order := types.LockPaymentOrderFields{
+		ID:              uuid.New(),
+		Token:           &ent.Token{Symbol: "USDT"},
+		Amount:          decimal.NewFromInt(10),
+		Rate:            decimal.NewFromInt(1500),
+		Institution:     "GTBINGLA",
+		ProvisionBucket: bucket,
+	}
+
+	t.Run("matches the top providers of a bucket once each", func(t *testing.T) {
+		store := newMemoryOrderBookStore()
+		entry := func(providerID string, rate float64) BookEntry {
+			return BookEntry{ProviderID: providerID, Rate: decimal.NewFromFloat(rate), MinOrderAmount: decimal.NewFromInt(1), MaxOrderAmount: decimal.NewFromInt(100)}
+		}
+		store.load("NGN", bucket.MinAmount, bucket.MaxAmount, "queue", nil, map[string][]BookEntry{
+			"USDT": {entry("provider-a", 1500), entry("provider-b", 1500.2), entry("provider-c", 1499.8), entry("provider-d", 1600)},
+		})
+
+		strategy, err := NewMatchingStrategy(MatchingStrategyPriceTime, store)
+		assert.NoError(t, err)
+
+		acceptAll := func(BookEntry) bool { return true }
+		entries, err := matchTopEntries(ctx, order, strategy, acceptAll, 2)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "provider-b", entries[0].ProviderID)
+		assert.Equal(t, "provider-a", entries[1].ProviderID)
+
+		// Fewer providers than the broadcast size are within tolerance of the order rate
+		entries, err = matchTopEntries(ctx, order, strategy, func(entry BookEntry) bool {
+			return entry.ProviderID != "provider-a"
+		}, 5)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "provider-b", entries[0].ProviderID)
+		assert.Equal(t, "provider-c", entries[1].ProviderID)
+	})
+
+	t.Run("shapes order requests to the node protocol version", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Minute)
+
+		data := orderRequestPayload(order, NodeProtocolV1, expiresAt)
+		assert.Equal(t, "15000", data["amount"])
+		assert.Equal(t, "GTBINGLA", data["institution"])
+		assert.NotContains(t, data, "rate")
+
+		data = orderRequestPayload(order, NodeProtocolV2, expiresAt)
+		assert.Equal(t, "1500", data["rate"])
+		assert.Equal(t, "USDT", data["token"])
+		assert.Equal(t, "NGN", data["currency"])
+		assert.Equal(t, expiresAt.Format(time.RFC3339), data["expiresAt"])
+	})
+
+	t.Run("uses the order request mode of the bucket's currency", func(t *testing.T) {
+		assert.Equal(t, fiatcurrency.OrderRequestModeBroadcast, orderRequestMode(bucket))
+		assert.Equal(t, fiatcurrency.OrderRequestModeSequential, orderRequestMode(&ent.ProvisionBucket{}))
+	})
+}
@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/deadletterorder"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/paymentorder"
+	"github.com/paycrest/aggregator/ent/predicate"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
@@ -19,14 +25,19 @@ import (
 	cryptoUtils "github.com/paycrest/aggregator/utils/crypto"
 	"github.com/paycrest/aggregator/utils/logger"
 	tokenUtils "github.com/paycrest/aggregator/utils/token"
+	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 )
 
-type PriorityQueueService struct{}
+type PriorityQueueService struct {
+	matchingEngine *MatchingEngine
+}
 
 // NewPriorityQueueService creates a new instance of PriorityQueueService
 func NewPriorityQueueService() *PriorityQueueService {
-	return &PriorityQueueService{}
+	return &PriorityQueueService{
+		matchingEngine: NewMatchingEngine(),
+	}
 }
 
 // ProcessBucketQueues creates a priority queue for each bucket and saves it to redis
@@ -45,21 +56,29 @@ func (s *PriorityQueueService) ProcessBucketQueues() error {
 	return nil
 }
 
-// GetProvisionBuckets returns a list of buckets with their providers
-func (s *PriorityQueueService) GetProvisionBuckets(ctx context.Context) ([]*ent.ProvisionBucket, error) {
+// GetProvisionBuckets returns a list of buckets with their providers, optionally filtered by the given predicates
+func (s *PriorityQueueService) GetProvisionBuckets(ctx context.Context, predicates ...predicate.ProvisionBucket) ([]*ent.ProvisionBucket, error) {
 	buckets, err := storage.Client.ProvisionBucket.
 		Query().
-		Select(provisionbucket.FieldMinAmount, provisionbucket.FieldMaxAmount).
+		Where(predicates...).
+		Select(provisionbucket.FieldMinAmount, provisionbucket.FieldMaxAmount, provisionbucket.FieldMatchingStrategy).
 		WithProviderProfiles(func(ppq *ent.ProviderProfileQuery) {
 			// ppq.WithProviderRating(func(prq *ent.ProviderRatingQuery) {
 			// 	prq.Select(providerrating.FieldTrustScore)
 			// })
-			ppq.Select(providerprofile.FieldID)
+			ppq.Select(providerprofile.FieldID, providerprofile.FieldDemotedUntil)
 
 			// Filter only providers that are always available
 			ppq.Where(
 				providerprofile.IsAvailable(true),
+				providerprofile.IsOpen(true),
 				providerprofile.IsActive(true),
+				providerprofile.IsHealthy(true),
+				providerprofile.NodeProtocolVersionGTE(orderConf.NodeMinProtocolVersion),
+				providerprofile.Or(
+					providerprofile.SuspendedUntilIsNil(),
+					providerprofile.SuspendedUntilLT(time.Now()),
+				),
 				providerprofile.IsKybVerified(true),
 				providerprofile.VisibilityModeEQ(providerprofile.VisibilityModePublic),
 			)
@@ -73,18 +92,18 @@ func (s *PriorityQueueService) GetProvisionBuckets(ctx context.Context) ([]*ent.
 	return buckets, nil
 }
 
-// GetProviderRate returns the rate for a provider
-func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *ent.ProviderProfile, token string) (decimal.Decimal, error) {
+// GetProviderRate returns the rate for a provider of a token in a fiat currency.
+// Floating rates are quoted on the market rate of the token in the currency.
+func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *ent.ProviderProfile, token string, currency string) (decimal.Decimal, error) {
 	// Fetch the token config for the provider
 	tokenConfig, err := storage.Client.ProviderOrderToken.
 		Query().
 		Where(
 			providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
 			providerordertoken.SymbolEQ(token),
+			providerordertoken.HasCurrencyWith(fiatcurrency.CodeEQ(currency)),
 		).
-		WithProvider(func(pq *ent.ProviderProfileQuery) {
-			pq.WithCurrency()
-		}).
+		WithCurrency().
 		Select(
 			providerordertoken.FieldConversionRateType,
 			providerordertoken.FieldFixedConversionRate,
@@ -101,7 +120,10 @@ func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *en
 		rate = tokenConfig.FixedConversionRate
 	} else {
 		// Handle floating rate case
-		marketRate := tokenConfig.Edges.Provider.Edges.Currency.MarketRate
+		marketRate, err := NewMarketRateService().GetRate(ctx, tokenConfig.Edges.Currency, token)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
 		floatingRate := tokenConfig.FloatingConversionRate // in percentage
 
 		// Calculate the floating rate based on the market rate
@@ -113,6 +135,51 @@ func (s *PriorityQueueService) GetProviderRate(ctx context.Context, provider *en
 
 // CreatePriorityQueueForBucket creates a priority queue for a bucket and saves it to redis
 func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context, bucket *ent.ProvisionBucket) {
+	overrides, err := s.bucketOverrides(ctx, bucket)
+	if err != nil {
+		logger.Errorf("failed to get bucket queue overrides: %v", err)
+	}
+
+	queue, bookEntries, exclusions := s.bucketEntries(ctx, bucket, true, overrides)
+
+	// Enqueue provider ID and rate as a single string into the circular queue
+	redisKey := bucketQueueKey(bucket)
+
+	_, err = storage.RedisClient.Del(ctx, redisKey).Result() // delete existing queue
+	if err != nil {
+		logger.Errorf("failed to delete existing circular queue: %v", err)
+	}
+
+	for _, data := range queue {
+		// Enqueue the serialized data into the circular queue
+		err = storage.RedisClient.RPush(ctx, redisKey, data).Err()
+		if err != nil {
+			logger.Errorf("failed to enqueue provider data to circular queue: %v", err)
+		}
+	}
+
+	err = s.matchingEngine.RebuildBooks(ctx, bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount, bookEntries)
+	if err != nil {
+		logger.Errorf("failed to rebuild order books: %v", err)
+	}
+
+	providerExclusions, err := s.providerExclusions(ctx, bucket)
+	if err != nil {
+		logger.Errorf("failed to get excluded providers of bucket: %v", err)
+	}
+
+	err = s.saveBucketBuild(ctx, bucket, append(providerExclusions, exclusions...))
+	if err != nil {
+		logger.Errorf("failed to save bucket queue build: %v", err)
+	}
+}
+
+// bucketEntries returns the circular queue of a bucket, its order book entries keyed by token, and why
+// providers or their tokens were left out of them.
+// Rates too far off the market rate are left out. When live is set, they are only left out in production and
+// are flagged as stale, and rates back within range have their stale flag cleared; otherwise nothing is written.
+// Providers pinned by the given overrides are put at the front of the queue and removed ones are left out.
+func (s *PriorityQueueService) bucketEntries(ctx context.Context, bucket *ent.ProvisionBucket, live bool, overrides map[string]types.BucketQueueOverride) ([]string, map[string][]BookEntry, []types.BucketQueueExclusion) {
 	// Create a slice to store the provider profiles sorted by trust score
 	providers := bucket.Edges.ProviderProfiles
 	// sort.SliceStable(providers, func(i, j int) bool {
@@ -121,66 +188,284 @@ func (s *PriorityQueueService) CreatePriorityQueueForBucket(ctx context.Context,
 	// 	return trustScoreI > trustScoreJ // Sort in descending order
 	// })
 
-	// Enqueue provider ID and rate as a single string into the circular queue
-	redisKey := fmt.Sprintf("bucket_%s_%s_%s", bucket.Edges.Currency.Code, bucket.MinAmount, bucket.MaxAmount)
+	// Pinned providers are moved to the front of the queue,
+	// and providers demoted for SLA breaches to the back
+	now := time.Now()
+	rank := func(provider *ent.ProviderProfile) int {
+		if overrides[provider.ID].Action == BucketQueueOverridePin {
+			return 0
+		} else if provider.DemotedUntil.After(now) {
+			return 2
+		}
+		return 1
+	}
+	sort.SliceStable(providers, func(i, j int) bool {
+		return rank(providers[i]) < rank(providers[j])
+	})
+
+	queue := []string{}
 
-	_, err := storage.RedisClient.Del(ctx, redisKey).Result() // delete existing queue
+	// Rates are checked against the market rate of their token, or of the currency for tokens without one
+	tokenMarketRates, err := NewMarketRateService().GetRates(ctx, bucket.Edges.Currency)
 	if err != nil {
-		logger.Errorf("failed to delete existing circular queue: %v", err)
+		logger.Errorf("failed to get token market rates of %s: %v", bucket.Edges.Currency.Code, err)
+	}
+	marketRateOf := func(symbol string) decimal.Decimal {
+		if rate, ok := tokenMarketRates[strings.ToUpper(symbol)]; ok {
+			return rate
+		}
+		return bucket.Edges.Currency.MarketRate
+	}
+
+	// Order book entries of the bucket, keyed by token
+	bookEntries := map[string][]BookEntry{}
+
+	exclusions := []types.BucketQueueExclusion{}
+	exclude := func(providerID, token, reason string) {
+		exclusions = append(exclusions, types.BucketQueueExclusion{ProviderID: providerID, Token: token, Reason: reason})
 	}
 
 	for _, provider := range providers {
+		if override, ok := overrides[provider.ID]; ok && override.Action == BucketQueueOverrideRemove {
+			exclude(provider.ID, "", fmt.Sprintf("removed by an admin until %s", override.ExpiresAt.Format(time.RFC3339)))
+			continue
+		}
+
 		tokens, err := storage.Client.ProviderOrderToken.
 			Query().
 			Where(
 				providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+				providerordertoken.HasCurrencyWith(fiatcurrency.IDEQ(bucket.Edges.Currency.ID)),
+			).
+			Select(
+				providerordertoken.FieldSymbol,
+				providerordertoken.FieldMinOrderAmount,
+				providerordertoken.FieldMaxOrderAmount,
+				providerordertoken.FieldRateStaleSince,
 			).
-			Select(providerordertoken.FieldSymbol, providerordertoken.FieldMinOrderAmount, providerordertoken.FieldMaxOrderAmount).
 			All(ctx)
 		if err != nil {
 			logger.Errorf("failed to get tokens for provider %s: %v", provider.ID, err)
+			exclude(provider.ID, "", "failed to fetch token configs")
+			continue
+		}
+
+		if len(tokens) == 0 {
+			exclude(provider.ID, "", fmt.Sprintf("no tokens configured for %s", bucket.Edges.Currency.Code))
 			continue
 		}
 
 		for _, token := range tokens {
 			providerID := provider.ID
-			rate, err := s.GetProviderRate(ctx, provider, token.Symbol)
+			rate, err := s.GetProviderRate(ctx, provider, token.Symbol, bucket.Edges.Currency.Code)
 			if err != nil {
 				logger.Errorf("failed to get %s rate for provider %s: %v", token.Symbol, providerID, err)
+				exclude(providerID, token.Symbol, "failed to get rate")
 				continue
 			}
 
 			// Check provider's rate against the market rate to ensure it's not too far off
-			percentDeviation := utils.AbsPercentageDeviation(bucket.Edges.Currency.MarketRate, rate)
-
-			if serverConf.Environment == "production" && percentDeviation.GreaterThan(orderConf.PercentDeviationFromMarketRate) {
-				// Skip this provider if the rate is too far off
-				// TODO: add a logic to notify the provider(s) to update his rate since it's stale. could be a cron job
+			marketRate := marketRateOf(token.Symbol)
+			percentDeviation := utils.AbsPercentageDeviation(marketRate, rate)
+
+			if (!live || serverConf.Environment == "production") && percentDeviation.GreaterThan(orderConf.PercentDeviationFromMarketRate) {
+				// Skip this provider if the rate is too far off and let them know it's stale
+				exclude(providerID, token.Symbol, fmt.Sprintf(
+					"rate %s deviates %s%% from the market rate %s", rate, percentDeviation.Round(2), marketRate,
+				))
+				if live {
+					s.flagStaleRate(ctx, providerID, token, rate, marketRate, percentDeviation)
+				}
 				continue
 			}
 
+			if live && !token.RateStaleSince.IsZero() {
+				// Rate is back within range of the market rate
+				_, err = storage.Client.ProviderOrderToken.
+					UpdateOneID(token.ID).
+					ClearRateStaleSince().
+					Save(ctx)
+				if err != nil {
+					logger.Errorf("failed to clear stale %s rate for provider %s: %v", token.Symbol, providerID, err)
+				}
+			}
+
 			// Serialize the provider ID, token, rate, min and max order amount into a single string
-			data := fmt.Sprintf("%s:%s:%s:%s:%s", providerID, token.Symbol, rate, token.MinOrderAmount, token.MaxOrderAmount)
+			queue = append(queue, fmt.Sprintf("%s:%s:%s:%s:%s", providerID, token.Symbol, rate, token.MinOrderAmount, token.MaxOrderAmount))
+
+			bookEntries[token.Symbol] = append(bookEntries[token.Symbol], BookEntry{
+				ProviderID:     providerID,
+				Pinned:         rank(provider) == 0,
+				Demoted:        provider.DemotedUntil.After(now),
+				Rate:           rate,
+				MinOrderAmount: token.MinOrderAmount,
+				MaxOrderAmount: token.MaxOrderAmount,
+			})
+		}
+	}
 
-			// Enqueue the serialized data into the circular queue
-			err = storage.RedisClient.RPush(ctx, redisKey, data).Err()
-			if err != nil {
-				logger.Errorf("failed to enqueue provider data to circular queue: %v", err)
-			}
+	return queue, bookEntries, exclusions
+}
+
+// flagStaleRate records that a provider's token rate was excluded from the bucket queues
+// and notifies the provider, at most once per notification cooldown
+func (s *PriorityQueueService) flagStaleRate(ctx context.Context, providerID string, token *ent.ProviderOrderToken, rate, marketRate, deviation decimal.Decimal) {
+	now := time.Now()
+
+	if token.RateStaleSince.IsZero() {
+		_, err := storage.Client.ProviderOrderToken.
+			Update().
+			Where(
+				providerordertoken.IDEQ(token.ID),
+				providerordertoken.RateStaleSinceIsNil(),
+			).
+			SetRateStaleSince(now).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("failed to flag stale %s rate for provider %s: %v", token.Symbol, providerID, err)
+			return
+		}
+	}
+
+	// Claim the notification with a conditional update so that concurrent bucket
+	// queue rebuilds don't notify the provider more than once
+	count, err := storage.Client.ProviderOrderToken.
+		Update().
+		Where(
+			providerordertoken.IDEQ(token.ID),
+			providerordertoken.Or(
+				providerordertoken.RateStaleNotifiedAtIsNil(),
+				providerordertoken.RateStaleNotifiedAtLT(now.Add(-orderConf.StaleRateNotificationCooldown)),
+			),
+		).
+		SetRateStaleNotifiedAt(now).
+		Save(ctx)
+	if err != nil {
+		logger.Errorf("failed to update stale %s rate notification for provider %s: %v", token.Symbol, providerID, err)
+		return
+	} else if count == 0 {
+		return
+	}
+
+	go func() {
+		err := s.notifyStaleRate(ctx, providerID, token.Symbol, rate, marketRate, deviation)
+		if err != nil {
+			logger.Errorf("failed to notify provider %s of stale %s rate: %v", providerID, token.Symbol, err)
+		}
+	}()
+}
+
+// notifyStaleRate alerts a provider by email and through their node that their token rate is stale
+func (s *PriorityQueueService) notifyStaleRate(ctx context.Context, providerID, token string, rate, marketRate, deviation decimal.Decimal) error {
+	provider, err := storage.Client.ProviderProfile.
+		Query().
+		Where(
+			providerprofile.IDEQ(providerID),
+		).
+		WithUser().
+		WithAPIKey().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("notifyStaleRate: %w", err)
+	}
+
+	emailService := NewEmailService(SENDGRID_MAIL_PROVIDER)
+	_, err = emailService.SendStaleRateEmail(
+		ctx, provider.Edges.User.Email, provider.Edges.User.FirstName, token, rate, marketRate, deviation,
+	)
+	if err != nil {
+		logger.Errorf("failed to send stale rate email to provider %s: %v", providerID, err)
+	}
+
+	if provider.HostIdentifier == "" || provider.Edges.APIKey == nil {
+		return nil
+	}
+
+	err = s.sendNodeRequest(provider, "/stale_rate", map[string]interface{}{
+		"token":        token,
+		"rate":         rate.String(),
+		"marketRate":   marketRate.String(),
+		"deviation":    deviation.RoundBank(2).String(),
+		"maxDeviation": orderConf.PercentDeviationFromMarketRate.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("notifyStaleRate: %w", err)
+	}
+
+	return nil
+}
+
+// GetStaleRates returns the provider's token rates currently excluded from the bucket queues
+func (s *PriorityQueueService) GetStaleRates(ctx context.Context, provider *ent.ProviderProfile) ([]types.ProviderStaleRate, error) {
+	tokens, err := storage.Client.ProviderOrderToken.
+		Query().
+		Where(
+			providerordertoken.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+			providerordertoken.RateStaleSinceNotNil(),
+		).
+		WithCurrency().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetStaleRates: %w", err)
+	}
+
+	staleRates := make([]types.ProviderStaleRate, 0, len(tokens))
+	for _, token := range tokens {
+		rate, err := s.GetProviderRate(ctx, provider, token.Symbol, token.Edges.Currency.Code)
+		if err != nil {
+			return nil, fmt.Errorf("GetStaleRates.GetProviderRate: %w", err)
 		}
+
+		marketRate, err := NewMarketRateService().GetRate(ctx, token.Edges.Currency, token.Symbol)
+		if err != nil {
+			return nil, fmt.Errorf("GetStaleRates.GetRate: %w", err)
+		}
+
+		staleRates = append(staleRates, types.ProviderStaleRate{
+			Symbol:     token.Symbol,
+			Currency:   token.Edges.Currency.Code,
+			Rate:       rate,
+			MarketRate: marketRate,
+			Deviation:  utils.AbsPercentageDeviation(marketRate, rate).RoundBank(2),
+			StaleSince: token.RateStaleSince,
+		})
 	}
+
+	return staleRates, nil
 }
 
 // AssignLockPaymentOrders assigns lock payment orders to providers
 func (s *PriorityQueueService) AssignLockPaymentOrder(ctx context.Context, order types.LockPaymentOrderFields) error {
 	orderIDPrefix := strings.Split(order.ID.String(), "-")[0]
 
-	excludeList, err := storage.RedisClient.LRange(ctx, fmt.Sprintf("order_exclude_list_%s", order.ID), 0, -1).Result()
+	// Orders are held back while the market rate circuit breaker of their currency is tripped
+	if order.ProvisionBucket != nil && order.ProvisionBucket.Edges.Currency != nil {
+		tripped, err := NewCircuitBreakerService().IsTripped(ctx, order.ProvisionBucket.Edges.Currency.Code)
+		if err != nil {
+			logger.Errorf("%s - failed to check circuit breaker: %v", orderIDPrefix, err)
+			return err
+		}
+
+		if tripped {
+			return NewDeadLetterService().Record(ctx, order, deadletterorder.ReasonMatchingPaused)
+		}
+	}
+
+	excludeList, err := NewOrderAssignmentService().ExcludedProviders(ctx, order.ID)
 	if err != nil {
 		logger.Errorf("%s - failed to get exclude list: %v", order.ID, err)
 		return err
 	}
 
+	orderInstitution, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(order.Institution)).
+		Only(ctx)
+	if err != nil {
+		logger.Errorf("%s - failed to get institution %s: %v", orderIDPrefix, order.Institution, err)
+		return err
+	}
+
 	// Sends order directly to the specified provider in order.
 	// Incase of failure, do nothing. The order will eventually refund
 	if order.ProviderID != "" && !utils.ContainsString(excludeList, order.ProviderID) {
@@ -191,11 +476,19 @@ func (s *PriorityQueueService) AssignLockPaymentOrder(ctx context.Context, order
 			).
 			Only(ctx)
 
-		if err == nil {
+		if err == nil && !provider.IsHealthy {
+			logger.Errorf("%s - provider %s node is unhealthy", orderIDPrefix, order.ProviderID)
+		} else if err == nil && !IsNodeCompatible(provider) {
+			logger.Errorf("%s - provider %s node protocol version %d is no longer supported", orderIDPrefix, order.ProviderID, provider.NodeProtocolVersion)
+		} else if err == nil && provider.SuspendedUntil.After(time.Now()) {
+			logger.Errorf("%s - provider %s is suspended until %s", orderIDPrefix, order.ProviderID, provider.SuspendedUntil)
+		} else if err == nil && !utils.SupportsInstitution(provider, orderInstitution) {
+			logger.Errorf("%s - provider %s does not support institution %s", orderIDPrefix, order.ProviderID, order.Institution)
+		} else if err == nil {
 			// TODO: check for provider's minimum and maximum rate for negotiation
 			// Update the rate with the current rate if order was last updated more than 10 mins ago
 			if order.UpdatedAt.Before(time.Now().Add(-10 * time.Minute)) {
-				order.Rate, err = s.GetProviderRate(ctx, provider, order.Token.Symbol)
+				order.Rate, err = s.GetProviderRate(ctx, provider, order.Token.Symbol, order.ProvisionBucket.Edges.Currency.Code)
 				if err != nil {
 					logger.Errorf("%s - failed to get rate for provider %s: %v", orderIDPrefix, order.ProviderID, err)
 				}
@@ -222,123 +515,141 @@ func (s *PriorityQueueService) AssignLockPaymentOrder(ctx context.Context, order
 		}
 	}
 
-	// Get the first provider from the circular queue
-	redisKey := fmt.Sprintf("bucket_%s_%s_%s", order.ProvisionBucket.Edges.Currency.Code, order.ProvisionBucket.MinAmount, order.ProvisionBucket.MaxAmount)
+	strategy := s.matchingStrategyFor(order.ProvisionBucket)
 
-	// partnerProviders := []string{}
+	// Providers checked against the order's institution, mapped to whether they support it
+	supportedProviders := map[string]bool{}
 
-	for index := 0; ; index++ {
-		providerData, err := storage.RedisClient.LIndex(ctx, redisKey, int64(index)).Result()
-		if err != nil {
-			break
-		}
-
-		// if providerData == "" {
-		// 	// Reached the end of the queue
-		// 	logger.Errorf("%s - rate didn't match a provider, finding a partner provider", orderIDPrefix)
-
-		// 	if len(partnerProviders) == 0 {
-		// 		logger.Errorf("%s - no partner providers found", orderIDPrefix)
-		// 		return nil
-		// 	}
-
-		// 	// Pick a random partner provider
-		// 	randomIndex := rand.New(rand.NewSource(time.Now().UnixNano())).Intn(len(partnerProviders))
-		// 	providerData = partnerProviders[randomIndex]
-		// }
-
-		// Extract the rate from the data (assuming it's in the format "providerID:token:rate:minAmount:maxAmount")
-		parts := strings.Split(providerData, ":")
-		if len(parts) != 5 {
-			logger.Errorf("%s - invalid data format at index %d: %s", orderIDPrefix, index, providerData)
-			continue // Skip this entry due to invalid format
-		}
-
-		order.ProviderID = parts[0]
+	recordMatchingMetric(ctx, strategy.Name(), "attempts", 1)
 
+	accept := func(entry BookEntry) bool {
 		// Skip entry if provider is excluded
-		if utils.ContainsString(excludeList, order.ProviderID) {
-			continue
-		}
-
-		// Skip entry if token doesn't match
-		if parts[1] != order.Token.Symbol {
-			continue
+		if utils.ContainsString(excludeList, entry.ProviderID) {
+			return false
 		}
 
 		// Skip entry if order amount is not within provider's min and max order amount
-		minOrderAmount, err := decimal.NewFromString(parts[3])
-		if err != nil {
-			continue
+		if order.Amount.LessThan(entry.MinOrderAmount) || order.Amount.GreaterThan(entry.MaxOrderAmount) {
+			return false
 		}
 
-		maxOrderAmount, err := decimal.NewFromString(parts[4])
-		if err != nil {
-			continue
+		// Skip entry if provider can't pay out to the order's institution
+		supported, ok := supportedProviders[entry.ProviderID]
+		if !ok {
+			var err error
+			supported, err = s.providerSupportsInstitution(ctx, entry.ProviderID, orderInstitution)
+			if err != nil {
+				logger.Errorf("%s - failed to check institution support for provider %s: %v", orderIDPrefix, entry.ProviderID, err)
+				return false
+			}
+			supportedProviders[entry.ProviderID] = supported
 		}
 
-		if order.Amount.LessThan(minOrderAmount) || order.Amount.GreaterThan(maxOrderAmount) {
-			continue
-		}
+		return supported
+	}
 
-		// Fetch and check provider for rate match
-		rate, err := decimal.NewFromString(parts[2])
-		if err != nil {
-			continue
-		}
+	if orderRequestMode(order.ProvisionBucket) == fiatcurrency.OrderRequestModeBroadcast {
+		return s.broadcastLockPaymentOrder(ctx, order, strategy, accept)
+	}
 
-		// TODO: make the slippage of 0.5 configurable by provider
-		if rate.Sub(order.Rate).Abs().LessThanOrEqual(decimal.NewFromFloat(0.5)) {
-			// Found a match for the rate
-			if index == 0 {
-				// Match found at index 0, perform LPOP to dequeue
-				data, err := storage.RedisClient.LPop(ctx, redisKey).Result()
-				if err != nil {
-					logger.Errorf("%s - failed to dequeue from circular queue: %v", orderIDPrefix, err)
-					return err
-				}
+	matchStart := time.Now()
+	entry, err := strategy.Match(ctx, order, accept)
+	recordMatchingMetric(ctx, strategy.Name(), "match_time_ms", time.Since(matchStart).Milliseconds())
+	if err != nil {
+		logger.Errorf("%s - failed to match order with %s strategy: %v", orderIDPrefix, strategy.Name(), err)
+		return err
+	}
 
-				// Enqueue data to the end of the queue
-				err = storage.RedisClient.RPush(ctx, redisKey, data).Err()
-				if err != nil {
-					logger.Errorf("%s - failed to enqueue to circular queue: %v", orderIDPrefix, err)
-					return err
-				}
-			}
+	if entry == nil {
+		recordMatchingMetric(ctx, strategy.Name(), "unmatched", 1)
+		s.deadLetterOrder(ctx, order)
+		return nil
+	}
 
-			// Assign the order to the provider and save it to Redis
-			err = s.sendOrderRequest(ctx, order)
-			if err != nil {
-				logger.Errorf("%s - failed to send order request to specific provider %s: %v", orderIDPrefix, order.ProviderID, err)
+	recordMatchingMetric(ctx, strategy.Name(), "matches", 1)
 
-				// Push provider ID to order exclude list
-				orderKey := fmt.Sprintf("order_exclude_list_%s", order.ID)
-				_, err = storage.RedisClient.RPush(ctx, orderKey, order.ProviderID).Result()
-				if err != nil {
-					logger.Errorf("%s - error pushing provider %s to order_exclude_list on Redis: %v", orderIDPrefix, order.ProviderID, err)
-				}
+	order.ProviderID = entry.ProviderID
 
-				// Reassign the lock payment order to another provider
-				return s.AssignLockPaymentOrder(ctx, order)
-			}
+	err = strategy.RecordAssignment(ctx, order, entry)
+	if err != nil {
+		logger.Errorf("%s - failed to record assignment to provider %s: %v", orderIDPrefix, order.ProviderID, err)
+		return err
+	}
+
+	// Assign the order to the provider and save it to Redis
+	err = s.sendOrderRequest(ctx, order)
+	if err != nil {
+		logger.Errorf("%s - failed to send order request to specific provider %s: %v", orderIDPrefix, order.ProviderID, err)
+		recordMatchingMetric(ctx, strategy.Name(), "send_failures", 1)
 
-			break
+		// Exclude the provider from the order
+		err = NewOrderAssignmentService().Exclude(ctx, order.ID, order.ProviderID)
+		if err != nil {
+			logger.Errorf("%s - error excluding provider %s from order: %v", orderIDPrefix, order.ProviderID, err)
 		}
+
+		// Reassign the lock payment order to another provider
+		return s.AssignLockPaymentOrder(ctx, order)
 	}
 
 	return nil
 }
 
+// matchingStrategyFor returns the matching strategy of a bucket.
+// A strategy set on the bucket takes precedence over one set on its currency, which takes precedence over the configured default.
+func (s *PriorityQueueService) matchingStrategyFor(bucket *ent.ProvisionBucket) MatchingStrategy {
+	strategy, err := NewMatchingStrategy(matchingStrategyName(bucket), s.matchingEngine)
+	if err != nil {
+		logger.Errorf("%v, falling back to %s", err, MatchingStrategyRoundRobin)
+		strategy, _ = NewMatchingStrategy(MatchingStrategyRoundRobin, s.matchingEngine)
+	}
+
+	return strategy
+}
+
+// matchingStrategyName returns the name of the matching strategy configured for a bucket
+func matchingStrategyName(bucket *ent.ProvisionBucket) string {
+	if bucket.MatchingStrategy != "" {
+		return string(bucket.MatchingStrategy)
+	} else if bucket.Edges.Currency != nil && bucket.Edges.Currency.MatchingStrategy != "" {
+		return string(bucket.Edges.Currency.MatchingStrategy)
+	}
+
+	return orderConf.MatchingStrategy
+}
+
+// providerSupportsInstitution checks whether a provider can pay out to an institution
+func (s *PriorityQueueService) providerSupportsInstitution(ctx context.Context, providerID string, institution *ent.Institution) (bool, error) {
+	provider, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDEQ(providerID)).
+		Select(providerprofile.FieldSupportedInstitutions, providerprofile.FieldSupportedInstitutionTypes).
+		Only(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return utils.SupportsInstitution(provider, institution), nil
+}
+
 // sendOrderRequest sends an order request to a provider
 func (s *PriorityQueueService) sendOrderRequest(ctx context.Context, order types.LockPaymentOrderFields) error {
+	provider, err := storage.Client.ProviderProfile.
+		Query().
+		Where(providerprofile.IDEQ(order.ProviderID)).
+		Select(providerprofile.FieldNodeProtocolVersion, providerprofile.FieldNodeCapabilities).
+		Only(ctx)
+	if err != nil {
+		logger.Errorf("failed to get provider %s: %v", order.ProviderID, err)
+		return err
+	}
+
 	// Assign the order to the provider and save it to Redis
 	orderKey := fmt.Sprintf("order_request_%s", order.ID)
+	expiresAt := time.Now().Add(orderConf.OrderRequestValidity)
 
-	orderRequestData := map[string]interface{}{
-		"amount":      order.Amount.Mul(order.Rate).RoundBank(0).String(),
-		"institution": order.Institution,
-		"providerId":  order.ProviderID,
-	}
+	orderRequestData := orderRequestPayload(order, provider.NodeProtocolVersion, expiresAt)
+	orderRequestData["providerId"] = order.ProviderID
 
 	if err := storage.RedisClient.HSet(ctx, orderKey, orderRequestData).Err(); err != nil {
 		logger.Errorf("failed to map order to a provider in Redis: %v", err)
@@ -346,22 +657,174 @@ func (s *PriorityQueueService) sendOrderRequest(ctx context.Context, order types
 	}
 
 	// Set a TTL for the order request
-	err := storage.RedisClient.ExpireAt(ctx, orderKey, time.Now().Add(orderConf.OrderRequestValidity)).Err()
+	err = storage.RedisClient.ExpireAt(ctx, orderKey, expiresAt).Err()
 	if err != nil {
 		logger.Errorf("failed to set TTL for order request: %v", err)
 		return err
 	}
 
+	if err := s.deliverOrderRequest(ctx, provider, order.ID, orderRequestData, expiresAt); err != nil {
+		return err
+	}
+
+	// Start tracking the provider's SLA for this order
+	if err := NewProviderSLAService().RecordAssignment(ctx, order.ID, order.ProviderID); err != nil {
+		logger.Errorf("failed to record order assignment for provider %s: %v", order.ProviderID, err)
+	}
+
+	if err := NewOrderAssignmentService().RecordOffer(ctx, order.ID, order.ProviderID, expiresAt); err != nil {
+		logger.Errorf("failed to record order request to provider %s: %v", order.ProviderID, err)
+	}
+
+	if err := NewDeadLetterService().Resolve(ctx, order.ID); err != nil {
+		logger.Errorf("failed to take order %s off the dead-letter queue: %v", order.ID, err)
+	}
+
+	return nil
+}
+
+// deadLetterOrder records an order that could not be matched to a provider in the dead-letter queue
+func (s *PriorityQueueService) deadLetterOrder(ctx context.Context, order types.LockPaymentOrderFields) {
+	reason, err := s.unmatchedReason(ctx, order)
+	if err != nil {
+		logger.Errorf("%s - failed to find why the order is unmatched: %v", order.ID, err)
+		reason = deadletterorder.ReasonNoEligibleProvider
+	}
+
+	if err := NewDeadLetterService().Record(ctx, order, reason); err != nil {
+		logger.Errorf("%s - failed to record unmatched order: %v", order.ID, err)
+	}
+}
+
+// unmatchedReason works out why no provider in the order book of an order could be matched to it
+func (s *PriorityQueueService) unmatchedReason(ctx context.Context, order types.LockPaymentOrderFields) (deadletterorder.Reason, error) {
+	book := orderBook(order)
+
+	depth, err := s.matchingEngine.BookDepth(ctx, book)
+	if err != nil {
+		return "", err
+	}
+
+	if depth == 0 {
+		return deadletterorder.ReasonNoProviderForToken, nil
+	}
+
+	quotes, err := s.matchingEngine.Candidates(ctx, book, order.Rate, matchingRateTolerance, func(BookEntry) bool { return true })
+	if err != nil {
+		return "", err
+	}
+
+	if len(quotes) == 0 {
+		return deadletterorder.ReasonRateOutOfRange, nil
+	}
+
+	excludeList, err := NewOrderAssignmentService().ExcludedProviders(ctx, order.ID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, quote := range quotes {
+		if !utils.ContainsString(excludeList, quote.ProviderID) {
+			return deadletterorder.ReasonNoEligibleProvider, nil
+		}
+	}
+
+	return deadletterorder.ReasonAllExcluded, nil
+}
+
+// orderRequestPayload returns the order request data sent to a provider's node, shaped to its protocol version
+func orderRequestPayload(order types.LockPaymentOrderFields, protocolVersion int, expiresAt time.Time) map[string]interface{} {
+	orderRequestData := map[string]interface{}{
+		"amount":      utils.OrderPayoutAmount(order.Amount, order.Rate).String(),
+		"institution": order.Institution,
+	}
+
+	if protocolVersion >= NodeProtocolV2 {
+		orderRequestData["rate"] = order.Rate.String()
+		orderRequestData["expiresAt"] = expiresAt.Format(time.RFC3339)
+		if order.Token != nil {
+			orderRequestData["token"] = order.Token.Symbol
+		}
+		if order.ProvisionBucket != nil && order.ProvisionBucket.Edges.Currency != nil {
+			orderRequestData["currency"] = order.ProvisionBucket.Edges.Currency.Code
+		}
+	}
+
+	return orderRequestData
+}
+
+// deliverOrderRequest indexes an order request for the provider's node to fetch if it pulls its order requests,
+// and notifies the node otherwise. The order request data must hold the provider ID.
+func (s *PriorityQueueService) deliverOrderRequest(ctx context.Context, provider *ent.ProviderProfile, orderID uuid.UUID, orderRequestData map[string]interface{}, expiresAt time.Time) error {
+	if NodeSupports(provider, NodeCapabilityPullDelivery) {
+		// Index the order request for the provider's node to fetch
+		err := storage.RedisClient.ZAdd(ctx, fmt.Sprintf("order_requests_%s", provider.ID), redis.Z{
+			Score:  float64(expiresAt.Unix()),
+			Member: orderID.String(),
+		}).Err()
+		if err != nil {
+			logger.Errorf("failed to index order request for provider %s: %v", provider.ID, err)
+			return err
+		}
+
+		return nil
+	}
+
 	// Notify the provider
-	orderRequestData["orderId"] = order.ID
+	orderRequestData["orderId"] = orderID
 	if err := s.notifyProvider(ctx, orderRequestData); err != nil {
-		logger.Errorf("failed to notify provider %s: %v", order.ProviderID, err)
+		logger.Errorf("failed to notify provider %s: %v", provider.ID, err)
 		return err
 	}
 
 	return nil
 }
 
+// GetPendingOrderRequests returns the live order requests of a provider whose node pulls its order requests
+func (s *PriorityQueueService) GetPendingOrderRequests(ctx context.Context, providerID string) ([]map[string]interface{}, error) {
+	indexKey := fmt.Sprintf("order_requests_%s", providerID)
+
+	// Drop expired order requests from the index
+	err := storage.RedisClient.ZRemRangeByScore(ctx, indexKey, "-inf", fmt.Sprintf("(%d", time.Now().Unix())).Err()
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingOrderRequests.prune: %w", err)
+	}
+
+	orderIDs, err := storage.RedisClient.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingOrderRequests.index: %w", err)
+	}
+
+	orderRequests := []map[string]interface{}{}
+	for _, orderID := range orderIDs {
+		result, err := storage.RedisClient.HGetAll(ctx, fmt.Sprintf("order_request_%s", orderID)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("GetPendingOrderRequests.request: %w", err)
+		}
+
+		recipient, err := s.IsOrderRequestRecipient(ctx, orderID, result, providerID)
+		if err != nil {
+			return nil, fmt.Errorf("GetPendingOrderRequests.recipient: %w", err)
+		}
+
+		// Order requests that were accepted, declined or reassigned are no longer pending
+		if !recipient {
+			_ = storage.RedisClient.ZRem(ctx, indexKey, orderID).Err()
+			continue
+		}
+
+		orderRequest := map[string]interface{}{"orderId": orderID}
+		for key, value := range result {
+			if key != "providerId" {
+				orderRequest[key] = value
+			}
+		}
+		orderRequests = append(orderRequests, orderRequest)
+	}
+
+	return orderRequests, nil
+}
+
 // notifyProvider sends an order request notification to a provider
 // TODO: ideally notifications should be moved to a notification service
 func (s *PriorityQueueService) notifyProvider(ctx context.Context, orderRequestData map[string]interface{}) error {
@@ -381,6 +844,11 @@ func (s *PriorityQueueService) notifyProvider(ctx context.Context, orderRequestD
 		return err
 	}
 
+	return s.sendNodeRequest(provider, "/new_order", orderRequestData)
+}
+
+// sendNodeRequest sends a signed POST request to a provider's node
+func (s *PriorityQueueService) sendNodeRequest(provider *ent.ProviderProfile, path string, payload map[string]interface{}) error {
 	// Compute HMAC
 	decodedSecret, err := base64.StdEncoding.DecodeString(provider.Edges.APIKey.Secret)
 	if err != nil {
@@ -391,14 +859,14 @@ func (s *PriorityQueueService) notifyProvider(ctx context.Context, orderRequestD
 		return err
 	}
 
-	signature := tokenUtils.GenerateHMACSignature(orderRequestData, string(decryptedSecret))
+	signature := tokenUtils.GenerateHMACSignature(payload, string(decryptedSecret))
 
 	// Send POST request to the provider's node
 	_, err = fastshot.NewClient(provider.HostIdentifier).
 		Config().SetTimeout(30*time.Second).
 		Header().Add("X-Request-Signature", signature).
-		Build().POST("/new_order").
-		Body().AsJSON(orderRequestData).
+		Build().POST(path).
+		Body().AsJSON(payload).
 		Send()
 	if err != nil {
 		return err
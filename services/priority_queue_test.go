@@ -9,11 +9,15 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/paycrest/aggregator/ent"
 	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerprofile"
 	"github.com/paycrest/aggregator/ent/provisionbucket"
 	db "github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
@@ -289,14 +293,111 @@ func TestPriorityQueueTest(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("TestMatchingEngine", func(t *testing.T) {
+		ctx := context.Background()
+		engine := NewMatchingEngine()
+		minAmount := decimal.NewFromInt(1)
+		maxAmount := decimal.NewFromInt(500)
+		book := BookKey("GHS", "USDT", minAmount, maxAmount)
+
+		entry := func(providerID string, rate float64) BookEntry {
+			return BookEntry{
+				ProviderID:     providerID,
+				Rate:           decimal.NewFromFloat(rate),
+				MinOrderAmount: decimal.NewFromInt(1),
+				MaxOrderAmount: decimal.NewFromInt(100),
+			}
+		}
+		acceptAll := func(BookEntry) bool { return true }
+
+		err := engine.RebuildBooks(ctx, "GHS", minAmount, maxAmount, map[string][]BookEntry{
+			"USDT": {entry("provider-a", 15.0), entry("provider-b", 15.3), entry("provider-c", 15.3)},
+		})
+		assert.NoError(t, err)
+
+		// Best rate within tolerance wins, rotating among providers quoting it
+		first, err := engine.Match(ctx, book, decimal.NewFromFloat(15.0), decimal.NewFromFloat(0.5), acceptAll)
+		assert.NoError(t, err)
+		assert.True(t, first.Rate.Equal(decimal.NewFromFloat(15.3)))
+		assert.NoError(t, engine.RecordAssignment(ctx, book, first))
+
+		second, err := engine.Match(ctx, book, decimal.NewFromFloat(15.0), decimal.NewFromFloat(0.5), acceptAll)
+		assert.NoError(t, err)
+		assert.True(t, second.Rate.Equal(decimal.NewFromFloat(15.3)))
+		assert.NotEqual(t, first.ProviderID, second.ProviderID)
+
+		// Providers rejected by the filter fall through to the next rate
+		match, err := engine.Match(ctx, book, decimal.NewFromFloat(15.0), decimal.NewFromFloat(0.5), func(e BookEntry) bool {
+			return e.ProviderID == "provider-a"
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "provider-a", match.ProviderID)
+
+		// Rates outside the tolerance don't match
+		match, err = engine.Match(ctx, book, decimal.NewFromFloat(16.0), decimal.NewFromFloat(0.5), acceptAll)
+		assert.NoError(t, err)
+		assert.Nil(t, match)
+
+		// Removed providers no longer match
+		assert.NoError(t, engine.RemoveProvider(ctx, book, "provider-a"))
+		match, err = engine.Match(ctx, book, decimal.NewFromFloat(14.6), decimal.NewFromFloat(0.1), acceptAll)
+		assert.NoError(t, err)
+		assert.Nil(t, match)
+
+		// Rebuilding without the token clears its book
+		err = engine.RebuildBooks(ctx, "GHS", minAmount, maxAmount, map[string][]BookEntry{})
+		assert.NoError(t, err)
+		exists, err := db.RedisClient.Exists(ctx, book).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), exists)
+	})
+
+	t.Run("TestMatchingStrategyFor", func(t *testing.T) {
+		bucket := &ent.ProvisionBucket{
+			Edges: ent.ProvisionBucketEdges{
+				Currency: &ent.FiatCurrency{},
+			},
+		}
+		assert.Equal(t, orderConf.MatchingStrategy, service.matchingStrategyFor(bucket).Name())
+
+		bucket.Edges.Currency.MatchingStrategy = fiatcurrency.MatchingStrategyTrustWeighted
+		assert.Equal(t, MatchingStrategyTrustWeighted, service.matchingStrategyFor(bucket).Name())
+
+		bucket.MatchingStrategy = provisionbucket.MatchingStrategyRoundRobin
+		assert.Equal(t, MatchingStrategyRoundRobin, service.matchingStrategyFor(bucket).Name())
+	})
+
 	t.Run("TestGetProviderRate", func(t *testing.T) {
-		rate, err := service.GetProviderRate(context.Background(), testCtxForPQ.publicProviderProfile, testCtxForPQ.token.Symbol)
+		rate, err := service.GetProviderRate(context.Background(), testCtxForPQ.publicProviderProfile, testCtxForPQ.token.Symbol, testCtxForPQ.currency.Code)
 		assert.NoError(t, err)
 		_rate, ok := rate.Float64()
 		assert.True(t, ok)
 		assert.Equal(t, _rate, float64(100))
 	})
 
+	t.Run("TestGetStaleRates", func(t *testing.T) {
+		ctx := context.Background()
+
+		staleRates, err := service.GetStaleRates(ctx, testCtxForPQ.publicProviderProfile)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(staleRates))
+
+		_, err = db.Client.ProviderOrderToken.
+			Update().
+			Where(providerordertoken.HasProviderWith(providerprofile.IDEQ(testCtxForPQ.publicProviderProfile.ID))).
+			SetRateStaleSince(time.Now()).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		staleRates, err = service.GetStaleRates(ctx, testCtxForPQ.publicProviderProfile)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(staleRates))
+		assert.Equal(t, testCtxForPQ.token.Symbol, staleRates[0].Symbol)
+		assert.True(t, staleRates[0].Rate.Equal(decimal.NewFromFloat(100)))
+		assert.True(t, staleRates[0].MarketRate.Equal(decimal.NewFromFloat(550)))
+		assert.True(t, staleRates[0].Deviation.Equal(decimal.NewFromFloat(81.82)))
+	})
+
 	t.Run("TestSendOrderRequest", func(t *testing.T) {
 		bucket, err := test.CreateTestProvisionBucket(map[string]interface{}{
 			"provider_id": testCtxForPQ.privateProviderProfile.ID,
@@ -382,6 +483,126 @@ func TestPriorityQueueTest(t *testing.T) {
 		})
 	})
 
+	t.Run("TestAssignLockPaymentOrderMultiCurrency", func(t *testing.T) {
+		ctx := context.Background()
+
+		httpmock.Activate()
+		defer httpmock.Deactivate()
+
+		httpmock.RegisterResponder("POST", testCtxForPQ.publicProviderProfile.HostIdentifier+"/new_order",
+			httpmock.NewBytesResponder(200, nil),
+		)
+
+		ghsInstitution, err := db.Client.Institution.
+			Create().
+			SetName("GCB Bank").
+			SetCode("GHCBGHAC").
+			Save(ctx)
+		assert.NoError(t, err)
+
+		ghs, err := db.Client.FiatCurrency.
+			Create().
+			SetCode("GHS").
+			SetShortName("Cedi").
+			SetDecimals(2).
+			SetSymbol("GH¢").
+			SetName("Ghana Cedi").
+			SetMarketRate(decimal.NewFromFloat(15)).
+			SetIsEnabled(true).
+			AddInstitutions(ghsInstitution).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		// The public provider quotes the token at 100 in KES and at 15 in GHS
+		provider, err := testCtxForPQ.publicProviderProfile.Update().
+			AddCurrencies(ghs).
+			SetIsAvailable(true).
+			SetIsActive(true).
+			SetIsKybVerified(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		_, err = db.Client.ProviderOrderToken.
+			Create().
+			SetSymbol(testCtxForPQ.token.Symbol).
+			SetProvider(provider).
+			SetCurrency(ghs).
+			SetConversionRateType(providerordertoken.ConversionRateTypeFixed).
+			SetFixedConversionRate(decimal.NewFromInt(15)).
+			SetFloatingConversionRate(decimal.Zero).
+			SetMinOrderAmount(decimal.NewFromInt(1)).
+			SetMaxOrderAmount(decimal.NewFromInt(1000)).
+			SetAddresses([]struct {
+				Address string `json:"address"`
+				Network string `json:"network"`
+			}{}).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		_, err = test.CreateTestProvisionBucket(map[string]interface{}{
+			"provider_id": provider.ID,
+			"min_amount":  testCtxForPQ.minAmount,
+			"max_amount":  testCtxForPQ.maxAmount,
+			"currency_id": ghs.ID,
+		})
+		assert.NoError(t, err)
+
+		buckets, err := service.GetProvisionBuckets(ctx, provisionbucket.HasCurrencyWith(fiatcurrency.IDEQ(ghs.ID)))
+		assert.NoError(t, err)
+		assert.Len(t, buckets, 1)
+		assert.Len(t, buckets[0].Edges.ProviderProfiles, 1)
+
+		service.CreatePriorityQueueForBucket(ctx, buckets[0])
+
+		data, err := db.RedisClient.LRange(ctx, bucketQueueKey(buckets[0]), 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fmt.Sprintf("%s:%s:15:1:1000", provider.ID, testCtxForPQ.token.Symbol)}, data)
+
+		assignOrder := func(gatewayID string, rate float64, providerID string, updatedAt time.Time) map[string]string {
+			order, err := test.CreateTestLockPaymentOrder(map[string]interface{}{
+				"tokenID":     testCtxForPQ.token.ID,
+				"gateway_id":  gatewayID,
+				"amount":      10.0,
+				"rate":        rate,
+				"institution": ghsInstitution.Code,
+			})
+			assert.NoError(t, err)
+
+			err = service.AssignLockPaymentOrder(ctx, types.LockPaymentOrderFields{
+				ID:                order.ID,
+				Token:             testCtxForPQ.token,
+				GatewayID:         order.GatewayID,
+				Amount:            order.Amount,
+				Rate:              order.Rate,
+				BlockNumber:       order.BlockNumber,
+				Institution:       order.Institution,
+				AccountIdentifier: order.AccountIdentifier,
+				AccountName:       order.AccountName,
+				ProviderID:        providerID,
+				ProvisionBucket:   buckets[0],
+				UpdatedAt:         updatedAt,
+			})
+			assert.NoError(t, err)
+
+			orderRequest, err := db.RedisClient.HGetAll(ctx, fmt.Sprintf("order_request_%s", order.ID)).Result()
+			assert.NoError(t, err)
+			return orderRequest
+		}
+
+		// Orders of the GHS bucket are matched against the GHS rate, not the KES one
+		orderRequest := assignOrder("order-ghs-1", 15, "", time.Now())
+		assert.Equal(t, provider.ID, orderRequest["providerId"])
+		assert.Equal(t, "150", orderRequest["amount"])
+
+		orderRequest = assignOrder("order-ghs-2", 100, "", time.Now())
+		assert.Empty(t, orderRequest)
+
+		// Stale orders sent to a specific provider are requoted at its rate in the bucket's currency
+		orderRequest = assignOrder("order-ghs-3", 100, provider.ID, time.Now().Add(-time.Hour))
+		assert.Equal(t, provider.ID, orderRequest["providerId"])
+		assert.Equal(t, "150", orderRequest["amount"])
+	})
+
 	// TODO: move these tests to tasks_test.go
 	// t.Run("TestNoErrorFunctions", func(t *testing.T) {
 
@@ -457,3 +678,129 @@ func TestPriorityQueueTest(t *testing.T) {
 	// 	})
 	// })
 }
+
+func TestMultiCurrencyProvider(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:multicurrency?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+	service := NewPriorityQueueService()
+
+	ngn, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	ghs, err := client.FiatCurrency.
+		Create().
+		SetCode("GHS").
+		SetShortName("Cedi").
+		SetDecimals(2).
+		SetSymbol("GH¢").
+		SetName("Ghana Cedi").
+		SetMarketRate(decimal.NewFromFloat(15)).
+		SetIsEnabled(true).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider", "email": "multicurrency@test.com"})
+	assert.NoError(t, err)
+
+	provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+		"user_id":     user.ID,
+		"currency_id": ngn.ID,
+	})
+	assert.NoError(t, err)
+
+	provider, err = provider.Update().
+		AddCurrencies(ghs).
+		SetIsAvailable(true).
+		SetIsActive(true).
+		SetIsKybVerified(true).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	// The provider quotes USDT in both currencies and USDC in NGN only
+	createToken := func(symbol string, currency *ent.FiatCurrency, rate int64) {
+		_, err := client.ProviderOrderToken.
+			Create().
+			SetSymbol(symbol).
+			SetProvider(provider).
+			SetCurrency(currency).
+			SetConversionRateType(providerordertoken.ConversionRateTypeFixed).
+			SetFixedConversionRate(decimal.NewFromInt(rate)).
+			SetFloatingConversionRate(decimal.Zero).
+			SetMinOrderAmount(decimal.NewFromInt(1)).
+			SetMaxOrderAmount(decimal.NewFromInt(100)).
+			SetAddresses([]struct {
+				Address string `json:"address"`
+				Network string `json:"network"`
+			}{}).
+			Save(ctx)
+		assert.NoError(t, err)
+	}
+	createToken("USDT", ngn, 950)
+	createToken("USDC", ngn, 950)
+	createToken("USDT", ghs, 15)
+
+	for _, currency := range []*ent.FiatCurrency{ngn, ghs} {
+		_, err := test.CreateTestProvisionBucket(map[string]interface{}{
+			"provider_id": provider.ID,
+			"min_amount":  decimal.NewFromInt(1),
+			"max_amount":  decimal.NewFromInt(100000),
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+	}
+
+	t.Run("provider is a member of the buckets of each of its currencies", func(t *testing.T) {
+		buckets, err := service.GetProvisionBuckets(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, buckets, 2)
+
+		for _, bucket := range buckets {
+			assert.Len(t, bucket.Edges.ProviderProfiles, 1)
+			assert.Equal(t, provider.ID, bucket.Edges.ProviderProfiles[0].ID)
+		}
+
+		buckets, err = service.GetProvisionBuckets(ctx, provisionbucket.HasCurrencyWith(fiatcurrency.CodeEQ("GHS")))
+		assert.NoError(t, err)
+		assert.Len(t, buckets, 1)
+		assert.Equal(t, "GHS", buckets[0].Edges.Currency.Code)
+	})
+
+	t.Run("bucket entries only use the token configs of the bucket's currency", func(t *testing.T) {
+		buckets, err := service.GetProvisionBuckets(ctx)
+		assert.NoError(t, err)
+
+		for _, bucket := range buckets {
+			queue, bookEntries, exclusions := service.bucketEntries(ctx, bucket, false, nil)
+			assert.Empty(t, exclusions)
+
+			switch bucket.Edges.Currency.Code {
+			case "NGN":
+				assert.Equal(t, []string{
+					provider.ID + ":USDT:950:1:100",
+					provider.ID + ":USDC:950:1:100",
+				}, queue)
+				assert.Len(t, bookEntries, 2)
+			case "GHS":
+				assert.Equal(t, []string{provider.ID + ":USDT:15:1:100"}, queue)
+				assert.Len(t, bookEntries, 1)
+				assert.True(t, bookEntries["USDT"][0].Rate.Equal(decimal.NewFromInt(15)))
+			}
+		}
+	})
+
+	t.Run("provider rate comes from the token config of the requested currency", func(t *testing.T) {
+		rate, err := service.GetProviderRate(ctx, provider, "USDT", "NGN")
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(950)))
+
+		rate, err = service.GetProviderRate(ctx, provider, "USDT", "GHS")
+		assert.NoError(t, err)
+		assert.True(t, rate.Equal(decimal.NewFromInt(15)))
+
+		_, err = service.GetProviderRate(ctx, provider, "USDC", "GHS")
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
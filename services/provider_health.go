@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/providerhealthcheck"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+)
+
+// ProviderHealthService provides functionality related to checking the health of provider nodes
+type ProviderHealthService struct{}
+
+// NewProviderHealthService creates a new instance of ProviderHealthService
+func NewProviderHealthService() *ProviderHealthService {
+	return &ProviderHealthService{}
+}
+
+// CheckProvider calls the /health endpoint of a provider's node and records the result.
+// A provider is marked unhealthy after consecutive failures reach the configured threshold, and healthy again on the next success.
+// The protocol version and capabilities declared by the node are stored on the provider.
+// It returns true if the provider's health status or protocol compatibility changed.
+func (s *ProviderHealthService) CheckProvider(ctx context.Context, provider *ent.ProviderProfile) (bool, error) {
+	start := time.Now()
+	res, err := fastshot.NewClient(provider.HostIdentifier).
+		Config().SetTimeout(orderConf.ProviderHealthCheckTimeout).
+		Build().GET("/health").
+		Send()
+	latency := time.Since(start).Milliseconds()
+
+	checkCreate := storage.Client.ProviderHealthCheck.
+		Create().
+		SetProviderID(provider.ID).
+		SetLatencyMs(latency)
+
+	isHealthy := false
+	protocolVersion := provider.NodeProtocolVersion
+	capabilities := provider.NodeCapabilities
+	if err != nil {
+		checkCreate.SetError(err.Error())
+	} else {
+		checkCreate.SetStatusCode(res.StatusCode())
+		if res.Is2xxSuccessful() {
+			isHealthy = true
+
+			// Nodes declare their protocol version and capabilities in the health check response
+			if data, err := utils.ParseJSONResponse(res.RawResponse); err == nil {
+				protocolVersion, capabilities = ParseNodeHandshake(data)
+			}
+		} else {
+			checkCreate.SetError(res.Status())
+		}
+	}
+
+	if err := checkCreate.SetIsHealthy(isHealthy).Exec(ctx); err != nil {
+		return false, fmt.Errorf("CheckProvider.create: %w", err)
+	}
+
+	streak := 0
+	healthy := provider.IsHealthy
+	if isHealthy {
+		healthy = true
+	} else {
+		streak = provider.HealthFailureStreak + 1
+		if streak >= orderConf.ProviderHealthFailureThreshold {
+			healthy = false
+		}
+	}
+
+	_, err = storage.Client.ProviderProfile.
+		UpdateOneID(provider.ID).
+		SetIsHealthy(healthy).
+		SetHealthFailureStreak(streak).
+		SetLastHealthCheckAt(start).
+		SetNodeProtocolVersion(protocolVersion).
+		SetNodeCapabilities(capabilities).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("CheckProvider.update: %w", err)
+	}
+
+	wasCompatible := IsNodeCompatible(provider)
+	isCompatible := protocolVersion >= orderConf.NodeMinProtocolVersion
+
+	return healthy != provider.IsHealthy || wasCompatible != isCompatible, nil
+}
+
+// GetHealthHistory returns the current health of a provider's node along with a page of its recent health checks
+func (s *ProviderHealthService) GetHealthHistory(ctx context.Context, provider *ent.ProviderProfile, page, offset, pageSize int) (*types.ProviderHealthResponse, error) {
+	checkQuery := storage.Client.ProviderHealthCheck.
+		Query().
+		Where(providerhealthcheck.HasProviderWith(providerprofile.IDEQ(provider.ID)))
+
+	count, err := checkQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetHealthHistory.count: %w", err)
+	}
+
+	checks, err := checkQuery.Clone().
+		Order(ent.Desc(providerhealthcheck.FieldCreatedAt)).
+		Limit(pageSize).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetHealthHistory.checks: %w", err)
+	}
+
+	// Average latency of successful checks over the last day
+	latencies, err := checkQuery.Clone().
+		Where(
+			providerhealthcheck.IsHealthyEQ(true),
+			providerhealthcheck.CreatedAtGTE(time.Now().Add(-24*time.Hour)),
+		).
+		Select(providerhealthcheck.FieldLatencyMs).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetHealthHistory.latencies: %w", err)
+	}
+
+	var averageLatency int64
+	if len(latencies) > 0 {
+		var total int64
+		for _, latency := range latencies {
+			total += int64(latency)
+		}
+		averageLatency = total / int64(len(latencies))
+	}
+
+	response := &types.ProviderHealthResponse{
+		ProviderID:     provider.ID,
+		IsHealthy:      provider.IsHealthy,
+		FailureStreak:  provider.HealthFailureStreak,
+		LastCheckedAt:  provider.LastHealthCheckAt,
+		AverageLatency: averageLatency,
+		TotalRecords:   count,
+		Page:           page,
+		PageSize:       pageSize,
+		Checks:         make([]types.ProviderHealthCheckResponse, 0, len(checks)),
+	}
+
+	for _, check := range checks {
+		response.Checks = append(response.Checks, types.ProviderHealthCheckResponse{
+			IsHealthy:  check.IsHealthy,
+			LatencyMs:  check.LatencyMs,
+			StatusCode: check.StatusCode,
+			Error:      check.Error,
+			CheckedAt:  check.CreatedAt,
+		})
+	}
+
+	return response, nil
+}
+
+// PruneHealthChecks deletes health checks older than the configured retention period
+func (s *ProviderHealthService) PruneHealthChecks(ctx context.Context) error {
+	_, err := storage.Client.ProviderHealthCheck.
+		Delete().
+		Where(providerhealthcheck.CreatedAtLT(time.Now().Add(-orderConf.ProviderHealthCheckRetention))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("PruneHealthChecks: %w", err)
+	}
+
+	return nil
+}
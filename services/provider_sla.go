@@ -0,0 +1,342 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+)
+
+// ProviderSLAService provides functionality related to tracking provider SLAs and applying penalties
+type ProviderSLAService struct{}
+
+// NewProviderSLAService creates a new instance of ProviderSLAService
+func NewProviderSLAService() *ProviderSLAService {
+	return &ProviderSLAService{}
+}
+
+// RecordAssignment starts tracking an order request sent to a provider
+func (s *ProviderSLAService) RecordAssignment(ctx context.Context, orderID uuid.UUID, providerID string) error {
+	err := storage.Client.ProviderSLARecord.
+		Create().
+		SetOrderID(orderID).
+		SetProviderID(providerID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordAssignment: %w", err)
+	}
+
+	return nil
+}
+
+// RecordAcceptance marks the provider's open order request as accepted
+func (s *ProviderSLAService) RecordAcceptance(ctx context.Context, orderID uuid.UUID, providerID string) error {
+	_, err := storage.Client.ProviderSLARecord.
+		Update().
+		Where(
+			providerslarecord.HasOrderWith(lockpaymentorder.IDEQ(orderID)),
+			providerslarecord.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerslarecord.AcceptedAtIsNil(),
+			providerslarecord.DeclinedAtIsNil(),
+			providerslarecord.BreachIsNil(),
+		).
+		SetAcceptedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordAcceptance: %w", err)
+	}
+
+	return nil
+}
+
+// RecordDecline marks the provider's open order request as declined
+func (s *ProviderSLAService) RecordDecline(ctx context.Context, orderID uuid.UUID, providerID string) error {
+	_, err := storage.Client.ProviderSLARecord.
+		Update().
+		Where(
+			providerslarecord.HasOrderWith(lockpaymentorder.IDEQ(orderID)),
+			providerslarecord.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerslarecord.AcceptedAtIsNil(),
+			providerslarecord.DeclinedAtIsNil(),
+			providerslarecord.BreachIsNil(),
+		).
+		SetDeclinedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordDecline: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseAssignments removes the open SLA records of providers whose order request was taken from them before
+// they responded, as when another recipient of a broadcast order request accepts it first
+func (s *ProviderSLAService) ReleaseAssignments(ctx context.Context, orderID uuid.UUID, providerIDs []string) error {
+	_, err := storage.Client.ProviderSLARecord.
+		Delete().
+		Where(
+			providerslarecord.HasOrderWith(lockpaymentorder.IDEQ(orderID)),
+			providerslarecord.HasProviderWith(providerprofile.IDIn(providerIDs...)),
+			providerslarecord.AcceptedAtIsNil(),
+			providerslarecord.DeclinedAtIsNil(),
+			providerslarecord.BreachIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("ReleaseAssignments: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFulfillment marks the order as fulfilled by the provider it is assigned to.
+// Records of providers that accepted the order before it was reassigned are left as they are.
+func (s *ProviderSLAService) RecordFulfillment(ctx context.Context, orderID uuid.UUID) error {
+	providerID, err := orderProviderID(ctx, orderID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("RecordFulfillment.provider: %w", err)
+	}
+
+	_, err = storage.Client.ProviderSLARecord.
+		Update().
+		Where(
+			providerslarecord.HasOrderWith(lockpaymentorder.IDEQ(orderID)),
+			providerslarecord.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerslarecord.AcceptedAtNotNil(),
+			providerslarecord.FulfilledAtIsNil(),
+		).
+		SetFulfilledAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordFulfillment: %w", err)
+	}
+
+	return nil
+}
+
+// RecordValidation marks the order as validated for the provider it is assigned to
+func (s *ProviderSLAService) RecordValidation(ctx context.Context, orderID uuid.UUID) error {
+	now := time.Now()
+
+	providerID, err := orderProviderID(ctx, orderID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("RecordValidation.provider: %w", err)
+	}
+
+	records, err := storage.Client.ProviderSLARecord.
+		Query().
+		Where(
+			providerslarecord.HasOrderWith(lockpaymentorder.IDEQ(orderID)),
+			providerslarecord.HasProviderWith(providerprofile.IDEQ(providerID)),
+			providerslarecord.AcceptedAtNotNil(),
+			providerslarecord.ValidatedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordValidation: %w", err)
+	}
+
+	for _, record := range records {
+		// Providers may report a successful validation without reporting the fulfillment first
+		update := record.Update().SetValidatedAt(now)
+		if record.FulfilledAt.IsZero() {
+			update.SetFulfilledAt(now)
+		}
+
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("RecordValidation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// orderProviderID returns the ID of the provider an order is assigned to
+func orderProviderID(ctx context.Context, orderID uuid.UUID) (string, error) {
+	return storage.Client.LockPaymentOrder.
+		Query().
+		Where(lockpaymentorder.IDEQ(orderID)).
+		QueryProvider().
+		OnlyID(ctx)
+}
+
+// RecordAcceptTimeout records a breach for an order request that expired before the provider accepted it
+func (s *ProviderSLAService) RecordAcceptTimeout(ctx context.Context, orderID uuid.UUID) error {
+	_, err := storage.Client.ProviderSLARecord.
+		Update().
+		Where(
+			providerslarecord.HasOrderWith(lockpaymentorder.IDEQ(orderID)),
+			providerslarecord.AcceptedAtIsNil(),
+			providerslarecord.DeclinedAtIsNil(),
+			providerslarecord.BreachIsNil(),
+		).
+		SetBreach(providerslarecord.BreachAcceptTimeout).
+		SetBreachedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordAcceptTimeout: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFulfillmentTimeouts records a breach for accepted orders not fulfilled within the fulfillment validity
+func (s *ProviderSLAService) RecordFulfillmentTimeouts(ctx context.Context) error {
+	_, err := storage.Client.ProviderSLARecord.
+		Update().
+		Where(
+			providerslarecord.AcceptedAtLTE(time.Now().Add(-orderConf.OrderFulfillmentValidity)),
+			providerslarecord.FulfilledAtIsNil(),
+			providerslarecord.BreachIsNil(),
+		).
+		SetBreach(providerslarecord.BreachFulfillmentTimeout).
+		SetBreachedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("RecordFulfillmentTimeouts: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyPenalties demotes or suspends a provider whose SLA breaches within the breach window reach the configured thresholds.
+// Breaches that happened before a penalty ended don't count towards the next penalty of the same kind.
+// It returns true if a new penalty was applied.
+func (s *ProviderSLAService) ApplyPenalties(ctx context.Context, provider *ent.ProviderProfile) (bool, error) {
+	now := time.Now()
+	windowStart := now.Add(-orderConf.ProviderSLABreachWindow)
+
+	countBreachesSince := func(since time.Time) (int, error) {
+		if since.Before(windowStart) {
+			since = windowStart
+		}
+
+		return storage.Client.ProviderSLARecord.
+			Query().
+			Where(
+				providerslarecord.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+				providerslarecord.BreachNotNil(),
+				providerslarecord.BreachedAtGTE(since),
+			).
+			Count(ctx)
+	}
+
+	if orderConf.ProviderSLASuspensionThreshold > 0 && !provider.SuspendedUntil.After(now) {
+		breaches, err := countBreachesSince(provider.SuspendedUntil)
+		if err != nil {
+			return false, fmt.Errorf("ApplyPenalties.suspension: %w", err)
+		}
+
+		if breaches >= orderConf.ProviderSLASuspensionThreshold {
+			_, err = provider.Update().
+				SetSuspendedUntil(now.Add(orderConf.ProviderSLASuspensionDuration)).
+				Save(ctx)
+			if err != nil {
+				return false, fmt.Errorf("ApplyPenalties.suspend: %w", err)
+			}
+			return true, nil
+		}
+	}
+
+	if orderConf.ProviderSLADemotionThreshold > 0 && !provider.DemotedUntil.After(now) {
+		breaches, err := countBreachesSince(provider.DemotedUntil)
+		if err != nil {
+			return false, fmt.Errorf("ApplyPenalties.demotion: %w", err)
+		}
+
+		if breaches >= orderConf.ProviderSLADemotionThreshold {
+			_, err = provider.Update().
+				SetDemotedUntil(now.Add(orderConf.ProviderSLADemotionDuration)).
+				Save(ctx)
+			if err != nil {
+				return false, fmt.Errorf("ApplyPenalties.demote: %w", err)
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetReport returns the SLA metrics of a provider for orders assigned within a time range
+func (s *ProviderSLAService) GetReport(ctx context.Context, provider *ent.ProviderProfile, from, to time.Time) (*types.ProviderSLAReport, error) {
+	records, err := storage.Client.ProviderSLARecord.
+		Query().
+		Where(
+			providerslarecord.HasProviderWith(providerprofile.IDEQ(provider.ID)),
+			providerslarecord.AssignedAtGTE(from),
+			providerslarecord.AssignedAtLTE(to),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetReport: %w", err)
+	}
+
+	report := &types.ProviderSLAReport{
+		ProviderID:    provider.ID,
+		From:          from,
+		To:            to,
+		TotalAssigned: len(records),
+	}
+
+	toAccept := []float64{}
+	toFulfill := []float64{}
+	toValidate := []float64{}
+
+	for _, record := range records {
+		if !record.AcceptedAt.IsZero() {
+			toAccept = append(toAccept, record.AcceptedAt.Sub(record.AssignedAt).Seconds())
+		}
+		if !record.FulfilledAt.IsZero() && !record.AcceptedAt.IsZero() {
+			toFulfill = append(toFulfill, record.FulfilledAt.Sub(record.AcceptedAt).Seconds())
+		}
+		if !record.ValidatedAt.IsZero() && !record.FulfilledAt.IsZero() {
+			toValidate = append(toValidate, record.ValidatedAt.Sub(record.FulfilledAt).Seconds())
+		}
+
+		switch record.Breach {
+		case providerslarecord.BreachAcceptTimeout:
+			report.AcceptTimeouts++
+		case providerslarecord.BreachFulfillmentTimeout:
+			report.FulfillmentTimeouts++
+		}
+	}
+
+	report.TimeToAccept = slaPercentiles(toAccept)
+	report.TimeToFulfill = slaPercentiles(toFulfill)
+	report.TimeToValidate = slaPercentiles(toValidate)
+
+	now := time.Now()
+	if provider.DemotedUntil.After(now) {
+		report.DemotedUntil = &provider.DemotedUntil
+	}
+	if provider.SuspendedUntil.After(now) {
+		report.SuspendedUntil = &provider.SuspendedUntil
+	}
+
+	return report, nil
+}
+
+// slaPercentiles computes the percentiles of a set of durations in seconds
+func slaPercentiles(durations []float64) types.SLAPercentiles {
+	return types.SLAPercentiles{
+		Count: len(durations),
+		P50:   utils.Percentile(durations, 50),
+		P90:   utils.Percentile(durations, 90),
+		P99:   utils.Percentile(durations, 99),
+	}
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/lockpaymentorder"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderSLA(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:providersla?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+	service := NewProviderSLAService()
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	createProvider := func(email string) string {
+		user, err := test.CreateTestUser(map[string]interface{}{"scope": "provider", "email": email})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":     user.ID,
+			"currency_id": currency.ID,
+		})
+		assert.NoError(t, err)
+		return provider.ID
+	}
+	first := createProvider("first@test.com")
+	second := createProvider("second@test.com")
+
+	network, err := client.Network.
+		Create().
+		SetChainID(1).
+		SetIdentifier("test").
+		SetRPCEndpoint("").
+		SetIsTestnet(true).
+		SetFee(decimal.Zero).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	token, err := client.Token.
+		Create().
+		SetSymbol("USDT").
+		SetContractAddress("0x").
+		SetDecimals(6).
+		SetNetwork(network).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	// The order was accepted and cancelled by the first provider, then reassigned to the second
+	order, err := client.LockPaymentOrder.
+		Create().
+		SetGatewayID("").
+		SetAmount(decimal.NewFromInt(100)).
+		SetRate(decimal.NewFromInt(1)).
+		SetOrderPercent(decimal.NewFromInt(100)).
+		SetBlockNumber(0).
+		SetInstitution("GTBINGLA").
+		SetAccountIdentifier("").
+		SetAccountName("").
+		SetStatus(lockpaymentorder.StatusProcessing).
+		SetToken(token).
+		SetProviderID(second).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	for _, providerID := range []string{first, second} {
+		assert.NoError(t, service.RecordAssignment(ctx, order.ID, providerID))
+		assert.NoError(t, service.RecordAcceptance(ctx, order.ID, providerID))
+	}
+
+	record := func(providerID string) (time.Time, time.Time) {
+		r := client.ProviderSLARecord.
+			Query().
+			Where(providerslarecord.HasProviderWith(providerprofile.IDEQ(providerID))).
+			OnlyX(ctx)
+		return r.FulfilledAt, r.ValidatedAt
+	}
+
+	t.Run("records the fulfillment for the assigned provider only", func(t *testing.T) {
+		assert.NoError(t, service.RecordFulfillment(ctx, order.ID))
+
+		fulfilledAt, _ := record(second)
+		assert.False(t, fulfilledAt.IsZero())
+
+		fulfilledAt, _ = record(first)
+		assert.True(t, fulfilledAt.IsZero())
+	})
+
+	t.Run("records the validation for the assigned provider only", func(t *testing.T) {
+		assert.NoError(t, service.RecordValidation(ctx, order.ID))
+
+		_, validatedAt := record(second)
+		assert.False(t, validatedAt.IsZero())
+
+		fulfilledAt, validatedAt := record(first)
+		assert.True(t, fulfilledAt.IsZero())
+		assert.True(t, validatedAt.IsZero())
+	})
+}
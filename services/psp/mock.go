@@ -0,0 +1,65 @@
+package psp
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/paycrest/aggregator/types"
+)
+
+// MockPSPName is the PSP name the mock adapter is registered under
+const MockPSPName = "mock"
+
+// MockAdapter is a PSP adapter for local testing.
+// Transfers report the status set for them, otherwise a state derived from the tx_id prefix:
+// "failed-" transfers fail, "pending-" transfers are pending and all others succeed.
+// Only transfers set with SetTransfer report an amount, currency and beneficiary, so others are validated on state alone.
+type MockAdapter struct {
+	mu        sync.RWMutex
+	transfers map[string]types.PSPTransferStatus
+}
+
+// NewMockAdapter creates a new instance of MockAdapter
+func NewMockAdapter() *MockAdapter {
+	return &MockAdapter{
+		transfers: map[string]types.PSPTransferStatus{},
+	}
+}
+
+// SetTransferState sets the state reported for a transfer
+func (a *MockAdapter) SetTransferState(txID string, state types.PSPTransferState) {
+	a.SetTransfer(txID, types.PSPTransferStatus{State: state})
+}
+
+// SetTransfer sets the status reported for a transfer
+func (a *MockAdapter) SetTransfer(txID string, status types.PSPTransferStatus) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.transfers[txID] = status
+}
+
+// GetTransferStatus looks up the status of a transfer
+func (a *MockAdapter) GetTransferStatus(ctx context.Context, txID string) (*types.PSPTransferStatus, error) {
+	a.mu.RLock()
+	status, ok := a.transfers[txID]
+	a.mu.RUnlock()
+
+	if !ok {
+		switch {
+		case strings.HasPrefix(txID, "failed-"):
+			status.State = types.PSPTransferFailed
+		case strings.HasPrefix(txID, "pending-"):
+			status.State = types.PSPTransferPending
+		default:
+			status.State = types.PSPTransferSuccess
+		}
+	}
+
+	if status.State == types.PSPTransferFailed && status.Message == "" {
+		status.Message = "Transfer failed at mock PSP"
+	}
+
+	return &status, nil
+}
@@ -0,0 +1,54 @@
+package psp
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/paycrest/aggregator/config"
+	"github.com/paycrest/aggregator/types"
+)
+
+var orderConf = config.OrderConfig()
+
+var (
+	adaptersMu sync.RWMutex
+	adapters   = map[string]types.PSPAdapter{}
+)
+
+// Register registers the adapter used to look up transfers made through a PSP.
+// PSP names are matched case-insensitively against LockOrderFulfillment.psp.
+func Register(name string, adapter types.PSPAdapter) {
+	adaptersMu.Lock()
+	defer adaptersMu.Unlock()
+
+	adapters[normalizeName(name)] = adapter
+}
+
+// Unregister removes the adapter of a PSP
+func Unregister(name string) {
+	adaptersMu.Lock()
+	defer adaptersMu.Unlock()
+
+	delete(adapters, normalizeName(name))
+}
+
+// Get returns the adapter of a PSP and whether one is registered
+func Get(name string) (types.PSPAdapter, bool) {
+	adaptersMu.RLock()
+	defer adaptersMu.RUnlock()
+
+	adapter, ok := adapters[normalizeName(name)]
+	return adapter, ok
+}
+
+// RegisterAdapters registers the PSP adapters enabled in the configuration
+func RegisterAdapters() {
+	if orderConf.PSPMockEnabled {
+		Register(MockPSPName, NewMockAdapter())
+	}
+}
+
+// normalizeName normalizes a PSP name for lookups
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/predicate"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+)
+
+// rateSnapshotBatchSize bounds the snapshots created or deleted in one statement
+const rateSnapshotBatchSize = 1000
+
+// maxRateCandles bounds the candles returned for one request
+const maxRateCandles = 1000
+
+// RateCandleIntervals are the intervals rate candles can be requested for
+var RateCandleIntervals = map[string]time.Duration{
+	"15m": 15 * time.Minute,
+	"1h":  time.Hour,
+	"4h":  4 * time.Hour,
+	"1d":  24 * time.Hour,
+	"1w":  7 * 24 * time.Hour,
+}
+
+// ErrTooManyCandles is returned when a candle request spans more intervals than are returned at once
+var ErrTooManyCandles = fmt.Errorf("time range spans more than %d candles", maxRateCandles)
+
+// RateHistoryService keeps the time series of market, external and provider-quoted rates of currencies
+type RateHistoryService struct{}
+
+// NewRateHistoryService creates a new instance of RateHistoryService
+func NewRateHistoryService() *RateHistoryService {
+	return &RateHistoryService{}
+}
+
+// Record stores rates observed at a time in the rate history
+func (s *RateHistoryService) Record(ctx context.Context, recordedAt time.Time, observations ...types.RateObservation) error {
+	builders := make([]*ent.RateSnapshotCreate, 0, len(observations))
+	for _, observation := range observations {
+		builder := storage.Client.RateSnapshot.
+			Create().
+			SetCurrencyID(observation.CurrencyID).
+			SetKind(observation.Kind).
+			SetOpen(observation.Rate).
+			SetHigh(observation.Rate).
+			SetLow(observation.Rate).
+			SetClose(observation.Rate).
+			SetRecordedAt(recordedAt)
+
+		if observation.ProviderID != "" {
+			builder.SetProviderID(observation.ProviderID)
+		}
+
+		if observation.Token != "" {
+			builder.SetToken(observation.Token)
+		}
+
+		builders = append(builders, builder)
+	}
+
+	for start := 0; start < len(builders); start += rateSnapshotBatchSize {
+		end := min(start+rateSnapshotBatchSize, len(builders))
+		if err := storage.Client.RateSnapshot.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			return fmt.Errorf("Record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetCandles returns the OHLC candles of the rates selected by the filter between two times, oldest first.
+// Periods without rates have no candle.
+func (s *RateHistoryService) GetCandles(ctx context.Context, filter types.RateHistoryFilter, interval time.Duration, from, to time.Time) ([]*types.RateCandleResponse, error) {
+	if int(to.Sub(from)/interval) > maxRateCandles {
+		return nil, ErrTooManyCandles
+	}
+
+	snapshots, err := storage.Client.RateSnapshot.
+		Query().
+		Where(append(
+			rateHistoryPredicates(filter),
+			ratesnapshot.RecordedAtGTE(from),
+			ratesnapshot.RecordedAtLT(to),
+		)...).
+		Order(ent.Asc(ratesnapshot.FieldRecordedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetCandles: %w", err)
+	}
+
+	candles := []*types.RateCandleResponse{}
+	for _, snapshot := range snapshots {
+		period := snapshot.RecordedAt.UTC().Truncate(interval)
+
+		if len(candles) == 0 || !candles[len(candles)-1].Time.Equal(period) {
+			candles = append(candles, &types.RateCandleResponse{
+				Time: period,
+				Open: snapshot.Open,
+				High: snapshot.High,
+				Low:  snapshot.Low,
+			})
+		}
+
+		candle := candles[len(candles)-1]
+		if snapshot.High.GreaterThan(candle.High) {
+			candle.High = snapshot.High
+		}
+		if snapshot.Low.LessThan(candle.Low) {
+			candle.Low = snapshot.Low
+		}
+		candle.Close = snapshot.Close
+		candle.SampleCount += snapshot.SampleCount
+	}
+
+	return candles, nil
+}
+
+// GetRateAt returns the last rate selected by the filter recorded at or before a time, such as the creation of an order.
+// Where the rates of the time were downsampled, it is the close of the hour or day.
+func (s *RateHistoryService) GetRateAt(ctx context.Context, filter types.RateHistoryFilter, at time.Time) (*types.HistoricalRateResponse, error) {
+	snapshot, err := storage.Client.RateSnapshot.
+		Query().
+		Where(append(rateHistoryPredicates(filter), ratesnapshot.RecordedAtLTE(at))...).
+		Order(ent.Desc(ratesnapshot.FieldRecordedAt)).
+		First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetRateAt: %w", err)
+	}
+
+	return &types.HistoricalRateResponse{
+		Rate:       snapshot.Close,
+		RecordedAt: snapshot.RecordedAt,
+		Resolution: string(snapshot.Resolution),
+	}, nil
+}
+
+// ApplyRetention downsamples raw rates past their retention into hourly snapshots and those into daily snapshots,
+// and deletes daily snapshots past the retention of the rate history.
+// It returns the number of snapshots removed.
+func (s *RateHistoryService) ApplyRetention(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	hourly, err := s.downsample(ctx, ratesnapshot.ResolutionRaw, ratesnapshot.ResolutionHour, time.Hour, now.Add(-orderConf.RateHistoryRawRetention))
+	if err != nil {
+		return 0, fmt.Errorf("ApplyRetention: %w", err)
+	}
+
+	daily, err := s.downsample(ctx, ratesnapshot.ResolutionHour, ratesnapshot.ResolutionDay, 24*time.Hour, now.Add(-orderConf.RateHistoryHourlyRetention))
+	if err != nil {
+		return hourly, fmt.Errorf("ApplyRetention: %w", err)
+	}
+
+	deleted, err := storage.Client.RateSnapshot.
+		Delete().
+		Where(
+			ratesnapshot.ResolutionEQ(ratesnapshot.ResolutionDay),
+			ratesnapshot.RecordedAtLT(now.Add(-orderConf.RateHistoryRetention)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return hourly + daily, fmt.Errorf("ApplyRetention.delete: %w", err)
+	}
+
+	return hourly + daily + deleted, nil
+}
+
+// downsample replaces the snapshots of a resolution recorded in whole periods before a time with one snapshot
+// per period of each series. It returns the number of snapshots removed.
+func (s *RateHistoryService) downsample(ctx context.Context, from, to ratesnapshot.Resolution, period time.Duration, before time.Time) (int, error) {
+	snapshots, err := storage.Client.RateSnapshot.
+		Query().
+		Where(
+			ratesnapshot.ResolutionEQ(from),
+			ratesnapshot.RecordedAtLT(before.UTC().Truncate(period)),
+		).
+		WithCurrency(func(fq *ent.FiatCurrencyQuery) {
+			fq.Select(fiatcurrency.FieldID)
+		}).
+		WithProvider(func(pq *ent.ProviderProfileQuery) {
+			pq.Select(providerprofile.FieldID)
+		}).
+		Order(ent.Asc(ratesnapshot.FieldRecordedAt)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("downsample: %w", err)
+	}
+
+	if len(snapshots) == 0 {
+		return 0, nil
+	}
+
+	// Group the snapshots by series and period, keeping the order in which the groups are first seen
+	type group struct {
+		first    *ent.RateSnapshot
+		snapshot types.RateCandleResponse
+	}
+	groups := map[string]*group{}
+	keys := []string{}
+	ids := make([]uuid.UUID, 0, len(snapshots))
+
+	for _, snapshot := range snapshots {
+		ids = append(ids, snapshot.ID)
+
+		providerID := ""
+		if snapshot.Edges.Provider != nil {
+			providerID = snapshot.Edges.Provider.ID
+		}
+
+		periodStart := snapshot.RecordedAt.UTC().Truncate(period)
+		key := strings.Join([]string{
+			snapshot.Edges.Currency.ID.String(), string(snapshot.Kind), providerID, snapshot.Token, periodStart.Format(time.RFC3339),
+		}, "|")
+
+		g, ok := groups[key]
+		if !ok {
+			g = &group{
+				first: snapshot,
+				snapshot: types.RateCandleResponse{
+					Time: periodStart,
+					Open: snapshot.Open,
+					High: snapshot.High,
+					Low:  snapshot.Low,
+				},
+			}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+
+		if snapshot.High.GreaterThan(g.snapshot.High) {
+			g.snapshot.High = snapshot.High
+		}
+		if snapshot.Low.LessThan(g.snapshot.Low) {
+			g.snapshot.Low = snapshot.Low
+		}
+		g.snapshot.Close = snapshot.Close
+		g.snapshot.SampleCount += snapshot.SampleCount
+	}
+
+	tx, err := storage.Client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("downsample.tx: %w", err)
+	}
+
+	builders := make([]*ent.RateSnapshotCreate, 0, len(keys))
+	for _, key := range keys {
+		g := groups[key]
+
+		builder := tx.RateSnapshot.
+			Create().
+			SetCurrencyID(g.first.Edges.Currency.ID).
+			SetKind(g.first.Kind).
+			SetResolution(to).
+			SetOpen(g.snapshot.Open).
+			SetHigh(g.snapshot.High).
+			SetLow(g.snapshot.Low).
+			SetClose(g.snapshot.Close).
+			SetSampleCount(g.snapshot.SampleCount).
+			SetRecordedAt(g.snapshot.Time)
+
+		if g.first.Edges.Provider != nil {
+			builder.SetProviderID(g.first.Edges.Provider.ID)
+		}
+
+		if g.first.Token != "" {
+			builder.SetToken(g.first.Token)
+		}
+
+		builders = append(builders, builder)
+	}
+
+	for start := 0; start < len(builders); start += rateSnapshotBatchSize {
+		end := min(start+rateSnapshotBatchSize, len(builders))
+		if err := tx.RateSnapshot.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("downsample.create: %w", err)
+		}
+	}
+
+	for start := 0; start < len(ids); start += rateSnapshotBatchSize {
+		end := min(start+rateSnapshotBatchSize, len(ids))
+		if _, err := tx.RateSnapshot.Delete().Where(ratesnapshot.IDIn(ids[start:end]...)).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return 0, fmt.Errorf("downsample.delete: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("downsample.commit: %w", err)
+	}
+
+	return len(ids), nil
+}
+
+// rateHistoryPredicates returns the predicates selecting the snapshots of a filter
+func rateHistoryPredicates(filter types.RateHistoryFilter) []predicate.RateSnapshot {
+	predicates := []predicate.RateSnapshot{
+		ratesnapshot.HasCurrencyWith(fiatcurrency.CodeEQ(strings.ToUpper(filter.Currency))),
+		ratesnapshot.KindEQ(filter.Kind),
+	}
+
+	if filter.ProviderID != "" {
+		predicates = append(predicates, ratesnapshot.HasProviderWith(providerprofile.IDEQ(filter.ProviderID)))
+	}
+
+	if filter.Token != "" {
+		predicates = append(predicates, ratesnapshot.TokenEQ(strings.ToUpper(filter.Token)))
+	}
+
+	return predicates
+}
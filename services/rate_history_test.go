@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/ent/enttest"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
+	db "github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/test"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateHistory(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ratehistory?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+	service := NewRateHistoryService()
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	market := types.RateHistoryFilter{Currency: currency.Code, Kind: ratesnapshot.KindMarket}
+	record := func(at time.Time, rate float64) {
+		err := service.Record(ctx, at, types.RateObservation{
+			CurrencyID: currency.ID,
+			Kind:       ratesnapshot.KindMarket,
+			Rate:       decimal.NewFromFloat(rate),
+		})
+		assert.NoError(t, err)
+	}
+
+	start := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	for i, rate := range []float64{1500, 1520, 1490, 1510, 1530, 1525} {
+		record(start.Add(time.Duration(i)*30*time.Minute), rate)
+	}
+
+	// Rates of other kinds are kept apart
+	err = service.Record(ctx, start, types.RateObservation{CurrencyID: currency.ID, Kind: ratesnapshot.KindExternal, Rate: decimal.NewFromInt(2000)})
+	assert.NoError(t, err)
+
+	t.Run("returns OHLC candles of an interval", func(t *testing.T) {
+		candles, err := service.GetCandles(ctx, market, time.Hour, start, start.Add(3*time.Hour))
+		assert.NoError(t, err)
+		assert.Len(t, candles, 3)
+
+		assert.True(t, candles[0].Time.Equal(start))
+		assert.Equal(t, "1500", candles[0].Open.String())
+		assert.Equal(t, "1520", candles[0].High.String())
+		assert.Equal(t, "1500", candles[0].Low.String())
+		assert.Equal(t, "1520", candles[0].Close.String())
+		assert.Equal(t, 2, candles[0].SampleCount)
+
+		assert.Equal(t, "1490", candles[1].Low.String())
+		assert.Equal(t, "1525", candles[2].Close.String())
+
+		_, err = service.GetCandles(ctx, market, 15*time.Minute, start.AddDate(-1, 0, 0), start)
+		assert.ErrorIs(t, err, ErrTooManyCandles)
+	})
+
+	t.Run("returns the rate at a point in time", func(t *testing.T) {
+		rate, err := service.GetRateAt(ctx, market, start.Add(95*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, "1510", rate.Rate.String())
+		assert.Equal(t, "raw", rate.Resolution)
+
+		_, err = service.GetRateAt(ctx, market, start.Add(-time.Minute))
+		assert.Error(t, err)
+	})
+
+	t.Run("downsamples rates past their retention", func(t *testing.T) {
+		conf := *orderConf
+		defer func() { *orderConf = conf }()
+
+		// Raw rates before the last of the recorded hours are downsampled, daily snapshots are kept
+		orderConf.RateHistoryRawRetention = time.Since(start.Add(2 * time.Hour))
+		orderConf.RateHistoryHourlyRetention = time.Since(start.AddDate(0, 0, -1))
+		orderConf.RateHistoryRetention = time.Since(start.AddDate(-1, 0, 0))
+
+		removed, err := service.ApplyRetention(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, removed)
+
+		hourly := client.RateSnapshot.Query().Where(ratesnapshot.ResolutionEQ(ratesnapshot.ResolutionHour)).AllX(ctx)
+		assert.Len(t, hourly, 3)
+
+		// Candles are the same after downsampling
+		candles, err := service.GetCandles(ctx, market, time.Hour, start, start.Add(3*time.Hour))
+		assert.NoError(t, err)
+		assert.Len(t, candles, 3)
+		assert.Equal(t, "1520", candles[0].High.String())
+		assert.Equal(t, 2, candles[0].SampleCount)
+		assert.Equal(t, "1490", candles[1].Low.String())
+		assert.Equal(t, "1510", candles[1].Close.String())
+
+		rate, err := service.GetRateAt(ctx, market, start.Add(95*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, "1510", rate.Rate.String())
+		assert.Equal(t, "hour", rate.Resolution)
+	})
+}
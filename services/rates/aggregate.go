@@ -0,0 +1,165 @@
+package rates
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// AggregationMedian takes the weighted median of the rates of a currency's sources
+	AggregationMedian = "median"
+	// AggregationTrimmedMean takes the weighted mean of the rates of a currency's sources, without the highest and lowest
+	AggregationTrimmedMean = "trimmed_mean"
+)
+
+// WeightedSource is a rate source configured for a currency or pair
+type WeightedSource struct {
+	Name   string
+	Weight decimal.Decimal
+}
+
+// Quote is a rate returned by a source
+type Quote struct {
+	Source string
+	Rate   decimal.Decimal
+	Weight decimal.Decimal
+}
+
+// ParseSourceConfig parses the rate sources configured per currency, or per token and currency pair.
+// Entries are separated by semicolons and their sources by commas, with an optional weight that defaults to 1:
+// "NGN=quidax:2,binance_p2p;KES=binance_p2p;CUSD/KES=file". The sources of a currency are used for the tokens
+// listed in RATE_SOURCE_TOKENS without sources of their own in the currency.
+func ParseSourceConfig(value string) (map[string][]WeightedSource, error) {
+	configured := map[string][]WeightedSource{}
+
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		currency, list, found := strings.Cut(entry, "=")
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if !found || currency == "" {
+			return nil, fmt.Errorf("invalid rate sources of %q", entry)
+		}
+
+		// Sources of a pair are keyed by the pair, such as USDC/NGN
+		if token, fiat, isPair := strings.Cut(currency, "/"); isPair {
+			token, fiat = strings.TrimSpace(token), strings.TrimSpace(fiat)
+			if token == "" || fiat == "" {
+				return nil, fmt.Errorf("invalid rate sources of %q", entry)
+			}
+			currency = pairKey(token, fiat)
+		}
+
+		for _, item := range strings.Split(list, ",") {
+			name, weightValue, hasWeight := strings.Cut(strings.TrimSpace(item), ":")
+			name = normalizeName(name)
+			if name == "" {
+				continue
+			}
+
+			weight := decimal.NewFromInt(1)
+			if hasWeight {
+				parsed, err := decimal.NewFromString(strings.TrimSpace(weightValue))
+				if err != nil || !parsed.IsPositive() {
+					return nil, fmt.Errorf("invalid weight of rate source %s for %s", name, currency)
+				}
+				weight = parsed
+			}
+
+			configured[currency] = append(configured[currency], WeightedSource{Name: name, Weight: weight})
+		}
+	}
+
+	return configured, nil
+}
+
+// Aggregate combines the rates of a currency's sources into one.
+// trimPercent is the percentage of the total weight trimmed from each end for a trimmed mean.
+func Aggregate(quotes []Quote, method string, trimPercent decimal.Decimal) (decimal.Decimal, error) {
+	if len(quotes) == 0 {
+		return decimal.Zero, fmt.Errorf("Aggregate: no rates")
+	}
+
+	sorted := make([]Quote, len(quotes))
+	copy(sorted, quotes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Rate.LessThan(sorted[j].Rate)
+	})
+
+	switch method {
+	case AggregationMedian, "":
+		return weightedMedian(sorted), nil
+	case AggregationTrimmedMean:
+		trim := trimPercent.Div(decimal.NewFromInt(100))
+		if trim.IsNegative() || trim.GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+			return weightedMedian(sorted), nil
+		}
+		return weightedTrimmedMean(sorted, trim), nil
+	default:
+		return decimal.Zero, fmt.Errorf("Aggregate: unknown aggregation %s", method)
+	}
+}
+
+// weightedMedian returns the rate at the middle of the total weight of quotes sorted by rate.
+// When the middle falls between two quotes their rates are averaged, so equal weights give the plain median.
+func weightedMedian(sorted []Quote) decimal.Decimal {
+	half := totalWeight(sorted).Div(decimal.NewFromInt(2))
+
+	cumulative := decimal.Zero
+	for i, quote := range sorted {
+		cumulative = cumulative.Add(quote.Weight)
+
+		if cumulative.Equal(half) && i+1 < len(sorted) {
+			return quote.Rate.Add(sorted[i+1].Rate).Div(decimal.NewFromInt(2))
+		}
+
+		if cumulative.GreaterThan(half) {
+			return quote.Rate
+		}
+	}
+
+	return sorted[len(sorted)-1].Rate
+}
+
+// weightedTrimmedMean returns the weighted mean of quotes sorted by rate, without the fraction trim of the total weight
+// at each end. Quotes straddling a cut count with the part of their weight inside it.
+func weightedTrimmedMean(sorted []Quote, trim decimal.Decimal) decimal.Decimal {
+	total := totalWeight(sorted)
+	lower := total.Mul(trim)
+	upper := total.Sub(lower)
+
+	sum := decimal.Zero
+	kept := decimal.Zero
+	cumulative := decimal.Zero
+	for _, quote := range sorted {
+		start := cumulative
+		cumulative = cumulative.Add(quote.Weight)
+
+		weight := decimal.Min(cumulative, upper).Sub(decimal.Max(start, lower))
+		if weight.IsPositive() {
+			sum = sum.Add(quote.Rate.Mul(weight))
+			kept = kept.Add(weight)
+		}
+	}
+
+	if kept.IsZero() {
+		return weightedMedian(sorted)
+	}
+
+	return sum.Div(kept)
+}
+
+// totalWeight returns the sum of the weights of quotes
+func totalWeight(quotes []Quote) decimal.Decimal {
+	total := decimal.Zero
+	for _, quote := range quotes {
+		total = total.Add(quote.Weight)
+	}
+	return total
+}
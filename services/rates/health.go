@@ -0,0 +1,62 @@
+package rates
+
+import (
+	"sync"
+	"time"
+
+	"github.com/paycrest/aggregator/utils/logger"
+)
+
+// sourceHealth tracks the consecutive failures of a rate source for a token and currency pair
+type sourceHealth struct {
+	failures      int
+	disabledUntil time.Time
+}
+
+var (
+	healthMu sync.Mutex
+	health   = map[string]*sourceHealth{}
+)
+
+// healthKey returns the key of the health of a rate source for a pair
+func healthKey(name, pair string) string {
+	return normalizeName(name) + ":" + pair
+}
+
+// isHealthy checks whether a rate source is used for a pair, that is it isn't cooling down after repeated failures
+func isHealthy(name, pair string) bool {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+
+	h, ok := health[healthKey(name, pair)]
+	return !ok || time.Now().After(h.disabledUntil)
+}
+
+// recordSuccess resets the failures of a rate source for a pair
+func recordSuccess(name, pair string) {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+
+	delete(health, healthKey(name, pair))
+}
+
+// recordFailure counts a failed or outlying rate of a source for a pair.
+// The source is dropped for the cooldown once its consecutive failures reach the threshold.
+func recordFailure(name, pair string) {
+	healthMu.Lock()
+	defer healthMu.Unlock()
+
+	key := healthKey(name, pair)
+	h, ok := health[key]
+	if !ok {
+		h = &sourceHealth{}
+		health[key] = h
+	}
+
+	h.failures++
+	if h.failures >= orderConf.RateSourceFailureThreshold {
+		h.failures = 0
+		h.disabledUntil = time.Now().Add(orderConf.RateSourceCooldown)
+		logger.Errorf("rate source %s dropped for %s until %s", name, pair, h.disabledUntil.Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,227 @@
+package rates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/paycrest/aggregator/config"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+var orderConf = config.OrderConfig()
+
+// ErrNoRateSource is returned when no rate source is configured for a token and currency
+var ErrNoRateSource = errors.New("no rate source configured for currency")
+
+var (
+	sourcesMu sync.RWMutex
+	sources   = map[string]types.RateSource{}
+)
+
+// Register registers a rate source under its name
+func Register(source types.RateSource) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+
+	sources[normalizeName(source.Name())] = source
+}
+
+// Unregister removes a rate source
+func Unregister(name string) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+
+	delete(sources, normalizeName(name))
+}
+
+// Get returns a rate source and whether one is registered under the name
+func Get(name string) (types.RateSource, bool) {
+	sourcesMu.RLock()
+	defer sourcesMu.RUnlock()
+
+	source, ok := sources[normalizeName(name)]
+	return source, ok
+}
+
+// RegisterSources registers the built-in rate sources, and the file source if one is configured
+func RegisterSources() {
+	Register(NewQuidaxSource(QuidaxBaseURL))
+	Register(NewBinanceP2PSource(BinanceP2PBaseURL))
+
+	if orderConf.RateSourceFile != "" {
+		Register(NewFileSource(orderConf.RateSourceFile))
+	}
+}
+
+// IsSupported checks whether at least one rate source is configured for a token in a currency
+func IsSupported(token, currency string) bool {
+	configured, err := ParseSourceConfig(orderConf.RateSources)
+	if err != nil {
+		return false
+	}
+
+	return len(sourcesFor(configured, token, currency)) > 0
+}
+
+// FetchRate fetches the rate of a token in a currency from its configured sources and aggregates it.
+// Sources that are unhealthy are skipped, and rates too far from the aggregated rate are dropped.
+func FetchRate(ctx context.Context, token, currency string) (decimal.Decimal, error) {
+	rate, _, err := FetchQuotes(ctx, token, currency)
+	return rate, err
+}
+
+// FetchQuotes fetches the rate of a token in a currency like FetchRate, and also returns the rates of the sources it was aggregated from
+func FetchQuotes(ctx context.Context, token, currency string) (decimal.Decimal, []Quote, error) {
+	pair := pairKey(token, currency)
+
+	configured, err := ParseSourceConfig(orderConf.RateSources)
+	if err != nil {
+		return decimal.Zero, nil, fmt.Errorf("FetchRate: %w", err)
+	}
+
+	weighted := sourcesFor(configured, token, currency)
+	if len(weighted) == 0 {
+		return decimal.Zero, nil, fmt.Errorf("FetchRate %s: %w", pair, ErrNoRateSource)
+	}
+
+	quotes := fetchQuotes(ctx, strings.ToUpper(token), strings.ToUpper(currency), weighted)
+	if len(quotes) == 0 {
+		return decimal.Zero, nil, fmt.Errorf("FetchRate %s: no rate source returned a rate", pair)
+	}
+
+	rate, err := Aggregate(quotes, orderConf.RateAggregation, orderConf.RateTrimPercent)
+	if err != nil {
+		return decimal.Zero, nil, fmt.Errorf("FetchRate %s: %w", pair, err)
+	}
+
+	kept := make([]Quote, 0, len(quotes))
+	for _, quote := range quotes {
+		// An outlier is only told apart from the others when there are enough of them
+		deviation := utils.AbsPercentageDeviation(rate, quote.Rate)
+		if len(quotes) >= 3 && deviation.GreaterThan(orderConf.RateSourceMaxDeviation) {
+			logger.Errorf("FetchRate %s: dropped rate %s of source %s, %s%% from %s", pair, quote.Rate, quote.Source, deviation.StringFixed(2), rate)
+			recordFailure(quote.Source, pair)
+			continue
+		}
+
+		recordSuccess(quote.Source, pair)
+		kept = append(kept, quote)
+	}
+
+	if len(kept) == len(quotes) {
+		return rate, kept, nil
+	}
+
+	rate, err = Aggregate(kept, orderConf.RateAggregation, orderConf.RateTrimPercent)
+	if err != nil {
+		return decimal.Zero, nil, fmt.Errorf("FetchRate %s: %w", pair, err)
+	}
+
+	return rate, kept, nil
+}
+
+// fetchQuotes fetches the rate of a token in a currency from the healthy sources among those configured, at once.
+// It returns the quotes fetched by the time every source is done or the context is done.
+func fetchQuotes(ctx context.Context, token, currency string, weighted []WeightedSource) []Quote {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		quotes []Quote
+	)
+
+	pair := pairKey(token, currency)
+
+	for _, ws := range weighted {
+		source, ok := Get(ws.Name)
+		if !ok {
+			logger.Errorf("FetchRate %s: rate source %s is not registered", pair, ws.Name)
+			continue
+		}
+
+		if !isHealthy(ws.Name, pair) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(ws WeightedSource, source types.RateSource) {
+			defer wg.Done()
+
+			rate, err := source.FetchRate(ctx, token, currency)
+			if err == nil && !rate.IsPositive() {
+				err = fmt.Errorf("invalid rate %s", rate)
+			}
+			if err != nil {
+				logger.Errorf("FetchRate %s: rate source %s: %v", pair, ws.Name, err)
+
+				// Running out of time is not the source's failure
+				if ctx.Err() == nil {
+					recordFailure(ws.Name, pair)
+				}
+				return
+			}
+
+			mu.Lock()
+			quotes = append(quotes, Quote{Source: ws.Name, Rate: rate, Weight: ws.Weight})
+			mu.Unlock()
+		}(ws, source)
+	}
+
+	// Quotes still being fetched when the context is done are left out
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return append([]Quote{}, quotes...)
+}
+
+// sourcesFor returns the sources configured for a token in a currency.
+// Sources configured for the pair take precedence over those configured for the currency, which only
+// price the tokens opted in with RATE_SOURCE_TOKENS.
+func sourcesFor(configured map[string][]WeightedSource, token, currency string) []WeightedSource {
+	if weighted := configured[pairKey(token, currency)]; len(weighted) > 0 {
+		return weighted
+	}
+
+	if !usesCurrencySources(token) {
+		return nil
+	}
+
+	return configured[strings.ToUpper(currency)]
+}
+
+// usesCurrencySources checks whether a token is priced through the sources of its currency
+func usesCurrencySources(token string) bool {
+	for _, symbol := range strings.Split(orderConf.RateSourceTokens, ",") {
+		if strings.EqualFold(strings.TrimSpace(symbol), token) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pairKey returns the key of a token and currency pair, such as USDC/NGN
+func pairKey(token, currency string) string {
+	return strings.ToUpper(token) + "/" + strings.ToUpper(currency)
+}
+
+// normalizeName normalizes a rate source name for lookups
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
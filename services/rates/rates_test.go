@@ -0,0 +1,232 @@
+package rates
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubSource is a rate source returning a set rate or error, after an optional delay
+type stubSource struct {
+	name  string
+	rate  decimal.Decimal
+	err   error
+	delay time.Duration
+}
+
+func (s *stubSource) Name() string {
+	return s.name
+}
+
+func (s *stubSource) FetchRate(ctx context.Context, token, currency string) (decimal.Decimal, error) {
+	select {
+	case <-time.After(s.delay):
+		return s.rate, s.err
+	case <-ctx.Done():
+		return decimal.Zero, ctx.Err()
+	}
+}
+
+func TestRates(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("parses the sources configured per currency", func(t *testing.T) {
+		configured, err := ParseSourceConfig("ngn=quidax:2, binance_p2p ; KES=binance_p2p; usdc / ngn=file")
+		assert.NoError(t, err)
+		assert.Len(t, configured, 3)
+		assert.Equal(t, []WeightedSource{
+			{Name: "quidax", Weight: decimal.NewFromInt(2)},
+			{Name: "binance_p2p", Weight: decimal.NewFromInt(1)},
+		}, configured["NGN"])
+		assert.Len(t, configured["KES"], 1)
+		assert.Equal(t, []WeightedSource{{Name: "file", Weight: decimal.NewFromInt(1)}}, configured["USDC/NGN"])
+
+		// Only opted-in tokens without sources of their own use those of the currency
+		conf := *orderConf
+		defer func() { *orderConf = conf }()
+
+		orderConf.RateSourceTokens = "USDT"
+		assert.Equal(t, configured["USDC/NGN"], sourcesFor(configured, "usdc", "ngn"))
+		assert.Equal(t, configured["NGN"], sourcesFor(configured, "usdt", "NGN"))
+		assert.Empty(t, sourcesFor(configured, "CUSD", "NGN"))
+
+		orderConf.RateSourceTokens = "USDT, cusd"
+		assert.Equal(t, configured["NGN"], sourcesFor(configured, "CUSD", "NGN"))
+
+		_, err = ParseSourceConfig("NGN=quidax:0")
+		assert.Error(t, err)
+
+		_, err = ParseSourceConfig("quidax")
+		assert.Error(t, err)
+
+		_, err = ParseSourceConfig("/NGN=quidax")
+		assert.Error(t, err)
+	})
+
+	t.Run("aggregates rates by weighted median and trimmed mean", func(t *testing.T) {
+		quote := func(rate, weight int64) Quote {
+			return Quote{Rate: decimal.NewFromInt(rate), Weight: decimal.NewFromInt(weight)}
+		}
+
+		rate, err := Aggregate([]Quote{quote(1500, 1), quote(1400, 1), quote(1600, 1)}, AggregationMedian, decimal.Zero)
+		assert.NoError(t, err)
+		assert.Equal(t, "1500", rate.String())
+
+		rate, err = Aggregate([]Quote{quote(1400, 1), quote(1500, 1)}, AggregationMedian, decimal.Zero)
+		assert.NoError(t, err)
+		assert.Equal(t, "1450", rate.String())
+
+		rate, err = Aggregate([]Quote{quote(1400, 1), quote(1500, 1), quote(1600, 3)}, AggregationMedian, decimal.Zero)
+		assert.NoError(t, err)
+		assert.Equal(t, "1600", rate.String())
+
+		// A fifth of the weight is trimmed from each end, the outlier with it
+		rate, err = Aggregate([]Quote{quote(1000, 1), quote(1500, 1), quote(1500, 1), quote(1600, 1), quote(1600, 1)}, AggregationTrimmedMean, decimal.NewFromInt(20))
+		assert.NoError(t, err)
+		assert.Equal(t, "1533.3333333333333333", rate.String())
+
+		_, err = Aggregate([]Quote{quote(1500, 1)}, "mode", decimal.Zero)
+		assert.Error(t, err)
+
+		_, err = Aggregate(nil, AggregationMedian, decimal.Zero)
+		assert.Error(t, err)
+	})
+
+	t.Run("fetches rates from HTTP and file sources", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Path {
+			case "/api/v1/markets/tickers/usdtngn":
+				_, _ = w.Write([]byte(`{"data": {"ticker": {"buy": "1510.5"}}}`))
+			case "/api/v1/markets/tickers/usdcngn":
+				_, _ = w.Write([]byte(`{"data": {"ticker": {"buy": "1508"}}}`))
+			case "/bapi/c2c/v2/friendly/c2c/adv/search":
+				_, _ = w.Write([]byte(`{"data": [{"adv": {"price": "129.1"}}, {"adv": {"price": "129.5"}}, {"adv": {}}, {"adv": {"price": "130"}}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"data": {}}`))
+			}
+		}))
+		defer server.Close()
+
+		rate, err := NewQuidaxSource(server.URL).FetchRate(ctx, "USDT", "NGN")
+		assert.NoError(t, err)
+		assert.Equal(t, "1510.5", rate.String())
+
+		rate, err = NewQuidaxSource(server.URL).FetchRate(ctx, "USDC", "NGN")
+		assert.NoError(t, err)
+		assert.Equal(t, "1508", rate.String())
+
+		_, err = NewQuidaxSource(server.URL).FetchRate(ctx, "USDT", "GHS")
+		assert.Error(t, err)
+
+		rate, err = NewBinanceP2PSource(server.URL).FetchRate(ctx, "USDT", "KES")
+		assert.NoError(t, err)
+		assert.Equal(t, "129.5", rate.String())
+
+		path := filepath.Join(t.TempDir(), "rates.json")
+		assert.NoError(t, os.WriteFile(path, []byte(`{"NGN": "1500.25", "USDC/NGN": "1499", "KES": 129}`), 0o600))
+
+		rate, err = NewFileSource(path).FetchRate(ctx, "usdt", "ngn")
+		assert.NoError(t, err)
+		assert.Equal(t, "1500.25", rate.String())
+
+		rate, err = NewFileSource(path).FetchRate(ctx, "usdc", "ngn")
+		assert.NoError(t, err)
+		assert.Equal(t, "1499", rate.String())
+
+		_, err = NewFileSource(path).FetchRate(ctx, "USDT", "GHS")
+		assert.Error(t, err)
+	})
+
+	t.Run("supports any currency with a configured source and drops bad sources", func(t *testing.T) {
+		conf := *orderConf
+		defer func() { *orderConf = conf }()
+
+		orderConf.RateSources = "GHS=stub_a,stub_b,stub_c:2,stub_d"
+		orderConf.RateSourceTokens = "USDT"
+		orderConf.RateAggregation = AggregationMedian
+		orderConf.RateSourceMaxDeviation = decimal.NewFromInt(5)
+		orderConf.RateSourceFailureThreshold = 2
+
+		sourceA := &stubSource{name: "stub_a", rate: decimal.NewFromInt(15)}
+		sourceB := &stubSource{name: "stub_b", rate: decimal.NewFromInt(15)}
+		sourceC := &stubSource{name: "stub_c", rate: decimal.NewFromFloat(15.2)}
+		sourceD := &stubSource{name: "stub_d", rate: decimal.NewFromInt(30)}
+		for _, source := range []*stubSource{sourceA, sourceB, sourceC, sourceD} {
+			Register(source)
+			defer Unregister(source.name)
+		}
+
+		assert.True(t, IsSupported("usdt", "ghs"))
+		assert.False(t, IsSupported("USDT", "XAF"))
+		assert.False(t, IsSupported("USDC", "GHS"))
+
+		_, err := FetchRate(ctx, "USDT", "XAF")
+		assert.True(t, errors.Is(err, ErrNoRateSource))
+
+		// The outlier is dropped from the rate
+		rate, err := FetchRate(ctx, "USDT", "GHS")
+		assert.NoError(t, err)
+		assert.Equal(t, "15.1", rate.String())
+		assert.True(t, isHealthy("stub_d", "USDT/GHS"))
+
+		// Sources are dropped once their failures reach the threshold
+		sourceB.err = errors.New("unavailable")
+		_, err = FetchRate(ctx, "USDT", "GHS")
+		assert.NoError(t, err)
+		assert.False(t, isHealthy("stub_d", "USDT/GHS"))
+		assert.True(t, isHealthy("stub_b", "USDT/GHS"))
+
+		// Sources are dropped per token
+		assert.True(t, isHealthy("stub_d", "USDC/GHS"))
+
+		rate, err = FetchRate(ctx, "USDT", "GHS")
+		assert.NoError(t, err)
+		assert.Equal(t, "15.2", rate.String())
+		assert.False(t, isHealthy("stub_b", "USDT/GHS"))
+		assert.True(t, isHealthy("stub_a", "USDT/GHS"))
+
+		// No source returns a rate
+		sourceA.err = errors.New("unavailable")
+		sourceC.err = errors.New("unavailable")
+		_, err = FetchRate(ctx, "USDT", "GHS")
+		assert.Error(t, err)
+	})
+
+	t.Run("stops waiting for sources when the context is done", func(t *testing.T) {
+		conf := *orderConf
+		defer func() { *orderConf = conf }()
+
+		orderConf.RateSources = "UGX=stub_fast;CUSD/UGX=stub_fast,stub_slow"
+		orderConf.RateAggregation = AggregationMedian
+		orderConf.RateSourceFailureThreshold = 1
+
+		sourceFast := &stubSource{name: "stub_fast", rate: decimal.NewFromInt(3700)}
+		sourceSlow := &stubSource{name: "stub_slow", rate: decimal.NewFromInt(3800), delay: time.Minute}
+		for _, source := range []*stubSource{sourceFast, sourceSlow} {
+			Register(source)
+			defer Unregister(source.name)
+		}
+
+		deadlineCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		rate, err := FetchRate(deadlineCtx, "CUSD", "UGX")
+		assert.NoError(t, err)
+		assert.Equal(t, "3700", rate.String())
+		assert.Less(t, time.Since(start), time.Second)
+
+		// A source cut off by the deadline isn't counted as failing
+		time.Sleep(10 * time.Millisecond)
+		assert.True(t, isHealthy("stub_slow", "CUSD/UGX"))
+	})
+}
@@ -0,0 +1,196 @@
+package rates
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	fastshot "github.com/opus-domini/fast-shot"
+	"github.com/paycrest/aggregator/utils"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// QuidaxBaseURL is the base URL of the Quidax API
+	QuidaxBaseURL = "https://www.quidax.com"
+	// BinanceP2PBaseURL is the base URL of the Binance P2P API
+	BinanceP2PBaseURL = "https://p2p.binance.com"
+)
+
+// QuidaxSource fetches the buy price of the market of a token in a currency on Quidax
+type QuidaxSource struct {
+	baseURL string
+}
+
+// NewQuidaxSource creates a new instance of QuidaxSource
+func NewQuidaxSource(baseURL string) *QuidaxSource {
+	return &QuidaxSource{baseURL: baseURL}
+}
+
+// Name returns the name the source is configured with
+func (s *QuidaxSource) Name() string {
+	return "quidax"
+}
+
+// FetchRate fetches the rate of a token in a currency
+func (s *QuidaxSource) FetchRate(ctx context.Context, token, currency string) (decimal.Decimal, error) {
+	res, err := fastshot.NewClient(s.baseURL).
+		Config().SetTimeout(30*time.Second).
+		Build().GET(fmt.Sprintf("/api/v1/markets/tickers/%s%s", strings.ToLower(token), strings.ToLower(currency))).
+		Context().Set(ctx).
+		Retry().Set(3, 5*time.Second).
+		Send()
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("QuidaxSource: %w", err)
+	}
+
+	data, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("QuidaxSource: %w %v", err, data)
+	}
+
+	market, ok := data["data"].(map[string]interface{})
+	if !ok {
+		return decimal.Zero, fmt.Errorf("QuidaxSource: no data in the response")
+	}
+
+	ticker, ok := market["ticker"].(map[string]interface{})
+	if !ok {
+		return decimal.Zero, fmt.Errorf("QuidaxSource: no ticker in the response")
+	}
+
+	buy, ok := ticker["buy"].(string)
+	if !ok {
+		return decimal.Zero, fmt.Errorf("QuidaxSource: no buy price in the response")
+	}
+
+	price, err := decimal.NewFromString(buy)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("QuidaxSource: %w", err)
+	}
+
+	return price, nil
+}
+
+// BinanceP2PSource fetches the median price of the sell adverts of a token in a currency on Binance P2P
+type BinanceP2PSource struct {
+	baseURL string
+}
+
+// NewBinanceP2PSource creates a new instance of BinanceP2PSource
+func NewBinanceP2PSource(baseURL string) *BinanceP2PSource {
+	return &BinanceP2PSource{baseURL: baseURL}
+}
+
+// Name returns the name the source is configured with
+func (s *BinanceP2PSource) Name() string {
+	return "binance_p2p"
+}
+
+// FetchRate fetches the rate of a token in a currency
+func (s *BinanceP2PSource) FetchRate(ctx context.Context, token, currency string) (decimal.Decimal, error) {
+	res, err := fastshot.NewClient(s.baseURL).
+		Config().SetTimeout(30*time.Second).
+		Header().Add("Content-Type", "application/json").
+		Build().POST("/bapi/c2c/v2/friendly/c2c/adv/search").
+		Context().Set(ctx).
+		Retry().Set(3, 5*time.Second).
+		Body().AsJSON(map[string]interface{}{
+		"asset":     strings.ToUpper(token),
+		"fiat":      strings.ToUpper(currency),
+		"tradeType": "SELL",
+		"page":      1,
+		"rows":      20,
+	}).
+		Send()
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("BinanceP2PSource: %w", err)
+	}
+
+	resData, err := utils.ParseJSONResponse(res.RawResponse)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("BinanceP2PSource: %w", err)
+	}
+
+	// Access the data array
+	data, ok := resData["data"].([]interface{})
+	if !ok || len(data) == 0 {
+		return decimal.Zero, fmt.Errorf("BinanceP2PSource: no data in the response")
+	}
+
+	// Loop through the data array and extract prices
+	var prices []decimal.Decimal
+	for _, item := range data {
+		advert, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		adv, ok := advert["adv"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		priceValue, ok := adv["price"].(string)
+		if !ok {
+			continue
+		}
+
+		price, err := decimal.NewFromString(priceValue)
+		if err != nil {
+			continue
+		}
+
+		prices = append(prices, price)
+	}
+
+	if len(prices) == 0 {
+		return decimal.Zero, fmt.Errorf("BinanceP2PSource: no prices in the response")
+	}
+
+	return utils.Median(prices), nil
+}
+
+// FileSource reads rates from a JSON file of rates by token and currency pair or by currency,
+// such as {"USDC/NGN": "1499.8", "NGN": "1500.5"}. The rate of a currency is used for tokens without a rate of their own.
+// The file is read on every fetch, so rates can be changed while the aggregator runs, as in tests and local setups.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource creates a new instance of FileSource
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// Name returns the name the source is configured with
+func (s *FileSource) Name() string {
+	return "file"
+}
+
+// FetchRate fetches the rate of a token in a currency
+func (s *FileSource) FetchRate(ctx context.Context, token, currency string) (decimal.Decimal, error) {
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("FileSource: %w", err)
+	}
+
+	var rates map[string]decimal.Decimal
+	if err := json.Unmarshal(content, &rates); err != nil {
+		return decimal.Zero, fmt.Errorf("FileSource: %w", err)
+	}
+
+	if rate, ok := rates[pairKey(token, currency)]; ok {
+		return rate, nil
+	}
+
+	rate, ok := rates[strings.ToUpper(currency)]
+	if !ok {
+		return decimal.Zero, fmt.Errorf("FileSource: no rate for %s", pairKey(token, currency))
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,391 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
+	"github.com/paycrest/aggregator/ent/institution"
+	"github.com/paycrest/aggregator/ent/providerordertoken"
+	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/storage"
+	"github.com/paycrest/aggregator/types"
+	"github.com/paycrest/aggregator/utils/logger"
+	"github.com/shopspring/decimal"
+)
+
+// Strategies a lock payment order can be split by
+const (
+	// SplitStrategyCapacity splits an order into the fewest chunks the eligible providers have capacity for
+	SplitStrategyCapacity = "capacity"
+
+	// SplitStrategyBucketSize splits an order into chunks the size of the largest bucket, regardless of providers
+	SplitStrategyBucketSize = "bucket_size"
+)
+
+// settlePercentPlaces is the number of decimal places of the order percent the gateway contract settles,
+// which takes the percent in thousandths
+const settlePercentPlaces = 3
+
+// splitBucket is the fiat range of a provision bucket
+type splitBucket struct {
+	ID  int
+	Min decimal.Decimal
+	Max decimal.Decimal
+}
+
+// splitCandidate is a provider a chunk of a split order can be planned for
+type splitCandidate struct {
+	ProviderID string
+	Demoted    bool
+
+	// Fiat range of a chunk the provider can take
+	MinFiat decimal.Decimal
+	MaxFiat decimal.Decimal
+
+	// Buckets the provider is in
+	Buckets []splitBucket
+}
+
+// bucketFor returns the bucket of a candidate a fiat amount falls in
+func (c splitCandidate) bucketFor(amount decimal.Decimal) (splitBucket, bool) {
+	for _, bucket := range c.Buckets {
+		if amount.GreaterThanOrEqual(bucket.Min) && amount.LessThanOrEqual(bucket.Max) {
+			return bucket, true
+		}
+	}
+	return splitBucket{}, false
+}
+
+// SplitPlanner plans how a lock payment order too large for a single bucket is split into chunks
+type SplitPlanner struct {
+	priorityQueue *PriorityQueueService
+}
+
+// NewSplitPlanner creates a new instance of SplitPlanner
+func NewSplitPlanner(priorityQueue *PriorityQueueService) *SplitPlanner {
+	return &SplitPlanner{
+		priorityQueue: priorityQueue,
+	}
+}
+
+// Plan plans the chunks a lock payment order is split into, and returns the buckets of the currency by ID.
+//
+// Chunks are planned for the eligible providers quoting a rate within tolerance of the order rate, each chunk
+// capped by its provider's max order amount and buckets. When their capacity can't cover the order, the order
+// is split into bucket-sized chunks instead.
+func (p *SplitPlanner) Plan(ctx context.Context, order types.LockPaymentOrderFields, currency *ent.FiatCurrency) (*types.SplitPlan, map[int]*ent.ProvisionBucket, error) {
+	buckets, err := p.priorityQueue.GetProvisionBuckets(ctx, provisionbucket.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Plan.buckets: %w", err)
+	}
+
+	if len(buckets) == 0 {
+		return nil, nil, fmt.Errorf("Plan: no provision buckets for %s", currency.Code)
+	}
+
+	bucketsByID := make(map[int]*ent.ProvisionBucket, len(buckets))
+	bucketRanges := make([]splitBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		bucketsByID[bucket.ID] = bucket
+		bucketRanges = append(bucketRanges, splitBucket{ID: bucket.ID, Min: bucket.MinAmount, Max: bucket.MaxAmount})
+	}
+
+	totalFiat := order.Amount.Mul(order.Rate)
+
+	plan := &types.SplitPlan{
+		Strategy:    SplitStrategyCapacity,
+		TotalAmount: order.Amount,
+	}
+
+	candidates, err := p.candidates(ctx, order, buckets)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Plan.candidates: %w", err)
+	}
+
+	chunks, ok := planCapacityChunks(totalFiat, candidates, orderConf.OrderSplitMaxChunks)
+	if !ok {
+		plan.Strategy = SplitStrategyBucketSize
+		plan.Reason = "Provider capacity can't cover the order"
+		chunks, ok = planBucketChunks(totalFiat, bucketRanges)
+		if !ok {
+			return nil, nil, fmt.Errorf("Plan: %s can't be split into the provision buckets of %s", totalFiat, currency.Code)
+		}
+	}
+
+	plan.Chunks = allocateChunkAmounts(order.Amount, totalFiat, chunks)
+
+	return plan, bucketsByID, nil
+}
+
+// candidates returns the providers in the given buckets chunks of an order can be planned for
+func (p *SplitPlanner) candidates(ctx context.Context, order types.LockPaymentOrderFields, buckets []*ent.ProvisionBucket) ([]splitCandidate, error) {
+	orderInstitution, err := storage.Client.Institution.
+		Query().
+		Where(institution.CodeEQ(order.Institution)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("institution: %w", err)
+	}
+
+	// Group the buckets each eligible provider is in
+	providers := map[string]*ent.ProviderProfile{}
+	providerBuckets := map[string][]splitBucket{}
+	currencyCode := ""
+	for _, bucket := range buckets {
+		currencyCode = bucket.Edges.Currency.Code
+		for _, provider := range bucket.Edges.ProviderProfiles {
+			providers[provider.ID] = provider
+			providerBuckets[provider.ID] = append(providerBuckets[provider.ID], splitBucket{
+				ID:  bucket.ID,
+				Min: bucket.MinAmount,
+				Max: bucket.MaxAmount,
+			})
+		}
+	}
+
+	candidates := []splitCandidate{}
+	for providerID, provider := range providers {
+		tokenConfig, err := storage.Client.ProviderOrderToken.
+			Query().
+			Where(
+				providerordertoken.HasProviderWith(providerprofile.IDEQ(providerID)),
+				providerordertoken.SymbolEQ(order.Token.Symbol),
+				providerordertoken.HasCurrencyWith(fiatcurrency.CodeEQ(currencyCode)),
+			).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("token config: %w", err)
+		}
+
+		rate, err := p.priorityQueue.GetProviderRate(ctx, provider, order.Token.Symbol, currencyCode)
+		if err != nil {
+			logger.Errorf("failed to get %s rate for provider %s: %v", order.Token.Symbol, providerID, err)
+			continue
+		}
+
+		if rate.Sub(order.Rate).Abs().GreaterThan(matchingRateTolerance) {
+			continue
+		}
+
+		supported, err := p.priorityQueue.providerSupportsInstitution(ctx, providerID, orderInstitution)
+		if err != nil {
+			return nil, fmt.Errorf("institution support: %w", err)
+		}
+
+		if !supported {
+			continue
+		}
+
+		candidate := splitCandidate{
+			ProviderID: providerID,
+			Demoted:    provider.DemotedUntil.After(time.Now()),
+			MinFiat:    tokenConfig.MinOrderAmount.Mul(order.Rate),
+			MaxFiat:    tokenConfig.MaxOrderAmount.Mul(order.Rate),
+			Buckets:    providerBuckets[providerID],
+		}
+
+		// A chunk can't be larger than the largest bucket the provider is in, nor smaller than the smallest
+		largestBucketMax := decimal.Zero
+		smallestBucketMin := candidate.Buckets[0].Min
+		for _, bucket := range candidate.Buckets {
+			largestBucketMax = decimal.Max(largestBucketMax, bucket.Max)
+			smallestBucketMin = decimal.Min(smallestBucketMin, bucket.Min)
+		}
+		candidate.MaxFiat = decimal.Min(candidate.MaxFiat, largestBucketMax)
+		candidate.MinFiat = decimal.Max(candidate.MinFiat, smallestBucketMin)
+
+		if candidate.MinFiat.GreaterThan(candidate.MaxFiat) {
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// planCapacityChunks plans the fewest chunks of a fiat amount the candidates have capacity for, one per provider
+// and at most maxChunks. It returns false if the candidates can't cover the amount.
+func planCapacityChunks(totalFiat decimal.Decimal, candidates []splitCandidate, maxChunks int) ([]types.SplitPlanChunk, bool) {
+	// Taking the providers with the most capacity first gives the fewest chunks
+	sorted := make([]splitCandidate, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Demoted != sorted[j].Demoted {
+			return !sorted[i].Demoted
+		}
+		if !sorted[i].MaxFiat.Equal(sorted[j].MaxFiat) {
+			return sorted[i].MaxFiat.GreaterThan(sorted[j].MaxFiat)
+		}
+		return sorted[i].ProviderID < sorted[j].ProviderID
+	})
+
+	chosen := []splitCandidate{}
+	capacity := decimal.Zero
+	for _, candidate := range sorted {
+		if capacity.GreaterThanOrEqual(totalFiat) || len(chosen) == maxChunks {
+			break
+		}
+		chosen = append(chosen, candidate)
+		capacity = capacity.Add(candidate.MaxFiat)
+	}
+
+	if len(chosen) == 0 || capacity.LessThan(totalFiat) {
+		return nil, false
+	}
+
+	// Fill each chunk to the provider's capacity and leave the remainder to the last one
+	amounts := make([]decimal.Decimal, len(chosen))
+	remaining := totalFiat
+	for i, candidate := range chosen {
+		amounts[i] = decimal.Min(candidate.MaxFiat, remaining)
+		remaining = remaining.Sub(amounts[i])
+	}
+
+	// Raise the last chunk to its provider's minimum by taking from the earlier chunks
+	last := len(chosen) - 1
+	for i := last - 1; i >= 0 && amounts[last].LessThan(chosen[last].MinFiat); i-- {
+		shift := decimal.Min(chosen[last].MinFiat.Sub(amounts[last]), amounts[i].Sub(chosen[i].MinFiat))
+		if shift.IsPositive() {
+			amounts[i] = amounts[i].Sub(shift)
+			amounts[last] = amounts[last].Add(shift)
+		}
+	}
+
+	chunks := make([]types.SplitPlanChunk, 0, len(chosen))
+	for i, candidate := range chosen {
+		if amounts[i].LessThan(candidate.MinFiat) {
+			return nil, false
+		}
+
+		bucket, ok := candidate.bucketFor(amounts[i])
+		if !ok {
+			return nil, false
+		}
+
+		chunks = append(chunks, types.SplitPlanChunk{
+			FiatAmount: amounts[i],
+			BucketID:   bucket.ID,
+			ProviderID: candidate.ProviderID,
+		})
+	}
+
+	return chunks, true
+}
+
+// planBucketChunks splits a fiat amount into chunks the size of the largest bucket, with the remainder in the
+// bucket it falls in. A remainder that falls in no bucket is split with the previous chunk so both fall in a
+// bucket. It returns false if the amount can't be split that way.
+func planBucketChunks(totalFiat decimal.Decimal, buckets []splitBucket) ([]types.SplitPlanChunk, bool) {
+	sorted := make([]splitBucket, len(buckets))
+	copy(sorted, buckets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Max.GreaterThan(sorted[j].Max)
+	})
+	largest := sorted[0]
+
+	bucketFor := func(amount decimal.Decimal) (splitBucket, bool) {
+		for _, bucket := range sorted {
+			if amount.GreaterThanOrEqual(bucket.Min) && amount.LessThanOrEqual(bucket.Max) {
+				return bucket, true
+			}
+		}
+		return splitBucket{}, false
+	}
+
+	chunks := []types.SplitPlanChunk{}
+	remaining := totalFiat
+	for remaining.IsPositive() {
+		if bucket, ok := bucketFor(remaining); ok {
+			chunks = append(chunks, types.SplitPlanChunk{FiatAmount: remaining, BucketID: bucket.ID})
+			break
+		}
+
+		if remaining.LessThan(largest.Max) {
+			// Split the remainder together with the previous chunk into two chunks that both fall in a bucket
+			if len(chunks) > 0 {
+				remaining = remaining.Add(chunks[len(chunks)-1].FiatAmount)
+				chunks = chunks[:len(chunks)-1]
+			}
+
+			head, tail, ok := splitAcrossBuckets(remaining, sorted)
+			if !ok {
+				return nil, false
+			}
+			chunks = append(chunks, head, tail)
+			break
+		}
+
+		chunks = append(chunks, types.SplitPlanChunk{FiatAmount: largest.Max, BucketID: largest.ID})
+		remaining = remaining.Sub(largest.Max)
+	}
+
+	return chunks, true
+}
+
+// splitAcrossBuckets splits a fiat amount into two chunks that each fall in a bucket, keeping the first chunk
+// as large as possible. It returns false if no two buckets can take the amount.
+func splitAcrossBuckets(amount decimal.Decimal, buckets []splitBucket) (types.SplitPlanChunk, types.SplitPlanChunk, bool) {
+	for _, headBucket := range buckets {
+		for _, tailBucket := range buckets {
+			// The tail has to fall in its bucket and leave a head that falls in the head's bucket
+			tailMin := decimal.Max(tailBucket.Min, amount.Sub(headBucket.Max))
+			tailMax := decimal.Min(tailBucket.Max, amount.Sub(headBucket.Min))
+			if tailMin.IsPositive() && tailMin.LessThanOrEqual(tailMax) {
+				return types.SplitPlanChunk{FiatAmount: amount.Sub(tailMin), BucketID: headBucket.ID},
+					types.SplitPlanChunk{FiatAmount: tailMin, BucketID: tailBucket.ID},
+					true
+			}
+		}
+	}
+
+	return types.SplitPlanChunk{}, types.SplitPlanChunk{}, false
+}
+
+// allocateChunkAmounts sets the token amount and order percent of each chunk.
+// Percents are rounded down to the thousandths of a percent the gateway contract settles in, and the last
+// chunk takes the remainder, so the chunks always add up to the whole order.
+func allocateChunkAmounts(amount, totalFiat decimal.Decimal, chunks []types.SplitPlanChunk) []types.SplitPlanChunk {
+	hundred := decimal.NewFromInt(100)
+	allocatedPercent := decimal.Zero
+	allocatedAmount := decimal.Zero
+
+	for i := range chunks {
+		if i == len(chunks)-1 {
+			chunks[i].OrderPercent = hundred.Sub(allocatedPercent)
+			chunks[i].Amount = amount.Sub(allocatedAmount)
+			break
+		}
+
+		chunks[i].OrderPercent = chunks[i].FiatAmount.Div(totalFiat).Mul(hundred).RoundDown(settlePercentPlaces)
+		chunks[i].Amount = amount.Mul(chunks[i].OrderPercent).Div(hundred)
+		allocatedPercent = allocatedPercent.Add(chunks[i].OrderPercent)
+		allocatedAmount = allocatedAmount.Add(chunks[i].Amount)
+	}
+
+	return chunks
+}
+
+// splitPlanMap returns a split plan in the form it's recorded on an order
+func splitPlanMap(plan *types.SplitPlan) (map[string]interface{}, error) {
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	var planMap map[string]interface{}
+	if err := json.Unmarshal(data, &planMap); err != nil {
+		return nil, err
+	}
+
+	return planMap, nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/paycrest/aggregator/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPlanner(t *testing.T) {
+	small := splitBucket{ID: 1, Min: decimal.NewFromInt(1), Max: decimal.NewFromInt(5000)}
+	large := splitBucket{ID: 2, Min: decimal.NewFromInt(5001), Max: decimal.NewFromInt(50000)}
+
+	candidate := func(providerID string, minFiat, maxFiat int64, buckets ...splitBucket) splitCandidate {
+		return splitCandidate{
+			ProviderID: providerID,
+			MinFiat:    decimal.NewFromInt(minFiat),
+			MaxFiat:    decimal.NewFromInt(maxFiat),
+			Buckets:    buckets,
+		}
+	}
+
+	sumChunks := func(chunks []types.SplitPlanChunk) (decimal.Decimal, decimal.Decimal, decimal.Decimal) {
+		fiat, amount, percent := decimal.Zero, decimal.Zero, decimal.Zero
+		for _, chunk := range chunks {
+			fiat = fiat.Add(chunk.FiatAmount)
+			amount = amount.Add(chunk.Amount)
+			percent = percent.Add(chunk.OrderPercent)
+		}
+		return fiat, amount, percent
+	}
+
+	t.Run("plans the fewest chunks for the providers with the most capacity", func(t *testing.T) {
+		candidates := []splitCandidate{
+			candidate("provider-a", 1, 20000, small, large),
+			candidate("provider-b", 1, 50000, small, large),
+			candidate("provider-c", 1, 4000, small),
+		}
+
+		chunks, ok := planCapacityChunks(decimal.NewFromInt(60000), candidates, 10)
+		assert.True(t, ok)
+		assert.Len(t, chunks, 2)
+		assert.Equal(t, "provider-b", chunks[0].ProviderID)
+		assert.True(t, chunks[0].FiatAmount.Equal(decimal.NewFromInt(50000)))
+		assert.Equal(t, large.ID, chunks[0].BucketID)
+		assert.Equal(t, "provider-a", chunks[1].ProviderID)
+		assert.True(t, chunks[1].FiatAmount.Equal(decimal.NewFromInt(10000)))
+		assert.Equal(t, large.ID, chunks[1].BucketID)
+	})
+
+	t.Run("raises the last chunk to its provider's minimum", func(t *testing.T) {
+		candidates := []splitCandidate{
+			candidate("provider-a", 1, 50000, small, large),
+			candidate("provider-b", 6000, 50000, large),
+		}
+
+		chunks, ok := planCapacityChunks(decimal.NewFromInt(52000), candidates, 10)
+		assert.True(t, ok)
+		assert.Len(t, chunks, 2)
+		assert.True(t, chunks[0].FiatAmount.Equal(decimal.NewFromInt(46000)))
+		assert.True(t, chunks[1].FiatAmount.Equal(decimal.NewFromInt(6000)))
+
+		fiat, _, _ := sumChunks(chunks)
+		assert.True(t, fiat.Equal(decimal.NewFromInt(52000)))
+	})
+
+	t.Run("fails when providers can't cover the order", func(t *testing.T) {
+		candidates := []splitCandidate{
+			candidate("provider-a", 1, 20000, small, large),
+			candidate("provider-b", 1, 20000, small, large),
+		}
+
+		_, ok := planCapacityChunks(decimal.NewFromInt(60000), candidates, 10)
+		assert.False(t, ok)
+
+		_, ok = planCapacityChunks(decimal.NewFromInt(30000), candidates, 1)
+		assert.False(t, ok)
+
+		_, ok = planCapacityChunks(decimal.NewFromInt(30000), nil, 10)
+		assert.False(t, ok)
+	})
+
+	t.Run("falls back to bucket-sized chunks", func(t *testing.T) {
+		chunks, ok := planBucketChunks(decimal.NewFromInt(123000), []splitBucket{small, large})
+		assert.True(t, ok)
+		assert.Len(t, chunks, 3)
+		assert.True(t, chunks[0].FiatAmount.Equal(decimal.NewFromInt(50000)))
+		assert.True(t, chunks[1].FiatAmount.Equal(decimal.NewFromInt(50000)))
+		assert.True(t, chunks[2].FiatAmount.Equal(decimal.NewFromInt(23000)))
+		assert.Equal(t, large.ID, chunks[2].BucketID)
+	})
+
+	t.Run("splits a remainder below the smallest bucket with the previous chunk", func(t *testing.T) {
+		buckets := []splitBucket{
+			{ID: 1, Min: decimal.NewFromInt(1000), Max: decimal.NewFromInt(5000)},
+			large,
+		}
+
+		chunks, ok := planBucketChunks(decimal.NewFromInt(100500), buckets)
+		assert.True(t, ok)
+		assert.Len(t, chunks, 3)
+
+		fiat, _, _ := sumChunks(chunks)
+		assert.True(t, fiat.Equal(decimal.NewFromInt(100500)))
+
+		// Every chunk falls in the bucket it's planned for
+		for _, chunk := range chunks {
+			assert.Equal(t, large.ID, chunk.BucketID)
+			assert.True(t, chunk.FiatAmount.GreaterThanOrEqual(large.Min))
+			assert.True(t, chunk.FiatAmount.LessThanOrEqual(large.Max))
+		}
+
+		// Fails when the remainder can't be split across the buckets
+		_, ok = planBucketChunks(decimal.NewFromInt(1300), []splitBucket{{ID: 1, Min: decimal.NewFromInt(1000), Max: decimal.NewFromInt(1200)}})
+		assert.False(t, ok)
+	})
+
+	t.Run("allocates chunk amounts that add up to the order", func(t *testing.T) {
+		chunks := []types.SplitPlanChunk{
+			{FiatAmount: decimal.NewFromInt(50000)},
+			{FiatAmount: decimal.NewFromInt(50000)},
+			{FiatAmount: decimal.NewFromInt(23333)},
+		}
+
+		chunks = allocateChunkAmounts(decimal.NewFromFloat(82.222), decimal.NewFromInt(123333), chunks)
+
+		_, amount, percent := sumChunks(chunks)
+		assert.True(t, amount.Equal(decimal.NewFromFloat(82.222)))
+		assert.True(t, percent.Equal(decimal.NewFromInt(100)))
+		assert.True(t, chunks[0].OrderPercent.Equal(decimal.NewFromFloat(40.54)))
+	})
+}
@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/paycrest/aggregator/config"
+)
+
+// ErrBlobNotFound is returned when a blob doesn't exist in the store
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore is the interface for storing files such as fulfillment receipts
+type BlobStore interface {
+	// Put stores the contents of a reader under a key, replacing any existing blob
+	Put(ctx context.Context, key string, data io.Reader) error
+	// Get opens the blob stored under a key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob stored under a key
+	Delete(ctx context.Context, key string) error
+}
+
+var (
+	// Blobs holds the blob store
+	Blobs BlobStore
+)
+
+// InitializeBlobStorage initializes the blob store for the configured driver
+func InitializeBlobStorage() error {
+	blobConf := config.BlobStorageConfig()
+
+	switch blobConf.Driver {
+	case "local":
+		store, err := NewLocalBlobStore(blobConf.LocalPath)
+		if err != nil {
+			return err
+		}
+		Blobs = store
+	default:
+		return fmt.Errorf("unsupported blob storage driver: %s", blobConf.Driver)
+	}
+
+	return nil
+}
+
+// LocalBlobStore stores blobs as files under a root directory
+type LocalBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore creates a new instance of LocalBlobStore, creating the root directory if needed
+func NewLocalBlobStore(root string) (*LocalBlobStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("NewLocalBlobStore: %w", err)
+	}
+
+	return &LocalBlobStore{root: root}, nil
+}
+
+// Put stores the contents of a reader under a key
+func (s *LocalBlobStore) Put(ctx context.Context, key string, data io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("Put.mkdir: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return fmt.Errorf("Put.create: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("Put.write: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("Put.close: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("Put.rename: %w", err)
+	}
+
+	return nil
+}
+
+// Get opens the blob stored under a key
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("Get: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete removes the blob stored under a key
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Delete: %w", err)
+	}
+
+	return nil
+}
+
+// path resolves a key to a file path, rejecting keys that escape the root directory
+func (s *LocalBlobStore) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if key == "" || cleaned == "/" || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewLocalBlobStore(t.TempDir())
+	assert.NoError(t, err)
+
+	t.Run("stores and reads a blob", func(t *testing.T) {
+		err := store.Put(ctx, "receipts/order/receipt.pdf", strings.NewReader("receipt"))
+		assert.NoError(t, err)
+
+		blob, err := store.Get(ctx, "receipts/order/receipt.pdf")
+		assert.NoError(t, err)
+		defer blob.Close()
+
+		data, err := io.ReadAll(blob)
+		assert.NoError(t, err)
+		assert.Equal(t, "receipt", string(data))
+	})
+
+	t.Run("deletes a blob", func(t *testing.T) {
+		err := store.Delete(ctx, "receipts/order/receipt.pdf")
+		assert.NoError(t, err)
+
+		_, err = store.Get(ctx, "receipts/order/receipt.pdf")
+		assert.ErrorIs(t, err, ErrBlobNotFound)
+	})
+
+	t.Run("rejects keys outside the root directory", func(t *testing.T) {
+		err := store.Put(ctx, "../escape.txt", strings.NewReader("escape"))
+		assert.Error(t, err)
+
+		_, err = store.Get(ctx, "")
+		assert.Error(t, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	fastshot "github.com/opus-domini/fast-shot"
 	"github.com/paycrest/aggregator/config"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
 	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
@@ -22,6 +24,10 @@ import (
 	"github.com/paycrest/aggregator/ent/paymentorderrecipient"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/providerslarecord"
+	"github.com/paycrest/aggregator/ent/provisionbucket"
+	"github.com/paycrest/aggregator/ent/publicholiday"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
 	"github.com/paycrest/aggregator/ent/receiveaddress"
 	"github.com/paycrest/aggregator/ent/senderprofile"
 	tokenent "github.com/paycrest/aggregator/ent/token"
@@ -29,6 +35,7 @@ import (
 	"github.com/paycrest/aggregator/ent/webhookretryattempt"
 	"github.com/paycrest/aggregator/services"
 	orderService "github.com/paycrest/aggregator/services/order"
+	"github.com/paycrest/aggregator/services/rates"
 	"github.com/paycrest/aggregator/storage"
 	"github.com/paycrest/aggregator/types"
 	"github.com/paycrest/aggregator/utils"
@@ -42,6 +49,9 @@ var serverConf = config.ServerConfig()
 
 var rpcClients = map[string]types.RPCClient{}
 
+// providerHealthCheckMu is held while provider nodes are being checked
+var providerHealthCheckMu sync.Mutex
+
 // setRPCClients connects to the RPC endpoints of all networks
 func setRPCClients(ctx context.Context) ([]*ent.Network, error) {
 	isTestnet := false
@@ -862,6 +872,10 @@ func ReassignUnvalidatedLockOrders() {
 						logger.Errorf("ReassignUnvalidatedLockOrders.UpdateOrderStatusValidated: %v", err)
 						continue
 					}
+
+					if err := services.NewProviderSLAService().RecordValidation(ctx, order.ID); err != nil {
+						logger.Errorf("ReassignUnvalidatedLockOrders.RecordValidation: %v", err)
+					}
 				}
 
 			} else if fulfillment.ValidationStatus == lockorderfulfillment.ValidationStatusFailed {
@@ -910,6 +924,10 @@ func ReassignUnvalidatedLockOrders() {
 					logger.Errorf("ReassignUnvalidatedLockOrders.UpdateOrderStatusValidated: %v", err)
 					continue
 				}
+
+				if err := services.NewProviderSLAService().RecordValidation(ctx, order.ID); err != nil {
+					logger.Errorf("ReassignUnvalidatedLockOrders.RecordValidation: %v", err)
+				}
 			}
 		}
 	}
@@ -927,37 +945,25 @@ func ReassignStaleOrderRequest(ctx context.Context, orderRequestChan <-chan *red
 			continue
 		}
 
-		// Get the order from the database
-		order, err := storage.Client.LockPaymentOrder.
-			Query().
-			Where(
-				lockpaymentorder.IDEQ(orderUUID),
-			).
-			WithProvisionBucket().
-			Only(ctx)
+		// Record the lapsed order request and assign the order to another provider
+		err = services.NewOrderAssignmentService().ExpireOffers(ctx, orderUUID)
 		if err != nil {
 			logger.Errorf("ReassignStaleOrderRequest: %v", err)
-			continue
 		}
+	}
+}
 
-		orderFields := types.LockPaymentOrderFields{
-			ID:                order.ID,
-			GatewayID:         order.GatewayID,
-			Amount:            order.Amount,
-			Rate:              order.Rate,
-			BlockNumber:       order.BlockNumber,
-			Institution:       order.Institution,
-			AccountIdentifier: order.AccountIdentifier,
-			AccountName:       order.AccountName,
-			Memo:              order.Memo,
-			ProvisionBucket:   order.Edges.ProvisionBucket,
-		}
+// RecoverOrderAssignments repairs orders whose order requests expired while their expiry events were missed
+func RecoverOrderAssignments() {
+	ctx := context.Background()
 
-		// Assign the order to a provider
-		err = services.NewPriorityQueueService().AssignLockPaymentOrder(ctx, orderFields)
-		if err != nil {
-			logger.Errorf("ReassignStaleOrderRequest.AssignLockPaymentOrder: %v", err)
-		}
+	recovered, err := services.NewOrderAssignmentService().RecoverMissedExpiries(ctx)
+	if err != nil {
+		logger.Errorf("RecoverOrderAssignments: %v", err)
+	}
+
+	if recovered > 0 {
+		logger.Infof("RecoverOrderAssignments: reassigned %d orders with missed order request expiries", recovered)
 	}
 }
 
@@ -1064,95 +1070,62 @@ func SubscribeToRedisKeyspaceEvents() {
 	go ReassignStaleOrderRequest(ctx, orderRequestChan)
 }
 
-// fetchExternalRate fetches the external rate for a fiat currency
-func fetchExternalRate(currency string) (decimal.Decimal, error) {
-	currency = strings.ToUpper(currency)
-	supportedCurrencies := []string{"KES", "NGN", "GHS", "TZS", "UGX", "XOF"}
-	isSupported := false
-	for _, supported := range supportedCurrencies {
-		if currency == supported {
-			isSupported = true
-			break
-		}
-	}
-	if !isSupported {
-		return decimal.Zero, fmt.Errorf("ComputeMarketRate: currency not supported")
+// fetchExternalRate fetches the external rate of a token in a fiat currency from its configured rate sources,
+// with the rates of the sources it was aggregated from
+func fetchExternalRate(ctx context.Context, token, currency string) (decimal.Decimal, []decimal.Decimal, error) {
+	rate, quotes, err := rates.FetchQuotes(ctx, token, currency)
+	if err != nil {
+		return decimal.Zero, nil, fmt.Errorf("ComputeMarketRate: %w", err)
 	}
 
-	// Fetch rates from third-party APIs
-	var price decimal.Decimal
-	if currency == "NGN" {
-		res, err := fastshot.NewClient("https://www.quidax.com").
-			Config().SetTimeout(30*time.Second).
-			Build().GET(fmt.Sprintf("/api/v1/markets/tickers/usdt%s", strings.ToLower(currency))).
-			Retry().Set(3, 5*time.Second).
-			Send()
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
+	sourceRates := make([]decimal.Decimal, 0, len(quotes))
+	for _, quote := range quotes {
+		sourceRates = append(sourceRates, quote.Rate)
+	}
 
-		data, err := utils.ParseJSONResponse(res.RawResponse)
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w %v", err, data)
-		}
+	return rate, sourceRates, nil
+}
 
-		price, err = decimal.NewFromString(data["data"].(map[string]interface{})["ticker"].(map[string]interface{})["buy"].(string))
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
-	} else {
-		res, err := fastshot.NewClient("https://p2p.binance.com").
-			Config().SetTimeout(30*time.Second).
-			Header().Add("Content-Type", "application/json").
-			Build().POST("/bapi/c2c/v2/friendly/c2c/adv/search").
-			Retry().Set(3, 5*time.Second).
-			Body().AsJSON(map[string]interface{}{
-			"asset":     "USDT",
-			"fiat":      currency,
-			"tradeType": "SELL",
-			"page":      1,
-			"rows":      20,
-		}).
-			Send()
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
+// fetchedRate is the external rate of a token fetched from its rate sources
+type fetchedRate struct {
+	rate        decimal.Decimal
+	sourceRates []decimal.Decimal
+	err         error
+}
 
-		resData, err := utils.ParseJSONResponse(res.RawResponse)
-		if err != nil {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
-		}
+// fetchExternalRates fetches the external rates of the tokens with a configured rate source in a fiat currency, at once
+func fetchExternalRates(ctx context.Context, currency string, symbols []string) map[string]fetchedRate {
+	var (
+		mu            sync.Mutex
+		wg            sync.WaitGroup
+		externalRates = map[string]fetchedRate{}
+	)
 
-		// Access the data array
-		data, ok := resData["data"].([]interface{})
-		if !ok || len(data) == 0 {
-			return decimal.Zero, fmt.Errorf("ComputeMarketRate: No data in the response")
+	for _, symbol := range symbols {
+		// Only tokens with a configured rate source in the currency are supported
+		if !rates.IsSupported(symbol, currency) {
+			continue
 		}
 
-		// Loop through the data array and extract prices
-		var prices []decimal.Decimal
-		for _, item := range data {
-			adv, ok := item.(map[string]interface{})["adv"].(map[string]interface{})
-			if !ok {
-				continue
-			}
-
-			price, err := decimal.NewFromString(adv["price"].(string))
-			if err != nil {
-				continue
-			}
+		wg.Add(1)
+		go func(symbol string) {
+			defer wg.Done()
 
-			prices = append(prices, price)
-		}
+			rate, sourceRates, err := fetchExternalRate(ctx, symbol, currency)
 
-		// Calculate and return the median
-		price = utils.Median(prices)
+			mu.Lock()
+			externalRates[symbol] = fetchedRate{rate: rate, sourceRates: sourceRates, err: err}
+			mu.Unlock()
+		}(symbol)
 	}
 
-	return price, nil
+	wg.Wait()
+
+	return externalRates
 }
 
-// ComputeMarketRate computes the market price for fiat currencies
+// ComputeMarketRate computes the market prices of tokens in fiat currencies.
+// The market price of USDT is also kept as the market price of the currency.
 func ComputeMarketRate() error {
 	ctx := context.Background()
 
@@ -1165,48 +1138,249 @@ func ComputeMarketRate() error {
 		return fmt.Errorf("ComputeMarketRate: %w", err)
 	}
 
+	symbols, err := marketRateTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("ComputeMarketRate: %w", err)
+	}
+
+	// External rates are fetched within a deadline so slow rate sources can't hold up the next computation
+	fetchCtx, cancel := context.WithTimeout(ctx, orderConf.RateFetchTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
 	for _, currency := range currencies {
-		// Fetch external rate
-		externalRate, err := fetchExternalRate(currency.Code)
+		// The market rates of a currency stay frozen while its circuit breaker is tripped
+		tripped, err := services.NewCircuitBreakerService().IsTripped(ctx, currency.Code)
 		if err != nil {
+			logger.Errorf("ComputeMarketRate: %v", err)
+			continue
+		}
+		if tripped {
+			continue
+		}
+
+		wg.Add(1)
+		go func(currency *ent.FiatCurrency) {
+			defer wg.Done()
+
+			err := computeCurrencyMarketRates(ctx, currency, fetchExternalRates(fetchCtx, currency.Code, symbols), symbols)
+			if err != nil {
+				logger.Errorf("%v", err)
+			}
+		}(currency)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// computeCurrencyMarketRates computes the market rates of tokens in a currency from their external rates, starting
+// with the token of the currency market rate. The other tokens are left as they are when its rate can't be computed
+// or trips the circuit breaker of the currency.
+func computeCurrencyMarketRates(ctx context.Context, currency *ent.FiatCurrency, externalRates map[string]fetchedRate, symbols []string) error {
+	marketRateService := services.NewMarketRateService()
+
+	var currencyExternalRate, currencyMarketRate decimal.Decimal
+	tokenRates := map[string]decimal.Decimal{}
+
+	for _, symbol := range symbols {
+		fetched, ok := externalRates[symbol]
+		if !ok {
 			continue
 		}
 
-		// Fetch rates from token configs with fixed conversion rate
-		tokenConfigs, err := storage.Client.ProviderOrderToken.
-			Query().
-			Where(
-				providerordertoken.SymbolIn("USDT", "USDC"),
-				providerordertoken.ConversionRateTypeEQ(providerordertoken.ConversionRateTypeFixed),
-			).
-			Select(providerordertoken.FieldFixedConversionRate).
-			All(ctx)
+		externalRate, sourceRates, err := fetched.rate, fetched.sourceRates, fetched.err
 		if err != nil {
+			if symbol == services.CurrencyMarketRateToken {
+				return err
+			}
+			logger.Errorf("%v", err)
 			continue
 		}
 
-		var rates []decimal.Decimal
-		for _, tokenConfig := range tokenConfigs {
-			rates = append(rates, tokenConfig.FixedConversionRate)
+		marketRate, err := tokenMarketRate(ctx, currency, symbol, externalRate)
+		if err != nil {
+			if symbol == services.CurrencyMarketRateToken {
+				return err
+			}
+			logger.Errorf("%v", err)
+			continue
 		}
 
-		// Calculate median
-		median := utils.Median(rates)
+		if symbol == services.CurrencyMarketRateToken {
+			// Check the new rate against the circuit breaker of the currency before it is used
+			tripped, err := services.NewCircuitBreakerService().Check(ctx, currency, marketRate, sourceRates)
+			if err != nil {
+				return fmt.Errorf("ComputeMarketRate: %w", err)
+			}
+			if tripped {
+				return nil
+			}
 
-		// Check the median rate against the external rate to ensure it's not too far off
-		percentDeviation := utils.AbsPercentageDeviation(externalRate, median)
-		if percentDeviation.GreaterThan(orderConf.PercentDeviationFromExternalRate) {
-			median = externalRate
+			// Update currency with median rate
+			_, err = storage.Client.FiatCurrency.
+				UpdateOneID(currency.ID).
+				SetMarketRate(marketRate).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("ComputeMarketRate: %w", err)
+			}
+
+			currencyExternalRate, currencyMarketRate = externalRate, marketRate
 		}
 
-		// Update currency with median rate
-		_, err = storage.Client.FiatCurrency.
-			UpdateOneID(currency.ID).
-			SetMarketRate(median).
-			Save(ctx)
+		err = marketRateService.SetRate(ctx, currency, symbol, marketRate)
 		if err != nil {
+			logger.Errorf("ComputeMarketRate: %v", err)
 			continue
 		}
+
+		tokenRates[symbol] = marketRate
+	}
+
+	if currencyMarketRate.IsZero() {
+		return nil
+	}
+
+	// Keep the rates in the rate history
+	err := recordRateHistory(ctx, currency, currencyExternalRate, currencyMarketRate, tokenRates)
+	if err != nil {
+		return fmt.Errorf("ComputeMarketRate.recordRateHistory: %w", err)
+	}
+
+	return nil
+}
+
+// tokenMarketRate computes the market rate of a token in a currency as the median of the fixed rates providers
+// quote for it, or its external rate when the median is too far off the external rate
+func tokenMarketRate(ctx context.Context, currency *ent.FiatCurrency, symbol string, externalRate decimal.Decimal) (decimal.Decimal, error) {
+	// Fetch rates from token configs with fixed conversion rate
+	tokenConfigs, err := storage.Client.ProviderOrderToken.
+		Query().
+		Where(
+			providerordertoken.SymbolEQ(symbol),
+			providerordertoken.ConversionRateTypeEQ(providerordertoken.ConversionRateTypeFixed),
+			providerordertoken.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID)),
+		).
+		Select(providerordertoken.FieldFixedConversionRate).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("ComputeMarketRate: %w", err)
+	}
+
+	var fixedRates []decimal.Decimal
+	for _, tokenConfig := range tokenConfigs {
+		fixedRates = append(fixedRates, tokenConfig.FixedConversionRate)
+	}
+
+	// Calculate median
+	median := utils.Median(fixedRates)
+
+	// Check the median rate against the external rate to ensure it's not too far off
+	percentDeviation := utils.AbsPercentageDeviation(externalRate, median)
+	if percentDeviation.GreaterThan(orderConf.PercentDeviationFromExternalRate) {
+		median = externalRate
+	}
+
+	return median, nil
+}
+
+// marketRateTokens returns the symbols of the tokens market rates are computed for, that is the enabled tokens,
+// starting with the token of the currency market rate
+func marketRateTokens(ctx context.Context) ([]string, error) {
+	enabled, err := storage.Client.Token.
+		Query().
+		Where(tokenent.IsEnabledEQ(true)).
+		Select(tokenent.FieldSymbol).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("marketRateTokens: %w", err)
+	}
+
+	symbols := []string{services.CurrencyMarketRateToken}
+	for _, symbol := range enabled {
+		symbol = strings.ToUpper(symbol)
+		if !slices.Contains(symbols, symbol) {
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	return symbols, nil
+}
+
+// recordRateHistory stores the market and external rates of a currency, and the rates quoted by its providers, in the rate history.
+// Floating provider rates are quoted on the market rate of their token, or the market rate of the currency.
+func recordRateHistory(ctx context.Context, currency *ent.FiatCurrency, externalRate, marketRate decimal.Decimal, tokenRates map[string]decimal.Decimal) error {
+	observations := []types.RateObservation{
+		{CurrencyID: currency.ID, Kind: ratesnapshot.KindExternal, Rate: externalRate},
+		{CurrencyID: currency.ID, Kind: ratesnapshot.KindMarket, Rate: marketRate},
+	}
+
+	tokenConfigs, err := storage.Client.ProviderOrderToken.
+		Query().
+		Where(providerordertoken.HasCurrencyWith(fiatcurrency.IDEQ(currency.ID))).
+		WithProvider(func(pq *ent.ProviderProfileQuery) {
+			pq.Select(providerprofile.FieldID)
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("recordRateHistory: %w", err)
+	}
+
+	for _, tokenConfig := range tokenConfigs {
+		if tokenConfig.Edges.Provider == nil {
+			continue
+		}
+
+		rate := tokenConfig.FixedConversionRate
+		if tokenConfig.ConversionRateType == providerordertoken.ConversionRateTypeFloating {
+			tokenRate, ok := tokenRates[strings.ToUpper(tokenConfig.Symbol)]
+			if !ok {
+				tokenRate = marketRate
+			}
+			rate = tokenRate.Add(tokenConfig.FloatingConversionRate).RoundBank(2)
+		}
+
+		observations = append(observations, types.RateObservation{
+			CurrencyID: currency.ID,
+			Kind:       ratesnapshot.KindProvider,
+			Rate:       rate,
+			ProviderID: tokenConfig.Edges.Provider.ID,
+			Token:      tokenConfig.Symbol,
+		})
+	}
+
+	return services.NewRateHistoryService().Record(ctx, time.Now(), observations...)
+}
+
+// ApplyRateHistoryRetention downsamples and deletes rates in the rate history past their retention
+func ApplyRateHistoryRetention() error {
+	ctx := context.Background()
+
+	removed, err := services.NewRateHistoryService().ApplyRetention(ctx)
+	if err != nil {
+		return fmt.Errorf("ApplyRateHistoryRetention: %w", err)
+	}
+
+	if removed > 0 {
+		logger.Infof("ApplyRateHistoryRetention: %d rate snapshots downsampled or deleted", removed)
+	}
+
+	return nil
+}
+
+// ResetCircuitBreakers resets the tripped market rate circuit breakers whose auto reset delay has passed
+func ResetCircuitBreakers() error {
+	ctx := context.Background()
+
+	reset, err := services.NewCircuitBreakerService().ResetExpired(ctx)
+	if err != nil {
+		return fmt.Errorf("ResetCircuitBreakers: %w", err)
+	}
+
+	if reset > 0 {
+		logger.Infof("ResetCircuitBreakers: %d circuit breakers reset", reset)
 	}
 
 	return nil
@@ -1303,6 +1477,307 @@ func RetryFailedWebhookNotifications() error {
 	return nil
 }
 
+// SyncProviderAvailability opens and closes providers according to their operating hours
+// and rebuilds the bucket queues of the affected currencies.
+// The availability providers set themselves is kept apart, so closing hours never override it.
+func SyncProviderAvailability() error {
+	ctx := context.Background()
+	now := time.Now()
+
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(
+			providerprofile.IsActive(true),
+			providerprofile.Or(
+				providerprofile.And(
+					providerprofile.OperatingTimezoneNEQ(""),
+					providerprofile.OperatingHoursNotNil(),
+				),
+				// Providers that dropped their operating hours while closed are reopened
+				providerprofile.IsOpen(false),
+			),
+		).
+		WithCurrencies(func(fq *ent.FiatCurrencyQuery) {
+			// Holidays are matched on the provider's local date, which can be a day off UTC
+			fq.WithPublicHolidays(func(phq *ent.PublicHolidayQuery) {
+				phq.Where(
+					publicholiday.DateGTE(now.AddDate(0, 0, -2)),
+					publicholiday.DateLTE(now.AddDate(0, 0, 2)),
+				)
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("SyncProviderAvailability: %w", err)
+	}
+
+	affectedCurrencies := []uuid.UUID{}
+
+	for _, provider := range providers {
+		hours := make([]types.OperatingHours, len(provider.OperatingHours))
+		for i, h := range provider.OperatingHours {
+			hours[i] = types.OperatingHours(h)
+		}
+
+		exceptions := make([]types.OperatingHoursException, len(provider.OperatingHoursExceptions))
+		for i, e := range provider.OperatingHoursExceptions {
+			exceptions[i] = types.OperatingHoursException(e)
+		}
+
+		// A provider closes on the public holidays of any currency they serve
+		holidays := []string{}
+		for _, currency := range provider.Edges.Currencies {
+			for _, holiday := range currency.Edges.PublicHolidays {
+				holidays = append(holidays, holiday.Date.UTC().Format("2006-01-02"))
+			}
+		}
+
+		isOpen := true
+		if provider.OperatingTimezone != "" && len(hours) > 0 {
+			isOpen, err = utils.IsWithinOperatingHours(now, provider.OperatingTimezone, hours, exceptions, holidays)
+			if err != nil {
+				logger.Errorf("SyncProviderAvailability: provider %s: %v", provider.ID, err)
+				continue
+			}
+		}
+
+		if isOpen == provider.IsOpen {
+			continue
+		}
+
+		_, err = provider.Update().
+			SetIsOpen(isOpen).
+			Save(ctx)
+		if err != nil {
+			logger.Errorf("SyncProviderAvailability: failed to update provider %s: %v", provider.ID, err)
+			continue
+		}
+
+		for _, currency := range provider.Edges.Currencies {
+			if !slices.Contains(affectedCurrencies, currency.ID) {
+				affectedCurrencies = append(affectedCurrencies, currency.ID)
+			}
+		}
+	}
+
+	// Rebuild the bucket queues so they reflect the new availability
+	if err := rebuildCurrencyBuckets(ctx, affectedCurrencies); err != nil {
+		return fmt.Errorf("SyncProviderAvailability: %w", err)
+	}
+
+	return nil
+}
+
+// CheckProviderHealth probes the nodes of active providers, marks providers unhealthy after
+// consecutive failures or healthy again on recovery, and rebuilds the bucket queues of the affected currencies
+func CheckProviderHealth() error {
+	// A run is skipped while the previous one is still checking slow nodes
+	if !providerHealthCheckMu.TryLock() {
+		logger.Warnf("CheckProviderHealth: previous run still in progress, skipping")
+		return nil
+	}
+	defer providerHealthCheckMu.Unlock()
+
+	ctx := context.Background()
+	healthService := services.NewProviderHealthService()
+
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(
+			providerprofile.IsActive(true),
+			providerprofile.HostIdentifierNEQ(""),
+		).
+		WithCurrencies().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("CheckProviderHealth: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	affectedCurrencies := []uuid.UUID{}
+
+	// Only a limited number of nodes are checked at once
+	sem := make(chan struct{}, max(orderConf.ProviderHealthCheckConcurrency, 1))
+
+	for _, provider := range providers {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(provider *ent.ProviderProfile) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			changed, err := healthService.CheckProvider(ctx, provider)
+			if err != nil {
+				logger.Errorf("CheckProviderHealth: provider %s: %v", provider.ID, err)
+				return
+			}
+
+			if !changed {
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, currency := range provider.Edges.Currencies {
+				if !slices.Contains(affectedCurrencies, currency.ID) {
+					affectedCurrencies = append(affectedCurrencies, currency.ID)
+				}
+			}
+		}(provider)
+	}
+	wg.Wait()
+
+	if err := rebuildCurrencyBuckets(ctx, affectedCurrencies); err != nil {
+		return fmt.Errorf("CheckProviderHealth: %w", err)
+	}
+
+	if err := healthService.PruneHealthChecks(ctx); err != nil {
+		return fmt.Errorf("CheckProviderHealth: %w", err)
+	}
+
+	return nil
+}
+
+// EnforceProviderSLA records fulfillment timeouts, demotes or suspends providers whose SLA breaches
+// reach the configured thresholds and rebuilds the bucket queues of the affected currencies
+func EnforceProviderSLA() error {
+	ctx := context.Background()
+	slaService := services.NewProviderSLAService()
+
+	if err := slaService.RecordFulfillmentTimeouts(ctx); err != nil {
+		return fmt.Errorf("EnforceProviderSLA: %w", err)
+	}
+
+	// Providers with breaches within the breach window
+	providers, err := storage.Client.ProviderProfile.
+		Query().
+		Where(
+			providerprofile.HasSLARecordsWith(
+				providerslarecord.BreachNotNil(),
+				providerslarecord.BreachedAtGTE(time.Now().Add(-orderConf.ProviderSLABreachWindow)),
+			),
+		).
+		WithCurrencies().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("EnforceProviderSLA: %w", err)
+	}
+
+	affectedCurrencies := []uuid.UUID{}
+
+	for _, provider := range providers {
+		penalized, err := slaService.ApplyPenalties(ctx, provider)
+		if err != nil {
+			logger.Errorf("EnforceProviderSLA: provider %s: %v", provider.ID, err)
+			continue
+		}
+
+		if !penalized {
+			continue
+		}
+
+		for _, currency := range provider.Edges.Currencies {
+			if !slices.Contains(affectedCurrencies, currency.ID) {
+				affectedCurrencies = append(affectedCurrencies, currency.ID)
+			}
+		}
+	}
+
+	if err := rebuildCurrencyBuckets(ctx, affectedCurrencies); err != nil {
+		return fmt.Errorf("EnforceProviderSLA: %w", err)
+	}
+
+	return nil
+}
+
+// EscalateOverdueDisputes puts disputes under review once the provider's response deadline passes,
+// and flags disputes that are past their resolution deadline
+func EscalateOverdueDisputes() error {
+	ctx := context.Background()
+
+	escalated, err := services.NewDisputeService().EscalateOverdueDisputes(ctx)
+	if err != nil {
+		return fmt.Errorf("EscalateOverdueDisputes: %w", err)
+	}
+	if escalated > 0 {
+		logger.Infof("EscalateOverdueDisputes: %d disputes put under review without a provider response", escalated)
+	}
+
+	overdue, err := storage.Client.Dispute.
+		Query().
+		Where(
+			dispute.StatusIn(dispute.StatusOpen, dispute.StatusUnderReview),
+			dispute.ResolutionDeadlineLTE(time.Now()),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("EscalateOverdueDisputes: %w", err)
+	}
+
+	for _, id := range overdue {
+		logger.Errorf("EscalateOverdueDisputes: dispute %s is past its resolution deadline", id)
+	}
+
+	return nil
+}
+
+// rebuildCurrencyBuckets rebuilds the priority queues of all buckets of the given currencies
+func rebuildCurrencyBuckets(ctx context.Context, currencyIDs []uuid.UUID) error {
+	if len(currencyIDs) == 0 {
+		return nil
+	}
+
+	priorityQueue := services.NewPriorityQueueService()
+	buckets, err := priorityQueue.GetProvisionBuckets(
+		ctx, provisionbucket.HasCurrencyWith(fiatcurrency.IDIn(currencyIDs...)),
+	)
+	if err != nil {
+		return fmt.Errorf("rebuildCurrencyBuckets: %w", err)
+	}
+
+	for _, bucket := range buckets {
+		priorityQueue.CreatePriorityQueueForBucket(ctx, bucket)
+	}
+
+	return nil
+}
+
+// ProposeBucketBoundaries proposes new provision buckets for each currency from its recent order amounts
+func ProposeBucketBoundaries() error {
+	ctx := context.Background()
+
+	proposed, err := services.NewBucketProposalService().ProposeBuckets(ctx)
+	if err != nil {
+		return fmt.Errorf("ProposeBucketBoundaries: %w", err)
+	}
+	if proposed > 0 {
+		logger.Infof("ProposeBucketBoundaries: %d bucket proposals awaiting review", proposed)
+	}
+
+	return nil
+}
+
+// RetryDeadLetterOrders retries matching the orders in the dead-letter queue that are due for a retry
+func RetryDeadLetterOrders() error {
+	ctx := context.Background()
+	deadLetterService := services.NewDeadLetterService()
+
+	entries, err := deadLetterService.DueForRetry(ctx)
+	if err != nil {
+		return fmt.Errorf("RetryDeadLetterOrders: %w", err)
+	}
+
+	for _, entry := range entries {
+		err := deadLetterService.Retry(ctx, entry)
+		if err != nil {
+			logger.Errorf("RetryDeadLetterOrders: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // StartCronJobs starts cron jobs
 func StartCronJobs() {
 	scheduler := gocron.NewScheduler(time.UTC)
@@ -1331,6 +1806,54 @@ func StartCronJobs() {
 		logger.Errorf("StartCronJobs: %v", err)
 	}
 
+	// Toggle provider availability according to their operating hours every 1 minute
+	_, err = scheduler.Cron("*/1 * * * *").Do(SyncProviderAvailability)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Check the health of provider nodes every 1 minute
+	_, err = scheduler.Cron("*/1 * * * *").Do(CheckProviderHealth)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Apply SLA penalties to providers every 1 minute
+	_, err = scheduler.Cron("*/1 * * * *").Do(EnforceProviderSLA)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Retry orders in the dead-letter queue every minute
+	_, err = scheduler.Cron("*/1 * * * *").Do(RetryDeadLetterOrders)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Propose provision buckets from recent order amounts every day at 03:00
+	_, err = scheduler.Cron("0 3 * * *").Do(ProposeBucketBoundaries)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Downsample and delete rates past their retention every day at 03:30
+	_, err = scheduler.Cron("30 3 * * *").Do(ApplyRateHistoryRetention)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Reset tripped circuit breakers past their auto reset delay every minute
+	_, err = scheduler.Cron("*/1 * * * *").Do(ResetCircuitBreakers)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
+	// Escalate overdue disputes every 5 minutes
+	_, err = scheduler.Cron("*/5 * * * *").Do(EscalateOverdueDisputes)
+	if err != nil {
+		logger.Errorf("StartCronJobs: %v", err)
+	}
+
 	// Retry failed webhook notifications every 59 minutes
 	_, err = scheduler.Cron("*/59 * * * *").Do(RetryFailedWebhookNotifications)
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -171,8 +173,81 @@ func TestTasks(t *testing.T) {
 	})
 
 	t.Run("fetchExternalRate", func(t *testing.T) {
-		value, err := fetchExternalRate("KSH")
+		value, _, err := fetchExternalRate(context.Background(), "USDT", "KSH")
 		assert.Error(t, err)
 		assert.Equal(t, value, decimal.Zero)
 	})
 }
+
+func TestCheckProviderHealth(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:providerhealth?mode=memory&_fk=1")
+	defer client.Close()
+
+	db.Client = client
+
+	conf := *orderConf
+	defer func() { *orderConf = conf }()
+	orderConf.ProviderHealthCheckConcurrency = 2
+
+	// Nodes respond slowly, keeping track of how many are checked at once
+	var mu sync.Mutex
+	inFlight, maxInFlight, requests := 0, 0, 0
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		requests++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		<-release
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	currency, err := test.CreateTestFiatCurrency(nil)
+	assert.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		user, err := test.CreateTestUser(map[string]interface{}{
+			"scope": "provider",
+			"email": fmt.Sprintf("provider%d@test.com", i),
+		})
+		assert.NoError(t, err)
+
+		provider, err := test.CreateTestProviderProfile(map[string]interface{}{
+			"user_id":         user.ID,
+			"currency_id":     currency.ID,
+			"host_identifier": server.URL,
+		})
+		assert.NoError(t, err)
+
+		_, err = provider.Update().SetIsActive(true).Save(context.Background())
+		assert.NoError(t, err)
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- CheckProviderHealth()
+	}()
+
+	// Runs overlapping one in progress are skipped
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return inFlight == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NoError(t, CheckProviderHealth())
+
+	close(release)
+	assert.NoError(t, <-done)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, maxInFlight)
+	assert.Equal(t, 5, requests)
+}
@@ -11,12 +11,16 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/google/uuid"
 	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/dispute"
+	"github.com/paycrest/aggregator/ent/disputeevidence"
+	"github.com/paycrest/aggregator/ent/fiatcurrency"
 	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/paycrest/aggregator/ent/lockorderfulfillment"
 	"github.com/paycrest/aggregator/ent/lockpaymentorder"
 	"github.com/paycrest/aggregator/ent/paymentorder"
 	"github.com/paycrest/aggregator/ent/providerordertoken"
 	"github.com/paycrest/aggregator/ent/providerprofile"
+	"github.com/paycrest/aggregator/ent/ratesnapshot"
 	"github.com/paycrest/aggregator/ent/transactionlog"
 	"github.com/shopspring/decimal"
 )
@@ -101,6 +105,36 @@ type OrderService interface {
 	SettleOrder(ctx context.Context, client RPCClient, orderID uuid.UUID) error
 }
 
+// PSPTransferState is the state of a transfer as reported by a PSP
+type PSPTransferState string
+
+const (
+	PSPTransferSuccess PSPTransferState = "success"
+	PSPTransferPending PSPTransferState = "pending"
+	PSPTransferFailed  PSPTransferState = "failed"
+)
+
+// PSPTransferStatus is the status of a transfer looked up from a PSP, with the amount, currency and
+// beneficiary account it was made for
+type PSPTransferStatus struct {
+	State             PSPTransferState
+	Message           string
+	Amount            decimal.Decimal
+	Currency          string
+	AccountIdentifier string
+}
+
+// PSPAdapter provides an interface for looking up transfers made through a PSP
+type PSPAdapter interface {
+	GetTransferStatus(ctx context.Context, txID string) (*PSPTransferStatus, error)
+}
+
+// RateSource provides an interface for fetching the market rate of a token in a fiat currency from an external source
+type RateSource interface {
+	Name() string
+	FetchRate(ctx context.Context, token, currency string) (decimal.Decimal, error)
+}
+
 // CreateOrderParams is the parameters for the create order payload
 type CreateOrderParams struct {
 	Token              common.Address
@@ -156,10 +190,68 @@ type AcceptOrderResponse struct {
 
 // FulfillLockOrderPayload is the payload for the fulfill order endpoint
 type FulfillLockOrderPayload struct {
-	TxID             string                                `json:"txId" binding:"required"`
-	PSP              string                                `json:"psp" binding:"required"`
-	ValidationStatus lockorderfulfillment.ValidationStatus `json:"validationStatus"`
-	ValidationError  string                                `json:"validationError"`
+	TxID                  string                                `json:"txId" binding:"required"`
+	PSP                   string                                `json:"psp" binding:"required"`
+	ValidationStatus      lockorderfulfillment.ValidationStatus `json:"validationStatus"`
+	ValidationError       string                                `json:"validationError"`
+	PSPSessionID          string                                `json:"pspSessionId"`
+	BankReference         string                                `json:"bankReference"`
+	TransferredAt         *time.Time                            `json:"transferredAt"`
+	Receipt               *FulfillmentReceiptPayload            `json:"receipt"`
+	RecipientConfirmation map[string]interface{}                `json:"recipientConfirmation"`
+}
+
+// FulfillmentReceiptPayload is a receipt file attached to a fulfillment, encoded in base64.
+// The stored content type is detected from the data, the one sent is only checked to be supported.
+type FulfillmentReceiptPayload struct {
+	ContentType string `json:"contentType" binding:"omitempty,oneof=application/pdf image/png image/jpeg"`
+	Data        string `json:"data" binding:"required,base64"`
+}
+
+// FulfillmentProofResponse is the proof of a fulfillment attached by the provider
+type FulfillmentProofResponse struct {
+	ID                    uuid.UUID                             `json:"id"`
+	TxID                  string                                `json:"txId"`
+	PSP                   string                                `json:"psp"`
+	PSPSessionID          string                                `json:"pspSessionId"`
+	BankReference         string                                `json:"bankReference"`
+	TransferredAt         *time.Time                            `json:"transferredAt"`
+	ReceiptURL            string                                `json:"receiptUrl"`
+	RecipientConfirmation map[string]interface{}                `json:"recipientConfirmation"`
+	ValidationStatus      lockorderfulfillment.ValidationStatus `json:"validationStatus"`
+	PSPConfirmedAt        *time.Time                            `json:"pspConfirmedAt"`
+	CreatedAt             time.Time                             `json:"createdAt"`
+}
+
+// BatchOrderActionPayload is the payload for the batch accept and decline order endpoints
+type BatchOrderActionPayload struct {
+	OrderIDs []string `json:"orderIds" binding:"required,min=1"`
+}
+
+// BatchFulfillOrderItem is a single order in the batch fulfill order payload
+type BatchFulfillOrderItem struct {
+	OrderID string `json:"orderId" binding:"required"`
+	FulfillLockOrderPayload
+}
+
+// BatchFulfillOrderPayload is the payload for the batch fulfill order endpoint
+type BatchFulfillOrderPayload struct {
+	Orders []BatchFulfillOrderItem `json:"orders" binding:"required,min=1,dive"`
+}
+
+// BatchOrderResult is the result of a single order in a batch order request
+type BatchOrderResult struct {
+	OrderID string      `json:"orderId"`
+	Status  string      `json:"status"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// BatchOrderResponse is the response for the batch order endpoints
+type BatchOrderResponse struct {
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Results   []BatchOrderResult `json:"results"`
 }
 
 // CancelLockOrderPayload is the payload for the cancel order endpoint
@@ -209,6 +301,7 @@ type SenderProfilePayload struct {
 // ProviderOrderTokenPayload defines the provider setting for a token
 type ProviderOrderTokenPayload struct {
 	Symbol                 string                                `json:"symbol" binding:"required"`
+	Currency               string                                `json:"currency"`
 	ConversionRateType     providerordertoken.ConversionRateType `json:"conversionRateType" binding:"required"`
 	FixedConversionRate    decimal.Decimal                       `json:"fixedConversionRate" binding:"required"`
 	FloatingConversionRate decimal.Decimal                       `json:"floatingConversionRate" binding:"required"`
@@ -222,43 +315,82 @@ type ProviderOrderTokenPayload struct {
 
 // ProviderProfilePayload is the payload for the provider profile endpoint
 type ProviderProfilePayload struct {
-	TradingName          string                      `json:"tradingName"`
-	Currency             string                      `json:"currency"`
-	HostIdentifier       string                      `json:"hostIdentifier"`
-	IsAvailable          bool                        `json:"isAvailable"`
-	Tokens               []ProviderOrderTokenPayload `json:"tokens"`
-	VisibilityMode       string                      `json:"visibilityMode"`
-	Address              string                      `json:"address"`
-	MobileNumber         string                      `json:"mobileNumber"`
-	DateOfBirth          time.Time                   `json:"dateOfBirth"`
-	BusinessName         string                      `json:"businessName"`
-	IdentityDocumentType string                      `json:"identityType"`
-	IdentityDocument     string                      `json:"identityDocument"`
-	BusinessDocument     string                      `json:"businessDocument"`
+	TradingName               string                      `json:"tradingName"`
+	Currency                  string                      `json:"currency"`
+	Currencies                []string                    `json:"currencies"`
+	HostIdentifier            string                      `json:"hostIdentifier"`
+	IsAvailable               bool                        `json:"isAvailable"`
+	Tokens                    []ProviderOrderTokenPayload `json:"tokens"`
+	VisibilityMode            string                      `json:"visibilityMode"`
+	Address                   string                      `json:"address"`
+	MobileNumber              string                      `json:"mobileNumber"`
+	DateOfBirth               time.Time                   `json:"dateOfBirth"`
+	BusinessName              string                      `json:"businessName"`
+	IdentityDocumentType      string                      `json:"identityType"`
+	IdentityDocument          string                      `json:"identityDocument"`
+	BusinessDocument          string                      `json:"businessDocument"`
+	OperatingTimezone         string                      `json:"operatingTimezone"`
+	OperatingHours            []OperatingHours            `json:"operatingHours"`
+	OperatingHoursExceptions  []OperatingHoursException   `json:"operatingHoursExceptions"`
+	SupportedInstitutions     []string                    `json:"supportedInstitutions"`
+	SupportedInstitutionTypes []string                    `json:"supportedInstitutionTypes" binding:"omitempty,dive,oneof=bank mobile_money"`
+}
+
+// OperatingHours is a provider's opening window on a day of the week
+type OperatingHours struct {
+	Weekday int    `json:"weekday"` // 0 is Sunday
+	Open    string `json:"open"`    // HH:MM in the provider's timezone
+	Close   string `json:"close"`   // HH:MM in the provider's timezone, before open if it closes after midnight
+}
+
+// OperatingHoursException overrides a provider's weekly operating hours on a date
+type OperatingHoursException struct {
+	Date   string `json:"date"` // YYYY-MM-DD in the provider's timezone
+	Closed bool   `json:"closed"`
+	Open   string `json:"open"`
+	Close  string `json:"close"`
 }
 
 // ProviderProfileResponse is the response for the provider profile endpoint
 type ProviderProfileResponse struct {
-	ID                   string                               `json:"id"`
-	FirstName            string                               `json:"firstName"`
-	LastName             string                               `json:"lastName"`
-	Email                string                               `json:"email"`
-	TradingName          string                               `json:"tradingName"`
-	Currency             string                               `json:"currency"`
-	HostIdentifier       string                               `json:"hostIdentifier"`
-	IsAvailable          bool                                 `json:"isAvailable"`
-	Tokens               []ProviderOrderTokenPayload          `json:"tokens"`
-	APIKey               APIKeyResponse                       `json:"apiKey"`
-	IsActive             bool                                 `json:"isActive"`
-	Address              string                               `json:"address"`
-	MobileNumber         string                               `json:"mobileNumber"`
-	VisibilityMode       providerprofile.VisibilityMode       `json:"visibilityMode"`
-	DateOfBirth          time.Time                            `json:"dateOfBirth"`
-	BusinessName         string                               `json:"businessName"`
-	IdentityDocumentType providerprofile.IdentityDocumentType `json:"identityType"`
-	IdentityDocument     string                               `json:"identityDocument"`
-	BusinessDocument     string                               `json:"businessDocument"`
-	IsKybVerified        bool                                 `json:"isKybVerified"`
+	ID                        string                               `json:"id"`
+	FirstName                 string                               `json:"firstName"`
+	LastName                  string                               `json:"lastName"`
+	Email                     string                               `json:"email"`
+	TradingName               string                               `json:"tradingName"`
+	Currencies                []string                             `json:"currencies"`
+	HostIdentifier            string                               `json:"hostIdentifier"`
+	IsAvailable               bool                                 `json:"isAvailable"`
+	Tokens                    []ProviderOrderTokenPayload          `json:"tokens"`
+	APIKey                    APIKeyResponse                       `json:"apiKey"`
+	IsActive                  bool                                 `json:"isActive"`
+	Address                   string                               `json:"address"`
+	MobileNumber              string                               `json:"mobileNumber"`
+	VisibilityMode            providerprofile.VisibilityMode       `json:"visibilityMode"`
+	DateOfBirth               time.Time                            `json:"dateOfBirth"`
+	BusinessName              string                               `json:"businessName"`
+	IdentityDocumentType      providerprofile.IdentityDocumentType `json:"identityType"`
+	IdentityDocument          string                               `json:"identityDocument"`
+	BusinessDocument          string                               `json:"businessDocument"`
+	IsKybVerified             bool                                 `json:"isKybVerified"`
+	StaleRates                []ProviderStaleRate                  `json:"staleRates"`
+	OperatingTimezone         string                               `json:"operatingTimezone"`
+	OperatingHours            []OperatingHours                     `json:"operatingHours"`
+	OperatingHoursExceptions  []OperatingHoursException            `json:"operatingHoursExceptions"`
+	SupportedInstitutions     []string                             `json:"supportedInstitutions"`
+	SupportedInstitutionTypes []string                             `json:"supportedInstitutionTypes"`
+	NodeProtocolVersion       int                                  `json:"nodeProtocolVersion"`
+	NodeCapabilities          []string                             `json:"nodeCapabilities"`
+}
+
+// ProviderStaleRate is a provider token rate excluded from the order queues for deviating too far from the market rate
+type ProviderStaleRate struct {
+	Symbol     string          `json:"symbol"`
+	Currency   string          `json:"currency"`
+	Rate       decimal.Decimal `json:"rate"`
+	MarketRate decimal.Decimal `json:"marketRate"`
+	Deviation  decimal.Decimal `json:"deviation"` // in percentage
+	StaleSince time.Time       `json:"staleSince"`
 }
 
 // SenderOrderTokenResponse defines the provider setting for a token
@@ -272,17 +404,17 @@ type SenderOrderTokenResponse struct {
 
 // SenderProfileResponse is the response for the sender profile endpoint
 type SenderProfileResponse struct {
-	ID               uuid.UUID                  `json:"id"`
-	FirstName        string                     `json:"firstName"`
-	LastName         string                     `json:"lastName"`
-	Email            string                     `json:"email"`
-	WebhookURL       string                     `json:"webhookUrl"`
-	DomainWhitelist  []string                   `json:"domainWhitelist"`
-	Tokens           []SenderOrderTokenResponse `json:"tokens"`
-	APIKey           APIKeyResponse             `json:"apiKey"`
-	ProviderID       string                     `json:"providerId"`
-	ProviderCurrency string                     `json:"providerCurrency"`
-	IsActive         bool                       `json:"isActive"`
+	ID                 uuid.UUID                  `json:"id"`
+	FirstName          string                     `json:"firstName"`
+	LastName           string                     `json:"lastName"`
+	Email              string                     `json:"email"`
+	WebhookURL         string                     `json:"webhookUrl"`
+	DomainWhitelist    []string                   `json:"domainWhitelist"`
+	Tokens             []SenderOrderTokenResponse `json:"tokens"`
+	APIKey             APIKeyResponse             `json:"apiKey"`
+	ProviderID         string                     `json:"providerId"`
+	ProviderCurrencies []string                   `json:"providerCurrencies"`
+	IsActive           bool                       `json:"isActive"`
 }
 
 // RefreshResponse is the response for the refresh endpoint
@@ -323,6 +455,23 @@ type LockPaymentOrderFields struct {
 	CreatedAt         time.Time
 }
 
+// SplitPlan is the plan a lock payment order too large for a single bucket is split into chunks by
+type SplitPlan struct {
+	Strategy    string           `json:"strategy"`
+	Reason      string           `json:"reason,omitempty"`
+	TotalAmount decimal.Decimal  `json:"totalAmount"`
+	Chunks      []SplitPlanChunk `json:"chunks"`
+}
+
+// SplitPlanChunk is a chunk of a split lock payment order
+type SplitPlanChunk struct {
+	Amount       decimal.Decimal `json:"amount"`
+	FiatAmount   decimal.Decimal `json:"fiatAmount"`
+	OrderPercent decimal.Decimal `json:"orderPercent"`
+	BucketID     int             `json:"bucketId"`
+	ProviderID   string          `json:"providerId,omitempty"`
+}
+
 // TransactionLog
 type TransactionLog struct {
 	ID        uuid.UUID             `json:"id" binding:"required"`
@@ -418,27 +567,28 @@ type ReceiveAddressResponse struct {
 
 // PaymentOrderResponse is the response type for a payment order
 type PaymentOrderResponse struct {
-	ID             uuid.UUID             `json:"id"`
-	Amount         decimal.Decimal       `json:"amount"`
-	AmountPaid     decimal.Decimal       `json:"amountPaid"`
-	AmountReturned decimal.Decimal       `json:"amountReturned"`
-	Token          string                `json:"token"`
-	SenderFee      decimal.Decimal       `json:"senderFee"`
-	TransactionFee decimal.Decimal       `json:"transactionFee"`
-	Rate           decimal.Decimal       `json:"rate"`
-	Network        string                `json:"network"`
-	GatewayID      string                `json:"gatewayId"`
-	Recipient      PaymentOrderRecipient `json:"recipient"`
-	FromAddress    string                `json:"fromAddress"`
-	ReturnAddress  string                `json:"returnAddress"`
-	ReceiveAddress string                `json:"receiveAddress"`
-	FeeAddress     string                `json:"feeAddress"`
-	Reference      string                `json:"reference"`
-	CreatedAt      time.Time             `json:"createdAt"`
-	UpdatedAt      time.Time             `json:"updatedAt"`
-	TxHash         string                `json:"txHash"`
-	Status         paymentorder.Status   `json:"status"`
-	Transactions   []TransactionLog      `json:"transactionLogs"`
+	ID             uuid.UUID                  `json:"id"`
+	Amount         decimal.Decimal            `json:"amount"`
+	AmountPaid     decimal.Decimal            `json:"amountPaid"`
+	AmountReturned decimal.Decimal            `json:"amountReturned"`
+	Token          string                     `json:"token"`
+	SenderFee      decimal.Decimal            `json:"senderFee"`
+	TransactionFee decimal.Decimal            `json:"transactionFee"`
+	Rate           decimal.Decimal            `json:"rate"`
+	Network        string                     `json:"network"`
+	GatewayID      string                     `json:"gatewayId"`
+	Recipient      PaymentOrderRecipient      `json:"recipient"`
+	FromAddress    string                     `json:"fromAddress"`
+	ReturnAddress  string                     `json:"returnAddress"`
+	ReceiveAddress string                     `json:"receiveAddress"`
+	FeeAddress     string                     `json:"feeAddress"`
+	Reference      string                     `json:"reference"`
+	CreatedAt      time.Time                  `json:"createdAt"`
+	UpdatedAt      time.Time                  `json:"updatedAt"`
+	TxHash         string                     `json:"txHash"`
+	Status         paymentorder.Status        `json:"status"`
+	Transactions   []TransactionLog           `json:"transactionLogs"`
+	Fulfillments   []FulfillmentProofResponse `json:"fulfillments,omitempty"`
 }
 
 // PaymentOrderWebhookData is the data type for a payment order webhook
@@ -566,6 +716,72 @@ type ChangePasswordPayload struct {
 	NewPassword string `json:"newPassword" binding:"required,min=6,max=20"`
 }
 
+// TeamInvitationPayload is the payload for inviting a user to a team
+type TeamInvitationPayload struct {
+	Email string `json:"email" binding:"required,email"`
+	Role  string `json:"role" binding:"required,oneof=admin developer finance"`
+}
+
+// UpdateTeamMemberPayload is the payload for changing the role of a team member
+type UpdateTeamMemberPayload struct {
+	Role string `json:"role" binding:"required,oneof=admin developer finance"`
+}
+
+// AcceptTeamInvitationPayload is the payload for the accept invitation endpoint.
+// FirstName and LastName are only required when the invited email has no account yet.
+type AcceptTeamInvitationPayload struct {
+	Token     string `json:"token" binding:"required"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Password  string `json:"password" binding:"required,min=6,max=20"`
+}
+
+// TeamMemberResponse is the response for a member of a team
+type TeamMemberResponse struct {
+	ID        uuid.UUID `json:"id"`
+	FirstName string    `json:"firstName"`
+	LastName  string    `json:"lastName"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TeamInvitationResponse is the response for a team invitation
+type TeamInvitationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TeamResponse is the response for the team endpoint.
+// The owner is the user the profile was registered with, and their ID is the user ID.
+type TeamResponse struct {
+	Owner       TeamMemberResponse       `json:"owner"`
+	Members     []TeamMemberResponse     `json:"members"`
+	Invitations []TeamInvitationResponse `json:"invitations"`
+}
+
+// TeamAuditLogResponse is the response for a team audit log entry
+type TeamAuditLogResponse struct {
+	ID          uuid.UUID              `json:"id"`
+	Action      string                 `json:"action"`
+	ActorEmail  string                 `json:"actorEmail"`
+	TargetEmail string                 `json:"targetEmail"`
+	Metadata    map[string]interface{} `json:"metadata"`
+	CreatedAt   time.Time              `json:"createdAt"`
+}
+
+// TeamAuditLogList is the struct for a list of team audit logs
+type TeamAuditLogList struct {
+	TotalRecords int                    `json:"total"`
+	Page         int                    `json:"page"`
+	PageSize     int                    `json:"pageSize"`
+	Logs         []TeamAuditLogResponse `json:"logs"`
+}
+
 // SenderStatsResponse is the response for the sender stats endpoint
 type SenderStatsResponse struct {
 	TotalOrders      int             `json:"totalOrders"`
@@ -580,6 +796,51 @@ type ProviderStatsResponse struct {
 	TotalCryptoVolume decimal.Decimal `json:"totalCryptoVolume"`
 }
 
+// ProviderHealthCheckResponse is the response for a single provider node health check
+type ProviderHealthCheckResponse struct {
+	IsHealthy  bool      `json:"isHealthy"`
+	LatencyMs  int64     `json:"latencyMs"`
+	StatusCode int       `json:"statusCode,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	CheckedAt  time.Time `json:"checkedAt"`
+}
+
+// ProviderHealthResponse is the response for the provider node health endpoints
+type ProviderHealthResponse struct {
+	ProviderID     string                        `json:"providerId"`
+	IsHealthy      bool                          `json:"isHealthy"`
+	FailureStreak  int                           `json:"failureStreak"`
+	LastCheckedAt  time.Time                     `json:"lastCheckedAt"`
+	AverageLatency int64                         `json:"averageLatencyMs"`
+	TotalRecords   int                           `json:"total"`
+	Page           int                           `json:"page"`
+	PageSize       int                           `json:"pageSize"`
+	Checks         []ProviderHealthCheckResponse `json:"checks"`
+}
+
+// SLAPercentiles holds the percentiles, in seconds, of one SLA metric
+type SLAPercentiles struct {
+	Count int     `json:"count"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+}
+
+// ProviderSLAReport is the response for the provider SLA report endpoints
+type ProviderSLAReport struct {
+	ProviderID          string         `json:"providerId"`
+	From                time.Time      `json:"from"`
+	To                  time.Time      `json:"to"`
+	TotalAssigned       int            `json:"totalAssigned"`
+	TimeToAccept        SLAPercentiles `json:"timeToAccept"`
+	TimeToFulfill       SLAPercentiles `json:"timeToFulfill"`
+	TimeToValidate      SLAPercentiles `json:"timeToValidate"`
+	AcceptTimeouts      int            `json:"acceptTimeouts"`
+	FulfillmentTimeouts int            `json:"fulfillmentTimeouts"`
+	DemotedUntil        *time.Time     `json:"demotedUntil,omitempty"`
+	SuspendedUntil      *time.Time     `json:"suspendedUntil,omitempty"`
+}
+
 // VerifyAccountRequest is the request for account verification of an institution
 type VerifyAccountRequest struct {
 	Institution       string `json:"institution" binding:"required"`
@@ -674,3 +935,370 @@ type LinkedAddressTransactionList struct {
 	PageSize     int                        `json:"pageSize"`
 	Transactions []LinkedAddressTransaction `json:"transactions"`
 }
+
+// DisputeEvidencePayload is the payload for submitting evidence on a dispute
+type DisputeEvidencePayload struct {
+	Type      disputeevidence.Type `json:"type" binding:"required,oneof=psp_reference receipt bank_statement other"`
+	Reference string               `json:"reference"`
+	URL       string               `json:"url" binding:"omitempty,url"`
+	Note      string               `json:"note"`
+}
+
+// NewDisputePayload is the payload for opening a dispute on a payment order
+type NewDisputePayload struct {
+	Reason      string                   `json:"reason" binding:"required"`
+	LockOrderID string                   `json:"lockOrderId" binding:"omitempty,uuid"`
+	Evidence    []DisputeEvidencePayload `json:"evidence" binding:"omitempty,dive"`
+}
+
+// ResolveDisputePayload is the payload for an admin resolution of a dispute
+type ResolveDisputePayload struct {
+	Resolution           dispute.Resolution `json:"resolution" binding:"required,oneof=sender_favored provider_favored"`
+	Note                 string             `json:"note" binding:"required"`
+	TrustScoreAdjustment decimal.Decimal    `json:"trustScoreAdjustment"`
+	CompensationAmount   decimal.Decimal    `json:"compensationAmount"`
+}
+
+// UpdateFulfillmentValidationPayload is the payload for setting a currency's fulfillment validation policy
+type UpdateFulfillmentValidationPayload struct {
+	Policy fiatcurrency.FulfillmentValidation `json:"policy" binding:"required,oneof=provider psp_optional psp_required"`
+}
+
+// UpdateOrderRequestModePayload is the payload for setting how a currency's order requests are sent to providers
+type UpdateOrderRequestModePayload struct {
+	Mode fiatcurrency.OrderRequestMode `json:"mode" binding:"required,oneof=sequential broadcast"`
+}
+
+// UpdateMatchingStrategyPayload is the payload for setting the matching strategy of a currency or bucket
+type UpdateMatchingStrategyPayload struct {
+	Strategy string `json:"strategy" binding:"omitempty,oneof=price_time round_robin trust_weighted lowest_latency"`
+}
+
+// MatchingStrategyMetrics is the response for the metrics of a matching strategy
+type MatchingStrategyMetrics struct {
+	Strategy           string          `json:"strategy"`
+	Attempts           int64           `json:"attempts"`
+	Matches            int64           `json:"matches"`
+	Unmatched          int64           `json:"unmatched"`
+	SendFailures       int64           `json:"sendFailures"`
+	MatchRate          decimal.Decimal `json:"matchRate"`
+	AverageMatchTimeMs decimal.Decimal `json:"averageMatchTimeMs"`
+}
+
+// BucketQueueEntry is a decoded entry of a bucket's circular queue
+type BucketQueueEntry struct {
+	Position       int             `json:"position"`
+	ProviderID     string          `json:"providerId"`
+	Token          string          `json:"token"`
+	Rate           decimal.Decimal `json:"rate"`
+	MinOrderAmount decimal.Decimal `json:"minOrderAmount"`
+	MaxOrderAmount decimal.Decimal `json:"maxOrderAmount"`
+	Pinned         bool            `json:"pinned"`
+}
+
+// BucketQueueExclusion is why a provider, or one of its tokens, was left out of a bucket queue
+type BucketQueueExclusion struct {
+	ProviderID string `json:"providerId"`
+	Token      string `json:"token,omitempty"`
+	Reason     string `json:"reason"`
+}
+
+// BucketQueueOverride is a temporary admin override of a provider's place in a bucket queue
+type BucketQueueOverride struct {
+	ProviderID string    `json:"providerId"`
+	Action     string    `json:"action"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// BucketQueueBuild is the record of the last time a bucket queue was built
+type BucketQueueBuild struct {
+	BuiltAt    time.Time              `json:"builtAt"`
+	Exclusions []BucketQueueExclusion `json:"exclusions"`
+}
+
+// BucketQueueResponse is the response for a bucket queue
+type BucketQueueResponse struct {
+	BucketID         int                   `json:"bucketId"`
+	Currency         string                `json:"currency"`
+	MinAmount        decimal.Decimal       `json:"minAmount"`
+	MaxAmount        decimal.Decimal       `json:"maxAmount"`
+	MatchingStrategy string                `json:"matchingStrategy,omitempty"`
+	Entries          []BucketQueueEntry    `json:"entries"`
+	Overrides        []BucketQueueOverride `json:"overrides"`
+	LastBuild        *BucketQueueBuild     `json:"lastBuild"`
+}
+
+// BucketQueueOverridePayload is the payload for pinning or removing a provider in a bucket queue
+type BucketQueueOverridePayload struct {
+	Action          string `json:"action" binding:"required,oneof=pin remove"`
+	DurationMinutes int    `json:"durationMinutes" binding:"required,min=1,max=10080"`
+}
+
+// ProposedBucket is a bucket of a bucket proposal with the orders that fell in it during the analysis window
+type ProposedBucket struct {
+	ID         int             `json:"id,omitempty"`
+	MinAmount  decimal.Decimal `json:"minAmount"`
+	MaxAmount  decimal.Decimal `json:"maxAmount"`
+	OrderCount int             `json:"orderCount"`
+}
+
+// BucketProposalResponse is the response for a bucket proposal
+type BucketProposalResponse struct {
+	ID             uuid.UUID        `json:"id"`
+	Currency       string           `json:"currency"`
+	Status         string           `json:"status"`
+	WindowStart    time.Time        `json:"windowStart"`
+	WindowEnd      time.Time        `json:"windowEnd"`
+	OrderCount     int              `json:"orderCount"`
+	Buckets        []ProposedBucket `json:"buckets"`
+	CurrentBuckets []ProposedBucket `json:"currentBuckets"`
+	AppliedAt      *time.Time       `json:"appliedAt,omitempty"`
+	CreatedAt      time.Time        `json:"createdAt"`
+}
+
+// NewBucketProposalPayload is the payload for proposing new buckets for a currency
+type NewBucketProposalPayload struct {
+	Currency string `json:"currency" binding:"required"`
+}
+
+// DeadLetterOrderResponse is the response for an order in the dead-letter queue
+type DeadLetterOrderResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	OrderID        uuid.UUID       `json:"orderId"`
+	Amount         decimal.Decimal `json:"amount"`
+	Rate           decimal.Decimal `json:"rate"`
+	Token          string          `json:"token"`
+	Currency       string          `json:"currency"`
+	Institution    string          `json:"institution"`
+	Reason         string          `json:"reason"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextRetryAt    time.Time       `json:"nextRetryAt"`
+	RefundDeadline time.Time       `json:"refundDeadline"`
+	ResolvedAt     *time.Time      `json:"resolvedAt,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+// MatchingSnapshot is a fixture of the providers, buckets and orders to replay through the matching engine
+type MatchingSnapshot struct {
+	Currencies []MatchingSnapshotCurrency `json:"currencies"`
+	Providers  []MatchingSnapshotProvider `json:"providers"`
+	Buckets    []MatchingSnapshotBucket   `json:"buckets"`
+	Orders     []MatchingSnapshotOrder    `json:"orders"`
+}
+
+// MatchingSnapshotCurrency is a fiat currency in a matching snapshot
+type MatchingSnapshotCurrency struct {
+	Code             string                        `json:"code"`
+	MarketRate       decimal.Decimal               `json:"marketRate"`
+	MatchingStrategy string                        `json:"matchingStrategy,omitempty"`
+	Institutions     []MatchingSnapshotInstitution `json:"institutions"`
+}
+
+// MatchingSnapshotInstitution is an institution orders in a matching snapshot are paid out to
+type MatchingSnapshotInstitution struct {
+	Code string `json:"code"`
+	Type string `json:"type,omitempty"`
+}
+
+// MatchingSnapshotProvider is a provider in a matching snapshot
+type MatchingSnapshotProvider struct {
+	ID                        string                          `json:"id"`
+	TrustScore                decimal.Decimal                 `json:"trustScore"`
+	LatencyMs                 int64                           `json:"latencyMs,omitempty"`
+	Demoted                   bool                            `json:"demoted,omitempty"`
+	SupportedInstitutions     []string                        `json:"supportedInstitutions,omitempty"`
+	SupportedInstitutionTypes []string                        `json:"supportedInstitutionTypes,omitempty"`
+	Tokens                    []MatchingSnapshotProviderToken `json:"tokens"`
+}
+
+// MatchingSnapshotProviderToken is a provider's token config in a matching snapshot
+type MatchingSnapshotProviderToken struct {
+	Symbol                 string          `json:"symbol"`
+	Currency               string          `json:"currency"`
+	ConversionRateType     string          `json:"conversionRateType"`
+	FixedConversionRate    decimal.Decimal `json:"fixedConversionRate"`
+	FloatingConversionRate decimal.Decimal `json:"floatingConversionRate"`
+	MinOrderAmount         decimal.Decimal `json:"minOrderAmount"`
+	MaxOrderAmount         decimal.Decimal `json:"maxOrderAmount"`
+}
+
+// MatchingSnapshotBucket is a provision bucket in a matching snapshot
+type MatchingSnapshotBucket struct {
+	Currency         string          `json:"currency"`
+	MinAmount        decimal.Decimal `json:"minAmount"`
+	MaxAmount        decimal.Decimal `json:"maxAmount"`
+	MatchingStrategy string          `json:"matchingStrategy,omitempty"`
+	Providers        []string        `json:"providers"`
+}
+
+// MatchingSnapshotOrder is a historical lock payment order in a matching snapshot
+type MatchingSnapshotOrder struct {
+	ID          uuid.UUID       `json:"id"`
+	Token       string          `json:"token"`
+	Amount      decimal.Decimal `json:"amount"`
+	Rate        decimal.Decimal `json:"rate"`
+	Institution string          `json:"institution"`
+	ProviderID  string          `json:"providerId,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+// MatchingSimulationReport is the outcome of replaying orders through the matching engine
+type MatchingSimulationReport struct {
+	Strategy        string                             `json:"strategy,omitempty"`
+	Orders          int                                `json:"orders"`
+	Matched         int                                `json:"matched"`
+	Unmatched       int                                `json:"unmatched"`
+	Reassigned      int                                `json:"reassigned"`
+	MatchRate       decimal.Decimal                    `json:"matchRate"`
+	Strategies      map[string]int                     `json:"strategies"`
+	Rates           MatchingSimulationRates            `json:"rates"`
+	Providers       []MatchingSimulationProviderLoad   `json:"providers"`
+	UnmatchedOrders []MatchingSimulationUnmatchedOrder `json:"unmatchedOrders"`
+}
+
+// MatchingSimulationRates summarizes the rates matched orders were quoted, relative to the order rates
+type MatchingSimulationRates struct {
+	AboveOrderRate int             `json:"aboveOrderRate"`
+	AtOrderRate    int             `json:"atOrderRate"`
+	BelowOrderRate int             `json:"belowOrderRate"`
+	AverageSpread  decimal.Decimal `json:"averageSpread"`
+	MinSpread      decimal.Decimal `json:"minSpread"`
+	MaxSpread      decimal.Decimal `json:"maxSpread"`
+}
+
+// MatchingSimulationProviderLoad is the load a provider was assigned in a matching simulation
+type MatchingSimulationProviderLoad struct {
+	ProviderID       string          `json:"providerId"`
+	Orders           int             `json:"orders"`
+	HistoricalOrders int             `json:"historicalOrders"`
+	Volume           decimal.Decimal `json:"volume"`
+	FiatVolume       decimal.Decimal `json:"fiatVolume"`
+	Share            decimal.Decimal `json:"share"`
+}
+
+// MatchingSimulationUnmatchedOrder is an order no provider was matched to in a matching simulation
+type MatchingSimulationUnmatchedOrder struct {
+	OrderID     uuid.UUID       `json:"orderId"`
+	Token       string          `json:"token"`
+	Amount      decimal.Decimal `json:"amount"`
+	Rate        decimal.Decimal `json:"rate"`
+	Institution string          `json:"institution"`
+	Reason      string          `json:"reason"`
+}
+
+// DisputeEvidenceResponse is the response for a piece of dispute evidence
+type DisputeEvidenceResponse struct {
+	ID          uuid.UUID                   `json:"id"`
+	SubmittedBy disputeevidence.SubmittedBy `json:"submittedBy"`
+	Type        disputeevidence.Type        `json:"type"`
+	Reference   string                      `json:"reference"`
+	URL         string                      `json:"url"`
+	Note        string                      `json:"note"`
+	CreatedAt   time.Time                   `json:"createdAt"`
+}
+
+// DisputeResponse is the response for a dispute
+type DisputeResponse struct {
+	ID                   uuid.UUID                  `json:"id"`
+	PaymentOrderID       uuid.UUID                  `json:"paymentOrderId"`
+	LockOrderID          *uuid.UUID                 `json:"lockOrderId"`
+	ProviderID           string                     `json:"providerId"`
+	Reason               string                     `json:"reason"`
+	Status               dispute.Status             `json:"status"`
+	ResponseDeadline     time.Time                  `json:"responseDeadline"`
+	ResolutionDeadline   time.Time                  `json:"resolutionDeadline"`
+	Resolution           dispute.Resolution         `json:"resolution,omitempty"`
+	ResolutionNote       string                     `json:"resolutionNote,omitempty"`
+	TrustScoreAdjustment decimal.Decimal            `json:"trustScoreAdjustment"`
+	CompensationAmount   decimal.Decimal            `json:"compensationAmount"`
+	CompensationStatus   dispute.CompensationStatus `json:"compensationStatus"`
+	ResolvedAt           *time.Time                 `json:"resolvedAt"`
+	Evidence             []DisputeEvidenceResponse  `json:"evidence"`
+	CreatedAt            time.Time                  `json:"createdAt"`
+	UpdatedAt            time.Time                  `json:"updatedAt"`
+}
+
+// DisputeList is the struct for a list of disputes
+type DisputeList struct {
+	TotalRecords int               `json:"total"`
+	Page         int               `json:"page"`
+	PageSize     int               `json:"pageSize"`
+	Disputes     []DisputeResponse `json:"disputes"`
+}
+
+// OrderAssignmentResponse is the response for an event in the assignment history of an order
+type OrderAssignmentResponse struct {
+	ProviderID string     `json:"providerId"`
+	Event      string     `json:"event"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// RateObservation is a rate recorded in the rate history
+type RateObservation struct {
+	CurrencyID uuid.UUID
+	Kind       ratesnapshot.Kind
+	Rate       decimal.Decimal
+	ProviderID string
+	Token      string
+}
+
+// RateHistoryFilter selects the rates of a currency in the rate history
+type RateHistoryFilter struct {
+	Currency   string
+	Kind       ratesnapshot.Kind
+	ProviderID string
+	Token      string
+}
+
+// RateCandleResponse is the response for the OHLC candle of a period in the rate history
+type RateCandleResponse struct {
+	Time        time.Time       `json:"time"`
+	Open        decimal.Decimal `json:"open"`
+	High        decimal.Decimal `json:"high"`
+	Low         decimal.Decimal `json:"low"`
+	Close       decimal.Decimal `json:"close"`
+	SampleCount int             `json:"sampleCount"`
+}
+
+// HistoricalRateResponse is the response for the rate in effect at a point in time
+type HistoricalRateResponse struct {
+	Rate       decimal.Decimal `json:"rate"`
+	RecordedAt time.Time       `json:"recordedAt"`
+	Resolution string          `json:"resolution"`
+}
+
+// RateCircuitBreakerPayload is the payload for setting the rules of a currency's market rate circuit breaker
+type RateCircuitBreakerPayload struct {
+	MaxChangePercent     decimal.Decimal `json:"maxChangePercent"`
+	ChangeWindow         int             `json:"changeWindow" binding:"gte=1"`
+	MaxDivergencePercent decimal.Decimal `json:"maxDivergencePercent"`
+	AutoResetAfter       int             `json:"autoResetAfter" binding:"gte=0"`
+}
+
+// RateCircuitBreakerResponse is the response for a currency's market rate circuit breaker
+type RateCircuitBreakerResponse struct {
+	Currency             string           `json:"currency"`
+	MaxChangePercent     decimal.Decimal  `json:"maxChangePercent"`
+	ChangeWindow         int              `json:"changeWindow"`
+	MaxDivergencePercent decimal.Decimal  `json:"maxDivergencePercent"`
+	AutoResetAfter       int              `json:"autoResetAfter"`
+	Tripped              bool             `json:"tripped"`
+	TripReason           string           `json:"tripReason,omitempty"`
+	TrippedAt            *time.Time       `json:"trippedAt,omitempty"`
+	FrozenRate           *decimal.Decimal `json:"frozenRate,omitempty"`
+}
+
+// PublicHolidayPayload is the payload for adding a public holiday to a currency
+type PublicHolidayPayload struct {
+	Date string `json:"date" binding:"required,datetime=2006-01-02"`
+	Name string `json:"name" binding:"required"`
+}
+
+// PublicHolidayResponse is the response for a public holiday of a currency
+type PublicHolidayResponse struct {
+	ID   int    `json:"id"`
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
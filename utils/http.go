@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -110,6 +111,32 @@ func Paginate(ctx *gin.Context) (page int, offset int, limit int) {
 	return page, offset, pageSize
 }
 
+// TimeRange parses the from and to query params (RFC 3339) and returns the time range they cover.
+// The range ends now and spans the default window when the params are not provided.
+func TimeRange(ctx *gin.Context, defaultWindow time.Duration) (from time.Time, to time.Time, err error) {
+	to = time.Now()
+	if toQuery := ctx.Query("to"); toQuery != "" {
+		to, err = time.Parse(time.RFC3339, toQuery)
+		if err != nil {
+			return from, to, fmt.Errorf("invalid to date: %w", err)
+		}
+	}
+
+	from = to.Add(-defaultWindow)
+	if fromQuery := ctx.Query("from"); fromQuery != "" {
+		from, err = time.Parse(time.RFC3339, fromQuery)
+		if err != nil {
+			return from, to, fmt.Errorf("invalid from date: %w", err)
+		}
+	}
+
+	if from.After(to) {
+		return from, to, fmt.Errorf("from date must be before to date")
+	}
+
+	return from, to, nil
+}
+
 // IsURL checks if a string is a valid URL
 func IsURL(s string) bool {
 	_, err := url.ParseRequestURI(s)
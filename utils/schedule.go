@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/paycrest/aggregator/types"
+)
+
+// parseClockTime parses a HH:MM time of day into minutes after midnight
+func parseClockTime(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ValidateOperatingHours checks that a provider's operating schedule is well formed
+func ValidateOperatingHours(timezone string, hours []types.OperatingHours, exceptions []types.OperatingHoursException) error {
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return fmt.Errorf("invalid timezone %q", timezone)
+	}
+
+	for _, h := range hours {
+		if h.Weekday < 0 || h.Weekday > 6 {
+			return fmt.Errorf("invalid weekday %d, expected 0 (Sunday) to 6 (Saturday)", h.Weekday)
+		}
+		opensAt, err := parseClockTime(h.Open)
+		if err != nil {
+			return err
+		}
+		closesAt, err := parseClockTime(h.Close)
+		if err != nil {
+			return err
+		}
+		if opensAt == closesAt {
+			return fmt.Errorf("opening and closing times must differ")
+		}
+	}
+
+	for _, e := range exceptions {
+		if _, err := time.Parse("2006-01-02", e.Date); err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", e.Date)
+		}
+		if e.Closed {
+			continue
+		}
+		opensAt, err := parseClockTime(e.Open)
+		if err != nil {
+			return err
+		}
+		closesAt, err := parseClockTime(e.Close)
+		if err != nil {
+			return err
+		}
+		if opensAt >= closesAt {
+			return fmt.Errorf("opening time must be before closing time on %s", e.Date)
+		}
+	}
+
+	return nil
+}
+
+// IsWithinOperatingHours checks whether a provider is open at the given time.
+// Date exceptions take precedence over public holidays (YYYY-MM-DD), which take precedence over the weekly hours.
+func IsWithinOperatingHours(
+	now time.Time,
+	timezone string,
+	hours []types.OperatingHours,
+	exceptions []types.OperatingHoursException,
+	holidays []string,
+) (bool, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return false, fmt.Errorf("IsWithinOperatingHours: %w", err)
+	}
+
+	local := now.In(location)
+	date := local.Format("2006-01-02")
+	minute := local.Hour()*60 + local.Minute()
+
+	for _, e := range exceptions {
+		if e.Date != date {
+			continue
+		}
+		if e.Closed {
+			return false, nil
+		}
+		opensAt, err := parseClockTime(e.Open)
+		if err != nil {
+			return false, fmt.Errorf("IsWithinOperatingHours: %w", err)
+		}
+		closesAt, err := parseClockTime(e.Close)
+		if err != nil {
+			return false, fmt.Errorf("IsWithinOperatingHours: %w", err)
+		}
+		return minute >= opensAt && minute < closesAt, nil
+	}
+
+	if ContainsString(holidays, date) {
+		return false, nil
+	}
+
+	today := int(local.Weekday())
+	yesterday := (today + 6) % 7
+
+	for _, h := range hours {
+		opensAt, err := parseClockTime(h.Open)
+		if err != nil {
+			return false, fmt.Errorf("IsWithinOperatingHours: %w", err)
+		}
+		closesAt, err := parseClockTime(h.Close)
+		if err != nil {
+			return false, fmt.Errorf("IsWithinOperatingHours: %w", err)
+		}
+
+		if h.Weekday == today {
+			if opensAt < closesAt && minute >= opensAt && minute < closesAt {
+				return true, nil
+			}
+			if opensAt > closesAt && minute >= opensAt {
+				return true, nil
+			}
+		}
+
+		// Hours that run past midnight carry over into the next day
+		if h.Weekday == yesterday && opensAt > closesAt && minute < closesAt {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
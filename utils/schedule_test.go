@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/paycrest/aggregator/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule(t *testing.T) {
+	hours := []types.OperatingHours{
+		{Weekday: 1, Open: "08:00", Close: "17:00"}, // Monday
+		{Weekday: 5, Open: "20:00", Close: "02:00"}, // Friday, past midnight
+	}
+	exceptions := []types.OperatingHoursException{
+		{Date: "2025-01-13", Closed: true},
+		{Date: "2025-01-18", Open: "10:00", Close: "12:00"},
+	}
+	holidays := []string{"2025-01-20"}
+
+	t.Run("ValidateOperatingHours", func(t *testing.T) {
+		assert.NoError(t, ValidateOperatingHours("Africa/Lagos", hours, exceptions))
+		assert.Error(t, ValidateOperatingHours("Mars/Olympus", hours, exceptions))
+		assert.Error(t, ValidateOperatingHours("", hours, exceptions))
+		assert.Error(t, ValidateOperatingHours("Africa/Lagos", []types.OperatingHours{{Weekday: 7, Open: "08:00", Close: "17:00"}}, nil))
+		assert.Error(t, ValidateOperatingHours("Africa/Lagos", []types.OperatingHours{{Weekday: 1, Open: "8am", Close: "17:00"}}, nil))
+		assert.Error(t, ValidateOperatingHours("Africa/Lagos", nil, []types.OperatingHoursException{{Date: "13-01-2025", Closed: true}}))
+		assert.Error(t, ValidateOperatingHours("Africa/Lagos", nil, []types.OperatingHoursException{{Date: "2025-01-13", Open: "12:00", Close: "10:00"}}))
+	})
+
+	t.Run("IsWithinOperatingHours", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			now      string // UTC, Africa/Lagos is UTC+1
+			expected bool
+		}{
+			{"within weekday hours", "2025-01-06T09:30:00Z", true},
+			{"before weekday hours", "2025-01-06T06:30:00Z", false},
+			{"at closing time", "2025-01-06T16:00:00Z", false},
+			{"closed weekday", "2025-01-07T09:30:00Z", false},
+			{"late friday", "2025-01-10T21:00:00Z", true},
+			{"after midnight into saturday", "2025-01-10T23:30:00Z", true},
+			{"saturday after overnight close", "2025-01-11T02:00:00Z", false},
+			{"closed exception", "2025-01-13T09:30:00Z", false},
+			{"open exception", "2025-01-18T10:30:00Z", true},
+			{"public holiday", "2025-01-20T09:30:00Z", false},
+		}
+
+		for _, tc := range testCases {
+			now, err := time.Parse(time.RFC3339, tc.now)
+			assert.NoError(t, err)
+
+			isOpen, err := IsWithinOperatingHours(now, "Africa/Lagos", hours, exceptions, holidays)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, isOpen, tc.name)
+		}
+	})
+}
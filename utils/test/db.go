@@ -469,7 +469,7 @@ func CreateTestProviderProfile(overrides map[string]interface{}) (*ent.ProviderP
 		SetHostIdentifier(payload["host_identifier"].(string)).
 		SetProvisionMode(providerprofile.ProvisionMode(payload["provision_mode"].(string))).
 		SetUserID(payload["user_id"].(uuid.UUID)).
-		SetCurrencyID(payload["currency_id"].(uuid.UUID)).
+		AddCurrencyIDs(payload["currency_id"].(uuid.UUID)).
 		SetVisibilityMode(providerprofile.VisibilityMode(payload["visibility_mode"].(string))).
 		Save(context.Background())
 
@@ -525,10 +525,21 @@ func AddProviderOrderTokenToProvider(overrides map[string]interface{}) (*ent.Pro
 		}
 	}
 
+	// Default to the provider's first currency
+	currencyID, ok := payload["currency_id"].(uuid.UUID)
+	if !ok {
+		currency, err := payload["provider"].(*ent.ProviderProfile).QueryCurrencies().First(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		currencyID = currency.ID
+	}
+
 	orderToken, err := db.Client.ProviderOrderToken.
 		Create().
 		SetSymbol(payload["tokenSymbol"].(string)).
 		SetProvider(payload["provider"].(*ent.ProviderProfile)).
+		SetCurrencyID(currencyID).
 		SetMaxOrderAmount(payload["min_order_amount"].(decimal.Decimal)).
 		SetMinOrderAmount(payload["max_order_amount"].(decimal.Decimal)).
 		SetConversionRateType(providerordertoken.ConversionRateType(payload["conversion_rate_type"].(string))).
@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"regexp"
@@ -146,6 +147,17 @@ func ContainsString(slice []string, item string) bool {
 	return false
 }
 
+// SupportsInstitution returns true if a provider can pay out to the given institution.
+// Providers without an institution allowlist support every institution.
+func SupportsInstitution(provider *ent.ProviderProfile, institution *ent.Institution) bool {
+	if len(provider.SupportedInstitutions) == 0 && len(provider.SupportedInstitutionTypes) == 0 {
+		return true
+	}
+
+	return ContainsString(provider.SupportedInstitutions, institution.Code) ||
+		ContainsString(provider.SupportedInstitutionTypes, institution.Type.String())
+}
+
 // Median returns the median value of a decimal slice
 func Median(data []decimal.Decimal) decimal.Decimal {
 	l := len(data)
@@ -170,6 +182,27 @@ func Median(data []decimal.Decimal) decimal.Decimal {
 	return result
 }
 
+// Percentile returns the p-th percentile (0-100) of a float slice using the nearest-rank method
+func Percentile(data []float64, p float64) float64 {
+	l := len(data)
+	if l == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, l)
+	copy(sorted, data)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p / 100 * float64(l)))
+	if rank < 1 {
+		rank = 1
+	} else if rank > l {
+		rank = l
+	}
+
+	return sorted[rank-1]
+}
+
 // AbsPercentageDeviation returns the absolute percentage deviation between two values
 func AbsPercentageDeviation(trueValue, measuredValue decimal.Decimal) decimal.Decimal {
 	if trueValue.IsZero() {
@@ -180,6 +213,11 @@ func AbsPercentageDeviation(trueValue, measuredValue decimal.Decimal) decimal.De
 	return deviation.Abs()
 }
 
+// OrderPayoutAmount returns the fiat amount a provider is instructed to pay out for an order
+func OrderPayoutAmount(amount, rate decimal.Decimal) decimal.Decimal {
+	return amount.Mul(rate).RoundBank(0)
+}
+
 // SendPaymentOrderWebhook notifies a sender when the status of a payment order changes
 func SendPaymentOrderWebhook(ctx context.Context, paymentOrder *ent.PaymentOrder) error {
 	var err error
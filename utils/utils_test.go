@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/paycrest/aggregator/ent"
+	"github.com/paycrest/aggregator/ent/institution"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
@@ -87,4 +89,36 @@ func TestUtils(t *testing.T) {
 		assert := assert.New(t)
 		assert.True(median.Equal(decimal.NewFromInt(2)), "Median calculation is incorrect")
 	})
+
+	t.Run("TestPercentile", func(t *testing.T) {
+		data := []float64{35, 15, 50, 20, 40}
+
+		assert.Equal(t, float64(0), Percentile([]float64{}, 50))
+		assert.Equal(t, float64(15), Percentile(data, 0))
+		assert.Equal(t, float64(20), Percentile(data, 30))
+		assert.Equal(t, float64(35), Percentile(data, 50))
+		assert.Equal(t, float64(50), Percentile(data, 99))
+
+		// The input slice is left in its original order
+		assert.Equal(t, []float64{35, 15, 50, 20, 40}, data)
+	})
+
+	t.Run("SupportsInstitution", func(t *testing.T) {
+		bank := &ent.Institution{Code: "ABNGNGLA", Type: institution.TypeBank}
+		mobileMoney := &ent.Institution{Code: "SAFAKEPC", Type: institution.TypeMobileMoney}
+
+		assert.True(t, SupportsInstitution(&ent.ProviderProfile{}, bank))
+		assert.True(t, SupportsInstitution(&ent.ProviderProfile{}, mobileMoney))
+
+		provider := &ent.ProviderProfile{SupportedInstitutions: []string{"ABNGNGLA"}}
+		assert.True(t, SupportsInstitution(provider, bank))
+		assert.False(t, SupportsInstitution(provider, mobileMoney))
+
+		provider = &ent.ProviderProfile{
+			SupportedInstitutions:     []string{"GTBINGLA"},
+			SupportedInstitutionTypes: []string{"mobile_money"},
+		}
+		assert.False(t, SupportsInstitution(provider, bank))
+		assert.True(t, SupportsInstitution(provider, mobileMoney))
+	})
 }